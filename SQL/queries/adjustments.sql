@@ -0,0 +1,36 @@
+-- name: fiatAdjustmentCreate :one
+-- fiatAdjustmentCreate will record a pending manual adjustment of a Fiat account that awaits a decision.
+INSERT INTO fiat_adjustments (adjustment_id, client_id, currency, amount, reason_code, justification, maker_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: fiatAdjustmentGet :one
+-- fiatAdjustmentGet will retrieve a manual adjustment of a Fiat account.
+SELECT *
+FROM fiat_adjustments
+WHERE adjustment_id=$1;
+
+-- name: fiatAdjustmentGetPaginated :many
+-- fiatAdjustmentGetPaginated will retrieve a page of manual adjustments, newest first, starting from an adjustment id.
+-- The adjustments can be restricted to those with a specific status.
+SELECT *
+FROM fiat_adjustments
+WHERE (@start_id::text = '' OR adjustment_id <= @start_id::text)
+      AND (@status::text = '' OR status::text = @status::text)
+ORDER BY adjustment_id DESC
+LIMIT $1;
+
+-- name: fiatAdjustmentRowLock :one
+-- fiatAdjustmentRowLock will acquire a row level lock on a manual adjustment without locks on the foreign keys.
+SELECT *
+FROM fiat_adjustments
+WHERE adjustment_id=$1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: fiatAdjustmentDecide :one
+-- fiatAdjustmentDecide will record the decision on a pending manual adjustment.
+UPDATE fiat_adjustments
+SET status=$2, checker_id=$3, decision_note=$4, tx_id=$5, decided_at=now()
+WHERE adjustment_id=$1 AND status='PENDING'
+RETURNING *;
@@ -0,0 +1,23 @@
+-- name: adminAuditLogCreate :one
+-- adminAuditLogCreate will record an administrative action in the audit log and return the id of the entry.
+INSERT INTO admin_audit_log (admin_id, action, target, details)
+VALUES ($1, $2, $3, $4)
+RETURNING id;
+
+-- name: adminAuditLogSetOutcome :execrows
+-- adminAuditLogSetOutcome will record the outcome of an administrative action once it has been carried out. The outcome
+-- of an action can only be recorded once.
+UPDATE admin_audit_log
+SET outcome = $2
+WHERE id = $1
+      AND outcome IS NULL;
+
+-- name: adminAuditLogGetPaginated :many
+-- adminAuditLogGetPaginated will retrieve a page of audit log entries, newest first, starting from an entry id. The
+-- entries can be restricted to those for a specific target.
+SELECT *
+FROM admin_audit_log
+WHERE id <= @start_id::bigint
+      AND (@target::text = '' OR target = @target::text)
+ORDER BY id DESC
+LIMIT $1;
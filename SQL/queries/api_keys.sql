@@ -0,0 +1,42 @@
+-- name: apiKeyCreate :execrows
+-- apiKeyCreate will record an API key for a client if they hold fewer than the maximum number of active API keys.
+INSERT INTO api_keys (key_id, client_id, name, secret, scopes, allowed_ips, expires_at)
+SELECT @key_id::VARCHAR(32), @client_id::UUID, @name::VARCHAR(64), @secret::VARCHAR(256), @scopes::VARCHAR(16)[],
+       @allowed_ips::VARCHAR(64)[], sqlc.narg('expires_at')::TIMESTAMPTZ
+WHERE (
+    SELECT COUNT(*)
+    FROM api_keys
+    WHERE client_id=@client_id::UUID AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > now())
+) < @max_keys::BIGINT;
+
+-- name: apiKeyGet :one
+-- apiKeyGet will retrieve an API key along with its encrypted secret.
+SELECT *
+FROM api_keys
+WHERE key_id=$1;
+
+-- name: apiKeyGetClient :many
+-- apiKeyGetClient will retrieve the details, without the secrets, of all the API keys a client has created, newest
+-- first.
+SELECT key_id, name, scopes, allowed_ips, expires_at, created_at, revoked_at
+FROM api_keys
+WHERE client_id=$1
+ORDER BY created_at DESC;
+
+-- name: apiKeyRevoke :execrows
+-- apiKeyRevoke will revoke an active API key belonging to a client.
+UPDATE api_keys
+SET revoked_at=now()
+WHERE key_id=$1 AND client_id=$2 AND revoked_at IS NULL;
+
+-- name: apiKeySecrets :many
+-- apiKeySecrets will retrieve the encrypted secrets of all the API keys that have not been revoked.
+SELECT key_id, secret
+FROM api_keys
+WHERE revoked_at IS NULL;
+
+-- name: apiKeySetSecret :execrows
+-- apiKeySetSecret will replace the encrypted secret of an API key if it has not changed since it was retrieved.
+UPDATE api_keys
+SET secret=@new_secret::VARCHAR(256)
+WHERE key_id=@key_id::VARCHAR(32) AND secret=@secret::VARCHAR(256);
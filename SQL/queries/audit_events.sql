@@ -0,0 +1,24 @@
+-- name: auditEventCreate :execrows
+-- auditEventCreate will record a security or account event in the append-only audit log.
+INSERT INTO audit_events (event_type, outcome, actor_id, subject_id, ip_address, user_agent, payload)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: auditEventsGetPaginated :many
+-- auditEventsGetPaginated will retrieve a page of audit events, newest first, starting from an event id. The events can
+-- be restricted to those about a specific subject, of a specific type, or with a specific outcome.
+SELECT *
+FROM audit_events
+WHERE event_id <= @start_id::bigint
+      AND (sqlc.narg('subject_id')::uuid IS NULL OR subject_id = sqlc.narg('subject_id')::uuid)
+      AND (@event_type::text = '' OR event_type::text = @event_type::text)
+      AND (@outcome::text = '' OR outcome::text = @outcome::text)
+ORDER BY event_id DESC
+LIMIT $1;
+
+-- name: auditEventsRecent :many
+-- auditEventsRecent will retrieve the most recent events about a client, newest first, excluding administrative actions.
+SELECT event_type, outcome, ip_address, user_agent, created_at
+FROM audit_events
+WHERE subject_id = @subject_id::uuid AND event_type <> 'ADMIN_ACTION'
+ORDER BY event_id DESC
+LIMIT $1;
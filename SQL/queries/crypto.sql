@@ -1,16 +1,60 @@
 -- name: cryptoCreateAccount :execrows
--- cryptoCreateAccount inserts a fiat account record.
+-- cryptoCreateAccount inserts a fiat account record. Accounts can only be opened for enabled Cryptocurrencies. A
+-- closed account will be reopened.
 INSERT INTO crypto_accounts (client_id, ticker)
-VALUES ($1, $2);
+SELECT $1, ticker
+FROM crypto_assets
+WHERE ticker=$2 AND status='ENABLED'
+ON CONFLICT (client_id, ticker) DO UPDATE
+SET status='ACTIVE'
+WHERE crypto_accounts.status='CLOSED';
+
+-- name: cryptoCloseAccount :execrows
+-- cryptoCloseAccount will mark a Crypto account with a zero balance as closed.
+UPDATE crypto_accounts
+SET status='CLOSED'
+WHERE client_id=$1 AND ticker=$2 AND balance=0 AND status<>'CLOSED';
+
+-- name: cryptoRowLockAccount :one
+-- cryptoRowLockAccount will acquire a row level lock without locks on the foreign keys. The account status and whether
+-- the client has been suspended are returned alongside the balance.
+SELECT ca.balance, ca.status, u.is_frozen
+FROM crypto_accounts AS ca
+    INNER JOIN users AS u ON ca.client_id = u.client_id
+WHERE ca.client_id=$1 AND ca.ticker=$2
+LIMIT 1
+FOR NO KEY UPDATE OF ca;
+
+-- name: cryptoHoldCreate :execrows
+-- cryptoHoldCreate will place a hold on funds in a Crypto account for a sale offer.
+INSERT INTO crypto_holds (offer_id, client_id, ticker, amount, expires_at)
+VALUES ($1, $2, $3, @amount::numeric(38, 18), $4);
+
+-- name: cryptoHoldPurgeExpired :execrows
+-- cryptoHoldPurgeExpired will remove the expired holds on a Crypto account.
+DELETE FROM crypto_holds
+WHERE client_id=$1 AND ticker=$2 AND expires_at<=now();
+
+-- name: cryptoHoldRelease :execrows
+-- cryptoHoldRelease will release a hold on a Crypto account for a sale offer.
+DELETE FROM crypto_holds
+WHERE offer_id=$1 AND client_id=$2;
+
+-- name: cryptoHoldTotal :one
+-- cryptoHoldTotal will retrieve the total of the funds held in a Crypto account for sale offers that have not expired.
+SELECT COALESCE(SUM(amount), 0)::numeric(38, 18) AS held
+FROM crypto_holds
+WHERE client_id=$1 AND ticker=$2 AND expires_at>now();
 
 -- name: cryptoPurchase :exec
 -- cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency.
-CALL purchase_cryptocurrency($1,$2,$3, @fiat_debit_amount::numeric(18, 2), $4, @crypto_credit_amount::numeric(24, 8));
+CALL purchase_cryptocurrency($1,$2,$3, @fiat_debit_amount::numeric(18, 2), $4, @crypto_credit_amount::numeric(38, 18));
 
 -- name: cryptoGetAccount :one
--- cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker.
+-- cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker with the ledger and
+-- available balances.
 SELECT *
-FROM crypto_accounts
+FROM crypto_account_balances
 WHERE client_id=$1 AND ticker=$2;
 
 -- name: cryptoGetJournalTransaction :many
@@ -21,13 +65,14 @@ WHERE client_id = $1 AND tx_id = $2;
 
 -- name: cryptoSell :exec
 -- cryptoSell will execute a transaction to sell a Cryptocurrency and purchase a Fiat currency.
-CALL sell_cryptocurrency($1,$2,$3, @fiat_credit_amount::numeric(18, 2), $4, @crypto_debit_amount::numeric(24, 8));
+CALL sell_cryptocurrency($1,$2,$3, @fiat_credit_amount::numeric(18, 2), $4, @crypto_debit_amount::numeric(38, 18));
 
 -- name: cryptoGetAllAccounts :many
--- cryptoGetAllAccounts will retrieve all accounts associated with a specific user.
+-- cryptoGetAllAccounts will retrieve all open accounts associated with a specific user with the ledger and available
+-- balances.
 SELECT *
-FROM crypto_accounts
-WHERE client_id=$1 AND ticker >= $2
+FROM crypto_account_balances
+WHERE client_id=$1 AND ticker >= $2 AND status<>'CLOSED'
 ORDER BY ticker
 LIMIT $3;
 
@@ -44,3 +89,9 @@ WHERE client_id = $1
 ORDER BY transacted_at DESC
 OFFSET $3
 LIMIT $4;
+
+-- name: cryptoSetAccountStatus :execrows
+-- cryptoSetAccountStatus will set the status of a Crypto account that has not been closed.
+UPDATE crypto_accounts
+SET status=@status::account_status
+WHERE client_id=$1 AND ticker=$2 AND status<>'CLOSED';
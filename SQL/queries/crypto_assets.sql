@@ -0,0 +1,30 @@
+-- name: cryptoAssetGet :one
+-- cryptoAssetGet will retrieve the registry entry for a specific Cryptocurrency ticker.
+SELECT *
+FROM crypto_assets
+WHERE ticker=$1;
+
+-- name: cryptoAssetGetAll :many
+-- cryptoAssetGetAll will retrieve all the registered Cryptocurrencies.
+SELECT *
+FROM crypto_assets
+ORDER BY ticker;
+
+-- name: cryptoAssetUpsert :execrows
+-- cryptoAssetUpsert will register a new Cryptocurrency or update the details of an existing one.
+INSERT INTO crypto_assets (ticker, name, decimal_places, status, min_order, max_order)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (ticker) DO UPDATE
+SET name = EXCLUDED.name,
+    decimal_places = EXCLUDED.decimal_places,
+    status = EXCLUDED.status,
+    min_order = EXCLUDED.min_order,
+    max_order = EXCLUDED.max_order,
+    updated_at = now();
+
+-- name: cryptoAssetSetStatus :execrows
+-- cryptoAssetSetStatus will enable or halt trading for a specific Cryptocurrency.
+UPDATE crypto_assets
+SET status = $2,
+    updated_at = now()
+WHERE ticker=$1;
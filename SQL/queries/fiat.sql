@@ -1,15 +1,55 @@
 -- name: fiatCreateAccount :execrows
--- fiatCreateAccount inserts a fiat account record.
+-- fiatCreateAccount inserts a fiat account record for an active currency. A closed account will be reopened.
 INSERT INTO fiat_accounts (client_id, currency)
-VALUES ($1, $2);
+SELECT @client_id::uuid, code
+FROM fiat_currencies
+WHERE code=@currency::currency AND status='ACTIVE'
+ON CONFLICT (client_id, currency) DO UPDATE
+SET status='ACTIVE'
+WHERE fiat_accounts.status='CLOSED';
+
+-- name: fiatCloseAccount :execrows
+-- fiatCloseAccount will mark a Fiat account with a zero balance as closed.
+UPDATE fiat_accounts
+SET status='CLOSED'
+WHERE client_id=$1 AND currency=$2 AND balance=0 AND status<>'CLOSED';
 
 -- name: fiatRowLockAccount :one
--- fiatRowLockAccount will acquire a row level lock without locks on the foreign keys.
-SELECT balance
-FROM fiat_accounts
-WHERE client_id=$1 AND currency=$2
+-- fiatRowLockAccount will acquire a row level lock without locks on the foreign keys. The account status and whether
+-- the client has been suspended are returned alongside the balance.
+SELECT fa.balance, fa.status, u.is_frozen
+FROM fiat_accounts AS fa
+    INNER JOIN users AS u ON fa.client_id = u.client_id
+WHERE fa.client_id=$1 AND fa.currency=$2
 LIMIT 1
-FOR NO KEY UPDATE;
+FOR NO KEY UPDATE OF fa;
+
+-- name: fiatHoldCreate :execrows
+-- fiatHoldCreate will place a hold on funds in a Fiat account for an exchange offer.
+INSERT INTO fiat_holds (offer_id, client_id, currency, amount, expires_at)
+VALUES ($1, $2, $3, @amount::numeric(18, 2), $4);
+
+-- name: fiatHoldPurgeExpired :execrows
+-- fiatHoldPurgeExpired will remove the expired holds on a Fiat account.
+DELETE FROM fiat_holds
+WHERE client_id=$1 AND currency=$2 AND expires_at<=now();
+
+-- name: fiatHoldRelease :execrows
+-- fiatHoldRelease will release a hold on a Fiat account for an exchange offer.
+DELETE FROM fiat_holds
+WHERE offer_id=$1 AND client_id=$2;
+
+-- name: fiatHoldTotal :one
+-- fiatHoldTotal will retrieve the total of the funds held in a Fiat account for exchange offers that have not expired.
+SELECT COALESCE(SUM(amount), 0)::numeric(18, 2) AS held
+FROM fiat_holds
+WHERE client_id=$1 AND currency=$2 AND expires_at>now();
+
+-- name: fiatSetAccountStatus :execrows
+-- fiatSetAccountStatus will set the status of a Fiat account that has not been closed.
+UPDATE fiat_accounts
+SET status=@status::account_status
+WHERE client_id=$1 AND currency=$2 AND status<>'CLOSED';
 
 -- name: fiatUpdateAccountBalance :one
 -- fiatUpdateAccountBalance will add an amount to a fiat accounts balance.
@@ -56,7 +96,8 @@ SELECT
 RETURNING tx_id, transacted_at;
 
 -- name: fiatInternalTransferJournalEntry :one
--- fiatInternalTransferJournalEntry will create both journal entries for fiat account internal transfers.
+-- fiatInternalTransferJournalEntry will create the journal entries for fiat account internal transfers. The transfer is
+-- routed through the FTeX Fiat operations account so that the entries balance in each currency.
 WITH deposit AS (
     INSERT INTO fiat_journal(
         client_id,
@@ -71,6 +112,27 @@ WITH deposit AS (
         now(),
         gen_random_uuid()
     RETURNING tx_id, transacted_at
+), operations AS (
+    INSERT INTO fiat_journal(
+        client_id,
+        currency,
+        amount,
+        transacted_at,
+        tx_id)
+    SELECT
+        (   SELECT client_id
+            FROM users
+            WHERE username = 'fiat-currencies'),
+        legs.currency,
+        SUM(legs.amount),
+        deposit.transacted_at,
+        deposit.tx_id
+    FROM deposit, (
+        VALUES
+            (@source_currency::currency, round_half_even(@debit_amount::numeric(18, 2), 2)),
+            (@destination_currency::currency, round_half_even(-1 * @credit_amount::numeric(18, 2), 2))
+        ) AS legs (currency, amount)
+    GROUP BY legs.currency, deposit.transacted_at, deposit.tx_id
 )
 INSERT INTO fiat_journal (
     client_id,
@@ -115,15 +177,16 @@ OFFSET $3
 LIMIT $4;
 
 -- name: fiatGetAccount :one
--- fiatGetAccount will retrieve a specific user's account for a given currency.
+-- fiatGetAccount will retrieve a specific user's account for a given currency with the ledger and available balances.
 SELECT *
-FROM fiat_accounts
+FROM fiat_account_balances
 WHERE client_id=$1 AND currency=$2;
 
 -- name: fiatGetAllAccounts :many
--- fiatGetAllAccounts will retrieve all accounts associated with a specific user.
+-- fiatGetAllAccounts will retrieve all open accounts associated with a specific user with the ledger and available
+-- balances.
 SELECT *
-FROM fiat_accounts
-WHERE client_id=$1 AND currency >= $2
+FROM fiat_account_balances
+WHERE client_id=$1 AND currency >= $2 AND status<>'CLOSED'
 ORDER BY currency
 LIMIT $3;
@@ -0,0 +1,13 @@
+-- name: fiatCurrencyGetAll :many
+-- fiatCurrencyGetAll will retrieve all the Fiat currencies in the reference table.
+SELECT *
+FROM fiat_currencies
+ORDER BY code;
+
+-- name: fiatCurrencyUpsert :execrows
+-- fiatCurrencyUpsert will register a new Fiat currency or update the status of an existing one.
+INSERT INTO fiat_currencies (code, status)
+VALUES ($1, $2)
+ON CONFLICT (code) DO UPDATE
+SET status = EXCLUDED.status,
+    updated_at = now();
@@ -0,0 +1,51 @@
+-- name: journalChainHeads :many
+-- journalChainHeads will retrieve the last entry and the archive anchor of the Fiat and Crypto journal chains.
+SELECT *
+FROM journal_chain_heads
+ORDER BY journal;
+
+-- name: fiatJournalChain :many
+-- fiatJournalChain will retrieve a page of the Fiat journal chain after a sequence number, up to and including a bound,
+-- alongside the hash recomputed from each entry's fields.
+SELECT
+    seq,
+    tx_id,
+    prev_hash,
+    entry_hash,
+    journal_entry_hash(seq, tx_id, client_id, currency::text, amount, transacted_at, prev_hash)::bytea AS computed_hash
+FROM fiat_journal
+WHERE seq > @after_seq::bigint
+      AND seq <= @until_seq::bigint
+ORDER BY seq
+LIMIT @limit_count::int;
+
+-- name: cryptoJournalChain :many
+-- cryptoJournalChain will retrieve a page of the Crypto journal chain after a sequence number, up to and including a
+-- bound, alongside the hash recomputed from each entry's fields.
+SELECT
+    seq,
+    tx_id,
+    prev_hash,
+    entry_hash,
+    journal_entry_hash(seq, tx_id, client_id, ticker, amount, transacted_at, prev_hash)::bytea AS computed_hash
+FROM crypto_journal
+WHERE seq > @after_seq::bigint
+      AND seq <= @until_seq::bigint
+ORDER BY seq
+LIMIT @limit_count::int;
+
+-- name: journalCheckpointCreate :execrows
+-- journalCheckpointCreate will record a signed checkpoint of a journal chain head. A head that has already been
+-- checkpointed will not be recorded again.
+INSERT INTO journal_checkpoints (journal, seq, entry_hash, key_id, public_key, signature, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (journal, seq) DO NOTHING;
+
+-- name: journalCheckpoints :many
+-- journalCheckpoints will retrieve the signed checkpoints of a journal, or all journals if none is specified, recorded
+-- at or after a point in time.
+SELECT *
+FROM journal_checkpoints
+WHERE (@journal::text = '' OR journal = @journal::text)
+      AND created_at >= @since::timestamptz
+ORDER BY journal, seq;
@@ -0,0 +1,20 @@
+-- name: loginLockoutCreate :execrows
+-- loginLockoutCreate will record a temporary lockout of a username or IP address after repeated failed login attempts.
+INSERT INTO login_lockouts (scope, subject, failures, ip_address, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: loginLockoutsRecent :many
+-- loginLockoutsRecent will retrieve the most recent lockouts, newest first. The lockouts can be restricted to those
+-- that are still in effect.
+SELECT *
+FROM login_lockouts
+WHERE NOT @active_only::boolean OR (unlocked_at IS NULL AND expires_at > now())
+ORDER BY lockout_id DESC
+LIMIT $1;
+
+-- name: loginLockoutUnlock :execrows
+-- loginLockoutUnlock will record that an administrator has lifted the lockouts of a username or IP address that are
+-- still in effect.
+UPDATE login_lockouts
+SET unlocked_by=@unlocked_by::UUID, unlocked_at=now()
+WHERE scope=@scope AND subject=@subject AND unlocked_at IS NULL AND expires_at > now();
@@ -0,0 +1,57 @@
+-- name: mfaEnroll :execrows
+-- mfaEnroll will record a pending two-factor authentication enrolment with an encrypted TOTP secret. A pending
+-- enrolment is replaced, but a confirmed enrolment is not.
+INSERT INTO user_mfa (client_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (client_id) DO UPDATE
+SET secret=EXCLUDED.secret, last_step=0, recovery_codes='{}', created_at=now()
+WHERE user_mfa.enrolled_at IS NULL;
+
+-- name: mfaGet :one
+-- mfaGet will retrieve the two-factor authentication enrolment of a client.
+SELECT *
+FROM user_mfa
+WHERE client_id=$1
+LIMIT 1;
+
+-- name: mfaConfirm :execrows
+-- mfaConfirm will complete a pending two-factor authentication enrolment with the time step of the first one-time
+-- password and the hashed recovery codes.
+UPDATE user_mfa
+SET enrolled_at=now(), last_step=@last_step::BIGINT, recovery_codes=@recovery_codes::VARCHAR(64)[]
+WHERE client_id=@client_id::UUID AND enrolled_at IS NULL AND last_step < @last_step::BIGINT;
+
+-- name: mfaUseStep :execrows
+-- mfaUseStep will record the time step of a one-time password that has been used. Time steps at or before the last
+-- step that was used are rejected to prevent one-time passwords from being replayed.
+UPDATE user_mfa
+SET last_step=@last_step::BIGINT
+WHERE client_id=@client_id::UUID AND enrolled_at IS NOT NULL AND last_step < @last_step::BIGINT;
+
+-- name: mfaUseRecoveryCode :execrows
+-- mfaUseRecoveryCode will remove a hashed recovery code once it has been used.
+UPDATE user_mfa
+SET recovery_codes=array_remove(recovery_codes, @code_hash::VARCHAR(64))
+WHERE client_id=@client_id::UUID AND enrolled_at IS NOT NULL AND @code_hash::VARCHAR(64)=ANY(recovery_codes);
+
+-- name: mfaSetRecoveryCodes :execrows
+-- mfaSetRecoveryCodes will replace the hashed recovery codes of a confirmed enrolment.
+UPDATE user_mfa
+SET recovery_codes=@recovery_codes::VARCHAR(64)[]
+WHERE client_id=@client_id::UUID AND enrolled_at IS NOT NULL;
+
+-- name: mfaDelete :execrows
+-- mfaDelete will remove the two-factor authentication enrolment of a client.
+DELETE FROM user_mfa
+WHERE client_id=$1;
+
+-- name: mfaSecrets :many
+-- mfaSecrets will retrieve the encrypted TOTP secrets of all two-factor authentication enrolments.
+SELECT client_id, secret
+FROM user_mfa;
+
+-- name: mfaSetSecret :execrows
+-- mfaSetSecret will replace the encrypted TOTP secret of an enrolment if it has not changed since it was retrieved.
+UPDATE user_mfa
+SET secret=@new_secret::VARCHAR(256)
+WHERE client_id=@client_id::UUID AND secret=@secret::VARCHAR(256);
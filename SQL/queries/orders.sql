@@ -0,0 +1,70 @@
+-- name: limitOrderCreate :execrows
+-- limitOrderCreate will place a Cryptocurrency limit order.
+INSERT INTO crypto_limit_orders (order_id, client_id, fiat_currency, ticker, is_purchase, amount, limit_price, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: limitOrderEventCreate :execrows
+-- limitOrderEventCreate will record a change in the state of a limit order in its history.
+INSERT INTO crypto_limit_order_events (order_id, status, price, details)
+VALUES ($1, $2, $3, $4);
+
+-- name: limitOrderGet :one
+-- limitOrderGet will retrieve a specific limit order belonging to a client.
+SELECT *
+FROM crypto_limit_orders
+WHERE client_id=$1 AND order_id=$2;
+
+-- name: limitOrderGetEvents :many
+-- limitOrderGetEvents will retrieve the state history of a limit order in the order it occurred.
+SELECT *
+FROM crypto_limit_order_events
+WHERE order_id=$1
+ORDER BY id;
+
+-- name: limitOrderGetPaginated :many
+-- limitOrderGetPaginated will retrieve a page of a client's limit orders, newest first, starting from an order id. The
+-- orders can be restricted to those with a specific status.
+SELECT *
+FROM crypto_limit_orders
+WHERE client_id=$1
+      AND (@start_id::text = '' OR order_id <= @start_id::text)
+      AND (@status::text = '' OR status::text = @status::text)
+ORDER BY order_id DESC
+LIMIT $2;
+
+-- name: limitOrderGetOpen :many
+-- limitOrderGetOpen will retrieve a batch of open and unexpired limit orders, oldest first, after an order id.
+SELECT *
+FROM crypto_limit_orders
+WHERE status='OPEN'
+      AND expires_at > now()
+      AND order_id > @after_id::text
+ORDER BY order_id
+LIMIT $1;
+
+-- name: limitOrderExpire :execrows
+-- limitOrderExpire will set the status of open limit orders that have passed their expiry and record the change in
+-- their histories. The holds on expired orders lapse at the same time as the orders.
+WITH expired AS (
+    UPDATE crypto_limit_orders
+    SET status='EXPIRED', updated_at=now()
+    WHERE status='OPEN' AND expires_at <= now()
+    RETURNING order_id
+)
+INSERT INTO crypto_limit_order_events (order_id, status, details)
+SELECT order_id, 'EXPIRED', 'order expired before the limit price was reached'
+FROM expired;
+
+-- name: limitOrderRowLock :one
+-- limitOrderRowLock will acquire a row level lock on a limit order without locks on the foreign keys.
+SELECT *
+FROM crypto_limit_orders
+WHERE client_id=$1 AND order_id=$2
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: limitOrderUpdateStatus :execrows
+-- limitOrderUpdateStatus will close an open limit order with a final status and the id of any transaction it executed.
+UPDATE crypto_limit_orders
+SET status=$3, tx_id=$4, updated_at=now()
+WHERE client_id=$1 AND order_id=$2 AND status='OPEN';
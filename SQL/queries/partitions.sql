@@ -0,0 +1,28 @@
+-- name: journalPartitionCreate :one
+-- journalPartitionCreate will create the monthly partition of a journal for the month of a date, moving in any entries
+-- that were posted to the default partition. Returns false if the partition already exists.
+SELECT journal_partition_create(@journal::text, @month::date) AS created;
+
+-- name: journalPartitions :many
+-- journalPartitions will retrieve the monthly partitions of the Fiat and Crypto journals, oldest first.
+SELECT
+    child.relname::text AS partition,
+    parent.relname::text AS journal,
+    COALESCE(spc.spcname, '')::text AS tablespace,
+    (to_date(substring(child.relname FROM '_y([0-9]{4}m[0-9]{2})$'), 'YYYY"m"MM')::timestamp
+        AT TIME ZONE 'UTC')::timestamptz AS starts_at
+FROM pg_inherits
+JOIN pg_class AS parent ON parent.oid = pg_inherits.inhparent
+JOIN pg_class AS child ON child.oid = pg_inherits.inhrelid
+LEFT JOIN pg_tablespace AS spc ON spc.oid = child.reltablespace
+WHERE parent.relname IN ('fiat_journal', 'crypto_journal')
+      AND child.relname ~ '_y[0-9]{4}m[0-9]{2}$'
+ORDER BY starts_at, journal;
+
+-- name: journalPartitionArchive :exec
+-- journalPartitionArchive will move a monthly journal partition and its indexes to a tablespace.
+SELECT journal_partition_archive(@partition::text, @tablespace::text);
+
+-- name: journalPartitionDrop :exec
+-- journalPartitionDrop will detach and drop a monthly journal partition.
+SELECT journal_partition_drop(@partition::text);
@@ -0,0 +1,69 @@
+-- name: recurringPurchaseCreate :execrows
+-- recurringPurchaseCreate will schedule a recurring Cryptocurrency purchase plan with its first run at the start time.
+INSERT INTO crypto_recurring_purchases (plan_id, client_id, fiat_currency, ticker, amount, cadence, starts_at, ends_at, next_run_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $7);
+
+-- name: recurringPurchaseGet :one
+-- recurringPurchaseGet will retrieve a specific recurring purchase plan belonging to a client.
+SELECT *
+FROM crypto_recurring_purchases
+WHERE client_id=$1 AND plan_id=$2;
+
+-- name: recurringPurchaseGetPaginated :many
+-- recurringPurchaseGetPaginated will retrieve a page of a client's recurring purchase plans, newest first, starting
+-- from a plan id. The plans can be restricted to those with a specific status.
+SELECT *
+FROM crypto_recurring_purchases
+WHERE client_id=$1
+      AND (@start_id::text = '' OR plan_id <= @start_id::text)
+      AND (@status::text = '' OR status::text = @status::text)
+ORDER BY plan_id DESC
+LIMIT $2;
+
+-- name: recurringPurchaseGetDue :many
+-- recurringPurchaseGetDue will retrieve a batch of active recurring purchase plans that are due to run, after a plan id.
+SELECT *
+FROM crypto_recurring_purchases
+WHERE status='ACTIVE'
+      AND next_run_at <= now()
+      AND plan_id > @after_id::text
+ORDER BY plan_id
+LIMIT $1;
+
+-- name: recurringPurchaseRowLock :one
+-- recurringPurchaseRowLock will acquire a row level lock on a recurring purchase plan without locks on the foreign keys.
+SELECT *
+FROM crypto_recurring_purchases
+WHERE client_id=$1 AND plan_id=$2
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: recurringPurchaseUpdateStatus :execrows
+-- recurringPurchaseUpdateStatus will close an active recurring purchase plan with a final status.
+UPDATE crypto_recurring_purchases
+SET status=$3, updated_at=now()
+WHERE client_id=$1 AND plan_id=$2 AND status='ACTIVE';
+
+-- name: recurringPurchaseAdvance :execrows
+-- recurringPurchaseAdvance will move an active recurring purchase plan on to its next scheduled run.
+UPDATE crypto_recurring_purchases
+SET next_run_at=$3, updated_at=now()
+WHERE client_id=$1 AND plan_id=$2 AND status='ACTIVE';
+
+-- name: recurringPurchaseRunCreate :execrows
+-- recurringPurchaseRunCreate will record the outcome of a scheduled run of a recurring purchase plan.
+INSERT INTO crypto_recurring_purchase_runs (plan_id, scheduled_at, status, price, fiat_amount, crypto_amount, tx_id, details)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: recurringPurchaseRunGetPaginated :many
+-- recurringPurchaseRunGetPaginated will retrieve a page of the runs of a client's recurring purchase plan, newest first,
+-- starting from a run id.
+SELECT *
+FROM crypto_recurring_purchase_runs
+WHERE plan_id=(
+        SELECT plan_id
+        FROM crypto_recurring_purchases
+        WHERE client_id=$1 AND crypto_recurring_purchases.plan_id=$2)
+      AND (@start_id::bigint = 0 OR id <= @start_id::bigint)
+ORDER BY id DESC
+LIMIT $3;
@@ -0,0 +1,41 @@
+-- name: refreshTokenCreate :exec
+-- refreshTokenCreate will record a hashed refresh token in a client session. A new session is started if no session
+-- start time is provided.
+INSERT INTO refresh_tokens (token_hash, session_id, client_id, device, ip_address, session_start, expires_at)
+VALUES (@token_hash::VARCHAR(64), @session_id::VARCHAR(32), @client_id::UUID, @device::VARCHAR(256),
+        @ip_address::VARCHAR(64), COALESCE(sqlc.narg('session_start')::TIMESTAMPTZ, now()),
+        @expires_at::TIMESTAMPTZ);
+
+-- name: refreshTokenRowLock :one
+-- refreshTokenRowLock will acquire a row lock on a refresh token and retrieve it.
+SELECT *
+FROM refresh_tokens
+WHERE token_hash=$1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: refreshTokenRotate :execrows
+-- refreshTokenRotate will mark an active refresh token as used once it has been exchanged for a new one.
+UPDATE refresh_tokens
+SET rotated_at=now()
+WHERE token_hash=$1 AND rotated_at IS NULL AND revoked_at IS NULL;
+
+-- name: refreshSessionGetClient :many
+-- refreshSessionGetClient will retrieve the details of all the active sessions of a client, most recently refreshed
+-- first. Each active session has exactly one refresh token that has been neither rotated nor revoked.
+SELECT session_id, device, ip_address, session_start, created_at, expires_at
+FROM refresh_tokens
+WHERE client_id=$1 AND rotated_at IS NULL AND revoked_at IS NULL AND expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: refreshSessionRevoke :execrows
+-- refreshSessionRevoke will revoke all the refresh tokens in a client's session.
+UPDATE refresh_tokens
+SET revoked_at=now()
+WHERE session_id=$1 AND client_id=$2 AND revoked_at IS NULL;
+
+-- name: refreshSessionRevokeAll :exec
+-- refreshSessionRevokeAll will revoke all the refresh tokens in all of a client's sessions.
+UPDATE refresh_tokens
+SET revoked_at=now()
+WHERE client_id=$1 AND revoked_at IS NULL;
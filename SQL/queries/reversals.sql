@@ -0,0 +1,66 @@
+-- name: journalReversalCreate :execrows
+-- journalReversalCreate will record the reversal of a transaction. A transaction that has already been reversed will
+-- not be recorded again.
+INSERT INTO journal_reversals (tx_id, reversal_tx_id, admin_id, reason)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (tx_id) DO NOTHING;
+
+-- name: journalReversalGet :one
+-- journalReversalGet will retrieve the reversal of a transaction, or the reversal that a transaction records.
+SELECT *
+FROM journal_reversals
+WHERE tx_id = @tx_id::uuid OR reversal_tx_id = @tx_id::uuid
+LIMIT 1;
+
+-- name: fiatJournalReversalEntries :many
+-- fiatJournalReversalEntries will retrieve the Fiat journal entries of a transaction and whether each is for a client
+-- account with a balance. The entries for the FTeX operations accounts do not have balances.
+SELECT
+    fj.client_id,
+    fj.currency,
+    fj.amount,
+    (fa.client_id IS NOT NULL)::boolean AS has_balance
+FROM fiat_journal AS fj
+    LEFT JOIN fiat_accounts AS fa ON fj.client_id = fa.client_id AND fj.currency = fa.currency
+WHERE fj.tx_id = $1
+ORDER BY fj.seq;
+
+-- name: fiatJournalReverse :many
+-- fiatJournalReverse will post equal and opposite Fiat journal entries for all the entries of a transaction.
+INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+SELECT client_id, currency, -amount, now(), @reversal_tx_id::uuid
+FROM fiat_journal
+WHERE fiat_journal.tx_id = @tx_id::uuid
+ORDER BY seq
+RETURNING *;
+
+-- name: cryptoJournalReversalEntries :many
+-- cryptoJournalReversalEntries will retrieve the Crypto journal entries of a transaction and whether each is for a
+-- client account with a balance. The entries for the FTeX operations accounts do not have balances.
+SELECT
+    cj.client_id,
+    cj.ticker,
+    cj.amount,
+    (ca.client_id IS NOT NULL)::boolean AS has_balance
+FROM crypto_journal AS cj
+    LEFT JOIN crypto_accounts AS ca ON cj.client_id = ca.client_id AND cj.ticker = ca.ticker
+WHERE cj.tx_id = $1
+ORDER BY cj.seq;
+
+-- name: cryptoJournalReverse :many
+-- cryptoJournalReverse will post equal and opposite Crypto journal entries for all the entries of a transaction.
+INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+SELECT client_id, ticker, -amount, now(), @reversal_tx_id::uuid
+FROM crypto_journal
+WHERE crypto_journal.tx_id = @tx_id::uuid
+ORDER BY seq
+RETURNING *;
+
+-- name: cryptoUpdateAccountBalance :one
+-- cryptoUpdateAccountBalance will add an amount to a Crypto account's balance.
+UPDATE crypto_accounts
+SET balance=balance + @amount::numeric(38, 18),
+    last_tx=@amount::numeric(38, 18),
+    last_tx_ts=$3
+WHERE client_id=$1 AND ticker=$2
+RETURNING balance, last_tx, last_tx_ts;
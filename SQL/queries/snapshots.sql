@@ -0,0 +1,123 @@
+-- name: fiatBalanceSnapshotCreate :execrows
+-- fiatBalanceSnapshotCreate will record the closing balances of all Fiat accounts opened before the snapshot time. Each
+-- closing balance is the previous snapshot's balance carried forward with the journal entries posted since it.
+INSERT INTO fiat_balance_snapshots (client_id, currency, snapshot_at, balance)
+SELECT
+    acc.client_id,
+    acc.currency,
+    @snapshot_at::timestamptz,
+    COALESCE(prev.balance, 0) + COALESCE((
+        SELECT SUM(amount)
+        FROM fiat_journal
+        WHERE fiat_journal.client_id=acc.client_id
+              AND fiat_journal.currency=acc.currency
+              AND fiat_journal.transacted_at >= COALESCE(prev.snapshot_at, '-infinity'::timestamptz)
+              AND fiat_journal.transacted_at < @snapshot_at::timestamptz), 0)
+FROM fiat_accounts AS acc
+LEFT JOIN LATERAL (
+    SELECT snap.snapshot_at, snap.balance
+    FROM fiat_balance_snapshots AS snap
+    WHERE snap.client_id=acc.client_id
+          AND snap.currency=acc.currency
+          AND snap.snapshot_at < @snapshot_at::timestamptz
+    ORDER BY snap.snapshot_at DESC
+    LIMIT 1) AS prev ON TRUE
+WHERE acc.created_at < @snapshot_at::timestamptz
+ON CONFLICT DO NOTHING;
+
+-- name: cryptoBalanceSnapshotCreate :execrows
+-- cryptoBalanceSnapshotCreate will record the closing balances of all Crypto accounts opened before the snapshot time.
+-- Each closing balance is the previous snapshot's balance carried forward with the journal entries posted since it.
+INSERT INTO crypto_balance_snapshots (client_id, ticker, snapshot_at, balance)
+SELECT
+    acc.client_id,
+    acc.ticker,
+    @snapshot_at::timestamptz,
+    COALESCE(prev.balance, 0) + COALESCE((
+        SELECT SUM(amount)
+        FROM crypto_journal
+        WHERE crypto_journal.client_id=acc.client_id
+              AND crypto_journal.ticker=acc.ticker
+              AND crypto_journal.transacted_at >= COALESCE(prev.snapshot_at, '-infinity'::timestamptz)
+              AND crypto_journal.transacted_at < @snapshot_at::timestamptz), 0)
+FROM crypto_accounts AS acc
+LEFT JOIN LATERAL (
+    SELECT snap.snapshot_at, snap.balance
+    FROM crypto_balance_snapshots AS snap
+    WHERE snap.client_id=acc.client_id
+          AND snap.ticker=acc.ticker
+          AND snap.snapshot_at < @snapshot_at::timestamptz
+    ORDER BY snap.snapshot_at DESC
+    LIMIT 1) AS prev ON TRUE
+WHERE acc.created_at < @snapshot_at::timestamptz
+ON CONFLICT DO NOTHING;
+
+-- name: balanceSnapshotLatest :one
+-- balanceSnapshotLatest will retrieve the time of the most recent Fiat or Crypto balance snapshot.
+SELECT GREATEST(
+    (SELECT MAX(snapshot_at) FROM fiat_balance_snapshots),
+    (SELECT MAX(snapshot_at) FROM crypto_balance_snapshots))::timestamptz AS snapshot_at;
+
+-- name: fiatBalanceAsOf :one
+-- fiatBalanceAsOf will compute the balance of a Fiat account at a point in time from the nearest snapshot at or before
+-- it and the journal entries posted from the snapshot up to the point in time.
+WITH snapshot AS (
+    SELECT snapshot_at, balance
+    FROM fiat_balance_snapshots
+    WHERE client_id=$1 AND currency=$2 AND snapshot_at <= @as_of::timestamptz
+    ORDER BY snapshot_at DESC
+    LIMIT 1)
+SELECT
+    acc.currency,
+    (SELECT snapshot_at FROM snapshot)::timestamptz AS snapshot_at,
+    (COALESCE((SELECT balance FROM snapshot), 0) + COALESCE((
+        SELECT SUM(amount)
+        FROM fiat_journal
+        WHERE fiat_journal.client_id=$1
+              AND fiat_journal.currency=$2
+              AND fiat_journal.transacted_at >= COALESCE((SELECT snapshot_at FROM snapshot), '-infinity'::timestamptz)
+              AND fiat_journal.transacted_at <= @as_of::timestamptz), 0))::numeric(18, 2) AS balance
+FROM fiat_accounts AS acc
+WHERE acc.client_id=$1 AND acc.currency=$2;
+
+-- name: cryptoBalanceAsOf :one
+-- cryptoBalanceAsOf will compute the balance of a Crypto account at a point in time from the nearest snapshot at or
+-- before it and the journal entries posted from the snapshot up to the point in time.
+WITH snapshot AS (
+    SELECT snapshot_at, balance
+    FROM crypto_balance_snapshots
+    WHERE client_id=$1 AND ticker=$2 AND snapshot_at <= @as_of::timestamptz
+    ORDER BY snapshot_at DESC
+    LIMIT 1)
+SELECT
+    acc.ticker,
+    (SELECT snapshot_at FROM snapshot)::timestamptz AS snapshot_at,
+    (COALESCE((SELECT balance FROM snapshot), 0) + COALESCE((
+        SELECT SUM(amount)
+        FROM crypto_journal
+        WHERE crypto_journal.client_id=$1
+              AND crypto_journal.ticker=$2
+              AND crypto_journal.transacted_at >= COALESCE((SELECT snapshot_at FROM snapshot), '-infinity'::timestamptz)
+              AND crypto_journal.transacted_at <= @as_of::timestamptz), 0))::numeric(38, 18) AS balance
+FROM crypto_accounts AS acc
+WHERE acc.client_id=$1 AND acc.ticker=$2;
+
+-- name: fiatBalanceHistory :many
+-- fiatBalanceHistory will retrieve the daily closing balances of a Fiat account within a time range, oldest first.
+SELECT *
+FROM fiat_balance_snapshots
+WHERE client_id=$1
+      AND currency=$2
+      AND snapshot_at >= @from_at::timestamptz
+      AND snapshot_at <= @to_at::timestamptz
+ORDER BY snapshot_at;
+
+-- name: cryptoBalanceHistory :many
+-- cryptoBalanceHistory will retrieve the daily closing balances of a Crypto account within a time range, oldest first.
+SELECT *
+FROM crypto_balance_snapshots
+WHERE client_id=$1
+      AND ticker=$2
+      AND snapshot_at >= @from_at::timestamptz
+      AND snapshot_at <= @to_at::timestamptz
+ORDER BY snapshot_at;
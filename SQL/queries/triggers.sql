@@ -0,0 +1,69 @@
+-- name: triggerOrderCreate :execrows
+-- triggerOrderCreate will place a Cryptocurrency stop-loss or take-profit trigger order.
+INSERT INTO crypto_trigger_orders (order_id, client_id, fiat_currency, ticker, trigger_type, trigger_price, trailing_distance, amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: triggerOrderEventCreate :execrows
+-- triggerOrderEventCreate will record a change in the state of a trigger order in its history.
+INSERT INTO crypto_trigger_order_events (order_id, status, price, amount, details)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: triggerOrderGet :one
+-- triggerOrderGet will retrieve a specific trigger order belonging to a client.
+SELECT *
+FROM crypto_trigger_orders
+WHERE client_id=$1 AND order_id=$2;
+
+-- name: triggerOrderGetEvents :many
+-- triggerOrderGetEvents will retrieve the state history of a trigger order in the order it occurred.
+SELECT *
+FROM crypto_trigger_order_events
+WHERE order_id=$1
+ORDER BY id;
+
+-- name: triggerOrderGetPaginated :many
+-- triggerOrderGetPaginated will retrieve a page of a client's trigger orders, newest first, starting from an order id.
+-- The orders can be restricted to those with a specific status.
+SELECT *
+FROM crypto_trigger_orders
+WHERE client_id=$1
+      AND (@start_id::text = '' OR order_id <= @start_id::text)
+      AND (@status::text = '' OR status::text = @status::text)
+ORDER BY order_id DESC
+LIMIT $2;
+
+-- name: triggerOrderGetActive :many
+-- triggerOrderGetActive will retrieve a batch of active trigger orders, oldest first, after an order id.
+SELECT *
+FROM crypto_trigger_orders
+WHERE status='ACTIVE'
+      AND order_id > @after_id::text
+ORDER BY order_id
+LIMIT $1;
+
+-- name: triggerOrderRowLock :one
+-- triggerOrderRowLock will acquire a row level lock on a trigger order without locks on the foreign keys.
+SELECT *
+FROM crypto_trigger_orders
+WHERE client_id=$1 AND order_id=$2
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: triggerOrderUpdateStatus :execrows
+-- triggerOrderUpdateStatus will close an active trigger order with a final status and the id of any transaction it
+-- executed.
+UPDATE crypto_trigger_orders
+SET status=$3, tx_id=$4, updated_at=now()
+WHERE client_id=$1 AND order_id=$2 AND status='ACTIVE';
+
+-- name: triggerOrderTrail :execrows
+-- triggerOrderTrail will raise the trigger price of an active trailing stop-loss order. The trigger price never moves
+-- down.
+UPDATE crypto_trigger_orders
+SET trigger_price=$3, updated_at=now()
+WHERE client_id=$1
+      AND order_id=$2
+      AND status='ACTIVE'
+      AND trigger_type='STOP_LOSS'
+      AND trailing_distance > 0
+      AND trigger_price < $3;
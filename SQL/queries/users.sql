@@ -6,7 +6,7 @@ RETURNING client_id;
 
 -- name: userGetInfo :one
 -- userGetInfo will retrieve a single users account information.
-SELECT username, client_id, password, first_name, last_name, email, is_deleted
+SELECT username, client_id, password, first_name, last_name, email, role, is_deleted, is_frozen, email_verified
 FROM users
 WHERE client_id=$1
 LIMIT 1;
@@ -37,3 +37,51 @@ SELECT is_deleted
 FROM users
 WHERE client_id=$1
 LIMIT 1;
+
+-- name: userGetStatus :one
+-- userGetStatus will return the role, soft delete, and freeze status of a user account.
+SELECT role, is_deleted, is_frozen
+FROM users
+WHERE client_id=$1
+LIMIT 1;
+
+-- name: userSearch :many
+-- userSearch will retrieve the user accounts with a client id, username, name, or email address matching the query.
+SELECT username, client_id, first_name, last_name, email, role, is_deleted, is_frozen, email_verified
+FROM users
+WHERE client_id::text=@query::text
+      OR username ILIKE '%' || @query::text || '%'
+      OR email ILIKE '%' || @query::text || '%'
+      OR first_name ILIKE '%' || @query::text || '%'
+      OR last_name ILIKE '%' || @query::text || '%'
+ORDER BY username
+LIMIT $1;
+
+-- name: userSetFrozen :execrows
+-- userSetFrozen will freeze or unfreeze a user account that has not been deleted.
+UPDATE users
+SET is_frozen=$2
+WHERE client_id=$1 AND is_deleted=false;
+
+-- name: userUpdatePassword :execrows
+-- userUpdatePassword will replace the password of a user account that has not been deleted.
+UPDATE users
+SET password=$2
+WHERE client_id=$1 AND is_deleted=false;
+
+-- name: userUpdateProfile :execrows
+-- userUpdateProfile will replace the names and email address of a user account that has not been deleted. Empty values
+-- are left unchanged and a changed email address is marked as unverified.
+UPDATE users
+SET first_name=COALESCE(NULLIF(@first_name::text, ''), first_name),
+    last_name=COALESCE(NULLIF(@last_name::text, ''), last_name),
+    email=COALESCE(NULLIF(@email::text, ''), email),
+    email_verified=email_verified AND (@email::text='' OR lower(@email::text)=lower(email))
+WHERE client_id=@client_id AND is_deleted=false;
+
+-- name: userVerifyEmail :execrows
+-- userVerifyEmail will mark the email address of a user account that has not been deleted as verified if it has not
+-- changed since the verification link was issued.
+UPDATE users
+SET email_verified=true
+WHERE client_id=@client_id AND lower(email)=lower(@email::text) AND is_deleted=false;
@@ -406,3 +406,2127 @@ AS '
     END;
 ';
 --rollback DROP PROCEDURE sell_cryptocurrency;
+
+--changeset surahman:12
+--preconditions onFail:HALT onError:HALT
+--comment: Registry of supported Cryptocurrencies along with their precision, trading status, and order size limits.
+CREATE TYPE crypto_asset_status AS ENUM ('ENABLED', 'HALTED');
+
+CREATE TABLE IF NOT EXISTS crypto_assets (
+    ticker          VARCHAR(6)          PRIMARY KEY,
+    name            VARCHAR(64)         NOT NULL,
+    decimal_places  INTEGER             NOT NULL CHECK (decimal_places BETWEEN 0 AND 18),
+    status          CRYPTO_ASSET_STATUS DEFAULT 'ENABLED' NOT NULL,
+    min_order       NUMERIC(38,18)      NOT NULL CHECK (min_order > 0),
+    max_order       NUMERIC(38,18)      NOT NULL,
+    updated_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    CHECK (max_order >= min_order)
+);
+
+INSERT INTO crypto_assets (ticker, name, decimal_places, min_order, max_order)
+VALUES
+    ('BTC',  'Bitcoin',   8,  0.00001,  1000000),
+    ('ETH',  'Ether',     18, 0.0001,   1000000),
+    ('LTC',  'Litecoin',  8,  0.001,    1000000),
+    ('SOL',  'Solana',    9,  0.01,     1000000),
+    ('USDC', 'USD Coin',  6,  1,        1000000),
+    ('USDT', 'Tether',    6,  1,        1000000),
+    ('XRP',  'XRP',       6,  1,        1000000);
+--rollback DROP TABLE crypto_assets; DROP TYPE crypto_asset_status;
+
+--changeset surahman:13
+--preconditions onFail:HALT onError:HALT
+--comment: Widen Cryptocurrency balances to support per-asset precision and validate orders against the asset registry.
+ALTER TABLE crypto_accounts
+    ALTER COLUMN balance TYPE NUMERIC(38,18),
+    ALTER COLUMN last_tx TYPE NUMERIC(38,18);
+
+ALTER TABLE crypto_journal
+    ALTER COLUMN amount TYPE NUMERIC(38,18);
+
+CREATE OR REPLACE FUNCTION crypto_asset_order_check(_ticker VARCHAR(6), _amount NUMERIC)
+RETURNS INTEGER
+LANGUAGE plpgsql
+    STABLE
+AS '
+    DECLARE
+      asset   crypto_assets%ROWTYPE;  -- registry entry for the Cryptocurrency.
+    BEGIN
+      SELECT * INTO asset
+      FROM crypto_assets
+      WHERE ticker = _ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''crypto_asset_order_check: unsupported Cryptocurrency %'', _ticker;
+      END IF;
+
+      IF asset.status <> ''ENABLED'' THEN
+        RAISE EXCEPTION ''crypto_asset_order_check: trading is halted for %'', _ticker;
+      END IF;
+
+      IF _amount < asset.min_order OR _amount > asset.max_order THEN
+        RAISE EXCEPTION ''crypto_asset_order_check: order size % is outside of the limits for %'', _amount, _ticker;
+      END IF;
+
+      IF _amount <> round(_amount, asset.decimal_places) THEN
+        RAISE EXCEPTION ''crypto_asset_order_check: order size % exceeds the precision for %'', _amount, _ticker;
+      END IF;
+
+      RETURN asset.decimal_places;
+    END;
+';
+
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_credit_amount) INTO STRICT asset_decimals;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Fiat balance to complete purchase.
+      IF _fiat_debit_amount > fiat_balance THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, asset_decimals),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+
+
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_debit_amount) INTO STRICT asset_decimals;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Cryptocurrency balance to complete sale.
+      IF _crypto_debit_amount > crypto_balance THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, asset_decimals),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP FUNCTION crypto_asset_order_check CASCADE;
+
+--changeset surahman:14
+--preconditions onFail:HALT onError:HALT
+--comment: Flag administrative users who can manage platform reference data.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS is_admin BOOLEAN DEFAULT false NOT NULL;
+--rollback ALTER TABLE users DROP COLUMN is_admin;
+
+--changeset surahman:15
+--preconditions onFail:HALT onError:HALT
+--comment: Replace the currency enum with a managed Fiat currency reference table.
+CREATE TYPE fiat_currency_status AS ENUM ('ACTIVE', 'DEPRECATED', 'WITHDRAWN', 'INTERNAL');
+
+CREATE TABLE IF NOT EXISTS fiat_currencies (
+    code            VARCHAR(6)              PRIMARY KEY,
+    status          FIAT_CURRENCY_STATUS    DEFAULT 'ACTIVE' NOT NULL,
+    updated_at      TIMESTAMPTZ             DEFAULT now() NOT NULL
+);
+
+INSERT INTO fiat_currencies (code)
+SELECT unnest(enum_range(NULL::currency))::TEXT;
+
+INSERT INTO fiat_currencies (code)
+VALUES ('SLE'), ('VES'), ('ZWL');
+
+UPDATE fiat_currencies SET status = 'INTERNAL' WHERE code IN ('FIAT', 'CRYPTO');
+UPDATE fiat_currencies SET status = 'DEPRECATED' WHERE code IN ('SLL');
+UPDATE fiat_currencies SET status = 'WITHDRAWN' WHERE code IN ('CUC', 'HRK', 'VEF', 'ZWD');
+
+-- Currency codes are stored as text constrained by the reference table. The domain retains the currency type name
+-- used by the queries and stored procedure signatures.
+ALTER TYPE currency RENAME TO currency_enum;
+
+CREATE DOMAIN currency AS VARCHAR(6);
+
+ALTER TABLE fiat_accounts
+    ALTER COLUMN currency DROP DEFAULT,
+    ALTER COLUMN currency TYPE currency USING currency::TEXT,
+    ALTER COLUMN currency SET DEFAULT 'USD',
+    ADD CONSTRAINT fiat_accounts_currency_fkey FOREIGN KEY (currency) REFERENCES fiat_currencies (code);
+
+ALTER TABLE fiat_journal
+    ALTER COLUMN currency TYPE currency USING currency::TEXT,
+    ADD CONSTRAINT fiat_journal_currency_fkey FOREIGN KEY (currency) REFERENCES fiat_currencies (code);
+
+-- Drops the Cryptocurrency purchase and sale procedures whose signatures depend on the enum.
+DROP TYPE currency_enum CASCADE;
+
+CREATE OR REPLACE FUNCTION fiat_currency_transact_check(_currency Currency)
+RETURNS VOID
+LANGUAGE plpgsql
+    STABLE
+AS '
+    DECLARE
+      currency_status   fiat_currency_status;  -- status of the Fiat currency.
+    BEGIN
+      SELECT status INTO currency_status
+      FROM fiat_currencies
+      WHERE code = _currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''fiat_currency_transact_check: unsupported Fiat currency %'', _currency;
+      END IF;
+
+      IF currency_status NOT IN (''ACTIVE'', ''DEPRECATED'') THEN
+        RAISE EXCEPTION ''fiat_currency_transact_check: Fiat currency % is %'', _currency, currency_status;
+      END IF;
+    END;
+';
+
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_credit_amount) INTO STRICT asset_decimals;
+
+      -- Validate the Fiat currency has not been withdrawn from circulation.
+      PERFORM fiat_currency_transact_check(_fiat_currency);
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Fiat balance to complete purchase.
+      IF _fiat_debit_amount > fiat_balance THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, asset_decimals),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_debit_amount) INTO STRICT asset_decimals;
+
+      -- Validate the Fiat currency has not been withdrawn from circulation.
+      PERFORM fiat_currency_transact_check(_fiat_currency);
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Cryptocurrency balance to complete sale.
+      IF _crypto_debit_amount > crypto_balance THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, asset_decimals),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP FUNCTION fiat_currency_transact_check CASCADE; DROP TABLE fiat_currencies CASCADE; DROP TYPE fiat_currency_status;
+
+--changeset surahman:16
+--preconditions onFail:HALT onError:HALT
+--comment: Replace the administrative flag with user roles, allow user accounts to be frozen, and audit administrative actions.
+CREATE TYPE user_role AS ENUM ('USER', 'SUPPORT', 'ADMIN');
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role       USER_ROLE   DEFAULT 'USER' NOT NULL,
+    ADD COLUMN IF NOT EXISTS is_frozen  BOOLEAN     DEFAULT false NOT NULL;
+
+UPDATE users SET role = 'ADMIN' WHERE is_admin;
+
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
+
+CREATE TYPE admin_action AS ENUM (
+    'USER_SEARCH',
+    'USER_VIEW',
+    'USER_FREEZE',
+    'USER_UNFREEZE',
+    'FIAT_ACCOUNT_VIEW',
+    'FIAT_JOURNAL_VIEW',
+    'CRYPTO_ACCOUNT_VIEW',
+    'CRYPTO_JOURNAL_VIEW',
+    'CRYPTO_ASSET_UPSERT',
+    'CRYPTO_ASSET_STATUS',
+    'FIAT_CURRENCY_UPSERT',
+    'AUDIT_LOG_VIEW');
+
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id              BIGSERIAL       PRIMARY KEY,
+    admin_id        UUID            REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    action          ADMIN_ACTION    NOT NULL,
+    target          VARCHAR(64)     DEFAULT '' NOT NULL,
+    details         JSONB           DEFAULT '{}'::JSONB NOT NULL,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS admin_audit_log_target_idx ON admin_audit_log USING btree (target);
+CREATE INDEX IF NOT EXISTS admin_audit_log_created_at_idx ON admin_audit_log USING btree (created_at);
+--rollback DROP TABLE admin_audit_log CASCADE; DROP TYPE admin_action; ALTER TABLE users ADD COLUMN is_admin BOOLEAN DEFAULT false NOT NULL; UPDATE users SET is_admin = true WHERE role = 'ADMIN'; ALTER TABLE users DROP COLUMN is_frozen, DROP COLUMN role; DROP TYPE user_role;
+
+--changeset surahman:17
+--preconditions onFail:HALT onError:HALT
+--comment: Add statuses to the Fiat and Crypto accounts and enforce them, and user suspensions, in the transfer procedures.
+CREATE TYPE account_status AS ENUM ('ACTIVE', 'FROZEN_DEBITS', 'FROZEN', 'CLOSED');
+
+ALTER TABLE fiat_accounts
+    ADD COLUMN IF NOT EXISTS status ACCOUNT_STATUS DEFAULT 'ACTIVE' NOT NULL;
+
+ALTER TABLE crypto_accounts
+    ADD COLUMN IF NOT EXISTS status ACCOUNT_STATUS DEFAULT 'ACTIVE' NOT NULL;
+
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'FIAT_ACCOUNT_STATUS';
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'CRYPTO_ACCOUNT_STATUS';
+
+CREATE OR REPLACE FUNCTION account_transact_check(
+    _client_id  UUID,
+    _account    TEXT,
+    _status     ACCOUNT_STATUS,
+    _is_debit   BOOLEAN
+)
+RETURNS VOID
+LANGUAGE plpgsql
+    STABLE
+AS '
+    DECLARE
+      user_frozen   BOOLEAN;  -- whether the client has been suspended.
+    BEGIN
+      SELECT is_frozen INTO STRICT user_frozen
+      FROM users
+      WHERE client_id = _client_id;
+
+      IF user_frozen THEN
+        RAISE EXCEPTION ''account_transact_check: client % is suspended'', _client_id USING ERRCODE = ''FX001'';
+      END IF;
+
+      IF _status = ''ACTIVE'' OR (_status = ''FROZEN_DEBITS'' AND NOT _is_debit) THEN
+        RETURN;
+      END IF;
+
+      RAISE EXCEPTION ''account_transact_check: % account is %'', _account, _status USING ERRCODE = ''FX001'';
+    END;
+';
+
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      fiat_status         ACCOUNT_STATUS; -- current status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- current status of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_credit_amount) INTO STRICT asset_decimals;
+
+      -- Validate the Fiat currency has not been withdrawn from circulation.
+      PERFORM fiat_currency_transact_check(_fiat_currency);
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Validate the client is not suspended and that the Fiat account can be debited and the Crypto account credited.
+      PERFORM account_transact_check(_client_id, ''Fiat'', fiat_status, true);
+      PERFORM account_transact_check(_client_id, ''Crypto'', crypto_status, false);
+
+      -- Check for sufficient Fiat balance to complete purchase.
+      IF _fiat_debit_amount > fiat_balance THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, asset_decimals),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      fiat_status         ACCOUNT_STATUS; -- current status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- current status of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_debit_amount) INTO STRICT asset_decimals;
+
+      -- Validate the Fiat currency has not been withdrawn from circulation.
+      PERFORM fiat_currency_transact_check(_fiat_currency);
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Validate the client is not suspended and that the Crypto account can be debited and the Fiat account credited.
+      PERFORM account_transact_check(_client_id, ''Crypto'', crypto_status, true);
+      PERFORM account_transact_check(_client_id, ''Fiat'', fiat_status, false);
+
+      -- Check for sufficient Cryptocurrency balance to complete sale.
+      IF _crypto_debit_amount > crypto_balance THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, asset_decimals),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP FUNCTION account_transact_check CASCADE; ALTER TABLE crypto_accounts DROP COLUMN status; ALTER TABLE fiat_accounts DROP COLUMN status; DROP TYPE account_status;
+
+--changeset surahman:18
+--preconditions onFail:HALT onError:HALT
+--comment: Remove transaction control from the Cryptocurrency stored procedures so they can run within a transaction block.
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      fiat_status         ACCOUNT_STATUS; -- current status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- current status of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_credit_amount) INTO STRICT asset_decimals;
+
+      -- Validate the Fiat currency has not been withdrawn from circulation.
+      PERFORM fiat_currency_transact_check(_fiat_currency);
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Validate the client is not suspended and that the Fiat account can be debited and the Crypto account credited.
+      PERFORM account_transact_check(_client_id, ''Fiat'', fiat_status, true);
+      PERFORM account_transact_check(_client_id, ''Crypto'', crypto_status, false);
+
+      -- Check for sufficient Fiat balance to complete purchase.
+      IF _fiat_debit_amount > fiat_balance THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, asset_decimals),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+    END;
+';
+
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(38,18)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(38,18); -- current balance of the Crypto account.
+      fiat_status         ACCOUNT_STATUS; -- current status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- current status of the Crypto account.
+      asset_decimals      INTEGER;        -- decimal places permitted for the Cryptocurrency.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Validate the Cryptocurrency is tradable and that the order is within the registered asset limits.
+      SELECT crypto_asset_order_check(_crypto_ticker, _crypto_debit_amount) INTO STRICT asset_decimals;
+
+      -- Validate the Fiat currency has not been withdrawn from circulation.
+      PERFORM fiat_currency_transact_check(_fiat_currency);
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Validate the client is not suspended and that the Crypto account can be debited and the Fiat account credited.
+      PERFORM account_transact_check(_client_id, ''Crypto'', crypto_status, true);
+      PERFORM account_transact_check(_client_id, ''Fiat'', fiat_status, false);
+
+      -- Check for sufficient Cryptocurrency balance to complete sale.
+      IF _crypto_debit_amount > crypto_balance THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX. The Fiat Journal
+      -- entries are posted before the Crypto Journal entries so that the journal chain heads are locked in the same
+      -- order as in purchases.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, asset_decimals),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+    END;
+';
+--rollback not required
+
+--changeset surahman:19
+--preconditions onFail:HALT onError:HALT
+--comment: Create the Fiat and Crypto funds holds and the account balance views with the available balances.
+CREATE TABLE IF NOT EXISTS fiat_holds (
+    offer_id        VARCHAR(32)     PRIMARY KEY,
+    client_id       UUID            NOT NULL,
+    currency        CURRENCY        NOT NULL,
+    amount          NUMERIC(18,2)   NOT NULL CHECK (amount > 0),
+    expires_at      TIMESTAMPTZ     NOT NULL,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    FOREIGN KEY (client_id, currency) REFERENCES fiat_accounts (client_id, currency) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS fiat_holds_account_idx ON fiat_holds USING btree (client_id, currency);
+
+CREATE TABLE IF NOT EXISTS crypto_holds (
+    offer_id        VARCHAR(32)     PRIMARY KEY,
+    client_id       UUID            NOT NULL,
+    ticker          VARCHAR(6)      NOT NULL,
+    amount          NUMERIC(38,18)  NOT NULL CHECK (amount > 0),
+    expires_at      TIMESTAMPTZ     NOT NULL,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    FOREIGN KEY (client_id, ticker) REFERENCES crypto_accounts (client_id, ticker) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS crypto_holds_account_idx ON crypto_holds USING btree (client_id, ticker);
+
+-- The available balance is the ledger balance less any holds that have not expired.
+CREATE OR REPLACE VIEW fiat_account_balances AS
+SELECT
+    fa.currency,
+    fa.balance,
+    (fa.balance - COALESCE(held.amount, 0))::NUMERIC(18,2) AS available,
+    fa.last_tx,
+    fa.last_tx_ts,
+    fa.created_at,
+    fa.client_id,
+    fa.status
+FROM fiat_accounts AS fa
+    LEFT JOIN LATERAL (
+        SELECT SUM(fh.amount) AS amount
+        FROM fiat_holds AS fh
+        WHERE fh.client_id = fa.client_id AND fh.currency = fa.currency AND fh.expires_at > now()
+    ) AS held ON true;
+
+CREATE OR REPLACE VIEW crypto_account_balances AS
+SELECT
+    ca.ticker,
+    ca.balance,
+    (ca.balance - COALESCE(held.amount, 0))::NUMERIC(38,18) AS available,
+    ca.last_tx,
+    ca.last_tx_ts,
+    ca.created_at,
+    ca.client_id,
+    ca.status
+FROM crypto_accounts AS ca
+    LEFT JOIN LATERAL (
+        SELECT SUM(ch.amount) AS amount
+        FROM crypto_holds AS ch
+        WHERE ch.client_id = ca.client_id AND ch.ticker = ca.ticker AND ch.expires_at > now()
+    ) AS held ON true;
+--rollback DROP VIEW crypto_account_balances; DROP VIEW fiat_account_balances; DROP TABLE crypto_holds; DROP TABLE fiat_holds;
+
+--changeset surahman:20
+--preconditions onFail:HALT onError:HALT
+--comment: Create the Cryptocurrency limit orders and their state history.
+CREATE TYPE limit_order_status AS ENUM ('OPEN', 'FILLED', 'CANCELLED', 'EXPIRED', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS crypto_limit_orders (
+    order_id        VARCHAR(32)         PRIMARY KEY,
+    client_id       UUID                NOT NULL,
+    fiat_currency   CURRENCY            NOT NULL,
+    ticker          VARCHAR(6)          NOT NULL,
+    is_purchase     BOOLEAN             NOT NULL,
+    amount          NUMERIC(38,18)      NOT NULL CHECK (amount > 0),
+    limit_price     NUMERIC(38,18)      NOT NULL CHECK (limit_price > 0),
+    status          LIMIT_ORDER_STATUS  DEFAULT 'OPEN' NOT NULL,
+    tx_id           UUID,
+    expires_at      TIMESTAMPTZ         NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    updated_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    FOREIGN KEY (client_id, fiat_currency) REFERENCES fiat_accounts (client_id, currency) ON DELETE CASCADE,
+    FOREIGN KEY (client_id, ticker) REFERENCES crypto_accounts (client_id, ticker) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS crypto_limit_orders_client_idx ON crypto_limit_orders USING btree (client_id, order_id);
+CREATE INDEX IF NOT EXISTS crypto_limit_orders_open_idx ON crypto_limit_orders USING btree (order_id) WHERE status = 'OPEN';
+
+CREATE TABLE IF NOT EXISTS crypto_limit_order_events (
+    id              BIGSERIAL           PRIMARY KEY,
+    order_id        VARCHAR(32)         REFERENCES crypto_limit_orders(order_id) ON DELETE CASCADE NOT NULL,
+    status          LIMIT_ORDER_STATUS  NOT NULL,
+    price           NUMERIC(38,18)      DEFAULT 0 NOT NULL,
+    details         VARCHAR(256)        DEFAULT '' NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS crypto_limit_order_events_order_idx ON crypto_limit_order_events USING btree (order_id);
+--rollback DROP TABLE crypto_limit_order_events; DROP TABLE crypto_limit_orders; DROP TYPE limit_order_status;
+
+--changeset surahman:21
+--preconditions onFail:HALT onError:HALT
+--comment: Create the Cryptocurrency stop-loss and take-profit trigger orders and their state history.
+CREATE TYPE trigger_order_type AS ENUM ('STOP_LOSS', 'TAKE_PROFIT');
+CREATE TYPE trigger_order_status AS ENUM ('ACTIVE', 'TRIGGERED', 'CANCELLED', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS crypto_trigger_orders (
+    order_id            VARCHAR(32)             PRIMARY KEY,
+    client_id           UUID                    NOT NULL,
+    fiat_currency       CURRENCY                NOT NULL,
+    ticker              VARCHAR(6)              NOT NULL,
+    trigger_type        TRIGGER_ORDER_TYPE      NOT NULL,
+    trigger_price       NUMERIC(38,18)          NOT NULL CHECK (trigger_price > 0),
+    trailing_distance   NUMERIC(38,18)          DEFAULT 0 NOT NULL CHECK (trailing_distance >= 0),
+    amount              NUMERIC(38,18)          DEFAULT 0 NOT NULL CHECK (amount >= 0),
+    status              TRIGGER_ORDER_STATUS    DEFAULT 'ACTIVE' NOT NULL,
+    tx_id               UUID,
+    created_at          TIMESTAMPTZ             DEFAULT now() NOT NULL,
+    updated_at          TIMESTAMPTZ             DEFAULT now() NOT NULL,
+    FOREIGN KEY (client_id, fiat_currency) REFERENCES fiat_accounts (client_id, currency) ON DELETE CASCADE,
+    FOREIGN KEY (client_id, ticker) REFERENCES crypto_accounts (client_id, ticker) ON DELETE CASCADE,
+    CHECK (trigger_type = 'STOP_LOSS' OR trailing_distance = 0)
+);
+
+CREATE INDEX IF NOT EXISTS crypto_trigger_orders_client_idx ON crypto_trigger_orders USING btree (client_id, order_id);
+CREATE INDEX IF NOT EXISTS crypto_trigger_orders_active_idx ON crypto_trigger_orders USING btree (order_id) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS crypto_trigger_order_events (
+    id              BIGSERIAL               PRIMARY KEY,
+    order_id        VARCHAR(32)             REFERENCES crypto_trigger_orders(order_id) ON DELETE CASCADE NOT NULL,
+    status          TRIGGER_ORDER_STATUS    NOT NULL,
+    price           NUMERIC(38,18)          DEFAULT 0 NOT NULL,
+    amount          NUMERIC(38,18)          DEFAULT 0 NOT NULL,
+    details         VARCHAR(256)            DEFAULT '' NOT NULL,
+    created_at      TIMESTAMPTZ             DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS crypto_trigger_order_events_order_idx ON crypto_trigger_order_events USING btree (order_id);
+--rollback DROP TABLE crypto_trigger_order_events; DROP TABLE crypto_trigger_orders; DROP TYPE trigger_order_status; DROP TYPE trigger_order_type;
+
+--changeset surahman:22
+--preconditions onFail:HALT onError:HALT
+--comment: Create the recurring Cryptocurrency purchase plans and their execution history.
+CREATE TYPE recurring_purchase_cadence AS ENUM ('DAILY', 'WEEKLY', 'BIWEEKLY', 'MONTHLY');
+CREATE TYPE recurring_purchase_status AS ENUM ('ACTIVE', 'CANCELLED', 'COMPLETED');
+CREATE TYPE recurring_purchase_run_status AS ENUM ('EXECUTED', 'INSUFFICIENT_FUNDS', 'SKIPPED', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS crypto_recurring_purchases (
+    plan_id         VARCHAR(32)                 PRIMARY KEY,
+    client_id       UUID                        NOT NULL,
+    fiat_currency   CURRENCY                    NOT NULL,
+    ticker          VARCHAR(6)                  NOT NULL,
+    amount          NUMERIC(18,2)               NOT NULL CHECK (amount > 0),
+    cadence         RECURRING_PURCHASE_CADENCE  NOT NULL,
+    starts_at       TIMESTAMPTZ                 NOT NULL,
+    ends_at         TIMESTAMPTZ,
+    next_run_at     TIMESTAMPTZ                 NOT NULL,
+    status          RECURRING_PURCHASE_STATUS   DEFAULT 'ACTIVE' NOT NULL,
+    created_at      TIMESTAMPTZ                 DEFAULT now() NOT NULL,
+    updated_at      TIMESTAMPTZ                 DEFAULT now() NOT NULL,
+    FOREIGN KEY (client_id, fiat_currency) REFERENCES fiat_accounts (client_id, currency) ON DELETE CASCADE,
+    FOREIGN KEY (client_id, ticker) REFERENCES crypto_accounts (client_id, ticker) ON DELETE CASCADE,
+    CHECK (ends_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS crypto_recurring_purchases_client_idx ON crypto_recurring_purchases USING btree (client_id, plan_id);
+CREATE INDEX IF NOT EXISTS crypto_recurring_purchases_due_idx ON crypto_recurring_purchases USING btree (next_run_at, plan_id) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS crypto_recurring_purchase_runs (
+    id              BIGSERIAL                       PRIMARY KEY,
+    plan_id         VARCHAR(32)                     REFERENCES crypto_recurring_purchases(plan_id) ON DELETE CASCADE NOT NULL,
+    scheduled_at    TIMESTAMPTZ                     NOT NULL,
+    status          RECURRING_PURCHASE_RUN_STATUS   NOT NULL,
+    price           NUMERIC(18,2)                   DEFAULT 0 NOT NULL,
+    fiat_amount     NUMERIC(18,2)                   DEFAULT 0 NOT NULL,
+    crypto_amount   NUMERIC(38,18)                  DEFAULT 0 NOT NULL,
+    tx_id           UUID,
+    details         VARCHAR(256)                    DEFAULT '' NOT NULL,
+    created_at      TIMESTAMPTZ                     DEFAULT now() NOT NULL,
+    UNIQUE (plan_id, scheduled_at)
+);
+
+CREATE INDEX IF NOT EXISTS crypto_recurring_purchase_runs_plan_idx ON crypto_recurring_purchase_runs USING btree (plan_id, id);
+--rollback DROP TABLE crypto_recurring_purchase_runs; DROP TABLE crypto_recurring_purchases; DROP TYPE recurring_purchase_run_status; DROP TYPE recurring_purchase_status; DROP TYPE recurring_purchase_cadence;
+
+--changeset surahman:23
+--preconditions onFail:HALT onError:HALT
+--comment: Create the daily closing balance snapshots of the Fiat and Crypto accounts and index the journals by account.
+CREATE TABLE IF NOT EXISTS fiat_balance_snapshots (
+    client_id       UUID            NOT NULL,
+    currency        CURRENCY        NOT NULL,
+    snapshot_at     TIMESTAMPTZ     NOT NULL,
+    balance         NUMERIC(18,2)   NOT NULL,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    PRIMARY KEY (client_id, currency, snapshot_at),
+    FOREIGN KEY (client_id, currency) REFERENCES fiat_accounts (client_id, currency) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS fiat_balance_snapshots_snapshot_at_idx ON fiat_balance_snapshots USING btree (snapshot_at);
+CREATE INDEX IF NOT EXISTS fiat_journal_account_idx ON fiat_journal USING btree (client_id, currency, transacted_at);
+
+CREATE TABLE IF NOT EXISTS crypto_balance_snapshots (
+    client_id       UUID            NOT NULL,
+    ticker          VARCHAR(6)      NOT NULL,
+    snapshot_at     TIMESTAMPTZ     NOT NULL,
+    balance         NUMERIC(38,18)  NOT NULL,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    PRIMARY KEY (client_id, ticker, snapshot_at),
+    FOREIGN KEY (client_id, ticker) REFERENCES crypto_accounts (client_id, ticker) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS crypto_balance_snapshots_snapshot_at_idx ON crypto_balance_snapshots USING btree (snapshot_at);
+CREATE INDEX IF NOT EXISTS crypto_journal_account_idx ON crypto_journal USING btree (client_id, ticker, transacted_at);
+--rollback DROP INDEX crypto_journal_account_idx; DROP TABLE crypto_balance_snapshots; DROP INDEX fiat_journal_account_idx; DROP TABLE fiat_balance_snapshots;
+
+--changeset surahman:24
+--preconditions onFail:HALT onError:HALT
+--comment: Partition the Fiat and Crypto journals by month on the transaction timestamp.
+CREATE OR REPLACE FUNCTION journal_partition_create(_journal TEXT, _month DATE)
+RETURNS BOOLEAN
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _starts_at    TIMESTAMPTZ;  -- inclusive lower bound of the partition at midnight UTC on the first of the month.
+      _ends_at      TIMESTAMPTZ;  -- exclusive upper bound of the partition at midnight UTC on the first of the next month.
+      _partition    TEXT;         -- name of the partition.
+      _tablespace   TEXT;         -- tablespace of the journal, if any, that the partition will be created in.
+    BEGIN
+      IF _journal NOT IN (''fiat_journal'', ''crypto_journal'') THEN
+        RAISE EXCEPTION ''unknown journal %'', _journal;
+      END IF;
+
+      _starts_at := date_trunc(''month'', _month)::TIMESTAMP AT TIME ZONE ''UTC'';
+      _ends_at := (date_trunc(''month'', _month) + INTERVAL ''1 month'')::TIMESTAMP AT TIME ZONE ''UTC'';
+      _partition := _journal || to_char(_month, ''"_y"YYYY"m"MM'');
+
+      IF to_regclass(_partition) IS NOT NULL THEN
+        RETURN false;
+      END IF;
+
+      SELECT spcname INTO _tablespace
+      FROM pg_class
+      JOIN pg_tablespace ON pg_tablespace.oid = pg_class.reltablespace
+      WHERE pg_class.oid = _journal::REGCLASS;
+
+      EXECUTE format(''CREATE TABLE %I (LIKE %I INCLUDING DEFAULTS)'', _partition, _journal) ||
+        CASE WHEN _tablespace IS NULL THEN '''' ELSE format('' TABLESPACE %I'', _tablespace) END;
+
+      -- Entries posted to the default partition before the monthly partition was created are moved into it.
+      EXECUTE format(
+        ''WITH moved AS (DELETE FROM %I WHERE transacted_at >= $1 AND transacted_at < $2 RETURNING *)
+        INSERT INTO %I SELECT * FROM moved'', _journal || ''_default'', _partition)
+      USING _starts_at, _ends_at;
+
+      EXECUTE format(
+        ''ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)'', _journal, _partition, _starts_at, _ends_at);
+
+      RETURN true;
+    END;
+';
+
+CREATE OR REPLACE FUNCTION journal_partition_check(_partition TEXT)
+RETURNS TEXT
+LANGUAGE plpgsql
+STABLE
+AS '
+    DECLARE
+      _journal  TEXT;  -- name of the journal the monthly partition belongs to.
+    BEGIN
+      SELECT parent.relname INTO _journal
+      FROM pg_inherits
+      JOIN pg_class AS parent ON parent.oid = pg_inherits.inhparent
+      JOIN pg_class AS child ON child.oid = pg_inherits.inhrelid
+      WHERE child.relname = _partition
+            AND parent.relname IN (''fiat_journal'', ''crypto_journal'')
+            AND child.relname ~ ''_y[0-9]{4}m[0-9]{2}$'';
+
+      IF _journal IS NULL THEN
+        RAISE EXCEPTION ''unknown journal partition %'', _partition;
+      END IF;
+
+      RETURN _journal;
+    END;
+';
+
+CREATE OR REPLACE FUNCTION journal_partition_archive(_partition TEXT, _tablespace TEXT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _index    TEXT;  -- name of an index on the partition.
+    BEGIN
+      PERFORM journal_partition_check(_partition);
+
+      EXECUTE format(''ALTER TABLE %I SET TABLESPACE %I'', _partition, _tablespace);
+
+      FOR _index IN
+        SELECT indexrelid::REGCLASS::TEXT
+        FROM pg_index
+        WHERE indrelid = _partition::REGCLASS
+      LOOP
+        EXECUTE format(''ALTER INDEX %s SET TABLESPACE %I'', _index, _tablespace);
+      END LOOP;
+    END;
+';
+
+CREATE OR REPLACE FUNCTION journal_partition_drop(_partition TEXT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS '
+    BEGIN
+      EXECUTE format(''ALTER TABLE %I DETACH PARTITION %I'', journal_partition_check(_partition), _partition);
+      EXECUTE format(''DROP TABLE %I'', _partition);
+    END;
+';
+
+-- The unpartitioned journals are renamed, with their indexes dropped and primary keys renamed to free up the names.
+ALTER TABLE fiat_journal RENAME TO fiat_journal_unpartitioned;
+ALTER TABLE fiat_journal_unpartitioned RENAME CONSTRAINT fiat_journal_pkey TO fiat_journal_unpartitioned_pkey;
+DROP INDEX fiat_journal_transacted_at_idx, fiat_journal_tx_idx, fiat_journal_account_idx;
+
+CREATE TABLE IF NOT EXISTS fiat_journal (
+    currency        CURRENCY        NOT NULL,
+    amount          NUMERIC(18,2)   NOT NULL,
+    transacted_at   TIMESTAMPTZ     NOT NULL,
+    client_id       UUID            REFERENCES users(client_id) ON DELETE CASCADE,
+    tx_id           UUID            DEFAULT gen_random_uuid() NOT NULL,
+    PRIMARY KEY(tx_id, client_id, currency, transacted_at),
+    CONSTRAINT fiat_journal_currency_fkey FOREIGN KEY (currency) REFERENCES fiat_currencies (code)
+) PARTITION BY RANGE (transacted_at);
+
+CREATE TABLE IF NOT EXISTS fiat_journal_default PARTITION OF fiat_journal DEFAULT;
+
+CREATE INDEX IF NOT EXISTS fiat_journal_transacted_at_idx ON fiat_journal USING btree (transacted_at);
+CREATE INDEX IF NOT EXISTS fiat_journal_tx_idx ON fiat_journal USING btree (tx_id);
+CREATE INDEX IF NOT EXISTS fiat_journal_account_idx ON fiat_journal USING btree (client_id, currency, transacted_at);
+
+ALTER TABLE crypto_journal RENAME TO crypto_journal_unpartitioned;
+ALTER TABLE crypto_journal_unpartitioned RENAME CONSTRAINT crypto_journal_pkey TO crypto_journal_unpartitioned_pkey;
+DROP INDEX crypto_journal_transacted_at_idx, crypto_journal_tx_idx, crypto_journal_account_idx;
+
+CREATE TABLE IF NOT EXISTS crypto_journal (
+    ticker          VARCHAR(6)      NOT NULL,
+    amount          NUMERIC(38,18)  NOT NULL,
+    transacted_at   TIMESTAMPTZ     NOT NULL,
+    client_id       UUID            REFERENCES users(client_id) ON DELETE CASCADE,
+    tx_id           UUID            DEFAULT gen_random_uuid() NOT NULL,
+    PRIMARY KEY(tx_id, client_id, ticker, transacted_at)
+) PARTITION BY RANGE (transacted_at);
+
+CREATE TABLE IF NOT EXISTS crypto_journal_default PARTITION OF crypto_journal DEFAULT;
+
+CREATE INDEX IF NOT EXISTS crypto_journal_transacted_at_idx ON crypto_journal USING btree (transacted_at);
+CREATE INDEX IF NOT EXISTS crypto_journal_tx_idx ON crypto_journal USING btree (tx_id);
+CREATE INDEX IF NOT EXISTS crypto_journal_account_idx ON crypto_journal USING btree (client_id, ticker, transacted_at);
+
+-- Monthly partitions are created from the earliest journal entry through the three months ahead. The entries are then
+-- copied into the partitioned journals.
+SELECT journal_partition_create('fiat_journal', month::DATE)
+FROM generate_series(
+    date_trunc('month', COALESCE((SELECT MIN(transacted_at) FROM fiat_journal_unpartitioned), now()) AT TIME ZONE 'UTC'),
+    date_trunc('month', now() AT TIME ZONE 'UTC') + INTERVAL '3 months',
+    INTERVAL '1 month') AS month;
+
+SELECT journal_partition_create('crypto_journal', month::DATE)
+FROM generate_series(
+    date_trunc('month', COALESCE((SELECT MIN(transacted_at) FROM crypto_journal_unpartitioned), now()) AT TIME ZONE 'UTC'),
+    date_trunc('month', now() AT TIME ZONE 'UTC') + INTERVAL '3 months',
+    INTERVAL '1 month') AS month;
+
+INSERT INTO fiat_journal (currency, amount, transacted_at, client_id, tx_id)
+SELECT currency, amount, transacted_at, client_id, tx_id
+FROM fiat_journal_unpartitioned;
+
+INSERT INTO crypto_journal (ticker, amount, transacted_at, client_id, tx_id)
+SELECT ticker, amount, transacted_at, client_id, tx_id
+FROM crypto_journal_unpartitioned;
+
+DROP TABLE fiat_journal_unpartitioned;
+DROP TABLE crypto_journal_unpartitioned;
+--rollback ALTER TABLE fiat_journal RENAME TO fiat_journal_partitioned; ALTER TABLE fiat_journal_partitioned RENAME CONSTRAINT fiat_journal_pkey TO fiat_journal_partitioned_pkey; DROP INDEX fiat_journal_transacted_at_idx, fiat_journal_tx_idx, fiat_journal_account_idx;
+--rollback CREATE TABLE fiat_journal (currency CURRENCY NOT NULL, amount NUMERIC(18,2) NOT NULL, transacted_at TIMESTAMPTZ NOT NULL, client_id UUID REFERENCES users(client_id) ON DELETE CASCADE, tx_id UUID DEFAULT gen_random_uuid() NOT NULL, PRIMARY KEY(tx_id, client_id, currency), CONSTRAINT fiat_journal_currency_fkey FOREIGN KEY (currency) REFERENCES fiat_currencies (code));
+--rollback INSERT INTO fiat_journal SELECT currency, amount, transacted_at, client_id, tx_id FROM fiat_journal_partitioned; DROP TABLE fiat_journal_partitioned;
+--rollback CREATE INDEX fiat_journal_transacted_at_idx ON fiat_journal USING btree (transacted_at); CREATE INDEX fiat_journal_tx_idx ON fiat_journal USING btree (tx_id); CREATE INDEX fiat_journal_account_idx ON fiat_journal USING btree (client_id, currency, transacted_at);
+--rollback ALTER TABLE crypto_journal RENAME TO crypto_journal_partitioned; ALTER TABLE crypto_journal_partitioned RENAME CONSTRAINT crypto_journal_pkey TO crypto_journal_partitioned_pkey; DROP INDEX crypto_journal_transacted_at_idx, crypto_journal_tx_idx, crypto_journal_account_idx;
+--rollback CREATE TABLE crypto_journal (ticker VARCHAR(6) NOT NULL, amount NUMERIC(38,18) NOT NULL, transacted_at TIMESTAMPTZ NOT NULL, client_id UUID REFERENCES users(client_id) ON DELETE CASCADE, tx_id UUID DEFAULT gen_random_uuid() NOT NULL, PRIMARY KEY(tx_id, client_id, ticker));
+--rollback INSERT INTO crypto_journal SELECT ticker, amount, transacted_at, client_id, tx_id FROM crypto_journal_partitioned; DROP TABLE crypto_journal_partitioned;
+--rollback CREATE INDEX crypto_journal_transacted_at_idx ON crypto_journal USING btree (transacted_at); CREATE INDEX crypto_journal_tx_idx ON crypto_journal USING btree (tx_id); CREATE INDEX crypto_journal_account_idx ON crypto_journal USING btree (client_id, ticker, transacted_at);
+--rollback DROP FUNCTION journal_partition_drop; DROP FUNCTION journal_partition_archive; DROP FUNCTION journal_partition_check; DROP FUNCTION journal_partition_create;
+
+--changeset surahman:25
+--preconditions onFail:HALT onError:HALT
+--comment: Enforce the double-entry invariants on the journals and account balances when transactions are committed.
+CREATE OR REPLACE FUNCTION fiat_journal_balance_check()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _currency   Currency;  -- currency of the transaction that does not balance.
+      _legs       BIGINT;    -- number of entries for the currency in the transaction.
+      _total      NUMERIC;   -- sum of the entries for the currency in the transaction.
+    BEGIN
+      SELECT currency, COUNT(*), SUM(amount) INTO _currency, _legs, _total
+      FROM fiat_journal
+      WHERE tx_id = NEW.tx_id AND transacted_at = NEW.transacted_at
+      GROUP BY currency
+      HAVING COUNT(*) < 2 OR SUM(amount) <> 0
+      LIMIT 1;
+
+      IF FOUND THEN
+        RAISE EXCEPTION ''fiat_journal_balance_check: transaction % has % % entries totalling %'',
+          NEW.tx_id, _legs, _currency, _total USING ERRCODE = ''check_violation'';
+      END IF;
+
+      RETURN NULL;
+    END;
+';
+
+CREATE CONSTRAINT TRIGGER fiat_journal_balance_trigger
+AFTER INSERT OR UPDATE ON fiat_journal
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW
+EXECUTE FUNCTION fiat_journal_balance_check();
+
+CREATE OR REPLACE FUNCTION crypto_journal_balance_check()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _ticker     VARCHAR(6);  -- ticker of the transaction that does not balance.
+      _legs       BIGINT;      -- number of entries for the ticker in the transaction.
+      _total      NUMERIC;     -- sum of the entries for the ticker in the transaction.
+    BEGIN
+      SELECT ticker, COUNT(*), SUM(amount) INTO _ticker, _legs, _total
+      FROM crypto_journal
+      WHERE tx_id = NEW.tx_id AND transacted_at = NEW.transacted_at
+      GROUP BY ticker
+      HAVING COUNT(*) < 2 OR SUM(amount) <> 0
+      LIMIT 1;
+
+      IF FOUND THEN
+        RAISE EXCEPTION ''crypto_journal_balance_check: transaction % has % % entries totalling %'',
+          NEW.tx_id, _legs, _ticker, _total USING ERRCODE = ''check_violation'';
+      END IF;
+
+      RETURN NULL;
+    END;
+';
+
+CREATE CONSTRAINT TRIGGER crypto_journal_balance_trigger
+AFTER INSERT OR UPDATE ON crypto_journal
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW
+EXECUTE FUNCTION crypto_journal_balance_check();
+
+CREATE OR REPLACE FUNCTION fiat_account_journal_check()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    BEGIN
+      IF TG_OP = ''INSERT'' THEN
+        IF NEW.balance <> 0 THEN
+          RAISE EXCEPTION ''fiat_account_journal_check: account % % opened with a balance of %'',
+            NEW.client_id, NEW.currency, NEW.balance USING ERRCODE = ''check_violation'';
+        END IF;
+
+        RETURN NULL;
+      END IF;
+
+      IF NEW.balance - OLD.balance <> NEW.last_tx THEN
+        RAISE EXCEPTION ''fiat_account_journal_check: account % % balance changed by % for a transaction of %'',
+          NEW.client_id, NEW.currency, NEW.balance - OLD.balance, NEW.last_tx USING ERRCODE = ''check_violation'';
+      END IF;
+
+      PERFORM
+      FROM fiat_journal
+      WHERE client_id = NEW.client_id
+            AND currency = NEW.currency
+            AND transacted_at = NEW.last_tx_ts
+            AND amount = NEW.last_tx;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''fiat_account_journal_check: account % % transaction of % at % has no journal entry'',
+          NEW.client_id, NEW.currency, NEW.last_tx, NEW.last_tx_ts USING ERRCODE = ''check_violation'';
+      END IF;
+
+      RETURN NULL;
+    END;
+';
+
+CREATE CONSTRAINT TRIGGER fiat_account_journal_trigger
+AFTER INSERT OR UPDATE OF balance ON fiat_accounts
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW
+EXECUTE FUNCTION fiat_account_journal_check();
+
+CREATE OR REPLACE FUNCTION crypto_account_journal_check()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    BEGIN
+      IF TG_OP = ''INSERT'' THEN
+        IF NEW.balance <> 0 THEN
+          RAISE EXCEPTION ''crypto_account_journal_check: account % % opened with a balance of %'',
+            NEW.client_id, NEW.ticker, NEW.balance USING ERRCODE = ''check_violation'';
+        END IF;
+
+        RETURN NULL;
+      END IF;
+
+      IF NEW.balance - OLD.balance <> NEW.last_tx THEN
+        RAISE EXCEPTION ''crypto_account_journal_check: account % % balance changed by % for a transaction of %'',
+          NEW.client_id, NEW.ticker, NEW.balance - OLD.balance, NEW.last_tx USING ERRCODE = ''check_violation'';
+      END IF;
+
+      PERFORM
+      FROM crypto_journal
+      WHERE client_id = NEW.client_id
+            AND ticker = NEW.ticker
+            AND transacted_at = NEW.last_tx_ts
+            AND amount = NEW.last_tx;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''crypto_account_journal_check: account % % transaction of % at % has no journal entry'',
+          NEW.client_id, NEW.ticker, NEW.last_tx, NEW.last_tx_ts USING ERRCODE = ''check_violation'';
+      END IF;
+
+      RETURN NULL;
+    END;
+';
+
+CREATE CONSTRAINT TRIGGER crypto_account_journal_trigger
+AFTER INSERT OR UPDATE OF balance ON crypto_accounts
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW
+EXECUTE FUNCTION crypto_account_journal_check();
+--rollback DROP TRIGGER crypto_account_journal_trigger ON crypto_accounts; DROP FUNCTION crypto_account_journal_check;
+--rollback DROP TRIGGER fiat_account_journal_trigger ON fiat_accounts; DROP FUNCTION fiat_account_journal_check;
+--rollback DROP TRIGGER crypto_journal_balance_trigger ON crypto_journal; DROP FUNCTION crypto_journal_balance_check;
+--rollback DROP TRIGGER fiat_journal_balance_trigger ON fiat_journal; DROP FUNCTION fiat_journal_balance_check;
+
+--changeset surahman:26
+--preconditions onFail:HALT onError:HALT
+--comment: Hash chain the Fiat and Crypto journal entries and record signed checkpoints of the chains.
+CREATE TABLE IF NOT EXISTS journal_chain_heads (
+    journal         TEXT            PRIMARY KEY CHECK (journal IN ('fiat_journal', 'crypto_journal')),
+    seq             BIGINT          NOT NULL,
+    entry_hash      BYTEA           NOT NULL,
+    anchor_seq      BIGINT          NOT NULL,
+    anchor_hash     BYTEA           NOT NULL
+);
+
+INSERT INTO journal_chain_heads (journal, seq, entry_hash, anchor_seq, anchor_hash)
+VALUES
+    ('crypto_journal', 0, decode(repeat('00', 32), 'hex'), 0, decode(repeat('00', 32), 'hex')),
+    ('fiat_journal', 0, decode(repeat('00', 32), 'hex'), 0, decode(repeat('00', 32), 'hex'));
+
+CREATE TABLE IF NOT EXISTS journal_checkpoints (
+    id              BIGSERIAL       PRIMARY KEY,
+    journal         TEXT            NOT NULL REFERENCES journal_chain_heads (journal),
+    seq             BIGINT          NOT NULL,
+    entry_hash      BYTEA           NOT NULL,
+    key_id          TEXT            NOT NULL,
+    public_key      BYTEA           NOT NULL,
+    signature       BYTEA           NOT NULL,
+    created_at      TIMESTAMPTZ     NOT NULL,
+    UNIQUE (journal, seq)
+);
+
+CREATE INDEX IF NOT EXISTS journal_checkpoints_created_at_idx ON journal_checkpoints USING btree (created_at);
+
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'LEDGER_VERIFY';
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'LEDGER_CHECKPOINT_VIEW';
+
+CREATE OR REPLACE FUNCTION journal_entry_hash(
+    _seq            BIGINT,
+    _tx_id          UUID,
+    _client_id      UUID,
+    _code           TEXT,
+    _amount         NUMERIC,
+    _transacted_at  TIMESTAMPTZ,
+    _prev_hash      BYTEA)
+RETURNS BYTEA
+LANGUAGE sql
+STABLE
+AS '
+    SELECT sha256(convert_to(concat_ws(''|'',
+        _seq,
+        _tx_id,
+        _client_id,
+        _code,
+        _amount,
+        to_char(_transacted_at AT TIME ZONE ''UTC'', ''YYYY-MM-DD"T"HH24:MI:SS.US"Z"''),
+        encode(_prev_hash, ''hex'')), ''UTF8''));
+';
+
+-- The balance checks are restricted to the entry fields so that the chain fields can be populated.
+DROP TRIGGER fiat_journal_balance_trigger ON fiat_journal;
+
+CREATE CONSTRAINT TRIGGER fiat_journal_balance_trigger
+AFTER INSERT OR UPDATE OF tx_id, client_id, currency, amount, transacted_at ON fiat_journal
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW
+EXECUTE FUNCTION fiat_journal_balance_check();
+
+DROP TRIGGER crypto_journal_balance_trigger ON crypto_journal;
+
+CREATE CONSTRAINT TRIGGER crypto_journal_balance_trigger
+AFTER INSERT OR UPDATE OF tx_id, client_id, ticker, amount, transacted_at ON crypto_journal
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW
+EXECUTE FUNCTION crypto_journal_balance_check();
+
+ALTER TABLE fiat_journal
+    ADD COLUMN IF NOT EXISTS seq BIGINT,
+    ADD COLUMN IF NOT EXISTS prev_hash BYTEA,
+    ADD COLUMN IF NOT EXISTS entry_hash BYTEA;
+
+ALTER TABLE crypto_journal
+    ADD COLUMN IF NOT EXISTS seq BIGINT,
+    ADD COLUMN IF NOT EXISTS prev_hash BYTEA,
+    ADD COLUMN IF NOT EXISTS entry_hash BYTEA;
+
+-- Chain the existing entries in the order they were posted.
+DO '
+    DECLARE
+      _entry  RECORD;                                   -- existing journal entry being chained.
+      _seq    BIGINT := 0;                              -- position of the entry in the chain.
+      _hash   BYTEA := decode(repeat(''00'', 32), ''hex''); -- hash of the previous entry in the chain.
+    BEGIN
+      FOR _entry IN
+        SELECT tx_id, client_id, currency, amount, transacted_at
+        FROM fiat_journal
+        ORDER BY transacted_at, tx_id, client_id, currency
+      LOOP
+        _seq := _seq + 1;
+
+        UPDATE fiat_journal
+        SET seq = _seq,
+            prev_hash = _hash,
+            entry_hash = journal_entry_hash(_seq, tx_id, client_id, currency::text, amount, transacted_at, _hash)
+        WHERE tx_id = _entry.tx_id
+              AND client_id = _entry.client_id
+              AND currency = _entry.currency
+              AND transacted_at = _entry.transacted_at
+        RETURNING entry_hash INTO _hash;
+      END LOOP;
+
+      UPDATE journal_chain_heads
+      SET seq = _seq, entry_hash = _hash
+      WHERE journal = ''fiat_journal'';
+
+      _seq := 0;
+      _hash := decode(repeat(''00'', 32), ''hex'');
+
+      FOR _entry IN
+        SELECT tx_id, client_id, ticker, amount, transacted_at
+        FROM crypto_journal
+        ORDER BY transacted_at, tx_id, client_id, ticker
+      LOOP
+        _seq := _seq + 1;
+
+        UPDATE crypto_journal
+        SET seq = _seq,
+            prev_hash = _hash,
+            entry_hash = journal_entry_hash(_seq, tx_id, client_id, ticker, amount, transacted_at, _hash)
+        WHERE tx_id = _entry.tx_id
+              AND client_id = _entry.client_id
+              AND ticker = _entry.ticker
+              AND transacted_at = _entry.transacted_at
+        RETURNING entry_hash INTO _hash;
+      END LOOP;
+
+      UPDATE journal_chain_heads
+      SET seq = _seq, entry_hash = _hash
+      WHERE journal = ''crypto_journal'';
+    END;
+';
+
+ALTER TABLE fiat_journal
+    ALTER COLUMN seq SET NOT NULL,
+    ALTER COLUMN prev_hash SET NOT NULL,
+    ALTER COLUMN entry_hash SET NOT NULL;
+
+ALTER TABLE crypto_journal
+    ALTER COLUMN seq SET NOT NULL,
+    ALTER COLUMN prev_hash SET NOT NULL,
+    ALTER COLUMN entry_hash SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS fiat_journal_seq_idx ON fiat_journal USING btree (seq);
+CREATE INDEX IF NOT EXISTS crypto_journal_seq_idx ON crypto_journal USING btree (seq);
+
+CREATE OR REPLACE FUNCTION fiat_journal_chain_append()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _head   journal_chain_heads%ROWTYPE;  -- last entry in the Fiat journal chain.
+    BEGIN
+      -- Only the head of this chain is locked. Transactions posting to both journals post to the Fiat journal first
+      -- so that the heads are always locked in the same order.
+      SELECT * INTO STRICT _head
+      FROM journal_chain_heads
+      WHERE journal = ''fiat_journal''
+      FOR UPDATE;
+
+      NEW.seq := _head.seq + 1;
+      NEW.prev_hash := _head.entry_hash;
+      NEW.entry_hash := journal_entry_hash(
+        NEW.seq, NEW.tx_id, NEW.client_id, NEW.currency::text, NEW.amount, NEW.transacted_at, NEW.prev_hash);
+
+      UPDATE journal_chain_heads
+      SET seq = NEW.seq, entry_hash = NEW.entry_hash
+      WHERE journal = ''fiat_journal'';
+
+      RETURN NEW;
+    END;
+';
+
+CREATE TRIGGER fiat_journal_chain_trigger
+BEFORE INSERT ON fiat_journal
+FOR EACH ROW
+EXECUTE FUNCTION fiat_journal_chain_append();
+
+CREATE OR REPLACE FUNCTION crypto_journal_chain_append()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _head   journal_chain_heads%ROWTYPE;  -- last entry in the Crypto journal chain.
+    BEGIN
+      -- Only the head of this chain is locked. Transactions posting to both journals post to the Fiat journal first
+      -- so that the heads are always locked in the same order.
+      SELECT * INTO STRICT _head
+      FROM journal_chain_heads
+      WHERE journal = ''crypto_journal''
+      FOR UPDATE;
+
+      NEW.seq := _head.seq + 1;
+      NEW.prev_hash := _head.entry_hash;
+      NEW.entry_hash := journal_entry_hash(
+        NEW.seq, NEW.tx_id, NEW.client_id, NEW.ticker, NEW.amount, NEW.transacted_at, NEW.prev_hash);
+
+      UPDATE journal_chain_heads
+      SET seq = NEW.seq, entry_hash = NEW.entry_hash
+      WHERE journal = ''crypto_journal'';
+
+      RETURN NEW;
+    END;
+';
+
+CREATE TRIGGER crypto_journal_chain_trigger
+BEFORE INSERT ON crypto_journal
+FOR EACH ROW
+EXECUTE FUNCTION crypto_journal_chain_append();
+
+-- Dropping a partition moves the archive anchor of its journal chain to the entry with the highest sequence number in
+-- the partition. Entries are sequenced when they are inserted but partitioned by the time their transaction started, so
+-- a transaction that straddles midnight at the end of a month, or a reversal that keeps the original timestamp, can
+-- leave entries in another partition that are sequenced before the anchor. Those entries remain in the journal but are
+-- not walked when the chain is verified. Only the partition holding the first remaining entry of a chain can be dropped,
+-- so that no entry sequenced before the partition is cut off from the chain.
+CREATE OR REPLACE FUNCTION journal_partition_drop(_partition TEXT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      _journal      TEXT;     -- journal the partition belongs to.
+      _first_seq    BIGINT;   -- lowest sequence number in the partition.
+      _last_seq     BIGINT;   -- highest sequence number in the partition.
+      _last_hash    BYTEA;    -- entry hash of the entry with the highest sequence number in the partition.
+      _outside_seq  BIGINT;   -- lowest sequence number remaining in the journal outside the partition.
+    BEGIN
+      _journal := journal_partition_check(_partition);
+
+      EXECUTE format(''ALTER TABLE %I DETACH PARTITION %I'', _journal, _partition);
+
+      EXECUTE format(''SELECT min(seq), max(seq) FROM %I'', _partition) INTO _first_seq, _last_seq;
+
+      IF _last_seq IS NOT NULL THEN
+        EXECUTE format(''SELECT entry_hash FROM %I WHERE seq = $1'', _partition) INTO _last_hash USING _last_seq;
+
+        EXECUTE format(''SELECT min(seq) FROM %I'', _journal) INTO _outside_seq;
+
+        IF _outside_seq < _first_seq THEN
+          RAISE EXCEPTION ''partition % is not at the start of the % hash chain: entry % precedes it'',
+            _partition, _journal, _outside_seq;
+        END IF;
+
+        UPDATE journal_chain_heads
+        SET anchor_seq = _last_seq, anchor_hash = _last_hash
+        WHERE journal = _journal AND anchor_seq < _last_seq;
+      END IF;
+
+      EXECUTE format(''DROP TABLE %I'', _partition);
+    END;
+';
+--rollback CREATE OR REPLACE FUNCTION journal_partition_drop(_partition TEXT) RETURNS VOID LANGUAGE plpgsql AS ' BEGIN EXECUTE format(''ALTER TABLE %I DETACH PARTITION %I'', journal_partition_check(_partition), _partition); EXECUTE format(''DROP TABLE %I'', _partition); END; ';
+--rollback DROP TRIGGER crypto_journal_chain_trigger ON crypto_journal; DROP FUNCTION crypto_journal_chain_append; DROP TRIGGER fiat_journal_chain_trigger ON fiat_journal; DROP FUNCTION fiat_journal_chain_append;
+--rollback DROP INDEX crypto_journal_seq_idx; DROP INDEX fiat_journal_seq_idx; ALTER TABLE crypto_journal DROP COLUMN seq, DROP COLUMN prev_hash, DROP COLUMN entry_hash; ALTER TABLE fiat_journal DROP COLUMN seq, DROP COLUMN prev_hash, DROP COLUMN entry_hash;
+--rollback DROP TRIGGER crypto_journal_balance_trigger ON crypto_journal; CREATE CONSTRAINT TRIGGER crypto_journal_balance_trigger AFTER INSERT OR UPDATE ON crypto_journal DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION crypto_journal_balance_check();
+--rollback DROP TRIGGER fiat_journal_balance_trigger ON fiat_journal; CREATE CONSTRAINT TRIGGER fiat_journal_balance_trigger AFTER INSERT OR UPDATE ON fiat_journal DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION fiat_journal_balance_check();
+--rollback DROP FUNCTION journal_entry_hash; DROP TABLE journal_checkpoints; DROP TABLE journal_chain_heads;
+
+--changeset surahman:27
+--preconditions onFail:HALT onError:HALT
+--comment: Record the compensating reversals of transactions along with the transactions they reverse.
+CREATE TABLE IF NOT EXISTS journal_reversals (
+    tx_id           UUID            PRIMARY KEY,
+    reversal_tx_id  UUID            UNIQUE NOT NULL,
+    admin_id        UUID            REFERENCES users(client_id) NOT NULL,
+    reason          VARCHAR(256)    NOT NULL,
+    reversed_at     TIMESTAMPTZ     DEFAULT now() NOT NULL
+);
+
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'TRANSACTION_REVERSE';
+--rollback DROP TABLE journal_reversals;
+
+--changeset surahman:28
+--preconditions onFail:HALT onError:HALT
+--comment: Manual adjustments of Fiat accounts that are requested by one administrator and approved by another.
+CREATE TYPE adjustment_reason AS ENUM (
+    'GOODWILL',
+    'FEE_CORRECTION',
+    'ERROR_CORRECTION',
+    'OTHER'
+);
+
+CREATE TYPE adjustment_status AS ENUM (
+    'PENDING',
+    'APPROVED',
+    'REJECTED'
+);
+
+CREATE TABLE IF NOT EXISTS fiat_adjustments (
+    adjustment_id   VARCHAR(32)         PRIMARY KEY,
+    client_id       UUID                NOT NULL,
+    currency        CURRENCY            NOT NULL,
+    amount          NUMERIC(18,2)       NOT NULL CHECK (amount <> 0),
+    reason_code     adjustment_reason   NOT NULL,
+    justification   VARCHAR(512)        NOT NULL,
+    status          adjustment_status   DEFAULT 'PENDING' NOT NULL,
+    maker_id        UUID                REFERENCES users(client_id) NOT NULL,
+    checker_id      UUID                REFERENCES users(client_id) CHECK (checker_id <> maker_id),
+    decision_note   VARCHAR(256)        DEFAULT '' NOT NULL,
+    tx_id           UUID,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    decided_at      TIMESTAMPTZ,
+    FOREIGN KEY (client_id, currency) REFERENCES fiat_accounts(client_id, currency),
+    CHECK ((status = 'PENDING') = (checker_id IS NULL AND decided_at IS NULL)),
+    CHECK ((status = 'APPROVED') = (tx_id IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS fiat_adjustments_status_idx ON fiat_adjustments USING btree (status, adjustment_id);
+CREATE INDEX IF NOT EXISTS fiat_adjustments_account_idx ON fiat_adjustments USING btree (client_id, currency);
+
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'ADJUSTMENT_CREATE';
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'ADJUSTMENT_APPROVE';
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'ADJUSTMENT_REJECT';
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'ADJUSTMENT_VIEW';
+--rollback DROP TABLE fiat_adjustments; DROP TYPE adjustment_status; DROP TYPE adjustment_reason;
+
+--changeset surahman:29
+--preconditions onFail:HALT onError:HALT
+--comment: Client-managed API keys for programmatic access with scopes, optional IP allow-lists, and expiry.
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id          VARCHAR(32)     PRIMARY KEY,
+    client_id       UUID            REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    name            VARCHAR(64)     NOT NULL,
+    secret          VARCHAR(256)    NOT NULL,
+    scopes          VARCHAR(16)[]   NOT NULL CHECK (
+                                        cardinality(scopes) > 0 AND
+                                        scopes <@ ARRAY['read', 'trade', 'transfer']::VARCHAR(16)[]),
+    allowed_ips     VARCHAR(64)[]   DEFAULT '{}' NOT NULL,
+    expires_at      TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    revoked_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_client_id_idx ON api_keys USING btree (client_id);
+--rollback DROP TABLE api_keys;
+
+--changeset surahman:30
+--preconditions onFail:HALT onError:HALT
+--comment: Hashed refresh tokens for client sessions that are rotated on every use.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_hash      VARCHAR(64)     PRIMARY KEY,
+    session_id      VARCHAR(32)     NOT NULL,
+    client_id       UUID            REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    device          VARCHAR(256)    DEFAULT '' NOT NULL,
+    ip_address      VARCHAR(64)     DEFAULT '' NOT NULL,
+    session_start   TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    expires_at      TIMESTAMPTZ     NOT NULL,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    rotated_at      TIMESTAMPTZ,
+    revoked_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_session_id_idx ON refresh_tokens USING btree (session_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_client_id_idx ON refresh_tokens USING btree (client_id);
+--rollback DROP TABLE refresh_tokens;
+
+--changeset surahman:31
+--preconditions onFail:HALT onError:HALT
+--comment: Two-factor authentication enrolments with encrypted TOTP secrets and hashed recovery codes.
+CREATE TABLE IF NOT EXISTS user_mfa (
+    client_id       UUID            PRIMARY KEY REFERENCES users(client_id) ON DELETE CASCADE,
+    secret          VARCHAR(256)    NOT NULL,
+    last_step       BIGINT          DEFAULT 0 NOT NULL,
+    recovery_codes  VARCHAR(64)[]   DEFAULT '{}' NOT NULL,
+    enrolled_at     TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL
+);
+--rollback DROP TABLE user_mfa;
+
+--changeset surahman:32
+--preconditions onFail:HALT onError:HALT
+--comment: Email address verification status and case-insensitive uniqueness of email addresses.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified BOOLEAN DEFAULT false NOT NULL;
+
+UPDATE users SET email_verified = true WHERE username IN ('fiat-currencies', 'crypto-currencies');
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key ON users USING btree (lower(email));
+--rollback DROP INDEX users_email_key; ALTER TABLE users DROP COLUMN email_verified;
+
+--changeset surahman:33
+--preconditions onFail:HALT onError:HALT
+--comment: Temporary lockouts of usernames and IP addresses after repeated failed login attempts.
+CREATE TYPE lockout_scope AS ENUM (
+    'USERNAME',
+    'IP_ADDRESS'
+);
+
+CREATE TABLE IF NOT EXISTS login_lockouts (
+    lockout_id      BIGSERIAL       PRIMARY KEY,
+    scope           lockout_scope   NOT NULL,
+    subject         VARCHAR(64)     NOT NULL,
+    failures        BIGINT          NOT NULL,
+    ip_address      VARCHAR(64)     DEFAULT '' NOT NULL,
+    locked_at       TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    expires_at      TIMESTAMPTZ     NOT NULL,
+    unlocked_by     UUID            REFERENCES users(client_id),
+    unlocked_at     TIMESTAMPTZ,
+    CHECK ((unlocked_by IS NULL) = (unlocked_at IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS login_lockouts_subject_idx ON login_lockouts USING btree (scope, subject, expires_at);
+
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'LOGIN_LOCKOUT_VIEW';
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'USER_UNLOCK';
+--rollback DROP TABLE login_lockouts; DROP TYPE lockout_scope;
+
+--changeset surahman:34
+--preconditions onFail:HALT onError:HALT
+--comment: Append-only audit log of authentication, account, and money movement events.
+CREATE TYPE audit_event_type AS ENUM (
+    'LOGIN',
+    'TOKEN_REFRESH',
+    'PASSWORD_CHANGE',
+    'PASSWORD_RESET',
+    'ACCOUNT_DELETE',
+    'ADMIN_ACTION',
+    'FIAT_DEPOSIT',
+    'FIAT_EXCHANGE',
+    'FIAT_ACCOUNT_CLOSE',
+    'CRYPTO_EXCHANGE',
+    'CRYPTO_ACCOUNT_CLOSE'
+);
+
+CREATE TYPE audit_outcome AS ENUM ('SUCCESS', 'FAILURE');
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    event_id        BIGSERIAL           PRIMARY KEY,
+    event_type      AUDIT_EVENT_TYPE    NOT NULL,
+    outcome         AUDIT_OUTCOME       NOT NULL,
+    actor_id        UUID,
+    subject_id      UUID,
+    ip_address      VARCHAR(64)         DEFAULT '' NOT NULL,
+    user_agent      VARCHAR(512)        DEFAULT '' NOT NULL,
+    payload         JSONB               DEFAULT '{}'::JSONB NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_subject_idx ON audit_events USING btree (subject_id, event_id);
+CREATE INDEX IF NOT EXISTS audit_events_created_at_idx ON audit_events USING btree (created_at);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS '
+    BEGIN
+      RAISE EXCEPTION ''audit_events_append_only: audit events cannot be %'', lower(TG_OP) || ''d''
+        USING ERRCODE = ''insufficient_privilege'';
+    END;
+';
+
+CREATE TRIGGER audit_events_append_only_trigger
+BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_events
+FOR EACH STATEMENT
+EXECUTE FUNCTION audit_events_append_only();
+
+ALTER TYPE admin_action ADD VALUE IF NOT EXISTS 'AUDIT_EVENT_VIEW';
+--rollback DROP TRIGGER audit_events_append_only_trigger ON audit_events; DROP FUNCTION audit_events_append_only;
+--rollback DROP TABLE audit_events; DROP TYPE audit_outcome; DROP TYPE audit_event_type;
+
+--changeset surahman:35
+--preconditions onFail:HALT onError:HALT
+--comment: Record the outcome of administrative actions once they have been carried out.
+ALTER TABLE admin_audit_log ADD COLUMN IF NOT EXISTS outcome AUDIT_OUTCOME;
+--rollback ALTER TABLE admin_audit_log DROP COLUMN outcome;
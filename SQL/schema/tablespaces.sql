@@ -3,3 +3,4 @@ CREATE TABLESPACE fiat_accounts_data LOCATION '/table_data/ftex_fiat_accounts';
 CREATE TABLESPACE fiat_journal_data LOCATION '/table_data/ftex_fiat_journal';
 CREATE TABLESPACE crypto_accounts_data LOCATION '/table_data/ftex_crypto_accounts';
 CREATE TABLESPACE crypto_journal_data LOCATION '/table_data/ftex_crypto_journal';
+CREATE TABLESPACE journal_archive LOCATION '/table_data/ftex_journal_archive';
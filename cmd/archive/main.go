@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/partition"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var (
+		archiver *partition.Archiver
+		count    int
+		database postgres.Postgres
+		err      error
+		logging  *logger.Logger
+	)
+
+	retainMonths := flag.Int("retain", constants.ArchiveRetainMonths(),
+		"number of closed months of journal partitions to leave in place")
+	tablespace := flag.String("tablespace", "",
+		"cold tablespace to move the closed journal partitions to")
+	directory := flag.String("export", "",
+		"directory to export the closed journal partitions to as compressed CSV files before dropping them")
+
+	flag.Parse()
+
+	if (len(*tablespace) == 0) == (len(*directory) == 0) {
+		log.Fatalf("exactly one of a tablespace or an export directory must be supplied")
+	}
+
+	// File system setup.
+	fs := afero.NewOsFs()
+
+	// Logger setup.
+	logging = logger.NewLogger()
+	if err = logging.Init(&fs); err != nil {
+		log.Fatalf("failed to initialize logger module: %v", err)
+	}
+
+	// Postgres setup.
+	if database, err = postgres.NewPostgres(&fs, logging); err != nil {
+		logging.Panic("failed to configure Postgres module", zap.Error(err))
+	}
+
+	if err = database.Open(); err != nil {
+		logging.Panic("failed open a connection to the Postgres database", zap.Error(err))
+	}
+
+	defer func() {
+		if err := database.Close(); err != nil {
+			logging.Error("failed to close the connection to the Postgres database", zap.Error(err))
+		}
+	}()
+
+	if archiver, err = partition.NewArchiver(database, fs, logging); err != nil {
+		logging.Panic("failed to create the journal partition archiver", zap.Error(err))
+	}
+
+	if len(*tablespace) > 0 {
+		count, err = archiver.MoveToTablespace(time.Now(), *retainMonths, *tablespace)
+	} else {
+		count, err = archiver.Export(time.Now(), *retainMonths, *directory)
+	}
+
+	if err != nil {
+		logging.Error("failed to archive journal partitions", zap.Int("archived", count), zap.Error(err))
+
+		return
+	}
+
+	logging.Info("archived journal partitions", zap.Int("archived", count))
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/ledger"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+func main() {
+	// The database connection is closed before exiting with a failure status.
+	if !run() {
+		os.Exit(1)
+	}
+}
+
+// run will verify the journal hash chains or export their signed checkpoints. Returns false on failure or if a
+// hash chain is broken.
+func run() bool {
+	var (
+		authorization auth.Auth
+		database      postgres.Postgres
+		err           error
+		logging       *logger.Logger
+		since         time.Time
+	)
+
+	journal := flag.String("journal", "",
+		"journal to verify or export the checkpoints of, fiat_journal or crypto_journal, defaults to both")
+	export := flag.String("export", "",
+		"file to export the signed checkpoints and public key to as JSON instead of verifying the hash chains")
+	sinceFlag := flag.String("since", "",
+		"RFC3339 time from which to export the signed checkpoints, defaults to all checkpoints")
+
+	flag.Parse()
+
+	if _, err = ledger.Journals(*journal); err != nil {
+		log.Fatalf("invalid journal: %v", err)
+	}
+
+	if len(*sinceFlag) > 0 {
+		if since, err = time.Parse(time.RFC3339, *sinceFlag); err != nil {
+			log.Fatalf("invalid export start time: %v", err)
+		}
+	}
+
+	// File system setup.
+	fs := afero.NewOsFs()
+
+	// Logger setup.
+	logging = logger.NewLogger()
+	if err = logging.Init(&fs); err != nil {
+		log.Fatalf("failed to initialize logger module: %v", err)
+	}
+
+	// Authorization setup, for the checkpoint signing key.
+	if authorization, err = auth.NewAuth(&fs, logging); err != nil {
+		logging.Panic("failed to configure authorization module", zap.Error(err))
+	}
+
+	// Postgres setup.
+	if database, err = postgres.NewPostgres(&fs, logging); err != nil {
+		logging.Panic("failed to configure Postgres module", zap.Error(err))
+	}
+
+	if err = database.Open(); err != nil {
+		logging.Panic("failed open a connection to the Postgres database", zap.Error(err))
+	}
+
+	defer func() {
+		if err := database.Close(); err != nil {
+			logging.Error("failed to close the connection to the Postgres database", zap.Error(err))
+		}
+	}()
+
+	if len(*export) > 0 {
+		return exportCheckpoints(fs, database, authorization, logging, *journal, since, *export)
+	}
+
+	verifications, err := ledger.Verify(database, authorization, *journal)
+	if err != nil {
+		logging.Error("failed to verify journal hash chains", zap.Error(err))
+
+		return false
+	}
+
+	for _, verification := range verifications {
+		if verification.Intact {
+			logging.Info("journal hash chain is intact",
+				zap.String("journal", verification.Journal),
+				zap.Int64("anchor_seq", verification.AnchorSeq),
+				zap.Int64("entries", verification.Entries),
+				zap.Int64("first_seq", verification.FirstSeq),
+				zap.Int64("last_seq", verification.LastSeq),
+				zap.String("last_hash", verification.LastHash),
+				zap.Int64("checkpoints", verification.Checkpoints),
+				zap.Int64("archived_checkpoints", verification.ArchivedCheckpoints))
+
+			continue
+		}
+
+		logging.Error("journal hash chain is broken",
+			zap.String("journal", verification.Journal),
+			zap.Int64("seq", verification.Break.Seq),
+			zap.String("tx_id", verification.Break.TxID.String()),
+			zap.String("reason", verification.Break.Reason))
+	}
+
+	return ledger.Intact(verifications)
+}
+
+// exportCheckpoints will write the signed checkpoints and the public key to verify them with to a file as JSON.
+func exportCheckpoints(fs afero.Fs, database postgres.Postgres, authorization auth.Auth, logging *logger.Logger,
+	journal string, since time.Time, path string) bool {
+	export, err := ledger.Export(database, authorization, journal, since)
+	if err != nil {
+		logging.Error("failed to export journal checkpoints", zap.Error(err))
+
+		return false
+	}
+
+	contents, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		logging.Error("failed to encode journal checkpoints", zap.Error(err))
+
+		return false
+	}
+
+	if err = afero.WriteFile(fs, path, contents, 0o600); err != nil {
+		logging.Error("failed to write journal checkpoints", zap.String("file", path), zap.Error(err))
+
+		return false
+	}
+
+	logging.Info("exported journal checkpoints",
+		zap.String("file", path), zap.Int("checkpoints", len(export.Checkpoints)))
+
+	return true
+}
@@ -7,11 +7,17 @@ import (
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/graphql"
+	"github.com/surahman/FTeX/pkg/ledger"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/matcher"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/partition"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
 	"github.com/surahman/FTeX/pkg/rest"
+	"github.com/surahman/FTeX/pkg/scheduler"
+	"github.com/surahman/FTeX/pkg/snapshot"
 	_ "go.uber.org/automaxprocs"
 	"go.uber.org/zap"
 )
@@ -37,13 +43,19 @@ func main() {
 	var (
 		authorization   auth.Auth
 		cache           redis.Redis
+		checkpointer    *ledger.Checkpointer
 		cleanup         callbacks
 		database        postgres.Postgres
 		err             error
 		logging         *logger.Logger
+		notify          notifier.Notifier
+		orderMatcher    *matcher.Matcher
+		partitioner     *partition.Partitioner
+		purchaseRunner  *scheduler.Scheduler
 		conversionRates quotes.Quotes
 		serverGraphQL   *graphql.Server
 		serverREST      *rest.Server
+		snapshotter     *snapshot.Snapshotter
 		waitGroup       sync.WaitGroup
 	)
 
@@ -94,6 +106,12 @@ func main() {
 		logging.Panic("failed to configure authorization module", zap.Error(err))
 	}
 
+	// Notifier setup.
+	if notify, err = notifier.NewNotifier(&fs, logging); err != nil {
+		cleanup.callback(logging)
+		logging.Panic("failed to configure notifier module", zap.Error(err))
+	}
+
 	// Setup is completed. Configure the cleanup callbacks to be executed on shutdown/exit.
 	defer cleanup.callback(logging)
 
@@ -101,7 +119,7 @@ func main() {
 	waitGroup.Add(1)
 
 	if serverREST, err = rest.
-		NewServer(&fs, authorization, database, cache, conversionRates, logging, &waitGroup); err != nil {
+		NewServer(&fs, authorization, database, cache, conversionRates, notify, logging, &waitGroup); err != nil {
 		logging.Panic("failed to create the REST server", zap.Error(err))
 	}
 
@@ -111,11 +129,56 @@ func main() {
 	waitGroup.Add(1)
 
 	if serverGraphQL, err = graphql.
-		NewServer(&fs, authorization, database, cache, conversionRates, logging, &waitGroup); err != nil {
+		NewServer(&fs, authorization, database, cache, conversionRates, notify, logging, &waitGroup); err != nil {
 		logging.Panic("failed to create the GraphQL server", zap.Error(err))
 	}
 
 	go serverGraphQL.Run()
 
+	// Setup limit order matcher and start it.
+	waitGroup.Add(1)
+
+	if orderMatcher, err = matcher.NewMatcher(database, conversionRates, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the limit order matcher", zap.Error(err))
+	}
+
+	go orderMatcher.Run()
+
+	// Setup recurring purchase scheduler and start it.
+	waitGroup.Add(1)
+
+	if purchaseRunner, err = scheduler.NewScheduler(database, conversionRates, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the recurring purchase scheduler", zap.Error(err))
+	}
+
+	go purchaseRunner.Run()
+
+	// Setup daily balance snapshotter and start it.
+	waitGroup.Add(1)
+
+	if snapshotter, err = snapshot.NewSnapshotter(database, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the balance snapshotter", zap.Error(err))
+	}
+
+	go snapshotter.Run()
+
+	// Setup journal partitioner and start it.
+	waitGroup.Add(1)
+
+	if partitioner, err = partition.NewPartitioner(database, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the journal partitioner", zap.Error(err))
+	}
+
+	go partitioner.Run()
+
+	// Setup journal hash chain checkpointer and start it.
+	waitGroup.Add(1)
+
+	if checkpointer, err = ledger.NewCheckpointer(database, authorization, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the journal checkpointer", zap.Error(err))
+	}
+
+	go checkpointer.Run()
+
 	waitGroup.Wait()
 }
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/rotation"
+	"go.uber.org/zap"
+)
+
+func main() {
+	generate := flag.Bool("generate", false,
+		"print a new JWT signing key, crypto secret, and checkpoint signing key to stage in the authorization configuration")
+	reencrypt := flag.Bool("reencrypt", false,
+		"re-encrypt the stored two-factor authentication and API key secrets with the active crypto secret")
+
+	flag.Parse()
+
+	if *generate == *reencrypt {
+		log.Fatalf("exactly one of -generate or -reencrypt must be supplied")
+	}
+
+	if *generate {
+		generateKeys()
+
+		return
+	}
+
+	// The database connection is closed before exiting with a failure status.
+	if !reencryptSecrets() {
+		os.Exit(1)
+	}
+}
+
+// generateKeys will print a new JWT signing key, crypto secret, and checkpoint signing key with its public key.
+func generateKeys() {
+	jwtKey, err := auth.NewJWTKey()
+	if err != nil {
+		log.Fatalf("failed to generate JWT signing key: %v", err)
+	}
+
+	cryptoSecret, err := auth.NewCryptoSecret()
+	if err != nil {
+		log.Fatalf("failed to generate crypto secret: %v", err)
+	}
+
+	checkpointKey, checkpointPublicKey, err := auth.NewCheckpointKey()
+	if err != nil {
+		log.Fatalf("failed to generate checkpoint signing key: %v", err)
+	}
+
+	fmt.Printf("jwt key:               %s\ncrypto secret:         %s\ncheckpoint key:        %s\n"+ //nolint:forbidigo
+		"checkpoint public key: %s\n", jwtKey, cryptoSecret, checkpointKey, checkpointPublicKey)
+}
+
+// reencryptSecrets will re-encrypt the stored secrets with the active crypto secret. Returns false on failure or if
+// any stored secret could not be re-encrypted.
+func reencryptSecrets() bool {
+	var (
+		authorization auth.Auth
+		database      postgres.Postgres
+		err           error
+		logging       *logger.Logger
+	)
+
+	// File system setup.
+	fs := afero.NewOsFs()
+
+	// Logger setup.
+	logging = logger.NewLogger()
+	if err = logging.Init(&fs); err != nil {
+		log.Fatalf("failed to initialize logger module: %v", err)
+	}
+
+	// Authorization setup, for the crypto secrets.
+	if authorization, err = auth.NewAuth(&fs, logging); err != nil {
+		logging.Panic("failed to configure authorization module", zap.Error(err))
+	}
+
+	// Postgres setup.
+	if database, err = postgres.NewPostgres(&fs, logging); err != nil {
+		logging.Panic("failed to configure Postgres module", zap.Error(err))
+	}
+
+	if err = database.Open(); err != nil {
+		logging.Panic("failed open a connection to the Postgres database", zap.Error(err))
+	}
+
+	defer func() {
+		if err := database.Close(); err != nil {
+			logging.Error("failed to close the connection to the Postgres database", zap.Error(err))
+		}
+	}()
+
+	report, err := rotation.Reencrypt(database, authorization, logging)
+	if err != nil {
+		logging.Error("failed to re-encrypt stored secrets", zap.Error(err))
+
+		return false
+	}
+
+	logging.Info("re-encrypted stored secrets",
+		zap.Any("mfa_secrets", report.MFASecrets),
+		zap.Any("api_key_secrets", report.APIKeySecrets))
+
+	return report.Failed() == 0
+}
@@ -30,14 +30,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/crypto/exchange/": {
-            "post": {
+        "/admin/adjustments": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Purchase or sell a Cryptocurrency to/from a Fiat currency accounts. The Offer ID must be valid and have expired.",
+                "description": "Retrieves manual adjustments, newest first. The adjustments can be restricted to those with a status of PENDING, APPROVED, or REJECTED. The initial request will only contain (optionally) the page size and status. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -45,24 +45,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto fiat currency cryptocurrency exchange convert offer transfer execute"
+                    "admin adjustments"
                 ],
-                "summary": "Transfer funds between Fiat and Crypto accounts using a valid Offer ID.",
-                "operationId": "exchangeCrypto",
+                "summary": "Retrieve manual adjustments of Fiat accounts.",
+                "operationId": "adjustmentsPaginated",
                 "parameters": [
                     {
-                        "description": "the two currency codes and amount to be converted",
-                        "name": "offerID",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPTransferRequest"
-                        }
+                        "type": "string",
+                        "description": "The status of the adjustments to retrieve.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "a page of adjustments",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -79,7 +88,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "408": {
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -92,16 +101,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/crypto/info/balance": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the Cryptocurrency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "description": "Records a pending manual credit (positive amount) or debit (negative amount) of a Fiat account with a reason code and justification. No journal entries are posted until the adjustment is approved by a different administrator. The reason code must be one of GOODWILL, FEE_CORRECTION, ERROR_CORRECTION, or OTHER. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,27 +116,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency balance"
+                    "admin adjustments"
                 ],
-                "summary": "Retrieve all the Cryptocurrency balances for a specific client.",
-                "operationId": "balanceCurrencyCryptoPaginated",
+                "summary": "Request a manual adjustment of a Fiat account.",
+                "operationId": "requestAdjustment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "the account, amount, reason code, and justification for the adjustment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminAdjustmentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "a message to with a page of account balances for the client's accounts",
+                    "201": {
+                        "description": "the pending adjustment",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -161,14 +165,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/balance/{ticker}": {
+        "/admin/adjustments/{adjustmentID}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the balance for a specific Cryptocurrency. The currency ticker must be supplied as a query parameter.",
+                "description": "Retrieves a manual adjustment along with the administrators who requested and decided it, the decision note, and the transaction ID of the posted journal entries if it was approved. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -176,22 +180,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency balance"
+                    "admin adjustments"
                 ],
-                "summary": "Retrieve balance for a specific Cryptocurrency.",
-                "operationId": "balanceCurrencyCrypto",
+                "summary": "Retrieve a manual adjustment of a Fiat account.",
+                "operationId": "adjustmentDetails",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the Cryptocurrency ticker to retrieve the balance for",
-                        "name": "ticker",
+                        "description": "the adjustment ID to retrieve",
+                        "name": "adjustmentID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the details for a specific currency account",
+                        "description": "the adjustment",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -223,14 +227,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/transaction/all/{ticker}/": {
-            "get": {
+        "/admin/adjustments/{adjustmentID}/approve": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "description": "Approves a pending manual adjustment and posts its journal entries between the Fiat account and the Fiat operations account. Adjustments must be approved by an administrator other than the one who requested them, cannot be decided more than once, and are refused if they would overdraw the account. A note must be provided and is recorded with the decision. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -238,52 +242,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency transaction"
+                    "admin adjustments"
                 ],
-                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
-                "operationId": "txDetailsCryptoPaginated",
+                "summary": "Approve a manual adjustment of a Fiat account.",
+                "operationId": "approveAdjustment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the currency ticker to retrieve the transaction details for.",
-                        "name": "ticker",
+                        "description": "the adjustment ID to approve",
+                        "name": "adjustmentID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "The timezone for the month in question.",
-                        "name": "timezone",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The month for which transaction records are being requested.",
-                        "name": "month",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The year for the month for which transaction records are being requested.",
-                        "name": "year",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "the note for the decision",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminAdjustmentDecisionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "the approved adjustment",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -294,6 +277,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "402": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "403": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -306,7 +295,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "416": {
+                    "409": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -321,14 +310,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/transaction/{transactionID}": {
-            "get": {
+        "/admin/adjustments/{adjustmentID}/reject": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
+                "description": "Rejects a pending manual adjustment without posting any journal entries. Adjustments must be rejected by an administrator other than the one who requested them and cannot be decided more than once. A note must be provided and is recorded with the decision. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -336,22 +325,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency transactionID transaction details"
+                    "admin adjustments"
                 ],
-                "summary": "Retrieve transaction details for a specific transactionID.",
-                "operationId": "txDetailsCrypto",
+                "summary": "Reject a manual adjustment of a Fiat account.",
+                "operationId": "rejectAdjustment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the transaction ID to retrieve the details for",
-                        "name": "transactionID",
+                        "description": "the adjustment ID to reject",
+                        "name": "adjustmentID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "the note for the decision",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminAdjustmentDecisionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the transaction details for a specific transaction ID",
+                        "description": "the rejected adjustment",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -374,6 +372,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -383,14 +387,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/offer": {
-            "post": {
+        "/admin/audit": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Purchase or sell a Fiat currency using a Cryptocurrency. The amount must be a positive number with at most two or eight decimal places for Fiat and Cryptocurrencies respectively. Both currency accounts must be opened beforehand.",
+                "description": "Retrieves the administrative audit log, newest first. The entries can be restricted to a specific target such as a client id, Cryptocurrency ticker, or Fiat currency code. The initial request will only contain (optionally) the page size and target. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Viewing the audit log is itself recorded in the audit log. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -398,24 +402,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat crypto cryptocurrency currency sell sale offer"
+                    "admin audit"
                 ],
-                "summary": "Purchase or sell a Cryptocurrency and using a Fiat currency.",
-                "operationId": "sellOfferCrypto",
+                "summary": "Retrieve the administrative audit log.",
+                "operationId": "auditLog",
                 "parameters": [
                     {
-                        "description": "the Cryptocurrency ticker, Fiat currency code, and amount to be converted in the source currency",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPCryptoOfferRequest"
-                        }
+                        "type": "string",
+                        "description": "The target of the administrative actions to retrieve.",
+                        "name": "target",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the purchase rate for a Fiat or Cryptocurrency",
+                        "description": "a page of audit log entries",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -432,6 +445,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -441,14 +460,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/open": {
-            "post": {
+        "/admin/audit/events": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Creates a Cryptocurrency account for a specified ticker, to be provided as the currency in the request, for a user by creating a row in the Crypto Accounts table.",
+                "description": "Retrieves the security audit log of authentication, account, and money movement events, newest first. The events can be restricted to those about a specific client, of a specific type, or with a specific outcome. The initial request will only contain (optionally) the page size and filters. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Viewing the security audit log is recorded in the administrative audit log. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -456,24 +475,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency open"
+                    "admin audit security"
                 ],
-                "summary": "Open a Cryptocurrency account.",
-                "operationId": "openCrypto",
+                "summary": "Retrieve the security audit log.",
+                "operationId": "auditEvents",
                 "parameters": [
                     {
-                        "description": "cryptocurrency ticker for new account",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
-                        }
+                        "type": "string",
+                        "description": "The client id the events are about.",
+                        "name": "clientID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The type of the events to retrieve.",
+                        "name": "eventType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The outcome of the events to retrieve: SUCCESS or FAILURE.",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "a message to confirm the creation of an account",
+                    "200": {
+                        "description": "a page of security audit events",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -490,6 +530,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -499,14 +545,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/deposit": {
-            "post": {
+        "/admin/crypto/assets": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deposit funds into a Fiat account in a specific currency for a user. The amount must be a positive number with at most two decimal places.",
+                "description": "Registers a Cryptocurrency, or updates an existing one, with its display name, precision, trading status, and order size limits. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -514,24 +560,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency deposit"
+                    "admin crypto cryptocurrency assets registry"
                 ],
-                "summary": "Deposit funds into a Fiat account.",
-                "operationId": "depositFiat",
+                "summary": "Register or update a Cryptocurrency.",
+                "operationId": "upsertCryptoAsset",
                 "parameters": [
                     {
-                        "description": "currency code and amount to be deposited",
+                        "description": "the Cryptocurrency registry details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPDepositCurrencyRequest"
+                            "$ref": "#/definitions/models.HTTPCryptoAssetRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the deposit of funds",
+                        "description": "the registered Cryptocurrency details",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -548,6 +594,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -557,14 +609,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/exchange/offer": {
-            "post": {
+        "/admin/crypto/assets/{ticker}/status": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Exchange quote for Fiat funds between two Fiat currencies. The amount must be a positive number with at most two decimal places and both currency accounts must be opened.",
+                "description": "Enables or halts trading for a registered Cryptocurrency. Balances in halted Cryptocurrencies remain accessible, but accounts cannot be opened, and offers cannot be issued or executed. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -572,24 +624,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency exchange convert offer transfer"
+                    "admin crypto cryptocurrency assets registry halt"
                 ],
-                "summary": "Exchange quote for Fiat funds between two Fiat currencies.",
-                "operationId": "exchangeOfferFiat",
+                "summary": "Enable or halt trading for a Cryptocurrency.",
+                "operationId": "statusCryptoAsset",
                 "parameters": [
                     {
-                        "description": "the two currency code and amount to be converted",
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to update the trading status for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the trading status",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPExchangeOfferRequest"
+                            "$ref": "#/definitions/models.HTTPCryptoAssetStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion rate for a currency",
+                        "description": "a message to confirm the trading status update",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -606,6 +665,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -615,14 +680,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/exchange/transfer": {
-            "post": {
+        "/admin/fiat/currencies": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Transfer Fiat funds between two Fiat currencies. The Offer ID must be valid and have expired.",
+                "description": "Registers a Fiat currency, or updates the circulation status of an existing one. Deprecated currencies no longer accept new accounts, and withdrawn currencies can no longer be transacted in. Balances in all registered currencies remain readable. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -630,24 +695,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency exchange convert offer transfer execute"
+                    "admin fiat currency currencies reference"
                 ],
-                "summary": "Transfer Fiat funds between two Fiat currencies using a valid Offer ID.",
-                "operationId": "exchangeTransferFiat",
+                "summary": "Register a Fiat currency or update its circulation status.",
+                "operationId": "upsertFiatCurrency",
                 "parameters": [
                     {
-                        "description": "the two currency codes and amount to be converted",
-                        "name": "offerID",
+                        "description": "the Fiat currency code and circulation status",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPTransferRequest"
+                            "$ref": "#/definitions/models.HTTPFiatCurrencyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "a message to confirm the Fiat currency update",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -664,7 +729,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "408": {
+                    "409": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -679,14 +744,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/balance": {
+        "/admin/ledger/checkpoints": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the currency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "description": "Retrieves the signed checkpoints of the Fiat or Crypto journal hash chain heads, or both if no journal is specified, recorded at or after a Unix timestamp. The public key and the signed message of each checkpoint are included so that auditors can verify the checkpoints independently. Viewing the checkpoints is recorded in the audit log. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -694,27 +759,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency balance"
+                    "admin ledger"
                 ],
-                "summary": "Retrieve all the currency balances for a specific client.",
-                "operationId": "balanceCurrencyFiatPaginated",
+                "summary": "Retrieve the signed journal hash chain checkpoints.",
+                "operationId": "ledgerCheckpoints",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
+                        "description": "The journal to retrieve the checkpoints of, fiat_journal or crypto_journal.",
+                        "name": "journal",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
+                        "description": "The Unix timestamp from which to retrieve the checkpoints.",
+                        "name": "since",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to with a page of account balances for the client's accounts",
+                        "description": "the signed checkpoints and the public key to verify them with",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -731,12 +796,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -746,14 +805,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/balance/{ticker}": {
+        "/admin/ledger/verify": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the balance for a specific Fiat currency. The currency ticker must be supplied as a query parameter.",
+                "description": "Walks the hash chain of the Fiat or Crypto journal, or both if no journal is specified, and verifies each entry and the signed checkpoints. The first broken link in each journal that is not intact is reported. Verification is recorded in the audit log. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -761,22 +820,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency balance"
+                    "admin ledger"
                 ],
-                "summary": "Retrieve balance for a specific Fiat currency.",
-                "operationId": "balanceCurrencyFiat",
+                "summary": "Verify the journal hash chains.",
+                "operationId": "ledgerVerify",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the currency ticker to retrieve the balance for",
-                        "name": "ticker",
-                        "in": "path",
-                        "required": true
+                        "description": "The journal to verify, fiat_journal or crypto_journal.",
+                        "name": "journal",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the details for a specific currency account",
+                        "description": "the verification of each journal hash chain",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -793,12 +851,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -808,14 +860,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/transaction/all/{currencyCode}/": {
+        "/admin/lockouts": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "description": "Retrieves the most recent lockouts of usernames and IP addresses after repeated failed login attempts, newest first. The lockouts can be restricted to those that are still in effect. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -823,52 +875,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency transaction"
+                    "admin users login lockout"
                 ],
-                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
-                "operationId": "txDetailsCurrencyFiatPaginated",
+                "summary": "Retrieve the most recent login lockouts.",
+                "operationId": "loginLockouts",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the currency code to retrieve the transaction details for.",
-                        "name": "currencyCode",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "The timezone for the month in question.",
-                        "name": "timezone",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The month for which transaction records are being requested.",
-                        "name": "month",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The year for the month for which transaction records are being requested.",
-                        "name": "year",
+                        "type": "boolean",
+                        "description": "whether to only return lockouts that are still in effect",
+                        "name": "active",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
+                        "description": "the maximum number of lockouts to return",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "the most recent login lockouts",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -885,18 +912,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
-                    "416": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -906,14 +921,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/transaction/{transactionID}": {
-            "get": {
+        "/admin/transactions/{transactionID}/reverse": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
+                "description": "Posts equal and opposite journal entries for a Fiat or Cryptocurrency transaction made in error and adjusts the account balances. The reversal is linked to the original transaction, which is marked as reversed in its transaction details. Transactions can only be reversed once, reversals cannot be reversed, and reversals that would overdraw an account are refused. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -921,22 +936,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat transactionID transaction details"
+                    "admin transactions reversal"
                 ],
-                "summary": "Retrieve transaction details for a specific transactionID.",
-                "operationId": "txDetailsCurrencyFiat",
+                "summary": "Reverse a transaction.",
+                "operationId": "reverseTransaction",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the transaction ID to retrieve the details for",
+                        "description": "the transaction ID to reverse",
                         "name": "transactionID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "the reason for the reversal",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminReversalRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the transaction details for a specific transaction ID",
+                        "description": "the reversal and its journal entries",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -947,6 +971,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "402": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "403": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -959,6 +989,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -968,14 +1004,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/open": {
-            "post": {
+        "/admin/users/search": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Creates a Fiat account for a specific currency for a user by creating a row in the Fiat Accounts table.",
+                "description": "Searches for user accounts with a client id, username, name, or email address matching the query. Login credentials are never returned. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -983,24 +1019,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency open"
+                    "admin users search"
                 ],
-                "summary": "Open a Fiat account.",
-                "operationId": "openFiat",
+                "summary": "Search for user accounts.",
+                "operationId": "searchUsers",
                 "parameters": [
                     {
-                        "description": "currency code for new account",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
-                        }
+                        "type": "string",
+                        "description": "the client id, or partial username, name, or email address to search for",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the maximum number of user accounts to return",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "a message to confirm the creation of an account",
+                    "200": {
+                        "description": "the matching user accounts",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -1017,35 +1057,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "500": {
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "This endpoint is exposed to allow load balancers etc. to check the health of the service.\nThis is achieved by the service pinging the data tier comprised of Postgres and Redis.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health healthcheck liveness"
-                ],
-                "summary": "Healthcheck for service liveness.",
-                "operationId": "healthcheck",
-                "responses": {
-                    "200": {
-                        "description": "message: healthy",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPSuccess"
-                        }
                     },
-                    "503": {
-                        "description": "error message with any available details",
+                    "500": {
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
@@ -1053,14 +1072,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/delete": {
-            "delete": {
+        "/admin/users/{clientID}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deletes a user stored in the database by marking it as deleted. The user must supply their login credentials as well as complete the following confirmation message:\n\"I understand the consequences, delete my user account USERNAME HERE\"",
+                "description": "Retrieves the profile, role, and status of a user account. Login credentials are never returned. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1068,24 +1087,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user users delete security"
+                    "admin users"
                 ],
-                "summary": "Deletes a user. The user must supply their credentials as well as a confirmation message.",
-                "operationId": "deleteUser",
+                "summary": "Retrieve a user account's profile.",
+                "operationId": "viewUser",
                 "parameters": [
                     {
-                        "description": "The request payload for deleting an account",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPDeleteUserRequest"
-                        }
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "message with a confirmation of a deleted user account",
+                    "200": {
+                        "description": "the user account's profile",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -1102,6 +1119,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -1111,9 +1134,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/login": {
-            "post": {
-                "description": "Logs in a user by validating credentials and returning a JWT.",
+        "/admin/users/{clientID}/crypto/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the Cryptocurrency account balances for a user account. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Requires the administrative read scope.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1121,26 +1149,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user users login security"
+                    "admin users crypto cryptocurrency balance"
                 ],
-                "summary": "Login a user.",
-                "operationId": "loginUser",
+                "summary": "Retrieve all the Cryptocurrency account balances for a user account.",
+                "operationId": "balanceCryptoUser",
                 "parameters": [
                     {
-                        "description": "Username and password to login with",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UserLoginCredentials"
-                        }
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a valid JWT token for the new account",
+                        "description": "a page of account balances for the user's accounts",
                         "schema": {
-                            "$ref": "#/definitions/models.JWTAuthResponse"
+                            "$ref": "#/definitions/models.HTTPSuccess"
                         }
                     },
                     "400": {
@@ -1149,7 +1187,13 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "409": {
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -1164,30 +1208,3991 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/refresh": {
-            "post": {
+        "/admin/users/{clientID}/crypto/transactions/{ticker}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Refreshes a user's JWT by validating it and then issuing a fresh JWT with an extended validity time. JWT must be expiring in under 60 seconds.",
+                "description": "Retrieves the Cryptocurrency journal entries for a user account during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. Requires the administrative read scope.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user users login refresh security"
+                    "admin users crypto cryptocurrency transaction journal"
                 ],
-                "summary": "Refresh a user's JWT by extending its expiration time.",
-                "operationId": "loginRefresh",
-                "responses": {
-                    "200": {
-                        "description": "A new valid JWT",
-                        "schema": {
-                            "$ref": "#/definitions/models.JWTAuthResponse"
-                        }
-                    },
-                    "403": {
+                "summary": "Retrieve all the transactions for a Cryptocurrency account of a user account during a specified month.",
+                "operationId": "txDetailsCryptoUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to retrieve the transaction details for.",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a page of journal entries for the account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{clientID}/crypto/{ticker}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sets the status of a user's Cryptocurrency account. Active accounts can be credited and debited, accounts frozen for debits can only be credited, and frozen accounts can neither be credited nor debited. Closed accounts cannot be updated. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin users crypto cryptocurrency account status freeze"
+                ],
+                "summary": "Set the status of a user's Cryptocurrency account.",
+                "operationId": "statusCryptoAccount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker of the account",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the account status and the reason for the change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminAccountStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the account status update",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{clientID}/fiat/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the Fiat currency account balances for a user account. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Requires the administrative read scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin users fiat currency balance"
+                ],
+                "summary": "Retrieve all the Fiat currency account balances for a user account.",
+                "operationId": "balanceFiatUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a page of account balances for the user's accounts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{clientID}/fiat/transactions/{currencyCode}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the Fiat currency journal entries for a user account during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. Requires the administrative read scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin users fiat currency transaction journal"
+                ],
+                "summary": "Retrieve all the transactions for a Fiat currency account of a user account during a specified month.",
+                "operationId": "txDetailsFiatUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the currency code to retrieve the transaction details for.",
+                        "name": "currencyCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a page of journal entries for the account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{clientID}/fiat/{currencyCode}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sets the status of a user's Fiat currency account. Active accounts can be credited and debited, accounts frozen for debits can only be credited, and frozen accounts can neither be credited nor debited. Closed accounts cannot be updated. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin users fiat currency account status freeze"
+                ],
+                "summary": "Set the status of a user's Fiat currency account.",
+                "operationId": "statusFiatAccount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the currency code of the account",
+                        "name": "currencyCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the account status and the reason for the change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminAccountStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the account status update",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{clientID}/freeze": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Freezes or unfreezes a user account. Frozen users cannot log in, refresh their tokens, or access any authenticated endpoints. A reason must be provided and is recorded in the audit log. Administrators cannot freeze their own accounts. Requires the administrative write scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin users freeze"
+                ],
+                "summary": "Freeze or unfreeze a user account.",
+                "operationId": "freezeUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the freeze status and the reason for the change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminFreezeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the freeze status update",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{clientID}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Lifts the lockout of a user account that was locked out after repeated failed login attempts and resets its failed attempts. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin users login lockout"
+                ],
+                "summary": "Lift the login lockout of a user account.",
+                "operationId": "unlockUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the client id of the user account",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the reason for lifting the lockout",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAdminUnlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the lockout has been lifted",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/assets": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the registry of supported Cryptocurrencies with their precision, trading status, and order size limits.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency assets registry"
+                ],
+                "summary": "Retrieve the supported Cryptocurrencies.",
+                "operationId": "assetsCrypto",
+                "responses": {
+                    "200": {
+                        "description": "the registered Cryptocurrencies",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/close": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Closes a Cryptocurrency account. The account balance must be zero unless a sweep currency is supplied, in which case the remaining balance is sold and the proceeds deposited into the sweep Fiat currency account. Closed accounts can be reopened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency close sweep"
+                ],
+                "summary": "Close a Cryptocurrency account.",
+                "operationId": "closeCrypto",
+                "parameters": [
+                    {
+                        "description": "Cryptocurrency ticker of the account and optional sweep Fiat currency",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPCloseCryptoAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the closure of an account with any sweep receipts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/exchange/": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Purchase or sell a Cryptocurrency to/from a Fiat currency accounts. The Offer ID must be valid and have expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto fiat currency cryptocurrency exchange convert offer transfer execute"
+                ],
+                "summary": "Transfer funds between Fiat and Crypto accounts using a valid Offer ID.",
+                "operationId": "exchangeCrypto",
+                "parameters": [
+                    {
+                        "description": "the two currency codes and amount to be converted",
+                        "name": "offerID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "402": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "408": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the Cryptocurrency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency balance"
+                ],
+                "summary": "Retrieve all the Cryptocurrency balances for a specific client.",
+                "operationId": "balanceCurrencyCryptoPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of account balances for the client's accounts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/balance/{ticker}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the balance for a specific Cryptocurrency. The currency ticker must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency balance"
+                ],
+                "summary": "Retrieve balance for a specific Cryptocurrency.",
+                "operationId": "balanceCurrencyCrypto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to retrieve the balance for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the details for a specific currency account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/balance/{ticker}/as-of": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the balance for a specific Cryptocurrency at a point in time. The point in time is a UNIX timestamp in seconds that cannot be in the future. The balance is computed from the nearest daily closing balance snapshot at or before the point in time and the journal entries posted since.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency balance history"
+                ],
+                "summary": "Retrieve the balance for a specific Cryptocurrency at a point in time.",
+                "operationId": "balanceAsOfCrypto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to retrieve the balance for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the UNIX timestamp in seconds to retrieve the balance at",
+                        "name": "timestamp",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the balance at the point in time",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/balance/{ticker}/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the daily closing balances for a specific Cryptocurrency within a time range, oldest first. The start and end of the range are UNIX timestamps in seconds. The range ends now and starts 30 days before its end if they are not supplied, and cannot be longer than 366 days.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency balance history"
+                ],
+                "summary": "Retrieve the daily closing balances for a specific Cryptocurrency.",
+                "operationId": "balanceHistoryCrypto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to retrieve the balance history for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the UNIX timestamp in seconds of the start of the range",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the UNIX timestamp in seconds of the end of the range",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the daily closing balances within the range",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/transaction/all/{ticker}/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency transaction"
+                ],
+                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
+                "operationId": "txDetailsCryptoPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency ticker to retrieve the transaction details for.",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/transaction/{transactionID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency transactionID transaction details"
+                ],
+                "summary": "Retrieve transaction details for a specific transactionID.",
+                "operationId": "txDetailsCrypto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the transaction ID to retrieve the details for",
+                        "name": "transactionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the transaction details for a specific transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/offer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Purchase or sell a Fiat currency using a Cryptocurrency. The amount must be a positive number with at most two decimal places for Fiat currencies, or the registered precision for Cryptocurrencies. Cryptocurrency amounts must fall within the registered order limits. Both currency accounts must be opened beforehand. The source funds can optionally be held for the lifetime of the offer, which reduces the available balance of the source account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat crypto cryptocurrency currency sell sale offer"
+                ],
+                "summary": "Purchase or sell a Cryptocurrency and using a Fiat currency.",
+                "operationId": "sellOfferCrypto",
+                "parameters": [
+                    {
+                        "description": "the Cryptocurrency ticker, Fiat currency code, and amount to be converted in the source currency",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPCryptoOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the purchase rate for a Fiat or Cryptocurrency",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "402": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/open": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a Cryptocurrency account for a specified ticker, to be provided as the currency in the request, for a user by creating a row in the Crypto Accounts table.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency open"
+                ],
+                "summary": "Open a Cryptocurrency account.",
+                "operationId": "openCrypto",
+                "parameters": [
+                    {
+                        "description": "cryptocurrency ticker for new account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the creation of an account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/orders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the Cryptocurrency limit orders placed by a client, newest first. The orders can optionally be restricted to a status of OPEN, FILLED, CANCELLED, EXPIRED, or FAILED. Subsequent requests will require a cursor to the next page that will be returned in a previous call to the endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency limit order"
+                ],
+                "summary": "Retrieve the Cryptocurrency limit orders for a client.",
+                "operationId": "limitOrdersPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The status of the orders to retrieve.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a page of limit orders",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Places a limit order to purchase or sell an amount of a Cryptocurrency once its price in a Fiat currency reaches the limit price. Purchases fill at or below the limit price and sales fill at or above it. The Fiat funds required at the limit price, or the Cryptocurrency being sold, are held until the order is filled, cancelled, or expires. The expiry is a Unix timestamp that must fall within thirty days.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency limit order"
+                ],
+                "summary": "Place a Cryptocurrency limit order.",
+                "operationId": "placeLimitOrder",
+                "parameters": [
+                    {
+                        "description": "the Cryptocurrency ticker, Fiat currency code, amount, limit price, and expiry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPLimitOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "the limit order that was placed",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "402": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/orders/{orderID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a Cryptocurrency limit order along with the history of its state changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency limit order details"
+                ],
+                "summary": "Retrieve a Cryptocurrency limit order.",
+                "operationId": "limitOrderDetails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the limit order ID to retrieve the details for",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the limit order and its state history",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancels an open Cryptocurrency limit order and releases the funds held for it. Orders that have been filled, cancelled, expired, or failed cannot be cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency limit order cancel"
+                ],
+                "summary": "Cancel a Cryptocurrency limit order.",
+                "operationId": "cancelLimitOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the limit order ID to cancel",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the cancellation of the order",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/recurring": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the recurring Cryptocurrency purchase plans scheduled by a client, newest first. The plans can optionally be restricted to a status of ACTIVE, CANCELLED, or COMPLETED. Subsequent requests will require a cursor to the next page that will be returned in a previous call to the endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency recurring purchase"
+                ],
+                "summary": "Retrieve the recurring Cryptocurrency purchase plans for a client.",
+                "operationId": "recurringPurchasesPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The status of the plans to retrieve.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a page of recurring purchase plans",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Schedules purchases of a Cryptocurrency for a fixed amount of a Fiat currency at a DAILY, WEEKLY, BIWEEKLY, or MONTHLY cadence. The start and end times are UNIX timestamps in seconds. Plans without a start time make their first purchase immediately and plans without an end time run until they are cancelled. No funds are held and each purchase is paid for from the available balance of the Fiat account when it runs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency recurring purchase schedule"
+                ],
+                "summary": "Schedule recurring purchases of a Cryptocurrency.",
+                "operationId": "scheduleRecurringPurchase",
+                "parameters": [
+                    {
+                        "description": "the Fiat currency code, Cryptocurrency ticker, Fiat amount per purchase, cadence, and optional start and end times",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPRecurringPurchaseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "the recurring purchase plan that was scheduled",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/recurring/{planID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a recurring Cryptocurrency purchase plan along with a page of its runs, newest first. Each run records whether the purchase was EXECUTED, along with its receipt, or was not made due to INSUFFICIENT_FUNDS, being SKIPPED, or having FAILED. Subsequent requests will require a cursor to the next page of runs that will be returned in a previous call to the endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency recurring purchase details"
+                ],
+                "summary": "Retrieve a recurring Cryptocurrency purchase plan.",
+                "operationId": "recurringPurchaseDetails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the recurring purchase plan ID to retrieve the details for",
+                        "name": "planID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the recurring purchase plan and a page of its runs",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancels an active recurring Cryptocurrency purchase plan. Plans that have been completed or cancelled cannot be cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency recurring purchase cancel"
+                ],
+                "summary": "Cancel a recurring Cryptocurrency purchase plan.",
+                "operationId": "cancelRecurringPurchase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the recurring purchase plan ID to cancel",
+                        "name": "planID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the cancellation of the plan",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/triggers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the Cryptocurrency trigger orders placed by a client, newest first. The orders can optionally be restricted to a status of ACTIVE, TRIGGERED, CANCELLED, or FAILED. Subsequent requests will require a cursor to the next page that will be returned in a previous call to the endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency trigger order"
+                ],
+                "summary": "Retrieve the Cryptocurrency trigger orders for a client.",
+                "operationId": "triggerOrdersPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The status of the orders to retrieve.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a page of trigger orders",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Places a STOP_LOSS or TAKE_PROFIT order to sell an amount of a Cryptocurrency position once its price in a Fiat currency crosses the trigger price. Stop-loss orders sell at or below the trigger price and take-profit orders sell at or above it. An amount of zero, or an omitted amount, sells the entire position available when the order is triggered. Stop-loss orders may specify a trailing distance in the Fiat currency, which raises the trigger price behind rising market prices but never lowers it. No funds are held and orders remain active until they are triggered or cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency trigger order stop-loss take-profit"
+                ],
+                "summary": "Place a Cryptocurrency trigger order.",
+                "operationId": "placeTriggerOrder",
+                "parameters": [
+                    {
+                        "description": "the Cryptocurrency ticker, Fiat currency code, trigger type and price, and optional trailing distance and amount",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPTriggerOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "the trigger order that was placed",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/triggers/{orderID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a Cryptocurrency trigger order along with the history of its state changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency trigger order details"
+                ],
+                "summary": "Retrieve a Cryptocurrency trigger order.",
+                "operationId": "triggerOrderDetails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the trigger order ID to retrieve the details for",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the trigger order and its state history",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancels an active Cryptocurrency trigger order. Orders that have been triggered, cancelled, or failed cannot be cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency trigger order cancel"
+                ],
+                "summary": "Cancel a Cryptocurrency trigger order.",
+                "operationId": "cancelTriggerOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the trigger order ID to cancel",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the cancellation of the order",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/close": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Closes a Fiat account for a specific currency. The account balance must be zero unless a sweep currency is supplied, in which case the remaining balance is converted and deposited into the sweep currency account. Closed accounts can be reopened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency close sweep"
+                ],
+                "summary": "Close a Fiat account.",
+                "operationId": "closeFiat",
+                "parameters": [
+                    {
+                        "description": "currency code of the account and optional sweep currency",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPCloseFiatAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the closure of an account with any sweep receipts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/currencies": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the Fiat currency reference table. Accounts may only be opened in active currencies, deprecated currencies may still be transacted in, and balances in withdrawn currencies remain readable.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency currencies reference"
+                ],
+                "summary": "Retrieve the Fiat currencies and their circulation status.",
+                "operationId": "currenciesFiat",
+                "responses": {
+                    "200": {
+                        "description": "the Fiat currencies",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/deposit": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deposit funds into a Fiat account in a specific currency for a user. The amount must be a positive number with at most two decimal places.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency deposit"
+                ],
+                "summary": "Deposit funds into a Fiat account.",
+                "operationId": "depositFiat",
+                "parameters": [
+                    {
+                        "description": "currency code and amount to be deposited",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPDepositCurrencyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the deposit of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/exchange/offer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Exchange quote for Fiat funds between two Fiat currencies. The amount must be a positive number with at most two decimal places and both currency accounts must be opened. The source funds can optionally be held for the lifetime of the offer, which reduces the available balance of the source account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency exchange convert offer transfer"
+                ],
+                "summary": "Exchange quote for Fiat funds between two Fiat currencies.",
+                "operationId": "exchangeOfferFiat",
+                "parameters": [
+                    {
+                        "description": "the two currency code and amount to be converted",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPExchangeOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion rate for a currency",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "402": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/exchange/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Transfer Fiat funds between two Fiat currencies. The Offer ID must be valid and have expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency exchange convert offer transfer execute"
+                ],
+                "summary": "Transfer Fiat funds between two Fiat currencies using a valid Offer ID.",
+                "operationId": "exchangeTransferFiat",
+                "parameters": [
+                    {
+                        "description": "the two currency codes and amount to be converted",
+                        "name": "offerID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "408": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the currency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency balance"
+                ],
+                "summary": "Retrieve all the currency balances for a specific client.",
+                "operationId": "balanceCurrencyFiatPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of account balances for the client's accounts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/balance/{ticker}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the balance for a specific Fiat currency. The currency ticker must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency balance"
+                ],
+                "summary": "Retrieve balance for a specific Fiat currency.",
+                "operationId": "balanceCurrencyFiat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency ticker to retrieve the balance for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the details for a specific currency account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/balance/{ticker}/as-of": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the balance for a specific Fiat currency at a point in time. The point in time is a UNIX timestamp in seconds that cannot be in the future. The balance is computed from the nearest daily closing balance snapshot at or before the point in time and the journal entries posted since.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency balance history"
+                ],
+                "summary": "Retrieve the balance for a specific Fiat currency at a point in time.",
+                "operationId": "balanceAsOfFiat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency ticker to retrieve the balance for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the UNIX timestamp in seconds to retrieve the balance at",
+                        "name": "timestamp",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the balance at the point in time",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/balance/{ticker}/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the daily closing balances for a specific Fiat currency within a time range, oldest first. The start and end of the range are UNIX timestamps in seconds. The range ends now and starts 30 days before its end if they are not supplied, and cannot be longer than 366 days.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency balance history"
+                ],
+                "summary": "Retrieve the daily closing balances for a specific Fiat currency.",
+                "operationId": "balanceHistoryFiat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency ticker to retrieve the balance history for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the UNIX timestamp in seconds of the start of the range",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the UNIX timestamp in seconds of the end of the range",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the daily closing balances within the range",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/transaction/all/{currencyCode}/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency transaction"
+                ],
+                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
+                "operationId": "txDetailsCurrencyFiatPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency code to retrieve the transaction details for.",
+                        "name": "currencyCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/transaction/{transactionID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat transactionID transaction details"
+                ],
+                "summary": "Retrieve transaction details for a specific transactionID.",
+                "operationId": "txDetailsCurrencyFiat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the transaction ID to retrieve the details for",
+                        "name": "transactionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the transaction details for a specific transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/open": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a Fiat account for a specific currency for a user by creating a row in the Fiat Accounts table.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency open"
+                ],
+                "summary": "Open a Fiat account.",
+                "operationId": "openFiat",
+                "parameters": [
+                    {
+                        "description": "currency code for new account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the creation of an account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "This endpoint is exposed to allow load balancers etc. to check the health of the service.\nThis is achieved by the service pinging the data tier comprised of Postgres and Redis.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health healthcheck liveness"
+                ],
+                "summary": "Healthcheck for service liveness.",
+                "operationId": "healthcheck",
+                "responses": {
+                    "200": {
+                        "description": "message: healthy",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "503": {
+                        "description": "error message with any available details",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the details of all the API keys a client has created, including revoked and expired keys, newest first. Secrets are never returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users api-key api-keys details"
+                ],
+                "summary": "Retrieve API keys.",
+                "operationId": "apiKeys",
+                "responses": {
+                    "200": {
+                        "description": "the details of the API keys",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates an API key for programmatic access with the read, trade, and/or transfer scopes. Keys may be restricted to a list of IP addresses and CIDR ranges and may expire at a Unix timestamp. The secret used to sign requests is only returned in the response to this request. API keys cannot be used to manage API keys.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users api-key api-keys create"
+                ],
+                "summary": "Create an API key.",
+                "operationId": "createAPIKey",
+                "parameters": [
+                    {
+                        "description": "the name, scopes, and optional IP allow-list and expiry of the API key",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAPIKeyRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code when two-factor authentication is enabled",
+                        "name": "X-OTP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "the API key details and its secret",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/api-keys/{keyID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes an active API key. Requests signed with a revoked key are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users api-key api-keys revoke"
+                ],
+                "summary": "Revoke an API key.",
+                "operationId": "revokeAPIKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the API key ID to revoke",
+                        "name": "keyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the revocation of the API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user stored in the database by marking it as deleted. The user must supply their login credentials as well as complete the following confirmation message:\n\"I understand the consequences, delete my user account USERNAME HERE\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users delete security"
+                ],
+                "summary": "Deletes a user. The user must supply their credentials as well as a confirmation message.",
+                "operationId": "deleteUser",
+                "parameters": [
+                    {
+                        "description": "The request payload for deleting an account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPDeleteUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code when two-factor authentication is enabled",
+                        "name": "X-OTP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "message with a confirmation of a deleted user account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/email/verification": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mails a new verification link to the unverified email address on a user account. The link expires after twenty-four hours.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users profile email"
+                ],
+                "summary": "Request an email address verification link.",
+                "operationId": "sendEmailVerification",
+                "responses": {
+                    "202": {
+                        "description": "a message to confirm the verification link has been sent",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/email/verify": {
+            "get": {
+                "description": "Marks the email address in a signed verification link as verified. Links that have expired or were sent to an email address that has since been changed are rejected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users profile email"
+                ],
+                "summary": "Verify an email address.",
+                "operationId": "verifyEmail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the signed email verification token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the email address has been verified",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "Logs in a user by validating credentials and returning a JWT. A session is started on the device the user logged in from and a refresh token is returned along with the JWT. Repeated failed attempts on a username or from an IP address are progressively delayed and then temporarily locked out, and rejected attempts report when to retry in the Retry-After header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login security"
+                ],
+                "summary": "Login a user.",
+                "operationId": "loginUser",
+                "parameters": [
+                    {
+                        "description": "Username and password to login with",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserLoginCredentials"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code when two-factor authentication is enabled",
+                        "name": "X-OTP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a valid JWT token for the new account",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "error message with the seconds to wait before retrying in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes the JWT the request is authorized with. The JWT will be rejected for the remainder of its validity interval and cannot be refreshed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users logout security"
+                ],
+                "summary": "Log out of a session.",
+                "operationId": "logoutUser",
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the session has been logged out of",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes all the sessions of the user and all the JWTs issued to the user up to and including the current second. The JWTs will be rejected for the remainder of their validity intervals and cannot be refreshed, and the refresh tokens of the sessions cannot be exchanged.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users logout security"
+                ],
+                "summary": "Log out of all sessions.",
+                "operationId": "logoutAllSessions",
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm all sessions have been logged out of",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Disables two-factor authentication and discards the TOTP secret and recovery codes. A one-time password or an unused recovery code must be provided in the X-OTP header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa two-factor disable security"
+                ],
+                "summary": "Disable two-factor authentication.",
+                "operationId": "disableMFA",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code",
+                        "name": "X-OTP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm two-factor authentication has been disabled",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Enables two-factor authentication by verifying a one-time password generated with the TOTP secret of a pending enrolment. The single-use recovery codes are only returned in the response to this request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa two-factor confirm security"
+                ],
+                "summary": "Enable two-factor authentication.",
+                "operationId": "confirmMFA",
+                "parameters": [
+                    {
+                        "description": "the one-time password to confirm the enrolment with",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPMFAConfirmRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the recovery codes",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generates a TOTP secret and the otpauth URI to add it to an authenticator application with. Two-factor authentication is only enabled once the enrolment is confirmed with a one-time password. A pending enrolment is replaced by a new request.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa two-factor enroll security"
+                ],
+                "summary": "Start a two-factor authentication enrolment.",
+                "operationId": "enrollMFA",
+                "responses": {
+                    "201": {
+                        "description": "the TOTP secret and otpauth URI",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/recovery-codes": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces all the recovery codes for two-factor authentication. A one-time password or an unused recovery code must be provided in the X-OTP header. The new recovery codes are only returned in the response to this request.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa two-factor recovery-codes security"
+                ],
+                "summary": "Replace two-factor authentication recovery codes.",
+                "operationId": "recoveryCodesMFA",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code",
+                        "name": "X-OTP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the new recovery codes",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces the password of a user account. The current password must be supplied and the new password must differ from it. All the sessions of the user are revoked and all the JWTs issued to the user, including the JWT the request is authorized with, are rejected once the password has been changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users password security"
+                ],
+                "summary": "Change the password of a user account.",
+                "operationId": "changePassword",
+                "parameters": [
+                    {
+                        "description": "the current and new passwords",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPChangePasswordRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code when two-factor authentication is enabled",
+                        "name": "X-OTP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the password has been changed",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password/reset": {
+            "post": {
+                "description": "Replaces the password of a user account using a password reset token. The token can only be used once. All the sessions of the user are revoked and all the JWTs issued to the user are rejected once the password has been reset.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users password reset security"
+                ],
+                "summary": "Reset the password of a user account.",
+                "operationId": "resetPassword",
+                "parameters": [
+                    {
+                        "description": "the password reset token and the new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPPasswordResetConfirmRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code when two-factor authentication is enabled",
+                        "name": "X-OTP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the password has been reset",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password/reset-request": {
+            "post": {
+                "description": "Mails a single-use password reset token to the email address on a user account. The token expires after thirty minutes. The same response is returned whether or not the username belongs to an active user account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users password reset security"
+                ],
+                "summary": "Request a password reset token.",
+                "operationId": "requestPasswordReset",
+                "parameters": [
+                    {
+                        "description": "the username of the user account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "a message to confirm the request has been accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/profile": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the account information of the user the JWT was issued to. The hashed password is not returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users profile"
+                ],
+                "summary": "Retrieve the profile of a user account.",
+                "operationId": "userProfile",
+                "responses": {
+                    "200": {
+                        "description": "the account information of the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates the first name, last name, and email address of a user account. Fields that are not supplied are left unchanged. A changed email address is unverified until the verification link mailed to it is followed. Users that have enabled two-factor authentication must supply a one-time password or recovery code to change their email address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users profile"
+                ],
+                "summary": "Update the profile of a user account.",
+                "operationId": "updateUserProfile",
+                "parameters": [
+                    {
+                        "description": "the names and email address to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPUpdateProfileRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password or recovery code when two-factor authentication is enabled",
+                        "name": "X-OTP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the updated account information of the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Refreshes a user's JWT by validating it and then issuing a fresh JWT with an extended validity time. JWT must be expiring in under 60 seconds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login refresh security"
+                ],
+                "summary": "Refresh a user's JWT by extending its expiration time.",
+                "operationId": "loginRefresh",
+                "responses": {
+                    "200": {
+                        "description": "A new valid JWT",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "510": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/register": {
+            "post": {
+                "description": "Creates a user account by inserting credentials into the database. A hashed password is stored. A session is started on the device the user registered from and a refresh token is returned along with the JWT. A verification link is mailed to the email address of the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users register security"
+                ],
+                "summary": "Register a user.",
+                "operationId": "registerUser",
+                "parameters": [
+                    {
+                        "description": "Username, password, first and last name, email address of user",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserAccount"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a valid JWT token for the new account",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -1198,8 +5203,190 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
+                    }
+                }
+            }
+        },
+        "/user/security-activity": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the most recent logins, token refreshes, password changes, and money movements on a user account, newest first, along with the device and IP address each originated from and whether it succeeded.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users security activity"
+                ],
+                "summary": "Retrieve recent security activity.",
+                "operationId": "securityActivity",
+                "responses": {
+                    "200": {
+                        "description": "the recent security activity",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
                     },
-                    "510": {
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the details of all the active sessions of a user, most recently refreshed first, along with the device and IP address each session was last refreshed from.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users session sessions details"
+                ],
+                "summary": "Retrieve active sessions.",
+                "operationId": "sessions",
+                "responses": {
+                    "200": {
+                        "description": "the details of the active sessions",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/sessions/refresh": {
+            "post": {
+                "description": "Exchanges a refresh token for a new JWT and a new refresh token in the same session. Refresh tokens can only be used once. Reusing a refresh token that has already been exchanged revokes its session.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users session sessions refresh security"
+                ],
+                "summary": "Refresh a session.",
+                "operationId": "refreshSession",
+                "parameters": [
+                    {
+                        "description": "the refresh token to exchange",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPRefreshSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a new valid JWT and refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/sessions/{sessionID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes a session so that its refresh token can no longer be exchanged. JWTs that were issued in the session remain valid until they expire.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users session sessions revoke security"
+                ],
+                "summary": "Revoke a session.",
+                "operationId": "revokeSession",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the session ID to revoke",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the revocation of the session",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -1207,62 +5394,242 @@ const docTemplate = `{
                     }
                 }
             }
+        }
+    },
+    "definitions": {
+        "models.HTTPAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "allowedIPs": {
+                    "type": "array",
+                    "maxItems": 16,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expiresAt": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "scopes": {
+                    "type": "array",
+                    "maxItems": 3,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.HTTPAdminAccountStatusRequest": {
+            "type": "object",
+            "required": [
+                "reason",
+                "status"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ACTIVE",
+                        "FROZEN_DEBITS",
+                        "FROZEN"
+                    ]
+                }
+            }
+        },
+        "models.HTTPAdminAdjustmentDecisionRequest": {
+            "type": "object",
+            "required": [
+                "note"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 256
+                }
+            }
+        },
+        "models.HTTPAdminAdjustmentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "clientID",
+                "currency",
+                "justification",
+                "reasonCode"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "clientID": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "justification": {
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "reasonCode": {
+                    "type": "string",
+                    "enum": [
+                        "GOODWILL",
+                        "FEE_CORRECTION",
+                        "ERROR_CORRECTION",
+                        "OTHER"
+                    ]
+                }
+            }
+        },
+        "models.HTTPAdminFreezeRequest": {
+            "type": "object",
+            "required": [
+                "isFrozen",
+                "reason"
+            ],
+            "properties": {
+                "isFrozen": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 256
+                }
+            }
+        },
+        "models.HTTPAdminReversalRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 256
+                }
+            }
+        },
+        "models.HTTPAdminUnlockRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 256
+                }
+            }
         },
-        "/user/register": {
-            "post": {
-                "description": "Creates a user account by inserting credentials into the database. A hashed password is stored.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "user users register security"
-                ],
-                "summary": "Register a user.",
-                "operationId": "registerUser",
-                "parameters": [
-                    {
-                        "description": "Username, password, first and last name, email address of user",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UserAccount"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "a valid JWT token for the new account",
-                        "schema": {
-                            "$ref": "#/definitions/models.JWTAuthResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    }
+        "models.HTTPChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "currentPassword",
+                "newPassword"
+            ],
+            "properties": {
+                "currentPassword": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "models.HTTPCloseCryptoAccountRequest": {
+            "type": "object",
+            "required": [
+                "ticker"
+            ],
+            "properties": {
+                "sweepCurrency": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPCloseFiatAccountRequest": {
+            "type": "object",
+            "required": [
+                "currency"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "sweepCurrency": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPCryptoAssetRequest": {
+            "type": "object",
+            "required": [
+                "decimalPlaces",
+                "maxOrder",
+                "minOrder",
+                "name",
+                "ticker"
+            ],
+            "properties": {
+                "decimalPlaces": {
+                    "type": "integer",
+                    "maximum": 18,
+                    "minimum": 0
+                },
+                "isHalted": {
+                    "type": "boolean"
+                },
+                "maxOrder": {
+                    "type": "number"
+                },
+                "minOrder": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "ticker": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 1
+                }
+            }
+        },
+        "models.HTTPCryptoAssetStatusRequest": {
+            "type": "object",
+            "required": [
+                "isHalted"
+            ],
+            "properties": {
+                "isHalted": {
+                    "type": "boolean"
+                }
+            }
+        },
         "models.HTTPCryptoOfferRequest": {
             "type": "object",
             "required": [
@@ -1336,6 +5703,9 @@ const docTemplate = `{
                 "destinationCurrency": {
                     "type": "string"
                 },
+                "hold": {
+                    "type": "boolean"
+                },
                 "sourceAmount": {
                     "type": "number"
                 },
@@ -1344,6 +5714,72 @@ const docTemplate = `{
                 }
             }
         },
+        "models.HTTPFiatCurrencyRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "status"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 3
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ACTIVE",
+                        "DEPRECATED",
+                        "WITHDRAWN"
+                    ]
+                }
+            }
+        },
+        "models.HTTPLimitOrderRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "expiresAt",
+                "fiatCurrency",
+                "isPurchase",
+                "limitPrice",
+                "ticker"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "expiresAt": {
+                    "type": "integer"
+                },
+                "fiatCurrency": {
+                    "type": "string"
+                },
+                "isPurchase": {
+                    "type": "boolean"
+                },
+                "limitPrice": {
+                    "type": "number"
+                },
+                "ticker": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 1
+                }
+            }
+        },
+        "models.HTTPMFAConfirmRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
         "models.HTTPOpenCurrencyAccountRequest": {
             "type": "object",
             "required": [
@@ -1355,6 +5791,88 @@ const docTemplate = `{
                 }
             }
         },
+        "models.HTTPPasswordResetConfirmRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "token": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "models.HTTPPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                }
+            }
+        },
+        "models.HTTPRecurringPurchaseRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "cadence",
+                "fiatCurrency",
+                "ticker"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "cadence": {
+                    "type": "string",
+                    "enum": [
+                        "DAILY",
+                        "WEEKLY",
+                        "BIWEEKLY",
+                        "MONTHLY"
+                    ]
+                },
+                "endsAt": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "fiatCurrency": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "ticker": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 1
+                }
+            }
+        },
+        "models.HTTPRefreshSessionRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
         "models.HTTPSuccess": {
             "type": "object",
             "properties": {
@@ -1375,6 +5893,58 @@ const docTemplate = `{
                 }
             }
         },
+        "models.HTTPTriggerOrderRequest": {
+            "type": "object",
+            "required": [
+                "fiatCurrency",
+                "ticker",
+                "triggerPrice",
+                "triggerType"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "fiatCurrency": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 1
+                },
+                "trailingDistance": {
+                    "type": "number"
+                },
+                "triggerPrice": {
+                    "type": "number"
+                },
+                "triggerType": {
+                    "type": "string",
+                    "enum": [
+                        "STOP_LOSS",
+                        "TAKE_PROFIT"
+                    ]
+                }
+            }
+        },
+        "models.HTTPUpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "firstName": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "lastName": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
         "models.JWTAuthResponse": {
             "type": "object",
             "required": [
@@ -1387,6 +5957,14 @@ const docTemplate = `{
                     "description": "Expiration time as unix time stamp. Strictly used by client to gauge when to refresh the token.",
                     "type": "integer"
                 },
+                "refreshExpires": {
+                    "description": "Expiration time of the refresh token as unix time stamp.",
+                    "type": "integer"
+                },
+                "refreshToken": {
+                    "description": "Opaque single-use refresh token that is exchanged for a new JWT and refresh token.",
+                    "type": "string"
+                },
                 "threshold": {
                     "description": "The window in seconds before expiration during which the token can be refreshed.",
                     "type": "integer"
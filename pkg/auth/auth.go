@@ -3,16 +3,22 @@ package auth
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/xid"
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
@@ -32,39 +38,108 @@ type Auth interface {
 	// CheckPassword will take the plaintext and hashed passwords as input, in that order, and verify if they match.
 	CheckPassword(plaintext string, hashed string) error
 
-	// GenerateJWT will create a valid JSON Web Token and return it in a JWT Authorization Response structure.
-	GenerateJWT(clientID uuid.UUID) (*models.JWTAuthResponse, error)
+	// GenerateJWT will create a valid JSON Web Token, carrying the role and the scopes it grants, and return it in a JWT
+	// Authorization Response structure.
+	GenerateJWT(clientID uuid.UUID, role string) (*models.JWTAuthResponse, error)
 
 	// ValidateJWT will take the JSON Web Token and validate it. It will extract and return the username and expiration
 	// time (Unix timestamp) or an error if validation fails.
 	ValidateJWT(token string) (uuid.UUID, int64, error)
 
+	// TokenSession will take the JSON Web Token and validate it. It will extract and return the unique token ID and the
+	// issuance time (Unix timestamp in milliseconds) or an error if validation fails. Tokens issued without an ID return
+	// an empty token ID.
+	TokenSession(token string) (string, int64, error)
+
+	// ValidateJWTScopes will take the JSON Web Token and validate it as well as verify that it carries all the required
+	// scopes. It will extract and return the username and expiration time (Unix timestamp) or an error if validation
+	// fails.
+	ValidateJWTScopes(token string, scopes ...string) (uuid.UUID, int64, error)
+
 	// RefreshJWT will take a valid JSON Web Token, and if valid and expiring soon, issue a fresh valid JWT with the time
-	// extended in JWT Authorization Response structure.
-	RefreshJWT(token string) (*models.JWTAuthResponse, error)
+	// extended in JWT Authorization Response structure. The fresh JWT carries the role supplied, which must be the
+	// client's current role, rather than the role in the original JWT.
+	RefreshJWT(token, role string) (*models.JWTAuthResponse, error)
 
 	// RefreshThreshold returns the time before the end of the JSON Web Tokens validity interval that a JWT can be
 	// refreshed in.
 	RefreshThreshold() int64
 
+	// ExpirationDuration returns the validity interval of a JSON Web Token in seconds.
+	ExpirationDuration() int64
+
+	// LoginThrottle returns the limits that are applied to slow down and lock out repeated failed login attempts.
+	LoginThrottle() LoginThrottle
+
+	// GenerateRefreshToken will create a random opaque refresh token. It will return the refresh token and its hash, in
+	// that order. Only the hash is to be stored.
+	GenerateRefreshToken() (string, string, error)
+
+	// HashRefreshToken will generate the hash of a refresh token under which it is stored.
+	HashRefreshToken(token string) string
+
+	// GenerateEmailVerificationToken will create a signed and expiring token that attests to a client's ownership of an
+	// email address. It is sent to the email address in a verification link.
+	GenerateEmailVerificationToken(clientID uuid.UUID, email string) (string, error)
+
+	// ValidateEmailVerificationToken will take an email verification token and validate it. It will extract and return
+	// the Client ID and email address or an error if validation fails.
+	ValidateEmailVerificationToken(token string) (uuid.UUID, string, error)
+
 	// EncryptToString will generate an encrypted base64 encoded character from the plaintext.
 	EncryptToString(plaintext []byte) (string, error)
 
 	// DecryptFromString will decrypt an encrypted base64 encoded character from the ciphertext.
 	DecryptFromString(encoded string) ([]byte, error)
 
+	// ReencryptString will encrypt a ciphertext again with the active crypto secret if it was encrypted with another
+	// one. It will return the ciphertext and whether it was re-encrypted.
+	ReencryptString(encoded string) (string, bool, error)
+
 	// TokenInfoFromGinCtx extracts the clientID and expiration deadline stored from a JWT in the Gin context.
 	TokenInfoFromGinCtx(ctx *gin.Context) (uuid.UUID, int64, error)
+
+	// SignCheckpoint will generate an Ed25519 signature of a journal hash chain checkpoint with the active checkpoint
+	// signing key.
+	SignCheckpoint(message []byte) []byte
+
+	// CheckpointKeyID returns the ID of the active checkpoint signing key, which is recorded with each checkpoint.
+	CheckpointKeyID() string
+
+	// CheckpointPublicKey returns the Ed25519 public key of the active checkpoint signing key.
+	CheckpointPublicKey() ed25519.PublicKey
+
+	// CheckpointPublicKeys returns the Ed25519 public keys of the active and retired checkpoint signing keys by their
+	// IDs, against which journal hash chain checkpoints are verified.
+	CheckpointPublicKeys() map[string]ed25519.PublicKey
 }
 
+const (
+	// keyIDHeader is the JWT header that carries the ID of the key a token was signed with.
+	keyIDHeader = "kid"
+
+	// secretIDSeparator separates the ID of the crypto secret that a ciphertext was encrypted with from the ciphertext.
+	secretIDSeparator = "."
+
+	// jwtKeyBytes is the number of random bytes in a generated JWT signing key.
+	jwtKeyBytes = 48
+
+	// cryptoSecretBytes is the number of random bytes in a generated crypto secret, which are 32 characters encoded.
+	cryptoSecretBytes = 24
+)
+
 // Check to ensure the Auth interface has been implemented.
 var _ Auth = &authImpl{}
 
 // authImpl implements the Auth interface and contains the logic for authorization functionality.
 type authImpl struct {
-	cryptoSecret []byte
-	conf         *config
-	logger       *logger.Logger
+	cryptoSecret   []byte
+	cryptoSecrets  map[string][]byte
+	jwtKeys        map[string][]byte
+	checkpointKey  ed25519.PrivateKey
+	checkpointKeys map[string]ed25519.PublicKey
+	conf           *config
+	logger         *logger.Logger
 }
 
 // NewAuth will create a new Authorization configuration by loading it.
@@ -85,11 +160,85 @@ func newAuthImpl(fs *afero.Fs, logger *logger.Logger) (a *authImpl, err error) {
 		return nil, err
 	}
 
-	a.cryptoSecret = []byte(a.conf.General.CryptoSecret)
+	if err = a.loadKeys(); err != nil {
+		a.logger.Error("failed to load Authorization key sets", zap.Error(err))
+
+		return nil, err
+	}
 
 	return
 }
 
+// loadKeys will assemble the key sets that JWTs are validated with, ciphertexts are decrypted with, and checkpoints are
+// verified with. The active JWT key, crypto secret, and checkpoint signing key are part of their key sets, and the IDs
+// within a key set must be unique.
+func (a *authImpl) loadKeys() error {
+	a.jwtKeys = map[string][]byte{a.conf.JWTConfig.KeyID: []byte(a.conf.JWTConfig.Key)}
+
+	for _, key := range a.conf.JWTConfig.VerificationKeys {
+		if _, ok := a.jwtKeys[key.KeyID]; ok {
+			return fmt.Errorf("duplicate JWT key id %s", key.KeyID)
+		}
+
+		a.jwtKeys[key.KeyID] = []byte(key.Key)
+	}
+
+	a.cryptoSecret = []byte(a.conf.General.CryptoSecret)
+	a.cryptoSecrets = map[string][]byte{a.conf.General.CryptoSecretID: a.cryptoSecret}
+
+	for _, secret := range a.conf.General.DecryptionSecrets {
+		if _, ok := a.cryptoSecrets[secret.SecretID]; ok {
+			return fmt.Errorf("duplicate crypto secret id %s", secret.SecretID)
+		}
+
+		a.cryptoSecrets[secret.SecretID] = []byte(secret.Secret)
+	}
+
+	seed, err := hex.DecodeString(a.conf.Checkpoint.Key)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return errors.New("malformed checkpoint signing key")
+	}
+
+	a.checkpointKey = ed25519.NewKeyFromSeed(seed)
+	a.checkpointKeys = map[string]ed25519.PublicKey{a.conf.Checkpoint.KeyID: publicKey(a.checkpointKey)}
+
+	for _, key := range a.conf.Checkpoint.VerificationKeys {
+		if _, ok := a.checkpointKeys[key.KeyID]; ok {
+			return fmt.Errorf("duplicate checkpoint key id %s", key.KeyID)
+		}
+
+		public, err := hex.DecodeString(key.PublicKey)
+		if err != nil || len(public) != ed25519.PublicKeySize {
+			return fmt.Errorf("malformed checkpoint public key %s", key.KeyID)
+		}
+
+		a.checkpointKeys[key.KeyID] = public
+	}
+
+	return nil
+}
+
+// keyByID will select the key that a token is validated with from a key set using the key ID in the token header.
+// Tokens issued before key IDs were introduced do not carry one and are validated with the active key.
+func keyByID(keys map[string][]byte, activeID string, token *jwt.Token) ([]byte, error) {
+	rawKeyID, ok := token.Header[keyIDHeader]
+	if !ok {
+		return keys[activeID], nil
+	}
+
+	keyID, ok := rawKeyID.(string)
+	if !ok {
+		return nil, errors.New("malformed signing key id")
+	}
+
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, errors.New("unknown signing key id")
+	}
+
+	return key, nil
+}
+
 // HashPassword hashes a password using the Bcrypt algorithm to avoid plaintext storage.
 func (a *authImpl) HashPassword(plaintext string) (hashed string, err error) {
 	var bytes []byte
@@ -114,23 +263,116 @@ func (a *authImpl) CheckPassword(hashed, plaintext string) (err error) {
 
 // jwtClaim is used internally by the JWT generation and validation routines.
 type jwtClaim struct {
-	ClientID uuid.UUID `json:"clientId" yaml:"clientId"`
+	ClientID      uuid.UUID `json:"clientId"        yaml:"clientId"`
+	Role          string    `json:"role"            yaml:"role"`
+	Scopes        []string  `json:"scopes"          yaml:"scopes"`
+	IssuedAtMilli int64     `json:"iatMs,omitempty" yaml:"iatMs,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT creates a payload consisting of the JWT with the Client ID and expiration time.
-func (a *authImpl) GenerateJWT(clientID uuid.UUID) (*models.JWTAuthResponse, error) {
+// RoleScopes returns the authorization scopes granted to a role. Unrecognized roles are granted no scopes.
+func RoleScopes(role string) []string {
+	switch role {
+	case constants.RoleUser():
+		return []string{constants.ScopeUser()}
+	case constants.RoleSupport():
+		return []string{constants.ScopeUser(), constants.ScopeAdminRead()}
+	case constants.RoleAdmin():
+		return []string{constants.ScopeUser(), constants.ScopeAdminRead(), constants.ScopeAdminWrite()}
+	default:
+		return []string{}
+	}
+}
+
+// HasScopes checks whether all the required scopes are present in the granted scopes.
+func HasScopes(granted []string, required ...string) bool {
+	grantedSet := make(map[string]struct{}, len(granted))
+	for _, scope := range granted {
+		grantedSet[scope] = struct{}{}
+	}
+
+	for _, scope := range required {
+		if _, ok := grantedSet[scope]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewJWTKey generates a random URL safe JWT signing key to be rotated in.
+func NewJWTKey() (string, error) {
+	key := make([]byte, jwtKeyBytes)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", fmt.Errorf("failed to generate JWT key %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// NewCryptoSecret generates a random URL safe 32 character crypto secret to be rotated in.
+func NewCryptoSecret() (string, error) {
+	secret := make([]byte, cryptoSecretBytes)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", fmt.Errorf("failed to generate crypto secret %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// NewCheckpointKey generates a random hex encoded Ed25519 checkpoint signing key seed, to be rotated in, and its hex
+// encoded public key.
+func NewCheckpointKey() (string, string, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate checkpoint signing key %w", err)
+	}
+
+	return hex.EncodeToString(private.Seed()), hex.EncodeToString(public), nil
+}
+
+// NewAPIKeySecret generates a random hex encoded secret with which requests made using an API key are signed.
+func NewAPIKeySecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", fmt.Errorf("failed to generate API key secret %w", err)
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// APIKeySignature generates the hex encoded HMAC-SHA256 signature of a request using an API key secret. The signed
+// message is the request method, path with query string, Unix timestamp, nonce, and hex encoded SHA-256 digest of the
+// body, separated by newlines.
+func APIKeySignature(secret, method, path, timestamp, nonce string, body []byte) string {
+	digest := sha256.Sum256(body)
+	message := strings.Join([]string{method, path, timestamp, nonce, hex.EncodeToString(digest[:])}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateJWT creates a payload consisting of the JWT with the Client ID, role, scopes, unique token ID, issuance time,
+// and expiration time. The JWT is signed with the active key and carries its ID in the header.
+func (a *authImpl) GenerateJWT(clientID uuid.UUID, role string) (*models.JWTAuthResponse, error) {
+	issuedAt := time.Now().UTC()
 	claims := &jwtClaim{
-		ClientID: clientID,
+		ClientID:      clientID,
+		Role:          role,
+		Scopes:        RoleScopes(role),
+		IssuedAtMilli: issuedAt.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer: a.conf.JWTConfig.Issuer,
+			ID:       xid.New().String(),
+			Issuer:   a.conf.JWTConfig.Issuer,
+			IssuedAt: jwt.NewNumericDate(issuedAt),
 			ExpiresAt: jwt.NewNumericDate(
-				time.
-					Now().
-					Add(time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second).UTC()),
+				issuedAt.Add(time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second)),
 		},
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header[keyIDHeader] = a.conf.JWTConfig.KeyID
 
 	tokenString, err := token.SignedString([]byte(a.conf.JWTConfig.Key))
 	if err != nil {
@@ -149,16 +391,20 @@ func (a *authImpl) GenerateJWT(clientID uuid.UUID) (*models.JWTAuthResponse, err
 	return authResponse, nil
 }
 
-// ValidateJWT will validate a signed JWT and extracts the Client ID and unix expiration timestamp from it.
-func (a *authImpl) ValidateJWT(signedToken string) (uuid.UUID, int64, error) {
+// parseJWT will validate a signed JWT, using the key whose ID it carries, and extract its claims.
+func (a *authImpl) parseJWT(signedToken string) (*jwtClaim, error) {
 	token, err := jwt.ParseWithClaims(signedToken, &jwtClaim{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(a.conf.JWTConfig.Key), nil
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+
+		return keyByID(a.jwtKeys, a.conf.JWTConfig.KeyID, token)
 	})
 	if err != nil {
 		msg := "failed to parse token"
 		a.logger.Warn(msg, zap.Error(err))
 
-		return uuid.UUID{}, -1, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
 	// Cast token claim to JWT.
@@ -167,34 +413,84 @@ func (a *authImpl) ValidateJWT(signedToken string) (uuid.UUID, int64, error) {
 		msg := "failed to extract jwt data"
 		a.logger.Warn(msg, zap.Error(err))
 
-		return uuid.UUID{}, -1, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
 	// Check for errors and compare the expiration time in Unix format.
 	expiration, err := claims.GetExpirationTime()
 	if err != nil || expiration.Unix() < time.Now().Unix() {
-		return uuid.UUID{}, -1, errors.New("token has expired")
+		return nil, errors.New("token has expired")
 	}
 
 	// Check the issuer is correct.
 	issuer, err := claims.GetIssuer()
 	if err != nil || issuer != a.conf.JWTConfig.Issuer {
-		return uuid.UUID{}, -1, errors.New("unauthorized issuer")
+		return nil, errors.New("unauthorized issuer")
+	}
+
+	return claims, nil
+}
+
+// ValidateJWT will validate a signed JWT and extracts the Client ID and unix expiration timestamp from it.
+func (a *authImpl) ValidateJWT(signedToken string) (uuid.UUID, int64, error) {
+	claims, err := a.parseJWT(signedToken)
+	if err != nil {
+		return uuid.UUID{}, -1, err
 	}
 
 	// Return the username and the unix expiration timestamp.
 	return claims.ClientID, claims.ExpiresAt.Unix(), nil
 }
 
-// RefreshJWT will extend a valid JWTs lease by generating a fresh valid JWT.
-func (a *authImpl) RefreshJWT(token string) (authResponse *models.JWTAuthResponse, err error) {
-	var clientID uuid.UUID
+// TokenSession will validate a signed JWT and extract the unique token ID and unix issuance timestamp in milliseconds
+// from it, so that tokens issued later in the second that a client's tokens were revoked remain valid. The issuance
+// time of tokens without the millisecond claim is rounded down to the second. Tokens issued before token IDs were
+// introduced are accepted until they expire with an empty token ID, and the issuance time of those without one is
+// derived from their expiration time.
+func (a *authImpl) TokenSession(signedToken string) (string, int64, error) {
+	claims, err := a.parseJWT(signedToken)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if claims.IssuedAtMilli > 0 {
+		return claims.ID, claims.IssuedAtMilli, nil
+	}
+
+	if claims.IssuedAt != nil {
+		return claims.ID, claims.IssuedAt.UnixMilli(), nil
+	}
 
-	if clientID, _, err = a.ValidateJWT(token); err != nil {
+	return claims.ID, claims.ExpiresAt.Add(-time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second).UnixMilli(),
+		nil
+}
+
+// ValidateJWTScopes will validate a signed JWT, check that it carries the required scopes, and extract the Client ID
+// and unix expiration timestamp from it.
+func (a *authImpl) ValidateJWTScopes(signedToken string, scopes ...string) (uuid.UUID, int64, error) {
+	claims, err := a.parseJWT(signedToken)
+	if err != nil {
+		return uuid.UUID{}, -1, err
+	}
+
+	if !HasScopes(claims.Scopes, scopes...) {
+		return uuid.UUID{}, -1, errors.New("token does not carry the required scopes")
+	}
+
+	// Return the username and the unix expiration timestamp.
+	return claims.ClientID, claims.ExpiresAt.Unix(), nil
+}
+
+// RefreshJWT will extend a valid JWTs lease by generating a fresh valid JWT with the client's current role. The role in
+// the original JWT is not carried over so that a client whose role has changed cannot retain it by refreshing.
+func (a *authImpl) RefreshJWT(token, role string) (authResponse *models.JWTAuthResponse, err error) {
+	var claims *jwtClaim
+
+	if claims, err = a.parseJWT(token); err != nil {
 		return
 	}
 
-	if authResponse, err = a.GenerateJWT(clientID); err != nil {
+	if authResponse, err = a.GenerateJWT(claims.ClientID, role); err != nil {
 		return
 	}
 
@@ -206,15 +502,42 @@ func (a *authImpl) RefreshThreshold() int64 {
 	return a.conf.JWTConfig.RefreshThreshold
 }
 
+// ExpirationDuration is the seconds for which a JWT is valid after it has been issued.
+func (a *authImpl) ExpirationDuration() int64 {
+	return a.conf.JWTConfig.ExpirationDuration
+}
+
+// GenerateRefreshToken will create a random opaque refresh token that is URL safe, along with its hash.
+func (a *authImpl) GenerateRefreshToken() (string, string, error) {
+	raw := make([]byte, constants.RefreshTokenBytes())
+	if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+		a.logger.Error("failed to generate refresh token", zap.Error(err))
+
+		return "", "", fmt.Errorf("%w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, a.HashRefreshToken(token), nil
+}
+
+// HashRefreshToken will generate the hex encoded SHA-256 hash of a refresh token. Refresh tokens carry enough entropy
+// that a salted and slow hash is not required.
+func (a *authImpl) HashRefreshToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
 // encryptAES256 employs Authenticated Encryption with Associated Data using Galois/Counter mode and returns the cipher
 // as a Base64 encoded string to be used in URIs.
-func (a *authImpl) encryptAES256(data []byte) (cipherStr string, cipherBytes []byte, err error) {
+func encryptAES256(secret, data []byte) (cipherStr string, cipherBytes []byte, err error) {
 	var (
 		cipherBlock cipher.Block
 		gcm         cipher.AEAD
 	)
 
-	if cipherBlock, err = aes.NewCipher(a.cryptoSecret); err != nil {
+	if cipherBlock, err = aes.NewCipher(secret); err != nil {
 		return
 	}
 
@@ -238,14 +561,14 @@ func (a *authImpl) encryptAES256(data []byte) (cipherStr string, cipherBytes []b
 
 // decryptAES256 employs Authenticated Encryption with Associated Data using Galois/Counter mode and returns the
 // decrypted plaintext bytes.
-func (a *authImpl) decryptAES256(data []byte) (cipherBytes []byte, err error) {
+func decryptAES256(secret, data []byte) (cipherBytes []byte, err error) {
 	var (
 		cipherBlock cipher.Block
 		gcm         cipher.AEAD
 		nonceSize   int
 	)
 
-	if cipherBlock, err = aes.NewCipher(a.cryptoSecret); err != nil {
+	if cipherBlock, err = aes.NewCipher(secret); err != nil {
 		return
 	}
 
@@ -253,8 +576,8 @@ func (a *authImpl) decryptAES256(data []byte) (cipherBytes []byte, err error) {
 		return
 	}
 
-	if nonceSize = gcm.NonceSize(); nonceSize < 0 {
-		return nil, errors.New("bad nonce size")
+	if nonceSize = gcm.NonceSize(); len(data) < nonceSize {
+		return nil, errors.New("ciphertext is too short")
 	}
 
 	// Extract the nonce and cipher blocks from the data.
@@ -266,22 +589,104 @@ func (a *authImpl) decryptAES256(data []byte) (cipherBytes []byte, err error) {
 	return
 }
 
-// EncryptToString will generate an encrypted base64 encoded character from the plaintext.
+// EncryptToString will generate an encrypted base64 encoded character from the plaintext. The ciphertext is prefixed
+// with the ID of the active crypto secret so that it can still be decrypted after the secret has been rotated out.
 func (a *authImpl) EncryptToString(plaintext []byte) (ciphertext string, err error) {
-	ciphertext, _, err = a.encryptAES256(plaintext)
+	if ciphertext, _, err = encryptAES256(a.cryptoSecret, plaintext); err != nil {
+		return
+	}
 
-	return
+	return a.conf.General.CryptoSecretID + secretIDSeparator + ciphertext, nil
 }
 
-// DecryptFromString will decrypt an encrypted base64 encoded character from the ciphertext.
+// DecryptFromString will decrypt an encrypted base64 encoded character from the ciphertext with the crypto secret whose
+// ID it is prefixed with. Ciphertexts encrypted before secret IDs were introduced carry no prefix, and each crypto
+// secret is tried in turn starting with the active one.
 func (a *authImpl) DecryptFromString(ciphertext string) (plaintext []byte, err error) {
 	var bytes []byte
 
-	if bytes, err = base64.URLEncoding.DecodeString(ciphertext); err != nil {
+	secretID, encoded, hasID := strings.Cut(ciphertext, secretIDSeparator)
+	if !hasID {
+		encoded = ciphertext
+	}
+
+	if bytes, err = base64.URLEncoding.DecodeString(encoded); err != nil {
+		return
+	}
+
+	if hasID {
+		secret, ok := a.cryptoSecrets[secretID]
+		if !ok {
+			return nil, errors.New("unknown crypto secret id")
+		}
+
+		return decryptAES256(secret, bytes)
+	}
+
+	if plaintext, err = decryptAES256(a.cryptoSecret, bytes); err == nil {
 		return
 	}
 
-	return a.decryptAES256(bytes)
+	for id, secret := range a.cryptoSecrets {
+		if id == a.conf.General.CryptoSecretID {
+			continue
+		}
+
+		if plaintext, err = decryptAES256(secret, bytes); err == nil {
+			return
+		}
+	}
+
+	return nil, err
+}
+
+// ReencryptString will decrypt a ciphertext and encrypt it again with the active crypto secret, unless it was already
+// encrypted with the active crypto secret.
+func (a *authImpl) ReencryptString(ciphertext string) (string, bool, error) {
+	if secretID, _, hasID := strings.Cut(ciphertext, secretIDSeparator); hasID &&
+		secretID == a.conf.General.CryptoSecretID {
+		return ciphertext, false, nil
+	}
+
+	plaintext, err := a.DecryptFromString(ciphertext)
+	if err != nil {
+		return "", false, err
+	}
+
+	if ciphertext, err = a.EncryptToString(plaintext); err != nil {
+		return "", false, err
+	}
+
+	return ciphertext, true, nil
+}
+
+// publicKey extracts the Ed25519 public key from a private key.
+func publicKey(private ed25519.PrivateKey) ed25519.PublicKey {
+	public, _ := private.Public().(ed25519.PublicKey)
+
+	return public
+}
+
+// SignCheckpoint will generate an Ed25519 signature of a journal hash chain checkpoint with the active checkpoint
+// signing key.
+func (a *authImpl) SignCheckpoint(message []byte) []byte {
+	return ed25519.Sign(a.checkpointKey, message)
+}
+
+// CheckpointKeyID returns the ID of the active checkpoint signing key.
+func (a *authImpl) CheckpointKeyID() string {
+	return a.conf.Checkpoint.KeyID
+}
+
+// CheckpointPublicKey returns the Ed25519 public key of the active checkpoint signing key.
+func (a *authImpl) CheckpointPublicKey() ed25519.PublicKey {
+	return publicKey(a.checkpointKey)
+}
+
+// CheckpointPublicKeys returns the Ed25519 public keys of the active checkpoint signing key and the verification keys
+// by their IDs.
+func (a *authImpl) CheckpointPublicKeys() map[string]ed25519.PublicKey {
+	return a.checkpointKeys
 }
 
 // testConfigurationImpl creates an authImpl configuration for testing.
@@ -290,12 +695,43 @@ func testConfigurationImpl(zapLogger *logger.Logger, expDuration, refThreshold i
 		conf:   &config{},
 		logger: zapLogger,
 	}
+	auth.conf.JWTConfig.KeyID = "test"
 	auth.conf.JWTConfig.Key = "encryption key for test suite"
 	auth.conf.JWTConfig.Issuer = "issuer for test suite"
 	auth.conf.JWTConfig.ExpirationDuration = expDuration
 	auth.conf.JWTConfig.RefreshThreshold = refThreshold
 	auth.conf.General.BcryptCost = 4
-	auth.cryptoSecret = []byte("*****crypto key for testing*****")
+	auth.conf.Login.MaxUserFailures = 5
+	auth.conf.Login.MaxIPFailures = 20
+	auth.conf.Login.FailureWindow = 900
+	auth.conf.Login.LockoutDuration = 900
+	auth.conf.Login.BaseDelay = 1
+	auth.conf.Login.MaxDelay = 30
+	auth.conf.General.CryptoSecretID = "test"
+	auth.conf.General.CryptoSecret = "*****crypto key for testing*****"
+	auth.conf.Checkpoint.KeyID = "test"
+	auth.conf.Checkpoint.Key = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	_ = auth.loadKeys()
+
+	return auth
+}
+
+// testRotatedConfigurationImpl creates an authImpl configuration for testing in which the JWT key and crypto secret of
+// the test configuration have been rotated out and are only used for validation and decryption.
+func testRotatedConfigurationImpl(zapLogger *logger.Logger, expDuration, refThreshold int64) *authImpl {
+	auth := testConfigurationImpl(zapLogger, expDuration, refThreshold)
+	auth.conf.JWTConfig.VerificationKeys = []jwtKey{{KeyID: auth.conf.JWTConfig.KeyID, Key: auth.conf.JWTConfig.Key}}
+	auth.conf.JWTConfig.KeyID = "rotated"
+	auth.conf.JWTConfig.Key = "rotated encryption key for test suite"
+	auth.conf.General.DecryptionSecrets = []cryptoSecret{
+		{SecretID: auth.conf.General.CryptoSecretID, Secret: auth.conf.General.CryptoSecret}}
+	auth.conf.General.CryptoSecretID = "rotated"
+	auth.conf.General.CryptoSecret = "****rotated crypto key for test*"
+	auth.conf.Checkpoint.VerificationKeys = []checkpointKey{{
+		KeyID: auth.conf.Checkpoint.KeyID, PublicKey: hex.EncodeToString(auth.CheckpointPublicKey())}}
+	auth.conf.Checkpoint.KeyID = "rotated"
+	auth.conf.Checkpoint.Key = "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	_ = auth.loadKeys()
 
 	return auth
 }
@@ -305,6 +741,13 @@ func TestAuth(zapLogger *logger.Logger, expDuration, refThreshold int64) Auth {
 	return testConfigurationImpl(zapLogger, expDuration, refThreshold)
 }
 
+// TestAuthRotated is a test Auth struct, to be used in test suites, that validates and decrypts everything that
+// TestAuth signs and encrypts, but signs and encrypts with a JWT key, crypto secret, and checkpoint signing key that
+// have been rotated in.
+func TestAuthRotated(zapLogger *logger.Logger, expDuration, refThreshold int64) Auth {
+	return testRotatedConfigurationImpl(zapLogger, expDuration, refThreshold)
+}
+
 // TokenInfoFromGinCtx extracts the clientID and expiration deadline stored from a JWT in the Gin context.
 func (a *authImpl) TokenInfoFromGinCtx(ctx *gin.Context) (uuid.UUID, int64, error) {
 	var (
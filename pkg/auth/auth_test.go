@@ -1,11 +1,17 @@
 package auth
 
 import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/FTeX/pkg/constants"
@@ -80,6 +86,24 @@ func TestNewAuthImpl(t *testing.T) {
 			input:     authConfigTestData["valid"],
 			expectErr: require.NoError,
 			expectNil: require.NotNil,
+		}, {
+			name:      "rotating keys",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["rotating"],
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		}, {
+			name:      "duplicate key ids",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["duplicate_key_ids"],
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "duplicate checkpoint key ids",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["duplicate_checkpoint_key_ids"],
+			expectErr: require.Error,
+			expectNil: require.Nil,
 		}, {
 			name:      "File not found",
 			fileName:  "wrong_file_name.yaml",
@@ -177,7 +201,7 @@ func TestAuthImpl_GenerateJWT(t *testing.T) {
 	clientID, err := uuid.NewV4()
 	require.NoError(t, err, "failed to generate clientID.")
 
-	authResponse, err := testAuth.GenerateJWT(clientID)
+	authResponse, err := testAuth.GenerateJWT(clientID, constants.RoleUser())
 	require.NoError(t, err, "JWT creation failed")
 	require.Greater(t, authResponse.Expires, time.Now().Unix(), "JWT expires before current time.")
 	require.Less(t, authResponse.Expires, time.Now().Add(time.Duration(expirationDuration+1)*time.Second).Unix(),
@@ -251,7 +275,7 @@ func TestAuthImpl_ValidateJWT(t *testing.T) {
 			}
 
 			// Generate test token.
-			testJWT, err := testAuthImpl.GenerateJWT(clientID)
+			testJWT, err := testAuthImpl.GenerateJWT(clientID, constants.RoleUser())
 			require.NoError(t, err, "failed to create test JWT")
 
 			// Conditional sleep to expire token.
@@ -274,6 +298,261 @@ func TestAuthImpl_ValidateJWT(t *testing.T) {
 	}
 }
 
+func TestAuthImpl_ValidateJWTScopes(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate clientID.")
+
+	testCases := []struct {
+		name      string
+		role      string
+		scopes    []string
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "user - no scopes",
+			role:      constants.RoleUser(),
+			scopes:    []string{},
+			expectErr: require.NoError,
+		}, {
+			name:      "user - user scope",
+			role:      constants.RoleUser(),
+			scopes:    []string{constants.ScopeUser()},
+			expectErr: require.NoError,
+		}, {
+			name:      "user - admin read scope",
+			role:      constants.RoleUser(),
+			scopes:    []string{constants.ScopeAdminRead()},
+			expectErr: require.Error,
+		}, {
+			name:      "support - admin read scope",
+			role:      constants.RoleSupport(),
+			scopes:    []string{constants.ScopeUser(), constants.ScopeAdminRead()},
+			expectErr: require.NoError,
+		}, {
+			name:      "support - admin write scope",
+			role:      constants.RoleSupport(),
+			scopes:    []string{constants.ScopeAdminRead(), constants.ScopeAdminWrite()},
+			expectErr: require.Error,
+		}, {
+			name:      "admin - admin write scope",
+			role:      constants.RoleAdmin(),
+			scopes:    []string{constants.ScopeAdminRead(), constants.ScopeAdminWrite()},
+			expectErr: require.NoError,
+		}, {
+			name:      "unknown role - user scope",
+			role:      "ROOT",
+			scopes:    []string{constants.ScopeUser()},
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testJWT, err := testAuth.GenerateJWT(clientID, test.role)
+			require.NoError(t, err, "failed to create test JWT")
+
+			actualClientID, expiresAt, err := testAuth.ValidateJWTScopes(testJWT.Token, test.scopes...)
+			test.expectErr(t, err, "scope validation error condition failed")
+
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, clientID, actualClientID, "clientId failed to match the expected")
+			require.Greater(t, expiresAt, time.Now().Unix(), "invalid expiration time")
+		})
+	}
+
+	_, _, err = testAuth.ValidateJWTScopes("bad#token#string", constants.ScopeUser())
+	require.Error(t, err, "parsing and invalid token should fail")
+}
+
+func TestAuthImpl_TokenSession(t *testing.T) {
+	t.Parallel()
+
+	testAuthImpl := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate clientID.")
+
+	first, err := testAuthImpl.GenerateJWT(clientID, constants.RoleUser())
+	require.NoError(t, err, "failed to create first test JWT")
+
+	second, err := testAuthImpl.GenerateJWT(clientID, constants.RoleUser())
+	require.NoError(t, err, "failed to create second test JWT")
+
+	firstID, issuedAt, err := testAuthImpl.TokenSession(first.Token)
+	require.NoError(t, err, "failed to extract session from first JWT")
+	require.NotEmpty(t, firstID, "empty token ID")
+	require.InDelta(t, time.Now().UnixMilli(), issuedAt, 1000, "invalid issuance time")
+
+	secondID, _, err := testAuthImpl.TokenSession(second.Token)
+	require.NoError(t, err, "failed to extract session from second JWT")
+	require.NotEqual(t, firstID, secondID, "token IDs must be unique")
+
+	_, _, err = testAuthImpl.TokenSession("bad#token#string")
+	require.Error(t, err, "parsing and invalid token should fail")
+
+	// Tokens issued without session information are accepted until they expire, and their issuance time is derived
+	// from their expiration time.
+	expires := time.Now().Add(time.Minute).Truncate(time.Second)
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwtClaim{
+		ClientID: clientID,
+		Role:     constants.RoleUser(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testAuthImpl.conf.JWTConfig.Issuer,
+			ExpiresAt: jwt.NewNumericDate(expires),
+		},
+	}).SignedString([]byte(testAuthImpl.conf.JWTConfig.Key))
+	require.NoError(t, err, "failed to create legacy test JWT")
+
+	legacyID, issuedAt, err := testAuthImpl.TokenSession(legacy)
+	require.NoError(t, err, "failed to extract session from legacy JWT")
+	require.Empty(t, legacyID, "legacy token ID should be empty")
+	require.Equal(t, expires.Add(-time.Duration(expirationDuration)*time.Second).UnixMilli(), issuedAt,
+		"issuance time not derived from expiration time")
+
+	// Tokens issued without the millisecond issuance time are rounded down to the second.
+	issued := time.Now().Truncate(time.Second).Add(500 * time.Millisecond)
+	seconds, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwtClaim{
+		ClientID: clientID,
+		Role:     constants.RoleUser(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "token-id",
+			Issuer:    testAuthImpl.conf.JWTConfig.Issuer,
+			IssuedAt:  jwt.NewNumericDate(issued),
+			ExpiresAt: jwt.NewNumericDate(issued.Add(time.Minute)),
+		},
+	}).SignedString([]byte(testAuthImpl.conf.JWTConfig.Key))
+	require.NoError(t, err, "failed to create seconds precision test JWT")
+
+	_, issuedAt, err = testAuthImpl.TokenSession(seconds)
+	require.NoError(t, err, "failed to extract session from seconds precision JWT")
+	require.Equal(t, issued.Truncate(time.Second).UnixMilli(), issuedAt, "issuance time not rounded down")
+}
+
+func TestAuthImpl_JWTKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	rotated := testRotatedConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate clientID.")
+
+	signed := func(key string, header map[string]any) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwtClaim{
+			ClientID: clientID,
+			Role:     constants.RoleUser(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testAuth.conf.JWTConfig.Issuer,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		})
+		for name, value := range header {
+			token.Header[name] = value
+		}
+
+		signedToken, err := token.SignedString([]byte(key))
+		require.NoError(t, err, "failed to sign test JWT")
+
+		return signedToken
+	}
+
+	// JWTs carry the ID of the key they were signed with.
+	original, err := testAuth.GenerateJWT(clientID, constants.RoleUser())
+	require.NoError(t, err, "failed to generate JWT")
+
+	token, _, err := jwt.NewParser().ParseUnverified(original.Token, &jwtClaim{})
+	require.NoError(t, err, "failed to parse JWT")
+	require.Equal(t, "test", token.Header["kid"], "JWT key id mismatch")
+
+	// JWTs signed before the key was rotated out are still valid.
+	actualID, _, err := rotated.ValidateJWT(original.Token)
+	require.NoError(t, err, "failed to validate JWT signed with rotated out key")
+	require.Equal(t, clientID, actualID, "incorrect clientID retrieved from JWT")
+
+	// JWTs are signed with the key that was rotated in.
+	fresh, err := rotated.RefreshJWT(original.Token, constants.RoleUser())
+	require.NoError(t, err, "failed to refresh JWT signed with rotated out key")
+
+	_, _, err = rotated.ValidateJWT(fresh.Token)
+	require.NoError(t, err, "failed to validate JWT signed with rotated in key")
+
+	_, _, err = testAuth.ValidateJWT(fresh.Token)
+	require.Error(t, err, "validated JWT signed with key that is not configured")
+
+	// JWTs issued before key IDs were introduced are validated with the active key.
+	_, _, err = testAuth.ValidateJWT(signed(testAuth.conf.JWTConfig.Key, nil))
+	require.NoError(t, err, "failed to validate JWT without a key id")
+
+	_, _, err = rotated.ValidateJWT(signed(testAuth.conf.JWTConfig.Key, nil))
+	require.Error(t, err, "validated JWT without a key id that is not signed with the active key")
+
+	// The key ID must belong to the key the JWT was signed with.
+	_, _, err = rotated.ValidateJWT(signed(testAuth.conf.JWTConfig.Key, map[string]any{"kid": "rotated"}))
+	require.Error(t, err, "validated JWT signed with a key other than the one it identifies")
+
+	_, _, err = rotated.ValidateJWT(signed(testAuth.conf.JWTConfig.Key, map[string]any{"kid": 1}))
+	require.Error(t, err, "validated JWT with a malformed key id")
+}
+
+func TestHasScopes(t *testing.T) {
+	t.Parallel()
+
+	granted := RoleScopes(constants.RoleSupport())
+
+	require.True(t, HasScopes(granted), "no required scopes should be satisfied.")
+	require.True(t, HasScopes(granted, constants.ScopeAdminRead()), "granted scope not found.")
+	require.False(t, HasScopes(granted, constants.ScopeAdminRead(), constants.ScopeAdminWrite()),
+		"missing scope was satisfied.")
+	require.False(t, HasScopes(RoleScopes("ROOT"), constants.ScopeUser()), "unknown role was granted scopes.")
+}
+
+func TestNewAPIKeySecret(t *testing.T) {
+	t.Parallel()
+
+	first, err := NewAPIKeySecret()
+	require.NoError(t, err, "failed to generate first secret.")
+	require.Len(t, first, 64, "secret length mismatch.")
+
+	second, err := NewAPIKeySecret()
+	require.NoError(t, err, "failed to generate second secret.")
+	require.NotEqual(t, first, second, "secrets are not random.")
+}
+
+func TestAPIKeySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"currency":"USD","amount":"100.00"}`)
+	signature := APIKeySignature("secret", "POST", "/api/rest/v1/fiat/deposit", "1685923200", "nonce", body)
+
+	// The signature is an HMAC-SHA256 over the request components and the digest of the body.
+	digest := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("POST\n/api/rest/v1/fiat/deposit\n1685923200\nnonce\n" + hex.EncodeToString(digest[:])))
+	require.Equal(t, hex.EncodeToString(mac.Sum(nil)), signature, "signature mismatch.")
+
+	// Any change to the request components changes the signature.
+	require.NotEqual(t, signature,
+		APIKeySignature("other", "POST", "/api/rest/v1/fiat/deposit", "1685923200", "nonce", body), "secret ignored.")
+	require.NotEqual(t, signature,
+		APIKeySignature("secret", "PUT", "/api/rest/v1/fiat/deposit", "1685923200", "nonce", body), "method ignored.")
+	require.NotEqual(t, signature,
+		APIKeySignature("secret", "POST", "/api/rest/v1/fiat/open", "1685923200", "nonce", body), "path ignored.")
+	require.NotEqual(t, signature,
+		APIKeySignature("secret", "POST", "/api/rest/v1/fiat/deposit", "1685923201", "nonce", body),
+		"timestamp ignored.")
+	require.NotEqual(t, signature,
+		APIKeySignature("secret", "POST", "/api/rest/v1/fiat/deposit", "1685923200", "other", body), "nonce ignored.")
+	require.NotEqual(t, signature,
+		APIKeySignature("secret", "POST", "/api/rest/v1/fiat/deposit", "1685923200", "nonce", nil), "body ignored.")
+}
+
 func TestAuthImpl_RefreshJWT(t *testing.T) {
 	t.Parallel()
 
@@ -310,7 +589,7 @@ func TestAuthImpl_RefreshJWT(t *testing.T) {
 
 			testAuthImpl.conf.JWTConfig.ExpirationDuration = test.expirationDuration
 
-			testJWT, err := testAuthImpl.GenerateJWT(clientID)
+			testJWT, err := testAuthImpl.GenerateJWT(clientID, constants.RoleAdmin())
 			require.NoError(t, err, "failed to create initial JWT")
 
 			actualClientID, expiresAt, err := testAuthImpl.ValidateJWT(testJWT.Token)
@@ -319,7 +598,7 @@ func TestAuthImpl_RefreshJWT(t *testing.T) {
 			require.Greater(t, expiresAt, int64(0), "invalid expiration time of original token")
 
 			time.Sleep(time.Duration(test.sleepTime) * time.Second)
-			refreshedToken, err := testAuthImpl.RefreshJWT(testJWT.Token)
+			refreshedToken, err := testAuthImpl.RefreshJWT(testJWT.Token, constants.RoleAdmin())
 			test.expectErr(t, err, "error case when refreshing JWT failed")
 
 			if err != nil {
@@ -331,10 +610,21 @@ func TestAuthImpl_RefreshJWT(t *testing.T) {
 					time.Duration(testAuthImpl.conf.JWTConfig.ExpirationDuration-1)*time.Second).Unix(),
 				"token expires before the required deadline")
 
-			actualClientID, expiresAt, err = testAuthImpl.ValidateJWT(testJWT.Token)
-			require.NoErrorf(t, err, "failed to validate refreshed JWT")
+			actualClientID, expiresAt, err = testAuthImpl.ValidateJWTScopes(refreshedToken.Token,
+				constants.ScopeAdminWrite())
+			require.NoErrorf(t, err, "failed to validate refreshed JWT with retained scopes")
 			require.Equal(t, clientID, actualClientID, "failed to extract correct clientID from refreshed JWT")
 			require.Greater(t, expiresAt, int64(0), "invalid expiration time of refreshed token")
+
+			// A client whose role has changed is issued a JWT with the scopes of their current role.
+			demotedToken, err := testAuthImpl.RefreshJWT(testJWT.Token, constants.RoleUser())
+			require.NoError(t, err, "failed to refresh JWT of demoted client")
+
+			_, _, err = testAuthImpl.ValidateJWTScopes(demotedToken.Token, constants.ScopeAdminWrite())
+			require.Error(t, err, "refreshed JWT retained the scopes of the original role")
+
+			_, _, err = testAuthImpl.ValidateJWTScopes(demotedToken.Token, constants.ScopeUser())
+			require.NoError(t, err, "refreshed JWT does not carry the scopes of the current role")
 		})
 	}
 }
@@ -346,6 +636,29 @@ func TestAuthImpl_RefreshThreshold(t *testing.T) {
 		"token refresh threshold did not match expected threshold")
 }
 
+func TestAuthImpl_ExpirationDuration(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, expirationDuration, testAuth.ExpirationDuration(),
+		"token expiration duration did not match expected duration")
+}
+
+func TestAuthImpl_GenerateRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	token1, hash1, err := testAuth.GenerateRefreshToken()
+	require.NoError(t, err, "failed to generate first refresh token.")
+	token2, hash2, err := testAuth.GenerateRefreshToken()
+	require.NoError(t, err, "failed to generate second refresh token.")
+
+	require.NotEqual(t, token1, token2, "refresh tokens are not unique.")
+	require.NotEqual(t, hash1, hash2, "refresh token hashes are not unique.")
+	require.Equal(t, hash1, testAuth.HashRefreshToken(token1), "refresh token hash mismatch.")
+	require.Len(t, hash1, 64, "refresh token hash length mismatch.")
+	require.NotContains(t, token1, "/", "refresh token is not URL safe.")
+	require.NotContains(t, token1, "=", "refresh token is padded.")
+}
+
 func TestAuthImpl_encryptAES256_and_decryptAES256(t *testing.T) {
 	t.Parallel()
 
@@ -368,13 +681,13 @@ func TestAuthImpl_encryptAES256_and_decryptAES256(t *testing.T) {
 			t.Parallel()
 
 			// Encrypt phase.
-			cipherStr, cipherBytes, err := testAuth.encryptAES256([]byte(test.plainText))
+			cipherStr, cipherBytes, err := encryptAES256(testAuth.cryptoSecret, []byte(test.plainText))
 			require.NoError(t, err, "error encrypting to AES256")
 			require.NotNil(t, cipherBytes, "no cipher block returned as bytes")
 			test.expectStr(t, len(cipherStr) > 0, "cipher string expectation failed")
 
 			// Decrypt phase.
-			plainTextBytes, err := testAuth.decryptAES256(cipherBytes)
+			plainTextBytes, err := decryptAES256(testAuth.cryptoSecret, cipherBytes)
 			require.NoError(t, err, "error decrypting from AES256")
 			require.NotNil(t, plainTextBytes, "no plaintext block returned as bytes")
 			require.Equal(t, test.plainText, string(plainTextBytes), "decrypted cipher does not match input plaintext")
@@ -394,6 +707,163 @@ func TestAuthImpl_Encrypt_Decrypt_String(t *testing.T) {
 	plaintext, err := testAuth.DecryptFromString(ciphertext)
 	require.NoError(t, err, "encrypt from string failed")
 	require.Equal(t, toEncrypt, string(plaintext), "decrypted string does not match original")
+
+	// Ciphertexts carry the ID of the crypto secret they were encrypted with.
+	require.True(t, strings.HasPrefix(ciphertext, "test."), "ciphertext not prefixed with crypto secret id")
+
+	_, err = testAuth.DecryptFromString("unknown." + strings.TrimPrefix(ciphertext, "test."))
+	require.Error(t, err, "decrypted ciphertext with unknown crypto secret id")
+
+	_, err = testAuth.DecryptFromString("test.dGVzdA==")
+	require.Error(t, err, "decrypted ciphertext shorter than the nonce")
+}
+
+func TestAuthImpl_Encrypt_Decrypt_String_Rotated(t *testing.T) {
+	t.Parallel()
+
+	rotated := testRotatedConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	toEncrypt := "this is a text string to be encrypted/decrypted"
+
+	// Ciphertexts encrypted before the crypto secret was rotated out are still decrypted.
+	ciphertext, err := testAuth.EncryptToString([]byte(toEncrypt))
+	require.NoError(t, err, "encrypt to string failed")
+
+	plaintext, err := rotated.DecryptFromString(ciphertext)
+	require.NoError(t, err, "decrypt with rotated out crypto secret failed")
+	require.Equal(t, toEncrypt, string(plaintext), "decrypted string does not match original")
+
+	// Ciphertexts are encrypted with the crypto secret that was rotated in.
+	ciphertext, err = rotated.EncryptToString([]byte(toEncrypt))
+	require.NoError(t, err, "encrypt to string with rotated in crypto secret failed")
+	require.True(t, strings.HasPrefix(ciphertext, "rotated."), "ciphertext not prefixed with crypto secret id")
+
+	_, err = testAuth.DecryptFromString(ciphertext)
+	require.Error(t, err, "decrypted ciphertext with crypto secret that is not configured")
+
+	// Ciphertexts encrypted before crypto secret IDs were introduced are decrypted with any of the crypto secrets.
+	legacy, _, err := encryptAES256(testAuth.cryptoSecret, []byte(toEncrypt))
+	require.NoError(t, err, "legacy encrypt failed")
+
+	for _, authority := range []*authImpl{testAuth, rotated} {
+		plaintext, err = authority.DecryptFromString(legacy)
+		require.NoError(t, err, "legacy decrypt failed")
+		require.Equal(t, toEncrypt, string(plaintext), "decrypted legacy string does not match original")
+	}
+
+	legacy, _, err = encryptAES256([]byte("**another crypto key for tests**"), []byte(toEncrypt))
+	require.NoError(t, err, "legacy encrypt with another crypto secret failed")
+
+	_, err = rotated.DecryptFromString(legacy)
+	require.Error(t, err, "decrypted legacy ciphertext encrypted with crypto secret that is not configured")
+}
+
+func TestAuthImpl_ReencryptString(t *testing.T) {
+	t.Parallel()
+
+	rotated := testRotatedConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	toEncrypt := "this is a text string to be re-encrypted"
+
+	original, err := testAuth.EncryptToString([]byte(toEncrypt))
+	require.NoError(t, err, "encrypt to string failed")
+
+	// Ciphertexts encrypted with the active crypto secret are left as they are.
+	ciphertext, reencrypted, err := testAuth.ReencryptString(original)
+	require.NoError(t, err, "re-encrypt with active crypto secret failed")
+	require.False(t, reencrypted, "re-encrypted ciphertext encrypted with active crypto secret")
+	require.Equal(t, original, ciphertext, "ciphertext encrypted with active crypto secret changed")
+
+	// Ciphertexts encrypted with a rotated out crypto secret are encrypted with the active one.
+	ciphertext, reencrypted, err = rotated.ReencryptString(original)
+	require.NoError(t, err, "re-encrypt with rotated out crypto secret failed")
+	require.True(t, reencrypted, "did not re-encrypt ciphertext encrypted with rotated out crypto secret")
+	require.True(t, strings.HasPrefix(ciphertext, "rotated."),
+		"re-encrypted ciphertext not prefixed with crypto secret id")
+
+	plaintext, err := rotated.DecryptFromString(ciphertext)
+	require.NoError(t, err, "decrypt re-encrypted ciphertext failed")
+	require.Equal(t, toEncrypt, string(plaintext), "re-encrypted string does not match original")
+
+	_, _, err = rotated.ReencryptString("unknown.dGVzdA==")
+	require.Error(t, err, "re-encrypted ciphertext with unknown crypto secret id")
+}
+
+func TestNewJWTKey_NewCryptoSecret(t *testing.T) {
+	t.Parallel()
+
+	key, err := NewJWTKey()
+	require.NoError(t, err, "failed to generate JWT key")
+	require.Len(t, key, 64, "JWT key length mismatch")
+
+	secret, err := NewCryptoSecret()
+	require.NoError(t, err, "failed to generate crypto secret")
+	require.Len(t, secret, 32, "crypto secret length mismatch")
+
+	other, err := NewCryptoSecret()
+	require.NoError(t, err, "failed to generate second crypto secret")
+	require.NotEqual(t, secret, other, "crypto secrets are not random")
+
+	_, _, err = encryptAES256([]byte(secret), []byte("generated crypto secrets are valid AES256 keys"))
+	require.NoError(t, err, "generated crypto secret is not a valid AES256 key")
+}
+
+func TestAuthImpl_SignCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	message := []byte("fiat_journal|42|checkpoint hash")
+
+	signature := testAuth.SignCheckpoint(message)
+	require.Len(t, signature, ed25519.SignatureSize, "signature size mismatch")
+	require.True(t, ed25519.Verify(testAuth.CheckpointPublicKey(), message, signature), "signature failed to verify")
+	require.False(t, ed25519.Verify(testAuth.CheckpointPublicKey(), []byte("tampered"), signature),
+		"signature verified tampered message")
+
+	require.Equal(t, "test", testAuth.CheckpointKeyID(), "key id mismatch")
+	require.Equal(t, map[string]ed25519.PublicKey{"test": testAuth.CheckpointPublicKey()},
+		testAuth.CheckpointPublicKeys(), "public keys mismatch")
+
+	// The key is independent of the crypto secret.
+	other := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	other.conf.General.CryptoSecret = "**another crypto key for tests**"
+	require.NoError(t, other.loadKeys(), "failed to load key sets")
+	require.Equal(t, testAuth.CheckpointPublicKey(), other.CheckpointPublicKey(), "public key derived from secret")
+
+	// Checkpoints are signed with the active key, and the retired keys are kept for verification.
+	rotated := testRotatedConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	require.Equal(t, "rotated", rotated.CheckpointKeyID(), "rotated key id mismatch")
+	require.Equal(t,
+		map[string]ed25519.PublicKey{"rotated": rotated.CheckpointPublicKey(), "test": testAuth.CheckpointPublicKey()},
+		rotated.CheckpointPublicKeys(), "rotated public keys mismatch")
+	require.NotEqual(t, testAuth.CheckpointPublicKey(), rotated.CheckpointPublicKey(), "active public key not rotated")
+	require.True(t, ed25519.Verify(rotated.CheckpointPublicKey(), message, rotated.SignCheckpoint(message)),
+		"signature with rotated in key failed to verify")
+
+	// Malformed keys are rejected.
+	malformed := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	malformed.conf.Checkpoint.Key = "not a hex encoded key"
+	require.Error(t, malformed.loadKeys(), "malformed signing key loaded")
+
+	malformed = testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	malformed.conf.Checkpoint.VerificationKeys = []checkpointKey{{KeyID: "retired", PublicKey: "0123"}}
+	require.Error(t, malformed.loadKeys(), "malformed public key loaded")
+}
+
+func TestNewCheckpointKey(t *testing.T) {
+	t.Parallel()
+
+	seed, public, err := NewCheckpointKey()
+	require.NoError(t, err, "failed to generate checkpoint key")
+	require.Len(t, seed, 2*ed25519.SeedSize, "seed length mismatch")
+	require.Len(t, public, 2*ed25519.PublicKeySize, "public key length mismatch")
+
+	other, _, err := NewCheckpointKey()
+	require.NoError(t, err, "failed to generate second checkpoint key")
+	require.NotEqual(t, seed, other, "checkpoint keys are not random")
+
+	// The generated key loads and its public key matches.
+	authority := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	authority.conf.Checkpoint.Key = seed
+	require.NoError(t, authority.loadKeys(), "generated checkpoint key failed to load")
+	require.Equal(t, public, hex.EncodeToString(authority.CheckpointPublicKey()), "public key mismatch")
 }
 
 func TestAuth_AuthFromGinCtx(t *testing.T) {
@@ -9,27 +9,86 @@ import (
 )
 
 // Config contains all the configurations for authentication.
+//
+//nolint:lll
 type config struct {
-	JWTConfig jwtConfig     `json:"jwt,omitempty"     mapstructure:"jwt"     validate:"required" yaml:"jwt,omitempty"`
-	General   generalConfig `json:"general,omitempty" mapstructure:"general" validate:"required" yaml:"general,omitempty"`
+	JWTConfig  jwtConfig        `json:"jwt,omitempty"        mapstructure:"jwt"        validate:"required" yaml:"jwt,omitempty"`
+	General    generalConfig    `json:"general,omitempty"    mapstructure:"general"    validate:"required" yaml:"general,omitempty"`
+	Login      loginConfig      `json:"login,omitempty"      mapstructure:"login"      validate:"required" yaml:"login,omitempty"`
+	Checkpoint checkpointConfig `json:"checkpoint,omitempty" mapstructure:"checkpoint" validate:"required" yaml:"checkpoint,omitempty"`
 }
 
-// jwtConfig contains the configurations for JWT creation and verification.
+// jwtConfig contains the configurations for JWT creation and verification. JWTs are signed with the key and its ID, and
+// are validated with the key whose ID they carry, which may also be one of the verification keys.
 //
 //nolint:lll
 type jwtConfig struct {
-	Key                string `json:"key,omitempty"                mapstructure:"key"                validate:"required,min=8,max=256"                     yaml:"key,omitempty"`
-	Issuer             string `json:"issuer,omitempty"             mapstructure:"issuer"             validate:"required"                                   yaml:"issuer,omitempty"`
-	ExpirationDuration int64  `json:"expirationDuration,omitempty" mapstructure:"expirationDuration" validate:"required,min=60,gtefield=RefreshThreshold"  yaml:"expirationDuration,omitempty"`
-	RefreshThreshold   int64  `json:"refreshThreshold,omitempty"   mapstructure:"refreshThreshold"   validate:"required,min=1,ltefield=ExpirationDuration" yaml:"refreshThreshold,omitempty"`
+	KeyID              string   `json:"keyID,omitempty"              mapstructure:"keyID"              validate:"required,alphanum,max=16"                   yaml:"keyID,omitempty"`
+	Key                string   `json:"key,omitempty"                mapstructure:"key"                validate:"required,min=8,max=256"                     yaml:"key,omitempty"`
+	VerificationKeys   []jwtKey `json:"verificationKeys,omitempty"   mapstructure:"verificationKeys"   validate:"omitempty,dive"                             yaml:"verificationKeys,omitempty"`
+	Issuer             string   `json:"issuer,omitempty"             mapstructure:"issuer"             validate:"required"                                   yaml:"issuer,omitempty"`
+	ExpirationDuration int64    `json:"expirationDuration,omitempty" mapstructure:"expirationDuration" validate:"required,min=60,gtefield=RefreshThreshold"  yaml:"expirationDuration,omitempty"`
+	RefreshThreshold   int64    `json:"refreshThreshold,omitempty"   mapstructure:"refreshThreshold"   validate:"required,min=1,ltefield=ExpirationDuration" yaml:"refreshThreshold,omitempty"`
+}
+
+// jwtKey is a JWT signing key that is only used to validate JWTs, such as a key that is being rotated in or out.
+//
+//nolint:lll
+type jwtKey struct {
+	KeyID string `json:"keyID,omitempty" mapstructure:"keyID" validate:"required,alphanum,max=16" yaml:"keyID,omitempty"`
+	Key   string `json:"key,omitempty"   mapstructure:"key"   validate:"required,min=8,max=256"   yaml:"key,omitempty"`
 }
 
-// generalConfig contains the configurations for general encryption.
+// generalConfig contains the configurations for general encryption. Data is encrypted with the crypto secret and its
+// ID, and is decrypted with the secret whose ID it carries, which may also be one of the decryption secrets.
 //
 //nolint:lll
 type generalConfig struct {
-	BcryptCost   int    `json:"bcryptCost,omitempty"   mapstructure:"bcryptCost"   validate:"required,min=4,max=31" yaml:"bcryptCost,omitempty"`
-	CryptoSecret string `json:"cryptoSecret,omitempty" mapstructure:"cryptoSecret" validate:"required,len=32"       yaml:"cryptoSecret,omitempty"`
+	BcryptCost        int            `json:"bcryptCost,omitempty"        mapstructure:"bcryptCost"        validate:"required,min=4,max=31"    yaml:"bcryptCost,omitempty"`
+	CryptoSecretID    string         `json:"cryptoSecretID,omitempty"    mapstructure:"cryptoSecretID"    validate:"required,alphanum,max=16" yaml:"cryptoSecretID,omitempty"`
+	CryptoSecret      string         `json:"cryptoSecret,omitempty"      mapstructure:"cryptoSecret"      validate:"required,len=32"          yaml:"cryptoSecret,omitempty"`
+	DecryptionSecrets []cryptoSecret `json:"decryptionSecrets,omitempty" mapstructure:"decryptionSecrets" validate:"omitempty,dive"           yaml:"decryptionSecrets,omitempty"`
+}
+
+// cryptoSecret is a crypto secret that is only used to decrypt data, such as a secret that is being rotated in or out.
+//
+//nolint:lll
+type cryptoSecret struct {
+	SecretID string `json:"secretID,omitempty" mapstructure:"secretID" validate:"required,alphanum,max=16" yaml:"secretID,omitempty"`
+	Secret   string `json:"secret,omitempty"   mapstructure:"secret"   validate:"required,len=32"          yaml:"secret,omitempty"`
+}
+
+// checkpointConfig contains the configurations for signing journal hash chain checkpoints. Checkpoints are signed with
+// the hex encoded Ed25519 private key seed and its ID, and are verified with the public key whose ID they carry, which
+// may also be one of the verification keys.
+//
+//nolint:lll
+type checkpointConfig struct {
+	KeyID            string          `json:"keyID,omitempty"            mapstructure:"keyID"            validate:"required,alphanum,max=16"    yaml:"keyID,omitempty"`
+	Key              string          `json:"key,omitempty"              mapstructure:"key"              validate:"required,hexadecimal,len=64" yaml:"key,omitempty"`
+	VerificationKeys []checkpointKey `json:"verificationKeys,omitempty" mapstructure:"verificationKeys" validate:"omitempty,dive"              yaml:"verificationKeys,omitempty"`
+}
+
+// checkpointKey is a hex encoded Ed25519 public key that checkpoints are only verified with, such as the key of a
+// retired checkpoint signing key.
+//
+//nolint:lll
+type checkpointKey struct {
+	KeyID     string `json:"keyID,omitempty"     mapstructure:"keyID"     validate:"required,alphanum,max=16"    yaml:"keyID,omitempty"`
+	PublicKey string `json:"publicKey,omitempty" mapstructure:"publicKey" validate:"required,hexadecimal,len=64" yaml:"publicKey,omitempty"`
+}
+
+// loginConfig contains the configurations for throttling and locking out repeated failed login attempts. Durations are
+// in seconds.
+//
+//nolint:lll
+type loginConfig struct {
+	MaxUserFailures int64 `json:"maxUserFailures,omitempty" mapstructure:"maxUserFailures" validate:"required,min=1"              yaml:"maxUserFailures,omitempty"`
+	MaxIPFailures   int64 `json:"maxIPFailures,omitempty"   mapstructure:"maxIPFailures"   validate:"required,min=1"              yaml:"maxIPFailures,omitempty"`
+	FailureWindow   int64 `json:"failureWindow,omitempty"   mapstructure:"failureWindow"   validate:"required,min=60"             yaml:"failureWindow,omitempty"`
+	LockoutDuration int64 `json:"lockoutDuration,omitempty" mapstructure:"lockoutDuration" validate:"required,min=60"             yaml:"lockoutDuration,omitempty"`
+	BaseDelay       int64 `json:"baseDelay,omitempty"       mapstructure:"baseDelay"       validate:"required,min=1"              yaml:"baseDelay,omitempty"`
+	MaxDelay        int64 `json:"maxDelay,omitempty"        mapstructure:"maxDelay"        validate:"required,gtefield=BaseDelay" yaml:"maxDelay,omitempty"`
 }
 
 // newConfig creates a blank configuration struct for the authorization.
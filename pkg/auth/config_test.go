@@ -17,6 +17,8 @@ import (
 func TestAuthConfigs_Load(t *testing.T) {
 	keyspaceJwt := constants.AuthPrefix() + "_JWT."
 	keyspaceGen := constants.AuthPrefix() + "_GENERAL."
+	keyspaceLogin := constants.AuthPrefix() + "_LOGIN."
+	keyspaceCheckpoint := constants.AuthPrefix() + "_CHECKPOINT."
 
 	testCases := []struct {
 		name         string
@@ -28,12 +30,27 @@ func TestAuthConfigs_Load(t *testing.T) {
 			name:         "empty - etc dir",
 			input:        authConfigTestData["empty"],
 			expectErr:    require.Error,
-			expectErrCnt: 6,
+			expectErrCnt: 16,
 		}, {
 			name:         "valid - etc dir",
 			input:        authConfigTestData["valid"],
 			expectErr:    require.NoError,
 			expectErrCnt: 0,
+		}, {
+			name:         "valid rotating - etc dir",
+			input:        authConfigTestData["rotating"],
+			expectErr:    require.NoError,
+			expectErrCnt: 0,
+		}, {
+			name:         "invalid key ids - etc dir",
+			input:        authConfigTestData["invalid_key_ids"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
+		}, {
+			name:         "invalid checkpoint keys - etc dir",
+			input:        authConfigTestData["invalid_checkpoint_keys"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
 		}, {
 			name:         "no issuer - etc dir",
 			input:        authConfigTestData["no_issuer"],
@@ -84,6 +101,21 @@ func TestAuthConfigs_Load(t *testing.T) {
 			input:        authConfigTestData["crypto_key_too_long"],
 			expectErr:    require.Error,
 			expectErrCnt: 1,
+		}, {
+			name:         "login no thresholds - etc dir",
+			input:        authConfigTestData["login_no_thresholds"],
+			expectErr:    require.Error,
+			expectErrCnt: 2,
+		}, {
+			name:         "login max delay below base delay - etc dir",
+			input:        authConfigTestData["login_max_delay_below_base"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "login window below 60s - etc dir",
+			input:        authConfigTestData["login_window_below_60s"],
+			expectErr:    require.Error,
+			expectErrCnt: 2,
 		},
 	}
 
@@ -119,12 +151,18 @@ func TestAuthConfigs_Load(t *testing.T) {
 			testBcryptCost := 16
 			testIssuer := "test issuer"
 			testCryptoSecret := "**crypto secret set in env var**"
+			testMaxUserFailures := int64(7)
+			testMaxDelay := int64(45)
+			testCheckpointKeyID := "v9"
 			t.Setenv(keyspaceJwt+"KEY", testKey)
 			t.Setenv(keyspaceJwt+"ISSUER", testIssuer)
 			t.Setenv(keyspaceJwt+"EXPIRATIONDURATION", strconv.FormatInt(testExpDur, 10))
 			t.Setenv(keyspaceJwt+"REFRESHTHRESHOLD", strconv.FormatInt(testRefThreshold, 10))
 			t.Setenv(keyspaceGen+"BCRYPTCOST", strconv.Itoa(testBcryptCost))
 			t.Setenv(keyspaceGen+"CRYPTOSECRET", testCryptoSecret)
+			t.Setenv(keyspaceLogin+"MAXUSERFAILURES", strconv.FormatInt(testMaxUserFailures, 10))
+			t.Setenv(keyspaceLogin+"MAXDELAY", strconv.FormatInt(testMaxDelay, 10))
+			t.Setenv(keyspaceCheckpoint+"KEYID", testCheckpointKeyID)
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
@@ -139,6 +177,12 @@ func TestAuthConfigs_Load(t *testing.T) {
 				"Failed to load bcrypt cost environment variable into configs")
 			require.Equal(t, testCryptoSecret, actual.General.CryptoSecret,
 				"Failed to load crypto secret environment variable into configs")
+			require.Equal(t, testMaxUserFailures, actual.Login.MaxUserFailures,
+				"Failed to load max user failures environment variable into configs")
+			require.Equal(t, testMaxDelay, actual.Login.MaxDelay,
+				"Failed to load max delay environment variable into configs")
+			require.Equal(t, testCheckpointKeyID, actual.Checkpoint.KeyID,
+				"Failed to load checkpoint key id environment variable into configs")
 		})
 	}
 }
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+func TestAuthImpl_EmailVerificationToken(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate clientID.")
+
+	// Round trip with the email address normalized to lowercase.
+	token, err := testAuth.GenerateEmailVerificationToken(clientID, "User@Email-Address.com")
+	require.NoError(t, err, "failed to generate token.")
+
+	actualClientID, actualEmail, err := testAuth.ValidateEmailVerificationToken(token)
+	require.NoError(t, err, "failed to validate token.")
+	require.Equal(t, clientID, actualClientID, "client id mismatch.")
+	require.Equal(t, "user@email-address.com", actualEmail, "email address mismatch.")
+
+	// Malformed and tampered tokens.
+	_, _, err = testAuth.ValidateEmailVerificationToken("")
+	require.Error(t, err, "validated empty token.")
+
+	_, _, err = testAuth.ValidateEmailVerificationToken(token + "tampered")
+	require.Error(t, err, "validated tampered token.")
+
+	// The signing key is derived from the crypto secret.
+	other := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	other.conf.General.CryptoSecret = "**another crypto key for tests**"
+	require.NoError(t, other.loadKeys(), "failed to load key sets.")
+	_, _, err = other.ValidateEmailVerificationToken(token)
+	require.Error(t, err, "validated token signed with another crypto secret.")
+
+	// Tokens signed before the crypto secret was rotated out are still valid.
+	rotated := testRotatedConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	actualClientID, _, err = rotated.ValidateEmailVerificationToken(token)
+	require.NoError(t, err, "failed to validate token signed with rotated out crypto secret.")
+	require.Equal(t, clientID, actualClientID, "rotated client id mismatch.")
+
+	rotatedToken, err := rotated.GenerateEmailVerificationToken(clientID, "user@email-address.com")
+	require.NoError(t, err, "failed to generate token with rotated in crypto secret.")
+	_, _, err = testAuth.ValidateEmailVerificationToken(rotatedToken)
+	require.Error(t, err, "validated token signed with crypto secret that is not configured.")
+
+	// JWTs and email verification tokens cannot be used in place of one another.
+	authToken, err := testAuth.GenerateJWT(clientID, constants.RoleUser())
+	require.NoError(t, err, "failed to generate JWT.")
+	_, _, err = testAuth.ValidateEmailVerificationToken(authToken.Token)
+	require.Error(t, err, "validated JWT as an email verification token.")
+	_, _, err = testAuth.ValidateJWT(token)
+	require.Error(t, err, "validated email verification token as a JWT.")
+}
+
+func TestAuthImpl_ValidateEmailVerificationToken(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate clientID.")
+
+	testCases := []struct {
+		name         string
+		issuer       string
+		expiresAt    *jwt.NumericDate
+		method       jwt.SigningMethod
+		key          any
+		expectErrMsg string
+	}{
+		{
+			name:         "expired",
+			issuer:       testAuth.conf.JWTConfig.Issuer,
+			expiresAt:    jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			method:       jwt.SigningMethodHS256,
+			key:          emailVerificationKey(testAuth.cryptoSecret),
+			expectErrMsg: "expired",
+		}, {
+			name:         "no expiration",
+			issuer:       testAuth.conf.JWTConfig.Issuer,
+			expiresAt:    nil,
+			method:       jwt.SigningMethodHS256,
+			key:          emailVerificationKey(testAuth.cryptoSecret),
+			expectErrMsg: "expired",
+		}, {
+			name:         "invalid issuer",
+			issuer:       "some random name",
+			expiresAt:    jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			method:       jwt.SigningMethodHS256,
+			key:          emailVerificationKey(testAuth.cryptoSecret),
+			expectErrMsg: "issuer",
+		}, {
+			name:         "unsigned",
+			issuer:       testAuth.conf.JWTConfig.Issuer,
+			expiresAt:    jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			method:       jwt.SigningMethodNone,
+			key:          jwt.UnsafeAllowNoneSignatureType,
+			expectErrMsg: "parse",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := jwt.NewWithClaims(test.method, &emailVerificationClaim{
+				ClientID: clientID,
+				Email:    "user@email-address.com",
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer:    test.issuer,
+					ExpiresAt: test.expiresAt,
+				},
+			}).SignedString(test.key)
+			require.NoError(t, err, "failed to sign test token.")
+
+			_, _, err = testAuth.ValidateEmailVerificationToken(token)
+			require.Error(t, err, "validated invalid token.")
+			require.Contains(t, err.Error(), test.expectErrMsg, "error message did not contain expected err.")
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"time"
+)
+
+// LoginThrottle contains the limits that are applied to slow down and then lock out repeated failed login attempts.
+type LoginThrottle struct {
+	MaxUserFailures int64         // Failed attempts on a username within the failure window before a lockout.
+	MaxIPFailures   int64         // Failed attempts from an IP address within the failure window before a lockout.
+	FailureWindow   time.Duration // Time for which failed attempts are counted.
+	LockoutDuration time.Duration // Time for which a locked out username or IP address cannot log in.
+	BaseDelay       time.Duration // Delay imposed after the first consecutive failed attempt on a username.
+	MaxDelay        time.Duration // Upper bound on the delay between consecutive failed attempts on a username.
+}
+
+// Delay returns the time that must pass before another login attempt on a username is permitted after a number of
+// consecutive failed attempts. The delay doubles with every failure and is capped at the maximum delay.
+func (l *LoginThrottle) Delay(failures int64) time.Duration {
+	if failures < 1 {
+		return 0
+	}
+
+	delay := l.BaseDelay
+	for i := int64(1); i < failures && delay < l.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > l.MaxDelay {
+		delay = l.MaxDelay
+	}
+
+	return delay
+}
+
+// LoginThrottle returns the limits that are applied to repeated failed login attempts.
+func (a *authImpl) LoginThrottle() LoginThrottle {
+	return LoginThrottle{
+		MaxUserFailures: a.conf.Login.MaxUserFailures,
+		MaxIPFailures:   a.conf.Login.MaxIPFailures,
+		FailureWindow:   time.Duration(a.conf.Login.FailureWindow) * time.Second,
+		LockoutDuration: time.Duration(a.conf.Login.LockoutDuration) * time.Second,
+		BaseDelay:       time.Duration(a.conf.Login.BaseDelay) * time.Second,
+		MaxDelay:        time.Duration(a.conf.Login.MaxDelay) * time.Second,
+	}
+}
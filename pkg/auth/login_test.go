@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginThrottle_Delay(t *testing.T) {
+	t.Parallel()
+
+	throttle := LoginThrottle{BaseDelay: time.Second, MaxDelay: 30 * time.Second}
+
+	testCases := []struct {
+		name     string
+		failures int64
+		expected time.Duration
+	}{
+		{
+			name:     "no failures",
+			failures: 0,
+			expected: 0,
+		}, {
+			name:     "first failure",
+			failures: 1,
+			expected: time.Second,
+		}, {
+			name:     "second failure",
+			failures: 2,
+			expected: 2 * time.Second,
+		}, {
+			name:     "fifth failure",
+			failures: 5,
+			expected: 16 * time.Second,
+		}, {
+			name:     "capped",
+			failures: 6,
+			expected: 30 * time.Second,
+		}, {
+			name:     "many failures",
+			failures: 1000,
+			expected: 30 * time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, throttle.Delay(test.failures), "delay mismatch")
+		})
+	}
+}
+
+func TestAuthImpl_LoginThrottle(t *testing.T) {
+	t.Parallel()
+
+	throttle := testAuth.LoginThrottle()
+	require.Equal(t, testAuth.conf.Login.MaxUserFailures, throttle.MaxUserFailures, "max user failures mismatch")
+	require.Equal(t, testAuth.conf.Login.MaxIPFailures, throttle.MaxIPFailures, "max IP failures mismatch")
+	require.Equal(t, 15*time.Minute, throttle.FailureWindow, "failure window mismatch")
+	require.Equal(t, 15*time.Minute, throttle.LockoutDuration, "lockout duration mismatch")
+	require.Equal(t, time.Second, throttle.BaseDelay, "base delay mismatch")
+	require.Equal(t, 30*time.Second, throttle.MaxDelay, "max delay mismatch")
+}
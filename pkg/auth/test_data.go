@@ -10,111 +10,435 @@ func configTestData() map[string]string {
 
 		"valid": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"no_issuer": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"bcrypt_cost_below_4": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 2
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"bcrypt_cost_above_31": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 32
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"jwt_expiration_below_60s": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 59
   refreshThreshold: 40
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"jwt_key_below_8": `
 jwt:
+  keyID: v1
   key: kYzJdnp
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"jwt_key_above_256": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9UkYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9UkYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9UkYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9UkYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"low_refresh_threshold": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 0
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"refresh_threshold_gt_expiration": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 601
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"crypto_key_too_short": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
 
 		"crypto_key_too_long": `
 jwt:
+  keyID: v1
   key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
   issuer: FTeX, Inc.
   expirationDuration: 600
   refreshThreshold: 60
 general:
   bcryptCost: 8
-  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$*`,
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$*
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
+
+		"login_no_thresholds": `
+jwt:
+  keyID: v1
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
+
+		"login_max_delay_below_base": `
+jwt:
+  keyID: v1
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 10
+  maxDelay: 5
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
+
+		"login_window_below_60s": `
+jwt:
+  keyID: v1
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 59
+  lockoutDuration: 59
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
+		"rotating": `
+jwt:
+  keyID: v2
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  verificationKeys:
+    - keyID: v1
+      key: CJa61NnAr8EwNDcTrZt2wmexYk642r44YHxGYQBNGK6UPEVwy3MARzaG
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v2
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+  decryptionSecrets:
+    - secretID: v1
+      secret: ^Zt*.^Rzan_oy?bBwB,dc^XtPbBT_Pw5
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v2
+  key: 4d5c897e865b9e54d6bdc3d31a3a3c2ddf217b3ca99523c15847ffff3ce4b342
+  verificationKeys:
+    - keyID: v1
+      publicKey: 07706f2a744073c52240c71b731b7bb0475dcb8cad6955831a1755f0d5ffc363`,
+
+		"invalid_key_ids": `
+jwt:
+  keyID: v-2
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  verificationKeys:
+    - key: CJa61NnAr8EwNDcTrZt2wmexYk642r44YHxGYQBNGK6UPEVwy3MARzaG
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v2
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+  decryptionSecrets:
+    - secretID: v1
+      secret: ^Zt*.^Rzan_oy?bBwB,dc^XtPbBT_Pw
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
+		"duplicate_key_ids": `
+jwt:
+  keyID: v2
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  verificationKeys:
+    - keyID: v2
+      key: CJa61NnAr8EwNDcTrZt2wmexYk642r44YHxGYQBNGK6UPEVwy3MARzaG
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v2
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v1
+  key: 0ecf61f49841d3cf84af3e45d608845653e6670a2489c7aadbbe44d548402a38`,
+		"invalid_checkpoint_keys": `
+jwt:
+  keyID: v1
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v2
+  key: 4d5c897e865b9e54d6bdc3d31a3a3c2ddf217b3ca99523c15847ffff3ce4b3
+  verificationKeys:
+    - publicKey: 07706f2a744073c52240c71b731b7bb0475dcb8cad6955831a1755f0d5ffc3zz`,
+		"duplicate_checkpoint_key_ids": `
+jwt:
+  keyID: v1
+  key: kYzJdnpm6Lj2E7AobZ35RE2itZ2ws82U5tcxrVmeQq1gA4mUfzYQ9t9U
+  issuer: FTeX, Inc.
+  expirationDuration: 600
+  refreshThreshold: 60
+general:
+  bcryptCost: 8
+  cryptoSecretID: v1
+  cryptoSecret: Xp2s5v8y/B?E(H+MbQeShVmYq3t6w9z$
+login:
+  maxUserFailures: 5
+  maxIPFailures: 20
+  failureWindow: 900
+  lockoutDuration: 900
+  baseDelay: 1
+  maxDelay: 30
+checkpoint:
+  keyID: v2
+  key: 4d5c897e865b9e54d6bdc3d31a3a3c2ddf217b3ca99523c15847ffff3ce4b342
+  verificationKeys:
+    - keyID: v2
+      publicKey: 07706f2a744073c52240c71b731b7bb0475dcb8cad6955831a1755f0d5ffc363`,
 	}
 }
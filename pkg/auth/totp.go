@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// totpEncoding is the unpadded Base32 encoding that authenticator applications expect secrets in.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates a random Base32 encoded secret for RFC 6238 time-based one-time passwords.
+func NewTOTPSecret() (string, error) {
+	// RFC 4226 recommends a 160-bit secret for HMAC-SHA1.
+	secret := make([]byte, 20)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret %w", err)
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPURI generates the otpauth URI that authenticator applications use to enroll a TOTP secret for an account.
+func TOTPURI(account, secret string) string {
+	issuer := constants.TOTPIssuer()
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(constants.TOTPDigits()))
+	params.Set("period", fmt.Sprint(int64(constants.TOTPPeriod().Seconds())))
+
+	return fmt.Sprintf("otpauth://totp/%s:%s?%s", url.PathEscape(issuer), url.PathEscape(account), params.Encode())
+}
+
+// TOTPStep returns the RFC 6238 time step a point in time falls within.
+func TOTPStep(at time.Time) int64 {
+	return at.Unix() / int64(constants.TOTPPeriod().Seconds())
+}
+
+// TOTPCode generates the one-time password for a Base32 encoded secret at a time step using the RFC 4226 dynamic
+// truncation of an HMAC-SHA1 digest.
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("malformed TOTP secret %w", err)
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	digest := mac.Sum(nil)
+
+	offset := digest[len(digest)-1] & 0x0f
+	value := binary.BigEndian.Uint32(digest[offset:offset+4]) & 0x7fffffff
+
+	modulus := uint32(1)
+	for idx := 0; idx < constants.TOTPDigits(); idx++ {
+		modulus *= 10
+	}
+
+	return fmt.Sprintf("%0*d", constants.TOTPDigits(), value%modulus), nil
+}
+
+// ValidateTOTP checks a one-time password against the time steps around a point in time, tolerating clock drift. Time
+// steps at or before the last step that was used are rejected to prevent a one-time password from being replayed. The
+// time step the one-time password was generated for is returned.
+func ValidateTOTP(secret, code string, lastStep int64, at time.Time) (int64, error) {
+	current := TOTPStep(at)
+
+	for step := current - constants.TOTPSkewSteps(); step <= current+constants.TOTPSkewSteps(); step++ {
+		if step <= lastStep {
+			continue
+		}
+
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, err
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, nil
+		}
+	}
+
+	return 0, errors.New("invalid or previously used one-time password")
+}
+
+// NewRecoveryCodes generates a set of random single-use recovery codes, formatted as two groups of five Base32
+// characters, along with their hashes.
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, constants.RecoveryCodeCount())
+	hashes := make([]string, constants.RecoveryCodeCount())
+	// Seven bytes encode to eleven Base32 characters, of which the first ten are used.
+	raw := make([]byte, 7)
+
+	for idx := range codes {
+		if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery codes %w", err)
+		}
+
+		encoded := strings.ToLower(totpEncoding.EncodeToString(raw))
+		codes[idx] = encoded[:5] + "-" + encoded[5:10]
+		hashes[idx] = HashRecoveryCode(codes[idx])
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode will generate the hex encoded SHA-256 hash of a recovery code, ignoring letter case, spaces, and
+// hyphens. Recovery codes carry enough entropy that a salted and slow hash is not required.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// rfc6238Secret is the SHA-1 seed from the test vectors in Appendix B of RFC 6238.
+var rfc6238Secret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestNewTOTPSecret(t *testing.T) {
+	t.Parallel()
+
+	first, err := NewTOTPSecret()
+	require.NoError(t, err, "failed to generate first secret.")
+	require.Len(t, first, 32, "secret length mismatch.")
+
+	second, err := NewTOTPSecret()
+	require.NoError(t, err, "failed to generate second secret.")
+	require.NotEqual(t, first, second, "secrets are not random.")
+}
+
+func TestTOTPURI(t *testing.T) {
+	t.Parallel()
+
+	uri := TOTPURI("username1", "SECRET")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/FTeX:username1?"), "URI label mismatch.")
+	require.Contains(t, uri, "secret=SECRET", "URI secret mismatch.")
+	require.Contains(t, uri, "issuer=FTeX", "URI issuer mismatch.")
+	require.Contains(t, uri, "digits=6", "URI digits mismatch.")
+	require.Contains(t, uri, "period=30", "URI period mismatch.")
+}
+
+func TestTOTPCode(t *testing.T) {
+	t.Parallel()
+
+	// The RFC 6238 test vectors are eight digits long, and the six-digit codes are their last six digits.
+	testCases := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1111111111, expected: "050471"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+		{unix: 20000000000, expected: "353130"},
+	}
+
+	for _, test := range testCases {
+		code, err := TOTPCode(rfc6238Secret, TOTPStep(time.Unix(test.unix, 0)))
+		require.NoError(t, err, "failed to generate code at %d.", test.unix)
+		require.Equal(t, test.expected, code, "code mismatch at %d.", test.unix)
+	}
+
+	_, err := TOTPCode("not base32!", 1)
+	require.Error(t, err, "malformed secret accepted.")
+}
+
+func TestValidateTOTP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1234567890, 0)
+	current := TOTPStep(now)
+
+	previous, err := TOTPCode(rfc6238Secret, current-1)
+	require.NoError(t, err, "failed to generate previous code.")
+
+	next, err := TOTPCode(rfc6238Secret, current+1)
+	require.NoError(t, err, "failed to generate next code.")
+
+	stale, err := TOTPCode(rfc6238Secret, current-constants.TOTPSkewSteps()-1)
+	require.NoError(t, err, "failed to generate stale code.")
+
+	testCases := []struct {
+		name         string
+		code         string
+		lastStep     int64
+		expectedStep int64
+		expectErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:         "current",
+			code:         "005924",
+			lastStep:     0,
+			expectedStep: current,
+			expectErr:    require.NoError,
+		}, {
+			name:         "previous step",
+			code:         previous,
+			lastStep:     0,
+			expectedStep: current - 1,
+			expectErr:    require.NoError,
+		}, {
+			name:         "next step",
+			code:         next,
+			lastStep:     0,
+			expectedStep: current + 1,
+			expectErr:    require.NoError,
+		}, {
+			name:         "outside skew",
+			code:         stale,
+			lastStep:     0,
+			expectedStep: 0,
+			expectErr:    require.Error,
+		}, {
+			name:         "replayed",
+			code:         "005924",
+			lastStep:     current,
+			expectedStep: 0,
+			expectErr:    require.Error,
+		}, {
+			name:         "incorrect",
+			code:         "000000",
+			lastStep:     0,
+			expectedStep: 0,
+			expectErr:    require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			step, err := ValidateTOTP(rfc6238Secret, test.code, test.lastStep, now)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStep, step, "step mismatch.")
+		})
+	}
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	codes, hashes, err := NewRecoveryCodes()
+	require.NoError(t, err, "failed to generate recovery codes.")
+	require.Len(t, codes, constants.RecoveryCodeCount(), "recovery code count mismatch.")
+	require.Len(t, hashes, constants.RecoveryCodeCount(), "recovery code hash count mismatch.")
+
+	unique := make(map[string]struct{}, len(codes))
+
+	for idx, code := range codes {
+		require.Len(t, code, 11, "recovery code length mismatch.")
+		require.Equal(t, byte('-'), code[5], "recovery code format mismatch.")
+		require.Equal(t, HashRecoveryCode(code), hashes[idx], "recovery code hash mismatch.")
+
+		unique[code] = struct{}{}
+	}
+
+	require.Len(t, unique, len(codes), "recovery codes are not unique.")
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	t.Parallel()
+
+	expected := HashRecoveryCode("abcde-fghij")
+	require.Len(t, expected, 64, "hash length mismatch.")
+	require.Equal(t, expected, HashRecoveryCode("ABCDE-FGHIJ"), "letter case not ignored.")
+	require.Equal(t, expected, HashRecoveryCode("abcde fghij"), "spaces not ignored.")
+	require.Equal(t, expected, HashRecoveryCode("abcdefghij"), "hyphens not ignored.")
+	require.NotEqual(t, expected, HashRecoveryCode("abcde-fghik"), "distinct codes share a hash.")
+}
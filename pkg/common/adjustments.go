@@ -0,0 +1,253 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPAdminAdjustmentCreate will validate and record a request for a manual adjustment of a Fiat account. No entries
+// are posted until the adjustment is approved by a different administrator.
+func HTTPAdminAdjustmentCreate(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	request *models.HTTPAdminAdjustmentRequest) (*postgres.FiatAdjustment, int, string, any, error) {
+	var (
+		err            error
+		clientID       uuid.UUID
+		parsedCurrency []postgres.Currency
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if clientID, err = uuid.FromString(request.ClientID); err != nil {
+		return nil, http.StatusBadRequest, "invalid client id", request.ClientID, fmt.Errorf("%w", err)
+	}
+
+	// Validate the Fiat currency and the magnitude of the adjustment.
+	if request.Amount.IsZero() {
+		msg := "adjustment amount cannot be zero"
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	if parsedCurrency, err = HTTPValidateOfferRequest(
+		request.Amount.Abs(), constants.DecimalPlacesFiat(), request.Currency); err != nil {
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	adjustment := &postgres.FiatAdjustment{
+		AdjustmentID:  xid.New().String(),
+		ClientID:      clientID,
+		Currency:      parsedCurrency[0],
+		Amount:        request.Amount,
+		ReasonCode:    postgres.AdjustmentReason(request.ReasonCode),
+		Justification: request.Justification,
+		Status:        postgres.AdjustmentStatusPENDING,
+		MakerID:       adminID,
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionADJUSTMENTCREATE,
+		adjustment.AdjustmentID, map[string]any{
+			"clientID":   clientID,
+			"currency":   adjustment.Currency,
+			"amount":     adjustment.Amount,
+			"reasonCode": adjustment.ReasonCode,
+		})
+	if err != nil {
+		return nil, httpStatus, httpMsg, nil, err
+	}
+
+	err = db.FiatAdjustmentCreate(adjustment)
+	done(err)
+
+	if err != nil {
+		var adjustmentErr *postgres.Error
+		if !errors.As(err, &adjustmentErr) {
+			logger.Info("failed to unpack adjustment request error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, adjustmentErr.Code, adjustmentErr.Message, request.ClientID, fmt.Errorf("%w", err)
+	}
+
+	return adjustment, 0, "", nil, nil
+}
+
+// HTTPAdminAdjustmentDecide will approve or reject a pending manual adjustment of a Fiat account. Approvals post the
+// journal entries against the Fiat operations account. Adjustments cannot be decided by the administrator who requested
+// them, decided more than once, or approved if they would overdraw the account.
+func HTTPAdminAdjustmentDecide(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, adjustmentID string,
+	approve bool, request *models.HTTPAdminAdjustmentDecisionRequest) (*postgres.FiatAdjustment, int, string, any,
+	error) {
+	var (
+		err        error
+		action     = postgres.AdminActionADJUSTMENTREJECT
+		decide     = db.FiatAdjustmentReject
+		adjustment *postgres.FiatAdjustment
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if len(adjustmentID) < 1 || len(adjustmentID) > 32 {
+		msg := "invalid adjustment id"
+
+		return nil, http.StatusBadRequest, msg, adjustmentID, errors.New(msg)
+	}
+
+	if approve {
+		action = postgres.AdminActionADJUSTMENTAPPROVE
+		decide = db.FiatAdjustmentApprove
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, action, adjustmentID,
+		map[string]any{"note": request.Note})
+	if err != nil {
+		return nil, httpStatus, httpMsg, nil, err
+	}
+
+	adjustment, err = decide(adjustmentID, adminID, request.Note)
+	done(err)
+
+	if err != nil {
+		var adjustmentErr *postgres.Error
+		if !errors.As(err, &adjustmentErr) {
+			logger.Info("failed to unpack adjustment decision error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, adjustmentErr.Code, adjustmentErr.Message, adjustmentID, fmt.Errorf("%w", err)
+	}
+
+	return adjustment, 0, "", nil, nil
+}
+
+// HTTPAdminAdjustment will retrieve a manual adjustment of a Fiat account along with its decision, if any.
+func HTTPAdminAdjustment(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, adjustmentID string) (
+	*postgres.FiatAdjustment, int, string, error) {
+	var (
+		err        error
+		adjustment postgres.FiatAdjustment
+	)
+
+	if len(adjustmentID) < 1 || len(adjustmentID) > 32 {
+		msg := "invalid adjustment id"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionADJUSTMENTVIEW,
+		adjustmentID, nil)
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	adjustment, err = db.FiatAdjustmentGet(adjustmentID)
+	done(err)
+
+	if err != nil {
+		var adjustmentErr *postgres.Error
+		if !errors.As(err, &adjustmentErr) {
+			logger.Info("failed to unpack adjustment error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, adjustmentErr.Code, adjustmentErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return &adjustment, 0, "", nil
+}
+
+// HTTPAdminAdjustmentsPaginated will retrieve a page of manual adjustments of Fiat accounts, newest first. The
+// adjustments can be restricted to those with a specific status.
+func HTTPAdminAdjustmentsPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	status, pageCursor, pageSizeStr string, isREST bool) (*models.HTTPAdminAdjustmentsPaginated, int, string, error) {
+	var (
+		err         error
+		decrypted   []byte
+		pageSize    int32
+		nextPage    string
+		startID     string
+		adjustments models.HTTPAdminAdjustmentsPaginated
+	)
+
+	// Validate the status filter.
+	if len(status) > 0 && !postgres.AdjustmentStatus(status).Valid() {
+		msg := "invalid adjustment status"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if len(pageCursor) > 0 {
+		if decrypted, err = auth.DecryptFromString(pageCursor); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		startID = string(decrypted)
+	}
+
+	if pageSize, err = adminPageSize(pageSizeStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionADJUSTMENTVIEW, "",
+		map[string]any{"status": status})
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	adjustments.Adjustments, err = db.FiatAdjustmentsPaginated(startID, status, pageSize+1)
+	done(err)
+
+	if err != nil {
+		var adjustmentErr *postgres.Error
+		if !errors.As(err, &adjustmentErr) {
+			logger.Info("failed to unpack adjustments error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, adjustmentErr.Code, adjustmentErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(adjustments.Adjustments) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString([]byte(adjustments.Adjustments[pageSize].AdjustmentID)); err != nil {
+			logger.Error("failed to encrypt adjustment id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		adjustments.Adjustments = adjustments.Adjustments[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			adjustments.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+			if len(status) > 0 {
+				adjustments.Links.NextPage += "&status=" + url.QueryEscape(status)
+			}
+		} else {
+			adjustments.Links.PageCursor = nextPage
+		}
+	}
+
+	return &adjustments, 0, "", nil
+}
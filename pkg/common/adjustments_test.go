@@ -0,0 +1,613 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPAdminAdjustmentCreate(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	validRequest := func() *models.HTTPAdminAdjustmentRequest {
+		return &models.HTTPAdminAdjustmentRequest{
+			ClientID:      clientID.String(),
+			Currency:      "USD",
+			Amount:        decimal.NewFromFloat(-12.34),
+			ReasonCode:    "FEE_CORRECTION",
+			Justification: "duplicate wire fee",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		request       func() *models.HTTPAdminAdjustmentRequest
+		auditErr      error
+		auditTimes    int
+		createErr     error
+		createTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "validation",
+			request:       func() *models.HTTPAdminAdjustmentRequest { return &models.HTTPAdminAdjustmentRequest{} },
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid reason code",
+			request: func() *models.HTTPAdminAdjustmentRequest {
+				request := validRequest()
+				request.ReasonCode = "REFUND"
+
+				return request
+			},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "justification too long",
+			request: func() *models.HTTPAdminAdjustmentRequest {
+				request := validRequest()
+				request.Justification = strings.Repeat("x", 513)
+
+				return request
+			},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid client id",
+			request: func() *models.HTTPAdminAdjustmentRequest {
+				request := validRequest()
+				request.ClientID = "invalid-client-id"
+
+				return request
+			},
+			expectErrMsg:  "invalid client id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "zero amount",
+			request: func() *models.HTTPAdminAdjustmentRequest {
+				request := validRequest()
+				request.Amount = decimal.NewFromFloat(0)
+
+				return request
+			},
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid precision",
+			request: func() *models.HTTPAdminAdjustmentRequest {
+				request := validRequest()
+				request.Amount = decimal.NewFromFloat(1.234)
+
+				return request
+			},
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid currency",
+			request: func() *models.HTTPAdminAdjustmentRequest {
+				request := validRequest()
+				request.Currency = "INVALID"
+
+				return request
+			},
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			request:       validRequest,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db error",
+			request:       validRequest,
+			auditTimes:    1,
+			createErr:     errors.New("unknown db error"),
+			createTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "account not found",
+			request:       validRequest,
+			auditTimes:    1,
+			createErr:     postgres.ErrNotFound,
+			createTimes:   1,
+			expectErrMsg:  "not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:        "valid",
+			request:     validRequest,
+			auditTimes:  1,
+			createTimes: 1,
+			expectErr:   require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTCREATE, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAdjustmentCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			adminID := uuid.Must(uuid.NewV4())
+			actual, actualErrCode, actualErrMsg, _, err := HTTPAdminAdjustmentCreate(mockDB, zapLogger, adminID,
+				test.request())
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				require.Nil(t, actual, "adjustment returned on failure.")
+
+				return
+			}
+
+			require.Equal(t, postgres.AdjustmentStatusPENDING, actual.Status, "adjustment not pending.")
+			require.Equal(t, adminID, actual.MakerID, "maker mismatch.")
+			require.Equal(t, clientID, actual.ClientID, "client id mismatch.")
+			require.True(t, actual.Amount.Equal(decimal.NewFromFloat(-12.34)), "amount mismatch.")
+			require.NotEmpty(t, actual.AdjustmentID, "adjustment id not generated.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAdjustmentDecide(t *testing.T) {
+	t.Parallel()
+
+	const adjustmentID = "cjld2cjxh0000qzrmn831i7rn"
+
+	adjustment := &postgres.FiatAdjustment{AdjustmentID: adjustmentID, Status: postgres.AdjustmentStatusAPPROVED}
+
+	testCases := []struct {
+		name          string
+		adjustmentID  string
+		approve       bool
+		request       *models.HTTPAdminAdjustmentDecisionRequest
+		action        postgres.AdminAction
+		auditErr      error
+		auditTimes    int
+		decideErr     error
+		approveTimes  int
+		rejectTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "validation",
+			adjustmentID:  adjustmentID,
+			approve:       true,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{},
+			action:        postgres.AdminActionADJUSTMENTAPPROVE,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid adjustment id",
+			adjustmentID:  strings.Repeat("x", 33),
+			approve:       true,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			action:        postgres.AdminActionADJUSTMENTAPPROVE,
+			expectErrMsg:  "invalid adjustment id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			adjustmentID:  adjustmentID,
+			approve:       true,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			action:        postgres.AdminActionADJUSTMENTAPPROVE,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db error",
+			adjustmentID:  adjustmentID,
+			approve:       true,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			action:        postgres.AdminActionADJUSTMENTAPPROVE,
+			auditTimes:    1,
+			decideErr:     errors.New("unknown db error"),
+			approveTimes:  1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "self approval",
+			adjustmentID:  adjustmentID,
+			approve:       true,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			action:        postgres.AdminActionADJUSTMENTAPPROVE,
+			auditTimes:    1,
+			decideErr:     postgres.ErrAdjustmentMaker,
+			approveTimes:  1,
+			expectErrMsg:  "different administrator",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "already decided",
+			adjustmentID:  adjustmentID,
+			approve:       false,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{Note: "duplicate"},
+			action:        postgres.AdminActionADJUSTMENTREJECT,
+			auditTimes:    1,
+			decideErr:     postgres.ErrAdjustmentDecided,
+			rejectTimes:   1,
+			expectErrMsg:  "already been decided",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name:          "insufficient funds",
+			adjustmentID:  adjustmentID,
+			approve:       true,
+			request:       &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			action:        postgres.AdminActionADJUSTMENTAPPROVE,
+			auditTimes:    1,
+			decideErr:     postgres.ErrInsufficientFunds,
+			approveTimes:  1,
+			expectErrMsg:  postgres.ErrInsufficientFunds.Error(),
+			expectErrCode: http.StatusPaymentRequired,
+			expectErr:     require.Error,
+		}, {
+			name:         "valid approval",
+			adjustmentID: adjustmentID,
+			approve:      true,
+			request:      &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			action:       postgres.AdminActionADJUSTMENTAPPROVE,
+			auditTimes:   1,
+			approveTimes: 1,
+			expectErr:    require.NoError,
+		}, {
+			name:         "valid rejection",
+			adjustmentID: adjustmentID,
+			approve:      false,
+			request:      &models.HTTPAdminAdjustmentDecisionRequest{Note: "duplicate"},
+			action:       postgres.AdminActionADJUSTMENTREJECT,
+			auditTimes:   1,
+			rejectTimes:  1,
+			expectErr:    require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), test.action, test.adjustmentID, gomock.Any()).
+				Return(int64(1), test.auditErr).
+				Times(test.auditTimes)
+
+			mockDB.EXPECT().FiatAdjustmentApprove(test.adjustmentID, gomock.Any(), test.request.Note).
+				Return(adjustment, test.decideErr).
+				Times(test.approveTimes)
+
+			mockDB.EXPECT().FiatAdjustmentReject(test.adjustmentID, gomock.Any(), test.request.Note).
+				Return(adjustment, test.decideErr).
+				Times(test.rejectTimes)
+
+			actual, actualErrCode, actualErrMsg, _, err := HTTPAdminAdjustmentDecide(mockDB, zapLogger, uuid.UUID{},
+				test.adjustmentID, test.approve, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				require.Nil(t, actual, "adjustment returned on failure.")
+
+				return
+			}
+
+			require.Equal(t, adjustment, actual, "adjustment mismatch.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAdjustment(t *testing.T) {
+	t.Parallel()
+
+	const adjustmentID = "cjld2cjxh0000qzrmn831i7rn"
+
+	testCases := []struct {
+		name          string
+		adjustmentID  string
+		auditErr      error
+		auditTimes    int
+		getErr        error
+		getTimes      int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid adjustment id",
+			adjustmentID:  "",
+			expectErrMsg:  "invalid adjustment id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			adjustmentID:  adjustmentID,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db error",
+			adjustmentID:  adjustmentID,
+			auditTimes:    1,
+			getErr:        errors.New("unknown db error"),
+			getTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			adjustmentID:  adjustmentID,
+			auditTimes:    1,
+			getErr:        postgres.ErrNotFound,
+			getTimes:      1,
+			expectErrMsg:  "not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:         "valid",
+			adjustmentID: adjustmentID,
+			auditTimes:   1,
+			getTimes:     1,
+			expectErr:    require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTVIEW,
+					test.adjustmentID, gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAdjustmentGet(test.adjustmentID).
+					Return(postgres.FiatAdjustment{AdjustmentID: test.adjustmentID}, test.getErr).
+					Times(test.getTimes),
+			)
+
+			actual, actualErrCode, actualErrMsg, err := HTTPAdminAdjustment(mockDB, zapLogger, uuid.UUID{},
+				test.adjustmentID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, test.adjustmentID, actual.AdjustmentID, "adjustment id mismatch.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAdjustmentsPaginated(t *testing.T) {
+	t.Parallel()
+
+	fullPage := []postgres.FiatAdjustment{
+		{AdjustmentID: "4"}, {AdjustmentID: "3"}, {AdjustmentID: "2"}, {AdjustmentID: "1"}}
+
+	testCases := []struct {
+		name             string
+		status           string
+		pageCursor       string
+		pageSize         string
+		isREST           bool
+		expectedStartID  string
+		adjustments      []postgres.FiatAdjustment
+		decryptErr       error
+		decryptTimes     int
+		auditTimes       int
+		adjustmentsErr   error
+		adjustmentsTimes int
+		encryptErr       error
+		encryptTimes     int
+		expectedNextPage string
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid status",
+			status:        "CANCELLED",
+			pageSize:      "3",
+			isREST:        true,
+			expectErrMsg:  "invalid adjustment status",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid page cursor",
+			pageCursor:    "page-cursor",
+			pageSize:      "3",
+			isREST:        true,
+			decryptErr:    errors.New("decrypt failure"),
+			decryptTimes:  1,
+			expectErrMsg:  "invalid page cursor",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid page size",
+			pageSize:      "three",
+			isREST:        true,
+			expectErrMsg:  "invalid page cursor",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:             "unknown db failure",
+			pageSize:         "3",
+			isREST:           true,
+			auditTimes:       1,
+			adjustmentsErr:   errors.New("unknown error"),
+			adjustmentsTimes: 1,
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "encrypt failure",
+			pageSize:         "3",
+			isREST:           true,
+			adjustments:      fullPage,
+			auditTimes:       1,
+			adjustmentsTimes: 1,
+			encryptErr:       errors.New("encrypt failure"),
+			encryptTimes:     1,
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "last page",
+			status:           "PENDING",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "12",
+			adjustments:      []postgres.FiatAdjustment{{AdjustmentID: "12"}, {AdjustmentID: "11"}},
+			decryptTimes:     1,
+			auditTimes:       1,
+			adjustmentsTimes: 1,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page REST",
+			status:           "APPROVED",
+			pageSize:         "3",
+			isREST:           true,
+			adjustments:      fullPage,
+			auditTimes:       1,
+			adjustmentsTimes: 1,
+			encryptTimes:     1,
+			expectedNextPage: "?pageCursor=encrypted-cursor&pageSize=3&status=APPROVED",
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page GraphQL",
+			pageSize:         "3",
+			isREST:           false,
+			adjustments:      fullPage,
+			auditTimes:       1,
+			adjustmentsTimes: 1,
+			encryptTimes:     1,
+			expectedNextPage: "encrypted-cursor",
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			// Copy the page since it is truncated in place.
+			adjustments := append([]postgres.FiatAdjustment(nil), test.adjustments...)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(test.pageCursor).
+					Return([]byte("12"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTVIEW, "",
+					gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAdjustmentsPaginated(test.expectedStartID, test.status, int32(4)).
+					Return(adjustments, test.adjustmentsErr).
+					Times(test.adjustmentsTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-cursor", test.encryptErr).
+					Times(test.encryptTimes),
+			)
+
+			actual, actualErrCode, actualErrMsg, err := HTTPAdminAdjustmentsPaginated(mockAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.status, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.LessOrEqual(t, len(actual.Adjustments), 3, "page size exceeded.")
+
+				if test.isREST {
+					require.Equal(t, test.expectedNextPage, actual.Links.NextPage, "next page link mismatched.")
+				} else {
+					require.Equal(t, test.expectedNextPage, actual.Links.PageCursor, "page cursor mismatched.")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPAdminAuthorize checks that an administrator's account is active and that their current role grants the required
+// scopes. This guards against tokens that were issued before an administrator was demoted, frozen, or deleted.
+func HTTPAdminAuthorize(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, scopes ...string) (
+	int, string, error) {
+	status, err := db.UserGetStatus(clientID)
+	if err != nil {
+		logger.Error("unable to retrieve administrator account status", zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if status.IsDeleted {
+		msg := "request contains invalid or expired authorization token"
+
+		return http.StatusForbidden, msg, errors.New(msg)
+	}
+
+	if status.IsFrozen {
+		return http.StatusForbidden, constants.FrozenAccountString(), errors.New(constants.FrozenAccountString())
+	}
+
+	if !auth.HasScopes(auth.RoleScopes(status.Role), scopes...) {
+		msg := "administrative privileges required"
+
+		return http.StatusForbidden, msg, errors.New(msg)
+	}
+
+	return 0, "", nil
+}
+
+// AdminActionDone records the outcome of an administrative action once it has been carried out. It must be called
+// with the error, if any, that the action failed with.
+type AdminActionDone func(err error)
+
+// HTTPAdminAudit records an administrative action in the audit log. Actions are recorded before they are carried out
+// and must not proceed if they could not be recorded. The returned callback records the outcome of the action in the
+// audit log and the security audit log once it has been carried out. Actions without a recorded outcome did not finish.
+func HTTPAdminAudit(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, action postgres.AdminAction,
+	target string, details any) (AdminActionDone, int, string, error) {
+	var (
+		err        error
+		entryID    int64
+		rawDetails json.RawMessage
+	)
+
+	if details != nil {
+		if rawDetails, err = json.Marshal(details); err != nil {
+			logger.Error("failed to marshal administrative action details", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+	}
+
+	if entryID, err = db.AdminAuditLogCreate(adminID, action, target, rawDetails); err != nil {
+		var auditErr *postgres.Error
+		if !errors.As(err, &auditErr) {
+			logger.Info("failed to unpack audit log error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, auditErr.Code, auditErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Actions upon a user account are also recorded in the security audit log about that user.
+	subjectID, _ := uuid.FromString(target)
+
+	return func(actionErr error) {
+		// The action has already been carried out, so a failure to record its outcome is only logged.
+		if err := db.AdminAuditLogSetOutcome(entryID, auditOutcome(actionErr)); err != nil {
+			logger.Error("failed to record outcome of administrative action", zap.Int64("entryID", entryID),
+				zap.String("action", string(action)), zap.Error(err))
+		}
+
+		payload := map[string]any{"action": action, "target": target, "details": rawDetails}
+		if actionErr != nil {
+			payload["error"] = actionErr.Error()
+		}
+
+		auditEvent(db, logger, &postgres.AuditEventDetails{
+			EventType: postgres.AuditEventTypeADMINACTION,
+			Outcome:   auditOutcome(actionErr),
+			ActorID:   adminID,
+			SubjectID: subjectID,
+		}, payload)
+	}, 0, "", nil
+}
+
+// HTTPAdminTarget parses the Client ID of the user account an administrator is acting upon and records the action in
+// the audit log. The returned callback must be called with the outcome of the action once it has been carried out.
+func HTTPAdminTarget(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, clientIDStr string,
+	action postgres.AdminAction, details any) (uuid.UUID, AdminActionDone, int, string, error) {
+	clientID, err := uuid.FromString(clientIDStr)
+	if err != nil {
+		return uuid.UUID{}, nil, http.StatusBadRequest, "invalid client id", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, action, clientID.String(), details)
+	if err != nil {
+		return uuid.UUID{}, nil, httpStatus, httpMsg, err
+	}
+
+	return clientID, done, 0, "", nil
+}
+
+// adminPageSize will parse a page size and set bounds for bad input.
+func adminPageSize(pageSizeStr string) (int32, error) {
+	var (
+		err      error
+		pageSize int64
+	)
+
+	if len(pageSizeStr) > 0 {
+		if pageSize, err = strconv.ParseInt(pageSizeStr, 10, 32); err != nil {
+			return -1, fmt.Errorf("failed to parse page size")
+		}
+	}
+
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	return int32(pageSize), nil
+}
+
+// HTTPAdminUserSearch will search for user accounts with a Client ID, username, name, or email address that match the
+// query.
+func HTTPAdminUserSearch(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, query, limitStr string) (
+	[]modelsPostgres.UserProfile, int, string, error) {
+	var (
+		err      error
+		limit    int32
+		profiles []modelsPostgres.UserProfile
+	)
+
+	if query = strings.TrimSpace(query); len(query) < 3 {
+		msg := "search query must be at least 3 characters"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if limit, err = adminPageSize(limitStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid result limit", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionUSERSEARCH, "",
+		map[string]any{"query": query, "limit": limit})
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	profiles, err = db.UserSearch(query, limit)
+	done(err)
+
+	if err != nil {
+		var searchErr *postgres.Error
+		if !errors.As(err, &searchErr) {
+			logger.Info("failed to unpack user search error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, searchErr.Code, searchErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return profiles, 0, "", nil
+}
+
+// HTTPAdminUserView will retrieve the profile of a user account, excluding the login credentials.
+func HTTPAdminUserView(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, clientIDStr string) (
+	*modelsPostgres.UserProfile, int, string, error) {
+	clientID, done, httpStatus, httpMsg, err := HTTPAdminTarget(db, logger, adminID, clientIDStr,
+		postgres.AdminActionUSERVIEW, nil)
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	user, err := db.UserGetInfo(clientID)
+	done(err)
+
+	if err != nil {
+		msg := "user account not found"
+
+		return nil, http.StatusNotFound, msg, fmt.Errorf("%w", err)
+	}
+
+	return &modelsPostgres.UserProfile{
+		Username:      user.Username,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		ClientID:      user.ClientID,
+		Role:          user.Role,
+		IsDeleted:     user.IsDeleted,
+		IsFrozen:      user.IsFrozen,
+		EmailVerified: user.EmailVerified,
+	}, 0, "", nil
+}
+
+// HTTPAdminUserFreeze will freeze or unfreeze a user account. Frozen users cannot log in, refresh their tokens, or
+// access any authenticated endpoints.
+func HTTPAdminUserFreeze(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, clientIDStr string,
+	request *models.HTTPAdminFreezeRequest) (int, string, any, error) {
+	var (
+		err      error
+		clientID uuid.UUID
+		action   = postgres.AdminActionUSERUNFREEZE
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if clientID, err = uuid.FromString(clientIDStr); err != nil {
+		return http.StatusBadRequest, "invalid client id", clientIDStr, fmt.Errorf("%w", err)
+	}
+
+	if clientID == adminID {
+		msg := "administrators cannot freeze or unfreeze their own account"
+
+		return http.StatusBadRequest, msg, nil, errors.New(msg)
+	}
+
+	if *request.IsFrozen {
+		action = postgres.AdminActionUSERFREEZE
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, action, clientID.String(),
+		map[string]any{"reason": request.Reason})
+	if err != nil {
+		return httpStatus, httpMsg, nil, err
+	}
+
+	err = db.UserSetFrozen(clientID, *request.IsFrozen)
+	done(err)
+
+	if err != nil {
+		msg := "user account not found or deleted"
+
+		return http.StatusNotFound, msg, clientIDStr, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil, nil
+}
+
+// HTTPAdminAccountStatus will set the status of a user's Fiat or Cryptocurrency account. Accounts can have their debits
+// frozen, be frozen entirely, or be reactivated. The status of closed accounts cannot be changed.
+func HTTPAdminAccountStatus(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, clientIDStr, code string,
+	isCrypto bool, request *models.HTTPAdminAccountStatusRequest) (int, string, any, error) {
+	var (
+		err      error
+		clientID uuid.UUID
+		currency postgres.Currency
+		action   = postgres.AdminActionFIATACCOUNTSTATUS
+		status   = postgres.AccountStatus(request.Status)
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if clientID, err = uuid.FromString(clientIDStr); err != nil {
+		return http.StatusBadRequest, "invalid client id", clientIDStr, fmt.Errorf("%w", err)
+	}
+
+	// Validate the currency code or ticker.
+	if isCrypto {
+		action = postgres.AdminActionCRYPTOACCOUNTSTATUS
+
+		if len(code) < 1 || len(code) > 6 {
+			return http.StatusBadRequest, constants.InvalidCurrencyString(), code,
+				errors.New(constants.InvalidCurrencyString())
+		}
+	} else if err = currency.Scan(code); err != nil || !currency.Valid() {
+		return http.StatusBadRequest, constants.InvalidCurrencyString(), code, fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, action, clientID.String(),
+		map[string]any{"code": code, "status": status, "reason": request.Reason})
+	if err != nil {
+		return httpStatus, httpMsg, nil, err
+	}
+
+	if isCrypto {
+		err = db.CryptoAccountSetStatus(clientID, code, status)
+	} else {
+		err = db.FiatAccountSetStatus(clientID, currency, status)
+	}
+
+	done(err)
+
+	if err != nil {
+		msg := "account not found or closed"
+
+		return http.StatusNotFound, msg, code, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil, nil
+}
+
+// HTTPAdminAuditLog will retrieve a page of the administrative audit log, newest first, and prepare a link to the next
+// page of data. The entries can be restricted to those for a specific target.
+func HTTPAdminAuditLog(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	target, pageCursor, pageSizeStr string, isREST bool) (*models.HTTPAdminAuditLogPaginated, int, string, error) {
+	var (
+		err       error
+		decrypted []byte
+		pageSize  int32
+		nextPage  string
+		startID   int64 = math.MaxInt64
+		auditLog  models.HTTPAdminAuditLogPaginated
+	)
+
+	// Extract and assemble the page cursor and page size.
+	if len(pageCursor) > 0 {
+		if decrypted, err = auth.DecryptFromString(pageCursor); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		if startID, err = strconv.ParseInt(string(decrypted), 10, 64); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+	}
+
+	if pageSize, err = adminPageSize(pageSizeStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionAUDITLOGVIEW, target,
+		nil)
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	auditLog.Entries, err = db.AdminAuditLogPaginated(target, startID, pageSize+1)
+	done(err)
+
+	if err != nil {
+		var auditErr *postgres.Error
+		if !errors.As(err, &auditErr) {
+			logger.Info("failed to unpack audit log error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, auditErr.Code, auditErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(auditLog.Entries) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString(
+			[]byte(strconv.FormatInt(auditLog.Entries[pageSize].ID, 10))); err != nil {
+			logger.Error("failed to encrypt audit log entry id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		auditLog.Entries = auditLog.Entries[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			auditLog.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+			if len(target) > 0 {
+				auditLog.Links.NextPage += "&target=" + url.QueryEscape(target)
+			}
+		} else {
+			auditLog.Links.PageCursor = nextPage
+		}
+	}
+
+	return &auditLog, 0, "", nil
+}
@@ -0,0 +1,1062 @@
+package common
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPAdminAuthorize(t *testing.T) {
+	testCases := []struct {
+		name          string
+		status        modelsPostgres.UserStatus
+		statusErr     error
+		scopes        []string
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "db failure",
+			status:        modelsPostgres.UserStatus{},
+			statusErr:     errors.New("db failure"),
+			scopes:        []string{constants.ScopeAdminRead()},
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "deleted",
+			status:        modelsPostgres.UserStatus{Role: constants.RoleAdmin(), IsDeleted: true},
+			statusErr:     nil,
+			scopes:        []string{constants.ScopeAdminRead()},
+			expectErrMsg:  "invalid or expired",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "frozen",
+			status:        modelsPostgres.UserStatus{Role: constants.RoleAdmin(), IsFrozen: true},
+			statusErr:     nil,
+			scopes:        []string{constants.ScopeAdminRead()},
+			expectErrMsg:  constants.FrozenAccountString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "user",
+			status:        modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			statusErr:     nil,
+			scopes:        []string{constants.ScopeAdminRead()},
+			expectErrMsg:  "administrative privileges required",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "support write",
+			status:        modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			statusErr:     nil,
+			scopes:        []string{constants.ScopeAdminWrite()},
+			expectErrMsg:  "administrative privileges required",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "support read",
+			status:        modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			statusErr:     nil,
+			scopes:        []string{constants.ScopeAdminRead()},
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "admin write",
+			status:        modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			statusErr:     nil,
+			scopes:        []string{constants.ScopeAdminRead(), constants.ScopeAdminWrite()},
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().UserGetStatus(gomock.Any()).
+				Return(test.status, test.statusErr).
+				Times(1)
+
+			actualErrCode, actualErrMsg, err := HTTPAdminAuthorize(mockDB, zapLogger, uuid.UUID{}, test.scopes...)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAudit(t *testing.T) {
+	testCases := []struct {
+		name          string
+		details       any
+		auditErr      error
+		auditTimes    int
+		actionErr     error
+		eventTimes    int
+		expectOutcome postgres.AuditOutcome
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "unmarshallable details",
+			details:       map[string]any{"channel": make(chan int)},
+			auditErr:      nil,
+			auditTimes:    0,
+			eventTimes:    0,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			details:       nil,
+			auditErr:      errors.New("unknown error"),
+			auditTimes:    1,
+			eventTimes:    0,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "known db failure",
+			details:       nil,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			eventTimes:    0,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "no details",
+			details:       nil,
+			auditErr:      nil,
+			auditTimes:    1,
+			actionErr:     nil,
+			eventTimes:    1,
+			expectOutcome: postgres.AuditOutcomeSUCCESS,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "details",
+			details:       map[string]any{"reason": "suspicious activity"},
+			auditErr:      nil,
+			auditTimes:    1,
+			actionErr:     nil,
+			eventTimes:    1,
+			expectOutcome: postgres.AuditOutcomeSUCCESS,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "action failure",
+			details:       map[string]any{"reason": "suspicious activity"},
+			auditErr:      nil,
+			auditTimes:    1,
+			actionErr:     postgres.ErrNotFound,
+			eventTimes:    1,
+			expectOutcome: postgres.AuditOutcomeFAILURE,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERVIEW, "target", gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AdminAuditLogSetOutcome(int64(1), test.expectOutcome).
+					Return(nil).
+					Times(test.eventTimes),
+
+				mockDB.EXPECT().AuditEventCreate(gomock.Any()).
+					DoAndReturn(func(event *postgres.AuditEventDetails) error {
+						require.Equal(t, test.expectOutcome, event.Outcome, "audit event outcome mismatched.")
+
+						return nil
+					}).
+					Times(test.eventTimes),
+			)
+
+			done, actualErrCode, actualErrMsg, err := HTTPAdminAudit(mockDB, zapLogger, uuid.UUID{},
+				postgres.AdminActionUSERVIEW, "target", test.details)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				require.Nil(t, done, "completion callback returned on failure.")
+
+				return
+			}
+
+			done(test.actionErr)
+		})
+	}
+}
+
+func TestCommon_HTTPAdminUserSearch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		limit         string
+		expectedLimit int32
+		auditErr      error
+		auditTimes    int
+		searchErr     error
+		searchTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "short query",
+			query:         " ab ",
+			limit:         "",
+			expectedLimit: 10,
+			auditErr:      nil,
+			auditTimes:    0,
+			searchErr:     nil,
+			searchTimes:   0,
+			expectErrMsg:  "at least 3 characters",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid limit",
+			query:         "username",
+			limit:         "ten",
+			expectedLimit: 10,
+			auditErr:      nil,
+			auditTimes:    0,
+			searchErr:     nil,
+			searchTimes:   0,
+			expectErrMsg:  "invalid result limit",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			query:         "username",
+			limit:         "",
+			expectedLimit: 10,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			searchErr:     nil,
+			searchTimes:   0,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			query:         "username",
+			limit:         "",
+			expectedLimit: 10,
+			auditErr:      nil,
+			auditTimes:    1,
+			searchErr:     errors.New("unknown error"),
+			searchTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			query:         "username",
+			limit:         "5",
+			expectedLimit: 5,
+			auditErr:      nil,
+			auditTimes:    1,
+			searchErr:     postgres.ErrNotFound,
+			searchTimes:   1,
+			expectErrMsg:  "records not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "limit capped",
+			query:         "username",
+			limit:         "1000",
+			expectedLimit: 100,
+			auditErr:      nil,
+			auditTimes:    1,
+			searchErr:     nil,
+			searchTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "valid",
+			query:         "username",
+			limit:         "5",
+			expectedLimit: 5,
+			auditErr:      nil,
+			auditTimes:    1,
+			searchErr:     nil,
+			searchTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERSEARCH, "", gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserSearch("username", test.expectedLimit).
+					Return([]modelsPostgres.UserProfile{{}}, test.searchErr).
+					Times(test.searchTimes),
+			)
+
+			profiles, actualErrCode, actualErrMsg, err := HTTPAdminUserSearch(mockDB, zapLogger, uuid.UUID{},
+				test.query, test.limit)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Len(t, profiles, 1, "profiles mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAdminUserView(t *testing.T) {
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name          string
+		clientID      string
+		auditErr      error
+		auditTimes    int
+		infoErr       error
+		infoTimes     int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid client id",
+			clientID:      "invalid-client-id",
+			auditErr:      nil,
+			auditTimes:    0,
+			infoErr:       nil,
+			infoTimes:     0,
+			expectErrMsg:  "invalid client id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			clientID:      clientID.String(),
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			infoErr:       nil,
+			infoTimes:     0,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			clientID:      clientID.String(),
+			auditErr:      nil,
+			auditTimes:    1,
+			infoErr:       postgres.ErrNotFound,
+			infoTimes:     1,
+			expectErrMsg:  "user account not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			clientID:      clientID.String(),
+			auditErr:      nil,
+			auditTimes:    1,
+			infoErr:       nil,
+			infoTimes:     1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			user := modelsPostgres.User{
+				UserAccount: &modelsPostgres.UserAccount{
+					UserLoginCredentials: modelsPostgres.UserLoginCredentials{
+						Username: "username1",
+						Password: "hashed password",
+					},
+				},
+				ClientID:      clientID,
+				Role:          constants.RoleSupport(),
+				IsFrozen:      true,
+				EmailVerified: true,
+			}
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERVIEW, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(user, test.infoErr).
+					Times(test.infoTimes),
+			)
+
+			profile, actualErrCode, actualErrMsg, err := HTTPAdminUserView(mockDB, zapLogger, uuid.UUID{},
+				test.clientID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, user.Username, profile.Username, "username mismatched.")
+				require.Equal(t, user.Role, profile.Role, "role mismatched.")
+				require.True(t, profile.IsFrozen, "frozen status mismatched.")
+				require.True(t, profile.EmailVerified, "email verification status mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAdminUserFreeze(t *testing.T) {
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	adminID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate admin id")
+
+	isFrozen := true
+	isUnfrozen := false
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		request        *models.HTTPAdminFreezeRequest
+		expectedAction postgres.AdminAction
+		auditErr       error
+		auditTimes     int
+		frozenErr      error
+		frozenTimes    int
+		expectErrMsg   string
+		expectErrCode  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			clientID:       clientID.String(),
+			request:        &models.HTTPAdminFreezeRequest{},
+			expectedAction: postgres.AdminActionUSERFREEZE,
+			auditErr:       nil,
+			auditTimes:     0,
+			frozenErr:      nil,
+			frozenTimes:    0,
+			expectErrMsg:   constants.ValidationString(),
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "invalid client id",
+			clientID:       "invalid-client-id",
+			request:        &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			expectedAction: postgres.AdminActionUSERFREEZE,
+			auditErr:       nil,
+			auditTimes:     0,
+			frozenErr:      nil,
+			frozenTimes:    0,
+			expectErrMsg:   "invalid client id",
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "self freeze",
+			clientID:       adminID.String(),
+			request:        &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			expectedAction: postgres.AdminActionUSERFREEZE,
+			auditErr:       nil,
+			auditTimes:     0,
+			frozenErr:      nil,
+			frozenTimes:    0,
+			expectErrMsg:   "own account",
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "audit failure",
+			clientID:       clientID.String(),
+			request:        &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			expectedAction: postgres.AdminActionUSERFREEZE,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+			frozenErr:      nil,
+			frozenTimes:    0,
+			expectErrMsg:   "could not record",
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name:           "not found",
+			clientID:       clientID.String(),
+			request:        &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			expectedAction: postgres.AdminActionUSERFREEZE,
+			auditErr:       nil,
+			auditTimes:     1,
+			frozenErr:      postgres.ErrNotFoundUser,
+			frozenTimes:    1,
+			expectErrMsg:   "not found or deleted",
+			expectErrCode:  http.StatusNotFound,
+			expectErr:      require.Error,
+		}, {
+			name:           "freeze",
+			clientID:       clientID.String(),
+			request:        &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			expectedAction: postgres.AdminActionUSERFREEZE,
+			auditErr:       nil,
+			auditTimes:     1,
+			frozenErr:      nil,
+			frozenTimes:    1,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "unfreeze",
+			clientID:       clientID.String(),
+			request:        &models.HTTPAdminFreezeRequest{IsFrozen: &isUnfrozen, Reason: "investigation closed"},
+			expectedAction: postgres.AdminActionUSERUNFREEZE,
+			auditErr:       nil,
+			auditTimes:     1,
+			frozenErr:      nil,
+			frozenTimes:    1,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(adminID, test.expectedAction, clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserSetFrozen(clientID, gomock.Any()).
+					Return(test.frozenErr).
+					Times(test.frozenTimes),
+			)
+
+			actualErrCode, actualErrMsg, _, err := HTTPAdminUserFreeze(mockDB, zapLogger, adminID, test.clientID,
+				test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAccountStatus(t *testing.T) {
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	adminID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate admin id")
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		code           string
+		isCrypto       bool
+		request        *models.HTTPAdminAccountStatusRequest
+		expectedAction postgres.AdminAction
+		auditErr       error
+		auditTimes     int
+		fiatErr        error
+		fiatTimes      int
+		cryptoErr      error
+		cryptoTimes    int
+		expectErrMsg   string
+		expectErrCode  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			clientID:       clientID.String(),
+			code:           "USD",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     0,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   constants.ValidationString(),
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "closed status",
+			clientID:       clientID.String(),
+			code:           "USD",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "CLOSED", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     0,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   constants.ValidationString(),
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "invalid client id",
+			clientID:       "invalid-client-id",
+			code:           "USD",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     0,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   "invalid client id",
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "invalid currency",
+			clientID:       clientID.String(),
+			code:           "INVALID",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     0,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   constants.InvalidCurrencyString(),
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "invalid ticker",
+			clientID:       clientID.String(),
+			code:           "INVALID",
+			isCrypto:       true,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionCRYPTOACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     0,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   constants.InvalidCurrencyString(),
+			expectErrCode:  http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:           "audit failure",
+			clientID:       clientID.String(),
+			code:           "USD",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   "could not record",
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name:           "fiat not found",
+			clientID:       clientID.String(),
+			code:           "USD",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     1,
+			fiatErr:        postgres.ErrNotFound,
+			fiatTimes:      1,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   "not found or closed",
+			expectErrCode:  http.StatusNotFound,
+			expectErr:      require.Error,
+		}, {
+			name:           "crypto not found",
+			clientID:       clientID.String(),
+			code:           "BTC",
+			isCrypto:       true,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionCRYPTOACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     1,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      postgres.ErrNotFound,
+			cryptoTimes:    1,
+			expectErrMsg:   "not found or closed",
+			expectErrCode:  http.StatusNotFound,
+			expectErr:      require.Error,
+		}, {
+			name:           "fiat",
+			clientID:       clientID.String(),
+			code:           "USD",
+			isCrypto:       false,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionFIATACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     1,
+			fiatErr:        nil,
+			fiatTimes:      1,
+			cryptoErr:      nil,
+			cryptoTimes:    0,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "crypto",
+			clientID:       clientID.String(),
+			code:           "BTC",
+			isCrypto:       true,
+			request:        &models.HTTPAdminAccountStatusRequest{Status: "FROZEN_DEBITS", Reason: "under investigation"},
+			expectedAction: postgres.AdminActionCRYPTOACCOUNTSTATUS,
+			auditErr:       nil,
+			auditTimes:     1,
+			fiatErr:        nil,
+			fiatTimes:      0,
+			cryptoErr:      nil,
+			cryptoTimes:    1,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(adminID, test.expectedAction, clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAccountSetStatus(clientID, postgres.Currency("USD"), postgres.AccountStatusFROZENDEBITS).
+					Return(test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockDB.EXPECT().CryptoAccountSetStatus(clientID, "BTC", postgres.AccountStatusFROZENDEBITS).
+					Return(test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+
+			actualErrCode, actualErrMsg, _, err := HTTPAdminAccountStatus(mockDB, zapLogger, adminID, test.clientID,
+				test.code, test.isCrypto, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAuditLog(t *testing.T) {
+	testCases := []struct {
+		name             string
+		target           string
+		pageCursor       string
+		pageSize         string
+		isREST           bool
+		expectedStartID  int64
+		entries          []postgres.AdminAuditLog
+		decryptErr       error
+		decryptTimes     int
+		auditErr         error
+		auditTimes       int
+		auditLogErr      error
+		auditLogTimes    int
+		encryptErr       error
+		encryptTimes     int
+		expectedNextPage string
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "invalid page cursor",
+			target:           "",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  math.MaxInt64,
+			entries:          nil,
+			decryptErr:       errors.New("decrypt failure"),
+			decryptTimes:     1,
+			auditErr:         nil,
+			auditTimes:       0,
+			auditLogErr:      nil,
+			auditLogTimes:    0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page size",
+			target:           "",
+			pageCursor:       "",
+			pageSize:         "three",
+			isREST:           true,
+			expectedStartID:  math.MaxInt64,
+			entries:          nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       0,
+			auditLogErr:      nil,
+			auditLogTimes:    0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "audit failure",
+			target:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  math.MaxInt64,
+			entries:          nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         postgres.ErrAuditLog,
+			auditTimes:       1,
+			auditLogErr:      nil,
+			auditLogTimes:    0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "could not record",
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "not found",
+			target:           "BTC",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  math.MaxInt64,
+			entries:          nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			auditLogErr:      postgres.ErrNotFound,
+			auditLogTimes:    1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "records not found",
+			expectErrCode:    http.StatusNotFound,
+			expectErr:        require.Error,
+		}, {
+			name:             "encrypt failure",
+			target:           "BTC",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  math.MaxInt64,
+			entries:          []postgres.AdminAuditLog{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			auditLogErr:      nil,
+			auditLogTimes:    1,
+			encryptErr:       errors.New("encrypt failure"),
+			encryptTimes:     1,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "last page",
+			target:           "",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  12,
+			entries:          []postgres.AdminAuditLog{{ID: 12}, {ID: 11}},
+			decryptErr:       nil,
+			decryptTimes:     1,
+			auditErr:         nil,
+			auditTimes:       1,
+			auditLogErr:      nil,
+			auditLogTimes:    1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page REST",
+			target:           "a b",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  math.MaxInt64,
+			entries:          []postgres.AdminAuditLog{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			auditLogErr:      nil,
+			auditLogTimes:    1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "?pageCursor=encrypted-cursor&pageSize=3&target=a+b",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page GraphQL",
+			target:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           false,
+			expectedStartID:  math.MaxInt64,
+			entries:          []postgres.AdminAuditLog{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			auditLogErr:      nil,
+			auditLogTimes:    1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "encrypted-cursor",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(test.pageCursor).
+					Return([]byte("12"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionAUDITLOGVIEW, test.target,
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AdminAuditLogPaginated(test.target, test.expectedStartID, int32(4)).
+					Return(test.entries, test.auditLogErr).
+					Times(test.auditLogTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-cursor", test.encryptErr).
+					Times(test.encryptTimes),
+			)
+
+			auditLog, actualErrCode, actualErrMsg, err := HTTPAdminAuditLog(mockAuth, mockDB, zapLogger, uuid.UUID{},
+				test.target, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			require.LessOrEqual(t, len(auditLog.Entries), 3, "page size exceeded.")
+
+			if test.isREST {
+				require.Equal(t, test.expectedNextPage, auditLog.Links.NextPage, "next page link mismatched.")
+			} else {
+				require.Equal(t, test.expectedNextPage, auditLog.Links.PageCursor, "page cursor mismatched.")
+			}
+		})
+	}
+}
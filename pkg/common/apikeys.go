@@ -0,0 +1,280 @@
+package common
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPAPIKeyCreate will validate and create a scoped API key for a client. The plaintext secret is only returned in the
+// response to this request and is stored encrypted. Clients that have enabled two-factor authentication must also
+// provide a one-time password or recovery code.
+func HTTPAPIKeyCreate(authority auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPAPIKeyRequest, otp string) (*models.HTTPAPIKeyResponse, int, string, any, error) {
+	var (
+		err        error
+		secret     string
+		encrypted  string
+		httpStatus int
+		httpMsg    string
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if _, httpStatus, httpMsg, err = HTTPSecondFactor(authority, db, logger, clientID, otp); err != nil {
+		return nil, httpStatus, httpMsg, nil, err
+	}
+
+	key := &postgres.APIKey{
+		KeyID:      xid.New().String(),
+		ClientID:   clientID,
+		Name:       request.Name,
+		Scopes:     request.Scopes,
+		AllowedIps: request.AllowedIPs,
+	}
+
+	if key.AllowedIps == nil {
+		key.AllowedIps = []string{}
+	}
+
+	// Keys without an expiry timestamp do not expire.
+	if request.ExpiresAt > 0 {
+		if expiresAt := time.Unix(request.ExpiresAt, 0); expiresAt.After(time.Now()) {
+			key.ExpiresAt = pgtype.Timestamptz{Time: expiresAt, Valid: true}
+		} else {
+			msg := "expiry must be in the future"
+
+			return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+		}
+	}
+
+	if secret, err = auth.NewAPIKeySecret(); err != nil {
+		logger.Error("failed to generate API key secret", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	if encrypted, err = authority.EncryptToString([]byte(secret)); err != nil {
+		logger.Error("failed to encrypt API key secret", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	key.Secret = encrypted
+
+	if err = db.APIKeyCreate(key); err != nil {
+		var keyErr *postgres.Error
+		if !errors.As(err, &keyErr) {
+			logger.Info("failed to unpack API key creation error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, keyErr.Code, keyErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return &models.HTTPAPIKeyResponse{
+		Key: modelsPostgres.APIKeyInfo{
+			KeyID:      key.KeyID,
+			Name:       key.Name,
+			Scopes:     key.Scopes,
+			AllowedIPs: key.AllowedIps,
+			ExpiresAt:  key.ExpiresAt,
+			CreatedAt:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		},
+		Secret: secret,
+	}, 0, "", nil, nil
+}
+
+// HTTPAPIKeys will retrieve the details, without the secrets, of all the API keys a client has created.
+func HTTPAPIKeys(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	[]modelsPostgres.APIKeyInfo, int, string, error) {
+	keys, err := db.APIKeysClient(clientID)
+	if err != nil {
+		var keyErr *postgres.Error
+		if !errors.As(err, &keyErr) {
+			logger.Info("failed to unpack API keys error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, keyErr.Code, keyErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return keys, 0, "", nil
+}
+
+// HTTPAPIKeyRevoke will revoke an active API key belonging to a client.
+func HTTPAPIKeyRevoke(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, keyID string) (
+	int, string, error) {
+	if len(keyID) < 1 || len(keyID) > 32 {
+		msg := "invalid API key id"
+
+		return http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if err := db.APIKeyRevoke(clientID, keyID); err != nil {
+		var keyErr *postgres.Error
+		if !errors.As(err, &keyErr) {
+			logger.Info("failed to unpack API key revocation error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return keyErr.Code, keyErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPAPIKeyAuthenticate will authenticate a request signed with an API key. The key must be active and the request
+// must originate from an allowed IP address, carry a valid signature, be timestamped within the request window, and
+// carry a nonce that has not been seen within the window.
+func HTTPAPIKeyAuthenticate(authority auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	request *http.Request, clientIP string, body []byte) (*postgres.APIKey, int, string, error) {
+	var (
+		err       error
+		key       postgres.APIKey
+		secret    []byte
+		placed    bool
+		timestamp int64
+		keyID     = request.Header.Get(constants.APIKeyHeader())
+		nonce     = request.Header.Get(constants.APIKeyNonceHeader())
+		signature = request.Header.Get(constants.APIKeySignatureHeader())
+		tsHeader  = request.Header.Get(constants.APIKeyTimestampHeader())
+	)
+
+	if len(keyID) < 1 || len(keyID) > 32 || len(nonce) < 1 || len(nonce) > 64 ||
+		len(signature) < 1 || len(tsHeader) < 1 {
+		msg := "incomplete API key credentials"
+
+		return nil, http.StatusUnauthorized, msg, errors.New(msg)
+	}
+
+	// Check the request timestamp is within the window.
+	if timestamp, err = strconv.ParseInt(tsHeader, 10, 64); err != nil {
+		return nil, http.StatusUnauthorized, "invalid request timestamp", fmt.Errorf("%w", err)
+	}
+
+	if skew := time.Since(time.Unix(timestamp, 0)).Abs(); skew > constants.APIKeyRequestWindow() {
+		msg := "request timestamp is outside the permitted window"
+
+		return nil, http.StatusUnauthorized, msg, errors.New(msg)
+	}
+
+	// Retrieve and check the status of the API key.
+	if key, err = db.APIKeyGet(keyID); err != nil {
+		return nil, http.StatusUnauthorized, "invalid API key", fmt.Errorf("%w", err)
+	}
+
+	if key.RevokedAt.Valid {
+		msg := "API key has been revoked"
+
+		return nil, http.StatusUnauthorized, msg, errors.New(msg)
+	}
+
+	if key.ExpiresAt.Valid && !key.ExpiresAt.Time.After(time.Now()) {
+		msg := "API key has expired"
+
+		return nil, http.StatusUnauthorized, msg, errors.New(msg)
+	}
+
+	if !apiKeyIPAllowed(key.AllowedIps, clientIP) {
+		msg := "request origin is not permitted for this API key"
+
+		return nil, http.StatusForbidden, msg, errors.New(msg)
+	}
+
+	// Verify the request signature.
+	if secret, err = authority.DecryptFromString(key.Secret); err != nil {
+		logger.Error("failed to decrypt API key secret", zap.String("keyID", keyID), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	expected := auth.APIKeySignature(string(secret), request.Method, request.URL.RequestURI(), tsHeader, nonce, body)
+	if !hmac.Equal([]byte(strings.ToLower(signature)), []byte(expected)) {
+		msg := "invalid request signature"
+
+		return nil, http.StatusUnauthorized, msg, errors.New(msg)
+	}
+
+	// Record the nonce for twice the window to cover requests timestamped on either side of the current time.
+	if placed, err = cache.SetNX(fmt.Sprintf(constants.APIKeyNonceFormatString(), keyID, nonce), true,
+		2*constants.APIKeyRequestWindow()); err != nil {
+		logger.Warn("failed to record API key request nonce", zap.String("keyID", keyID), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if !placed {
+		msg := "request has already been processed"
+
+		return nil, http.StatusForbidden, msg, errors.New(msg)
+	}
+
+	return &key, 0, "", nil
+}
+
+// HTTPAPIKeyAuthorize will check that an authenticated API key has been granted all the scopes an endpoint requires.
+// Endpoints that do not list any scopes are not accessible with API keys.
+func HTTPAPIKeyAuthorize(key *postgres.APIKey, scopes ...string) (int, string, error) {
+	if len(scopes) == 0 || !auth.HasScopes(key.Scopes, scopes...) {
+		msg := "API key does not carry the required scopes"
+
+		return http.StatusForbidden, msg, errors.New(msg)
+	}
+
+	return 0, "", nil
+}
+
+// apiKeyIPAllowed checks whether an IP address is in an allow-list of IP addresses and CIDR ranges. An empty allow-list
+// permits all IP addresses.
+func apiKeyIPAllowed(allowed []string, clientIP string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(clientIP)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, entry := range allowed {
+		if strings.Contains(entry, "/") {
+			if prefix, err := netip.ParsePrefix(entry); err == nil && prefix.Contains(addr) {
+				return true
+			}
+
+			continue
+		}
+
+		if allowedAddr, err := netip.ParseAddr(entry); err == nil && allowedAddr.Unmap() == addr {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,495 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_HTTPAPIKeyCreate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		request       *models.HTTPAPIKeyRequest
+		createErr     error
+		createTimes   int
+		mfaTimes      int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectPayload require.ValueAssertionFunc
+	}{
+		{
+			name:          "validation no scopes",
+			request:       &models.HTTPAPIKeyRequest{Name: "bot"},
+			createErr:     nil,
+			createTimes:   0,
+			mfaTimes:      0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name:          "validation invalid scope",
+			request:       &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"admin"}},
+			createErr:     nil,
+			createTimes:   0,
+			mfaTimes:      0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "validation invalid ip",
+			request: &models.HTTPAPIKeyRequest{
+				Name: "bot", Scopes: []string{"read"}, AllowedIPs: []string{"not-an-ip"}},
+			createErr:     nil,
+			createTimes:   0,
+			mfaTimes:      0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "expired",
+			request: &models.HTTPAPIKeyRequest{
+				Name: "bot", Scopes: []string{"read"}, ExpiresAt: time.Now().Add(-time.Hour).Unix()},
+			createErr:     nil,
+			createTimes:   0,
+			mfaTimes:      1,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name:          "key limit",
+			request:       &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"read"}},
+			createErr:     postgres.ErrAPIKeyLimit,
+			createTimes:   1,
+			mfaTimes:      1,
+			expectErrMsg:  "maximum number",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+			expectPayload: require.Nil,
+		}, {
+			name:          "unknown db failure",
+			request:       &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"read"}},
+			createErr:     errors.New("unknown error"),
+			createTimes:   1,
+			mfaTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectPayload: require.Nil,
+		}, {
+			name: "created",
+			request: &models.HTTPAPIKeyRequest{
+				Name: "bot", Scopes: []string{"read", "trade"}, AllowedIPs: []string{"10.0.0.0/8", "127.0.0.1"},
+				ExpiresAt: time.Now().Add(time.Hour).Unix()},
+			createErr:     nil,
+			createTimes:   1,
+			mfaTimes:      1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			var recorded *postgres.APIKey
+
+			mockDB.EXPECT().MFAGet(gomock.Any()).
+				Return(postgres.UserMFA{}, postgres.ErrNotFound).
+				Times(test.mfaTimes)
+
+			mockDB.EXPECT().APIKeyCreate(gomock.Any()).
+				DoAndReturn(func(key *postgres.APIKey) error {
+					recorded = key
+
+					return test.createErr
+				}).
+				Times(test.createTimes)
+
+			response, actualErrCode, actualErrMsg, payload, err := HTTPAPIKeyCreate(testAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.request, "")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.NotEmpty(t, response.Key.KeyID, "key id not set.")
+				require.Len(t, response.Secret, 64, "secret length mismatched.")
+				require.NotEqual(t, response.Secret, recorded.Secret, "secret stored in plaintext.")
+
+				decrypted, err := testAuth.DecryptFromString(recorded.Secret)
+				require.NoError(t, err, "failed to decrypt stored secret.")
+				require.Equal(t, response.Secret, string(decrypted), "stored secret mismatched.")
+				require.True(t, recorded.ExpiresAt.Valid, "expiry not set.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeyRevoke(t *testing.T) {
+	testCases := []struct {
+		name          string
+		keyID         string
+		revokeErr     error
+		revokeTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid key id",
+			keyID:         "",
+			revokeErr:     nil,
+			revokeTimes:   0,
+			expectErrMsg:  "invalid API key id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			keyID:         "key-id",
+			revokeErr:     errors.New("unknown error"),
+			revokeTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			keyID:         "key-id",
+			revokeErr:     postgres.ErrNotFound,
+			revokeTimes:   1,
+			expectErrMsg:  "not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "revoked",
+			keyID:         "key-id",
+			revokeErr:     nil,
+			revokeTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().APIKeyRevoke(gomock.Any(), test.keyID).
+				Return(test.revokeErr).
+				Times(test.revokeTimes)
+
+			actualErrCode, actualErrMsg, err := HTTPAPIKeyRevoke(mockDB, zapLogger, uuid.UUID{}, test.keyID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeyAuthenticate(t *testing.T) {
+	secret, err := auth.NewAPIKeySecret()
+	require.NoError(t, err, "failed to generate secret.")
+
+	encrypted, err := testAuth.EncryptToString([]byte(secret))
+	require.NoError(t, err, "failed to encrypt secret.")
+
+	activeKey := postgres.APIKey{KeyID: "key-id", Secret: encrypted, Scopes: []string{"read"}, AllowedIps: []string{}}
+
+	revokedKey := activeKey
+	revokedKey.RevokedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	expiredKey := activeKey
+	expiredKey.ExpiresAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	restrictedKey := activeKey
+	restrictedKey.AllowedIps = []string{"10.0.0.0/8"}
+
+	body := []byte(`{"currency":"USD"}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-2*constants.APIKeyRequestWindow()).Unix(), 10)
+	signature := auth.APIKeySignature(secret, http.MethodPost, "/api/rest/v1/fiat/deposit", now, "nonce", body)
+
+	testCases := []struct {
+		name          string
+		keyID         string
+		timestamp     string
+		signature     string
+		key           postgres.APIKey
+		getErr        error
+		getTimes      int
+		placed        bool
+		setErr        error
+		setTimes      int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "incomplete credentials",
+			keyID:         "",
+			timestamp:     now,
+			signature:     signature,
+			expectErrMsg:  "incomplete",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid timestamp",
+			keyID:         "key-id",
+			timestamp:     "yesterday",
+			signature:     signature,
+			expectErrMsg:  "invalid request timestamp",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "stale timestamp",
+			keyID:         "key-id",
+			timestamp:     stale,
+			signature:     signature,
+			expectErrMsg:  "outside the permitted window",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown key",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			getErr:        postgres.ErrNotFound,
+			getTimes:      1,
+			expectErrMsg:  "invalid API key",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "revoked key",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			key:           revokedKey,
+			getTimes:      1,
+			expectErrMsg:  "revoked",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "expired key",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			key:           expiredKey,
+			getTimes:      1,
+			expectErrMsg:  "expired",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "ip not allowed",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			key:           restrictedKey,
+			getTimes:      1,
+			expectErrMsg:  "origin is not permitted",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid signature",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     "deadbeef",
+			key:           activeKey,
+			getTimes:      1,
+			expectErrMsg:  "invalid request signature",
+			expectErrCode: http.StatusUnauthorized,
+			expectErr:     require.Error,
+		}, {
+			name:          "cache failure",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			key:           activeKey,
+			getTimes:      1,
+			setErr:        redis.ErrCacheSet,
+			setTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "replayed",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			key:           activeKey,
+			getTimes:      1,
+			placed:        false,
+			setTimes:      1,
+			expectErrMsg:  "already been processed",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "authenticated",
+			keyID:         "key-id",
+			timestamp:     now,
+			signature:     signature,
+			key:           activeKey,
+			getTimes:      1,
+			placed:        true,
+			setTimes:      1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().APIKeyGet(test.keyID).
+					Return(test.key, test.getErr).
+					Times(test.getTimes),
+
+				mockCache.EXPECT().SetNX("api-key-nonce:key-id:nonce", gomock.Any(), gomock.Any()).
+					Return(test.placed, test.setErr).
+					Times(test.setTimes),
+			)
+
+			request := httptest.NewRequest(http.MethodPost, "/api/rest/v1/fiat/deposit", bytes.NewReader(body))
+			request.Header.Set(constants.APIKeyHeader(), test.keyID)
+			request.Header.Set(constants.APIKeyTimestampHeader(), test.timestamp)
+			request.Header.Set(constants.APIKeyNonceHeader(), "nonce")
+			request.Header.Set(constants.APIKeySignatureHeader(), test.signature)
+
+			key, actualErrCode, actualErrMsg, err := HTTPAPIKeyAuthenticate(testAuth, mockCache, mockDB, zapLogger,
+				request, "127.0.0.1", body)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, test.key.KeyID, key.KeyID, "key id mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeyAuthorize(t *testing.T) {
+	key := &postgres.APIKey{Scopes: []string{"read", "trade"}}
+
+	testCases := []struct {
+		name          string
+		scopes        []string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "no scopes",
+			scopes:        nil,
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "missing scope",
+			scopes:        []string{"transfer"},
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "granted scope",
+			scopes:        []string{"trade"},
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			actualErrCode, _, err := HTTPAPIKeyAuthorize(key, test.scopes...)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+		})
+	}
+}
+
+func TestCommon_APIKeyIPAllowed(t *testing.T) {
+	testCases := []struct {
+		name     string
+		allowed  []string
+		clientIP string
+		expected bool
+	}{
+		{name: "empty allow-list", allowed: nil, clientIP: "203.0.113.7", expected: true},
+		{name: "exact address", allowed: []string{"203.0.113.7"}, clientIP: "203.0.113.7", expected: true},
+		{name: "cidr range", allowed: []string{"203.0.113.0/24"}, clientIP: "203.0.113.7", expected: true},
+		{name: "mapped address", allowed: []string{"203.0.113.0/24"}, clientIP: "::ffff:203.0.113.7", expected: true},
+		{name: "ipv6 range", allowed: []string{"2001:db8::/32"}, clientIP: "2001:db8::1", expected: true},
+		{name: "outside range", allowed: []string{"203.0.113.0/24"}, clientIP: "198.51.100.7", expected: false},
+		{name: "invalid client ip", allowed: []string{"203.0.113.0/24"}, clientIP: "unknown", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, apiKeyIPAllowed(test.allowed, test.clientIP), "allow-list mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeys(t *testing.T) {
+	// Mock configurations.
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockDB := mocks.NewMockPostgres(mockCtrl)
+
+	gomock.InOrder(
+		mockDB.EXPECT().APIKeysClient(gomock.Any()).
+			Return(nil, errors.New("unknown error")).
+			Times(1),
+
+		mockDB.EXPECT().APIKeysClient(gomock.Any()).
+			Return([]modelsPostgres.APIKeyInfo{{KeyID: "key-id"}}, nil).
+			Times(1),
+	)
+
+	_, actualErrCode, _, err := HTTPAPIKeys(mockDB, zapLogger, uuid.UUID{})
+	require.Error(t, err, "unknown error did not fail.")
+	require.Equal(t, http.StatusInternalServerError, actualErrCode, "error codes mismatched.")
+
+	keys, _, _, err := HTTPAPIKeys(mockDB, zapLogger, uuid.UUID{})
+	require.NoError(t, err, "failed to retrieve keys.")
+	require.Len(t, keys, 1, "keys count mismatched.")
+}
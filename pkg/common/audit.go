@@ -0,0 +1,213 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// auditEvent will record a security or account event in the audit log along with a payload describing it. Events are
+// recorded after the fact, and a failure to record one is logged rather than failing the request it describes.
+func auditEvent(db postgres.Postgres, logger *logger.Logger, event *postgres.AuditEventDetails, payload any) {
+	var err error
+
+	if payload != nil {
+		if event.Payload, err = json.Marshal(payload); err != nil {
+			logger.Error("failed to marshal audit event payload", zap.String("eventType", string(event.EventType)),
+				zap.Error(err))
+		}
+	}
+
+	if err = db.AuditEventCreate(event); err != nil {
+		logger.Error("failed to record audit event", zap.String("eventType", string(event.EventType)),
+			zap.String("outcome", string(event.Outcome)), zap.String("subjectID", event.SubjectID.String()),
+			zap.Error(err))
+	}
+}
+
+// auditOutcome will return the outcome of a request to be recorded in the audit log.
+func auditOutcome(err error) postgres.AuditOutcome {
+	if err != nil {
+		return postgres.AuditOutcomeFAILURE
+	}
+
+	return postgres.AuditOutcomeSUCCESS
+}
+
+// auditClientEvent will record an event in the audit log about a request a client made on their own behalf.
+func auditClientEvent(db postgres.Postgres, logger *logger.Logger, eventType postgres.AuditEventType,
+	clientID uuid.UUID, device, ipAddress string, err error, payload any) {
+	auditEvent(db, logger, &postgres.AuditEventDetails{
+		EventType: eventType,
+		Outcome:   auditOutcome(err),
+		ActorID:   clientID,
+		SubjectID: clientID,
+		IPAddress: ipAddress,
+		UserAgent: device,
+	}, payload)
+}
+
+// HTTPAuditEventsParams contains the HTTP request parameters for a paginated audit events request.
+type HTTPAuditEventsParams struct {
+	ClientIDStr   string
+	EventType     string
+	Outcome       string
+	PageCursorStr string
+	PageSizeStr   string
+}
+
+// parse will validate the filters, page cursor, and page size of a paginated audit events request.
+func (p *HTTPAuditEventsParams) parse(auth auth.Auth) (uuid.UUID, int64, int32, string, error) {
+	var (
+		err       error
+		clientID  uuid.UUID
+		decrypted []byte
+		pageSize  int32
+		startID   int64 = math.MaxInt64
+	)
+
+	if len(p.ClientIDStr) > 0 {
+		if clientID, err = uuid.FromString(p.ClientIDStr); err != nil {
+			return uuid.UUID{}, -1, -1, "invalid client id", fmt.Errorf("%w", err)
+		}
+	}
+
+	if len(p.EventType) > 0 && !postgres.AuditEventType(p.EventType).Valid() {
+		msg := "invalid audit event type"
+
+		return uuid.UUID{}, -1, -1, msg, errors.New(msg)
+	}
+
+	if len(p.Outcome) > 0 && !postgres.AuditOutcome(p.Outcome).Valid() {
+		msg := "invalid audit event outcome"
+
+		return uuid.UUID{}, -1, -1, msg, errors.New(msg)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if len(p.PageCursorStr) > 0 {
+		if decrypted, err = auth.DecryptFromString(p.PageCursorStr); err != nil {
+			return uuid.UUID{}, -1, -1, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		if startID, err = strconv.ParseInt(string(decrypted), 10, 64); err != nil {
+			return uuid.UUID{}, -1, -1, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+	}
+
+	if pageSize, err = adminPageSize(p.PageSizeStr); err != nil {
+		return uuid.UUID{}, -1, -1, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	return clientID, startID, pageSize, "", nil
+}
+
+// query will return the filters of a paginated audit events request as query parameters for the next page link.
+func (p *HTTPAuditEventsParams) query() string {
+	query := url.Values{}
+
+	for key, value := range map[string]string{
+		"clientID": p.ClientIDStr, "eventType": p.EventType, "outcome": p.Outcome,
+	} {
+		if len(value) > 0 {
+			query.Set(key, value)
+		}
+	}
+
+	if len(query) == 0 {
+		return ""
+	}
+
+	return "&" + query.Encode()
+}
+
+// HTTPAdminAuditEvents will retrieve a page of the security audit log, newest first, and prepare a link to the next
+// page of data. The events can be restricted to those about a specific client, of a specific type, or with a specific
+// outcome.
+func HTTPAdminAuditEvents(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	params *HTTPAuditEventsParams, isREST bool) (*models.HTTPAuditEventsPaginated, int, string, error) {
+	var (
+		nextPage string
+		events   models.HTTPAuditEventsPaginated
+	)
+
+	clientID, startID, pageSize, httpMsg, err := params.parse(auth)
+	if err != nil {
+		return nil, http.StatusBadRequest, httpMsg, err
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionAUDITEVENTVIEW,
+		params.ClientIDStr, map[string]any{"eventType": params.EventType, "outcome": params.Outcome})
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	events.Events, err = db.AuditEventsPaginated(clientID, postgres.AuditEventType(params.EventType),
+		postgres.AuditOutcome(params.Outcome), startID, pageSize+1)
+	done(err)
+
+	if err != nil {
+		var auditErr *postgres.Error
+		if !errors.As(err, &auditErr) {
+			logger.Info("failed to unpack audit events error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, auditErr.Code, auditErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(events.Events) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString(
+			[]byte(strconv.FormatInt(events.Events[pageSize].EventID, 10))); err != nil {
+			logger.Error("failed to encrypt audit event id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		events.Events = events.Events[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			events.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize) +
+				params.query()
+		} else {
+			events.Links.PageCursor = nextPage
+		}
+	}
+
+	return &events, 0, "", nil
+}
+
+// HTTPSecurityActivity will retrieve the most recent security and account events about a client.
+func HTTPSecurityActivity(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	[]modelsPostgres.SecurityEvent, int, string, error) {
+	events, err := db.AuditEventsRecent(clientID, constants.AuditRecentEvents())
+	if err != nil {
+		var auditErr *postgres.Error
+		if !errors.As(err, &auditErr) {
+			logger.Info("failed to unpack security activity error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, auditErr.Code, auditErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return events, 0, "", nil
+}
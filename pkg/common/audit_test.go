@@ -0,0 +1,509 @@
+package common
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_auditEvent(t *testing.T) {
+	testCases := []struct {
+		name            string
+		payload         any
+		createErr       error
+		expectedPayload string
+	}{
+		{
+			name:            "unmarshallable payload",
+			payload:         map[string]any{"channel": make(chan int)},
+			createErr:       nil,
+			expectedPayload: "",
+		}, {
+			name:            "db failure",
+			payload:         nil,
+			createErr:       postgres.ErrAuditEvent,
+			expectedPayload: "",
+		}, {
+			name:            "no payload",
+			payload:         nil,
+			createErr:       nil,
+			expectedPayload: "",
+		}, {
+			name:            "payload",
+			payload:         map[string]string{"method": "jwt"},
+			createErr:       nil,
+			expectedPayload: `{"method":"jwt"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			var recorded *postgres.AuditEventDetails
+
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).
+				DoAndReturn(func(event *postgres.AuditEventDetails) error {
+					recorded = event
+
+					return test.createErr
+				}).
+				Times(1)
+
+			auditEvent(mockDB, zapLogger, &postgres.AuditEventDetails{
+				EventType: postgres.AuditEventTypeTOKENREFRESH,
+				Outcome:   postgres.AuditOutcomeSUCCESS,
+			}, test.payload)
+
+			require.Equal(t, postgres.AuditEventTypeTOKENREFRESH, recorded.EventType, "event type mismatched.")
+			require.Equal(t, test.expectedPayload, string(recorded.Payload), "payload mismatched.")
+		})
+	}
+}
+
+func TestCommon_auditLogin(t *testing.T) {
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name            string
+		clientID        uuid.UUID
+		failure         string
+		expectedOutcome postgres.AuditOutcome
+		expectedPayload string
+	}{
+		{
+			name:            "unknown user",
+			clientID:        uuid.UUID{},
+			failure:         "unknown user",
+			expectedOutcome: postgres.AuditOutcomeFAILURE,
+			expectedPayload: `{"reason":"unknown user","username":"username"}`,
+		}, {
+			name:            "invalid password",
+			clientID:        clientID,
+			failure:         "invalid password",
+			expectedOutcome: postgres.AuditOutcomeFAILURE,
+			expectedPayload: `{"reason":"invalid password","username":"username"}`,
+		}, {
+			name:            "success",
+			clientID:        clientID,
+			failure:         "",
+			expectedOutcome: postgres.AuditOutcomeSUCCESS,
+			expectedPayload: `{"username":"username"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			var recorded *postgres.AuditEventDetails
+
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).
+				DoAndReturn(func(event *postgres.AuditEventDetails) error {
+					recorded = event
+
+					return nil
+				}).
+				Times(1)
+
+			auditLogin(mockDB, zapLogger, test.clientID, "username", "device", "127.0.0.1", test.failure)
+
+			require.Equal(t, postgres.AuditEventTypeLOGIN, recorded.EventType, "event type mismatched.")
+			require.Equal(t, test.expectedOutcome, recorded.Outcome, "outcome mismatched.")
+			require.Equal(t, test.clientID, recorded.SubjectID, "subject mismatched.")
+			require.Equal(t, test.clientID, recorded.ActorID, "actor mismatched.")
+			require.Equal(t, "device", recorded.UserAgent, "user agent mismatched.")
+			require.Equal(t, "127.0.0.1", recorded.IPAddress, "ip address mismatched.")
+			require.JSONEq(t, test.expectedPayload, string(recorded.Payload), "payload mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminAuditEvents(t *testing.T) {
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name             string
+		params           HTTPAuditEventsParams
+		isREST           bool
+		expectedClientID uuid.UUID
+		expectedStartID  int64
+		events           []postgres.AuditEvent
+		decryptErr       error
+		decryptTimes     int
+		auditErr         error
+		auditTimes       int
+		eventsErr        error
+		eventsTimes      int
+		encryptErr       error
+		encryptTimes     int
+		expectedNextPage string
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "invalid client id",
+			params:           HTTPAuditEventsParams{ClientIDStr: "client-id"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       0,
+			eventsErr:        nil,
+			eventsTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid client id",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid event type",
+			params:           HTTPAuditEventsParams{EventType: "LOGOUT"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       0,
+			eventsErr:        nil,
+			eventsTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid audit event type",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid outcome",
+			params:           HTTPAuditEventsParams{Outcome: "PENDING"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       0,
+			eventsErr:        nil,
+			eventsTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid audit event outcome",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page cursor",
+			params:           HTTPAuditEventsParams{PageCursorStr: "page-cursor", PageSizeStr: "3"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       errors.New("decrypt failure"),
+			decryptTimes:     1,
+			auditErr:         nil,
+			auditTimes:       0,
+			eventsErr:        nil,
+			eventsTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page size",
+			params:           HTTPAuditEventsParams{PageSizeStr: "three"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       0,
+			eventsErr:        nil,
+			eventsTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "audit failure",
+			params:           HTTPAuditEventsParams{PageSizeStr: "3"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         postgres.ErrAuditLog,
+			auditTimes:       1,
+			eventsErr:        nil,
+			eventsTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "could not record",
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "unknown db failure",
+			params:           HTTPAuditEventsParams{PageSizeStr: "3"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			eventsErr:        errors.New("unknown error"),
+			eventsTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "not found",
+			params:           HTTPAuditEventsParams{ClientIDStr: clientID.String(), PageSizeStr: "3"},
+			isREST:           true,
+			expectedClientID: clientID,
+			expectedStartID:  math.MaxInt64,
+			events:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			eventsErr:        postgres.ErrNotFound,
+			eventsTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "records not found",
+			expectErrCode:    http.StatusNotFound,
+			expectErr:        require.Error,
+		}, {
+			name:             "encrypt failure",
+			params:           HTTPAuditEventsParams{PageSizeStr: "3"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           []postgres.AuditEvent{{EventID: 4}, {EventID: 3}, {EventID: 2}, {EventID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			eventsErr:        nil,
+			eventsTimes:      1,
+			encryptErr:       errors.New("encrypt failure"),
+			encryptTimes:     1,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "last page",
+			params:           HTTPAuditEventsParams{PageCursorStr: "page-cursor", PageSizeStr: "3"},
+			isREST:           true,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  12,
+			events:           []postgres.AuditEvent{{EventID: 12}, {EventID: 11}},
+			decryptErr:       nil,
+			decryptTimes:     1,
+			auditErr:         nil,
+			auditTimes:       1,
+			eventsErr:        nil,
+			eventsTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name: "next page REST",
+			params: HTTPAuditEventsParams{
+				ClientIDStr: clientID.String(), EventType: "LOGIN", Outcome: "FAILURE", PageSizeStr: "3",
+			},
+			isREST:           true,
+			expectedClientID: clientID,
+			expectedStartID:  math.MaxInt64,
+			events:           []postgres.AuditEvent{{EventID: 4}, {EventID: 3}, {EventID: 2}, {EventID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			eventsErr:        nil,
+			eventsTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "?pageCursor=encrypted-cursor&pageSize=3&clientID=" + clientID.String() +
+				"&eventType=LOGIN&outcome=FAILURE",
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:             "next page GraphQL",
+			params:           HTTPAuditEventsParams{EventType: "LOGIN", PageSizeStr: "3"},
+			isREST:           false,
+			expectedClientID: uuid.UUID{},
+			expectedStartID:  math.MaxInt64,
+			events:           []postgres.AuditEvent{{EventID: 4}, {EventID: 3}, {EventID: 2}, {EventID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			auditErr:         nil,
+			auditTimes:       1,
+			eventsErr:        nil,
+			eventsTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "encrypted-cursor",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(test.params.PageCursorStr).
+					Return([]byte("12"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionAUDITEVENTVIEW,
+					test.params.ClientIDStr, gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AuditEventsPaginated(test.expectedClientID, postgres.AuditEventType(test.params.EventType),
+					postgres.AuditOutcome(test.params.Outcome), test.expectedStartID, int32(4)).
+					Return(test.events, test.eventsErr).
+					Times(test.eventsTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-cursor", test.encryptErr).
+					Times(test.encryptTimes),
+			)
+
+			events, actualErrCode, actualErrMsg, err := HTTPAdminAuditEvents(mockAuth, mockDB, zapLogger, uuid.UUID{},
+				&test.params, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			require.LessOrEqual(t, len(events.Events), 3, "page size exceeded.")
+
+			if test.isREST {
+				require.Equal(t, test.expectedNextPage, events.Links.NextPage, "next page link mismatched.")
+			} else {
+				require.Equal(t, test.expectedNextPage, events.Links.PageCursor, "page cursor mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPSecurityActivity(t *testing.T) {
+	testCases := []struct {
+		name          string
+		events        []modelsPostgres.SecurityEvent
+		eventsErr     error
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "unknown db failure",
+			events:        nil,
+			eventsErr:     errors.New("unknown error"),
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "known db failure",
+			events:        nil,
+			eventsErr:     postgres.ErrAuditEvent,
+			expectErrMsg:  "could not process audit event",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "no events",
+			events:        []modelsPostgres.SecurityEvent{},
+			eventsErr:     nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "events",
+			events:        []modelsPostgres.SecurityEvent{{EventType: "LOGIN"}, {EventType: "TOKEN_REFRESH"}},
+			eventsErr:     nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().AuditEventsRecent(gomock.Any(), constants.AuditRecentEvents()).
+				Return(test.events, test.eventsErr).
+				Times(1)
+
+			events, actualErrCode, actualErrMsg, err := HTTPSecurityActivity(mockDB, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+			require.Len(t, events, len(test.events), "events mismatched.")
+		})
+	}
+}
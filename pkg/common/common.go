@@ -264,6 +264,10 @@ func HTTPValidateOfferRequest(debitAmount decimal.Decimal, precision int32, fiat
 		if err = parsedCurrencies[idx].Scan(fiatCurrencyCode); err != nil || !parsedCurrencies[idx].Valid() {
 			return parsedCurrencies, fmt.Errorf("invalid Fiat currency %s", fiatCurrencyCode)
 		}
+
+		if !parsedCurrencies[idx].Transactable() {
+			return parsedCurrencies, fmt.Errorf("currency %s is not in circulation", fiatCurrencyCode)
+		}
 	}
 
 	// Check for correct decimal places in source amount.
@@ -280,6 +284,7 @@ func HTTPTxDetails(db postgres.Postgres, logger *logger.Logger, clientID uuid.UU
 	var (
 		cryptoEntries []postgres.CryptoJournal
 		fiatEntries   []postgres.FiatJournal
+		reversal      *postgres.JournalReversal
 		transactionID uuid.UUID
 		err           error
 	)
@@ -311,20 +316,53 @@ func HTTPTxDetails(db postgres.Postgres, logger *logger.Logger, clientID uuid.UU
 		return nil, balanceErr.Code, balanceErr.Message, fmt.Errorf("%w", err)
 	}
 
+	if len(fiatEntries) == 0 && len(cryptoEntries) == 0 {
+		return nil, http.StatusNotFound, "transaction id not found", errors.New("transaction id not found")
+	}
+
+	// Check whether the transaction has been reversed or is a reversal.
+	if reversal, err = db.JournalReversalGet(transactionID); err != nil && !errors.Is(err, postgres.ErrNotFound) {
+		logger.Info("failed to retrieve transaction reversal", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
 	// Collate journal entries from both Crypto and Fiat for this transaction.
 	journalEntries := make([]any, 0, len(cryptoEntries)+len(fiatEntries))
 
 	for _, item := range fiatEntries {
-		journalEntries = append(journalEntries, item)
+		if reversal == nil {
+			journalEntries = append(journalEntries, item)
+
+			continue
+		}
+
+		entry := models.HTTPFiatJournalEntry{FiatJournal: item}
+		entry.ReversedBy, entry.Reverses = txReversalLinks(transactionID, reversal)
+		journalEntries = append(journalEntries, entry)
 	}
 
 	for _, item := range cryptoEntries {
-		journalEntries = append(journalEntries, item)
-	}
+		if reversal == nil {
+			journalEntries = append(journalEntries, item)
 
-	if len(journalEntries) == 0 {
-		return nil, http.StatusNotFound, "transaction id not found", errors.New("transaction id not found")
+			continue
+		}
+
+		entry := models.HTTPCryptoJournalEntry{CryptoJournal: item}
+		entry.ReversedBy, entry.Reverses = txReversalLinks(transactionID, reversal)
+		journalEntries = append(journalEntries, entry)
 	}
 
 	return journalEntries, 0, "", nil
 }
+
+// txReversalLinks will link the journal entries of a transaction to the transaction that reversed it, or to the
+// transaction that it reverses.
+func txReversalLinks(txID uuid.UUID, reversal *postgres.JournalReversal) (*uuid.UUID, *uuid.UUID) {
+	if reversal.ReversalTxID == txID {
+		return nil, &reversal.TxID
+	}
+
+	return &reversal.ReversalTxID, nil
+}
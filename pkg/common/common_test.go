@@ -456,6 +456,24 @@ func TestCommon_HTTPValidateOfferRequest(t *testing.T) {
 			currencies:   []string{"USD", "INVALID"},
 			amount:       amountValid,
 			expectErr:    require.Error,
+		}, {
+			name:         "deprecated currency",
+			expectErrMsg: "",
+			currencies:   []string{"SLL", "CAD"},
+			amount:       amountValid,
+			expectErr:    require.NoError,
+		}, {
+			name:         "withdrawn currency",
+			expectErrMsg: "not in circulation",
+			currencies:   []string{"USD", "ZWD"},
+			amount:       amountValid,
+			expectErr:    require.Error,
+		}, {
+			name:         "internal currency",
+			expectErrMsg: "not in circulation",
+			currencies:   []string{"FIAT", "USD"},
+			amount:       amountValid,
+			expectErr:    require.Error,
 		}, {
 			name:         "invalid negative amount",
 			expectErrMsg: "source amount",
@@ -503,8 +521,13 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 	validTxID, err := uuid.NewV4()
 	require.NoError(t, err, "failed to generate uuid.")
 
+	reversalTxID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate reversal uuid.")
+
 	cryptoJournal := []postgres.CryptoJournal{{}, {}}
 	fiatJournal := []postgres.FiatJournal{{}, {}}
+	reversed := &postgres.JournalReversal{TxID: validTxID, ReversalTxID: reversalTxID}
+	reversal := &postgres.JournalReversal{TxID: reversalTxID, ReversalTxID: validTxID}
 
 	testCases := []struct {
 		name          string
@@ -517,6 +540,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 		cryptoTxTimes int
 		fiatTxErr     error
 		fiatTxTimes   int
+		reversal      *postgres.JournalReversal
+		reversalErr   error
+		reversalTimes int
+		expectLinks   bool
 		expectErr     require.ErrorAssertionFunc
 	}{
 		{
@@ -591,6 +618,18 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 1,
 			expectErr:     require.Error,
+		}, {
+			name:          "unknown reversal db error",
+			txID:          validTxID.String(),
+			expectErrMsg:  "please retry",
+			httpStatus:    http.StatusInternalServerError,
+			fiatJournal:   fiatJournal,
+			fiatTxTimes:   1,
+			cryptoJournal: cryptoJournal,
+			cryptoTxTimes: 1,
+			reversalErr:   postgres.ErrReverseTransaction,
+			reversalTimes: 1,
+			expectErr:     require.Error,
 		}, {
 			name:          "valid",
 			txID:          validTxID.String(),
@@ -602,6 +641,30 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: cryptoJournal,
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 1,
+			reversalErr:   postgres.ErrNotFound,
+			reversalTimes: 1,
+			expectErr:     require.NoError,
+		}, {
+			name:          "valid reversed",
+			txID:          validTxID.String(),
+			fiatJournal:   fiatJournal,
+			fiatTxTimes:   1,
+			cryptoJournal: cryptoJournal,
+			cryptoTxTimes: 1,
+			reversal:      reversed,
+			reversalTimes: 1,
+			expectLinks:   true,
+			expectErr:     require.NoError,
+		}, {
+			name:          "valid reversal",
+			txID:          validTxID.String(),
+			fiatJournal:   fiatJournal,
+			fiatTxTimes:   1,
+			cryptoJournal: cryptoJournal,
+			cryptoTxTimes: 1,
+			reversal:      reversal,
+			reversalTimes: 1,
+			expectLinks:   true,
 			expectErr:     require.NoError,
 		},
 	}
@@ -624,13 +687,42 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return(test.cryptoJournal, test.cryptoTxErr).
 					Times(test.cryptoTxTimes),
+
+				mockPostgres.EXPECT().JournalReversalGet(gomock.Any()).
+					Return(test.reversal, test.reversalErr).
+					Times(test.reversalTimes),
 			)
 
-			_, status, errMsg, err := HTTPTxDetails(mockPostgres, zapLogger, uuid.UUID{}, test.txID)
+			entries, status, errMsg, err := HTTPTxDetails(mockPostgres, zapLogger, uuid.UUID{}, test.txID)
 			test.expectErr(t, err, "error expectation failed.")
 
 			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
 			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if !test.expectLinks {
+				return
+			}
+
+			// Verify the journal entries are linked to the reversal.
+			require.Len(t, entries, len(test.fiatJournal)+len(test.cryptoJournal), "journal entries count mismatch.")
+
+			fiatEntry, ok := entries[0].(models.HTTPFiatJournalEntry)
+			require.True(t, ok, "failed to convert Fiat journal entry.")
+
+			cryptoEntry, ok := entries[len(entries)-1].(models.HTTPCryptoJournalEntry)
+			require.True(t, ok, "failed to convert Crypto journal entry.")
+
+			if test.reversal.TxID == validTxID {
+				require.Equal(t, reversalTxID, *fiatEntry.ReversedBy, "Fiat entry reversed by mismatch.")
+				require.Nil(t, fiatEntry.Reverses, "Fiat entry reverses set.")
+				require.Equal(t, reversalTxID, *cryptoEntry.ReversedBy, "Crypto entry reversed by mismatch.")
+
+				return
+			}
+
+			require.Nil(t, fiatEntry.ReversedBy, "Fiat entry reversed by set.")
+			require.Equal(t, reversalTxID, *fiatEntry.Reverses, "Fiat entry reverses mismatch.")
+			require.Equal(t, reversalTxID, *cryptoEntry.Reverses, "Crypto entry reverses mismatch.")
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -17,13 +18,23 @@ import (
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/surahman/FTeX/pkg/validator"
 	"go.uber.org/zap"
 )
 
 // HTTPCryptoOpen opens a Crypto account.
 func HTTPCryptoOpen(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker string) (
 	int, string, error) {
-	var err error
+	var (
+		err        error
+		httpStatus int
+		httpMsg    string
+	)
+
+	// Accounts can only be opened for registered Cryptocurrencies that are enabled for trading.
+	if _, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, ticker, true); err != nil {
+		return httpStatus, httpMsg, err
+	}
 
 	if err = db.CryptoCreateAccount(clientID, ticker); err != nil {
 		var createErr *postgres.Error
@@ -41,18 +52,29 @@ func HTTPCryptoOpen(db postgres.Postgres, logger *logger.Logger, clientID uuid.U
 
 // HTTPCryptoBalance retrieves a balance for a specific Crypto account.
 func HTTPCryptoBalance(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker string) (
-	*postgres.CryptoAccount, int, string, any, error) {
+	*postgres.CryptoAccountBalance, int, string, any, error) {
 	var (
-		accDetails postgres.CryptoAccount
+		accDetails postgres.CryptoAccountBalance
 		err        error
 	)
 
-	// Validate the ticker.
+	// Validate the ticker. Balances remain accessible for registered Cryptocurrencies that have been halted.
 	if len(ticker) < 1 || len(ticker) > 6 {
 		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), ticker,
 			errors.New(constants.InvalidCurrencyString())
 	}
 
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if _, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, ticker, false); err != nil {
+			return nil, httpStatus, httpMsg, ticker, err
+		}
+	}
+
 	if accDetails, err = db.CryptoBalance(clientID, ticker); err != nil {
 		var balanceErr *postgres.Error
 		if !errors.As(err, &balanceErr) {
@@ -68,28 +90,55 @@ func HTTPCryptoBalance(db postgres.Postgres, logger *logger.Logger, clientID uui
 }
 
 // HTTPCryptoOffer will request the conversion rate, prepare the price quote, and store it in the Redis cache.
-func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quotes quotes.Quotes,
-	clientID uuid.UUID, source, destination string, sourceAmount decimal.Decimal, isPurchase bool) (
-	models.HTTPExchangeOfferResponse, int, string, error) {
+func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	quotes quotes.Quotes, clientID uuid.UUID, source, destination string, sourceAmount decimal.Decimal,
+	isPurchase, hold bool) (models.HTTPExchangeOfferResponse, int, string, error) {
 	var (
-		err          error
-		offer        models.HTTPExchangeOfferResponse
-		offerID      = xid.New().String()
-		precision    = constants.DecimalPlacesFiat()
-		fiatCurrency = source
+		err            error
+		asset          postgres.CryptoAsset
+		offer          models.HTTPExchangeOfferResponse
+		offerID        = xid.New().String()
+		expiresAt      = time.Now().Add(constants.FiatOfferTTL())
+		precision      = constants.DecimalPlacesFiat()
+		fiatCurrency   = source
+		cryptoTicker   = destination
+		parsedCurrency []postgres.Currency
 	)
 
 	// Configure precision and fiat tickers for Crypto sale.
 	if !isPurchase {
-		precision = constants.DecimalPlacesCrypto()
 		fiatCurrency = destination
+		cryptoTicker = source
+	}
+
+	// Retrieve the Cryptocurrency registry entry and check that it is enabled for trading.
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if asset, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, cryptoTicker, true); err != nil {
+			return offer, httpStatus, httpMsg, err
+		}
+	}
+
+	if !isPurchase {
+		precision = asset.DecimalPlaces
 	}
 
 	// Validate the Fiat currency and source amount.
-	if _, err = HTTPValidateOfferRequest(sourceAmount, precision, fiatCurrency); err != nil {
+	if parsedCurrency, err = HTTPValidateOfferRequest(sourceAmount, precision, fiatCurrency); err != nil {
 		return offer, http.StatusBadRequest, constants.InvalidRequestString(), fmt.Errorf("%w", err)
 	}
 
+	// Validate the Cryptocurrency amount being sold against the registered order limits.
+	if !isPurchase {
+		if err = HTTPCryptoOrderCheck(&asset, sourceAmount); err != nil {
+			return offer, http.StatusBadRequest, constants.InvalidRequestString(), err
+		}
+	}
+
 	// Compile exchange rate offer.
 	if offer.Rate, offer.Amount, err = quotes.CryptoConversion(
 		source, destination, sourceAmount, isPurchase, nil); err != nil {
@@ -98,6 +147,11 @@ func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, q
 		return offer, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
 	}
 
+	// Round the Cryptocurrency purchase amount to the precision supported by the asset.
+	if isPurchase {
+		offer.Amount = offer.Amount.RoundBank(asset.DecimalPlaces)
+	}
+
 	// Check to make sure there is a valid Cryptocurrency amount.
 	if !offer.Amount.GreaterThan(decimal.NewFromFloat(0)) {
 		msg := "cryptocurrency purchase/sale amount is too small"
@@ -105,11 +159,18 @@ func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, q
 		return offer, http.StatusBadRequest, msg, errors.New(msg)
 	}
 
+	// Validate the Cryptocurrency amount being purchased against the registered order limits.
+	if isPurchase {
+		if err = HTTPCryptoOrderCheck(&asset, offer.Amount); err != nil {
+			return offer, http.StatusBadRequest, constants.InvalidRequestString(), err
+		}
+	}
+
 	offer.PriceQuote.ClientID = clientID
 	offer.SourceAcc = source
 	offer.DestinationAcc = destination
 	offer.DebitAmount = sourceAmount
-	offer.Expires = time.Now().Add(constants.FiatOfferTTL()).Unix()
+	offer.Expires = expiresAt.Unix()
 	offer.IsCryptoPurchase = isPurchase
 	offer.IsCryptoSale = !isPurchase
 
@@ -121,6 +182,31 @@ func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, q
 		return offer, http.StatusInternalServerError, constants.RetryMessageString(), errors.New(msg)
 	}
 
+	// Reserve the source funds for the lifetime of the offer. The hold is placed before the offer is stored so that an
+	// offer can never be redeemed without its hold. A hold on an offer that fails to be stored will lapse on expiry.
+	if hold {
+		if isPurchase {
+			err = db.FiatHold(context.Background(), offerID, &postgres.FiatTransactionDetails{
+				ClientID: clientID,
+				Currency: parsedCurrency[0],
+				Amount:   sourceAmount,
+			}, expiresAt)
+		} else {
+			err = db.CryptoHold(context.Background(), offerID, clientID, cryptoTicker, sourceAmount, expiresAt)
+		}
+
+		if err != nil {
+			var holdErr *postgres.Error
+			if !errors.As(err, &holdErr) {
+				return offer, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+			}
+
+			return offer, holdErr.Code, holdErr.Message, fmt.Errorf("%w", err)
+		}
+
+		offer.IsHeld = true
+	}
+
 	// Store the offer in Redis.
 	if err = cache.Set(offerID, &offer, constants.FiatOfferTTL()); err != nil {
 		msg := "failed to store Cryptocurrency purchase/sale offer in cache"
@@ -134,7 +220,7 @@ func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, q
 
 // HTTPExchangeCrypto will complete a Cryptocurrency exchange.
 func HTTPExchangeCrypto(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
-	clientID uuid.UUID, offerID string) (models.HTTPCryptoTransferResponse, int, string, error) {
+	clientID uuid.UUID, offerID, device, ipAddress string) (models.HTTPCryptoTransferResponse, int, string, error) {
 	var (
 		err          error
 		offer        models.HTTPExchangeOfferResponse
@@ -143,9 +229,9 @@ func HTTPExchangeCrypto(auth auth.Auth, cache redis.Redis, db postgres.Postgres,
 		fiatTicker   string
 		cryptoAmount decimal.Decimal
 		fiatAmount   decimal.Decimal
-		precision    = constants.DecimalPlacesCrypto()
 		transferFunc = db.CryptoPurchase
 		fiatCurrency []postgres.Currency
+		holdID       string
 	)
 
 	// Extract Offer ID from request.
@@ -199,28 +285,144 @@ func HTTPExchangeCrypto(auth auth.Auth, cache redis.Redis, db postgres.Postgres,
 		cryptoAmount = offer.DebitAmount
 		fiatTicker = offer.DestinationAcc
 		fiatAmount = offer.Amount
-		precision = constants.DecimalPlacesCrypto()
 		transferFunc = db.CryptoSell
 	}
 
-	// Get Fiat currency code.
+	// Get Fiat currency code. Only the Fiat leg is checked here because the Cryptocurrency leg was rounded to the
+	// precision of its asset, which may exceed the default Cryptocurrency precision, when the offer was generated.
 	if fiatCurrency, err = HTTPValidateOfferRequest(
-		offer.Amount, precision, fiatTicker); err != nil {
+		fiatAmount, constants.DecimalPlacesFiat(), fiatTicker); err != nil {
 		msg := "failed to extract Fiat currency from Crypto exchange offer"
 		logger.Warn(msg, zap.Error(err))
 
 		return receipt, http.StatusBadRequest, msg, fmt.Errorf("%w", err)
 	}
 
+	// Release the funds reserved for the offer.
+	if offer.IsHeld {
+		holdID = offerID
+	}
+
 	// Execute transfer.
-	if receipt.FiatTxReceipt, receipt.CryptoTxReceipt, err =
-		transferFunc(clientID, fiatCurrency[0], fiatAmount, cryptoTicker, cryptoAmount); err != nil {
-		return receipt, http.StatusInternalServerError, err.Error(), fmt.Errorf("%w", err)
+	receipt.FiatTxReceipt, receipt.CryptoTxReceipt, err =
+		transferFunc(clientID, fiatCurrency[0], fiatAmount, cryptoTicker, cryptoAmount, holdID)
+
+	auditClientEvent(db, logger, postgres.AuditEventTypeCRYPTOEXCHANGE, clientID, device, ipAddress, err,
+		map[string]any{
+			"isPurchase": offer.IsCryptoPurchase, "fiatCurrency": fiatTicker, "fiatAmount": fiatAmount,
+			"ticker": cryptoTicker, "cryptoAmount": cryptoAmount,
+		})
+
+	if err != nil {
+		var transferErr *postgres.Error
+		if !errors.As(err, &transferErr) {
+			return receipt, http.StatusInternalServerError, err.Error(), fmt.Errorf("%w", err)
+		}
+
+		return receipt, transferErr.Code, transferErr.Message, fmt.Errorf("%w", err)
 	}
 
 	return receipt, 0, "", nil
 }
 
+// HTTPCryptoClose closes a Cryptocurrency account. If a sweep currency is provided, the remaining balance will first be
+// sold at the current exchange rate and the proceeds deposited into the client's account in the sweep currency.
+func HTTPCryptoClose(db postgres.Postgres, logger *logger.Logger, quotes quotes.Quotes, clientID uuid.UUID,
+	request *models.HTTPCloseCryptoAccountRequest, device, ipAddress string) (
+	*models.HTTPCryptoTransferResponse, int, string, any, error) {
+	var (
+		err          error
+		receipt      models.HTTPCryptoTransferResponse
+		fiatCurrency postgres.Currency
+		fiatAmount   decimal.Decimal
+		cryptoAmount decimal.Decimal
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Validate the ticker. Accounts in halted Cryptocurrencies can still be closed.
+	if len(request.Ticker) < 1 || len(request.Ticker) > 6 {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), request.Ticker,
+			errors.New(constants.InvalidCurrencyString())
+	}
+
+	// Compile the sweep of the remaining balance.
+	if len(request.SweepCurrency) > 0 {
+		var (
+			account          postgres.CryptoAccountBalance
+			asset            postgres.CryptoAsset
+			parsedCurrencies []postgres.Currency
+			httpStatus       int
+			httpMsg          string
+		)
+
+		if account, err = db.CryptoBalance(clientID, request.Ticker); err != nil {
+			var balanceErr *postgres.Error
+			if !errors.As(err, &balanceErr) {
+				logger.Info("failed to unpack Crypto account balance error for close", zap.Error(err))
+
+				return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+			}
+
+			return nil, balanceErr.Code, balanceErr.Message, nil, fmt.Errorf("%w", err)
+		}
+
+		if account.Balance.IsPositive() {
+			// The Cryptocurrency must be enabled for trading and the balance within the registered order limits.
+			if asset, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, request.Ticker, true); err != nil {
+				return nil, httpStatus, httpMsg, nil, err
+			}
+
+			if err = HTTPCryptoOrderCheck(&asset, account.Balance); err != nil {
+				return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), err
+			}
+
+			if parsedCurrencies, err = HTTPValidateOfferRequest(
+				account.Balance, asset.DecimalPlaces, request.SweepCurrency); err != nil {
+				return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+			}
+
+			if _, fiatAmount, err = quotes.CryptoConversion(
+				request.Ticker, request.SweepCurrency, account.Balance, false, nil); err != nil {
+				logger.Warn("failed to retrieve quote for Crypto account close sweep", zap.Error(err))
+
+				return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+			}
+
+			// Check to make sure there is a valid Fiat amount.
+			if !fiatAmount.GreaterThan(decimal.NewFromFloat(0)) {
+				msg := "cryptocurrency sweep amount is too small"
+
+				return nil, http.StatusBadRequest, msg, nil, errors.New(msg)
+			}
+
+			fiatCurrency = parsedCurrencies[0]
+			cryptoAmount = account.Balance
+		}
+	}
+
+	receipt.FiatTxReceipt, receipt.CryptoTxReceipt, err =
+		db.CryptoCloseAccount(clientID, fiatCurrency, fiatAmount, request.Ticker, cryptoAmount)
+
+	auditClientEvent(db, logger, postgres.AuditEventTypeCRYPTOACCOUNTCLOSE, clientID, device, ipAddress, err,
+		map[string]any{"ticker": request.Ticker, "sweepCurrency": request.SweepCurrency, "sweptAmount": cryptoAmount})
+
+	if err != nil {
+		var closeErr *postgres.Error
+		if !errors.As(err, &closeErr) {
+			logger.Info("failed to unpack close Crypto account error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, closeErr.Code, closeErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return &receipt, 0, "", nil, nil
+}
+
 // cryptoBalancePaginatedRequest will convert the encrypted URL query parameter for the ticker and the record
 // limit and covert them to a string and integer record limit. The tickerStr is the encrypted pageCursor passed in.
 func cryptoBalancePaginatedRequest(auth auth.Auth, tickerStr, limitStr string) (string, int32, error) {
@@ -371,3 +573,127 @@ func HTTPCryptoTransactionsPaginated(auth auth.Auth, db postgres.Postgres, logge
 
 	return transactions, 0, "", nil
 }
+
+// HTTPCryptoAsset will retrieve the registry entry for a Cryptocurrency. Trading operations require the Cryptocurrency
+// to be enabled, whilst read-only operations will accept halted Cryptocurrencies.
+func HTTPCryptoAsset(db postgres.Postgres, logger *logger.Logger, ticker string, isTrading bool) (
+	postgres.CryptoAsset, int, string, error) {
+	asset, err := db.CryptoAssetGet(ticker)
+	if err != nil {
+		var assetErr *postgres.Error
+		if !errors.As(err, &assetErr) {
+			logger.Info("failed to unpack Cryptocurrency registry error", zap.Error(err))
+
+			return asset, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		if assetErr.Is(postgres.ErrNotFound) {
+			return asset, http.StatusBadRequest, constants.InvalidCurrencyString(), fmt.Errorf("%w", err)
+		}
+
+		return asset, assetErr.Code, assetErr.Message, fmt.Errorf("%w", err)
+	}
+
+	if isTrading && asset.Status != postgres.CryptoAssetStatusENABLED {
+		msg := "trading is halted for this Cryptocurrency"
+
+		return asset, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	return asset, 0, "", nil
+}
+
+// HTTPCryptoOrderCheck will validate a Cryptocurrency amount against the precision and order size limits of the asset.
+func HTTPCryptoOrderCheck(asset *postgres.CryptoAsset, amount decimal.Decimal) error {
+	if !amount.Equal(amount.Truncate(asset.DecimalPlaces)) {
+		return fmt.Errorf("%s amount %s exceeds %d decimal places", asset.Ticker, amount, asset.DecimalPlaces)
+	}
+
+	if amount.LessThan(asset.MinOrder) || amount.GreaterThan(asset.MaxOrder) {
+		return fmt.Errorf("%s amount %s is outside of the order limits [%s, %s]",
+			asset.Ticker, amount, asset.MinOrder, asset.MaxOrder)
+	}
+
+	return nil
+}
+
+// HTTPCryptoAssets retrieves all the Cryptocurrencies in the registry.
+func HTTPCryptoAssets(db postgres.Postgres, logger *logger.Logger) ([]postgres.CryptoAsset, int, string, error) {
+	assets, err := db.CryptoAssetGetAll()
+	if err != nil {
+		var assetErr *postgres.Error
+		if !errors.As(err, &assetErr) {
+			logger.Info("failed to unpack Cryptocurrency registry error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, assetErr.Code, assetErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return assets, 0, "", nil
+}
+
+// HTTPCryptoAssetUpsert will register a new Cryptocurrency or update the details of an existing one.
+func HTTPCryptoAssetUpsert(db postgres.Postgres, logger *logger.Logger, request *models.HTTPCryptoAssetRequest) (
+	*postgres.CryptoAsset, int, string, any, error) {
+	var err error
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if !request.MinOrder.IsPositive() || request.MaxOrder.LessThan(request.MinOrder) {
+		msg := "order limits must be positive with the maximum no less than the minimum"
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	asset := &postgres.CryptoAsset{
+		Ticker:        request.Ticker,
+		Name:          request.Name,
+		DecimalPlaces: *request.DecimalPlaces,
+		Status:        postgres.CryptoAssetStatusENABLED,
+		MinOrder:      request.MinOrder,
+		MaxOrder:      request.MaxOrder,
+	}
+
+	if request.IsHalted {
+		asset.Status = postgres.CryptoAssetStatusHALTED
+	}
+
+	if err = db.CryptoAssetUpsert(asset); err != nil {
+		var assetErr *postgres.Error
+		if !errors.As(err, &assetErr) {
+			logger.Info("failed to unpack Cryptocurrency registration error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, assetErr.Code, assetErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return asset, 0, "", nil, nil
+}
+
+// HTTPCryptoAssetStatus will enable or halt trading for a registered Cryptocurrency.
+func HTTPCryptoAssetStatus(db postgres.Postgres, logger *logger.Logger, ticker string, isHalted bool) (
+	int, string, error) {
+	status := postgres.CryptoAssetStatusENABLED
+	if isHalted {
+		status = postgres.CryptoAssetStatusHALTED
+	}
+
+	if err := db.CryptoAssetSetStatus(ticker, status); err != nil {
+		var assetErr *postgres.Error
+		if !errors.As(err, &assetErr) {
+			logger.Info("failed to unpack Cryptocurrency trading status error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return assetErr.Code, assetErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
@@ -18,18 +18,45 @@ import (
 )
 
 func TestCommon_HTTPCryptoOpen(t *testing.T) {
+	haltedAsset := testCryptoAsset
+	haltedAsset.Status = postgres.CryptoAssetStatusHALTED
+
 	testCases := []struct {
 		name          string
 		tickerStr     string
 		expectErrMsg  string
 		expectErrCode int
+		asset         postgres.CryptoAsset
+		assetErr      error
 		openAccErr    error
 		openAccTimes  int
 		expectErr     require.ErrorAssertionFunc
 	}{
 		{
+			name:          "unregistered ticker",
+			tickerStr:     "BTC",
+			asset:         testCryptoAsset,
+			assetErr:      postgres.ErrNotFound,
+			openAccErr:    nil,
+			openAccTimes:  0,
+			expectErrCode: http.StatusBadRequest,
+			expectErrMsg:  constants.InvalidCurrencyString(),
+			expectErr:     require.Error,
+		}, {
+			name:          "halted ticker",
+			tickerStr:     "BTC",
+			asset:         haltedAsset,
+			assetErr:      nil,
+			openAccErr:    nil,
+			openAccTimes:  0,
+			expectErrCode: http.StatusBadRequest,
+			expectErrMsg:  "halted",
+			expectErr:     require.Error,
+		}, {
 			name:          "unknown db failure",
 			tickerStr:     "BTC",
+			asset:         testCryptoAsset,
+			assetErr:      nil,
 			openAccErr:    errors.New("unknown error"),
 			openAccTimes:  1,
 			expectErrCode: http.StatusInternalServerError,
@@ -38,6 +65,8 @@ func TestCommon_HTTPCryptoOpen(t *testing.T) {
 		}, {
 			name:          "known db failure",
 			tickerStr:     "BTC",
+			asset:         testCryptoAsset,
+			assetErr:      nil,
 			openAccErr:    postgres.ErrNotFound,
 			openAccTimes:  1,
 			expectErrCode: http.StatusNotFound,
@@ -46,6 +75,8 @@ func TestCommon_HTTPCryptoOpen(t *testing.T) {
 		}, {
 			name:          "BTC",
 			tickerStr:     "BTC",
+			asset:         testCryptoAsset,
+			assetErr:      nil,
 			openAccErr:    nil,
 			openAccTimes:  1,
 			expectErrCode: 0,
@@ -62,9 +93,15 @@ func TestCommon_HTTPCryptoOpen(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
-			mockDB.EXPECT().CryptoCreateAccount(gomock.Any(), gomock.Any()).
-				Return(test.openAccErr).
-				Times(test.openAccTimes)
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet(test.tickerStr).
+					Return(test.asset, test.assetErr).
+					Times(1),
+
+				mockDB.EXPECT().CryptoCreateAccount(gomock.Any(), gomock.Any()).
+					Return(test.openAccErr).
+					Times(test.openAccTimes),
+			)
 
 			actualErrCode, actualErrMsg, err := HTTPCryptoOpen(mockDB, zapLogger, uuid.UUID{}, test.tickerStr)
 			test.expectErr(t, err, "error expectation failed.")
@@ -80,6 +117,8 @@ func TestCommon_HTTPCryptoBalance(t *testing.T) {
 		tickerStr        string
 		expectErrMsg     string
 		expectErrCode    int
+		assetErr         error
+		assetTimes       int
 		balanceAccErr    error
 		balanceAccTimes  int
 		expectErr        require.ErrorAssertionFunc
@@ -89,6 +128,8 @@ func TestCommon_HTTPCryptoBalance(t *testing.T) {
 		{
 			name:             "invalid ticker",
 			tickerStr:        "INVALID",
+			assetErr:         nil,
+			assetTimes:       0,
 			balanceAccErr:    errors.New(constants.InvalidCurrencyString()),
 			balanceAccTimes:  0,
 			expectErrCode:    http.StatusBadRequest,
@@ -96,9 +137,23 @@ func TestCommon_HTTPCryptoBalance(t *testing.T) {
 			expectErr:        require.Error,
 			expectNilBalance: require.Nil,
 			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unregistered ticker",
+			tickerStr:        "BTC",
+			assetErr:         postgres.ErrNotFound,
+			assetTimes:       1,
+			balanceAccErr:    nil,
+			balanceAccTimes:  0,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     constants.InvalidCurrencyString(),
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
 		}, {
 			name:             "unknown db failure",
 			tickerStr:        "BTC",
+			assetErr:         nil,
+			assetTimes:       1,
 			balanceAccErr:    errors.New("unknown error"),
 			balanceAccTimes:  1,
 			expectErrCode:    http.StatusInternalServerError,
@@ -109,6 +164,8 @@ func TestCommon_HTTPCryptoBalance(t *testing.T) {
 		}, {
 			name:             "known db failure",
 			tickerStr:        "BTC",
+			assetErr:         nil,
+			assetTimes:       1,
 			balanceAccErr:    postgres.ErrNotFound,
 			balanceAccTimes:  1,
 			expectErrCode:    http.StatusNotFound,
@@ -119,6 +176,8 @@ func TestCommon_HTTPCryptoBalance(t *testing.T) {
 		}, {
 			name:             "BTC",
 			tickerStr:        "BTC",
+			assetErr:         nil,
+			assetTimes:       1,
 			balanceAccErr:    nil,
 			balanceAccTimes:  1,
 			expectErrCode:    0,
@@ -137,9 +196,15 @@ func TestCommon_HTTPCryptoBalance(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
-			mockDB.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
-				Return(postgres.CryptoAccount{}, test.balanceAccErr).
-				Times(test.balanceAccTimes)
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet(test.tickerStr).
+					Return(testCryptoAsset, test.assetErr).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
+					Return(postgres.CryptoAccountBalance{}, test.balanceAccErr).
+					Times(test.balanceAccTimes),
+			)
 
 			balance, actualErrCode, actualErrMsg, payload, err :=
 				HTTPCryptoBalance(mockDB, zapLogger, uuid.UUID{}, test.tickerStr)
@@ -365,8 +430,12 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 	var (
 		sourceAmount = decimal.NewFromFloat(23123.12)
 		quotesRate   = decimal.NewFromFloat(23100)
+		limitedAsset = testCryptoAsset
 	)
 
+	limitedAsset.MinOrder = decimal.NewFromFloat(2)
+	limitedAsset.MaxOrder = decimal.NewFromFloat(100)
+
 	testCases := []struct {
 		name             string
 		source           string
@@ -375,16 +444,88 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 		httpMessage      string
 		httpStatus       int
 		isPurchase       bool
+		hold             bool
+		asset            postgres.CryptoAsset
+		assetErr         error
 		quotesAmount     decimal.Decimal
 		quotesTimes      int
 		quotesErr        error
 		authEncryptTimes int
 		authEncryptErr   error
+		fiatHoldTimes    int
+		cryptoHoldTimes  int
+		holdErr          error
 		redisTimes       int
 		redisErr         error
 		expectErr        require.ErrorAssertionFunc
 	}{
 		{
+			name:             "unregistered crypto - purchase",
+			source:           "USD",
+			destination:      "BTC",
+			expectErrMsg:     "records not found",
+			httpMessage:      constants.InvalidCurrencyString(),
+			httpStatus:       http.StatusBadRequest,
+			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         postgres.ErrNotFound,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "order limits - purchase",
+			source:           "USD",
+			destination:      "BTC",
+			expectErrMsg:     "order limits",
+			httpMessage:      constants.InvalidRequestString(),
+			httpStatus:       http.StatusBadRequest,
+			isPurchase:       true,
+			hold:             false,
+			asset:            limitedAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "order limits - sale",
+			source:           "BTC",
+			destination:      "USD",
+			expectErrMsg:     "order limits",
+			httpMessage:      constants.InvalidRequestString(),
+			httpStatus:       http.StatusBadRequest,
+			isPurchase:       false,
+			hold:             false,
+			asset:            limitedAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
 			name:             "validate offer - purchase",
 			source:           "INVALID",
 			destination:      "BTC",
@@ -392,11 +533,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.InvalidRequestString(),
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      0,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -408,11 +555,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.RetryMessageString(),
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        errors.New("quote failure"),
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -424,11 +577,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      "too small",
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(0),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -440,11 +599,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.RetryMessageString(),
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   errors.New("encryption failure"),
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -456,11 +621,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.RetryMessageString(),
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       1,
 			redisErr:         errors.New("cache failure"),
 			expectErr:        require.Error,
@@ -472,11 +643,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      "",
 			httpStatus:       0,
 			isPurchase:       true,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       1,
 			redisErr:         nil,
 			expectErr:        require.NoError,
@@ -488,11 +665,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.InvalidRequestString(),
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       false,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      0,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -504,11 +687,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.RetryMessageString(),
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       false,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        errors.New("quote failure"),
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -520,11 +709,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      "too small",
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       false,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(0),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -536,11 +731,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.RetryMessageString(),
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       false,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   errors.New("encryption failure"),
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -552,11 +753,17 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      constants.RetryMessageString(),
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       false,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
 			redisTimes:       1,
 			redisErr:         errors.New("cache failure"),
 			expectErr:        require.Error,
@@ -568,11 +775,127 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpMessage:      "",
 			httpStatus:       0,
 			isPurchase:       false,
+			hold:             false,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
 			quotesAmount:     decimal.NewFromFloat(1.23),
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+		}, {
+			name:             "hold insufficient funds - purchase",
+			source:           "USD",
+			destination:      "BTC",
+			expectErrMsg:     "insufficient",
+			httpMessage:      postgres.ErrInsufficientFunds.Error(),
+			httpStatus:       http.StatusPaymentRequired,
+			isPurchase:       true,
+			hold:             true,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    1,
+			cryptoHoldTimes:  0,
+			holdErr:          postgres.ErrInsufficientFunds,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "hold unknown error - purchase",
+			source:           "USD",
+			destination:      "BTC",
+			expectErrMsg:     "unknown error",
+			httpMessage:      constants.RetryMessageString(),
+			httpStatus:       http.StatusInternalServerError,
+			isPurchase:       true,
+			hold:             true,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    1,
+			cryptoHoldTimes:  0,
+			holdErr:          errors.New("unknown error"),
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "valid hold - purchase",
+			source:           "USD",
+			destination:      "BTC",
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			isPurchase:       true,
+			hold:             true,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    1,
+			cryptoHoldTimes:  0,
+			holdErr:          nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+		}, {
+			name:             "hold account status - sale",
+			source:           "BTC",
+			destination:      "USD",
+			expectErrMsg:     "frozen",
+			httpMessage:      postgres.ErrAccountStatus.Error(),
+			httpStatus:       http.StatusForbidden,
+			isPurchase:       false,
+			hold:             true,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  1,
+			holdErr:          postgres.ErrAccountStatus,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "valid hold - sale",
+			source:           "BTC",
+			destination:      "USD",
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			isPurchase:       false,
+			hold:             true,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			fiatHoldTimes:    0,
+			cryptoHoldTimes:  1,
+			holdErr:          nil,
 			redisTimes:       1,
 			redisErr:         nil,
 			expectErr:        require.NoError,
@@ -589,7 +912,13 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
 
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
 			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet("BTC").
+					Return(test.asset, test.assetErr).
+					Times(1),
+
 				mockQuotes.EXPECT().CryptoConversion(
 					test.source, test.destination, sourceAmount, test.isPurchase, nil).
 					Return(quotesRate, test.quotesAmount, test.quotesErr).
@@ -599,13 +928,21 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 					Return("OFFER-ID", test.authEncryptErr).
 					Times(test.authEncryptTimes),
 
+				mockDB.EXPECT().FiatHold(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.holdErr).
+					Times(test.fiatHoldTimes),
+
+				mockDB.EXPECT().CryptoHold(gomock.Any(), gomock.Any(), gomock.Any(), "BTC", sourceAmount, gomock.Any()).
+					Return(test.holdErr).
+					Times(test.cryptoHoldTimes),
+
 				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(test.redisErr).
 					Times(test.redisTimes),
 			)
 
-			offer, status, msg, err := HTTPCryptoOffer(mockAuth, mockCache, zapLogger, mockQuotes,
-				uuid.UUID{}, test.source, test.destination, sourceAmount, test.isPurchase)
+			offer, status, msg, err := HTTPCryptoOffer(mockAuth, mockCache, mockDB, zapLogger, mockQuotes,
+				uuid.UUID{}, test.source, test.destination, sourceAmount, test.isPurchase, test.hold)
 			test.expectErr(t, err, "error expectation failed.")
 
 			if err != nil {
@@ -620,7 +957,8 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			require.Equal(t, test.destination, offer.DestinationAcc, "destination account mismatch.")
 			require.Equal(t, sourceAmount, offer.DebitAmount, "debit amount mismatch.")
 			require.Equal(t, quotesRate, offer.Rate, "offer rate mismatch.")
-			require.Equal(t, test.quotesAmount, offer.Amount, "offer amount mismatch.")
+			require.True(t, test.quotesAmount.Equal(offer.Amount), "offer amount mismatch.")
+			require.Equal(t, test.hold, offer.IsHeld, "offer hold mismatch.")
 		})
 	}
 }
@@ -695,6 +1033,17 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 		IsCryptoSale:     false,
 	}
 
+	// Purchases of assets with up to 18 decimal places are not held to the default Cryptocurrency precision.
+	precisePurchase := validPurchase
+	precisePurchase.DestinationAcc = "ETH"
+	precisePurchase.Amount = decimal.RequireFromString("1.123456789012345678")
+
+	heldSale := validSale
+	heldSale.IsHeld = true
+
+	heldPurchase := validPurchase
+	heldPurchase.IsHeld = true
+
 	testCases := []struct {
 		name             string
 		expectErrMsg     string
@@ -730,6 +1079,23 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 			sellTimes:        0,
 			sellErr:          nil,
 			expectErr:        require.NoError,
+		}, {
+			name:             "valid - purchase 18 decimal places",
+			clientID:         validClientID,
+			expectErrMsg:     "",
+			httpStatus:       0,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisGetData:     precisePurchase,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			purchaseTimes:    1,
+			purchaseErr:      nil,
+			sellTimes:        0,
+			sellErr:          nil,
+			expectErr:        require.NoError,
 		}, {
 			name:             "decrypt failure - sell",
 			clientID:         validClientID,
@@ -866,6 +1232,40 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 			sellTimes:        1,
 			sellErr:          nil,
 			expectErr:        require.NoError,
+		}, {
+			name:             "valid - held purchase",
+			clientID:         validClientID,
+			expectErrMsg:     "",
+			httpStatus:       0,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisGetData:     heldPurchase,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			purchaseTimes:    1,
+			purchaseErr:      nil,
+			sellTimes:        0,
+			sellErr:          nil,
+			expectErr:        require.NoError,
+		}, {
+			name:             "valid - held sell",
+			clientID:         validClientID,
+			expectErrMsg:     "",
+			httpStatus:       0,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisGetData:     heldSale,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			purchaseTimes:    0,
+			purchaseErr:      nil,
+			sellTimes:        1,
+			sellErr:          nil,
+			expectErr:        require.NoError,
 		},
 	}
 
@@ -878,6 +1278,19 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			// Held offers must release their hold when redeemed.
+			expectedHoldID := ""
+			if test.redisGetData.IsHeld {
+				expectedHoldID = "OFFER-ID"
+			}
+
+			// The Cryptocurrency leg of purchases is the amount the offer was rounded to.
+			expectedTicker, expectedCryptoAmount := "BTC", cryptoAmount
+			if test.redisGetData.IsCryptoPurchase {
+				expectedTicker, expectedCryptoAmount = test.redisGetData.DestinationAcc, test.redisGetData.Amount
+			}
 
 			gomock.InOrder(
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
@@ -894,18 +1307,20 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 					Times(test.redisDelTimes),
 
 				mockPostgres.EXPECT().CryptoPurchase(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.Currency("USD"), fiatAmount, expectedTicker, expectedCryptoAmount,
+					expectedHoldID).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.purchaseErr).
 					Times(test.purchaseTimes),
 
 				mockPostgres.EXPECT().CryptoSell(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.Currency("USD"), fiatAmount, "BTC", cryptoAmount, expectedHoldID).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.sellErr).
 					Times(test.sellTimes),
 			)
 
 			_, status, errMsg, err :=
-				HTTPExchangeCrypto(mockAuth, mockCache, mockPostgres, zapLogger, test.clientID, "offer-id")
+				HTTPExchangeCrypto(mockAuth, mockCache, mockPostgres, zapLogger, test.clientID, "offer-id", "device",
+					"127.0.0.1")
 			test.expectErr(t, err, "error expectation failed.")
 
 			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
@@ -996,7 +1411,7 @@ func TestCommon_CryptoBalancePaginatedRequest(t *testing.T) {
 func TestCommon_HTTPCryptoBalancePaginated(t *testing.T) {
 	var (
 		pageCursor  = "some-page-cursor"
-		fourRecords = []postgres.CryptoAccount{{}, {}, {}, {}}
+		fourRecords = []postgres.CryptoAccountBalance{{}, {}, {}, {}}
 	)
 
 	testCases := []struct {
@@ -1010,7 +1425,7 @@ func TestCommon_HTTPCryptoBalancePaginated(t *testing.T) {
 		expectedPageSize   int32
 		decryptStringErr   error
 		decryptStringTimes int
-		balanceData        []postgres.CryptoAccount
+		balanceData        []postgres.CryptoAccountBalance
 		balanceErr         error
 		balanceTimes       int
 		encryptStringErr   error
@@ -1125,7 +1540,7 @@ func TestCommon_HTTPCryptoBalancePaginated(t *testing.T) {
 			expectedPageSize:   4,
 			decryptStringErr:   nil,
 			decryptStringTimes: 1,
-			balanceData:        []postgres.CryptoAccount{{}, {}, {}},
+			balanceData:        []postgres.CryptoAccountBalance{{}, {}, {}},
 			balanceErr:         nil,
 			balanceTimes:       1,
 			encryptStringErr:   nil,
@@ -1216,3 +1631,676 @@ func TestCommon_HTTPCryptoBalancePaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestCommon_HTTPCryptoAsset(t *testing.T) {
+	haltedAsset := testCryptoAsset
+	haltedAsset.Status = postgres.CryptoAssetStatusHALTED
+
+	testCases := []struct {
+		name          string
+		isTrading     bool
+		asset         postgres.CryptoAsset
+		assetErr      error
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "unknown db failure",
+			isTrading:     true,
+			asset:         testCryptoAsset,
+			assetErr:      errors.New("unknown error"),
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unregistered",
+			isTrading:     true,
+			asset:         testCryptoAsset,
+			assetErr:      postgres.ErrNotFound,
+			expectErrMsg:  constants.InvalidCurrencyString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "halted trading",
+			isTrading:     true,
+			asset:         haltedAsset,
+			assetErr:      nil,
+			expectErrMsg:  "halted",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "halted read-only",
+			isTrading:     false,
+			asset:         haltedAsset,
+			assetErr:      nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "enabled trading",
+			isTrading:     true,
+			asset:         testCryptoAsset,
+			assetErr:      nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().CryptoAssetGet("BTC").
+				Return(test.asset, test.assetErr).
+				Times(1)
+
+			asset, actualErrCode, actualErrMsg, err := HTTPCryptoAsset(mockDB, zapLogger, "BTC", test.isTrading)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, test.asset, asset, "asset mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoOrderCheck(t *testing.T) {
+	testCases := []struct {
+		name         string
+		amount       decimal.Decimal
+		expectErrMsg string
+		expectErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:         "excess precision",
+			amount:       decimal.RequireFromString("1.123456789"),
+			expectErrMsg: "decimal places",
+			expectErr:    require.Error,
+		}, {
+			name:         "below minimum",
+			amount:       decimal.RequireFromString("0.000001"),
+			expectErrMsg: "order limits",
+			expectErr:    require.Error,
+		}, {
+			name:         "above maximum",
+			amount:       decimal.RequireFromString("1000000.00000001"),
+			expectErrMsg: "order limits",
+			expectErr:    require.Error,
+		}, {
+			name:         "at minimum",
+			amount:       decimal.RequireFromString("0.00001"),
+			expectErrMsg: "",
+			expectErr:    require.NoError,
+		}, {
+			name:         "at maximum",
+			amount:       decimal.RequireFromString("1000000"),
+			expectErrMsg: "",
+			expectErr:    require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			err := HTTPCryptoOrderCheck(&testCryptoAsset, test.amount)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				require.Contains(t, err.Error(), test.expectErrMsg, "error message mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoAssets(t *testing.T) {
+	testCases := []struct {
+		name          string
+		assetsErr     error
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "unknown db failure",
+			assetsErr:     errors.New("unknown error"),
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "known db failure",
+			assetsErr:     postgres.ErrNotFound,
+			expectErrMsg:  "records not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			assetsErr:     nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().CryptoAssetGetAll().
+				Return([]postgres.CryptoAsset{testCryptoAsset}, test.assetsErr).
+				Times(1)
+
+			assets, actualErrCode, actualErrMsg, err := HTTPCryptoAssets(mockDB, zapLogger)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Len(t, assets, 1, "asset count mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoAssetUpsert(t *testing.T) {
+	var (
+		decimalPlaces = int32(18)
+		invalidPlaces = int32(19)
+	)
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPCryptoAssetRequest
+		upsertErr     error
+		upsertTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name: "validation",
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &invalidPlaces,
+				MinOrder: decimal.NewFromFloat(0.01), MaxOrder: decimal.NewFromFloat(100)},
+			upsertErr:     nil,
+			upsertTimes:   0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid limits",
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(100), MaxOrder: decimal.NewFromFloat(0.01)},
+			upsertErr:     nil,
+			upsertTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "unknown db failure",
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(0.01), MaxOrder: decimal.NewFromFloat(100)},
+			upsertErr:     errors.New("unknown error"),
+			upsertTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name: "known db failure",
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(0.01), MaxOrder: decimal.NewFromFloat(100)},
+			upsertErr:     postgres.ErrCryptoAsset,
+			upsertTimes:   1,
+			expectErrMsg:  "could not register",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name: "valid halted",
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces, IsHalted: true,
+				MinOrder: decimal.NewFromFloat(0.01), MaxOrder: decimal.NewFromFloat(100)},
+			upsertErr:     nil,
+			upsertTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().CryptoAssetUpsert(gomock.Any()).
+				Return(test.upsertErr).
+				Times(test.upsertTimes)
+
+			asset, actualErrCode, actualErrMsg, _, err := HTTPCryptoAssetUpsert(mockDB, zapLogger, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, postgres.CryptoAssetStatusHALTED, asset.Status, "asset status mismatched.")
+				require.Equal(t, decimalPlaces, asset.DecimalPlaces, "asset precision mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoAssetStatus(t *testing.T) {
+	testCases := []struct {
+		name           string
+		isHalted       bool
+		expectedStatus postgres.CryptoAssetStatus
+		statusErr      error
+		expectErrMsg   string
+		expectErrCode  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "unknown db failure",
+			isHalted:       true,
+			expectedStatus: postgres.CryptoAssetStatusHALTED,
+			statusErr:      errors.New("unknown error"),
+			expectErrMsg:   constants.RetryMessageString(),
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name:           "not found",
+			isHalted:       false,
+			expectedStatus: postgres.CryptoAssetStatusENABLED,
+			statusErr:      postgres.ErrNotFound,
+			expectErrMsg:   "records not found",
+			expectErrCode:  http.StatusNotFound,
+			expectErr:      require.Error,
+		}, {
+			name:           "halt",
+			isHalted:       true,
+			expectedStatus: postgres.CryptoAssetStatusHALTED,
+			statusErr:      nil,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "enable",
+			isHalted:       false,
+			expectedStatus: postgres.CryptoAssetStatusENABLED,
+			statusErr:      nil,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().CryptoAssetSetStatus("BTC", test.expectedStatus).
+				Return(test.statusErr).
+				Times(1)
+
+			actualErrCode, actualErrMsg, err := HTTPCryptoAssetStatus(mockDB, zapLogger, "BTC", test.isHalted)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoClose(t *testing.T) {
+	balance := postgres.CryptoAccountBalance{Balance: decimal.NewFromFloat(0.5)}
+	haltedAsset := testCryptoAsset
+	haltedAsset.Status = postgres.CryptoAssetStatusHALTED
+
+	testCases := []struct {
+		name             string
+		request          *models.HTTPCloseCryptoAccountRequest
+		expectedMsg      string
+		expectedStatus   int
+		balance          postgres.CryptoAccountBalance
+		balanceErr       error
+		balanceTimes     int
+		asset            postgres.CryptoAsset
+		assetErr         error
+		assetTimes       int
+		quotesAmount     decimal.Decimal
+		quotesErr        error
+		quotesTimes      int
+		closeErr         error
+		closeTimes       int
+		expectErr        require.ErrorAssertionFunc
+		expectNilReceipt require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "empty request",
+			request:          &models.HTTPCloseCryptoAccountRequest{},
+			expectedMsg:      constants.ValidationString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "invalid ticker",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "INVALID"},
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "balance unknown db error",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balance:          balance,
+			balanceErr:       errors.New("unknown error"),
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "balance known db error",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			balance:          balance,
+			balanceErr:       postgres.ErrNotFound,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "trading halted",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "halted",
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            haltedAsset,
+			assetErr:         nil,
+			assetTimes:       1,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "order limits",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      constants.InvalidRequestString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          postgres.CryptoAccountBalance{Balance: decimal.NewFromFloat(0.000001)},
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       1,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "invalid sweep currency",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "INVALID"},
+			expectedMsg:      constants.InvalidRequestString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       1,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "quotes failure",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       1,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        errors.New("quotes failure"),
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "sweep amount too small",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "too small",
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       1,
+			quotesAmount:     decimal.NewFromFloat(0),
+			quotesErr:        nil,
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "close unknown db error",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         errors.New("unknown error"),
+			closeTimes:       1,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "close known db error",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			expectedMsg:      "balance must be zero",
+			expectedStatus:   http.StatusConflict,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         postgres.ErrCloseAccount,
+			closeTimes:       1,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid without sweep",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid with sweep of zero balance",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balance:          postgres.CryptoAccountBalance{},
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       0,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid with sweep",
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			asset:            testCryptoAsset,
+			assetErr:         nil,
+			assetTimes:       1,
+			quotesAmount:     decimal.NewFromFloat(25000.5),
+			quotesErr:        nil,
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
+					Return(test.balance, test.balanceErr).
+					Times(test.balanceTimes),
+
+				mockDB.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(test.asset, test.assetErr).
+					Times(test.assetTimes),
+
+				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), false, nil).
+					Return(decimal.NewFromFloat(50001), test.quotesAmount, test.quotesErr).
+					Times(test.quotesTimes),
+
+				mockDB.EXPECT().CryptoCloseAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			receipt, httpStatus, httpMessage, payload, err :=
+				HTTPCryptoClose(mockDB, zapLogger, mockQuotes, uuid.UUID{}, test.request, "device", "127.0.0.1")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilReceipt(t, receipt, "nil receipt expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatched.")
+			require.Contains(t, httpMessage, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
@@ -35,6 +35,12 @@ func HTTPFiatOpen(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUI
 		return http.StatusBadRequest, constants.InvalidCurrencyString(), fmt.Errorf("%w", err)
 	}
 
+	// New accounts may only be opened in currencies that are actively in circulation.
+	if !pgCurrency.Openable() {
+		return http.StatusBadRequest, constants.UnavailableCurrencyString(),
+			fmt.Errorf("currency %s is not accepting new accounts", currency)
+	}
+
 	if err = db.FiatCreateAccount(clientID, pgCurrency); err != nil {
 		var createErr *postgres.Error
 		if !errors.As(err, &createErr) {
@@ -51,7 +57,8 @@ func HTTPFiatOpen(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUI
 
 // HTTPFiatDeposit deposits a valid amount into a Fiat account.
 func HTTPFiatDeposit(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
-	request *models.HTTPDepositCurrencyRequest) (*postgres.FiatAccountTransferResult, int, string, any, error) {
+	request *models.HTTPDepositCurrencyRequest, device, ipAddress string) (
+	*postgres.FiatAccountTransferResult, int, string, any, error) {
 	var (
 		pgCurrency      postgres.Currency
 		err             error
@@ -67,16 +74,27 @@ func HTTPFiatDeposit(db postgres.Postgres, logger *logger.Logger, clientID uuid.
 		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), request.Currency, fmt.Errorf("%w", err)
 	}
 
+	// Deposits are refused once a currency has been withdrawn from circulation.
+	if !pgCurrency.Transactable() {
+		return nil, http.StatusBadRequest, constants.UnavailableCurrencyString(), request.Currency,
+			fmt.Errorf("currency %s is not in circulation", request.Currency)
+	}
+
 	// Check for correct decimal places.
 	if !request.Amount.Equal(request.Amount.Truncate(constants.DecimalPlacesFiat())) || request.Amount.IsNegative() {
 		return nil, http.StatusBadRequest, "invalid amount", request.Amount, fmt.Errorf("%w", err)
 	}
 
-	if transferReceipt, err = db.FiatExternalTransfer(context.Background(),
+	transferReceipt, err = db.FiatExternalTransfer(context.Background(),
 		&postgres.FiatTransactionDetails{
 			ClientID: clientID,
 			Currency: pgCurrency,
-			Amount:   request.Amount}); err != nil {
+			Amount:   request.Amount})
+
+	auditClientEvent(db, logger, postgres.AuditEventTypeFIATDEPOSIT, clientID, device, ipAddress, err,
+		map[string]any{"currency": request.Currency, "amount": request.Amount})
+
+	if err != nil {
 		var createErr *postgres.Error
 		if !errors.As(err, &createErr) {
 			logger.Info("failed to unpack deposit Fiat account error", zap.Error(err))
@@ -91,12 +109,15 @@ func HTTPFiatDeposit(db postgres.Postgres, logger *logger.Logger, clientID uuid.
 }
 
 // HTTPFiatOffer retrieves an exchange rate offer from a quote provider and stores it in the Redis session cache.
-func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quotes quotes.Quotes, clientID uuid.UUID,
-	request *models.HTTPExchangeOfferRequest) (*models.HTTPExchangeOfferResponse, int, string, any, error) {
+func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	quotes quotes.Quotes, clientID uuid.UUID, request *models.HTTPExchangeOfferRequest) (
+	*models.HTTPExchangeOfferResponse, int, string, any, error) {
 	var (
-		err     error
-		offer   models.HTTPExchangeOfferResponse
-		offerID = xid.New().String()
+		err              error
+		offer            models.HTTPExchangeOfferResponse
+		offerID          = xid.New().String()
+		expiresAt        = time.Now().Add(constants.FiatOfferTTL())
+		parsedCurrencies []postgres.Currency
 	)
 
 	if err = validator.ValidateStruct(request); err != nil {
@@ -104,7 +125,7 @@ func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quo
 	}
 
 	// Extract and validate the currency.
-	if _, err = HTTPValidateOfferRequest(request.SourceAmount, constants.DecimalPlacesFiat(),
+	if parsedCurrencies, err = HTTPValidateOfferRequest(request.SourceAmount, constants.DecimalPlacesFiat(),
 		request.SourceCurrency, request.DestinationCurrency); err != nil {
 		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
 	}
@@ -128,7 +149,7 @@ func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quo
 	offer.SourceAcc = request.SourceCurrency
 	offer.DestinationAcc = request.DestinationCurrency
 	offer.DebitAmount = request.SourceAmount
-	offer.Expires = time.Now().Add(constants.FiatOfferTTL()).Unix()
+	offer.Expires = expiresAt.Unix()
 
 	// Encrypt offer ID before returning to client.
 	if offer.OfferID, err = auth.EncryptToString([]byte(offerID)); err != nil {
@@ -137,6 +158,25 @@ func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quo
 		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
 	}
 
+	// Reserve the source funds for the lifetime of the offer. The hold is placed before the offer is stored so that an
+	// offer can never be redeemed without its hold. A hold on an offer that fails to be stored will lapse on expiry.
+	if request.Hold {
+		if err = db.FiatHold(context.Background(), offerID, &postgres.FiatTransactionDetails{
+			ClientID: clientID,
+			Currency: parsedCurrencies[0],
+			Amount:   request.SourceAmount,
+		}, expiresAt); err != nil {
+			var holdErr *postgres.Error
+			if !errors.As(err, &holdErr) {
+				return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+			}
+
+			return nil, holdErr.Code, holdErr.Message, nil, fmt.Errorf("%w", err)
+		}
+
+		offer.IsHeld = true
+	}
+
 	// Store the offer in Redis.
 	if err = cache.Set(offerID, &offer, constants.FiatOfferTTL()); err != nil {
 		logger.Warn("failed to store Fiat conversion offer in cache", zap.Error(err))
@@ -149,7 +189,8 @@ func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quo
 
 // HTTPFiatTransfer will retrieve an offer from the session cache, validate it, then update the database.
 func HTTPFiatTransfer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
-	clientID uuid.UUID, request *models.HTTPTransferRequest) (*models.HTTPFiatTransferResponse, int, string, any, error) {
+	clientID uuid.UUID, request *models.HTTPTransferRequest, device, ipAddress string) (
+	*models.HTTPFiatTransferResponse, int, string, any, error) {
 	var (
 		err              error
 		offer            models.HTTPExchangeOfferResponse
@@ -214,16 +255,34 @@ func HTTPFiatTransfer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, l
 		Currency: parsedCurrencies[0],
 		Amount:   offer.DebitAmount,
 	}
+
+	// Release the funds reserved for the offer.
+	if offer.IsHeld {
+		srcTxDetails.HoldID = offerID
+	}
+
 	dstTxDetails := &postgres.FiatTransactionDetails{
 		ClientID: offer.ClientID,
 		Currency: parsedCurrencies[1],
 		Amount:   offer.Amount,
 	}
 
-	if receipt.SrcTxReceipt, receipt.DstTxReceipt, err = db.
-		FiatInternalTransfer(context.Background(), srcTxDetails, dstTxDetails); err != nil {
+	receipt.SrcTxReceipt, receipt.DstTxReceipt, err = db.
+		FiatInternalTransfer(context.Background(), srcTxDetails, dstTxDetails)
+
+	auditClientEvent(db, logger, postgres.AuditEventTypeFIATEXCHANGE, clientID, device, ipAddress, err,
+		map[string]any{
+			"source": offer.SourceAcc, "debitAmount": offer.DebitAmount,
+			"destination": offer.DestinationAcc, "creditAmount": offer.Amount,
+		})
+
+	if err != nil {
 		logger.Warn("failed to complete internal Fiat transfer", zap.Error(err))
 
+		if errors.Is(err, postgres.ErrAccountStatus) {
+			return nil, http.StatusForbidden, postgres.ErrAccountStatus.Error(), nil, fmt.Errorf("%w", err)
+		}
+
 		return nil, http.StatusBadRequest, "please check you have both currency accounts and enough funds.",
 			nil, fmt.Errorf("%w", err)
 	}
@@ -231,11 +290,111 @@ func HTTPFiatTransfer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, l
 	return &receipt, 0, "", nil, nil
 }
 
+// HTTPFiatClose closes a Fiat account. If a sweep currency is provided, the remaining balance will first be converted at
+// the current exchange rate and deposited into the client's account in the sweep currency.
+func HTTPFiatClose(db postgres.Postgres, logger *logger.Logger, quotes quotes.Quotes, clientID uuid.UUID,
+	request *models.HTTPCloseFiatAccountRequest, device, ipAddress string) (
+	*models.HTTPFiatTransferResponse, int, string, any, error) {
+	var (
+		err          error
+		pgCurrency   postgres.Currency
+		receipt      models.HTTPFiatTransferResponse
+		srcTxDetails = &postgres.FiatTransactionDetails{ClientID: clientID}
+		dstTxDetails *postgres.FiatTransactionDetails
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Extract and validate the currency. Accounts in currencies that are no longer in circulation can still be closed.
+	if err = pgCurrency.Scan(request.Currency); err != nil || !pgCurrency.Valid() {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), request.Currency, fmt.Errorf("%w", err)
+	}
+
+	srcTxDetails.Currency = pgCurrency
+
+	// Compile the sweep of the remaining balance.
+	if len(request.SweepCurrency) > 0 {
+		var (
+			account          postgres.FiatAccountBalance
+			parsedCurrencies []postgres.Currency
+			sweepAmount      decimal.Decimal
+		)
+
+		if request.SweepCurrency == request.Currency {
+			msg := "sweep currency must differ from the account currency"
+
+			return nil, http.StatusBadRequest, msg, nil, errors.New(msg)
+		}
+
+		if account, err = db.FiatBalance(clientID, pgCurrency); err != nil {
+			var balanceErr *postgres.Error
+			if !errors.As(err, &balanceErr) {
+				logger.Info("failed to unpack Fiat account balance error for close", zap.Error(err))
+
+				return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+			}
+
+			return nil, balanceErr.Code, balanceErr.Message, nil, fmt.Errorf("%w", err)
+		}
+
+		if account.Balance.IsPositive() {
+			if parsedCurrencies, err = HTTPValidateOfferRequest(account.Balance, constants.DecimalPlacesFiat(),
+				request.Currency, request.SweepCurrency); err != nil {
+				return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+			}
+
+			if _, sweepAmount, err = quotes.FiatConversion(
+				request.Currency, request.SweepCurrency, account.Balance, nil); err != nil {
+				logger.Warn("failed to retrieve quote for Fiat account close sweep", zap.Error(err))
+
+				return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+			}
+
+			// Check to make sure there is a valid Fiat amount.
+			if !sweepAmount.GreaterThan(decimal.NewFromFloat(0)) {
+				msg := "fiat currency sweep amount is too small"
+
+				return nil, http.StatusBadRequest, msg, nil, errors.New(msg)
+			}
+
+			srcTxDetails.Amount = account.Balance
+			dstTxDetails = &postgres.FiatTransactionDetails{
+				ClientID: clientID,
+				Currency: parsedCurrencies[1],
+				Amount:   sweepAmount,
+			}
+		}
+	}
+
+	receipt.SrcTxReceipt, receipt.DstTxReceipt, err = db.
+		FiatCloseAccount(context.Background(), srcTxDetails, dstTxDetails)
+
+	auditClientEvent(db, logger, postgres.AuditEventTypeFIATACCOUNTCLOSE, clientID, device, ipAddress, err,
+		map[string]any{
+			"currency": request.Currency, "sweepCurrency": request.SweepCurrency, "sweptAmount": srcTxDetails.Amount,
+		})
+
+	if err != nil {
+		var closeErr *postgres.Error
+		if !errors.As(err, &closeErr) {
+			logger.Info("failed to unpack close Fiat account error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, closeErr.Code, closeErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return &receipt, 0, "", nil, nil
+}
+
 // HTTPFiatBalance retrieves the account balance for a specific Fiat currency.
 func HTTPFiatBalance(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker string) (
-	*postgres.FiatAccount, int, string, any, error) {
+	*postgres.FiatAccountBalance, int, string, any, error) {
 	var (
-		accDetails postgres.FiatAccount
+		accDetails postgres.FiatAccountBalance
 		currency   postgres.Currency
 		err        error
 	)
@@ -412,3 +571,43 @@ func HTTPFiatTransactionsPaginated(auth auth.Auth, db postgres.Postgres, logger
 
 	return &journalEntries, 0, "", nil, nil
 }
+
+// HTTPFiatCurrencies will retrieve all the Fiat currencies in the reference table along with their circulation status.
+func HTTPFiatCurrencies(db postgres.Postgres, logger *logger.Logger) ([]postgres.FiatCurrency, int, string, error) {
+	records, err := db.FiatCurrencyGetAll()
+	if err != nil {
+		var currencyErr *postgres.Error
+		if !errors.As(err, &currencyErr) {
+			logger.Info("failed to unpack Fiat currency reference table error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, currencyErr.Code, currencyErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return records, 0, "", nil
+}
+
+// HTTPFiatCurrencyUpsert will register a new Fiat currency or update the circulation status of an existing one.
+func HTTPFiatCurrencyUpsert(db postgres.Postgres, logger *logger.Logger, request *models.HTTPFiatCurrencyRequest) (
+	int, string, any, error) {
+	var err error
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if err = db.FiatCurrencyUpsert(request.Code, postgres.FiatCurrencyStatus(request.Status)); err != nil {
+		var currencyErr *postgres.Error
+		if !errors.As(err, &currencyErr) {
+			logger.Info("failed to unpack Fiat currency registration error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return currencyErr.Code, currencyErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil, nil
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -36,6 +37,22 @@ func TestCommon_HTTPFiatOpen(t *testing.T) {
 			expectErrCode: http.StatusBadRequest,
 			expectErrMsg:  constants.InvalidCurrencyString(),
 			expectErr:     require.Error,
+		}, {
+			name:          "deprecated currency",
+			currencyStr:   "SLL",
+			openAccErr:    nil,
+			openAccTimes:  0,
+			expectErrCode: http.StatusBadRequest,
+			expectErrMsg:  constants.UnavailableCurrencyString(),
+			expectErr:     require.Error,
+		}, {
+			name:          "withdrawn currency",
+			currencyStr:   "ZWD",
+			openAccErr:    nil,
+			openAccTimes:  0,
+			expectErrCode: http.StatusBadRequest,
+			expectErrMsg:  constants.UnavailableCurrencyString(),
+			expectErr:     require.Error,
 		}, {
 			name:          "unknown db failure",
 			currencyStr:   "USD",
@@ -120,6 +137,26 @@ func TestCommon_HTTPFiatDeposit(t *testing.T) {
 			expectErr:        require.Error,
 			expectNilReceipt: require.Nil,
 			expectNilPayload: require.NotNil,
+		}, {
+			name:             "withdrawn currency",
+			request:          &models.HTTPDepositCurrencyRequest{Currency: "ZWD", Amount: validRequest.Amount},
+			depositErr:       nil,
+			depositTimes:     0,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     constants.UnavailableCurrencyString(),
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "deprecated currency",
+			request:          &models.HTTPDepositCurrencyRequest{Currency: "SLL", Amount: validRequest.Amount},
+			depositErr:       nil,
+			depositTimes:     1,
+			expectErrCode:    0,
+			expectErrMsg:     "",
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
 		}, {
 			name: "too many decimal places",
 			request: &models.HTTPDepositCurrencyRequest{
@@ -186,12 +223,14 @@ func TestCommon_HTTPFiatDeposit(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			mockDB.EXPECT().FiatExternalTransfer(gomock.Any(), gomock.Any()).
 				Return(&postgres.FiatAccountTransferResult{}, test.depositErr).
 				Times(test.depositTimes)
 
-			result, actualErrCode, actualErrMsg, payload, err := HTTPFiatDeposit(mockDB, zapLogger, uuid.UUID{}, test.request)
+			result, actualErrCode, actualErrMsg, payload, err :=
+				HTTPFiatDeposit(mockDB, zapLogger, uuid.UUID{}, test.request, "device", "127.0.0.1")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectNilReceipt(t, result, "nil result expectation failed.")
 			test.expectNilPayload(t, payload, "nil payload expectation failed.")
@@ -211,6 +250,12 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			DestinationCurrency: "CAD",
 			SourceAmount:        sourceAmount,
 		}
+		heldRequest = models.HTTPExchangeOfferRequest{
+			SourceCurrency:      "USD",
+			DestinationCurrency: "CAD",
+			SourceAmount:        sourceAmount,
+			Hold:                true,
+		}
 	)
 
 	testCases := []struct {
@@ -226,6 +271,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 		quotesErr        error
 		authEncryptTimes int
 		authEncryptErr   error
+		holdTimes        int
+		holdErr          error
 		redisTimes       int
 		redisErr         error
 		expectErr        require.ErrorAssertionFunc
@@ -248,6 +295,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			quotesErr:        nil,
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			holdTimes:        0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -263,6 +312,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			quotesErr:        errors.New("quote failure"),
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			holdTimes:        0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -278,6 +329,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			quotesErr:        nil,
 			authEncryptTimes: 0,
 			authEncryptErr:   nil,
+			holdTimes:        0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -293,6 +346,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   errors.New("encryption failure"),
+			holdTimes:        0,
+			holdErr:          nil,
 			redisTimes:       0,
 			redisErr:         nil,
 			expectErr:        require.Error,
@@ -308,6 +363,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   nil,
+			holdTimes:        0,
+			holdErr:          nil,
 			redisTimes:       1,
 			redisErr:         errors.New("cache failure"),
 			expectErr:        require.Error,
@@ -323,6 +380,59 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			quotesErr:        nil,
 			authEncryptTimes: 1,
 			authEncryptErr:   nil,
+			holdTimes:        0,
+			holdErr:          nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "hold insufficient funds",
+			expectErrMsg:     "insufficient",
+			httpMessage:      postgres.ErrInsufficientFunds.Error(),
+			httpStatus:       http.StatusPaymentRequired,
+			request:          &heldRequest,
+			quotesAmount:     quotesAmount,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			holdTimes:        1,
+			holdErr:          postgres.ErrInsufficientFunds,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "hold unknown error",
+			expectErrMsg:     "unknown error",
+			httpMessage:      constants.RetryMessageString(),
+			httpStatus:       http.StatusInternalServerError,
+			request:          &heldRequest,
+			quotesAmount:     quotesAmount,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			holdTimes:        1,
+			holdErr:          errors.New("unknown error"),
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid hold",
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			request:          &heldRequest,
+			quotesAmount:     quotesAmount,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			holdTimes:        1,
+			holdErr:          nil,
 			redisTimes:       1,
 			redisErr:         nil,
 			expectErr:        require.NoError,
@@ -339,6 +449,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			gomock.InOrder(
 				mockQuotes.EXPECT().FiatConversion(
@@ -350,12 +461,20 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 					Return("OFFER-ID", test.authEncryptErr).
 					Times(test.authEncryptTimes),
 
+				mockDB.EXPECT().FiatHold(gomock.Any(), gomock.Any(), &postgres.FiatTransactionDetails{
+					ClientID: uuid.UUID{},
+					Currency: postgres.Currency("USD"),
+					Amount:   sourceAmount,
+				}, gomock.Any()).
+					Return(test.holdErr).
+					Times(test.holdTimes),
+
 				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(test.redisErr).
 					Times(test.redisTimes),
 			)
 
-			offer, status, msg, payload, err := HTTPFiatOffer(mockAuth, mockCache, zapLogger, mockQuotes,
+			offer, status, msg, payload, err := HTTPFiatOffer(mockAuth, mockCache, mockDB, zapLogger, mockQuotes,
 				uuid.UUID{}, test.request)
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectNilPayload(t, payload, "nil payload expectation failed.")
@@ -373,6 +492,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			require.Equal(t, test.request.SourceAmount, offer.DebitAmount, "debit amount mismatch.")
 			require.Equal(t, quotesRate, offer.Rate, "offer rate mismatch.")
 			require.Equal(t, test.quotesAmount, offer.Amount, "offer amount mismatch.")
+			require.Equal(t, test.request.Hold, offer.IsHeld, "offer hold mismatch.")
 		})
 	}
 }
@@ -398,6 +518,9 @@ func TestCommon_HTTPFiatTransfer(t *testing.T) { //nolint:maintidx
 		},
 	}
 
+	heldOffer := validOffer
+	heldOffer.IsHeld = true
+
 	invalidOfferClientID := models.HTTPExchangeOfferResponse{
 		PriceQuote: models.PriceQuote{
 			ClientID:       invalidClientID,
@@ -648,6 +771,23 @@ func TestCommon_HTTPFiatTransfer(t *testing.T) { //nolint:maintidx
 			expectErr:         require.NoError,
 			expectNilResponse: require.NotNil,
 			expectNilPayload:  require.Nil,
+		}, {
+			name:              "valid - held",
+			expectedMsg:       "",
+			expectedStatus:    0,
+			request:           models.HTTPTransferRequest{OfferID: "VALID"},
+			authDecryptErr:    nil,
+			authDecryptTimes:  1,
+			redisGetData:      heldOffer,
+			redisGetErr:       nil,
+			redisGetTimes:     1,
+			redisDelErr:       nil,
+			redisDelTimes:     1,
+			internalXferErr:   nil,
+			internalXferTimes: 1,
+			expectErr:         require.NoError,
+			expectNilResponse: require.NotNil,
+			expectNilPayload:  require.Nil,
 		},
 	}
 
@@ -661,6 +801,13 @@ func TestCommon_HTTPFiatTransfer(t *testing.T) { //nolint:maintidx
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			// Held offers must release their hold when redeemed.
+			expectedHoldID := ""
+			if test.redisGetData.IsHeld {
+				expectedHoldID = string(validOfferID)
+			}
 
 			gomock.InOrder(
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
@@ -677,12 +824,17 @@ func TestCommon_HTTPFiatTransfer(t *testing.T) { //nolint:maintidx
 					Times(test.redisDelTimes),
 
 				mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil, nil, test.internalXferErr).
+					DoAndReturn(func(_ context.Context, src, _ *postgres.FiatTransactionDetails) (
+						*postgres.FiatAccountTransferResult, *postgres.FiatAccountTransferResult, error) {
+						require.Equal(t, expectedHoldID, src.HoldID, "hold id mismatch.")
+
+						return nil, nil, test.internalXferErr
+					}).
 					Times(test.internalXferTimes),
 			)
 
 			response, httpStatus, httpMessage, payload, err :=
-				HTTPFiatTransfer(mockAuth, mockCache, mockDB, zapLogger, validClientID, &test.request)
+				HTTPFiatTransfer(mockAuth, mockCache, mockDB, zapLogger, validClientID, &test.request, "device", "127.0.0.1")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectNilResponse(t, response, "nil response expectation failed.")
 			test.expectNilPayload(t, payload, "nil payload expectation failed.")
@@ -757,7 +909,7 @@ func TestCommon_HTTPFiatBalance(t *testing.T) {
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			mockDB.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
-				Return(postgres.FiatAccount{}, test.fiatBalanceErr).
+				Return(postgres.FiatAccountBalance{}, test.fiatBalanceErr).
 				Times(test.fiatBalanceTimes)
 
 			accDetails, httpStatus, httpMessage, payload, err :=
@@ -792,49 +944,49 @@ func TestCommon_HTTPFiatBalancePaginatedRequest(t *testing.T) {
 			name:           "empty currency",
 			currencyStr:    "",
 			limitStr:       "5",
-			expectCurrency: postgres.CurrencyAED,
+			expectCurrency: postgres.Currency("AED"),
 			expectLimit:    5,
 		}, {
 			name:           "AED",
 			currencyStr:    encAED,
 			limitStr:       "5",
-			expectCurrency: postgres.CurrencyAED,
+			expectCurrency: postgres.Currency("AED"),
 			expectLimit:    5,
 		}, {
 			name:           "USD",
 			currencyStr:    encUSD,
 			limitStr:       "5",
-			expectCurrency: postgres.CurrencyUSD,
+			expectCurrency: postgres.Currency("USD"),
 			expectLimit:    5,
 		}, {
 			name:           "EUR",
 			currencyStr:    encEUR,
 			limitStr:       "5",
-			expectCurrency: postgres.CurrencyEUR,
+			expectCurrency: postgres.Currency("EUR"),
 			expectLimit:    5,
 		}, {
 			name:           "base bound limit",
 			currencyStr:    encEUR,
 			limitStr:       "0",
-			expectCurrency: postgres.CurrencyEUR,
+			expectCurrency: postgres.Currency("EUR"),
 			expectLimit:    10,
 		}, {
 			name:           "above base bound limit",
 			currencyStr:    encEUR,
 			limitStr:       "999",
-			expectCurrency: postgres.CurrencyEUR,
+			expectCurrency: postgres.Currency("EUR"),
 			expectLimit:    999,
 		}, {
 			name:           "empty request",
 			currencyStr:    "",
 			limitStr:       "",
-			expectCurrency: postgres.CurrencyAED,
+			expectCurrency: postgres.Currency("AED"),
 			expectLimit:    10,
 		}, {
 			name:           "empty currency",
 			currencyStr:    "",
 			limitStr:       "999",
-			expectCurrency: postgres.CurrencyAED,
+			expectCurrency: postgres.Currency("AED"),
 			expectLimit:    999,
 		},
 	}
@@ -851,7 +1003,7 @@ func TestCommon_HTTPFiatBalancePaginatedRequest(t *testing.T) {
 }
 
 func TestCommon_HTTPFiatBalancePaginated(t *testing.T) {
-	accDetails := []postgres.FiatAccount{{}, {}, {}, {}}
+	accDetails := []postgres.FiatAccountBalance{{}, {}, {}, {}}
 
 	testCases := []struct {
 		name                 string
@@ -859,7 +1011,7 @@ func TestCommon_HTTPFiatBalancePaginated(t *testing.T) {
 		pageSize             string
 		expectedMsg          string
 		expectedStatus       int
-		accDetails           []postgres.FiatAccount
+		accDetails           []postgres.FiatAccountBalance
 		authDecryptStrErr    error
 		authDecryptStrTimes  int
 		fiatBalanceErr       error
@@ -934,7 +1086,7 @@ func TestCommon_HTTPFiatBalancePaginated(t *testing.T) {
 			pageCursor:           "",
 			pageSize:             "",
 			expectedMsg:          "",
-			accDetails:           []postgres.FiatAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:           []postgres.FiatAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			expectedStatus:       0,
 			authDecryptStrErr:    nil,
 			authDecryptStrTimes:  0,
@@ -949,7 +1101,7 @@ func TestCommon_HTTPFiatBalancePaginated(t *testing.T) {
 			pageCursor:           "",
 			pageSize:             "",
 			expectedMsg:          "",
-			accDetails:           []postgres.FiatAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:           []postgres.FiatAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			expectedStatus:       0,
 			authDecryptStrErr:    nil,
 			authDecryptStrTimes:  0,
@@ -1004,7 +1156,7 @@ func TestCommon_HTTPFiatBalancePaginated(t *testing.T) {
 
 			gomock.InOrder(
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
-					Return([]byte{}, test.authDecryptStrErr).
+					Return([]byte("USD"), test.authDecryptStrErr).
 					Times(test.authDecryptStrTimes),
 
 				mockDB.EXPECT().FiatBalancePaginated(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -1208,3 +1360,412 @@ func TestHandler_TxDetailsFiatPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestCommon_HTTPFiatCurrencies(t *testing.T) {
+	testCases := []struct {
+		name          string
+		currenciesErr error
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "unknown db failure",
+			currenciesErr: errors.New("unknown error"),
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "known db failure",
+			currenciesErr: postgres.ErrNotFound,
+			expectErrMsg:  "records not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			currenciesErr: nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatCurrencyGetAll().
+				Return(postgres.GenerateTestCurrencies(), test.currenciesErr).
+				Times(1)
+
+			records, actualErrCode, actualErrMsg, err := HTTPFiatCurrencies(mockDB, zapLogger)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Len(t, records, len(postgres.GenerateTestCurrencies()), "currency count mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPFiatCurrencyUpsert(t *testing.T) {
+	testCases := []struct {
+		name             string
+		request          *models.HTTPFiatCurrencyRequest
+		upsertErr        error
+		upsertTimes      int
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "empty request",
+			request:          &models.HTTPFiatCurrencyRequest{},
+			upsertErr:        nil,
+			upsertTimes:      0,
+			expectErrMsg:     constants.ValidationString(),
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "lowercase code",
+			request:          &models.HTTPFiatCurrencyRequest{Code: "usd", Status: "ACTIVE"},
+			upsertErr:        nil,
+			upsertTimes:      0,
+			expectErrMsg:     constants.ValidationString(),
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "internal status",
+			request:          &models.HTTPFiatCurrencyRequest{Code: "USD", Status: "INTERNAL"},
+			upsertErr:        nil,
+			upsertTimes:      0,
+			expectErrMsg:     constants.ValidationString(),
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unknown db failure",
+			request:          &models.HTTPFiatCurrencyRequest{Code: "USD", Status: "WITHDRAWN"},
+			upsertErr:        errors.New("unknown error"),
+			upsertTimes:      1,
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "known db failure",
+			request:          &models.HTTPFiatCurrencyRequest{Code: "USD", Status: "WITHDRAWN"},
+			upsertErr:        postgres.ErrFiatCurrency,
+			upsertTimes:      1,
+			expectErrMsg:     "could not register or update Fiat currency",
+			expectErrCode:    http.StatusConflict,
+			expectErr:        require.Error,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid",
+			request:          &models.HTTPFiatCurrencyRequest{Code: "XTS", Status: "DEPRECATED"},
+			upsertErr:        nil,
+			upsertTimes:      1,
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatCurrencyUpsert(test.request.Code, postgres.FiatCurrencyStatus(test.request.Status)).
+				Return(test.upsertErr).
+				Times(test.upsertTimes)
+
+			actualErrCode, actualErrMsg, payload, err := HTTPFiatCurrencyUpsert(mockDB, zapLogger, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+		})
+	}
+}
+
+func TestCommon_HTTPFiatClose(t *testing.T) {
+	balance := postgres.FiatAccountBalance{Balance: decimal.NewFromFloat(100.25)}
+
+	testCases := []struct {
+		name             string
+		request          *models.HTTPCloseFiatAccountRequest
+		expectedMsg      string
+		expectedStatus   int
+		balance          postgres.FiatAccountBalance
+		balanceErr       error
+		balanceTimes     int
+		quotesAmount     decimal.Decimal
+		quotesErr        error
+		quotesTimes      int
+		closeErr         error
+		closeTimes       int
+		expectErr        require.ErrorAssertionFunc
+		expectNilReceipt require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "empty request",
+			request:          &models.HTTPCloseFiatAccountRequest{},
+			expectedMsg:      constants.ValidationString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "invalid currency",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "INVALID"},
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "same sweep currency",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "USD"},
+			expectedMsg:      "must differ",
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "balance unknown db error",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balance:          balance,
+			balanceErr:       errors.New("unknown error"),
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "balance known db error",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			balance:          balance,
+			balanceErr:       postgres.ErrNotFound,
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "invalid sweep currency",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "INVALID"},
+			expectedMsg:      constants.InvalidRequestString(),
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "quotes failure",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        errors.New("quotes failure"),
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "sweep amount too small",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			expectedMsg:      "too small",
+			expectedStatus:   http.StatusBadRequest,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(0),
+			quotesErr:        nil,
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "close unknown db error",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         errors.New("unknown error"),
+			closeTimes:       1,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "close known db error",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			expectedMsg:      "balance must be zero",
+			expectedStatus:   http.StatusConflict,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         postgres.ErrCloseAccount,
+			closeTimes:       1,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid without sweep",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid with sweep of zero balance",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balance:          postgres.FiatAccountBalance{},
+			balanceErr:       nil,
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid with sweep",
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balance:          balance,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			quotesAmount:     decimal.NewFromFloat(135.55),
+			quotesErr:        nil,
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
+					Return(test.balance, test.balanceErr).
+					Times(test.balanceTimes),
+
+				mockQuotes.EXPECT().FiatConversion(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(decimal.NewFromFloat(1.3555), test.quotesAmount, test.quotesErr).
+					Times(test.quotesTimes),
+
+				mockDB.EXPECT().FiatCloseAccount(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatAccountTransferResult{}, &postgres.FiatAccountTransferResult{}, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			receipt, httpStatus, httpMessage, payload, err :=
+				HTTPFiatClose(mockDB, zapLogger, mockQuotes, uuid.UUID{}, test.request, "device", "127.0.0.1")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilReceipt(t, receipt, "nil receipt expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatched.")
+			require.Contains(t, httpMessage, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/ledger"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// ledgerAuditTarget is the audit log target for a journal, or for all journals if none is specified.
+func ledgerAuditTarget(journal string) string {
+	if len(journal) == 0 {
+		return "journals"
+	}
+
+	return journal
+}
+
+// HTTPAdminLedgerVerify will walk the hash chain of a journal, or of all journals if none is specified, and verify it
+// against the signed checkpoints.
+func HTTPAdminLedgerVerify(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	journal string) (*models.HTTPLedgerVerification, int, string, error) {
+	var (
+		err          error
+		verification models.HTTPLedgerVerification
+	)
+
+	if _, err = ledger.Journals(journal); err != nil {
+		return nil, http.StatusBadRequest, "invalid journal", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionLEDGERVERIFY,
+		ledgerAuditTarget(journal), nil)
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	verification.Journals, err = ledger.Verify(db, auth, journal)
+	done(err)
+
+	if err != nil {
+		logger.Warn("failed to verify journal hash chains", zap.String("journal", journal), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	verification.Intact = ledger.Intact(verification.Journals)
+
+	return &verification, 0, "", nil
+}
+
+// HTTPAdminLedgerCheckpoints will retrieve the signed checkpoints of a journal, or of all journals if none is
+// specified, recorded at or after a Unix timestamp along with the public key to verify them with.
+func HTTPAdminLedgerCheckpoints(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	journal, sinceStr string) (*ledger.CheckpointExport, int, string, error) {
+	var (
+		err    error
+		export *ledger.CheckpointExport
+		since  time.Time
+	)
+
+	if _, err = ledger.Journals(journal); err != nil {
+		return nil, http.StatusBadRequest, "invalid journal", fmt.Errorf("%w", err)
+	}
+
+	if since, err = balanceTimestamp(sinceStr, time.Unix(0, 0), time.Now()); err != nil {
+		return nil, http.StatusBadRequest, "invalid timestamp", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionLEDGERCHECKPOINTVIEW,
+		ledgerAuditTarget(journal), nil)
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	export, err = ledger.Export(db, auth, journal, since)
+	done(err)
+
+	if err != nil {
+		logger.Warn("failed to retrieve journal checkpoints", zap.String("journal", journal), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return export, 0, "", nil
+}
@@ -0,0 +1,238 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPAdminLedgerVerify(t *testing.T) {
+	t.Parallel()
+
+	broken := &postgres.JournalChainVerification{
+		Journal: postgres.JournalFiat,
+		Break:   &postgres.JournalChainBreak{Seq: 7, Reason: "entry hash does not match the entry fields"},
+	}
+	intact := &postgres.JournalChainVerification{Journal: postgres.JournalCrypto, Intact: true}
+
+	testCases := []struct {
+		name          string
+		journal       string
+		expectTarget  string
+		verification  *postgres.JournalChainVerification
+		auditErr      error
+		auditTimes    int
+		verifyErr     error
+		verifyTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectIntact  require.BoolAssertionFunc
+	}{
+		{
+			name:          "invalid journal",
+			journal:       "users",
+			auditTimes:    0,
+			verifyTimes:   0,
+			expectErrMsg:  "invalid journal",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			journal:       postgres.JournalFiat,
+			expectTarget:  postgres.JournalFiat,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			verifyTimes:   0,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "verify failure",
+			journal:       postgres.JournalFiat,
+			expectTarget:  postgres.JournalFiat,
+			auditTimes:    1,
+			verifyErr:     postgres.ErrJournalChain,
+			verifyTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:         "intact",
+			journal:      "",
+			expectTarget: "journals",
+			verification: intact,
+			auditTimes:   1,
+			verifyTimes:  2,
+			expectErr:    require.NoError,
+			expectIntact: require.True,
+		}, {
+			name:         "broken",
+			journal:      postgres.JournalFiat,
+			expectTarget: postgres.JournalFiat,
+			verification: broken,
+			auditTimes:   1,
+			verifyTimes:  1,
+			expectErr:    require.NoError,
+			expectIntact: require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionLEDGERVERIFY, test.expectTarget,
+				gomock.Any()).
+				Return(int64(1), test.auditErr).
+				Times(test.auditTimes)
+
+			mockDB.EXPECT().JournalCheckpoints(gomock.Any(), time.Time{}).
+				Return(nil, nil).
+				Times(test.verifyTimes)
+
+			mockDB.EXPECT().JournalChainVerify(gomock.Any(), gomock.Any()).
+				Return(test.verification, test.verifyErr).
+				Times(test.verifyTimes)
+
+			verification, actualErrCode, actualErrMsg, err := HTTPAdminLedgerVerify(testAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.journal)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				require.Nil(t, verification, "verification returned on failure.")
+
+				return
+			}
+
+			test.expectIntact(t, verification.Intact, "intact expectation failed.")
+			require.Len(t, verification.Journals, test.verifyTimes, "verified journals count mismatch.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminLedgerCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	checkpoint := postgres.JournalCheckpoint{Journal: postgres.JournalCrypto, Seq: 3, EntryHash: []byte{0xbe, 0xef}}
+
+	testCases := []struct {
+		name             string
+		journal          string
+		since            string
+		expectSince      time.Time
+		auditErr         error
+		auditTimes       int
+		checkpointsErr   error
+		checkpointsTimes int
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid journal",
+			journal:       "users",
+			expectErrMsg:  "invalid journal",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid timestamp",
+			journal:       postgres.JournalCrypto,
+			since:         "yesterday",
+			expectErrMsg:  "invalid timestamp",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "future timestamp",
+			journal:       postgres.JournalCrypto,
+			since:         "99999999999",
+			expectErrMsg:  "invalid timestamp",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			journal:       postgres.JournalCrypto,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:             "checkpoints failure",
+			journal:          postgres.JournalCrypto,
+			expectSince:      time.Unix(0, 0),
+			auditTimes:       1,
+			checkpointsErr:   errors.New("unknown failure"),
+			checkpointsTimes: 1,
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "valid",
+			journal:          postgres.JournalCrypto,
+			since:            "1685923200",
+			expectSince:      time.Unix(1685923200, 0),
+			auditTimes:       1,
+			checkpointsTimes: 1,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionLEDGERCHECKPOINTVIEW,
+					postgres.JournalCrypto, gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().JournalCheckpoints(test.journal, test.expectSince).
+					Return([]postgres.JournalCheckpoint{checkpoint}, test.checkpointsErr).
+					Times(test.checkpointsTimes),
+			)
+
+			export, actualErrCode, actualErrMsg, err := HTTPAdminLedgerCheckpoints(testAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.journal, test.since)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				require.Nil(t, export, "checkpoints returned on failure.")
+
+				return
+			}
+
+			require.Len(t, export.Checkpoints, 1, "exported checkpoints count mismatch.")
+			require.Equal(t, "beef", export.Checkpoints[0].EntryHash, "exported entry hash mismatch.")
+		})
+	}
+}
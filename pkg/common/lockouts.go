@@ -0,0 +1,239 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// loginRetryAfter will check whether login attempts on a username or from an IP address are being rejected after
+// repeated failures. It returns the number of seconds until another attempt is permitted, which is zero if an attempt
+// is permitted now.
+func loginRetryAfter(cache redis.Redis, logger *logger.Logger, username, ipAddress string) (int64, error) {
+	var retryAfter int64
+
+	keys := []string{
+		fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeUSERNAME, username),
+		fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeIPADDRESS, ipAddress),
+		fmt.Sprintf(constants.LoginDelayFormatString(), username),
+	}
+
+	for _, key := range keys {
+		var permittedAt int64
+
+		if err := cache.Get(key, &permittedAt); err != nil {
+			if isCacheMiss(err) {
+				continue
+			}
+
+			logger.Error("failed to check login throttling", zap.String("key", key), zap.Error(err))
+
+			return 0, fmt.Errorf("%w", err)
+		}
+
+		if wait := permittedAt - time.Now().Unix(); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	return retryAfter, nil
+}
+
+// recordLoginFailure will count a failed login attempt against the username and IP address it was made with. The next
+// attempt on the username is delayed, and the username or IP address is locked out once it exceeds its limit within
+// the failure window. Errors are logged but not returned so that the failed attempt is reported to the requester.
+func recordLoginFailure(authority auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	username, ipAddress string) {
+	var (
+		err          error
+		userFailures int64
+		ipFailures   int64
+		now          = time.Now()
+		throttle     = authority.LoginThrottle()
+	)
+
+	if userFailures, err = cache.Incr(fmt.Sprintf(constants.LoginFailuresFormatString(),
+		postgres.LockoutScopeUSERNAME, username), throttle.FailureWindow); err != nil {
+		logger.Error("failed to count failed login attempt", zap.String("username", username), zap.Error(err))
+
+		return
+	}
+
+	if delay := throttle.Delay(userFailures); delay > 0 {
+		if err = cache.Set(fmt.Sprintf(constants.LoginDelayFormatString(), username), now.Add(delay).Unix(),
+			delay); err != nil {
+			logger.Error("failed to delay login attempts", zap.String("username", username), zap.Error(err))
+		}
+	}
+
+	if userFailures >= throttle.MaxUserFailures {
+		lockOut(cache, db, logger, postgres.LockoutScopeUSERNAME, username, ipAddress, userFailures,
+			now.Add(throttle.LockoutDuration))
+	}
+
+	if ipFailures, err = cache.Incr(fmt.Sprintf(constants.LoginFailuresFormatString(),
+		postgres.LockoutScopeIPADDRESS, ipAddress), throttle.FailureWindow); err != nil {
+		logger.Error("failed to count failed login attempt", zap.String("ipAddress", ipAddress), zap.Error(err))
+
+		return
+	}
+
+	if ipFailures >= throttle.MaxIPFailures {
+		lockOut(cache, db, logger, postgres.LockoutScopeIPADDRESS, ipAddress, ipAddress, ipFailures,
+			now.Add(throttle.LockoutDuration))
+	}
+}
+
+// lockOut will reject login attempts on a username or from an IP address until the lockout expires. Only the first of
+// any concurrent lockouts is recorded.
+func lockOut(cache redis.Redis, db postgres.Postgres, logger *logger.Logger, scope postgres.LockoutScope,
+	subject, ipAddress string, failures int64, expiresAt time.Time) {
+	placed, err := cache.SetNX(fmt.Sprintf(constants.LoginLockoutFormatString(), scope, subject), expiresAt.Unix(),
+		time.Until(expiresAt))
+	if err != nil {
+		logger.Error("failed to lock out login attempts",
+			zap.String("scope", string(scope)), zap.String("subject", subject), zap.Error(err))
+
+		return
+	}
+
+	if !placed {
+		return
+	}
+
+	logger.Warn("login attempts locked out after repeated failures", zap.String("scope", string(scope)),
+		zap.String("subject", subject), zap.String("ipAddress", ipAddress), zap.Int64("failures", failures))
+
+	if runes := []rune(subject); len(runes) > 64 {
+		subject = string(runes[:64])
+	}
+
+	if runes := []rune(ipAddress); len(runes) > 64 {
+		ipAddress = string(runes[:64])
+	}
+
+	// Failures to record the lockout are logged in the database package.
+	_ = db.LoginLockoutCreate(scope, subject, failures, ipAddress, expiresAt)
+}
+
+// clearLoginFailures will reset the failed login attempts on a username after a successful login. Failures from the IP
+// address are retained so that a valid account cannot be used to reset them.
+func clearLoginFailures(cache redis.Redis, logger *logger.Logger, username string) {
+	keys := []string{
+		fmt.Sprintf(constants.LoginFailuresFormatString(), postgres.LockoutScopeUSERNAME, username),
+		fmt.Sprintf(constants.LoginDelayFormatString(), username),
+	}
+
+	for _, key := range keys {
+		if err := cache.Del(key); err != nil && !isCacheMiss(err) {
+			logger.Error("failed to clear failed login attempts", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// HTTPAdminUnlockUser will lift the login lockout of a user account and reset its failed login attempts.
+func HTTPAdminUnlockUser(cache redis.Redis, db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	clientIDStr string, request *models.HTTPAdminUnlockRequest) (int, string, any, error) {
+	var (
+		err      error
+		clientID uuid.UUID
+		unlocked bool
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if clientID, err = uuid.FromString(clientIDStr); err != nil {
+		return http.StatusBadRequest, "invalid client id", clientIDStr, fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionUSERUNLOCK,
+		clientID.String(), map[string]any{"reason": request.Reason})
+	if err != nil {
+		return httpStatus, httpMsg, nil, err
+	}
+
+	user, err := db.UserGetInfo(clientID)
+	if err != nil {
+		done(err)
+
+		msg := "user account not found"
+
+		return http.StatusNotFound, msg, clientIDStr, fmt.Errorf("%w", err)
+	}
+
+	// A lockout that is in effect in the cache is lifted even if it could not be recorded in the database.
+	lockoutKey := fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeUSERNAME, user.Username)
+	if err = cache.Del(lockoutKey); err == nil {
+		unlocked = true
+	} else if !isCacheMiss(err) {
+		logger.Error("failed to lift login lockout", zap.String("key", lockoutKey), zap.Error(err))
+		done(err)
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	clearLoginFailures(cache, logger, user.Username)
+
+	if err = db.LoginLockoutUnlock(adminID, postgres.LockoutScopeUSERNAME, user.Username); err != nil {
+		if !errors.Is(err, postgres.ErrNotFound) {
+			done(err)
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		if !unlocked {
+			done(err)
+
+			msg := "user account is not locked out"
+
+			return http.StatusNotFound, msg, clientIDStr, fmt.Errorf("%w", err)
+		}
+	}
+
+	done(nil)
+
+	return 0, "", nil, nil
+}
+
+// HTTPAdminLoginLockouts will retrieve the most recent login lockouts of usernames and IP addresses, newest first. The
+// lockouts can be restricted to those that are still in effect.
+func HTTPAdminLoginLockouts(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, activeOnly bool,
+	limitStr string) ([]postgres.LoginLockout, int, string, error) {
+	var (
+		err      error
+		limit    int32
+		lockouts []postgres.LoginLockout
+	)
+
+	if limit, err = adminPageSize(limitStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid result limit", fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionLOGINLOCKOUTVIEW, "",
+		map[string]any{"activeOnly": activeOnly, "limit": limit})
+	if err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	lockouts, err = db.LoginLockoutsRecent(activeOnly, limit)
+	done(err)
+
+	if err != nil {
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return lockouts, 0, "", nil
+}
@@ -0,0 +1,646 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_LoginRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		permittedAt   []int64
+		getErr        []error
+		expectedAfter int64
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "not throttled",
+			permittedAt:   []int64{0, 0, 0},
+			getErr:        []error{redis.ErrCacheMiss, redis.ErrCacheMiss, redis.ErrCacheMiss},
+			expectedAfter: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "username locked out",
+			permittedAt:   []int64{600, 0, 0},
+			getErr:        []error{nil, redis.ErrCacheMiss, redis.ErrCacheMiss},
+			expectedAfter: 600,
+			expectErr:     require.NoError,
+		}, {
+			name:          "ip address locked out",
+			permittedAt:   []int64{0, 300, 0},
+			getErr:        []error{redis.ErrCacheMiss, nil, redis.ErrCacheMiss},
+			expectedAfter: 300,
+			expectErr:     require.NoError,
+		}, {
+			name:          "delayed",
+			permittedAt:   []int64{0, 0, 8},
+			getErr:        []error{redis.ErrCacheMiss, redis.ErrCacheMiss, nil},
+			expectedAfter: 8,
+			expectErr:     require.NoError,
+		}, {
+			name:          "longest wait",
+			permittedAt:   []int64{600, 0, 8},
+			getErr:        []error{nil, redis.ErrCacheMiss, nil},
+			expectedAfter: 600,
+			expectErr:     require.NoError,
+		}, {
+			name:          "cache failure",
+			permittedAt:   []int64{0, 0, 0},
+			getErr:        []error{redis.ErrCacheUnknown, nil, nil},
+			expectedAfter: 0,
+			expectErr:     require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			keys := []string{
+				fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeUSERNAME, "username1"),
+				fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeIPADDRESS, "127.0.0.1"),
+				fmt.Sprintf(constants.LoginDelayFormatString(), "username1"),
+			}
+
+			calls := make([]*gomock.Call, 0, len(keys))
+
+			for idx, key := range keys {
+				// Waits are relative to the time of the check, so they are padded to avoid rounding down.
+				permittedAt := time.Now().Add(time.Duration(test.permittedAt[idx])*time.Second + time.Second/2).Unix()
+				calls = append(calls, mockRedis.EXPECT().Get(key, gomock.Any()).
+					SetArg(1, permittedAt).
+					Return(test.getErr[idx]).
+					MaxTimes(1))
+			}
+
+			gomock.InOrder(calls...)
+
+			retryAfter, err := loginRetryAfter(mockRedis, zapLogger, "username1", "127.0.0.1")
+			test.expectErr(t, err, "error expectation failed.")
+			require.InDelta(t, test.expectedAfter, retryAfter, 1, "retry after mismatched.")
+		})
+	}
+}
+
+func TestCommon_RecordLoginFailure(t *testing.T) {
+	t.Parallel()
+
+	throttle := auth.LoginThrottle{
+		MaxUserFailures: 5,
+		MaxIPFailures:   20,
+		FailureWindow:   15 * time.Minute,
+		LockoutDuration: 15 * time.Minute,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+	}
+
+	testCases := []struct {
+		name            string
+		userFailures    int64
+		userIncrErr     error
+		ipFailures      int64
+		ipIncrTimes     int
+		expectedDelay   time.Duration
+		delayTimes      int
+		userLockTimes   int
+		userLockPlaced  bool
+		ipLockTimes     int
+		lockCreateTimes int
+	}{
+		{
+			name:            "first failure",
+			userFailures:    1,
+			ipFailures:      1,
+			ipIncrTimes:     1,
+			expectedDelay:   time.Second,
+			delayTimes:      1,
+			userLockTimes:   0,
+			userLockPlaced:  false,
+			ipLockTimes:     0,
+			lockCreateTimes: 0,
+		}, {
+			name:            "cache failure",
+			userFailures:    0,
+			userIncrErr:     redis.ErrCacheSet,
+			ipFailures:      0,
+			ipIncrTimes:     0,
+			expectedDelay:   0,
+			delayTimes:      0,
+			userLockTimes:   0,
+			userLockPlaced:  false,
+			ipLockTimes:     0,
+			lockCreateTimes: 0,
+		}, {
+			name:            "username locked out",
+			userFailures:    5,
+			ipFailures:      5,
+			ipIncrTimes:     1,
+			expectedDelay:   16 * time.Second,
+			delayTimes:      1,
+			userLockTimes:   1,
+			userLockPlaced:  true,
+			ipLockTimes:     0,
+			lockCreateTimes: 1,
+		}, {
+			name:            "username already locked out",
+			userFailures:    6,
+			ipFailures:      6,
+			ipIncrTimes:     1,
+			expectedDelay:   30 * time.Second,
+			delayTimes:      1,
+			userLockTimes:   1,
+			userLockPlaced:  false,
+			ipLockTimes:     0,
+			lockCreateTimes: 0,
+		}, {
+			name:            "ip address locked out",
+			userFailures:    1,
+			ipFailures:      20,
+			ipIncrTimes:     1,
+			expectedDelay:   time.Second,
+			delayTimes:      1,
+			userLockTimes:   0,
+			userLockPlaced:  false,
+			ipLockTimes:     1,
+			lockCreateTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			userFailuresKey := fmt.Sprintf(constants.LoginFailuresFormatString(), postgres.LockoutScopeUSERNAME,
+				"username1")
+			ipFailuresKey := fmt.Sprintf(constants.LoginFailuresFormatString(), postgres.LockoutScopeIPADDRESS,
+				"127.0.0.1")
+			userLockKey := fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeUSERNAME, "username1")
+			ipLockKey := fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeIPADDRESS, "127.0.0.1")
+
+			gomock.InOrder(
+				mockAuth.EXPECT().LoginThrottle().
+					Return(throttle).
+					Times(1),
+
+				mockRedis.EXPECT().Incr(userFailuresKey, throttle.FailureWindow).
+					Return(test.userFailures, test.userIncrErr).
+					Times(1),
+
+				mockRedis.EXPECT().Set(fmt.Sprintf(constants.LoginDelayFormatString(), "username1"), gomock.Any(),
+					test.expectedDelay).
+					Return(nil).
+					Times(test.delayTimes),
+
+				mockRedis.EXPECT().SetNX(userLockKey, gomock.Any(), gomock.Any()).
+					Return(test.userLockPlaced, nil).
+					Times(test.userLockTimes),
+
+				mockRedis.EXPECT().Incr(ipFailuresKey, throttle.FailureWindow).
+					Return(test.ipFailures, nil).
+					Times(test.ipIncrTimes),
+
+				mockRedis.EXPECT().SetNX(ipLockKey, gomock.Any(), gomock.Any()).
+					Return(true, nil).
+					Times(test.ipLockTimes),
+			)
+
+			mockPostgres.EXPECT().LoginLockoutCreate(gomock.Any(), gomock.Any(), gomock.Any(), "127.0.0.1",
+				gomock.Any()).
+				Return(nil).
+				Times(test.lockCreateTimes)
+
+			recordLoginFailure(mockAuth, mockRedis, mockPostgres, zapLogger, "username1", "127.0.0.1")
+		})
+	}
+}
+
+func TestCommon_HTTPUserLogin_Throttled(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		otp             string
+		getErr          error
+		checkPassErr    error
+		credsTimes      int
+		mfaTimes        int
+		failureTimes    int
+		expectedStatus  int
+		expectedMsg     string
+		expectedPayload any
+	}{
+		{
+			name:            "locked out",
+			getErr:          nil,
+			credsTimes:      0,
+			mfaTimes:        0,
+			failureTimes:    0,
+			expectedStatus:  http.StatusTooManyRequests,
+			expectedMsg:     constants.LoginThrottledString(),
+			expectedPayload: models.HTTPRetryAfter{RetryAfter: 60},
+		}, {
+			name:            "cache failure",
+			getErr:          redis.ErrCacheUnknown,
+			credsTimes:      0,
+			mfaTimes:        0,
+			failureTimes:    0,
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMsg:     constants.RetryMessageString(),
+			expectedPayload: nil,
+		}, {
+			name:            "invalid second factor",
+			otp:             "invalid-recovery-code",
+			getErr:          redis.ErrCacheMiss,
+			credsTimes:      1,
+			mfaTimes:        1,
+			failureTimes:    1,
+			expectedStatus:  http.StatusForbidden,
+			expectedMsg:     constants.MFAInvalidString(),
+			expectedPayload: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			// Padded to avoid rounding the wait down.
+			permittedAt := time.Now().Add(time.Minute + time.Second/2).Unix()
+
+			mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+				SetArg(1, permittedAt).
+				Return(test.getErr).
+				MinTimes(1).
+				MaxTimes(3)
+
+			mockPostgres.EXPECT().UserCredentials("username1").
+				Return(uuid.UUID{}, "hashed password", nil).
+				Times(test.credsTimes)
+
+			mockAuth.EXPECT().CheckPassword(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(test.credsTimes)
+
+			mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+				Return(modelsPostgres.UserStatus{Role: constants.RoleUser()}, nil).
+				Times(test.credsTimes)
+
+			mockPostgres.EXPECT().MFAGet(gomock.Any()).
+				Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, nil).
+				Times(test.mfaTimes)
+
+			mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), auth.HashRecoveryCode(test.otp)).
+				Return(postgres.ErrNotFound).
+				Times(test.mfaTimes)
+
+			mockAuth.EXPECT().LoginThrottle().
+				Return(auth.LoginThrottle{MaxUserFailures: 5, MaxIPFailures: 20, BaseDelay: time.Second,
+					MaxDelay: time.Minute}).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Incr(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(2 * test.failureTimes)
+
+			mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), time.Second).
+				Return(nil).
+				Times(test.failureTimes)
+
+			token, httpMsg, httpCode, payload, err := HTTPLoginUser(mockAuth, mockRedis, mockPostgres, zapLogger,
+				&modelsPostgres.UserLoginCredentials{Username: "username1", Password: "user-password-1"},
+				"device", "127.0.0.1", test.otp)
+			require.Error(t, err, "error expectation failed.")
+			require.Nil(t, token, "token returned.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Equal(t, test.expectedMsg, httpMsg, "http message mismatched.")
+
+			if retry, ok := payload.(models.HTTPRetryAfter); ok {
+				require.InDelta(t, 60, retry.RetryAfter, 1, "retry after mismatched.")
+			} else {
+				require.Equal(t, test.expectedPayload, payload, "payload mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAdminUnlockUser(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	adminID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate admin id")
+
+	request := &models.HTTPAdminUnlockRequest{Reason: "verified identity"}
+	user := modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{
+		UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"},
+	}}
+	lockKey := fmt.Sprintf(constants.LoginLockoutFormatString(), postgres.LockoutScopeUSERNAME, "username1")
+
+	testCases := []struct {
+		name          string
+		clientID      string
+		request       *models.HTTPAdminUnlockRequest
+		auditErr      error
+		auditTimes    int
+		userErr       error
+		userTimes     int
+		lockDelErr    error
+		lockDelTimes  int
+		cacheTimes    int
+		unlockErr     error
+		unlockTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "empty request",
+			clientID:      clientID.String(),
+			request:       &models.HTTPAdminUnlockRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid client id",
+			clientID:      "invalid-client-id",
+			request:       request,
+			expectErrMsg:  "invalid client id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			clientID:      clientID.String(),
+			request:       request,
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "user not found",
+			clientID:      clientID.String(),
+			request:       request,
+			auditTimes:    1,
+			userErr:       postgres.ErrNotFoundUser,
+			userTimes:     1,
+			expectErrMsg:  "user account not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "cache failure",
+			clientID:      clientID.String(),
+			request:       request,
+			auditTimes:    1,
+			userTimes:     1,
+			lockDelErr:    redis.ErrCacheDel,
+			lockDelTimes:  1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not locked out",
+			clientID:      clientID.String(),
+			request:       request,
+			auditTimes:    1,
+			userTimes:     1,
+			lockDelErr:    redis.ErrCacheMiss,
+			lockDelTimes:  1,
+			cacheTimes:    1,
+			unlockErr:     postgres.ErrNotFound,
+			unlockTimes:   1,
+			expectErrMsg:  "not locked out",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "database failure",
+			clientID:      clientID.String(),
+			request:       request,
+			auditTimes:    1,
+			userTimes:     1,
+			lockDelErr:    nil,
+			lockDelTimes:  1,
+			cacheTimes:    1,
+			unlockErr:     postgres.ErrLoginLockout,
+			unlockTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "lockout only in cache",
+			clientID:      clientID.String(),
+			request:       request,
+			auditTimes:    1,
+			userTimes:     1,
+			lockDelErr:    nil,
+			lockDelTimes:  1,
+			cacheTimes:    1,
+			unlockErr:     postgres.ErrNotFound,
+			unlockTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "unlocked",
+			clientID:      clientID.String(),
+			request:       request,
+			auditTimes:    1,
+			userTimes:     1,
+			lockDelErr:    redis.ErrCacheMiss,
+			lockDelTimes:  1,
+			cacheTimes:    1,
+			unlockErr:     nil,
+			unlockTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(adminID, postgres.AdminActionUSERUNLOCK, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(user, test.userErr).
+					Times(test.userTimes),
+
+				mockRedis.EXPECT().Del(lockKey).
+					Return(test.lockDelErr).
+					Times(test.lockDelTimes),
+
+				mockRedis.EXPECT().Del(gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(2*test.cacheTimes),
+
+				mockDB.EXPECT().LoginLockoutUnlock(adminID, postgres.LockoutScopeUSERNAME, "username1").
+					Return(test.unlockErr).
+					Times(test.unlockTimes),
+			)
+
+			actualErrCode, actualErrMsg, _, err := HTTPAdminUnlockUser(mockRedis, mockDB, zapLogger, adminID,
+				test.clientID, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminLoginLockouts(t *testing.T) {
+	t.Parallel()
+
+	adminID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate admin id")
+
+	testCases := []struct {
+		name          string
+		limit         string
+		expectedLimit int32
+		auditErr      error
+		auditTimes    int
+		lockoutsErr   error
+		lockoutsTimes int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid limit",
+			limit:         "invalid",
+			expectErrMsg:  "invalid result limit",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			limit:         "",
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "database failure",
+			limit:         "",
+			expectedLimit: 10,
+			auditTimes:    1,
+			lockoutsErr:   postgres.ErrLoginLockout,
+			lockoutsTimes: 1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "default limit",
+			limit:         "",
+			expectedLimit: 10,
+			auditTimes:    1,
+			lockoutsTimes: 1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "capped limit",
+			limit:         "1000",
+			expectedLimit: 100,
+			auditTimes:    1,
+			lockoutsTimes: 1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(adminID, postgres.AdminActionLOGINLOCKOUTVIEW, "", gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().LoginLockoutsRecent(true, test.expectedLimit).
+					Return([]postgres.LoginLockout{{LockoutID: 1}}, test.lockoutsErr).
+					Times(test.lockoutsTimes),
+			)
+
+			lockouts, actualErrCode, actualErrMsg, err := HTTPAdminLoginLockouts(mockDB, zapLogger, adminID, true,
+				test.limit)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Len(t, lockouts, 1, "lockouts mismatched.")
+			}
+		})
+	}
+}
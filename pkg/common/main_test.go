@@ -5,8 +5,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +24,16 @@ var expirationDuration int64 = 10
 // refreshThreshold is the time in seconds before expiration that a JWT can be refreshed in.
 var refreshThreshold int64 = 99
 
+// testCryptoAsset is the Cryptocurrency registry entry used for Bitcoin in the tests.
+var testCryptoAsset = postgres.CryptoAsset{
+	Ticker:        "BTC",
+	Name:          "Bitcoin",
+	DecimalPlaces: 8,
+	Status:        postgres.CryptoAssetStatusENABLED,
+	MinOrder:      decimal.NewFromFloat(0.00001),
+	MaxOrder:      decimal.NewFromFloat(1000000),
+}
+
 // zapLogger is the Zap logger used strictly for the test suite in this package.
 var zapLogger *logger.Logger
 
@@ -57,6 +69,9 @@ func TestMain(m *testing.M) {
 func setup() error {
 	testAuth = auth.TestAuth(zapLogger, expirationDuration, refreshThreshold)
 
+	// Populate the Fiat currency cache.
+	postgres.SetCurrencyCache(postgres.GenerateTestCurrencies())
+
 	return nil
 }
 
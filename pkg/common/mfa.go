@@ -0,0 +1,245 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPMFAEnroll will start a two-factor authentication enrolment for a client with a new TOTP secret that is stored
+// encrypted. Two-factor authentication is only enabled once the enrolment is confirmed with a one-time password.
+func HTTPMFAEnroll(authority auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	*models.HTTPMFAEnrollResponse, int, string, error) {
+	var (
+		err       error
+		secret    string
+		encrypted string
+		user      modelsPostgres.User
+	)
+
+	if user, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during two-factor authentication enrolment",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if secret, err = auth.NewTOTPSecret(); err != nil {
+		logger.Error("failed to generate TOTP secret", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if encrypted, err = authority.EncryptToString([]byte(secret)); err != nil {
+		logger.Error("failed to encrypt TOTP secret", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = db.MFAEnroll(clientID, encrypted); err != nil {
+		var mfaErr *postgres.Error
+		if !errors.As(err, &mfaErr) {
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, mfaErr.Code, mfaErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return &models.HTTPMFAEnrollResponse{Secret: secret, URI: auth.TOTPURI(user.Username, secret)}, 0, "", nil
+}
+
+// HTTPMFAConfirm will enable a pending two-factor authentication enrolment once the first one-time password generated
+// for it has been verified. The single-use recovery codes are only returned in the response to this request.
+func HTTPMFAConfirm(authority auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPMFAConfirmRequest) ([]string, int, string, error) {
+	var (
+		err       error
+		enrolment postgres.UserMFA
+		secret    string
+		step      int64
+		codes     []string
+		hashes    []string
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), fmt.Errorf("%w", err)
+	}
+
+	if enrolment, err = db.MFAGet(clientID); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return nil, http.StatusNotFound, "two-factor authentication enrolment not found", fmt.Errorf("%w", err)
+		}
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if enrolment.EnrolledAt.Valid {
+		return nil, http.StatusConflict, postgres.ErrMFAEnrolled.Error(), postgres.ErrMFAEnrolled
+	}
+
+	if secret, err = decryptTOTPSecret(authority, logger, &enrolment); err != nil {
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), err
+	}
+
+	if step, err = auth.ValidateTOTP(secret, request.Code, enrolment.LastStep, time.Now()); err != nil {
+		return nil, http.StatusForbidden, constants.MFAInvalidString(), fmt.Errorf("%w", err)
+	}
+
+	if codes, hashes, err = auth.NewRecoveryCodes(); err != nil {
+		logger.Error("failed to generate recovery codes", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = db.MFAConfirm(clientID, step, hashes); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return nil, http.StatusForbidden, constants.MFAInvalidString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return codes, 0, "", nil
+}
+
+// HTTPSecondFactor will verify the second factor of a client that has enabled two-factor authentication. The code may
+// be a one-time password or a recovery code, and either can only be used once. Clients that have not enabled
+// two-factor authentication are not required to provide a code. Whether two-factor authentication is enabled is
+// returned.
+func HTTPSecondFactor(authority auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	code string) (bool, int, string, error) {
+	var (
+		err       error
+		enrolment postgres.UserMFA
+		secret    string
+		step      int64
+	)
+
+	if enrolment, err = db.MFAGet(clientID); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return false, 0, "", nil
+		}
+
+		return false, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	// Pending enrolments do not protect the account until they are confirmed.
+	if !enrolment.EnrolledAt.Valid {
+		return false, 0, "", nil
+	}
+
+	if len(code) == 0 {
+		return true, http.StatusForbidden, constants.MFARequiredString(), errors.New(constants.MFARequiredString())
+	}
+
+	// One-time passwords are numeric codes of a fixed length, and any other code is treated as a recovery code.
+	if len(code) == constants.TOTPDigits() {
+		if secret, err = decryptTOTPSecret(authority, logger, &enrolment); err != nil {
+			return true, http.StatusInternalServerError, constants.RetryMessageString(), err
+		}
+
+		if step, err = auth.ValidateTOTP(secret, code, enrolment.LastStep, time.Now()); err != nil {
+			return true, http.StatusForbidden, constants.MFAInvalidString(), fmt.Errorf("%w", err)
+		}
+
+		err = db.MFAUseStep(clientID, step)
+	} else {
+		err = db.MFAUseRecoveryCode(clientID, auth.HashRecoveryCode(code))
+	}
+
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return true, http.StatusForbidden, constants.MFAInvalidString(), fmt.Errorf("%w", err)
+		}
+
+		return true, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return true, 0, "", nil
+}
+
+// HTTPMFARecoveryCodes will replace the recovery codes of a client that has enabled two-factor authentication once
+// their second factor has been verified. The new recovery codes are only returned in the response to this request.
+func HTTPMFARecoveryCodes(authority auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	code string) ([]string, int, string, error) {
+	var (
+		err        error
+		enrolled   bool
+		httpStatus int
+		httpMsg    string
+		codes      []string
+		hashes     []string
+	)
+
+	if enrolled, httpStatus, httpMsg, err = HTTPSecondFactor(authority, db, logger, clientID, code); err != nil {
+		return nil, httpStatus, httpMsg, err
+	}
+
+	if !enrolled {
+		msg := "two-factor authentication is not enabled"
+
+		return nil, http.StatusNotFound, msg, errors.New(msg)
+	}
+
+	if codes, hashes, err = auth.NewRecoveryCodes(); err != nil {
+		logger.Error("failed to generate recovery codes", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = db.MFASetRecoveryCodes(clientID, hashes); err != nil {
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return codes, 0, "", nil
+}
+
+// HTTPMFADisable will disable two-factor authentication for a client once their second factor has been verified.
+func HTTPMFADisable(authority auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	code string) (int, string, error) {
+	var (
+		err        error
+		enrolled   bool
+		httpStatus int
+		httpMsg    string
+	)
+
+	if enrolled, httpStatus, httpMsg, err = HTTPSecondFactor(authority, db, logger, clientID, code); err != nil {
+		return httpStatus, httpMsg, err
+	}
+
+	if !enrolled {
+		msg := "two-factor authentication is not enabled"
+
+		return http.StatusNotFound, msg, errors.New(msg)
+	}
+
+	if err = db.MFADelete(clientID); err != nil {
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// decryptTOTPSecret will decrypt the TOTP secret of a two-factor authentication enrolment.
+func decryptTOTPSecret(authority auth.Auth, logger *logger.Logger, enrolment *postgres.UserMFA) (string, error) {
+	secret, err := authority.DecryptFromString(enrolment.Secret)
+	if err != nil {
+		logger.Error("failed to decrypt TOTP secret", zap.String("clientID", enrolment.ClientID.String()), zap.Error(err))
+
+		return "", fmt.Errorf("%w", err)
+	}
+
+	return string(secret), nil
+}
@@ -0,0 +1,653 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// testMFAEnrolment will generate a TOTP secret, its encrypted form, and a valid one-time password for it.
+func testMFAEnrolment(t *testing.T) (string, string, string) {
+	t.Helper()
+
+	secret, err := auth.NewTOTPSecret()
+	require.NoError(t, err, "failed to generate TOTP secret.")
+
+	encrypted, err := testAuth.EncryptToString([]byte(secret))
+	require.NoError(t, err, "failed to encrypt TOTP secret.")
+
+	code, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now()))
+	require.NoError(t, err, "failed to generate one-time password.")
+
+	return secret, encrypted, code
+}
+
+func TestCommon_HTTPMFAEnroll(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		userInfoErr    error
+		userInfoTimes  int
+		enrollErr      error
+		enrollTimes    int
+		expectErrMsg   string
+		expectErrCode  int
+		expectErr      require.ErrorAssertionFunc
+		expectResponse require.ValueAssertionFunc
+	}{
+		{
+			name:           "user info failure",
+			userInfoErr:    errors.New("unknown error"),
+			userInfoTimes:  1,
+			enrollErr:      nil,
+			enrollTimes:    0,
+			expectErrMsg:   constants.RetryMessageString(),
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "already enrolled",
+			userInfoErr:    nil,
+			userInfoTimes:  1,
+			enrollErr:      postgres.ErrMFAEnrolled,
+			enrollTimes:    1,
+			expectErrMsg:   "already enabled",
+			expectErrCode:  http.StatusConflict,
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "unknown db failure",
+			userInfoErr:    nil,
+			userInfoTimes:  1,
+			enrollErr:      errors.New("unknown error"),
+			enrollTimes:    1,
+			expectErrMsg:   constants.RetryMessageString(),
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "enrolled",
+			userInfoErr:    nil,
+			userInfoTimes:  1,
+			enrollErr:      nil,
+			enrollTimes:    1,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+			expectResponse: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			var recorded string
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{
+						UserAccount: &modelsPostgres.UserAccount{
+							UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"}}},
+						test.userInfoErr).
+					Times(test.userInfoTimes),
+
+				mockDB.EXPECT().MFAEnroll(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ uuid.UUID, secret string) error {
+						recorded = secret
+
+						return test.enrollErr
+					}).
+					Times(test.enrollTimes),
+			)
+
+			response, actualErrCode, actualErrMsg, err := HTTPMFAEnroll(testAuth, mockDB, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Contains(t, response.URI, "username1", "account missing from URI.")
+				require.Contains(t, response.URI, response.Secret, "secret missing from URI.")
+				require.NotEqual(t, response.Secret, recorded, "secret stored in plaintext.")
+
+				decrypted, err := testAuth.DecryptFromString(recorded)
+				require.NoError(t, err, "failed to decrypt stored secret.")
+				require.Equal(t, response.Secret, string(decrypted), "stored secret mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPMFAConfirm(t *testing.T) {
+	t.Parallel()
+
+	_, encrypted, code := testMFAEnrolment(t)
+
+	testCases := []struct {
+		name          string
+		code          string
+		enrolment     postgres.UserMFA
+		getErr        error
+		getTimes      int
+		confirmErr    error
+		confirmTimes  int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectCodes   int
+	}{
+		{
+			name:          "validation",
+			code:          "12345",
+			enrolment:     postgres.UserMFA{Secret: encrypted},
+			getErr:        nil,
+			getTimes:      0,
+			confirmErr:    nil,
+			confirmTimes:  0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "not enrolled",
+			code:          code,
+			enrolment:     postgres.UserMFA{},
+			getErr:        postgres.ErrNotFound,
+			getTimes:      1,
+			confirmErr:    nil,
+			confirmTimes:  0,
+			expectErrMsg:  "not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "unknown db read failure",
+			code:          code,
+			enrolment:     postgres.UserMFA{},
+			getErr:        postgres.ErrMFA,
+			getTimes:      1,
+			confirmErr:    nil,
+			confirmTimes:  0,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "already enabled",
+			code:          code,
+			enrolment:     postgres.UserMFA{Secret: encrypted, EnrolledAt: pgtype.Timestamptz{Valid: true}},
+			getErr:        nil,
+			getTimes:      1,
+			confirmErr:    nil,
+			confirmTimes:  0,
+			expectErrMsg:  "already enabled",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "malformed secret",
+			code:          code,
+			enrolment:     postgres.UserMFA{Secret: "not-encrypted"},
+			getErr:        nil,
+			getTimes:      1,
+			confirmErr:    nil,
+			confirmTimes:  0,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "invalid code",
+			code:          "000000",
+			enrolment:     postgres.UserMFA{Secret: encrypted, LastStep: auth.TOTPStep(time.Now()) + 1},
+			getErr:        nil,
+			getTimes:      1,
+			confirmErr:    nil,
+			confirmTimes:  0,
+			expectErrMsg:  constants.MFAInvalidString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "confirmed concurrently",
+			code:          code,
+			enrolment:     postgres.UserMFA{Secret: encrypted},
+			getErr:        nil,
+			getTimes:      1,
+			confirmErr:    postgres.ErrNotFound,
+			confirmTimes:  1,
+			expectErrMsg:  constants.MFAInvalidString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "unknown db write failure",
+			code:          code,
+			enrolment:     postgres.UserMFA{Secret: encrypted},
+			getErr:        nil,
+			getTimes:      1,
+			confirmErr:    postgres.ErrMFA,
+			confirmTimes:  1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "confirmed",
+			code:          code,
+			enrolment:     postgres.UserMFA{Secret: encrypted},
+			getErr:        nil,
+			getTimes:      1,
+			confirmErr:    nil,
+			confirmTimes:  1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectCodes:   constants.RecoveryCodeCount(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			var recorded []string
+
+			gomock.InOrder(
+				mockDB.EXPECT().MFAGet(gomock.Any()).
+					Return(test.enrolment, test.getErr).
+					Times(test.getTimes),
+
+				mockDB.EXPECT().MFAConfirm(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ uuid.UUID, _ int64, hashes []string) error {
+						recorded = hashes
+
+						return test.confirmErr
+					}).
+					Times(test.confirmTimes),
+			)
+
+			codes, actualErrCode, actualErrMsg, err := HTTPMFAConfirm(testAuth, mockDB, zapLogger, uuid.UUID{},
+				&models.HTTPMFAConfirmRequest{Code: test.code})
+			test.expectErr(t, err, "error expectation failed.")
+			require.Len(t, codes, test.expectCodes, "recovery code count mismatched.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			for idx, code := range codes {
+				require.Equal(t, auth.HashRecoveryCode(code), recorded[idx], "recovery code hash mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPSecondFactor(t *testing.T) {
+	t.Parallel()
+
+	_, encrypted, code := testMFAEnrolment(t)
+	enabled := postgres.UserMFA{Secret: encrypted, EnrolledAt: pgtype.Timestamptz{Valid: true}}
+
+	testCases := []struct {
+		name           string
+		code           string
+		enrolment      postgres.UserMFA
+		getErr         error
+		getTimes       int
+		useStepErr     error
+		useStepTimes   int
+		useCodeErr     error
+		useCodeTimes   int
+		expectEnrolled bool
+		expectErrMsg   string
+		expectErrCode  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "not enrolled",
+			code:           "",
+			enrolment:      postgres.UserMFA{},
+			getErr:         postgres.ErrNotFound,
+			getTimes:       1,
+			expectEnrolled: false,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "unknown db read failure",
+			code:           code,
+			enrolment:      postgres.UserMFA{},
+			getErr:         postgres.ErrMFA,
+			getTimes:       1,
+			expectEnrolled: false,
+			expectErrMsg:   constants.RetryMessageString(),
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name:           "pending enrolment",
+			code:           "",
+			enrolment:      postgres.UserMFA{Secret: encrypted},
+			getErr:         nil,
+			getTimes:       1,
+			expectEnrolled: false,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "code required",
+			code:           "",
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			expectEnrolled: true,
+			expectErrMsg:   constants.MFARequiredString(),
+			expectErrCode:  http.StatusForbidden,
+			expectErr:      require.Error,
+		}, {
+			name:           "invalid one-time password",
+			code:           "abcdef",
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			expectEnrolled: true,
+			expectErrMsg:   constants.MFAInvalidString(),
+			expectErrCode:  http.StatusForbidden,
+			expectErr:      require.Error,
+		}, {
+			name:           "replayed one-time password",
+			code:           code,
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			useStepErr:     postgres.ErrNotFound,
+			useStepTimes:   1,
+			expectEnrolled: true,
+			expectErrMsg:   constants.MFAInvalidString(),
+			expectErrCode:  http.StatusForbidden,
+			expectErr:      require.Error,
+		}, {
+			name:           "unknown db write failure",
+			code:           code,
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			useStepErr:     postgres.ErrMFA,
+			useStepTimes:   1,
+			expectEnrolled: true,
+			expectErrMsg:   constants.RetryMessageString(),
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid one-time password",
+			code:           code,
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			useStepErr:     nil,
+			useStepTimes:   1,
+			expectEnrolled: true,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "used recovery code",
+			code:           "abcde-fghij",
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			useCodeErr:     postgres.ErrNotFound,
+			useCodeTimes:   1,
+			expectEnrolled: true,
+			expectErrMsg:   constants.MFAInvalidString(),
+			expectErrCode:  http.StatusForbidden,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid recovery code",
+			code:           "abcde-fghij",
+			enrolment:      enabled,
+			getErr:         nil,
+			getTimes:       1,
+			useCodeErr:     nil,
+			useCodeTimes:   1,
+			expectEnrolled: true,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().MFAGet(gomock.Any()).
+					Return(test.enrolment, test.getErr).
+					Times(test.getTimes),
+
+				mockDB.EXPECT().MFAUseStep(gomock.Any(), gomock.Any()).
+					Return(test.useStepErr).
+					Times(test.useStepTimes),
+
+				mockDB.EXPECT().MFAUseRecoveryCode(gomock.Any(), auth.HashRecoveryCode(test.code)).
+					Return(test.useCodeErr).
+					Times(test.useCodeTimes),
+			)
+
+			enrolled, actualErrCode, actualErrMsg, err := HTTPSecondFactor(testAuth, mockDB, zapLogger, uuid.UUID{},
+				test.code)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectEnrolled, enrolled, "enrolment status mismatched.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPMFARecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		getErr        error
+		setErr        error
+		setTimes      int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectCodes   int
+	}{
+		{
+			name:          "not enrolled",
+			getErr:        postgres.ErrNotFound,
+			setErr:        nil,
+			setTimes:      0,
+			expectErrMsg:  "not enabled",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "unknown db failure",
+			getErr:        nil,
+			setErr:        postgres.ErrMFA,
+			setTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectCodes:   0,
+		}, {
+			name:          "replaced",
+			getErr:        nil,
+			setErr:        nil,
+			setTimes:      1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectCodes:   constants.RecoveryCodeCount(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.getErr).
+					Times(1),
+
+				mockDB.EXPECT().MFAUseRecoveryCode(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.setTimes),
+
+				mockDB.EXPECT().MFASetRecoveryCodes(gomock.Any(), gomock.Any()).
+					Return(test.setErr).
+					Times(test.setTimes),
+			)
+
+			codes, actualErrCode, actualErrMsg, err := HTTPMFARecoveryCodes(testAuth, mockDB, zapLogger, uuid.UUID{},
+				"abcde-fghij")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Len(t, codes, test.expectCodes, "recovery code count mismatched.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			for _, code := range codes {
+				require.Len(t, strings.Split(code, "-"), 2, "recovery code malformed.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPMFADisable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		code          string
+		getErr        error
+		useCodeTimes  int
+		deleteErr     error
+		deleteTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "not enrolled",
+			code:          "abcde-fghij",
+			getErr:        postgres.ErrNotFound,
+			useCodeTimes:  0,
+			deleteErr:     nil,
+			deleteTimes:   0,
+			expectErrMsg:  "not enabled",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "code required",
+			code:          "",
+			getErr:        nil,
+			useCodeTimes:  0,
+			deleteErr:     nil,
+			deleteTimes:   0,
+			expectErrMsg:  constants.MFARequiredString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			code:          "abcde-fghij",
+			getErr:        nil,
+			useCodeTimes:  1,
+			deleteErr:     postgres.ErrMFA,
+			deleteTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "disabled",
+			code:          "abcde-fghij",
+			getErr:        nil,
+			useCodeTimes:  1,
+			deleteErr:     nil,
+			deleteTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.getErr).
+					Times(1),
+
+				mockDB.EXPECT().MFAUseRecoveryCode(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.useCodeTimes),
+
+				mockDB.EXPECT().MFADelete(gomock.Any()).
+					Return(test.deleteErr).
+					Times(test.deleteTimes),
+			)
+
+			actualErrCode, actualErrMsg, err := HTTPMFADisable(testAuth, mockDB, zapLogger, uuid.UUID{}, test.code)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPLimitOrderPlace will validate and place a Cryptocurrency limit order. The funds required to fill the order at the
+// limit price are held for the lifetime of the order.
+func HTTPLimitOrderPlace(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPLimitOrderRequest) (*postgres.CryptoLimitOrder, int, string, any, error) {
+	var (
+		err            error
+		asset          postgres.CryptoAsset
+		parsedCurrency []postgres.Currency
+		expiresAt      time.Time
+		holdAmount     = request.Amount
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Orders must expire in the future and cannot remain open indefinitely.
+	expiresAt = time.Unix(request.ExpiresAt, 0)
+	if now := time.Now(); !expiresAt.After(now) || expiresAt.After(now.Add(constants.LimitOrderMaxTTL())) {
+		msg := fmt.Sprintf("order must expire within %s", constants.LimitOrderMaxTTL())
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	// Retrieve the Cryptocurrency registry entry and check that it is enabled for trading.
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if asset, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, request.Ticker, true); err != nil {
+			return nil, httpStatus, httpMsg, request.Ticker, err
+		}
+	}
+
+	// Validate the Cryptocurrency amount against the registered order limits.
+	if err = HTTPCryptoOrderCheck(&asset, request.Amount); err != nil {
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), err
+	}
+
+	// Validate the Fiat currency and limit price.
+	if parsedCurrency, err = HTTPValidateOfferRequest(
+		request.LimitPrice, constants.DecimalPlacesFiat(), request.FiatCurrency); err != nil {
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Purchases hold the Fiat funds required at the limit price, rounded up to the nearest cent.
+	if *request.IsPurchase {
+		holdAmount = request.Amount.Mul(request.LimitPrice).RoundCeil(constants.DecimalPlacesFiat())
+	}
+
+	order := &postgres.CryptoLimitOrder{
+		OrderID:      xid.New().String(),
+		ClientID:     clientID,
+		FiatCurrency: parsedCurrency[0],
+		Ticker:       request.Ticker,
+		IsPurchase:   *request.IsPurchase,
+		Amount:       request.Amount,
+		LimitPrice:   request.LimitPrice,
+		Status:       postgres.LimitOrderStatusOPEN,
+		ExpiresAt:    pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}
+
+	if err = db.LimitOrderCreate(order, holdAmount); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack limit order placement error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, orderErr.Code, orderErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return order, 0, "", nil, nil
+}
+
+// HTTPLimitOrderCancel will cancel an open Cryptocurrency limit order and release the funds held for it.
+func HTTPLimitOrderCancel(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, orderID string) (
+	int, string, error) {
+	if len(orderID) < 1 || len(orderID) > 32 {
+		msg := "invalid order id"
+
+		return http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if err := db.LimitOrderClose(
+		clientID, orderID, postgres.LimitOrderStatusCANCELLED, "order cancelled by client"); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack limit order cancellation error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return orderErr.Code, orderErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPLimitOrderDetails will retrieve a Cryptocurrency limit order along with its state history.
+func HTTPLimitOrderDetails(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, orderID string) (
+	*models.HTTPLimitOrderDetails, int, string, error) {
+	var (
+		err     error
+		details models.HTTPLimitOrderDetails
+	)
+
+	if len(orderID) < 1 || len(orderID) > 32 {
+		msg := "invalid order id"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if details.Order, details.History, err = db.LimitOrderGet(clientID, orderID); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack limit order details error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, orderErr.Code, orderErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return &details, 0, "", nil
+}
+
+// HTTPLimitOrdersPaginated will retrieve a page of a client's Cryptocurrency limit orders, newest first, and prepare a
+// link to the next page of data. The orders can be restricted to those with a specific status.
+func HTTPLimitOrdersPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	status, pageCursor, pageSizeStr string, isREST bool) (*models.HTTPLimitOrdersPaginated, int, string, error) {
+	var (
+		err       error
+		decrypted []byte
+		pageSize  int32
+		nextPage  string
+		startID   string
+		orders    models.HTTPLimitOrdersPaginated
+	)
+
+	// Validate the status filter.
+	if len(status) > 0 && !postgres.LimitOrderStatus(status).Valid() {
+		msg := "invalid order status"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if len(pageCursor) > 0 {
+		if decrypted, err = auth.DecryptFromString(pageCursor); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		startID = string(decrypted)
+	}
+
+	if pageSize, err = adminPageSize(pageSizeStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if orders.Orders, err = db.LimitOrdersPaginated(clientID, startID, status, pageSize+1); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack limit orders error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, orderErr.Code, orderErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(orders.Orders) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString([]byte(orders.Orders[pageSize].OrderID)); err != nil {
+			logger.Error("failed to encrypt limit order id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		orders.Orders = orders.Orders[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			orders.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+			if len(status) > 0 {
+				orders.Links.NextPage += "&status=" + url.QueryEscape(status)
+			}
+		} else {
+			orders.Links.PageCursor = nextPage
+		}
+	}
+
+	return &orders, 0, "", nil
+}
@@ -0,0 +1,212 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// invalidResetTokenString is returned for password reset tokens that are unknown, expired, or already used.
+const invalidResetTokenString = "invalid or expired password reset token"
+
+// HTTPChangePassword will replace the password of a user account after checking the current password and, if enabled,
+// the second factor. All the sessions and JWTs of the user are revoked once the password has been changed.
+func HTTPChangePassword(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	clientID uuid.UUID, request *models.HTTPChangePasswordRequest, device, ipAddress, otp string) (int, string, error) {
+	var (
+		err         error
+		userAccount modelsPostgres.User
+		httpStatus  int
+		httpMsg     string
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), fmt.Errorf("%w", err)
+	}
+
+	if userAccount, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during a password change request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = auth.CheckPassword(userAccount.Password, request.CurrentPassword); err != nil {
+		auditClientEvent(db, logger, postgres.AuditEventTypePASSWORDCHANGE, clientID, device, ipAddress, err,
+			map[string]string{"reason": "invalid password"})
+
+		return http.StatusForbidden, "invalid user credentials", fmt.Errorf("%w", err)
+	}
+
+	if _, httpStatus, httpMsg, err = HTTPSecondFactor(auth, db, logger, clientID, otp); err != nil {
+		auditClientEvent(db, logger, postgres.AuditEventTypePASSWORDCHANGE, clientID, device, ipAddress, err,
+			map[string]string{"reason": "second factor"})
+
+		return httpStatus, httpMsg, err
+	}
+
+	httpStatus, httpMsg, err = setPassword(auth, cache, db, logger, clientID, request.NewPassword)
+	auditClientEvent(db, logger, postgres.AuditEventTypePASSWORDCHANGE, clientID, device, ipAddress, err, nil)
+
+	return httpStatus, httpMsg, err
+}
+
+// HTTPPasswordResetRequest will mail a single-use password reset token to the email address on a user account. The
+// outcome is not disclosed for usernames without an active user account to stop the discovery of accounts.
+func HTTPPasswordResetRequest(auth auth.Auth, cache redis.Redis, db postgres.Postgres, notify notifier.Notifier,
+	logger *logger.Logger, request *models.HTTPPasswordResetRequest) (int, string, error) {
+	var (
+		err         error
+		clientID    uuid.UUID
+		userAccount modelsPostgres.User
+		token       string
+		tokenHash   string
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), fmt.Errorf("%w", err)
+	}
+
+	if clientID, _, err = db.UserCredentials(request.Username); err != nil {
+		logger.Info("password reset requested for an unknown user account", zap.String("username", request.Username))
+
+		return 0, "", nil
+	}
+
+	if userAccount, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during a password reset request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if token, tokenHash, err = auth.GenerateRefreshToken(); err != nil {
+		logger.Error("failed to generate password reset token", zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	key := fmt.Sprintf(constants.PasswordResetFormatString(), tokenHash)
+
+	if err = cache.Set(key, clientID.String(), constants.PasswordResetTTL()); err != nil {
+		logger.Warn("failed to store password reset token", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = notify.Send(&notifier.Message{
+		To:      userAccount.Email,
+		Subject: "FTeX password reset",
+		Body: fmt.Sprintf("A password reset was requested for the FTeX user account %s.\n\n"+
+			"Use the token below to reset your password within %.0f minutes. If you did not request a password reset "+
+			"you can ignore this message.\n\n%s",
+			userAccount.Username, constants.PasswordResetTTL().Minutes(), token),
+	}); err != nil {
+		logger.Warn("failed to send password reset token", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		if delErr := cache.Del(key); delErr != nil {
+			logger.Warn("failed to discard unsent password reset token", zap.Error(delErr))
+		}
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPPasswordReset will replace the password of a user account using a password reset token. Users that have enabled
+// two-factor authentication must also supply a one-time password or recovery code. The token is consumed once the
+// second factor has been verified and all the sessions and JWTs of the user are revoked.
+func HTTPPasswordReset(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	request *models.HTTPPasswordResetConfirmRequest, device, ipAddress, otp string) (int, string, error) {
+	var (
+		err        error
+		clientID   uuid.UUID
+		clientStr  string
+		httpStatus int
+		httpMsg    string
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return http.StatusBadRequest, constants.ValidationString(), fmt.Errorf("%w", err)
+	}
+
+	key := fmt.Sprintf(constants.PasswordResetFormatString(), auth.HashRefreshToken(request.Token))
+
+	if err = cache.Get(key, &clientStr); err != nil {
+		if isCacheMiss(err) {
+			return http.StatusForbidden, invalidResetTokenString, errors.New(invalidResetTokenString)
+		}
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if clientID, err = uuid.FromString(clientStr); err != nil {
+		logger.Error("invalid client id stored for password reset token", zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if _, httpStatus, httpMsg, err = HTTPSecondFactor(auth, db, logger, clientID, otp); err != nil {
+		auditClientEvent(db, logger, postgres.AuditEventTypePASSWORDRESET, clientID, device, ipAddress, err,
+			map[string]string{"reason": "second factor"})
+
+		return httpStatus, httpMsg, err
+	}
+
+	// Consume the token. Only one of any concurrent requests with the same token will succeed.
+	if err = cache.GetDel(key, &clientStr); err != nil {
+		if isCacheMiss(err) {
+			return http.StatusForbidden, invalidResetTokenString, errors.New(invalidResetTokenString)
+		}
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	httpStatus, httpMsg, err = setPassword(auth, cache, db, logger, clientID, request.Password)
+	auditClientEvent(db, logger, postgres.AuditEventTypePASSWORDRESET, clientID, device, ipAddress, err, nil)
+
+	return httpStatus, httpMsg, err
+}
+
+// setPassword will hash and store a new password for a user account and then revoke all the sessions and JWTs of the
+// user.
+func setPassword(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	password string) (int, string, error) {
+	var (
+		err    error
+		hashed string
+	)
+
+	if hashed, err = auth.HashPassword(password); err != nil {
+		logger.Error("failure hashing password", zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = db.UserUpdatePassword(clientID, hashed); err != nil {
+		logger.Warn("failed to update user password", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if err = revokeClientCredentials(auth, cache, db, logger, clientID); err != nil {
+		return http.StatusInternalServerError,
+			"password changed but active sessions could not be revoked, please log out of all sessions",
+			fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
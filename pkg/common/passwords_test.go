@@ -0,0 +1,531 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_HTTPChangePassword(t *testing.T) {
+	t.Parallel()
+
+	hashedPassword, err := testAuth.HashPassword("current-password")
+	require.NoError(t, err, "failed to hash current password.")
+
+	testCases := []struct {
+		name            string
+		request         *models.HTTPChangePasswordRequest
+		userInfoErr     error
+		userInfoTimes   int
+		mfaErr          error
+		mfaTimes        int
+		updateErr       error
+		updateTimes     int
+		revokeAllErr    error
+		revokeAllTimes  int
+		setErr          error
+		setTimes        int
+		expectErrMsg    string
+		expectErrCode   int
+		expectErr       require.ErrorAssertionFunc
+		expectNewHashed bool
+	}{
+		{
+			name:          "validation",
+			request:       &models.HTTPChangePasswordRequest{CurrentPassword: "current-password"},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "unchanged password",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "current-password"},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name: "user info failure",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "new-password"},
+			userInfoErr:   errors.New("unknown error"),
+			userInfoTimes: 1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name: "incorrect current password",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "wrong-password", NewPassword: "new-password"},
+			userInfoTimes: 1,
+			expectErrMsg:  "invalid user credentials",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name: "second factor required",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "new-password"},
+			userInfoTimes: 1,
+			mfaErr:        nil,
+			mfaTimes:      1,
+			expectErrMsg:  constants.MFARequiredString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name: "update failure",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "new-password"},
+			userInfoTimes: 1,
+			mfaErr:        postgres.ErrNotFound,
+			mfaTimes:      1,
+			updateErr:     postgres.ErrNotFoundUser,
+			updateTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name: "session revocation failure",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "new-password"},
+			userInfoTimes:  1,
+			mfaErr:         postgres.ErrNotFound,
+			mfaTimes:       1,
+			updateTimes:    1,
+			revokeAllErr:   postgres.ErrRefreshToken,
+			revokeAllTimes: 1,
+			expectErrMsg:   "log out of all sessions",
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name: "JWT revocation failure",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "new-password"},
+			userInfoTimes:  1,
+			mfaErr:         postgres.ErrNotFound,
+			mfaTimes:       1,
+			updateTimes:    1,
+			revokeAllTimes: 1,
+			setErr:         redis.ErrCacheSet,
+			setTimes:       1,
+			expectErrMsg:   "log out of all sessions",
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name: "valid",
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "new-password"},
+			userInfoTimes:   1,
+			mfaErr:          postgres.ErrNotFound,
+			mfaTimes:        1,
+			updateTimes:     1,
+			revokeAllTimes:  1,
+			setTimes:        1,
+			expectErrMsg:    "",
+			expectErrCode:   0,
+			expectErr:       require.NoError,
+			expectNewHashed: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			var (
+				stored        string
+				revokedBefore int64
+			)
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(modelsPostgres.User{
+						UserAccount: &modelsPostgres.UserAccount{
+							UserLoginCredentials: modelsPostgres.UserLoginCredentials{Password: hashedPassword}}},
+						test.userInfoErr).
+					Times(test.userInfoTimes),
+
+				mockDB.EXPECT().MFAGet(clientID).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.mfaErr).
+					Times(test.mfaTimes),
+
+				mockDB.EXPECT().UserUpdatePassword(clientID, gomock.Any()).
+					DoAndReturn(func(_ uuid.UUID, hashed string) error {
+						stored = hashed
+
+						return test.updateErr
+					}).
+					Times(test.updateTimes),
+
+				mockDB.EXPECT().RefreshSessionsRevokeAll(clientID).
+					Return(test.revokeAllErr).
+					Times(test.revokeAllTimes),
+
+				mockCache.EXPECT().Set("jwt-revoked-before:"+clientID.String(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ string, value any, ttl time.Duration) error {
+						var ok bool
+						revokedBefore, ok = value.(int64)
+						require.True(t, ok, "revocation time is not a Unix timestamp.")
+						require.InDelta(t, time.Now().UnixMilli(), revokedBefore, 1000, "revocation time mismatched.")
+						require.Greater(t, ttl, time.Duration(testAuth.ExpirationDuration())*time.Second,
+							"revocation TTL too short.")
+
+						return test.setErr
+					}).
+					Times(test.setTimes),
+			)
+
+			actualErrCode, actualErrMsg, err := HTTPChangePassword(
+				testAuth, mockCache, mockDB, zapLogger, clientID, test.request, "device", "127.0.0.1", "")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if test.expectNewHashed {
+				require.NoError(t, testAuth.CheckPassword(stored, test.request.NewPassword),
+					"stored password does not match new password.")
+
+				// JWTs issued after the password change, even within the same second, are not revoked.
+				time.Sleep(time.Millisecond)
+
+				token, err := testAuth.GenerateJWT(clientID, constants.RoleUser())
+				require.NoError(t, err, "failed to issue JWT after password change.")
+
+				_, issuedAt, err := testAuth.TokenSession(token.Token)
+				require.NoError(t, err, "failed to extract session from JWT.")
+				require.Greater(t, issuedAt, revokedBefore, "JWT issued after the password change is revoked.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPPasswordResetRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPPasswordResetRequest
+		credsErr      error
+		credsTimes    int
+		userInfoErr   error
+		userInfoTimes int
+		setErr        error
+		setTimes      int
+		sendErr       error
+		sendTimes     int
+		delTimes      int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "validation",
+			request:       &models.HTTPPasswordResetRequest{Username: "short"},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown user",
+			request:       &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsErr:      postgres.ErrLoginUser,
+			credsTimes:    1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "user info failure",
+			request:       &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsTimes:    1,
+			userInfoErr:   errors.New("unknown error"),
+			userInfoTimes: 1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "cache failure",
+			request:       &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsTimes:    1,
+			userInfoTimes: 1,
+			setErr:        redis.ErrCacheSet,
+			setTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "notifier failure",
+			request:       &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsTimes:    1,
+			userInfoTimes: 1,
+			setTimes:      1,
+			sendErr:       errors.New("unknown error"),
+			sendTimes:     1,
+			delTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			request:       &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsTimes:    1,
+			userInfoTimes: 1,
+			setTimes:      1,
+			sendTimes:     1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			var storedKey string
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserCredentials(test.request.Username).
+					Return(clientID, "hashed password", test.credsErr).
+					Times(test.credsTimes),
+
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(modelsPostgres.User{
+						UserAccount: &modelsPostgres.UserAccount{
+							UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"},
+							Email:                "user@ftex.com"}},
+						test.userInfoErr).
+					Times(test.userInfoTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), clientID.String(), constants.PasswordResetTTL()).
+					DoAndReturn(func(key string, _ any, _ time.Duration) error {
+						storedKey = key
+
+						return test.setErr
+					}).
+					Times(test.setTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					DoAndReturn(func(message *notifier.Message) error {
+						require.Equal(t, "user@ftex.com", message.To, "recipient mismatched.")
+
+						token := message.Body[strings.LastIndex(message.Body, "\n")+1:]
+						require.Equal(t, "password-reset:"+testAuth.HashRefreshToken(token), storedKey,
+							"mailed token does not match stored token hash.")
+
+						return test.sendErr
+					}).
+					Times(test.sendTimes),
+
+				mockCache.EXPECT().Del(gomock.Any()).
+					DoAndReturn(func(keys ...string) error {
+						require.Equal(t, []string{storedKey}, keys, "unsent token was not discarded.")
+
+						return nil
+					}).
+					Times(test.delTimes),
+			)
+
+			actualErrCode, actualErrMsg, err := HTTPPasswordResetRequest(
+				testAuth, mockCache, mockDB, mockNotifier, zapLogger, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	validRequest := &models.HTTPPasswordResetConfirmRequest{Token: "reset-token", Password: "new-password"}
+	tokenKey := "password-reset:" + testAuth.HashRefreshToken(validRequest.Token)
+
+	testCases := []struct {
+		name           string
+		request        *models.HTTPPasswordResetConfirmRequest
+		storedClientID string
+		getErr         error
+		getTimes       int
+		mfaErr         error
+		mfaTimes       int
+		getDelErr      error
+		getDelTimes    int
+		updateErr      error
+		updateTimes    int
+		revokeAllTimes int
+		setTimes       int
+		expectErrMsg   string
+		expectErrCode  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:          "validation",
+			request:       &models.HTTPPasswordResetConfirmRequest{Token: "reset-token", Password: "short"},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown token",
+			request:       validRequest,
+			getErr:        redis.ErrCacheMiss,
+			getTimes:      1,
+			expectErrMsg:  "invalid or expired",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "cache failure",
+			request:       validRequest,
+			getErr:        redis.ErrCacheUnknown,
+			getTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:           "invalid stored client id",
+			request:        validRequest,
+			storedClientID: "not-a-client-id",
+			getTimes:       1,
+			expectErrMsg:   constants.RetryMessageString(),
+			expectErrCode:  http.StatusInternalServerError,
+			expectErr:      require.Error,
+		}, {
+			name:          "second factor required",
+			request:       validRequest,
+			getTimes:      1,
+			mfaErr:        nil,
+			mfaTimes:      1,
+			expectErrMsg:  constants.MFARequiredString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "token already used",
+			request:       validRequest,
+			getTimes:      1,
+			mfaErr:        postgres.ErrNotFound,
+			mfaTimes:      1,
+			getDelErr:     redis.ErrCacheMiss,
+			getDelTimes:   1,
+			expectErrMsg:  "invalid or expired",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "update failure",
+			request:       validRequest,
+			getTimes:      1,
+			mfaErr:        postgres.ErrNotFound,
+			mfaTimes:      1,
+			getDelTimes:   1,
+			updateErr:     postgres.ErrNotFoundUser,
+			updateTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:           "valid",
+			request:        validRequest,
+			getTimes:       1,
+			mfaErr:         postgres.ErrNotFound,
+			mfaTimes:       1,
+			getDelTimes:    1,
+			updateTimes:    1,
+			revokeAllTimes: 1,
+			setTimes:       1,
+			expectErrMsg:   "",
+			expectErrCode:  0,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			storedClientID := clientID.String()
+			if test.storedClientID != "" {
+				storedClientID = test.storedClientID
+			}
+
+			gomock.InOrder(
+				mockCache.EXPECT().Get(tokenKey, gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						stored, ok := value.(*string)
+						require.True(t, ok, "client id destination is not a string.")
+						*stored = storedClientID
+
+						return test.getErr
+					}).
+					Times(test.getTimes),
+
+				mockDB.EXPECT().MFAGet(clientID).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.mfaErr).
+					Times(test.mfaTimes),
+
+				mockCache.EXPECT().GetDel(tokenKey, gomock.Any()).
+					Return(test.getDelErr).
+					Times(test.getDelTimes),
+
+				mockDB.EXPECT().UserUpdatePassword(clientID, gomock.Any()).
+					Return(test.updateErr).
+					Times(test.updateTimes),
+
+				mockDB.EXPECT().RefreshSessionsRevokeAll(clientID).
+					Return(nil).
+					Times(test.revokeAllTimes),
+
+				mockCache.EXPECT().Set("jwt-revoked-before:"+clientID.String(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.setTimes),
+			)
+
+			actualErrCode, actualErrMsg, err := HTTPPasswordReset(
+				testAuth, mockCache, mockDB, zapLogger, test.request, "device", "127.0.0.1", "")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
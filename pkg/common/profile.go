@@ -0,0 +1,159 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// invalidVerificationLinkString is returned for email verification links that are malformed or expired, or that were
+// sent to an email address that has since been changed.
+const invalidVerificationLinkString = "invalid or expired email verification link"
+
+// HTTPUserProfile will retrieve the account information of a user with the hashed password removed.
+func HTTPUserProfile(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	*modelsPostgres.User, int, string, error) {
+	userAccount, err := db.UserGetInfo(clientID)
+	if err != nil {
+		logger.Warn("failed to read user record for a profile request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	userAccount.Password = ""
+
+	return &userAccount, 0, "", nil
+}
+
+// HTTPUpdateProfile will update the names and email address of a user account. Users that have enabled two-factor
+// authentication must also provide a one-time password or recovery code to change their email address. A changed email
+// address is unverified and is sent a verification link.
+func HTTPUpdateProfile(auth auth.Auth, db postgres.Postgres, notify notifier.Notifier, logger *logger.Logger,
+	clientID uuid.UUID, request *models.HTTPUpdateProfileRequest, otp string) (
+	*modelsPostgres.User, int, string, error) {
+	var (
+		err          error
+		userAccount  modelsPostgres.User
+		emailChanged bool
+		httpStatus   int
+		httpMsg      string
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), fmt.Errorf("%w", err)
+	}
+
+	if userAccount, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during a profile update request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if emailChanged = request.Email != "" && !strings.EqualFold(request.Email, userAccount.Email); emailChanged {
+		if _, httpStatus, httpMsg, err = HTTPSecondFactor(auth, db, logger, clientID, otp); err != nil {
+			return nil, httpStatus, httpMsg, err
+		}
+	}
+
+	if err = db.UserUpdateProfile(clientID, request.FirstName, request.LastName, request.Email); err != nil {
+		if errors.Is(err, postgres.ErrEmailRegistered) {
+			return nil, http.StatusConflict, err.Error(), fmt.Errorf("%w", err)
+		}
+
+		logger.Warn("failed to update user profile", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	// The profile has been updated. A verification link can be requested again if it could not be sent.
+	if emailChanged {
+		if err = sendEmailVerification(auth, notify, clientID, userAccount.Username, request.Email); err != nil {
+			logger.Warn("failed to send email verification link after an email address change",
+				zap.String("clientID", clientID.String()), zap.Error(err))
+		}
+	}
+
+	return HTTPUserProfile(db, logger, clientID)
+}
+
+// HTTPSendEmailVerification will send a new verification link to the unverified email address on a user account.
+func HTTPSendEmailVerification(auth auth.Auth, db postgres.Postgres, notify notifier.Notifier, logger *logger.Logger,
+	clientID uuid.UUID) (int, string, error) {
+	userAccount, err := db.UserGetInfo(clientID)
+	if err != nil {
+		logger.Warn("failed to read user record during an email verification request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	if userAccount.EmailVerified {
+		return http.StatusConflict, "email address is already verified", errors.New("email address is already verified")
+	}
+
+	if err = sendEmailVerification(auth, notify, clientID, userAccount.Username, userAccount.Email); err != nil {
+		logger.Warn("failed to send email verification link", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPVerifyEmail will mark the email address in a signed verification link as verified. Links sent to an email address
+// that has since been changed are rejected.
+func HTTPVerifyEmail(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, token string) (int, string, error) {
+	clientID, email, err := auth.ValidateEmailVerificationToken(token)
+	if err != nil {
+		return http.StatusForbidden, invalidVerificationLinkString, fmt.Errorf("%w", err)
+	}
+
+	if err = db.UserVerifyEmail(clientID, email); err != nil {
+		if errors.Is(err, postgres.ErrNotFoundUser) {
+			return http.StatusForbidden, invalidVerificationLinkString, fmt.Errorf("%w", err)
+		}
+
+		logger.Warn("failed to verify email address", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// sendEmailVerification will mail a signed verification link to an email address of a user account.
+func sendEmailVerification(auth auth.Auth, notify notifier.Notifier, clientID uuid.UUID, username, email string) error {
+	token, err := auth.GenerateEmailVerificationToken(clientID, email)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err = notify.Send(&notifier.Message{
+		To:      email,
+		Subject: "FTeX email address verification",
+		Body: fmt.Sprintf("Please verify the email address of the FTeX user account %s by following the link below "+
+			"within %.0f hours. If you did not register or change the email address of an FTeX user account you can "+
+			"ignore this message.\n\n%s",
+			username, constants.EmailVerificationTTL().Hours(),
+			notify.Link(constants.EmailVerificationPath(), url.Values{"token": {token}})),
+	}); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
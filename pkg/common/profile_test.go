@@ -0,0 +1,431 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// testProfileUser generates the account information of a test user with an unverified email address.
+func testProfileUser(clientID uuid.UUID) modelsPostgres.User {
+	return modelsPostgres.User{
+		UserAccount: &modelsPostgres.UserAccount{
+			UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1", Password: "hashed password"},
+			FirstName:            "first name",
+			LastName:             "last name",
+			Email:                "user@ftex.com",
+		},
+		ClientID: clientID,
+		Role:     constants.RoleUser(),
+	}
+}
+
+func TestCommon_HTTPUserProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		userInfoErr   error
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectProfile require.ValueAssertionFunc
+	}{
+		{
+			name:          "user info failure",
+			userInfoErr:   postgres.ErrNotFoundUser,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectProfile: require.Nil,
+		}, {
+			name:          "valid",
+			userInfoErr:   nil,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectProfile: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			mockDB.EXPECT().UserGetInfo(clientID).
+				Return(testProfileUser(clientID), test.userInfoErr).
+				Times(1)
+
+			profile, actualErrCode, actualErrMsg, err := HTTPUserProfile(mockDB, zapLogger, clientID)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectProfile(t, profile, "profile expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if profile != nil {
+				require.Empty(t, profile.Password, "hashed password returned in profile.")
+				require.Equal(t, "username1", profile.Username, "username mismatched.")
+				require.Equal(t, clientID, profile.ClientID, "client id mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPUpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPUpdateProfileRequest
+		userInfoErr   error
+		userInfoTimes int
+		profileTimes  int
+		mfaErr        error
+		mfaTimes      int
+		updateErr     error
+		updateTimes   int
+		sendErr       error
+		sendTimes     int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "empty request",
+			request:       &models.HTTPUpdateProfileRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid email address",
+			request:       &models.HTTPUpdateProfileRequest{Email: "not an email address"},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "user info failure",
+			request:       &models.HTTPUpdateProfileRequest{FirstName: "new first name"},
+			userInfoErr:   postgres.ErrNotFoundUser,
+			userInfoTimes: 1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "names only",
+			request:       &models.HTTPUpdateProfileRequest{FirstName: "new first name", LastName: "new last name"},
+			userInfoTimes: 1,
+			profileTimes:  1,
+			updateTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "unchanged email address",
+			request:       &models.HTTPUpdateProfileRequest{Email: "USER@ftex.com"},
+			userInfoTimes: 1,
+			profileTimes:  1,
+			updateTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "email address change second factor required",
+			request:       &models.HTTPUpdateProfileRequest{Email: "new-user@ftex.com"},
+			userInfoTimes: 1,
+			mfaErr:        nil,
+			mfaTimes:      1,
+			expectErrMsg:  constants.MFARequiredString(),
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "email address registered",
+			request:       &models.HTTPUpdateProfileRequest{Email: "new-user@ftex.com"},
+			userInfoTimes: 1,
+			mfaErr:        postgres.ErrNotFound,
+			mfaTimes:      1,
+			updateErr:     postgres.ErrEmailRegistered,
+			updateTimes:   1,
+			expectErrMsg:  "already registered",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name:          "update failure",
+			request:       &models.HTTPUpdateProfileRequest{LastName: "new last name"},
+			userInfoTimes: 1,
+			updateErr:     postgres.ErrNotFoundUser,
+			updateTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "email address change notifier failure",
+			request:       &models.HTTPUpdateProfileRequest{Email: "new-user@ftex.com"},
+			userInfoTimes: 1,
+			profileTimes:  1,
+			mfaErr:        postgres.ErrNotFound,
+			mfaTimes:      1,
+			updateTimes:   1,
+			sendErr:       errors.New("unknown error"),
+			sendTimes:     1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		}, {
+			name:          "email address change",
+			request:       &models.HTTPUpdateProfileRequest{FirstName: "new first name", Email: "new-user@ftex.com"},
+			userInfoTimes: 1,
+			profileTimes:  1,
+			mfaErr:        postgres.ErrNotFound,
+			mfaTimes:      1,
+			updateTimes:   1,
+			sendTimes:     1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(testProfileUser(clientID), test.userInfoErr).
+					Times(test.userInfoTimes),
+
+				mockDB.EXPECT().MFAGet(clientID).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.mfaErr).
+					Times(test.mfaTimes),
+
+				mockDB.EXPECT().UserUpdateProfile(clientID, test.request.FirstName, test.request.LastName,
+					test.request.Email).
+					Return(test.updateErr).
+					Times(test.updateTimes),
+
+				mockNotifier.EXPECT().Link(constants.EmailVerificationPath(), gomock.Any()).
+					DoAndReturn(func(_ string, query url.Values) string {
+						clientIDFromToken, email, err := testAuth.ValidateEmailVerificationToken(query.Get("token"))
+						require.NoError(t, err, "invalid email verification token.")
+						require.Equal(t, clientID, clientIDFromToken, "client id mismatched.")
+						require.Equal(t, test.request.Email, email, "email address mismatched.")
+
+						return "verification-link"
+					}).
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					DoAndReturn(func(message *notifier.Message) error {
+						require.Equal(t, test.request.Email, message.To, "recipient mismatched.")
+						require.Contains(t, message.Body, "verification-link", "verification link missing.")
+
+						return test.sendErr
+					}).
+					Times(test.sendTimes),
+
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(testProfileUser(clientID), nil).
+					Times(test.profileTimes),
+			)
+
+			profile, actualErrCode, actualErrMsg, err := HTTPUpdateProfile(
+				testAuth, mockDB, mockNotifier, zapLogger, clientID, test.request, "")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.NotNil(t, profile, "profile not returned.")
+				require.Empty(t, profile.Password, "hashed password returned in profile.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPSendEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		userInfoErr   error
+		emailVerified bool
+		sendErr       error
+		sendTimes     int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "user info failure",
+			userInfoErr:   postgres.ErrNotFoundUser,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "already verified",
+			emailVerified: true,
+			expectErrMsg:  "already verified",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name:          "notifier failure",
+			sendErr:       errors.New("unknown error"),
+			sendTimes:     1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			sendTimes:     1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			userAccount := testProfileUser(clientID)
+			userAccount.EmailVerified = test.emailVerified
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(userAccount, test.userInfoErr).
+					Times(1),
+
+				mockNotifier.EXPECT().Link(constants.EmailVerificationPath(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					DoAndReturn(func(message *notifier.Message) error {
+						require.Equal(t, userAccount.Email, message.To, "recipient mismatched.")
+
+						return test.sendErr
+					}).
+					Times(test.sendTimes),
+			)
+
+			actualErrCode, actualErrMsg, err := HTTPSendEmailVerification(
+				testAuth, mockDB, mockNotifier, zapLogger, clientID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	token, err := testAuth.GenerateEmailVerificationToken(clientID, "User@ftex.com")
+	require.NoError(t, err, "failed to generate email verification token.")
+
+	testCases := []struct {
+		name          string
+		token         string
+		verifyErr     error
+		verifyTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid token",
+			token:         "invalid-token",
+			expectErrMsg:  "invalid or expired",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "email address changed",
+			token:         token,
+			verifyErr:     postgres.ErrNotFoundUser,
+			verifyTimes:   1,
+			expectErrMsg:  "invalid or expired",
+			expectErrCode: http.StatusForbidden,
+			expectErr:     require.Error,
+		}, {
+			name:          "database failure",
+			token:         token,
+			verifyErr:     errors.New("unknown error"),
+			verifyTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			token:         token,
+			verifyTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().UserVerifyEmail(clientID, "user@ftex.com").
+				Return(test.verifyErr).
+				Times(test.verifyTimes)
+
+			actualErrCode, actualErrMsg, err := HTTPVerifyEmail(testAuth, mockDB, zapLogger, test.token)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
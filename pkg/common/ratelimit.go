@@ -0,0 +1,97 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// RateLimitPolicy is the number of requests a requester may make over a sliding window. Requests are only counted
+// against other requests limited by a policy with the same name.
+type RateLimitPolicy struct {
+	Name   string
+	Limit  int64
+	Window time.Duration
+}
+
+// RateLimitStatus is the outcome of admitting a request under a rate limit policy.
+type RateLimitStatus struct {
+	Policy    RateLimitPolicy
+	Admitted  bool
+	Remaining int64
+	Reset     time.Duration
+}
+
+// Headers will return the response headers that report the rate limit status to the requester. The seconds until the
+// oldest request leaves the window are reported as the time to retry a rejected request.
+func (s *RateLimitStatus) Headers() map[string]string {
+	reset := strconv.FormatInt(int64(math.Ceil(s.Reset.Seconds())), 10)
+	headers := map[string]string{
+		constants.RateLimitLimitHeader():     strconv.FormatInt(s.Policy.Limit, 10),
+		constants.RateLimitRemainingHeader(): strconv.FormatInt(s.Remaining, 10),
+		constants.RateLimitResetHeader():     reset,
+		constants.RateLimitPolicyHeader(): fmt.Sprintf("%d;w=%d", s.Policy.Limit,
+			int64(math.Ceil(s.Policy.Window.Seconds()))),
+	}
+
+	if !s.Admitted {
+		headers[constants.RetryAfterHeader()] = reset
+	}
+
+	return headers
+}
+
+// RateLimitSubject will return the requester a request is attributed to for rate limiting before it is authenticated.
+// Requests are attributed to the client of a valid JWT, or otherwise the IP address they were made from. Requests
+// signed with an API key are attributed to their IP address, so that unverified key IDs cannot be used to evade its
+// limit, and are also attributed to the key once it has been verified.
+func RateLimitSubject(auth auth.Auth, tokenString, ipAddress string) string {
+	if len(tokenString) > 0 {
+		if clientID, _, err := auth.ValidateJWT(tokenString); err == nil {
+			return "client:" + clientID.String()
+		}
+	}
+
+	return "ip:" + ipAddress
+}
+
+// RateLimitAPIKeySubject will return the requester a request signed with a verified API key is attributed to.
+func RateLimitAPIKeySubject(keyID string) string {
+	return "api-key:" + keyID
+}
+
+// HTTPRateLimit will admit a request from a requester under a rate limit policy. Requests are admitted if the cache is
+// unavailable so that an outage of the cache does not take the service down with it.
+func HTTPRateLimit(cache redis.Redis, logger *logger.Logger, policy RateLimitPolicy, subject string) (
+	*RateLimitStatus, int, string, any, error) {
+	var (
+		err    error
+		status = RateLimitStatus{Policy: policy}
+	)
+
+	if status.Admitted, status.Remaining, status.Reset, err = cache.SlidingWindow(
+		fmt.Sprintf(constants.RateLimitFormatString(), policy.Name, subject), policy.Limit, policy.Window); err != nil {
+		logger.Error("failed to apply rate limit", zap.String("policy", policy.Name), zap.String("subject", subject),
+			zap.Error(err))
+
+		return nil, 0, "", nil, nil
+	}
+
+	if !status.Admitted {
+		return &status, http.StatusTooManyRequests, constants.RateLimitedString(),
+			&models.HTTPRetryAfter{RetryAfter: int64(math.Ceil(status.Reset.Seconds()))},
+			errors.New(constants.RateLimitedString())
+	}
+
+	return &status, 0, "", nil, nil
+}
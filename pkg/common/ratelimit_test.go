@@ -0,0 +1,183 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_RateLimitStatus_Headers(t *testing.T) {
+	t.Parallel()
+
+	policy := RateLimitPolicy{Name: "rest", Limit: 10, Window: time.Minute}
+
+	testCases := []struct {
+		name     string
+		status   RateLimitStatus
+		expected map[string]string
+	}{
+		{
+			name:   "admitted",
+			status: RateLimitStatus{Policy: policy, Admitted: true, Remaining: 7, Reset: 42100 * time.Millisecond},
+			expected: map[string]string{
+				constants.RateLimitLimitHeader():     "10",
+				constants.RateLimitRemainingHeader(): "7",
+				constants.RateLimitResetHeader():     "43",
+				constants.RateLimitPolicyHeader():    "10;w=60",
+			},
+		}, {
+			name:   "rejected",
+			status: RateLimitStatus{Policy: policy, Admitted: false, Remaining: 0, Reset: 5 * time.Second},
+			expected: map[string]string{
+				constants.RateLimitLimitHeader():     "10",
+				constants.RateLimitRemainingHeader(): "0",
+				constants.RateLimitResetHeader():     "5",
+				constants.RateLimitPolicyHeader():    "10;w=60",
+				constants.RetryAfterHeader():         "5",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, test.status.Headers(), "rate limit headers mismatch")
+		})
+	}
+}
+
+func TestCommon_RateLimitSubject(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name          string
+		tokenString   string
+		validateErr   error
+		validateTimes int
+		expected      string
+	}{
+		{
+			name:          "valid jwt",
+			tokenString:   "valid-jwt",
+			validateErr:   nil,
+			validateTimes: 1,
+			expected:      "client:" + clientID.String(),
+		}, {
+			name:          "invalid jwt",
+			tokenString:   "invalid-jwt",
+			validateErr:   errors.New("invalid jwt"),
+			validateTimes: 1,
+			expected:      "ip:127.0.0.1",
+		}, {
+			name:          "anonymous",
+			validateTimes: 0,
+			expected:      "ip:127.0.0.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().ValidateJWT(test.tokenString).
+				Return(clientID, int64(0), test.validateErr).
+				Times(test.validateTimes)
+
+			require.Equal(t, test.expected, RateLimitSubject(mockAuth, test.tokenString, "127.0.0.1"),
+				"rate limit subject mismatch")
+		})
+	}
+}
+
+func TestCommon_RateLimitAPIKeySubject(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "api-key:api-key-id", RateLimitAPIKeySubject("api-key-id"), "rate limit subject mismatch")
+}
+
+func TestCommon_HTTPRateLimit(t *testing.T) {
+	t.Parallel()
+
+	policy := RateLimitPolicy{Name: "rest", Limit: 10, Window: time.Minute}
+
+	testCases := []struct {
+		name           string
+		admitted       bool
+		slidingErr     error
+		expectedStatus int
+		expectStatus   require.ValueAssertionFunc
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "admitted",
+			admitted:       true,
+			slidingErr:     nil,
+			expectedStatus: 0,
+			expectStatus:   require.NotNil,
+			expectErr:      require.NoError,
+		}, {
+			name:           "rejected",
+			admitted:       false,
+			slidingErr:     nil,
+			expectedStatus: http.StatusTooManyRequests,
+			expectStatus:   require.NotNil,
+			expectErr:      require.Error,
+		}, {
+			name:           "cache failure",
+			admitted:       false,
+			slidingErr:     redis.ErrCacheSet,
+			expectedStatus: 0,
+			expectStatus:   require.Nil,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			mockRedis.EXPECT().SlidingWindow("rate-limit:rest:ip:127.0.0.1", policy.Limit, policy.Window).
+				Return(test.admitted, int64(0), 1500*time.Millisecond, test.slidingErr).
+				Times(1)
+
+			status, httpStatus, httpMsg, payload, err := HTTPRateLimit(mockRedis, zapLogger, policy, "ip:127.0.0.1")
+			test.expectErr(t, err, "error expectation failed")
+			test.expectStatus(t, status, "status expectation failed")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatch")
+
+			if err != nil {
+				require.Equal(t, constants.RateLimitedString(), httpMsg, "http message mismatch")
+				require.Equal(t, &models.HTTPRetryAfter{RetryAfter: 2}, payload, "retry after payload mismatch")
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPRecurringPurchaseCreate will validate and schedule recurring purchases of a Cryptocurrency. No funds are held for
+// the plan and each purchase is paid for from the available balance of the Fiat account when it runs.
+func HTTPRecurringPurchaseCreate(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPRecurringPurchaseRequest) (*postgres.CryptoRecurringPurchase, int, string, any, error) {
+	var (
+		err            error
+		parsedCurrency []postgres.Currency
+		now            = time.Now()
+		startsAt       = now
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Check that the Cryptocurrency is enabled for trading.
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if _, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, request.Ticker, true); err != nil {
+			return nil, httpStatus, httpMsg, request.Ticker, err
+		}
+	}
+
+	// Validate the Fiat currency and the amount spent on each purchase.
+	if parsedCurrency, err = HTTPValidateOfferRequest(
+		request.Amount, constants.DecimalPlacesFiat(), request.FiatCurrency); err != nil {
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Validate the schedule. Plans without a start time make their first purchase immediately.
+	if request.StartsAt > 0 {
+		if request.StartsAt < now.Unix() {
+			msg := "start time cannot be in the past"
+
+			return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+		}
+
+		startsAt = time.Unix(request.StartsAt, 0)
+	}
+
+	plan := &postgres.CryptoRecurringPurchase{
+		PlanID:       xid.New().String(),
+		ClientID:     clientID,
+		FiatCurrency: parsedCurrency[0],
+		Ticker:       request.Ticker,
+		Amount:       request.Amount,
+		Cadence:      postgres.RecurringPurchaseCadence(request.Cadence),
+		StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+		NextRunAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+		Status:       postgres.RecurringPurchaseStatusACTIVE,
+	}
+
+	if request.EndsAt > 0 {
+		if plan.EndsAt.Time = time.Unix(request.EndsAt, 0); !plan.EndsAt.Time.After(startsAt) {
+			msg := "end time must be after the start time"
+
+			return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+		}
+
+		plan.EndsAt.Valid = true
+	}
+
+	if err = db.RecurringPurchaseCreate(plan); err != nil {
+		var planErr *postgres.Error
+		if !errors.As(err, &planErr) {
+			logger.Info("failed to unpack recurring purchase scheduling error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, planErr.Code, planErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return plan, 0, "", nil, nil
+}
+
+// HTTPRecurringPurchaseCancel will cancel an active recurring Cryptocurrency purchase plan.
+func HTTPRecurringPurchaseCancel(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, planID string) (
+	int, string, error) {
+	if len(planID) < 1 || len(planID) > 32 {
+		msg := "invalid plan id"
+
+		return http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if err := db.RecurringPurchaseCancel(clientID, planID); err != nil {
+		var planErr *postgres.Error
+		if !errors.As(err, &planErr) {
+			logger.Info("failed to unpack recurring purchase cancellation error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return planErr.Code, planErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPRecurringPurchaseDetails will retrieve a recurring Cryptocurrency purchase plan along with a page of its runs,
+// newest first, and prepare a link to the next page of runs.
+func HTTPRecurringPurchaseDetails(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	planID, pageCursor, pageSizeStr string, isREST bool) (*models.HTTPRecurringPurchaseDetails, int, string, error) {
+	var (
+		err       error
+		decrypted []byte
+		pageSize  int32
+		nextPage  string
+		startID   int64
+		details   models.HTTPRecurringPurchaseDetails
+	)
+
+	if len(planID) < 1 || len(planID) > 32 {
+		msg := "invalid plan id"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if len(pageCursor) > 0 {
+		if decrypted, err = auth.DecryptFromString(pageCursor); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		if startID, err = strconv.ParseInt(string(decrypted), 10, 64); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+	}
+
+	if pageSize, err = adminPageSize(pageSizeStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if details.Plan, err = db.RecurringPurchaseGet(clientID, planID); err != nil {
+		var planErr *postgres.Error
+		if !errors.As(err, &planErr) {
+			logger.Info("failed to unpack recurring purchase details error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, planErr.Code, planErr.Message, fmt.Errorf("%w", err)
+	}
+
+	if details.Runs, err = db.RecurringPurchaseRunsPaginated(clientID, planID, startID, pageSize+1); err != nil {
+		var runsErr *postgres.Error
+		if !errors.As(err, &runsErr) {
+			logger.Info("failed to unpack recurring purchase runs error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, runsErr.Code, runsErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(details.Runs) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString(
+			[]byte(strconv.FormatInt(details.Runs[pageSize].ID, 10))); err != nil {
+			logger.Error("failed to encrypt recurring purchase run id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		details.Runs = details.Runs[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			details.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+		} else {
+			details.Links.PageCursor = nextPage
+		}
+	}
+
+	return &details, 0, "", nil
+}
+
+// HTTPRecurringPurchasesPaginated will retrieve a page of a client's recurring Cryptocurrency purchase plans, newest
+// first, and prepare a link to the next page of data. The plans can be restricted to those with a specific status.
+func HTTPRecurringPurchasesPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	status, pageCursor, pageSizeStr string, isREST bool) (*models.HTTPRecurringPurchasesPaginated, int, string, error) {
+	var (
+		err       error
+		decrypted []byte
+		pageSize  int32
+		nextPage  string
+		startID   string
+		plans     models.HTTPRecurringPurchasesPaginated
+	)
+
+	// Validate the status filter.
+	if len(status) > 0 && !postgres.RecurringPurchaseStatus(status).Valid() {
+		msg := "invalid plan status"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if len(pageCursor) > 0 {
+		if decrypted, err = auth.DecryptFromString(pageCursor); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		startID = string(decrypted)
+	}
+
+	if pageSize, err = adminPageSize(pageSizeStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if plans.Plans, err = db.RecurringPurchasesPaginated(clientID, startID, status, pageSize+1); err != nil {
+		var planErr *postgres.Error
+		if !errors.As(err, &planErr) {
+			logger.Info("failed to unpack recurring purchases error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, planErr.Code, planErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(plans.Plans) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString([]byte(plans.Plans[pageSize].PlanID)); err != nil {
+			logger.Error("failed to encrypt recurring purchase id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		plans.Plans = plans.Plans[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			plans.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+			if len(status) > 0 {
+				plans.Links.NextPage += "&status=" + url.QueryEscape(status)
+			}
+		} else {
+			plans.Links.PageCursor = nextPage
+		}
+	}
+
+	return &plans, 0, "", nil
+}
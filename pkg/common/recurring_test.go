@@ -0,0 +1,726 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPRecurringPurchaseCreate(t *testing.T) {
+	haltedAsset := testCryptoAsset
+	haltedAsset.Status = postgres.CryptoAssetStatusHALTED
+
+	startsAt := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPRecurringPurchaseRequest
+		asset         postgres.CryptoAsset
+		assetTimes    int
+		createErr     error
+		createTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectPayload require.ValueAssertionFunc
+	}{
+		{
+			name: "validation",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "HOURLY"},
+			asset:         testCryptoAsset,
+			assetTimes:    0,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "trading halted",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY"},
+			asset:         haltedAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  "halted",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "invalid amount",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50.001), Cadence: "WEEKLY"},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "start in the past",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY",
+				StartsAt: time.Now().Add(-time.Hour).Unix()},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "end before start",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY",
+				StartsAt: startsAt, EndsAt: startsAt},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "account not found",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY"},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     postgres.ErrNotFound,
+			createTimes:   1,
+			expectErrMsg:  "records not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+			expectPayload: require.Nil,
+		}, {
+			name: "unknown db failure",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY"},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     errors.New("unknown error"),
+			createTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectPayload: require.Nil,
+		}, {
+			name: "immediate start",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "DAILY"},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectPayload: require.Nil,
+		}, {
+			name: "scheduled start and end",
+			request: &models.HTTPRecurringPurchaseRequest{
+				FiatCurrency: "USD", Ticker: "BTC", Amount: decimal.NewFromFloat(50), Cadence: "MONTHLY",
+				StartsAt: startsAt, EndsAt: startsAt + 365*24*60*60},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(test.asset, nil).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().RecurringPurchaseCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			plan, actualErrCode, actualErrMsg, payload, err := HTTPRecurringPurchaseCreate(mockDB, zapLogger,
+				uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.NotEmpty(t, plan.PlanID, "plan id not set.")
+				require.Equal(t, postgres.RecurringPurchaseStatusACTIVE, plan.Status, "plan status mismatched.")
+				require.True(t, plan.NextRunAt.Time.Equal(plan.StartsAt.Time), "first run not at start time.")
+				require.Equal(t, test.request.EndsAt > 0, plan.EndsAt.Valid, "plan end mismatched.")
+
+				if test.request.StartsAt > 0 {
+					require.Equal(t, test.request.StartsAt, plan.StartsAt.Time.Unix(), "plan start mismatched.")
+				}
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPRecurringPurchaseCancel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		planID        string
+		cancelErr     error
+		cancelTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid plan id",
+			planID:        "",
+			cancelErr:     nil,
+			cancelTimes:   0,
+			expectErrMsg:  "invalid plan id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			planID:        "plan-id",
+			cancelErr:     errors.New("unknown error"),
+			cancelTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "plan closed",
+			planID:        "plan-id",
+			cancelErr:     postgres.ErrRecurringClosed,
+			cancelTimes:   1,
+			expectErrMsg:  "no longer active",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name:          "cancelled",
+			planID:        "plan-id",
+			cancelErr:     nil,
+			cancelTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().RecurringPurchaseCancel(gomock.Any(), test.planID).
+				Return(test.cancelErr).
+				Times(test.cancelTimes)
+
+			actualErrCode, actualErrMsg, err := HTTPRecurringPurchaseCancel(mockDB, zapLogger, uuid.UUID{},
+				test.planID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPRecurringPurchaseDetails(t *testing.T) {
+	testCases := []struct {
+		name             string
+		planID           string
+		pageCursor       string
+		pageSize         string
+		isREST           bool
+		cursor           string
+		expectedStartID  int64
+		runs             []postgres.CryptoRecurringPurchaseRun
+		decryptErr       error
+		decryptTimes     int
+		getErr           error
+		getTimes         int
+		runsErr          error
+		runsTimes        int
+		encryptErr       error
+		encryptTimes     int
+		expectedNextPage string
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "invalid plan id",
+			planID:           "a-plan-id-that-is-far-too-long-to-be-valid",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           nil,
+			getTimes:         0,
+			runsErr:          nil,
+			runsTimes:        0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid plan id",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page cursor",
+			planID:           "plan-id",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "twelve",
+			expectedStartID:  0,
+			runs:             nil,
+			decryptErr:       nil,
+			decryptTimes:     1,
+			getErr:           nil,
+			getTimes:         0,
+			runsErr:          nil,
+			runsTimes:        0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page size",
+			planID:           "plan-id",
+			pageCursor:       "",
+			pageSize:         "three",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           nil,
+			getTimes:         0,
+			runsErr:          nil,
+			runsTimes:        0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "not found",
+			planID:           "plan-id",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           postgres.ErrNotFound,
+			getTimes:         1,
+			runsErr:          nil,
+			runsTimes:        0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "records not found",
+			expectErrCode:    http.StatusNotFound,
+			expectErr:        require.Error,
+		}, {
+			name:             "unknown runs failure",
+			planID:           "plan-id",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           nil,
+			getTimes:         1,
+			runsErr:          errors.New("unknown error"),
+			runsTimes:        1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "encrypt failure",
+			planID:           "plan-id",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             []postgres.CryptoRecurringPurchaseRun{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           nil,
+			getTimes:         1,
+			runsErr:          nil,
+			runsTimes:        1,
+			encryptErr:       errors.New("encrypt failure"),
+			encryptTimes:     1,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "last page",
+			planID:           "plan-id",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  12,
+			runs:             []postgres.CryptoRecurringPurchaseRun{{ID: 12}, {ID: 11}},
+			decryptErr:       nil,
+			decryptTimes:     1,
+			getErr:           nil,
+			getTimes:         1,
+			runsErr:          nil,
+			runsTimes:        1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page REST",
+			planID:           "plan-id",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             []postgres.CryptoRecurringPurchaseRun{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           nil,
+			getTimes:         1,
+			runsErr:          nil,
+			runsTimes:        1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "?pageCursor=encrypted-cursor&pageSize=3",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page GraphQL",
+			planID:           "plan-id",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           false,
+			cursor:           "12",
+			expectedStartID:  0,
+			runs:             []postgres.CryptoRecurringPurchaseRun{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			getErr:           nil,
+			getTimes:         1,
+			runsErr:          nil,
+			runsTimes:        1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "encrypted-cursor",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(test.pageCursor).
+					Return([]byte(test.cursor), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockDB.EXPECT().RecurringPurchaseGet(gomock.Any(), test.planID).
+					Return(postgres.CryptoRecurringPurchase{PlanID: test.planID}, test.getErr).
+					Times(test.getTimes),
+
+				mockDB.EXPECT().RecurringPurchaseRunsPaginated(gomock.Any(), test.planID, test.expectedStartID,
+					int32(4)).
+					Return(test.runs, test.runsErr).
+					Times(test.runsTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-cursor", test.encryptErr).
+					Times(test.encryptTimes),
+			)
+
+			details, actualErrCode, actualErrMsg, err := HTTPRecurringPurchaseDetails(mockAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.planID, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, test.planID, details.Plan.PlanID, "plan mismatched.")
+				require.LessOrEqual(t, len(details.Runs), 3, "page size exceeded.")
+
+				if test.isREST {
+					require.Equal(t, test.expectedNextPage, details.Links.NextPage, "next page link mismatched.")
+				} else {
+					require.Equal(t, test.expectedNextPage, details.Links.PageCursor, "page cursor mismatched.")
+				}
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPRecurringPurchasesPaginated(t *testing.T) {
+	testCases := []struct {
+		name             string
+		status           string
+		pageCursor       string
+		pageSize         string
+		isREST           bool
+		expectedStartID  string
+		plans            []postgres.CryptoRecurringPurchase
+		decryptErr       error
+		decryptTimes     int
+		plansErr         error
+		plansTimes       int
+		encryptErr       error
+		encryptTimes     int
+		expectedNextPage string
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "invalid status",
+			status:           "PAUSED",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			plans:            nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			plansErr:         nil,
+			plansTimes:       0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid plan status",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page cursor",
+			status:           "",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			plans:            nil,
+			decryptErr:       errors.New("decrypt failure"),
+			decryptTimes:     1,
+			plansErr:         nil,
+			plansTimes:       0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page size",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "three",
+			isREST:           true,
+			expectedStartID:  "",
+			plans:            nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			plansErr:         nil,
+			plansTimes:       0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "unknown db failure",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			plans:            nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			plansErr:         errors.New("unknown error"),
+			plansTimes:       1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "encrypt failure",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			plans:            []postgres.CryptoRecurringPurchase{{PlanID: "4"}, {PlanID: "3"}, {PlanID: "2"}, {PlanID: "1"}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			plansErr:         nil,
+			plansTimes:       1,
+			encryptErr:       errors.New("encrypt failure"),
+			encryptTimes:     1,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "last page",
+			status:           "ACTIVE",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "12",
+			plans:            []postgres.CryptoRecurringPurchase{{PlanID: "12"}, {PlanID: "11"}},
+			decryptErr:       nil,
+			decryptTimes:     1,
+			plansErr:         nil,
+			plansTimes:       1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page REST",
+			status:           "COMPLETED",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			plans:            []postgres.CryptoRecurringPurchase{{PlanID: "4"}, {PlanID: "3"}, {PlanID: "2"}, {PlanID: "1"}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			plansErr:         nil,
+			plansTimes:       1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "?pageCursor=encrypted-cursor&pageSize=3&status=COMPLETED",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page GraphQL",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           false,
+			expectedStartID:  "",
+			plans:            []postgres.CryptoRecurringPurchase{{PlanID: "4"}, {PlanID: "3"}, {PlanID: "2"}, {PlanID: "1"}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			plansErr:         nil,
+			plansTimes:       1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "encrypted-cursor",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(test.pageCursor).
+					Return([]byte("12"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockDB.EXPECT().RecurringPurchasesPaginated(gomock.Any(), test.expectedStartID, test.status, int32(4)).
+					Return(test.plans, test.plansErr).
+					Times(test.plansTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-cursor", test.encryptErr).
+					Times(test.encryptTimes),
+			)
+
+			plans, actualErrCode, actualErrMsg, err := HTTPRecurringPurchasesPaginated(mockAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.status, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.LessOrEqual(t, len(plans.Plans), 3, "page size exceeded.")
+
+				if test.isREST {
+					require.Equal(t, test.expectedNextPage, plans.Links.NextPage, "next page link mismatched.")
+				} else {
+					require.Equal(t, test.expectedNextPage, plans.Links.PageCursor, "page cursor mismatched.")
+				}
+			}
+		})
+	}
+}
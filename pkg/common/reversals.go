@@ -0,0 +1,57 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPAdminTransactionReverse will post a compensating reversal of a transaction made in error. Equal and opposite
+// journal entries are posted and the account balances are adjusted. Reversals that would overdraw an account, of
+// transactions that have already been reversed, and of reversals are refused.
+func HTTPAdminTransactionReverse(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID, txIDStr string,
+	request *models.HTTPAdminReversalRequest) (*postgres.TransactionReversal, int, string, any, error) {
+	var (
+		err      error
+		txID     uuid.UUID
+		reversal *postgres.TransactionReversal
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if txID, err = uuid.FromString(txIDStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid transaction ID", txIDStr, fmt.Errorf("%w", err)
+	}
+
+	done, httpStatus, httpMsg, err := HTTPAdminAudit(db, logger, adminID, postgres.AdminActionTRANSACTIONREVERSE,
+		txID.String(), map[string]any{"reason": request.Reason})
+	if err != nil {
+		return nil, httpStatus, httpMsg, nil, err
+	}
+
+	reversal, err = db.TransactionReverse(txID, adminID, request.Reason)
+	done(err)
+
+	if err != nil {
+		var reverseErr *postgres.Error
+		if !errors.As(err, &reverseErr) {
+			logger.Info("failed to unpack transaction reversal error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, reverseErr.Code, reverseErr.Message, txIDStr, fmt.Errorf("%w", err)
+	}
+
+	return reversal, 0, "", nil, nil
+}
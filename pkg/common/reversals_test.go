@@ -0,0 +1,159 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPAdminTransactionReverse(t *testing.T) {
+	t.Parallel()
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	reversal := &postgres.TransactionReversal{
+		JournalReversal: postgres.JournalReversal{TxID: txID, ReversalTxID: uuid.Must(uuid.NewV4())},
+		FiatEntries:     []postgres.FiatJournal{{}, {}},
+	}
+
+	testCases := []struct {
+		name          string
+		txID          string
+		request       *models.HTTPAdminReversalRequest
+		auditErr      error
+		auditTimes    int
+		reverseErr    error
+		reverseTimes  int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "validation",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "reason too long",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{Reason: strings.Repeat("x", 257)},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid transaction id",
+			txID:          "invalid-transaction-id",
+			request:       &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			expectErrMsg:  "invalid transaction ID",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "audit failure",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			auditErr:      postgres.ErrAuditLog,
+			auditTimes:    1,
+			expectErrMsg:  "could not record",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db error",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			auditTimes:    1,
+			reverseErr:    errors.New("unknown db error"),
+			reverseTimes:  1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			auditTimes:    1,
+			reverseErr:    postgres.ErrNotFound,
+			reverseTimes:  1,
+			expectErrMsg:  "not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "already reversed",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			auditTimes:    1,
+			reverseErr:    postgres.ErrReversed,
+			reverseTimes:  1,
+			expectErrMsg:  "already been reversed",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name:          "insufficient funds",
+			txID:          txID.String(),
+			request:       &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			auditTimes:    1,
+			reverseErr:    postgres.ErrInsufficientFunds,
+			reverseTimes:  1,
+			expectErrMsg:  postgres.ErrInsufficientFunds.Error(),
+			expectErrCode: http.StatusPaymentRequired,
+			expectErr:     require.Error,
+		}, {
+			name:         "valid",
+			txID:         txID.String(),
+			request:      &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			auditTimes:   1,
+			reverseTimes: 1,
+			expectErr:    require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionTRANSACTIONREVERSE, test.txID,
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().TransactionReverse(txID, gomock.Any(), test.request.Reason).
+					Return(reversal, test.reverseErr).
+					Times(test.reverseTimes),
+			)
+
+			actual, actualErrCode, actualErrMsg, _, err := HTTPAdminTransactionReverse(mockDB, zapLogger,
+				uuid.UUID{}, test.txID, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err != nil {
+				require.Nil(t, actual, "reversal returned on failure.")
+
+				return
+			}
+
+			require.Equal(t, reversal, actual, "reversal mismatch.")
+		})
+	}
+}
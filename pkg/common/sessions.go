@@ -0,0 +1,317 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPJWTRevoked will check whether a valid JWT has been revoked, either by logging out of its session or by logging
+// out of all the sessions of the client it was issued to. JWTs issued without a token ID are only checked against the
+// latter.
+func HTTPJWTRevoked(auth auth.Auth, cache redis.Redis, logger *logger.Logger, clientID uuid.UUID, token string) (
+	int, string, error) {
+	var (
+		err           error
+		tokenID       string
+		issuedAt      int64
+		revoked       bool
+		revokedBefore int64
+	)
+
+	if tokenID, issuedAt, err = auth.TokenSession(token); err != nil {
+		return http.StatusForbidden, "request contains invalid or expired authorization token", fmt.Errorf("%w", err)
+	}
+
+	// Check whether the session has been logged out of. JWTs issued without a token ID cannot be on the denylist.
+	if tokenID != "" {
+		if err = cache.Get(fmt.Sprintf(constants.JWTDenylistFormatString(), tokenID), &revoked); err == nil {
+			msg := "request contains invalid or expired authorization token"
+
+			return http.StatusForbidden, msg, errors.New(msg)
+		} else if !isCacheMiss(err) {
+			logger.Warn("failed to check JWT denylist", zap.String("clientID", clientID.String()), zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+	}
+
+	// Check whether the client has logged out of all sessions since the token was issued, to the millisecond.
+	if err = cache.Get(fmt.Sprintf(constants.JWTRevokedBeforeFormatString(), clientID), &revokedBefore); err == nil {
+		if issuedAt <= revokedBefore {
+			msg := "request contains invalid or expired authorization token"
+
+			return http.StatusForbidden, msg, errors.New(msg)
+		}
+	} else if !isCacheMiss(err) {
+		logger.Warn("failed to check JWT session revocations", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPLogout will revoke a valid JWT by placing its ID on the denylist until it expires. JWTs issued without a token ID
+// are revoked, along with any of the client's JWTs issued before them, by revoking the client's JWTs issued up to and
+// including the issuance time of the JWT.
+func HTTPLogout(auth auth.Auth, cache redis.Redis, logger *logger.Logger, clientID uuid.UUID, token string,
+	expiresAt int64) (int, string, error) {
+	tokenID, issuedAt, err := auth.TokenSession(token)
+	if err != nil {
+		return http.StatusForbidden, "request contains invalid or expired authorization token", fmt.Errorf("%w", err)
+	}
+
+	// Tokens expiring during this request are revoked briefly to cover clock skew.
+	ttl := time.Until(time.Unix(expiresAt, 0))
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+
+	// The JWT has passed the revocation checks, so it was issued after any earlier revocation of the client's JWTs and
+	// the revocation time only moves forward.
+	if tokenID == "" {
+		if err = cache.Set(
+			fmt.Sprintf(constants.JWTRevokedBeforeFormatString(), clientID), issuedAt, ttl); err != nil {
+			logger.Warn("failed to revoke client JWTs", zap.String("clientID", clientID.String()), zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return 0, "", nil
+	}
+
+	if err = cache.Set(fmt.Sprintf(constants.JWTDenylistFormatString(), tokenID), true, ttl); err != nil {
+		logger.Warn("failed to place JWT on denylist", zap.String("tokenID", tokenID), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPLogoutAll will revoke all the sessions of a client as well as all the JWTs issued to it up to and including the
+// current millisecond. The revocation of the JWTs is remembered for the validity interval of a JWT, after which all the
+// revoked tokens will have expired.
+func HTTPLogoutAll(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	token string, expiresAt int64) (int, string, error) {
+	_, issuedAt, err := auth.TokenSession(token)
+	if err != nil {
+		return http.StatusForbidden, "request contains invalid or expired authorization token", fmt.Errorf("%w", err)
+	}
+
+	if err = db.RefreshSessionsRevokeAll(clientID); err != nil {
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	ttl := time.Unix(expiresAt, 0).Sub(time.UnixMilli(issuedAt)) + time.Second
+
+	if err = cache.Set(
+		fmt.Sprintf(constants.JWTRevokedBeforeFormatString(), clientID), time.Now().UnixMilli(), ttl); err != nil {
+		logger.Warn("failed to revoke client JWTs", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// revokeClientCredentials will revoke all the sessions of a client as well as all the JWTs issued to it up to and
+// including the current millisecond. The revocation of the JWTs is remembered for the validity interval of a JWT.
+func revokeClientCredentials(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	clientID uuid.UUID) error {
+	if err := db.RefreshSessionsRevokeAll(clientID); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	ttl := time.Duration(auth.ExpirationDuration()+1) * time.Second
+
+	if err := cache.Set(
+		fmt.Sprintf(constants.JWTRevokedBeforeFormatString(), clientID), time.Now().UnixMilli(), ttl); err != nil {
+		logger.Warn("failed to revoke client JWTs", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// HTTPRefreshSession will exchange a refresh token for a new JWT and refresh token in the same session. The refresh
+// token that is exchanged cannot be used again, and any attempt to do so will revoke the session.
+func HTTPRefreshSession(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
+	request *models.HTTPRefreshSessionRequest, device, ipAddress string) (*models.JWTAuthResponse, string, int, error) {
+	var (
+		err          error
+		authToken    *models.JWTAuthResponse
+		refreshToken string
+		status       modelsPostgres.UserStatus
+		token        = newRefreshToken(device, ipAddress)
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err)
+	}
+
+	if refreshToken, token.TokenHash, err = auth.GenerateRefreshToken(); err != nil {
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if err = db.RefreshTokenRotate(auth.HashRefreshToken(request.RefreshToken), token); err != nil {
+		var rotateErr *postgres.Error
+		if !errors.As(err, &rotateErr) {
+			logger.Info("failed to unpack refresh token rotation error", zap.Error(err))
+
+			return nil, constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+		}
+
+		if errors.Is(err, postgres.ErrNotFound) {
+			return nil, postgres.ErrRefreshTokenInvalid.Error(), http.StatusForbidden, fmt.Errorf("%w", err)
+		}
+
+		return nil, rotateErr.Message, rotateErr.Code, fmt.Errorf("%w", err)
+	}
+
+	if status, err = db.UserGetStatus(token.ClientID); err != nil {
+		logger.Warn("failed to read user status during session refresh",
+			zap.String("clientID", token.ClientID.String()), zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if status.IsDeleted {
+		auditClientEvent(db, logger, postgres.AuditEventTypeTOKENREFRESH, token.ClientID, device, ipAddress,
+			postgres.ErrRefreshTokenInvalid, map[string]string{"method": "refresh token", "reason": "deleted account"})
+
+		return nil, postgres.ErrRefreshTokenInvalid.Error(), http.StatusForbidden, postgres.ErrRefreshTokenInvalid
+	}
+
+	if status.IsFrozen {
+		auditClientEvent(db, logger, postgres.AuditEventTypeTOKENREFRESH, token.ClientID, device, ipAddress,
+			errors.New(constants.FrozenAccountString()),
+			map[string]string{"method": "refresh token", "reason": "frozen account"})
+
+		return nil, constants.FrozenAccountString(), http.StatusForbidden, errors.New(constants.FrozenAccountString())
+	}
+
+	if authToken, err = auth.GenerateJWT(token.ClientID, status.Role); err != nil {
+		logger.Error("failure generating JWT during session refresh", zap.Error(err))
+
+		return nil, err.Error(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	authToken.RefreshToken = refreshToken
+	authToken.RefreshExpires = token.ExpiresAt.Time.Unix()
+
+	auditClientEvent(db, logger, postgres.AuditEventTypeTOKENREFRESH, token.ClientID, device, ipAddress, nil,
+		map[string]string{"method": "refresh token"})
+
+	return authToken, "", 0, nil
+}
+
+// HTTPSessions will retrieve the details of all the active sessions of a client.
+func HTTPSessions(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	[]modelsPostgres.SessionInfo, int, string, error) {
+	sessions, err := db.RefreshSessionsClient(clientID)
+	if err != nil {
+		var sessionErr *postgres.Error
+		if !errors.As(err, &sessionErr) {
+			logger.Info("failed to unpack sessions error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, sessionErr.Code, sessionErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return sessions, 0, "", nil
+}
+
+// HTTPSessionRevoke will revoke a session belonging to a client. The refresh tokens in the session can no longer be
+// exchanged, but JWTs that were issued in the session remain valid until they expire.
+func HTTPSessionRevoke(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, sessionID string) (
+	int, string, error) {
+	if len(sessionID) < 1 || len(sessionID) > 32 {
+		msg := "invalid session id"
+
+		return http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if err := db.RefreshSessionRevoke(clientID, sessionID); err != nil {
+		var sessionErr *postgres.Error
+		if !errors.As(err, &sessionErr) {
+			logger.Info("failed to unpack session revocation error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return sessionErr.Code, sessionErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// startSession will issue a refresh token that starts a new session for a client and attach it to a JWT authorization
+// response.
+func startSession(auth auth.Auth, db postgres.Postgres, clientID uuid.UUID, device, ipAddress string,
+	authToken *models.JWTAuthResponse) error {
+	var (
+		err          error
+		refreshToken string
+		token        = newRefreshToken(device, ipAddress)
+	)
+
+	if refreshToken, token.TokenHash, err = auth.GenerateRefreshToken(); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	token.SessionID = xid.New().String()
+	token.ClientID = clientID
+
+	if err = db.RefreshTokenCreate(token); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	authToken.RefreshToken = refreshToken
+	authToken.RefreshExpires = token.ExpiresAt.Time.Unix()
+
+	return nil
+}
+
+// newRefreshToken will prepare a refresh token record for a device, with its details truncated to fit their columns,
+// that expires after the lifetime of a refresh token.
+func newRefreshToken(device, ipAddress string) *postgres.RefreshToken {
+	if runes := []rune(device); len(runes) > 256 {
+		device = string(runes[:256])
+	}
+
+	if runes := []rune(ipAddress); len(runes) > 64 {
+		ipAddress = string(runes[:64])
+	}
+
+	return &postgres.RefreshToken{
+		Device:    device,
+		IpAddress: ipAddress,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(constants.RefreshTokenLifetime()), Valid: true},
+	}
+}
+
+// isCacheMiss checks whether an error returned by the cache is a cache miss.
+func isCacheMiss(err error) bool {
+	var redisErr *redis.Error
+
+	return errors.As(err, &redisErr) && redisErr.Is(redis.ErrCacheMiss)
+}
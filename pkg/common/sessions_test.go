@@ -0,0 +1,760 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_HTTPJWTRevoked(t *testing.T) {
+	t.Parallel()
+
+	// Issued half way through the current second, in milliseconds.
+	issuedAt := time.Now().Truncate(time.Second).Add(500 * time.Millisecond).UnixMilli()
+
+	testCases := []struct {
+		name               string
+		expectedStatus     int
+		tokenID            string
+		sessionErr         error
+		denylistErr        error
+		denylistTimes      int
+		revokedBefore      int64
+		revokedBeforeErr   error
+		revokedBeforeTimes int
+		expectErr          require.ErrorAssertionFunc
+	}{
+		{
+			name:               "invalid token",
+			expectedStatus:     http.StatusForbidden,
+			tokenID:            "token-id",
+			sessionErr:         errors.New("invalid token"),
+			denylistErr:        nil,
+			denylistTimes:      0,
+			revokedBefore:      0,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 0,
+			expectErr:          require.Error,
+		}, {
+			name:               "logged out",
+			expectedStatus:     http.StatusForbidden,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        nil,
+			denylistTimes:      1,
+			revokedBefore:      0,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 0,
+			expectErr:          require.Error,
+		}, {
+			name:               "denylist unavailable",
+			expectedStatus:     http.StatusInternalServerError,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheUnknown,
+			denylistTimes:      1,
+			revokedBefore:      0,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 0,
+			expectErr:          require.Error,
+		}, {
+			name:               "logged out of all sessions",
+			expectedStatus:     http.StatusForbidden,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedBefore:      issuedAt,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 1,
+			expectErr:          require.Error,
+		}, {
+			name:               "session revocations unavailable",
+			expectedStatus:     http.StatusInternalServerError,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedBefore:      0,
+			revokedBeforeErr:   redis.ErrCacheUnknown,
+			revokedBeforeTimes: 1,
+			expectErr:          require.Error,
+		}, {
+			name:               "issued after logging out of all sessions",
+			expectedStatus:     0,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedBefore:      issuedAt - 1,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 1,
+			expectErr:          require.NoError,
+		}, {
+			name:               "logged out of all sessions later in the same second",
+			expectedStatus:     http.StatusForbidden,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedBefore:      issuedAt + 100,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 1,
+			expectErr:          require.Error,
+		}, {
+			name:               "issued after logging out of all sessions in the same second",
+			expectedStatus:     0,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedBefore:      issuedAt - 100,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 1,
+			expectErr:          require.NoError,
+		}, {
+			name:               "no token ID logged out of all sessions",
+			expectedStatus:     http.StatusForbidden,
+			tokenID:            "",
+			sessionErr:         nil,
+			denylistErr:        nil,
+			denylistTimes:      0,
+			revokedBefore:      issuedAt,
+			revokedBeforeErr:   nil,
+			revokedBeforeTimes: 1,
+			expectErr:          require.Error,
+		}, {
+			name:               "no token ID active",
+			expectedStatus:     0,
+			tokenID:            "",
+			sessionErr:         nil,
+			denylistErr:        nil,
+			denylistTimes:      0,
+			revokedBefore:      0,
+			revokedBeforeErr:   redis.ErrCacheMiss,
+			revokedBeforeTimes: 1,
+			expectErr:          require.NoError,
+		}, {
+			name:               "active",
+			expectedStatus:     0,
+			tokenID:            "token-id",
+			sessionErr:         nil,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedBefore:      0,
+			revokedBeforeErr:   redis.ErrCacheMiss,
+			revokedBeforeTimes: 1,
+			expectErr:          require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenSession("token").
+					Return(test.tokenID, issuedAt, test.sessionErr).
+					Times(1),
+
+				mockCache.EXPECT().Get("jwt-denylist:"+test.tokenID, gomock.Any()).
+					Return(test.denylistErr).
+					Times(test.denylistTimes),
+
+				mockCache.EXPECT().Get("jwt-revoked-before:"+clientID.String(), gomock.Any()).
+					SetArg(1, test.revokedBefore).
+					Return(test.revokedBeforeErr).
+					Times(test.revokedBeforeTimes),
+			)
+
+			status, _, err := HTTPJWTRevoked(mockAuth, mockCache, zapLogger, clientID, "token")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, status, "status codes mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPLogout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedStatus int
+		tokenID        string
+		expiresAt      int64
+		minTTL         time.Duration
+		sessionErr     error
+		setErr         error
+		setTimes       int
+		revokeErr      error
+		revokeTimes    int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "invalid token",
+			expectedStatus: http.StatusForbidden,
+			tokenID:        "token-id",
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			sessionErr:     errors.New("invalid token"),
+			setErr:         nil,
+			setTimes:       0,
+			revokeErr:      nil,
+			revokeTimes:    0,
+			expectErr:      require.Error,
+		}, {
+			name:           "cache failure",
+			expectedStatus: http.StatusInternalServerError,
+			tokenID:        "token-id",
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			minTTL:         50 * time.Second,
+			sessionErr:     nil,
+			setErr:         redis.ErrCacheSet,
+			setTimes:       1,
+			revokeErr:      nil,
+			revokeTimes:    0,
+			expectErr:      require.Error,
+		}, {
+			name:           "expiring",
+			expectedStatus: 0,
+			tokenID:        "token-id",
+			expiresAt:      time.Now().Unix(),
+			minTTL:         time.Second,
+			sessionErr:     nil,
+			setErr:         nil,
+			setTimes:       1,
+			revokeErr:      nil,
+			revokeTimes:    0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "valid",
+			expectedStatus: 0,
+			tokenID:        "token-id",
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			minTTL:         50 * time.Second,
+			sessionErr:     nil,
+			setErr:         nil,
+			setTimes:       1,
+			revokeErr:      nil,
+			revokeTimes:    0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "no token ID cache failure",
+			expectedStatus: http.StatusInternalServerError,
+			tokenID:        "",
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			minTTL:         50 * time.Second,
+			sessionErr:     nil,
+			setErr:         nil,
+			setTimes:       0,
+			revokeErr:      redis.ErrCacheSet,
+			revokeTimes:    1,
+			expectErr:      require.Error,
+		}, {
+			name:           "no token ID",
+			expectedStatus: 0,
+			tokenID:        "",
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			minTTL:         50 * time.Second,
+			sessionErr:     nil,
+			setErr:         nil,
+			setTimes:       0,
+			revokeErr:      nil,
+			revokeTimes:    1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			issuedAt := time.Now().Add(-time.Minute).UnixMilli()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenSession("token").
+					Return(test.tokenID, issuedAt, test.sessionErr).
+					Times(1),
+
+				mockCache.EXPECT().Set("jwt-revoked-before:"+clientID.String(), issuedAt, gomock.Any()).
+					DoAndReturn(func(_ string, _ any, ttl time.Duration) error {
+						require.GreaterOrEqual(t, ttl, test.minTTL, "revocation TTL too short.")
+
+						return test.revokeErr
+					}).
+					Times(test.revokeTimes),
+
+				mockCache.EXPECT().Set("jwt-denylist:token-id", true, gomock.Any()).
+					DoAndReturn(func(_ string, _ any, ttl time.Duration) error {
+						require.GreaterOrEqual(t, ttl, test.minTTL, "denylist TTL too short.")
+
+						return test.setErr
+					}).
+					Times(test.setTimes),
+			)
+
+			status, _, err := HTTPLogout(mockAuth, mockCache, zapLogger, clientID, "token", test.expiresAt)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, status, "status codes mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPLogoutAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedStatus int
+		sessionErr     error
+		revokeAllErr   error
+		revokeAllTimes int
+		setErr         error
+		setTimes       int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "invalid token",
+			expectedStatus: http.StatusForbidden,
+			sessionErr:     errors.New("invalid token"),
+			revokeAllErr:   nil,
+			revokeAllTimes: 0,
+			setErr:         nil,
+			setTimes:       0,
+			expectErr:      require.Error,
+		}, {
+			name:           "db failure",
+			expectedStatus: http.StatusInternalServerError,
+			sessionErr:     nil,
+			revokeAllErr:   postgres.ErrRefreshToken,
+			revokeAllTimes: 1,
+			setErr:         nil,
+			setTimes:       0,
+			expectErr:      require.Error,
+		}, {
+			name:           "cache failure",
+			expectedStatus: http.StatusInternalServerError,
+			sessionErr:     nil,
+			revokeAllErr:   nil,
+			revokeAllTimes: 1,
+			setErr:         redis.ErrCacheSet,
+			setTimes:       1,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			expectedStatus: 0,
+			sessionErr:     nil,
+			revokeAllErr:   nil,
+			revokeAllTimes: 1,
+			setErr:         nil,
+			setTimes:       1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			issuedAt := time.Now().Add(-time.Minute).UnixMilli()
+			lifetime := int64(600)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenSession("token").
+					Return("token-id", issuedAt, test.sessionErr).
+					Times(1),
+
+				mockDB.EXPECT().RefreshSessionsRevokeAll(clientID).
+					Return(test.revokeAllErr).
+					Times(test.revokeAllTimes),
+
+				mockCache.EXPECT().Set("jwt-revoked-before:"+clientID.String(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ string, value any, ttl time.Duration) error {
+						revokedBefore, ok := value.(int64)
+						require.True(t, ok, "revocation time is not a Unix timestamp.")
+						require.InDelta(t, time.Now().UnixMilli(), revokedBefore, 1000, "revocation time mismatched.")
+						require.Greater(t, ttl, time.Duration(lifetime)*time.Second, "revocation TTL too short.")
+
+						return test.setErr
+					}).
+					Times(test.setTimes),
+			)
+
+			status, _, err := HTTPLogoutAll(mockAuth, mockCache, mockDB, zapLogger, clientID, "token",
+				issuedAt/1000+lifetime)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, status, "status codes mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPRefreshSession(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		expectedMsg     string
+		expectedStatus  int
+		request         *models.HTTPRefreshSessionRequest
+		generateErr     error
+		generateTimes   int
+		rotateErr       error
+		rotateTimes     int
+		userStatus      modelsPostgres.UserStatus
+		userStatusErr   error
+		userStatusTimes int
+		authGenJWTErr   error
+		authGenJWTTimes int
+		expectErr       require.ErrorAssertionFunc
+		expectToken     require.ValueAssertionFunc
+	}{
+		{
+			name:            "empty request",
+			expectedMsg:     constants.ValidationString(),
+			expectedStatus:  http.StatusBadRequest,
+			request:         &models.HTTPRefreshSessionRequest{},
+			generateTimes:   0,
+			rotateTimes:     0,
+			userStatusTimes: 0,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "generate failure",
+			expectedMsg:     constants.RetryMessageString(),
+			expectedStatus:  http.StatusInternalServerError,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateErr:     errors.New("generate failure"),
+			generateTimes:   1,
+			rotateTimes:     0,
+			userStatusTimes: 0,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "unknown refresh token",
+			expectedMsg:     postgres.ErrRefreshTokenInvalid.Error(),
+			expectedStatus:  http.StatusForbidden,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateErr:       postgres.ErrNotFound,
+			rotateTimes:     1,
+			userStatusTimes: 0,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "reused refresh token",
+			expectedMsg:     postgres.ErrRefreshTokenReuse.Error(),
+			expectedStatus:  http.StatusForbidden,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateErr:       postgres.ErrRefreshTokenReuse,
+			rotateTimes:     1,
+			userStatusTimes: 0,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "unknown rotation failure",
+			expectedMsg:     constants.RetryMessageString(),
+			expectedStatus:  http.StatusInternalServerError,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateErr:       errors.New("unknown failure"),
+			rotateTimes:     1,
+			userStatusTimes: 0,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "user status failure",
+			expectedMsg:     constants.RetryMessageString(),
+			expectedStatus:  http.StatusInternalServerError,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateTimes:     1,
+			userStatusErr:   errors.New("database failure"),
+			userStatusTimes: 1,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "deleted user",
+			expectedMsg:     postgres.ErrRefreshTokenInvalid.Error(),
+			expectedStatus:  http.StatusForbidden,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateTimes:     1,
+			userStatus:      modelsPostgres.UserStatus{Role: constants.RoleUser(), IsDeleted: true},
+			userStatusTimes: 1,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "frozen user",
+			expectedMsg:     constants.FrozenAccountString(),
+			expectedStatus:  http.StatusForbidden,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateTimes:     1,
+			userStatus:      modelsPostgres.UserStatus{Role: constants.RoleUser(), IsFrozen: true},
+			userStatusTimes: 1,
+			authGenJWTTimes: 0,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "auth token failure",
+			expectedMsg:     "auth token failure",
+			expectedStatus:  http.StatusInternalServerError,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateTimes:     1,
+			userStatus:      modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusTimes: 1,
+			authGenJWTErr:   errors.New("auth token failure"),
+			authGenJWTTimes: 1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "valid",
+			expectedMsg:     "",
+			expectedStatus:  0,
+			request:         &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			generateTimes:   1,
+			rotateTimes:     1,
+			userStatus:      modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusTimes: 1,
+			authGenJWTTimes: 1,
+			expectErr:       require.NoError,
+			expectToken:     require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			clientID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate client id.")
+
+			gomock.InOrder(
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("new-refresh-token", "new-refresh-token-hash", test.generateErr).
+					Times(test.generateTimes),
+
+				mockAuth.EXPECT().HashRefreshToken("refresh-token").
+					Return("refresh-token-hash").
+					Times(test.rotateTimes),
+
+				mockDB.EXPECT().RefreshTokenRotate("refresh-token-hash", gomock.Any()).
+					DoAndReturn(func(_ string, token *postgres.RefreshToken) error {
+						require.Equal(t, "new-refresh-token-hash", token.TokenHash, "new refresh token hash mismatch.")
+						require.Equal(t, "device", token.Device, "device mismatch.")
+						require.Equal(t, "127.0.0.1", token.IpAddress, "IP address mismatch.")
+						token.ClientID = clientID
+
+						return test.rotateErr
+					}).
+					Times(test.rotateTimes),
+
+				mockDB.EXPECT().UserGetStatus(clientID).
+					Return(test.userStatus, test.userStatusErr).
+					Times(test.userStatusTimes),
+
+				mockAuth.EXPECT().GenerateJWT(clientID, constants.RoleUser()).
+					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
+					Times(test.authGenJWTTimes),
+			)
+
+			token, httpMsg, httpCode, err := HTTPRefreshSession(
+				mockAuth, mockDB, zapLogger, test.request, "device", "127.0.0.1")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectToken(t, token, "token expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if token != nil {
+				require.Equal(t, "new-refresh-token", token.RefreshToken, "refresh token mismatched.")
+				require.Greater(t, token.RefreshExpires, time.Now().Unix(), "refresh token expiry mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPSessions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedStatus int
+		sessionsErr    error
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "unknown error",
+			expectedStatus: http.StatusInternalServerError,
+			sessionsErr:    errors.New("unknown error"),
+			expectErr:      require.Error,
+		}, {
+			name:           "known error",
+			expectedStatus: http.StatusNotFound,
+			sessionsErr:    postgres.ErrNotFound,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			expectedStatus: 0,
+			sessionsErr:    nil,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().RefreshSessionsClient(gomock.Any()).
+				Return([]modelsPostgres.SessionInfo{{SessionID: "session-id"}}, test.sessionsErr).
+				Times(1)
+
+			_, status, _, err := HTTPSessions(mockDB, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, status, "status codes mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPSessionRevoke(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		sessionID      string
+		expectedStatus int
+		revokeErr      error
+		revokeTimes    int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "empty session id",
+			sessionID:      "",
+			expectedStatus: http.StatusBadRequest,
+			revokeTimes:    0,
+			expectErr:      require.Error,
+		}, {
+			name:           "long session id",
+			sessionID:      "a-session-id-that-is-far-too-long-to-be-valid",
+			expectedStatus: http.StatusBadRequest,
+			revokeTimes:    0,
+			expectErr:      require.Error,
+		}, {
+			name:           "unknown error",
+			sessionID:      "session-id",
+			expectedStatus: http.StatusInternalServerError,
+			revokeErr:      errors.New("unknown error"),
+			revokeTimes:    1,
+			expectErr:      require.Error,
+		}, {
+			name:           "not found",
+			sessionID:      "session-id",
+			expectedStatus: http.StatusNotFound,
+			revokeErr:      postgres.ErrNotFound,
+			revokeTimes:    1,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			sessionID:      "session-id",
+			expectedStatus: 0,
+			revokeErr:      nil,
+			revokeTimes:    1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().RefreshSessionRevoke(gomock.Any(), test.sessionID).
+				Return(test.revokeErr).
+				Times(test.revokeTimes)
+
+			status, _, err := HTTPSessionRevoke(mockDB, zapLogger, uuid.UUID{}, test.sessionID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, status, "status codes mismatched.")
+		})
+	}
+}
+
+func TestCommon_newRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	token := newRefreshToken(strings.Repeat("ü", 300), strings.Repeat("1", 100))
+	require.Equal(t, strings.Repeat("ü", 256), token.Device, "device not truncated.")
+	require.Len(t, token.IpAddress, 64, "IP address not truncated.")
+	require.True(t, token.ExpiresAt.Valid, "expiry not set.")
+	require.WithinDuration(t, time.Now().Add(constants.RefreshTokenLifetime()), token.ExpiresAt.Time, time.Minute,
+		"expiry mismatched.")
+}
@@ -0,0 +1,236 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// balanceTimestamp will convert a Unix timestamp string to a time. An empty string is converted to the fallback time.
+// Timestamps that are negative or in the future are rejected.
+func balanceTimestamp(timestampStr string, fallback time.Time, now time.Time) (time.Time, error) {
+	if len(timestampStr) == 0 {
+		return fallback, nil
+	}
+
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w", err)
+	}
+
+	if timestamp < 0 || timestamp > now.Unix() {
+		return time.Time{}, errors.New("timestamp is negative or in the future")
+	}
+
+	return time.Unix(timestamp, 0), nil
+}
+
+// balanceHistoryRange will convert the Unix timestamp strings for the start and end of a balance history range to
+// times. The range ends now and starts the default range before its end if the timestamps are not supplied.
+func balanceHistoryRange(fromStr, toStr string) (time.Time, time.Time, error) {
+	var (
+		err  error
+		from time.Time
+		to   time.Time
+		now  = time.Now()
+	)
+
+	if to, err = balanceTimestamp(toStr, now, now); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if from, err = balanceTimestamp(fromStr, to.Add(-constants.BalanceHistoryDefaultRange()), now); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if from.After(to) || to.Sub(from) > constants.BalanceHistoryMaxRange() {
+		return time.Time{}, time.Time{}, errors.New("range is reversed or too long")
+	}
+
+	return from, to, nil
+}
+
+// HTTPFiatBalanceAsOf retrieves the balance of a Fiat account at a point in time.
+func HTTPFiatBalanceAsOf(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker, timestampStr string) (
+	*postgres.BalanceAsOf, int, string, any, error) {
+	var (
+		balance  postgres.BalanceAsOf
+		currency postgres.Currency
+		asOf     time.Time
+		err      error
+	)
+
+	// Extract and validate the currency.
+	if err = currency.Scan(ticker); err != nil || !currency.Valid() {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), ticker, fmt.Errorf("%w", err)
+	}
+
+	if len(timestampStr) == 0 {
+		msg := "invalid timestamp"
+
+		return nil, http.StatusBadRequest, msg, timestampStr, errors.New(msg)
+	}
+
+	if asOf, err = balanceTimestamp(timestampStr, time.Time{}, time.Now()); err != nil {
+		return nil, http.StatusBadRequest, "invalid timestamp", timestampStr, err
+	}
+
+	if balance, err = db.FiatBalanceAsOf(clientID, currency, asOf); err != nil {
+		var balanceErr *postgres.Error
+		if !errors.As(err, &balanceErr) {
+			logger.Info("failed to unpack Fiat account balance as of error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, balanceErr.Code, balanceErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return &balance, 0, "", nil, nil
+}
+
+// HTTPCryptoBalanceAsOf retrieves the balance of a Crypto account at a point in time.
+func HTTPCryptoBalanceAsOf(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker,
+	timestampStr string) (*postgres.BalanceAsOf, int, string, any, error) {
+	var (
+		balance postgres.BalanceAsOf
+		asOf    time.Time
+		err     error
+	)
+
+	// Validate the ticker. Balances remain accessible for registered Cryptocurrencies that have been halted.
+	if len(ticker) < 1 || len(ticker) > 6 {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), ticker,
+			errors.New(constants.InvalidCurrencyString())
+	}
+
+	if len(timestampStr) == 0 {
+		msg := "invalid timestamp"
+
+		return nil, http.StatusBadRequest, msg, timestampStr, errors.New(msg)
+	}
+
+	if asOf, err = balanceTimestamp(timestampStr, time.Time{}, time.Now()); err != nil {
+		return nil, http.StatusBadRequest, "invalid timestamp", timestampStr, err
+	}
+
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if _, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, ticker, false); err != nil {
+			return nil, httpStatus, httpMsg, ticker, err
+		}
+	}
+
+	if balance, err = db.CryptoBalanceAsOf(clientID, ticker, asOf); err != nil {
+		var balanceErr *postgres.Error
+		if !errors.As(err, &balanceErr) {
+			logger.Info("failed to unpack Crypto account balance as of error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, balanceErr.Code, balanceErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return &balance, 0, "", nil, nil
+}
+
+// HTTPFiatBalanceHistory retrieves the daily closing balances of a Fiat account within a time range, oldest first.
+func HTTPFiatBalanceHistory(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker, fromStr,
+	toStr string) (*models.HTTPFiatBalanceHistory, int, string, any, error) {
+	var (
+		currency postgres.Currency
+		from     time.Time
+		to       time.Time
+		history  models.HTTPFiatBalanceHistory
+		err      error
+	)
+
+	// Extract and validate the currency.
+	if err = currency.Scan(ticker); err != nil || !currency.Valid() {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), ticker, fmt.Errorf("%w", err)
+	}
+
+	if from, to, err = balanceHistoryRange(fromStr, toStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid time range", []string{fromStr, toStr}, err
+	}
+
+	if history.Snapshots, err = db.FiatBalanceHistory(clientID, currency, from, to); err != nil {
+		var historyErr *postgres.Error
+		if !errors.As(err, &historyErr) {
+			logger.Info("failed to unpack Fiat account balance history error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, historyErr.Code, historyErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	history.Currency = string(currency)
+	history.From = from.Unix()
+	history.To = to.Unix()
+
+	return &history, 0, "", nil, nil
+}
+
+// HTTPCryptoBalanceHistory retrieves the daily closing balances of a Crypto account within a time range, oldest first.
+func HTTPCryptoBalanceHistory(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker, fromStr,
+	toStr string) (*models.HTTPCryptoBalanceHistory, int, string, any, error) {
+	var (
+		from    time.Time
+		to      time.Time
+		history models.HTTPCryptoBalanceHistory
+		err     error
+	)
+
+	// Validate the ticker. Balances remain accessible for registered Cryptocurrencies that have been halted.
+	if len(ticker) < 1 || len(ticker) > 6 {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), ticker,
+			errors.New(constants.InvalidCurrencyString())
+	}
+
+	if from, to, err = balanceHistoryRange(fromStr, toStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid time range", []string{fromStr, toStr}, err
+	}
+
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if _, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, ticker, false); err != nil {
+			return nil, httpStatus, httpMsg, ticker, err
+		}
+	}
+
+	if history.Snapshots, err = db.CryptoBalanceHistory(clientID, ticker, from, to); err != nil {
+		var historyErr *postgres.Error
+		if !errors.As(err, &historyErr) {
+			logger.Info("failed to unpack Crypto account balance history error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, historyErr.Code, historyErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	history.Ticker = ticker
+	history.From = from.Unix()
+	history.To = to.Unix()
+
+	return &history, 0, "", nil, nil
+}
@@ -0,0 +1,544 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_BalanceHistoryRange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
+	maxRange := int64(constants.BalanceHistoryMaxRange().Seconds())
+
+	testCases := []struct {
+		name      string
+		from      string
+		to        string
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "defaults",
+			from:      "",
+			to:        "",
+			expectErr: require.NoError,
+		}, {
+			name:      "explicit range",
+			from:      strconv.FormatInt(now-maxRange, 10),
+			to:        strconv.FormatInt(now, 10),
+			expectErr: require.NoError,
+		}, {
+			name:      "malformed start",
+			from:      "start",
+			to:        "",
+			expectErr: require.Error,
+		}, {
+			name:      "negative start",
+			from:      "-1",
+			to:        "",
+			expectErr: require.Error,
+		}, {
+			name:      "future end",
+			from:      "",
+			to:        strconv.FormatInt(now+3600, 10),
+			expectErr: require.Error,
+		}, {
+			name:      "reversed range",
+			from:      strconv.FormatInt(now, 10),
+			to:        strconv.FormatInt(now-3600, 10),
+			expectErr: require.Error,
+		}, {
+			name:      "range too long",
+			from:      strconv.FormatInt(now-maxRange-1, 10),
+			to:        strconv.FormatInt(now, 10),
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			from, to, err := balanceHistoryRange(test.from, test.to)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err == nil {
+				require.False(t, from.After(to), "range start after end.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPFiatBalanceAsOf(t *testing.T) {
+	timestamp := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	testCases := []struct {
+		name             string
+		ticker           string
+		timestamp        string
+		expectedMsg      string
+		expectedStatus   int
+		balanceErr       error
+		balanceTimes     int
+		expectErr        require.ErrorAssertionFunc
+		expectNilBalance require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "invalid currency",
+			ticker:           "INVALID",
+			timestamp:        timestamp,
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "no timestamp",
+			ticker:           "USD",
+			timestamp:        "",
+			expectedMsg:      "invalid timestamp",
+			expectedStatus:   http.StatusBadRequest,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "future timestamp",
+			ticker:           "USD",
+			timestamp:        strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+			expectedMsg:      "invalid timestamp",
+			expectedStatus:   http.StatusBadRequest,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unknown db error",
+			ticker:           "USD",
+			timestamp:        timestamp,
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			balanceErr:       errors.New("unknown error"),
+			balanceTimes:     1,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "known db error",
+			ticker:           "USD",
+			timestamp:        timestamp,
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			balanceErr:       postgres.ErrNotFound,
+			balanceTimes:     1,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid",
+			ticker:           "USD",
+			timestamp:        timestamp,
+			expectedMsg:      "",
+			expectedStatus:   0,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			expectErr:        require.NoError,
+			expectNilBalance: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatBalanceAsOf(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(postgres.BalanceAsOf{}, test.balanceErr).
+				Times(test.balanceTimes)
+
+			balance, httpStatus, httpMessage, payload, err :=
+				HTTPFiatBalanceAsOf(mockDB, zapLogger, uuid.UUID{}, test.ticker, test.timestamp)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilBalance(t, balance, "nil balance expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatched.")
+			require.Contains(t, httpMessage, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoBalanceAsOf(t *testing.T) {
+	timestamp := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	testCases := []struct {
+		name             string
+		ticker           string
+		timestamp        string
+		expectedMsg      string
+		expectedStatus   int
+		assetErr         error
+		assetTimes       int
+		balanceErr       error
+		balanceTimes     int
+		expectErr        require.ErrorAssertionFunc
+		expectNilBalance require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "invalid ticker",
+			ticker:           "INVALID",
+			timestamp:        timestamp,
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			assetErr:         nil,
+			assetTimes:       0,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "malformed timestamp",
+			ticker:           "BTC",
+			timestamp:        "yesterday",
+			expectedMsg:      "invalid timestamp",
+			expectedStatus:   http.StatusBadRequest,
+			assetErr:         nil,
+			assetTimes:       0,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unregistered ticker",
+			ticker:           "BTC",
+			timestamp:        timestamp,
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			assetErr:         postgres.ErrNotFound,
+			assetTimes:       1,
+			balanceErr:       nil,
+			balanceTimes:     0,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unknown db error",
+			ticker:           "BTC",
+			timestamp:        timestamp,
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			assetErr:         nil,
+			assetTimes:       1,
+			balanceErr:       errors.New("unknown error"),
+			balanceTimes:     1,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "known db error",
+			ticker:           "BTC",
+			timestamp:        timestamp,
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			assetErr:         nil,
+			assetTimes:       1,
+			balanceErr:       postgres.ErrNotFound,
+			balanceTimes:     1,
+			expectErr:        require.Error,
+			expectNilBalance: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid",
+			ticker:           "BTC",
+			timestamp:        timestamp,
+			expectedMsg:      "",
+			expectedStatus:   0,
+			assetErr:         nil,
+			assetTimes:       1,
+			balanceErr:       nil,
+			balanceTimes:     1,
+			expectErr:        require.NoError,
+			expectNilBalance: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet(test.ticker).
+					Return(testCryptoAsset, test.assetErr).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().CryptoBalanceAsOf(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(postgres.BalanceAsOf{}, test.balanceErr).
+					Times(test.balanceTimes),
+			)
+
+			balance, httpStatus, httpMessage, payload, err :=
+				HTTPCryptoBalanceAsOf(mockDB, zapLogger, uuid.UUID{}, test.ticker, test.timestamp)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilBalance(t, balance, "nil balance expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatched.")
+			require.Contains(t, httpMessage, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPFiatBalanceHistory(t *testing.T) {
+	testCases := []struct {
+		name             string
+		ticker           string
+		from             string
+		expectedMsg      string
+		expectedStatus   int
+		historyErr       error
+		historyTimes     int
+		expectErr        require.ErrorAssertionFunc
+		expectNilHistory require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "invalid currency",
+			ticker:           "INVALID",
+			from:             "",
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			historyErr:       nil,
+			historyTimes:     0,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "invalid range",
+			ticker:           "USD",
+			from:             "-1",
+			expectedMsg:      "invalid time range",
+			expectedStatus:   http.StatusBadRequest,
+			historyErr:       nil,
+			historyTimes:     0,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unknown db error",
+			ticker:           "USD",
+			from:             "",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			historyErr:       errors.New("unknown error"),
+			historyTimes:     1,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "known db error",
+			ticker:           "USD",
+			from:             "",
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			historyErr:       postgres.ErrNotFound,
+			historyTimes:     1,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid",
+			ticker:           "USD",
+			from:             "",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			historyErr:       nil,
+			historyTimes:     1,
+			expectErr:        require.NoError,
+			expectNilHistory: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatBalanceHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatBalanceSnapshot{{}}, test.historyErr).
+				Times(test.historyTimes)
+
+			history, httpStatus, httpMessage, payload, err :=
+				HTTPFiatBalanceHistory(mockDB, zapLogger, uuid.UUID{}, test.ticker, test.from, "")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilHistory(t, history, "nil history expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatched.")
+			require.Contains(t, httpMessage, test.expectedMsg, "http message mismatched.")
+
+			if err == nil {
+				require.Equal(t, test.ticker, history.Currency, "currency mismatched.")
+				require.Len(t, history.Snapshots, 1, "snapshot count mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPCryptoBalanceHistory(t *testing.T) {
+	testCases := []struct {
+		name             string
+		ticker           string
+		from             string
+		expectedMsg      string
+		expectedStatus   int
+		assetErr         error
+		assetTimes       int
+		historyErr       error
+		historyTimes     int
+		expectErr        require.ErrorAssertionFunc
+		expectNilHistory require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "invalid ticker",
+			ticker:           "INVALID",
+			from:             "",
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			assetErr:         nil,
+			assetTimes:       0,
+			historyErr:       nil,
+			historyTimes:     0,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "invalid range",
+			ticker:           "BTC",
+			from:             "start",
+			expectedMsg:      "invalid time range",
+			expectedStatus:   http.StatusBadRequest,
+			assetErr:         nil,
+			assetTimes:       0,
+			historyErr:       nil,
+			historyTimes:     0,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unregistered ticker",
+			ticker:           "BTC",
+			from:             "",
+			expectedMsg:      constants.InvalidCurrencyString(),
+			expectedStatus:   http.StatusBadRequest,
+			assetErr:         postgres.ErrNotFound,
+			assetTimes:       1,
+			historyErr:       nil,
+			historyTimes:     0,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unknown db error",
+			ticker:           "BTC",
+			from:             "",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			assetErr:         nil,
+			assetTimes:       1,
+			historyErr:       errors.New("unknown error"),
+			historyTimes:     1,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "known db error",
+			ticker:           "BTC",
+			from:             "",
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			assetErr:         nil,
+			assetTimes:       1,
+			historyErr:       postgres.ErrNotFound,
+			historyTimes:     1,
+			expectErr:        require.Error,
+			expectNilHistory: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "valid",
+			ticker:           "BTC",
+			from:             "",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			assetErr:         nil,
+			assetTimes:       1,
+			historyErr:       nil,
+			historyTimes:     1,
+			expectErr:        require.NoError,
+			expectNilHistory: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet(test.ticker).
+					Return(testCryptoAsset, test.assetErr).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().CryptoBalanceHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoBalanceSnapshot{{}}, test.historyErr).
+					Times(test.historyTimes),
+			)
+
+			history, httpStatus, httpMessage, payload, err :=
+				HTTPCryptoBalanceHistory(mockDB, zapLogger, uuid.UUID{}, test.ticker, test.from, "")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilHistory(t, history, "nil history expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http status mismatched.")
+			require.Contains(t, httpMessage, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
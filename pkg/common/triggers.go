@@ -0,0 +1,225 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gofrs/uuid"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPTriggerOrderPlace will validate and place a Cryptocurrency stop-loss or take-profit trigger order. No funds are
+// held for trigger orders and the position is sold from the available balance when the order is triggered.
+func HTTPTriggerOrderPlace(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPTriggerOrderRequest) (*postgres.CryptoTriggerOrder, int, string, any, error) {
+	var (
+		err            error
+		asset          postgres.CryptoAsset
+		parsedCurrency []postgres.Currency
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Retrieve the Cryptocurrency registry entry and check that it is enabled for trading.
+	{
+		var (
+			httpStatus int
+			httpMsg    string
+		)
+
+		if asset, httpStatus, httpMsg, err = HTTPCryptoAsset(db, logger, request.Ticker, true); err != nil {
+			return nil, httpStatus, httpMsg, request.Ticker, err
+		}
+	}
+
+	// Validate the Cryptocurrency amount against the registered order limits. An amount of zero covers the entire
+	// position at the time the order is triggered.
+	if request.Amount.IsNegative() {
+		msg := "order amount cannot be negative"
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	if !request.Amount.IsZero() {
+		if err = HTTPCryptoOrderCheck(&asset, request.Amount); err != nil {
+			return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), err
+		}
+	}
+
+	// Validate the Fiat currency and trigger price.
+	if parsedCurrency, err = HTTPValidateOfferRequest(
+		request.TriggerPrice, constants.DecimalPlacesFiat(), request.FiatCurrency); err != nil {
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Only stop-loss orders can trail the market price, and the trailing distance is a Fiat amount.
+	if request.TrailingDistance.IsNegative() ||
+		!request.TrailingDistance.Equal(request.TrailingDistance.Truncate(constants.DecimalPlacesFiat())) {
+		msg := "invalid trailing distance"
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	if !request.TrailingDistance.IsZero() && request.TriggerType != string(postgres.TriggerOrderTypeSTOPLOSS) {
+		msg := "only stop-loss orders can trail"
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	order := &postgres.CryptoTriggerOrder{
+		OrderID:          xid.New().String(),
+		ClientID:         clientID,
+		FiatCurrency:     parsedCurrency[0],
+		Ticker:           request.Ticker,
+		TriggerType:      postgres.TriggerOrderType(request.TriggerType),
+		TriggerPrice:     request.TriggerPrice,
+		TrailingDistance: request.TrailingDistance,
+		Amount:           request.Amount,
+		Status:           postgres.TriggerOrderStatusACTIVE,
+	}
+
+	if err = db.TriggerOrderCreate(order); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack trigger order placement error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, orderErr.Code, orderErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return order, 0, "", nil, nil
+}
+
+// HTTPTriggerOrderCancel will cancel an active Cryptocurrency trigger order.
+func HTTPTriggerOrderCancel(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, orderID string) (
+	int, string, error) {
+	if len(orderID) < 1 || len(orderID) > 32 {
+		msg := "invalid order id"
+
+		return http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if err := db.TriggerOrderClose(
+		clientID, orderID, postgres.TriggerOrderStatusCANCELLED, "order cancelled by client"); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack trigger order cancellation error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return orderErr.Code, orderErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// HTTPTriggerOrderDetails will retrieve a Cryptocurrency trigger order along with its state history.
+func HTTPTriggerOrderDetails(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, orderID string) (
+	*models.HTTPTriggerOrderDetails, int, string, error) {
+	var (
+		err     error
+		details models.HTTPTriggerOrderDetails
+	)
+
+	if len(orderID) < 1 || len(orderID) > 32 {
+		msg := "invalid order id"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	if details.Order, details.History, err = db.TriggerOrderGet(clientID, orderID); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack trigger order details error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, orderErr.Code, orderErr.Message, fmt.Errorf("%w", err)
+	}
+
+	return &details, 0, "", nil
+}
+
+// HTTPTriggerOrdersPaginated will retrieve a page of a client's Cryptocurrency trigger orders, newest first, and
+// prepare a link to the next page of data. The orders can be restricted to those with a specific status.
+func HTTPTriggerOrdersPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	status, pageCursor, pageSizeStr string, isREST bool) (*models.HTTPTriggerOrdersPaginated, int, string, error) {
+	var (
+		err       error
+		decrypted []byte
+		pageSize  int32
+		nextPage  string
+		startID   string
+		orders    models.HTTPTriggerOrdersPaginated
+	)
+
+	// Validate the status filter.
+	if len(status) > 0 && !postgres.TriggerOrderStatus(status).Valid() {
+		msg := "invalid order status"
+
+		return nil, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if len(pageCursor) > 0 {
+		if decrypted, err = auth.DecryptFromString(pageCursor); err != nil {
+			return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+		}
+
+		startID = string(decrypted)
+	}
+
+	if pageSize, err = adminPageSize(pageSizeStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if orders.Orders, err = db.TriggerOrdersPaginated(clientID, startID, status, pageSize+1); err != nil {
+		var orderErr *postgres.Error
+		if !errors.As(err, &orderErr) {
+			logger.Info("failed to unpack trigger orders error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, orderErr.Code, orderErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link by pulling the last item returned if the page size is N + 1 of the requested.
+	if len(orders.Orders) > int(pageSize) {
+		if nextPage, err = auth.EncryptToString([]byte(orders.Orders[pageSize].OrderID)); err != nil {
+			logger.Error("failed to encrypt trigger order id for use as cursor", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		orders.Orders = orders.Orders[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			orders.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+			if len(status) > 0 {
+				orders.Links.NextPage += "&status=" + url.QueryEscape(status)
+			}
+		} else {
+			orders.Links.PageCursor = nextPage
+		}
+	}
+
+	return &orders, 0, "", nil
+}
@@ -0,0 +1,559 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPTriggerOrderPlace(t *testing.T) {
+	haltedAsset := testCryptoAsset
+	haltedAsset.Status = postgres.CryptoAssetStatusHALTED
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPTriggerOrderRequest
+		asset         postgres.CryptoAsset
+		assetTimes    int
+		createErr     error
+		createTimes   int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+		expectPayload require.ValueAssertionFunc
+	}{
+		{
+			name: "validation",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LIMIT",
+				TriggerPrice: decimal.NewFromFloat(1000)},
+			asset:         testCryptoAsset,
+			assetTimes:    0,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "trading halted",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000)},
+			asset:         haltedAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  "halted",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "negative amount",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000), Amount: decimal.NewFromFloat(-0.5)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "order limits",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000), Amount: decimal.NewFromFloat(0.000000001)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "invalid trigger price",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000.001)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "invalid trailing distance",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000), TrailingDistance: decimal.NewFromFloat(10.001)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "trailing take-profit",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "TAKE_PROFIT",
+				TriggerPrice: decimal.NewFromFloat(1000), TrailingDistance: decimal.NewFromFloat(10)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   0,
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+			expectPayload: require.NotNil,
+		}, {
+			name: "transaction failure",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     postgres.ErrTransactCrypto,
+			createTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectPayload: require.Nil,
+		}, {
+			name: "unknown db failure",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     errors.New("unknown error"),
+			createTimes:   1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+			expectPayload: require.Nil,
+		}, {
+			name: "trailing stop-loss on entire position",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "STOP_LOSS",
+				TriggerPrice: decimal.NewFromFloat(1000), TrailingDistance: decimal.NewFromFloat(50.25)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectPayload: require.Nil,
+		}, {
+			name: "take-profit",
+			request: &models.HTTPTriggerOrderRequest{
+				FiatCurrency: "USD", Ticker: "BTC", TriggerType: "TAKE_PROFIT",
+				TriggerPrice: decimal.NewFromFloat(2000), Amount: decimal.NewFromFloat(0.5)},
+			asset:         testCryptoAsset,
+			assetTimes:    1,
+			createErr:     nil,
+			createTimes:   1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+			expectPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(test.asset, nil).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().TriggerOrderCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			order, actualErrCode, actualErrMsg, payload, err := HTTPTriggerOrderPlace(mockDB, zapLogger,
+				uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.NotEmpty(t, order.OrderID, "order id not set.")
+				require.Equal(t, postgres.TriggerOrderStatusACTIVE, order.Status, "order status mismatched.")
+				require.Equal(t, test.request.TriggerType, string(order.TriggerType), "order type mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPTriggerOrderCancel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		orderID       string
+		closeErr      error
+		closeTimes    int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid order id",
+			orderID:       "",
+			closeErr:      nil,
+			closeTimes:    0,
+			expectErrMsg:  "invalid order id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			orderID:       "order-id",
+			closeErr:      errors.New("unknown error"),
+			closeTimes:    1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "order closed",
+			orderID:       "order-id",
+			closeErr:      postgres.ErrTriggerOrderClosed,
+			closeTimes:    1,
+			expectErrMsg:  "no longer active",
+			expectErrCode: http.StatusConflict,
+			expectErr:     require.Error,
+		}, {
+			name:          "cancelled",
+			orderID:       "order-id",
+			closeErr:      nil,
+			closeTimes:    1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().TriggerOrderClose(gomock.Any(), test.orderID, postgres.TriggerOrderStatusCANCELLED,
+				gomock.Any()).
+				Return(test.closeErr).
+				Times(test.closeTimes)
+
+			actualErrCode, actualErrMsg, err := HTTPTriggerOrderCancel(mockDB, zapLogger, uuid.UUID{}, test.orderID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPTriggerOrderDetails(t *testing.T) {
+	testCases := []struct {
+		name          string
+		orderID       string
+		getErr        error
+		getTimes      int
+		expectErrMsg  string
+		expectErrCode int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid order id",
+			orderID:       "an-order-id-that-is-far-too-long-to-be-valid",
+			getErr:        nil,
+			getTimes:      0,
+			expectErrMsg:  "invalid order id",
+			expectErrCode: http.StatusBadRequest,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			orderID:       "order-id",
+			getErr:        errors.New("unknown error"),
+			getTimes:      1,
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			orderID:       "order-id",
+			getErr:        postgres.ErrNotFound,
+			getTimes:      1,
+			expectErrMsg:  "records not found",
+			expectErrCode: http.StatusNotFound,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid",
+			orderID:       "order-id",
+			getErr:        nil,
+			getTimes:      1,
+			expectErrMsg:  "",
+			expectErrCode: 0,
+			expectErr:     require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().TriggerOrderGet(gomock.Any(), test.orderID).
+				Return(postgres.CryptoTriggerOrder{OrderID: test.orderID},
+					[]postgres.CryptoTriggerOrderEvent{{OrderID: test.orderID}}, test.getErr).
+				Times(test.getTimes)
+
+			details, actualErrCode, actualErrMsg, err := HTTPTriggerOrderDetails(mockDB, zapLogger, uuid.UUID{},
+				test.orderID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.Equal(t, test.orderID, details.Order.OrderID, "order mismatched.")
+				require.Len(t, details.History, 1, "order history mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPTriggerOrdersPaginated(t *testing.T) {
+	testCases := []struct {
+		name             string
+		status           string
+		pageCursor       string
+		pageSize         string
+		isREST           bool
+		expectedStartID  string
+		orders           []postgres.CryptoTriggerOrder
+		decryptErr       error
+		decryptTimes     int
+		ordersErr        error
+		ordersTimes      int
+		encryptErr       error
+		encryptTimes     int
+		expectedNextPage string
+		expectErrMsg     string
+		expectErrCode    int
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "invalid status",
+			status:           "PENDING",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			orders:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			ordersErr:        nil,
+			ordersTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid order status",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page cursor",
+			status:           "",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			orders:           nil,
+			decryptErr:       errors.New("decrypt failure"),
+			decryptTimes:     1,
+			ordersErr:        nil,
+			ordersTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid page size",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "three",
+			isREST:           true,
+			expectedStartID:  "",
+			orders:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			ordersErr:        nil,
+			ordersTimes:      0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "invalid page cursor",
+			expectErrCode:    http.StatusBadRequest,
+			expectErr:        require.Error,
+		}, {
+			name:             "unknown db failure",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			orders:           nil,
+			decryptErr:       nil,
+			decryptTimes:     0,
+			ordersErr:        errors.New("unknown error"),
+			ordersTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "encrypt failure",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			orders:           []postgres.CryptoTriggerOrder{{OrderID: "4"}, {OrderID: "3"}, {OrderID: "2"}, {OrderID: "1"}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			ordersErr:        nil,
+			ordersTimes:      1,
+			encryptErr:       errors.New("encrypt failure"),
+			encryptTimes:     1,
+			expectedNextPage: "",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			expectErr:        require.Error,
+		}, {
+			name:             "last page",
+			status:           "ACTIVE",
+			pageCursor:       "page-cursor",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "12",
+			orders:           []postgres.CryptoTriggerOrder{{OrderID: "12"}, {OrderID: "11"}},
+			decryptErr:       nil,
+			decryptTimes:     1,
+			ordersErr:        nil,
+			ordersTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			expectedNextPage: "",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page REST",
+			status:           "TRIGGERED",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           true,
+			expectedStartID:  "",
+			orders:           []postgres.CryptoTriggerOrder{{OrderID: "4"}, {OrderID: "3"}, {OrderID: "2"}, {OrderID: "1"}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			ordersErr:        nil,
+			ordersTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "?pageCursor=encrypted-cursor&pageSize=3&status=TRIGGERED",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		}, {
+			name:             "next page GraphQL",
+			status:           "",
+			pageCursor:       "",
+			pageSize:         "3",
+			isREST:           false,
+			expectedStartID:  "",
+			orders:           []postgres.CryptoTriggerOrder{{OrderID: "4"}, {OrderID: "3"}, {OrderID: "2"}, {OrderID: "1"}},
+			decryptErr:       nil,
+			decryptTimes:     0,
+			ordersErr:        nil,
+			ordersTimes:      1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			expectedNextPage: "encrypted-cursor",
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(test.pageCursor).
+					Return([]byte("12"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockDB.EXPECT().TriggerOrdersPaginated(gomock.Any(), test.expectedStartID, test.status, int32(4)).
+					Return(test.orders, test.ordersErr).
+					Times(test.ordersTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-cursor", test.encryptErr).
+					Times(test.encryptTimes),
+			)
+
+			orders, actualErrCode, actualErrMsg, err := HTTPTriggerOrdersPaginated(mockAuth, mockDB, zapLogger,
+				uuid.UUID{}, test.status, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+
+			if err == nil {
+				require.LessOrEqual(t, len(orders.Orders), 3, "page size exceeded.")
+
+				if test.isREST {
+					require.Equal(t, test.expectedNextPage, orders.Links.NextPage, "next page link mismatched.")
+				} else {
+					require.Equal(t, test.expectedNextPage, orders.Links.PageCursor, "page cursor mismatched.")
+				}
+			}
+		})
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"github.com/surahman/FTeX/pkg/validator"
 	"go.uber.org/zap"
 )
@@ -42,9 +44,10 @@ func HTTPGenerateTestUsers() map[string]*modelsPostgres.UserAccount {
 	return users
 }
 
-// HTTPRegisterUser will create a row in the database's users' table corresponding to a new user.
-func HTTPRegisterUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, user *modelsPostgres.UserAccount) (
-	*models.JWTAuthResponse, string, int, any, error) {
+// HTTPRegisterUser will create a row in the database's users' table corresponding to a new user, start a session on the
+// device the user registered from, and send a verification link to the user's email address.
+func HTTPRegisterUser(auth auth.Auth, db postgres.Postgres, notify notifier.Notifier, logger *logger.Logger,
+	user *modelsPostgres.UserAccount, device, ipAddress string) (*models.JWTAuthResponse, string, int, any, error) {
 	var (
 		authToken *models.JWTAuthResponse
 		clientID  uuid.UUID
@@ -72,49 +75,139 @@ func HTTPRegisterUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logge
 		return nil, err.Error(), registerErr.Code, nil, fmt.Errorf("%w", err)
 	}
 
-	if authToken, err = auth.GenerateJWT(clientID); err != nil {
+	if authToken, err = auth.GenerateJWT(clientID, constants.RoleUser()); err != nil {
 		logger.Error("failure generating JWT during account creation", zap.Error(err))
 
 		return nil, err.Error(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
 	}
 
+	// The account has been created and cannot be registered again. The user must log in to start a session instead.
+	if err = startSession(auth, db, clientID, device, ipAddress, authToken); err != nil {
+		logger.Warn("failed to start session during account creation",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+	}
+
+	// A verification link can be requested again if it could not be sent.
+	if err = sendEmailVerification(auth, notify, clientID, user.Username, user.Email); err != nil {
+		logger.Warn("failed to send email verification link during account creation",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+	}
+
 	return authToken, "", 0, nil, nil
 }
 
-// HTTPLoginUser will complete a login request for a user.
-func HTTPLoginUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
-	loginRequest *modelsPostgres.UserLoginCredentials) (*models.JWTAuthResponse, string, int, any, error) {
+// HTTPLoginUser will complete a login request for a user and start a session on the device the user logged in from.
+// Users that have enabled two-factor authentication must also provide a one-time password or recovery code.
+func HTTPLoginUser(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	loginRequest *modelsPostgres.UserLoginCredentials, device, ipAddress, otp string) (
+	*models.JWTAuthResponse, string, int, any, error) {
 	var (
 		err            error
 		authToken      *models.JWTAuthResponse
 		clientID       uuid.UUID
 		hashedPassword string
+		retryAfter     int64
+		status         modelsPostgres.UserStatus
+		httpStatus     int
+		httpMsg        string
 	)
 
 	if err = validator.ValidateStruct(loginRequest); err != nil {
 		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
 	}
 
+	if retryAfter, err = loginRetryAfter(cache, logger, loginRequest.Username, ipAddress); err != nil {
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, err
+	}
+
+	if retryAfter > 0 {
+		auditLogin(db, logger, uuid.UUID{}, loginRequest.Username, device, ipAddress, "throttled")
+
+		return nil, constants.LoginThrottledString(), http.StatusTooManyRequests,
+			models.HTTPRetryAfter{RetryAfter: retryAfter}, errors.New(constants.LoginThrottledString())
+	}
+
 	if clientID, hashedPassword, err = db.UserCredentials(loginRequest.Username); err != nil {
+		recordLoginFailure(auth, cache, db, logger, loginRequest.Username, ipAddress)
+		auditLogin(db, logger, uuid.UUID{}, loginRequest.Username, device, ipAddress, "unknown user")
+
 		return nil, "invalid credentials", http.StatusForbidden, nil, fmt.Errorf("%w", err)
 	}
 
 	if err = auth.CheckPassword(hashedPassword, loginRequest.Password); err != nil {
+		recordLoginFailure(auth, cache, db, logger, loginRequest.Username, ipAddress)
+		auditLogin(db, logger, clientID, loginRequest.Username, device, ipAddress, "invalid password")
+
 		return nil, "invalid username or password", http.StatusForbidden, nil, fmt.Errorf("%w", err)
 	}
 
-	if authToken, err = auth.GenerateJWT(clientID); err != nil {
+	if status, err = db.UserGetStatus(clientID); err != nil {
+		logger.Warn("failed to read user status during login", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if status.IsFrozen {
+		auditLogin(db, logger, clientID, loginRequest.Username, device, ipAddress, "frozen account")
+
+		return nil, constants.FrozenAccountString(), http.StatusForbidden, nil, errors.New(constants.FrozenAccountString())
+	}
+
+	if _, httpStatus, httpMsg, err = HTTPSecondFactor(auth, db, logger, clientID, otp); err != nil {
+		// Invalid codes are failed attempts, but a missing code is not since the password was correct.
+		if len(otp) > 0 && httpStatus == http.StatusForbidden {
+			recordLoginFailure(auth, cache, db, logger, loginRequest.Username, ipAddress)
+		}
+
+		auditLogin(db, logger, clientID, loginRequest.Username, device, ipAddress, "second factor")
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	clearLoginFailures(cache, logger, loginRequest.Username)
+
+	if authToken, err = auth.GenerateJWT(clientID, status.Role); err != nil {
 		logger.Error("failure generating JWT during login", zap.Error(err))
 
 		return nil, err.Error(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
 	}
 
+	if err = startSession(auth, db, clientID, device, ipAddress, authToken); err != nil {
+		logger.Error("failure starting session during login", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	auditLogin(db, logger, clientID, loginRequest.Username, device, ipAddress, "")
+
 	return authToken, "", 0, nil, nil
 }
 
+// auditLogin will record a login attempt in the audit log. Failed attempts are recorded with the reason they failed,
+// and attempts with an unknown username are recorded without a subject.
+func auditLogin(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, username, device, ipAddress,
+	failure string) {
+	event := postgres.AuditEventDetails{
+		EventType: postgres.AuditEventTypeLOGIN,
+		Outcome:   postgres.AuditOutcomeSUCCESS,
+		ActorID:   clientID,
+		SubjectID: clientID,
+		IPAddress: ipAddress,
+		UserAgent: device,
+	}
+	payload := map[string]string{"username": username}
+
+	if len(failure) > 0 {
+		event.Outcome = postgres.AuditOutcomeFAILURE
+		payload["reason"] = failure
+	}
+
+	auditEvent(db, logger, &event, payload)
+}
+
 // HTTPRefreshLogin validates a JWT token and issues a fresh token.
 func HTTPRefreshLogin(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
-	clientID uuid.UUID, expiresAt int64) (*models.JWTAuthResponse, string, int, error) {
+	clientID uuid.UUID, expiresAt int64, device, ipAddress string) (*models.JWTAuthResponse, string, int, error) {
 	var (
 		err         error
 		freshToken  *models.JWTAuthResponse
@@ -130,10 +223,19 @@ func HTTPRefreshLogin(auth auth.Auth, db postgres.Postgres, logger *logger.Logge
 
 	if accountInfo.IsDeleted {
 		logger.Warn("attempt to refresh a JWT for a deleted user", zap.String("clientID", accountInfo.Username))
+		auditClientEvent(db, logger, postgres.AuditEventTypeTOKENREFRESH, clientID, device, ipAddress,
+			errors.New("deleted account"), map[string]string{"method": "jwt", "reason": "deleted account"})
 
 		return nil, "invalid token", http.StatusForbidden, fmt.Errorf("%w", err)
 	}
 
+	if accountInfo.IsFrozen {
+		auditClientEvent(db, logger, postgres.AuditEventTypeTOKENREFRESH, clientID, device, ipAddress,
+			errors.New(constants.FrozenAccountString()), map[string]string{"method": "jwt", "reason": "frozen account"})
+
+		return nil, constants.FrozenAccountString(), http.StatusForbidden, errors.New(constants.FrozenAccountString())
+	}
+
 	// Do not refresh tokens that are outside the refresh threshold. Tokens could expire during the execution of
 	// this handler, but expired ones would be rejected during token validation. Thus, it is not necessary to
 	// re-check expiration.
@@ -142,21 +244,27 @@ func HTTPRefreshLogin(auth auth.Auth, db postgres.Postgres, logger *logger.Logge
 			http.StatusNotExtended, fmt.Errorf("%w", err)
 	}
 
-	if freshToken, err = auth.GenerateJWT(clientID); err != nil {
+	if freshToken, err = auth.GenerateJWT(clientID, accountInfo.Role); err != nil {
 		logger.Error("failure generating JWT during token refresh", zap.Error(err))
 
 		return nil, err.Error(), http.StatusInternalServerError, fmt.Errorf("%w", err)
 	}
 
+	auditClientEvent(db, logger, postgres.AuditEventTypeTOKENREFRESH, clientID, device, ipAddress, nil,
+		map[string]string{"method": "jwt"})
+
 	return freshToken, "", 0, nil
 }
 
-// HTTPDeleteUser validates a JWT token and issues a fresh token.
+// HTTPDeleteUser validates a JWT token and issues a fresh token. Users that have enabled two-factor authentication must
+// also provide a one-time password or recovery code.
 func HTTPDeleteUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
-	deleteRequest *models.HTTPDeleteUserRequest) (string, int, any, error) {
+	deleteRequest *models.HTTPDeleteUserRequest, device, ipAddress, otp string) (string, int, any, error) {
 	var (
 		err         error
 		userAccount modelsPostgres.User
+		httpStatus  int
+		httpMsg     string
 	)
 
 	if err = validator.ValidateStruct(deleteRequest); err != nil {
@@ -194,10 +302,19 @@ func HTTPDeleteUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
 
 	if err = auth.CheckPassword(userAccount.Password, deleteRequest.Password); err != nil {
 		msg := "invalid user credentials"
+		auditClientEvent(db, logger, postgres.AuditEventTypeACCOUNTDELETE, clientID, device, ipAddress, err,
+			map[string]string{"reason": "invalid password"})
 
 		return msg, http.StatusForbidden, nil, errors.New(msg)
 	}
 
+	if _, httpStatus, httpMsg, err = HTTPSecondFactor(auth, db, logger, clientID, otp); err != nil {
+		auditClientEvent(db, logger, postgres.AuditEventTypeACCOUNTDELETE, clientID, device, ipAddress, err,
+			map[string]string{"reason": "second factor"})
+
+		return httpMsg, httpStatus, nil, err
+	}
+
 	// Mark the account as deleted.
 	if err = db.UserDelete(clientID); err != nil {
 		logger.Warn("failed to mark a user record as deleted", zap.String("username", userAccount.Username), zap.Error(err))
@@ -205,5 +322,7 @@ func HTTPDeleteUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
 		return constants.RetryMessageString(), http.StatusInternalServerError, nil, errors.New(constants.RetryMessageString())
 	}
 
+	auditClientEvent(db, logger, postgres.AuditEventTypeACCOUNTDELETE, clientID, device, ipAddress, nil, nil)
+
 	return "", 0, nil, nil
 }
@@ -9,12 +9,15 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestCommon_HTTPUserRegister(t *testing.T) {
@@ -31,6 +34,10 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 		authGenJWTToken *models.JWTAuthResponse
 		authGenJWTErr   error
 		authGenJWTTimes int
+		sessionErr      error
+		sessionTimes    int
+		sendErr         error
+		sendTimes       int
 		createUserErr   error
 		createUserTimes int
 		expectErr       require.ErrorAssertionFunc
@@ -50,6 +57,10 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 			authGenJWTToken: nil,
 			authGenJWTErr:   nil,
 			authGenJWTTimes: 0,
+			sessionErr:      nil,
+			sessionTimes:    0,
+			sendErr:         nil,
+			sendTimes:       0,
 			expectErr:       require.Error,
 			expectPayload:   require.NotNil,
 			expectResponse:  require.Nil,
@@ -66,6 +77,50 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 			authGenJWTToken: &models.JWTAuthResponse{},
 			authGenJWTErr:   nil,
 			authGenJWTTimes: 1,
+			sessionErr:      nil,
+			sessionTimes:    1,
+			sendErr:         nil,
+			sendTimes:       1,
+			expectErr:       require.NoError,
+			expectPayload:   require.Nil,
+			expectResponse:  require.NotNil,
+		}, {
+			name:            "session failure",
+			expectedMsg:     "",
+			expectedStatus:  0,
+			user:            *testUserData["username1"],
+			authHashPass:    "hashed password",
+			authHashErr:     nil,
+			authHashTimes:   1,
+			createUserErr:   nil,
+			createUserTimes: 1,
+			authGenJWTToken: &models.JWTAuthResponse{},
+			authGenJWTErr:   nil,
+			authGenJWTTimes: 1,
+			sessionErr:      postgres.ErrRefreshToken,
+			sessionTimes:    1,
+			sendErr:         nil,
+			sendTimes:       1,
+			expectErr:       require.NoError,
+			expectPayload:   require.Nil,
+			expectResponse:  require.NotNil,
+		}, {
+			name:            "verification email failure",
+			expectedMsg:     "",
+			expectedStatus:  0,
+			user:            *testUserData["username1"],
+			authHashPass:    "hashed password",
+			authHashErr:     nil,
+			authHashTimes:   1,
+			createUserErr:   nil,
+			createUserTimes: 1,
+			authGenJWTToken: &models.JWTAuthResponse{},
+			authGenJWTErr:   nil,
+			authGenJWTTimes: 1,
+			sessionErr:      nil,
+			sessionTimes:    1,
+			sendErr:         errors.New("send failure"),
+			sendTimes:       1,
 			expectErr:       require.NoError,
 			expectPayload:   require.Nil,
 			expectResponse:  require.NotNil,
@@ -82,6 +137,10 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 			authGenJWTToken: nil,
 			authGenJWTErr:   nil,
 			authGenJWTTimes: 0,
+			sessionErr:      nil,
+			sessionTimes:    0,
+			sendErr:         nil,
+			sendTimes:       0,
 			expectErr:       require.Error,
 			expectPayload:   require.Nil,
 			expectResponse:  require.Nil,
@@ -98,6 +157,10 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 			authGenJWTToken: nil,
 			authGenJWTErr:   nil,
 			authGenJWTTimes: 0,
+			sessionErr:      nil,
+			sessionTimes:    0,
+			sendErr:         nil,
+			sendTimes:       0,
 			expectErr:       require.Error,
 			expectPayload:   require.Nil,
 			expectResponse:  require.Nil,
@@ -131,6 +194,7 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().HashPassword(gomock.Any()).
@@ -141,17 +205,42 @@ func TestCommon_HTTPUserRegister(t *testing.T) {
 					Return(uuid.UUID{}, test.createUserErr).
 					Times(test.createUserTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), constants.RoleUser()).
 					Return(test.authGenJWTToken, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("refresh-token", "refresh-token-hash", nil).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshTokenCreate(gomock.Any()).
+					Return(test.sessionErr).
+					Times(test.sessionTimes),
+
+				mockAuth.EXPECT().GenerateEmailVerificationToken(gomock.Any(), test.user.Email).
+					Return("verification-token", nil).
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Link(constants.EmailVerificationPath(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(test.sendErr).
+					Times(test.sendTimes),
 			)
 
-			response, httpMsg, httpCode, payload, err := HTTPRegisterUser(mockAuth, mockPostgres, zapLogger, &test.user)
+			response, httpMsg, httpCode, payload, err := HTTPRegisterUser(
+				mockAuth, mockPostgres, mockNotifier, zapLogger, &test.user, "device", "127.0.0.1")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectPayload(t, payload, "payload expectation failed.")
 			test.expectResponse(t, response, "response expectation failed.")
 			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
 			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if response != nil {
+				require.Equal(t, test.sessionErr == nil, response.RefreshToken != "", "refresh token expectation failed.")
+			}
 		})
 	}
 }
@@ -168,8 +257,18 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 		userCredsTimes     int
 		authCheckPassErr   error
 		authCheckPassTimes int
+		userStatus         modelsPostgres.UserStatus
+		userStatusErr      error
+		userStatusTimes    int
+		mfaErr             error
+		mfaTimes           int
 		authGenJWTErr      error
 		authGenJWTTimes    int
+		sessionErr         error
+		sessionTimes       int
+		throttleTimes      int
+		failureTimes       int
+		clearTimes         int
 		expectErr          require.ErrorAssertionFunc
 		expectPayload      require.ValueAssertionFunc
 		expectToken        require.ValueAssertionFunc
@@ -183,8 +282,18 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     0,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 0,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    0,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
+			throttleTimes:      0,
+			failureTimes:       0,
+			clearTimes:         0,
+			sessionErr:         nil,
+			sessionTimes:       0,
 			expectErr:          require.Error,
 			expectPayload:      require.NotNil,
 			expectToken:        require.Nil,
@@ -197,8 +306,18 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    1,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           1,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    1,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         1,
+			sessionErr:         nil,
+			sessionTimes:       1,
 			expectErr:          require.NoError,
 			expectPayload:      require.Nil,
 			expectToken:        require.NotNil,
@@ -211,8 +330,18 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 0,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    0,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       1,
+			clearTimes:         0,
+			sessionErr:         nil,
+			sessionTimes:       0,
 			expectErr:          require.Error,
 			expectPayload:      require.Nil,
 			expectToken:        require.Nil,
@@ -225,8 +354,90 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   errors.New("password hash failure"),
 			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    0,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       1,
+			clearTimes:         0,
+			sessionErr:         nil,
+			sessionTimes:       0,
+			expectErr:          require.Error,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+		}, {
+			name:               "user status failure",
+			expectedMsg:        constants.RetryMessageString(),
+			expectedStatus:     http.StatusInternalServerError,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{},
+			userStatusErr:      errors.New("database failure"),
+			userStatusTimes:    1,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         0,
+			sessionErr:         nil,
+			sessionTimes:       0,
+			expectErr:          require.Error,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+		}, {
+			name:               "frozen user",
+			expectedMsg:        constants.FrozenAccountString(),
+			expectedStatus:     http.StatusForbidden,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser(), IsFrozen: true},
+			userStatusErr:      nil,
+			userStatusTimes:    1,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         0,
+			sessionErr:         nil,
+			sessionTimes:       0,
+			expectErr:          require.Error,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+		}, {
+			name:               "session failure",
+			expectedMsg:        constants.RetryMessageString(),
+			expectedStatus:     http.StatusInternalServerError,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    1,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           1,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    1,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         1,
+			sessionErr:         postgres.ErrRefreshToken,
+			sessionTimes:       1,
 			expectErr:          require.Error,
 			expectPayload:      require.Nil,
 			expectToken:        require.Nil,
@@ -237,10 +448,42 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			user:               &testUserData["username1"].UserLoginCredentials,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    1,
 			userCredsErr:       nil,
 			userCredsTimes:     1,
+			mfaErr:             postgres.ErrNotFound,
+			mfaTimes:           1,
 			authGenJWTErr:      errors.New("auth token failure"),
 			authGenJWTTimes:    1,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         1,
+			expectErr:          require.Error,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+		}, {
+			name:               "second factor required",
+			expectedMsg:        constants.MFARequiredString(),
+			expectedStatus:     http.StatusForbidden,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			userStatus:         modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusErr:      nil,
+			userStatusTimes:    1,
+			mfaErr:             nil,
+			mfaTimes:           1,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         0,
+			sessionErr:         nil,
+			sessionTimes:       0,
 			expectErr:          require.Error,
 			expectPayload:      require.Nil,
 			expectToken:        require.Nil,
@@ -258,8 +501,32 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			// Failed attempts are counted, but never enough to delay or lock out the next attempt.
+			mockAuth.EXPECT().LoginThrottle().
+				Return(auth.LoginThrottle{MaxUserFailures: 5, MaxIPFailures: 20, BaseDelay: time.Second,
+					MaxDelay: time.Minute}).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Incr(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(2 * test.failureTimes)
+
+			mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), time.Second).
+				Return(nil).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Del(gomock.Any()).
+				Return(redis.ErrCacheMiss).
+				Times(2 * test.clearTimes)
 
 			gomock.InOrder(
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.throttleTimes),
+
 				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
 					Return(uuid.UUID{}, "hashed password", test.userCredsErr).
 					Times(test.userCredsTimes),
@@ -268,17 +535,38 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(test.userStatus, test.userStatusErr).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.mfaErr).
+					Times(test.mfaTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), constants.RoleUser()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("refresh-token", "refresh-token-hash", nil).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshTokenCreate(gomock.Any()).
+					Return(test.sessionErr).
+					Times(test.sessionTimes),
 			)
 
-			token, httpMsg, httpCode, payload, err := HTTPLoginUser(mockAuth, mockPostgres, zapLogger, test.user)
+			token, httpMsg, httpCode, payload, err :=
+				HTTPLoginUser(mockAuth, mockRedis, mockPostgres, zapLogger, test.user, "device", "127.0.0.1", "")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectPayload(t, payload, "payload expectation failed.")
 			test.expectToken(t, token, "token expectation failed.")
 			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
 			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if token != nil {
+				require.Equal(t, "refresh-token", token.RefreshToken, "refresh token mismatched.")
+			}
 		})
 	}
 }
@@ -308,7 +596,7 @@ func TestCommon_HTTPLoginRefresh(t *testing.T) {
 			expectedMsg:      "",
 			expectedStatus:   0,
 			expiresAt:        validTime,
-			userGetInfoAcc:   modelsPostgres.User{IsDeleted: false},
+			userGetInfoAcc:   modelsPostgres.User{IsDeleted: false, Role: constants.RoleSupport()},
 			userGetInfoErr:   nil,
 			userGetInfoTimes: 1,
 			authRefreshTimes: 1,
@@ -363,6 +651,24 @@ func TestCommon_HTTPLoginRefresh(t *testing.T) {
 			authGenJWTTimes:  0,
 			expectErr:        require.Error,
 			expectToken:      require.Nil,
+		}, {
+			name:           "frozen user",
+			expectedMsg:    constants.FrozenAccountString(),
+			expectedStatus: http.StatusForbidden,
+			expiresAt:      validTime,
+			userGetInfoAcc: modelsPostgres.User{
+				UserAccount: &modelsPostgres.UserAccount{
+					UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "some username"},
+				},
+				IsFrozen: true,
+			},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 1,
+			authRefreshTimes: 0,
+			authGenJWTErr:    nil,
+			authGenJWTTimes:  0,
+			expectErr:        require.Error,
+			expectToken:      require.Nil,
 		}, {
 			name:             "token generation failure",
 			expectedMsg:      "failed to generate token",
@@ -390,6 +696,7 @@ func TestCommon_HTTPLoginRefresh(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			gomock.InOrder(
 				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
@@ -400,12 +707,14 @@ func TestCommon_HTTPLoginRefresh(t *testing.T) {
 					Return(int64(60)).
 					Times(test.authRefreshTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				// The refreshed token carries the role currently recorded for the client.
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), test.userGetInfoAcc.Role).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
 			)
 
-			token, httpMsg, httpCode, err := HTTPRefreshLogin(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.expiresAt)
+			token, httpMsg, httpCode, err :=
+				HTTPRefreshLogin(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.expiresAt, "device", "127.0.0.1")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectToken(t, token, "token expectation failed.")
 			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
@@ -446,6 +755,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 		userGetInfoTimes  int
 		authCheckPwdErr   error
 		authCheckPwdTimes int
+		mfaErr            error
+		mfaTimes          int
 		userDeleteErr     error
 		userDeleteTimes   int
 		expectErr         require.ErrorAssertionFunc
@@ -461,6 +772,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  0,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 0,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          0,
 			userDeleteErr:     nil,
 			userDeleteTimes:   0,
 			expectErr:         require.Error,
@@ -481,6 +794,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 1,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          1,
 			userDeleteErr:     nil,
 			userDeleteTimes:   1,
 			expectErr:         require.NoError,
@@ -501,6 +816,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 0,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          0,
 			userDeleteErr:     nil,
 			userDeleteTimes:   0,
 			expectErr:         require.Error,
@@ -521,6 +838,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 0,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          0,
 			userDeleteErr:     nil,
 			userDeleteTimes:   0,
 			expectErr:         require.Error,
@@ -541,6 +860,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 0,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          0,
 			userDeleteErr:     nil,
 			userDeleteTimes:   0,
 			expectErr:         require.Error,
@@ -561,6 +882,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 1,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          1,
 			userDeleteErr:     errors.New("db delete failure"),
 			userDeleteTimes:   1,
 			expectErr:         require.Error,
@@ -581,6 +904,8 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   nil,
 			authCheckPwdTimes: 0,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          0,
 			userDeleteErr:     nil,
 			userDeleteTimes:   0,
 			expectErr:         require.Error,
@@ -601,6 +926,30 @@ func TestCommon_DeleteUser(t *testing.T) {
 			userGetInfoTimes:  1,
 			authCheckPwdErr:   errors.New("password check failed"),
 			authCheckPwdTimes: 1,
+			mfaErr:            postgres.ErrNotFound,
+			mfaTimes:          0,
+			userDeleteErr:     nil,
+			userDeleteTimes:   0,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:           "second factor required",
+			expectedMsg:    constants.MFARequiredString(),
+			expectedStatus: http.StatusForbidden,
+			deleteRequest: &models.HTTPDeleteUserRequest{
+				UserLoginCredentials: modelsPostgres.UserLoginCredentials{
+					Username: "username1",
+					Password: "password",
+				},
+				Confirmation: fmt.Sprintf(constants.DeleteUserAccountConfirmation(), "username1"),
+			},
+			userGetInfoAcc:    *userValid,
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 1,
+			mfaErr:            nil,
+			mfaTimes:          1,
 			userDeleteErr:     nil,
 			userDeleteTimes:   0,
 			expectErr:         require.Error,
@@ -619,6 +968,7 @@ func TestCommon_DeleteUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			gomock.InOrder(
 				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
@@ -629,12 +979,17 @@ func TestCommon_DeleteUser(t *testing.T) {
 					Return(test.authCheckPwdErr).
 					Times(test.authCheckPwdTimes),
 
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.mfaErr).
+					Times(test.mfaTimes),
+
 				mockPostgres.EXPECT().UserDelete(gomock.Any()).
 					Return(test.userDeleteErr).
 					Times(test.userDeleteTimes),
 			)
 
-			httpMsg, httpCode, payload, err := HTTPDeleteUser(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.deleteRequest)
+			httpMsg, httpCode, payload, err :=
+				HTTPDeleteUser(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.deleteRequest, "device", "127.0.0.1", "")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectPayload(t, payload, "payload expectation failed.")
 			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
@@ -18,6 +18,7 @@ const (
 	authConfigFileName     = "AuthConfig.yaml"
 	restConfigFileName     = "HTTPRESTConfig.yaml"
 	graphqlConfigFileName  = "HTTPGraphQLConfig.yaml"
+	notifierConfigFileName = "NotifierConfig.yaml"
 
 	// Environment variables.
 	githubCIKey    = "GITHUB_ACTIONS_CI"
@@ -28,6 +29,7 @@ const (
 	authPrefix     = "AUTH"
 	restPrefix     = "REST"
 	graphQLPrefix  = "GRAPHQL"
+	notifierPrefix = "NOTIFIER"
 
 	// Miscellaneous.
 	postgresDSN                   = "user=%s password=%s host=%s port=%d dbname=%s connect_timeout=%d sslmode=disable"
@@ -37,6 +39,28 @@ const (
 	cryptoDecimalPlaces           = int32(8)
 	fiatOfferTTL                  = 2 * time.Minute
 	cryptoOfferTTL                = 2 * time.Minute
+	limitOrderMaxTTL              = 30 * 24 * time.Hour
+	limitOrderPollInterval        = 10 * time.Second
+	limitOrderBatchSize           = int32(100)
+	recurringPollInterval         = time.Minute
+	recurringBatchSize            = int32(100)
+	snapshotPollInterval          = time.Hour
+	snapshotSettlementDelay       = 5 * time.Minute
+	snapshotTimeout               = time.Minute
+	balanceHistoryDefaultRange    = 30 * 24 * time.Hour
+	balanceHistoryMaxRange        = 366 * 24 * time.Hour
+	partitionPollInterval         = 12 * time.Hour
+	partitionMonthsAhead          = 3
+	partitionTimeout              = time.Minute
+	archiveRetainMonths           = 12
+	archiveTimeout                = time.Hour
+	ledgerCheckpointInterval      = time.Hour
+	ledgerVerifyPageSize          = int32(1000)
+	ledgerTimeout                 = time.Minute
+	apiKeyRequestWindow           = 5 * time.Minute
+	apiKeyMaxPerClient            = int32(10)
+	refreshTokenLifetime          = 30 * 24 * time.Hour
+	refreshTokenBytes             = 32
 	monthFormatString             = "%d-%02d-01T00:00:00%s" // YYYY-MM-DDTHH:MM:SS+HH:MM (last section is +/- timezone.)
 	nextPageRESTFormatString      = "?pageCursor=%s&pageSize=%d"
 	specialAccountFiat            = "fiat-currencies"
@@ -44,10 +68,65 @@ const (
 	invalidRequestString          = "invalid request"
 	validationSting               = "validation"
 	invalidCurrencyString         = "invalid currency"
+	unavailableCurrencyString     = "currency is not in circulation"
 	retryMessageString            = "please retry your request later"
+	frozenAccountString           = "user account is frozen"
+	mfaRequiredString             = "two-factor authentication code required"
+	mfaInvalidString              = "invalid two-factor authentication code"
 	clientIDCtxKey                = "ftex-client-id-context-key"
 	expiresAtCtxKey               = "ftex-expires-at-context-key"
+	apiKeyCtxKey                  = "ftex-api-key-context-key"
+	rateLimitPoliciesCtxKey       = "ftex-rate-limit-policies-context-key"
+	apiKeyNonceFormatString       = "api-key-nonce:%s:%s"
+	jwtDenylistFormatString       = "jwt-denylist:%s"
+	jwtRevokedBeforeFormatString  = "jwt-revoked-before:%s"
+	passwordResetFormatString     = "password-reset:%s"
+	passwordResetTTL              = 30 * time.Minute
+	emailVerificationTTL          = 24 * time.Hour
+	emailVerificationPath         = "/user/email/verify"
+	loginFailuresFormatString     = "login-failures:%s:%s"
+	loginLockoutFormatString      = "login-lockout:%s:%s"
+	loginDelayFormatString        = "login-delay:%s"
+	loginThrottledString          = "too many failed login attempts, please try again later"
 	errorFormatMessage            = "%s + %w"
+
+	// Roles and authorization scopes.
+	roleUser        = "USER"
+	roleSupport     = "SUPPORT"
+	roleAdmin       = "ADMIN"
+	scopeUser       = "user"
+	scopeAdminRead  = "admin:read"
+	scopeAdminWrite = "admin:write"
+
+	// API key request headers and scopes.
+	apiKeyHeader          = "X-API-Key"
+	apiKeyTimestampHeader = "X-API-Timestamp"
+	apiKeyNonceHeader     = "X-API-Nonce"
+	apiKeySignatureHeader = "X-API-Signature"
+	apiKeyScopeRead       = "read"
+	apiKeyScopeTrade      = "trade"
+	apiKeyScopeTransfer   = "transfer"
+
+	// Two-factor authentication.
+	otpHeader         = "X-OTP"
+	totpIssuer        = "FTeX"
+	totpDigits        = 6
+	totpPeriod        = 30 * time.Second
+	totpSkewSteps     = 1
+	recoveryCodeCount = 10
+
+	// Rate limiting.
+	rateLimitFormatString    = "rate-limit:%s:%s"
+	rateLimitedString        = "rate limit exceeded, please try again later"
+	rateLimitLimitHeader     = "RateLimit-Limit"
+	rateLimitRemainingHeader = "RateLimit-Remaining"
+	rateLimitResetHeader     = "RateLimit-Reset"
+	rateLimitPolicyHeader    = "RateLimit-Policy"
+	retryAfterHeader         = "Retry-After"
+
+	// Security audit log.
+	auditUserAgentLength = 512
+	auditRecentEvents    = 20
 )
 
 var (
@@ -135,6 +214,16 @@ func AuthPrefix() string {
 	return authPrefix
 }
 
+// NotifierFileName returns the notifier configuration file name.
+func NotifierFileName() string {
+	return notifierConfigFileName
+}
+
+// NotifierPrefix returns the environment variable prefix for the notifier.
+func NotifierPrefix() string {
+	return notifierPrefix
+}
+
 // HTTPRESTFileName returns the HTTP REST endpoint configuration file name.
 func HTTPRESTFileName() string {
 	return restConfigFileName
@@ -170,6 +259,182 @@ func CryptoOfferTTL() time.Duration {
 	return cryptoOfferTTL
 }
 
+// LimitOrderMaxTTL is the maximum time duration that a Cryptocurrency limit order can remain open for.
+func LimitOrderMaxTTL() time.Duration {
+	return limitOrderMaxTTL
+}
+
+// LimitOrderPollInterval is the time duration between sweeps of the open Cryptocurrency limit orders.
+func LimitOrderPollInterval() time.Duration {
+	return limitOrderPollInterval
+}
+
+// LimitOrderBatchSize is the number of open Cryptocurrency limit orders retrieved at a time during a sweep.
+func LimitOrderBatchSize() int32 {
+	return limitOrderBatchSize
+}
+
+// RecurringPollInterval is the time duration between sweeps of the due recurring Cryptocurrency purchases.
+func RecurringPollInterval() time.Duration {
+	return recurringPollInterval
+}
+
+// RecurringBatchSize is the number of due recurring Cryptocurrency purchases retrieved at a time during a sweep.
+func RecurringBatchSize() int32 {
+	return recurringBatchSize
+}
+
+// SnapshotPollInterval is the time duration between checks for daily closing balance snapshots that are due.
+func SnapshotPollInterval() time.Duration {
+	return snapshotPollInterval
+}
+
+// SnapshotSettlementDelay is the time duration after midnight UTC that a daily closing balance snapshot is deferred
+// for, to allow transactions in flight at the close to be committed.
+func SnapshotSettlementDelay() time.Duration {
+	return snapshotSettlementDelay
+}
+
+// SnapshotTimeout is the time duration that recording a day's closing balance snapshots is allowed to run for.
+func SnapshotTimeout() time.Duration {
+	return snapshotTimeout
+}
+
+// BalanceHistoryDefaultRange is the time duration of balance history returned when no range start is supplied.
+func BalanceHistoryDefaultRange() time.Duration {
+	return balanceHistoryDefaultRange
+}
+
+// BalanceHistoryMaxRange is the maximum time duration of balance history that can be retrieved in a single request.
+func BalanceHistoryMaxRange() time.Duration {
+	return balanceHistoryMaxRange
+}
+
+// PartitionPollInterval is the time duration between checks for monthly journal partitions that need to be created.
+func PartitionPollInterval() time.Duration {
+	return partitionPollInterval
+}
+
+// PartitionMonthsAhead is the number of months after the current month that monthly journal partitions are created for.
+func PartitionMonthsAhead() int {
+	return partitionMonthsAhead
+}
+
+// PartitionTimeout is the time duration that creating the monthly journal partitions is allowed to run for.
+func PartitionTimeout() time.Duration {
+	return partitionTimeout
+}
+
+// ArchiveRetainMonths is the default number of closed months of journal partitions that are left in place by archival.
+func ArchiveRetainMonths() int {
+	return archiveRetainMonths
+}
+
+// ArchiveTimeout is the time duration that archiving or exporting a single monthly journal partition is allowed to run
+// for.
+func ArchiveTimeout() time.Duration {
+	return archiveTimeout
+}
+
+// LedgerCheckpointInterval is the time duration between signed checkpoints of the journal hash chains.
+func LedgerCheckpointInterval() time.Duration {
+	return ledgerCheckpointInterval
+}
+
+// LedgerVerifyPageSize is the number of journal entries retrieved at a time whilst walking a journal hash chain.
+func LedgerVerifyPageSize() int32 {
+	return ledgerVerifyPageSize
+}
+
+// LedgerTimeout is the time duration that a single query on the journal hash chains is allowed to run for.
+func LedgerTimeout() time.Duration {
+	return ledgerTimeout
+}
+
+// APIKeyRequestWindow is the maximum time duration between the timestamp of a request signed with an API key and its
+// receipt. Nonces are remembered for twice this duration to reject replayed requests.
+func APIKeyRequestWindow() time.Duration {
+	return apiKeyRequestWindow
+}
+
+// APIKeyMaxPerClient is the maximum number of active API keys a client can hold.
+func APIKeyMaxPerClient() int32 {
+	return apiKeyMaxPerClient
+}
+
+// RefreshTokenLifetime is the time duration that a refresh token is valid for. Every rotation issues a refresh token
+// with a fresh lifetime, so a session expires once it has been idle for this duration.
+func RefreshTokenLifetime() time.Duration {
+	return refreshTokenLifetime
+}
+
+// RefreshTokenBytes is the number of random bytes in an opaque refresh token.
+func RefreshTokenBytes() int {
+	return refreshTokenBytes
+}
+
+// APIKeyNonceFormatString is the format for the cache key under which the nonce of a request signed with an API key is
+// remembered.
+func APIKeyNonceFormatString() string {
+	return apiKeyNonceFormatString
+}
+
+// JWTDenylistFormatString is the format for the cache key under which the ID of a revoked JWT is remembered until it
+// expires.
+func JWTDenylistFormatString() string {
+	return jwtDenylistFormatString
+}
+
+// PasswordResetFormatString is the format for the cache key under which the hash of a password reset token is mapped to
+// the client it was issued to.
+func PasswordResetFormatString() string {
+	return passwordResetFormatString
+}
+
+// PasswordResetTTL is the time duration for which a password reset token can be used.
+func PasswordResetTTL() time.Duration {
+	return passwordResetTTL
+}
+
+// EmailVerificationTTL is the time duration for which an email address verification link can be used.
+func EmailVerificationTTL() time.Duration {
+	return emailVerificationTTL
+}
+
+// EmailVerificationPath is the path, relative to the base URL of the REST API, of the email address verification links.
+func EmailVerificationPath() string {
+	return emailVerificationPath
+}
+
+// LoginFailuresFormatString is the format for the cache key under which the failed login attempts on a username or from
+// an IP address are counted.
+func LoginFailuresFormatString() string {
+	return loginFailuresFormatString
+}
+
+// LoginLockoutFormatString is the format for the cache key under which the time a locked out username or IP address can
+// log in again is remembered.
+func LoginLockoutFormatString() string {
+	return loginLockoutFormatString
+}
+
+// LoginDelayFormatString is the format for the cache key under which the time another login attempt on a username is
+// permitted after a failed attempt is remembered.
+func LoginDelayFormatString() string {
+	return loginDelayFormatString
+}
+
+// LoginThrottledString is the error message returned when a login attempt is rejected after repeated failed attempts.
+func LoginThrottledString() string {
+	return loginThrottledString
+}
+
+// JWTRevokedBeforeFormatString is the format for the cache key under which the time before which all the JWTs issued to
+// a client have been revoked is remembered.
+func JWTRevokedBeforeFormatString() string {
+	return jwtRevokedBeforeFormatString
+}
+
 // MonthFormatString is the base RFC3339 format string for a configurable month, year, and timezone.
 func MonthFormatString() string {
 	return monthFormatString
@@ -215,11 +480,31 @@ func InvalidCurrencyString() string {
 	return invalidCurrencyString
 }
 
+// UnavailableCurrencyString is the error message for a currency that has been retired from circulation.
+func UnavailableCurrencyString() string {
+	return unavailableCurrencyString
+}
+
 // RetryMessageString is the error message requesting a retry.
 func RetryMessageString() string {
 	return retryMessageString
 }
 
+// FrozenAccountString is the error message returned when a frozen user account attempts to access the platform.
+func FrozenAccountString() string {
+	return frozenAccountString
+}
+
+// MFARequiredString is the error message returned when a request requires a second factor that was not provided.
+func MFARequiredString() string {
+	return mfaRequiredString
+}
+
+// MFAInvalidString is the error message returned when a second factor is incorrect or has already been used.
+func MFAInvalidString() string {
+	return mfaInvalidString
+}
+
 // TwoSeconds is a two-second time duration.
 func TwoSeconds() time.Duration {
 	return twoSecondDuration
@@ -240,7 +525,164 @@ func ExpiresAtCtxKey() string {
 	return expiresAtCtxKey
 }
 
+// APIKeyCtxKey is the key used to store the outcome of authenticating a request signed with an API key in a context.
+func APIKeyCtxKey() string {
+	return apiKeyCtxKey
+}
+
+// RateLimitPoliciesCtxKey is the key used to store the rate limit policies a request was admitted under in a context.
+func RateLimitPoliciesCtxKey() string {
+	return rateLimitPoliciesCtxKey
+}
+
 // ErrorFormatMessage will return a format string to be used for error message creation.
 func ErrorFormatMessage() string {
 	return errorFormatMessage
 }
+
+// RoleUser is the role assigned to regular user accounts.
+func RoleUser() string {
+	return roleUser
+}
+
+// RoleSupport is the role assigned to operations staff with read-only administrative access.
+func RoleSupport() string {
+	return roleSupport
+}
+
+// RoleAdmin is the role assigned to administrators with full administrative access.
+func RoleAdmin() string {
+	return roleAdmin
+}
+
+// ScopeUser is the authorization scope required to access regular user endpoints.
+func ScopeUser() string {
+	return scopeUser
+}
+
+// ScopeAdminRead is the authorization scope required to access read-only administrative endpoints.
+func ScopeAdminRead() string {
+	return scopeAdminRead
+}
+
+// ScopeAdminWrite is the authorization scope required to access administrative endpoints that modify data.
+func ScopeAdminWrite() string {
+	return scopeAdminWrite
+}
+
+// APIKeyHeader is the request header that carries the ID of the API key a request is signed with.
+func APIKeyHeader() string {
+	return apiKeyHeader
+}
+
+// APIKeyTimestampHeader is the request header that carries the Unix timestamp a request was signed at.
+func APIKeyTimestampHeader() string {
+	return apiKeyTimestampHeader
+}
+
+// APIKeyNonceHeader is the request header that carries the single-use nonce of a signed request.
+func APIKeyNonceHeader() string {
+	return apiKeyNonceHeader
+}
+
+// APIKeySignatureHeader is the request header that carries the HMAC signature of a request.
+func APIKeySignatureHeader() string {
+	return apiKeySignatureHeader
+}
+
+// APIKeyScopeRead is the API key scope required to access endpoints that only retrieve data.
+func APIKeyScopeRead() string {
+	return apiKeyScopeRead
+}
+
+// APIKeyScopeTrade is the API key scope required to access endpoints that exchange currencies or place orders.
+func APIKeyScopeTrade() string {
+	return apiKeyScopeTrade
+}
+
+// APIKeyScopeTransfer is the API key scope required to access endpoints that open, close, or deposit into accounts.
+func APIKeyScopeTransfer() string {
+	return apiKeyScopeTransfer
+}
+
+// OTPHeader is the request header that carries a one-time password or recovery code to satisfy two-factor
+// authentication.
+func OTPHeader() string {
+	return otpHeader
+}
+
+// TOTPIssuer is the issuer presented by authenticator applications for the time-based one-time passwords.
+func TOTPIssuer() string {
+	return totpIssuer
+}
+
+// TOTPDigits is the number of digits in a time-based one-time password.
+func TOTPDigits() int {
+	return totpDigits
+}
+
+// TOTPPeriod is the time duration for which a time-based one-time password is valid.
+func TOTPPeriod() time.Duration {
+	return totpPeriod
+}
+
+// TOTPSkewSteps is the number of periods before and after the current period for which one-time passwords are
+// accepted to tolerate clock drift.
+func TOTPSkewSteps() int64 {
+	return totpSkewSteps
+}
+
+// RecoveryCodeCount is the number of single-use recovery codes issued for two-factor authentication.
+func RecoveryCodeCount() int {
+	return recoveryCodeCount
+}
+
+// RateLimitFormatString is the format for the cache key under which the requests admitted for a requester under a rate
+// limit policy are logged.
+func RateLimitFormatString() string {
+	return rateLimitFormatString
+}
+
+// RateLimitedString is the error message returned when a request is rejected because its requester has exceeded a rate
+// limit.
+func RateLimitedString() string {
+	return rateLimitedString
+}
+
+// RateLimitLimitHeader is the response header that carries the number of requests permitted over the rate limit window.
+func RateLimitLimitHeader() string {
+	return rateLimitLimitHeader
+}
+
+// RateLimitRemainingHeader is the response header that carries the number of requests that may still be made in the
+// current rate limit window.
+func RateLimitRemainingHeader() string {
+	return rateLimitRemainingHeader
+}
+
+// RateLimitResetHeader is the response header that carries the seconds until another request will be permitted once
+// the rate limit has been reached.
+func RateLimitResetHeader() string {
+	return rateLimitResetHeader
+}
+
+// RateLimitPolicyHeader is the response header that carries the rate limit policy applied to a request.
+func RateLimitPolicyHeader() string {
+	return rateLimitPolicyHeader
+}
+
+// RetryAfterHeader is the response header that carries the seconds a requester must wait before retrying a rejected
+// request.
+func RetryAfterHeader() string {
+	return retryAfterHeader
+}
+
+// AuditUserAgentLength is the maximum number of characters of a user agent that are recorded with an audit event.
+func AuditUserAgentLength() int {
+	return auditUserAgentLength
+}
+
+// AuditRecentEvents is the number of a user's most recent security events that are shown to them.
+func AuditRecentEvents() int32 {
+	return auditRecentEvents
+}
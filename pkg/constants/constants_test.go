@@ -71,6 +71,14 @@ func TestAuthPrefix(t *testing.T) {
 	require.Equal(t, authPrefix, AuthPrefix(), "Incorrect authorization environment prefix")
 }
 
+func TestNotifierFileName(t *testing.T) {
+	require.Equal(t, notifierConfigFileName, NotifierFileName(), "Incorrect notifier filename")
+}
+
+func TestNotifierPrefix(t *testing.T) {
+	require.Equal(t, notifierPrefix, NotifierPrefix(), "Incorrect notifier environment prefix")
+}
+
 func TestHTTPRESTFileName(t *testing.T) {
 	require.Equal(t, restConfigFileName, HTTPRESTFileName(), "Incorrect HTTP REST filename")
 }
@@ -99,6 +107,139 @@ func TestCryptoOfferTTL(t *testing.T) {
 	require.Equal(t, cryptoOfferTTL, CryptoOfferTTL(), "Incorrect Crypto offer TTL.")
 }
 
+func TestLimitOrderMaxTTL(t *testing.T) {
+	require.Equal(t, limitOrderMaxTTL, LimitOrderMaxTTL(), "Incorrect limit order maximum TTL.")
+}
+
+func TestLimitOrderPollInterval(t *testing.T) {
+	require.Equal(t, limitOrderPollInterval, LimitOrderPollInterval(), "Incorrect limit order poll interval.")
+}
+
+func TestLimitOrderBatchSize(t *testing.T) {
+	require.Equal(t, limitOrderBatchSize, LimitOrderBatchSize(), "Incorrect limit order batch size.")
+}
+
+func TestRecurringPollInterval(t *testing.T) {
+	require.Equal(t, recurringPollInterval, RecurringPollInterval(), "Incorrect recurring purchase poll interval.")
+}
+
+func TestRecurringBatchSize(t *testing.T) {
+	require.Equal(t, recurringBatchSize, RecurringBatchSize(), "Incorrect recurring purchase batch size.")
+}
+
+func TestSnapshotPollInterval(t *testing.T) {
+	require.Equal(t, snapshotPollInterval, SnapshotPollInterval(), "Incorrect balance snapshot poll interval.")
+}
+
+func TestSnapshotSettlementDelay(t *testing.T) {
+	require.Equal(t, snapshotSettlementDelay, SnapshotSettlementDelay(), "Incorrect balance snapshot settlement delay.")
+}
+
+func TestSnapshotTimeout(t *testing.T) {
+	require.Equal(t, snapshotTimeout, SnapshotTimeout(), "Incorrect balance snapshot timeout.")
+}
+
+func TestBalanceHistoryDefaultRange(t *testing.T) {
+	require.Equal(t, balanceHistoryDefaultRange, BalanceHistoryDefaultRange(), "Incorrect default balance history range.")
+}
+
+func TestBalanceHistoryMaxRange(t *testing.T) {
+	require.Equal(t, balanceHistoryMaxRange, BalanceHistoryMaxRange(), "Incorrect maximum balance history range.")
+}
+
+func TestPartitionPollInterval(t *testing.T) {
+	require.Equal(t, partitionPollInterval, PartitionPollInterval(), "Incorrect journal partition poll interval.")
+}
+
+func TestPartitionMonthsAhead(t *testing.T) {
+	require.Equal(t, partitionMonthsAhead, PartitionMonthsAhead(), "Incorrect journal partition months ahead.")
+}
+
+func TestPartitionTimeout(t *testing.T) {
+	require.Equal(t, partitionTimeout, PartitionTimeout(), "Incorrect journal partition timeout.")
+}
+
+func TestArchiveRetainMonths(t *testing.T) {
+	require.Equal(t, archiveRetainMonths, ArchiveRetainMonths(), "Incorrect journal archive retained months.")
+}
+
+func TestArchiveTimeout(t *testing.T) {
+	require.Equal(t, archiveTimeout, ArchiveTimeout(), "Incorrect journal archive timeout.")
+}
+
+func TestLedgerCheckpointInterval(t *testing.T) {
+	require.Equal(t, ledgerCheckpointInterval, LedgerCheckpointInterval(), "Incorrect ledger checkpoint interval.")
+}
+
+func TestLedgerVerifyPageSize(t *testing.T) {
+	require.Equal(t, ledgerVerifyPageSize, LedgerVerifyPageSize(), "Incorrect ledger verification page size.")
+}
+
+func TestLedgerTimeout(t *testing.T) {
+	require.Equal(t, ledgerTimeout, LedgerTimeout(), "Incorrect ledger timeout.")
+}
+
+func TestAPIKeyRequestWindow(t *testing.T) {
+	require.Equal(t, apiKeyRequestWindow, APIKeyRequestWindow(), "Incorrect API key request window.")
+}
+
+func TestAPIKeyMaxPerClient(t *testing.T) {
+	require.Equal(t, apiKeyMaxPerClient, APIKeyMaxPerClient(), "Incorrect API key maximum per client.")
+}
+
+func TestRefreshTokenLifetime(t *testing.T) {
+	require.Equal(t, refreshTokenLifetime, RefreshTokenLifetime(), "Incorrect refresh token lifetime.")
+}
+
+func TestRefreshTokenBytes(t *testing.T) {
+	require.Equal(t, refreshTokenBytes, RefreshTokenBytes(), "Incorrect refresh token length.")
+}
+
+func TestAPIKeyNonceFormatString(t *testing.T) {
+	require.Equal(t, apiKeyNonceFormatString, APIKeyNonceFormatString(), "Incorrect API key nonce format string.")
+}
+
+func TestJWTDenylistFormatString(t *testing.T) {
+	require.Equal(t, jwtDenylistFormatString, JWTDenylistFormatString(), "Incorrect JWT denylist format string.")
+}
+
+func TestJWTRevokedBeforeFormatString(t *testing.T) {
+	require.Equal(t, jwtRevokedBeforeFormatString, JWTRevokedBeforeFormatString(),
+		"Incorrect JWT revoked before format string.")
+}
+
+func TestPasswordResetFormatString(t *testing.T) {
+	require.Equal(t, passwordResetFormatString, PasswordResetFormatString(), "Incorrect password reset format string.")
+}
+
+func TestPasswordResetTTL(t *testing.T) {
+	require.Equal(t, passwordResetTTL, PasswordResetTTL(), "Incorrect password reset TTL.")
+}
+
+func TestEmailVerificationTTL(t *testing.T) {
+	require.Equal(t, emailVerificationTTL, EmailVerificationTTL(), "Incorrect email verification TTL.")
+}
+
+func TestLoginFailuresFormatString(t *testing.T) {
+	require.Equal(t, loginFailuresFormatString, LoginFailuresFormatString(), "Incorrect login failures format string.")
+}
+
+func TestLoginLockoutFormatString(t *testing.T) {
+	require.Equal(t, loginLockoutFormatString, LoginLockoutFormatString(), "Incorrect login lockout format string.")
+}
+
+func TestLoginDelayFormatString(t *testing.T) {
+	require.Equal(t, loginDelayFormatString, LoginDelayFormatString(), "Incorrect login delay format string.")
+}
+
+func TestLoginThrottledString(t *testing.T) {
+	require.Equal(t, loginThrottledString, LoginThrottledString(), "Incorrect login throttled string.")
+}
+
+func TestEmailVerificationPath(t *testing.T) {
+	require.Equal(t, emailVerificationPath, EmailVerificationPath(), "Incorrect email verification path.")
+}
+
 func TestMonthFormatString(t *testing.T) {
 	t.Parallel()
 
@@ -182,10 +323,26 @@ func TestInvalidCurrencyString(t *testing.T) {
 	require.Equal(t, invalidCurrencyString, InvalidCurrencyString(), "Incorrect invalid currency string.")
 }
 
+func TestUnavailableCurrencyString(t *testing.T) {
+	require.Equal(t, unavailableCurrencyString, UnavailableCurrencyString(), "Incorrect unavailable currency string.")
+}
+
 func TestRetryMessageString(t *testing.T) {
 	require.Equal(t, retryMessageString, RetryMessageString(), "Incorrect retry message string.")
 }
 
+func TestFrozenAccountString(t *testing.T) {
+	require.Equal(t, frozenAccountString, FrozenAccountString(), "Incorrect frozen account string.")
+}
+
+func TestMFARequiredString(t *testing.T) {
+	require.Equal(t, mfaRequiredString, MFARequiredString(), "Incorrect MFA required string.")
+}
+
+func TestMFAInvalidString(t *testing.T) {
+	require.Equal(t, mfaInvalidString, MFAInvalidString(), "Incorrect MFA invalid string.")
+}
+
 func TestTwoSeconds(t *testing.T) {
 	require.Equal(t, twoSecondDuration, TwoSeconds(), "Incorrect two second duration.")
 }
@@ -201,6 +358,126 @@ func TestExpiresAtCtxKey(t *testing.T) {
 	require.Equal(t, expiresAtCtxKey, ExpiresAtCtxKey(), "Incorrect expiration deadline context key.")
 }
 
+func TestAPIKeyCtxKey(t *testing.T) {
+	require.Equal(t, apiKeyCtxKey, APIKeyCtxKey(), "Incorrect API key context key.")
+}
+
+func TestRateLimitPoliciesCtxKey(t *testing.T) {
+	require.Equal(t, rateLimitPoliciesCtxKey, RateLimitPoliciesCtxKey(), "Incorrect rate limit policies context key.")
+}
+
 func TestErrorFormatMessage(t *testing.T) {
 	require.Equal(t, errorFormatMessage, ErrorFormatMessage(), "Incorrect error format string.")
 }
+
+func TestRoleUser(t *testing.T) {
+	require.Equal(t, roleUser, RoleUser(), "Incorrect user role.")
+}
+
+func TestRoleSupport(t *testing.T) {
+	require.Equal(t, roleSupport, RoleSupport(), "Incorrect support role.")
+}
+
+func TestRoleAdmin(t *testing.T) {
+	require.Equal(t, roleAdmin, RoleAdmin(), "Incorrect admin role.")
+}
+
+func TestScopeUser(t *testing.T) {
+	require.Equal(t, scopeUser, ScopeUser(), "Incorrect user scope.")
+}
+
+func TestScopeAdminRead(t *testing.T) {
+	require.Equal(t, scopeAdminRead, ScopeAdminRead(), "Incorrect admin read scope.")
+}
+
+func TestScopeAdminWrite(t *testing.T) {
+	require.Equal(t, scopeAdminWrite, ScopeAdminWrite(), "Incorrect admin write scope.")
+}
+
+func TestAPIKeyHeader(t *testing.T) {
+	require.Equal(t, apiKeyHeader, APIKeyHeader(), "Incorrect API key header.")
+}
+
+func TestAPIKeyTimestampHeader(t *testing.T) {
+	require.Equal(t, apiKeyTimestampHeader, APIKeyTimestampHeader(), "Incorrect API key timestamp header.")
+}
+
+func TestAPIKeyNonceHeader(t *testing.T) {
+	require.Equal(t, apiKeyNonceHeader, APIKeyNonceHeader(), "Incorrect API key nonce header.")
+}
+
+func TestAPIKeySignatureHeader(t *testing.T) {
+	require.Equal(t, apiKeySignatureHeader, APIKeySignatureHeader(), "Incorrect API key signature header.")
+}
+
+func TestAPIKeyScopeRead(t *testing.T) {
+	require.Equal(t, apiKeyScopeRead, APIKeyScopeRead(), "Incorrect API key read scope.")
+}
+
+func TestAPIKeyScopeTrade(t *testing.T) {
+	require.Equal(t, apiKeyScopeTrade, APIKeyScopeTrade(), "Incorrect API key trade scope.")
+}
+
+func TestAPIKeyScopeTransfer(t *testing.T) {
+	require.Equal(t, apiKeyScopeTransfer, APIKeyScopeTransfer(), "Incorrect API key transfer scope.")
+}
+
+func TestOTPHeader(t *testing.T) {
+	require.Equal(t, otpHeader, OTPHeader(), "Incorrect one-time password header.")
+}
+
+func TestTOTPIssuer(t *testing.T) {
+	require.Equal(t, totpIssuer, TOTPIssuer(), "Incorrect TOTP issuer.")
+}
+
+func TestTOTPDigits(t *testing.T) {
+	require.Equal(t, totpDigits, TOTPDigits(), "Incorrect TOTP digits.")
+}
+
+func TestTOTPPeriod(t *testing.T) {
+	require.Equal(t, totpPeriod, TOTPPeriod(), "Incorrect TOTP period.")
+}
+
+func TestTOTPSkewSteps(t *testing.T) {
+	require.Equal(t, int64(totpSkewSteps), TOTPSkewSteps(), "Incorrect TOTP skew steps.")
+}
+
+func TestRecoveryCodeCount(t *testing.T) {
+	require.Equal(t, recoveryCodeCount, RecoveryCodeCount(), "Incorrect recovery code count.")
+}
+
+func TestRateLimitFormatString(t *testing.T) {
+	require.Equal(t, rateLimitFormatString, RateLimitFormatString(), "Incorrect rate limit format string.")
+}
+
+func TestRateLimitedString(t *testing.T) {
+	require.Equal(t, rateLimitedString, RateLimitedString(), "Incorrect rate limited string.")
+}
+
+func TestRateLimitLimitHeader(t *testing.T) {
+	require.Equal(t, rateLimitLimitHeader, RateLimitLimitHeader(), "Incorrect rate limit limit header.")
+}
+
+func TestRateLimitRemainingHeader(t *testing.T) {
+	require.Equal(t, rateLimitRemainingHeader, RateLimitRemainingHeader(), "Incorrect rate limit remaining header.")
+}
+
+func TestRateLimitResetHeader(t *testing.T) {
+	require.Equal(t, rateLimitResetHeader, RateLimitResetHeader(), "Incorrect rate limit reset header.")
+}
+
+func TestRateLimitPolicyHeader(t *testing.T) {
+	require.Equal(t, rateLimitPolicyHeader, RateLimitPolicyHeader(), "Incorrect rate limit policy header.")
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	require.Equal(t, retryAfterHeader, RetryAfterHeader(), "Incorrect retry after header.")
+}
+
+func TestAuditUserAgentLength(t *testing.T) {
+	require.Equal(t, auditUserAgentLength, AuditUserAgentLength(), "Incorrect audit user agent length.")
+}
+
+func TestAuditRecentEvents(t *testing.T) {
+	require.Equal(t, int32(auditRecentEvents), AuditRecentEvents(), "Incorrect audit recent events count.")
+}
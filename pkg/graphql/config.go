@@ -15,6 +15,7 @@ import (
 type config struct {
 	Server        serverConfig        `json:"server,omitempty"        mapstructure:"server"        validate:"required" yaml:"server,omitempty"`
 	Authorization authorizationConfig `json:"authorization,omitempty" mapstructure:"authorization" validate:"required" yaml:"authorization,omitempty"`
+	RateLimit     rateLimitConfig     `json:"rateLimit,omitempty"     mapstructure:"rateLimit"     validate:"required" yaml:"rateLimit,omitempty"`
 }
 
 // serverConfig contains the configurations for the HTTP REST server.
@@ -36,6 +37,25 @@ type authorizationConfig struct {
 	HeaderKey string `json:"headerKey,omitempty" mapstructure:"headerKey" validate:"required" yaml:"headerKey,omitempty"`
 }
 
+// rateLimitConfig contains the default limit on the requests from each requester, and the limits for the queries and
+// mutations that are limited separately.
+//
+//nolint:lll
+type rateLimitConfig struct {
+	Limit      int64                      `json:"limit,omitempty"      mapstructure:"limit"      validate:"required,min=1"  yaml:"limit,omitempty"`
+	Window     time.Duration              `json:"window,omitempty"     mapstructure:"window"     validate:"required,min=1s" yaml:"window,omitempty"`
+	Operations []operationRateLimitConfig `json:"operations,omitempty" mapstructure:"operations" validate:"omitempty,dive" yaml:"operations,omitempty"`
+}
+
+// operationRateLimitConfig contains the limit on the calls from each requester to a query or mutation.
+//
+//nolint:lll
+type operationRateLimitConfig struct {
+	Name   string        `json:"name,omitempty"   mapstructure:"name"   validate:"required"         yaml:"name,omitempty"`
+	Limit  int64         `json:"limit,omitempty"  mapstructure:"limit"  validate:"required,min=1"  yaml:"limit,omitempty"`
+	Window time.Duration `json:"window,omitempty" mapstructure:"window" validate:"required,min=1s" yaml:"window,omitempty"`
+}
+
 // newConfig creates a blank configuration struct for the authorization.
 func newConfig() *config {
 	return &config{}
@@ -18,6 +18,7 @@ import (
 func TestGraphQLConfigs_Load(t *testing.T) {
 	keyspaceServer := constants.HTTPGraphQLPrefix() + "_SERVER."
 	keyspaceAuth := constants.HTTPGraphQLPrefix() + "_AUTHORIZATION."
+	keyspaceRateLimit := constants.HTTPGraphQLPrefix() + "_RATELIMIT."
 
 	testCases := []struct {
 		name         string
@@ -29,7 +30,7 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			name:         "empty - etc dir",
 			input:        graphQLConfigTestData["empty"],
 			expectErr:    require.Error,
-			expectErrCnt: 9,
+			expectErrCnt: 11,
 		}, {
 			name:         "valid - etc dir",
 			input:        graphQLConfigTestData["valid"],
@@ -80,6 +81,16 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			input:        graphQLConfigTestData["no auth header"],
 			expectErr:    require.Error,
 			expectErrCnt: 1,
+		}, {
+			name:         "no rate limit - etc dir",
+			input:        graphQLConfigTestData["no rate limit"],
+			expectErr:    require.Error,
+			expectErrCnt: 2,
+		}, {
+			name:         "invalid operation rate limit - etc dir",
+			input:        graphQLConfigTestData["invalid operation rate limit"],
+			expectErr:    require.Error,
+			expectErrCnt: 2,
 		},
 	}
 
@@ -114,6 +125,8 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			playgroundPath := xid.New().String()
 			queryPath := xid.New().String()
 			headerKey := xid.New().String()
+			rateLimit := int64(30)
+			rateLimitWindow := 10 * time.Second
 			portNumber := 1600
 			shutdownDelay := time.Duration(36)
 			readTimeout := time.Duration(4)
@@ -128,6 +141,8 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			t.Setenv(keyspaceServer+"WRITETIMEOUT", writeTimeout.String())
 			t.Setenv(keyspaceServer+"READHEADERTIMEOUT", readHeaderTimeout.String())
 			t.Setenv(keyspaceAuth+"HEADERKEY", headerKey)
+			t.Setenv(keyspaceRateLimit+"LIMIT", strconv.FormatInt(rateLimit, 10))
+			t.Setenv(keyspaceRateLimit+"WINDOW", rateLimitWindow.String())
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
@@ -148,6 +163,10 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 				"failed to load read header timeout environment variable into configs")
 			require.Equal(t, headerKey, actual.Authorization.HeaderKey,
 				"Failed to load authorization header key environment variable into configs")
+			require.Equal(t, rateLimit, actual.RateLimit.Limit,
+				"failed to load rate limit environment variable into configs")
+			require.Equal(t, rateLimitWindow, actual.RateLimit.Window,
+				"failed to load rate limit window environment variable into configs")
 		})
 	}
 }
@@ -19,11 +19,26 @@ import (
 // region    ************************** generated!.gotpl **************************
 
 type CryptoAccountResolver interface {
-	Balance(ctx context.Context, obj *postgres.CryptoAccount) (float64, error)
-	LastTx(ctx context.Context, obj *postgres.CryptoAccount) (float64, error)
-	LastTxTs(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
-	CreatedAt(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
-	ClientID(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
+	Balance(ctx context.Context, obj *postgres.CryptoAccountBalance) (float64, error)
+	Available(ctx context.Context, obj *postgres.CryptoAccountBalance) (float64, error)
+	LastTx(ctx context.Context, obj *postgres.CryptoAccountBalance) (float64, error)
+	LastTxTs(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error)
+	CreatedAt(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error)
+	ClientID(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error)
+	Status(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error)
+}
+type CryptoAssetResolver interface {
+	Status(ctx context.Context, obj *postgres.CryptoAsset) (string, error)
+	MinOrder(ctx context.Context, obj *postgres.CryptoAsset) (float64, error)
+	MaxOrder(ctx context.Context, obj *postgres.CryptoAsset) (float64, error)
+	UpdatedAt(ctx context.Context, obj *postgres.CryptoAsset) (string, error)
+}
+type CryptoBalanceSnapshotResolver interface {
+	ClientID(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (string, error)
+
+	SnapshotAt(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (string, error)
+	Balance(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (float64, error)
+	CreatedAt(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (string, error)
 }
 type CryptoJournalResolver interface {
 	Amount(ctx context.Context, obj *postgres.CryptoJournal) (float64, error)
@@ -31,13 +46,86 @@ type CryptoJournalResolver interface {
 	ClientID(ctx context.Context, obj *postgres.CryptoJournal) (string, error)
 	TxID(ctx context.Context, obj *postgres.CryptoJournal) (string, error)
 }
+type CryptoLimitOrderResolver interface {
+	ClientID(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error)
+	FiatCurrency(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error)
+
+	Amount(ctx context.Context, obj *postgres.CryptoLimitOrder) (float64, error)
+	LimitPrice(ctx context.Context, obj *postgres.CryptoLimitOrder) (float64, error)
+	Status(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error)
+	TxID(ctx context.Context, obj *postgres.CryptoLimitOrder) (*string, error)
+	ExpiresAt(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error)
+	CreatedAt(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error)
+	UpdatedAt(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error)
+}
+type CryptoLimitOrderEventResolver interface {
+	Status(ctx context.Context, obj *postgres.CryptoLimitOrderEvent) (string, error)
+	Price(ctx context.Context, obj *postgres.CryptoLimitOrderEvent) (float64, error)
+
+	CreatedAt(ctx context.Context, obj *postgres.CryptoLimitOrderEvent) (string, error)
+}
+type CryptoRecurringPurchaseResolver interface {
+	ClientID(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+	FiatCurrency(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+
+	Amount(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (float64, error)
+	Cadence(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+	StartsAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+	EndsAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (*string, error)
+	NextRunAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+	Status(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+	CreatedAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+	UpdatedAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error)
+}
+type CryptoRecurringPurchaseRunResolver interface {
+	ScheduledAt(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (string, error)
+	Status(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (string, error)
+	Price(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (float64, error)
+	FiatAmount(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (float64, error)
+	CryptoAmount(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (float64, error)
+	TxID(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (*string, error)
+
+	CreatedAt(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (string, error)
+}
 type CryptoTransactionsPaginatedResolver interface {
 	Transactions(ctx context.Context, obj *models.HTTPCryptoTransactionsPaginated) ([]postgres.CryptoJournal, error)
 }
+type CryptoTriggerOrderResolver interface {
+	ClientID(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error)
+	FiatCurrency(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error)
+
+	TriggerType(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error)
+	TriggerPrice(ctx context.Context, obj *postgres.CryptoTriggerOrder) (float64, error)
+	TrailingDistance(ctx context.Context, obj *postgres.CryptoTriggerOrder) (float64, error)
+	Amount(ctx context.Context, obj *postgres.CryptoTriggerOrder) (float64, error)
+	Status(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error)
+	TxID(ctx context.Context, obj *postgres.CryptoTriggerOrder) (*string, error)
+	CreatedAt(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error)
+	UpdatedAt(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error)
+}
+type CryptoTriggerOrderEventResolver interface {
+	Status(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (string, error)
+	Price(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (float64, error)
+	Amount(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (float64, error)
+
+	CreatedAt(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (string, error)
+}
 
+type CryptoLimitOrderRequestResolver interface {
+	Amount(ctx context.Context, obj *models.HTTPLimitOrderRequest, data float64) error
+	LimitPrice(ctx context.Context, obj *models.HTTPLimitOrderRequest, data float64) error
+}
 type CryptoOfferRequestResolver interface {
 	SourceAmount(ctx context.Context, obj *models.HTTPCryptoOfferRequest, data float64) error
 }
+type CryptoRecurringPurchaseRequestResolver interface {
+	Amount(ctx context.Context, obj *models.HTTPRecurringPurchaseRequest, data float64) error
+}
+type CryptoTriggerOrderRequestResolver interface {
+	TriggerPrice(ctx context.Context, obj *models.HTTPTriggerOrderRequest, data float64) error
+	TrailingDistance(ctx context.Context, obj *models.HTTPTriggerOrderRequest, data *float64) error
+	Amount(ctx context.Context, obj *models.HTTPTriggerOrderRequest, data *float64) error
+}
 
 // endregion ************************** generated!.gotpl **************************
 
@@ -51,7 +139,7 @@ type CryptoOfferRequestResolver interface {
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _CryptoAccount_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _CryptoAccount_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoAccount_ticker(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -95,7 +183,7 @@ func (ec *executionContext) fieldContext_CryptoAccount_ticker(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoAccount_balance(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _CryptoAccount_balance(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoAccount_balance(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -139,7 +227,51 @@ func (ec *executionContext) fieldContext_CryptoAccount_balance(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoAccount_lastTx(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _CryptoAccount_available(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAccount_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoAccount().Available(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoAccount_available(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoAccount_lastTx(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoAccount_lastTx(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -183,7 +315,7 @@ func (ec *executionContext) fieldContext_CryptoAccount_lastTx(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoAccount_lastTxTs(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _CryptoAccount_lastTxTs(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoAccount_lastTxTs(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -227,7 +359,7 @@ func (ec *executionContext) fieldContext_CryptoAccount_lastTxTs(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoAccount_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _CryptoAccount_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoAccount_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -271,7 +403,7 @@ func (ec *executionContext) fieldContext_CryptoAccount_createdAt(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoAccount_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _CryptoAccount_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoAccount_clientID(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -315,8 +447,8 @@ func (ec *executionContext) fieldContext_CryptoAccount_clientID(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoDetailsPaginated) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoBalancesPaginated_accountBalances(ctx, field)
+func (ec *executionContext) _CryptoAccount_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccountBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAccount_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -329,7 +461,7 @@ func (ec *executionContext) _CryptoBalancesPaginated_accountBalances(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AccountBalances, nil
+		return ec.resolvers.CryptoAccount().Status(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -341,40 +473,26 @@ func (ec *executionContext) _CryptoBalancesPaginated_accountBalances(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]postgres.CryptoAccount)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNCryptoAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAccount_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoBalancesPaginated",
+		Object:     "CryptoAccount",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ticker":
-				return ec.fieldContext_CryptoAccount_ticker(ctx, field)
-			case "balance":
-				return ec.fieldContext_CryptoAccount_balance(ctx, field)
-			case "lastTx":
-				return ec.fieldContext_CryptoAccount_lastTx(ctx, field)
-			case "lastTxTs":
-				return ec.fieldContext_CryptoAccount_lastTxTs(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_CryptoAccount_createdAt(ctx, field)
-			case "clientID":
-				return ec.fieldContext_CryptoAccount_clientID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CryptoAccount", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoBalancesPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoDetailsPaginated) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoBalancesPaginated_links(ctx, field)
+func (ec *executionContext) _CryptoAsset_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_ticker(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -387,7 +505,7 @@ func (ec *executionContext) _CryptoBalancesPaginated_links(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Links, nil
+		return obj.Ticker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -399,32 +517,26 @@ func (ec *executionContext) _CryptoBalancesPaginated_links(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(models.HTTPLinks)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoBalancesPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoBalancesPaginated",
+		Object:     "CryptoAsset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nextPage":
-				return ec.fieldContext_Links_nextPage(ctx, field)
-			case "pageCursor":
-				return ec.fieldContext_Links_pageCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoJournal_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoJournal_ticker(ctx, field)
+func (ec *executionContext) _CryptoAsset_name(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -437,7 +549,7 @@ func (ec *executionContext) _CryptoJournal_ticker(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ticker, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -454,9 +566,9 @@ func (ec *executionContext) _CryptoJournal_ticker(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoJournal_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoJournal",
+		Object:     "CryptoAsset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -467,8 +579,8 @@ func (ec *executionContext) fieldContext_CryptoJournal_ticker(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoJournal_amount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoJournal_amount(ctx, field)
+func (ec *executionContext) _CryptoAsset_decimalPlaces(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_decimalPlaces(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -481,7 +593,7 @@ func (ec *executionContext) _CryptoJournal_amount(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CryptoJournal().Amount(rctx, obj)
+		return obj.DecimalPlaces, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -493,26 +605,26 @@ func (ec *executionContext) _CryptoJournal_amount(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(int32)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt322int32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoJournal_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_decimalPlaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoJournal",
+		Object:     "CryptoAsset",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int32 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoJournal_transactedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
+func (ec *executionContext) _CryptoAsset_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -525,7 +637,7 @@ func (ec *executionContext) _CryptoJournal_transactedAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CryptoJournal().TransactedAt(rctx, obj)
+		return ec.resolvers.CryptoAsset().Status(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -542,9 +654,9 @@ func (ec *executionContext) _CryptoJournal_transactedAt(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoJournal_transactedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoJournal",
+		Object:     "CryptoAsset",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -555,8 +667,8 @@ func (ec *executionContext) fieldContext_CryptoJournal_transactedAt(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoJournal_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoJournal_clientID(ctx, field)
+func (ec *executionContext) _CryptoAsset_minOrder(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_minOrder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -569,7 +681,7 @@ func (ec *executionContext) _CryptoJournal_clientID(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CryptoJournal().ClientID(rctx, obj)
+		return ec.resolvers.CryptoAsset().MinOrder(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -581,26 +693,26 @@ func (ec *executionContext) _CryptoJournal_clientID(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNUUID2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoJournal_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_minOrder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoJournal",
+		Object:     "CryptoAsset",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoJournal_txID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoJournal_txID(ctx, field)
+func (ec *executionContext) _CryptoAsset_maxOrder(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_maxOrder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -613,7 +725,7 @@ func (ec *executionContext) _CryptoJournal_txID(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CryptoJournal().TxID(rctx, obj)
+		return ec.resolvers.CryptoAsset().MaxOrder(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -625,26 +737,26 @@ func (ec *executionContext) _CryptoJournal_txID(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNUUID2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoJournal_txID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_maxOrder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoJournal",
+		Object:     "CryptoAsset",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoOpenAccountResponse_clientID(ctx context.Context, field graphql.CollectedField, obj *models.CryptoOpenAccountResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoOpenAccountResponse_clientID(ctx, field)
+func (ec *executionContext) _CryptoAsset_updatedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAsset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAsset_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -657,7 +769,7 @@ func (ec *executionContext) _CryptoOpenAccountResponse_clientID(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClientID, nil
+		return ec.resolvers.CryptoAsset().UpdatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -674,12 +786,12 @@ func (ec *executionContext) _CryptoOpenAccountResponse_clientID(ctx context.Cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoAsset_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoOpenAccountResponse",
+		Object:     "CryptoAsset",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -687,8 +799,8 @@ func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_clientID(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoOpenAccountResponse_ticker(ctx context.Context, field graphql.CollectedField, obj *models.CryptoOpenAccountResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoOpenAccountResponse_ticker(ctx, field)
+func (ec *executionContext) _CryptoBalanceHistory_ticker(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceHistory_ticker(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -718,9 +830,9 @@ func (ec *executionContext) _CryptoOpenAccountResponse_ticker(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoBalanceHistory_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoOpenAccountResponse",
+		Object:     "CryptoBalanceHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -731,8 +843,8 @@ func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_ticker(ctx co
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoTransactionsPaginated_transactions(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransactionsPaginated) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoTransactionsPaginated_transactions(ctx, field)
+func (ec *executionContext) _CryptoBalanceHistory_from(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceHistory_from(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -745,7 +857,7 @@ func (ec *executionContext) _CryptoTransactionsPaginated_transactions(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CryptoTransactionsPaginated().Transactions(rctx, obj)
+		return obj.From, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -757,38 +869,26 @@ func (ec *executionContext) _CryptoTransactionsPaginated_transactions(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]postgres.CryptoJournal)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournalᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoTransactionsPaginated_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoBalanceHistory_from(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoTransactionsPaginated",
+		Object:     "CryptoBalanceHistory",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ticker":
-				return ec.fieldContext_CryptoJournal_ticker(ctx, field)
-			case "amount":
-				return ec.fieldContext_CryptoJournal_amount(ctx, field)
-			case "transactedAt":
-				return ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
-			case "clientID":
-				return ec.fieldContext_CryptoJournal_clientID(ctx, field)
-			case "txID":
-				return ec.fieldContext_CryptoJournal_txID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CryptoJournal", field.Name)
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoTransactionsPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransactionsPaginated) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoTransactionsPaginated_links(ctx, field)
+func (ec *executionContext) _CryptoBalanceHistory_to(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceHistory_to(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -801,7 +901,7 @@ func (ec *executionContext) _CryptoTransactionsPaginated_links(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Links, nil
+		return obj.To, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -813,32 +913,26 @@ func (ec *executionContext) _CryptoTransactionsPaginated_links(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(models.HTTPLinks)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoTransactionsPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoBalanceHistory_to(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoTransactionsPaginated",
+		Object:     "CryptoBalanceHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nextPage":
-				return ec.fieldContext_Links_nextPage(ctx, field)
-			case "pageCursor":
-				return ec.fieldContext_Links_pageCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoTransferResponse_fiatTxReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransferResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoTransferResponse_fiatTxReceipt(ctx, field)
+func (ec *executionContext) _CryptoBalanceHistory_snapshots(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceHistory_snapshots(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -851,47 +945,50 @@ func (ec *executionContext) _CryptoTransferResponse_fiatTxReceipt(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FiatTxReceipt, nil
+		return obj.Snapshots, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*postgres.FiatJournal)
+	res := resTmp.([]postgres.CryptoBalanceSnapshot)
 	fc.Result = res
-	return ec.marshalOFiatJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatJournal(ctx, field.Selections, res)
+	return ec.marshalNCryptoBalanceSnapshot2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoBalanceSnapshotᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoTransferResponse_fiatTxReceipt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoBalanceHistory_snapshots(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoTransferResponse",
+		Object:     "CryptoBalanceHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "currency":
-				return ec.fieldContext_FiatJournal_currency(ctx, field)
-			case "amount":
-				return ec.fieldContext_FiatJournal_amount(ctx, field)
-			case "transactedAt":
-				return ec.fieldContext_FiatJournal_transactedAt(ctx, field)
 			case "clientID":
-				return ec.fieldContext_FiatJournal_clientID(ctx, field)
-			case "txID":
-				return ec.fieldContext_FiatJournal_txID(ctx, field)
+				return ec.fieldContext_CryptoBalanceSnapshot_clientID(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoBalanceSnapshot_ticker(ctx, field)
+			case "snapshotAt":
+				return ec.fieldContext_CryptoBalanceSnapshot_snapshotAt(ctx, field)
+			case "balance":
+				return ec.fieldContext_CryptoBalanceSnapshot_balance(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoBalanceSnapshot_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type FiatJournal", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CryptoBalanceSnapshot", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CryptoTransferResponse_cryptoTxReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransferResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CryptoTransferResponse_cryptoTxReceipt(ctx, field)
+func (ec *executionContext) _CryptoBalanceSnapshot_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceSnapshot_clientID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -904,207 +1001,6530 @@ func (ec *executionContext) _CryptoTransferResponse_cryptoTxReceipt(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CryptoTxReceipt, nil
+		return ec.resolvers.CryptoBalanceSnapshot().ClientID(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*postgres.CryptoJournal)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOCryptoJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx, field.Selections, res)
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CryptoTransferResponse_cryptoTxReceipt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CryptoBalanceSnapshot_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CryptoTransferResponse",
+		Object:     "CryptoBalanceSnapshot",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ticker":
-				return ec.fieldContext_CryptoJournal_ticker(ctx, field)
-			case "amount":
-				return ec.fieldContext_CryptoJournal_amount(ctx, field)
-			case "transactedAt":
-				return ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
-			case "clientID":
-				return ec.fieldContext_CryptoJournal_clientID(ctx, field)
-			case "txID":
-				return ec.fieldContext_CryptoJournal_txID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CryptoJournal", field.Name)
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputCryptoOfferRequest(ctx context.Context, obj interface{}) (models.HTTPCryptoOfferRequest, error) {
-	var it models.HTTPCryptoOfferRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
+func (ec *executionContext) _CryptoBalanceSnapshot_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceSnapshot_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoBalanceSnapshot_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoBalanceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoBalanceSnapshot_snapshotAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceSnapshot_snapshotAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoBalanceSnapshot().SnapshotAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoBalanceSnapshot_snapshotAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoBalanceSnapshot_balance(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceSnapshot_balance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoBalanceSnapshot().Balance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoBalanceSnapshot_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoBalanceSnapshot_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalanceSnapshot_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoBalanceSnapshot().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoBalanceSnapshot_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoDetailsPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalancesPaginated_accountBalances(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AccountBalances, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoAccountBalance)
+	fc.Result = res
+	return ec.marshalNCryptoAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountBalanceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoBalancesPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ticker":
+				return ec.fieldContext_CryptoAccount_ticker(ctx, field)
+			case "balance":
+				return ec.fieldContext_CryptoAccount_balance(ctx, field)
+			case "available":
+				return ec.fieldContext_CryptoAccount_available(ctx, field)
+			case "lastTx":
+				return ec.fieldContext_CryptoAccount_lastTx(ctx, field)
+			case "lastTxTs":
+				return ec.fieldContext_CryptoAccount_lastTxTs(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoAccount_createdAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoAccount_clientID(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoAccount_status(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoAccount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoBalancesPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoDetailsPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoBalancesPaginated_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoBalancesPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoBalancesPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoJournal_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoJournal_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoJournal_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoJournal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoJournal_amount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoJournal_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoJournal().Amount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoJournal_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoJournal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoJournal_transactedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoJournal().TransactedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoJournal_transactedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoJournal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoJournal_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoJournal_clientID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoJournal().ClientID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoJournal_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoJournal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoJournal_txID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoJournal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoJournal_txID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoJournal().TxID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoJournal_txID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoJournal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_orderID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_orderID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrderID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_orderID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_clientID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().ClientID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_fiatCurrency(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_fiatCurrency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().FiatCurrency(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_fiatCurrency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_isPurchase(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_isPurchase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsPurchase, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_isPurchase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_amount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().Amount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_limitPrice(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_limitPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().LimitPrice(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_limitPrice(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_txID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_txID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().TxID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_txID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_expiresAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_expiresAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().ExpiresAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_expiresAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrder_updatedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrder_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrder().UpdatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrder_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderDetails_order(ctx context.Context, field graphql.CollectedField, obj *models.HTTPLimitOrderDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderDetails_order(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Order, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(postgres.CryptoLimitOrder)
+	fc.Result = res
+	return ec.marshalNCryptoLimitOrder2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrder(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderDetails_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orderID":
+				return ec.fieldContext_CryptoLimitOrder_orderID(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoLimitOrder_clientID(ctx, field)
+			case "fiatCurrency":
+				return ec.fieldContext_CryptoLimitOrder_fiatCurrency(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoLimitOrder_ticker(ctx, field)
+			case "isPurchase":
+				return ec.fieldContext_CryptoLimitOrder_isPurchase(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoLimitOrder_amount(ctx, field)
+			case "limitPrice":
+				return ec.fieldContext_CryptoLimitOrder_limitPrice(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoLimitOrder_status(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoLimitOrder_txID(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_CryptoLimitOrder_expiresAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoLimitOrder_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CryptoLimitOrder_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoLimitOrder", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderDetails_history(ctx context.Context, field graphql.CollectedField, obj *models.HTTPLimitOrderDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderDetails_history(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.History, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoLimitOrderEvent)
+	fc.Result = res
+	return ec.marshalNCryptoLimitOrderEvent2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrderEventᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderDetails_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CryptoLimitOrderEvent_id(ctx, field)
+			case "orderID":
+				return ec.fieldContext_CryptoLimitOrderEvent_orderID(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoLimitOrderEvent_status(ctx, field)
+			case "price":
+				return ec.fieldContext_CryptoLimitOrderEvent_price(ctx, field)
+			case "details":
+				return ec.fieldContext_CryptoLimitOrderEvent_details(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoLimitOrderEvent_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoLimitOrderEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderEvent_id(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderEvent_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderEvent_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderEvent_orderID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderEvent_orderID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrderID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderEvent_orderID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderEvent_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderEvent_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrderEvent().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderEvent_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderEvent_price(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderEvent_price(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrderEvent().Price(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderEvent_price(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderEvent_details(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderEvent_details(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Details, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderEvent_details(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrderEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoLimitOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrderEvent_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoLimitOrderEvent().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrderEvent_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrdersPaginated_orders(ctx context.Context, field graphql.CollectedField, obj *models.HTTPLimitOrdersPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrdersPaginated_orders(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Orders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoLimitOrder)
+	fc.Result = res
+	return ec.marshalNCryptoLimitOrder2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrderᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrdersPaginated_orders(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrdersPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orderID":
+				return ec.fieldContext_CryptoLimitOrder_orderID(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoLimitOrder_clientID(ctx, field)
+			case "fiatCurrency":
+				return ec.fieldContext_CryptoLimitOrder_fiatCurrency(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoLimitOrder_ticker(ctx, field)
+			case "isPurchase":
+				return ec.fieldContext_CryptoLimitOrder_isPurchase(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoLimitOrder_amount(ctx, field)
+			case "limitPrice":
+				return ec.fieldContext_CryptoLimitOrder_limitPrice(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoLimitOrder_status(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoLimitOrder_txID(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_CryptoLimitOrder_expiresAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoLimitOrder_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CryptoLimitOrder_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoLimitOrder", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoLimitOrdersPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPLimitOrdersPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoLimitOrdersPaginated_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoLimitOrdersPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoLimitOrdersPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoOpenAccountResponse_clientID(ctx context.Context, field graphql.CollectedField, obj *models.CryptoOpenAccountResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoOpenAccountResponse_clientID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoOpenAccountResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoOpenAccountResponse_ticker(ctx context.Context, field graphql.CollectedField, obj *models.CryptoOpenAccountResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoOpenAccountResponse_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoOpenAccountResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_planID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_planID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PlanID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_planID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_clientID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().ClientID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_fiatCurrency(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_fiatCurrency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().FiatCurrency(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_fiatCurrency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_amount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().Amount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_cadence(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_cadence(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().Cadence(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_cadence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_startsAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_startsAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().StartsAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_startsAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_endsAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_endsAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().EndsAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_endsAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_nextRunAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_nextRunAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().NextRunAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_nextRunAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchase_updatedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchase_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchase().UpdatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchase_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchase",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseDetails_plan(ctx context.Context, field graphql.CollectedField, obj *models.HTTPRecurringPurchaseDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseDetails_plan(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Plan, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(postgres.CryptoRecurringPurchase)
+	fc.Result = res
+	return ec.marshalNCryptoRecurringPurchase2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseDetails_plan(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "planID":
+				return ec.fieldContext_CryptoRecurringPurchase_planID(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoRecurringPurchase_clientID(ctx, field)
+			case "fiatCurrency":
+				return ec.fieldContext_CryptoRecurringPurchase_fiatCurrency(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoRecurringPurchase_ticker(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoRecurringPurchase_amount(ctx, field)
+			case "cadence":
+				return ec.fieldContext_CryptoRecurringPurchase_cadence(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_CryptoRecurringPurchase_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_CryptoRecurringPurchase_endsAt(ctx, field)
+			case "nextRunAt":
+				return ec.fieldContext_CryptoRecurringPurchase_nextRunAt(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoRecurringPurchase_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoRecurringPurchase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CryptoRecurringPurchase_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoRecurringPurchase", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseDetails_runs(ctx context.Context, field graphql.CollectedField, obj *models.HTTPRecurringPurchaseDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseDetails_runs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Runs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoRecurringPurchaseRun)
+	fc.Result = res
+	return ec.marshalNCryptoRecurringPurchaseRun2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchaseRunᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseDetails_runs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_id(ctx, field)
+			case "planID":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_planID(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_scheduledAt(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_status(ctx, field)
+			case "price":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_price(ctx, field)
+			case "fiatAmount":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_fiatAmount(ctx, field)
+			case "cryptoAmount":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_cryptoAmount(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_txID(ctx, field)
+			case "details":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_details(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoRecurringPurchaseRun_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoRecurringPurchaseRun", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseDetails_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPRecurringPurchaseDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseDetails_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseDetails_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_id(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_planID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_planID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PlanID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_planID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_scheduledAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_scheduledAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().ScheduledAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_scheduledAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_price(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_price(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().Price(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_price(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_fiatAmount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_fiatAmount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().FiatAmount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_fiatAmount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_cryptoAmount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_cryptoAmount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().CryptoAmount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_cryptoAmount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_txID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_txID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().TxID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_txID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_details(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_details(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Details, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_details(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoRecurringPurchaseRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchaseRun_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoRecurringPurchaseRun().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchaseRun_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchaseRun",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchasesPaginated_plans(ctx context.Context, field graphql.CollectedField, obj *models.HTTPRecurringPurchasesPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchasesPaginated_plans(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Plans, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoRecurringPurchase)
+	fc.Result = res
+	return ec.marshalNCryptoRecurringPurchase2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchaseᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchasesPaginated_plans(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchasesPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "planID":
+				return ec.fieldContext_CryptoRecurringPurchase_planID(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoRecurringPurchase_clientID(ctx, field)
+			case "fiatCurrency":
+				return ec.fieldContext_CryptoRecurringPurchase_fiatCurrency(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoRecurringPurchase_ticker(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoRecurringPurchase_amount(ctx, field)
+			case "cadence":
+				return ec.fieldContext_CryptoRecurringPurchase_cadence(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_CryptoRecurringPurchase_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_CryptoRecurringPurchase_endsAt(ctx, field)
+			case "nextRunAt":
+				return ec.fieldContext_CryptoRecurringPurchase_nextRunAt(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoRecurringPurchase_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoRecurringPurchase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CryptoRecurringPurchase_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoRecurringPurchase", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoRecurringPurchasesPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPRecurringPurchasesPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoRecurringPurchasesPaginated_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoRecurringPurchasesPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoRecurringPurchasesPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTransactionsPaginated_transactions(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransactionsPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTransactionsPaginated_transactions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTransactionsPaginated().Transactions(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoJournal)
+	fc.Result = res
+	return ec.marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournalᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTransactionsPaginated_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTransactionsPaginated",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ticker":
+				return ec.fieldContext_CryptoJournal_ticker(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoJournal_amount(ctx, field)
+			case "transactedAt":
+				return ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoJournal_clientID(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoJournal_txID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoJournal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTransactionsPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransactionsPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTransactionsPaginated_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTransactionsPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTransactionsPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTransferResponse_fiatTxReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransferResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTransferResponse_fiatTxReceipt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FiatTxReceipt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*postgres.FiatJournal)
+	fc.Result = res
+	return ec.marshalOFiatJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatJournal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTransferResponse_fiatTxReceipt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTransferResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "currency":
+				return ec.fieldContext_FiatJournal_currency(ctx, field)
+			case "amount":
+				return ec.fieldContext_FiatJournal_amount(ctx, field)
+			case "transactedAt":
+				return ec.fieldContext_FiatJournal_transactedAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_FiatJournal_clientID(ctx, field)
+			case "txID":
+				return ec.fieldContext_FiatJournal_txID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FiatJournal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTransferResponse_cryptoTxReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransferResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTransferResponse_cryptoTxReceipt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CryptoTxReceipt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*postgres.CryptoJournal)
+	fc.Result = res
+	return ec.marshalOCryptoJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTransferResponse_cryptoTxReceipt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTransferResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ticker":
+				return ec.fieldContext_CryptoJournal_ticker(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoJournal_amount(ctx, field)
+			case "transactedAt":
+				return ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoJournal_clientID(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoJournal_txID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoJournal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_orderID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_orderID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrderID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_orderID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_clientID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().ClientID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_fiatCurrency(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_fiatCurrency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().FiatCurrency(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_fiatCurrency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_ticker(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_ticker(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ticker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_ticker(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_triggerType(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_triggerType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().TriggerType(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_triggerType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_triggerPrice(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_triggerPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().TriggerPrice(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_triggerPrice(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_trailingDistance(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_trailingDistance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().TrailingDistance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_trailingDistance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_amount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().Amount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_txID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_txID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().TxID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_txID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrder_updatedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrder_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrder().UpdatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrder_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrder",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderDetails_order(ctx context.Context, field graphql.CollectedField, obj *models.HTTPTriggerOrderDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderDetails_order(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Order, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(postgres.CryptoTriggerOrder)
+	fc.Result = res
+	return ec.marshalNCryptoTriggerOrder2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrder(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderDetails_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orderID":
+				return ec.fieldContext_CryptoTriggerOrder_orderID(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoTriggerOrder_clientID(ctx, field)
+			case "fiatCurrency":
+				return ec.fieldContext_CryptoTriggerOrder_fiatCurrency(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoTriggerOrder_ticker(ctx, field)
+			case "triggerType":
+				return ec.fieldContext_CryptoTriggerOrder_triggerType(ctx, field)
+			case "triggerPrice":
+				return ec.fieldContext_CryptoTriggerOrder_triggerPrice(ctx, field)
+			case "trailingDistance":
+				return ec.fieldContext_CryptoTriggerOrder_trailingDistance(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoTriggerOrder_amount(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoTriggerOrder_status(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoTriggerOrder_txID(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoTriggerOrder_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CryptoTriggerOrder_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoTriggerOrder", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderDetails_history(ctx context.Context, field graphql.CollectedField, obj *models.HTTPTriggerOrderDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderDetails_history(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.History, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoTriggerOrderEvent)
+	fc.Result = res
+	return ec.marshalNCryptoTriggerOrderEvent2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrderEventᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderDetails_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CryptoTriggerOrderEvent_id(ctx, field)
+			case "orderID":
+				return ec.fieldContext_CryptoTriggerOrderEvent_orderID(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoTriggerOrderEvent_status(ctx, field)
+			case "price":
+				return ec.fieldContext_CryptoTriggerOrderEvent_price(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoTriggerOrderEvent_amount(ctx, field)
+			case "details":
+				return ec.fieldContext_CryptoTriggerOrderEvent_details(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoTriggerOrderEvent_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoTriggerOrderEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_id(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_orderID(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_orderID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrderID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_orderID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrderEvent().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_price(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_price(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrderEvent().Price(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_price(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_amount(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrderEvent().Amount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_details(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_details(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Details, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_details(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrderEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoTriggerOrderEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrderEvent_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoTriggerOrderEvent().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrderEvent_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrderEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrdersPaginated_orders(ctx context.Context, field graphql.CollectedField, obj *models.HTTPTriggerOrdersPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrdersPaginated_orders(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Orders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.CryptoTriggerOrder)
+	fc.Result = res
+	return ec.marshalNCryptoTriggerOrder2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrderᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrdersPaginated_orders(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrdersPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orderID":
+				return ec.fieldContext_CryptoTriggerOrder_orderID(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoTriggerOrder_clientID(ctx, field)
+			case "fiatCurrency":
+				return ec.fieldContext_CryptoTriggerOrder_fiatCurrency(ctx, field)
+			case "ticker":
+				return ec.fieldContext_CryptoTriggerOrder_ticker(ctx, field)
+			case "triggerType":
+				return ec.fieldContext_CryptoTriggerOrder_triggerType(ctx, field)
+			case "triggerPrice":
+				return ec.fieldContext_CryptoTriggerOrder_triggerPrice(ctx, field)
+			case "trailingDistance":
+				return ec.fieldContext_CryptoTriggerOrder_trailingDistance(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoTriggerOrder_amount(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoTriggerOrder_status(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoTriggerOrder_txID(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CryptoTriggerOrder_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CryptoTriggerOrder_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoTriggerOrder", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoTriggerOrdersPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPTriggerOrdersPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoTriggerOrdersPaginated_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoTriggerOrdersPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoTriggerOrdersPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputCryptoCloseAccountRequest(ctx context.Context, obj interface{}) (models.HTTPCloseCryptoAccountRequest, error) {
+	var it models.HTTPCloseCryptoAccountRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ticker", "sweepCurrency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ticker":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ticker"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ticker = data
+		case "sweepCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sweepCurrency"))
+			data, err := ec.unmarshalOString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SweepCurrency = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCryptoLimitOrderRequest(ctx context.Context, obj interface{}) (models.HTTPLimitOrderRequest, error) {
+	var it models.HTTPLimitOrderRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"fiatCurrency", "ticker", "amount", "limitPrice", "expiresAt", "isPurchase"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "fiatCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiatCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiatCurrency = data
+		case "ticker":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ticker"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ticker = data
+		case "amount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoLimitOrderRequest().Amount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "limitPrice":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limitPrice"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoLimitOrderRequest().LimitPrice(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "expiresAt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expiresAt"))
+			data, err := ec.unmarshalNInt642int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpiresAt = data
+		case "isPurchase":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isPurchase"))
+			data, err := ec.unmarshalNBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsPurchase = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCryptoOfferRequest(ctx context.Context, obj interface{}) (models.HTTPCryptoOfferRequest, error) {
+	var it models.HTTPCryptoOfferRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sourceCurrency", "destinationCurrency", "sourceAmount", "isPurchase", "hold"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sourceCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SourceCurrency = data
+		case "destinationCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destinationCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DestinationCurrency = data
+		case "sourceAmount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceAmount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoOfferRequest().SourceAmount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "isPurchase":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isPurchase"))
+			data, err := ec.unmarshalNBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsPurchase = data
+		case "hold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hold"))
+			data, err := ec.unmarshalOBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hold = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCryptoPaginatedTxDetailsRequest(ctx context.Context, obj interface{}) (models.CryptoPaginatedTxDetailsRequest, error) {
+	var it models.CryptoPaginatedTxDetailsRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ticker", "pageSize", "pageCursor", "timezone", "month", "year"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ticker":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ticker"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ticker = data
+		case "pageSize":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageSize"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PageSize = data
+		case "pageCursor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageCursor"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PageCursor = data
+		case "timezone":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Timezone = data
+		case "month":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("month"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Month = data
+		case "year":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("year"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Year = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCryptoRecurringPurchaseRequest(ctx context.Context, obj interface{}) (models.HTTPRecurringPurchaseRequest, error) {
+	var it models.HTTPRecurringPurchaseRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"fiatCurrency", "ticker", "amount", "cadence", "startsAt", "endsAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "fiatCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiatCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiatCurrency = data
+		case "ticker":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ticker"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ticker = data
+		case "amount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoRecurringPurchaseRequest().Amount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "cadence":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cadence"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Cadence = data
+		case "startsAt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startsAt"))
+			data, err := ec.unmarshalOInt642int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartsAt = data
+		case "endsAt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endsAt"))
+			data, err := ec.unmarshalOInt642int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EndsAt = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCryptoTriggerOrderRequest(ctx context.Context, obj interface{}) (models.HTTPTriggerOrderRequest, error) {
+	var it models.HTTPTriggerOrderRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sourceCurrency", "destinationCurrency", "sourceAmount", "isPurchase"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+	fieldsInOrder := [...]string{"fiatCurrency", "ticker", "triggerType", "triggerPrice", "trailingDistance", "amount"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "fiatCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiatCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiatCurrency = data
+		case "ticker":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ticker"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ticker = data
+		case "triggerType":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("triggerType"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TriggerType = data
+		case "triggerPrice":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("triggerPrice"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoTriggerOrderRequest().TriggerPrice(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "trailingDistance":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trailingDistance"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoTriggerOrderRequest().TrailingDistance(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "amount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoTriggerOrderRequest().Amount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var cryptoAccountImplementors = []string{"CryptoAccount"}
+
+func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoAccountBalance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoAccountImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoAccount")
+		case "ticker":
+
+			out.Values[i] = ec._CryptoAccount_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "balance":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_balance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "available":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_available(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "lastTx":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_lastTx(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "lastTxTs":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_lastTxTs(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoAssetImplementors = []string{"CryptoAsset"}
+
+func (ec *executionContext) _CryptoAsset(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoAsset) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoAssetImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoAsset")
+		case "ticker":
+
+			out.Values[i] = ec._CryptoAsset_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+
+			out.Values[i] = ec._CryptoAsset_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "decimalPlaces":
+
+			out.Values[i] = ec._CryptoAsset_decimalPlaces(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAsset_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "minOrder":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAsset_minOrder(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "maxOrder":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAsset_maxOrder(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "updatedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAsset_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoBalanceHistoryImplementors = []string{"CryptoBalanceHistory"}
+
+func (ec *executionContext) _CryptoBalanceHistory(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoBalanceHistory) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoBalanceHistoryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoBalanceHistory")
+		case "ticker":
+
+			out.Values[i] = ec._CryptoBalanceHistory_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "from":
+
+			out.Values[i] = ec._CryptoBalanceHistory_from(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "to":
+
+			out.Values[i] = ec._CryptoBalanceHistory_to(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "snapshots":
+
+			out.Values[i] = ec._CryptoBalanceHistory_snapshots(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoBalanceSnapshotImplementors = []string{"CryptoBalanceSnapshot"}
+
+func (ec *executionContext) _CryptoBalanceSnapshot(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoBalanceSnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoBalanceSnapshotImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoBalanceSnapshot")
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoBalanceSnapshot_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "ticker":
+
+			out.Values[i] = ec._CryptoBalanceSnapshot_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "snapshotAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoBalanceSnapshot_snapshotAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "balance":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoBalanceSnapshot_balance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoBalanceSnapshot_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoBalancesPaginatedImplementors = []string{"CryptoBalancesPaginated"}
+
+func (ec *executionContext) _CryptoBalancesPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoDetailsPaginated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoBalancesPaginatedImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoBalancesPaginated")
+		case "accountBalances":
+
+			out.Values[i] = ec._CryptoBalancesPaginated_accountBalances(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "links":
+
+			out.Values[i] = ec._CryptoBalancesPaginated_links(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoJournalImplementors = []string{"CryptoJournal"}
+
+func (ec *executionContext) _CryptoJournal(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoJournal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoJournalImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoJournal")
+		case "ticker":
+
+			out.Values[i] = ec._CryptoJournal_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "amount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoJournal_amount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "transactedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoJournal_transactedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoJournal_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "txID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoJournal_txID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoLimitOrderImplementors = []string{"CryptoLimitOrder"}
+
+func (ec *executionContext) _CryptoLimitOrder(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoLimitOrder) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoLimitOrderImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoLimitOrder")
+		case "orderID":
+
+			out.Values[i] = ec._CryptoLimitOrder_orderID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "fiatCurrency":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_fiatCurrency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "ticker":
+
+			out.Values[i] = ec._CryptoLimitOrder_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isPurchase":
+
+			out.Values[i] = ec._CryptoLimitOrder_isPurchase(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "amount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_amount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "limitPrice":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_limitPrice(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "txID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_txID(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "expiresAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_expiresAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "updatedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrder_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoLimitOrderDetailsImplementors = []string{"CryptoLimitOrderDetails"}
+
+func (ec *executionContext) _CryptoLimitOrderDetails(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPLimitOrderDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoLimitOrderDetailsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoLimitOrderDetails")
+		case "order":
+
+			out.Values[i] = ec._CryptoLimitOrderDetails_order(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "history":
+
+			out.Values[i] = ec._CryptoLimitOrderDetails_history(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoLimitOrderEventImplementors = []string{"CryptoLimitOrderEvent"}
+
+func (ec *executionContext) _CryptoLimitOrderEvent(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoLimitOrderEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoLimitOrderEventImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoLimitOrderEvent")
+		case "id":
+
+			out.Values[i] = ec._CryptoLimitOrderEvent_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "orderID":
+
+			out.Values[i] = ec._CryptoLimitOrderEvent_orderID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrderEvent_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "price":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrderEvent_price(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "details":
+
+			out.Values[i] = ec._CryptoLimitOrderEvent_details(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoLimitOrderEvent_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoLimitOrdersPaginatedImplementors = []string{"CryptoLimitOrdersPaginated"}
+
+func (ec *executionContext) _CryptoLimitOrdersPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPLimitOrdersPaginated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoLimitOrdersPaginatedImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoLimitOrdersPaginated")
+		case "orders":
+
+			out.Values[i] = ec._CryptoLimitOrdersPaginated_orders(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "links":
+
+			out.Values[i] = ec._CryptoLimitOrdersPaginated_links(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoOpenAccountResponseImplementors = []string{"CryptoOpenAccountResponse"}
+
+func (ec *executionContext) _CryptoOpenAccountResponse(ctx context.Context, sel ast.SelectionSet, obj *models.CryptoOpenAccountResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoOpenAccountResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoOpenAccountResponse")
+		case "clientID":
+
+			out.Values[i] = ec._CryptoOpenAccountResponse_clientID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "ticker":
+
+			out.Values[i] = ec._CryptoOpenAccountResponse_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoRecurringPurchaseImplementors = []string{"CryptoRecurringPurchase"}
+
+func (ec *executionContext) _CryptoRecurringPurchase(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoRecurringPurchase) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoRecurringPurchaseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoRecurringPurchase")
+		case "planID":
+
+			out.Values[i] = ec._CryptoRecurringPurchase_planID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "fiatCurrency":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_fiatCurrency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "ticker":
+
+			out.Values[i] = ec._CryptoRecurringPurchase_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "amount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_amount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "cadence":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_cadence(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "startsAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_startsAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "endsAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_endsAt(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "nextRunAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_nextRunAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "updatedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchase_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoRecurringPurchaseDetailsImplementors = []string{"CryptoRecurringPurchaseDetails"}
+
+func (ec *executionContext) _CryptoRecurringPurchaseDetails(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPRecurringPurchaseDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoRecurringPurchaseDetailsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoRecurringPurchaseDetails")
+		case "plan":
+
+			out.Values[i] = ec._CryptoRecurringPurchaseDetails_plan(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "runs":
+
+			out.Values[i] = ec._CryptoRecurringPurchaseDetails_runs(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "links":
+
+			out.Values[i] = ec._CryptoRecurringPurchaseDetails_links(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoRecurringPurchaseRunImplementors = []string{"CryptoRecurringPurchaseRun"}
+
+func (ec *executionContext) _CryptoRecurringPurchaseRun(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoRecurringPurchaseRun) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoRecurringPurchaseRunImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoRecurringPurchaseRun")
+		case "id":
+
+			out.Values[i] = ec._CryptoRecurringPurchaseRun_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "planID":
+
+			out.Values[i] = ec._CryptoRecurringPurchaseRun_planID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "scheduledAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_scheduledAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "price":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_price(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "fiatAmount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_fiatAmount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "cryptoAmount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_cryptoAmount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "txID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_txID(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "details":
+
+			out.Values[i] = ec._CryptoRecurringPurchaseRun_details(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoRecurringPurchaseRun_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoRecurringPurchasesPaginatedImplementors = []string{"CryptoRecurringPurchasesPaginated"}
+
+func (ec *executionContext) _CryptoRecurringPurchasesPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPRecurringPurchasesPaginated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoRecurringPurchasesPaginatedImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoRecurringPurchasesPaginated")
+		case "plans":
+
+			out.Values[i] = ec._CryptoRecurringPurchasesPaginated_plans(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "links":
+
+			out.Values[i] = ec._CryptoRecurringPurchasesPaginated_links(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		switch k {
-		case "sourceCurrency":
-			var err error
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoTransactionsPaginatedImplementors = []string{"CryptoTransactionsPaginated"}
+
+func (ec *executionContext) _CryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTransactionsPaginatedImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoTransactionsPaginated")
+		case "transactions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTransactionsPaginated_transactions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "links":
+
+			out.Values[i] = ec._CryptoTransactionsPaginated_links(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoTransferResponseImplementors = []string{"CryptoTransferResponse"}
+
+func (ec *executionContext) _CryptoTransferResponse(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoTransferResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTransferResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoTransferResponse")
+		case "fiatTxReceipt":
+
+			out.Values[i] = ec._CryptoTransferResponse_fiatTxReceipt(ctx, field, obj)
+
+		case "cryptoTxReceipt":
+
+			out.Values[i] = ec._CryptoTransferResponse_cryptoTxReceipt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoTriggerOrderImplementors = []string{"CryptoTriggerOrder"}
+
+func (ec *executionContext) _CryptoTriggerOrder(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoTriggerOrder) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTriggerOrderImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoTriggerOrder")
+		case "orderID":
+
+			out.Values[i] = ec._CryptoTriggerOrder_orderID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceCurrency"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "fiatCurrency":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_fiatCurrency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-			it.SourceCurrency = data
-		case "destinationCurrency":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destinationCurrency"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "ticker":
+
+			out.Values[i] = ec._CryptoTriggerOrder_ticker(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-			it.DestinationCurrency = data
-		case "sourceAmount":
-			var err error
+		case "triggerType":
+			field := field
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceAmount"))
-			data, err := ec.unmarshalNFloat2float64(ctx, v)
-			if err != nil {
-				return it, err
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_triggerType(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-			if err = ec.resolvers.CryptoOfferRequest().SourceAmount(ctx, &it, data); err != nil {
-				return it, err
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "triggerPrice":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_triggerPrice(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "isPurchase":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isPurchase"))
-			data, err := ec.unmarshalNBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "trailingDistance":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_trailingDistance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-			it.IsPurchase = data
-		}
-	}
 
-	return it, nil
-}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-func (ec *executionContext) unmarshalInputCryptoPaginatedTxDetailsRequest(ctx context.Context, obj interface{}) (models.CryptoPaginatedTxDetailsRequest, error) {
-	var it models.CryptoPaginatedTxDetailsRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+			})
+		case "amount":
+			field := field
 
-	fieldsInOrder := [...]string{"ticker", "pageSize", "pageCursor", "timezone", "month", "year"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "ticker":
-			var err error
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_amount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ticker"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-			it.Ticker = data
-		case "pageSize":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageSize"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "txID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_txID(ctx, field, obj)
+				return res
 			}
-			it.PageSize = data
-		case "pageCursor":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageCursor"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-			it.PageCursor = data
-		case "timezone":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "updatedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoTriggerOrder_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-			it.Timezone = data
-		case "month":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("month"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cryptoTriggerOrderDetailsImplementors = []string{"CryptoTriggerOrderDetails"}
+
+func (ec *executionContext) _CryptoTriggerOrderDetails(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPTriggerOrderDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTriggerOrderDetailsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoTriggerOrderDetails")
+		case "order":
+
+			out.Values[i] = ec._CryptoTriggerOrderDetails_order(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			it.Month = data
-		case "year":
-			var err error
+		case "history":
+
+			out.Values[i] = ec._CryptoTriggerOrderDetails_history(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("year"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			it.Year = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
-
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var cryptoAccountImplementors = []string{"CryptoAccount"}
+var cryptoTriggerOrderEventImplementors = []string{"CryptoTriggerOrderEvent"}
 
-func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoAccount) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoAccountImplementors)
+func (ec *executionContext) _CryptoTriggerOrderEvent(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoTriggerOrderEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTriggerOrderEventImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CryptoAccount")
-		case "ticker":
+			out.Values[i] = graphql.MarshalString("CryptoTriggerOrderEvent")
+		case "id":
 
-			out.Values[i] = ec._CryptoAccount_ticker(ctx, field, obj)
+			out.Values[i] = ec._CryptoTriggerOrderEvent_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "balance":
+		case "orderID":
+
+			out.Values[i] = ec._CryptoTriggerOrderEvent_orderID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "status":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1113,7 +7533,7 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._CryptoAccount_balance(ctx, field, obj)
+				res = ec._CryptoTriggerOrderEvent_status(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1124,7 +7544,7 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 				return innerFunc(ctx)
 
 			})
-		case "lastTx":
+		case "price":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1133,7 +7553,7 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._CryptoAccount_lastTx(ctx, field, obj)
+				res = ec._CryptoTriggerOrderEvent_price(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1144,7 +7564,7 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 				return innerFunc(ctx)
 
 			})
-		case "lastTxTs":
+		case "amount":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1153,7 +7573,7 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._CryptoAccount_lastTxTs(ctx, field, obj)
+				res = ec._CryptoTriggerOrderEvent_amount(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1164,6 +7584,13 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 				return innerFunc(ctx)
 
 			})
+		case "details":
+
+			out.Values[i] = ec._CryptoTriggerOrderEvent_details(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "createdAt":
 			field := field
 
@@ -1173,7 +7600,7 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._CryptoAccount_createdAt(ctx, field, obj)
+				res = ec._CryptoTriggerOrderEvent_createdAt(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1184,301 +7611,536 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 				return innerFunc(ctx)
 
 			})
-		case "clientID":
-			field := field
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CryptoAccount_clientID(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
+var cryptoTriggerOrdersPaginatedImplementors = []string{"CryptoTriggerOrdersPaginated"}
+
+func (ec *executionContext) _CryptoTriggerOrdersPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPTriggerOrdersPaginated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTriggerOrdersPaginatedImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoTriggerOrdersPaginated")
+		case "orders":
+
+			out.Values[i] = ec._CryptoTriggerOrdersPaginated_orders(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "links":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			out.Values[i] = ec._CryptoTriggerOrdersPaginated_links(ctx, field, obj)
 
-			})
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNCryptoAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountBalance(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoAccountBalance) graphql.Marshaler {
+	return ec._CryptoAccount(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountBalanceᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoAccountBalance) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCryptoAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountBalance(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCryptoAccount2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountBalance(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoAccountBalance) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoAccount(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCryptoAsset2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAsset(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoAsset) graphql.Marshaler {
+	return ec._CryptoAsset(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoAsset2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAssetᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoAsset) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCryptoAsset2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAsset(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCryptoBalanceHistory2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoBalanceHistory(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoBalanceHistory) graphql.Marshaler {
+	return ec._CryptoBalanceHistory(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoBalanceHistory2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoBalanceHistory(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoBalanceHistory) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoBalanceHistory(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCryptoBalanceSnapshot2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoBalanceSnapshot(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoBalanceSnapshot) graphql.Marshaler {
+	return ec._CryptoBalanceSnapshot(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoBalanceSnapshot2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoBalanceSnapshotᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoBalanceSnapshot) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCryptoBalanceSnapshot2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoBalanceSnapshot(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCryptoBalancesPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoDetailsPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoDetailsPaginated) graphql.Marshaler {
+	return ec._CryptoBalancesPaginated(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoBalancesPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoDetailsPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoDetailsPaginated) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._CryptoBalancesPaginated(ctx, sel, v)
 }
 
-var cryptoBalancesPaginatedImplementors = []string{"CryptoBalancesPaginated"}
-
-func (ec *executionContext) _CryptoBalancesPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoDetailsPaginated) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoBalancesPaginatedImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CryptoBalancesPaginated")
-		case "accountBalances":
+func (ec *executionContext) unmarshalNCryptoCloseAccountRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCloseCryptoAccountRequest(ctx context.Context, v interface{}) (models.HTTPCloseCryptoAccountRequest, error) {
+	res, err := ec.unmarshalInputCryptoCloseAccountRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec._CryptoBalancesPaginated_accountBalances(ctx, field, obj)
+func (ec *executionContext) marshalNCryptoJournal2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoJournal) graphql.Marshaler {
+	return ec._CryptoJournal(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournalᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoJournal) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "links":
+			ret[i] = ec.marshalNCryptoJournal2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec._CryptoBalancesPaginated_links(ctx, field, obj)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var cryptoJournalImplementors = []string{"CryptoJournal"}
-
-func (ec *executionContext) _CryptoJournal(ctx context.Context, sel ast.SelectionSet, obj *postgres.CryptoJournal) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoJournalImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CryptoJournal")
-		case "ticker":
 
-			out.Values[i] = ec._CryptoJournal_ticker(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "amount":
-			field := field
+func (ec *executionContext) marshalNCryptoLimitOrder2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrder(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoLimitOrder) graphql.Marshaler {
+	return ec._CryptoLimitOrder(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CryptoJournal_amount(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNCryptoLimitOrder2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoLimitOrder) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNCryptoLimitOrder2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrder(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+	}
+	wg.Wait()
 
-			})
-		case "transactedAt":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CryptoJournal_transactedAt(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+	return ret
+}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+func (ec *executionContext) marshalNCryptoLimitOrder2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrder(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoLimitOrder) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoLimitOrder(ctx, sel, v)
+}
 
-			})
-		case "clientID":
-			field := field
+func (ec *executionContext) marshalNCryptoLimitOrderDetails2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLimitOrderDetails(ctx context.Context, sel ast.SelectionSet, v models.HTTPLimitOrderDetails) graphql.Marshaler {
+	return ec._CryptoLimitOrderDetails(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CryptoJournal_clientID(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNCryptoLimitOrderDetails2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLimitOrderDetails(ctx context.Context, sel ast.SelectionSet, v *models.HTTPLimitOrderDetails) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoLimitOrderDetails(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCryptoLimitOrderEvent2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrderEvent(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoLimitOrderEvent) graphql.Marshaler {
+	return ec._CryptoLimitOrderEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoLimitOrderEvent2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrderEventᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoLimitOrderEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNCryptoLimitOrderEvent2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoLimitOrderEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+	}
+	wg.Wait()
 
-			})
-		case "txID":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CryptoJournal_txID(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+	return ret
+}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+func (ec *executionContext) unmarshalNCryptoLimitOrderRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLimitOrderRequest(ctx context.Context, v interface{}) (models.HTTPLimitOrderRequest, error) {
+	res, err := ec.unmarshalInputCryptoLimitOrderRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCryptoLimitOrdersPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLimitOrdersPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPLimitOrdersPaginated) graphql.Marshaler {
+	return ec._CryptoLimitOrdersPaginated(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoLimitOrdersPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLimitOrdersPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPLimitOrdersPaginated) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._CryptoLimitOrdersPaginated(ctx, sel, v)
 }
 
-var cryptoOpenAccountResponseImplementors = []string{"CryptoOpenAccountResponse"}
-
-func (ec *executionContext) _CryptoOpenAccountResponse(ctx context.Context, sel ast.SelectionSet, obj *models.CryptoOpenAccountResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoOpenAccountResponseImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CryptoOpenAccountResponse")
-		case "clientID":
-
-			out.Values[i] = ec._CryptoOpenAccountResponse_clientID(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "ticker":
+func (ec *executionContext) unmarshalNCryptoOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoOfferRequest(ctx context.Context, v interface{}) (models.HTTPCryptoOfferRequest, error) {
+	res, err := ec.unmarshalInputCryptoOfferRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec._CryptoOpenAccountResponse_ticker(ctx, field, obj)
+func (ec *executionContext) marshalNCryptoOpenAccountResponse2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoOpenAccountResponse(ctx context.Context, sel ast.SelectionSet, v models.CryptoOpenAccountResponse) graphql.Marshaler {
+	return ec._CryptoOpenAccountResponse(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCryptoOpenAccountResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoOpenAccountResponse(ctx context.Context, sel ast.SelectionSet, v *models.CryptoOpenAccountResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._CryptoOpenAccountResponse(ctx, sel, v)
 }
 
-var cryptoTransactionsPaginatedImplementors = []string{"CryptoTransactionsPaginated"}
+func (ec *executionContext) unmarshalNCryptoPaginatedTxDetailsRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoPaginatedTxDetailsRequest(ctx context.Context, v interface{}) (models.CryptoPaginatedTxDetailsRequest, error) {
+	res, err := ec.unmarshalInputCryptoPaginatedTxDetailsRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _CryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTransactionsPaginatedImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CryptoTransactionsPaginated")
-		case "transactions":
-			field := field
+func (ec *executionContext) marshalNCryptoRecurringPurchase2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchase(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoRecurringPurchase) graphql.Marshaler {
+	return ec._CryptoRecurringPurchase(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CryptoTransactionsPaginated_transactions(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNCryptoRecurringPurchase2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchaseᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoRecurringPurchase) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNCryptoRecurringPurchase2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchase(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+	}
+	wg.Wait()
 
-			})
-		case "links":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._CryptoTransactionsPaginated_links(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCryptoRecurringPurchase2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchase(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoRecurringPurchase) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._CryptoRecurringPurchase(ctx, sel, v)
 }
 
-var cryptoTransferResponseImplementors = []string{"CryptoTransferResponse"}
-
-func (ec *executionContext) _CryptoTransferResponse(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoTransferResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoTransferResponseImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CryptoTransferResponse")
-		case "fiatTxReceipt":
-
-			out.Values[i] = ec._CryptoTransferResponse_fiatTxReceipt(ctx, field, obj)
-
-		case "cryptoTxReceipt":
-
-			out.Values[i] = ec._CryptoTransferResponse_cryptoTxReceipt(ctx, field, obj)
+func (ec *executionContext) marshalNCryptoRecurringPurchaseDetails2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPRecurringPurchaseDetails(ctx context.Context, sel ast.SelectionSet, v models.HTTPRecurringPurchaseDetails) graphql.Marshaler {
+	return ec._CryptoRecurringPurchaseDetails(ctx, sel, &v)
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCryptoRecurringPurchaseDetails2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPRecurringPurchaseDetails(ctx context.Context, sel ast.SelectionSet, v *models.HTTPRecurringPurchaseDetails) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._CryptoRecurringPurchaseDetails(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) unmarshalNCryptoRecurringPurchaseRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPRecurringPurchaseRequest(ctx context.Context, v interface{}) (models.HTTPRecurringPurchaseRequest, error) {
+	res, err := ec.unmarshalInputCryptoRecurringPurchaseRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) marshalNCryptoAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccount(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoAccount) graphql.Marshaler {
-	return ec._CryptoAccount(ctx, sel, &v)
+func (ec *executionContext) marshalNCryptoRecurringPurchaseRun2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchaseRun(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoRecurringPurchaseRun) graphql.Marshaler {
+	return ec._CryptoRecurringPurchaseRun(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCryptoAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccountᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoAccount) graphql.Marshaler {
+func (ec *executionContext) marshalNCryptoRecurringPurchaseRun2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchaseRunᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoRecurringPurchaseRun) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -1502,7 +8164,7 @@ func (ec *executionContext) marshalNCryptoAccount2ᚕgithubᚗcomᚋsurahmanᚋF
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCryptoAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccount(ctx, sel, v[i])
+			ret[i] = ec.marshalNCryptoRecurringPurchaseRun2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoRecurringPurchaseRun(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -1522,35 +8184,53 @@ func (ec *executionContext) marshalNCryptoAccount2ᚕgithubᚗcomᚋsurahmanᚋF
 	return ret
 }
 
-func (ec *executionContext) marshalNCryptoAccount2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoAccount(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoAccount) graphql.Marshaler {
+func (ec *executionContext) marshalNCryptoRecurringPurchasesPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPRecurringPurchasesPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPRecurringPurchasesPaginated) graphql.Marshaler {
+	return ec._CryptoRecurringPurchasesPaginated(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoRecurringPurchasesPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPRecurringPurchasesPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPRecurringPurchasesPaginated) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CryptoAccount(ctx, sel, v)
+	return ec._CryptoRecurringPurchasesPaginated(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCryptoBalancesPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoDetailsPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoDetailsPaginated) graphql.Marshaler {
-	return ec._CryptoBalancesPaginated(ctx, sel, &v)
+func (ec *executionContext) marshalNCryptoTransactionsPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
+	return ec._CryptoTransactionsPaginated(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCryptoBalancesPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoDetailsPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoDetailsPaginated) graphql.Marshaler {
+func (ec *executionContext) marshalNCryptoTransactionsPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CryptoBalancesPaginated(ctx, sel, v)
+	return ec._CryptoTransactionsPaginated(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCryptoJournal2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoJournal) graphql.Marshaler {
-	return ec._CryptoJournal(ctx, sel, &v)
+func (ec *executionContext) marshalNCryptoTransferResponse2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransferResponse(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoTransferResponse) graphql.Marshaler {
+	return ec._CryptoTransferResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournalᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoJournal) graphql.Marshaler {
+func (ec *executionContext) marshalNCryptoTransferResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransferResponse(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoTransferResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoTransferResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCryptoTriggerOrder2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrder(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoTriggerOrder) graphql.Marshaler {
+	return ec._CryptoTriggerOrder(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoTriggerOrder2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoTriggerOrder) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -1574,7 +8254,7 @@ func (ec *executionContext) marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋF
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCryptoJournal2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx, sel, v[i])
+			ret[i] = ec.marshalNCryptoTriggerOrder2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrder(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -1594,56 +8274,95 @@ func (ec *executionContext) marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋF
 	return ret
 }
 
-func (ec *executionContext) unmarshalNCryptoOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoOfferRequest(ctx context.Context, v interface{}) (models.HTTPCryptoOfferRequest, error) {
-	res, err := ec.unmarshalInputCryptoOfferRequest(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNCryptoTriggerOrder2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrder(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoTriggerOrder) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoTriggerOrder(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCryptoOpenAccountResponse2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoOpenAccountResponse(ctx context.Context, sel ast.SelectionSet, v models.CryptoOpenAccountResponse) graphql.Marshaler {
-	return ec._CryptoOpenAccountResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNCryptoTriggerOrderDetails2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPTriggerOrderDetails(ctx context.Context, sel ast.SelectionSet, v models.HTTPTriggerOrderDetails) graphql.Marshaler {
+	return ec._CryptoTriggerOrderDetails(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCryptoOpenAccountResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoOpenAccountResponse(ctx context.Context, sel ast.SelectionSet, v *models.CryptoOpenAccountResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNCryptoTriggerOrderDetails2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPTriggerOrderDetails(ctx context.Context, sel ast.SelectionSet, v *models.HTTPTriggerOrderDetails) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CryptoOpenAccountResponse(ctx, sel, v)
+	return ec._CryptoTriggerOrderDetails(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCryptoPaginatedTxDetailsRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoPaginatedTxDetailsRequest(ctx context.Context, v interface{}) (models.CryptoPaginatedTxDetailsRequest, error) {
-	res, err := ec.unmarshalInputCryptoPaginatedTxDetailsRequest(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNCryptoTriggerOrderEvent2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrderEvent(ctx context.Context, sel ast.SelectionSet, v postgres.CryptoTriggerOrderEvent) graphql.Marshaler {
+	return ec._CryptoTriggerOrderEvent(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCryptoTransactionsPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
-	return ec._CryptoTransactionsPaginated(ctx, sel, &v)
-}
+func (ec *executionContext) marshalNCryptoTriggerOrderEvent2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrderEventᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.CryptoTriggerOrderEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCryptoTriggerOrderEvent2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoTriggerOrderEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNCryptoTransactionsPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._CryptoTransactionsPaginated(ctx, sel, v)
+
+	return ret
 }
 
-func (ec *executionContext) marshalNCryptoTransferResponse2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransferResponse(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoTransferResponse) graphql.Marshaler {
-	return ec._CryptoTransferResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalNCryptoTriggerOrderRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPTriggerOrderRequest(ctx context.Context, v interface{}) (models.HTTPTriggerOrderRequest, error) {
+	res, err := ec.unmarshalInputCryptoTriggerOrderRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCryptoTransferResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransferResponse(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoTransferResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNCryptoTriggerOrdersPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPTriggerOrdersPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPTriggerOrdersPaginated) graphql.Marshaler {
+	return ec._CryptoTriggerOrdersPaginated(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoTriggerOrdersPaginated2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPTriggerOrdersPaginated(ctx context.Context, sel ast.SelectionSet, v *models.HTTPTriggerOrdersPaginated) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CryptoTransferResponse(ctx, sel, v)
+	return ec._CryptoTriggerOrdersPaginated(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalOCryptoJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoJournal) graphql.Marshaler {
@@ -19,12 +19,29 @@ import (
 // region    ************************** generated!.gotpl **************************
 
 type FiatAccountResolver interface {
-	Currency(ctx context.Context, obj *postgres.FiatAccount) (string, error)
-	Balance(ctx context.Context, obj *postgres.FiatAccount) (float64, error)
-	LastTx(ctx context.Context, obj *postgres.FiatAccount) (float64, error)
-	LastTxTs(ctx context.Context, obj *postgres.FiatAccount) (string, error)
-	CreatedAt(ctx context.Context, obj *postgres.FiatAccount) (string, error)
-	ClientID(ctx context.Context, obj *postgres.FiatAccount) (string, error)
+	Currency(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error)
+	Balance(ctx context.Context, obj *postgres.FiatAccountBalance) (float64, error)
+	Available(ctx context.Context, obj *postgres.FiatAccountBalance) (float64, error)
+	LastTx(ctx context.Context, obj *postgres.FiatAccountBalance) (float64, error)
+	LastTxTs(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error)
+	CreatedAt(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error)
+	ClientID(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error)
+	Status(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error)
+}
+type FiatBalanceSnapshotResolver interface {
+	ClientID(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error)
+	Currency(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error)
+	SnapshotAt(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error)
+	Balance(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (float64, error)
+	CreatedAt(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error)
+}
+type FiatCloseAccountResponseResolver interface {
+	SourceReceipt(ctx context.Context, obj *models.HTTPFiatTransferResponse) (*postgres.FiatAccountTransferResult, error)
+	DestinationReceipt(ctx context.Context, obj *models.HTTPFiatTransferResponse) (*postgres.FiatAccountTransferResult, error)
+}
+type FiatCurrencyResolver interface {
+	Status(ctx context.Context, obj *postgres.FiatCurrency) (string, error)
+	UpdatedAt(ctx context.Context, obj *postgres.FiatCurrency) (string, error)
 }
 type FiatDepositResponseResolver interface {
 	TxID(ctx context.Context, obj *postgres.FiatAccountTransferResult) (string, error)
@@ -68,7 +85,7 @@ type FiatExchangeOfferRequestResolver interface {
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _FiatAccount_currency(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _FiatAccount_currency(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatAccount_currency(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -112,7 +129,7 @@ func (ec *executionContext) fieldContext_FiatAccount_currency(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatAccount_balance(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _FiatAccount_balance(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatAccount_balance(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -156,7 +173,51 @@ func (ec *executionContext) fieldContext_FiatAccount_balance(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatAccount_lastTx(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _FiatAccount_available(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatAccount_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatAccount().Available(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatAccount_available(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatAccount_lastTx(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatAccount_lastTx(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -200,7 +261,7 @@ func (ec *executionContext) fieldContext_FiatAccount_lastTx(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatAccount_lastTxTs(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _FiatAccount_lastTxTs(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatAccount_lastTxTs(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -244,7 +305,7 @@ func (ec *executionContext) fieldContext_FiatAccount_lastTxTs(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatAccount_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _FiatAccount_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatAccount_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -288,7 +349,7 @@ func (ec *executionContext) fieldContext_FiatAccount_createdAt(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatAccount_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+func (ec *executionContext) _FiatAccount_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatAccount_clientID(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -332,8 +393,8 @@ func (ec *executionContext) fieldContext_FiatAccount_clientID(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatDetailsPaginated) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FiatBalancesPaginated_accountBalances(ctx, field)
+func (ec *executionContext) _FiatAccount_status(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccountBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatAccount_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -346,7 +407,7 @@ func (ec *executionContext) _FiatBalancesPaginated_accountBalances(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AccountBalances, nil
+		return ec.resolvers.FiatAccount().Status(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -358,40 +419,214 @@ func (ec *executionContext) _FiatBalancesPaginated_accountBalances(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]postgres.FiatAccount)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFiatAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FiatBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FiatAccount_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FiatBalancesPaginated",
+		Object:     "FiatAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceHistory_currency(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceHistory_currency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Currency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceHistory_currency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceHistory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceHistory_from(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceHistory_from(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceHistory_from(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceHistory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceHistory_to(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceHistory_to(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceHistory_to(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceHistory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceHistory_snapshots(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatBalanceHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceHistory_snapshots(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Snapshots, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.FiatBalanceSnapshot)
+	fc.Result = res
+	return ec.marshalNFiatBalanceSnapshot2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatBalanceSnapshotᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceHistory_snapshots(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "clientID":
+				return ec.fieldContext_FiatBalanceSnapshot_clientID(ctx, field)
 			case "currency":
-				return ec.fieldContext_FiatAccount_currency(ctx, field)
+				return ec.fieldContext_FiatBalanceSnapshot_currency(ctx, field)
+			case "snapshotAt":
+				return ec.fieldContext_FiatBalanceSnapshot_snapshotAt(ctx, field)
 			case "balance":
-				return ec.fieldContext_FiatAccount_balance(ctx, field)
-			case "lastTx":
-				return ec.fieldContext_FiatAccount_lastTx(ctx, field)
-			case "lastTxTs":
-				return ec.fieldContext_FiatAccount_lastTxTs(ctx, field)
+				return ec.fieldContext_FiatBalanceSnapshot_balance(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_FiatAccount_createdAt(ctx, field)
-			case "clientID":
-				return ec.fieldContext_FiatAccount_clientID(ctx, field)
+				return ec.fieldContext_FiatBalanceSnapshot_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type FiatAccount", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type FiatBalanceSnapshot", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FiatBalancesPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatDetailsPaginated) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FiatBalancesPaginated_links(ctx, field)
+func (ec *executionContext) _FiatBalanceSnapshot_clientID(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceSnapshot_clientID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -404,7 +639,7 @@ func (ec *executionContext) _FiatBalancesPaginated_links(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Links, nil
+		return ec.resolvers.FiatBalanceSnapshot().ClientID(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -416,25 +651,549 @@ func (ec *executionContext) _FiatBalancesPaginated_links(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(models.HTTPLinks)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+	return ec.marshalNUUID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FiatBalancesPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FiatBalanceSnapshot_clientID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FiatBalancesPaginated",
+		Object:     "FiatBalanceSnapshot",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceSnapshot_currency(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceSnapshot_currency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatBalanceSnapshot().Currency(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceSnapshot_currency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceSnapshot_snapshotAt(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceSnapshot_snapshotAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatBalanceSnapshot().SnapshotAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceSnapshot_snapshotAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceSnapshot_balance(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceSnapshot_balance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatBalanceSnapshot().Balance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceSnapshot_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalanceSnapshot_createdAt(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatBalanceSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalanceSnapshot_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatBalanceSnapshot().CreatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalanceSnapshot_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalanceSnapshot",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatDetailsPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalancesPaginated_accountBalances(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AccountBalances, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]postgres.FiatAccountBalance)
+	fc.Result = res
+	return ec.marshalNFiatAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountBalanceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalancesPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "currency":
+				return ec.fieldContext_FiatAccount_currency(ctx, field)
+			case "balance":
+				return ec.fieldContext_FiatAccount_balance(ctx, field)
+			case "available":
+				return ec.fieldContext_FiatAccount_available(ctx, field)
+			case "lastTx":
+				return ec.fieldContext_FiatAccount_lastTx(ctx, field)
+			case "lastTxTs":
+				return ec.fieldContext_FiatAccount_lastTxTs(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FiatAccount_createdAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_FiatAccount_clientID(ctx, field)
+			case "status":
+				return ec.fieldContext_FiatAccount_status(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FiatAccount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatBalancesPaginated_links(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatDetailsPaginated) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatBalancesPaginated_links(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.HTTPLinks)
+	fc.Result = res
+	return ec.marshalNLinks2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPLinks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatBalancesPaginated_links(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatBalancesPaginated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nextPage":
+				return ec.fieldContext_Links_nextPage(ctx, field)
+			case "pageCursor":
+				return ec.fieldContext_Links_pageCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatCloseAccountResponse_sourceReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatTransferResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatCloseAccountResponse_sourceReceipt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatCloseAccountResponse().SourceReceipt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*postgres.FiatAccountTransferResult)
+	fc.Result = res
+	return ec.marshalOFiatDepositResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountTransferResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatCloseAccountResponse_sourceReceipt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatCloseAccountResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "txId":
+				return ec.fieldContext_FiatDepositResponse_txId(ctx, field)
+			case "clientId":
+				return ec.fieldContext_FiatDepositResponse_clientId(ctx, field)
+			case "txTimestamp":
+				return ec.fieldContext_FiatDepositResponse_txTimestamp(ctx, field)
+			case "balance":
+				return ec.fieldContext_FiatDepositResponse_balance(ctx, field)
+			case "lastTx":
+				return ec.fieldContext_FiatDepositResponse_lastTx(ctx, field)
+			case "currency":
+				return ec.fieldContext_FiatDepositResponse_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FiatDepositResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatCloseAccountResponse_destinationReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatTransferResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatCloseAccountResponse_destinationReceipt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatCloseAccountResponse().DestinationReceipt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*postgres.FiatAccountTransferResult)
+	fc.Result = res
+	return ec.marshalOFiatDepositResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountTransferResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatCloseAccountResponse_destinationReceipt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatCloseAccountResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "txId":
+				return ec.fieldContext_FiatDepositResponse_txId(ctx, field)
+			case "clientId":
+				return ec.fieldContext_FiatDepositResponse_clientId(ctx, field)
+			case "txTimestamp":
+				return ec.fieldContext_FiatDepositResponse_txTimestamp(ctx, field)
+			case "balance":
+				return ec.fieldContext_FiatDepositResponse_balance(ctx, field)
+			case "lastTx":
+				return ec.fieldContext_FiatDepositResponse_lastTx(ctx, field)
+			case "currency":
+				return ec.fieldContext_FiatDepositResponse_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FiatDepositResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatCurrency_code(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatCurrency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatCurrency_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatCurrency_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatCurrency",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatCurrency_status(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatCurrency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatCurrency_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatCurrency().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatCurrency_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatCurrency",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatCurrency_updatedAt(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatCurrency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatCurrency_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatCurrency().UpdatedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatCurrency_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatCurrency",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nextPage":
-				return ec.fieldContext_Links_nextPage(ctx, field)
-			case "pageCursor":
-				return ec.fieldContext_Links_pageCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Links", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1238,6 +1997,44 @@ func (ec *executionContext) fieldContext_FiatTransactionsPaginated_links(ctx con
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputFiatCloseAccountRequest(ctx context.Context, obj interface{}) (models.HTTPCloseFiatAccountRequest, error) {
+	var it models.HTTPCloseFiatAccountRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"currency", "sweepCurrency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "currency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		case "sweepCurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sweepCurrency"))
+			data, err := ec.unmarshalOString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SweepCurrency = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputFiatDepositRequest(ctx context.Context, obj interface{}) (models.HTTPDepositCurrencyRequest, error) {
 	var it models.HTTPDepositCurrencyRequest
 	asMap := map[string]interface{}{}
@@ -1285,7 +2082,7 @@ func (ec *executionContext) unmarshalInputFiatExchangeOfferRequest(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sourceCurrency", "destinationCurrency", "sourceAmount"}
+	fieldsInOrder := [...]string{"sourceCurrency", "destinationCurrency", "sourceAmount", "hold"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -1321,6 +2118,15 @@ func (ec *executionContext) unmarshalInputFiatExchangeOfferRequest(ctx context.C
 			if err = ec.resolvers.FiatExchangeOfferRequest().SourceAmount(ctx, &it, data); err != nil {
 				return it, err
 			}
+		case "hold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hold"))
+			data, err := ec.unmarshalOBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hold = data
 		}
 	}
 
@@ -1409,17 +2215,247 @@ func (ec *executionContext) unmarshalInputFiatPaginatedTxDetailsRequest(ctx cont
 
 // region    **************************** object.gotpl ****************************
 
-var fiatAccountImplementors = []string{"FiatAccount"}
+var fiatAccountImplementors = []string{"FiatAccount"}
+
+func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionSet, obj *postgres.FiatAccountBalance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fiatAccountImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FiatAccount")
+		case "currency":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_currency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "balance":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_balance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "available":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_available(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "lastTx":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_lastTx(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "lastTxTs":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_lastTxTs(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "clientID":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_clientID(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fiatBalanceHistoryImplementors = []string{"FiatBalanceHistory"}
+
+func (ec *executionContext) _FiatBalanceHistory(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPFiatBalanceHistory) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fiatBalanceHistoryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FiatBalanceHistory")
+		case "currency":
+
+			out.Values[i] = ec._FiatBalanceHistory_currency(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "from":
+
+			out.Values[i] = ec._FiatBalanceHistory_from(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "to":
+
+			out.Values[i] = ec._FiatBalanceHistory_to(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "snapshots":
+
+			out.Values[i] = ec._FiatBalanceHistory_snapshots(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fiatBalanceSnapshotImplementors = []string{"FiatBalanceSnapshot"}
 
-func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionSet, obj *postgres.FiatAccount) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fiatAccountImplementors)
+func (ec *executionContext) _FiatBalanceSnapshot(ctx context.Context, sel ast.SelectionSet, obj *postgres.FiatBalanceSnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fiatBalanceSnapshotImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FiatAccount")
-		case "currency":
+			out.Values[i] = graphql.MarshalString("FiatBalanceSnapshot")
+		case "clientID":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1428,7 +2464,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FiatAccount_currency(ctx, field, obj)
+				res = ec._FiatBalanceSnapshot_clientID(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1439,7 +2475,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 				return innerFunc(ctx)
 
 			})
-		case "balance":
+		case "currency":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1448,7 +2484,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FiatAccount_balance(ctx, field, obj)
+				res = ec._FiatBalanceSnapshot_currency(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1459,7 +2495,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 				return innerFunc(ctx)
 
 			})
-		case "lastTx":
+		case "snapshotAt":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1468,7 +2504,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FiatAccount_lastTx(ctx, field, obj)
+				res = ec._FiatBalanceSnapshot_snapshotAt(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1479,7 +2515,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 				return innerFunc(ctx)
 
 			})
-		case "lastTxTs":
+		case "balance":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1488,7 +2524,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FiatAccount_lastTxTs(ctx, field, obj)
+				res = ec._FiatBalanceSnapshot_balance(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1508,7 +2544,7 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FiatAccount_createdAt(ctx, field, obj)
+				res = ec._FiatBalanceSnapshot_createdAt(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -1519,7 +2555,63 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 				return innerFunc(ctx)
 
 			})
-		case "clientID":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fiatBalancesPaginatedImplementors = []string{"FiatBalancesPaginated"}
+
+func (ec *executionContext) _FiatBalancesPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPFiatDetailsPaginated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fiatBalancesPaginatedImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FiatBalancesPaginated")
+		case "accountBalances":
+
+			out.Values[i] = ec._FiatBalancesPaginated_accountBalances(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "links":
+
+			out.Values[i] = ec._FiatBalancesPaginated_links(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fiatCloseAccountResponseImplementors = []string{"FiatCloseAccountResponse"}
+
+func (ec *executionContext) _FiatCloseAccountResponse(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPFiatTransferResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fiatCloseAccountResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FiatCloseAccountResponse")
+		case "sourceReceipt":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -1528,10 +2620,24 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FiatAccount_clientID(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._FiatCloseAccountResponse_sourceReceipt(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "destinationReceipt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatCloseAccountResponse_destinationReceipt(ctx, field, obj)
 				return res
 			}
 
@@ -1550,30 +2656,63 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var fiatBalancesPaginatedImplementors = []string{"FiatBalancesPaginated"}
+var fiatCurrencyImplementors = []string{"FiatCurrency"}
 
-func (ec *executionContext) _FiatBalancesPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPFiatDetailsPaginated) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fiatBalancesPaginatedImplementors)
+func (ec *executionContext) _FiatCurrency(ctx context.Context, sel ast.SelectionSet, obj *postgres.FiatCurrency) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fiatCurrencyImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FiatBalancesPaginated")
-		case "accountBalances":
+			out.Values[i] = graphql.MarshalString("FiatCurrency")
+		case "code":
 
-			out.Values[i] = ec._FiatBalancesPaginated_accountBalances(ctx, field, obj)
+			out.Values[i] = ec._FiatCurrency_code(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "links":
+		case "status":
+			field := field
 
-			out.Values[i] = ec._FiatBalancesPaginated_links(ctx, field, obj)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatCurrency_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "updatedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatCurrency_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1995,11 +3134,11 @@ func (ec *executionContext) _FiatTransactionsPaginated(ctx context.Context, sel
 
 // region    ***************************** type.gotpl *****************************
 
-func (ec *executionContext) marshalNFiatAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccount(ctx context.Context, sel ast.SelectionSet, v postgres.FiatAccount) graphql.Marshaler {
+func (ec *executionContext) marshalNFiatAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountBalance(ctx context.Context, sel ast.SelectionSet, v postgres.FiatAccountBalance) graphql.Marshaler {
 	return ec._FiatAccount(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFiatAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.FiatAccount) graphql.Marshaler {
+func (ec *executionContext) marshalNFiatAccount2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountBalanceᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.FiatAccountBalance) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -2023,7 +3162,7 @@ func (ec *executionContext) marshalNFiatAccount2ᚕgithubᚗcomᚋsurahmanᚋFTe
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFiatAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccount(ctx, sel, v[i])
+			ret[i] = ec.marshalNFiatAccount2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountBalance(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -2043,7 +3182,7 @@ func (ec *executionContext) marshalNFiatAccount2ᚕgithubᚗcomᚋsurahmanᚋFTe
 	return ret
 }
 
-func (ec *executionContext) marshalNFiatAccount2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccount(ctx context.Context, sel ast.SelectionSet, v *postgres.FiatAccount) graphql.Marshaler {
+func (ec *executionContext) marshalNFiatAccount2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountBalance(ctx context.Context, sel ast.SelectionSet, v *postgres.FiatAccountBalance) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -2053,6 +3192,68 @@ func (ec *executionContext) marshalNFiatAccount2ᚖgithubᚗcomᚋsurahmanᚋFTe
 	return ec._FiatAccount(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNFiatBalanceHistory2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPFiatBalanceHistory(ctx context.Context, sel ast.SelectionSet, v models.HTTPFiatBalanceHistory) graphql.Marshaler {
+	return ec._FiatBalanceHistory(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFiatBalanceHistory2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPFiatBalanceHistory(ctx context.Context, sel ast.SelectionSet, v *models.HTTPFiatBalanceHistory) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FiatBalanceHistory(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFiatBalanceSnapshot2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatBalanceSnapshot(ctx context.Context, sel ast.SelectionSet, v postgres.FiatBalanceSnapshot) graphql.Marshaler {
+	return ec._FiatBalanceSnapshot(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFiatBalanceSnapshot2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatBalanceSnapshotᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.FiatBalanceSnapshot) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFiatBalanceSnapshot2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatBalanceSnapshot(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNFiatBalancesPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPFiatDetailsPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPFiatDetailsPaginated) graphql.Marshaler {
 	return ec._FiatBalancesPaginated(ctx, sel, &v)
 }
@@ -2067,6 +3268,73 @@ func (ec *executionContext) marshalNFiatBalancesPaginated2ᚖgithubᚗcomᚋsura
 	return ec._FiatBalancesPaginated(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNFiatCloseAccountRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCloseFiatAccountRequest(ctx context.Context, v interface{}) (models.HTTPCloseFiatAccountRequest, error) {
+	res, err := ec.unmarshalInputFiatCloseAccountRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFiatCloseAccountResponse2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPFiatTransferResponse(ctx context.Context, sel ast.SelectionSet, v models.HTTPFiatTransferResponse) graphql.Marshaler {
+	return ec._FiatCloseAccountResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFiatCloseAccountResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPFiatTransferResponse(ctx context.Context, sel ast.SelectionSet, v *models.HTTPFiatTransferResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FiatCloseAccountResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFiatCurrency2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatCurrency(ctx context.Context, sel ast.SelectionSet, v postgres.FiatCurrency) graphql.Marshaler {
+	return ec._FiatCurrency(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFiatCurrency2ᚕgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatCurrencyᚄ(ctx context.Context, sel ast.SelectionSet, v []postgres.FiatCurrency) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFiatCurrency2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatCurrency(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNFiatDepositRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPDepositCurrencyRequest(ctx context.Context, v interface{}) (models.HTTPDepositCurrencyRequest, error) {
 	res, err := ec.unmarshalInputFiatDepositRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -2186,6 +3454,13 @@ func (ec *executionContext) marshalNFiatTransactionsPaginated2ᚖgithubᚗcomᚋ
 	return ec._FiatTransactionsPaginated(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOFiatDepositResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatAccountTransferResult(ctx context.Context, sel ast.SelectionSet, v *postgres.FiatAccountTransferResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FiatDepositResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOFiatJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatJournal(ctx context.Context, sel ast.SelectionSet, v *postgres.FiatJournal) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
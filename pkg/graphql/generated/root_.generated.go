@@ -31,19 +31,46 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	APIKey() APIKeyResolver
+	AdminAuditLog() AdminAuditLogResolver
+	AuditEvent() AuditEventResolver
+	BalanceAsOf() BalanceAsOfResolver
 	CryptoAccount() CryptoAccountResolver
+	CryptoAsset() CryptoAssetResolver
+	CryptoBalanceSnapshot() CryptoBalanceSnapshotResolver
 	CryptoJournal() CryptoJournalResolver
+	CryptoLimitOrder() CryptoLimitOrderResolver
+	CryptoLimitOrderEvent() CryptoLimitOrderEventResolver
+	CryptoRecurringPurchase() CryptoRecurringPurchaseResolver
+	CryptoRecurringPurchaseRun() CryptoRecurringPurchaseRunResolver
 	CryptoTransactionsPaginated() CryptoTransactionsPaginatedResolver
+	CryptoTriggerOrder() CryptoTriggerOrderResolver
+	CryptoTriggerOrderEvent() CryptoTriggerOrderEventResolver
 	FiatAccount() FiatAccountResolver
+	FiatAdjustment() FiatAdjustmentResolver
+	FiatBalanceSnapshot() FiatBalanceSnapshotResolver
+	FiatCloseAccountResponse() FiatCloseAccountResponseResolver
+	FiatCurrency() FiatCurrencyResolver
 	FiatDepositResponse() FiatDepositResponseResolver
 	FiatExchangeTransferResponse() FiatExchangeTransferResponseResolver
 	FiatJournal() FiatJournalResolver
 	FiatTransactionsPaginated() FiatTransactionsPaginatedResolver
+	JournalChainBreak() JournalChainBreakResolver
+	LoginLockout() LoginLockoutResolver
 	Mutation() MutationResolver
 	OfferResponse() OfferResponseResolver
 	PriceQuote() PriceQuoteResolver
 	Query() QueryResolver
+	SecurityEvent() SecurityEventResolver
+	Session() SessionResolver
+	TransactionReversal() TransactionReversalResolver
+	User() UserResolver
+	UserProfile() UserProfileResolver
+	CryptoLimitOrderRequest() CryptoLimitOrderRequestResolver
 	CryptoOfferRequest() CryptoOfferRequestResolver
+	CryptoRecurringPurchaseRequest() CryptoRecurringPurchaseRequestResolver
+	CryptoTriggerOrderRequest() CryptoTriggerOrderRequestResolver
+	FiatAdjustmentRequest() FiatAdjustmentRequestResolver
 	FiatDepositRequest() FiatDepositRequestResolver
 	FiatExchangeOfferRequest() FiatExchangeOfferRequestResolver
 }
@@ -52,13 +79,116 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	APIKey struct {
+		AllowedIPs func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		KeyID      func(childComplexity int) int
+		Name       func(childComplexity int) int
+		RevokedAt  func(childComplexity int) int
+		Scopes     func(childComplexity int) int
+	}
+
+	APIKeyResponse struct {
+		Key    func(childComplexity int) int
+		Secret func(childComplexity int) int
+	}
+
+	AdminAccountStatusResponse struct {
+		ClientID func(childComplexity int) int
+		Code     func(childComplexity int) int
+		Status   func(childComplexity int) int
+	}
+
+	AdminAuditLog struct {
+		Action    func(childComplexity int) int
+		AdminID   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Details   func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Outcome   func(childComplexity int) int
+		Target    func(childComplexity int) int
+	}
+
+	AdminAuditLogPaginated struct {
+		Entries func(childComplexity int) int
+		Links   func(childComplexity int) int
+	}
+
+	AdminFreezeResponse struct {
+		ClientID func(childComplexity int) int
+		IsFrozen func(childComplexity int) int
+	}
+
+	AdminUnlockResponse struct {
+		ClientID func(childComplexity int) int
+	}
+
+	AuditEvent struct {
+		ActorID   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		EventID   func(childComplexity int) int
+		EventType func(childComplexity int) int
+		IpAddress func(childComplexity int) int
+		Outcome   func(childComplexity int) int
+		Payload   func(childComplexity int) int
+		SubjectID func(childComplexity int) int
+		UserAgent func(childComplexity int) int
+	}
+
+	AuditEventsPaginated struct {
+		Events func(childComplexity int) int
+		Links  func(childComplexity int) int
+	}
+
+	BalanceAsOf struct {
+		AsOf       func(childComplexity int) int
+		Balance    func(childComplexity int) int
+		Currency   func(childComplexity int) int
+		SnapshotAt func(childComplexity int) int
+	}
+
+	CheckpointExport struct {
+		Algorithm   func(childComplexity int) int
+		Checkpoints func(childComplexity int) int
+		KeyID       func(childComplexity int) int
+		PublicKey   func(childComplexity int) int
+	}
+
 	CryptoAccount struct {
+		Available func(childComplexity int) int
 		Balance   func(childComplexity int) int
 		ClientID  func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
 		LastTx    func(childComplexity int) int
 		LastTxTs  func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Ticker    func(childComplexity int) int
+	}
+
+	CryptoAsset struct {
+		DecimalPlaces func(childComplexity int) int
+		MaxOrder      func(childComplexity int) int
+		MinOrder      func(childComplexity int) int
+		Name          func(childComplexity int) int
+		Status        func(childComplexity int) int
+		Ticker        func(childComplexity int) int
+		UpdatedAt     func(childComplexity int) int
+	}
+
+	CryptoBalanceHistory struct {
+		From      func(childComplexity int) int
+		Snapshots func(childComplexity int) int
 		Ticker    func(childComplexity int) int
+		To        func(childComplexity int) int
+	}
+
+	CryptoBalanceSnapshot struct {
+		Balance    func(childComplexity int) int
+		ClientID   func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		SnapshotAt func(childComplexity int) int
+		Ticker     func(childComplexity int) int
 	}
 
 	CryptoBalancesPaginated struct {
@@ -74,11 +204,84 @@ type ComplexityRoot struct {
 		TxID         func(childComplexity int) int
 	}
 
+	CryptoLimitOrder struct {
+		Amount       func(childComplexity int) int
+		ClientID     func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		ExpiresAt    func(childComplexity int) int
+		FiatCurrency func(childComplexity int) int
+		IsPurchase   func(childComplexity int) int
+		LimitPrice   func(childComplexity int) int
+		OrderID      func(childComplexity int) int
+		Status       func(childComplexity int) int
+		Ticker       func(childComplexity int) int
+		TxID         func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+	}
+
+	CryptoLimitOrderDetails struct {
+		History func(childComplexity int) int
+		Order   func(childComplexity int) int
+	}
+
+	CryptoLimitOrderEvent struct {
+		CreatedAt func(childComplexity int) int
+		Details   func(childComplexity int) int
+		ID        func(childComplexity int) int
+		OrderID   func(childComplexity int) int
+		Price     func(childComplexity int) int
+		Status    func(childComplexity int) int
+	}
+
+	CryptoLimitOrdersPaginated struct {
+		Links  func(childComplexity int) int
+		Orders func(childComplexity int) int
+	}
+
 	CryptoOpenAccountResponse struct {
 		ClientID func(childComplexity int) int
 		Ticker   func(childComplexity int) int
 	}
 
+	CryptoRecurringPurchase struct {
+		Amount       func(childComplexity int) int
+		Cadence      func(childComplexity int) int
+		ClientID     func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		EndsAt       func(childComplexity int) int
+		FiatCurrency func(childComplexity int) int
+		NextRunAt    func(childComplexity int) int
+		PlanID       func(childComplexity int) int
+		StartsAt     func(childComplexity int) int
+		Status       func(childComplexity int) int
+		Ticker       func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+	}
+
+	CryptoRecurringPurchaseDetails struct {
+		Links func(childComplexity int) int
+		Plan  func(childComplexity int) int
+		Runs  func(childComplexity int) int
+	}
+
+	CryptoRecurringPurchaseRun struct {
+		CreatedAt    func(childComplexity int) int
+		CryptoAmount func(childComplexity int) int
+		Details      func(childComplexity int) int
+		FiatAmount   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		PlanID       func(childComplexity int) int
+		Price        func(childComplexity int) int
+		ScheduledAt  func(childComplexity int) int
+		Status       func(childComplexity int) int
+		TxID         func(childComplexity int) int
+	}
+
+	CryptoRecurringPurchasesPaginated struct {
+		Links func(childComplexity int) int
+		Plans func(childComplexity int) int
+	}
+
 	CryptoTransactionsPaginated struct {
 		Links        func(childComplexity int) int
 		Transactions func(childComplexity int) int
@@ -89,13 +292,97 @@ type ComplexityRoot struct {
 		FiatTxReceipt   func(childComplexity int) int
 	}
 
+	CryptoTriggerOrder struct {
+		Amount           func(childComplexity int) int
+		ClientID         func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		FiatCurrency     func(childComplexity int) int
+		OrderID          func(childComplexity int) int
+		Status           func(childComplexity int) int
+		Ticker           func(childComplexity int) int
+		TrailingDistance func(childComplexity int) int
+		TriggerPrice     func(childComplexity int) int
+		TriggerType      func(childComplexity int) int
+		TxID             func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+	}
+
+	CryptoTriggerOrderDetails struct {
+		History func(childComplexity int) int
+		Order   func(childComplexity int) int
+	}
+
+	CryptoTriggerOrderEvent struct {
+		Amount    func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Details   func(childComplexity int) int
+		ID        func(childComplexity int) int
+		OrderID   func(childComplexity int) int
+		Price     func(childComplexity int) int
+		Status    func(childComplexity int) int
+	}
+
+	CryptoTriggerOrdersPaginated struct {
+		Links  func(childComplexity int) int
+		Orders func(childComplexity int) int
+	}
+
+	ExportedCheckpoint struct {
+		CreatedAt func(childComplexity int) int
+		EntryHash func(childComplexity int) int
+		Journal   func(childComplexity int) int
+		KeyID     func(childComplexity int) int
+		Message   func(childComplexity int) int
+		PublicKey func(childComplexity int) int
+		Seq       func(childComplexity int) int
+		Signature func(childComplexity int) int
+	}
+
 	FiatAccount struct {
+		Available func(childComplexity int) int
 		Balance   func(childComplexity int) int
 		ClientID  func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
 		Currency  func(childComplexity int) int
 		LastTx    func(childComplexity int) int
 		LastTxTs  func(childComplexity int) int
+		Status    func(childComplexity int) int
+	}
+
+	FiatAdjustment struct {
+		AdjustmentID  func(childComplexity int) int
+		Amount        func(childComplexity int) int
+		CheckerID     func(childComplexity int) int
+		ClientID      func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		Currency      func(childComplexity int) int
+		DecidedAt     func(childComplexity int) int
+		DecisionNote  func(childComplexity int) int
+		Justification func(childComplexity int) int
+		MakerID       func(childComplexity int) int
+		ReasonCode    func(childComplexity int) int
+		Status        func(childComplexity int) int
+		TxID          func(childComplexity int) int
+	}
+
+	FiatAdjustmentsPaginated struct {
+		Adjustments func(childComplexity int) int
+		Links       func(childComplexity int) int
+	}
+
+	FiatBalanceHistory struct {
+		Currency  func(childComplexity int) int
+		From      func(childComplexity int) int
+		Snapshots func(childComplexity int) int
+		To        func(childComplexity int) int
+	}
+
+	FiatBalanceSnapshot struct {
+		Balance    func(childComplexity int) int
+		ClientID   func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Currency   func(childComplexity int) int
+		SnapshotAt func(childComplexity int) int
 	}
 
 	FiatBalancesPaginated struct {
@@ -103,6 +390,17 @@ type ComplexityRoot struct {
 		Links           func(childComplexity int) int
 	}
 
+	FiatCloseAccountResponse struct {
+		DestinationReceipt func(childComplexity int) int
+		SourceReceipt      func(childComplexity int) int
+	}
+
+	FiatCurrency struct {
+		Code      func(childComplexity int) int
+		Status    func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
 	FiatDepositResponse struct {
 		Balance     func(childComplexity int) int
 		ClientID    func(childComplexity int) int
@@ -136,9 +434,35 @@ type ComplexityRoot struct {
 	}
 
 	JWTAuthResponse struct {
-		Expires   func(childComplexity int) int
-		Threshold func(childComplexity int) int
-		Token     func(childComplexity int) int
+		Expires        func(childComplexity int) int
+		RefreshExpires func(childComplexity int) int
+		RefreshToken   func(childComplexity int) int
+		Threshold      func(childComplexity int) int
+		Token          func(childComplexity int) int
+	}
+
+	JournalChainBreak struct {
+		Reason func(childComplexity int) int
+		Seq    func(childComplexity int) int
+		TxID   func(childComplexity int) int
+	}
+
+	JournalChainVerification struct {
+		AnchorSeq           func(childComplexity int) int
+		ArchivedCheckpoints func(childComplexity int) int
+		Break               func(childComplexity int) int
+		Checkpoints         func(childComplexity int) int
+		Entries             func(childComplexity int) int
+		FirstSeq            func(childComplexity int) int
+		Intact              func(childComplexity int) int
+		Journal             func(childComplexity int) int
+		LastHash            func(childComplexity int) int
+		LastSeq             func(childComplexity int) int
+	}
+
+	LedgerVerification struct {
+		Intact   func(childComplexity int) int
+		Journals func(childComplexity int) int
 	}
 
 	Links struct {
@@ -146,23 +470,73 @@ type ComplexityRoot struct {
 		PageCursor func(childComplexity int) int
 	}
 
+	LoginLockout struct {
+		ExpiresAt  func(childComplexity int) int
+		Failures   func(childComplexity int) int
+		IpAddress  func(childComplexity int) int
+		LockedAt   func(childComplexity int) int
+		LockoutID  func(childComplexity int) int
+		Scope      func(childComplexity int) int
+		Subject    func(childComplexity int) int
+		UnlockedAt func(childComplexity int) int
+		UnlockedBy func(childComplexity int) int
+	}
+
+	MFAEnrollment struct {
+		Secret func(childComplexity int) int
+		URI    func(childComplexity int) int
+	}
+
 	Mutation struct {
-		DeleteUser           func(childComplexity int, input models.HTTPDeleteUserRequest) int
-		DepositFiat          func(childComplexity int, input models.HTTPDepositCurrencyRequest) int
-		ExchangeCrypto       func(childComplexity int, offerID string) int
-		ExchangeOfferFiat    func(childComplexity int, input models.HTTPExchangeOfferRequest) int
-		ExchangeTransferFiat func(childComplexity int, offerID string) int
-		LoginUser            func(childComplexity int, input models1.UserLoginCredentials) int
-		OfferCrypto          func(childComplexity int, input models.HTTPCryptoOfferRequest) int
-		OpenCrypto           func(childComplexity int, ticker string) int
-		OpenFiat             func(childComplexity int, currency string) int
-		RefreshToken         func(childComplexity int) int
-		RegisterUser         func(childComplexity int, input *models1.UserAccount) int
+		AdminApproveAdjustment    func(childComplexity int, adjustmentID string, note string) int
+		AdminCryptoAccountStatus  func(childComplexity int, clientID string, ticker string, status string, reason string) int
+		AdminFiatAccountStatus    func(childComplexity int, clientID string, currency string, status string, reason string) int
+		AdminFreezeUser           func(childComplexity int, clientID string, isFrozen bool, reason string) int
+		AdminRejectAdjustment     func(childComplexity int, adjustmentID string, note string) int
+		AdminRequestAdjustment    func(childComplexity int, input models.HTTPAdminAdjustmentRequest) int
+		AdminReverseTransaction   func(childComplexity int, transactionID string, reason string) int
+		AdminUnlockUser           func(childComplexity int, clientID string, reason string) int
+		CancelLimitOrder          func(childComplexity int, orderID string) int
+		CancelRecurringPurchase   func(childComplexity int, planID string) int
+		CancelTriggerOrder        func(childComplexity int, orderID string) int
+		ChangePassword            func(childComplexity int, input models.HTTPChangePasswordRequest) int
+		CloseCrypto               func(childComplexity int, input models.HTTPCloseCryptoAccountRequest) int
+		CloseFiat                 func(childComplexity int, input models.HTTPCloseFiatAccountRequest) int
+		ConfirmMfa                func(childComplexity int, code string) int
+		CreateAPIKey              func(childComplexity int, input models.HTTPAPIKeyRequest) int
+		DeleteUser                func(childComplexity int, input models.HTTPDeleteUserRequest) int
+		DepositFiat               func(childComplexity int, input models.HTTPDepositCurrencyRequest) int
+		DisableMfa                func(childComplexity int) int
+		EnrollMfa                 func(childComplexity int) int
+		ExchangeCrypto            func(childComplexity int, offerID string) int
+		ExchangeOfferFiat         func(childComplexity int, input models.HTTPExchangeOfferRequest) int
+		ExchangeTransferFiat      func(childComplexity int, offerID string) int
+		LoginUser                 func(childComplexity int, input models1.UserLoginCredentials) int
+		LogoutAllSessions         func(childComplexity int) int
+		LogoutUser                func(childComplexity int) int
+		OfferCrypto               func(childComplexity int, input models.HTTPCryptoOfferRequest) int
+		OpenCrypto                func(childComplexity int, ticker string) int
+		OpenFiat                  func(childComplexity int, currency string) int
+		PlaceLimitOrder           func(childComplexity int, input models.HTTPLimitOrderRequest) int
+		PlaceTriggerOrder         func(childComplexity int, input models.HTTPTriggerOrderRequest) int
+		RefreshSession            func(childComplexity int, refreshToken string) int
+		RefreshToken              func(childComplexity int) int
+		RegenerateRecoveryCodes   func(childComplexity int) int
+		RegisterUser              func(childComplexity int, input *models1.UserAccount) int
+		RequestPasswordReset      func(childComplexity int, username string) int
+		ResetPassword             func(childComplexity int, input models.HTTPPasswordResetConfirmRequest) int
+		RevokeAPIKey              func(childComplexity int, keyID string) int
+		RevokeSession             func(childComplexity int, sessionID string) int
+		ScheduleRecurringPurchase func(childComplexity int, input models.HTTPRecurringPurchaseRequest) int
+		SendEmailVerification     func(childComplexity int) int
+		UpdateProfile             func(childComplexity int, input models.HTTPUpdateProfileRequest) int
+		VerifyEmail               func(childComplexity int, token string) int
 	}
 
 	OfferResponse struct {
 		DebitAmount func(childComplexity int) int
 		Expires     func(childComplexity int) int
+		IsHeld      func(childComplexity int) int
 		OfferID     func(childComplexity int) int
 		PriceQuote  func(childComplexity int) int
 	}
@@ -176,15 +550,95 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		BalanceAllCrypto            func(childComplexity int, pageCursor *string, pageSize *int32) int
-		BalanceAllFiat              func(childComplexity int, pageCursor *string, pageSize *int32) int
-		BalanceCrypto               func(childComplexity int, ticker string) int
-		BalanceFiat                 func(childComplexity int, currencyCode string) int
-		Healthcheck                 func(childComplexity int) int
-		TransactionDetailsAllCrypto func(childComplexity int, input models.CryptoPaginatedTxDetailsRequest) int
-		TransactionDetailsAllFiat   func(childComplexity int, input models.FiatPaginatedTxDetailsRequest) int
-		TransactionDetailsCrypto    func(childComplexity int, transactionID string) int
-		TransactionDetailsFiat      func(childComplexity int, transactionID string) int
+		APIKeys                          func(childComplexity int) int
+		AdminAdjustment                  func(childComplexity int, adjustmentID string) int
+		AdminAdjustments                 func(childComplexity int, status *string, pageCursor *string, pageSize *int32) int
+		AdminAuditEvents                 func(childComplexity int, clientID *string, eventType *string, outcome *string, pageCursor *string, pageSize *int32) int
+		AdminAuditLog                    func(childComplexity int, target *string, pageCursor *string, pageSize *int32) int
+		AdminBalanceAllCrypto            func(childComplexity int, clientID string, pageCursor *string, pageSize *int32) int
+		AdminBalanceAllFiat              func(childComplexity int, clientID string, pageCursor *string, pageSize *int32) int
+		AdminLedgerCheckpoints           func(childComplexity int, journal *string, since *int64) int
+		AdminLedgerVerify                func(childComplexity int, journal *string) int
+		AdminLoginLockouts               func(childComplexity int, active *bool, limit *int32) int
+		AdminTransactionDetailsAllCrypto func(childComplexity int, clientID string, input models.CryptoPaginatedTxDetailsRequest) int
+		AdminTransactionDetailsAllFiat   func(childComplexity int, clientID string, input models.FiatPaginatedTxDetailsRequest) int
+		AdminUser                        func(childComplexity int, clientID string) int
+		AdminUserSearch                  func(childComplexity int, query string, limit *int32) int
+		BalanceAllCrypto                 func(childComplexity int, pageCursor *string, pageSize *int32) int
+		BalanceAllFiat                   func(childComplexity int, pageCursor *string, pageSize *int32) int
+		BalanceAsOfCrypto                func(childComplexity int, ticker string, timestamp int64) int
+		BalanceAsOfFiat                  func(childComplexity int, currencyCode string, timestamp int64) int
+		BalanceCrypto                    func(childComplexity int, ticker string) int
+		BalanceFiat                      func(childComplexity int, currencyCode string) int
+		BalanceHistoryCrypto             func(childComplexity int, ticker string, from *int64, to *int64) int
+		BalanceHistoryFiat               func(childComplexity int, currencyCode string, from *int64, to *int64) int
+		CryptoAssets                     func(childComplexity int) int
+		FiatCurrencies                   func(childComplexity int) int
+		Healthcheck                      func(childComplexity int) int
+		LimitOrder                       func(childComplexity int, orderID string) int
+		LimitOrders                      func(childComplexity int, status *string, pageCursor *string, pageSize *int32) int
+		Me                               func(childComplexity int) int
+		RecurringPurchase                func(childComplexity int, planID string, pageCursor *string, pageSize *int32) int
+		RecurringPurchases               func(childComplexity int, status *string, pageCursor *string, pageSize *int32) int
+		SecurityActivity                 func(childComplexity int) int
+		Sessions                         func(childComplexity int) int
+		TransactionDetailsAllCrypto      func(childComplexity int, input models.CryptoPaginatedTxDetailsRequest) int
+		TransactionDetailsAllFiat        func(childComplexity int, input models.FiatPaginatedTxDetailsRequest) int
+		TransactionDetailsCrypto         func(childComplexity int, transactionID string) int
+		TransactionDetailsFiat           func(childComplexity int, transactionID string) int
+		TriggerOrder                     func(childComplexity int, orderID string) int
+		TriggerOrders                    func(childComplexity int, status *string, pageCursor *string, pageSize *int32) int
+	}
+
+	SecurityEvent struct {
+		EventType  func(childComplexity int) int
+		IPAddress  func(childComplexity int) int
+		OccurredAt func(childComplexity int) int
+		Outcome    func(childComplexity int) int
+		UserAgent  func(childComplexity int) int
+	}
+
+	Session struct {
+		Device        func(childComplexity int) int
+		ExpiresAt     func(childComplexity int) int
+		IPAddress     func(childComplexity int) int
+		LastRefreshed func(childComplexity int) int
+		SessionID     func(childComplexity int) int
+		StartedAt     func(childComplexity int) int
+	}
+
+	TransactionReversal struct {
+		AdminID       func(childComplexity int) int
+		CryptoEntries func(childComplexity int) int
+		FiatEntries   func(childComplexity int) int
+		Reason        func(childComplexity int) int
+		ReversalTxID  func(childComplexity int) int
+		ReversedAt    func(childComplexity int) int
+		TxID          func(childComplexity int) int
+	}
+
+	User struct {
+		ClientID      func(childComplexity int) int
+		Email         func(childComplexity int) int
+		EmailVerified func(childComplexity int) int
+		FirstName     func(childComplexity int) int
+		IsDeleted     func(childComplexity int) int
+		IsFrozen      func(childComplexity int) int
+		LastName      func(childComplexity int) int
+		Role          func(childComplexity int) int
+		Username      func(childComplexity int) int
+	}
+
+	UserProfile struct {
+		ClientID      func(childComplexity int) int
+		Email         func(childComplexity int) int
+		EmailVerified func(childComplexity int) int
+		FirstName     func(childComplexity int) int
+		IsDeleted     func(childComplexity int) int
+		IsFrozen      func(childComplexity int) int
+		LastName      func(childComplexity int) int
+		Role          func(childComplexity int) int
+		Username      func(childComplexity int) int
 	}
 }
 
@@ -203,641 +657,2972 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "CryptoAccount.balance":
-		if e.complexity.CryptoAccount.Balance == nil {
+	case "APIKey.allowedIPs":
+		if e.complexity.APIKey.AllowedIPs == nil {
 			break
 		}
 
-		return e.complexity.CryptoAccount.Balance(childComplexity), true
+		return e.complexity.APIKey.AllowedIPs(childComplexity), true
 
-	case "CryptoAccount.clientID":
-		if e.complexity.CryptoAccount.ClientID == nil {
+	case "APIKey.createdAt":
+		if e.complexity.APIKey.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.CryptoAccount.ClientID(childComplexity), true
+		return e.complexity.APIKey.CreatedAt(childComplexity), true
 
-	case "CryptoAccount.createdAt":
-		if e.complexity.CryptoAccount.CreatedAt == nil {
+	case "APIKey.expiresAt":
+		if e.complexity.APIKey.ExpiresAt == nil {
 			break
 		}
 
-		return e.complexity.CryptoAccount.CreatedAt(childComplexity), true
+		return e.complexity.APIKey.ExpiresAt(childComplexity), true
 
-	case "CryptoAccount.lastTx":
-		if e.complexity.CryptoAccount.LastTx == nil {
+	case "APIKey.keyID":
+		if e.complexity.APIKey.KeyID == nil {
 			break
 		}
 
-		return e.complexity.CryptoAccount.LastTx(childComplexity), true
+		return e.complexity.APIKey.KeyID(childComplexity), true
 
-	case "CryptoAccount.lastTxTs":
-		if e.complexity.CryptoAccount.LastTxTs == nil {
+	case "APIKey.name":
+		if e.complexity.APIKey.Name == nil {
 			break
 		}
 
-		return e.complexity.CryptoAccount.LastTxTs(childComplexity), true
+		return e.complexity.APIKey.Name(childComplexity), true
 
-	case "CryptoAccount.ticker":
-		if e.complexity.CryptoAccount.Ticker == nil {
+	case "APIKey.revokedAt":
+		if e.complexity.APIKey.RevokedAt == nil {
 			break
 		}
 
-		return e.complexity.CryptoAccount.Ticker(childComplexity), true
+		return e.complexity.APIKey.RevokedAt(childComplexity), true
 
-	case "CryptoBalancesPaginated.accountBalances":
-		if e.complexity.CryptoBalancesPaginated.AccountBalances == nil {
+	case "APIKey.scopes":
+		if e.complexity.APIKey.Scopes == nil {
 			break
 		}
 
-		return e.complexity.CryptoBalancesPaginated.AccountBalances(childComplexity), true
+		return e.complexity.APIKey.Scopes(childComplexity), true
 
-	case "CryptoBalancesPaginated.links":
-		if e.complexity.CryptoBalancesPaginated.Links == nil {
+	case "APIKeyResponse.key":
+		if e.complexity.APIKeyResponse.Key == nil {
 			break
 		}
 
-		return e.complexity.CryptoBalancesPaginated.Links(childComplexity), true
+		return e.complexity.APIKeyResponse.Key(childComplexity), true
 
-	case "CryptoJournal.amount":
-		if e.complexity.CryptoJournal.Amount == nil {
+	case "APIKeyResponse.secret":
+		if e.complexity.APIKeyResponse.Secret == nil {
 			break
 		}
 
-		return e.complexity.CryptoJournal.Amount(childComplexity), true
+		return e.complexity.APIKeyResponse.Secret(childComplexity), true
 
-	case "CryptoJournal.clientID":
-		if e.complexity.CryptoJournal.ClientID == nil {
+	case "AdminAccountStatusResponse.clientID":
+		if e.complexity.AdminAccountStatusResponse.ClientID == nil {
 			break
 		}
 
-		return e.complexity.CryptoJournal.ClientID(childComplexity), true
+		return e.complexity.AdminAccountStatusResponse.ClientID(childComplexity), true
 
-	case "CryptoJournal.ticker":
-		if e.complexity.CryptoJournal.Ticker == nil {
+	case "AdminAccountStatusResponse.code":
+		if e.complexity.AdminAccountStatusResponse.Code == nil {
 			break
 		}
 
-		return e.complexity.CryptoJournal.Ticker(childComplexity), true
+		return e.complexity.AdminAccountStatusResponse.Code(childComplexity), true
 
-	case "CryptoJournal.transactedAt":
-		if e.complexity.CryptoJournal.TransactedAt == nil {
+	case "AdminAccountStatusResponse.status":
+		if e.complexity.AdminAccountStatusResponse.Status == nil {
 			break
 		}
 
-		return e.complexity.CryptoJournal.TransactedAt(childComplexity), true
+		return e.complexity.AdminAccountStatusResponse.Status(childComplexity), true
 
-	case "CryptoJournal.txID":
-		if e.complexity.CryptoJournal.TxID == nil {
+	case "AdminAuditLog.action":
+		if e.complexity.AdminAuditLog.Action == nil {
 			break
 		}
 
-		return e.complexity.CryptoJournal.TxID(childComplexity), true
+		return e.complexity.AdminAuditLog.Action(childComplexity), true
 
-	case "CryptoOpenAccountResponse.clientID":
-		if e.complexity.CryptoOpenAccountResponse.ClientID == nil {
+	case "AdminAuditLog.adminID":
+		if e.complexity.AdminAuditLog.AdminID == nil {
 			break
 		}
 
-		return e.complexity.CryptoOpenAccountResponse.ClientID(childComplexity), true
+		return e.complexity.AdminAuditLog.AdminID(childComplexity), true
 
-	case "CryptoOpenAccountResponse.ticker":
-		if e.complexity.CryptoOpenAccountResponse.Ticker == nil {
+	case "AdminAuditLog.createdAt":
+		if e.complexity.AdminAuditLog.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.CryptoOpenAccountResponse.Ticker(childComplexity), true
+		return e.complexity.AdminAuditLog.CreatedAt(childComplexity), true
 
-	case "CryptoTransactionsPaginated.links":
-		if e.complexity.CryptoTransactionsPaginated.Links == nil {
+	case "AdminAuditLog.details":
+		if e.complexity.AdminAuditLog.Details == nil {
 			break
 		}
 
-		return e.complexity.CryptoTransactionsPaginated.Links(childComplexity), true
+		return e.complexity.AdminAuditLog.Details(childComplexity), true
 
-	case "CryptoTransactionsPaginated.transactions":
-		if e.complexity.CryptoTransactionsPaginated.Transactions == nil {
+	case "AdminAuditLog.id":
+		if e.complexity.AdminAuditLog.ID == nil {
 			break
 		}
 
-		return e.complexity.CryptoTransactionsPaginated.Transactions(childComplexity), true
+		return e.complexity.AdminAuditLog.ID(childComplexity), true
 
-	case "CryptoTransferResponse.cryptoTxReceipt":
-		if e.complexity.CryptoTransferResponse.CryptoTxReceipt == nil {
+	case "AdminAuditLog.outcome":
+		if e.complexity.AdminAuditLog.Outcome == nil {
 			break
 		}
 
-		return e.complexity.CryptoTransferResponse.CryptoTxReceipt(childComplexity), true
+		return e.complexity.AdminAuditLog.Outcome(childComplexity), true
 
-	case "CryptoTransferResponse.fiatTxReceipt":
-		if e.complexity.CryptoTransferResponse.FiatTxReceipt == nil {
+	case "AdminAuditLog.target":
+		if e.complexity.AdminAuditLog.Target == nil {
 			break
 		}
 
-		return e.complexity.CryptoTransferResponse.FiatTxReceipt(childComplexity), true
+		return e.complexity.AdminAuditLog.Target(childComplexity), true
 
-	case "FiatAccount.balance":
-		if e.complexity.FiatAccount.Balance == nil {
+	case "AdminAuditLogPaginated.entries":
+		if e.complexity.AdminAuditLogPaginated.Entries == nil {
 			break
 		}
 
-		return e.complexity.FiatAccount.Balance(childComplexity), true
+		return e.complexity.AdminAuditLogPaginated.Entries(childComplexity), true
 
-	case "FiatAccount.clientID":
-		if e.complexity.FiatAccount.ClientID == nil {
+	case "AdminAuditLogPaginated.links":
+		if e.complexity.AdminAuditLogPaginated.Links == nil {
 			break
 		}
 
-		return e.complexity.FiatAccount.ClientID(childComplexity), true
+		return e.complexity.AdminAuditLogPaginated.Links(childComplexity), true
 
-	case "FiatAccount.createdAt":
-		if e.complexity.FiatAccount.CreatedAt == nil {
+	case "AdminFreezeResponse.clientID":
+		if e.complexity.AdminFreezeResponse.ClientID == nil {
 			break
 		}
 
-		return e.complexity.FiatAccount.CreatedAt(childComplexity), true
+		return e.complexity.AdminFreezeResponse.ClientID(childComplexity), true
 
-	case "FiatAccount.currency":
-		if e.complexity.FiatAccount.Currency == nil {
+	case "AdminFreezeResponse.isFrozen":
+		if e.complexity.AdminFreezeResponse.IsFrozen == nil {
 			break
 		}
 
-		return e.complexity.FiatAccount.Currency(childComplexity), true
+		return e.complexity.AdminFreezeResponse.IsFrozen(childComplexity), true
 
-	case "FiatAccount.lastTx":
-		if e.complexity.FiatAccount.LastTx == nil {
+	case "AdminUnlockResponse.clientID":
+		if e.complexity.AdminUnlockResponse.ClientID == nil {
 			break
 		}
 
-		return e.complexity.FiatAccount.LastTx(childComplexity), true
+		return e.complexity.AdminUnlockResponse.ClientID(childComplexity), true
 
-	case "FiatAccount.lastTxTs":
-		if e.complexity.FiatAccount.LastTxTs == nil {
+	case "AuditEvent.actorID":
+		if e.complexity.AuditEvent.ActorID == nil {
 			break
 		}
 
-		return e.complexity.FiatAccount.LastTxTs(childComplexity), true
+		return e.complexity.AuditEvent.ActorID(childComplexity), true
 
-	case "FiatBalancesPaginated.accountBalances":
-		if e.complexity.FiatBalancesPaginated.AccountBalances == nil {
+	case "AuditEvent.createdAt":
+		if e.complexity.AuditEvent.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.FiatBalancesPaginated.AccountBalances(childComplexity), true
+		return e.complexity.AuditEvent.CreatedAt(childComplexity), true
 
-	case "FiatBalancesPaginated.links":
-		if e.complexity.FiatBalancesPaginated.Links == nil {
+	case "AuditEvent.eventID":
+		if e.complexity.AuditEvent.EventID == nil {
 			break
 		}
 
-		return e.complexity.FiatBalancesPaginated.Links(childComplexity), true
+		return e.complexity.AuditEvent.EventID(childComplexity), true
 
-	case "FiatDepositResponse.balance":
-		if e.complexity.FiatDepositResponse.Balance == nil {
+	case "AuditEvent.eventType":
+		if e.complexity.AuditEvent.EventType == nil {
 			break
 		}
 
-		return e.complexity.FiatDepositResponse.Balance(childComplexity), true
+		return e.complexity.AuditEvent.EventType(childComplexity), true
 
-	case "FiatDepositResponse.clientId":
-		if e.complexity.FiatDepositResponse.ClientID == nil {
+	case "AuditEvent.ipAddress":
+		if e.complexity.AuditEvent.IpAddress == nil {
 			break
 		}
 
-		return e.complexity.FiatDepositResponse.ClientID(childComplexity), true
+		return e.complexity.AuditEvent.IpAddress(childComplexity), true
 
-	case "FiatDepositResponse.currency":
-		if e.complexity.FiatDepositResponse.Currency == nil {
+	case "AuditEvent.outcome":
+		if e.complexity.AuditEvent.Outcome == nil {
 			break
 		}
 
-		return e.complexity.FiatDepositResponse.Currency(childComplexity), true
+		return e.complexity.AuditEvent.Outcome(childComplexity), true
 
-	case "FiatDepositResponse.lastTx":
-		if e.complexity.FiatDepositResponse.LastTx == nil {
+	case "AuditEvent.payload":
+		if e.complexity.AuditEvent.Payload == nil {
 			break
 		}
 
-		return e.complexity.FiatDepositResponse.LastTx(childComplexity), true
+		return e.complexity.AuditEvent.Payload(childComplexity), true
 
-	case "FiatDepositResponse.txId":
-		if e.complexity.FiatDepositResponse.TxID == nil {
+	case "AuditEvent.subjectID":
+		if e.complexity.AuditEvent.SubjectID == nil {
 			break
 		}
 
-		return e.complexity.FiatDepositResponse.TxID(childComplexity), true
+		return e.complexity.AuditEvent.SubjectID(childComplexity), true
 
-	case "FiatDepositResponse.txTimestamp":
-		if e.complexity.FiatDepositResponse.TxTimestamp == nil {
+	case "AuditEvent.userAgent":
+		if e.complexity.AuditEvent.UserAgent == nil {
 			break
 		}
 
-		return e.complexity.FiatDepositResponse.TxTimestamp(childComplexity), true
+		return e.complexity.AuditEvent.UserAgent(childComplexity), true
 
-	case "FiatExchangeTransferResponse.destinationReceipt":
-		if e.complexity.FiatExchangeTransferResponse.DestinationReceipt == nil {
+	case "AuditEventsPaginated.events":
+		if e.complexity.AuditEventsPaginated.Events == nil {
 			break
 		}
 
-		return e.complexity.FiatExchangeTransferResponse.DestinationReceipt(childComplexity), true
+		return e.complexity.AuditEventsPaginated.Events(childComplexity), true
 
-	case "FiatExchangeTransferResponse.sourceReceipt":
-		if e.complexity.FiatExchangeTransferResponse.SourceReceipt == nil {
+	case "AuditEventsPaginated.links":
+		if e.complexity.AuditEventsPaginated.Links == nil {
 			break
 		}
 
-		return e.complexity.FiatExchangeTransferResponse.SourceReceipt(childComplexity), true
+		return e.complexity.AuditEventsPaginated.Links(childComplexity), true
 
-	case "FiatJournal.amount":
-		if e.complexity.FiatJournal.Amount == nil {
+	case "BalanceAsOf.asOf":
+		if e.complexity.BalanceAsOf.AsOf == nil {
 			break
 		}
 
-		return e.complexity.FiatJournal.Amount(childComplexity), true
+		return e.complexity.BalanceAsOf.AsOf(childComplexity), true
 
-	case "FiatJournal.clientID":
-		if e.complexity.FiatJournal.ClientID == nil {
+	case "BalanceAsOf.balance":
+		if e.complexity.BalanceAsOf.Balance == nil {
 			break
 		}
 
-		return e.complexity.FiatJournal.ClientID(childComplexity), true
+		return e.complexity.BalanceAsOf.Balance(childComplexity), true
 
-	case "FiatJournal.currency":
-		if e.complexity.FiatJournal.Currency == nil {
+	case "BalanceAsOf.currency":
+		if e.complexity.BalanceAsOf.Currency == nil {
 			break
 		}
 
-		return e.complexity.FiatJournal.Currency(childComplexity), true
+		return e.complexity.BalanceAsOf.Currency(childComplexity), true
 
-	case "FiatJournal.transactedAt":
-		if e.complexity.FiatJournal.TransactedAt == nil {
+	case "BalanceAsOf.snapshotAt":
+		if e.complexity.BalanceAsOf.SnapshotAt == nil {
 			break
 		}
 
-		return e.complexity.FiatJournal.TransactedAt(childComplexity), true
+		return e.complexity.BalanceAsOf.SnapshotAt(childComplexity), true
 
-	case "FiatJournal.txID":
-		if e.complexity.FiatJournal.TxID == nil {
+	case "CheckpointExport.algorithm":
+		if e.complexity.CheckpointExport.Algorithm == nil {
 			break
 		}
 
-		return e.complexity.FiatJournal.TxID(childComplexity), true
+		return e.complexity.CheckpointExport.Algorithm(childComplexity), true
 
-	case "FiatOpenAccountResponse.clientID":
-		if e.complexity.FiatOpenAccountResponse.ClientID == nil {
+	case "CheckpointExport.checkpoints":
+		if e.complexity.CheckpointExport.Checkpoints == nil {
 			break
 		}
 
-		return e.complexity.FiatOpenAccountResponse.ClientID(childComplexity), true
+		return e.complexity.CheckpointExport.Checkpoints(childComplexity), true
 
-	case "FiatOpenAccountResponse.currency":
-		if e.complexity.FiatOpenAccountResponse.Currency == nil {
+	case "CheckpointExport.keyID":
+		if e.complexity.CheckpointExport.KeyID == nil {
 			break
 		}
 
-		return e.complexity.FiatOpenAccountResponse.Currency(childComplexity), true
+		return e.complexity.CheckpointExport.KeyID(childComplexity), true
 
-	case "FiatTransactionsPaginated.links":
-		if e.complexity.FiatTransactionsPaginated.Links == nil {
+	case "CheckpointExport.publicKey":
+		if e.complexity.CheckpointExport.PublicKey == nil {
 			break
 		}
 
-		return e.complexity.FiatTransactionsPaginated.Links(childComplexity), true
+		return e.complexity.CheckpointExport.PublicKey(childComplexity), true
 
-	case "FiatTransactionsPaginated.transactions":
-		if e.complexity.FiatTransactionsPaginated.Transactions == nil {
+	case "CryptoAccount.available":
+		if e.complexity.CryptoAccount.Available == nil {
 			break
 		}
 
-		return e.complexity.FiatTransactionsPaginated.Transactions(childComplexity), true
+		return e.complexity.CryptoAccount.Available(childComplexity), true
 
-	case "JWTAuthResponse.expires":
-		if e.complexity.JWTAuthResponse.Expires == nil {
+	case "CryptoAccount.balance":
+		if e.complexity.CryptoAccount.Balance == nil {
 			break
 		}
 
-		return e.complexity.JWTAuthResponse.Expires(childComplexity), true
+		return e.complexity.CryptoAccount.Balance(childComplexity), true
 
-	case "JWTAuthResponse.threshold":
-		if e.complexity.JWTAuthResponse.Threshold == nil {
+	case "CryptoAccount.clientID":
+		if e.complexity.CryptoAccount.ClientID == nil {
 			break
 		}
 
-		return e.complexity.JWTAuthResponse.Threshold(childComplexity), true
+		return e.complexity.CryptoAccount.ClientID(childComplexity), true
 
-	case "JWTAuthResponse.token":
-		if e.complexity.JWTAuthResponse.Token == nil {
+	case "CryptoAccount.createdAt":
+		if e.complexity.CryptoAccount.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.JWTAuthResponse.Token(childComplexity), true
+		return e.complexity.CryptoAccount.CreatedAt(childComplexity), true
+
+	case "CryptoAccount.lastTx":
+		if e.complexity.CryptoAccount.LastTx == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.LastTx(childComplexity), true
+
+	case "CryptoAccount.lastTxTs":
+		if e.complexity.CryptoAccount.LastTxTs == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.LastTxTs(childComplexity), true
+
+	case "CryptoAccount.status":
+		if e.complexity.CryptoAccount.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.Status(childComplexity), true
+
+	case "CryptoAccount.ticker":
+		if e.complexity.CryptoAccount.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.Ticker(childComplexity), true
+
+	case "CryptoAsset.decimalPlaces":
+		if e.complexity.CryptoAsset.DecimalPlaces == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.DecimalPlaces(childComplexity), true
+
+	case "CryptoAsset.maxOrder":
+		if e.complexity.CryptoAsset.MaxOrder == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.MaxOrder(childComplexity), true
+
+	case "CryptoAsset.minOrder":
+		if e.complexity.CryptoAsset.MinOrder == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.MinOrder(childComplexity), true
+
+	case "CryptoAsset.name":
+		if e.complexity.CryptoAsset.Name == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.Name(childComplexity), true
+
+	case "CryptoAsset.status":
+		if e.complexity.CryptoAsset.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.Status(childComplexity), true
+
+	case "CryptoAsset.ticker":
+		if e.complexity.CryptoAsset.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.Ticker(childComplexity), true
+
+	case "CryptoAsset.updatedAt":
+		if e.complexity.CryptoAsset.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoAsset.UpdatedAt(childComplexity), true
+
+	case "CryptoBalanceHistory.from":
+		if e.complexity.CryptoBalanceHistory.From == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceHistory.From(childComplexity), true
+
+	case "CryptoBalanceHistory.snapshots":
+		if e.complexity.CryptoBalanceHistory.Snapshots == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceHistory.Snapshots(childComplexity), true
+
+	case "CryptoBalanceHistory.ticker":
+		if e.complexity.CryptoBalanceHistory.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceHistory.Ticker(childComplexity), true
+
+	case "CryptoBalanceHistory.to":
+		if e.complexity.CryptoBalanceHistory.To == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceHistory.To(childComplexity), true
+
+	case "CryptoBalanceSnapshot.balance":
+		if e.complexity.CryptoBalanceSnapshot.Balance == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceSnapshot.Balance(childComplexity), true
+
+	case "CryptoBalanceSnapshot.clientID":
+		if e.complexity.CryptoBalanceSnapshot.ClientID == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceSnapshot.ClientID(childComplexity), true
+
+	case "CryptoBalanceSnapshot.createdAt":
+		if e.complexity.CryptoBalanceSnapshot.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceSnapshot.CreatedAt(childComplexity), true
+
+	case "CryptoBalanceSnapshot.snapshotAt":
+		if e.complexity.CryptoBalanceSnapshot.SnapshotAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceSnapshot.SnapshotAt(childComplexity), true
+
+	case "CryptoBalanceSnapshot.ticker":
+		if e.complexity.CryptoBalanceSnapshot.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalanceSnapshot.Ticker(childComplexity), true
+
+	case "CryptoBalancesPaginated.accountBalances":
+		if e.complexity.CryptoBalancesPaginated.AccountBalances == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalancesPaginated.AccountBalances(childComplexity), true
+
+	case "CryptoBalancesPaginated.links":
+		if e.complexity.CryptoBalancesPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.CryptoBalancesPaginated.Links(childComplexity), true
+
+	case "CryptoJournal.amount":
+		if e.complexity.CryptoJournal.Amount == nil {
+			break
+		}
+
+		return e.complexity.CryptoJournal.Amount(childComplexity), true
+
+	case "CryptoJournal.clientID":
+		if e.complexity.CryptoJournal.ClientID == nil {
+			break
+		}
+
+		return e.complexity.CryptoJournal.ClientID(childComplexity), true
+
+	case "CryptoJournal.ticker":
+		if e.complexity.CryptoJournal.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoJournal.Ticker(childComplexity), true
+
+	case "CryptoJournal.transactedAt":
+		if e.complexity.CryptoJournal.TransactedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoJournal.TransactedAt(childComplexity), true
+
+	case "CryptoJournal.txID":
+		if e.complexity.CryptoJournal.TxID == nil {
+			break
+		}
+
+		return e.complexity.CryptoJournal.TxID(childComplexity), true
+
+	case "CryptoLimitOrder.amount":
+		if e.complexity.CryptoLimitOrder.Amount == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.Amount(childComplexity), true
+
+	case "CryptoLimitOrder.clientID":
+		if e.complexity.CryptoLimitOrder.ClientID == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.ClientID(childComplexity), true
+
+	case "CryptoLimitOrder.createdAt":
+		if e.complexity.CryptoLimitOrder.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.CreatedAt(childComplexity), true
+
+	case "CryptoLimitOrder.expiresAt":
+		if e.complexity.CryptoLimitOrder.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.ExpiresAt(childComplexity), true
+
+	case "CryptoLimitOrder.fiatCurrency":
+		if e.complexity.CryptoLimitOrder.FiatCurrency == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.FiatCurrency(childComplexity), true
+
+	case "CryptoLimitOrder.isPurchase":
+		if e.complexity.CryptoLimitOrder.IsPurchase == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.IsPurchase(childComplexity), true
+
+	case "CryptoLimitOrder.limitPrice":
+		if e.complexity.CryptoLimitOrder.LimitPrice == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.LimitPrice(childComplexity), true
+
+	case "CryptoLimitOrder.orderID":
+		if e.complexity.CryptoLimitOrder.OrderID == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.OrderID(childComplexity), true
+
+	case "CryptoLimitOrder.status":
+		if e.complexity.CryptoLimitOrder.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.Status(childComplexity), true
+
+	case "CryptoLimitOrder.ticker":
+		if e.complexity.CryptoLimitOrder.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.Ticker(childComplexity), true
+
+	case "CryptoLimitOrder.txID":
+		if e.complexity.CryptoLimitOrder.TxID == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.TxID(childComplexity), true
+
+	case "CryptoLimitOrder.updatedAt":
+		if e.complexity.CryptoLimitOrder.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrder.UpdatedAt(childComplexity), true
+
+	case "CryptoLimitOrderDetails.history":
+		if e.complexity.CryptoLimitOrderDetails.History == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderDetails.History(childComplexity), true
+
+	case "CryptoLimitOrderDetails.order":
+		if e.complexity.CryptoLimitOrderDetails.Order == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderDetails.Order(childComplexity), true
+
+	case "CryptoLimitOrderEvent.createdAt":
+		if e.complexity.CryptoLimitOrderEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderEvent.CreatedAt(childComplexity), true
+
+	case "CryptoLimitOrderEvent.details":
+		if e.complexity.CryptoLimitOrderEvent.Details == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderEvent.Details(childComplexity), true
+
+	case "CryptoLimitOrderEvent.id":
+		if e.complexity.CryptoLimitOrderEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderEvent.ID(childComplexity), true
+
+	case "CryptoLimitOrderEvent.orderID":
+		if e.complexity.CryptoLimitOrderEvent.OrderID == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderEvent.OrderID(childComplexity), true
+
+	case "CryptoLimitOrderEvent.price":
+		if e.complexity.CryptoLimitOrderEvent.Price == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderEvent.Price(childComplexity), true
+
+	case "CryptoLimitOrderEvent.status":
+		if e.complexity.CryptoLimitOrderEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrderEvent.Status(childComplexity), true
+
+	case "CryptoLimitOrdersPaginated.links":
+		if e.complexity.CryptoLimitOrdersPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrdersPaginated.Links(childComplexity), true
+
+	case "CryptoLimitOrdersPaginated.orders":
+		if e.complexity.CryptoLimitOrdersPaginated.Orders == nil {
+			break
+		}
+
+		return e.complexity.CryptoLimitOrdersPaginated.Orders(childComplexity), true
+
+	case "CryptoOpenAccountResponse.clientID":
+		if e.complexity.CryptoOpenAccountResponse.ClientID == nil {
+			break
+		}
+
+		return e.complexity.CryptoOpenAccountResponse.ClientID(childComplexity), true
+
+	case "CryptoOpenAccountResponse.ticker":
+		if e.complexity.CryptoOpenAccountResponse.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoOpenAccountResponse.Ticker(childComplexity), true
+
+	case "CryptoRecurringPurchase.amount":
+		if e.complexity.CryptoRecurringPurchase.Amount == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.Amount(childComplexity), true
+
+	case "CryptoRecurringPurchase.cadence":
+		if e.complexity.CryptoRecurringPurchase.Cadence == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.Cadence(childComplexity), true
+
+	case "CryptoRecurringPurchase.clientID":
+		if e.complexity.CryptoRecurringPurchase.ClientID == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.ClientID(childComplexity), true
+
+	case "CryptoRecurringPurchase.createdAt":
+		if e.complexity.CryptoRecurringPurchase.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.CreatedAt(childComplexity), true
+
+	case "CryptoRecurringPurchase.endsAt":
+		if e.complexity.CryptoRecurringPurchase.EndsAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.EndsAt(childComplexity), true
+
+	case "CryptoRecurringPurchase.fiatCurrency":
+		if e.complexity.CryptoRecurringPurchase.FiatCurrency == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.FiatCurrency(childComplexity), true
+
+	case "CryptoRecurringPurchase.nextRunAt":
+		if e.complexity.CryptoRecurringPurchase.NextRunAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.NextRunAt(childComplexity), true
+
+	case "CryptoRecurringPurchase.planID":
+		if e.complexity.CryptoRecurringPurchase.PlanID == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.PlanID(childComplexity), true
+
+	case "CryptoRecurringPurchase.startsAt":
+		if e.complexity.CryptoRecurringPurchase.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.StartsAt(childComplexity), true
+
+	case "CryptoRecurringPurchase.status":
+		if e.complexity.CryptoRecurringPurchase.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.Status(childComplexity), true
+
+	case "CryptoRecurringPurchase.ticker":
+		if e.complexity.CryptoRecurringPurchase.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.Ticker(childComplexity), true
+
+	case "CryptoRecurringPurchase.updatedAt":
+		if e.complexity.CryptoRecurringPurchase.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchase.UpdatedAt(childComplexity), true
+
+	case "CryptoRecurringPurchaseDetails.links":
+		if e.complexity.CryptoRecurringPurchaseDetails.Links == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseDetails.Links(childComplexity), true
+
+	case "CryptoRecurringPurchaseDetails.plan":
+		if e.complexity.CryptoRecurringPurchaseDetails.Plan == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseDetails.Plan(childComplexity), true
+
+	case "CryptoRecurringPurchaseDetails.runs":
+		if e.complexity.CryptoRecurringPurchaseDetails.Runs == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseDetails.Runs(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.createdAt":
+		if e.complexity.CryptoRecurringPurchaseRun.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.CreatedAt(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.cryptoAmount":
+		if e.complexity.CryptoRecurringPurchaseRun.CryptoAmount == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.CryptoAmount(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.details":
+		if e.complexity.CryptoRecurringPurchaseRun.Details == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.Details(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.fiatAmount":
+		if e.complexity.CryptoRecurringPurchaseRun.FiatAmount == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.FiatAmount(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.id":
+		if e.complexity.CryptoRecurringPurchaseRun.ID == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.ID(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.planID":
+		if e.complexity.CryptoRecurringPurchaseRun.PlanID == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.PlanID(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.price":
+		if e.complexity.CryptoRecurringPurchaseRun.Price == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.Price(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.scheduledAt":
+		if e.complexity.CryptoRecurringPurchaseRun.ScheduledAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.ScheduledAt(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.status":
+		if e.complexity.CryptoRecurringPurchaseRun.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.Status(childComplexity), true
+
+	case "CryptoRecurringPurchaseRun.txID":
+		if e.complexity.CryptoRecurringPurchaseRun.TxID == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchaseRun.TxID(childComplexity), true
+
+	case "CryptoRecurringPurchasesPaginated.links":
+		if e.complexity.CryptoRecurringPurchasesPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchasesPaginated.Links(childComplexity), true
+
+	case "CryptoRecurringPurchasesPaginated.plans":
+		if e.complexity.CryptoRecurringPurchasesPaginated.Plans == nil {
+			break
+		}
+
+		return e.complexity.CryptoRecurringPurchasesPaginated.Plans(childComplexity), true
+
+	case "CryptoTransactionsPaginated.links":
+		if e.complexity.CryptoTransactionsPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.CryptoTransactionsPaginated.Links(childComplexity), true
+
+	case "CryptoTransactionsPaginated.transactions":
+		if e.complexity.CryptoTransactionsPaginated.Transactions == nil {
+			break
+		}
+
+		return e.complexity.CryptoTransactionsPaginated.Transactions(childComplexity), true
+
+	case "CryptoTransferResponse.cryptoTxReceipt":
+		if e.complexity.CryptoTransferResponse.CryptoTxReceipt == nil {
+			break
+		}
+
+		return e.complexity.CryptoTransferResponse.CryptoTxReceipt(childComplexity), true
+
+	case "CryptoTransferResponse.fiatTxReceipt":
+		if e.complexity.CryptoTransferResponse.FiatTxReceipt == nil {
+			break
+		}
+
+		return e.complexity.CryptoTransferResponse.FiatTxReceipt(childComplexity), true
+
+	case "CryptoTriggerOrder.amount":
+		if e.complexity.CryptoTriggerOrder.Amount == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.Amount(childComplexity), true
+
+	case "CryptoTriggerOrder.clientID":
+		if e.complexity.CryptoTriggerOrder.ClientID == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.ClientID(childComplexity), true
+
+	case "CryptoTriggerOrder.createdAt":
+		if e.complexity.CryptoTriggerOrder.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.CreatedAt(childComplexity), true
+
+	case "CryptoTriggerOrder.fiatCurrency":
+		if e.complexity.CryptoTriggerOrder.FiatCurrency == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.FiatCurrency(childComplexity), true
+
+	case "CryptoTriggerOrder.orderID":
+		if e.complexity.CryptoTriggerOrder.OrderID == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.OrderID(childComplexity), true
+
+	case "CryptoTriggerOrder.status":
+		if e.complexity.CryptoTriggerOrder.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.Status(childComplexity), true
+
+	case "CryptoTriggerOrder.ticker":
+		if e.complexity.CryptoTriggerOrder.Ticker == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.Ticker(childComplexity), true
+
+	case "CryptoTriggerOrder.trailingDistance":
+		if e.complexity.CryptoTriggerOrder.TrailingDistance == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.TrailingDistance(childComplexity), true
+
+	case "CryptoTriggerOrder.triggerPrice":
+		if e.complexity.CryptoTriggerOrder.TriggerPrice == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.TriggerPrice(childComplexity), true
+
+	case "CryptoTriggerOrder.triggerType":
+		if e.complexity.CryptoTriggerOrder.TriggerType == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.TriggerType(childComplexity), true
+
+	case "CryptoTriggerOrder.txID":
+		if e.complexity.CryptoTriggerOrder.TxID == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.TxID(childComplexity), true
+
+	case "CryptoTriggerOrder.updatedAt":
+		if e.complexity.CryptoTriggerOrder.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrder.UpdatedAt(childComplexity), true
+
+	case "CryptoTriggerOrderDetails.history":
+		if e.complexity.CryptoTriggerOrderDetails.History == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderDetails.History(childComplexity), true
+
+	case "CryptoTriggerOrderDetails.order":
+		if e.complexity.CryptoTriggerOrderDetails.Order == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderDetails.Order(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.amount":
+		if e.complexity.CryptoTriggerOrderEvent.Amount == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.Amount(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.createdAt":
+		if e.complexity.CryptoTriggerOrderEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.CreatedAt(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.details":
+		if e.complexity.CryptoTriggerOrderEvent.Details == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.Details(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.id":
+		if e.complexity.CryptoTriggerOrderEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.ID(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.orderID":
+		if e.complexity.CryptoTriggerOrderEvent.OrderID == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.OrderID(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.price":
+		if e.complexity.CryptoTriggerOrderEvent.Price == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.Price(childComplexity), true
+
+	case "CryptoTriggerOrderEvent.status":
+		if e.complexity.CryptoTriggerOrderEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrderEvent.Status(childComplexity), true
+
+	case "CryptoTriggerOrdersPaginated.links":
+		if e.complexity.CryptoTriggerOrdersPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrdersPaginated.Links(childComplexity), true
+
+	case "CryptoTriggerOrdersPaginated.orders":
+		if e.complexity.CryptoTriggerOrdersPaginated.Orders == nil {
+			break
+		}
+
+		return e.complexity.CryptoTriggerOrdersPaginated.Orders(childComplexity), true
+
+	case "ExportedCheckpoint.createdAt":
+		if e.complexity.ExportedCheckpoint.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.CreatedAt(childComplexity), true
+
+	case "ExportedCheckpoint.entryHash":
+		if e.complexity.ExportedCheckpoint.EntryHash == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.EntryHash(childComplexity), true
+
+	case "ExportedCheckpoint.journal":
+		if e.complexity.ExportedCheckpoint.Journal == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.Journal(childComplexity), true
+
+	case "ExportedCheckpoint.keyID":
+		if e.complexity.ExportedCheckpoint.KeyID == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.KeyID(childComplexity), true
+
+	case "ExportedCheckpoint.message":
+		if e.complexity.ExportedCheckpoint.Message == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.Message(childComplexity), true
+
+	case "ExportedCheckpoint.publicKey":
+		if e.complexity.ExportedCheckpoint.PublicKey == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.PublicKey(childComplexity), true
+
+	case "ExportedCheckpoint.seq":
+		if e.complexity.ExportedCheckpoint.Seq == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.Seq(childComplexity), true
+
+	case "ExportedCheckpoint.signature":
+		if e.complexity.ExportedCheckpoint.Signature == nil {
+			break
+		}
+
+		return e.complexity.ExportedCheckpoint.Signature(childComplexity), true
+
+	case "FiatAccount.available":
+		if e.complexity.FiatAccount.Available == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Available(childComplexity), true
+
+	case "FiatAccount.balance":
+		if e.complexity.FiatAccount.Balance == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Balance(childComplexity), true
+
+	case "FiatAccount.clientID":
+		if e.complexity.FiatAccount.ClientID == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.ClientID(childComplexity), true
+
+	case "FiatAccount.createdAt":
+		if e.complexity.FiatAccount.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.CreatedAt(childComplexity), true
+
+	case "FiatAccount.currency":
+		if e.complexity.FiatAccount.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Currency(childComplexity), true
+
+	case "FiatAccount.lastTx":
+		if e.complexity.FiatAccount.LastTx == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.LastTx(childComplexity), true
+
+	case "FiatAccount.lastTxTs":
+		if e.complexity.FiatAccount.LastTxTs == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.LastTxTs(childComplexity), true
+
+	case "FiatAccount.status":
+		if e.complexity.FiatAccount.Status == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Status(childComplexity), true
+
+	case "FiatAdjustment.adjustmentID":
+		if e.complexity.FiatAdjustment.AdjustmentID == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.AdjustmentID(childComplexity), true
+
+	case "FiatAdjustment.amount":
+		if e.complexity.FiatAdjustment.Amount == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.Amount(childComplexity), true
+
+	case "FiatAdjustment.checkerID":
+		if e.complexity.FiatAdjustment.CheckerID == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.CheckerID(childComplexity), true
+
+	case "FiatAdjustment.clientID":
+		if e.complexity.FiatAdjustment.ClientID == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.ClientID(childComplexity), true
+
+	case "FiatAdjustment.createdAt":
+		if e.complexity.FiatAdjustment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.CreatedAt(childComplexity), true
+
+	case "FiatAdjustment.currency":
+		if e.complexity.FiatAdjustment.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.Currency(childComplexity), true
+
+	case "FiatAdjustment.decidedAt":
+		if e.complexity.FiatAdjustment.DecidedAt == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.DecidedAt(childComplexity), true
+
+	case "FiatAdjustment.decisionNote":
+		if e.complexity.FiatAdjustment.DecisionNote == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.DecisionNote(childComplexity), true
+
+	case "FiatAdjustment.justification":
+		if e.complexity.FiatAdjustment.Justification == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.Justification(childComplexity), true
+
+	case "FiatAdjustment.makerID":
+		if e.complexity.FiatAdjustment.MakerID == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.MakerID(childComplexity), true
+
+	case "FiatAdjustment.reasonCode":
+		if e.complexity.FiatAdjustment.ReasonCode == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.ReasonCode(childComplexity), true
+
+	case "FiatAdjustment.status":
+		if e.complexity.FiatAdjustment.Status == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.Status(childComplexity), true
+
+	case "FiatAdjustment.txID":
+		if e.complexity.FiatAdjustment.TxID == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustment.TxID(childComplexity), true
+
+	case "FiatAdjustmentsPaginated.adjustments":
+		if e.complexity.FiatAdjustmentsPaginated.Adjustments == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustmentsPaginated.Adjustments(childComplexity), true
+
+	case "FiatAdjustmentsPaginated.links":
+		if e.complexity.FiatAdjustmentsPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.FiatAdjustmentsPaginated.Links(childComplexity), true
+
+	case "FiatBalanceHistory.currency":
+		if e.complexity.FiatBalanceHistory.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceHistory.Currency(childComplexity), true
+
+	case "FiatBalanceHistory.from":
+		if e.complexity.FiatBalanceHistory.From == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceHistory.From(childComplexity), true
+
+	case "FiatBalanceHistory.snapshots":
+		if e.complexity.FiatBalanceHistory.Snapshots == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceHistory.Snapshots(childComplexity), true
+
+	case "FiatBalanceHistory.to":
+		if e.complexity.FiatBalanceHistory.To == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceHistory.To(childComplexity), true
+
+	case "FiatBalanceSnapshot.balance":
+		if e.complexity.FiatBalanceSnapshot.Balance == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceSnapshot.Balance(childComplexity), true
+
+	case "FiatBalanceSnapshot.clientID":
+		if e.complexity.FiatBalanceSnapshot.ClientID == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceSnapshot.ClientID(childComplexity), true
+
+	case "FiatBalanceSnapshot.createdAt":
+		if e.complexity.FiatBalanceSnapshot.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceSnapshot.CreatedAt(childComplexity), true
+
+	case "FiatBalanceSnapshot.currency":
+		if e.complexity.FiatBalanceSnapshot.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceSnapshot.Currency(childComplexity), true
+
+	case "FiatBalanceSnapshot.snapshotAt":
+		if e.complexity.FiatBalanceSnapshot.SnapshotAt == nil {
+			break
+		}
+
+		return e.complexity.FiatBalanceSnapshot.SnapshotAt(childComplexity), true
+
+	case "FiatBalancesPaginated.accountBalances":
+		if e.complexity.FiatBalancesPaginated.AccountBalances == nil {
+			break
+		}
+
+		return e.complexity.FiatBalancesPaginated.AccountBalances(childComplexity), true
+
+	case "FiatBalancesPaginated.links":
+		if e.complexity.FiatBalancesPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.FiatBalancesPaginated.Links(childComplexity), true
+
+	case "FiatCloseAccountResponse.destinationReceipt":
+		if e.complexity.FiatCloseAccountResponse.DestinationReceipt == nil {
+			break
+		}
+
+		return e.complexity.FiatCloseAccountResponse.DestinationReceipt(childComplexity), true
+
+	case "FiatCloseAccountResponse.sourceReceipt":
+		if e.complexity.FiatCloseAccountResponse.SourceReceipt == nil {
+			break
+		}
+
+		return e.complexity.FiatCloseAccountResponse.SourceReceipt(childComplexity), true
+
+	case "FiatCurrency.code":
+		if e.complexity.FiatCurrency.Code == nil {
+			break
+		}
+
+		return e.complexity.FiatCurrency.Code(childComplexity), true
+
+	case "FiatCurrency.status":
+		if e.complexity.FiatCurrency.Status == nil {
+			break
+		}
+
+		return e.complexity.FiatCurrency.Status(childComplexity), true
+
+	case "FiatCurrency.updatedAt":
+		if e.complexity.FiatCurrency.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.FiatCurrency.UpdatedAt(childComplexity), true
+
+	case "FiatDepositResponse.balance":
+		if e.complexity.FiatDepositResponse.Balance == nil {
+			break
+		}
+
+		return e.complexity.FiatDepositResponse.Balance(childComplexity), true
+
+	case "FiatDepositResponse.clientId":
+		if e.complexity.FiatDepositResponse.ClientID == nil {
+			break
+		}
+
+		return e.complexity.FiatDepositResponse.ClientID(childComplexity), true
+
+	case "FiatDepositResponse.currency":
+		if e.complexity.FiatDepositResponse.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatDepositResponse.Currency(childComplexity), true
+
+	case "FiatDepositResponse.lastTx":
+		if e.complexity.FiatDepositResponse.LastTx == nil {
+			break
+		}
+
+		return e.complexity.FiatDepositResponse.LastTx(childComplexity), true
+
+	case "FiatDepositResponse.txId":
+		if e.complexity.FiatDepositResponse.TxID == nil {
+			break
+		}
+
+		return e.complexity.FiatDepositResponse.TxID(childComplexity), true
+
+	case "FiatDepositResponse.txTimestamp":
+		if e.complexity.FiatDepositResponse.TxTimestamp == nil {
+			break
+		}
+
+		return e.complexity.FiatDepositResponse.TxTimestamp(childComplexity), true
+
+	case "FiatExchangeTransferResponse.destinationReceipt":
+		if e.complexity.FiatExchangeTransferResponse.DestinationReceipt == nil {
+			break
+		}
+
+		return e.complexity.FiatExchangeTransferResponse.DestinationReceipt(childComplexity), true
+
+	case "FiatExchangeTransferResponse.sourceReceipt":
+		if e.complexity.FiatExchangeTransferResponse.SourceReceipt == nil {
+			break
+		}
+
+		return e.complexity.FiatExchangeTransferResponse.SourceReceipt(childComplexity), true
+
+	case "FiatJournal.amount":
+		if e.complexity.FiatJournal.Amount == nil {
+			break
+		}
+
+		return e.complexity.FiatJournal.Amount(childComplexity), true
+
+	case "FiatJournal.clientID":
+		if e.complexity.FiatJournal.ClientID == nil {
+			break
+		}
+
+		return e.complexity.FiatJournal.ClientID(childComplexity), true
+
+	case "FiatJournal.currency":
+		if e.complexity.FiatJournal.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatJournal.Currency(childComplexity), true
+
+	case "FiatJournal.transactedAt":
+		if e.complexity.FiatJournal.TransactedAt == nil {
+			break
+		}
+
+		return e.complexity.FiatJournal.TransactedAt(childComplexity), true
+
+	case "FiatJournal.txID":
+		if e.complexity.FiatJournal.TxID == nil {
+			break
+		}
+
+		return e.complexity.FiatJournal.TxID(childComplexity), true
+
+	case "FiatOpenAccountResponse.clientID":
+		if e.complexity.FiatOpenAccountResponse.ClientID == nil {
+			break
+		}
+
+		return e.complexity.FiatOpenAccountResponse.ClientID(childComplexity), true
+
+	case "FiatOpenAccountResponse.currency":
+		if e.complexity.FiatOpenAccountResponse.Currency == nil {
+			break
+		}
+
+		return e.complexity.FiatOpenAccountResponse.Currency(childComplexity), true
+
+	case "FiatTransactionsPaginated.links":
+		if e.complexity.FiatTransactionsPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.FiatTransactionsPaginated.Links(childComplexity), true
+
+	case "FiatTransactionsPaginated.transactions":
+		if e.complexity.FiatTransactionsPaginated.Transactions == nil {
+			break
+		}
+
+		return e.complexity.FiatTransactionsPaginated.Transactions(childComplexity), true
+
+	case "JWTAuthResponse.expires":
+		if e.complexity.JWTAuthResponse.Expires == nil {
+			break
+		}
+
+		return e.complexity.JWTAuthResponse.Expires(childComplexity), true
+
+	case "JWTAuthResponse.refreshExpires":
+		if e.complexity.JWTAuthResponse.RefreshExpires == nil {
+			break
+		}
+
+		return e.complexity.JWTAuthResponse.RefreshExpires(childComplexity), true
+
+	case "JWTAuthResponse.refreshToken":
+		if e.complexity.JWTAuthResponse.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.JWTAuthResponse.RefreshToken(childComplexity), true
+
+	case "JWTAuthResponse.threshold":
+		if e.complexity.JWTAuthResponse.Threshold == nil {
+			break
+		}
+
+		return e.complexity.JWTAuthResponse.Threshold(childComplexity), true
+
+	case "JWTAuthResponse.token":
+		if e.complexity.JWTAuthResponse.Token == nil {
+			break
+		}
+
+		return e.complexity.JWTAuthResponse.Token(childComplexity), true
+
+	case "JournalChainBreak.reason":
+		if e.complexity.JournalChainBreak.Reason == nil {
+			break
+		}
+
+		return e.complexity.JournalChainBreak.Reason(childComplexity), true
+
+	case "JournalChainBreak.seq":
+		if e.complexity.JournalChainBreak.Seq == nil {
+			break
+		}
+
+		return e.complexity.JournalChainBreak.Seq(childComplexity), true
+
+	case "JournalChainBreak.txID":
+		if e.complexity.JournalChainBreak.TxID == nil {
+			break
+		}
+
+		return e.complexity.JournalChainBreak.TxID(childComplexity), true
+
+	case "JournalChainVerification.anchorSeq":
+		if e.complexity.JournalChainVerification.AnchorSeq == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.AnchorSeq(childComplexity), true
+
+	case "JournalChainVerification.archivedCheckpoints":
+		if e.complexity.JournalChainVerification.ArchivedCheckpoints == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.ArchivedCheckpoints(childComplexity), true
+
+	case "JournalChainVerification.break":
+		if e.complexity.JournalChainVerification.Break == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.Break(childComplexity), true
+
+	case "JournalChainVerification.checkpoints":
+		if e.complexity.JournalChainVerification.Checkpoints == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.Checkpoints(childComplexity), true
+
+	case "JournalChainVerification.entries":
+		if e.complexity.JournalChainVerification.Entries == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.Entries(childComplexity), true
+
+	case "JournalChainVerification.firstSeq":
+		if e.complexity.JournalChainVerification.FirstSeq == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.FirstSeq(childComplexity), true
+
+	case "JournalChainVerification.intact":
+		if e.complexity.JournalChainVerification.Intact == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.Intact(childComplexity), true
+
+	case "JournalChainVerification.journal":
+		if e.complexity.JournalChainVerification.Journal == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.Journal(childComplexity), true
+
+	case "JournalChainVerification.lastHash":
+		if e.complexity.JournalChainVerification.LastHash == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.LastHash(childComplexity), true
+
+	case "JournalChainVerification.lastSeq":
+		if e.complexity.JournalChainVerification.LastSeq == nil {
+			break
+		}
+
+		return e.complexity.JournalChainVerification.LastSeq(childComplexity), true
+
+	case "LedgerVerification.intact":
+		if e.complexity.LedgerVerification.Intact == nil {
+			break
+		}
+
+		return e.complexity.LedgerVerification.Intact(childComplexity), true
+
+	case "LedgerVerification.journals":
+		if e.complexity.LedgerVerification.Journals == nil {
+			break
+		}
+
+		return e.complexity.LedgerVerification.Journals(childComplexity), true
 
 	case "Links.nextPage":
 		if e.complexity.Links.NextPage == nil {
 			break
 		}
 
-		return e.complexity.Links.NextPage(childComplexity), true
+		return e.complexity.Links.NextPage(childComplexity), true
+
+	case "Links.pageCursor":
+		if e.complexity.Links.PageCursor == nil {
+			break
+		}
+
+		return e.complexity.Links.PageCursor(childComplexity), true
+
+	case "LoginLockout.expiresAt":
+		if e.complexity.LoginLockout.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.ExpiresAt(childComplexity), true
+
+	case "LoginLockout.failures":
+		if e.complexity.LoginLockout.Failures == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.Failures(childComplexity), true
+
+	case "LoginLockout.ipAddress":
+		if e.complexity.LoginLockout.IpAddress == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.IpAddress(childComplexity), true
+
+	case "LoginLockout.lockedAt":
+		if e.complexity.LoginLockout.LockedAt == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.LockedAt(childComplexity), true
+
+	case "LoginLockout.lockoutID":
+		if e.complexity.LoginLockout.LockoutID == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.LockoutID(childComplexity), true
+
+	case "LoginLockout.scope":
+		if e.complexity.LoginLockout.Scope == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.Scope(childComplexity), true
+
+	case "LoginLockout.subject":
+		if e.complexity.LoginLockout.Subject == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.Subject(childComplexity), true
+
+	case "LoginLockout.unlockedAt":
+		if e.complexity.LoginLockout.UnlockedAt == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.UnlockedAt(childComplexity), true
+
+	case "LoginLockout.unlockedBy":
+		if e.complexity.LoginLockout.UnlockedBy == nil {
+			break
+		}
+
+		return e.complexity.LoginLockout.UnlockedBy(childComplexity), true
+
+	case "MFAEnrollment.secret":
+		if e.complexity.MFAEnrollment.Secret == nil {
+			break
+		}
+
+		return e.complexity.MFAEnrollment.Secret(childComplexity), true
+
+	case "MFAEnrollment.uri":
+		if e.complexity.MFAEnrollment.URI == nil {
+			break
+		}
+
+		return e.complexity.MFAEnrollment.URI(childComplexity), true
+
+	case "Mutation.adminApproveAdjustment":
+		if e.complexity.Mutation.AdminApproveAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminApproveAdjustment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminApproveAdjustment(childComplexity, args["adjustmentID"].(string), args["note"].(string)), true
+
+	case "Mutation.adminCryptoAccountStatus":
+		if e.complexity.Mutation.AdminCryptoAccountStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminCryptoAccountStatus_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminCryptoAccountStatus(childComplexity, args["clientID"].(string), args["ticker"].(string), args["status"].(string), args["reason"].(string)), true
+
+	case "Mutation.adminFiatAccountStatus":
+		if e.complexity.Mutation.AdminFiatAccountStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminFiatAccountStatus_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminFiatAccountStatus(childComplexity, args["clientID"].(string), args["currency"].(string), args["status"].(string), args["reason"].(string)), true
+
+	case "Mutation.adminFreezeUser":
+		if e.complexity.Mutation.AdminFreezeUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminFreezeUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminFreezeUser(childComplexity, args["clientID"].(string), args["isFrozen"].(bool), args["reason"].(string)), true
+
+	case "Mutation.adminRejectAdjustment":
+		if e.complexity.Mutation.AdminRejectAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminRejectAdjustment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminRejectAdjustment(childComplexity, args["adjustmentID"].(string), args["note"].(string)), true
+
+	case "Mutation.adminRequestAdjustment":
+		if e.complexity.Mutation.AdminRequestAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminRequestAdjustment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminRequestAdjustment(childComplexity, args["input"].(models.HTTPAdminAdjustmentRequest)), true
+
+	case "Mutation.adminReverseTransaction":
+		if e.complexity.Mutation.AdminReverseTransaction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminReverseTransaction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminReverseTransaction(childComplexity, args["transactionID"].(string), args["reason"].(string)), true
+
+	case "Mutation.adminUnlockUser":
+		if e.complexity.Mutation.AdminUnlockUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminUnlockUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminUnlockUser(childComplexity, args["clientID"].(string), args["reason"].(string)), true
+
+	case "Mutation.cancelLimitOrder":
+		if e.complexity.Mutation.CancelLimitOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelLimitOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelLimitOrder(childComplexity, args["orderID"].(string)), true
+
+	case "Mutation.cancelRecurringPurchase":
+		if e.complexity.Mutation.CancelRecurringPurchase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelRecurringPurchase_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelRecurringPurchase(childComplexity, args["planID"].(string)), true
+
+	case "Mutation.cancelTriggerOrder":
+		if e.complexity.Mutation.CancelTriggerOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelTriggerOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelTriggerOrder(childComplexity, args["orderID"].(string)), true
+
+	case "Mutation.changePassword":
+		if e.complexity.Mutation.ChangePassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_changePassword_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ChangePassword(childComplexity, args["input"].(models.HTTPChangePasswordRequest)), true
+
+	case "Mutation.closeCrypto":
+		if e.complexity.Mutation.CloseCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_closeCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CloseCrypto(childComplexity, args["input"].(models.HTTPCloseCryptoAccountRequest)), true
+
+	case "Mutation.closeFiat":
+		if e.complexity.Mutation.CloseFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_closeFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CloseFiat(childComplexity, args["input"].(models.HTTPCloseFiatAccountRequest)), true
+
+	case "Mutation.confirmMFA":
+		if e.complexity.Mutation.ConfirmMfa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_confirmMFA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConfirmMfa(childComplexity, args["code"].(string)), true
+
+	case "Mutation.createAPIKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["input"].(models.HTTPAPIKeyRequest)), true
+
+	case "Mutation.deleteUser":
+		if e.complexity.Mutation.DeleteUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteUser(childComplexity, args["input"].(models.HTTPDeleteUserRequest)), true
+
+	case "Mutation.depositFiat":
+		if e.complexity.Mutation.DepositFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_depositFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DepositFiat(childComplexity, args["input"].(models.HTTPDepositCurrencyRequest)), true
+
+	case "Mutation.disableMFA":
+		if e.complexity.Mutation.DisableMfa == nil {
+			break
+		}
+
+		return e.complexity.Mutation.DisableMfa(childComplexity), true
+
+	case "Mutation.enrollMFA":
+		if e.complexity.Mutation.EnrollMfa == nil {
+			break
+		}
+
+		return e.complexity.Mutation.EnrollMfa(childComplexity), true
+
+	case "Mutation.exchangeCrypto":
+		if e.complexity.Mutation.ExchangeCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeCrypto(childComplexity, args["offerID"].(string)), true
+
+	case "Mutation.exchangeOfferFiat":
+		if e.complexity.Mutation.ExchangeOfferFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeOfferFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeOfferFiat(childComplexity, args["input"].(models.HTTPExchangeOfferRequest)), true
+
+	case "Mutation.exchangeTransferFiat":
+		if e.complexity.Mutation.ExchangeTransferFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeTransferFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeTransferFiat(childComplexity, args["offerID"].(string)), true
+
+	case "Mutation.loginUser":
+		if e.complexity.Mutation.LoginUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginUser(childComplexity, args["input"].(models1.UserLoginCredentials)), true
+
+	case "Mutation.logoutAllSessions":
+		if e.complexity.Mutation.LogoutAllSessions == nil {
+			break
+		}
+
+		return e.complexity.Mutation.LogoutAllSessions(childComplexity), true
+
+	case "Mutation.logoutUser":
+		if e.complexity.Mutation.LogoutUser == nil {
+			break
+		}
+
+		return e.complexity.Mutation.LogoutUser(childComplexity), true
+
+	case "Mutation.offerCrypto":
+		if e.complexity.Mutation.OfferCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_offerCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OfferCrypto(childComplexity, args["input"].(models.HTTPCryptoOfferRequest)), true
+
+	case "Mutation.openCrypto":
+		if e.complexity.Mutation.OpenCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_openCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OpenCrypto(childComplexity, args["ticker"].(string)), true
+
+	case "Mutation.openFiat":
+		if e.complexity.Mutation.OpenFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_openFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OpenFiat(childComplexity, args["currency"].(string)), true
+
+	case "Mutation.placeLimitOrder":
+		if e.complexity.Mutation.PlaceLimitOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_placeLimitOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PlaceLimitOrder(childComplexity, args["input"].(models.HTTPLimitOrderRequest)), true
+
+	case "Mutation.placeTriggerOrder":
+		if e.complexity.Mutation.PlaceTriggerOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_placeTriggerOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PlaceTriggerOrder(childComplexity, args["input"].(models.HTTPTriggerOrderRequest)), true
+
+	case "Mutation.refreshSession":
+		if e.complexity.Mutation.RefreshSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_refreshSession_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RefreshSession(childComplexity, args["refreshToken"].(string)), true
+
+	case "Mutation.refreshToken":
+		if e.complexity.Mutation.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RefreshToken(childComplexity), true
+
+	case "Mutation.regenerateRecoveryCodes":
+		if e.complexity.Mutation.RegenerateRecoveryCodes == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RegenerateRecoveryCodes(childComplexity), true
+
+	case "Mutation.registerUser":
+		if e.complexity.Mutation.RegisterUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_registerUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RegisterUser(childComplexity, args["input"].(*models1.UserAccount)), true
+
+	case "Mutation.requestPasswordReset":
+		if e.complexity.Mutation.RequestPasswordReset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestPasswordReset_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestPasswordReset(childComplexity, args["username"].(string)), true
+
+	case "Mutation.resetPassword":
+		if e.complexity.Mutation.ResetPassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resetPassword_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResetPassword(childComplexity, args["input"].(models.HTTPPasswordResetConfirmRequest)), true
+
+	case "Mutation.revokeAPIKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["keyID"].(string)), true
+
+	case "Mutation.revokeSession":
+		if e.complexity.Mutation.RevokeSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeSession_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeSession(childComplexity, args["sessionID"].(string)), true
+
+	case "Mutation.scheduleRecurringPurchase":
+		if e.complexity.Mutation.ScheduleRecurringPurchase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_scheduleRecurringPurchase_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ScheduleRecurringPurchase(childComplexity, args["input"].(models.HTTPRecurringPurchaseRequest)), true
+
+	case "Mutation.sendEmailVerification":
+		if e.complexity.Mutation.SendEmailVerification == nil {
+			break
+		}
+
+		return e.complexity.Mutation.SendEmailVerification(childComplexity), true
+
+	case "Mutation.updateProfile":
+		if e.complexity.Mutation.UpdateProfile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateProfile_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateProfile(childComplexity, args["input"].(models.HTTPUpdateProfileRequest)), true
+
+	case "Mutation.verifyEmail":
+		if e.complexity.Mutation.VerifyEmail == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyEmail_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyEmail(childComplexity, args["token"].(string)), true
+
+	case "OfferResponse.debitAmount":
+		if e.complexity.OfferResponse.DebitAmount == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.DebitAmount(childComplexity), true
+
+	case "OfferResponse.expires":
+		if e.complexity.OfferResponse.Expires == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.Expires(childComplexity), true
+
+	case "OfferResponse.isHeld":
+		if e.complexity.OfferResponse.IsHeld == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.IsHeld(childComplexity), true
+
+	case "OfferResponse.offerID":
+		if e.complexity.OfferResponse.OfferID == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.OfferID(childComplexity), true
+
+	case "OfferResponse.priceQuote":
+		if e.complexity.OfferResponse.PriceQuote == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.PriceQuote(childComplexity), true
+
+	case "PriceQuote.amount":
+		if e.complexity.PriceQuote.Amount == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.Amount(childComplexity), true
+
+	case "PriceQuote.clientID":
+		if e.complexity.PriceQuote.ClientID == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.ClientID(childComplexity), true
+
+	case "PriceQuote.destinationAcc":
+		if e.complexity.PriceQuote.DestinationAcc == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.DestinationAcc(childComplexity), true
+
+	case "PriceQuote.rate":
+		if e.complexity.PriceQuote.Rate == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.Rate(childComplexity), true
+
+	case "PriceQuote.sourceAcc":
+		if e.complexity.PriceQuote.SourceAcc == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.SourceAcc(childComplexity), true
+
+	case "Query.apiKeys":
+		if e.complexity.Query.APIKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.APIKeys(childComplexity), true
+
+	case "Query.adminAdjustment":
+		if e.complexity.Query.AdminAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminAdjustment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminAdjustment(childComplexity, args["adjustmentID"].(string)), true
+
+	case "Query.adminAdjustments":
+		if e.complexity.Query.AdminAdjustments == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminAdjustments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminAdjustments(childComplexity, args["status"].(*string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminAuditEvents":
+		if e.complexity.Query.AdminAuditEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminAuditEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminAuditEvents(childComplexity, args["clientID"].(*string), args["eventType"].(*string), args["outcome"].(*string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminAuditLog":
+		if e.complexity.Query.AdminAuditLog == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminAuditLog_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminAuditLog(childComplexity, args["target"].(*string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminBalanceAllCrypto":
+		if e.complexity.Query.AdminBalanceAllCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminBalanceAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminBalanceAllCrypto(childComplexity, args["clientID"].(string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminBalanceAllFiat":
+		if e.complexity.Query.AdminBalanceAllFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminBalanceAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminBalanceAllFiat(childComplexity, args["clientID"].(string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminLedgerCheckpoints":
+		if e.complexity.Query.AdminLedgerCheckpoints == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminLedgerCheckpoints_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminLedgerCheckpoints(childComplexity, args["journal"].(*string), args["since"].(*int64)), true
+
+	case "Query.adminLedgerVerify":
+		if e.complexity.Query.AdminLedgerVerify == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminLedgerVerify_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminLedgerVerify(childComplexity, args["journal"].(*string)), true
+
+	case "Query.adminLoginLockouts":
+		if e.complexity.Query.AdminLoginLockouts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminLoginLockouts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminLoginLockouts(childComplexity, args["active"].(*bool), args["limit"].(*int32)), true
+
+	case "Query.adminTransactionDetailsAllCrypto":
+		if e.complexity.Query.AdminTransactionDetailsAllCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminTransactionDetailsAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminTransactionDetailsAllCrypto(childComplexity, args["clientID"].(string), args["input"].(models.CryptoPaginatedTxDetailsRequest)), true
+
+	case "Query.adminTransactionDetailsAllFiat":
+		if e.complexity.Query.AdminTransactionDetailsAllFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminTransactionDetailsAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminTransactionDetailsAllFiat(childComplexity, args["clientID"].(string), args["input"].(models.FiatPaginatedTxDetailsRequest)), true
+
+	case "Query.adminUser":
+		if e.complexity.Query.AdminUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminUser(childComplexity, args["clientID"].(string)), true
 
-	case "Links.pageCursor":
-		if e.complexity.Links.PageCursor == nil {
+	case "Query.adminUserSearch":
+		if e.complexity.Query.AdminUserSearch == nil {
 			break
 		}
 
-		return e.complexity.Links.PageCursor(childComplexity), true
+		args, err := ec.field_Query_adminUserSearch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.deleteUser":
-		if e.complexity.Mutation.DeleteUser == nil {
+		return e.complexity.Query.AdminUserSearch(childComplexity, args["query"].(string), args["limit"].(*int32)), true
+
+	case "Query.balanceAllCrypto":
+		if e.complexity.Query.BalanceAllCrypto == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteUser_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceAllCrypto_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteUser(childComplexity, args["input"].(models.HTTPDeleteUserRequest)), true
+		return e.complexity.Query.BalanceAllCrypto(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
 
-	case "Mutation.depositFiat":
-		if e.complexity.Mutation.DepositFiat == nil {
+	case "Query.balanceAllFiat":
+		if e.complexity.Query.BalanceAllFiat == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_depositFiat_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceAllFiat_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DepositFiat(childComplexity, args["input"].(models.HTTPDepositCurrencyRequest)), true
+		return e.complexity.Query.BalanceAllFiat(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
 
-	case "Mutation.exchangeCrypto":
-		if e.complexity.Mutation.ExchangeCrypto == nil {
+	case "Query.balanceAsOfCrypto":
+		if e.complexity.Query.BalanceAsOfCrypto == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_exchangeCrypto_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceAsOfCrypto_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ExchangeCrypto(childComplexity, args["offerID"].(string)), true
+		return e.complexity.Query.BalanceAsOfCrypto(childComplexity, args["ticker"].(string), args["timestamp"].(int64)), true
 
-	case "Mutation.exchangeOfferFiat":
-		if e.complexity.Mutation.ExchangeOfferFiat == nil {
+	case "Query.balanceAsOfFiat":
+		if e.complexity.Query.BalanceAsOfFiat == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_exchangeOfferFiat_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceAsOfFiat_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ExchangeOfferFiat(childComplexity, args["input"].(models.HTTPExchangeOfferRequest)), true
+		return e.complexity.Query.BalanceAsOfFiat(childComplexity, args["currencyCode"].(string), args["timestamp"].(int64)), true
 
-	case "Mutation.exchangeTransferFiat":
-		if e.complexity.Mutation.ExchangeTransferFiat == nil {
+	case "Query.balanceCrypto":
+		if e.complexity.Query.BalanceCrypto == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_exchangeTransferFiat_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceCrypto_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ExchangeTransferFiat(childComplexity, args["offerID"].(string)), true
+		return e.complexity.Query.BalanceCrypto(childComplexity, args["ticker"].(string)), true
 
-	case "Mutation.loginUser":
-		if e.complexity.Mutation.LoginUser == nil {
+	case "Query.balanceFiat":
+		if e.complexity.Query.BalanceFiat == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_loginUser_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceFiat_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoginUser(childComplexity, args["input"].(models1.UserLoginCredentials)), true
+		return e.complexity.Query.BalanceFiat(childComplexity, args["currencyCode"].(string)), true
 
-	case "Mutation.offerCrypto":
-		if e.complexity.Mutation.OfferCrypto == nil {
+	case "Query.balanceHistoryCrypto":
+		if e.complexity.Query.BalanceHistoryCrypto == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_offerCrypto_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceHistoryCrypto_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.OfferCrypto(childComplexity, args["input"].(models.HTTPCryptoOfferRequest)), true
+		return e.complexity.Query.BalanceHistoryCrypto(childComplexity, args["ticker"].(string), args["from"].(*int64), args["to"].(*int64)), true
 
-	case "Mutation.openCrypto":
-		if e.complexity.Mutation.OpenCrypto == nil {
+	case "Query.balanceHistoryFiat":
+		if e.complexity.Query.BalanceHistoryFiat == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_openCrypto_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_balanceHistoryFiat_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.OpenCrypto(childComplexity, args["ticker"].(string)), true
+		return e.complexity.Query.BalanceHistoryFiat(childComplexity, args["currencyCode"].(string), args["from"].(*int64), args["to"].(*int64)), true
 
-	case "Mutation.openFiat":
-		if e.complexity.Mutation.OpenFiat == nil {
+	case "Query.cryptoAssets":
+		if e.complexity.Query.CryptoAssets == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_openFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Query.CryptoAssets(childComplexity), true
+
+	case "Query.fiatCurrencies":
+		if e.complexity.Query.FiatCurrencies == nil {
+			break
 		}
 
-		return e.complexity.Mutation.OpenFiat(childComplexity, args["currency"].(string)), true
+		return e.complexity.Query.FiatCurrencies(childComplexity), true
 
-	case "Mutation.refreshToken":
-		if e.complexity.Mutation.RefreshToken == nil {
+	case "Query.healthcheck":
+		if e.complexity.Query.Healthcheck == nil {
 			break
 		}
 
-		return e.complexity.Mutation.RefreshToken(childComplexity), true
+		return e.complexity.Query.Healthcheck(childComplexity), true
 
-	case "Mutation.registerUser":
-		if e.complexity.Mutation.RegisterUser == nil {
+	case "Query.limitOrder":
+		if e.complexity.Query.LimitOrder == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_registerUser_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_limitOrder_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RegisterUser(childComplexity, args["input"].(*models1.UserAccount)), true
+		return e.complexity.Query.LimitOrder(childComplexity, args["orderID"].(string)), true
 
-	case "OfferResponse.debitAmount":
-		if e.complexity.OfferResponse.DebitAmount == nil {
+	case "Query.limitOrders":
+		if e.complexity.Query.LimitOrders == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.DebitAmount(childComplexity), true
+		args, err := ec.field_Query_limitOrders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "OfferResponse.expires":
-		if e.complexity.OfferResponse.Expires == nil {
+		return e.complexity.Query.LimitOrders(childComplexity, args["status"].(*string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.Expires(childComplexity), true
+		return e.complexity.Query.Me(childComplexity), true
 
-	case "OfferResponse.offerID":
-		if e.complexity.OfferResponse.OfferID == nil {
+	case "Query.recurringPurchase":
+		if e.complexity.Query.RecurringPurchase == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.OfferID(childComplexity), true
+		args, err := ec.field_Query_recurringPurchase_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "OfferResponse.priceQuote":
-		if e.complexity.OfferResponse.PriceQuote == nil {
+		return e.complexity.Query.RecurringPurchase(childComplexity, args["planID"].(string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.recurringPurchases":
+		if e.complexity.Query.RecurringPurchases == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.PriceQuote(childComplexity), true
+		args, err := ec.field_Query_recurringPurchases_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PriceQuote.amount":
-		if e.complexity.PriceQuote.Amount == nil {
+		return e.complexity.Query.RecurringPurchases(childComplexity, args["status"].(*string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.securityActivity":
+		if e.complexity.Query.SecurityActivity == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.Amount(childComplexity), true
+		return e.complexity.Query.SecurityActivity(childComplexity), true
 
-	case "PriceQuote.clientID":
-		if e.complexity.PriceQuote.ClientID == nil {
+	case "Query.sessions":
+		if e.complexity.Query.Sessions == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.ClientID(childComplexity), true
+		return e.complexity.Query.Sessions(childComplexity), true
 
-	case "PriceQuote.destinationAcc":
-		if e.complexity.PriceQuote.DestinationAcc == nil {
+	case "Query.transactionDetailsAllCrypto":
+		if e.complexity.Query.TransactionDetailsAllCrypto == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.DestinationAcc(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PriceQuote.rate":
-		if e.complexity.PriceQuote.Rate == nil {
+		return e.complexity.Query.TransactionDetailsAllCrypto(childComplexity, args["input"].(models.CryptoPaginatedTxDetailsRequest)), true
+
+	case "Query.transactionDetailsAllFiat":
+		if e.complexity.Query.TransactionDetailsAllFiat == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.Rate(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PriceQuote.sourceAcc":
-		if e.complexity.PriceQuote.SourceAcc == nil {
+		return e.complexity.Query.TransactionDetailsAllFiat(childComplexity, args["input"].(models.FiatPaginatedTxDetailsRequest)), true
+
+	case "Query.transactionDetailsCrypto":
+		if e.complexity.Query.TransactionDetailsCrypto == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.SourceAcc(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Query.balanceAllCrypto":
-		if e.complexity.Query.BalanceAllCrypto == nil {
+		return e.complexity.Query.TransactionDetailsCrypto(childComplexity, args["transactionID"].(string)), true
+
+	case "Query.transactionDetailsFiat":
+		if e.complexity.Query.TransactionDetailsFiat == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceAllCrypto_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_transactionDetailsFiat_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.BalanceAllCrypto(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+		return e.complexity.Query.TransactionDetailsFiat(childComplexity, args["transactionID"].(string)), true
 
-	case "Query.balanceAllFiat":
-		if e.complexity.Query.BalanceAllFiat == nil {
+	case "Query.triggerOrder":
+		if e.complexity.Query.TriggerOrder == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceAllFiat_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_triggerOrder_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.BalanceAllFiat(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+		return e.complexity.Query.TriggerOrder(childComplexity, args["orderID"].(string)), true
 
-	case "Query.balanceCrypto":
-		if e.complexity.Query.BalanceCrypto == nil {
+	case "Query.triggerOrders":
+		if e.complexity.Query.TriggerOrders == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceCrypto_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_triggerOrders_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.BalanceCrypto(childComplexity, args["ticker"].(string)), true
+		return e.complexity.Query.TriggerOrders(childComplexity, args["status"].(*string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
 
-	case "Query.balanceFiat":
-		if e.complexity.Query.BalanceFiat == nil {
+	case "SecurityEvent.eventType":
+		if e.complexity.SecurityEvent.EventType == nil {
+			break
+		}
+
+		return e.complexity.SecurityEvent.EventType(childComplexity), true
+
+	case "SecurityEvent.ipAddress":
+		if e.complexity.SecurityEvent.IPAddress == nil {
+			break
+		}
+
+		return e.complexity.SecurityEvent.IPAddress(childComplexity), true
+
+	case "SecurityEvent.occurredAt":
+		if e.complexity.SecurityEvent.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.SecurityEvent.OccurredAt(childComplexity), true
+
+	case "SecurityEvent.outcome":
+		if e.complexity.SecurityEvent.Outcome == nil {
+			break
+		}
+
+		return e.complexity.SecurityEvent.Outcome(childComplexity), true
+
+	case "SecurityEvent.userAgent":
+		if e.complexity.SecurityEvent.UserAgent == nil {
+			break
+		}
+
+		return e.complexity.SecurityEvent.UserAgent(childComplexity), true
+
+	case "Session.device":
+		if e.complexity.Session.Device == nil {
+			break
+		}
+
+		return e.complexity.Session.Device(childComplexity), true
+
+	case "Session.expiresAt":
+		if e.complexity.Session.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.Session.ExpiresAt(childComplexity), true
+
+	case "Session.ipAddress":
+		if e.complexity.Session.IPAddress == nil {
+			break
+		}
+
+		return e.complexity.Session.IPAddress(childComplexity), true
+
+	case "Session.lastRefreshed":
+		if e.complexity.Session.LastRefreshed == nil {
+			break
+		}
+
+		return e.complexity.Session.LastRefreshed(childComplexity), true
+
+	case "Session.sessionID":
+		if e.complexity.Session.SessionID == nil {
+			break
+		}
+
+		return e.complexity.Session.SessionID(childComplexity), true
+
+	case "Session.startedAt":
+		if e.complexity.Session.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.Session.StartedAt(childComplexity), true
+
+	case "TransactionReversal.adminID":
+		if e.complexity.TransactionReversal.AdminID == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.AdminID(childComplexity), true
+
+	case "TransactionReversal.cryptoEntries":
+		if e.complexity.TransactionReversal.CryptoEntries == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.CryptoEntries(childComplexity), true
+
+	case "TransactionReversal.fiatEntries":
+		if e.complexity.TransactionReversal.FiatEntries == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.FiatEntries(childComplexity), true
+
+	case "TransactionReversal.reason":
+		if e.complexity.TransactionReversal.Reason == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.Reason(childComplexity), true
+
+	case "TransactionReversal.reversalTxID":
+		if e.complexity.TransactionReversal.ReversalTxID == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.ReversalTxID(childComplexity), true
+
+	case "TransactionReversal.reversedAt":
+		if e.complexity.TransactionReversal.ReversedAt == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.ReversedAt(childComplexity), true
+
+	case "TransactionReversal.txID":
+		if e.complexity.TransactionReversal.TxID == nil {
+			break
+		}
+
+		return e.complexity.TransactionReversal.TxID(childComplexity), true
+
+	case "User.clientID":
+		if e.complexity.User.ClientID == nil {
+			break
+		}
+
+		return e.complexity.User.ClientID(childComplexity), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.emailVerified":
+		if e.complexity.User.EmailVerified == nil {
+			break
+		}
+
+		return e.complexity.User.EmailVerified(childComplexity), true
+
+	case "User.firstName":
+		if e.complexity.User.FirstName == nil {
+			break
+		}
+
+		return e.complexity.User.FirstName(childComplexity), true
+
+	case "User.isDeleted":
+		if e.complexity.User.IsDeleted == nil {
+			break
+		}
+
+		return e.complexity.User.IsDeleted(childComplexity), true
+
+	case "User.isFrozen":
+		if e.complexity.User.IsFrozen == nil {
+			break
+		}
+
+		return e.complexity.User.IsFrozen(childComplexity), true
+
+	case "User.lastName":
+		if e.complexity.User.LastName == nil {
+			break
+		}
+
+		return e.complexity.User.LastName(childComplexity), true
+
+	case "User.role":
+		if e.complexity.User.Role == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.User.Role(childComplexity), true
+
+	case "User.username":
+		if e.complexity.User.Username == nil {
+			break
 		}
 
-		return e.complexity.Query.BalanceFiat(childComplexity, args["currencyCode"].(string)), true
+		return e.complexity.User.Username(childComplexity), true
 
-	case "Query.healthcheck":
-		if e.complexity.Query.Healthcheck == nil {
+	case "UserProfile.clientID":
+		if e.complexity.UserProfile.ClientID == nil {
 			break
 		}
 
-		return e.complexity.Query.Healthcheck(childComplexity), true
+		return e.complexity.UserProfile.ClientID(childComplexity), true
 
-	case "Query.transactionDetailsAllCrypto":
-		if e.complexity.Query.TransactionDetailsAllCrypto == nil {
+	case "UserProfile.email":
+		if e.complexity.UserProfile.Email == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsAllCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.UserProfile.Email(childComplexity), true
+
+	case "UserProfile.emailVerified":
+		if e.complexity.UserProfile.EmailVerified == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsAllCrypto(childComplexity, args["input"].(models.CryptoPaginatedTxDetailsRequest)), true
+		return e.complexity.UserProfile.EmailVerified(childComplexity), true
 
-	case "Query.transactionDetailsAllFiat":
-		if e.complexity.Query.TransactionDetailsAllFiat == nil {
+	case "UserProfile.firstName":
+		if e.complexity.UserProfile.FirstName == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsAllFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.UserProfile.FirstName(childComplexity), true
+
+	case "UserProfile.isDeleted":
+		if e.complexity.UserProfile.IsDeleted == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsAllFiat(childComplexity, args["input"].(models.FiatPaginatedTxDetailsRequest)), true
+		return e.complexity.UserProfile.IsDeleted(childComplexity), true
 
-	case "Query.transactionDetailsCrypto":
-		if e.complexity.Query.TransactionDetailsCrypto == nil {
+	case "UserProfile.isFrozen":
+		if e.complexity.UserProfile.IsFrozen == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.UserProfile.IsFrozen(childComplexity), true
+
+	case "UserProfile.lastName":
+		if e.complexity.UserProfile.LastName == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsCrypto(childComplexity, args["transactionID"].(string)), true
+		return e.complexity.UserProfile.LastName(childComplexity), true
 
-	case "Query.transactionDetailsFiat":
-		if e.complexity.Query.TransactionDetailsFiat == nil {
+	case "UserProfile.role":
+		if e.complexity.UserProfile.Role == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.UserProfile.Role(childComplexity), true
+
+	case "UserProfile.username":
+		if e.complexity.UserProfile.Username == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsFiat(childComplexity, args["transactionID"].(string)), true
+		return e.complexity.UserProfile.Username(childComplexity), true
 
 	}
 	return 0, false
@@ -847,12 +3632,22 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAPIKeyRequest,
+		ec.unmarshalInputChangePasswordRequest,
+		ec.unmarshalInputCryptoCloseAccountRequest,
+		ec.unmarshalInputCryptoLimitOrderRequest,
 		ec.unmarshalInputCryptoOfferRequest,
 		ec.unmarshalInputCryptoPaginatedTxDetailsRequest,
+		ec.unmarshalInputCryptoRecurringPurchaseRequest,
+		ec.unmarshalInputCryptoTriggerOrderRequest,
 		ec.unmarshalInputDeleteUserRequest,
+		ec.unmarshalInputFiatAdjustmentRequest,
+		ec.unmarshalInputFiatCloseAccountRequest,
 		ec.unmarshalInputFiatDepositRequest,
 		ec.unmarshalInputFiatExchangeOfferRequest,
 		ec.unmarshalInputFiatPaginatedTxDetailsRequest,
+		ec.unmarshalInputResetPasswordRequest,
+		ec.unmarshalInputUpdateProfileRequest,
 		ec.unmarshalInputUserAccount,
 		ec.unmarshalInputUserLoginCredentials,
 	)
@@ -915,11 +3710,267 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "../schema/admin.graphqls", Input: `# UserProfile is a user account's profile, role, and status as seen by an administrator.
+type UserProfile {
+    username:       String!
+    firstName:      String!
+    lastName:       String!
+    email:          String!
+    emailVerified:  Boolean!
+    clientID:       UUID!
+    role:           String!
+    isDeleted:      Boolean!
+    isFrozen:       Boolean!
+}
+
+# AdminAuditLog is a record of an action taken by an administrator.
+type AdminAuditLog {
+    id:         Int64!
+    adminID:    UUID!
+    action:     String!
+    target:     String!
+    details:    String!
+    createdAt:  String!
+    outcome:    String
+}
+
+# AdminAuditLogPaginated are the administrative audit log entries retrieved via pagination.
+type AdminAuditLogPaginated {
+    entries:    [AdminAuditLog!]!
+    links:      Links!
+}
+
+# AuditEvent is a record of a security or account event in the security audit log. The actor and subject are empty when
+# they are not known, such as for a login attempt with an unknown username.
+type AuditEvent {
+    eventID:    Int64!
+    eventType:  String!
+    outcome:    String!
+    actorID:    String!
+    subjectID:  String!
+    ipAddress:  String!
+    userAgent:  String!
+    payload:    String!
+    createdAt:  String!
+}
+
+# AuditEventsPaginated are the security audit log events retrieved via pagination.
+type AuditEventsPaginated {
+    events:     [AuditEvent!]!
+    links:      Links!
+}
+
+# AdminFreezeResponse is the response returned when a user account is frozen or unfrozen.
+type AdminFreezeResponse {
+    clientID:   String!
+    isFrozen:   Boolean!
+}
+
+# AdminUnlockResponse is the response returned when the login lockout of a user account is lifted.
+type AdminUnlockResponse {
+    clientID:   String!
+}
+
+# LoginLockout is a temporary lockout of a username or IP address after repeated failed login attempts. The scope is
+# either USERNAME or IP_ADDRESS.
+type LoginLockout {
+    lockoutID:  Int64!
+    scope:      String!
+    subject:    String!
+    failures:   Int64!
+    ipAddress:  String!
+    lockedAt:   String!
+    expiresAt:  String!
+    unlockedBy: UUID
+    unlockedAt: String
+}
+
+# AdminAccountStatusResponse is the response returned when the status of a user's Fiat or Cryptocurrency account is set.
+type AdminAccountStatusResponse {
+    clientID:   String!
+    code:       String!
+    status:     String!
+}
+
+# JournalChainBreak is the first link in a journal hash chain that failed verification.
+type JournalChainBreak {
+    seq:        Int64!
+    txID:       String!
+    reason:     String!
+}
+
+# JournalChainVerification is the outcome of walking a journal hash chain up to its head.
+type JournalChainVerification {
+    journal:             String!
+    anchorSeq:           Int64!
+    entries:             Int64!
+    firstSeq:            Int64!
+    lastSeq:             Int64!
+    lastHash:            String!
+    checkpoints:         Int64!
+    archivedCheckpoints: Int64!
+    intact:              Boolean!
+    break:               JournalChainBreak
+}
+
+# LedgerVerification is the outcome of verifying the journal hash chains against their signed checkpoints.
+type LedgerVerification {
+    intact:     Boolean!
+    journals:   [JournalChainVerification!]!
+}
+
+# ExportedCheckpoint is a signed checkpoint of a journal hash chain head. The signature is over the message and is
+# verified with the public key of the signing key ID.
+type ExportedCheckpoint {
+    journal:    String!
+    seq:        Int64!
+    entryHash:  String!
+    createdAt:  String!
+    message:    String!
+    signature:  String!
+    keyID:      String!
+    publicKey:  String!
+}
+
+# CheckpointExport are the signed journal hash chain checkpoints and the ID and public key of the current signing key.
+type CheckpointExport {
+    algorithm:      String!
+    keyID:          String!
+    publicKey:      String!
+    checkpoints:    [ExportedCheckpoint!]!
+}
+
+# TransactionReversal is a compensating reversal of a transaction along with the equal and opposite journal entries
+# posted for it.
+type TransactionReversal {
+    txID:           UUID!
+    reversalTxID:   UUID!
+    adminID:        UUID!
+    reason:         String!
+    reversedAt:     String!
+    fiatEntries:    [FiatJournal!]!
+    cryptoEntries:  [CryptoJournal!]!
+}
+
+# FiatAdjustment is a manual adjustment of a Fiat account requested by one administrator and decided by another.
+type FiatAdjustment {
+    adjustmentID:   String!
+    clientID:       UUID!
+    currency:       String!
+    amount:         Float!
+    reasonCode:     String!
+    justification:  String!
+    status:         String!
+    makerID:        UUID!
+    checkerID:      UUID
+    decisionNote:   String!
+    txID:           UUID
+    createdAt:      String!
+    decidedAt:      String
+}
+
+# FiatAdjustmentsPaginated are the manual adjustments of Fiat accounts retrieved via pagination.
+type FiatAdjustmentsPaginated {
+    adjustments:    [FiatAdjustment!]!
+    links:          Links!
+}
+
+# FiatAdjustmentRequest is a request for a signed manual adjustment of a Fiat account. The reason code must be one of
+# GOODWILL, FEE_CORRECTION, ERROR_CORRECTION, or OTHER.
+input FiatAdjustmentRequest {
+    clientID:       String!
+    currency:       String!
+    amount:         Float!
+    reasonCode:     String!
+    justification:  String!
+}
+
+# Requests that might alter the state of data in the database.
+extend type Mutation {
+    # adminFreezeUser is a request to freeze or unfreeze a user account. Requires the administrative write scope.
+    adminFreezeUser(clientID: String!, isFrozen: Boolean!, reason: String!): AdminFreezeResponse!
+
+    # adminUnlockUser is a request to lift the login lockout of a user account after repeated failed login attempts and
+    # reset its failed attempts. Requires the administrative write scope.
+    adminUnlockUser(clientID: String!, reason: String!): AdminUnlockResponse!
+
+    # adminFiatAccountStatus is a request to set the status of a user's Fiat currency account to ACTIVE, FROZEN_DEBITS,
+    # or FROZEN. Requires the administrative write scope.
+    adminFiatAccountStatus(clientID: String!, currency: String!, status: String!, reason: String!): AdminAccountStatusResponse!
+
+    # adminCryptoAccountStatus is a request to set the status of a user's Cryptocurrency account to ACTIVE,
+    # FROZEN_DEBITS, or FROZEN. Requires the administrative write scope.
+    adminCryptoAccountStatus(clientID: String!, ticker: String!, status: String!, reason: String!): AdminAccountStatusResponse!
+
+    # adminReverseTransaction is a request to post a compensating reversal of a Fiat or Cryptocurrency transaction made
+    # in error. Requires the administrative write scope.
+    adminReverseTransaction(transactionID: String!, reason: String!): TransactionReversal!
+
+    # adminRequestAdjustment is a request for a manual adjustment of a Fiat account. The adjustment is not posted until it
+    # is approved by a different administrator. Requires the administrative write scope.
+    adminRequestAdjustment(input: FiatAdjustmentRequest!): FiatAdjustment!
+
+    # adminApproveAdjustment is a request to approve a pending manual adjustment and post its journal entries against
+    # the Fiat operations account. Requires the administrative write scope.
+    adminApproveAdjustment(adjustmentID: String!, note: String!): FiatAdjustment!
+
+    # adminRejectAdjustment is a request to reject a pending manual adjustment. Requires the administrative write scope.
+    adminRejectAdjustment(adjustmentID: String!, note: String!): FiatAdjustment!
+}
+
+extend type Query {
+    # adminUserSearch is a request to search for user accounts by client id, username, name, or email address.
+    adminUserSearch(query: String!, limit: Int32): [UserProfile!]!
+
+    # adminUser is a request to retrieve a user account's profile, role, and status.
+    adminUser(clientID: String!): UserProfile!
+
+    # adminBalanceAllFiat is a request to retrieve all the Fiat currency account balances for a user account.
+    adminBalanceAllFiat(clientID: String!, pageCursor: String, pageSize: Int32): FiatBalancesPaginated!
+
+    # adminTransactionDetailsAllFiat is a request to retrieve the Fiat currency journal entries for a user account.
+    adminTransactionDetailsAllFiat(clientID: String!, input: FiatPaginatedTxDetailsRequest!): FiatTransactionsPaginated!
+
+    # adminBalanceAllCrypto is a request to retrieve all the Cryptocurrency account balances for a user account.
+    adminBalanceAllCrypto(clientID: String!, pageCursor: String, pageSize: Int32): CryptoBalancesPaginated!
+
+    # adminTransactionDetailsAllCrypto is a request to retrieve the Cryptocurrency journal entries for a user account.
+    adminTransactionDetailsAllCrypto(clientID: String!, input: CryptoPaginatedTxDetailsRequest!): CryptoTransactionsPaginated!
+
+    # adminAuditLog is a request to retrieve the administrative audit log, optionally restricted to a target.
+    adminAuditLog(target: String, pageCursor: String, pageSize: Int32): AdminAuditLogPaginated!
+
+    # adminAuditEvents is a request to retrieve the security audit log, optionally restricted to the events about a
+    # client, of a type, or with an outcome.
+    adminAuditEvents(clientID: String, eventType: String, outcome: String, pageCursor: String, pageSize: Int32): AuditEventsPaginated!
+
+    # adminLoginLockouts is a request to retrieve the most recent login lockouts, optionally restricted to those that
+    # are still in effect.
+    adminLoginLockouts(active: Boolean, limit: Int32): [LoginLockout!]!
+
+    # adminLedgerVerify is a request to verify the hash chain of the fiat_journal or crypto_journal, or both if no
+    # journal is specified, against the signed checkpoints.
+    adminLedgerVerify(journal: String): LedgerVerification!
+
+    # adminLedgerCheckpoints is a request to retrieve the signed checkpoints of the fiat_journal or crypto_journal, or
+    # both if no journal is specified, recorded at or after a Unix timestamp.
+    adminLedgerCheckpoints(journal: String, since: Int64): CheckpointExport!
+
+    # adminAdjustment is a request to retrieve a manual adjustment of a Fiat account.
+    adminAdjustment(adjustmentID: String!): FiatAdjustment!
+
+    # adminAdjustments is a request to retrieve the manual adjustments of Fiat accounts, optionally restricted to a
+    # status of PENDING, APPROVED, or REJECTED.
+    adminAdjustments(status: String, pageCursor: String, pageSize: Int32): FiatAdjustmentsPaginated!
+}
+`, BuiltIn: false},
 	{Name: "../schema/auth.graphqls", Input: `# JWT Authorization Response.
 type JWTAuthResponse {
     token: String!
     expires: Int64!
     threshold: Int64!
+    refreshToken: String
+    refreshExpires: Int64
 }
 `, BuiltIn: false},
 	{Name: "../schema/common.graphqls", Input: `# PriceQuote is the quote provided to the end-user requesting a transfer and will be stored in the Redis cache.
@@ -937,6 +3988,7 @@ type OfferResponse {
     debitAmount: Float!
     offerID: String!
     expires: Int64!
+    isHeld: Boolean!
 }
 
 # Links are links used in responses to retrieve pages of information.
@@ -944,15 +3996,27 @@ type Links {
     nextPage:   String
     pageCursor: String
 }
+
+# BalanceAsOf is the balance of a Fiat or Crypto account at a point in time. The snapshot time is that of the daily
+# closing balance the journal entries were added to, and is not set if there was no snapshot before the point in time.
+type BalanceAsOf {
+    currency:   String!
+    balance:    Float!
+    asOf:       String!
+    snapshotAt: String
+}
 `, BuiltIn: false},
-	{Name: "../schema/crypto.graphqls", Input: `# Crypto Account are the Crypto account details associated with a specific Client ID.
+	{Name: "../schema/crypto.graphqls", Input: `# Crypto Account are the Crypto account details associated with a specific Client ID. The available balance is the
+# ledger balance net of the funds held for outstanding offers.
 type CryptoAccount {
     ticker:   String!
     balance:    Float!
+    available:  Float!
     lastTx:     Float!
     lastTxTs:   String!
     createdAt:  String!
     clientID:   UUID!
+    status:     String!
 }
 
 # CryptoOpenAccountResponse is the response returned when opening a Cryptocurrency account.
@@ -982,18 +4046,203 @@ type CryptoBalancesPaginated {
     links:              Links!
 }
 
+# CryptoBalanceSnapshot is the closing balance of a Crypto account at midnight UTC.
+type CryptoBalanceSnapshot {
+    clientID:   UUID!
+    ticker:     String!
+    snapshotAt: String!
+    balance:    Float!
+    createdAt:  String!
+}
+
+# CryptoBalanceHistory are the daily closing balances of a Crypto account within a time range, oldest first.
+type CryptoBalanceHistory {
+    ticker:     String!
+    from:       Int64!
+    to:         Int64!
+    snapshots:  [CryptoBalanceSnapshot!]!
+}
+
 # CryptoBalancesPaginated are all of the Fiat account balances retrieved via pagination.
 type CryptoTransactionsPaginated {
     transactions:   [CryptoJournal!]!
     links:          Links!
 }
 
+# CryptoAsset is a supported Cryptocurrency along with its precision, trading status, and order size limits.
+type CryptoAsset {
+    ticker:         String!
+    name:           String!
+    decimalPlaces:  Int32!
+    status:         String!
+    minOrder:       Float!
+    maxOrder:       Float!
+    updatedAt:      String!
+}
+
+# CryptoLimitOrder is a limit order to purchase or sell a Cryptocurrency once its price reaches the limit price.
+type CryptoLimitOrder {
+    orderID:        String!
+    clientID:       UUID!
+    fiatCurrency:   String!
+    ticker:         String!
+    isPurchase:     Boolean!
+    amount:         Float!
+    limitPrice:     Float!
+    status:         String!
+    txID:           String
+    expiresAt:      String!
+    createdAt:      String!
+    updatedAt:      String!
+}
+
+# CryptoLimitOrderEvent is a state transition in the history of a limit order.
+type CryptoLimitOrderEvent {
+    id:         Int64!
+    orderID:    String!
+    status:     String!
+    price:      Float!
+    details:    String!
+    createdAt:  String!
+}
+
+# CryptoLimitOrderDetails is a limit order along with its state history.
+type CryptoLimitOrderDetails {
+    order:      CryptoLimitOrder!
+    history:    [CryptoLimitOrderEvent!]!
+}
+
+# CryptoLimitOrdersPaginated are the limit orders retrieved via pagination.
+type CryptoLimitOrdersPaginated {
+    orders: [CryptoLimitOrder!]!
+    links:  Links!
+}
+
+# CryptoTriggerOrder is a stop-loss or take-profit order to sell a Cryptocurrency position once its price crosses the trigger price.
+type CryptoTriggerOrder {
+    orderID:            String!
+    clientID:           UUID!
+    fiatCurrency:       String!
+    ticker:             String!
+    triggerType:        String!
+    triggerPrice:       Float!
+    trailingDistance:   Float!
+    amount:             Float!
+    status:             String!
+    txID:               String
+    createdAt:          String!
+    updatedAt:          String!
+}
+
+# CryptoTriggerOrderEvent is a state transition in the history of a trigger order.
+type CryptoTriggerOrderEvent {
+    id:         Int64!
+    orderID:    String!
+    status:     String!
+    price:      Float!
+    amount:     Float!
+    details:    String!
+    createdAt:  String!
+}
+
+# CryptoTriggerOrderDetails is a trigger order along with its state history.
+type CryptoTriggerOrderDetails {
+    order:      CryptoTriggerOrder!
+    history:    [CryptoTriggerOrderEvent!]!
+}
+
+# CryptoTriggerOrdersPaginated are the trigger orders retrieved via pagination.
+type CryptoTriggerOrdersPaginated {
+    orders: [CryptoTriggerOrder!]!
+    links:  Links!
+}
+
+# CryptoRecurringPurchase is a plan to purchase a Cryptocurrency with a fixed amount of a Fiat currency at a regular cadence.
+type CryptoRecurringPurchase {
+    planID:         String!
+    clientID:       UUID!
+    fiatCurrency:   String!
+    ticker:         String!
+    amount:         Float!
+    cadence:        String!
+    startsAt:       String!
+    endsAt:         String
+    nextRunAt:      String!
+    status:         String!
+    createdAt:      String!
+    updatedAt:      String!
+}
+
+# CryptoRecurringPurchaseRun is the outcome of a scheduled run of a recurring purchase plan.
+type CryptoRecurringPurchaseRun {
+    id:             Int64!
+    planID:         String!
+    scheduledAt:    String!
+    status:         String!
+    price:          Float!
+    fiatAmount:     Float!
+    cryptoAmount:   Float!
+    txID:           String
+    details:        String!
+    createdAt:      String!
+}
+
+# CryptoRecurringPurchaseDetails is a recurring purchase plan along with a page of its runs.
+type CryptoRecurringPurchaseDetails {
+    plan:   CryptoRecurringPurchase!
+    runs:   [CryptoRecurringPurchaseRun!]!
+    links:  Links!
+}
+
+# CryptoRecurringPurchasesPaginated are the recurring purchase plans retrieved via pagination.
+type CryptoRecurringPurchasesPaginated {
+    plans:  [CryptoRecurringPurchase!]!
+    links:  Links!
+}
+
 # CryptoOfferRequest is the request parameters to purchase or sell a Cryptocurrency.
 input CryptoOfferRequest {
     sourceCurrency:         String!
     destinationCurrency:    String!
     sourceAmount:           Float!
     isPurchase:             Boolean!
+    hold:                   Boolean
+}
+
+# CryptoLimitOrderRequest is a request to place a limit order. The order expires at the supplied Unix timestamp.
+input CryptoLimitOrderRequest {
+    fiatCurrency:   String!
+    ticker:         String!
+    amount:         Float!
+    limitPrice:     Float!
+    expiresAt:      Int64!
+    isPurchase:     Boolean!
+}
+
+# CryptoTriggerOrderRequest is a request to place a STOP_LOSS or TAKE_PROFIT order. An omitted or zero amount sells the entire position.
+input CryptoTriggerOrderRequest {
+    fiatCurrency:       String!
+    ticker:             String!
+    triggerType:        String!
+    triggerPrice:       Float!
+    trailingDistance:   Float
+    amount:             Float
+}
+
+# CryptoRecurringPurchaseRequest is a request to schedule DAILY, WEEKLY, BIWEEKLY, or MONTHLY purchases. The optional start and end times are Unix timestamps.
+input CryptoRecurringPurchaseRequest {
+    fiatCurrency:   String!
+    ticker:         String!
+    amount:         Float!
+    cadence:        String!
+    startsAt:       Int64
+    endsAt:         Int64
+}
+
+# CryptoCloseAccountRequest is a request to close a Cryptocurrency account with an optional Fiat currency to sweep the balance into.
+input CryptoCloseAccountRequest {
+    ticker:         String!
+    sweepCurrency:  String
 }
 
 # CryptoPaginatedTxDetailsRequest request input parameters for all transaction records for a specific currency.
@@ -1016,6 +4265,27 @@ extend type Mutation {
 
     # offerCrypto is a request for a Cryptocurrency purchase/sale quote. The exchange quote provided will expire after a fixed period.
     exchangeCrypto(offerID: String!): CryptoTransferResponse!
+
+    # closeCrypto is a request to close a Cryptocurrency account. The balance must be zero unless a sweep currency is provided.
+    closeCrypto(input: CryptoCloseAccountRequest!): CryptoTransferResponse!
+
+    # placeLimitOrder is a request to place a Cryptocurrency limit order. The funds required to fill the order are held until it closes.
+    placeLimitOrder(input: CryptoLimitOrderRequest!): CryptoLimitOrder!
+
+    # cancelLimitOrder is a request to cancel an open limit order and release the funds held for it.
+    cancelLimitOrder(orderID: String!): String!
+
+    # placeTriggerOrder is a request to place a Cryptocurrency stop-loss or take-profit order. No funds are held for the order.
+    placeTriggerOrder(input: CryptoTriggerOrderRequest!): CryptoTriggerOrder!
+
+    # cancelTriggerOrder is a request to cancel an active trigger order.
+    cancelTriggerOrder(orderID: String!): String!
+
+    # scheduleRecurringPurchase is a request to schedule recurring purchases of a Cryptocurrency. No funds are held for the plan.
+    scheduleRecurringPurchase(input: CryptoRecurringPurchaseRequest!): CryptoRecurringPurchase!
+
+    # cancelRecurringPurchase is a request to cancel an active recurring purchase plan.
+    cancelRecurringPurchase(planID: String!): String!
 }
 
 
@@ -1026,11 +4296,38 @@ extend type Query {
     # balanceAllCrypto is a request to retrieve the balance for a specific Crypto currency.
     balanceAllCrypto(pageCursor: String, pageSize: Int32): CryptoBalancesPaginated!
 
+    # balanceAsOfCrypto is a request to retrieve the balance for a specific Cryptocurrency at a point in time.
+    balanceAsOfCrypto(ticker: String!, timestamp: Int64!): BalanceAsOf!
+
+    # balanceHistoryCrypto is a request to retrieve the daily closing balances for a specific Cryptocurrency within a time range.
+    balanceHistoryCrypto(ticker: String!, from: Int64, to: Int64): CryptoBalanceHistory!
+
     # transactionDetailsCrypto is a request to retrieve the details for a specific transaction.
     transactionDetailsCrypto(transactionID: String!): [Any!]!
 
     # transactionDetailsAllCrypto is a request to retrieve the details for a specific transaction.
     transactionDetailsAllCrypto(input: CryptoPaginatedTxDetailsRequest!): CryptoTransactionsPaginated!
+
+    # cryptoAssets is a request to retrieve the supported Cryptocurrencies.
+    cryptoAssets: [CryptoAsset!]!
+
+    # limitOrder is a request to retrieve a limit order along with its state history.
+    limitOrder(orderID: String!): CryptoLimitOrderDetails!
+
+    # limitOrders is a request to retrieve the limit orders, newest first, optionally restricted to a specific status.
+    limitOrders(status: String, pageCursor: String, pageSize: Int32): CryptoLimitOrdersPaginated!
+
+    # triggerOrder is a request to retrieve a trigger order along with its state history.
+    triggerOrder(orderID: String!): CryptoTriggerOrderDetails!
+
+    # triggerOrders is a request to retrieve the trigger orders, newest first, optionally restricted to a specific status.
+    triggerOrders(status: String, pageCursor: String, pageSize: Int32): CryptoTriggerOrdersPaginated!
+
+    # recurringPurchase is a request to retrieve a recurring purchase plan along with a page of its runs, newest first.
+    recurringPurchase(planID: String!, pageCursor: String, pageSize: Int32): CryptoRecurringPurchaseDetails!
+
+    # recurringPurchases is a request to retrieve the recurring purchase plans, newest first, optionally restricted to a specific status.
+    recurringPurchases(status: String, pageCursor: String, pageSize: Int32): CryptoRecurringPurchasesPaginated!
 }
 `, BuiltIn: false},
 	{Name: "../schema/fiat.graphqls", Input: `# FiatOpenAccountResponse is the response returned
@@ -1049,20 +4346,36 @@ type FiatDepositResponse {
     currency: String!
 }
 
-# FiatExchangeTransferResponse
+# FiatExchangeTransferResponse is the response to a Fiat exchange request.
 type FiatExchangeTransferResponse {
     sourceReceipt: FiatDepositResponse!
     destinationReceipt: FiatDepositResponse!
 }
 
-# FiatAccount are the Fiat account details associated with a specific Client ID.
+# FiatCloseAccountResponse is the response to a Fiat account closure. The receipts are only present if a balance was swept.
+type FiatCloseAccountResponse {
+    sourceReceipt: FiatDepositResponse
+    destinationReceipt: FiatDepositResponse
+}
+
+# FiatAccount are the Fiat account details associated with a specific Client ID. The available balance is the ledger
+# balance net of the funds held for outstanding offers.
 type FiatAccount {
     currency:   String!
     balance:    Float!
+    available:  Float!
     lastTx:     Float!
     lastTxTs:   String!
     createdAt:  String!
     clientID:   UUID!
+    status:     String!
+}
+
+# FiatCurrency is a Fiat currency along with its circulation status.
+type FiatCurrency {
+    code:       String!
+    status:     String!
+    updatedAt:  String!
 }
 
 # FiatJournal are the Fiat transactional records for a specific transaction.
@@ -1080,6 +4393,23 @@ type FiatBalancesPaginated {
     links:              Links!
 }
 
+# FiatBalanceSnapshot is the closing balance of a Fiat account at midnight UTC.
+type FiatBalanceSnapshot {
+    clientID:   UUID!
+    currency:   String!
+    snapshotAt: String!
+    balance:    Float!
+    createdAt:  String!
+}
+
+# FiatBalanceHistory are the daily closing balances of a Fiat account within a time range, oldest first.
+type FiatBalanceHistory {
+    currency:   String!
+    from:       Int64!
+    to:         Int64!
+    snapshots:  [FiatBalanceSnapshot!]!
+}
+
 # FiatBalancesPaginated are all of the Fiat account balances retrieved via pagination.
 type FiatTransactionsPaginated {
     transactions:   [FiatJournal!]!
@@ -1092,11 +4422,18 @@ input FiatDepositRequest {
     currency:   String!
 }
 
+# FiatCloseAccountRequest is a request to close a Fiat account with an optional currency to sweep the balance into.
+input FiatCloseAccountRequest {
+    currency:       String!
+    sweepCurrency:  String
+}
+
 # FiatExchangeOfferRequest is a request to exchange Fiat currency from one to another.
 input FiatExchangeOfferRequest {
     sourceCurrency:         String!
     destinationCurrency:    String!
     sourceAmount:           Float!
+    hold:                   Boolean
 }
 
 # FiatPaginatedTxDetailsRequest request input parameters for all transaction records for a specific currency.
@@ -1122,6 +4459,9 @@ extend type Mutation {
 
     # exchangeTransferFiat will execute and complete a valid Fiat currency exchange offer.
     exchangeTransferFiat(offerID: String!): FiatExchangeTransferResponse!
+
+    # closeFiat is a request to close a Fiat account. The balance must be zero unless a sweep currency is provided.
+    closeFiat(input: FiatCloseAccountRequest!): FiatCloseAccountResponse!
 }
 
 extend type Query {
@@ -1131,14 +4471,24 @@ extend type Query {
     # balanceAllFiat is a request to retrieve the balance for a specific Fiat currency.
     balanceAllFiat(pageCursor: String, pageSize: Int32): FiatBalancesPaginated!
 
+    # balanceAsOfFiat is a request to retrieve the balance for a specific Fiat currency at a point in time.
+    balanceAsOfFiat(currencyCode: String!, timestamp: Int64!): BalanceAsOf!
+
+    # balanceHistoryFiat is a request to retrieve the daily closing balances for a specific Fiat currency within a time range.
+    balanceHistoryFiat(currencyCode: String!, from: Int64, to: Int64): FiatBalanceHistory!
+
     # transactionDetailsFiat is a request to retrieve the details for a specific transaction.
     transactionDetailsFiat(transactionID: String!): [Any!]!
 
     # transactionDetailsAllFiat is a request to retrieve the details for a specific transaction.
     transactionDetailsAllFiat(input: FiatPaginatedTxDetailsRequest!): FiatTransactionsPaginated!
+
+    # fiatCurrencies is a request to retrieve the Fiat currencies and their circulation status.
+    fiatCurrencies: [FiatCurrency!]!
 }
 `, BuiltIn: false},
 	{Name: "../schema/healthcheck.graphqls", Input: `type Query {
+    # healthcheck will ping the data tier to check for connectivity.
     healthcheck: String!
 }
 `, BuiltIn: false},
@@ -1147,7 +4497,7 @@ scalar Int32
 scalar Int64
 scalar UUID
 `, BuiltIn: false},
-	{Name: "../schema/user.graphqls", Input: `# User account information.
+	{Name: "../schema/user.graphqls", Input: `# UserAccount is user information.
 input UserAccount {
     firstname: String!
     lastname: String!
@@ -1155,34 +4505,180 @@ input UserAccount {
     userLoginCredentials: UserLoginCredentials!
 }
 
-# Login credentials.
+# UserLoginCredentials are the user's username and password.
 input UserLoginCredentials {
     username: String!
     password: String!
 }
 
-# User account deletion request.
+# User is the account information of a user, excluding the password.
+type User {
+    clientID:       UUID!
+    username:       String!
+    firstName:      String!
+    lastName:       String!
+    email:          String!
+    emailVerified:  Boolean!
+    role:           String!
+    isDeleted:      Boolean!
+    isFrozen:       Boolean!
+}
+
+# UpdateProfileRequest is a request to update the names and email address of a user account. Fields that are not supplied are left unchanged.
+input UpdateProfileRequest {
+    firstName:  String
+    lastName:   String
+    email:      String
+}
+
+# DeleteUserRequest is a user account deletion request.
 input DeleteUserRequest {
     username: String!
     password: String!
     confirmation: String!
 }
 
+# ChangePasswordRequest is a request to replace the password of a user account. The new password must differ from the current password.
+input ChangePasswordRequest {
+    currentPassword: String!
+    newPassword:     String!
+}
+
+# ResetPasswordRequest is a request to replace the password of a user account using a password reset token.
+input ResetPasswordRequest {
+    token:    String!
+    password: String!
+}
+
+# APIKey is the details of an API key for programmatic access, excluding its secret.
+type APIKey {
+    keyID:      String!
+    name:       String!
+    scopes:     [String!]!
+    allowedIPs: [String!]!
+    expiresAt:  String
+    createdAt:  String!
+    revokedAt:  String
+}
+
+# APIKeyResponse is a newly created API key along with its secret, which is only ever returned once.
+type APIKeyResponse {
+    key:    APIKey!
+    secret: String!
+}
+
+# APIKeyRequest is a request to create an API key with the read, trade, and/or transfer scopes. The key may be restricted to a list of IP addresses and CIDR ranges and may expire at a Unix timestamp.
+input APIKeyRequest {
+    name:       String!
+    scopes:     [String!]!
+    allowedIPs: [String!]
+    expiresAt:  Int64
+}
+
+# Session is the details of an active session and the device it was last refreshed from.
+type Session {
+    sessionID:     String!
+    device:        String!
+    ipAddress:     String!
+    startedAt:     String!
+    lastRefreshed: String!
+    expiresAt:     String!
+}
+
+# SecurityEvent is a recent security or account event on a user account along with the device it originated from.
+type SecurityEvent {
+    eventType:  String!
+    outcome:    String!
+    ipAddress:  String!
+    userAgent:  String!
+    occurredAt: String!
+}
+
+# MFAEnrollment is a pending two-factor authentication enrolment with its TOTP secret and the otpauth URI to add it to an authenticator application with.
+type MFAEnrollment {
+    secret: String!
+    uri:    String!
+}
+
 # Requests that might alter the state of data in the database.
 type Mutation {
-    # Send a user registration request and receive a JWT authorization token in response.
+    # registerUser is a user registration request. A JWT authorization token and a refresh token are returned as a successful response.
     registerUser(input: UserAccount): JWTAuthResponse!
 
-    # Send a user account deletion request.
+    # deleteUser is a mutation to soft delete a user account. Users that have enabled two-factor authentication must provide a one-time password or recovery code in the X-OTP header.
     deleteUser(input: DeleteUserRequest!): String!
 
-    # Send a user login request And receive a JWT authorization token in response. This has no side effects but is a
+    # loginUser is a login request And receive a JWT authorization token in response. This has no side effects but is a
     # mutation to force sequential execution. This stops operations such as delete and refresh from being run in
-    # parallel with a login.
+    # parallel with a login. Users that have enabled two-factor authentication must provide a one-time password or
+    # recovery code in the X-OTP header.
     loginUser(input: UserLoginCredentials!): JWTAuthResponse!
 
-    # Refreshes a users JWT if it is within the refresh time window.
+    # refreshToken refreshes a users JWT if it is within the refresh time window.
     refreshToken: JWTAuthResponse!
+
+    # refreshSession exchanges a single-use refresh token for a new JWT and refresh token in the same session. Reusing a refresh token revokes its session.
+    refreshSession(refreshToken: String!): JWTAuthResponse!
+
+    # logoutUser revokes the JWT the request is authorized with for the remainder of its validity interval.
+    logoutUser: String!
+
+    # logoutAllSessions revokes all the JWTs issued to the user, including the JWT the request is authorized with.
+    logoutAllSessions: String!
+
+    # changePassword replaces the password of a user account. All the sessions of the user are revoked and all the JWTs issued to the user, including the JWT the request is authorized with, are rejected. Users that have enabled two-factor authentication must provide a one-time password or recovery code in the X-OTP header.
+    changePassword(input: ChangePasswordRequest!): String!
+
+    # requestPasswordReset mails a single-use password reset token to the email address on a user account. The same response is returned whether or not the username belongs to an active user account.
+    requestPasswordReset(username: String!): String!
+
+    # resetPassword replaces the password of a user account using a password reset token. All the sessions of the user are revoked and all the JWTs issued to the user are rejected. Users that have enabled two-factor authentication must provide a one-time password or recovery code in the X-OTP header.
+    resetPassword(input: ResetPasswordRequest!): String!
+
+    # createAPIKey is a request to create a scoped API key for programmatic access. API keys cannot be used to manage API keys. Users that have enabled two-factor authentication must provide a one-time password or recovery code in the X-OTP header.
+    createAPIKey(input: APIKeyRequest!): APIKeyResponse!
+
+    # revokeAPIKey is a request to revoke an active API key.
+    revokeAPIKey(keyID: String!): String!
+
+    # revokeSession is a request to revoke an active session so that its refresh token can no longer be exchanged.
+    revokeSession(sessionID: String!): String!
+
+    # enrollMFA starts a two-factor authentication enrolment. Two-factor authentication is only enabled once the enrolment is confirmed with a one-time password.
+    enrollMFA: MFAEnrollment!
+
+    # confirmMFA enables two-factor authentication with a one-time password generated for a pending enrolment. The single-use recovery codes are only returned in the response to this request.
+    confirmMFA(code: String!): [String!]!
+
+    # regenerateRecoveryCodes replaces the recovery codes for two-factor authentication. A one-time password or recovery code must be provided in the X-OTP header.
+    regenerateRecoveryCodes: [String!]!
+
+    # disableMFA disables two-factor authentication. A one-time password or recovery code must be provided in the X-OTP header.
+    disableMFA: String!
+
+    # updateProfile updates the names and email address of a user account. A changed email address is unverified until the verification link mailed to it is followed. Users that have enabled two-factor authentication must provide a one-time password or recovery code in the X-OTP header to change their email address.
+    updateProfile(input: UpdateProfileRequest!): User!
+
+    # sendEmailVerification mails a new verification link to the unverified email address on a user account.
+    sendEmailVerification: String!
+
+    # verifyEmail marks the email address in a signed verification token as verified. Tokens that have expired or were sent to an email address that has since been changed are rejected.
+    verifyEmail(token: String!): String!
+}
+
+extend type Query {
+    # me is a request to retrieve the account information of the user, excluding the password.
+    me: User!
+
+    # apiKeys is a request to retrieve the details of all the API keys a client has created, newest first.
+    apiKeys: [APIKey!]!
+
+    # sessions is a request to retrieve the details of all the active sessions of a user, most recently refreshed first.
+    sessions: [Session!]!
+
+    # securityActivity is a request to retrieve the most recent logins, token refreshes, password changes, and money
+    # movements on a user account, newest first.
+    securityActivity: [SecurityEvent!]!
 }
 `, BuiltIn: false},
 }
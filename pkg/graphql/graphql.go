@@ -12,8 +12,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
 	graphql "github.com/surahman/FTeX/pkg/graphql/resolvers"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -28,6 +30,7 @@ type Server struct {
 	auth   auth.Auth
 	cache  redis.Redis
 	db     postgres.Postgres
+	notify notifier.Notifier
 	quotes quotes.Quotes
 	conf   *config
 	logger *logger.Logger
@@ -37,7 +40,7 @@ type Server struct {
 
 // NewServer will create a new GraphQL server instance in a non-running state.
 func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis redis.Redis, quotes quotes.Quotes,
-	logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
+	notify notifier.Notifier, logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
 	// Load configurations.
 	conf := newConfig()
 	if err = conf.Load(*fs); err != nil {
@@ -49,6 +52,7 @@ func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis r
 			auth:   auth,
 			cache:  redis,
 			db:     postgres,
+			notify: notify,
 			quotes: quotes,
 			logger: logger,
 			wg:     wg,
@@ -63,11 +67,28 @@ func (s *Server) initialize() {
 	// Endpoint configurations
 	api := s.router.Group(s.conf.Server.BasePath)
 	api.Use(graphql.GinContextToContextMiddleware())
+	defaultPolicy, operationPolicies := rateLimitPolicies(s.conf)
 	api.POST(s.conf.Server.QueryPath,
-		graphql.QueryHandler(s.conf.Authorization.HeaderKey, s.auth, s.cache, s.db, s.quotes, s.logger))
+		graphql.RateLimitMiddleware(
+			s.auth, s.cache, s.logger, s.conf.Authorization.HeaderKey, defaultPolicy, operationPolicies),
+		graphql.QueryHandler(s.conf.Authorization.HeaderKey, s.auth, s.cache, s.db, s.notify, s.quotes, s.logger))
 	api.GET(s.conf.Server.PlaygroundPath, graphql.PlaygroundHandler(s.conf.Server.BasePath, s.conf.Server.QueryPath))
 }
 
+// rateLimitPolicies will build the default rate limit policy and the policies for individual queries and mutations.
+// Operation policies are keyed by the name of the query or mutation field in the schema.
+func rateLimitPolicies(conf *config) (common.RateLimitPolicy, map[string]common.RateLimitPolicy) {
+	defaultPolicy := common.RateLimitPolicy{Name: "graphql", Limit: conf.RateLimit.Limit, Window: conf.RateLimit.Window}
+	operationPolicies := make(map[string]common.RateLimitPolicy, len(conf.RateLimit.Operations))
+
+	for _, operation := range conf.RateLimit.Operations {
+		operationPolicies[operation.Name] = common.RateLimitPolicy{
+			Name: "graphql:" + operation.Name, Limit: operation.Limit, Window: operation.Window}
+	}
+
+	return defaultPolicy, operationPolicies
+}
+
 // Run brings the HTTP GraphQL service up.
 func (s *Server) Run() {
 	// Indicate to bootstrapping thread to wait for completion.
@@ -3,13 +3,16 @@ package graphql
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/quotes"
+	"gopkg.in/yaml.v3"
 )
 
 func TestNewGraphQLServer(t *testing.T) {
@@ -20,13 +23,27 @@ func TestNewGraphQLServer(t *testing.T) {
 	mockPostgres := mocks.NewMockPostgres(mockCtrl)
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+	mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
 	fs := afero.NewMemMapFs()
 	require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
 	require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.HTTPGraphQLFileName(),
 		[]byte(graphQLConfigTestData["valid"]), 0644), "Failed to write in memory file")
 
-	server, err := NewServer(&fs, mockAuth, mockPostgres, mockRedis, mockQuotes, zapLogger, &sync.WaitGroup{})
+	server, err := NewServer(
+		&fs, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, zapLogger, &sync.WaitGroup{})
 	require.NoError(t, err, "error whilst creating mock server")
 	require.NotNil(t, server, "failed to create mock server")
 }
+
+func TestRateLimitPolicies(t *testing.T) {
+	conf := newConfig()
+	require.NoError(t, yaml.Unmarshal([]byte(graphQLConfigTestData["valid"]), conf), "failed to unmarshal config")
+
+	defaultPolicy, operationPolicies := rateLimitPolicies(conf)
+	require.Equal(t, common.RateLimitPolicy{Name: "graphql", Limit: 120, Window: time.Minute}, defaultPolicy,
+		"default policy mismatch")
+	require.Equal(t, map[string]common.RateLimitPolicy{
+		"offerCrypto": {Name: "graphql:offerCrypto", Limit: 10, Window: time.Minute},
+	}, operationPolicies, "operation policies mismatch")
+}
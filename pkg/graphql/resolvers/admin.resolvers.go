@@ -0,0 +1,958 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.31
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/ledger"
+	"github.com/surahman/FTeX/pkg/models"
+	models1 "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// AdminID is the resolver for the adminID field.
+func (r *adminAuditLogResolver) AdminID(ctx context.Context, obj *postgres.AdminAuditLog) (string, error) {
+	return obj.AdminID.String(), nil
+}
+
+// Action is the resolver for the action field.
+func (r *adminAuditLogResolver) Action(ctx context.Context, obj *postgres.AdminAuditLog) (string, error) {
+	return string(obj.Action), nil
+}
+
+// Details is the resolver for the details field.
+func (r *adminAuditLogResolver) Details(ctx context.Context, obj *postgres.AdminAuditLog) (string, error) {
+	return string(obj.Details), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *adminAuditLogResolver) CreatedAt(ctx context.Context, obj *postgres.AdminAuditLog) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// Outcome is the resolver for the outcome field.
+func (r *adminAuditLogResolver) Outcome(ctx context.Context, obj *postgres.AdminAuditLog) (*string, error) {
+	if obj.Outcome == nil {
+		return nil, nil
+	}
+
+	outcome := string(*obj.Outcome)
+
+	return &outcome, nil
+}
+
+// EventType is the resolver for the eventType field.
+func (r *auditEventResolver) EventType(ctx context.Context, obj *postgres.AuditEvent) (string, error) {
+	return string(obj.EventType), nil
+}
+
+// Outcome is the resolver for the outcome field.
+func (r *auditEventResolver) Outcome(ctx context.Context, obj *postgres.AuditEvent) (string, error) {
+	return string(obj.Outcome), nil
+}
+
+// ActorID is the resolver for the actorID field.
+func (r *auditEventResolver) ActorID(ctx context.Context, obj *postgres.AuditEvent) (string, error) {
+	if !obj.ActorID.Valid {
+		return "", nil
+	}
+
+	return uuid.UUID(obj.ActorID.Bytes).String(), nil
+}
+
+// SubjectID is the resolver for the subjectID field.
+func (r *auditEventResolver) SubjectID(ctx context.Context, obj *postgres.AuditEvent) (string, error) {
+	if !obj.SubjectID.Valid {
+		return "", nil
+	}
+
+	return uuid.UUID(obj.SubjectID.Bytes).String(), nil
+}
+
+// Payload is the resolver for the payload field.
+func (r *auditEventResolver) Payload(ctx context.Context, obj *postgres.AuditEvent) (string, error) {
+	return string(obj.Payload), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *auditEventResolver) CreatedAt(ctx context.Context, obj *postgres.AuditEvent) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *fiatAdjustmentResolver) ClientID(ctx context.Context, obj *postgres.FiatAdjustment) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// Currency is the resolver for the currency field.
+func (r *fiatAdjustmentResolver) Currency(ctx context.Context, obj *postgres.FiatAdjustment) (string, error) {
+	return string(obj.Currency), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *fiatAdjustmentResolver) Amount(ctx context.Context, obj *postgres.FiatAdjustment) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// ReasonCode is the resolver for the reasonCode field.
+func (r *fiatAdjustmentResolver) ReasonCode(ctx context.Context, obj *postgres.FiatAdjustment) (string, error) {
+	return string(obj.ReasonCode), nil
+}
+
+// Status is the resolver for the status field.
+func (r *fiatAdjustmentResolver) Status(ctx context.Context, obj *postgres.FiatAdjustment) (string, error) {
+	return string(obj.Status), nil
+}
+
+// MakerID is the resolver for the makerID field.
+func (r *fiatAdjustmentResolver) MakerID(ctx context.Context, obj *postgres.FiatAdjustment) (string, error) {
+	return obj.MakerID.String(), nil
+}
+
+// CheckerID is the resolver for the checkerID field.
+func (r *fiatAdjustmentResolver) CheckerID(ctx context.Context, obj *postgres.FiatAdjustment) (*string, error) {
+	if !obj.CheckerID.Valid {
+		return nil, nil
+	}
+
+	checkerID := uuid.UUID(obj.CheckerID.Bytes).String()
+
+	return &checkerID, nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *fiatAdjustmentResolver) TxID(ctx context.Context, obj *postgres.FiatAdjustment) (*string, error) {
+	if !obj.TxID.Valid {
+		return nil, nil
+	}
+
+	txID := uuid.UUID(obj.TxID.Bytes).String()
+
+	return &txID, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *fiatAdjustmentResolver) CreatedAt(ctx context.Context, obj *postgres.FiatAdjustment) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// DecidedAt is the resolver for the decidedAt field.
+func (r *fiatAdjustmentResolver) DecidedAt(ctx context.Context, obj *postgres.FiatAdjustment) (*string, error) {
+	if !obj.DecidedAt.Valid {
+		return nil, nil
+	}
+
+	decidedAt := obj.DecidedAt.Time.String()
+
+	return &decidedAt, nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *fiatAdjustmentRequestResolver) Amount(ctx context.Context, obj *models.HTTPAdminAdjustmentRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *journalChainBreakResolver) TxID(ctx context.Context, obj *postgres.JournalChainBreak) (string, error) {
+	return obj.TxID.String(), nil
+}
+
+// Scope is the resolver for the scope field.
+func (r *loginLockoutResolver) Scope(ctx context.Context, obj *postgres.LoginLockout) (string, error) {
+	return string(obj.Scope), nil
+}
+
+// LockedAt is the resolver for the lockedAt field.
+func (r *loginLockoutResolver) LockedAt(ctx context.Context, obj *postgres.LoginLockout) (string, error) {
+	return obj.LockedAt.Time.String(), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *loginLockoutResolver) ExpiresAt(ctx context.Context, obj *postgres.LoginLockout) (string, error) {
+	return obj.ExpiresAt.Time.String(), nil
+}
+
+// UnlockedBy is the resolver for the unlockedBy field.
+func (r *loginLockoutResolver) UnlockedBy(ctx context.Context, obj *postgres.LoginLockout) (*string, error) {
+	if !obj.UnlockedBy.Valid {
+		return nil, nil
+	}
+
+	unlockedBy := uuid.UUID(obj.UnlockedBy.Bytes).String()
+
+	return &unlockedBy, nil
+}
+
+// UnlockedAt is the resolver for the unlockedAt field.
+func (r *loginLockoutResolver) UnlockedAt(ctx context.Context, obj *postgres.LoginLockout) (*string, error) {
+	if !obj.UnlockedAt.Valid {
+		return nil, nil
+	}
+
+	unlockedAt := obj.UnlockedAt.Time.String()
+
+	return &unlockedAt, nil
+}
+
+// AdminFreezeUser is the resolver for the adminFreezeUser field.
+func (r *mutationResolver) AdminFreezeUser(ctx context.Context, clientID string, isFrozen bool, reason string) (*models.AdminFreezeResponse, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		request     = models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: reason}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if _, httpMessage, payload, err = common.HTTPAdminUserFreeze(r.db, r.logger, adminID, clientID,
+		&request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return &models.AdminFreezeResponse{ClientID: clientID, IsFrozen: isFrozen}, nil
+}
+
+// AdminUnlockUser is the resolver for the adminUnlockUser field.
+func (r *mutationResolver) AdminUnlockUser(ctx context.Context, clientID string, reason string) (*models.AdminUnlockResponse, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		request     = models.HTTPAdminUnlockRequest{Reason: reason}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if _, httpMessage, payload, err = common.HTTPAdminUnlockUser(r.cache, r.db, r.logger, adminID, clientID,
+		&request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return &models.AdminUnlockResponse{ClientID: clientID}, nil
+}
+
+// AdminFiatAccountStatus is the resolver for the adminFiatAccountStatus field.
+func (r *mutationResolver) AdminFiatAccountStatus(ctx context.Context, clientID string, currency string, status string, reason string) (*models.AdminAccountStatusResponse, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		request     = models.HTTPAdminAccountStatusRequest{Status: status, Reason: reason}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if _, httpMessage, payload, err = common.HTTPAdminAccountStatus(r.db, r.logger, adminID, clientID, currency,
+		false, &request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return &models.AdminAccountStatusResponse{ClientID: clientID, Code: currency, Status: status}, nil
+}
+
+// AdminCryptoAccountStatus is the resolver for the adminCryptoAccountStatus field.
+func (r *mutationResolver) AdminCryptoAccountStatus(ctx context.Context, clientID string, ticker string, status string, reason string) (*models.AdminAccountStatusResponse, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		request     = models.HTTPAdminAccountStatusRequest{Status: status, Reason: reason}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if _, httpMessage, payload, err = common.HTTPAdminAccountStatus(r.db, r.logger, adminID, clientID, ticker,
+		true, &request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return &models.AdminAccountStatusResponse{ClientID: clientID, Code: ticker, Status: status}, nil
+}
+
+// AdminReverseTransaction is the resolver for the adminReverseTransaction field.
+func (r *mutationResolver) AdminReverseTransaction(ctx context.Context, transactionID string, reason string) (*postgres.TransactionReversal, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		reversal    *postgres.TransactionReversal
+		request     = models.HTTPAdminReversalRequest{Reason: reason}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if reversal, _, httpMessage, payload, err = common.HTTPAdminTransactionReverse(r.db, r.logger, adminID,
+		transactionID, &request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return reversal, nil
+}
+
+// AdminRequestAdjustment is the resolver for the adminRequestAdjustment field.
+func (r *mutationResolver) AdminRequestAdjustment(ctx context.Context, input models.HTTPAdminAdjustmentRequest) (*postgres.FiatAdjustment, error) {
+	var (
+		adjustment  *postgres.FiatAdjustment
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if adjustment, _, httpMessage, payload, err = common.HTTPAdminAdjustmentCreate(r.db, r.logger, adminID,
+		&input); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return adjustment, nil
+}
+
+// AdminApproveAdjustment is the resolver for the adminApproveAdjustment field.
+func (r *mutationResolver) AdminApproveAdjustment(ctx context.Context, adjustmentID string, note string) (*postgres.FiatAdjustment, error) {
+	var (
+		adjustment  *postgres.FiatAdjustment
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		request     = models.HTTPAdminAdjustmentDecisionRequest{Note: note}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if adjustment, _, httpMessage, payload, err = common.HTTPAdminAdjustmentDecide(r.db, r.logger, adminID,
+		adjustmentID, true, &request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return adjustment, nil
+}
+
+// AdminRejectAdjustment is the resolver for the adminRejectAdjustment field.
+func (r *mutationResolver) AdminRejectAdjustment(ctx context.Context, adjustmentID string, note string) (*postgres.FiatAdjustment, error) {
+	var (
+		adjustment  *postgres.FiatAdjustment
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		request     = models.HTTPAdminAdjustmentDecisionRequest{Note: note}
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminWrite()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if adjustment, _, httpMessage, payload, err = common.HTTPAdminAdjustmentDecide(r.db, r.logger, adminID,
+		adjustmentID, false, &request); err != nil {
+		if payload != nil {
+			return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+		}
+
+		return nil, errors.New(httpMessage)
+	}
+
+	return adjustment, nil
+}
+
+// AdminUserSearch is the resolver for the adminUserSearch field.
+func (r *queryResolver) AdminUserSearch(ctx context.Context, query string, limit *int32) ([]models1.UserProfile, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		profiles    []models1.UserProfile
+	)
+
+	if limit == nil {
+		limit = new(int32)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if profiles, _, httpMessage, err = common.HTTPAdminUserSearch(r.db, r.logger, adminID, query,
+		strconv.Itoa(int(*limit))); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return profiles, nil
+}
+
+// AdminUser is the resolver for the adminUser field.
+func (r *queryResolver) AdminUser(ctx context.Context, clientID string) (*models1.UserProfile, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		profile     *models1.UserProfile
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if profile, _, httpMessage, err = common.HTTPAdminUserView(r.db, r.logger, adminID, clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return profile, nil
+}
+
+// AdminBalanceAllFiat is the resolver for the adminBalanceAllFiat field.
+func (r *queryResolver) AdminBalanceAllFiat(ctx context.Context, clientID string, pageCursor *string, pageSize *int32) (*models.HTTPFiatDetailsPaginated, error) {
+	var (
+		accDetails  *models.HTTPFiatDetailsPaginated
+		adminID     uuid.UUID
+		targetID    uuid.UUID
+		done        common.AdminActionDone
+		err         error
+		httpMessage string
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if targetID, done, _, httpMessage, err = common.HTTPAdminTarget(r.db, r.logger, adminID, clientID,
+		postgres.AdminActionFIATACCOUNTVIEW, nil); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	accDetails, _, httpMessage, err = common.HTTPFiatBalancePaginated(r.auth, r.db, r.logger,
+		targetID, *pageCursor, strconv.Itoa(int(*pageSize)), false)
+	done(err)
+
+	if err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return accDetails, nil
+}
+
+// AdminTransactionDetailsAllFiat is the resolver for the adminTransactionDetailsAllFiat field.
+func (r *queryResolver) AdminTransactionDetailsAllFiat(ctx context.Context, clientID string, input models.FiatPaginatedTxDetailsRequest) (*models.HTTPFiatTransactionsPaginated, error) {
+	var (
+		journalEntries *models.HTTPFiatTransactionsPaginated
+		adminID        uuid.UUID
+		targetID       uuid.UUID
+		done           common.AdminActionDone
+		err            error
+		params         common.HTTPPaginatedTxParams
+		httpMessage    string
+		payload        any
+	)
+
+	if input.PageSize == nil {
+		input.PageSize = new(string)
+	}
+	params.PageSizeStr = *input.PageSize
+
+	if input.PageCursor == nil {
+		input.PageCursor = new(string)
+	}
+	params.PageCursorStr = *input.PageCursor
+
+	if input.Timezone == nil {
+		input.Timezone = new(string)
+	}
+	params.TimezoneStr = *input.Timezone
+
+	if input.Month == nil {
+		input.Month = new(string)
+	}
+	params.MonthStr = *input.Month
+
+	if input.Year == nil {
+		input.Year = new(string)
+	}
+	params.YearStr = *input.Year
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if targetID, done, _, httpMessage, err = common.HTTPAdminTarget(r.db, r.logger, adminID, clientID,
+		postgres.AdminActionFIATJOURNALVIEW, map[string]any{"currency": input.Currency}); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	journalEntries, _, httpMessage, payload, err = common.HTTPFiatTransactionsPaginated(r.auth, r.db,
+		r.logger, targetID, input.Currency, &params, false)
+	done(err)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return journalEntries, nil
+}
+
+// AdminBalanceAllCrypto is the resolver for the adminBalanceAllCrypto field.
+func (r *queryResolver) AdminBalanceAllCrypto(ctx context.Context, clientID string, pageCursor *string, pageSize *int32) (*models.HTTPCryptoDetailsPaginated, error) {
+	var (
+		accDetails  models.HTTPCryptoDetailsPaginated
+		adminID     uuid.UUID
+		targetID    uuid.UUID
+		done        common.AdminActionDone
+		err         error
+		httpMessage string
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if targetID, done, _, httpMessage, err = common.HTTPAdminTarget(r.db, r.logger, adminID, clientID,
+		postgres.AdminActionCRYPTOACCOUNTVIEW, nil); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	accDetails, _, httpMessage, err = common.HTTPCryptoBalancePaginated(r.auth, r.db, r.logger,
+		targetID, *pageCursor, strconv.Itoa(int(*pageSize)), false)
+	done(err)
+
+	if err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &accDetails, nil
+}
+
+// AdminTransactionDetailsAllCrypto is the resolver for the adminTransactionDetailsAllCrypto field.
+func (r *queryResolver) AdminTransactionDetailsAllCrypto(ctx context.Context, clientID string, input models.CryptoPaginatedTxDetailsRequest) (*models.HTTPCryptoTransactionsPaginated, error) {
+	var (
+		journalEntries models.HTTPCryptoTransactionsPaginated
+		adminID        uuid.UUID
+		targetID       uuid.UUID
+		done           common.AdminActionDone
+		err            error
+		params         common.HTTPPaginatedTxParams
+		httpMessage    string
+	)
+
+	if input.PageSize == nil {
+		input.PageSize = new(string)
+	}
+	params.PageSizeStr = *input.PageSize
+
+	if input.PageCursor == nil {
+		input.PageCursor = new(string)
+	}
+	params.PageCursorStr = *input.PageCursor
+
+	if input.Timezone == nil {
+		input.Timezone = new(string)
+	}
+	params.TimezoneStr = *input.Timezone
+
+	if input.Month == nil {
+		input.Month = new(string)
+	}
+	params.MonthStr = *input.Month
+
+	if input.Year == nil {
+		input.Year = new(string)
+	}
+	params.YearStr = *input.Year
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if targetID, done, _, httpMessage, err = common.HTTPAdminTarget(r.db, r.logger, adminID, clientID,
+		postgres.AdminActionCRYPTOJOURNALVIEW, map[string]any{"ticker": input.Ticker}); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	journalEntries, _, httpMessage, err = common.HTTPCryptoTransactionsPaginated(r.auth, r.db,
+		r.logger, &params, targetID, input.Ticker, false)
+	done(err)
+
+	if err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &journalEntries, nil
+}
+
+// AdminAuditLog is the resolver for the adminAuditLog field.
+func (r *queryResolver) AdminAuditLog(ctx context.Context, target *string, pageCursor *string, pageSize *int32) (*models.HTTPAdminAuditLogPaginated, error) {
+	var (
+		adminID     uuid.UUID
+		auditLog    *models.HTTPAdminAuditLogPaginated
+		err         error
+		httpMessage string
+	)
+
+	if target == nil {
+		target = new(string)
+	}
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if auditLog, _, httpMessage, err = common.HTTPAdminAuditLog(r.auth, r.db, r.logger, adminID, *target,
+		*pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return auditLog, nil
+}
+
+// AdminAuditEvents is the resolver for the adminAuditEvents field.
+func (r *queryResolver) AdminAuditEvents(ctx context.Context, clientID *string, eventType *string, outcome *string, pageCursor *string, pageSize *int32) (*models.HTTPAuditEventsPaginated, error) {
+	var (
+		adminID     uuid.UUID
+		events      *models.HTTPAuditEventsPaginated
+		err         error
+		httpMessage string
+	)
+
+	for _, param := range []**string{&clientID, &eventType, &outcome, &pageCursor} {
+		if *param == nil {
+			*param = new(string)
+		}
+	}
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if events, _, httpMessage, err = common.HTTPAdminAuditEvents(r.auth, r.db, r.logger, adminID,
+		&common.HTTPAuditEventsParams{
+			ClientIDStr:   *clientID,
+			EventType:     *eventType,
+			Outcome:       *outcome,
+			PageCursorStr: *pageCursor,
+			PageSizeStr:   strconv.Itoa(int(*pageSize)),
+		}, false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return events, nil
+}
+
+// AdminLoginLockouts is the resolver for the adminLoginLockouts field.
+func (r *queryResolver) AdminLoginLockouts(ctx context.Context, active *bool, limit *int32) ([]postgres.LoginLockout, error) {
+	var (
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+		lockouts    []postgres.LoginLockout
+	)
+
+	if active == nil {
+		active = new(bool)
+	}
+
+	if limit == nil {
+		limit = new(int32)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if lockouts, _, httpMessage, err = common.HTTPAdminLoginLockouts(r.db, r.logger, adminID, *active,
+		strconv.Itoa(int(*limit))); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return lockouts, nil
+}
+
+// AdminLedgerVerify is the resolver for the adminLedgerVerify field.
+func (r *queryResolver) AdminLedgerVerify(ctx context.Context, journal *string) (*models.HTTPLedgerVerification, error) {
+	var (
+		adminID      uuid.UUID
+		verification *models.HTTPLedgerVerification
+		err          error
+		httpMessage  string
+	)
+
+	if journal == nil {
+		journal = new(string)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if verification, _, httpMessage, err = common.HTTPAdminLedgerVerify(r.auth, r.db, r.logger, adminID,
+		*journal); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return verification, nil
+}
+
+// AdminLedgerCheckpoints is the resolver for the adminLedgerCheckpoints field.
+func (r *queryResolver) AdminLedgerCheckpoints(ctx context.Context, journal *string, since *int64) (*ledger.CheckpointExport, error) {
+	var (
+		adminID     uuid.UUID
+		export      *ledger.CheckpointExport
+		err         error
+		httpMessage string
+		sinceStr    string
+	)
+
+	if journal == nil {
+		journal = new(string)
+	}
+
+	if since != nil {
+		sinceStr = strconv.FormatInt(*since, 10)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if export, _, httpMessage, err = common.HTTPAdminLedgerCheckpoints(r.auth, r.db, r.logger, adminID, *journal,
+		sinceStr); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return export, nil
+}
+
+// AdminAdjustment is the resolver for the adminAdjustment field.
+func (r *queryResolver) AdminAdjustment(ctx context.Context, adjustmentID string) (*postgres.FiatAdjustment, error) {
+	var (
+		adjustment  *postgres.FiatAdjustment
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if adjustment, _, httpMessage, err = common.HTTPAdminAdjustment(r.db, r.logger, adminID,
+		adjustmentID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return adjustment, nil
+}
+
+// AdminAdjustments is the resolver for the adminAdjustments field.
+func (r *queryResolver) AdminAdjustments(ctx context.Context, status *string, pageCursor *string, pageSize *int32) (*models.HTTPAdminAdjustmentsPaginated, error) {
+	var (
+		adjustments *models.HTTPAdminAdjustmentsPaginated
+		adminID     uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if status == nil {
+		status = new(string)
+	}
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.ScopeAdminRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if adjustments, _, httpMessage, err = common.HTTPAdminAdjustmentsPaginated(r.auth, r.db, r.logger, adminID,
+		*status, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return adjustments, nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *transactionReversalResolver) TxID(ctx context.Context, obj *postgres.TransactionReversal) (string, error) {
+	return obj.TxID.String(), nil
+}
+
+// ReversalTxID is the resolver for the reversalTxID field.
+func (r *transactionReversalResolver) ReversalTxID(ctx context.Context, obj *postgres.TransactionReversal) (string, error) {
+	return obj.ReversalTxID.String(), nil
+}
+
+// AdminID is the resolver for the adminID field.
+func (r *transactionReversalResolver) AdminID(ctx context.Context, obj *postgres.TransactionReversal) (string, error) {
+	return obj.AdminID.String(), nil
+}
+
+// ReversedAt is the resolver for the reversedAt field.
+func (r *transactionReversalResolver) ReversedAt(ctx context.Context, obj *postgres.TransactionReversal) (string, error) {
+	return obj.ReversedAt.Time.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *userProfileResolver) ClientID(ctx context.Context, obj *models1.UserProfile) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// AdminAuditLog returns graphql_generated.AdminAuditLogResolver implementation.
+func (r *Resolver) AdminAuditLog() graphql_generated.AdminAuditLogResolver {
+	return &adminAuditLogResolver{r}
+}
+
+// AuditEvent returns graphql_generated.AuditEventResolver implementation.
+func (r *Resolver) AuditEvent() graphql_generated.AuditEventResolver { return &auditEventResolver{r} }
+
+// FiatAdjustment returns graphql_generated.FiatAdjustmentResolver implementation.
+func (r *Resolver) FiatAdjustment() graphql_generated.FiatAdjustmentResolver {
+	return &fiatAdjustmentResolver{r}
+}
+
+// FiatAdjustmentRequest returns graphql_generated.FiatAdjustmentRequestResolver implementation.
+func (r *Resolver) FiatAdjustmentRequest() graphql_generated.FiatAdjustmentRequestResolver {
+	return &fiatAdjustmentRequestResolver{r}
+}
+
+// JournalChainBreak returns graphql_generated.JournalChainBreakResolver implementation.
+func (r *Resolver) JournalChainBreak() graphql_generated.JournalChainBreakResolver {
+	return &journalChainBreakResolver{r}
+}
+
+// LoginLockout returns graphql_generated.LoginLockoutResolver implementation.
+func (r *Resolver) LoginLockout() graphql_generated.LoginLockoutResolver {
+	return &loginLockoutResolver{r}
+}
+
+// TransactionReversal returns graphql_generated.TransactionReversalResolver implementation.
+func (r *Resolver) TransactionReversal() graphql_generated.TransactionReversalResolver {
+	return &transactionReversalResolver{r}
+}
+
+// UserProfile returns graphql_generated.UserProfileResolver implementation.
+func (r *Resolver) UserProfile() graphql_generated.UserProfileResolver {
+	return &userProfileResolver{r}
+}
+
+type adminAuditLogResolver struct{ *Resolver }
+type auditEventResolver struct{ *Resolver }
+type fiatAdjustmentResolver struct{ *Resolver }
+type fiatAdjustmentRequestResolver struct{ *Resolver }
+type journalChainBreakResolver struct{ *Resolver }
+type loginLockoutResolver struct{ *Resolver }
+type transactionReversalResolver struct{ *Resolver }
+type userProfileResolver struct{ *Resolver }
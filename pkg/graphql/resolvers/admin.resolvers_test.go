@@ -0,0 +1,2469 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestAdminResolver_AdminFreezeUser(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		setFrozenErr         error
+		setFrozenTimes       int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-freeze-user/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], clientID, true, "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			setFrozenErr:         nil,
+			setFrozenTimes:       0,
+		}, {
+			name:                 "empty reason",
+			path:                 "/admin-freeze-user/empty-reason",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], clientID, true, ""),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			setFrozenErr:         nil,
+			setFrozenTimes:       0,
+		}, {
+			name:                 "invalid client id",
+			path:                 "/admin-freeze-user/invalid-client-id",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], "invalid-client-id", true, "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			setFrozenErr:         nil,
+			setFrozenTimes:       0,
+		}, {
+			name:                 "self freeze",
+			path:                 "/admin-freeze-user/self-freeze",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], uuid.UUID{}, true, "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			setFrozenErr:         nil,
+			setFrozenTimes:       0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-freeze-user/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], clientID, true, "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			setFrozenErr:         nil,
+			setFrozenTimes:       0,
+		}, {
+			name:                 "user not found",
+			path:                 "/admin-freeze-user/user-not-found",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], clientID, true, "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			setFrozenErr:         postgres.ErrNotFoundUser,
+			setFrozenTimes:       1,
+		}, {
+			name:                 "valid freeze",
+			path:                 "/admin-freeze-user/valid-freeze",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], clientID, true, "suspicious activity"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			setFrozenErr:         nil,
+			setFrozenTimes:       1,
+		}, {
+			name:                 "valid unfreeze",
+			path:                 "/admin-freeze-user/valid-unfreeze",
+			query:                fmt.Sprintf(testAdminQuery["freezeUser"], clientID, false, "investigation closed"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			setFrozenErr:         nil,
+			setFrozenTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().UserSetFrozen(clientID, gomock.Any()).
+					Return(test.setFrozenErr).
+					Times(test.setFrozenTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminAccountStatus(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		fiatErr              error
+		fiatTimes            int
+		cryptoErr            error
+		cryptoTimes          int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-account-status/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "USD", "FROZEN", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "empty reason",
+			path:                 "/admin-account-status/empty-reason",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "USD", "FROZEN", ""),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "closed status",
+			path:                 "/admin-account-status/closed-status",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "USD", "CLOSED", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "invalid client id",
+			path:                 "/admin-account-status/invalid-client-id",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], "invalid-client-id", "USD", "FROZEN", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/admin-account-status/invalid-currency",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "INVALID", "FROZEN", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-account-status/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "USD", "FROZEN", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "fiat not found",
+			path:                 "/admin-account-status/fiat-not-found",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "USD", "FROZEN", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			fiatErr:              postgres.ErrNotFound,
+			fiatTimes:            1,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "crypto not found",
+			path:                 "/admin-account-status/crypto-not-found",
+			query:                fmt.Sprintf(testAdminQuery["cryptoAccountStatus"], clientID, "BTC", "FROZEN", "suspicious activity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            postgres.ErrNotFound,
+			cryptoTimes:          1,
+		}, {
+			name:                 "valid fiat",
+			path:                 "/admin-account-status/valid-fiat",
+			query:                fmt.Sprintf(testAdminQuery["fiatAccountStatus"], clientID, "USD", "FROZEN", "suspicious activity"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			fiatErr:              nil,
+			fiatTimes:            1,
+			cryptoErr:            nil,
+			cryptoTimes:          0,
+		}, {
+			name:                 "valid crypto",
+			path:                 "/admin-account-status/valid-crypto",
+			query:                fmt.Sprintf(testAdminQuery["cryptoAccountStatus"], clientID, "BTC", "FROZEN", "suspicious activity"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			fiatErr:              nil,
+			fiatTimes:            0,
+			cryptoErr:            nil,
+			cryptoTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().FiatAccountSetStatus(clientID, postgres.Currency("USD"), postgres.AccountStatusFROZEN).
+					Return(test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockPostgres.EXPECT().CryptoAccountSetStatus(clientID, "BTC", postgres.AccountStatusFROZEN).
+					Return(test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminUserSearch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		searchErr            error
+		searchTimes          int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-user-search/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["userSearch"], "username1", 5),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			searchErr:            nil,
+			searchTimes:          0,
+		}, {
+			name:                 "short query",
+			path:                 "/admin-user-search/short-query",
+			query:                fmt.Sprintf(testAdminQuery["userSearch"], "us", 5),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			searchErr:            nil,
+			searchTimes:          0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-user-search/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["userSearch"], "username1", 5),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			searchErr:            nil,
+			searchTimes:          0,
+		}, {
+			name:                 "not found",
+			path:                 "/admin-user-search/not-found",
+			query:                fmt.Sprintf(testAdminQuery["userSearch"], "username1", 5),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			searchErr:            postgres.ErrNotFound,
+			searchTimes:          1,
+		}, {
+			name:                 "valid",
+			path:                 "/admin-user-search/valid",
+			query:                fmt.Sprintf(testAdminQuery["userSearch"], "username1", 5),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			searchErr:            nil,
+			searchTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleSupport()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERSEARCH, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().UserSearch("username1", int32(5)).
+					Return([]modelsPostgres.UserProfile{{}}, test.searchErr).
+					Times(test.searchTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminUser(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		userInfoErr          error
+		userInfoTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-user/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["user"], clientID),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			userInfoErr:          nil,
+			userInfoTimes:        0,
+		}, {
+			name:                 "invalid client id",
+			path:                 "/admin-user/invalid-client-id",
+			query:                fmt.Sprintf(testAdminQuery["user"], "invalid-client-id"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			userInfoErr:          nil,
+			userInfoTimes:        0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-user/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["user"], clientID),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             errors.New("unknown db error"),
+			auditTimes:           1,
+			userInfoErr:          nil,
+			userInfoTimes:        0,
+		}, {
+			name:                 "not found",
+			path:                 "/admin-user/not-found",
+			query:                fmt.Sprintf(testAdminQuery["user"], clientID),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			userInfoErr:          postgres.ErrNotFound,
+			userInfoTimes:        1,
+		}, {
+			name:                 "valid",
+			path:                 "/admin-user/valid",
+			query:                fmt.Sprintf(testAdminQuery["user"], clientID),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			userInfoErr:          nil,
+			userInfoTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleSupport()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERVIEW, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(clientID).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}, ClientID: clientID,
+						Role: constants.RoleUser()}, test.userInfoErr).
+					Times(test.userInfoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminAccountViews(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	decryptedCursor := fmt.Sprintf("%s,%s,%d",
+		fmt.Sprintf(constants.MonthFormatString(), 2023, 6, "-04:00"),
+		fmt.Sprintf(constants.MonthFormatString(), 2023, 7, "-04:00"),
+		10)
+
+	testCases := []struct {
+		name           string
+		path           string
+		query          string
+		action         postgres.AdminAction
+		expectErr      bool
+		auditErr       error
+		auditTimes     int
+		cursorTimes    int
+		fiatBalTimes   int
+		fiatTxTimes    int
+		cryptoBalTimes int
+		cryptoTxTimes  int
+	}{
+		{
+			name:           "fiat balances invalid client id",
+			path:           "/admin-account-views/fiat-balances-invalid-client-id",
+			query:          fmt.Sprintf(testAdminQuery["balanceAllFiat"], "invalid-client-id", 3),
+			action:         postgres.AdminActionFIATACCOUNTVIEW,
+			expectErr:      true,
+			auditErr:       nil,
+			auditTimes:     0,
+			cursorTimes:    0,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "fiat balances audit failure",
+			path:           "/admin-account-views/fiat-balances-audit-failure",
+			query:          fmt.Sprintf(testAdminQuery["balanceAllFiat"], clientID, 3),
+			action:         postgres.AdminActionFIATACCOUNTVIEW,
+			expectErr:      true,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+			cursorTimes:    0,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "fiat balances valid",
+			path:           "/admin-account-views/fiat-balances-valid",
+			query:          fmt.Sprintf(testAdminQuery["balanceAllFiat"], clientID, 3),
+			action:         postgres.AdminActionFIATACCOUNTVIEW,
+			expectErr:      false,
+			auditErr:       nil,
+			auditTimes:     1,
+			cursorTimes:    0,
+			fiatBalTimes:   1,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name: "fiat transactions audit failure",
+			path: "/admin-account-views/fiat-transactions-audit-failure",
+			query: fmt.Sprintf(testAdminQuery["transactionDetailsAllFiat"],
+				clientID, "USD", 3, "page-cursor"),
+			action:         postgres.AdminActionFIATJOURNALVIEW,
+			expectErr:      true,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+			cursorTimes:    0,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name: "fiat transactions valid",
+			path: "/admin-account-views/fiat-transactions-valid",
+			query: fmt.Sprintf(testAdminQuery["transactionDetailsAllFiat"],
+				clientID, "USD", 3, "page-cursor"),
+			action:         postgres.AdminActionFIATJOURNALVIEW,
+			expectErr:      false,
+			auditErr:       nil,
+			auditTimes:     1,
+			cursorTimes:    1,
+			fiatBalTimes:   0,
+			fiatTxTimes:    1,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "crypto balances audit failure",
+			path:           "/admin-account-views/crypto-balances-audit-failure",
+			query:          fmt.Sprintf(testAdminQuery["balanceAllCrypto"], clientID, 3),
+			action:         postgres.AdminActionCRYPTOACCOUNTVIEW,
+			expectErr:      true,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+			cursorTimes:    0,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "crypto balances valid",
+			path:           "/admin-account-views/crypto-balances-valid",
+			query:          fmt.Sprintf(testAdminQuery["balanceAllCrypto"], clientID, 3),
+			action:         postgres.AdminActionCRYPTOACCOUNTVIEW,
+			expectErr:      false,
+			auditErr:       nil,
+			auditTimes:     1,
+			cursorTimes:    0,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 1,
+			cryptoTxTimes:  0,
+		}, {
+			name: "crypto transactions audit failure",
+			path: "/admin-account-views/crypto-transactions-audit-failure",
+			query: fmt.Sprintf(testAdminQuery["transactionDetailsAllCrypto"],
+				clientID, "BTC", 3, "page-cursor"),
+			action:         postgres.AdminActionCRYPTOJOURNALVIEW,
+			expectErr:      true,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+			cursorTimes:    0,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  0,
+		}, {
+			name: "crypto transactions valid",
+			path: "/admin-account-views/crypto-transactions-valid",
+			query: fmt.Sprintf(testAdminQuery["transactionDetailsAllCrypto"],
+				clientID, "BTC", 3, "page-cursor"),
+			action:         postgres.AdminActionCRYPTOJOURNALVIEW,
+			expectErr:      false,
+			auditErr:       nil,
+			auditTimes:     1,
+			cursorTimes:    1,
+			fiatBalTimes:   0,
+			fiatTxTimes:    0,
+			cryptoBalTimes: 0,
+			cryptoTxTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), nil).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleSupport()}, nil).
+					Times(1),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), test.action, clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+			)
+
+			mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+				Return([]byte(decryptedCursor), nil).
+				Times(test.cursorTimes)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).
+				Return("encrypted-cursor", nil).
+				Times(test.cursorTimes)
+
+			mockPostgres.EXPECT().FiatBalancePaginated(clientID, gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatAccountBalance{{}}, nil).
+				Times(test.fiatBalTimes)
+
+			mockPostgres.EXPECT().FiatTransactionsPaginated(clientID, gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatJournal{{}}, nil).
+				Times(test.fiatTxTimes)
+
+			mockPostgres.EXPECT().CryptoBalancesPaginated(clientID, gomock.Any(), gomock.Any()).
+				Return([]postgres.CryptoAccountBalance{{}}, nil).
+				Times(test.cryptoBalTimes)
+
+			mockPostgres.EXPECT().CryptoTransactionsPaginated(clientID, gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any()).
+				Return([]postgres.CryptoJournal{{}}, nil).
+				Times(test.cryptoTxTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminAuditLog(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatus           modelsPostgres.UserStatus
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		entries              []postgres.AdminAuditLog
+		auditLogErr          error
+		auditLogTimes        int
+		encryptTimes         int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-audit-log/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["auditLog"], "", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{},
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			entries:              nil,
+			auditLogErr:          nil,
+			auditLogTimes:        0,
+			encryptTimes:         0,
+		}, {
+			name:                 "regular user",
+			path:                 "/admin-audit-log/regular-user",
+			query:                fmt.Sprintf(testAdminQuery["auditLog"], "", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			entries:              nil,
+			auditLogErr:          nil,
+			auditLogTimes:        0,
+			encryptTimes:         0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-audit-log/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["auditLog"], "", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			entries:              nil,
+			auditLogErr:          nil,
+			auditLogTimes:        0,
+			encryptTimes:         0,
+		}, {
+			name:                 "not found",
+			path:                 "/admin-audit-log/not-found",
+			query:                fmt.Sprintf(testAdminQuery["auditLog"], "BTC", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			entries:              nil,
+			auditLogErr:          postgres.ErrNotFound,
+			auditLogTimes:        1,
+			encryptTimes:         0,
+		}, {
+			name:                 "valid last page",
+			path:                 "/admin-audit-log/valid-last-page",
+			query:                fmt.Sprintf(testAdminQuery["auditLog"], "BTC", 3),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			entries:              []postgres.AdminAuditLog{{ID: 3}, {ID: 2}},
+			auditLogErr:          nil,
+			auditLogTimes:        1,
+			encryptTimes:         0,
+		}, {
+			name:                 "valid with next page",
+			path:                 "/admin-audit-log/valid-next-page",
+			query:                fmt.Sprintf(testAdminQuery["auditLog"], "BTC", 3),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			entries:              []postgres.AdminAuditLog{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}},
+			auditLogErr:          nil,
+			auditLogTimes:        1,
+			encryptTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(test.userStatus, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionAUDITLOGVIEW, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogPaginated(gomock.Any(), gomock.Any(), int32(4)).
+					Return(test.entries, test.auditLogErr).
+					Times(test.auditLogTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-page-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatus           modelsPostgres.UserStatus
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		events               []postgres.AuditEvent
+		eventsErr            error
+		eventsTimes          int
+		encryptTimes         int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-audit-events/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "", "", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{},
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			events:               nil,
+			eventsErr:            nil,
+			eventsTimes:          0,
+			encryptTimes:         0,
+		}, {
+			name:                 "regular user",
+			path:                 "/admin-audit-events/regular-user",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "", "", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			events:               nil,
+			eventsErr:            nil,
+			eventsTimes:          0,
+			encryptTimes:         0,
+		}, {
+			name:                 "invalid event type",
+			path:                 "/admin-audit-events/invalid-event-type",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "", "LOGOUT", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			events:               nil,
+			eventsErr:            nil,
+			eventsTimes:          0,
+			encryptTimes:         0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-audit-events/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "", "", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			events:               nil,
+			eventsErr:            nil,
+			eventsTimes:          0,
+			encryptTimes:         0,
+		}, {
+			name:                 "not found",
+			path:                 "/admin-audit-events/not-found",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "1a1d6ad4-5d63-4e6c-a1bd-8a6c4b3b1c8f", "LOGIN", 3),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			events:               nil,
+			eventsErr:            postgres.ErrNotFound,
+			eventsTimes:          1,
+			encryptTimes:         0,
+		}, {
+			name:                 "valid last page",
+			path:                 "/admin-audit-events/valid-last-page",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "", "LOGIN", 3),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			events:               []postgres.AuditEvent{{EventID: 3}, {EventID: 2}},
+			eventsErr:            nil,
+			eventsTimes:          1,
+			encryptTimes:         0,
+		}, {
+			name:                 "valid with next page",
+			path:                 "/admin-audit-events/valid-next-page",
+			query:                fmt.Sprintf(testAdminQuery["auditEvents"], "", "", 3),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			events:               []postgres.AuditEvent{{EventID: 4}, {EventID: 3}, {EventID: 2}, {EventID: 1}},
+			eventsErr:            nil,
+			eventsTimes:          1,
+			encryptTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(test.userStatus, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionAUDITEVENTVIEW, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().AuditEventsPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					int32(4)).
+					Return(test.events, test.eventsErr).
+					Times(test.eventsTimes),
+
+				mockAuth.EXPECT().EncryptToString([]byte("1")).
+					Return("encrypted-page-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminUnlockUser(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	user := modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{
+		UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"},
+	}}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		cacheTimes           int
+		unlockErr            error
+		unlockTimes          int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-unlock-user/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["unlockUser"], clientID, "verified identity"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			cacheTimes:           0,
+			unlockErr:            nil,
+			unlockTimes:          0,
+		}, {
+			name:                 "empty reason",
+			path:                 "/admin-unlock-user/empty-reason",
+			query:                fmt.Sprintf(testAdminQuery["unlockUser"], clientID, ""),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			cacheTimes:           0,
+			unlockErr:            nil,
+			unlockTimes:          0,
+		}, {
+			name:                 "invalid client id",
+			path:                 "/admin-unlock-user/invalid-client-id",
+			query:                fmt.Sprintf(testAdminQuery["unlockUser"], "invalid-client-id", "verified identity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           0,
+			cacheTimes:           0,
+			unlockErr:            nil,
+			unlockTimes:          0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-unlock-user/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["unlockUser"], clientID, "verified identity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			cacheTimes:           0,
+			unlockErr:            nil,
+			unlockTimes:          0,
+		}, {
+			name:                 "not locked out",
+			path:                 "/admin-unlock-user/not-locked-out",
+			query:                fmt.Sprintf(testAdminQuery["unlockUser"], clientID, "verified identity"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			cacheTimes:           1,
+			unlockErr:            postgres.ErrNotFound,
+			unlockTimes:          1,
+		}, {
+			name:                 "valid",
+			path:                 "/admin-unlock-user/valid",
+			query:                fmt.Sprintf(testAdminQuery["unlockUser"], clientID, "verified identity"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			cacheTimes:           1,
+			unlockErr:            nil,
+			unlockTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERUNLOCK, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(clientID).
+					Return(user, nil).
+					Times(test.cacheTimes),
+
+				mockRedis.EXPECT().Del(gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(3*test.cacheTimes),
+
+				mockPostgres.EXPECT().LoginLockoutUnlock(gomock.Any(), postgres.LockoutScopeUSERNAME, "username1").
+					Return(test.unlockErr).
+					Times(test.unlockTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminLoginLockouts(t *testing.T) {
+	t.Parallel()
+
+	unlockedBy, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate admin id")
+
+	lockouts := []postgres.LoginLockout{
+		{LockoutID: 2, Scope: postgres.LockoutScopeIPADDRESS, Subject: "127.0.0.1", Failures: 20,
+			IpAddress: "127.0.0.1"},
+		{LockoutID: 1, Scope: postgres.LockoutScopeUSERNAME, Subject: "username1", Failures: 5,
+			IpAddress: "127.0.0.1", UnlockedBy: pgtype.UUID{Bytes: unlockedBy, Valid: true},
+			UnlockedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		expectedActive       bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		lockoutsErr          error
+		lockoutsTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-login-lockouts/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["loginLockouts"], false, 10),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			userStatusTimes:      0,
+			auditErr:             nil,
+			auditTimes:           0,
+			lockoutsErr:          nil,
+			lockoutsTimes:        0,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-login-lockouts/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["loginLockouts"], false, 10),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+			lockoutsErr:          nil,
+			lockoutsTimes:        0,
+		}, {
+			name:                 "database failure",
+			path:                 "/admin-login-lockouts/database-failure",
+			query:                fmt.Sprintf(testAdminQuery["loginLockouts"], false, 10),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			lockoutsErr:          postgres.ErrLoginLockout,
+			lockoutsTimes:        1,
+		}, {
+			name:                 "all lockouts",
+			path:                 "/admin-login-lockouts/all-lockouts",
+			query:                fmt.Sprintf(testAdminQuery["loginLockouts"], false, 10),
+			expectErr:            false,
+			expectedActive:       false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			lockoutsErr:          nil,
+			lockoutsTimes:        1,
+		}, {
+			name:                 "active lockouts",
+			path:                 "/admin-login-lockouts/active-lockouts",
+			query:                fmt.Sprintf(testAdminQuery["loginLockouts"], true, 10),
+			expectErr:            false,
+			expectedActive:       true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			lockoutsErr:          nil,
+			lockoutsTimes:        1,
+		}, {
+			name:                 "out of bounds limit",
+			path:                 "/admin-login-lockouts/out-of-bounds-limit",
+			query:                fmt.Sprintf(testAdminQuery["loginLockouts"], true, -1),
+			expectErr:            false,
+			expectedActive:       true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             nil,
+			auditTimes:           1,
+			lockoutsErr:          nil,
+			lockoutsTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleSupport()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionLOGINLOCKOUTVIEW, "",
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().LoginLockoutsRecent(test.expectedActive, int32(10)).
+					Return(lockouts, test.lockoutsErr).
+					Times(test.lockoutsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+
+				return
+			}
+
+			require.Nil(t, response["errors"], "unexpected error returned")
+
+			data, ok := response["data"].(map[string]any)
+			require.True(t, ok, "failed to extract data.")
+			returned, ok := data["adminLoginLockouts"].([]any)
+			require.True(t, ok, "failed to extract lockouts.")
+			require.Len(t, returned, len(lockouts), "lockout count mismatch")
+
+			unlocked, ok := returned[1].(map[string]any)
+			require.True(t, ok, "failed to extract unlocked lockout.")
+			require.Equal(t, unlockedBy.String(), unlocked["unlockedBy"], "unlocked by mismatch")
+		})
+	}
+}
+
+func TestAdminResolver_AdminLedger(t *testing.T) {
+	t.Parallel()
+
+	broken := &postgres.JournalChainVerification{
+		Journal: postgres.JournalFiat,
+		Break:   &postgres.JournalChainBreak{Seq: 7, Reason: "entry hash does not match the entry fields"},
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatus           modelsPostgres.UserStatus
+		userStatusTimes      int
+		auditAction          postgres.AdminAction
+		auditErr             error
+		auditTimes           int
+		checkpointsErr       error
+		checkpointsTimes     int
+		verifyTimes          int
+	}{
+		{
+			name:                 "verify invalid jwt",
+			path:                 "/admin-ledger/verify-invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["ledgerVerify"], postgres.JournalFiat),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "verify regular user",
+			path:                 "/admin-ledger/verify-regular-user",
+			query:                fmt.Sprintf(testAdminQuery["ledgerVerify"], postgres.JournalFiat),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleUser()},
+			userStatusTimes:      1,
+		}, {
+			name:                 "verify invalid journal",
+			path:                 "/admin-ledger/verify-invalid-journal",
+			query:                fmt.Sprintf(testAdminQuery["ledgerVerify"], "users"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			userStatusTimes:      1,
+		}, {
+			name:                 "verify broken",
+			path:                 "/admin-ledger/verify-broken",
+			query:                fmt.Sprintf(testAdminQuery["ledgerVerify"], postgres.JournalFiat),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+			userStatusTimes:      1,
+			auditAction:          postgres.AdminActionLEDGERVERIFY,
+			auditTimes:           1,
+			checkpointsTimes:     1,
+			verifyTimes:          1,
+		}, {
+			name:                 "checkpoints audit failure",
+			path:                 "/admin-ledger/checkpoints-audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["ledgerCheckpoints"], postgres.JournalCrypto, 1685923200),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditAction:          postgres.AdminActionLEDGERCHECKPOINTVIEW,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+		}, {
+			name:                 "checkpoints failure",
+			path:                 "/admin-ledger/checkpoints-failure",
+			query:                fmt.Sprintf(testAdminQuery["ledgerCheckpoints"], postgres.JournalCrypto, 1685923200),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditAction:          postgres.AdminActionLEDGERCHECKPOINTVIEW,
+			auditTimes:           1,
+			checkpointsErr:       postgres.ErrNotFound,
+			checkpointsTimes:     1,
+		}, {
+			name:                 "checkpoints valid",
+			path:                 "/admin-ledger/checkpoints-valid",
+			query:                fmt.Sprintf(testAdminQuery["ledgerCheckpoints"], postgres.JournalCrypto, 1685923200),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			userStatus:           modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+			userStatusTimes:      1,
+			auditAction:          postgres.AdminActionLEDGERCHECKPOINTVIEW,
+			auditTimes:           1,
+			checkpointsTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(test.userStatus, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), test.auditAction, gomock.Any(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().JournalCheckpoints(gomock.Any(), gomock.Any()).
+					Return([]postgres.JournalCheckpoint{}, test.checkpointsErr).
+					Times(test.checkpointsTimes),
+
+				mockPostgres.EXPECT().JournalChainVerify(postgres.JournalFiat, gomock.Any()).
+					Return(broken, nil).
+					Times(test.verifyTimes),
+			)
+
+			mockAuth.EXPECT().CheckpointKeyID().
+				Return("v1").
+				AnyTimes()
+
+			mockAuth.EXPECT().CheckpointPublicKey().
+				Return(ed25519.PublicKey{}).
+				AnyTimes()
+
+			mockAuth.EXPECT().CheckpointPublicKeys().
+				Return(map[string]ed25519.PublicKey{"v1": {}}).
+				AnyTimes()
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminReverseTransaction(t *testing.T) {
+	t.Parallel()
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id")
+
+	reversal := &postgres.TransactionReversal{
+		JournalReversal: postgres.JournalReversal{TxID: txID, ReversalTxID: uuid.Must(uuid.NewV4())},
+		FiatEntries:     []postgres.FiatJournal{{Currency: "USD"}, {Currency: "USD"}},
+		CryptoEntries:   []postgres.CryptoJournal{},
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		reverseErr           error
+		reverseTimes         int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-reverse-transaction/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["reverseTransaction"], txID, "deposit made in error"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "empty reason",
+			path:                 "/admin-reverse-transaction/empty-reason",
+			query:                fmt.Sprintf(testAdminQuery["reverseTransaction"], txID, ""),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+		}, {
+			name:                 "invalid transaction id",
+			path:                 "/admin-reverse-transaction/invalid-transaction-id",
+			query:                fmt.Sprintf(testAdminQuery["reverseTransaction"], "invalid-tx-id", "deposit made in error"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-reverse-transaction/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["reverseTransaction"], txID, "deposit made in error"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+		}, {
+			name:                 "already reversed",
+			path:                 "/admin-reverse-transaction/already-reversed",
+			query:                fmt.Sprintf(testAdminQuery["reverseTransaction"], txID, "deposit made in error"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			reverseErr:           postgres.ErrReversed,
+			reverseTimes:         1,
+		}, {
+			name:                 "valid",
+			path:                 "/admin-reverse-transaction/valid",
+			query:                fmt.Sprintf(testAdminQuery["reverseTransaction"], txID, "deposit made in error"),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			reverseTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionTRANSACTIONREVERSE,
+					txID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().TransactionReverse(txID, gomock.Any(), gomock.Any()).
+					Return(reversal, test.reverseErr).
+					Times(test.reverseTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminRequestAdjustment(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		createErr            error
+		createTimes          int
+	}{
+		{
+			name: "invalid jwt",
+			path: "/admin-request-adjustment/invalid-jwt",
+			query: fmt.Sprintf(testAdminQuery["requestAdjustment"], clientID, "USD", 25.50, "GOODWILL",
+				"service outage credit"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name: "invalid reason code",
+			path: "/admin-request-adjustment/invalid-reason-code",
+			query: fmt.Sprintf(testAdminQuery["requestAdjustment"], clientID, "USD", 25.50, "BONUS",
+				"service outage credit"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+		}, {
+			name: "zero amount",
+			path: "/admin-request-adjustment/zero-amount",
+			query: fmt.Sprintf(testAdminQuery["requestAdjustment"], clientID, "USD", 0.0, "GOODWILL",
+				"service outage credit"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+		}, {
+			name: "audit failure",
+			path: "/admin-request-adjustment/audit-failure",
+			query: fmt.Sprintf(testAdminQuery["requestAdjustment"], clientID, "USD", 25.50, "GOODWILL",
+				"service outage credit"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+		}, {
+			name: "closed account",
+			path: "/admin-request-adjustment/closed-account",
+			query: fmt.Sprintf(testAdminQuery["requestAdjustment"], clientID, "USD", -25.50, "FEE_CORRECTION",
+				"duplicate fee"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			createErr:            postgres.ErrAccountStatus,
+			createTimes:          1,
+		}, {
+			name: "valid",
+			path: "/admin-request-adjustment/valid",
+			query: fmt.Sprintf(testAdminQuery["requestAdjustment"], clientID, "USD", 25.50, "GOODWILL",
+				"service outage credit"),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			createTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTCREATE,
+					gomock.Any(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockPostgres.EXPECT().FiatAdjustmentCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminDecideAdjustment(t *testing.T) {
+	t.Parallel()
+
+	adjustment := &postgres.FiatAdjustment{
+		AdjustmentID: "adjustment-id",
+		ClientID:     uuid.Must(uuid.NewV4()),
+		Currency:     "USD",
+		Status:       postgres.AdjustmentStatusAPPROVED,
+		MakerID:      uuid.Must(uuid.NewV4()),
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		action               postgres.AdminAction
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditErr             error
+		auditTimes           int
+		decideErr            error
+		approveTimes         int
+		rejectTimes          int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-decide-adjustment/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["approveAdjustment"], "adjustment-id", "approved"),
+			expectErr:            true,
+			action:               postgres.AdminActionADJUSTMENTAPPROVE,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "empty note",
+			path:                 "/admin-decide-adjustment/empty-note",
+			query:                fmt.Sprintf(testAdminQuery["approveAdjustment"], "adjustment-id", ""),
+			expectErr:            true,
+			action:               postgres.AdminActionADJUSTMENTAPPROVE,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+		}, {
+			name:                 "audit failure",
+			path:                 "/admin-decide-adjustment/audit-failure",
+			query:                fmt.Sprintf(testAdminQuery["rejectAdjustment"], "adjustment-id", "rejected"),
+			expectErr:            true,
+			action:               postgres.AdminActionADJUSTMENTREJECT,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditErr:             postgres.ErrAuditLog,
+			auditTimes:           1,
+		}, {
+			name:                 "approved by maker",
+			path:                 "/admin-decide-adjustment/approved-by-maker",
+			query:                fmt.Sprintf(testAdminQuery["approveAdjustment"], "adjustment-id", "approved"),
+			expectErr:            true,
+			action:               postgres.AdminActionADJUSTMENTAPPROVE,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			decideErr:            postgres.ErrAdjustmentMaker,
+			approveTimes:         1,
+		}, {
+			name:                 "already decided",
+			path:                 "/admin-decide-adjustment/already-decided",
+			query:                fmt.Sprintf(testAdminQuery["rejectAdjustment"], "adjustment-id", "rejected"),
+			expectErr:            true,
+			action:               postgres.AdminActionADJUSTMENTREJECT,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			decideErr:            postgres.ErrAdjustmentDecided,
+			rejectTimes:          1,
+		}, {
+			name:                 "approved",
+			path:                 "/admin-decide-adjustment/approved",
+			query:                fmt.Sprintf(testAdminQuery["approveAdjustment"], "adjustment-id", "approved"),
+			expectErr:            false,
+			action:               postgres.AdminActionADJUSTMENTAPPROVE,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			approveTimes:         1,
+		}, {
+			name:                 "rejected",
+			path:                 "/admin-decide-adjustment/rejected",
+			query:                fmt.Sprintf(testAdminQuery["rejectAdjustment"], "adjustment-id", "rejected"),
+			expectErr:            false,
+			action:               postgres.AdminActionADJUSTMENTREJECT,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			rejectTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), test.action, "adjustment-id", gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+			)
+
+			mockPostgres.EXPECT().FiatAdjustmentApprove("adjustment-id", gomock.Any(), gomock.Any()).
+				Return(adjustment, test.decideErr).
+				Times(test.approveTimes)
+
+			mockPostgres.EXPECT().FiatAdjustmentReject("adjustment-id", gomock.Any(), gomock.Any()).
+				Return(adjustment, test.decideErr).
+				Times(test.rejectTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
+
+func TestAdminResolver_AdminAdjustmentViews(t *testing.T) {
+	t.Parallel()
+
+	adjustment := postgres.FiatAdjustment{
+		AdjustmentID: "adjustment-id",
+		ClientID:     uuid.Must(uuid.NewV4()),
+		Currency:     "USD",
+		Status:       postgres.AdjustmentStatusPENDING,
+		MakerID:      uuid.Must(uuid.NewV4()),
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		userStatusTimes      int
+		auditTimes           int
+		getErr               error
+		getTimes             int
+		pageTimes            int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-adjustment-views/invalid-jwt",
+			query:                fmt.Sprintf(testAdminQuery["adjustment"], "adjustment-id"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "unknown adjustment",
+			path:                 "/admin-adjustment-views/unknown-adjustment",
+			query:                fmt.Sprintf(testAdminQuery["adjustment"], "adjustment-id"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			getErr:               postgres.ErrNotFound,
+			getTimes:             1,
+		}, {
+			name:                 "adjustment",
+			path:                 "/admin-adjustment-views/adjustment",
+			query:                fmt.Sprintf(testAdminQuery["adjustment"], "adjustment-id"),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			getTimes:             1,
+		}, {
+			name:                 "invalid status",
+			path:                 "/admin-adjustment-views/invalid-status",
+			query:                fmt.Sprintf(testAdminQuery["adjustments"], "CANCELLED", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+		}, {
+			name:                 "adjustments",
+			path:                 "/admin-adjustment-views/adjustments",
+			query:                fmt.Sprintf(testAdminQuery["adjustments"], "PENDING", 3),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			userStatusTimes:      1,
+			auditTimes:           1,
+			pageTimes:            1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockPostgres.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleAdmin()}, nil).
+					Times(test.userStatusTimes),
+
+				mockPostgres.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTVIEW,
+					gomock.Any(), gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.auditTimes),
+			)
+
+			mockPostgres.EXPECT().FiatAdjustmentGet("adjustment-id").
+				Return(adjustment, test.getErr).
+				Times(test.getTimes)
+
+			mockPostgres.EXPECT().FiatAdjustmentsPaginated("", "PENDING", int32(4)).
+				Return([]postgres.FiatAdjustment{adjustment}, nil).
+				Times(test.pageTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				require.Nil(t, response["errors"], "unexpected error returned")
+			}
+		})
+	}
+}
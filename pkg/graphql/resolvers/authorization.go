@@ -8,9 +8,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"go.uber.org/zap"
 )
 
@@ -35,14 +38,43 @@ func GinContextFromContext(ctx context.Context, logger *logger.Logger) (*gin.Con
 	return ginContext, nil
 }
 
-// AuthorizationCheck will validate the JWT payload for valid authorization information.
-func AuthorizationCheck(ctx context.Context, auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
-	authHeaderKey string) (uuid.UUID, int64, error) {
+// OneTimePasswordFromContext will extract the two-factor authentication code from the headers of a request.
+func OneTimePasswordFromContext(ctx context.Context, logger *logger.Logger) (string, error) {
+	ginContext, err := GinContextFromContext(ctx, logger)
+	if err != nil {
+		return "", err
+	}
+
+	return ginContext.GetHeader(constants.OTPHeader()), nil
+}
+
+// RequestOriginFromContext will extract the user agent and IP address of the device a request was sent from.
+func RequestOriginFromContext(ctx context.Context, logger *logger.Logger) (string, string, error) {
+	ginContext, err := GinContextFromContext(ctx, logger)
+	if err != nil {
+		return "", "", err
+	}
+
+	return ginContext.Request.UserAgent(), ginContext.ClientIP(), nil
+}
+
+// apiKeyAuthentication is the outcome of authenticating a request signed with an API key. Requests are authenticated
+// once before any resolvers run so that concurrently executed resolvers do not reject each other as replays.
+type apiKeyAuthentication struct {
+	key *postgres.APIKey
+	err error
+}
+
+// AuthorizationCheck will validate the JWT payload, and check that it has not been revoked, or the API key a request is
+// signed with, for valid authorization information. API keys must carry all the listed scopes and cannot access
+// resolvers that do not list any scopes.
+func AuthorizationCheck(ctx context.Context, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	logger *logger.Logger, authHeaderKey string, apiKeyScopes ...string) (uuid.UUID, int64, error) {
 	var (
 		clientID   uuid.UUID
 		expiresAt  int64
 		err        error
-		isDeleted  bool
+		status     modelsPostgres.UserStatus
 		ginContext *gin.Context
 	)
 
@@ -51,24 +83,103 @@ func AuthorizationCheck(ctx context.Context, auth auth.Auth, db postgres.Postgre
 	}
 
 	tokenString := ginContext.GetHeader(authHeaderKey)
-	if tokenString == "" {
+
+	switch {
+	case tokenString == "" && ginContext.GetHeader(constants.APIKeyHeader()) != "":
+		if clientID, expiresAt, err = apiKeyAuthorizationCheck(ginContext, apiKeyScopes...); err != nil {
+			return clientID, -1, err
+		}
+
+	case tokenString == "":
 		return clientID, -1, errors.New("request does not contain an access token")
-	}
 
-	if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
-		return clientID, expiresAt, fmt.Errorf("failed to validate JWT %w", err)
+	default:
+		if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
+			return clientID, expiresAt, fmt.Errorf("failed to validate JWT %w", err)
+		}
+
+		if _, msg, err := common.HTTPJWTRevoked(auth, cache, logger, clientID, tokenString); err != nil {
+			return clientID, -1, errors.New(msg)
+		}
 	}
 
-	// Check for user deleted status.
-	if isDeleted, err = db.UserIsDeleted(clientID); err != nil {
+	// Check for user deleted and frozen status.
+	if status, err = db.UserGetStatus(clientID); err != nil {
 		logger.Error("unable to retrieve client account status", zap.Error(err))
 
 		return clientID, expiresAt, errors.New(constants.RetryMessageString())
 	}
 
-	if isDeleted {
+	if status.IsDeleted {
 		return clientID, expiresAt, errors.New("request contains invalid or expired authorization token")
 	}
 
+	if status.IsFrozen {
+		return clientID, expiresAt, errors.New(constants.FrozenAccountString())
+	}
+
 	return clientID, expiresAt, nil
 }
+
+// apiKeyAuthorizationCheck will retrieve the outcome of authenticating the API key a request is signed with and check
+// that the key carries the scopes.
+func apiKeyAuthorizationCheck(ginContext *gin.Context, scopes ...string) (uuid.UUID, int64, error) {
+	var expiresAt int64
+
+	value, _ := ginContext.Get(constants.APIKeyCtxKey())
+
+	authentication, ok := value.(*apiKeyAuthentication)
+	if !ok {
+		return uuid.UUID{}, -1, errors.New("malformed request: API key authentication not found")
+	}
+
+	if authentication.err != nil {
+		return uuid.UUID{}, -1, authentication.err
+	}
+
+	if _, msg, err := common.HTTPAPIKeyAuthorize(authentication.key, scopes...); err != nil {
+		return uuid.UUID{}, -1, errors.New(msg)
+	}
+
+	if authentication.key.ExpiresAt.Valid {
+		expiresAt = authentication.key.ExpiresAt.Time.Unix()
+	}
+
+	return authentication.key.ClientID, expiresAt, nil
+}
+
+// AdminAuthorizationCheck will validate the JWT payload for valid authorization information and the required
+// administrative scopes, check that it has not been revoked, and check that the administrator's current role still
+// grants those scopes.
+func AdminAuthorizationCheck(ctx context.Context, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	logger *logger.Logger, authHeaderKey string, scopes ...string) (uuid.UUID, error) {
+	var (
+		clientID   uuid.UUID
+		err        error
+		ginContext *gin.Context
+	)
+
+	if ginContext, err = GinContextFromContext(ctx, logger); err != nil {
+		return clientID, err
+	}
+
+	tokenString := ginContext.GetHeader(authHeaderKey)
+	if tokenString == "" {
+		return clientID, errors.New("request does not contain an access token")
+	}
+
+	if clientID, _, err = auth.ValidateJWTScopes(tokenString, scopes...); err != nil {
+		return clientID, fmt.Errorf("failed to validate JWT %w", err)
+	}
+
+	if _, msg, err := common.HTTPJWTRevoked(auth, cache, logger, clientID, tokenString); err != nil {
+		return clientID, errors.New(msg)
+	}
+
+	// Check the administrator's current account status and role.
+	if _, msg, err := common.HTTPAdminAuthorize(db, logger, clientID, scopes...); err != nil {
+		return clientID, errors.New(msg)
+	}
+
+	return clientID, nil
+}
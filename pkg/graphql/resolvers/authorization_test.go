@@ -5,12 +5,18 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestGinContextFromContext(t *testing.T) {
@@ -70,9 +76,13 @@ func TestAuthorizationCheck(t *testing.T) {
 		ctx                  context.Context //nolint:containedctx
 		authValidateJWTErr   error
 		authValidateJWTTimes int
+		sessionTimes         int
+		denylistErr          error
+		revokedTimes         int
 		isDeletedError       error
 		isDeletedTimes       int
 		isDeletedValue       bool
+		isFrozenValue        bool
 	}{
 		{
 			name:                 "no context",
@@ -81,6 +91,9 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.TODO(),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 0,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
@@ -91,6 +104,9 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, context.TODO()),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 0,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
@@ -101,6 +117,9 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxNoAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 0,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
@@ -111,6 +130,22 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   errors.New("failed to authenticate token"),
 			authValidateJWTTimes: 1,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+		}, {
+			name:                 "revoked token",
+			expectedMsg:          "invalid or expired",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          nil,
+			revokedTimes:         0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
@@ -120,6 +155,9 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
 			isDeletedError:       errors.New("db failure"),
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
@@ -129,15 +167,35 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       true,
+		}, {
+			name:                 "frozen user",
+			expectedMsg:          constants.FrozenAccountString(),
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenValue:        true,
 		}, {
 			name:                 "success",
 			expectErr:            require.NoError,
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
@@ -152,6 +210,7 @@ func TestAuthorizationCheck(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			gomock.InOrder(
@@ -159,12 +218,299 @@ func TestAuthorizationCheck(t *testing.T) {
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockDB.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockAuth.EXPECT().TokenSession("test-token").
+					Return("token-id", time.Now().UnixMilli(), nil).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-denylist:token-id", gomock.Any()).
+					Return(test.denylistErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-revoked-before:"+uuid.UUID{}.String(), gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.revokedTimes),
+
+				mockDB.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue, IsFrozen: test.isFrozenValue},
+						test.isDeletedError).
 					Times(test.isDeletedTimes),
 			)
 
-			_, _, err := AuthorizationCheck(test.ctx, mockAuth, mockDB, zapLogger, testAuthHeaderKey)
+			_, _, err := AuthorizationCheck(test.ctx, mockAuth, mockCache, mockDB, zapLogger, testAuthHeaderKey)
+
+			test.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				require.Contains(t, err.Error(), test.expectedMsg, "incorrect error message returned")
+			}
+		})
+	}
+}
+
+func TestAuthorizationCheck_APIKey(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	key := &postgres.APIKey{
+		KeyID:     "key-id",
+		ClientID:  clientID,
+		Scopes:    []string{constants.APIKeyScopeRead()},
+		ExpiresAt: pgtype.Timestamptz{Time: time.Unix(1700000000, 0), Valid: true},
+	}
+
+	newGinCtx := func(authentication *apiKeyAuthentication) *gin.Context {
+		ginCtx := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+		ginCtx.Request.Header.Add(constants.APIKeyHeader(), "key-id")
+
+		if authentication != nil {
+			ginCtx.Set(constants.APIKeyCtxKey(), authentication)
+		}
+
+		return ginCtx
+	}
+
+	testCases := []struct {
+		name        string
+		expectedMsg string
+		expectErr   require.ErrorAssertionFunc
+		ginCtx      *gin.Context
+		scopes      []string
+		statusTimes int
+	}{
+		{
+			name:        "not authenticated",
+			expectedMsg: "API key authentication not found",
+			expectErr:   require.Error,
+			ginCtx:      newGinCtx(nil),
+			scopes:      []string{constants.APIKeyScopeRead()},
+			statusTimes: 0,
+		}, {
+			name:        "authentication failed",
+			expectedMsg: "invalid request signature",
+			expectErr:   require.Error,
+			ginCtx:      newGinCtx(&apiKeyAuthentication{err: errors.New("invalid request signature")}),
+			scopes:      []string{constants.APIKeyScopeRead()},
+			statusTimes: 0,
+		}, {
+			name:        "unscoped resolver",
+			expectedMsg: "required scopes",
+			expectErr:   require.Error,
+			ginCtx:      newGinCtx(&apiKeyAuthentication{key: key}),
+			scopes:      nil,
+			statusTimes: 0,
+		}, {
+			name:        "missing scope",
+			expectedMsg: "required scopes",
+			expectErr:   require.Error,
+			ginCtx:      newGinCtx(&apiKeyAuthentication{key: key}),
+			scopes:      []string{constants.APIKeyScopeTransfer()},
+			statusTimes: 0,
+		}, {
+			name:        "success",
+			expectErr:   require.NoError,
+			ginCtx:      newGinCtx(&apiKeyAuthentication{key: key}),
+			scopes:      []string{constants.APIKeyScopeRead()},
+			statusTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().UserGetStatus(clientID).
+				Return(modelsPostgres.UserStatus{}, nil).
+				Times(test.statusTimes)
+
+			ctx := context.WithValue(context.TODO(), GinContextKey{}, test.ginCtx)
+			actualClientID, expiresAt, err := AuthorizationCheck(ctx, mockAuth, mockCache, mockDB, zapLogger,
+				testAuthHeaderKey, test.scopes...)
+
+			test.expectErr(t, err, "error expectation failed")
+			if err != nil {
+				require.Contains(t, err.Error(), test.expectedMsg, "incorrect error message returned")
+
+				return
+			}
+
+			require.Equal(t, clientID, actualClientID, "client id mismatched")
+			require.Equal(t, key.ExpiresAt.Time.Unix(), expiresAt, "expiration mismatched")
+		})
+	}
+}
+
+func TestAdminAuthorizationCheck(t *testing.T) {
+	t.Parallel()
+
+	ginCtxNoAuth := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+	ginCtxNoAuth.Request.Header.Add(testAuthHeaderKey, "")
+
+	ginCtxAuth := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+	ginCtxAuth.Request.Header.Add(testAuthHeaderKey, "test-token")
+
+	testCases := []struct {
+		name                 string
+		expectedMsg          string
+		expectErr            require.ErrorAssertionFunc
+		ctx                  context.Context //nolint:containedctx
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		sessionTimes         int
+		denylistErr          error
+		revokedTimes         int
+		statusErr            error
+		statusTimes          int
+		statusValue          modelsPostgres.UserStatus
+	}{
+		{
+			name:                 "no context",
+			expectedMsg:          "information not found",
+			expectErr:            require.Error,
+			ctx:                  context.TODO(),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 0,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
+			statusErr:            nil,
+			statusTimes:          0,
+			statusValue:          modelsPostgres.UserStatus{},
+		}, {
+			name:                 "no token",
+			expectedMsg:          "does not contain",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxNoAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 0,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
+			statusErr:            nil,
+			statusTimes:          0,
+			statusValue:          modelsPostgres.UserStatus{},
+		}, {
+			name:                 "insufficient token scopes",
+			expectedMsg:          "failed to validate",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   errors.New("insufficient scopes"),
+			authValidateJWTTimes: 1,
+			sessionTimes:         0,
+			denylistErr:          nil,
+			revokedTimes:         0,
+			statusErr:            nil,
+			statusTimes:          0,
+			statusValue:          modelsPostgres.UserStatus{},
+		}, {
+			name:                 "revoked token",
+			expectedMsg:          "invalid or expired",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          nil,
+			revokedTimes:         0,
+			statusErr:            nil,
+			statusTimes:          0,
+			statusValue:          modelsPostgres.UserStatus{},
+		}, {
+			name:                 "db failure",
+			expectedMsg:          "retry",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
+			statusErr:            errors.New("db failure"),
+			statusTimes:          1,
+			statusValue:          modelsPostgres.UserStatus{},
+		}, {
+			name:                 "frozen administrator",
+			expectedMsg:          constants.FrozenAccountString(),
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
+			statusErr:            nil,
+			statusTimes:          1,
+			statusValue:          modelsPostgres.UserStatus{Role: constants.RoleAdmin(), IsFrozen: true},
+		}, {
+			name:                 "demoted administrator",
+			expectedMsg:          "administrative privileges required",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
+			statusErr:            nil,
+			statusTimes:          1,
+			statusValue:          modelsPostgres.UserStatus{Role: constants.RoleUser()},
+		}, {
+			name:                 "success",
+			expectErr:            require.NoError,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionTimes:         1,
+			denylistErr:          redis.ErrCacheMiss,
+			revokedTimes:         1,
+			statusErr:            nil,
+			statusTimes:          1,
+			statusValue:          modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminRead()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().TokenSession("test-token").
+					Return("token-id", time.Now().UnixMilli(), nil).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-denylist:token-id", gomock.Any()).
+					Return(test.denylistErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-revoked-before:"+uuid.UUID{}.String(), gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.revokedTimes),
+
+				mockDB.EXPECT().UserGetStatus(gomock.Any()).
+					Return(test.statusValue, test.statusErr).
+					Times(test.statusTimes),
+			)
+
+			_, err := AdminAuthorizationCheck(test.ctx, mockAuth, mockCache, mockDB, zapLogger, testAuthHeaderKey,
+				constants.ScopeAdminRead())
 
 			test.expectErr(t, err, "error expectation failed")
 			if err != nil {
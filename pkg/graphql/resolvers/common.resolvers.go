@@ -9,8 +9,30 @@ import (
 
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
 )
 
+// Balance is the resolver for the balance field.
+func (r *balanceAsOfResolver) Balance(ctx context.Context, obj *postgres.BalanceAsOf) (float64, error) {
+	return obj.Balance.InexactFloat64(), nil
+}
+
+// AsOf is the resolver for the asOf field.
+func (r *balanceAsOfResolver) AsOf(ctx context.Context, obj *postgres.BalanceAsOf) (string, error) {
+	return obj.AsOf.Time.String(), nil
+}
+
+// SnapshotAt is the resolver for the snapshotAt field.
+func (r *balanceAsOfResolver) SnapshotAt(ctx context.Context, obj *postgres.BalanceAsOf) (*string, error) {
+	if !obj.SnapshotAt.Valid {
+		return nil, nil
+	}
+
+	snapshotAt := obj.SnapshotAt.Time.String()
+
+	return &snapshotAt, nil
+}
+
 // DebitAmount is the resolver for the debitAmount field.
 func (r *offerResponseResolver) DebitAmount(ctx context.Context, obj *models.HTTPExchangeOfferResponse) (float64, error) {
 	return obj.DebitAmount.InexactFloat64(), nil
@@ -31,6 +53,11 @@ func (r *priceQuoteResolver) Amount(ctx context.Context, obj *models.PriceQuote)
 	return obj.Amount.InexactFloat64(), nil
 }
 
+// BalanceAsOf returns graphql_generated.BalanceAsOfResolver implementation.
+func (r *Resolver) BalanceAsOf() graphql_generated.BalanceAsOfResolver {
+	return &balanceAsOfResolver{r}
+}
+
 // OfferResponse returns graphql_generated.OfferResponseResolver implementation.
 func (r *Resolver) OfferResponse() graphql_generated.OfferResponseResolver {
 	return &offerResponseResolver{r}
@@ -39,5 +66,6 @@ func (r *Resolver) OfferResponse() graphql_generated.OfferResponseResolver {
 // PriceQuote returns graphql_generated.PriceQuoteResolver implementation.
 func (r *Resolver) PriceQuote() graphql_generated.PriceQuoteResolver { return &priceQuoteResolver{r} }
 
+type balanceAsOfResolver struct{ *Resolver }
 type offerResponseResolver struct{ *Resolver }
 type priceQuoteResolver struct{ *Resolver }
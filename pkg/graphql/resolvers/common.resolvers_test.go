@@ -3,11 +3,14 @@ package graphql
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
 )
 
 func TestResolver_PriceQuoteResolvers(t *testing.T) {
@@ -53,3 +56,53 @@ func TestResolver_PriceQuoteResolvers(t *testing.T) {
 		require.InDelta(t, amount.InexactFloat64(), result, 0.0001, "amount mismatched.")
 	})
 }
+
+func TestResolver_BalanceAsOfResolvers(t *testing.T) {
+	t.Parallel()
+
+	resolver := balanceAsOfResolver{}
+
+	balance := decimal.NewFromFloat(1234.56)
+	asOf := time.Now().Add(-time.Hour)
+	snapshotAt := time.Now().Add(-2 * time.Hour)
+
+	balanceAsOf := &postgres.BalanceAsOf{
+		Currency:   "USD",
+		Balance:    balance,
+		AsOf:       pgtype.Timestamptz{Time: asOf, Valid: true},
+		SnapshotAt: pgtype.Timestamptz{Time: snapshotAt, Valid: true},
+	}
+
+	t.Run("Balance", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Balance(context.TODO(), balanceAsOf)
+		require.NoError(t, err, "failed to resolve balance.")
+		require.InDelta(t, balance.InexactFloat64(), result, 0.01, "balance mismatched.")
+	})
+
+	t.Run("AsOf", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.AsOf(context.TODO(), balanceAsOf)
+		require.NoError(t, err, "failed to resolve as of timestamp.")
+		require.Equal(t, asOf.String(), result, "as of timestamp mismatched.")
+	})
+
+	t.Run("SnapshotAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.SnapshotAt(context.TODO(), balanceAsOf)
+		require.NoError(t, err, "failed to resolve snapshot timestamp.")
+		require.NotNil(t, result, "snapshot timestamp not set.")
+		require.Equal(t, snapshotAt.String(), *result, "snapshot timestamp mismatched.")
+	})
+
+	t.Run("SnapshotAt without snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.SnapshotAt(context.TODO(), &postgres.BalanceAsOf{})
+		require.NoError(t, err, "failed to resolve missing snapshot timestamp.")
+		require.Nil(t, result, "missing snapshot timestamp set.")
+	})
+}
@@ -20,30 +20,319 @@ import (
 )
 
 // Balance is the resolver for the balance field.
-func (r *cryptoAccountResolver) Balance(ctx context.Context, obj *postgres.CryptoAccount) (float64, error) {
+func (r *cryptoAccountResolver) Balance(ctx context.Context, obj *postgres.CryptoAccountBalance) (float64, error) {
 	return obj.Balance.InexactFloat64(), nil
 }
 
+// Available is the resolver for the available field.
+func (r *cryptoAccountResolver) Available(ctx context.Context, obj *postgres.CryptoAccountBalance) (float64, error) {
+	return obj.Available.InexactFloat64(), nil
+}
+
 // LastTx is the resolver for the lastTx field.
-func (r *cryptoAccountResolver) LastTx(ctx context.Context, obj *postgres.CryptoAccount) (float64, error) {
+func (r *cryptoAccountResolver) LastTx(ctx context.Context, obj *postgres.CryptoAccountBalance) (float64, error) {
 	return obj.LastTx.InexactFloat64(), nil
 }
 
 // LastTxTs is the resolver for the lastTxTs field.
-func (r *cryptoAccountResolver) LastTxTs(ctx context.Context, obj *postgres.CryptoAccount) (string, error) {
+func (r *cryptoAccountResolver) LastTxTs(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error) {
 	return obj.LastTxTs.Time.String(), nil
 }
 
 // CreatedAt is the resolver for the createdAt field.
-func (r *cryptoAccountResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoAccount) (string, error) {
+func (r *cryptoAccountResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *cryptoAccountResolver) ClientID(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoAccountResolver) Status(ctx context.Context, obj *postgres.CryptoAccountBalance) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoAssetResolver) Status(ctx context.Context, obj *postgres.CryptoAsset) (string, error) {
+	return string(obj.Status), nil
+}
+
+// MinOrder is the resolver for the minOrder field.
+func (r *cryptoAssetResolver) MinOrder(ctx context.Context, obj *postgres.CryptoAsset) (float64, error) {
+	return obj.MinOrder.InexactFloat64(), nil
+}
+
+// MaxOrder is the resolver for the maxOrder field.
+func (r *cryptoAssetResolver) MaxOrder(ctx context.Context, obj *postgres.CryptoAsset) (float64, error) {
+	return obj.MaxOrder.InexactFloat64(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *cryptoAssetResolver) UpdatedAt(ctx context.Context, obj *postgres.CryptoAsset) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *cryptoBalanceSnapshotResolver) ClientID(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// SnapshotAt is the resolver for the snapshotAt field.
+func (r *cryptoBalanceSnapshotResolver) SnapshotAt(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (string, error) {
+	return obj.SnapshotAt.Time.String(), nil
+}
+
+// Balance is the resolver for the balance field.
+func (r *cryptoBalanceSnapshotResolver) Balance(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (float64, error) {
+	return obj.Balance.InexactFloat64(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoBalanceSnapshotResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoBalanceSnapshot) (string, error) {
 	return obj.CreatedAt.Time.String(), nil
 }
 
 // ClientID is the resolver for the clientID field.
-func (r *cryptoAccountResolver) ClientID(ctx context.Context, obj *postgres.CryptoAccount) (string, error) {
+func (r *cryptoLimitOrderResolver) ClientID(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error) {
 	return obj.ClientID.String(), nil
 }
 
+// FiatCurrency is the resolver for the fiatCurrency field.
+func (r *cryptoLimitOrderResolver) FiatCurrency(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error) {
+	return string(obj.FiatCurrency), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *cryptoLimitOrderResolver) Amount(ctx context.Context, obj *postgres.CryptoLimitOrder) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// LimitPrice is the resolver for the limitPrice field.
+func (r *cryptoLimitOrderResolver) LimitPrice(ctx context.Context, obj *postgres.CryptoLimitOrder) (float64, error) {
+	return obj.LimitPrice.InexactFloat64(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoLimitOrderResolver) Status(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error) {
+	return string(obj.Status), nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *cryptoLimitOrderResolver) TxID(ctx context.Context, obj *postgres.CryptoLimitOrder) (*string, error) {
+	if !obj.TxID.Valid {
+		return nil, nil
+	}
+
+	txID := uuid.UUID(obj.TxID.Bytes).String()
+
+	return &txID, nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *cryptoLimitOrderResolver) ExpiresAt(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error) {
+	return obj.ExpiresAt.Time.String(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoLimitOrderResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *cryptoLimitOrderResolver) UpdatedAt(ctx context.Context, obj *postgres.CryptoLimitOrder) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoLimitOrderEventResolver) Status(ctx context.Context, obj *postgres.CryptoLimitOrderEvent) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Price is the resolver for the price field.
+func (r *cryptoLimitOrderEventResolver) Price(ctx context.Context, obj *postgres.CryptoLimitOrderEvent) (float64, error) {
+	return obj.Price.InexactFloat64(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoLimitOrderEventResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoLimitOrderEvent) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *cryptoTriggerOrderResolver) ClientID(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// FiatCurrency is the resolver for the fiatCurrency field.
+func (r *cryptoTriggerOrderResolver) FiatCurrency(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error) {
+	return string(obj.FiatCurrency), nil
+}
+
+// TriggerType is the resolver for the triggerType field.
+func (r *cryptoTriggerOrderResolver) TriggerType(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error) {
+	return string(obj.TriggerType), nil
+}
+
+// TriggerPrice is the resolver for the triggerPrice field.
+func (r *cryptoTriggerOrderResolver) TriggerPrice(ctx context.Context, obj *postgres.CryptoTriggerOrder) (float64, error) {
+	return obj.TriggerPrice.InexactFloat64(), nil
+}
+
+// TrailingDistance is the resolver for the trailingDistance field.
+func (r *cryptoTriggerOrderResolver) TrailingDistance(ctx context.Context, obj *postgres.CryptoTriggerOrder) (float64, error) {
+	return obj.TrailingDistance.InexactFloat64(), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *cryptoTriggerOrderResolver) Amount(ctx context.Context, obj *postgres.CryptoTriggerOrder) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoTriggerOrderResolver) Status(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error) {
+	return string(obj.Status), nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *cryptoTriggerOrderResolver) TxID(ctx context.Context, obj *postgres.CryptoTriggerOrder) (*string, error) {
+	if !obj.TxID.Valid {
+		return nil, nil
+	}
+
+	txID := uuid.UUID(obj.TxID.Bytes).String()
+
+	return &txID, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoTriggerOrderResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *cryptoTriggerOrderResolver) UpdatedAt(ctx context.Context, obj *postgres.CryptoTriggerOrder) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoTriggerOrderEventResolver) Status(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Price is the resolver for the price field.
+func (r *cryptoTriggerOrderEventResolver) Price(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (float64, error) {
+	return obj.Price.InexactFloat64(), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *cryptoTriggerOrderEventResolver) Amount(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoTriggerOrderEventResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoTriggerOrderEvent) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *cryptoRecurringPurchaseResolver) ClientID(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// FiatCurrency is the resolver for the fiatCurrency field.
+func (r *cryptoRecurringPurchaseResolver) FiatCurrency(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return string(obj.FiatCurrency), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *cryptoRecurringPurchaseResolver) Amount(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// Cadence is the resolver for the cadence field.
+func (r *cryptoRecurringPurchaseResolver) Cadence(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return string(obj.Cadence), nil
+}
+
+// StartsAt is the resolver for the startsAt field.
+func (r *cryptoRecurringPurchaseResolver) StartsAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return obj.StartsAt.Time.String(), nil
+}
+
+// EndsAt is the resolver for the endsAt field.
+func (r *cryptoRecurringPurchaseResolver) EndsAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (*string, error) {
+	if !obj.EndsAt.Valid {
+		return nil, nil
+	}
+
+	endsAt := obj.EndsAt.Time.String()
+
+	return &endsAt, nil
+}
+
+// NextRunAt is the resolver for the nextRunAt field.
+func (r *cryptoRecurringPurchaseResolver) NextRunAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return obj.NextRunAt.Time.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoRecurringPurchaseResolver) Status(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return string(obj.Status), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoRecurringPurchaseResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *cryptoRecurringPurchaseResolver) UpdatedAt(ctx context.Context, obj *postgres.CryptoRecurringPurchase) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
+// ScheduledAt is the resolver for the scheduledAt field.
+func (r *cryptoRecurringPurchaseRunResolver) ScheduledAt(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (string, error) {
+	return obj.ScheduledAt.Time.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *cryptoRecurringPurchaseRunResolver) Status(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Price is the resolver for the price field.
+func (r *cryptoRecurringPurchaseRunResolver) Price(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (float64, error) {
+	return obj.Price.InexactFloat64(), nil
+}
+
+// FiatAmount is the resolver for the fiatAmount field.
+func (r *cryptoRecurringPurchaseRunResolver) FiatAmount(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (float64, error) {
+	return obj.FiatAmount.InexactFloat64(), nil
+}
+
+// CryptoAmount is the resolver for the cryptoAmount field.
+func (r *cryptoRecurringPurchaseRunResolver) CryptoAmount(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (float64, error) {
+	return obj.CryptoAmount.InexactFloat64(), nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *cryptoRecurringPurchaseRunResolver) TxID(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (*string, error) {
+	if !obj.TxID.Valid {
+		return nil, nil
+	}
+
+	txID := uuid.UUID(obj.TxID.Bytes).String()
+
+	return &txID, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *cryptoRecurringPurchaseRunResolver) CreatedAt(ctx context.Context, obj *postgres.CryptoRecurringPurchaseRun) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
 // Amount is the resolver for the amount field.
 func (r *cryptoJournalResolver) Amount(ctx context.Context, obj *postgres.CryptoJournal) (float64, error) {
 	return obj.Amount.InexactFloat64(), nil
@@ -77,7 +366,8 @@ func (r *mutationResolver) OpenCrypto(ctx context.Context, ticker string) (*mode
 		err        error
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTransfer()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -97,12 +387,14 @@ func (r *mutationResolver) OfferCrypto(ctx context.Context, input models.HTTPCry
 		statusMessage string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
-	if offer, _, statusMessage, err = common.HTTPCryptoOffer(r.auth, r.cache, r.logger, r.quotes,
-		clientID, input.SourceCurrency, input.DestinationCurrency, input.SourceAmount, *input.IsPurchase); err != nil {
+	if offer, _, statusMessage, err = common.HTTPCryptoOffer(r.auth, r.cache, r.db, r.logger, r.quotes,
+		clientID, input.SourceCurrency, input.DestinationCurrency, input.SourceAmount, *input.IsPurchase,
+		input.Hold); err != nil {
 		if statusMessage == constants.InvalidRequestString() {
 			statusMessage = err.Error()
 		}
@@ -122,30 +414,194 @@ func (r *mutationResolver) ExchangeCrypto(ctx context.Context, offerID string) (
 		err           error
 		receipt       models.HTTPCryptoTransferResponse
 		statusMessage string
+		device        string
+		ipAddress     string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
-	if receipt, _, statusMessage, err = common.HTTPExchangeCrypto(r.auth, r.cache, r.db, r.logger, clientID, offerID); err != nil {
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if receipt, _, statusMessage, err = common.HTTPExchangeCrypto(r.auth, r.cache, r.db, r.logger, clientID, offerID,
+		device, ipAddress); err != nil {
 		return nil, errors.New(statusMessage)
 	}
 
 	return &receipt, nil
 }
 
+// CloseCrypto is the resolver for the closeCrypto field.
+func (r *mutationResolver) CloseCrypto(ctx context.Context, input models.HTTPCloseCryptoAccountRequest) (*models.HTTPCryptoTransferResponse, error) {
+	var (
+		err         error
+		clientID    uuid.UUID
+		httpMessage string
+		payload     any
+		receipt     *models.HTTPCryptoTransferResponse
+		device      string
+		ipAddress   string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTransfer()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if receipt, _, httpMessage, payload, err =
+		common.HTTPCryptoClose(r.db, r.logger, r.quotes, clientID, &input, device, ipAddress); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return receipt, nil
+}
+
+// PlaceLimitOrder is the resolver for the placeLimitOrder field.
+func (r *mutationResolver) PlaceLimitOrder(ctx context.Context, input models.HTTPLimitOrderRequest) (*postgres.CryptoLimitOrder, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		order       *postgres.CryptoLimitOrder
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if order, _, httpMessage, payload, err = common.HTTPLimitOrderPlace(r.db, r.logger, clientID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return order, nil
+}
+
+// CancelLimitOrder is the resolver for the cancelLimitOrder field.
+func (r *mutationResolver) CancelLimitOrder(ctx context.Context, orderID string) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMessage, err = common.HTTPLimitOrderCancel(r.db, r.logger, clientID, orderID); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return orderID, nil
+}
+
+// PlaceTriggerOrder is the resolver for the placeTriggerOrder field.
+func (r *mutationResolver) PlaceTriggerOrder(ctx context.Context, input models.HTTPTriggerOrderRequest) (*postgres.CryptoTriggerOrder, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		order       *postgres.CryptoTriggerOrder
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if order, _, httpMessage, payload, err = common.HTTPTriggerOrderPlace(r.db, r.logger, clientID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return order, nil
+}
+
+// CancelTriggerOrder is the resolver for the cancelTriggerOrder field.
+func (r *mutationResolver) CancelTriggerOrder(ctx context.Context, orderID string) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMessage, err = common.HTTPTriggerOrderCancel(r.db, r.logger, clientID, orderID); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return orderID, nil
+}
+
+// ScheduleRecurringPurchase is the resolver for the scheduleRecurringPurchase field.
+func (r *mutationResolver) ScheduleRecurringPurchase(ctx context.Context, input models.HTTPRecurringPurchaseRequest) (*postgres.CryptoRecurringPurchase, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		plan        *postgres.CryptoRecurringPurchase
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if plan, _, httpMessage, payload, err = common.HTTPRecurringPurchaseCreate(r.db, r.logger, clientID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return plan, nil
+}
+
+// CancelRecurringPurchase is the resolver for the cancelRecurringPurchase field.
+func (r *mutationResolver) CancelRecurringPurchase(ctx context.Context, planID string) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMessage, err = common.HTTPRecurringPurchaseCancel(r.db, r.logger, clientID, planID); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return planID, nil
+}
+
 // BalanceCrypto is the resolver for the balanceCrypto field.
-func (r *queryResolver) BalanceCrypto(ctx context.Context, ticker string) (*postgres.CryptoAccount, error) {
+func (r *queryResolver) BalanceCrypto(ctx context.Context, ticker string) (*postgres.CryptoAccountBalance, error) {
 	var (
-		accDetails  *postgres.CryptoAccount
+		accDetails  *postgres.CryptoAccountBalance
 		clientID    uuid.UUID
 		err         error
 		httpMessage string
 		payload     any
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -174,7 +630,8 @@ func (r *queryResolver) BalanceAllCrypto(ctx context.Context, pageCursor *string
 		pageCursor = new(string)
 	}
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -186,6 +643,62 @@ func (r *queryResolver) BalanceAllCrypto(ctx context.Context, pageCursor *string
 	return &accDetails, nil
 }
 
+// BalanceAsOfCrypto is the resolver for the balanceAsOfCrypto field.
+func (r *queryResolver) BalanceAsOfCrypto(ctx context.Context, ticker string, timestamp int64) (*postgres.BalanceAsOf, error) {
+	var (
+		balance     *postgres.BalanceAsOf
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if balance, _, httpMessage, payload, err = common.HTTPCryptoBalanceAsOf(
+		r.db, r.logger, clientID, ticker, strconv.FormatInt(timestamp, 10)); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return balance, nil
+}
+
+// BalanceHistoryCrypto is the resolver for the balanceHistoryCrypto field.
+func (r *queryResolver) BalanceHistoryCrypto(ctx context.Context, ticker string, from *int64, to *int64) (*models.HTTPCryptoBalanceHistory, error) {
+	var (
+		history     *models.HTTPCryptoBalanceHistory
+		clientID    uuid.UUID
+		err         error
+		fromStr     string
+		toStr       string
+		httpMessage string
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if from != nil {
+		fromStr = strconv.FormatInt(*from, 10)
+	}
+
+	if to != nil {
+		toStr = strconv.FormatInt(*to, 10)
+	}
+
+	if history, _, httpMessage, payload, err = common.HTTPCryptoBalanceHistory(
+		r.db, r.logger, clientID, ticker, fromStr, toStr); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return history, nil
+}
+
 // TransactionDetailsCrypto is the resolver for the transactionDetailsCrypto field.
 func (r *queryResolver) TransactionDetailsCrypto(ctx context.Context, transactionID string) ([]interface{}, error) {
 	var (
@@ -195,7 +708,8 @@ func (r *queryResolver) TransactionDetailsCrypto(ctx context.Context, transactio
 		httpMessage    string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -242,7 +756,8 @@ func (r *queryResolver) TransactionDetailsAllCrypto(ctx context.Context, input m
 	}
 	params.YearStr = *input.Year
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -255,6 +770,214 @@ func (r *queryResolver) TransactionDetailsAllCrypto(ctx context.Context, input m
 	return &journalEntries, nil
 }
 
+// CryptoAssets is the resolver for the cryptoAssets field.
+func (r *queryResolver) CryptoAssets(ctx context.Context) ([]postgres.CryptoAsset, error) {
+	var (
+		assets      []postgres.CryptoAsset
+		err         error
+		httpMessage string
+	)
+
+	if _, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if assets, _, httpMessage, err = common.HTTPCryptoAssets(r.db, r.logger); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return assets, nil
+}
+
+// LimitOrder is the resolver for the limitOrder field.
+func (r *queryResolver) LimitOrder(ctx context.Context, orderID string) (*models.HTTPLimitOrderDetails, error) {
+	var (
+		clientID    uuid.UUID
+		details     *models.HTTPLimitOrderDetails
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if details, _, httpMessage, err = common.HTTPLimitOrderDetails(r.db, r.logger, clientID, orderID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return details, nil
+}
+
+// LimitOrders is the resolver for the limitOrders field.
+func (r *queryResolver) LimitOrders(ctx context.Context, status *string, pageCursor *string, pageSize *int32) (*models.HTTPLimitOrdersPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		orders      *models.HTTPLimitOrdersPaginated
+	)
+
+	if status == nil {
+		status = new(string)
+	}
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if orders, _, httpMessage, err = common.HTTPLimitOrdersPaginated(r.auth, r.db, r.logger, clientID, *status,
+		*pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return orders, nil
+}
+
+// TriggerOrder is the resolver for the triggerOrder field.
+func (r *queryResolver) TriggerOrder(ctx context.Context, orderID string) (*models.HTTPTriggerOrderDetails, error) {
+	var (
+		clientID    uuid.UUID
+		details     *models.HTTPTriggerOrderDetails
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if details, _, httpMessage, err = common.HTTPTriggerOrderDetails(r.db, r.logger, clientID, orderID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return details, nil
+}
+
+// TriggerOrders is the resolver for the triggerOrders field.
+func (r *queryResolver) TriggerOrders(ctx context.Context, status *string, pageCursor *string, pageSize *int32) (*models.HTTPTriggerOrdersPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		orders      *models.HTTPTriggerOrdersPaginated
+	)
+
+	if status == nil {
+		status = new(string)
+	}
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if orders, _, httpMessage, err = common.HTTPTriggerOrdersPaginated(r.auth, r.db, r.logger, clientID, *status,
+		*pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return orders, nil
+}
+
+// RecurringPurchase is the resolver for the recurringPurchase field.
+func (r *queryResolver) RecurringPurchase(ctx context.Context, planID string, pageCursor *string, pageSize *int32) (*models.HTTPRecurringPurchaseDetails, error) {
+	var (
+		clientID    uuid.UUID
+		details     *models.HTTPRecurringPurchaseDetails
+		err         error
+		httpMessage string
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if details, _, httpMessage, err = common.HTTPRecurringPurchaseDetails(r.auth, r.db, r.logger, clientID, planID,
+		*pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return details, nil
+}
+
+// RecurringPurchases is the resolver for the recurringPurchases field.
+func (r *queryResolver) RecurringPurchases(ctx context.Context, status *string, pageCursor *string, pageSize *int32) (*models.HTTPRecurringPurchasesPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		plans       *models.HTTPRecurringPurchasesPaginated
+	)
+
+	if status == nil {
+		status = new(string)
+	}
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if plans, _, httpMessage, err = common.HTTPRecurringPurchasesPaginated(r.auth, r.db, r.logger, clientID, *status,
+		*pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return plans, nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *cryptoLimitOrderRequestResolver) Amount(ctx context.Context, obj *models.HTTPLimitOrderRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// LimitPrice is the resolver for the limitPrice field.
+func (r *cryptoLimitOrderRequestResolver) LimitPrice(ctx context.Context, obj *models.HTTPLimitOrderRequest, data float64) error {
+	obj.LimitPrice = decimal.NewFromFloat(data)
+
+	return nil
+}
+
 // SourceAmount is the resolver for the sourceAmount field.
 func (r *cryptoOfferRequestResolver) SourceAmount(ctx context.Context, obj *models.HTTPCryptoOfferRequest, data float64) error {
 	obj.SourceAmount = decimal.NewFromFloat(data)
@@ -262,11 +985,83 @@ func (r *cryptoOfferRequestResolver) SourceAmount(ctx context.Context, obj *mode
 	return nil
 }
 
+// Amount is the resolver for the amount field.
+func (r *cryptoRecurringPurchaseRequestResolver) Amount(ctx context.Context, obj *models.HTTPRecurringPurchaseRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// TriggerPrice is the resolver for the triggerPrice field.
+func (r *cryptoTriggerOrderRequestResolver) TriggerPrice(ctx context.Context, obj *models.HTTPTriggerOrderRequest, data float64) error {
+	obj.TriggerPrice = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// TrailingDistance is the resolver for the trailingDistance field.
+func (r *cryptoTriggerOrderRequestResolver) TrailingDistance(ctx context.Context, obj *models.HTTPTriggerOrderRequest, data *float64) error {
+	if data != nil {
+		obj.TrailingDistance = decimal.NewFromFloat(*data)
+	}
+
+	return nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *cryptoTriggerOrderRequestResolver) Amount(ctx context.Context, obj *models.HTTPTriggerOrderRequest, data *float64) error {
+	if data != nil {
+		obj.Amount = decimal.NewFromFloat(*data)
+	}
+
+	return nil
+}
+
 // CryptoAccount returns graphql_generated.CryptoAccountResolver implementation.
 func (r *Resolver) CryptoAccount() graphql_generated.CryptoAccountResolver {
 	return &cryptoAccountResolver{r}
 }
 
+// CryptoAsset returns graphql_generated.CryptoAssetResolver implementation.
+func (r *Resolver) CryptoAsset() graphql_generated.CryptoAssetResolver {
+	return &cryptoAssetResolver{r}
+}
+
+// CryptoBalanceSnapshot returns graphql_generated.CryptoBalanceSnapshotResolver implementation.
+func (r *Resolver) CryptoBalanceSnapshot() graphql_generated.CryptoBalanceSnapshotResolver {
+	return &cryptoBalanceSnapshotResolver{r}
+}
+
+// CryptoLimitOrder returns graphql_generated.CryptoLimitOrderResolver implementation.
+func (r *Resolver) CryptoLimitOrder() graphql_generated.CryptoLimitOrderResolver {
+	return &cryptoLimitOrderResolver{r}
+}
+
+// CryptoLimitOrderEvent returns graphql_generated.CryptoLimitOrderEventResolver implementation.
+func (r *Resolver) CryptoLimitOrderEvent() graphql_generated.CryptoLimitOrderEventResolver {
+	return &cryptoLimitOrderEventResolver{r}
+}
+
+// CryptoTriggerOrder returns graphql_generated.CryptoTriggerOrderResolver implementation.
+func (r *Resolver) CryptoTriggerOrder() graphql_generated.CryptoTriggerOrderResolver {
+	return &cryptoTriggerOrderResolver{r}
+}
+
+// CryptoTriggerOrderEvent returns graphql_generated.CryptoTriggerOrderEventResolver implementation.
+func (r *Resolver) CryptoTriggerOrderEvent() graphql_generated.CryptoTriggerOrderEventResolver {
+	return &cryptoTriggerOrderEventResolver{r}
+}
+
+// CryptoRecurringPurchase returns graphql_generated.CryptoRecurringPurchaseResolver implementation.
+func (r *Resolver) CryptoRecurringPurchase() graphql_generated.CryptoRecurringPurchaseResolver {
+	return &cryptoRecurringPurchaseResolver{r}
+}
+
+// CryptoRecurringPurchaseRun returns graphql_generated.CryptoRecurringPurchaseRunResolver implementation.
+func (r *Resolver) CryptoRecurringPurchaseRun() graphql_generated.CryptoRecurringPurchaseRunResolver {
+	return &cryptoRecurringPurchaseRunResolver{r}
+}
+
 // CryptoJournal returns graphql_generated.CryptoJournalResolver implementation.
 func (r *Resolver) CryptoJournal() graphql_generated.CryptoJournalResolver {
 	return &cryptoJournalResolver{r}
@@ -277,12 +1072,38 @@ func (r *Resolver) CryptoTransactionsPaginated() graphql_generated.CryptoTransac
 	return &cryptoTransactionsPaginatedResolver{r}
 }
 
+// CryptoLimitOrderRequest returns graphql_generated.CryptoLimitOrderRequestResolver implementation.
+func (r *Resolver) CryptoLimitOrderRequest() graphql_generated.CryptoLimitOrderRequestResolver {
+	return &cryptoLimitOrderRequestResolver{r}
+}
+
 // CryptoOfferRequest returns graphql_generated.CryptoOfferRequestResolver implementation.
 func (r *Resolver) CryptoOfferRequest() graphql_generated.CryptoOfferRequestResolver {
 	return &cryptoOfferRequestResolver{r}
 }
 
+// CryptoRecurringPurchaseRequest returns graphql_generated.CryptoRecurringPurchaseRequestResolver implementation.
+func (r *Resolver) CryptoRecurringPurchaseRequest() graphql_generated.CryptoRecurringPurchaseRequestResolver {
+	return &cryptoRecurringPurchaseRequestResolver{r}
+}
+
+// CryptoTriggerOrderRequest returns graphql_generated.CryptoTriggerOrderRequestResolver implementation.
+func (r *Resolver) CryptoTriggerOrderRequest() graphql_generated.CryptoTriggerOrderRequestResolver {
+	return &cryptoTriggerOrderRequestResolver{r}
+}
+
 type cryptoAccountResolver struct{ *Resolver }
+type cryptoAssetResolver struct{ *Resolver }
+type cryptoBalanceSnapshotResolver struct{ *Resolver }
+type cryptoLimitOrderResolver struct{ *Resolver }
+type cryptoLimitOrderEventResolver struct{ *Resolver }
+type cryptoTriggerOrderResolver struct{ *Resolver }
+type cryptoTriggerOrderEventResolver struct{ *Resolver }
+type cryptoRecurringPurchaseResolver struct{ *Resolver }
+type cryptoRecurringPurchaseRunResolver struct{ *Resolver }
 type cryptoJournalResolver struct{ *Resolver }
 type cryptoTransactionsPaginatedResolver struct{ *Resolver }
+type cryptoLimitOrderRequestResolver struct{ *Resolver }
 type cryptoOfferRequestResolver struct{ *Resolver }
+type cryptoRecurringPurchaseRequestResolver struct{ *Resolver }
+type cryptoTriggerOrderRequestResolver struct{ *Resolver }
@@ -20,6 +20,7 @@ import (
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 )
@@ -102,18 +103,23 @@ func TestCryptoResolver_OpenCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.cryptoCreateAccTimes),
+
 				mockPostgres.EXPECT().CryptoCreateAccount(gomock.Any(), gomock.Any()).
 					Return(test.cryptoCreateAccErr).
 					Times(test.cryptoCreateAccTimes),
@@ -122,7 +128,8 @@ func TestCryptoResolver_OpenCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -185,11 +192,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 		isDeletedError     error
 		isDeletedTimes     int
 		isDeletedValue     bool
+		assetTimes         int
 		quotesErr          error
 		quotesAmount       decimal.Decimal
 		quotesTimes        int
 		authEncryptErr     error
 		authEncryptTimes   int
+		holdErr            error
+		holdTimes          int
 		redisErr           error
 		redisTimes         int
 	}{
@@ -203,11 +213,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -220,11 +233,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -237,11 +253,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -254,11 +273,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     0,
 			isDeletedValue:     false,
+			assetTimes:         0,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -271,11 +293,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     true,
+			assetTimes:         0,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -288,11 +313,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          errors.New(""),
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -305,11 +333,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       decimal.NewFromFloat(0),
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -322,11 +353,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     errors.New("encryption error"),
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -339,11 +373,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           errors.New("redis error"),
 			redisTimes:         1,
 		}, {
@@ -356,11 +393,14 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         1,
 		}, {
@@ -373,11 +413,55 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isDeletedError:     nil,
 			isDeletedTimes:     1,
 			isDeletedValue:     false,
+			assetTimes:         1,
+			quotesErr:          nil,
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptErr:     nil,
+			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
+			redisErr:           nil,
+			redisTimes:         1,
+		}, {
+			name:               "hold insufficient funds",
+			path:               "/offer-crypto/hold-insufficient-funds",
+			query:              fmt.Sprintf(testCryptoQuery["offerCryptoHold"], validFloat, "BTC", "USD", false),
+			expectErr:          true,
+			isPurchase:         false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedError:     nil,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			quotesErr:          nil,
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptErr:     nil,
+			authEncryptTimes:   1,
+			holdErr:            postgres.ErrInsufficientFunds,
+			holdTimes:          1,
+			redisErr:           nil,
+			redisTimes:         0,
+		}, {
+			name:               "valid - held sale",
+			path:               "/offer-crypto/valid-held-sale",
+			query:              fmt.Sprintf(testCryptoQuery["offerCryptoHold"], validFloat, "BTC", "USD", false),
+			isPurchase:         false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedError:     nil,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          1,
 			redisErr:           nil,
 			redisTimes:         1,
 		},
@@ -393,19 +477,24 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
-			mockPostgres := mocks.NewMockPostgres(mockCtrl) // Not called.
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
 				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), test.isPurchase, nil).
 					Return(amountValid, test.quotesAmount, test.quotesErr).
 					Times(test.quotesTimes),
@@ -414,6 +503,11 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 					Return("OFFER-ID", test.authEncryptErr).
 					Times(test.authEncryptTimes),
 
+				mockPostgres.EXPECT().
+					CryptoHold(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.holdErr).
+					Times(test.holdTimes),
+
 				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(test.redisErr).
 					Times(test.redisTimes),
@@ -422,7 +516,8 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -653,16 +748,18 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(validClientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
@@ -679,12 +776,12 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 					Times(test.redisDelTimes),
 
 				mockPostgres.EXPECT().CryptoPurchase(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.Currency("USD"), fiatAmount, "BTC", cryptoAmount, "").
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.purchaseTimes),
 
 				mockPostgres.EXPECT().CryptoSell(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.Currency("USD"), fiatAmount, "BTC", cryptoAmount, "").
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.sellTimes),
 			)
@@ -692,7 +789,191 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CloseCrypto(t *testing.T) {
+	t.Parallel()
+
+	balance := postgres.CryptoAccountBalance{Balance: decimal.NewFromFloat(0.5)}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		balanceTimes         int
+		assetTimes           int
+		quotesTimes          int
+		closeErr             error
+		closeTimes           int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/close-crypto/invalid-jwt",
+			query:                fmt.Sprintf(testCryptoQuery["closeCrypto"], ""),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid token"),
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			assetTimes:           0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/close-crypto/deleted-account",
+			query:                fmt.Sprintf(testCryptoQuery["closeCrypto"], ""),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			balanceTimes:         0,
+			assetTimes:           0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/close-crypto/invalid-currency",
+			query:                fmt.Sprintf(testCryptoQuery["closeCrypto"], "INVALIDTICKER"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			assetTimes:           0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           0,
+		}, {
+			name:                 "db failure",
+			path:                 "/close-crypto/db-failure",
+			query:                fmt.Sprintf(testCryptoQuery["closeCrypto"], "BTC"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			assetTimes:           0,
+			quotesTimes:          0,
+			closeErr:             postgres.ErrCloseAccount,
+			closeTimes:           1,
+		}, {
+			name:                 "valid",
+			path:                 "/close-crypto/valid",
+			query:                fmt.Sprintf(testCryptoQuery["closeCrypto"], "BTC"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			assetTimes:           0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           1,
+		}, {
+			name:                 "valid with sweep",
+			path:                 "/close-crypto/valid-sweep",
+			query:                fmt.Sprintf(testCryptoQuery["closeCryptoSweep"], "BTC", "USD"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         1,
+			assetTimes:           1,
+			quotesTimes:          1,
+			closeErr:             nil,
+			closeTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
+					Return(balance, nil).
+					Times(test.balanceTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), false, nil).
+					Return(decimal.NewFromFloat(50001), decimal.NewFromFloat(25000.5), nil).
+					Times(test.quotesTimes),
+
+				mockPostgres.EXPECT().CryptoCloseAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -731,9 +1012,10 @@ func TestCryptoResolver_CryptoAccountResolver(t *testing.T) {
 	createdAtPG := pgtype.Timestamptz{}
 	require.NoError(t, createdAtPG.Scan(createdAt), "failed to generate createdAt.")
 
-	obj := &postgres.CryptoAccount{
+	obj := &postgres.CryptoAccountBalance{
 		Ticker:    "BTC",
 		Balance:   decimal.NewFromFloat(46.39),
+		Available: decimal.NewFromFloat(40.39),
 		LastTx:    decimal.NewFromFloat(-789.33),
 		LastTxTs:  lastTxTSPG,
 		CreatedAt: createdAtPG,
@@ -748,6 +1030,14 @@ func TestCryptoResolver_CryptoAccountResolver(t *testing.T) {
 		require.InDelta(t, obj.Balance.InexactFloat64(), result, 0.01, "balance mismatched.")
 	})
 
+	t.Run("Available", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Available(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve available balance.")
+		require.InDelta(t, obj.Available.InexactFloat64(), result, 0.01, "available balance mismatched.")
+	})
+
 	t.Run("LastTx", func(t *testing.T) {
 		t.Parallel()
 
@@ -871,27 +1161,33 @@ func TestCryptoResolver_BalanceCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.balanceTimes),
+
 				mockPostgres.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
-					Return(postgres.CryptoAccount{}, test.balanceErr).
+					Return(postgres.CryptoAccountBalance{}, test.balanceErr).
 					Times(test.balanceTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -917,14 +1213,14 @@ func TestCryptoResolver_BalanceCrypto(t *testing.T) {
 func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 	t.Parallel()
 
-	accDetails := []postgres.CryptoAccount{{}, {}, {}, {}}
+	accDetails := []postgres.CryptoAccountBalance{{}, {}, {}, {}}
 
 	testCases := []struct {
 		name                 string
 		path                 string
 		query                string
 		expectErr            bool
-		accDetails           []postgres.CryptoAccount
+		accDetails           []postgres.CryptoAccountBalance
 		authValidateJWTErr   error
 		authValidateJWTTimes int
 		isDeletedError       error
@@ -1044,7 +1340,7 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 			path:                 "/balance-all-crypto/valid-no-query-10-records",
 			query:                testCryptoQuery["balanceAllCryptoNoParams"],
 			expectErr:            false,
-			accDetails:           []postgres.CryptoAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:           []postgres.CryptoAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
@@ -1061,7 +1357,7 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 			path:                 "/balance-all-crypto/valid-no-query-11-records",
 			query:                testCryptoQuery["balanceAllCryptoNoParams"],
 			expectErr:            false,
-			accDetails:           []postgres.CryptoAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:           []postgres.CryptoAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
@@ -1121,16 +1417,17 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
@@ -1149,7 +1446,8 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1172,16 +1470,63 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 	}
 }
 
-func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
+func TestCryptoResolver_CryptoBalanceSnapshotResolver(t *testing.T) {
 	t.Parallel()
 
+	resolver := cryptoBalanceSnapshotResolver{}
+
 	clientID, err := uuid.NewV4()
 	require.NoError(t, err, "failed to generate client id.")
 
-	txUUID, err := uuid.NewV4()
-	require.NoError(t, err, "failed to generate transaction id.")
+	balance := decimal.NewFromFloat(1234.56)
+	snapshotAt := time.Now().Add(-time.Hour)
+	createdAt := time.Now().Add(-time.Minute)
 
-	txID := txUUID.String()
+	snapshot := &postgres.CryptoBalanceSnapshot{
+		ClientID:   clientID,
+		Ticker:     "BTC",
+		SnapshotAt: pgtype.Timestamptz{Time: snapshotAt, Valid: true},
+		Balance:    balance,
+		CreatedAt:  pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve client id.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("SnapshotAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.SnapshotAt(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve snapshot timestamp.")
+		require.Equal(t, snapshotAt.String(), result, "snapshot timestamp mismatched.")
+	})
+
+	t.Run("Balance", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Balance(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve balance.")
+		require.InDelta(t, balance.InexactFloat64(), result, 0.01, "balance mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve created at timestamp.")
+		require.Equal(t, createdAt.String(), result, "created at timestamp mismatched.")
+	})
+}
+
+func TestCryptoResolver_BalanceAsOfCrypto(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
 
 	testCases := []struct {
 		name                 string
@@ -1189,92 +1534,2980 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 		query                string
 		expectErr            bool
 		authValidateJWTErr   error
-		authValidateTimes    int
+		authValidateJWTTimes int
 		isDeletedError       error
 		isDeletedTimes       int
 		isDeletedValue       bool
-		fiatTxDetailsErr     error
-		fiatTxDetailsTimes   int
-		cryptoTxDetailsErr   error
-		cryptoTxDetailsTimes int
+		assetErr             error
+		assetTimes           int
+		dbErr                error
+		dbTimes              int
 	}{
 		{
-			name:                 "invalid jwt",
-			path:                 "/transaction-details-crypto/invalid-jwt",
-			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			name:                 "invalid JWT",
+			path:                 "/balance-as-of-crypto/invalid-jwt",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now),
 			expectErr:            true,
-			authValidateTimes:    1,
-			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTErr:   errors.New("invalid JWT"),
+			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
-			fiatTxDetailsErr:     nil,
-			fiatTxDetailsTimes:   0,
-			cryptoTxDetailsErr:   nil,
-			cryptoTxDetailsTimes: 0,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
 		}, {
 			name:                 "deleted account",
-			path:                 "/transaction-details-crypto/deleted-account",
-			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			path:                 "/balance-as-of-crypto/deleted-account",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now),
 			expectErr:            true,
-			authValidateTimes:    1,
 			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       true,
-			fiatTxDetailsErr:     nil,
-			fiatTxDetailsTimes:   0,
-			cryptoTxDetailsErr:   nil,
-			cryptoTxDetailsTimes: 0,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
 		}, {
-			name:                 "db failure fiat",
-			path:                 "/transaction-details-crypto/db-failure-fiat",
-			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
-			expectErr:            false,
-			authValidateTimes:    1,
+			name:                 "invalid ticker",
+			path:                 "/balance-as-of-crypto/invalid-ticker",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "INVALID", now),
+			expectErr:            true,
 			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
-			fiatTxDetailsTimes:   1,
-			fiatTxDetailsErr:     postgres.ErrTransactCryptoDetails,
-			cryptoTxDetailsTimes: 0,
-			cryptoTxDetailsErr:   nil,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
 		}, {
-			name:                 "db failure crypto",
-			path:                 "/transaction-details-crypto/db-failure-crypto",
-			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
-			expectErr:            false,
-			authValidateTimes:    1,
+			name:                 "future timestamp",
+			path:                 "/balance-as-of-crypto/future-timestamp",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now+3600),
+			expectErr:            true,
 			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
-			fiatTxDetailsTimes:   1,
-			fiatTxDetailsErr:     nil,
-			cryptoTxDetailsTimes: 1,
-			cryptoTxDetailsErr:   postgres.ErrTransactCryptoDetails,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
 		}, {
-			name:                 "valid",
-			path:                 "/transaction-details-crypto/valid",
-			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
-			expectErr:            false,
-			authValidateTimes:    1,
+			name:                 "unknown asset",
+			path:                 "/balance-as-of-crypto/unknown-asset",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now),
+			expectErr:            true,
 			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
-			fiatTxDetailsTimes:   1,
-			fiatTxDetailsErr:     nil,
-			cryptoTxDetailsTimes: 1,
-			cryptoTxDetailsErr:   nil,
-		},
-	}
-
-	for _, testCase := range testCases {
-		test := testCase
-
-		t.Run(test.name, func(t *testing.T) {
+			assetErr:             postgres.ErrNotFound,
+			assetTimes:           1,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "unknown db error",
+			path:                 "/balance-as-of-crypto/unknown-db-error",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                errors.New("unknown error"),
+			dbTimes:              1,
+		}, {
+			name:                 "known db error",
+			path:                 "/balance-as-of-crypto/known-db-error",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                postgres.ErrNotFound,
+			dbTimes:              1,
+		}, {
+			name:                 "valid",
+			path:                 "/balance-as-of-crypto/valid",
+			query:                fmt.Sprintf(testCryptoQuery["balanceAsOfCrypto"], "BTC", now),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                nil,
+			dbTimes:              1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(postgres.CryptoAsset{}, test.assetErr).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().CryptoBalanceAsOf(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(postgres.BalanceAsOf{}, test.dbErr).
+					Times(test.dbTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_BalanceHistoryCrypto(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		assetErr             error
+		assetTimes           int
+		dbErr                error
+		dbTimes              int
+	}{
+		{
+			name:                 "invalid JWT",
+			path:                 "/balance-history-crypto/invalid-jwt",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid JWT"),
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/balance-history-crypto/deleted-account",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "invalid ticker",
+			path:                 "/balance-history-crypto/invalid-ticker",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "INVALID", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "reversed range",
+			path:                 "/balance-history-crypto/reversed-range",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now, now-86400),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           0,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "unknown asset",
+			path:                 "/balance-history-crypto/unknown-asset",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             postgres.ErrNotFound,
+			assetTimes:           1,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "unknown db error",
+			path:                 "/balance-history-crypto/unknown-db-error",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                errors.New("unknown error"),
+			dbTimes:              1,
+		}, {
+			name:                 "known db error",
+			path:                 "/balance-history-crypto/known-db-error",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                postgres.ErrNotFound,
+			dbTimes:              1,
+		}, {
+			name:                 "valid",
+			path:                 "/balance-history-crypto/valid",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCrypto"], "BTC", now-86400, now),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                nil,
+			dbTimes:              1,
+		}, {
+			name:                 "valid no range",
+			path:                 "/balance-history-crypto/valid-no-range",
+			query:                fmt.Sprintf(testCryptoQuery["balanceHistoryCryptoNoParams"], "BTC"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			assetErr:             nil,
+			assetTimes:           1,
+			dbErr:                nil,
+			dbTimes:              1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(postgres.CryptoAsset{}, test.assetErr).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().CryptoBalanceHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoBalanceSnapshot{}, test.dbErr).
+					Times(test.dbTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txUUID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	txID := txUUID.String()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateTimes    int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		fiatTxDetailsErr     error
+		fiatTxDetailsTimes   int
+		cryptoTxDetailsErr   error
+		cryptoTxDetailsTimes int
+		reversalTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/transaction-details-crypto/invalid-jwt",
+			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			expectErr:            true,
+			authValidateTimes:    1,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			fiatTxDetailsErr:     nil,
+			fiatTxDetailsTimes:   0,
+			cryptoTxDetailsErr:   nil,
+			cryptoTxDetailsTimes: 0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/transaction-details-crypto/deleted-account",
+			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			expectErr:            true,
+			authValidateTimes:    1,
+			authValidateJWTErr:   nil,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			fiatTxDetailsErr:     nil,
+			fiatTxDetailsTimes:   0,
+			cryptoTxDetailsErr:   nil,
+			cryptoTxDetailsTimes: 0,
+		}, {
+			name:                 "db failure fiat",
+			path:                 "/transaction-details-crypto/db-failure-fiat",
+			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			expectErr:            false,
+			authValidateTimes:    1,
+			authValidateJWTErr:   nil,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatTxDetailsTimes:   1,
+			fiatTxDetailsErr:     postgres.ErrTransactCryptoDetails,
+			cryptoTxDetailsTimes: 0,
+			cryptoTxDetailsErr:   nil,
+		}, {
+			name:                 "db failure crypto",
+			path:                 "/transaction-details-crypto/db-failure-crypto",
+			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			expectErr:            false,
+			authValidateTimes:    1,
+			authValidateJWTErr:   nil,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatTxDetailsTimes:   1,
+			fiatTxDetailsErr:     nil,
+			cryptoTxDetailsTimes: 1,
+			cryptoTxDetailsErr:   postgres.ErrTransactCryptoDetails,
+		}, {
+			name:                 "valid",
+			path:                 "/transaction-details-crypto/valid",
+			query:                fmt.Sprintf(testCryptoQuery["transactionDetailsCrypto"], txID),
+			expectErr:            false,
+			authValidateTimes:    1,
+			authValidateJWTErr:   nil,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatTxDetailsTimes:   1,
+			fiatTxDetailsErr:     nil,
+			cryptoTxDetailsTimes: 1,
+			cryptoTxDetailsErr:   nil,
+			reversalTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(clientID, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().FiatTxDetails(gomock.Any(), gomock.Any()).
+					Return([]postgres.FiatJournal{{}}, test.fiatTxDetailsErr).
+					Times(test.fiatTxDetailsTimes),
+
+				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoJournal{{}}, test.cryptoTxDetailsErr).
+					Times(test.cryptoTxDetailsTimes),
+
+				mockPostgres.EXPECT().JournalReversalGet(gomock.Any()).
+					Return(nil, postgres.ErrNotFound).
+					Times(test.reversalTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestFiatResolver_CryptoTransactionsPaginatedResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoTransactionsPaginatedResolver{}
+
+	transactions := &models.HTTPCryptoTransactionsPaginated{}
+
+	actual, err := resolver.Transactions(context.TODO(), transactions)
+	require.NoError(t, err, "error should always be nil.")
+	require.Equal(t, transactions.TransactionDetails, actual, "actual and returned addresses do not match.")
+}
+
+func TestCryptoResolver_TransactionDetailsAllCrypto(t *testing.T) {
+	t.Parallel()
+
+	decryptedCursor := fmt.Sprintf("%s,%s,%d",
+		fmt.Sprintf(constants.MonthFormatString(), 2023, 6, "-04:00"),
+		fmt.Sprintf(constants.MonthFormatString(), 2023, 7, "-04:00"),
+		10)
+
+	journalEntries := []postgres.CryptoJournal{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                   string
+		path                   string
+		query                  string
+		expectErr              bool
+		journalEntries         []postgres.CryptoJournal
+		authValidateJWTErr     error
+		authValidateJWTTimes   int
+		isDeletedError         error
+		isDeletedTimes         int
+		isDeletedValue         bool
+		authDecryptCursorErr   error
+		authDecryptCursorTimes int
+		authEncryptCursorErr   error
+		authEncryptCursorTimes int
+		cryptoTxPaginatedErr   error
+		cryptoTxPaginatedTimes int
+	}{
+		{
+			name: "auth failure",
+			path: "/transaction-details-all-crypto/auth-failure",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"USD", 3, "page-cusror"),
+			expectErr:              true,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     errors.New("auth failure"),
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         0,
+			isDeletedValue:         false,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 0,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 0,
+			cryptoTxPaginatedErr:   nil,
+			cryptoTxPaginatedTimes: 0,
+		}, {
+			name: "deleted account",
+			path: "/transaction-details-all-crypto/deleted-account",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"USD", 3, "page-cusror"),
+			expectErr:              true,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     nil,
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         true,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 0,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 0,
+			cryptoTxPaginatedErr:   nil,
+			cryptoTxPaginatedTimes: 0,
+		}, {
+			name: "no cursor or params",
+			path: "/transaction-details-all-crypto/no-cursor-or-params",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"", 3, ""),
+			expectErr:              true,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     nil,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         false,
+			authValidateJWTTimes:   1,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 0,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 0,
+			cryptoTxPaginatedErr:   nil,
+			cryptoTxPaginatedTimes: 0,
+		}, {
+			name: "db failure",
+			path: "/transaction-details-all-crypto/db-failure",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"BTC", 3, "page-cusror"),
+			expectErr:              true,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     nil,
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         false,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 1,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 1,
+			cryptoTxPaginatedErr:   postgres.ErrNotFound,
+			cryptoTxPaginatedTimes: 1,
+		}, {
+			name: "unknown db failure",
+			path: "/transaction-details-all-crypto/unknown-db-failure",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"BTC", 3, "page-cusror"),
+			expectErr:              true,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     nil,
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         false,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 1,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 1,
+			cryptoTxPaginatedErr:   errors.New("db failure"),
+			cryptoTxPaginatedTimes: 1,
+		}, {
+			name: "no transactions",
+			path: "/transaction-details-all-crypto/no-transactions",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"BTC", 3, "page-cusror"),
+			expectErr:              false,
+			journalEntries:         []postgres.CryptoJournal{},
+			authValidateJWTErr:     nil,
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         false,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 1,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 1,
+			cryptoTxPaginatedErr:   nil,
+			cryptoTxPaginatedTimes: 1,
+		}, {
+			name: "valid with cursor",
+			path: "/transaction-details-all-crypto/valid-with-cursor",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
+				"BTC", 3, "page-cusror"),
+			expectErr:              false,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     nil,
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         false,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 1,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 1,
+			cryptoTxPaginatedErr:   nil,
+			cryptoTxPaginatedTimes: 1,
+		}, {
+			name: "valid with query",
+			path: "/transaction-details-all-crypto/valid-with-query",
+			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoInit"],
+				"BTC", 3, "-04:00", 6, 2023),
+			expectErr:              false,
+			journalEntries:         journalEntries,
+			authValidateJWTErr:     nil,
+			authValidateJWTTimes:   1,
+			isDeletedError:         nil,
+			isDeletedTimes:         1,
+			isDeletedValue:         false,
+			authDecryptCursorErr:   nil,
+			authDecryptCursorTimes: 0,
+			authEncryptCursorErr:   nil,
+			authEncryptCursorTimes: 1,
+			cryptoTxPaginatedErr:   nil,
+			cryptoTxPaginatedTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte(decryptedCursor), test.authDecryptCursorErr).
+					Times(test.authDecryptCursorTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-cursor", test.authEncryptCursorErr).
+					Times(test.authEncryptCursorTimes),
+
+				mockPostgres.EXPECT().CryptoTransactionsPaginated(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.journalEntries, test.cryptoTxPaginatedErr).
+					Times(test.cryptoTxPaginatedTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CryptoAssetResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoAssetResolver{}
+
+	obj := &postgres.CryptoAsset{
+		Ticker:        "ETH",
+		Name:          "Ether",
+		DecimalPlaces: 18,
+		Status:        postgres.CryptoAssetStatusHALTED,
+		MinOrder:      decimal.NewFromFloat(0.0001),
+		MaxOrder:      decimal.NewFromFloat(1000000),
+		UpdatedAt:     pgtype.Timestamptz{},
+	}
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("MinOrder", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.MinOrder(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve minimum order.")
+		require.InDelta(t, obj.MinOrder.InexactFloat64(), result, 0.00001, "minimum order mismatched.")
+	})
+
+	t.Run("MaxOrder", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.MaxOrder(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve maximum order.")
+		require.InDelta(t, obj.MaxOrder.InexactFloat64(), result, 0.01, "maximum order mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve updated at.")
+		require.Equal(t, obj.UpdatedAt.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoAssets(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		assetsErr          error
+		assetsTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/crypto-assets/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			assetsErr:          nil,
+			assetsTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/crypto-assets/deleted-account",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			assetsErr:          nil,
+			assetsTimes:        0,
+		}, {
+			name:               "db failure",
+			path:               "/crypto-assets/db-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetsErr:          errors.New("unknown error"),
+			assetsTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/crypto-assets/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetsErr:          nil,
+			assetsTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGetAll().
+					Return([]postgres.CryptoAsset{testCryptoAsset}, test.assetsErr).
+					Times(test.assetsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testCryptoQuery["cryptoAssets"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CryptoLimitOrderRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		resolver   cryptoLimitOrderRequestResolver
+		input      models.HTTPLimitOrderRequest
+		floatValue = 1234.5678
+		decValue   = decimal.NewFromFloat(floatValue)
+	)
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.Amount(context.TODO(), &input, floatValue)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.Equal(t, decValue, input.Amount, "amounts mismatched.")
+	})
+
+	t.Run("LimitPrice", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.LimitPrice(context.TODO(), &input, floatValue)
+		require.NoError(t, err, "limit price should always return a nil error.")
+		require.Equal(t, decValue, input.LimitPrice, "limit prices mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoLimitOrderResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoLimitOrderResolver{}
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id.")
+
+	obj := &postgres.CryptoLimitOrder{
+		OrderID:      "order-id",
+		ClientID:     clientID,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		IsPurchase:   true,
+		Amount:       decimal.NewFromFloat(1.5),
+		LimitPrice:   decimal.NewFromFloat(25000.25),
+		Status:       postgres.LimitOrderStatusFILLED,
+		TxID:         pgtype.UUID{Bytes: txID, Valid: true},
+		ExpiresAt:    pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve client id.")
+		require.Equal(t, obj.ClientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("FiatCurrency", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FiatCurrency(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve Fiat currency.")
+		require.Equal(t, string(obj.FiatCurrency), result, "Fiat currency mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve amount.")
+		require.InDelta(t, obj.Amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("LimitPrice", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.LimitPrice(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve limit price.")
+		require.InDelta(t, obj.LimitPrice.InexactFloat64(), result, 0.01, "limit price mismatched.")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("TxID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TxID(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve tx id.")
+		require.NotNil(t, result, "tx id not returned.")
+		require.Equal(t, txID.String(), *result, "tx id mismatched.")
+
+		result, err = resolver.TxID(context.TODO(), &postgres.CryptoLimitOrder{})
+		require.NoError(t, err, "failed to resolve empty tx id.")
+		require.Nil(t, result, "empty tx id returned.")
+	})
+
+	t.Run("ExpiresAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ExpiresAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve expires at.")
+		require.Equal(t, obj.ExpiresAt.Time.String(), result, "expires at mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve created at.")
+		require.Equal(t, obj.CreatedAt.Time.String(), result, "created at mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve updated at.")
+		require.Equal(t, obj.UpdatedAt.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoLimitOrderEventResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoLimitOrderEventResolver{}
+
+	obj := &postgres.CryptoLimitOrderEvent{
+		ID:        1,
+		OrderID:   "order-id",
+		Status:    postgres.LimitOrderStatusOPEN,
+		Price:     decimal.NewFromFloat(25000.25),
+		Details:   "order placed",
+		CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("Price", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Price(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve price.")
+		require.InDelta(t, obj.Price.InexactFloat64(), result, 0.01, "price mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve created at.")
+		require.Equal(t, obj.CreatedAt.Time.String(), result, "created at mismatched.")
+	})
+}
+
+func TestCryptoResolver_PlaceLimitOrder(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		assetTimes         int
+		createErr          error
+		createTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/place-limit-order/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["placeLimitOrder"], "USD", "BTC", 1.5, 20000.0, expiresAt, true),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/place-limit-order/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["placeLimitOrder"], "USD", "BTC", 1.5, 20000.0, expiresAt, true),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "expired",
+			path:               "/place-limit-order/expired",
+			query:              fmt.Sprintf(testCryptoQuery["placeLimitOrder"], "USD", "BTC", 1.5, 20000.0, int64(1), true),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "insufficient funds",
+			path:               "/place-limit-order/insufficient-funds",
+			query:              fmt.Sprintf(testCryptoQuery["placeLimitOrder"], "USD", "BTC", 1.5, 20000.0, expiresAt, true),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          postgres.ErrInsufficientFunds,
+			createTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/place-limit-order/valid",
+			query:              fmt.Sprintf(testCryptoQuery["placeLimitOrder"], "USD", "BTC", 1.5, 20000.0, expiresAt, false),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          nil,
+			createTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().LimitOrderCreate(gomock.Any(), gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CancelLimitOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		closeErr           error
+		closeTimes         int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/cancel-limit-order/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["cancelLimitOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "deleted account",
+			path:               "/cancel-limit-order/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["cancelLimitOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "invalid order id",
+			path:               "/cancel-limit-order/invalid-order-id",
+			query:              fmt.Sprintf(testCryptoQuery["cancelLimitOrder"], ""),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "order closed",
+			path:               "/cancel-limit-order/order-closed",
+			query:              fmt.Sprintf(testCryptoQuery["cancelLimitOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			closeErr:           postgres.ErrLimitOrderClosed,
+			closeTimes:         1,
+		}, {
+			name:               "valid",
+			path:               "/cancel-limit-order/valid",
+			query:              fmt.Sprintf(testCryptoQuery["cancelLimitOrder"], "order-id"),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			closeErr:           nil,
+			closeTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().LimitOrderClose(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_LimitOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		getErr             error
+		getTimes           int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/limit-order/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["limitOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			getErr:             nil,
+			getTimes:           0,
+		}, {
+			name:               "deleted account",
+			path:               "/limit-order/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["limitOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			getErr:             nil,
+			getTimes:           0,
+		}, {
+			name:               "not found",
+			path:               "/limit-order/not-found",
+			query:              fmt.Sprintf(testCryptoQuery["limitOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+		}, {
+			name:               "valid",
+			path:               "/limit-order/valid",
+			query:              fmt.Sprintf(testCryptoQuery["limitOrder"], "order-id"),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			getErr:             nil,
+			getTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().LimitOrderGet(gomock.Any(), gomock.Any()).
+					Return(postgres.CryptoLimitOrder{OrderID: "order-id"},
+						[]postgres.CryptoLimitOrderEvent{{OrderID: "order-id"}}, test.getErr).
+					Times(test.getTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_LimitOrders(t *testing.T) {
+	t.Parallel()
+
+	orders := []postgres.CryptoLimitOrder{{OrderID: "order-1"}, {OrderID: "order-2"}, {OrderID: "order-3"}}
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		encryptTimes       int
+		ordersErr          error
+		ordersTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/limit-orders/invalid-jwt",
+			query:              testCryptoQuery["limitOrdersNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/limit-orders/deleted-account",
+			query:              testCryptoQuery["limitOrdersNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			encryptTimes:       0,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "invalid status",
+			path:               "/limit-orders/invalid-status",
+			query:              fmt.Sprintf(testCryptoQuery["limitOrders"], "INVALID", "", 2),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "db failure",
+			path:               "/limit-orders/db-failure",
+			query:              testCryptoQuery["limitOrdersNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			ordersErr:          postgres.ErrTransactCrypto,
+			ordersTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/limit-orders/valid",
+			query:              fmt.Sprintf(testCryptoQuery["limitOrders"], "OPEN", "", 2),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       1,
+			ordersErr:          nil,
+			ordersTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().LimitOrdersPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(orders, test.ordersErr).
+					Times(test.ordersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CryptoTriggerOrderRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		resolver   cryptoTriggerOrderRequestResolver
+		floatValue = 1234.56
+		decValue   = decimal.NewFromFloat(floatValue)
+	)
+
+	t.Run("TriggerPrice", func(t *testing.T) {
+		t.Parallel()
+
+		var input models.HTTPTriggerOrderRequest
+
+		err := resolver.TriggerPrice(context.TODO(), &input, floatValue)
+		require.NoError(t, err, "trigger price should always return a nil error.")
+		require.Equal(t, decValue, input.TriggerPrice, "trigger prices mismatched.")
+	})
+
+	t.Run("TrailingDistance", func(t *testing.T) {
+		t.Parallel()
+
+		var input models.HTTPTriggerOrderRequest
+
+		err := resolver.TrailingDistance(context.TODO(), &input, nil)
+		require.NoError(t, err, "trailing distance should always return a nil error.")
+		require.True(t, input.TrailingDistance.IsZero(), "omitted trailing distance set.")
+
+		err = resolver.TrailingDistance(context.TODO(), &input, &floatValue)
+		require.NoError(t, err, "trailing distance should always return a nil error.")
+		require.Equal(t, decValue, input.TrailingDistance, "trailing distances mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		var input models.HTTPTriggerOrderRequest
+
+		err := resolver.Amount(context.TODO(), &input, nil)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.True(t, input.Amount.IsZero(), "omitted amount set.")
+
+		err = resolver.Amount(context.TODO(), &input, &floatValue)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.Equal(t, decValue, input.Amount, "amounts mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoTriggerOrderResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoTriggerOrderResolver{}
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id.")
+
+	obj := &postgres.CryptoTriggerOrder{
+		OrderID:          "order-id",
+		ClientID:         clientID,
+		FiatCurrency:     "USD",
+		Ticker:           "BTC",
+		TriggerType:      postgres.TriggerOrderTypeSTOPLOSS,
+		TriggerPrice:     decimal.NewFromFloat(25000.25),
+		TrailingDistance: decimal.NewFromFloat(1500),
+		Amount:           decimal.NewFromFloat(1.5),
+		Status:           postgres.TriggerOrderStatusTRIGGERED,
+		TxID:             pgtype.UUID{Bytes: txID, Valid: true},
+		CreatedAt:        pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		UpdatedAt:        pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve client id.")
+		require.Equal(t, obj.ClientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("FiatCurrency", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FiatCurrency(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve Fiat currency.")
+		require.Equal(t, string(obj.FiatCurrency), result, "Fiat currency mismatched.")
+	})
+
+	t.Run("TriggerType", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TriggerType(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve trigger type.")
+		require.Equal(t, string(obj.TriggerType), result, "trigger type mismatched.")
+	})
+
+	t.Run("TriggerPrice", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TriggerPrice(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve trigger price.")
+		require.InDelta(t, obj.TriggerPrice.InexactFloat64(), result, 0.01, "trigger price mismatched.")
+	})
+
+	t.Run("TrailingDistance", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TrailingDistance(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve trailing distance.")
+		require.InDelta(t, obj.TrailingDistance.InexactFloat64(), result, 0.01, "trailing distance mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve amount.")
+		require.InDelta(t, obj.Amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("TxID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TxID(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve tx id.")
+		require.NotNil(t, result, "tx id not returned.")
+		require.Equal(t, txID.String(), *result, "tx id mismatched.")
+
+		result, err = resolver.TxID(context.TODO(), &postgres.CryptoTriggerOrder{})
+		require.NoError(t, err, "failed to resolve empty tx id.")
+		require.Nil(t, result, "empty tx id returned.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve created at.")
+		require.Equal(t, obj.CreatedAt.Time.String(), result, "created at mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve updated at.")
+		require.Equal(t, obj.UpdatedAt.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoTriggerOrderEventResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoTriggerOrderEventResolver{}
+
+	obj := &postgres.CryptoTriggerOrderEvent{
+		ID:        1,
+		OrderID:   "order-id",
+		Status:    postgres.TriggerOrderStatusTRIGGERED,
+		Price:     decimal.NewFromFloat(24850.43),
+		Amount:    decimal.NewFromFloat(1.5),
+		Details:   "stop-loss triggered",
+		CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("Price", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Price(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve price.")
+		require.InDelta(t, obj.Price.InexactFloat64(), result, 0.01, "price mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve amount.")
+		require.InDelta(t, obj.Amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve created at.")
+		require.Equal(t, obj.CreatedAt.Time.String(), result, "created at mismatched.")
+	})
+}
+
+func TestCryptoResolver_PlaceTriggerOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		assetTimes         int
+		createErr          error
+		createTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/place-trigger-order/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["placeTriggerOrder"], "USD", "BTC", "STOP_LOSS", 20000.0, 0.0, 1.5),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/place-trigger-order/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["placeTriggerOrder"], "USD", "BTC", "STOP_LOSS", 20000.0, 0.0, 1.5),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "invalid trigger type",
+			path:               "/place-trigger-order/invalid-trigger-type",
+			query:              fmt.Sprintf(testCryptoQuery["placeTriggerOrder"], "USD", "BTC", "STOP", 20000.0, 0.0, 1.5),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "trailing stop-loss db failure",
+			path:               "/place-trigger-order/trailing-stop-loss-db-failure",
+			query:              fmt.Sprintf(testCryptoQuery["placeTriggerOrder"], "USD", "BTC", "STOP_LOSS", 20000.0, 1500.0, 1.5),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          postgres.ErrTransactCrypto,
+			createTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/place-trigger-order/valid",
+			query:              fmt.Sprintf(testCryptoQuery["placeTriggerOrder"], "USD", "BTC", "TAKE_PROFIT", 30000.0, 0.0, 0.0),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          nil,
+			createTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().TriggerOrderCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CancelTriggerOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		closeErr           error
+		closeTimes         int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/cancel-trigger-order/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["cancelTriggerOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "deleted account",
+			path:               "/cancel-trigger-order/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["cancelTriggerOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "invalid order id",
+			path:               "/cancel-trigger-order/invalid-order-id",
+			query:              fmt.Sprintf(testCryptoQuery["cancelTriggerOrder"], ""),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "order closed",
+			path:               "/cancel-trigger-order/order-closed",
+			query:              fmt.Sprintf(testCryptoQuery["cancelTriggerOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			closeErr:           postgres.ErrTriggerOrderClosed,
+			closeTimes:         1,
+		}, {
+			name:               "valid",
+			path:               "/cancel-trigger-order/valid",
+			query:              fmt.Sprintf(testCryptoQuery["cancelTriggerOrder"], "order-id"),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			closeErr:           nil,
+			closeTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().TriggerOrderClose(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_TriggerOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		getErr             error
+		getTimes           int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/trigger-order/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["triggerOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			getErr:             nil,
+			getTimes:           0,
+		}, {
+			name:               "deleted account",
+			path:               "/trigger-order/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["triggerOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			getErr:             nil,
+			getTimes:           0,
+		}, {
+			name:               "not found",
+			path:               "/trigger-order/not-found",
+			query:              fmt.Sprintf(testCryptoQuery["triggerOrder"], "order-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+		}, {
+			name:               "valid",
+			path:               "/trigger-order/valid",
+			query:              fmt.Sprintf(testCryptoQuery["triggerOrder"], "order-id"),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			getErr:             nil,
+			getTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().TriggerOrderGet(gomock.Any(), gomock.Any()).
+					Return(postgres.CryptoTriggerOrder{OrderID: "order-id"},
+						[]postgres.CryptoTriggerOrderEvent{{OrderID: "order-id"}}, test.getErr).
+					Times(test.getTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_TriggerOrders(t *testing.T) {
+	t.Parallel()
+
+	orders := []postgres.CryptoTriggerOrder{{OrderID: "order-1"}, {OrderID: "order-2"}, {OrderID: "order-3"}}
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		encryptTimes       int
+		ordersErr          error
+		ordersTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/trigger-orders/invalid-jwt",
+			query:              testCryptoQuery["triggerOrdersNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/trigger-orders/deleted-account",
+			query:              testCryptoQuery["triggerOrdersNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			encryptTimes:       0,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "invalid status",
+			path:               "/trigger-orders/invalid-status",
+			query:              fmt.Sprintf(testCryptoQuery["triggerOrders"], "INVALID", "", 2),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "db failure",
+			path:               "/trigger-orders/db-failure",
+			query:              testCryptoQuery["triggerOrdersNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			ordersErr:          postgres.ErrTransactCrypto,
+			ordersTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/trigger-orders/valid",
+			query:              fmt.Sprintf(testCryptoQuery["triggerOrders"], "ACTIVE", "", 2),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       1,
+			ordersErr:          nil,
+			ordersTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().TriggerOrdersPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(orders, test.ordersErr).
+					Times(test.ordersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CryptoRecurringPurchaseRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		resolver   cryptoRecurringPurchaseRequestResolver
+		input      models.HTTPRecurringPurchaseRequest
+		floatValue = 1234.56
+	)
+
+	err := resolver.Amount(context.TODO(), &input, floatValue)
+	require.NoError(t, err, "amount should always return a nil error.")
+	require.Equal(t, decimal.NewFromFloat(floatValue), input.Amount, "amounts mismatched.")
+}
+
+func TestCryptoResolver_CryptoRecurringPurchaseResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoRecurringPurchaseResolver{}
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	obj := &postgres.CryptoRecurringPurchase{
+		PlanID:       "plan-id",
+		ClientID:     clientID,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		Amount:       decimal.NewFromFloat(50),
+		Cadence:      postgres.RecurringPurchaseCadenceWEEKLY,
+		StartsAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		EndsAt:       pgtype.Timestamptz{Time: time.Now().AddDate(1, 0, 0), Valid: true},
+		NextRunAt:    pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, 7), Valid: true},
+		Status:       postgres.RecurringPurchaseStatusACTIVE,
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve client id.")
+		require.Equal(t, obj.ClientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("FiatCurrency", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FiatCurrency(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve Fiat currency.")
+		require.Equal(t, string(obj.FiatCurrency), result, "Fiat currency mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve amount.")
+		require.InDelta(t, obj.Amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("Cadence", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Cadence(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve cadence.")
+		require.Equal(t, string(obj.Cadence), result, "cadence mismatched.")
+	})
+
+	t.Run("StartsAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.StartsAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve starts at.")
+		require.Equal(t, obj.StartsAt.Time.String(), result, "starts at mismatched.")
+	})
+
+	t.Run("EndsAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.EndsAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve ends at.")
+		require.NotNil(t, result, "ends at not returned.")
+		require.Equal(t, obj.EndsAt.Time.String(), *result, "ends at mismatched.")
+
+		result, err = resolver.EndsAt(context.TODO(), &postgres.CryptoRecurringPurchase{})
+		require.NoError(t, err, "failed to resolve empty ends at.")
+		require.Nil(t, result, "empty ends at returned.")
+	})
+
+	t.Run("NextRunAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.NextRunAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve next run at.")
+		require.Equal(t, obj.NextRunAt.Time.String(), result, "next run at mismatched.")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve created at.")
+		require.Equal(t, obj.CreatedAt.Time.String(), result, "created at mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve updated at.")
+		require.Equal(t, obj.UpdatedAt.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoRecurringPurchaseRunResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoRecurringPurchaseRunResolver{}
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id.")
+
+	obj := &postgres.CryptoRecurringPurchaseRun{
+		ID:           1,
+		PlanID:       "plan-id",
+		ScheduledAt:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		Status:       postgres.RecurringPurchaseRunStatusEXECUTED,
+		Price:        decimal.NewFromFloat(25833.21),
+		FiatAmount:   decimal.NewFromFloat(50),
+		CryptoAmount: decimal.NewFromFloat(0.00193549),
+		TxID:         pgtype.UUID{Bytes: txID, Valid: true},
+		Details:      "purchase executed",
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("ScheduledAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ScheduledAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve scheduled at.")
+		require.Equal(t, obj.ScheduledAt.Time.String(), result, "scheduled at mismatched.")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("Price", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Price(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve price.")
+		require.InDelta(t, obj.Price.InexactFloat64(), result, 0.01, "price mismatched.")
+	})
+
+	t.Run("FiatAmount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FiatAmount(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve Fiat amount.")
+		require.InDelta(t, obj.FiatAmount.InexactFloat64(), result, 0.01, "Fiat amount mismatched.")
+	})
+
+	t.Run("CryptoAmount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CryptoAmount(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve Crypto amount.")
+		require.InDelta(t, obj.CryptoAmount.InexactFloat64(), result, 0.00000001, "Crypto amount mismatched.")
+	})
+
+	t.Run("TxID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TxID(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve tx id.")
+		require.NotNil(t, result, "tx id not returned.")
+		require.Equal(t, txID.String(), *result, "tx id mismatched.")
+
+		result, err = resolver.TxID(context.TODO(), &postgres.CryptoRecurringPurchaseRun{})
+		require.NoError(t, err, "failed to resolve empty tx id.")
+		require.Nil(t, result, "empty tx id returned.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve created at.")
+		require.Equal(t, obj.CreatedAt.Time.String(), result, "created at mismatched.")
+	})
+}
+
+func TestCryptoResolver_ScheduleRecurringPurchase(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		assetTimes         int
+		createErr          error
+		createTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/schedule-recurring-purchase/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["scheduleRecurringPurchaseNoSchedule"], "USD", "BTC", 50.0, "WEEKLY"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/schedule-recurring-purchase/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["scheduleRecurringPurchaseNoSchedule"], "USD", "BTC", 50.0, "WEEKLY"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "invalid cadence",
+			path:               "/schedule-recurring-purchase/invalid-cadence",
+			query:              fmt.Sprintf(testCryptoQuery["scheduleRecurringPurchaseNoSchedule"], "USD", "BTC", 50.0, "HOURLY"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "end before start",
+			path:               "/schedule-recurring-purchase/end-before-start",
+			query:              fmt.Sprintf(testCryptoQuery["scheduleRecurringPurchase"], "USD", "BTC", 50.0, "WEEKLY", startsAt, startsAt-60),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name:               "db failure",
+			path:               "/schedule-recurring-purchase/db-failure",
+			query:              fmt.Sprintf(testCryptoQuery["scheduleRecurringPurchaseNoSchedule"], "USD", "BTC", 50.0, "DAILY"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          postgres.ErrTransactCrypto,
+			createTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/schedule-recurring-purchase/valid",
+			query:              fmt.Sprintf(testCryptoQuery["scheduleRecurringPurchase"], "USD", "BTC", 50.0, "MONTHLY", startsAt, startsAt+365*24*60*60),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			assetTimes:         1,
+			createErr:          nil,
+			createTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().RecurringPurchaseCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CancelRecurringPurchase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		cancelErr          error
+		cancelTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/cancel-recurring-purchase/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["cancelRecurringPurchase"], "plan-id"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			cancelErr:          nil,
+			cancelTimes:        0,
+		}, {
+			name:               "deleted account",
+			path:               "/cancel-recurring-purchase/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["cancelRecurringPurchase"], "plan-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			cancelErr:          nil,
+			cancelTimes:        0,
+		}, {
+			name:               "invalid plan id",
+			path:               "/cancel-recurring-purchase/invalid-plan-id",
+			query:              fmt.Sprintf(testCryptoQuery["cancelRecurringPurchase"], ""),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			cancelErr:          nil,
+			cancelTimes:        0,
+		}, {
+			name:               "plan closed",
+			path:               "/cancel-recurring-purchase/plan-closed",
+			query:              fmt.Sprintf(testCryptoQuery["cancelRecurringPurchase"], "plan-id"),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			cancelErr:          postgres.ErrRecurringClosed,
+			cancelTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/cancel-recurring-purchase/valid",
+			query:              fmt.Sprintf(testCryptoQuery["cancelRecurringPurchase"], "plan-id"),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			cancelErr:          nil,
+			cancelTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().RecurringPurchaseCancel(gomock.Any(), gomock.Any()).
+					Return(test.cancelErr).
+					Times(test.cancelTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_RecurringPurchase(t *testing.T) {
+	t.Parallel()
+
+	runs := []postgres.CryptoRecurringPurchaseRun{{ID: 3, PlanID: "plan-id"}, {ID: 2, PlanID: "plan-id"},
+		{ID: 1, PlanID: "plan-id"}}
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		getErr             error
+		getTimes           int
+		runsTimes          int
+		encryptTimes       int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/recurring-purchase/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["recurringPurchase"], "plan-id", "", 2),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			getErr:             nil,
+			getTimes:           0,
+			runsTimes:          0,
+			encryptTimes:       0,
+		}, {
+			name:               "deleted account",
+			path:               "/recurring-purchase/deleted-account",
+			query:              fmt.Sprintf(testCryptoQuery["recurringPurchase"], "plan-id", "", 2),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			getErr:             nil,
+			getTimes:           0,
+			runsTimes:          0,
+			encryptTimes:       0,
+		}, {
+			name:               "not found",
+			path:               "/recurring-purchase/not-found",
+			query:              fmt.Sprintf(testCryptoQuery["recurringPurchase"], "plan-id", "", 2),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+			runsTimes:          0,
+			encryptTimes:       0,
+		}, {
+			name:               "valid",
+			path:               "/recurring-purchase/valid",
+			query:              fmt.Sprintf(testCryptoQuery["recurringPurchase"], "plan-id", "", 2),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			getErr:             nil,
+			getTimes:           1,
+			runsTimes:          1,
+			encryptTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
 			// Mock configurations.
@@ -1282,31 +4515,38 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
-					Return(clientID, int64(0), test.authValidateJWTErr).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
 					Times(test.isDeletedTimes),
 
-				mockPostgres.EXPECT().FiatTxDetails(gomock.Any(), gomock.Any()).
-					Return([]postgres.FiatJournal{{}}, test.fiatTxDetailsErr).
-					Times(test.fiatTxDetailsTimes),
+				mockPostgres.EXPECT().RecurringPurchaseGet(gomock.Any(), gomock.Any()).
+					Return(postgres.CryptoRecurringPurchase{PlanID: "plan-id"}, test.getErr).
+					Times(test.getTimes),
 
-				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
-					Return([]postgres.CryptoJournal{{}}, test.cryptoTxDetailsErr).
-					Times(test.cryptoTxDetailsTimes),
+				mockPostgres.EXPECT().RecurringPurchaseRunsPaginated(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).
+					Return(runs, nil).
+					Times(test.runsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-cursor", nil).
+					Times(test.encryptTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1329,194 +4569,88 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 	}
 }
 
-func TestFiatResolver_CryptoTransactionsPaginatedResolver(t *testing.T) {
-	t.Parallel()
-
-	resolver := cryptoTransactionsPaginatedResolver{}
-
-	transactions := &models.HTTPCryptoTransactionsPaginated{}
-
-	actual, err := resolver.Transactions(context.TODO(), transactions)
-	require.NoError(t, err, "error should always be nil.")
-	require.Equal(t, transactions.TransactionDetails, actual, "actual and returned addresses do not match.")
-}
-
-func TestCryptoResolver_TransactionDetailsAllCrypto(t *testing.T) {
+func TestCryptoResolver_RecurringPurchases(t *testing.T) {
 	t.Parallel()
 
-	decryptedCursor := fmt.Sprintf("%s,%s,%d",
-		fmt.Sprintf(constants.MonthFormatString(), 2023, 6, "-04:00"),
-		fmt.Sprintf(constants.MonthFormatString(), 2023, 7, "-04:00"),
-		10)
-
-	journalEntries := []postgres.CryptoJournal{{}, {}, {}, {}}
+	plans := []postgres.CryptoRecurringPurchase{{PlanID: "plan-1"}, {PlanID: "plan-2"}, {PlanID: "plan-3"}}
 
 	testCases := []struct {
-		name                   string
-		path                   string
-		query                  string
-		expectErr              bool
-		journalEntries         []postgres.CryptoJournal
-		authValidateJWTErr     error
-		authValidateJWTTimes   int
-		isDeletedError         error
-		isDeletedTimes         int
-		isDeletedValue         bool
-		authDecryptCursorErr   error
-		authDecryptCursorTimes int
-		authEncryptCursorErr   error
-		authEncryptCursorTimes int
-		cryptoTxPaginatedErr   error
-		cryptoTxPaginatedTimes int
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		encryptTimes       int
+		plansErr           error
+		plansTimes         int
 	}{
 		{
-			name: "auth failure",
-			path: "/transaction-details-all-crypto/auth-failure",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"USD", 3, "page-cusror"),
-			expectErr:              true,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     errors.New("auth failure"),
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         0,
-			isDeletedValue:         false,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 0,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 0,
-			cryptoTxPaginatedErr:   nil,
-			cryptoTxPaginatedTimes: 0,
-		}, {
-			name: "deleted account",
-			path: "/transaction-details-all-crypto/deleted-account",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"USD", 3, "page-cusror"),
-			expectErr:              true,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     nil,
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         true,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 0,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 0,
-			cryptoTxPaginatedErr:   nil,
-			cryptoTxPaginatedTimes: 0,
-		}, {
-			name: "no cursor or params",
-			path: "/transaction-details-all-crypto/no-cursor-or-params",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"", 3, ""),
-			expectErr:              true,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     nil,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         false,
-			authValidateJWTTimes:   1,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 0,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 0,
-			cryptoTxPaginatedErr:   nil,
-			cryptoTxPaginatedTimes: 0,
-		}, {
-			name: "db failure",
-			path: "/transaction-details-all-crypto/db-failure",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"BTC", 3, "page-cusror"),
-			expectErr:              true,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     nil,
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         false,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 1,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 1,
-			cryptoTxPaginatedErr:   postgres.ErrNotFound,
-			cryptoTxPaginatedTimes: 1,
+			name:               "invalid jwt",
+			path:               "/recurring-purchases/invalid-jwt",
+			query:              testCryptoQuery["recurringPurchasesNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			plansErr:           nil,
+			plansTimes:         0,
 		}, {
-			name: "unknown db failure",
-			path: "/transaction-details-all-crypto/unknown-db-failure",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"BTC", 3, "page-cusror"),
-			expectErr:              true,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     nil,
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         false,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 1,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 1,
-			cryptoTxPaginatedErr:   errors.New("db failure"),
-			cryptoTxPaginatedTimes: 1,
+			name:               "deleted account",
+			path:               "/recurring-purchases/deleted-account",
+			query:              testCryptoQuery["recurringPurchasesNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			encryptTimes:       0,
+			plansErr:           nil,
+			plansTimes:         0,
 		}, {
-			name: "no transactions",
-			path: "/transaction-details-all-crypto/no-transactions",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"BTC", 3, "page-cusror"),
-			expectErr:              false,
-			journalEntries:         []postgres.CryptoJournal{},
-			authValidateJWTErr:     nil,
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         false,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 1,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 1,
-			cryptoTxPaginatedErr:   nil,
-			cryptoTxPaginatedTimes: 1,
+			name:               "invalid status",
+			path:               "/recurring-purchases/invalid-status",
+			query:              fmt.Sprintf(testCryptoQuery["recurringPurchases"], "INVALID", "", 2),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			plansErr:           nil,
+			plansTimes:         0,
 		}, {
-			name: "valid with cursor",
-			path: "/transaction-details-all-crypto/valid-with-cursor",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoSubsequent"],
-				"BTC", 3, "page-cusror"),
-			expectErr:              false,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     nil,
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         false,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 1,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 1,
-			cryptoTxPaginatedErr:   nil,
-			cryptoTxPaginatedTimes: 1,
+			name:               "db failure",
+			path:               "/recurring-purchases/db-failure",
+			query:              testCryptoQuery["recurringPurchasesNoParams"],
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       0,
+			plansErr:           postgres.ErrTransactCrypto,
+			plansTimes:         1,
 		}, {
-			name: "valid with query",
-			path: "/transaction-details-all-crypto/valid-with-query",
-			query: fmt.Sprintf(testCryptoQuery["transactionDetailsAllCryptoInit"],
-				"BTC", 3, "-04:00", 6, 2023),
-			expectErr:              false,
-			journalEntries:         journalEntries,
-			authValidateJWTErr:     nil,
-			authValidateJWTTimes:   1,
-			isDeletedError:         nil,
-			isDeletedTimes:         1,
-			isDeletedValue:         false,
-			authDecryptCursorErr:   nil,
-			authDecryptCursorTimes: 0,
-			authEncryptCursorErr:   nil,
-			authEncryptCursorTimes: 1,
-			cryptoTxPaginatedErr:   nil,
-			cryptoTxPaginatedTimes: 1,
+			name:               "valid",
+			path:               "/recurring-purchases/valid",
+			query:              fmt.Sprintf(testCryptoQuery["recurringPurchases"], "ACTIVE", "", 2),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			encryptTimes:       1,
+			plansErr:           nil,
+			plansTimes:         1,
 		},
 	}
 
-	for _, testCase := range testCases { //nolint:dupl
+	for _, testCase := range testCases {
 		test := testCase
 
 		t.Run(test.name, func(t *testing.T) {
@@ -1527,36 +4661,34 @@ func TestCryptoResolver_TransactionDetailsAllCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
-					Times(test.authValidateJWTTimes),
+					Times(test.authValidateTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
 					Times(test.isDeletedTimes),
 
-				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
-					Return([]byte(decryptedCursor), test.authDecryptCursorErr).
-					Times(test.authDecryptCursorTimes),
+				mockPostgres.EXPECT().RecurringPurchasesPaginated(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).
+					Return(plans, test.plansErr).
+					Times(test.plansTimes),
 
 				mockAuth.EXPECT().EncryptToString(gomock.Any()).
-					Return("encrypted-cursor", test.authEncryptCursorErr).
-					Times(test.authEncryptCursorTimes),
-
-				mockPostgres.EXPECT().CryptoTransactionsPaginated(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(test.journalEntries, test.cryptoTxPaginatedErr).
-					Times(test.cryptoTxPaginatedTimes),
+					Return("encrypted-cursor", nil).
+					Times(test.encryptTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
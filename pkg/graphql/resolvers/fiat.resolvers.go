@@ -13,41 +13,97 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/models"
 	"github.com/surahman/FTeX/pkg/postgres"
 )
 
 // Currency is the resolver for the currency field.
-func (r *fiatAccountResolver) Currency(ctx context.Context, obj *postgres.FiatAccount) (string, error) {
+func (r *fiatAccountResolver) Currency(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error) {
 	return string(obj.Currency), nil
 }
 
 // Balance is the resolver for the balance field.
-func (r *fiatAccountResolver) Balance(ctx context.Context, obj *postgres.FiatAccount) (float64, error) {
+func (r *fiatAccountResolver) Balance(ctx context.Context, obj *postgres.FiatAccountBalance) (float64, error) {
 	return obj.Balance.InexactFloat64(), nil
 }
 
+// Available is the resolver for the available field.
+func (r *fiatAccountResolver) Available(ctx context.Context, obj *postgres.FiatAccountBalance) (float64, error) {
+	return obj.Available.InexactFloat64(), nil
+}
+
 // LastTx is the resolver for the lastTx field.
-func (r *fiatAccountResolver) LastTx(ctx context.Context, obj *postgres.FiatAccount) (float64, error) {
+func (r *fiatAccountResolver) LastTx(ctx context.Context, obj *postgres.FiatAccountBalance) (float64, error) {
 	return obj.LastTx.InexactFloat64(), nil
 }
 
 // LastTxTs is the resolver for the lastTxTs field.
-func (r *fiatAccountResolver) LastTxTs(ctx context.Context, obj *postgres.FiatAccount) (string, error) {
+func (r *fiatAccountResolver) LastTxTs(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error) {
 	return obj.LastTxTs.Time.String(), nil
 }
 
 // CreatedAt is the resolver for the createdAt field.
-func (r *fiatAccountResolver) CreatedAt(ctx context.Context, obj *postgres.FiatAccount) (string, error) {
+func (r *fiatAccountResolver) CreatedAt(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error) {
 	return obj.CreatedAt.Time.String(), nil
 }
 
 // ClientID is the resolver for the clientID field.
-func (r *fiatAccountResolver) ClientID(ctx context.Context, obj *postgres.FiatAccount) (string, error) {
+func (r *fiatAccountResolver) ClientID(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *fiatAccountResolver) Status(ctx context.Context, obj *postgres.FiatAccountBalance) (string, error) {
+	return string(obj.Status), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *fiatBalanceSnapshotResolver) ClientID(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error) {
 	return obj.ClientID.String(), nil
 }
 
+// Currency is the resolver for the currency field.
+func (r *fiatBalanceSnapshotResolver) Currency(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error) {
+	return string(obj.Currency), nil
+}
+
+// SnapshotAt is the resolver for the snapshotAt field.
+func (r *fiatBalanceSnapshotResolver) SnapshotAt(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error) {
+	return obj.SnapshotAt.Time.String(), nil
+}
+
+// Balance is the resolver for the balance field.
+func (r *fiatBalanceSnapshotResolver) Balance(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (float64, error) {
+	return obj.Balance.InexactFloat64(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *fiatBalanceSnapshotResolver) CreatedAt(ctx context.Context, obj *postgres.FiatBalanceSnapshot) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// SourceReceipt is the resolver for the sourceReceipt field.
+func (r *fiatCloseAccountResponseResolver) SourceReceipt(ctx context.Context, obj *models.HTTPFiatTransferResponse) (*postgres.FiatAccountTransferResult, error) {
+	return obj.SrcTxReceipt, nil
+}
+
+// DestinationReceipt is the resolver for the destinationReceipt field.
+func (r *fiatCloseAccountResponseResolver) DestinationReceipt(ctx context.Context, obj *models.HTTPFiatTransferResponse) (*postgres.FiatAccountTransferResult, error) {
+	return obj.DstTxReceipt, nil
+}
+
+// Status is the resolver for the status field.
+func (r *fiatCurrencyResolver) Status(ctx context.Context, obj *postgres.FiatCurrency) (string, error) {
+	return string(obj.Status), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *fiatCurrencyResolver) UpdatedAt(ctx context.Context, obj *postgres.FiatCurrency) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
 // TxID is the resolver for the txId field.
 func (r *fiatDepositResponseResolver) TxID(ctx context.Context, obj *postgres.FiatAccountTransferResult) (string, error) {
 	return obj.TxID.String(), nil
@@ -126,7 +182,8 @@ func (r *mutationResolver) OpenFiat(ctx context.Context, currency string) (*mode
 		err        error
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTransfer()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -144,14 +201,21 @@ func (r *mutationResolver) DepositFiat(ctx context.Context, input models.HTTPDep
 		err             error
 		httpMessage     string
 		transferReceipt *postgres.FiatAccountTransferResult
+		device          string
+		ipAddress       string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTransfer()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
 	if transferReceipt, _, httpMessage, _, err =
-		common.HTTPFiatDeposit(r.db, r.logger, clientID, &input); err != nil {
+		common.HTTPFiatDeposit(r.db, r.logger, clientID, &input, device, ipAddress); err != nil {
 
 		return nil, errors.New(httpMessage)
 	}
@@ -169,12 +233,13 @@ func (r *mutationResolver) ExchangeOfferFiat(ctx context.Context, input models.H
 		offer       *models.HTTPExchangeOfferResponse
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
 	if offer, _, httpMessage, payload, err =
-		common.HTTPFiatOffer(r.auth, r.cache, r.logger, r.quotes, clientID, &input); err != nil {
+		common.HTTPFiatOffer(r.auth, r.cache, r.db, r.logger, r.quotes, clientID, &input); err != nil {
 
 		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
 	}
@@ -190,14 +255,50 @@ func (r *mutationResolver) ExchangeTransferFiat(ctx context.Context, offerID str
 		httpMessage string
 		payload     any
 		receipt     *models.HTTPFiatTransferResponse
+		device      string
+		ipAddress   string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTrade()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
 	if receipt, _, httpMessage, payload, err = common.HTTPFiatTransfer(r.auth, r.cache, r.db, r.logger,
-		clientID, &models.HTTPTransferRequest{OfferID: offerID}); err != nil {
+		clientID, &models.HTTPTransferRequest{OfferID: offerID}, device, ipAddress); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return receipt, nil
+}
+
+// CloseFiat is the resolver for the closeFiat field.
+func (r *mutationResolver) CloseFiat(ctx context.Context, input models.HTTPCloseFiatAccountRequest) (*models.HTTPFiatTransferResponse, error) {
+	var (
+		err         error
+		clientID    uuid.UUID
+		httpMessage string
+		payload     any
+		receipt     *models.HTTPFiatTransferResponse
+		device      string
+		ipAddress   string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeTransfer()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if receipt, _, httpMessage, payload, err =
+		common.HTTPFiatClose(r.db, r.logger, r.quotes, clientID, &input, device, ipAddress); err != nil {
 		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
 	}
 
@@ -205,16 +306,17 @@ func (r *mutationResolver) ExchangeTransferFiat(ctx context.Context, offerID str
 }
 
 // BalanceFiat is the resolver for the balanceFiat field.
-func (r *queryResolver) BalanceFiat(ctx context.Context, currencyCode string) (*postgres.FiatAccount, error) {
+func (r *queryResolver) BalanceFiat(ctx context.Context, currencyCode string) (*postgres.FiatAccountBalance, error) {
 	var (
-		accDetails  *postgres.FiatAccount
+		accDetails  *postgres.FiatAccountBalance
 		clientID    uuid.UUID
 		err         error
 		httpMessage string
 		payload     any
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -243,7 +345,8 @@ func (r *queryResolver) BalanceAllFiat(ctx context.Context, pageCursor *string,
 		pageCursor = new(string)
 	}
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -255,6 +358,62 @@ func (r *queryResolver) BalanceAllFiat(ctx context.Context, pageCursor *string,
 	return accDetails, nil
 }
 
+// BalanceAsOfFiat is the resolver for the balanceAsOfFiat field.
+func (r *queryResolver) BalanceAsOfFiat(ctx context.Context, currencyCode string, timestamp int64) (*postgres.BalanceAsOf, error) {
+	var (
+		balance     *postgres.BalanceAsOf
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if balance, _, httpMessage, payload, err = common.HTTPFiatBalanceAsOf(
+		r.db, r.logger, clientID, currencyCode, strconv.FormatInt(timestamp, 10)); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return balance, nil
+}
+
+// BalanceHistoryFiat is the resolver for the balanceHistoryFiat field.
+func (r *queryResolver) BalanceHistoryFiat(ctx context.Context, currencyCode string, from *int64, to *int64) (*models.HTTPFiatBalanceHistory, error) {
+	var (
+		history     *models.HTTPFiatBalanceHistory
+		clientID    uuid.UUID
+		err         error
+		fromStr     string
+		toStr       string
+		httpMessage string
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if from != nil {
+		fromStr = strconv.FormatInt(*from, 10)
+	}
+
+	if to != nil {
+		toStr = strconv.FormatInt(*to, 10)
+	}
+
+	if history, _, httpMessage, payload, err = common.HTTPFiatBalanceHistory(
+		r.db, r.logger, clientID, currencyCode, fromStr, toStr); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return history, nil
+}
+
 // TransactionDetailsFiat is the resolver for the transactionDetailsFiat field.
 func (r *queryResolver) TransactionDetailsFiat(ctx context.Context, transactionID string) ([]interface{}, error) {
 	var (
@@ -264,7 +423,8 @@ func (r *queryResolver) TransactionDetailsFiat(ctx context.Context, transactionI
 		httpMessage    string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -311,7 +471,8 @@ func (r *queryResolver) TransactionDetailsAllFiat(ctx context.Context, input mod
 	}
 	params.YearStr = *input.Year
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -324,6 +485,26 @@ func (r *queryResolver) TransactionDetailsAllFiat(ctx context.Context, input mod
 	return journalEntries, nil
 }
 
+// FiatCurrencies is the resolver for the fiatCurrencies field.
+func (r *queryResolver) FiatCurrencies(ctx context.Context) ([]postgres.FiatCurrency, error) {
+	var (
+		records     []postgres.FiatCurrency
+		err         error
+		httpMessage string
+	)
+
+	if _, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey,
+		constants.APIKeyScopeRead()); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if records, _, httpMessage, err = common.HTTPFiatCurrencies(r.db, r.logger); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return records, nil
+}
+
 // Amount is the resolver for the amount field.
 func (r *fiatDepositRequestResolver) Amount(ctx context.Context, obj *models.HTTPDepositCurrencyRequest, data float64) error {
 	obj.Amount = decimal.NewFromFloat(data)
@@ -343,6 +524,21 @@ func (r *Resolver) FiatAccount() graphql_generated.FiatAccountResolver {
 	return &fiatAccountResolver{r}
 }
 
+// FiatBalanceSnapshot returns graphql_generated.FiatBalanceSnapshotResolver implementation.
+func (r *Resolver) FiatBalanceSnapshot() graphql_generated.FiatBalanceSnapshotResolver {
+	return &fiatBalanceSnapshotResolver{r}
+}
+
+// FiatCloseAccountResponse returns graphql_generated.FiatCloseAccountResponseResolver implementation.
+func (r *Resolver) FiatCloseAccountResponse() graphql_generated.FiatCloseAccountResponseResolver {
+	return &fiatCloseAccountResponseResolver{r}
+}
+
+// FiatCurrency returns graphql_generated.FiatCurrencyResolver implementation.
+func (r *Resolver) FiatCurrency() graphql_generated.FiatCurrencyResolver {
+	return &fiatCurrencyResolver{r}
+}
+
 // FiatDepositResponse returns graphql_generated.FiatDepositResponseResolver implementation.
 func (r *Resolver) FiatDepositResponse() graphql_generated.FiatDepositResponseResolver {
 	return &fiatDepositResponseResolver{r}
@@ -374,6 +570,9 @@ func (r *Resolver) FiatExchangeOfferRequest() graphql_generated.FiatExchangeOffe
 }
 
 type fiatAccountResolver struct{ *Resolver }
+type fiatBalanceSnapshotResolver struct{ *Resolver }
+type fiatCloseAccountResponseResolver struct{ *Resolver }
+type fiatCurrencyResolver struct{ *Resolver }
 type fiatDepositResponseResolver struct{ *Resolver }
 type fiatExchangeTransferResponseResolver struct{ *Resolver }
 type fiatJournalResolver struct{ *Resolver }
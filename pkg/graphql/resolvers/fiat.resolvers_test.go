@@ -20,6 +20,7 @@ import (
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -115,16 +116,17 @@ func TestFiatResolver_OpenFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockPostgres.EXPECT().FiatCreateAccount(gomock.Any(), gomock.Any()).
@@ -135,7 +137,8 @@ func TestFiatResolver_OpenFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -184,7 +187,7 @@ func TestFiatResolver_FiatDepositResponseResolvers(t *testing.T) {
 		TxTS:     txTS,
 		Balance:  balance,
 		LastTx:   lastTx,
-		Currency: postgres.CurrencyUSD,
+		Currency: postgres.Currency("USD"),
 	}
 
 	t.Run("TxID", func(t *testing.T) {
@@ -338,16 +341,18 @@ func TestFiatResolver_DepositFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockPostgres.EXPECT().FiatExternalTransfer(gomock.Any(), gomock.Any()).
@@ -358,7 +363,8 @@ func TestFiatResolver_DepositFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -445,6 +451,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 		quotesTimes          int
 		authEncryptErr       error
 		authEncryptTimes     int
+		holdErr              error
+		holdTimes            int
 		redisErr             error
 		redisTimes           int
 	}{
@@ -462,6 +470,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -478,6 +488,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -494,6 +506,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -510,6 +524,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -526,6 +542,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -542,6 +560,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -558,6 +578,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          0,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -574,6 +596,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          1,
 			authEncryptErr:       nil,
 			authEncryptTimes:     0,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -590,6 +614,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          1,
 			authEncryptErr:       errors.New(""),
 			authEncryptTimes:     1,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             nil,
 			redisTimes:           0,
 		}, {
@@ -606,6 +632,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          1,
 			authEncryptErr:       nil,
 			authEncryptTimes:     1,
+			holdErr:              nil,
+			holdTimes:            0,
 			redisErr:             errors.New(""),
 			redisTimes:           1,
 		}, {
@@ -622,6 +650,44 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:          1,
 			authEncryptErr:       nil,
 			authEncryptTimes:     1,
+			holdErr:              nil,
+			holdTimes:            0,
+			redisErr:             nil,
+			redisTimes:           1,
+		}, {
+			name:                 "hold insufficient funds",
+			path:                 "/exchange-offer-fiat/hold-insufficient-funds",
+			query:                fmt.Sprintf(testFiatQuery["exchangeOfferFiatHold"], "USD", "CAD", 101.11),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			quotesErr:            nil,
+			quotesTimes:          1,
+			authEncryptErr:       nil,
+			authEncryptTimes:     1,
+			holdErr:              postgres.ErrInsufficientFunds,
+			holdTimes:            1,
+			redisErr:             nil,
+			redisTimes:           0,
+		}, {
+			name:                 "valid - held",
+			path:                 "/exchange-offer-fiat/valid-held",
+			query:                fmt.Sprintf(testFiatQuery["exchangeOfferFiatHold"], "USD", "CAD", 101.11),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			quotesErr:            nil,
+			quotesTimes:          1,
+			authEncryptErr:       nil,
+			authEncryptTimes:     1,
+			holdErr:              nil,
+			holdTimes:            1,
 			redisErr:             nil,
 			redisTimes:           1,
 		},
@@ -640,14 +706,15 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			mockPostgres := mocks.NewMockPostgres(mockCtrl) // Not called.
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockQuotes.EXPECT().FiatConversion(gomock.Any(), gomock.Any(), gomock.Any(), nil).
@@ -658,6 +725,10 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 					Return("OFFER-ID", test.authEncryptErr).
 					Times(test.authEncryptTimes),
 
+				mockPostgres.EXPECT().FiatHold(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.holdErr).
+					Times(test.holdTimes),
+
 				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(test.redisErr).
 					Times(test.redisTimes),
@@ -666,7 +737,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1008,16 +1080,18 @@ func TestFiatResolver_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(validClientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
@@ -1041,7 +1115,223 @@ func TestFiatResolver_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestFiatResolver_FiatCloseAccountResponseResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := fiatCloseAccountResponseResolver{}
+
+	response := &models.HTTPFiatTransferResponse{
+		SrcTxReceipt: &postgres.FiatAccountTransferResult{
+			TxID:     uuid.UUID{},
+			ClientID: uuid.UUID{},
+			TxTS:     pgtype.Timestamptz{},
+			Balance:  decimal.Decimal{},
+			LastTx:   decimal.Decimal{},
+			Currency: "",
+		},
+		DstTxReceipt: &postgres.FiatAccountTransferResult{
+			TxID:     uuid.UUID{},
+			ClientID: uuid.UUID{},
+			TxTS:     pgtype.Timestamptz{},
+			Balance:  decimal.Decimal{},
+			LastTx:   decimal.Decimal{},
+			Currency: "",
+		},
+	}
+
+	source, err := resolver.SourceReceipt(context.TODO(), response)
+	require.NoError(t, err, "source should always return a nil error.")
+	require.Equal(t, response.SrcTxReceipt, source, "source and returned struct addresses mismatched.")
+
+	destination, err := resolver.DestinationReceipt(context.TODO(), response)
+	require.NoError(t, err, "destinations should always return a nil error.")
+	require.Equal(t, response.DstTxReceipt, destination, "destination and returned struct addresses mismatched.")
+
+	empty := &models.HTTPFiatTransferResponse{}
+
+	source, err = resolver.SourceReceipt(context.TODO(), empty)
+	require.NoError(t, err, "empty source should always return a nil error.")
+	require.Nil(t, source, "empty source should be nil.")
+
+	destination, err = resolver.DestinationReceipt(context.TODO(), empty)
+	require.NoError(t, err, "empty destination should always return a nil error.")
+	require.Nil(t, destination, "empty destination should be nil.")
+}
+
+func TestFiatResolver_CloseFiat(t *testing.T) {
+	t.Parallel()
+
+	balance := postgres.FiatAccountBalance{Balance: decimal.NewFromFloat(100.25)}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		balanceTimes         int
+		quotesTimes          int
+		closeErr             error
+		closeTimes           int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/close-fiat/invalid-jwt",
+			query:                fmt.Sprintf(testFiatQuery["closeFiat"], ""),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid token"),
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/close-fiat/deleted-account",
+			query:                fmt.Sprintf(testFiatQuery["closeFiat"], ""),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			balanceTimes:         0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/close-fiat/invalid-currency",
+			query:                fmt.Sprintf(testFiatQuery["closeFiat"], "INVALID"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           0,
+		}, {
+			name:                 "db failure",
+			path:                 "/close-fiat/db-failure",
+			query:                fmt.Sprintf(testFiatQuery["closeFiat"], "USD"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			quotesTimes:          0,
+			closeErr:             postgres.ErrCloseAccount,
+			closeTimes:           1,
+		}, {
+			name:                 "valid",
+			path:                 "/close-fiat/valid",
+			query:                fmt.Sprintf(testFiatQuery["closeFiat"], "USD"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         0,
+			quotesTimes:          0,
+			closeErr:             nil,
+			closeTimes:           1,
+		}, {
+			name:                 "valid with sweep",
+			path:                 "/close-fiat/valid-sweep",
+			query:                fmt.Sprintf(testFiatQuery["closeFiatSweep"], "USD", "CAD"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			balanceTimes:         1,
+			quotesTimes:          1,
+			closeErr:             nil,
+			closeTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
+					Return(balance, nil).
+					Times(test.balanceTimes),
+
+				mockQuotes.EXPECT().FiatConversion(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(decimal.NewFromFloat(1.3555), decimal.NewFromFloat(135.89), nil).
+					Times(test.quotesTimes),
+
+				mockPostgres.EXPECT().FiatCloseAccount(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatAccountTransferResult{}, &postgres.FiatAccountTransferResult{}, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1073,6 +1363,7 @@ func TestFiatResolver_FiatAccountResolvers(t *testing.T) {
 	require.NoError(t, err, "failed to generate client id.")
 
 	balanceAmount := decimal.NewFromFloat(123456.78)
+	availableAmount := decimal.NewFromFloat(23456.78)
 	lastTxAmount := decimal.NewFromFloat(91011.12)
 
 	lastTxTS := time.Now().Add(-15 * time.Second)
@@ -1083,9 +1374,10 @@ func TestFiatResolver_FiatAccountResolvers(t *testing.T) {
 	createdAtPG := pgtype.Timestamptz{}
 	require.NoError(t, createdAtPG.Scan(createdAt), "failed to generate createdAt.")
 
-	fiatAccount := &postgres.FiatAccount{
-		Currency:  postgres.CurrencyUSD,
+	fiatAccount := &postgres.FiatAccountBalance{
+		Currency:  postgres.Currency("USD"),
 		Balance:   balanceAmount,
+		Available: availableAmount,
 		LastTx:    lastTxAmount,
 		LastTxTs:  lastTxTSPG,
 		CreatedAt: createdAtPG,
@@ -1108,6 +1400,14 @@ func TestFiatResolver_FiatAccountResolvers(t *testing.T) {
 		require.InDelta(t, balanceAmount.InexactFloat64(), result, 0.01, "balance amount mismatched.")
 	})
 
+	t.Run("AvailableAmount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Available(context.TODO(), fiatAccount)
+		require.NoError(t, err, "failed to resolve available amount")
+		require.InDelta(t, availableAmount.InexactFloat64(), result, 0.01, "available amount mismatched.")
+	})
+
 	t.Run("LastTxAmount", func(t *testing.T) {
 		t.Parallel()
 
@@ -1231,27 +1531,29 @@ func TestFiatResolver_BalanceFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockPostgres.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
-					Return(postgres.FiatAccount{}, test.fiatBalanceErr).
+					Return(postgres.FiatAccountBalance{}, test.fiatBalanceErr).
 					Times(test.fiatBalanceTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1277,14 +1579,14 @@ func TestFiatResolver_BalanceFiat(t *testing.T) {
 func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 	t.Parallel()
 
-	accDetails := []postgres.FiatAccount{{}, {}, {}, {}}
+	accDetails := []postgres.FiatAccountBalance{{}, {}, {}, {}}
 
 	testCases := []struct {
 		name                 string
 		path                 string
 		query                string
 		expectErr            bool
-		accDetails           []postgres.FiatAccount
+		accDetails           []postgres.FiatAccountBalance
 		authValidateJWTErr   error
 		authValidateJWTTimes int
 		isDeletedError       error
@@ -1404,7 +1706,7 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 			path:                 "/balance-all-fiat/valid-no-query-10-records",
 			query:                testFiatQuery["balanceAllFiatNoParams"],
 			expectErr:            false,
-			accDetails:           []postgres.FiatAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:           []postgres.FiatAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
@@ -1421,7 +1723,7 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 			path:                 "/balance-all-fiat/valid-no-query-11-records",
 			query:                testFiatQuery["balanceAllFiatNoParams"],
 			expectErr:            false,
-			accDetails:           []postgres.FiatAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:           []postgres.FiatAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
 			isDeletedError:       nil,
@@ -1481,20 +1783,21 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
-					Return([]byte{}, test.authDecryptStrErr).
+					Return([]byte("USD"), test.authDecryptStrErr).
 					Times(test.authDecryptStrTimes),
 
 				mockPostgres.EXPECT().FiatBalancePaginated(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -1509,7 +1812,8 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1532,82 +1836,71 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 	}
 }
 
-func TestFiatResolver_FiatJournalResolvers(t *testing.T) {
+func TestFiatResolver_FiatBalanceSnapshotResolver(t *testing.T) {
 	t.Parallel()
 
-	resolver := fiatJournalResolver{}
-
-	amount := decimal.NewFromFloat(123456.78)
-
-	timestamp := time.Now()
-	transactedAt := pgtype.Timestamptz{}
-	require.NoError(t, transactedAt.Scan(timestamp), "failed to generate timestamp.")
+	resolver := fiatBalanceSnapshotResolver{}
 
 	clientID, err := uuid.NewV4()
 	require.NoError(t, err, "failed to generate client id.")
 
-	txID, err := uuid.NewV4()
-	require.NoError(t, err, "failed to generate tx id.")
+	balance := decimal.NewFromFloat(1234.56)
+	snapshotAt := time.Now().Add(-time.Hour)
+	createdAt := time.Now().Add(-time.Minute)
 
-	fiatJournal := &postgres.FiatJournal{
-		Currency:     postgres.CurrencyUSD,
-		Amount:       amount,
-		TransactedAt: transactedAt,
-		ClientID:     clientID,
-		TxID:         txID,
+	snapshot := &postgres.FiatBalanceSnapshot{
+		ClientID:   clientID,
+		Currency:   postgres.Currency("USD"),
+		SnapshotAt: pgtype.Timestamptz{Time: snapshotAt, Valid: true},
+		Balance:    balance,
+		CreatedAt:  pgtype.Timestamptz{Time: createdAt, Valid: true},
 	}
 
-	t.Run("Currency", func(t *testing.T) {
+	t.Run("ClientID", func(t *testing.T) {
 		t.Parallel()
 
-		result, err := resolver.Currency(context.TODO(), fiatJournal)
-		require.NoError(t, err, "failed to resolve currency")
-		require.Equal(t, string(postgres.CurrencyUSD), result, "currency mismatched.")
+		result, err := resolver.ClientID(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve client id.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
 	})
 
-	t.Run("Amount", func(t *testing.T) {
+	t.Run("Currency", func(t *testing.T) {
 		t.Parallel()
 
-		result, err := resolver.Amount(context.TODO(), fiatJournal)
-		require.NoError(t, err, "failed to resolve amount")
-		require.InDeltaf(t, amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+		result, err := resolver.Currency(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve currency.")
+		require.Equal(t, "USD", result, "currency mismatched.")
 	})
 
-	t.Run("TransactedAt", func(t *testing.T) {
+	t.Run("SnapshotAt", func(t *testing.T) {
 		t.Parallel()
 
-		result, err := resolver.TransactedAt(context.TODO(), fiatJournal)
-		require.NoError(t, err, "failed to resolve transacted at timestamp.")
-		require.Equal(t, timestamp.String(), result, "transacted at timestamp mismatched.")
+		result, err := resolver.SnapshotAt(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve snapshot timestamp.")
+		require.Equal(t, snapshotAt.String(), result, "snapshot timestamp mismatched.")
 	})
 
-	t.Run("ClientID", func(t *testing.T) {
+	t.Run("Balance", func(t *testing.T) {
 		t.Parallel()
 
-		result, err := resolver.ClientID(context.TODO(), fiatJournal)
-		require.NoError(t, err, "failed to resolve client id.")
-		require.Equal(t, clientID.String(), result, "client id mismatched.")
+		result, err := resolver.Balance(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve balance.")
+		require.InDelta(t, balance.InexactFloat64(), result, 0.01, "balance mismatched.")
 	})
 
-	t.Run("TxID", func(t *testing.T) {
+	t.Run("CreatedAt", func(t *testing.T) {
 		t.Parallel()
 
-		result, err := resolver.TxID(context.TODO(), fiatJournal)
-		require.NoError(t, err, "failed to resolve tx id.")
-		require.Equal(t, txID.String(), result, "tx id mismatched.")
+		result, err := resolver.CreatedAt(context.TODO(), snapshot)
+		require.NoError(t, err, "failed to resolve created at timestamp.")
+		require.Equal(t, createdAt.String(), result, "created at timestamp mismatched.")
 	})
 }
 
-func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
+func TestFiatResolver_BalanceAsOfFiat(t *testing.T) {
 	t.Parallel()
 
-	clientID, err := uuid.NewV4()
-	require.NoError(t, err, "failed to generate client id.")
-
-	txUUID, err := uuid.NewV4()
-	require.NoError(t, err, "failed to generate transaction id.")
-
-	txID := txUUID.String()
+	now := time.Now().Unix()
 
 	testCases := []struct {
 		name                 string
@@ -1615,22 +1908,429 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 		query                string
 		expectErr            bool
 		authValidateJWTErr   error
-		authValidateTimes    int
+		authValidateJWTTimes int
 		isDeletedError       error
 		isDeletedTimes       int
 		isDeletedValue       bool
-		fiatTxDetailsErr     error
-		fiatTxDetailsTimes   int
-		cryptoTxDetailsErr   error
-		cryptoTxDetailsTimes int
+		dbErr                error
+		dbTimes              int
 	}{
 		{
-			name:                 "invalid jwt",
-			path:                 "/transaction-details-fiat/invalid-jwt",
-			query:                fmt.Sprintf(testFiatQuery["transactionDetailsFiat"], txID),
+			name:                 "invalid JWT",
+			path:                 "/balance-as-of-fiat/invalid-jwt",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "USD", now),
 			expectErr:            true,
-			authValidateTimes:    1,
-			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTErr:   errors.New("invalid JWT"),
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/balance-as-of-fiat/deleted-account",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "USD", now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/balance-as-of-fiat/invalid-currency",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "INVALID", now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "future timestamp",
+			path:                 "/balance-as-of-fiat/future-timestamp",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "USD", now+3600),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "unknown db error",
+			path:                 "/balance-as-of-fiat/unknown-db-error",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "USD", now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                errors.New("unknown error"),
+			dbTimes:              1,
+		}, {
+			name:                 "known db error",
+			path:                 "/balance-as-of-fiat/known-db-error",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "USD", now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                postgres.ErrNotFound,
+			dbTimes:              1,
+		}, {
+			name:                 "valid",
+			path:                 "/balance-as-of-fiat/valid",
+			query:                fmt.Sprintf(testFiatQuery["balanceAsOfFiat"], "USD", now),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().FiatBalanceAsOf(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(postgres.BalanceAsOf{}, test.dbErr).
+					Times(test.dbTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestFiatResolver_BalanceHistoryFiat(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		dbErr                error
+		dbTimes              int
+	}{
+		{
+			name:                 "invalid JWT",
+			path:                 "/balance-history-fiat/invalid-jwt",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "USD", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid JWT"),
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/balance-history-fiat/deleted-account",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "USD", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/balance-history-fiat/invalid-currency",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "INVALID", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "reversed range",
+			path:                 "/balance-history-fiat/reversed-range",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "USD", now, now-86400),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              0,
+		}, {
+			name:                 "unknown db error",
+			path:                 "/balance-history-fiat/unknown-db-error",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "USD", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                errors.New("unknown error"),
+			dbTimes:              1,
+		}, {
+			name:                 "known db error",
+			path:                 "/balance-history-fiat/known-db-error",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "USD", now-86400, now),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                postgres.ErrNotFound,
+			dbTimes:              1,
+		}, {
+			name:                 "valid",
+			path:                 "/balance-history-fiat/valid",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiat"], "USD", now-86400, now),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              1,
+		}, {
+			name:                 "valid no range",
+			path:                 "/balance-history-fiat/valid-no-range",
+			query:                fmt.Sprintf(testFiatQuery["balanceHistoryFiatNoParams"], "USD"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			dbErr:                nil,
+			dbTimes:              1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().FiatBalanceHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.FiatBalanceSnapshot{}, test.dbErr).
+					Times(test.dbTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestFiatResolver_FiatJournalResolvers(t *testing.T) {
+	t.Parallel()
+
+	resolver := fiatJournalResolver{}
+
+	amount := decimal.NewFromFloat(123456.78)
+
+	timestamp := time.Now()
+	transactedAt := pgtype.Timestamptz{}
+	require.NoError(t, transactedAt.Scan(timestamp), "failed to generate timestamp.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id.")
+
+	fiatJournal := &postgres.FiatJournal{
+		Currency:     postgres.Currency("USD"),
+		Amount:       amount,
+		TransactedAt: transactedAt,
+		ClientID:     clientID,
+		TxID:         txID,
+	}
+
+	t.Run("Currency", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Currency(context.TODO(), fiatJournal)
+		require.NoError(t, err, "failed to resolve currency")
+		require.Equal(t, string(postgres.Currency("USD")), result, "currency mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), fiatJournal)
+		require.NoError(t, err, "failed to resolve amount")
+		require.InDeltaf(t, amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("TransactedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TransactedAt(context.TODO(), fiatJournal)
+		require.NoError(t, err, "failed to resolve transacted at timestamp.")
+		require.Equal(t, timestamp.String(), result, "transacted at timestamp mismatched.")
+	})
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), fiatJournal)
+		require.NoError(t, err, "failed to resolve client id.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("TxID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TxID(context.TODO(), fiatJournal)
+		require.NoError(t, err, "failed to resolve tx id.")
+		require.Equal(t, txID.String(), result, "tx id mismatched.")
+	})
+}
+
+func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txUUID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	txID := txUUID.String()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateTimes    int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		fiatTxDetailsErr     error
+		fiatTxDetailsTimes   int
+		cryptoTxDetailsErr   error
+		cryptoTxDetailsTimes int
+		reversalTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/transaction-details-fiat/invalid-jwt",
+			query:                fmt.Sprintf(testFiatQuery["transactionDetailsFiat"], txID),
+			expectErr:            true,
+			authValidateTimes:    1,
+			authValidateJWTErr:   errors.New("invalid jwt"),
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
@@ -1694,6 +2394,7 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			fiatTxDetailsErr:     nil,
 			cryptoTxDetailsTimes: 1,
 			cryptoTxDetailsErr:   nil,
+			reversalTimes:        1,
 		},
 	}
 
@@ -1708,16 +2409,17 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(clientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockPostgres.EXPECT().FiatTxDetails(gomock.Any(), gomock.Any()).
@@ -1727,12 +2429,17 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.CryptoJournal{{}}, test.cryptoTxDetailsErr).
 					Times(test.cryptoTxDetailsTimes),
+
+				mockPostgres.EXPECT().JournalReversalGet(gomock.Any()).
+					Return(nil, postgres.ErrNotFound).
+					Times(test.reversalTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1959,16 +2666,17 @@ func TestFiatResolver_TransactionDetailsAllFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
@@ -1988,7 +2696,8 @@ func TestFiatResolver_TransactionDetailsAllFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -2022,3 +2731,144 @@ func TestFiatResolver_FiatTransactionsPaginatedResolver(t *testing.T) {
 	require.NoError(t, err, "error should always be nil.")
 	require.Equal(t, transactions.TransactionDetails, actual, "actual and returned addresses do not match.")
 }
+
+func TestFiatResolver_FiatCurrencyResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := fiatCurrencyResolver{}
+
+	obj := &postgres.FiatCurrency{
+		Code:      "ZWD",
+		Status:    postgres.FiatCurrencyStatusWITHDRAWN,
+		UpdatedAt: pgtype.Timestamptz{},
+	}
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve status.")
+		require.Equal(t, string(obj.Status), result, "status mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), obj)
+		require.NoError(t, err, "failed to resolve updated at.")
+		require.Equal(t, obj.UpdatedAt.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestFiatResolver_FiatCurrencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		currenciesErr      error
+		currenciesTimes    int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/fiat-currencies/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			isDeletedValue:     false,
+			currenciesErr:      nil,
+			currenciesTimes:    0,
+		}, {
+			name:               "deleted account",
+			path:               "/fiat-currencies/deleted-account",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     true,
+			currenciesErr:      nil,
+			currenciesTimes:    0,
+		}, {
+			name:               "db failure",
+			path:               "/fiat-currencies/db-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			currenciesErr:      errors.New("unknown error"),
+			currenciesTimes:    1,
+		}, {
+			name:               "valid",
+			path:               "/fiat-currencies/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			isDeletedValue:     false,
+			currenciesErr:      nil,
+			currenciesTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().FiatCurrencyGetAll().
+					Return(postgres.GenerateTestCurrencies(), test.currenciesErr).
+					Times(test.currenciesTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testFiatQuery["fiatCurrencies"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
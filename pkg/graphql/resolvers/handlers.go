@@ -1,15 +1,21 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-gonic/gin"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -17,7 +23,7 @@ import (
 
 // QueryHandler is the endpoint through which GraphQL can be accessed.
 func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
-	quotes quotes.Quotes, logger *logger.Logger) gin.HandlerFunc {
+	notify notifier.Notifier, quotes quotes.Quotes, logger *logger.Logger) gin.HandlerFunc {
 	gqlHandler := handler.NewDefaultServer(graphql_generated.NewExecutableSchema(
 		graphql_generated.Config{
 			Resolvers: &Resolver{
@@ -25,6 +31,7 @@ func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db po
 				auth:          auth,
 				cache:         cache,
 				db:            db,
+				notify:        notify,
 				quotes:        quotes,
 				logger:        logger,
 			},
@@ -32,10 +39,48 @@ func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db po
 	))
 
 	return func(c *gin.Context) {
+		if c.GetHeader(authHeaderKey) == "" && c.GetHeader(constants.APIKeyHeader()) != "" {
+			authentication := authenticateAPIKey(auth, cache, db, logger, c)
+
+			// Requests signed with a verified API key are rate limited by the key.
+			if authentication.key != nil && !rateLimitAPIKey(c, cache, logger, authentication.key.KeyID) {
+				return
+			}
+
+			c.Set(constants.APIKeyCtxKey(), authentication)
+		}
+
 		gqlHandler.ServeHTTP(c.Writer, c.Request)
 	}
 }
 
+// authenticateAPIKey will authenticate a request signed with an API key. The request body is restored after it has been
+// read for the signature check.
+func authenticateAPIKey(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	c *gin.Context) *apiKeyAuthentication {
+	var (
+		err  error
+		body []byte
+		key  *postgres.APIKey
+		msg  string
+	)
+
+	if c.Request.Body != nil {
+		if body, err = io.ReadAll(c.Request.Body); err != nil {
+			return &apiKeyAuthentication{err: errors.New("unable to read request body")}
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if key, _, msg, err = common.HTTPAPIKeyAuthenticate(
+		auth, cache, db, logger, c.Request, c.ClientIP(), body); err != nil {
+		return &apiKeyAuthentication{err: errors.New(msg)}
+	}
+
+	return &apiKeyAuthentication{key: key}
+}
+
 // PlaygroundHandler is the endpoint through which the GraphQL playground can be accessed.
 func PlaygroundHandler(baseURL, queryURL string) gin.HandlerFunc {
 	h := playground.Handler("GraphQL", fmt.Sprintf("/%s%s", baseURL, queryURL))
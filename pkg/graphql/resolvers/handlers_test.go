@@ -1,15 +1,23 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 )
 
@@ -22,11 +30,121 @@ func TestQueryHandler(t *testing.T) {
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 	mockQuotes := quotes.NewMockQuotes(mockCtrl)
 
-	handler := QueryHandler("Authorization", mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger)
+	handler := QueryHandler("Authorization", mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger)
 
 	require.NotNil(t, handler, "failed to create graphql endpoint handler")
 }
 
+func TestQueryHandler_APIKey(t *testing.T) {
+	t.Parallel()
+
+	secret := "api-key-secret"
+	body := `{"query": "query { fiatCurrencies { code } cryptoAssets { ticker } }"}`
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	activeKey := postgres.APIKey{KeyID: "key-id", Secret: "encrypted", Scopes: []string{constants.APIKeyScopeRead()}}
+
+	testCases := []struct {
+		name        string
+		path        string
+		signature   string
+		placed      bool
+		placeTimes  int
+		statusTimes int
+		fetchTimes  int
+		expectErr   bool
+	}{
+		{
+			name:        "invalid signature",
+			path:        "/api-key/invalid-signature",
+			signature:   "deadbeef",
+			placed:      false,
+			placeTimes:  0,
+			statusTimes: 0,
+			fetchTimes:  0,
+			expectErr:   true,
+		}, {
+			name:        "replayed",
+			path:        "/api-key/replayed",
+			placed:      false,
+			placeTimes:  1,
+			statusTimes: 0,
+			fetchTimes:  0,
+			expectErr:   true,
+		}, {
+			name:        "valid",
+			path:        "/api-key/valid",
+			placed:      true,
+			placeTimes:  1,
+			statusTimes: 2,
+			fetchTimes:  1,
+			expectErr:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+
+			// The request is authenticated once for both of the concurrently resolved fields.
+			mockPostgres.EXPECT().APIKeyGet(activeKey.KeyID).Return(activeKey, nil).Times(1)
+			mockAuth.EXPECT().DecryptFromString(activeKey.Secret).Return([]byte(secret), nil).Times(1)
+			mockRedis.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(test.placed, nil).
+				Times(test.placeTimes)
+			mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+				Return(modelsPostgres.UserStatus{}, nil).
+				Times(test.statusTimes)
+			mockPostgres.EXPECT().FiatCurrencyGetAll().Return([]postgres.FiatCurrency{}, nil).Times(test.fetchTimes)
+			mockPostgres.EXPECT().CryptoAssetGetAll().Return([]postgres.CryptoAsset{}, nil).Times(test.fetchTimes)
+
+			signature := test.signature
+			if signature == "" {
+				signature = auth.APIKeySignature(secret, http.MethodPost, test.path, timestamp, "nonce", []byte(body))
+			}
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(constants.APIKeyHeader(), activeKey.KeyID)
+			req.Header.Set(constants.APIKeyTimestampHeader(), timestamp)
+			req.Header.Set(constants.APIKeyNonceHeader(), "nonce")
+			req.Header.Set(constants.APIKeySignatureHeader(), signature)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+
+				return
+			}
+
+			_, ok := response["errors"]
+			require.False(t, ok, "unexpected errors returned")
+		})
+	}
+}
+
 func TestPlaygroundHandler(t *testing.T) {
 	handler := PlaygroundHandler("/base-url", "/query-endpoint-url")
 	require.NotNil(t, handler, "failed to create playground endpoint handler")
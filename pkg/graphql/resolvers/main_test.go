@@ -1,12 +1,20 @@
 package graphql
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +33,38 @@ var testFiatQuery = getFiatQuery()
 // testCryptoQuery is the test Crypto-related mutations and queries.
 var testCryptoQuery = getCryptoQuery()
 
+// testAdminQuery is the test administrative mutations and queries.
+var testAdminQuery = getAdminQuery()
+
+// testCryptoAsset is the Cryptocurrency registry entry used for all tickers in the tests.
+var testCryptoAsset = postgres.CryptoAsset{
+	Ticker:        "BTC",
+	Name:          "Bitcoin",
+	DecimalPlaces: 8,
+	Status:        postgres.CryptoAssetStatusENABLED,
+	MinOrder:      decimal.NewFromFloat(0.00001),
+	MaxOrder:      decimal.NewFromFloat(1000000),
+}
+
+// sessionKeyMatcher matches the cache keys under which JWT revocations are recorded.
+type sessionKeyMatcher struct{}
+
+func (sessionKeyMatcher) Matches(x any) bool {
+	key, ok := x.(string)
+
+	return ok && strings.HasPrefix(key, "jwt-")
+}
+
+func (sessionKeyMatcher) String() string {
+	return fmt.Sprintf("has prefix %q", "jwt-")
+}
+
+// expectActiveSession configures the mocks to report that JWTs which pass validation have not been revoked.
+func expectActiveSession(mockAuth *mocks.MockAuth, mockRedis *mocks.MockRedis) {
+	mockAuth.EXPECT().TokenSession(gomock.Any()).Return("token-id", time.Now().UnixMilli(), nil).AnyTimes()
+	mockRedis.EXPECT().Get(sessionKeyMatcher{}, gomock.Any()).Return(redis.ErrCacheMiss).AnyTimes()
+}
+
 func TestMain(m *testing.M) {
 	var err error
 	// Configure logger.
@@ -53,6 +93,9 @@ func TestMain(m *testing.M) {
 
 // setup will configure the auth test object.
 func setup() error {
+	// Populate the Fiat currency cache.
+	postgres.SetCurrencyCache(postgres.GenerateTestCurrencies())
+
 	return nil
 }
 
@@ -0,0 +1,144 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// RateLimitMiddleware is the middleware that limits the rate of requests from each requester. Every request is limited
+// by the default policy, and each top-level field selected by the operation is also limited by its own policy, if any.
+// The policies are stored in the Gin context so that requests signed with an API key can also be limited by the key
+// once it has been verified.
+func RateLimitMiddleware(auth auth.Auth, cache redis.Redis, logger *logger.Logger, authHeaderKey string,
+	defaultPolicy common.RateLimitPolicy, operationPolicies map[string]common.RateLimitPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policies := []common.RateLimitPolicy{defaultPolicy}
+
+		for _, field := range operationFields(c) {
+			if policy, ok := operationPolicies[field]; ok {
+				policies = append(policies, policy)
+			}
+		}
+
+		if !rateLimit(c, cache, logger, policies, common.RateLimitSubject(auth, c.GetHeader(authHeaderKey), c.ClientIP())) {
+			return
+		}
+
+		c.Set(constants.RateLimitPoliciesCtxKey(), policies)
+
+		c.Next()
+	}
+}
+
+// rateLimitAPIKey will limit the rate of requests signed with a verified API key under the policies the request was
+// admitted under by the rate limit middleware, if any. Returns false if the request was rejected and aborted.
+func rateLimitAPIKey(c *gin.Context, cache redis.Redis, logger *logger.Logger, keyID string) bool {
+	value, _ := c.Get(constants.RateLimitPoliciesCtxKey())
+	policies, _ := value.([]common.RateLimitPolicy)
+
+	return rateLimit(c, cache, logger, policies, common.RateLimitAPIKeySubject(keyID))
+}
+
+// rateLimit will admit a request from a requester under each of the rate limit policies in turn and report the rate
+// limit status in the response headers. Returns false if the request was rejected and aborted.
+func rateLimit(c *gin.Context, cache redis.Redis, logger *logger.Logger, policies []common.RateLimitPolicy,
+	subject string) bool {
+	for _, policy := range policies {
+		status, httpStatus, httpMessage, payload, err := common.HTTPRateLimit(cache, logger, policy, subject)
+		if status != nil {
+			for header, value := range status.Headers() {
+				c.Header(header, value)
+			}
+		}
+
+		if err != nil {
+			retryAfter, _ := payload.(*models.HTTPRetryAfter)
+			c.AbortWithStatusJSON(httpStatus, gin.H{"errors": gqlerror.List{{
+				Message:    httpMessage,
+				Extensions: map[string]any{"retryAfter": retryAfter.RetryAfter},
+			}}})
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// operationFields will return the names of the top-level fields selected by the operation in a GraphQL request. Fields
+// are named as they are in the schema, rather than by their aliases, so that aliasing a field does not evade its rate
+// limit. The request body is restored after it has been read. Malformed requests select no fields and are left to be
+// rejected by the GraphQL handler.
+func operationFields(c *gin.Context) []string {
+	var (
+		err     error
+		body    []byte
+		request struct {
+			Query         string `json:"query"`
+			OperationName string `json:"operationName"`
+		}
+		document *ast.QueryDocument
+	)
+
+	if c.Request.Body == nil {
+		return nil
+	}
+
+	if body, err = io.ReadAll(c.Request.Body); err != nil {
+		return nil
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err = json.Unmarshal(body, &request); err != nil {
+		return nil
+	}
+
+	if document, err = parser.ParseQuery(&ast.Source{Input: request.Query}); err != nil {
+		return nil
+	}
+
+	operation := document.Operations.ForName(request.OperationName)
+	if operation == nil {
+		return nil
+	}
+
+	return selectionFields(document, operation.SelectionSet, map[string]bool{})
+}
+
+// selectionFields will return the names of the fields in a selection set, including those selected through fragments.
+// Each fragment is only expanded once to guard against fragment cycles.
+func selectionFields(document *ast.QueryDocument, selections ast.SelectionSet, expanded map[string]bool) []string {
+	var fields []string
+
+	for _, selection := range selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, selection.Name)
+		case *ast.InlineFragment:
+			fields = append(fields, selectionFields(document, selection.SelectionSet, expanded)...)
+		case *ast.FragmentSpread:
+			fragment := document.Fragments.ForName(selection.Name)
+			if fragment == nil || expanded[selection.Name] {
+				continue
+			}
+
+			expanded[selection.Name] = true
+			fields = append(fields, selectionFields(document, fragment.SelectionSet, expanded)...)
+		}
+	}
+
+	return fields
+}
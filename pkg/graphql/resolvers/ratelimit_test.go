@@ -0,0 +1,268 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	defaultKey := "rate-limit:graphql:ip:192.0.2.1"
+	offerKey := "rate-limit:graphql:offerCrypto:ip:192.0.2.1"
+	defaultPolicy := common.RateLimitPolicy{Name: "graphql", Limit: 100, Window: time.Minute}
+	operationPolicies := map[string]common.RateLimitPolicy{
+		"offerCrypto": {Name: "graphql:offerCrypto", Limit: 5, Window: time.Minute},
+	}
+
+	testCases := []struct {
+		name           string
+		body           string
+		defaultAdmit   bool
+		defaultErr     error
+		offerTimes     int
+		offerAdmit     bool
+		expectedStatus int
+		expectedLimit  string
+	}{
+		{
+			name:           "malformed request",
+			body:           `{"query": "mutation { offerCrypto(`,
+			defaultAdmit:   true,
+			offerTimes:     0,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "100",
+		}, {
+			name:           "no operation policy",
+			body:           `{"query": "query { cryptoAssets { ticker } }"}`,
+			defaultAdmit:   true,
+			offerTimes:     0,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "100",
+		}, {
+			name:           "default policy rejected",
+			body:           `{"query": "query { cryptoAssets { ticker } }"}`,
+			defaultAdmit:   false,
+			offerTimes:     0,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedLimit:  "100",
+		}, {
+			name:           "operation policy admitted",
+			body:           `{"query": "mutation { offerCrypto(input: {}) { offerID } }"}`,
+			defaultAdmit:   true,
+			offerTimes:     1,
+			offerAdmit:     true,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "5",
+		}, {
+			name:           "operation policy rejected",
+			body:           `{"query": "mutation { offerCrypto(input: {}) { offerID } }"}`,
+			defaultAdmit:   true,
+			offerTimes:     1,
+			offerAdmit:     false,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedLimit:  "5",
+		}, {
+			name: "aliased and fragment fields",
+			body: `{"query": "mutation Offers { a: offerCrypto(input: {}) { offerID } ... on Mutation { ` +
+				`b: offerCrypto(input: {}) { offerID } } ...offer } fragment offer on Mutation { c: offerCrypto(input: {}) ` +
+				`{ offerID } ...offer }", "operationName": "Offers"}`,
+			defaultAdmit:   true,
+			offerTimes:     3,
+			offerAdmit:     true,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "5",
+		}, {
+			name:           "unselected operation",
+			body:           `{"query": "query A { cryptoAssets { ticker } } mutation B { offerCrypto(input: {}) { offerID } }"}`,
+			defaultAdmit:   true,
+			offerTimes:     0,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "100",
+		}, {
+			name:           "cache failure",
+			body:           `{"query": "query { cryptoAssets { ticker } }"}`,
+			defaultErr:     redis.ErrCacheSet,
+			offerTimes:     0,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockRedis.EXPECT().SlidingWindow(defaultKey, defaultPolicy.Limit, defaultPolicy.Window).
+					Return(test.defaultAdmit, int64(0), 2*time.Second, test.defaultErr).
+					Times(1),
+
+				mockRedis.EXPECT().SlidingWindow(offerKey, int64(5), time.Minute).
+					Return(test.offerAdmit, int64(0), 2*time.Second, nil).
+					Times(test.offerTimes),
+			)
+
+			// Endpoint setup for test.
+			var forwarded string
+
+			router := gin.Default()
+			router.POST("/query", RateLimitMiddleware(mockAuth, mockRedis, zapLogger, "Authorization", defaultPolicy,
+				operationPolicies), func(ginCtx *gin.Context) {
+				body, err := io.ReadAll(ginCtx.Request.Body)
+				require.NoError(t, err, "failed to read forwarded body")
+				forwarded = string(body)
+				ginCtx.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "/query",
+				bytes.NewBufferString(test.body))
+			req.RemoteAddr = "192.0.2.1:4321"
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Equal(t, test.expectedLimit, recorder.Header().Get(constants.RateLimitLimitHeader()),
+				"rate limit header mismatch")
+
+			if test.expectedStatus == http.StatusOK {
+				require.Equal(t, test.body, forwarded, "request body not restored")
+
+				return
+			}
+
+			require.Equal(t, "2", recorder.Header().Get(constants.RetryAfterHeader()), "retry after header mismatch")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response")
+			errs, ok := response["errors"].([]any)
+			require.True(t, ok, "errors missing from response")
+			require.Len(t, errs, 1, "expected one error")
+			require.Equal(t, map[string]any{"retryAfter": float64(2)}, errs[0].(map[string]any)["extensions"],
+				"retry after extension mismatch")
+		})
+	}
+}
+
+func TestRateLimitAPIKey(t *testing.T) {
+	t.Parallel()
+
+	defaultPolicy := common.RateLimitPolicy{Name: "graphql", Limit: 100, Window: time.Minute}
+	offerPolicy := common.RateLimitPolicy{Name: "graphql:offerCrypto", Limit: 5, Window: time.Minute}
+
+	testCases := []struct {
+		name           string
+		policies       any
+		defaultTimes   int
+		defaultAdmit   bool
+		offerTimes     int
+		offerAdmit     bool
+		expectedStatus int
+		expectedLimit  string
+	}{
+		{
+			name:           "admitted",
+			policies:       []common.RateLimitPolicy{defaultPolicy, offerPolicy},
+			defaultTimes:   1,
+			defaultAdmit:   true,
+			offerTimes:     1,
+			offerAdmit:     true,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "5",
+		}, {
+			name:           "default policy rejected",
+			policies:       []common.RateLimitPolicy{defaultPolicy, offerPolicy},
+			defaultTimes:   1,
+			defaultAdmit:   false,
+			offerTimes:     0,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedLimit:  "100",
+		}, {
+			name:           "operation policy rejected",
+			policies:       []common.RateLimitPolicy{defaultPolicy, offerPolicy},
+			defaultTimes:   1,
+			defaultAdmit:   true,
+			offerTimes:     1,
+			offerAdmit:     false,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedLimit:  "5",
+		}, {
+			name:           "no policies",
+			policies:       nil,
+			defaultTimes:   0,
+			offerTimes:     0,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockRedis.EXPECT().SlidingWindow("rate-limit:graphql:api-key:api-key-id", int64(100), time.Minute).
+					Return(test.defaultAdmit, int64(0), 2*time.Second, nil).
+					Times(test.defaultTimes),
+
+				mockRedis.EXPECT().SlidingWindow("rate-limit:graphql:offerCrypto:api-key:api-key-id", int64(5),
+					time.Minute).
+					Return(test.offerAdmit, int64(0), 2*time.Second, nil).
+					Times(test.offerTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST("/query", func(ginCtx *gin.Context) {
+				if test.policies != nil {
+					ginCtx.Set(constants.RateLimitPoliciesCtxKey(), test.policies)
+				}
+
+				if !rateLimitAPIKey(ginCtx, mockRedis, zapLogger, "api-key-id") {
+					return
+				}
+
+				ginCtx.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "/query", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Equal(t, test.expectedLimit, recorder.Header().Get(constants.RateLimitLimitHeader()),
+				"rate limit header mismatch")
+		})
+	}
+}
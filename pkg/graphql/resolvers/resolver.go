@@ -3,6 +3,7 @@ package graphql
 import (
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -17,6 +18,7 @@ type Resolver struct {
 	auth          auth.Auth
 	cache         redis.Redis
 	db            postgres.Postgres
+	notify        notifier.Notifier
 	quotes        quotes.Quotes
 	logger        *logger.Logger
 }
@@ -13,20 +13,100 @@ func getHealthcheckQuery() string {
 func getUsersQuery() map[string]string {
 	return map[string]string{
 		"register": `{
-		"query": "mutation { registerUser(input: { firstname: \"%s\", lastname:\"%s\", email: \"%s\", userLoginCredentials: { username:\"%s\", password: \"%s\" } }) { token, expires, threshold }}"
+		"query": "mutation { registerUser(input: { firstname: \"%s\", lastname:\"%s\", email: \"%s\", userLoginCredentials: { username:\"%s\", password: \"%s\" } }) { token, expires, threshold, refreshToken, refreshExpires }}"
 		}`,
 
 		"login": `{
-		"query": "mutation { loginUser(input: { username:\"%s\", password: \"%s\" }) { token, expires, threshold }}"
+		"query": "mutation { loginUser(input: { username:\"%s\", password: \"%s\" }) { token, expires, threshold, refreshToken, refreshExpires }}"
 		}`,
 
 		"refresh": `{
 		"query": "mutation { refreshToken() { token expires threshold }}"
 		}`,
 
+		"refreshSession": `{
+		"query": "mutation { refreshSession(refreshToken: \"%s\") { token, expires, threshold, refreshToken, refreshExpires }}"
+		}`,
+
 		"delete": `{
 	    "query": "mutation { deleteUser(input: { username: \"%s\" password: \"%s\" confirmation:\"I understand the consequences, delete my user account %s\" })}"
 		}`,
+
+		"createAPIKey": `{
+		"query": "mutation { createAPIKey(input: { name: \"%s\", scopes: [%s], allowedIPs: [%s], expiresAt: %d }) { key { keyID, name, scopes, allowedIPs, expiresAt, createdAt, revokedAt }, secret }}"
+		}`,
+
+		"revokeAPIKey": `{
+		"query": "mutation { revokeAPIKey(keyID: \"%s\") }"
+		}`,
+
+		"apiKeys": `{
+		"query": "query { apiKeys { keyID, name, scopes, allowedIPs, expiresAt, createdAt, revokedAt } }"
+		}`,
+
+		"revokeSession": `{
+		"query": "mutation { revokeSession(sessionID: \"%s\") }"
+		}`,
+
+		"sessions": `{
+		"query": "query { sessions { sessionID, device, ipAddress, startedAt, lastRefreshed, expiresAt } }"
+		}`,
+
+		"securityActivity": `{
+		"query": "query { securityActivity { eventType, outcome, ipAddress, userAgent, occurredAt } }"
+		}`,
+
+		"enrollMFA": `{
+		"query": "mutation { enrollMFA { secret, uri } }"
+		}`,
+
+		"confirmMFA": `{
+		"query": "mutation { confirmMFA(code: \"%s\") }"
+		}`,
+
+		"regenerateRecoveryCodes": `{
+		"query": "mutation { regenerateRecoveryCodes }"
+		}`,
+
+		"disableMFA": `{
+		"query": "mutation { disableMFA }"
+		}`,
+
+		"changePassword": `{
+		"query": "mutation { changePassword(input: { currentPassword: \"%s\", newPassword: \"%s\" }) }"
+		}`,
+
+		"requestPasswordReset": `{
+		"query": "mutation { requestPasswordReset(username: \"%s\") }"
+		}`,
+
+		"resetPassword": `{
+		"query": "mutation { resetPassword(input: { token: \"%s\", password: \"%s\" }) }"
+		}`,
+
+		"me": `{
+		"query": "query { me { clientID, username, firstName, lastName, email, emailVerified, role, isDeleted, isFrozen } }"
+		}`,
+
+		"updateProfile": `{
+		"query": "mutation { updateProfile(input: { firstName: \"%s\", lastName: \"%s\", email: \"%s\" }) { clientID, username, firstName, lastName, email, emailVerified } }"
+		}`,
+
+		"sendEmailVerification": `{
+		"query": "mutation { sendEmailVerification }"
+		}`,
+
+		"verifyEmail": `{
+		"query": "mutation { verifyEmail(token: \"%s\") }"
+		}`,
+
+		"logout": `{
+		"query": "mutation { logoutUser }"
+		}`,
+
+		"logoutAll": `{
+		"query": "mutation { logoutAllSessions }"
+		}`,
 	}
 }
 
@@ -47,20 +127,44 @@ func getFiatQuery() map[string]string {
 		"query": "mutation { exchangeOfferFiat(input: { sourceCurrency:\"%s\" destinationCurrency: \"%s\" sourceAmount: %f }) { priceQuote{ clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, offerID, expires } }"
 		}`,
 
+		"exchangeOfferFiatHold": `{
+		"query": "mutation { exchangeOfferFiat(input: { sourceCurrency:\"%s\" destinationCurrency: \"%s\" sourceAmount: %f hold: true }) { priceQuote{ clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, offerID, expires, isHeld } }"
+		}`,
+
 		"exchangeTransferFiat": `{
 		"query": "mutation { exchangeTransferFiat(offerID: \"%s\") { sourceReceipt { txId, clientId, txTimestamp, balance, lastTx, currency }, destinationReceipt { txId, clientId, txTimestamp, balance, lastTx, currency } } }"
 		}`,
 
+		"closeFiat": `{
+		"query": "mutation { closeFiat(input: { currency: \"%s\" }) { sourceReceipt { txId, clientId, txTimestamp, balance, lastTx, currency }, destinationReceipt { txId, clientId, txTimestamp, balance, lastTx, currency } } }"
+		}`,
+
+		"closeFiatSweep": `{
+		"query": "mutation { closeFiat(input: { currency: \"%s\", sweepCurrency: \"%s\" }) { sourceReceipt { txId, clientId, txTimestamp, balance, lastTx, currency }, destinationReceipt { txId, clientId, txTimestamp, balance, lastTx, currency } } }"
+		}`,
+
 		"balanceFiat": `{
-		"query": "query { balanceFiat(currencyCode: \"%s\") { currency, balance, lastTx, lastTxTs, createdAt, clientID } }"
+		"query": "query { balanceFiat(currencyCode: \"%s\") { currency, balance, available, lastTx, lastTxTs, createdAt, clientID } }"
 		}`,
 
 		"balanceAllFiat": `{
-		"query": "query { balanceAllFiat( pageCursor: \"%s\", pageSize: %d ) { accountBalances { currency, balance, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		"query": "query { balanceAllFiat( pageCursor: \"%s\", pageSize: %d ) { accountBalances { currency, balance, available, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
 		}`,
 
 		"balanceAllFiatNoParams": `{
-		"query": "query { balanceAllFiat { accountBalances { currency, balance, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		"query": "query { balanceAllFiat { accountBalances { currency, balance, available, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		}`,
+
+		"balanceAsOfFiat": `{
+		"query": "query { balanceAsOfFiat(currencyCode: \"%s\", timestamp: %d) { currency, balance, asOf, snapshotAt } }"
+		}`,
+
+		"balanceHistoryFiat": `{
+		"query": "query { balanceHistoryFiat(currencyCode: \"%s\", from: %d, to: %d) { currency, from, to, snapshots { clientID, currency, snapshotAt, balance, createdAt } } }"
+		}`,
+
+		"balanceHistoryFiatNoParams": `{
+		"query": "query { balanceHistoryFiat(currencyCode: \"%s\") { currency, from, to, snapshots { snapshotAt, balance } } }"
 		}`,
 
 		"transactionDetailsFiat": `{
@@ -74,6 +178,10 @@ func getFiatQuery() map[string]string {
 		"transactionDetailsAllFiatSubsequent": `{
 		"query": "query { transactionDetailsAllFiat(input: { currency: \"%s\", pageSize:\"%d\", pageCursor:\"%s\" }) { transactions { currency, amount, transactedAt, clientID, txID }, links { pageCursor } } }"
 		}`,
+
+		"fiatCurrencies": `{
+		"query": "query { fiatCurrencies { code, status, updatedAt } }"
+		}`,
 	}
 }
 
@@ -90,20 +198,112 @@ func getCryptoQuery() map[string]string {
 		"query": "mutation { offerCrypto(input: { sourceAmount: %f, sourceCurrency:\"%s\", destinationCurrency:\"%s\", isPurchase: %t, }) { priceQuote { clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, offerID, expires } }"
 		}`,
 
+		"offerCryptoHold": `{
+		"query": "mutation { offerCrypto(input: { sourceAmount: %f, sourceCurrency:\"%s\", destinationCurrency:\"%s\", isPurchase: %t, hold: true }) { priceQuote { clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, offerID, expires, isHeld } }"
+		}`,
+
 		"exchangeCrypto": `{
 		"query": "mutation { exchangeCrypto(offerID: \"%s\") { fiatTxReceipt{ currency, amount, transactedAt, clientID, txID, }, cryptoTxReceipt{ ticker, amount, transactedAt, clientID, txID, }, } }"
 		}`,
 
+		"closeCrypto": `{
+		"query": "mutation { closeCrypto(input: { ticker: \"%s\" }) { fiatTxReceipt{ currency, amount, transactedAt, clientID, txID, }, cryptoTxReceipt{ ticker, amount, transactedAt, clientID, txID, }, } }"
+		}`,
+
+		"closeCryptoSweep": `{
+		"query": "mutation { closeCrypto(input: { ticker: \"%s\", sweepCurrency: \"%s\" }) { fiatTxReceipt{ currency, amount, transactedAt, clientID, txID, }, cryptoTxReceipt{ ticker, amount, transactedAt, clientID, txID, }, } }"
+		}`,
+
+		"cryptoAssets": `{
+		"query": "query { cryptoAssets { ticker, name, decimalPlaces, status, minOrder, maxOrder, updatedAt } }"
+		}`,
+
+		"placeLimitOrder": `{
+		"query": "mutation { placeLimitOrder(input: { fiatCurrency: \"%s\", ticker: \"%s\", amount: %f, limitPrice: %f, expiresAt: %d, isPurchase: %t }) { orderID, clientID, fiatCurrency, ticker, isPurchase, amount, limitPrice, status, txID, expiresAt, createdAt, updatedAt } }"
+		}`,
+
+		"cancelLimitOrder": `{
+		"query": "mutation { cancelLimitOrder(orderID: \"%s\") }"
+		}`,
+
+		"limitOrder": `{
+		"query": "query { limitOrder(orderID: \"%s\") { order { orderID, status, amount, limitPrice }, history { id, orderID, status, price, details, createdAt } } }"
+		}`,
+
+		"limitOrders": `{
+		"query": "query { limitOrders(status: \"%s\", pageCursor: \"%s\", pageSize: %d) { orders { orderID, status, amount, limitPrice, txID }, links { pageCursor } } }"
+		}`,
+
+		"limitOrdersNoParams": `{
+		"query": "query { limitOrders { orders { orderID, status, amount, limitPrice, txID }, links { pageCursor } } }"
+		}`,
+
+		"placeTriggerOrder": `{
+		"query": "mutation { placeTriggerOrder(input: { fiatCurrency: \"%s\", ticker: \"%s\", triggerType: \"%s\", triggerPrice: %f, trailingDistance: %f, amount: %f }) { orderID, clientID, fiatCurrency, ticker, triggerType, triggerPrice, trailingDistance, amount, status, txID, createdAt, updatedAt } }"
+		}`,
+
+		"cancelTriggerOrder": `{
+		"query": "mutation { cancelTriggerOrder(orderID: \"%s\") }"
+		}`,
+
+		"triggerOrder": `{
+		"query": "query { triggerOrder(orderID: \"%s\") { order { orderID, triggerType, triggerPrice, amount, status }, history { id, orderID, status, price, amount, details, createdAt } } }"
+		}`,
+
+		"triggerOrders": `{
+		"query": "query { triggerOrders(status: \"%s\", pageCursor: \"%s\", pageSize: %d) { orders { orderID, triggerType, triggerPrice, amount, status, txID }, links { pageCursor } } }"
+		}`,
+
+		"triggerOrdersNoParams": `{
+		"query": "query { triggerOrders { orders { orderID, triggerType, triggerPrice, amount, status, txID }, links { pageCursor } } }"
+		}`,
+
+		"scheduleRecurringPurchase": `{
+		"query": "mutation { scheduleRecurringPurchase(input: { fiatCurrency: \"%s\", ticker: \"%s\", amount: %f, cadence: \"%s\", startsAt: %d, endsAt: %d }) { planID, clientID, fiatCurrency, ticker, amount, cadence, startsAt, endsAt, nextRunAt, status, createdAt, updatedAt } }"
+		}`,
+
+		"scheduleRecurringPurchaseNoSchedule": `{
+		"query": "mutation { scheduleRecurringPurchase(input: { fiatCurrency: \"%s\", ticker: \"%s\", amount: %f, cadence: \"%s\" }) { planID, cadence, startsAt, endsAt, nextRunAt, status } }"
+		}`,
+
+		"cancelRecurringPurchase": `{
+		"query": "mutation { cancelRecurringPurchase(planID: \"%s\") }"
+		}`,
+
+		"recurringPurchase": `{
+		"query": "query { recurringPurchase(planID: \"%s\", pageCursor: \"%s\", pageSize: %d) { plan { planID, amount, cadence, nextRunAt, status }, runs { id, planID, scheduledAt, status, price, fiatAmount, cryptoAmount, txID, details, createdAt }, links { pageCursor } } }"
+		}`,
+
+		"recurringPurchases": `{
+		"query": "query { recurringPurchases(status: \"%s\", pageCursor: \"%s\", pageSize: %d) { plans { planID, ticker, amount, cadence, nextRunAt, status }, links { pageCursor } } }"
+		}`,
+
+		"recurringPurchasesNoParams": `{
+		"query": "query { recurringPurchases { plans { planID, ticker, amount, cadence, nextRunAt, status }, links { pageCursor } } }"
+		}`,
+
 		"balanceCrypto": `{
-		"query": "query { balanceCrypto(ticker: \"%s\") { ticker, balance, lastTx, lastTxTs, createdAt, clientID } }"
+		"query": "query { balanceCrypto(ticker: \"%s\") { ticker, balance, available, lastTx, lastTxTs, createdAt, clientID } }"
 		}`,
 
 		"balanceAllCrypto": `{
-		"query": "query { balanceAllCrypto( pageCursor: \"%s\", pageSize: %d ) { accountBalances { ticker, balance, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		"query": "query { balanceAllCrypto( pageCursor: \"%s\", pageSize: %d ) { accountBalances { ticker, balance, available, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
 		}`,
 
 		"balanceAllCryptoNoParams": `{
-		"query": "query { balanceAllCrypto { accountBalances { ticker, balance, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		"query": "query { balanceAllCrypto { accountBalances { ticker, balance, available, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		}`,
+
+		"balanceAsOfCrypto": `{
+		"query": "query { balanceAsOfCrypto(ticker: \"%s\", timestamp: %d) { currency, balance, asOf, snapshotAt } }"
+		}`,
+
+		"balanceHistoryCrypto": `{
+		"query": "query { balanceHistoryCrypto(ticker: \"%s\", from: %d, to: %d) { ticker, from, to, snapshots { clientID, ticker, snapshotAt, balance, createdAt } } }"
+		}`,
+
+		"balanceHistoryCryptoNoParams": `{
+		"query": "query { balanceHistoryCrypto(ticker: \"%s\") { ticker, from, to, snapshots { snapshotAt, balance } } }"
 		}`,
 
 		"transactionDetailsCrypto": `{
@@ -119,3 +319,94 @@ func getCryptoQuery() map[string]string {
 		}`,
 	}
 }
+
+// getAdminQuery is a map of test administrative mutations and queries.
+//
+//nolint:lll
+func getAdminQuery() map[string]string {
+	return map[string]string{
+		"freezeUser": `{
+		"query": "mutation { adminFreezeUser(clientID: \"%s\", isFrozen: %t, reason: \"%s\") { clientID, isFrozen } }"
+		}`,
+
+		"unlockUser": `{
+		"query": "mutation { adminUnlockUser(clientID: \"%s\", reason: \"%s\") { clientID } }"
+		}`,
+
+		"fiatAccountStatus": `{
+		"query": "mutation { adminFiatAccountStatus(clientID: \"%s\", currency: \"%s\", status: \"%s\", reason: \"%s\") { clientID, code, status } }"
+		}`,
+
+		"cryptoAccountStatus": `{
+		"query": "mutation { adminCryptoAccountStatus(clientID: \"%s\", ticker: \"%s\", status: \"%s\", reason: \"%s\") { clientID, code, status } }"
+		}`,
+
+		"userSearch": `{
+		"query": "query { adminUserSearch(query: \"%s\", limit: %d) { username, firstName, lastName, email, clientID, role, isDeleted, isFrozen } }"
+		}`,
+
+		"user": `{
+		"query": "query { adminUser(clientID: \"%s\") { username, firstName, lastName, email, clientID, role, isDeleted, isFrozen } }"
+		}`,
+
+		"balanceAllFiat": `{
+		"query": "query { adminBalanceAllFiat(clientID: \"%s\", pageSize: %d) { accountBalances { currency, balance, available, lastTx, lastTxTs, createdAt, clientID, status }, links { pageCursor } } }"
+		}`,
+
+		"transactionDetailsAllFiat": `{
+		"query": "query { adminTransactionDetailsAllFiat(clientID: \"%s\", input: { currency: \"%s\", pageSize:\"%d\", pageCursor:\"%s\" }) { transactions { currency, amount, transactedAt, clientID, txID }, links { pageCursor } } }"
+		}`,
+
+		"balanceAllCrypto": `{
+		"query": "query { adminBalanceAllCrypto(clientID: \"%s\", pageSize: %d) { accountBalances { ticker, balance, available, lastTx, lastTxTs, createdAt, clientID, status }, links { pageCursor } } }"
+		}`,
+
+		"transactionDetailsAllCrypto": `{
+		"query": "query { adminTransactionDetailsAllCrypto(clientID: \"%s\", input: { ticker: \"%s\", pageSize:\"%d\", pageCursor:\"%s\" }) { transactions { ticker, amount, transactedAt, clientID, txID }, links { pageCursor } } }"
+		}`,
+
+		"auditLog": `{
+		"query": "query { adminAuditLog(target: \"%s\", pageSize: %d) { entries { id, adminID, action, target, details, createdAt }, links { pageCursor } } }"
+		}`,
+
+		"auditEvents": `{
+		"query": "query { adminAuditEvents(clientID: \"%s\", eventType: \"%s\", pageSize: %d) { events { eventID, eventType, outcome, actorID, subjectID, ipAddress, userAgent, payload, createdAt }, links { pageCursor } } }"
+		}`,
+
+		"loginLockouts": `{
+		"query": "query { adminLoginLockouts(active: %t, limit: %d) { lockoutID, scope, subject, failures, ipAddress, lockedAt, expiresAt, unlockedBy, unlockedAt } }"
+		}`,
+
+		"ledgerVerify": `{
+		"query": "query { adminLedgerVerify(journal: \"%s\") { intact, journals { journal, entries, firstSeq, lastSeq, lastHash, checkpoints, intact, break { seq, txID, reason } } } }"
+		}`,
+
+		"ledgerCheckpoints": `{
+		"query": "query { adminLedgerCheckpoints(journal: \"%s\", since: %d) { algorithm, publicKey, checkpoints { journal, seq, entryHash, createdAt, message, signature, publicKey } } }"
+		}`,
+
+		"reverseTransaction": `{
+		"query": "mutation { adminReverseTransaction(transactionID: \"%s\", reason: \"%s\") { txID, reversalTxID, adminID, reason, reversedAt, fiatEntries { currency, amount, transactedAt, clientID, txID }, cryptoEntries { ticker, amount, transactedAt, clientID, txID } } }"
+		}`,
+
+		"requestAdjustment": `{
+		"query": "mutation { adminRequestAdjustment(input: { clientID: \"%s\", currency: \"%s\", amount: %f, reasonCode: \"%s\", justification: \"%s\" }) { adjustmentID, clientID, currency, amount, reasonCode, justification, status, makerID, checkerID, decisionNote, txID, createdAt, decidedAt } }"
+		}`,
+
+		"approveAdjustment": `{
+		"query": "mutation { adminApproveAdjustment(adjustmentID: \"%s\", note: \"%s\") { adjustmentID, clientID, currency, amount, reasonCode, justification, status, makerID, checkerID, decisionNote, txID, createdAt, decidedAt } }"
+		}`,
+
+		"rejectAdjustment": `{
+		"query": "mutation { adminRejectAdjustment(adjustmentID: \"%s\", note: \"%s\") { adjustmentID, clientID, currency, amount, reasonCode, justification, status, makerID, checkerID, decisionNote, txID, createdAt, decidedAt } }"
+		}`,
+
+		"adjustment": `{
+		"query": "query { adminAdjustment(adjustmentID: \"%s\") { adjustmentID, clientID, currency, amount, reasonCode, justification, status, makerID, checkerID, decisionNote, txID, createdAt, decidedAt } }"
+		}`,
+
+		"adjustments": `{
+		"query": "query { adminAdjustments(status: \"%s\", pageSize: %d) { adjustments { adjustmentID, clientID, currency, amount, reasonCode, justification, status, makerID, checkerID, decisionNote, txID, createdAt, decidedAt }, links { pageCursor } } }"
+		}`,
+	}
+}
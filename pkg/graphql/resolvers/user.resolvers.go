@@ -9,24 +9,84 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/validator"
 )
 
+// ClientID is the resolver for the clientID field.
+func (r *userResolver) ClientID(ctx context.Context, obj *modelsPostgres.User) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *aPIKeyResolver) ExpiresAt(ctx context.Context, obj *modelsPostgres.APIKeyInfo) (*string, error) {
+	if !obj.ExpiresAt.Valid {
+		return nil, nil
+	}
+
+	expiresAt := obj.ExpiresAt.Time.String()
+
+	return &expiresAt, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *aPIKeyResolver) CreatedAt(ctx context.Context, obj *modelsPostgres.APIKeyInfo) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// RevokedAt is the resolver for the revokedAt field.
+func (r *aPIKeyResolver) RevokedAt(ctx context.Context, obj *modelsPostgres.APIKeyInfo) (*string, error) {
+	if !obj.RevokedAt.Valid {
+		return nil, nil
+	}
+
+	revokedAt := obj.RevokedAt.Time.String()
+
+	return &revokedAt, nil
+}
+
+// StartedAt is the resolver for the startedAt field.
+func (r *sessionResolver) StartedAt(ctx context.Context, obj *modelsPostgres.SessionInfo) (string, error) {
+	return obj.StartedAt.Time.String(), nil
+}
+
+// LastRefreshed is the resolver for the lastRefreshed field.
+func (r *sessionResolver) LastRefreshed(ctx context.Context, obj *modelsPostgres.SessionInfo) (string, error) {
+	return obj.LastRefreshed.Time.String(), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *sessionResolver) ExpiresAt(ctx context.Context, obj *modelsPostgres.SessionInfo) (string, error) {
+	return obj.ExpiresAt.Time.String(), nil
+}
+
+// OccurredAt is the resolver for the occurredAt field.
+func (r *securityEventResolver) OccurredAt(ctx context.Context, obj *modelsPostgres.SecurityEvent) (string, error) {
+	return obj.OccurredAt.Time.String(), nil
+}
+
 // RegisterUser is the resolver for the registerUser field.
 func (r *mutationResolver) RegisterUser(ctx context.Context, input *modelsPostgres.UserAccount) (*models.JWTAuthResponse, error) {
 	var (
-		authToken *models.JWTAuthResponse
-		err       error
-		httpMsg   string
-		payload   any
+		authToken  *models.JWTAuthResponse
+		err        error
+		ginContext *gin.Context
+		httpMsg    string
+		payload    any
 	)
 
-	if authToken, httpMsg, _, payload, err = common.HTTPRegisterUser(r.auth, r.db, r.logger, input); err != nil {
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if authToken, httpMsg, _, payload, err = common.HTTPRegisterUser(
+		r.auth, r.db, r.notify, r.logger, input, ginContext.Request.UserAgent(), ginContext.ClientIP()); err != nil {
 		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
 	}
 
@@ -36,19 +96,31 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input *modelsPostgr
 // DeleteUser is the resolver for the deleteUser field.
 func (r *mutationResolver) DeleteUser(ctx context.Context, input models.HTTPDeleteUserRequest) (string, error) {
 	var (
-		clientID uuid.UUID
-		err      error
-		httpMsg  string
-		payload  any
+		clientID  uuid.UUID
+		err       error
+		httpMsg   string
+		otp       string
+		payload   any
+		device    string
+		ipAddress string
 	)
 
 	// Validate the JWT and extract the clientID. Compare the clientID against the deletion request login
 	// credentials.
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
 		return "", errors.New("authorization failure")
 	}
 
-	if httpMsg, _, payload, err = common.HTTPDeleteUser(r.auth, r.db, r.logger, clientID, &input); err != nil {
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if httpMsg, _, payload, err = common.HTTPDeleteUser(r.auth, r.db, r.logger, clientID, &input, device, ipAddress,
+		otp); err != nil {
 		return "", fmt.Errorf("%s: %v", httpMsg, payload)
 	}
 
@@ -58,17 +130,24 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, input models.HTTPDele
 // LoginUser is the resolver for the loginUser field.
 func (r *mutationResolver) LoginUser(ctx context.Context, input modelsPostgres.UserLoginCredentials) (*models.JWTAuthResponse, error) {
 	var (
-		err       error
-		authToken *models.JWTAuthResponse
-		httpMsg   string
-		payload   any
+		err        error
+		authToken  *models.JWTAuthResponse
+		ginContext *gin.Context
+		httpMsg    string
+		payload    any
 	)
 
 	if err = validator.ValidateStruct(&input); err != nil {
 		return nil, fmt.Errorf("validation %w", err)
 	}
 
-	if authToken, httpMsg, _, payload, err = common.HTTPLoginUser(r.auth, r.db, r.logger, &input); err != nil {
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if authToken, httpMsg, _, payload, err = common.HTTPLoginUser(
+		r.auth, r.cache, r.db, r.logger, &input, ginContext.Request.UserAgent(), ginContext.ClientIP(),
+		ginContext.GetHeader(constants.OTPHeader())); err != nil {
 		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
 	}
 
@@ -83,15 +162,22 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*models.JWTAuthRes
 		clientID   uuid.UUID
 		expiresAt  int64
 		httpMsg    string
+		device     string
+		ipAddress  string
 	)
 
 	// Validate the JWT and extract the clientID. Compare the clientID against the deletion request login
 	// credentials.
-	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
 		return freshToken, errors.New("authorization failure")
 	}
 
-	if freshToken, httpMsg, _, err = common.HTTPRefreshLogin(r.auth, r.db, r.logger, clientID, expiresAt); err != nil {
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if freshToken, httpMsg, _, err = common.HTTPRefreshLogin(r.auth, r.db, r.logger, clientID, expiresAt, device,
+		ipAddress); err != nil {
 
 		return nil, errors.New(httpMsg)
 	}
@@ -99,7 +185,465 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*models.JWTAuthRes
 	return freshToken, nil
 }
 
+// RefreshSession is the resolver for the refreshSession field.
+func (r *mutationResolver) RefreshSession(ctx context.Context, refreshToken string) (*models.JWTAuthResponse, error) {
+	var (
+		err        error
+		authToken  *models.JWTAuthResponse
+		ginContext *gin.Context
+		httpMsg    string
+	)
+
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if authToken, httpMsg, _, err = common.HTTPRefreshSession(r.auth, r.db, r.logger,
+		&models.HTTPRefreshSessionRequest{RefreshToken: refreshToken},
+		ginContext.Request.UserAgent(), ginContext.ClientIP()); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return authToken, nil
+}
+
+// LogoutUser is the resolver for the logoutUser field.
+func (r *mutationResolver) LogoutUser(ctx context.Context) (string, error) {
+	var (
+		err        error
+		clientID   uuid.UUID
+		expiresAt  int64
+		ginContext *gin.Context
+		httpMsg    string
+	)
+
+	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMsg, err = common.HTTPLogout(
+		r.auth, r.cache, r.logger, clientID, ginContext.GetHeader(r.authHeaderKey), expiresAt); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "successfully logged out", nil
+}
+
+// LogoutAllSessions is the resolver for the logoutAllSessions field.
+func (r *mutationResolver) LogoutAllSessions(ctx context.Context) (string, error) {
+	var (
+		err        error
+		clientID   uuid.UUID
+		expiresAt  int64
+		ginContext *gin.Context
+		httpMsg    string
+	)
+
+	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMsg, err = common.HTTPLogoutAll(
+		r.auth, r.cache, r.db, r.logger, clientID, ginContext.GetHeader(r.authHeaderKey), expiresAt); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "successfully logged out of all sessions", nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, input models.HTTPChangePasswordRequest) (string, error) {
+	var (
+		clientID  uuid.UUID
+		err       error
+		httpMsg   string
+		otp       string
+		device    string
+		ipAddress string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if _, httpMsg, err = common.HTTPChangePassword(r.auth, r.cache, r.db, r.logger, clientID, &input, device,
+		ipAddress, otp); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "password changed, please log in again", nil
+}
+
+// RequestPasswordReset is the resolver for the requestPasswordReset field.
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, username string) (string, error) {
+	var (
+		err     error
+		httpMsg string
+	)
+
+	if _, httpMsg, err = common.HTTPPasswordResetRequest(r.auth, r.cache, r.db, r.notify, r.logger,
+		&models.HTTPPasswordResetRequest{Username: username}); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "a password reset token will be sent if the user account exists", nil
+}
+
+// ResetPassword is the resolver for the resetPassword field.
+func (r *mutationResolver) ResetPassword(ctx context.Context, input models.HTTPPasswordResetConfirmRequest) (string, error) {
+	var (
+		err       error
+		httpMsg   string
+		otp       string
+		device    string
+		ipAddress string
+	)
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if device, ipAddress, err = RequestOriginFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if _, httpMsg, err = common.HTTPPasswordReset(r.auth, r.cache, r.db, r.logger, &input, device, ipAddress,
+		otp); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "password reset, please log in", nil
+}
+
+// CreateAPIKey is the resolver for the createAPIKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input models.HTTPAPIKeyRequest) (*models.HTTPAPIKeyResponse, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		key         *models.HTTPAPIKeyResponse
+		otp         string
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if key, _, httpMessage, payload, err =
+		common.HTTPAPIKeyCreate(r.auth, r.db, r.logger, clientID, &input, otp); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey is the resolver for the revokeAPIKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, keyID string) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMessage, err = common.HTTPAPIKeyRevoke(r.db, r.logger, clientID, keyID); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return keyID, nil
+}
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, sessionID string) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMessage, err = common.HTTPSessionRevoke(r.db, r.logger, clientID, sessionID); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return sessionID, nil
+}
+
+// EnrollMfa is the resolver for the enrollMFA field.
+func (r *mutationResolver) EnrollMfa(ctx context.Context) (*models.HTTPMFAEnrollResponse, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		enrolment   *models.HTTPMFAEnrollResponse
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if enrolment, _, httpMessage, err = common.HTTPMFAEnroll(r.auth, r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return enrolment, nil
+}
+
+// ConfirmMfa is the resolver for the confirmMFA field.
+func (r *mutationResolver) ConfirmMfa(ctx context.Context, code string) ([]string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		codes       []string
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if codes, _, httpMessage, err = common.HTTPMFAConfirm(
+		r.auth, r.db, r.logger, clientID, &models.HTTPMFAConfirmRequest{Code: code}); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes is the resolver for the regenerateRecoveryCodes field.
+func (r *mutationResolver) RegenerateRecoveryCodes(ctx context.Context) ([]string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		codes       []string
+		otp         string
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if codes, _, httpMessage, err = common.HTTPMFARecoveryCodes(r.auth, r.db, r.logger, clientID, otp); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return codes, nil
+}
+
+// DisableMfa is the resolver for the disableMFA field.
+func (r *mutationResolver) DisableMfa(ctx context.Context) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		otp         string
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if _, httpMessage, err = common.HTTPMFADisable(r.auth, r.db, r.logger, clientID, otp); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return "two-factor authentication disabled", nil
+}
+
+// UpdateProfile is the resolver for the updateProfile field.
+func (r *mutationResolver) UpdateProfile(ctx context.Context, input models.HTTPUpdateProfileRequest) (*modelsPostgres.User, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		otp      string
+		profile  *modelsPostgres.User
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if otp, err = OneTimePasswordFromContext(ctx, r.logger); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if profile, _, httpMsg, err = common.HTTPUpdateProfile(
+		r.auth, r.db, r.notify, r.logger, clientID, &input, otp); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return profile, nil
+}
+
+// SendEmailVerification is the resolver for the sendEmailVerification field.
+func (r *mutationResolver) SendEmailVerification(ctx context.Context) (string, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMsg, err = common.HTTPSendEmailVerification(r.auth, r.db, r.notify, r.logger, clientID); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "email address verification link sent", nil
+}
+
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, token string) (string, error) {
+	var (
+		err     error
+		httpMsg string
+	)
+
+	if _, httpMsg, err = common.HTTPVerifyEmail(r.auth, r.db, r.logger, token); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "email address verified", nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*modelsPostgres.User, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		profile  *modelsPostgres.User
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if profile, _, httpMsg, err = common.HTTPUserProfile(r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return profile, nil
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context) ([]modelsPostgres.APIKeyInfo, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		keys        []modelsPostgres.APIKeyInfo
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if keys, _, httpMessage, err = common.HTTPAPIKeys(r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return keys, nil
+}
+
+// Sessions is the resolver for the sessions field.
+func (r *queryResolver) Sessions(ctx context.Context) ([]modelsPostgres.SessionInfo, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		sessions    []modelsPostgres.SessionInfo
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if sessions, _, httpMessage, err = common.HTTPSessions(r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return sessions, nil
+}
+
+// SecurityActivity is the resolver for the securityActivity field.
+func (r *queryResolver) SecurityActivity(ctx context.Context) ([]modelsPostgres.SecurityEvent, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		events      []modelsPostgres.SecurityEvent
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if events, _, httpMessage, err = common.HTTPSecurityActivity(r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return events, nil
+}
+
+// APIKey returns graphql_generated.APIKeyResolver implementation.
+func (r *Resolver) APIKey() graphql_generated.APIKeyResolver { return &aPIKeyResolver{r} }
+
 // Mutation returns graphql_generated.MutationResolver implementation.
 func (r *Resolver) Mutation() graphql_generated.MutationResolver { return &mutationResolver{r} }
 
+// SecurityEvent returns graphql_generated.SecurityEventResolver implementation.
+func (r *Resolver) SecurityEvent() graphql_generated.SecurityEventResolver {
+	return &securityEventResolver{r}
+}
+
+// Session returns graphql_generated.SessionResolver implementation.
+func (r *Resolver) Session() graphql_generated.SessionResolver { return &sessionResolver{r} }
+
+// User returns graphql_generated.UserResolver implementation.
+func (r *Resolver) User() graphql_generated.UserResolver { return &userResolver{r} }
+
+type aPIKeyResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type securityEventResolver struct{ *Resolver }
+type sessionResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -14,13 +14,17 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestUserResolver_RegisterUser(t *testing.T) {
@@ -37,6 +41,7 @@ func TestUserResolver_RegisterUser(t *testing.T) {
 		userRegisterTimes int
 		authGenJWTErr     error
 		authGenJWTTimes   int
+		sessionTimes      int
 	}{
 		{
 			name:              "empty user",
@@ -61,6 +66,7 @@ func TestUserResolver_RegisterUser(t *testing.T) {
 			userRegisterTimes: 1,
 			authGenJWTErr:     nil,
 			authGenJWTTimes:   1,
+			sessionTimes:      1,
 		}, {
 			name: "password hash failure",
 			path: "/register/pwd-hash-failure",
@@ -112,6 +118,7 @@ func TestUserResolver_RegisterUser(t *testing.T) {
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
 			gomock.InOrder(
 				mockAuth.EXPECT().HashPassword(gomock.Any()).
@@ -122,14 +129,36 @@ func TestUserResolver_RegisterUser(t *testing.T) {
 					Return(uuid.UUID{}, test.userRegisterErr).
 					Times(test.userRegisterTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("refresh-token", "refresh-token-hash", nil).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshTokenCreate(gomock.Any()).
+					Return(nil).
+					Times(test.sessionTimes),
+
+				mockAuth.EXPECT().GenerateEmailVerificationToken(gomock.Any(), "email@address.com").
+					Return("verification-token", nil).
+					Times(test.sessionTimes),
+
+				mockNotifier.EXPECT().Link(gomock.Any(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sessionTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(nil).
+					Times(test.sessionTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockNotifier, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.user))
 			req.Header.Set("Content-Type", "application/json")
@@ -383,8 +412,10 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			authToken := xid.New().String()
 
@@ -393,8 +424,8 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
@@ -405,6 +436,10 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.deleteUserTimes),
+
 				mockPostgres.EXPECT().UserDelete(gomock.Any()).
 					Return(test.deleteUserErr).
 					Times(test.deleteUserTimes),
@@ -413,7 +448,8 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.query))
 			req.Header.Set("Content-Type", "application/json")
@@ -457,6 +493,12 @@ func TestUserResolver_LoginUser(t *testing.T) {
 		authCheckPassTimes     int
 		authGenJWTErr          error
 		authGenJWTTimes        int
+		sessionErr             error
+		sessionTimes           int
+		throttleTimes          int
+		lockedOut              bool
+		failureTimes           int
+		clearTimes             int
 	}{
 		{
 			name:                   "empty user",
@@ -469,6 +511,9 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			authCheckPassTimes:     0,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        0,
+			throttleTimes:          0,
+			failureTimes:           0,
+			clearTimes:             0,
 		}, {
 			name:                   "valid user",
 			path:                   "/login/valid-user",
@@ -480,6 +525,11 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			authCheckPassTimes:     1,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        1,
+			throttleTimes:          3,
+			failureTimes:           0,
+			clearTimes:             1,
+			sessionErr:             nil,
+			sessionTimes:           1,
 		},
 		{
 			name:                   "database failure",
@@ -492,6 +542,9 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			authCheckPassTimes:     0,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        0,
+			throttleTimes:          3,
+			failureTimes:           1,
+			clearTimes:             0,
 		}, {
 			name:                   "password check failure",
 			path:                   "/login/pwd-check-failure",
@@ -503,6 +556,9 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			authCheckPassTimes:     1,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        0,
+			throttleTimes:          3,
+			failureTimes:           1,
+			clearTimes:             0,
 		}, {
 			name:                   "auth token failure",
 			path:                   "/login/auth-token-failure",
@@ -514,6 +570,42 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			authCheckPassTimes:     1,
 			authGenJWTErr:          errors.New("auth token failure"),
 			authGenJWTTimes:        1,
+			throttleTimes:          3,
+			failureTimes:           0,
+			clearTimes:             1,
+			sessionErr:             nil,
+			sessionTimes:           0,
+		}, {
+			name:                   "session failure",
+			path:                   "/login/session-failure",
+			user:                   fmt.Sprintf(testUserQuery["login"], "username999", "password999"),
+			expectErr:              true,
+			userCredentialsReadErr: nil,
+			userCredentialsTimes:   1,
+			authCheckPassErr:       nil,
+			authCheckPassTimes:     1,
+			authGenJWTErr:          nil,
+			authGenJWTTimes:        1,
+			throttleTimes:          3,
+			failureTimes:           0,
+			clearTimes:             1,
+			sessionErr:             errors.New("session failure"),
+			sessionTimes:           1,
+		}, {
+			name:                   "locked out",
+			path:                   "/login/locked-out",
+			user:                   fmt.Sprintf(testUserQuery["login"], "username999", "password999"),
+			expectErr:              true,
+			userCredentialsReadErr: nil,
+			userCredentialsTimes:   0,
+			authCheckPassErr:       nil,
+			authCheckPassTimes:     0,
+			authGenJWTErr:          nil,
+			authGenJWTTimes:        0,
+			throttleTimes:          3,
+			lockedOut:              true,
+			failureTimes:           0,
+			clearTimes:             0,
 		},
 	}
 
@@ -528,10 +620,38 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
 
+			getErr := redis.ErrCacheMiss
+			if test.lockedOut {
+				getErr = nil
+			}
+
+			mockAuth.EXPECT().LoginThrottle().
+				Return(auth.LoginThrottle{MaxUserFailures: 5, MaxIPFailures: 20, BaseDelay: time.Second,
+					MaxDelay: time.Minute}).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Incr(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(2 * test.failureTimes)
+
+			mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), time.Second).
+				Return(nil).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Del(gomock.Any()).
+				Return(redis.ErrCacheMiss).
+				Times(2 * test.clearTimes)
+
 			gomock.InOrder(
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+					SetArg(1, time.Now().Add(time.Minute).Unix()).
+					Return(getErr).
+					Times(test.throttleTimes),
+
 				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
 					Return(uuid.UUID{}, "hashed-password", test.userCredentialsReadErr).
 					Times(test.userCredentialsTimes),
@@ -540,14 +660,32 @@ func TestUserResolver_LoginUser(t *testing.T) {
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleUser()}, nil).
+					Times(test.authGenJWTTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("refresh-token", "refresh-token-hash", nil).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshTokenCreate(gomock.Any()).
+					Return(test.sessionErr).
+					Times(test.sessionTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.user))
 			req.Header.Set("Content-Type", "application/json")
@@ -767,8 +905,10 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
 
 			gomock.InOrder(
 				// JWT check.
@@ -776,8 +916,8 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 					Return(uuid.UUID{}, test.authValidateJWTExp, test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
-				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue}, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
 				// Database call for user record.
@@ -791,7 +931,7 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 					Times(test.authRefThresholdTimes),
 
 				// Generate fresh JWT.
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
 			)
@@ -799,7 +939,8 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(testUserQuery["refresh"]))
@@ -821,3 +962,2284 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 		})
 	}
 }
+
+func TestUserResolver_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		encryptTimes       int
+		createErr          error
+		createTimes        int
+	}{
+		{
+			name: "invalid jwt",
+			path: "/create-api-key/invalid-jwt",
+			query: fmt.Sprintf(testUserQuery["createAPIKey"], "bot", `\"read\"`, `\"10.0.0.0/8\"`,
+				time.Now().Add(time.Hour).Unix()),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			encryptTimes:       0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name: "invalid scope",
+			path: "/create-api-key/invalid-scope",
+			query: fmt.Sprintf(testUserQuery["createAPIKey"], "bot", `\"withdraw\"`, "",
+				time.Now().Add(time.Hour).Unix()),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			encryptTimes:       0,
+			createErr:          nil,
+			createTimes:        0,
+		}, {
+			name: "key limit",
+			path: "/create-api-key/key-limit",
+			query: fmt.Sprintf(testUserQuery["createAPIKey"], "bot", `\"read\"`, "",
+				time.Now().Add(time.Hour).Unix()),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			encryptTimes:       1,
+			createErr:          postgres.ErrAPIKeyLimit,
+			createTimes:        1,
+		}, {
+			name: "valid",
+			path: "/create-api-key/valid",
+			query: fmt.Sprintf(testUserQuery["createAPIKey"], "bot", `\"read\", \"trade\"`, `\"10.0.0.0/8\"`,
+				time.Now().Add(time.Hour).Unix()),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			authValidateTimes:  1,
+			isDeletedTimes:     1,
+			encryptTimes:       1,
+			createErr:          nil,
+			createTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.encryptTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-secret", nil).
+					Times(test.encryptTimes),
+
+				mockPostgres.EXPECT().APIKeyCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_RevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		keyID              string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		revokeErr          error
+		revokeTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/revoke-api-key/invalid-jwt",
+			keyID:              "key-id",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "invalid key id",
+			path:               "/revoke-api-key/invalid-key-id",
+			keyID:              "",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "not found",
+			path:               "/revoke-api-key/not-found",
+			keyID:              "key-id",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			revokeErr:          postgres.ErrNotFound,
+			revokeTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/revoke-api-key/valid",
+			keyID:              "key-id",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			revokeErr:          nil,
+			revokeTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().APIKeyRevoke(gomock.Any(), test.keyID).
+					Return(test.revokeErr).
+					Times(test.revokeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["revokeAPIKey"], test.keyID)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		keysErr            error
+		keysTimes          int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/api-keys/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			keysErr:            nil,
+			keysTimes:          0,
+		}, {
+			name:               "db failure",
+			path:               "/api-keys/db-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			keysErr:            errors.New("db failure"),
+			keysTimes:          1,
+		}, {
+			name:               "valid",
+			path:               "/api-keys/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			keysErr:            nil,
+			keysTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().APIKeysClient(gomock.Any()).
+					Return([]modelsPostgres.APIKeyInfo{{KeyID: "key-id", Scopes: []string{"read"}}}, test.keysErr).
+					Times(test.keysTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["apiKeys"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_RefreshSession(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		path         string
+		refreshToken string
+		expectErr    bool
+		rotateErr    error
+		rotateTimes  int
+		jwtTimes     int
+	}{
+		{
+			name:         "empty refresh token",
+			path:         "/refresh-session/empty-refresh-token",
+			refreshToken: "",
+			expectErr:    true,
+			rotateErr:    nil,
+			rotateTimes:  0,
+			jwtTimes:     0,
+		}, {
+			name:         "reused refresh token",
+			path:         "/refresh-session/reused-refresh-token",
+			refreshToken: "refresh-token",
+			expectErr:    true,
+			rotateErr:    postgres.ErrRefreshTokenReuse,
+			rotateTimes:  1,
+			jwtTimes:     0,
+		}, {
+			name:         "valid",
+			path:         "/refresh-session/valid",
+			refreshToken: "refresh-token",
+			expectErr:    false,
+			rotateErr:    nil,
+			rotateTimes:  1,
+			jwtTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("new-refresh-token", "new-refresh-token-hash", nil).
+					Times(test.rotateTimes),
+
+				mockAuth.EXPECT().HashRefreshToken(test.refreshToken).
+					Return("refresh-token-hash").
+					Times(test.rotateTimes),
+
+				mockPostgres.EXPECT().RefreshTokenRotate("refresh-token-hash", gomock.Any()).
+					Return(test.rotateErr).
+					Times(test.rotateTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleUser()}, nil).
+					Times(test.jwtTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
+					Return(&models.JWTAuthResponse{}, nil).
+					Times(test.jwtTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["refreshSession"], test.refreshToken)))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		sessionID          string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		revokeErr          error
+		revokeTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/revoke-session/invalid-jwt",
+			sessionID:          "session-id",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "invalid session id",
+			path:               "/revoke-session/invalid-session-id",
+			sessionID:          "",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "not found",
+			path:               "/revoke-session/not-found",
+			sessionID:          "session-id",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			revokeErr:          postgres.ErrNotFound,
+			revokeTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/revoke-session/valid",
+			sessionID:          "session-id",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			revokeErr:          nil,
+			revokeTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().RefreshSessionRevoke(gomock.Any(), test.sessionID).
+					Return(test.revokeErr).
+					Times(test.revokeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["revokeSession"], test.sessionID)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_Sessions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		sessionsErr        error
+		sessionsTimes      int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/sessions/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			sessionsErr:        nil,
+			sessionsTimes:      0,
+		}, {
+			name:               "db failure",
+			path:               "/sessions/db-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			sessionsErr:        errors.New("db failure"),
+			sessionsTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/sessions/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			sessionsErr:        nil,
+			sessionsTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsClient(gomock.Any()).
+					Return([]modelsPostgres.SessionInfo{{SessionID: "session-id", Device: "device"}}, test.sessionsErr).
+					Times(test.sessionsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["sessions"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_SecurityActivity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		eventsErr          error
+		eventsTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/security-activity/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			eventsErr:          nil,
+			eventsTimes:        0,
+		}, {
+			name:               "db failure",
+			path:               "/security-activity/db-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			eventsErr:          errors.New("db failure"),
+			eventsTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/security-activity/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			eventsErr:          nil,
+			eventsTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().AuditEventsRecent(gomock.Any(), gomock.Any()).
+					Return([]modelsPostgres.SecurityEvent{{EventType: "LOGIN", Outcome: "SUCCESS"}}, test.eventsErr).
+					Times(test.eventsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["securityActivity"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_Logout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		cacheSetKey        string
+		expectErr          bool
+		authValidateJWTErr error
+		sessionTimes       int
+		denylistErr        error
+		denylistTimes      int
+		revokedTimes       int
+		isDeletedTimes     int
+		revokeAllTimes     int
+		cacheSetErr        error
+		cacheSetTimes      int
+	}{
+		{
+			name:               "logout - invalid jwt",
+			path:               "/logout/invalid-jwt",
+			query:              testUserQuery["logout"],
+			cacheSetKey:        "jwt-denylist:token-id",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			sessionTimes:       0,
+			denylistErr:        nil,
+			denylistTimes:      0,
+			revokedTimes:       0,
+			isDeletedTimes:     0,
+			revokeAllTimes:     0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "logout - revoked jwt",
+			path:               "/logout/revoked-jwt",
+			query:              testUserQuery["logout"],
+			cacheSetKey:        "jwt-denylist:token-id",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			sessionTimes:       1,
+			denylistErr:        nil,
+			denylistTimes:      1,
+			revokedTimes:       0,
+			isDeletedTimes:     0,
+			revokeAllTimes:     0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "logout - cache failure",
+			path:               "/logout/cache-failure",
+			query:              testUserQuery["logout"],
+			cacheSetKey:        "jwt-denylist:token-id",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			sessionTimes:       2,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedTimes:       1,
+			isDeletedTimes:     1,
+			revokeAllTimes:     0,
+			cacheSetErr:        redis.ErrCacheSet,
+			cacheSetTimes:      1,
+		}, {
+			name:               "logout - valid",
+			path:               "/logout/valid",
+			query:              testUserQuery["logout"],
+			cacheSetKey:        "jwt-denylist:token-id",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			sessionTimes:       2,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedTimes:       1,
+			isDeletedTimes:     1,
+			revokeAllTimes:     0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      1,
+		}, {
+			name:               "logout all - invalid jwt",
+			path:               "/logout-all/invalid-jwt",
+			query:              testUserQuery["logoutAll"],
+			cacheSetKey:        "jwt-revoked-before:" + uuid.UUID{}.String(),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			sessionTimes:       0,
+			denylistErr:        nil,
+			denylistTimes:      0,
+			revokedTimes:       0,
+			isDeletedTimes:     0,
+			revokeAllTimes:     0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "logout all - cache failure",
+			path:               "/logout-all/cache-failure",
+			query:              testUserQuery["logoutAll"],
+			cacheSetKey:        "jwt-revoked-before:" + uuid.UUID{}.String(),
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			sessionTimes:       2,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedTimes:       1,
+			isDeletedTimes:     1,
+			revokeAllTimes:     1,
+			cacheSetErr:        redis.ErrCacheSet,
+			cacheSetTimes:      1,
+		}, {
+			name:               "logout all - valid",
+			path:               "/logout-all/valid",
+			query:              testUserQuery["logoutAll"],
+			cacheSetKey:        "jwt-revoked-before:" + uuid.UUID{}.String(),
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			sessionTimes:       2,
+			denylistErr:        redis.ErrCacheMiss,
+			denylistTimes:      1,
+			revokedTimes:       1,
+			isDeletedTimes:     1,
+			revokeAllTimes:     1,
+			cacheSetErr:        nil,
+			cacheSetTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+
+			// Session information is extracted to check for and then record the revocation.
+			mockAuth.EXPECT().TokenSession("some valid auth token goes here").
+				Return("token-id", time.Now().Add(-time.Minute).UnixMilli(), nil).
+				Times(test.sessionTimes)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authValidateJWTErr).
+					Times(1),
+
+				mockRedis.EXPECT().Get("jwt-denylist:token-id", gomock.Any()).
+					Return(test.denylistErr).
+					Times(test.denylistTimes),
+
+				mockRedis.EXPECT().Get("jwt-revoked-before:"+uuid.UUID{}.String(), gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.revokedTimes),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsRevokeAll(gomock.Any()).
+					Return(nil).
+					Times(test.revokeAllTimes),
+
+				mockRedis.EXPECT().Set(test.cacheSetKey, gomock.Any(), gomock.Any()).
+					Return(test.cacheSetErr).
+					Times(test.cacheSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_EnrollMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		enrollErr          error
+		enrollTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/enroll-mfa/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			enrollErr:          nil,
+			enrollTimes:        0,
+		}, {
+			name:               "already enabled",
+			path:               "/enroll-mfa/already-enabled",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			enrollErr:          postgres.ErrMFAEnrolled,
+			enrollTimes:        1,
+		}, {
+			name:               "valid",
+			path:               "/enroll-mfa/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			enrollErr:          nil,
+			enrollTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.enrollTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-secret", nil).
+					Times(test.enrollTimes),
+
+				mockPostgres.EXPECT().MFAEnroll(gomock.Any(), "encrypted-secret").
+					Return(test.enrollErr).
+					Times(test.enrollTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["enrollMFA"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				enrolment, ok := data.(map[string]any)["enrollMFA"].(map[string]any)
+				require.True(t, ok, "failed to extract enrolment.")
+				require.Contains(t, enrolment["uri"], enrolment["secret"], "secret missing from URI.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_ConfirmMFA(t *testing.T) {
+	t.Parallel()
+
+	secret, err := auth.NewTOTPSecret()
+	require.NoError(t, err, "failed to generate TOTP secret.")
+
+	code, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now()))
+	require.NoError(t, err, "failed to generate one-time password.")
+
+	testCases := []struct {
+		name               string
+		path               string
+		code               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		getErr             error
+		getTimes           int
+		confirmTimes       int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/confirm-mfa/invalid-jwt",
+			code:               code,
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			getErr:             nil,
+			getTimes:           0,
+			confirmTimes:       0,
+		}, {
+			name:               "validation",
+			path:               "/confirm-mfa/validation",
+			code:               "abc",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			getErr:             nil,
+			getTimes:           0,
+			confirmTimes:       0,
+		}, {
+			name:               "not enrolled",
+			path:               "/confirm-mfa/not-enrolled",
+			code:               code,
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+			confirmTimes:       0,
+		}, {
+			name:               "valid",
+			path:               "/confirm-mfa/valid",
+			code:               code,
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			getErr:             nil,
+			getTimes:           1,
+			confirmTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{Secret: "encrypted-secret"}, test.getErr).
+					Times(test.getTimes),
+
+				mockAuth.EXPECT().DecryptFromString("encrypted-secret").
+					Return([]byte(secret), nil).
+					Times(test.confirmTimes),
+
+				mockPostgres.EXPECT().MFAConfirm(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.confirmTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["confirmMFA"], test.code)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				codes, ok := data.(map[string]any)["confirmMFA"].([]any)
+				require.True(t, ok, "failed to extract recovery codes.")
+				require.Len(t, codes, constants.RecoveryCodeCount(), "recovery code count mismatched.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_RegenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		otp                string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		useCodeTimes       int
+		setErr             error
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/regenerate-recovery-codes/invalid-jwt",
+			otp:                "abcde-fghij",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			useCodeTimes:       0,
+			setErr:             nil,
+		}, {
+			name:               "code required",
+			path:               "/regenerate-recovery-codes/code-required",
+			otp:                "",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			useCodeTimes:       0,
+			setErr:             nil,
+		}, {
+			name:               "db failure",
+			path:               "/regenerate-recovery-codes/db-failure",
+			otp:                "abcde-fghij",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			useCodeTimes:       1,
+			setErr:             postgres.ErrMFA,
+		}, {
+			name:               "valid",
+			path:               "/regenerate-recovery-codes/valid",
+			otp:                "abcde-fghij",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			useCodeTimes:       1,
+			setErr:             nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), auth.HashRecoveryCode(test.otp)).
+					Return(nil).
+					Times(test.useCodeTimes),
+
+				mockPostgres.EXPECT().MFASetRecoveryCodes(gomock.Any(), gomock.Any()).
+					Return(test.setErr).
+					Times(test.useCodeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["regenerateRecoveryCodes"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			req.Header.Set(constants.OTPHeader(), test.otp)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_DisableMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		otp                string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		useCodeErr         error
+		useCodeTimes       int
+		deleteTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/disable-mfa/invalid-jwt",
+			otp:                "abcde-fghij",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			useCodeErr:         nil,
+			useCodeTimes:       0,
+			deleteTimes:        0,
+		}, {
+			name:               "used recovery code",
+			path:               "/disable-mfa/used-recovery-code",
+			otp:                "abcde-fghij",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			useCodeErr:         postgres.ErrNotFound,
+			useCodeTimes:       1,
+			deleteTimes:        0,
+		}, {
+			name:               "valid",
+			path:               "/disable-mfa/valid",
+			otp:                "abcde-fghij",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			useCodeErr:         nil,
+			useCodeTimes:       1,
+			deleteTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), auth.HashRecoveryCode(test.otp)).
+					Return(test.useCodeErr).
+					Times(test.useCodeTimes),
+
+				mockPostgres.EXPECT().MFADelete(gomock.Any()).
+					Return(nil).
+					Times(test.deleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["disableMFA"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			req.Header.Set(constants.OTPHeader(), test.otp)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.Equal(t, "two-factor authentication disabled", data.(map[string]any)["disableMFA"],
+					"confirmation message does not match expected.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		currentPassword    string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		checkPassErr       error
+		updateTimes        int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/change-password/invalid-jwt",
+			currentPassword:    "current-password",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			checkPassErr:       nil,
+			updateTimes:        0,
+		}, {
+			name:               "unchanged password",
+			path:               "/change-password/unchanged-password",
+			currentPassword:    "new-password",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			checkPassErr:       nil,
+			updateTimes:        0,
+		}, {
+			name:               "incorrect current password",
+			path:               "/change-password/incorrect-current-password",
+			currentPassword:    "current-password",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			checkPassErr:       errors.New("password mismatch"),
+			updateTimes:        0,
+		}, {
+			name:               "valid",
+			path:               "/change-password/valid",
+			currentPassword:    "current-password",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			checkPassErr:       nil,
+			updateTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			checkPassTimes := test.updateTimes
+			if test.checkPassErr != nil {
+				checkPassTimes = 1
+			}
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(checkPassTimes),
+
+				mockAuth.EXPECT().CheckPassword(gomock.Any(), test.currentPassword).
+					Return(test.checkPassErr).
+					Times(checkPassTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("hashed password", nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "hashed password").
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsRevokeAll(gomock.Any()).
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.updateTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.updateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["changePassword"], test.currentPassword, "new-password")))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.Equal(t, "password changed, please log in again", data.(map[string]any)["changePassword"],
+					"confirmation message does not match expected.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		path       string
+		username   string
+		expectErr  bool
+		credsErr   error
+		credsTimes int
+		resetTimes int
+		sendErr    error
+	}{
+		{
+			name:       "validation",
+			path:       "/request-password-reset/validation",
+			username:   "short",
+			expectErr:  true,
+			credsErr:   nil,
+			credsTimes: 0,
+			resetTimes: 0,
+			sendErr:    nil,
+		}, {
+			name:       "unknown user",
+			path:       "/request-password-reset/unknown-user",
+			username:   "username1",
+			expectErr:  false,
+			credsErr:   postgres.ErrLoginUser,
+			credsTimes: 1,
+			resetTimes: 0,
+			sendErr:    nil,
+		}, {
+			name:       "notifier failure",
+			path:       "/request-password-reset/notifier-failure",
+			username:   "username1",
+			expectErr:  true,
+			credsErr:   nil,
+			credsTimes: 1,
+			resetTimes: 1,
+			sendErr:    errors.New("unknown error"),
+		}, {
+			name:       "valid",
+			path:       "/request-password-reset/valid",
+			username:   "username1",
+			expectErr:  false,
+			credsErr:   nil,
+			credsTimes: 1,
+			resetTimes: 1,
+			sendErr:    nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserCredentials(test.username).
+					Return(uuid.UUID{}, "hashed password", test.credsErr).
+					Times(test.credsTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{Email: "user@ftex.com"}}, nil).
+					Times(test.resetTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("reset-token", "reset-token-hash", nil).
+					Times(test.resetTimes),
+
+				mockRedis.EXPECT().Set("password-reset:reset-token-hash", gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.resetTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(test.sendErr).
+					Times(test.resetTimes),
+			)
+
+			if test.sendErr != nil {
+				mockRedis.EXPECT().Del("password-reset:reset-token-hash").Return(nil).Times(1)
+			}
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockNotifier, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["requestPasswordReset"], test.username)))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.Equal(t, "a password reset token will be sent if the user account exists",
+					data.(map[string]any)["requestPasswordReset"], "confirmation message does not match expected.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name        string
+		path        string
+		expectErr   bool
+		getErr      error
+		getDelErr   error
+		getDelTimes int
+		updateTimes int
+	}{
+		{
+			name:        "unknown token",
+			path:        "/reset-password/unknown-token",
+			expectErr:   true,
+			getErr:      redis.ErrCacheMiss,
+			getDelErr:   nil,
+			getDelTimes: 0,
+			updateTimes: 0,
+		}, {
+			name:        "token already used",
+			path:        "/reset-password/token-already-used",
+			expectErr:   true,
+			getErr:      nil,
+			getDelErr:   redis.ErrCacheMiss,
+			getDelTimes: 1,
+			updateTimes: 0,
+		}, {
+			name:        "valid",
+			path:        "/reset-password/valid",
+			expectErr:   false,
+			getErr:      nil,
+			getDelErr:   nil,
+			getDelTimes: 1,
+			updateTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().HashRefreshToken("reset-token").
+					Return("reset-token-hash").
+					Times(1),
+
+				mockRedis.EXPECT().Get("password-reset:reset-token-hash", gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						stored, ok := value.(*string)
+						require.True(t, ok, "client id destination is not a string.")
+						*stored = clientID.String()
+
+						return test.getErr
+					}).
+					Times(1),
+
+				mockPostgres.EXPECT().MFAGet(clientID).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.getDelTimes),
+
+				mockRedis.EXPECT().GetDel("password-reset:reset-token-hash", gomock.Any()).
+					Return(test.getDelErr).
+					Times(test.getDelTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("hashed password", nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(clientID, "hashed password").
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsRevokeAll(clientID).
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.updateTimes),
+
+				mockRedis.EXPECT().Set("jwt-revoked-before:"+clientID.String(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.updateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["resetPassword"], "reset-token", "new-password")))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.Equal(t, "password reset, please log in", data.(map[string]any)["resetPassword"],
+					"confirmation message does not match expected.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_Me(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		userInfoErr        error
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/me/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+			isDeletedTimes:     0,
+			userInfoErr:        nil,
+		}, {
+			name:               "database failure",
+			path:               "/me/database-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			userInfoErr:        postgres.ErrNotFoundUser,
+		}, {
+			name:               "valid",
+			path:               "/me/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			userInfoErr:        nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{
+						UserAccount: &modelsPostgres.UserAccount{
+							UserLoginCredentials: modelsPostgres.UserLoginCredentials{
+								Username: "username1",
+								Password: "hashed password",
+							},
+							Email: "user@ftex.com",
+						},
+						Role: "user",
+					}, test.userInfoErr).
+					Times(test.isDeletedTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["me"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.NotContains(t, recorder.Body.String(), "hashed password", "hashed password returned.")
+				require.Equal(t, "username1", data.(map[string]any)["me"].(map[string]any)["username"],
+					"username does not match expected.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_UpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		firstName          string
+		email              string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		userInfoTimes      int
+		mfaTimes           int
+		updateErr          error
+		updateTimes        int
+		sendTimes          int
+		profileTimes       int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/update-profile/invalid-jwt",
+			firstName:          "first name",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+		}, {
+			name:               "empty request",
+			path:               "/update-profile/empty-request",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+		}, {
+			name:               "email address registered",
+			path:               "/update-profile/email-address-registered",
+			email:              "new-user@ftex.com",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			userInfoTimes:      1,
+			mfaTimes:           1,
+			updateErr:          postgres.ErrEmailRegistered,
+			updateTimes:        1,
+		}, {
+			name:               "names only",
+			path:               "/update-profile/names-only",
+			firstName:          "first name",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			userInfoTimes:      1,
+			updateTimes:        1,
+			profileTimes:       1,
+		}, {
+			name:               "email address change",
+			path:               "/update-profile/email-address-change",
+			firstName:          "first name",
+			email:              "new-user@ftex.com",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			userInfoTimes:      1,
+			mfaTimes:           1,
+			updateTimes:        1,
+			sendTimes:          1,
+			profileTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			userAccount := modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{Email: "user@ftex.com"}}
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(userAccount, nil).
+					Times(test.userInfoTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.mfaTimes),
+
+				mockPostgres.EXPECT().UserUpdateProfile(gomock.Any(), test.firstName, "", test.email).
+					Return(test.updateErr).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().GenerateEmailVerificationToken(gomock.Any(), test.email).
+					Return("verification-token", nil).
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Link(gomock.Any(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(nil).
+					Times(test.sendTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(userAccount, nil).
+					Times(test.profileTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockNotifier, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["updateProfile"], test.firstName, "", test.email)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.NotNil(t, data.(map[string]any)["updateProfile"], "profile not returned.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_SendEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectErr          bool
+		authValidateJWTErr error
+		isDeletedTimes     int
+		emailVerified      bool
+		sendErr            error
+		sendTimes          int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/send-email-verification/invalid-jwt",
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid token"),
+		}, {
+			name:               "already verified",
+			path:               "/send-email-verification/already-verified",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			emailVerified:      true,
+		}, {
+			name:               "notifier failure",
+			path:               "/send-email-verification/notifier-failure",
+			expectErr:          true,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			sendErr:            errors.New("notifier failure"),
+			sendTimes:          1,
+		}, {
+			name:               "valid",
+			path:               "/send-email-verification/valid",
+			expectErr:          false,
+			authValidateJWTErr: nil,
+			isDeletedTimes:     1,
+			sendTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			expectActiveSession(mockAuth, mockRedis)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{
+						UserAccount:   &modelsPostgres.UserAccount{Email: "user@ftex.com"},
+						EmailVerified: test.emailVerified,
+					}, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().GenerateEmailVerificationToken(gomock.Any(), "user@ftex.com").
+					Return("verification-token", nil).
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Link(gomock.Any(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(test.sendErr).
+					Times(test.sendTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockNotifier, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["sendEmailVerification"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.Equal(t, "email address verification link sent",
+					data.(map[string]any)["sendEmailVerification"], "confirmation message does not match expected.")
+			}
+		})
+	}
+}
+
+func TestUserResolver_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		path        string
+		expectErr   bool
+		validateErr error
+		verifyErr   error
+		verifyTimes int
+	}{
+		{
+			name:        "invalid token",
+			path:        "/verify-email/invalid-token",
+			expectErr:   true,
+			validateErr: errors.New("token has expired"),
+			verifyErr:   nil,
+			verifyTimes: 0,
+		}, {
+			name:        "email address changed",
+			path:        "/verify-email/email-address-changed",
+			expectErr:   true,
+			validateErr: nil,
+			verifyErr:   postgres.ErrNotFoundUser,
+			verifyTimes: 1,
+		}, {
+			name:        "valid",
+			path:        "/verify-email/valid",
+			expectErr:   false,
+			validateErr: nil,
+			verifyErr:   nil,
+			verifyTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateEmailVerificationToken("verification-token").
+					Return(uuid.UUID{}, "user@ftex.com", test.validateErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserVerifyEmail(gomock.Any(), "user@ftex.com").
+					Return(test.verifyErr).
+					Times(test.verifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, nil, mockQuotes, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testUserQuery["verifyEmail"], "verification-token")))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				require.Equal(t, "email address verified", data.(map[string]any)["verifyEmail"],
+					"confirmation message does not match expected.")
+			}
+		})
+	}
+}
@@ -16,7 +16,14 @@ server:
   writeTimeout: 3s
   readHeaderTimeout: 3s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"out of range port": `
 server:
@@ -29,7 +36,14 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"out of range time delay": `
 server:
@@ -42,7 +56,14 @@ server:
   writeTimeout: 0s
   readHeaderTimeout: 0s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no base path": `
 server:
@@ -54,7 +75,14 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no playground path": `
 server:
@@ -66,7 +94,14 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no query path": `
 server:
@@ -78,7 +113,14 @@ server:
   writeTimeout: 3s
   readHeaderTimeout: 3s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no read timeout": `
 server:
@@ -90,7 +132,14 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no write timeout": `
 server:
@@ -102,7 +151,14 @@ server:
   readTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no read header timeout": `
 server:
@@ -114,7 +170,14 @@ server:
   readTimeout: 1s
   writeTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
 
 		"no auth header": `
 server:
@@ -127,6 +190,45 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey:`,
+  headerKey:
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - name: offerCrypto
+      limit: 10
+      window: 60s`,
+
+		"no rate limit": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  playgroundPath: /playground
+  queryPath: /query
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization`,
+
+		"invalid operation rate limit": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  playgroundPath: /playground
+  queryPath: /query
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  operations:
+    - limit: 0
+      window: 60s`,
 	}
 }
@@ -0,0 +1,111 @@
+package ledger
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// Checkpointer is the background worker that periodically records signed checkpoints of the Fiat and Crypto journal
+// hash chain heads.
+type Checkpointer struct {
+	db       postgres.Postgres
+	auth     auth.Auth
+	logger   *logger.Logger
+	wg       *sync.WaitGroup
+	interval time.Duration
+}
+
+// NewCheckpointer will create a new journal hash chain checkpointer in a non-running state.
+func NewCheckpointer(db postgres.Postgres, auth auth.Auth, logger *logger.Logger, wg *sync.WaitGroup) (
+	*Checkpointer, error) {
+	if db == nil || auth == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, authorization, logger, or wait group supplied")
+	}
+
+	return &Checkpointer{
+		db:       db,
+		auth:     auth,
+		logger:   logger,
+		wg:       wg,
+		interval: constants.LedgerCheckpointInterval(),
+	}, nil
+}
+
+// Run will checkpoint the journal hash chain heads on start and then periodically until an interrupt signal is
+// received.
+func (c *Checkpointer) Run() {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	// Wait for interrupt signal to gracefully shut down the checkpointer.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	c.checkpoint(time.Now())
+
+	for {
+		select {
+		case <-quit:
+			c.logger.Info("Journal checkpointer exited")
+
+			return
+		case <-ticker.C:
+			c.checkpoint(time.Now())
+		}
+	}
+}
+
+// checkpoint will sign and record the heads of the journal hash chains that have entries. Heads that have not advanced
+// since the last checkpoint are not recorded again. Failures are retried on the next run.
+func (c *Checkpointer) checkpoint(now time.Time) {
+	heads, err := c.db.JournalChainHeads()
+	if err != nil {
+		c.logger.Warn("failed to retrieve journal chain heads for checkpoint", zap.Error(err))
+
+		return
+	}
+
+	for _, head := range heads {
+		if head.Seq == 0 {
+			continue
+		}
+
+		checkpoint := &postgres.JournalCheckpoint{
+			Journal:   head.Journal,
+			Seq:       head.Seq,
+			EntryHash: head.EntryHash,
+			KeyID:     c.auth.CheckpointKeyID(),
+			PublicKey: c.auth.CheckpointPublicKey(),
+		}
+
+		// Timestamps are stored to the microsecond and must be signed as they will be read back.
+		checkpoint.CreatedAt.Time = now.UTC().Truncate(time.Microsecond)
+		checkpoint.CreatedAt.Valid = true
+		checkpoint.Signature = c.auth.SignCheckpoint(CheckpointMessage(checkpoint))
+
+		count, err := c.db.JournalCheckpointCreate(checkpoint)
+		if err != nil {
+			c.logger.Warn("failed to record journal checkpoint",
+				zap.String("journal", head.Journal), zap.Int64("seq", head.Seq), zap.Error(err))
+
+			continue
+		}
+
+		if count > 0 {
+			c.logger.Info("recorded journal checkpoint", zap.String("journal", head.Journal), zap.Int64("seq", head.Seq))
+		}
+	}
+}
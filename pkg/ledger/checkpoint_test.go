@@ -0,0 +1,112 @@
+package ledger
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestNewCheckpointer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+	mockAuth := mocks.NewMockAuth(mockCtrl)
+
+	checkpointer, err := NewCheckpointer(nil, mockAuth, zapLogger, &sync.WaitGroup{})
+	require.Error(t, err, "created checkpointer with nil database.")
+	require.Nil(t, checkpointer, "returned checkpointer with nil database.")
+
+	checkpointer, err = NewCheckpointer(mockPostgres, nil, zapLogger, &sync.WaitGroup{})
+	require.Error(t, err, "created checkpointer with nil authorization.")
+	require.Nil(t, checkpointer, "returned checkpointer with nil authorization.")
+
+	checkpointer, err = NewCheckpointer(mockPostgres, mockAuth, zapLogger, &sync.WaitGroup{})
+	require.NoError(t, err, "failed to create checkpointer.")
+	require.NotNil(t, checkpointer, "failed to return checkpointer.")
+}
+
+func TestCheckpointer_Checkpoint(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 5, 10, 30, 15, 123456789, time.UTC)
+	testAuth := auth.TestAuth(zapLogger, 60, 30)
+	heads := []postgres.JournalChainHead{
+		{Journal: postgres.JournalCrypto, Seq: 0, EntryHash: make([]byte, 32)},
+		{Journal: postgres.JournalFiat, Seq: 42, EntryHash: []byte("fiat journal chain head hash")},
+	}
+
+	testCases := []struct {
+		name         string
+		headsErr     error
+		headsTimes   int
+		createErr    error
+		createTimes  int
+		createdCount int64
+	}{
+		{
+			name:         "recorded",
+			headsTimes:   1,
+			createTimes:  1,
+			createdCount: 1,
+		}, {
+			name:         "already recorded",
+			headsTimes:   1,
+			createTimes:  1,
+			createdCount: 0,
+		}, {
+			name:        "heads failure",
+			headsErr:    postgres.ErrNotFound,
+			headsTimes:  1,
+			createTimes: 0,
+		}, {
+			name:        "record failure",
+			headsTimes:  1,
+			createErr:   errors.New("unknown failure"),
+			createTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().
+				JournalChainHeads().
+				Return(heads, test.headsErr).
+				Times(test.headsTimes)
+
+			mockPostgres.EXPECT().
+				JournalCheckpointCreate(gomock.Any()).
+				DoAndReturn(func(checkpoint *postgres.JournalCheckpoint) (int64, error) {
+					require.Equal(t, postgres.JournalFiat, checkpoint.Journal, "checkpoint journal mismatch.")
+					require.Equal(t, int64(42), checkpoint.Seq, "checkpoint sequence number mismatch.")
+					require.Equal(t, now.Truncate(time.Microsecond), checkpoint.CreatedAt.Time,
+						"checkpoint creation time mismatch.")
+					require.Equal(t, testAuth.CheckpointKeyID(), checkpoint.KeyID, "checkpoint key id mismatch.")
+					require.True(t, ed25519.Verify(testAuth.CheckpointPublicKey(), CheckpointMessage(checkpoint),
+						checkpoint.Signature), "checkpoint signature failed to verify.")
+
+					return test.createdCount, test.createErr
+				}).
+				Times(test.createTimes)
+
+			checkpointer := &Checkpointer{db: mockPostgres, auth: testAuth, logger: zapLogger}
+			checkpointer.checkpoint(now)
+		})
+	}
+}
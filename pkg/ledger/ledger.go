@@ -0,0 +1,177 @@
+package ledger
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// journals are the journal hash chains that are checkpointed and verified.
+var journals = []string{postgres.JournalFiat, postgres.JournalCrypto} //nolint:gochecknoglobals
+
+// ExportedCheckpoint is a signed checkpoint of a journal hash chain head in the format exported to auditors. The
+// signature is over the message and is verified with the public key of the signing key ID, and the binary fields are
+// hex encoded.
+type ExportedCheckpoint struct {
+	Journal   string `json:"journal"`
+	Seq       int64  `json:"seq"`
+	EntryHash string `json:"entryHash"`
+	CreatedAt string `json:"createdAt"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+	KeyID     string `json:"keyID"`
+	PublicKey string `json:"publicKey"`
+}
+
+// CheckpointExport is the set of signed journal hash chain checkpoints and the ID and public key of the current
+// checkpoint signing key. Checkpoints signed before the signing key was rotated are verified with the public key of the
+// signing key ID they carry.
+type CheckpointExport struct {
+	Algorithm   string               `json:"algorithm"`
+	KeyID       string               `json:"keyID"`
+	PublicKey   string               `json:"publicKey"`
+	Checkpoints []ExportedCheckpoint `json:"checkpoints"`
+}
+
+// CheckpointMessage will generate the message that is signed for a journal hash chain checkpoint. The message is the
+// journal, sequence number, hex encoded entry hash, and RFC3339 UTC creation time of the checkpoint separated by pipes.
+func CheckpointMessage(checkpoint *postgres.JournalCheckpoint) []byte {
+	return []byte(fmt.Sprintf("%s|%d|%s|%s",
+		checkpoint.Journal,
+		checkpoint.Seq,
+		hex.EncodeToString(checkpoint.EntryHash),
+		checkpoint.CreatedAt.Time.UTC().Format(time.RFC3339Nano)))
+}
+
+// Journals will validate a journal name and return the journals it selects. An empty name selects all journals.
+func Journals(journal string) ([]string, error) {
+	if len(journal) == 0 {
+		return journals, nil
+	}
+
+	for _, name := range journals {
+		if name == journal {
+			return []string{journal}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown journal %s", journal)
+}
+
+// Export will retrieve the signed checkpoints of a journal, or of all journals if none is specified, recorded at or
+// after a point in time for auditors.
+func Export(db postgres.Postgres, authority auth.Auth, journal string, since time.Time) (*CheckpointExport, error) {
+	if _, err := Journals(journal); err != nil {
+		return nil, err
+	}
+
+	checkpoints, err := db.JournalCheckpoints(journal, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve journal checkpoints: %w", err)
+	}
+
+	export := &CheckpointExport{
+		Algorithm:   "Ed25519",
+		KeyID:       authority.CheckpointKeyID(),
+		PublicKey:   hex.EncodeToString(authority.CheckpointPublicKey()),
+		Checkpoints: make([]ExportedCheckpoint, 0, len(checkpoints)),
+	}
+
+	for idx := range checkpoints {
+		checkpoint := &checkpoints[idx]
+		export.Checkpoints = append(export.Checkpoints, ExportedCheckpoint{
+			Journal:   checkpoint.Journal,
+			Seq:       checkpoint.Seq,
+			EntryHash: hex.EncodeToString(checkpoint.EntryHash),
+			CreatedAt: checkpoint.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+			Message:   string(CheckpointMessage(checkpoint)),
+			Signature: hex.EncodeToString(checkpoint.Signature),
+			KeyID:     checkpoint.KeyID,
+			PublicKey: hex.EncodeToString(checkpoint.PublicKey),
+		})
+	}
+
+	return export, nil
+}
+
+// Verify will walk the hash chain of a journal, or of all journals if none is specified, and verify it against the
+// signed checkpoints. Checkpoints with invalid signatures, or that were not signed with one of the configured active or
+// retired checkpoint signing keys, are reported as the broken link without walking the chain.
+func Verify(db postgres.Postgres, authority auth.Auth, journal string) ([]*postgres.JournalChainVerification, error) {
+	selected, err := Journals(journal)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := authority.CheckpointPublicKeys()
+	verifications := make([]*postgres.JournalChainVerification, 0, len(selected))
+
+	for _, name := range selected {
+		checkpoints, err := db.JournalCheckpoints(name, time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve %s checkpoints: %w", name, err)
+		}
+
+		if verification := verifySignatures(name, checkpoints, publicKeys); verification != nil {
+			verifications = append(verifications, verification)
+
+			continue
+		}
+
+		verification, err := db.JournalChainVerify(name, checkpoints)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify %s hash chain: %w", name, err)
+		}
+
+		verifications = append(verifications, verification)
+	}
+
+	return verifications, nil
+}
+
+// Intact will check whether all the verified journal hash chains are intact.
+func Intact(verifications []*postgres.JournalChainVerification) bool {
+	for _, verification := range verifications {
+		if !verification.Intact {
+			return false
+		}
+	}
+
+	return true
+}
+
+// verifySignatures will verify the signatures on the checkpoints of a journal with the configured public keys of the
+// signing key IDs they carry, which must match the public keys recorded with the checkpoints. The first checkpoint that
+// fails is reported in a failed verification, and nil is returned if all the signatures are valid.
+func verifySignatures(journal string, checkpoints []postgres.JournalCheckpoint,
+	publicKeys map[string]ed25519.PublicKey) (verification *postgres.JournalChainVerification) {
+	for idx := range checkpoints {
+		var (
+			checkpoint = &checkpoints[idx]
+			publicKey  = publicKeys[checkpoint.KeyID]
+			reason     string
+		)
+
+		switch {
+		case publicKey == nil || !bytes.Equal(checkpoint.PublicKey, publicKey):
+			reason = "checkpoint was not signed with a configured checkpoint key"
+		case !ed25519.Verify(publicKey, CheckpointMessage(checkpoint), checkpoint.Signature):
+			reason = "checkpoint signature is invalid"
+		default:
+			continue
+		}
+
+		return &postgres.JournalChainVerification{
+			Journal: journal,
+			Intact:  false,
+			Break:   &postgres.JournalChainBreak{Seq: checkpoint.Seq, Reason: reason},
+		}
+	}
+
+	return nil
+}
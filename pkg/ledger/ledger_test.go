@@ -0,0 +1,244 @@
+package ledger
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// testCheckpoint will generate a journal hash chain checkpoint signed by an authority.
+func testCheckpoint(authority auth.Auth, journal string, seq int64) postgres.JournalCheckpoint {
+	checkpoint := postgres.JournalCheckpoint{
+		Journal:   journal,
+		Seq:       seq,
+		EntryHash: []byte("journal chain entry hash"),
+		KeyID:     authority.CheckpointKeyID(),
+		PublicKey: authority.CheckpointPublicKey(),
+	}
+	checkpoint.CreatedAt.Time = time.Date(2023, time.June, 5, 10, 30, 15, 123456000, time.UTC)
+	checkpoint.CreatedAt.Valid = true
+	checkpoint.Signature = authority.SignCheckpoint(CheckpointMessage(&checkpoint))
+
+	return checkpoint
+}
+
+func TestLedger_CheckpointMessage(t *testing.T) {
+	t.Parallel()
+
+	checkpoint := postgres.JournalCheckpoint{Journal: postgres.JournalFiat, Seq: 42, EntryHash: []byte{0xde, 0xad}}
+	checkpoint.CreatedAt.Time = time.Date(2023, time.June, 5, 10, 30, 15, 123456000, time.FixedZone("EST", -5*3600))
+
+	require.Equal(t, "fiat_journal|42|dead|2023-06-05T15:30:15.123456Z", string(CheckpointMessage(&checkpoint)),
+		"checkpoint message mismatch.")
+}
+
+func TestLedger_Journals(t *testing.T) {
+	t.Parallel()
+
+	selected, err := Journals("")
+	require.NoError(t, err, "failed to select all journals.")
+	require.Equal(t, []string{postgres.JournalFiat, postgres.JournalCrypto}, selected, "all journals mismatch.")
+
+	selected, err = Journals(postgres.JournalCrypto)
+	require.NoError(t, err, "failed to select Crypto journal.")
+	require.Equal(t, []string{postgres.JournalCrypto}, selected, "Crypto journal mismatch.")
+
+	selected, err = Journals("users")
+	require.Error(t, err, "selected unknown journal.")
+	require.Nil(t, selected, "returned unknown journal.")
+}
+
+func TestLedger_Export(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+	testAuth := auth.TestAuth(zapLogger, 60, 30)
+	checkpoint := testCheckpoint(testAuth, postgres.JournalFiat, 42)
+	since := time.Now().Add(-time.Hour)
+
+	gomock.InOrder(
+		mockPostgres.EXPECT().
+			JournalCheckpoints(postgres.JournalFiat, since).
+			Return([]postgres.JournalCheckpoint{checkpoint}, nil).
+			Times(1),
+		mockPostgres.EXPECT().
+			JournalCheckpoints("", since).
+			Return(nil, postgres.ErrNotFound).
+			Times(1),
+	)
+
+	export, err := Export(mockPostgres, testAuth, postgres.JournalFiat, since)
+	require.NoError(t, err, "failed to export checkpoints.")
+	require.Equal(t, "Ed25519", export.Algorithm, "export algorithm mismatch.")
+	require.Equal(t, testAuth.CheckpointKeyID(), export.KeyID, "export key id mismatch.")
+	require.Equal(t, hex.EncodeToString(testAuth.CheckpointPublicKey()), export.PublicKey, "export public key mismatch.")
+	require.Len(t, export.Checkpoints, 1, "exported checkpoints count mismatch.")
+	require.Equal(t, string(CheckpointMessage(&checkpoint)), export.Checkpoints[0].Message, "exported message mismatch.")
+	require.Equal(t, hex.EncodeToString(checkpoint.Signature), export.Checkpoints[0].Signature,
+		"exported signature mismatch.")
+	require.Equal(t, checkpoint.KeyID, export.Checkpoints[0].KeyID, "exported checkpoint key id mismatch.")
+	require.Equal(t, hex.EncodeToString(checkpoint.PublicKey), export.Checkpoints[0].PublicKey,
+		"exported checkpoint public key mismatch.")
+
+	_, err = Export(mockPostgres, testAuth, "", since)
+	require.ErrorIs(t, err, postgres.ErrNotFound, "exported checkpoints on database failure.")
+
+	_, err = Export(mockPostgres, testAuth, "users", since)
+	require.Error(t, err, "exported checkpoints of unknown journal.")
+}
+
+func TestLedger_Verify(t *testing.T) {
+	t.Parallel()
+
+	testAuth := auth.TestAuth(zapLogger, 60, 30)
+	rotatedAuth := auth.TestAuthRotated(zapLogger, 60, 30)
+	intact := &postgres.JournalChainVerification{Journal: postgres.JournalFiat, Intact: true}
+
+	forged := testCheckpoint(testAuth, postgres.JournalFiat, 7)
+	forged.Seq = 8
+
+	foreign := testCheckpoint(testAuth, postgres.JournalFiat, 9)
+	foreign.PublicKey = []byte("another public key")
+
+	unknown := testCheckpoint(testAuth, postgres.JournalFiat, 10)
+	unknown.KeyID = "unknown"
+
+	testCases := []struct {
+		name            string
+		authority       auth.Auth
+		journal         string
+		checkpoints     []postgres.JournalCheckpoint
+		checkpointsErr  error
+		checkpointTimes int
+		verifyErr       error
+		verifyTimes     int
+		expectErr       require.ErrorAssertionFunc
+		expectIntact    require.BoolAssertionFunc
+		expectBreakSeq  int64
+	}{
+		{
+			name:            "intact",
+			journal:         postgres.JournalFiat,
+			checkpoints:     []postgres.JournalCheckpoint{testCheckpoint(testAuth, postgres.JournalFiat, 5)},
+			checkpointTimes: 1,
+			verifyTimes:     1,
+			expectErr:       require.NoError,
+			expectIntact:    require.True,
+		}, {
+			name:      "rotated checkpoint key",
+			authority: rotatedAuth,
+			journal:   postgres.JournalFiat,
+			checkpoints: []postgres.JournalCheckpoint{
+				testCheckpoint(testAuth, postgres.JournalFiat, 5), testCheckpoint(rotatedAuth, postgres.JournalFiat, 6)},
+			checkpointTimes: 1,
+			verifyTimes:     1,
+			expectErr:       require.NoError,
+			expectIntact:    require.True,
+		}, {
+			name:            "rotated checkpoint key not configured",
+			journal:         postgres.JournalFiat,
+			checkpoints:     []postgres.JournalCheckpoint{testCheckpoint(rotatedAuth, postgres.JournalFiat, 6)},
+			checkpointTimes: 1,
+			expectErr:       require.NoError,
+			expectIntact:    require.False,
+			expectBreakSeq:  6,
+		}, {
+			name:            "all journals",
+			checkpointTimes: 2,
+			verifyTimes:     2,
+			expectErr:       require.NoError,
+			expectIntact:    require.True,
+		}, {
+			name:            "invalid signature",
+			journal:         postgres.JournalFiat,
+			checkpoints:     []postgres.JournalCheckpoint{testCheckpoint(testAuth, postgres.JournalFiat, 5), forged},
+			checkpointTimes: 1,
+			expectErr:       require.NoError,
+			expectIntact:    require.False,
+			expectBreakSeq:  8,
+		}, {
+			name:            "foreign key",
+			journal:         postgres.JournalFiat,
+			checkpoints:     []postgres.JournalCheckpoint{foreign},
+			checkpointTimes: 1,
+			expectErr:       require.NoError,
+			expectIntact:    require.False,
+			expectBreakSeq:  9,
+		}, {
+			name:            "unknown key id",
+			journal:         postgres.JournalFiat,
+			checkpoints:     []postgres.JournalCheckpoint{unknown},
+			checkpointTimes: 1,
+			expectErr:       require.NoError,
+			expectIntact:    require.False,
+			expectBreakSeq:  10,
+		}, {
+			name:            "checkpoints failure",
+			journal:         postgres.JournalFiat,
+			checkpointsErr:  postgres.ErrNotFound,
+			checkpointTimes: 1,
+			expectErr:       require.Error,
+		}, {
+			name:            "verify failure",
+			journal:         postgres.JournalFiat,
+			checkpointTimes: 1,
+			verifyErr:       errors.New("unknown failure"),
+			verifyTimes:     1,
+			expectErr:       require.Error,
+		}, {
+			name:      "unknown journal",
+			journal:   "users",
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().
+				JournalCheckpoints(gomock.Any(), time.Time{}).
+				Return(test.checkpoints, test.checkpointsErr).
+				Times(test.checkpointTimes)
+
+			mockPostgres.EXPECT().
+				JournalChainVerify(gomock.Any(), test.checkpoints).
+				Return(intact, test.verifyErr).
+				Times(test.verifyTimes)
+
+			authority := test.authority
+			if authority == nil {
+				authority = testAuth
+			}
+
+			verifications, err := Verify(mockPostgres, authority, test.journal)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				return
+			}
+
+			test.expectIntact(t, Intact(verifications), "intact expectation failed.")
+
+			if test.expectBreakSeq > 0 {
+				require.Equal(t, test.expectBreakSeq, verifications[0].Break.Seq, "broken link sequence mismatch.")
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package matcher
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+// Matcher is the background worker that fills open Cryptocurrency limit orders once the market price crosses their
+// limit price. It also sells the positions covered by stop-loss and take-profit orders once their trigger prices are
+// crossed.
+type Matcher struct {
+	db        postgres.Postgres
+	quotes    quotes.Quotes
+	logger    *logger.Logger
+	wg        *sync.WaitGroup
+	interval  time.Duration
+	batchSize int32
+}
+
+// quote is a market price retrieved for a Cryptocurrency and Fiat currency pair during a single sweep.
+type quote struct {
+	price     decimal.Decimal
+	available bool
+}
+
+// NewMatcher will create a new limit order matcher in a non-running state.
+func NewMatcher(db postgres.Postgres, quotes quotes.Quotes, logger *logger.Logger, wg *sync.WaitGroup) (
+	*Matcher, error) {
+	if db == nil || quotes == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, quotes, logger, or wait group supplied")
+	}
+
+	return &Matcher{
+		db:        db,
+		quotes:    quotes,
+		logger:    logger,
+		wg:        wg,
+		interval:  constants.LimitOrderPollInterval(),
+		batchSize: constants.LimitOrderBatchSize(),
+	}, nil
+}
+
+// Run will periodically sweep the open limit orders until an interrupt signal is received.
+func (m *Matcher) Run() {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	// Wait for interrupt signal to gracefully shut down the matcher.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-quit:
+			m.logger.Info("Limit order matcher exited")
+
+			return
+		case <-ticker.C:
+			m.sweep()
+		}
+	}
+}
+
+// sweep will expire stale limit orders and then attempt to fill every open limit order and execute every active trigger
+// order in batches. Quotes and trading statuses are retrieved at most once per sweep for each market.
+func (m *Matcher) sweep() {
+	var (
+		prices = make(map[string]quote)
+		halted = make(map[string]bool)
+	)
+
+	if expired, err := m.db.LimitOrdersExpire(); err != nil {
+		m.logger.Warn("failed to expire limit orders", zap.Error(err))
+	} else if expired > 0 {
+		m.logger.Info("expired limit orders", zap.Int64("count", expired))
+	}
+
+	m.sweepLimitOrders(prices, halted)
+	m.sweepTriggerOrders(prices, halted)
+}
+
+// sweepLimitOrders will attempt to fill every open limit order in batches.
+func (m *Matcher) sweepLimitOrders(prices map[string]quote, halted map[string]bool) {
+	var afterID string
+
+	for {
+		orders, err := m.db.LimitOrdersOpen(afterID, m.batchSize)
+		if err != nil {
+			m.logger.Warn("failed to retrieve open limit orders", zap.Error(err))
+
+			return
+		}
+
+		for idx := range orders {
+			m.match(&orders[idx], prices, halted)
+		}
+
+		if len(orders) < int(m.batchSize) {
+			return
+		}
+
+		afterID = orders[len(orders)-1].OrderID
+	}
+}
+
+// sweepTriggerOrders will attempt to execute every active stop-loss and take-profit trigger order in batches.
+func (m *Matcher) sweepTriggerOrders(prices map[string]quote, halted map[string]bool) {
+	var afterID string
+
+	for {
+		orders, err := m.db.TriggerOrdersActive(afterID, m.batchSize)
+		if err != nil {
+			m.logger.Warn("failed to retrieve active trigger orders", zap.Error(err))
+
+			return
+		}
+
+		for idx := range orders {
+			m.trigger(&orders[idx], prices, halted)
+		}
+
+		if len(orders) < int(m.batchSize) {
+			return
+		}
+
+		afterID = orders[len(orders)-1].OrderID
+	}
+}
+
+// marketPrice will retrieve the market price in Fiat per unit of Cryptocurrency. No price is available for halted
+// Cryptocurrencies, so their orders remain open until trading resumes.
+func (m *Matcher) marketPrice(ticker string, fiatCurrency postgres.Currency, prices map[string]quote,
+	halted map[string]bool) (decimal.Decimal, bool) {
+	isHalted, ok := halted[ticker]
+	if !ok {
+		asset, err := m.db.CryptoAssetGet(ticker)
+		if err != nil {
+			m.logger.Warn("failed to retrieve Cryptocurrency registry entry for orders",
+				zap.String("ticker", ticker), zap.Error(err))
+
+			return decimal.Zero, false
+		}
+
+		isHalted = asset.Status != postgres.CryptoAssetStatusENABLED
+		halted[ticker] = isHalted
+	}
+
+	if isHalted {
+		return decimal.Zero, false
+	}
+
+	market := fmt.Sprintf("%s/%s", ticker, fiatCurrency)
+
+	current, ok := prices[market]
+	if !ok {
+		rate, _, err := m.quotes.CryptoConversion(ticker, string(fiatCurrency), decimal.NewFromInt(1), false, nil)
+		if err != nil {
+			m.logger.Warn("failed to retrieve quote for orders", zap.String("market", market), zap.Error(err))
+		}
+
+		current = quote{price: rate, available: err == nil}
+		prices[market] = current
+	}
+
+	return current.price, current.available
+}
+
+// match will fill a limit order if its limit price has been crossed. Orders that can no longer be filled because of
+// insufficient funds or a frozen account are closed as failed.
+func (m *Matcher) match(order *postgres.CryptoLimitOrder, prices map[string]quote, halted map[string]bool) {
+	price, ok := m.marketPrice(order.Ticker, order.FiatCurrency, prices, halted)
+	if !ok {
+		return
+	}
+
+	// Purchases fill at or below the limit price and sales fill at or above it.
+	if (order.IsPurchase && price.GreaterThan(order.LimitPrice)) ||
+		(!order.IsPurchase && price.LessThan(order.LimitPrice)) {
+		return
+	}
+
+	fiatAmount := order.Amount.Mul(price).RoundBank(constants.DecimalPlacesFiat())
+
+	_, _, err := m.db.LimitOrderFill(order, fiatAmount, price)
+
+	switch {
+	case err == nil:
+		m.logger.Info("filled limit order", zap.String("orderID", order.OrderID), zap.String("price", price.String()))
+	case errors.Is(err, postgres.ErrLimitOrderClosed):
+		// The order was cancelled or expired since it was retrieved.
+	case errors.Is(err, postgres.ErrInsufficientFunds), errors.Is(err, postgres.ErrAccountStatus):
+		if err = m.db.LimitOrderClose(order.ClientID, order.OrderID, postgres.LimitOrderStatusFAILED,
+			err.Error()); err != nil && !errors.Is(err, postgres.ErrLimitOrderClosed) {
+			m.logger.Warn("failed to close unfillable limit order", zap.String("orderID", order.OrderID),
+				zap.Error(err))
+		}
+	default:
+		m.logger.Warn("failed to fill limit order, retrying on next sweep", zap.String("orderID", order.OrderID),
+			zap.Error(err))
+	}
+}
+
+// trigger will sell the position covered by a trigger order if its trigger price has been crossed. Trailing stop-loss
+// orders have their trigger prices raised as the market price rises. Orders that cannot be executed because the
+// position is empty or the account is frozen are closed as failed.
+func (m *Matcher) trigger(order *postgres.CryptoTriggerOrder, prices map[string]quote, halted map[string]bool) {
+	price, ok := m.marketPrice(order.Ticker, order.FiatCurrency, prices, halted)
+	if !ok {
+		return
+	}
+
+	// Stop-losses trigger at or below the trigger price and take-profits trigger at or above it.
+	if order.TriggerType == postgres.TriggerOrderTypeSTOPLOSS {
+		// A rising price raises the trigger price of a trailing stop-loss and so cannot trigger it.
+		if order.TrailingDistance.IsPositive() {
+			trailed := price.Sub(order.TrailingDistance).Round(constants.DecimalPlacesFiat())
+			if trailed.GreaterThan(order.TriggerPrice) {
+				if _, err := m.db.TriggerOrderTrail(order, trailed); err != nil {
+					m.logger.Warn("failed to trail stop-loss order", zap.String("orderID", order.OrderID),
+						zap.Error(err))
+				}
+
+				return
+			}
+		}
+
+		if price.GreaterThan(order.TriggerPrice) {
+			return
+		}
+	} else if price.LessThan(order.TriggerPrice) {
+		return
+	}
+
+	_, _, err := m.db.TriggerOrderExecute(order, price)
+
+	switch {
+	case err == nil:
+		m.logger.Info("executed trigger order", zap.String("orderID", order.OrderID),
+			zap.String("price", price.String()))
+	case errors.Is(err, postgres.ErrTriggerOrderClosed):
+		// The order was cancelled since it was retrieved.
+	case errors.Is(err, postgres.ErrInsufficientFunds), errors.Is(err, postgres.ErrAccountStatus):
+		if err = m.db.TriggerOrderClose(order.ClientID, order.OrderID, postgres.TriggerOrderStatusFAILED,
+			err.Error()); err != nil && !errors.Is(err, postgres.ErrTriggerOrderClosed) {
+			m.logger.Warn("failed to close unexecutable trigger order", zap.String("orderID", order.OrderID),
+				zap.Error(err))
+		}
+	default:
+		m.logger.Warn("failed to execute trigger order, retrying on next sweep",
+			zap.String("orderID", order.OrderID), zap.Error(err))
+	}
+}
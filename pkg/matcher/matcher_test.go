@@ -0,0 +1,563 @@
+package matcher
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+)
+
+func TestNewMatcher(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+	matcher, err := NewMatcher(nil, mockQuotes, zapLogger, &sync.WaitGroup{})
+	require.Error(t, err, "created matcher with nil database.")
+	require.Nil(t, matcher, "returned matcher with nil database.")
+
+	matcher, err = NewMatcher(mockPostgres, mockQuotes, zapLogger, &sync.WaitGroup{})
+	require.NoError(t, err, "failed to create matcher.")
+	require.NotNil(t, matcher, "failed to return matcher.")
+}
+
+func TestMatcher_Sweep(t *testing.T) {
+	t.Parallel()
+
+	purchase := postgres.CryptoLimitOrder{
+		OrderID:      "order-purchase",
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		IsPurchase:   true,
+		Amount:       decimal.NewFromFloat(0.5),
+		LimitPrice:   decimal.NewFromFloat(1000),
+	}
+	sale := postgres.CryptoLimitOrder{
+		OrderID:      "order-sale",
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		IsPurchase:   false,
+		Amount:       decimal.NewFromFloat(0.5),
+		LimitPrice:   decimal.NewFromFloat(2000),
+	}
+	enabled := postgres.CryptoAsset{Ticker: "BTC", Status: postgres.CryptoAssetStatusENABLED}
+
+	testCases := []struct {
+		name        string
+		expireErr   error
+		orders      []postgres.CryptoLimitOrder
+		openErr     error
+		asset       postgres.CryptoAsset
+		assetErr    error
+		assetTimes  int
+		price       decimal.Decimal
+		quoteErr    error
+		quoteTimes  int
+		fillErr     error
+		fillTimes   int
+		closeTimes  int
+		closeStatus postgres.LimitOrderStatus
+	}{
+		{
+			name:        "open orders failure",
+			expireErr:   errors.New("expire failure"),
+			orders:      nil,
+			openErr:     errors.New("open orders failure"),
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  0,
+			price:       decimal.Zero,
+			quoteErr:    nil,
+			quoteTimes:  0,
+			fillErr:     nil,
+			fillTimes:   0,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "registry failure",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase},
+			openErr:     nil,
+			asset:       postgres.CryptoAsset{},
+			assetErr:    errors.New("registry failure"),
+			assetTimes:  1,
+			price:       decimal.Zero,
+			quoteErr:    nil,
+			quoteTimes:  0,
+			fillErr:     nil,
+			fillTimes:   0,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "trading halted",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase, sale},
+			openErr:     nil,
+			asset:       postgres.CryptoAsset{Ticker: "BTC", Status: postgres.CryptoAssetStatusHALTED},
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.Zero,
+			quoteErr:    nil,
+			quoteTimes:  0,
+			fillErr:     nil,
+			fillTimes:   0,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "quote failure",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase, sale},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.Zero,
+			quoteErr:    errors.New("quote failure"),
+			quoteTimes:  1,
+			fillErr:     nil,
+			fillTimes:   0,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "limit prices not crossed",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase, sale},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(1500),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     nil,
+			fillTimes:   0,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "purchase filled",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase, sale},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(900),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     nil,
+			fillTimes:   1,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "sale filled",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase, sale},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(2000),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     nil,
+			fillTimes:   1,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "order closed",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(900),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     postgres.ErrLimitOrderClosed,
+			fillTimes:   1,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "fill failure",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(900),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     postgres.ErrTransactCrypto,
+			fillTimes:   1,
+			closeTimes:  0,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "insufficient funds",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{purchase},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(900),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     postgres.ErrInsufficientFunds,
+			fillTimes:   1,
+			closeTimes:  1,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		}, {
+			name:        "account frozen",
+			expireErr:   nil,
+			orders:      []postgres.CryptoLimitOrder{sale},
+			openErr:     nil,
+			asset:       enabled,
+			assetErr:    nil,
+			assetTimes:  1,
+			price:       decimal.NewFromFloat(2500),
+			quoteErr:    nil,
+			quoteTimes:  1,
+			fillErr:     postgres.ErrAccountStatus,
+			fillTimes:   1,
+			closeTimes:  1,
+			closeStatus: postgres.LimitOrderStatusFAILED,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			mockPostgres.EXPECT().
+				LimitOrdersExpire().
+				Return(int64(1), test.expireErr).
+				Times(1)
+
+			mockPostgres.EXPECT().
+				LimitOrdersOpen(gomock.Any(), gomock.Any()).
+				Return(test.orders, test.openErr).
+				Times(1)
+
+			mockPostgres.EXPECT().
+				CryptoAssetGet(gomock.Any()).
+				Return(test.asset, test.assetErr).
+				Times(test.assetTimes)
+
+			mockQuotes.EXPECT().
+				CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), false, gomock.Any()).
+				Return(test.price, test.price, test.quoteErr).
+				Times(test.quoteTimes)
+
+			mockPostgres.EXPECT().
+				LimitOrderFill(gomock.Any(), gomock.Any(), test.price).
+				Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.fillErr).
+				Times(test.fillTimes)
+
+			mockPostgres.EXPECT().
+				LimitOrderClose(gomock.Any(), gomock.Any(), test.closeStatus, gomock.Any()).
+				Return(nil).
+				Times(test.closeTimes)
+
+			mockPostgres.EXPECT().
+				TriggerOrdersActive(gomock.Any(), gomock.Any()).
+				Return(nil, nil).
+				Times(1)
+
+			matcher := &Matcher{db: mockPostgres, quotes: mockQuotes, logger: zapLogger, batchSize: 10}
+			matcher.sweep()
+		})
+	}
+}
+
+func TestMatcher_SweepBatches(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+	halted := postgres.CryptoAsset{Ticker: "BTC", Status: postgres.CryptoAssetStatusHALTED}
+	firstBatch := []postgres.CryptoLimitOrder{{OrderID: "order-1", Ticker: "BTC"}, {OrderID: "order-2", Ticker: "BTC"}}
+	secondBatch := []postgres.CryptoLimitOrder{{OrderID: "order-3", Ticker: "BTC"}}
+	firstTriggers := []postgres.CryptoTriggerOrder{
+		{OrderID: "trigger-1", Ticker: "BTC"}, {OrderID: "trigger-2", Ticker: "BTC"}}
+
+	gomock.InOrder(
+		mockPostgres.EXPECT().
+			LimitOrdersExpire().
+			Return(int64(0), nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			LimitOrdersOpen("", int32(2)).
+			Return(firstBatch, nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			CryptoAssetGet("BTC").
+			Return(halted, nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			LimitOrdersOpen("order-2", int32(2)).
+			Return(secondBatch, nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			TriggerOrdersActive("", int32(2)).
+			Return(firstTriggers, nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			TriggerOrdersActive("trigger-2", int32(2)).
+			Return(nil, nil).
+			Times(1),
+	)
+
+	matcher := &Matcher{db: mockPostgres, quotes: mockQuotes, logger: zapLogger, batchSize: 2}
+	matcher.sweep()
+}
+
+func TestMatcher_SweepTriggers(t *testing.T) {
+	t.Parallel()
+
+	stopLoss := postgres.CryptoTriggerOrder{
+		OrderID:      "trigger-stop-loss",
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		TriggerType:  postgres.TriggerOrderTypeSTOPLOSS,
+		TriggerPrice: decimal.NewFromFloat(1000),
+	}
+	trailingStop := postgres.CryptoTriggerOrder{
+		OrderID:          "trigger-trailing-stop",
+		FiatCurrency:     "USD",
+		Ticker:           "BTC",
+		TriggerType:      postgres.TriggerOrderTypeSTOPLOSS,
+		TriggerPrice:     decimal.NewFromFloat(1000),
+		TrailingDistance: decimal.NewFromFloat(100),
+	}
+	takeProfit := postgres.CryptoTriggerOrder{
+		OrderID:      "trigger-take-profit",
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		TriggerType:  postgres.TriggerOrderTypeTAKEPROFIT,
+		TriggerPrice: decimal.NewFromFloat(2000),
+	}
+	enabled := postgres.CryptoAsset{Ticker: "BTC", Status: postgres.CryptoAssetStatusENABLED}
+
+	testCases := []struct {
+		name         string
+		orders       []postgres.CryptoTriggerOrder
+		activeErr    error
+		assetTimes   int
+		price        decimal.Decimal
+		quoteTimes   int
+		trailPrice   decimal.Decimal
+		trailTimes   int
+		executeErr   error
+		executeTimes int
+		closeTimes   int
+	}{
+		{
+			name:         "active orders failure",
+			orders:       nil,
+			activeErr:    errors.New("active orders failure"),
+			assetTimes:   0,
+			price:        decimal.Zero,
+			quoteTimes:   0,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   nil,
+			executeTimes: 0,
+			closeTimes:   0,
+		}, {
+			name:         "trigger prices not crossed",
+			orders:       []postgres.CryptoTriggerOrder{stopLoss, takeProfit},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(1500),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   nil,
+			executeTimes: 0,
+			closeTimes:   0,
+		}, {
+			name:         "stop-loss triggered",
+			orders:       []postgres.CryptoTriggerOrder{stopLoss, takeProfit},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(1000),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   nil,
+			executeTimes: 1,
+			closeTimes:   0,
+		}, {
+			name:         "take-profit triggered",
+			orders:       []postgres.CryptoTriggerOrder{stopLoss, takeProfit},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(2500),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   nil,
+			executeTimes: 1,
+			closeTimes:   0,
+		}, {
+			name:         "trailing stop raised",
+			orders:       []postgres.CryptoTriggerOrder{trailingStop},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(1500),
+			quoteTimes:   1,
+			trailPrice:   decimal.NewFromFloat(1400),
+			trailTimes:   1,
+			executeErr:   nil,
+			executeTimes: 0,
+			closeTimes:   0,
+		}, {
+			name:         "trailing stop within distance",
+			orders:       []postgres.CryptoTriggerOrder{trailingStop},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(1050),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   nil,
+			executeTimes: 0,
+			closeTimes:   0,
+		}, {
+			name:         "trailing stop triggered",
+			orders:       []postgres.CryptoTriggerOrder{trailingStop},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(950),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   nil,
+			executeTimes: 1,
+			closeTimes:   0,
+		}, {
+			name:         "order closed",
+			orders:       []postgres.CryptoTriggerOrder{stopLoss},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(900),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   postgres.ErrTriggerOrderClosed,
+			executeTimes: 1,
+			closeTimes:   0,
+		}, {
+			name:         "execution failure",
+			orders:       []postgres.CryptoTriggerOrder{stopLoss},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(900),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   postgres.ErrTransactCrypto,
+			executeTimes: 1,
+			closeTimes:   0,
+		}, {
+			name:         "empty position",
+			orders:       []postgres.CryptoTriggerOrder{stopLoss},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(900),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   postgres.ErrInsufficientFunds,
+			executeTimes: 1,
+			closeTimes:   1,
+		}, {
+			name:         "account frozen",
+			orders:       []postgres.CryptoTriggerOrder{takeProfit},
+			activeErr:    nil,
+			assetTimes:   1,
+			price:        decimal.NewFromFloat(2500),
+			quoteTimes:   1,
+			trailPrice:   decimal.Zero,
+			trailTimes:   0,
+			executeErr:   postgres.ErrAccountStatus,
+			executeTimes: 1,
+			closeTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			mockPostgres.EXPECT().
+				TriggerOrdersActive(gomock.Any(), gomock.Any()).
+				Return(test.orders, test.activeErr).
+				Times(1)
+
+			mockPostgres.EXPECT().
+				CryptoAssetGet(gomock.Any()).
+				Return(enabled, nil).
+				Times(test.assetTimes)
+
+			mockQuotes.EXPECT().
+				CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), false, gomock.Any()).
+				Return(test.price, test.price, nil).
+				Times(test.quoteTimes)
+
+			mockPostgres.EXPECT().
+				TriggerOrderTrail(gomock.Any(), gomock.Any()).
+				Do(func(_ *postgres.CryptoTriggerOrder, price decimal.Decimal) {
+					require.True(t, price.Equal(test.trailPrice), "trailed trigger price mismatch.")
+				}).
+				Return(true, nil).
+				Times(test.trailTimes)
+
+			mockPostgres.EXPECT().
+				TriggerOrderExecute(gomock.Any(), test.price).
+				Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.executeErr).
+				Times(test.executeTimes)
+
+			mockPostgres.EXPECT().
+				TriggerOrderClose(gomock.Any(), gomock.Any(), postgres.TriggerOrderStatusFAILED, gomock.Any()).
+				Return(nil).
+				Times(test.closeTimes)
+
+			matcher := &Matcher{db: mockPostgres, quotes: mockQuotes, logger: zapLogger, batchSize: 10}
+			matcher.sweepTriggerOrders(make(map[string]quote), make(map[string]bool))
+		})
+	}
+}
@@ -5,11 +5,13 @@
 package mocks
 
 import (
+	ed25519 "crypto/ed25519"
 	reflect "reflect"
 
 	gin "github.com/gin-gonic/gin"
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
+	auth "github.com/surahman/FTeX/pkg/auth"
 	models "github.com/surahman/FTeX/pkg/models"
 )
 
@@ -50,6 +52,48 @@ func (mr *MockAuthMockRecorder) CheckPassword(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPassword", reflect.TypeOf((*MockAuth)(nil).CheckPassword), arg0, arg1)
 }
 
+// CheckpointKeyID mocks base method.
+func (m *MockAuth) CheckpointKeyID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointKeyID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CheckpointKeyID indicates an expected call of CheckpointKeyID.
+func (mr *MockAuthMockRecorder) CheckpointKeyID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointKeyID", reflect.TypeOf((*MockAuth)(nil).CheckpointKeyID))
+}
+
+// CheckpointPublicKey mocks base method.
+func (m *MockAuth) CheckpointPublicKey() ed25519.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointPublicKey")
+	ret0, _ := ret[0].(ed25519.PublicKey)
+	return ret0
+}
+
+// CheckpointPublicKey indicates an expected call of CheckpointPublicKey.
+func (mr *MockAuthMockRecorder) CheckpointPublicKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointPublicKey", reflect.TypeOf((*MockAuth)(nil).CheckpointPublicKey))
+}
+
+// CheckpointPublicKeys mocks base method.
+func (m *MockAuth) CheckpointPublicKeys() map[string]ed25519.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointPublicKeys")
+	ret0, _ := ret[0].(map[string]ed25519.PublicKey)
+	return ret0
+}
+
+// CheckpointPublicKeys indicates an expected call of CheckpointPublicKeys.
+func (mr *MockAuthMockRecorder) CheckpointPublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointPublicKeys", reflect.TypeOf((*MockAuth)(nil).CheckpointPublicKeys))
+}
+
 // DecryptFromString mocks base method.
 func (m *MockAuth) DecryptFromString(arg0 string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -80,19 +124,64 @@ func (mr *MockAuthMockRecorder) EncryptToString(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptToString", reflect.TypeOf((*MockAuth)(nil).EncryptToString), arg0)
 }
 
+// ExpirationDuration mocks base method.
+func (m *MockAuth) ExpirationDuration() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirationDuration")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// ExpirationDuration indicates an expected call of ExpirationDuration.
+func (mr *MockAuthMockRecorder) ExpirationDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirationDuration", reflect.TypeOf((*MockAuth)(nil).ExpirationDuration))
+}
+
+// GenerateEmailVerificationToken mocks base method.
+func (m *MockAuth) GenerateEmailVerificationToken(arg0 uuid.UUID, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateEmailVerificationToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateEmailVerificationToken indicates an expected call of GenerateEmailVerificationToken.
+func (mr *MockAuthMockRecorder) GenerateEmailVerificationToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateEmailVerificationToken", reflect.TypeOf((*MockAuth)(nil).GenerateEmailVerificationToken), arg0, arg1)
+}
+
 // GenerateJWT mocks base method.
-func (m *MockAuth) GenerateJWT(arg0 uuid.UUID) (*models.JWTAuthResponse, error) {
+func (m *MockAuth) GenerateJWT(arg0 uuid.UUID, arg1 string) (*models.JWTAuthResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateJWT", arg0)
+	ret := m.ctrl.Call(m, "GenerateJWT", arg0, arg1)
 	ret0, _ := ret[0].(*models.JWTAuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateJWT indicates an expected call of GenerateJWT.
-func (mr *MockAuthMockRecorder) GenerateJWT(arg0 interface{}) *gomock.Call {
+func (mr *MockAuthMockRecorder) GenerateJWT(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockAuth)(nil).GenerateJWT), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockAuth)(nil).GenerateJWT), arg0, arg1)
+}
+
+// GenerateRefreshToken mocks base method.
+func (m *MockAuth) GenerateRefreshToken() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRefreshToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
+func (mr *MockAuthMockRecorder) GenerateRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockAuth)(nil).GenerateRefreshToken))
 }
 
 // HashPassword mocks base method.
@@ -110,19 +199,63 @@ func (mr *MockAuthMockRecorder) HashPassword(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockAuth)(nil).HashPassword), arg0)
 }
 
+// HashRefreshToken mocks base method.
+func (m *MockAuth) HashRefreshToken(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRefreshToken", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRefreshToken indicates an expected call of HashRefreshToken.
+func (mr *MockAuthMockRecorder) HashRefreshToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRefreshToken", reflect.TypeOf((*MockAuth)(nil).HashRefreshToken), arg0)
+}
+
+// LoginThrottle mocks base method.
+func (m *MockAuth) LoginThrottle() auth.LoginThrottle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginThrottle")
+	ret0, _ := ret[0].(auth.LoginThrottle)
+	return ret0
+}
+
+// LoginThrottle indicates an expected call of LoginThrottle.
+func (mr *MockAuthMockRecorder) LoginThrottle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginThrottle", reflect.TypeOf((*MockAuth)(nil).LoginThrottle))
+}
+
+// ReencryptString mocks base method.
+func (m *MockAuth) ReencryptString(arg0 string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptString", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReencryptString indicates an expected call of ReencryptString.
+func (mr *MockAuthMockRecorder) ReencryptString(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptString", reflect.TypeOf((*MockAuth)(nil).ReencryptString), arg0)
+}
+
 // RefreshJWT mocks base method.
-func (m *MockAuth) RefreshJWT(arg0 string) (*models.JWTAuthResponse, error) {
+func (m *MockAuth) RefreshJWT(arg0, arg1 string) (*models.JWTAuthResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshJWT", arg0)
+	ret := m.ctrl.Call(m, "RefreshJWT", arg0, arg1)
 	ret0, _ := ret[0].(*models.JWTAuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RefreshJWT indicates an expected call of RefreshJWT.
-func (mr *MockAuthMockRecorder) RefreshJWT(arg0 interface{}) *gomock.Call {
+func (mr *MockAuthMockRecorder) RefreshJWT(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshJWT", reflect.TypeOf((*MockAuth)(nil).RefreshJWT), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshJWT", reflect.TypeOf((*MockAuth)(nil).RefreshJWT), arg0, arg1)
 }
 
 // RefreshThreshold mocks base method.
@@ -139,6 +272,20 @@ func (mr *MockAuthMockRecorder) RefreshThreshold() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshThreshold", reflect.TypeOf((*MockAuth)(nil).RefreshThreshold))
 }
 
+// SignCheckpoint mocks base method.
+func (m *MockAuth) SignCheckpoint(arg0 []byte) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignCheckpoint", arg0)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// SignCheckpoint indicates an expected call of SignCheckpoint.
+func (mr *MockAuthMockRecorder) SignCheckpoint(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignCheckpoint", reflect.TypeOf((*MockAuth)(nil).SignCheckpoint), arg0)
+}
+
 // TokenInfoFromGinCtx mocks base method.
 func (m *MockAuth) TokenInfoFromGinCtx(arg0 *gin.Context) (uuid.UUID, int64, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +302,38 @@ func (mr *MockAuthMockRecorder) TokenInfoFromGinCtx(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenInfoFromGinCtx", reflect.TypeOf((*MockAuth)(nil).TokenInfoFromGinCtx), arg0)
 }
 
+// TokenSession mocks base method.
+func (m *MockAuth) TokenSession(arg0 string) (string, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenSession", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TokenSession indicates an expected call of TokenSession.
+func (mr *MockAuthMockRecorder) TokenSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenSession", reflect.TypeOf((*MockAuth)(nil).TokenSession), arg0)
+}
+
+// ValidateEmailVerificationToken mocks base method.
+func (m *MockAuth) ValidateEmailVerificationToken(arg0 string) (uuid.UUID, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateEmailVerificationToken", arg0)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateEmailVerificationToken indicates an expected call of ValidateEmailVerificationToken.
+func (mr *MockAuthMockRecorder) ValidateEmailVerificationToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEmailVerificationToken", reflect.TypeOf((*MockAuth)(nil).ValidateEmailVerificationToken), arg0)
+}
+
 // ValidateJWT mocks base method.
 func (m *MockAuth) ValidateJWT(arg0 string) (uuid.UUID, int64, error) {
 	m.ctrl.T.Helper()
@@ -170,3 +349,24 @@ func (mr *MockAuthMockRecorder) ValidateJWT(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJWT", reflect.TypeOf((*MockAuth)(nil).ValidateJWT), arg0)
 }
+
+// ValidateJWTScopes mocks base method.
+func (m *MockAuth) ValidateJWTScopes(arg0 string, arg1 ...string) (uuid.UUID, int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateJWTScopes", varargs...)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateJWTScopes indicates an expected call of ValidateJWTScopes.
+func (mr *MockAuthMockRecorder) ValidateJWTScopes(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJWTScopes", reflect.TypeOf((*MockAuth)(nil).ValidateJWTScopes), varargs...)
+}
@@ -6,7 +6,10 @@ package mocks
 
 import (
 	context "context"
+	json "encoding/json"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
@@ -39,257 +42,1504 @@ func (m *MockPostgres) EXPECT() *MockPostgresMockRecorder {
 	return m.recorder
 }
 
+// APIKeyCreate mocks base method.
+func (m *MockPostgres) APIKeyCreate(arg0 *postgres.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyCreate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// APIKeyCreate indicates an expected call of APIKeyCreate.
+func (mr *MockPostgresMockRecorder) APIKeyCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyCreate", reflect.TypeOf((*MockPostgres)(nil).APIKeyCreate), arg0)
+}
+
+// APIKeyGet mocks base method.
+func (m *MockPostgres) APIKeyGet(arg0 string) (postgres.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyGet", arg0)
+	ret0, _ := ret[0].(postgres.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeyGet indicates an expected call of APIKeyGet.
+func (mr *MockPostgresMockRecorder) APIKeyGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyGet", reflect.TypeOf((*MockPostgres)(nil).APIKeyGet), arg0)
+}
+
+// APIKeyRevoke mocks base method.
+func (m *MockPostgres) APIKeyRevoke(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyRevoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// APIKeyRevoke indicates an expected call of APIKeyRevoke.
+func (mr *MockPostgresMockRecorder) APIKeyRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyRevoke", reflect.TypeOf((*MockPostgres)(nil).APIKeyRevoke), arg0, arg1)
+}
+
+// APIKeySecretUpdate mocks base method.
+func (m *MockPostgres) APIKeySecretUpdate(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeySecretUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// APIKeySecretUpdate indicates an expected call of APIKeySecretUpdate.
+func (mr *MockPostgresMockRecorder) APIKeySecretUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeySecretUpdate", reflect.TypeOf((*MockPostgres)(nil).APIKeySecretUpdate), arg0, arg1, arg2)
+}
+
+// APIKeySecrets mocks base method.
+func (m *MockPostgres) APIKeySecrets() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeySecrets")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeySecrets indicates an expected call of APIKeySecrets.
+func (mr *MockPostgresMockRecorder) APIKeySecrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeySecrets", reflect.TypeOf((*MockPostgres)(nil).APIKeySecrets))
+}
+
+// APIKeysClient mocks base method.
+func (m *MockPostgres) APIKeysClient(arg0 uuid.UUID) ([]models.APIKeyInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeysClient", arg0)
+	ret0, _ := ret[0].([]models.APIKeyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeysClient indicates an expected call of APIKeysClient.
+func (mr *MockPostgresMockRecorder) APIKeysClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeysClient", reflect.TypeOf((*MockPostgres)(nil).APIKeysClient), arg0)
+}
+
+// AdminAuditLogCreate mocks base method.
+func (m *MockPostgres) AdminAuditLogCreate(arg0 uuid.UUID, arg1 postgres.AdminAction, arg2 string, arg3 json.RawMessage) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminAuditLogCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminAuditLogCreate indicates an expected call of AdminAuditLogCreate.
+func (mr *MockPostgresMockRecorder) AdminAuditLogCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminAuditLogCreate", reflect.TypeOf((*MockPostgres)(nil).AdminAuditLogCreate), arg0, arg1, arg2, arg3)
+}
+
+// AdminAuditLogPaginated mocks base method.
+func (m *MockPostgres) AdminAuditLogPaginated(arg0 string, arg1 int64, arg2 int32) ([]postgres.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminAuditLogPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminAuditLogPaginated indicates an expected call of AdminAuditLogPaginated.
+func (mr *MockPostgresMockRecorder) AdminAuditLogPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminAuditLogPaginated", reflect.TypeOf((*MockPostgres)(nil).AdminAuditLogPaginated), arg0, arg1, arg2)
+}
+
+// AdminAuditLogSetOutcome mocks base method.
+func (m *MockPostgres) AdminAuditLogSetOutcome(arg0 int64, arg1 postgres.AuditOutcome) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminAuditLogSetOutcome", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminAuditLogSetOutcome indicates an expected call of AdminAuditLogSetOutcome.
+func (mr *MockPostgresMockRecorder) AdminAuditLogSetOutcome(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminAuditLogSetOutcome", reflect.TypeOf((*MockPostgres)(nil).AdminAuditLogSetOutcome), arg0, arg1)
+}
+
+// AuditEventCreate mocks base method.
+func (m *MockPostgres) AuditEventCreate(arg0 *postgres.AuditEventDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEventCreate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuditEventCreate indicates an expected call of AuditEventCreate.
+func (mr *MockPostgresMockRecorder) AuditEventCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEventCreate", reflect.TypeOf((*MockPostgres)(nil).AuditEventCreate), arg0)
+}
+
+// AuditEventsPaginated mocks base method.
+func (m *MockPostgres) AuditEventsPaginated(arg0 uuid.UUID, arg1 postgres.AuditEventType, arg2 postgres.AuditOutcome, arg3 int64, arg4 int32) ([]postgres.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEventsPaginated", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]postgres.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditEventsPaginated indicates an expected call of AuditEventsPaginated.
+func (mr *MockPostgresMockRecorder) AuditEventsPaginated(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEventsPaginated", reflect.TypeOf((*MockPostgres)(nil).AuditEventsPaginated), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AuditEventsRecent mocks base method.
+func (m *MockPostgres) AuditEventsRecent(arg0 uuid.UUID, arg1 int32) ([]models.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEventsRecent", arg0, arg1)
+	ret0, _ := ret[0].([]models.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditEventsRecent indicates an expected call of AuditEventsRecent.
+func (mr *MockPostgresMockRecorder) AuditEventsRecent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEventsRecent", reflect.TypeOf((*MockPostgres)(nil).AuditEventsRecent), arg0, arg1)
+}
+
+// BalanceSnapshotCreate mocks base method.
+func (m *MockPostgres) BalanceSnapshotCreate(arg0 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceSnapshotCreate", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceSnapshotCreate indicates an expected call of BalanceSnapshotCreate.
+func (mr *MockPostgresMockRecorder) BalanceSnapshotCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceSnapshotCreate", reflect.TypeOf((*MockPostgres)(nil).BalanceSnapshotCreate), arg0)
+}
+
+// BalanceSnapshotLatest mocks base method.
+func (m *MockPostgres) BalanceSnapshotLatest() (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceSnapshotLatest")
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceSnapshotLatest indicates an expected call of BalanceSnapshotLatest.
+func (mr *MockPostgresMockRecorder) BalanceSnapshotLatest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceSnapshotLatest", reflect.TypeOf((*MockPostgres)(nil).BalanceSnapshotLatest))
+}
+
 // Close mocks base method.
 func (m *MockPostgres) Close() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Close")
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPostgresMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPostgres)(nil).Close))
+}
+
+// CryptoAccountSetStatus mocks base method.
+func (m *MockPostgres) CryptoAccountSetStatus(arg0 uuid.UUID, arg1 string, arg2 postgres.AccountStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoAccountSetStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CryptoAccountSetStatus indicates an expected call of CryptoAccountSetStatus.
+func (mr *MockPostgresMockRecorder) CryptoAccountSetStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoAccountSetStatus", reflect.TypeOf((*MockPostgres)(nil).CryptoAccountSetStatus), arg0, arg1, arg2)
+}
+
+// CryptoAssetGet mocks base method.
+func (m *MockPostgres) CryptoAssetGet(arg0 string) (postgres.CryptoAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoAssetGet", arg0)
+	ret0, _ := ret[0].(postgres.CryptoAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoAssetGet indicates an expected call of CryptoAssetGet.
+func (mr *MockPostgresMockRecorder) CryptoAssetGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoAssetGet", reflect.TypeOf((*MockPostgres)(nil).CryptoAssetGet), arg0)
+}
+
+// CryptoAssetGetAll mocks base method.
+func (m *MockPostgres) CryptoAssetGetAll() ([]postgres.CryptoAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoAssetGetAll")
+	ret0, _ := ret[0].([]postgres.CryptoAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoAssetGetAll indicates an expected call of CryptoAssetGetAll.
+func (mr *MockPostgresMockRecorder) CryptoAssetGetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoAssetGetAll", reflect.TypeOf((*MockPostgres)(nil).CryptoAssetGetAll))
+}
+
+// CryptoAssetSetStatus mocks base method.
+func (m *MockPostgres) CryptoAssetSetStatus(arg0 string, arg1 postgres.CryptoAssetStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoAssetSetStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CryptoAssetSetStatus indicates an expected call of CryptoAssetSetStatus.
+func (mr *MockPostgresMockRecorder) CryptoAssetSetStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoAssetSetStatus", reflect.TypeOf((*MockPostgres)(nil).CryptoAssetSetStatus), arg0, arg1)
+}
+
+// CryptoAssetUpsert mocks base method.
+func (m *MockPostgres) CryptoAssetUpsert(arg0 *postgres.CryptoAsset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoAssetUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CryptoAssetUpsert indicates an expected call of CryptoAssetUpsert.
+func (mr *MockPostgresMockRecorder) CryptoAssetUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoAssetUpsert", reflect.TypeOf((*MockPostgres)(nil).CryptoAssetUpsert), arg0)
+}
+
+// CryptoBalance mocks base method.
+func (m *MockPostgres) CryptoBalance(arg0 uuid.UUID, arg1 string) (postgres.CryptoAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoBalance", arg0, arg1)
+	ret0, _ := ret[0].(postgres.CryptoAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoBalance indicates an expected call of CryptoBalance.
+func (mr *MockPostgresMockRecorder) CryptoBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoBalance", reflect.TypeOf((*MockPostgres)(nil).CryptoBalance), arg0, arg1)
+}
+
+// CryptoBalanceAsOf mocks base method.
+func (m *MockPostgres) CryptoBalanceAsOf(arg0 uuid.UUID, arg1 string, arg2 time.Time) (postgres.BalanceAsOf, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoBalanceAsOf", arg0, arg1, arg2)
+	ret0, _ := ret[0].(postgres.BalanceAsOf)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoBalanceAsOf indicates an expected call of CryptoBalanceAsOf.
+func (mr *MockPostgresMockRecorder) CryptoBalanceAsOf(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoBalanceAsOf", reflect.TypeOf((*MockPostgres)(nil).CryptoBalanceAsOf), arg0, arg1, arg2)
+}
+
+// CryptoBalanceHistory mocks base method.
+func (m *MockPostgres) CryptoBalanceHistory(arg0 uuid.UUID, arg1 string, arg2, arg3 time.Time) ([]postgres.CryptoBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoBalanceHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.CryptoBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoBalanceHistory indicates an expected call of CryptoBalanceHistory.
+func (mr *MockPostgresMockRecorder) CryptoBalanceHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoBalanceHistory", reflect.TypeOf((*MockPostgres)(nil).CryptoBalanceHistory), arg0, arg1, arg2, arg3)
+}
+
+// CryptoBalancesPaginated mocks base method.
+func (m *MockPostgres) CryptoBalancesPaginated(arg0 uuid.UUID, arg1 string, arg2 int32) ([]postgres.CryptoAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoBalancesPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.CryptoAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoBalancesPaginated indicates an expected call of CryptoBalancesPaginated.
+func (mr *MockPostgresMockRecorder) CryptoBalancesPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoBalancesPaginated", reflect.TypeOf((*MockPostgres)(nil).CryptoBalancesPaginated), arg0, arg1, arg2)
+}
+
+// CryptoCloseAccount mocks base method.
+func (m *MockPostgres) CryptoCloseAccount(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoCloseAccount", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*postgres.FiatJournal)
+	ret1, _ := ret[1].(*postgres.CryptoJournal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CryptoCloseAccount indicates an expected call of CryptoCloseAccount.
+func (mr *MockPostgresMockRecorder) CryptoCloseAccount(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoCloseAccount", reflect.TypeOf((*MockPostgres)(nil).CryptoCloseAccount), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CryptoCreateAccount mocks base method.
+func (m *MockPostgres) CryptoCreateAccount(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoCreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CryptoCreateAccount indicates an expected call of CryptoCreateAccount.
+func (mr *MockPostgresMockRecorder) CryptoCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoCreateAccount", reflect.TypeOf((*MockPostgres)(nil).CryptoCreateAccount), arg0, arg1)
+}
+
+// CryptoHold mocks base method.
+func (m *MockPostgres) CryptoHold(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3 string, arg4 decimal.Decimal, arg5 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoHold", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CryptoHold indicates an expected call of CryptoHold.
+func (mr *MockPostgresMockRecorder) CryptoHold(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoHold", reflect.TypeOf((*MockPostgres)(nil).CryptoHold), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CryptoPurchase mocks base method.
+func (m *MockPostgres) CryptoPurchase(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal, arg5 string) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoPurchase", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*postgres.FiatJournal)
+	ret1, _ := ret[1].(*postgres.CryptoJournal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CryptoPurchase indicates an expected call of CryptoPurchase.
+func (mr *MockPostgresMockRecorder) CryptoPurchase(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoPurchase", reflect.TypeOf((*MockPostgres)(nil).CryptoPurchase), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CryptoSell mocks base method.
+func (m *MockPostgres) CryptoSell(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal, arg5 string) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoSell", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*postgres.FiatJournal)
+	ret1, _ := ret[1].(*postgres.CryptoJournal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CryptoSell indicates an expected call of CryptoSell.
+func (mr *MockPostgresMockRecorder) CryptoSell(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoSell", reflect.TypeOf((*MockPostgres)(nil).CryptoSell), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CryptoTransactionsPaginated mocks base method.
+func (m *MockPostgres) CryptoTransactionsPaginated(arg0 uuid.UUID, arg1 string, arg2, arg3 int32, arg4, arg5 pgtype.Timestamptz) ([]postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoTransactionsPaginated", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]postgres.CryptoJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoTransactionsPaginated indicates an expected call of CryptoTransactionsPaginated.
+func (mr *MockPostgresMockRecorder) CryptoTransactionsPaginated(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoTransactionsPaginated", reflect.TypeOf((*MockPostgres)(nil).CryptoTransactionsPaginated), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CryptoTxDetails mocks base method.
+func (m *MockPostgres) CryptoTxDetails(arg0, arg1 uuid.UUID) ([]postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoTxDetails", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.CryptoJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoTxDetails indicates an expected call of CryptoTxDetails.
+func (mr *MockPostgresMockRecorder) CryptoTxDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoTxDetails", reflect.TypeOf((*MockPostgres)(nil).CryptoTxDetails), arg0, arg1)
+}
+
+// FiatAccountSetStatus mocks base method.
+func (m *MockPostgres) FiatAccountSetStatus(arg0 uuid.UUID, arg1 postgres.Currency, arg2 postgres.AccountStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatAccountSetStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FiatAccountSetStatus indicates an expected call of FiatAccountSetStatus.
+func (mr *MockPostgresMockRecorder) FiatAccountSetStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatAccountSetStatus", reflect.TypeOf((*MockPostgres)(nil).FiatAccountSetStatus), arg0, arg1, arg2)
+}
+
+// FiatAdjustmentApprove mocks base method.
+func (m *MockPostgres) FiatAdjustmentApprove(arg0 string, arg1 uuid.UUID, arg2 string) (*postgres.FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatAdjustmentApprove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*postgres.FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatAdjustmentApprove indicates an expected call of FiatAdjustmentApprove.
+func (mr *MockPostgresMockRecorder) FiatAdjustmentApprove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatAdjustmentApprove", reflect.TypeOf((*MockPostgres)(nil).FiatAdjustmentApprove), arg0, arg1, arg2)
+}
+
+// FiatAdjustmentCreate mocks base method.
+func (m *MockPostgres) FiatAdjustmentCreate(arg0 *postgres.FiatAdjustment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatAdjustmentCreate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FiatAdjustmentCreate indicates an expected call of FiatAdjustmentCreate.
+func (mr *MockPostgresMockRecorder) FiatAdjustmentCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatAdjustmentCreate", reflect.TypeOf((*MockPostgres)(nil).FiatAdjustmentCreate), arg0)
+}
+
+// FiatAdjustmentGet mocks base method.
+func (m *MockPostgres) FiatAdjustmentGet(arg0 string) (postgres.FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatAdjustmentGet", arg0)
+	ret0, _ := ret[0].(postgres.FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatAdjustmentGet indicates an expected call of FiatAdjustmentGet.
+func (mr *MockPostgresMockRecorder) FiatAdjustmentGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatAdjustmentGet", reflect.TypeOf((*MockPostgres)(nil).FiatAdjustmentGet), arg0)
+}
+
+// FiatAdjustmentReject mocks base method.
+func (m *MockPostgres) FiatAdjustmentReject(arg0 string, arg1 uuid.UUID, arg2 string) (*postgres.FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatAdjustmentReject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*postgres.FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatAdjustmentReject indicates an expected call of FiatAdjustmentReject.
+func (mr *MockPostgresMockRecorder) FiatAdjustmentReject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatAdjustmentReject", reflect.TypeOf((*MockPostgres)(nil).FiatAdjustmentReject), arg0, arg1, arg2)
+}
+
+// FiatAdjustmentsPaginated mocks base method.
+func (m *MockPostgres) FiatAdjustmentsPaginated(arg0, arg1 string, arg2 int32) ([]postgres.FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatAdjustmentsPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatAdjustmentsPaginated indicates an expected call of FiatAdjustmentsPaginated.
+func (mr *MockPostgresMockRecorder) FiatAdjustmentsPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatAdjustmentsPaginated", reflect.TypeOf((*MockPostgres)(nil).FiatAdjustmentsPaginated), arg0, arg1, arg2)
+}
+
+// FiatBalance mocks base method.
+func (m *MockPostgres) FiatBalance(arg0 uuid.UUID, arg1 postgres.Currency) (postgres.FiatAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatBalance", arg0, arg1)
+	ret0, _ := ret[0].(postgres.FiatAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatBalance indicates an expected call of FiatBalance.
+func (mr *MockPostgresMockRecorder) FiatBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatBalance", reflect.TypeOf((*MockPostgres)(nil).FiatBalance), arg0, arg1)
+}
+
+// FiatBalanceAsOf mocks base method.
+func (m *MockPostgres) FiatBalanceAsOf(arg0 uuid.UUID, arg1 postgres.Currency, arg2 time.Time) (postgres.BalanceAsOf, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatBalanceAsOf", arg0, arg1, arg2)
+	ret0, _ := ret[0].(postgres.BalanceAsOf)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatBalanceAsOf indicates an expected call of FiatBalanceAsOf.
+func (mr *MockPostgresMockRecorder) FiatBalanceAsOf(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatBalanceAsOf", reflect.TypeOf((*MockPostgres)(nil).FiatBalanceAsOf), arg0, arg1, arg2)
+}
+
+// FiatBalanceHistory mocks base method.
+func (m *MockPostgres) FiatBalanceHistory(arg0 uuid.UUID, arg1 postgres.Currency, arg2, arg3 time.Time) ([]postgres.FiatBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatBalanceHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.FiatBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatBalanceHistory indicates an expected call of FiatBalanceHistory.
+func (mr *MockPostgresMockRecorder) FiatBalanceHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatBalanceHistory", reflect.TypeOf((*MockPostgres)(nil).FiatBalanceHistory), arg0, arg1, arg2, arg3)
+}
+
+// FiatBalancePaginated mocks base method.
+func (m *MockPostgres) FiatBalancePaginated(arg0 uuid.UUID, arg1 postgres.Currency, arg2 int32) ([]postgres.FiatAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatBalancePaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.FiatAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatBalancePaginated indicates an expected call of FiatBalancePaginated.
+func (mr *MockPostgresMockRecorder) FiatBalancePaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatBalancePaginated", reflect.TypeOf((*MockPostgres)(nil).FiatBalancePaginated), arg0, arg1, arg2)
+}
+
+// FiatCloseAccount mocks base method.
+func (m *MockPostgres) FiatCloseAccount(arg0 context.Context, arg1, arg2 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, *postgres.FiatAccountTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatCloseAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
+	ret1, _ := ret[1].(*postgres.FiatAccountTransferResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FiatCloseAccount indicates an expected call of FiatCloseAccount.
+func (mr *MockPostgresMockRecorder) FiatCloseAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatCloseAccount", reflect.TypeOf((*MockPostgres)(nil).FiatCloseAccount), arg0, arg1, arg2)
+}
+
+// FiatCreateAccount mocks base method.
+func (m *MockPostgres) FiatCreateAccount(arg0 uuid.UUID, arg1 postgres.Currency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatCreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FiatCreateAccount indicates an expected call of FiatCreateAccount.
+func (mr *MockPostgresMockRecorder) FiatCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatCreateAccount", reflect.TypeOf((*MockPostgres)(nil).FiatCreateAccount), arg0, arg1)
+}
+
+// FiatCurrencyGetAll mocks base method.
+func (m *MockPostgres) FiatCurrencyGetAll() ([]postgres.FiatCurrency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatCurrencyGetAll")
+	ret0, _ := ret[0].([]postgres.FiatCurrency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatCurrencyGetAll indicates an expected call of FiatCurrencyGetAll.
+func (mr *MockPostgresMockRecorder) FiatCurrencyGetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatCurrencyGetAll", reflect.TypeOf((*MockPostgres)(nil).FiatCurrencyGetAll))
+}
+
+// FiatCurrencyUpsert mocks base method.
+func (m *MockPostgres) FiatCurrencyUpsert(arg0 string, arg1 postgres.FiatCurrencyStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatCurrencyUpsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FiatCurrencyUpsert indicates an expected call of FiatCurrencyUpsert.
+func (mr *MockPostgresMockRecorder) FiatCurrencyUpsert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatCurrencyUpsert", reflect.TypeOf((*MockPostgres)(nil).FiatCurrencyUpsert), arg0, arg1)
+}
+
+// FiatExternalTransfer mocks base method.
+func (m *MockPostgres) FiatExternalTransfer(arg0 context.Context, arg1 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatExternalTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatExternalTransfer indicates an expected call of FiatExternalTransfer.
+func (mr *MockPostgresMockRecorder) FiatExternalTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatExternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatExternalTransfer), arg0, arg1)
+}
+
+// FiatHold mocks base method.
+func (m *MockPostgres) FiatHold(arg0 context.Context, arg1 string, arg2 *postgres.FiatTransactionDetails, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatHold", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FiatHold indicates an expected call of FiatHold.
+func (mr *MockPostgresMockRecorder) FiatHold(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatHold", reflect.TypeOf((*MockPostgres)(nil).FiatHold), arg0, arg1, arg2, arg3)
+}
+
+// FiatInternalTransfer mocks base method.
+func (m *MockPostgres) FiatInternalTransfer(arg0 context.Context, arg1, arg2 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, *postgres.FiatAccountTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatInternalTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
+	ret1, _ := ret[1].(*postgres.FiatAccountTransferResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FiatInternalTransfer indicates an expected call of FiatInternalTransfer.
+func (mr *MockPostgresMockRecorder) FiatInternalTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatInternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatInternalTransfer), arg0, arg1, arg2)
+}
+
+// FiatTransactionsPaginated mocks base method.
+func (m *MockPostgres) FiatTransactionsPaginated(arg0 uuid.UUID, arg1 postgres.Currency, arg2, arg3 int32, arg4, arg5 pgtype.Timestamptz) ([]postgres.FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatTransactionsPaginated", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]postgres.FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatTransactionsPaginated indicates an expected call of FiatTransactionsPaginated.
+func (mr *MockPostgresMockRecorder) FiatTransactionsPaginated(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatTransactionsPaginated", reflect.TypeOf((*MockPostgres)(nil).FiatTransactionsPaginated), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// FiatTxDetails mocks base method.
+func (m *MockPostgres) FiatTxDetails(arg0, arg1 uuid.UUID) ([]postgres.FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatTxDetails", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatTxDetails indicates an expected call of FiatTxDetails.
+func (mr *MockPostgresMockRecorder) FiatTxDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatTxDetails", reflect.TypeOf((*MockPostgres)(nil).FiatTxDetails), arg0, arg1)
+}
+
+// Healthcheck mocks base method.
+func (m *MockPostgres) Healthcheck() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Healthcheck")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Healthcheck indicates an expected call of Healthcheck.
+func (mr *MockPostgresMockRecorder) Healthcheck() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthcheck", reflect.TypeOf((*MockPostgres)(nil).Healthcheck))
+}
+
+// JournalChainHeads mocks base method.
+func (m *MockPostgres) JournalChainHeads() ([]postgres.JournalChainHead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalChainHeads")
+	ret0, _ := ret[0].([]postgres.JournalChainHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalChainHeads indicates an expected call of JournalChainHeads.
+func (mr *MockPostgresMockRecorder) JournalChainHeads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalChainHeads", reflect.TypeOf((*MockPostgres)(nil).JournalChainHeads))
+}
+
+// JournalChainVerify mocks base method.
+func (m *MockPostgres) JournalChainVerify(arg0 string, arg1 []postgres.JournalCheckpoint) (*postgres.JournalChainVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalChainVerify", arg0, arg1)
+	ret0, _ := ret[0].(*postgres.JournalChainVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalChainVerify indicates an expected call of JournalChainVerify.
+func (mr *MockPostgresMockRecorder) JournalChainVerify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalChainVerify", reflect.TypeOf((*MockPostgres)(nil).JournalChainVerify), arg0, arg1)
+}
+
+// JournalCheckpointCreate mocks base method.
+func (m *MockPostgres) JournalCheckpointCreate(arg0 *postgres.JournalCheckpoint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalCheckpointCreate", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalCheckpointCreate indicates an expected call of JournalCheckpointCreate.
+func (mr *MockPostgresMockRecorder) JournalCheckpointCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalCheckpointCreate", reflect.TypeOf((*MockPostgres)(nil).JournalCheckpointCreate), arg0)
+}
+
+// JournalCheckpoints mocks base method.
+func (m *MockPostgres) JournalCheckpoints(arg0 string, arg1 time.Time) ([]postgres.JournalCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalCheckpoints", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.JournalCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalCheckpoints indicates an expected call of JournalCheckpoints.
+func (mr *MockPostgresMockRecorder) JournalCheckpoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalCheckpoints", reflect.TypeOf((*MockPostgres)(nil).JournalCheckpoints), arg0, arg1)
+}
+
+// JournalPartitionArchive mocks base method.
+func (m *MockPostgres) JournalPartitionArchive(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalPartitionArchive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JournalPartitionArchive indicates an expected call of JournalPartitionArchive.
+func (mr *MockPostgresMockRecorder) JournalPartitionArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalPartitionArchive", reflect.TypeOf((*MockPostgres)(nil).JournalPartitionArchive), arg0, arg1)
+}
+
+// JournalPartitionDrop mocks base method.
+func (m *MockPostgres) JournalPartitionDrop(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalPartitionDrop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JournalPartitionDrop indicates an expected call of JournalPartitionDrop.
+func (mr *MockPostgresMockRecorder) JournalPartitionDrop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalPartitionDrop", reflect.TypeOf((*MockPostgres)(nil).JournalPartitionDrop), arg0)
+}
+
+// JournalPartitionExport mocks base method.
+func (m *MockPostgres) JournalPartitionExport(arg0 string, arg1 io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalPartitionExport", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalPartitionExport indicates an expected call of JournalPartitionExport.
+func (mr *MockPostgresMockRecorder) JournalPartitionExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalPartitionExport", reflect.TypeOf((*MockPostgres)(nil).JournalPartitionExport), arg0, arg1)
+}
+
+// JournalPartitions mocks base method.
+func (m *MockPostgres) JournalPartitions() ([]postgres.JournalPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalPartitions")
+	ret0, _ := ret[0].([]postgres.JournalPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalPartitions indicates an expected call of JournalPartitions.
+func (mr *MockPostgresMockRecorder) JournalPartitions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalPartitions", reflect.TypeOf((*MockPostgres)(nil).JournalPartitions))
+}
+
+// JournalPartitionsCreate mocks base method.
+func (m *MockPostgres) JournalPartitionsCreate(arg0 time.Time, arg1 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalPartitionsCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalPartitionsCreate indicates an expected call of JournalPartitionsCreate.
+func (mr *MockPostgresMockRecorder) JournalPartitionsCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalPartitionsCreate", reflect.TypeOf((*MockPostgres)(nil).JournalPartitionsCreate), arg0, arg1)
+}
+
+// JournalReversalGet mocks base method.
+func (m *MockPostgres) JournalReversalGet(arg0 uuid.UUID) (*postgres.JournalReversal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JournalReversalGet", arg0)
+	ret0, _ := ret[0].(*postgres.JournalReversal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JournalReversalGet indicates an expected call of JournalReversalGet.
+func (mr *MockPostgresMockRecorder) JournalReversalGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JournalReversalGet", reflect.TypeOf((*MockPostgres)(nil).JournalReversalGet), arg0)
+}
+
+// LimitOrderClose mocks base method.
+func (m *MockPostgres) LimitOrderClose(arg0 uuid.UUID, arg1 string, arg2 postgres.LimitOrderStatus, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrderClose", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LimitOrderClose indicates an expected call of LimitOrderClose.
+func (mr *MockPostgresMockRecorder) LimitOrderClose(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrderClose", reflect.TypeOf((*MockPostgres)(nil).LimitOrderClose), arg0, arg1, arg2, arg3)
+}
+
+// LimitOrderCreate mocks base method.
+func (m *MockPostgres) LimitOrderCreate(arg0 *postgres.CryptoLimitOrder, arg1 decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrderCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LimitOrderCreate indicates an expected call of LimitOrderCreate.
+func (mr *MockPostgresMockRecorder) LimitOrderCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrderCreate", reflect.TypeOf((*MockPostgres)(nil).LimitOrderCreate), arg0, arg1)
+}
+
+// LimitOrderFill mocks base method.
+func (m *MockPostgres) LimitOrderFill(arg0 *postgres.CryptoLimitOrder, arg1, arg2 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrderFill", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*postgres.FiatJournal)
+	ret1, _ := ret[1].(*postgres.CryptoJournal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LimitOrderFill indicates an expected call of LimitOrderFill.
+func (mr *MockPostgresMockRecorder) LimitOrderFill(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrderFill", reflect.TypeOf((*MockPostgres)(nil).LimitOrderFill), arg0, arg1, arg2)
+}
+
+// LimitOrderGet mocks base method.
+func (m *MockPostgres) LimitOrderGet(arg0 uuid.UUID, arg1 string) (postgres.CryptoLimitOrder, []postgres.CryptoLimitOrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrderGet", arg0, arg1)
+	ret0, _ := ret[0].(postgres.CryptoLimitOrder)
+	ret1, _ := ret[1].([]postgres.CryptoLimitOrderEvent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LimitOrderGet indicates an expected call of LimitOrderGet.
+func (mr *MockPostgresMockRecorder) LimitOrderGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrderGet", reflect.TypeOf((*MockPostgres)(nil).LimitOrderGet), arg0, arg1)
+}
+
+// LimitOrdersExpire mocks base method.
+func (m *MockPostgres) LimitOrdersExpire() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrdersExpire")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LimitOrdersExpire indicates an expected call of LimitOrdersExpire.
+func (mr *MockPostgresMockRecorder) LimitOrdersExpire() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrdersExpire", reflect.TypeOf((*MockPostgres)(nil).LimitOrdersExpire))
+}
+
+// LimitOrdersOpen mocks base method.
+func (m *MockPostgres) LimitOrdersOpen(arg0 string, arg1 int32) ([]postgres.CryptoLimitOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrdersOpen", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.CryptoLimitOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LimitOrdersOpen indicates an expected call of LimitOrdersOpen.
+func (mr *MockPostgresMockRecorder) LimitOrdersOpen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrdersOpen", reflect.TypeOf((*MockPostgres)(nil).LimitOrdersOpen), arg0, arg1)
+}
+
+// LimitOrdersPaginated mocks base method.
+func (m *MockPostgres) LimitOrdersPaginated(arg0 uuid.UUID, arg1, arg2 string, arg3 int32) ([]postgres.CryptoLimitOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitOrdersPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.CryptoLimitOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LimitOrdersPaginated indicates an expected call of LimitOrdersPaginated.
+func (mr *MockPostgresMockRecorder) LimitOrdersPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitOrdersPaginated", reflect.TypeOf((*MockPostgres)(nil).LimitOrdersPaginated), arg0, arg1, arg2, arg3)
+}
+
+// LoginLockoutCreate mocks base method.
+func (m *MockPostgres) LoginLockoutCreate(arg0 postgres.LockoutScope, arg1 string, arg2 int64, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginLockoutCreate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoginLockoutCreate indicates an expected call of LoginLockoutCreate.
+func (mr *MockPostgresMockRecorder) LoginLockoutCreate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginLockoutCreate", reflect.TypeOf((*MockPostgres)(nil).LoginLockoutCreate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// LoginLockoutUnlock mocks base method.
+func (m *MockPostgres) LoginLockoutUnlock(arg0 uuid.UUID, arg1 postgres.LockoutScope, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginLockoutUnlock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoginLockoutUnlock indicates an expected call of LoginLockoutUnlock.
+func (mr *MockPostgresMockRecorder) LoginLockoutUnlock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginLockoutUnlock", reflect.TypeOf((*MockPostgres)(nil).LoginLockoutUnlock), arg0, arg1, arg2)
+}
+
+// LoginLockoutsRecent mocks base method.
+func (m *MockPostgres) LoginLockoutsRecent(arg0 bool, arg1 int32) ([]postgres.LoginLockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginLockoutsRecent", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.LoginLockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginLockoutsRecent indicates an expected call of LoginLockoutsRecent.
+func (mr *MockPostgresMockRecorder) LoginLockoutsRecent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginLockoutsRecent", reflect.TypeOf((*MockPostgres)(nil).LoginLockoutsRecent), arg0, arg1)
+}
+
+// MFAConfirm mocks base method.
+func (m *MockPostgres) MFAConfirm(arg0 uuid.UUID, arg1 int64, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAConfirm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFAConfirm indicates an expected call of MFAConfirm.
+func (mr *MockPostgresMockRecorder) MFAConfirm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAConfirm", reflect.TypeOf((*MockPostgres)(nil).MFAConfirm), arg0, arg1, arg2)
+}
+
+// MFADelete mocks base method.
+func (m *MockPostgres) MFADelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFADelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFADelete indicates an expected call of MFADelete.
+func (mr *MockPostgresMockRecorder) MFADelete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFADelete", reflect.TypeOf((*MockPostgres)(nil).MFADelete), arg0)
+}
+
+// MFAEnroll mocks base method.
+func (m *MockPostgres) MFAEnroll(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAEnroll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFAEnroll indicates an expected call of MFAEnroll.
+func (mr *MockPostgresMockRecorder) MFAEnroll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAEnroll", reflect.TypeOf((*MockPostgres)(nil).MFAEnroll), arg0, arg1)
+}
+
+// MFAGet mocks base method.
+func (m *MockPostgres) MFAGet(arg0 uuid.UUID) (postgres.UserMFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAGet", arg0)
+	ret0, _ := ret[0].(postgres.UserMFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MFAGet indicates an expected call of MFAGet.
+func (mr *MockPostgresMockRecorder) MFAGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAGet", reflect.TypeOf((*MockPostgres)(nil).MFAGet), arg0)
+}
+
+// MFASecretUpdate mocks base method.
+func (m *MockPostgres) MFASecretUpdate(arg0 uuid.UUID, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFASecretUpdate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Close indicates an expected call of Close.
-func (mr *MockPostgresMockRecorder) Close() *gomock.Call {
+// MFASecretUpdate indicates an expected call of MFASecretUpdate.
+func (mr *MockPostgresMockRecorder) MFASecretUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPostgres)(nil).Close))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFASecretUpdate", reflect.TypeOf((*MockPostgres)(nil).MFASecretUpdate), arg0, arg1, arg2)
 }
 
-// CryptoBalance mocks base method.
-func (m *MockPostgres) CryptoBalance(arg0 uuid.UUID, arg1 string) (postgres.CryptoAccount, error) {
+// MFASecrets mocks base method.
+func (m *MockPostgres) MFASecrets() (map[uuid.UUID]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoBalance", arg0, arg1)
-	ret0, _ := ret[0].(postgres.CryptoAccount)
+	ret := m.ctrl.Call(m, "MFASecrets")
+	ret0, _ := ret[0].(map[uuid.UUID]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CryptoBalance indicates an expected call of CryptoBalance.
-func (mr *MockPostgresMockRecorder) CryptoBalance(arg0, arg1 interface{}) *gomock.Call {
+// MFASecrets indicates an expected call of MFASecrets.
+func (mr *MockPostgresMockRecorder) MFASecrets() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoBalance", reflect.TypeOf((*MockPostgres)(nil).CryptoBalance), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFASecrets", reflect.TypeOf((*MockPostgres)(nil).MFASecrets))
 }
 
-// CryptoBalancesPaginated mocks base method.
-func (m *MockPostgres) CryptoBalancesPaginated(arg0 uuid.UUID, arg1 string, arg2 int32) ([]postgres.CryptoAccount, error) {
+// MFASetRecoveryCodes mocks base method.
+func (m *MockPostgres) MFASetRecoveryCodes(arg0 uuid.UUID, arg1 []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoBalancesPaginated", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]postgres.CryptoAccount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MFASetRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CryptoBalancesPaginated indicates an expected call of CryptoBalancesPaginated.
-func (mr *MockPostgresMockRecorder) CryptoBalancesPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+// MFASetRecoveryCodes indicates an expected call of MFASetRecoveryCodes.
+func (mr *MockPostgresMockRecorder) MFASetRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoBalancesPaginated", reflect.TypeOf((*MockPostgres)(nil).CryptoBalancesPaginated), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFASetRecoveryCodes", reflect.TypeOf((*MockPostgres)(nil).MFASetRecoveryCodes), arg0, arg1)
 }
 
-// CryptoCreateAccount mocks base method.
-func (m *MockPostgres) CryptoCreateAccount(arg0 uuid.UUID, arg1 string) error {
+// MFAUseRecoveryCode mocks base method.
+func (m *MockPostgres) MFAUseRecoveryCode(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoCreateAccount", arg0, arg1)
+	ret := m.ctrl.Call(m, "MFAUseRecoveryCode", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CryptoCreateAccount indicates an expected call of CryptoCreateAccount.
-func (mr *MockPostgresMockRecorder) CryptoCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+// MFAUseRecoveryCode indicates an expected call of MFAUseRecoveryCode.
+func (mr *MockPostgresMockRecorder) MFAUseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoCreateAccount", reflect.TypeOf((*MockPostgres)(nil).CryptoCreateAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAUseRecoveryCode", reflect.TypeOf((*MockPostgres)(nil).MFAUseRecoveryCode), arg0, arg1)
 }
 
-// CryptoPurchase mocks base method.
-func (m *MockPostgres) CryptoPurchase(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+// MFAUseStep mocks base method.
+func (m *MockPostgres) MFAUseStep(arg0 uuid.UUID, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoPurchase", arg0, arg1, arg2, arg3, arg4)
-	ret0, _ := ret[0].(*postgres.FiatJournal)
-	ret1, _ := ret[1].(*postgres.CryptoJournal)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "MFAUseStep", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CryptoPurchase indicates an expected call of CryptoPurchase.
-func (mr *MockPostgresMockRecorder) CryptoPurchase(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+// MFAUseStep indicates an expected call of MFAUseStep.
+func (mr *MockPostgresMockRecorder) MFAUseStep(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoPurchase", reflect.TypeOf((*MockPostgres)(nil).CryptoPurchase), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAUseStep", reflect.TypeOf((*MockPostgres)(nil).MFAUseStep), arg0, arg1)
 }
 
-// CryptoSell mocks base method.
-func (m *MockPostgres) CryptoSell(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+// Open mocks base method.
+func (m *MockPostgres) Open() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockPostgresMockRecorder) Open() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockPostgres)(nil).Open))
+}
+
+// RecurringPurchaseCancel mocks base method.
+func (m *MockPostgres) RecurringPurchaseCancel(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecurringPurchaseCancel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecurringPurchaseCancel indicates an expected call of RecurringPurchaseCancel.
+func (mr *MockPostgresMockRecorder) RecurringPurchaseCancel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchaseCancel", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchaseCancel), arg0, arg1)
+}
+
+// RecurringPurchaseCreate mocks base method.
+func (m *MockPostgres) RecurringPurchaseCreate(arg0 *postgres.CryptoRecurringPurchase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecurringPurchaseCreate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecurringPurchaseCreate indicates an expected call of RecurringPurchaseCreate.
+func (mr *MockPostgresMockRecorder) RecurringPurchaseCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchaseCreate", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchaseCreate), arg0)
+}
+
+// RecurringPurchaseExecute mocks base method.
+func (m *MockPostgres) RecurringPurchaseExecute(arg0 *postgres.CryptoRecurringPurchase, arg1 pgtype.Timestamptz, arg2, arg3 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoSell", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "RecurringPurchaseExecute", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*postgres.FiatJournal)
 	ret1, _ := ret[1].(*postgres.CryptoJournal)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// CryptoSell indicates an expected call of CryptoSell.
-func (mr *MockPostgresMockRecorder) CryptoSell(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+// RecurringPurchaseExecute indicates an expected call of RecurringPurchaseExecute.
+func (mr *MockPostgresMockRecorder) RecurringPurchaseExecute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoSell", reflect.TypeOf((*MockPostgres)(nil).CryptoSell), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchaseExecute", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchaseExecute), arg0, arg1, arg2, arg3)
 }
 
-// CryptoTransactionsPaginated mocks base method.
-func (m *MockPostgres) CryptoTransactionsPaginated(arg0 uuid.UUID, arg1 string, arg2, arg3 int32, arg4, arg5 pgtype.Timestamptz) ([]postgres.CryptoJournal, error) {
+// RecurringPurchaseGet mocks base method.
+func (m *MockPostgres) RecurringPurchaseGet(arg0 uuid.UUID, arg1 string) (postgres.CryptoRecurringPurchase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoTransactionsPaginated", arg0, arg1, arg2, arg3, arg4, arg5)
-	ret0, _ := ret[0].([]postgres.CryptoJournal)
+	ret := m.ctrl.Call(m, "RecurringPurchaseGet", arg0, arg1)
+	ret0, _ := ret[0].(postgres.CryptoRecurringPurchase)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CryptoTransactionsPaginated indicates an expected call of CryptoTransactionsPaginated.
-func (mr *MockPostgresMockRecorder) CryptoTransactionsPaginated(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+// RecurringPurchaseGet indicates an expected call of RecurringPurchaseGet.
+func (mr *MockPostgresMockRecorder) RecurringPurchaseGet(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoTransactionsPaginated", reflect.TypeOf((*MockPostgres)(nil).CryptoTransactionsPaginated), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchaseGet", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchaseGet), arg0, arg1)
 }
 
-// CryptoTxDetails mocks base method.
-func (m *MockPostgres) CryptoTxDetails(arg0, arg1 uuid.UUID) ([]postgres.CryptoJournal, error) {
+// RecurringPurchaseRunsPaginated mocks base method.
+func (m *MockPostgres) RecurringPurchaseRunsPaginated(arg0 uuid.UUID, arg1 string, arg2 int64, arg3 int32) ([]postgres.CryptoRecurringPurchaseRun, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoTxDetails", arg0, arg1)
-	ret0, _ := ret[0].([]postgres.CryptoJournal)
+	ret := m.ctrl.Call(m, "RecurringPurchaseRunsPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.CryptoRecurringPurchaseRun)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CryptoTxDetails indicates an expected call of CryptoTxDetails.
-func (mr *MockPostgresMockRecorder) CryptoTxDetails(arg0, arg1 interface{}) *gomock.Call {
+// RecurringPurchaseRunsPaginated indicates an expected call of RecurringPurchaseRunsPaginated.
+func (mr *MockPostgresMockRecorder) RecurringPurchaseRunsPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoTxDetails", reflect.TypeOf((*MockPostgres)(nil).CryptoTxDetails), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchaseRunsPaginated", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchaseRunsPaginated), arg0, arg1, arg2, arg3)
 }
 
-// FiatBalance mocks base method.
-func (m *MockPostgres) FiatBalance(arg0 uuid.UUID, arg1 postgres.Currency) (postgres.FiatAccount, error) {
+// RecurringPurchaseSkip mocks base method.
+func (m *MockPostgres) RecurringPurchaseSkip(arg0 *postgres.CryptoRecurringPurchase, arg1 pgtype.Timestamptz, arg2 postgres.RecurringPurchaseRunStatus, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatBalance", arg0, arg1)
-	ret0, _ := ret[0].(postgres.FiatAccount)
+	ret := m.ctrl.Call(m, "RecurringPurchaseSkip", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecurringPurchaseSkip indicates an expected call of RecurringPurchaseSkip.
+func (mr *MockPostgresMockRecorder) RecurringPurchaseSkip(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchaseSkip", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchaseSkip), arg0, arg1, arg2, arg3)
+}
+
+// RecurringPurchasesDue mocks base method.
+func (m *MockPostgres) RecurringPurchasesDue(arg0 string, arg1 int32) ([]postgres.CryptoRecurringPurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecurringPurchasesDue", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.CryptoRecurringPurchase)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FiatBalance indicates an expected call of FiatBalance.
-func (mr *MockPostgresMockRecorder) FiatBalance(arg0, arg1 interface{}) *gomock.Call {
+// RecurringPurchasesDue indicates an expected call of RecurringPurchasesDue.
+func (mr *MockPostgresMockRecorder) RecurringPurchasesDue(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatBalance", reflect.TypeOf((*MockPostgres)(nil).FiatBalance), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchasesDue", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchasesDue), arg0, arg1)
 }
 
-// FiatBalancePaginated mocks base method.
-func (m *MockPostgres) FiatBalancePaginated(arg0 uuid.UUID, arg1 postgres.Currency, arg2 int32) ([]postgres.FiatAccount, error) {
+// RecurringPurchasesPaginated mocks base method.
+func (m *MockPostgres) RecurringPurchasesPaginated(arg0 uuid.UUID, arg1, arg2 string, arg3 int32) ([]postgres.CryptoRecurringPurchase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatBalancePaginated", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]postgres.FiatAccount)
+	ret := m.ctrl.Call(m, "RecurringPurchasesPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.CryptoRecurringPurchase)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FiatBalancePaginated indicates an expected call of FiatBalancePaginated.
-func (mr *MockPostgresMockRecorder) FiatBalancePaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+// RecurringPurchasesPaginated indicates an expected call of RecurringPurchasesPaginated.
+func (mr *MockPostgresMockRecorder) RecurringPurchasesPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatBalancePaginated", reflect.TypeOf((*MockPostgres)(nil).FiatBalancePaginated), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringPurchasesPaginated", reflect.TypeOf((*MockPostgres)(nil).RecurringPurchasesPaginated), arg0, arg1, arg2, arg3)
 }
 
-// FiatCreateAccount mocks base method.
-func (m *MockPostgres) FiatCreateAccount(arg0 uuid.UUID, arg1 postgres.Currency) error {
+// RefreshSessionRevoke mocks base method.
+func (m *MockPostgres) RefreshSessionRevoke(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatCreateAccount", arg0, arg1)
+	ret := m.ctrl.Call(m, "RefreshSessionRevoke", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// FiatCreateAccount indicates an expected call of FiatCreateAccount.
-func (mr *MockPostgresMockRecorder) FiatCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+// RefreshSessionRevoke indicates an expected call of RefreshSessionRevoke.
+func (mr *MockPostgresMockRecorder) RefreshSessionRevoke(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatCreateAccount", reflect.TypeOf((*MockPostgres)(nil).FiatCreateAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSessionRevoke", reflect.TypeOf((*MockPostgres)(nil).RefreshSessionRevoke), arg0, arg1)
 }
 
-// FiatExternalTransfer mocks base method.
-func (m *MockPostgres) FiatExternalTransfer(arg0 context.Context, arg1 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, error) {
+// RefreshSessionsClient mocks base method.
+func (m *MockPostgres) RefreshSessionsClient(arg0 uuid.UUID) ([]models.SessionInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatExternalTransfer", arg0, arg1)
-	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
+	ret := m.ctrl.Call(m, "RefreshSessionsClient", arg0)
+	ret0, _ := ret[0].([]models.SessionInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FiatExternalTransfer indicates an expected call of FiatExternalTransfer.
-func (mr *MockPostgresMockRecorder) FiatExternalTransfer(arg0, arg1 interface{}) *gomock.Call {
+// RefreshSessionsClient indicates an expected call of RefreshSessionsClient.
+func (mr *MockPostgresMockRecorder) RefreshSessionsClient(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatExternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatExternalTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSessionsClient", reflect.TypeOf((*MockPostgres)(nil).RefreshSessionsClient), arg0)
 }
 
-// FiatInternalTransfer mocks base method.
-func (m *MockPostgres) FiatInternalTransfer(arg0 context.Context, arg1, arg2 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, *postgres.FiatAccountTransferResult, error) {
+// RefreshSessionsRevokeAll mocks base method.
+func (m *MockPostgres) RefreshSessionsRevokeAll(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatInternalTransfer", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
-	ret1, _ := ret[1].(*postgres.FiatAccountTransferResult)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "RefreshSessionsRevokeAll", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FiatInternalTransfer indicates an expected call of FiatInternalTransfer.
-func (mr *MockPostgresMockRecorder) FiatInternalTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+// RefreshSessionsRevokeAll indicates an expected call of RefreshSessionsRevokeAll.
+func (mr *MockPostgresMockRecorder) RefreshSessionsRevokeAll(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatInternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatInternalTransfer), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSessionsRevokeAll", reflect.TypeOf((*MockPostgres)(nil).RefreshSessionsRevokeAll), arg0)
 }
 
-// FiatTransactionsPaginated mocks base method.
-func (m *MockPostgres) FiatTransactionsPaginated(arg0 uuid.UUID, arg1 postgres.Currency, arg2, arg3 int32, arg4, arg5 pgtype.Timestamptz) ([]postgres.FiatJournal, error) {
+// RefreshTokenCreate mocks base method.
+func (m *MockPostgres) RefreshTokenCreate(arg0 *postgres.RefreshToken) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatTransactionsPaginated", arg0, arg1, arg2, arg3, arg4, arg5)
-	ret0, _ := ret[0].([]postgres.FiatJournal)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RefreshTokenCreate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FiatTransactionsPaginated indicates an expected call of FiatTransactionsPaginated.
-func (mr *MockPostgresMockRecorder) FiatTransactionsPaginated(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+// RefreshTokenCreate indicates an expected call of RefreshTokenCreate.
+func (mr *MockPostgresMockRecorder) RefreshTokenCreate(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatTransactionsPaginated", reflect.TypeOf((*MockPostgres)(nil).FiatTransactionsPaginated), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenCreate", reflect.TypeOf((*MockPostgres)(nil).RefreshTokenCreate), arg0)
 }
 
-// FiatTxDetails mocks base method.
-func (m *MockPostgres) FiatTxDetails(arg0, arg1 uuid.UUID) ([]postgres.FiatJournal, error) {
+// RefreshTokenRotate mocks base method.
+func (m *MockPostgres) RefreshTokenRotate(arg0 string, arg1 *postgres.RefreshToken) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatTxDetails", arg0, arg1)
-	ret0, _ := ret[0].([]postgres.FiatJournal)
+	ret := m.ctrl.Call(m, "RefreshTokenRotate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshTokenRotate indicates an expected call of RefreshTokenRotate.
+func (mr *MockPostgresMockRecorder) RefreshTokenRotate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenRotate", reflect.TypeOf((*MockPostgres)(nil).RefreshTokenRotate), arg0, arg1)
+}
+
+// TransactionReverse mocks base method.
+func (m *MockPostgres) TransactionReverse(arg0, arg1 uuid.UUID, arg2 string) (*postgres.TransactionReversal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReverse", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*postgres.TransactionReversal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FiatTxDetails indicates an expected call of FiatTxDetails.
-func (mr *MockPostgresMockRecorder) FiatTxDetails(arg0, arg1 interface{}) *gomock.Call {
+// TransactionReverse indicates an expected call of TransactionReverse.
+func (mr *MockPostgresMockRecorder) TransactionReverse(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatTxDetails", reflect.TypeOf((*MockPostgres)(nil).FiatTxDetails), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReverse", reflect.TypeOf((*MockPostgres)(nil).TransactionReverse), arg0, arg1, arg2)
 }
 
-// Healthcheck mocks base method.
-func (m *MockPostgres) Healthcheck() error {
+// TriggerOrderClose mocks base method.
+func (m *MockPostgres) TriggerOrderClose(arg0 uuid.UUID, arg1 string, arg2 postgres.TriggerOrderStatus, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Healthcheck")
+	ret := m.ctrl.Call(m, "TriggerOrderClose", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Healthcheck indicates an expected call of Healthcheck.
-func (mr *MockPostgresMockRecorder) Healthcheck() *gomock.Call {
+// TriggerOrderClose indicates an expected call of TriggerOrderClose.
+func (mr *MockPostgresMockRecorder) TriggerOrderClose(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthcheck", reflect.TypeOf((*MockPostgres)(nil).Healthcheck))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrderClose", reflect.TypeOf((*MockPostgres)(nil).TriggerOrderClose), arg0, arg1, arg2, arg3)
 }
 
-// Open mocks base method.
-func (m *MockPostgres) Open() error {
+// TriggerOrderCreate mocks base method.
+func (m *MockPostgres) TriggerOrderCreate(arg0 *postgres.CryptoTriggerOrder) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Open")
+	ret := m.ctrl.Call(m, "TriggerOrderCreate", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Open indicates an expected call of Open.
-func (mr *MockPostgresMockRecorder) Open() *gomock.Call {
+// TriggerOrderCreate indicates an expected call of TriggerOrderCreate.
+func (mr *MockPostgresMockRecorder) TriggerOrderCreate(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockPostgres)(nil).Open))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrderCreate", reflect.TypeOf((*MockPostgres)(nil).TriggerOrderCreate), arg0)
+}
+
+// TriggerOrderExecute mocks base method.
+func (m *MockPostgres) TriggerOrderExecute(arg0 *postgres.CryptoTriggerOrder, arg1 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerOrderExecute", arg0, arg1)
+	ret0, _ := ret[0].(*postgres.FiatJournal)
+	ret1, _ := ret[1].(*postgres.CryptoJournal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TriggerOrderExecute indicates an expected call of TriggerOrderExecute.
+func (mr *MockPostgresMockRecorder) TriggerOrderExecute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrderExecute", reflect.TypeOf((*MockPostgres)(nil).TriggerOrderExecute), arg0, arg1)
+}
+
+// TriggerOrderGet mocks base method.
+func (m *MockPostgres) TriggerOrderGet(arg0 uuid.UUID, arg1 string) (postgres.CryptoTriggerOrder, []postgres.CryptoTriggerOrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerOrderGet", arg0, arg1)
+	ret0, _ := ret[0].(postgres.CryptoTriggerOrder)
+	ret1, _ := ret[1].([]postgres.CryptoTriggerOrderEvent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TriggerOrderGet indicates an expected call of TriggerOrderGet.
+func (mr *MockPostgresMockRecorder) TriggerOrderGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrderGet", reflect.TypeOf((*MockPostgres)(nil).TriggerOrderGet), arg0, arg1)
+}
+
+// TriggerOrderTrail mocks base method.
+func (m *MockPostgres) TriggerOrderTrail(arg0 *postgres.CryptoTriggerOrder, arg1 decimal.Decimal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerOrderTrail", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerOrderTrail indicates an expected call of TriggerOrderTrail.
+func (mr *MockPostgresMockRecorder) TriggerOrderTrail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrderTrail", reflect.TypeOf((*MockPostgres)(nil).TriggerOrderTrail), arg0, arg1)
+}
+
+// TriggerOrdersActive mocks base method.
+func (m *MockPostgres) TriggerOrdersActive(arg0 string, arg1 int32) ([]postgres.CryptoTriggerOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerOrdersActive", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.CryptoTriggerOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerOrdersActive indicates an expected call of TriggerOrdersActive.
+func (mr *MockPostgresMockRecorder) TriggerOrdersActive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrdersActive", reflect.TypeOf((*MockPostgres)(nil).TriggerOrdersActive), arg0, arg1)
+}
+
+// TriggerOrdersPaginated mocks base method.
+func (m *MockPostgres) TriggerOrdersPaginated(arg0 uuid.UUID, arg1, arg2 string, arg3 int32) ([]postgres.CryptoTriggerOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerOrdersPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.CryptoTriggerOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerOrdersPaginated indicates an expected call of TriggerOrdersPaginated.
+func (mr *MockPostgresMockRecorder) TriggerOrdersPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOrdersPaginated", reflect.TypeOf((*MockPostgres)(nil).TriggerOrdersPaginated), arg0, arg1, arg2, arg3)
 }
 
 // UserCredentials mocks base method.
@@ -337,6 +1587,21 @@ func (mr *MockPostgresMockRecorder) UserGetInfo(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetInfo", reflect.TypeOf((*MockPostgres)(nil).UserGetInfo), arg0)
 }
 
+// UserGetStatus mocks base method.
+func (m *MockPostgres) UserGetStatus(arg0 uuid.UUID) (models.UserStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGetStatus", arg0)
+	ret0, _ := ret[0].(models.UserStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGetStatus indicates an expected call of UserGetStatus.
+func (mr *MockPostgresMockRecorder) UserGetStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetStatus", reflect.TypeOf((*MockPostgres)(nil).UserGetStatus), arg0)
+}
+
 // UserIsDeleted mocks base method.
 func (m *MockPostgres) UserIsDeleted(arg0 uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -366,3 +1631,74 @@ func (mr *MockPostgresMockRecorder) UserRegister(arg0 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRegister", reflect.TypeOf((*MockPostgres)(nil).UserRegister), arg0)
 }
+
+// UserSearch mocks base method.
+func (m *MockPostgres) UserSearch(arg0 string, arg1 int32) ([]models.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserSearch", arg0, arg1)
+	ret0, _ := ret[0].([]models.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserSearch indicates an expected call of UserSearch.
+func (mr *MockPostgresMockRecorder) UserSearch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSearch", reflect.TypeOf((*MockPostgres)(nil).UserSearch), arg0, arg1)
+}
+
+// UserSetFrozen mocks base method.
+func (m *MockPostgres) UserSetFrozen(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserSetFrozen", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserSetFrozen indicates an expected call of UserSetFrozen.
+func (mr *MockPostgresMockRecorder) UserSetFrozen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSetFrozen", reflect.TypeOf((*MockPostgres)(nil).UserSetFrozen), arg0, arg1)
+}
+
+// UserUpdatePassword mocks base method.
+func (m *MockPostgres) UserUpdatePassword(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserUpdatePassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserUpdatePassword indicates an expected call of UserUpdatePassword.
+func (mr *MockPostgresMockRecorder) UserUpdatePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUpdatePassword", reflect.TypeOf((*MockPostgres)(nil).UserUpdatePassword), arg0, arg1)
+}
+
+// UserUpdateProfile mocks base method.
+func (m *MockPostgres) UserUpdateProfile(arg0 uuid.UUID, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserUpdateProfile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserUpdateProfile indicates an expected call of UserUpdateProfile.
+func (mr *MockPostgresMockRecorder) UserUpdateProfile(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUpdateProfile", reflect.TypeOf((*MockPostgres)(nil).UserUpdateProfile), arg0, arg1, arg2, arg3)
+}
+
+// UserVerifyEmail mocks base method.
+func (m *MockPostgres) UserVerifyEmail(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserVerifyEmail indicates an expected call of UserVerifyEmail.
+func (mr *MockPostgresMockRecorder) UserVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserVerifyEmail", reflect.TypeOf((*MockPostgres)(nil).UserVerifyEmail), arg0, arg1)
+}
@@ -80,6 +80,20 @@ func (mr *MockRedisMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedis)(nil).Get), arg0, arg1)
 }
 
+// GetDel mocks base method.
+func (m *MockRedis) GetDel(arg0 string, arg1 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetDel indicates an expected call of GetDel.
+func (mr *MockRedisMockRecorder) GetDel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDel", reflect.TypeOf((*MockRedis)(nil).GetDel), arg0, arg1)
+}
+
 // Healthcheck mocks base method.
 func (m *MockRedis) Healthcheck() error {
 	m.ctrl.T.Helper()
@@ -94,6 +108,21 @@ func (mr *MockRedisMockRecorder) Healthcheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthcheck", reflect.TypeOf((*MockRedis)(nil).Healthcheck))
 }
 
+// Incr mocks base method.
+func (m *MockRedis) Incr(arg0 string, arg1 time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockRedisMockRecorder) Incr(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockRedis)(nil).Incr), arg0, arg1)
+}
+
 // Open mocks base method.
 func (m *MockRedis) Open() error {
 	m.ctrl.T.Helper()
@@ -121,3 +150,35 @@ func (mr *MockRedisMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedis)(nil).Set), arg0, arg1, arg2)
 }
+
+// SetNX mocks base method.
+func (m *MockRedis) SetNX(arg0 string, arg1 interface{}, arg2 time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockRedisMockRecorder) SetNX(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockRedis)(nil).SetNX), arg0, arg1, arg2)
+}
+
+// SlidingWindow mocks base method.
+func (m *MockRedis) SlidingWindow(arg0 string, arg1 int64, arg2 time.Duration) (bool, int64, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlidingWindow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(time.Duration)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SlidingWindow indicates an expected call of SlidingWindow.
+func (mr *MockRedisMockRecorder) SlidingWindow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlidingWindow", reflect.TypeOf((*MockRedis)(nil).SlidingWindow), arg0, arg1, arg2)
+}
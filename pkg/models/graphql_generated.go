@@ -2,6 +2,21 @@
 
 package models
 
+type AdminAccountStatusResponse struct {
+	ClientID string `json:"clientID"`
+	Code     string `json:"code"`
+	Status   string `json:"status"`
+}
+
+type AdminFreezeResponse struct {
+	ClientID string `json:"clientID"`
+	IsFrozen bool   `json:"isFrozen"`
+}
+
+type AdminUnlockResponse struct {
+	ClientID string `json:"clientID"`
+}
+
 type CryptoOpenAccountResponse struct {
 	ClientID string `json:"clientID"`
 	Ticker   string `json:"ticker"`
@@ -1,19 +1,23 @@
 package models
 
 import (
+	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
 )
 
 // JWTAuthResponse is the response to a successful login and token refresh.
-// The client uses the expires field on to know when to refresh the token.
+// The client uses the expires field on to know when to refresh the token. Logins and session refreshes also issue a
+// refresh token that can be exchanged for a new JWT once the JWT has expired.
 //
 //nolint:lll
 type JWTAuthResponse struct {
-	Token     string `json:"token"     validate:"required" yaml:"token"`     // JWT string sent to and validated by the server.
-	Expires   int64  `json:"expires"   validate:"required" yaml:"expires"`   // Expiration time as unix time stamp. Strictly used by client to gauge when to refresh the token.
-	Threshold int64  `json:"threshold" validate:"required" yaml:"threshold"` // The window in seconds before expiration during which the token can be refreshed.
+	Token          string `json:"token"                    validate:"required" yaml:"token"`                    // JWT string sent to and validated by the server.
+	Expires        int64  `json:"expires"                  validate:"required" yaml:"expires"`                  // Expiration time as unix time stamp. Strictly used by client to gauge when to refresh the token.
+	Threshold      int64  `json:"threshold"                validate:"required" yaml:"threshold"`                // The window in seconds before expiration during which the token can be refreshed.
+	RefreshToken   string `json:"refreshToken,omitempty"                       yaml:"refreshToken,omitempty"`   // Opaque single-use refresh token that is exchanged for a new JWT and refresh token.
+	RefreshExpires int64  `json:"refreshExpires,omitempty"                     yaml:"refreshExpires,omitempty"` // Expiration time of the refresh token as unix time stamp.
 }
 
 // HTTPError is a generic error message that is returned to the requester.
@@ -22,6 +26,12 @@ type HTTPError struct {
 	Payload any    `json:"payload,omitempty" yaml:"payload,omitempty"`
 }
 
+// HTTPRetryAfter is the payload of an error message for a request that was rejected until a number of seconds have
+// passed.
+type HTTPRetryAfter struct {
+	RetryAfter int64 `json:"retryAfter" yaml:"retryAfter"`
+}
+
 // HTTPSuccess is a generic success message that is returned to the requester.
 type HTTPSuccess struct {
 	Message string `json:"message,omitempty" yaml:"message,omitempty"`
@@ -35,11 +45,73 @@ type HTTPDeleteUserRequest struct {
 	Confirmation string `json:"confirmation" validate:"required" yaml:"confirmation"`
 }
 
+// HTTPChangePasswordRequest is a request to replace the password of a user account. The current password must be
+// supplied and the new password must differ from it.
+//
+//nolint:lll
+type HTTPChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" validate:"required,min=8,max=32"                         yaml:"currentPassword"`
+	NewPassword     string `json:"newPassword"     validate:"required,min=8,max=32,nefield=CurrentPassword" yaml:"newPassword"`
+}
+
+// HTTPPasswordResetRequest is a request to mail a password reset token to the email address on a user account.
+type HTTPPasswordResetRequest struct {
+	Username string `json:"username" validate:"required,min=8,max=32" yaml:"username"`
+}
+
+// HTTPPasswordResetConfirmRequest is a request to replace the password of a user account using a password reset token.
+type HTTPPasswordResetConfirmRequest struct {
+	Token    string `json:"token"    validate:"required,max=64"       yaml:"token"`
+	Password string `json:"password" validate:"required,min=8,max=32" yaml:"password"`
+}
+
+// HTTPUpdateProfileRequest is a request to update the names and email address of a user account. At least one field
+// must be supplied, and fields that are left empty are not changed.
+//
+//nolint:lll
+type HTTPUpdateProfileRequest struct {
+	FirstName string `json:"firstName,omitempty" validate:"required_without_all=LastName Email,max=64" yaml:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"  validate:"max=64"                                     yaml:"lastName,omitempty"`
+	Email     string `json:"email,omitempty"     validate:"omitempty,email,max=64"                     yaml:"email,omitempty"`
+}
+
+// HTTPRefreshSessionRequest is a request to exchange a refresh token for a new JWT and refresh token.
+type HTTPRefreshSessionRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required,max=64" yaml:"refreshToken"`
+}
+
+// HTTPMFAConfirmRequest is a request to enable two-factor authentication with the first one-time password generated
+// for the enrolment.
+type HTTPMFAConfirmRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6" yaml:"code"`
+}
+
+// HTTPMFAEnrollResponse is the response to a two-factor authentication enrolment request. The secret and the otpauth URI
+// are only ever returned once and are presented to the authenticator application of the user.
+type HTTPMFAEnrollResponse struct {
+	Secret string `json:"secret" yaml:"secret"`
+	URI    string `json:"uri"    yaml:"uri"`
+}
+
 // HTTPOpenCurrencyAccountRequest is a request to open an account in a specified Fiat currency.
 type HTTPOpenCurrencyAccountRequest struct {
 	Currency string `json:"currency" validate:"required" yaml:"currency"`
 }
 
+// HTTPCloseFiatAccountRequest is a request to close an account in a specified Fiat currency. Any remaining balance can
+// optionally be swept into another of the client's Fiat currency accounts.
+type HTTPCloseFiatAccountRequest struct {
+	Currency      string `json:"currency"      validate:"required" yaml:"currency"`
+	SweepCurrency string `json:"sweepCurrency"                     yaml:"sweepCurrency"`
+}
+
+// HTTPCloseCryptoAccountRequest is a request to close an account in a specified Cryptocurrency. Any remaining balance
+// can optionally be sold into one of the client's Fiat currency accounts.
+type HTTPCloseCryptoAccountRequest struct {
+	Ticker        string `json:"ticker"        validate:"required" yaml:"ticker"`
+	SweepCurrency string `json:"sweepCurrency"                     yaml:"sweepCurrency"`
+}
+
 // HTTPDepositCurrencyRequest is a request to deposit currency in to a specified Fiat currency.
 type HTTPDepositCurrencyRequest struct {
 	Amount   decimal.Decimal `json:"amount"   validate:"required,gt=0" yaml:"amount"`
@@ -51,6 +123,7 @@ type HTTPExchangeOfferRequest struct {
 	SourceCurrency      string          `json:"sourceCurrency"      validate:"required"      yaml:"sourceCurrency"`
 	DestinationCurrency string          `json:"destinationCurrency" validate:"required"      yaml:"destinationCurrency"`
 	SourceAmount        decimal.Decimal `json:"sourceAmount"        validate:"required,gt=0" yaml:"sourceAmount"`
+	Hold                bool            `json:"hold,omitempty"                                yaml:"hold,omitempty"`
 }
 
 // HTTPCryptoOfferRequest is a request to convert a source to destination currency in the source currency amount.
@@ -67,6 +140,7 @@ type HTTPExchangeOfferResponse struct {
 	Expires          int64           `json:"expires"                    yaml:"expires"`
 	IsCryptoPurchase bool            `json:"isCryptoPurchase,omitempty" yaml:"isCryptoPurchase,omitempty"`
 	IsCryptoSale     bool            `json:"isCryptoSale,omitempty"     yaml:"isCryptoSale,omitempty"`
+	IsHeld           bool            `json:"isHeld,omitempty"           yaml:"isHeld,omitempty"`
 }
 
 // HTTPTransferRequest is the request to accept and execute an existing exchange offer.
@@ -89,8 +163,8 @@ type HTTPCryptoTransferResponse struct {
 // HTTPFiatDetailsPaginated is the response to paginated account details request. It returns a link to the next page of
 // information.
 type HTTPFiatDetailsPaginated struct {
-	AccountBalances []postgres.FiatAccount `json:"accountBalances"`
-	Links           HTTPLinks              `json:"links"`
+	AccountBalances []postgres.FiatAccountBalance `json:"accountBalances"`
+	Links           HTTPLinks                     `json:"links"`
 }
 
 // HTTPFiatTransactionsPaginated is the response to paginated account transactions request. It returns a link to the
@@ -109,8 +183,8 @@ type HTTPLinks struct {
 // HTTPCryptoDetailsPaginated is the response to paginated account details request. It returns a link to the next page
 // of information.
 type HTTPCryptoDetailsPaginated struct {
-	AccountBalances []postgres.CryptoAccount `json:"accountBalances"`
-	Links           HTTPLinks                `json:"links"`
+	AccountBalances []postgres.CryptoAccountBalance `json:"accountBalances"`
+	Links           HTTPLinks                       `json:"links"`
 }
 
 // HTTPCryptoTransactionsPaginated is the response to paginated account transactions request. It returns a link to the
@@ -119,3 +193,221 @@ type HTTPCryptoTransactionsPaginated struct {
 	TransactionDetails []postgres.CryptoJournal `json:"transactionDetails"`
 	Links              HTTPLinks                `json:"links,omitempty"`
 }
+
+// HTTPCryptoAssetRequest is a request to register a Cryptocurrency or update its details in the registry.
+//
+//nolint:lll
+type HTTPCryptoAssetRequest struct {
+	Ticker        string          `json:"ticker"        validate:"required,min=1,max=6" yaml:"ticker"`
+	Name          string          `json:"name"          validate:"required,max=64"      yaml:"name"`
+	DecimalPlaces *int32          `json:"decimalPlaces" validate:"required,min=0,max=18" yaml:"decimalPlaces"`
+	MinOrder      decimal.Decimal `json:"minOrder"      validate:"required"             yaml:"minOrder"`
+	MaxOrder      decimal.Decimal `json:"maxOrder"      validate:"required"             yaml:"maxOrder"`
+	IsHalted      bool            `json:"isHalted"                                      yaml:"isHalted"`
+}
+
+// HTTPFiatCurrencyRequest is a request to register a Fiat currency or update its circulation status.
+type HTTPFiatCurrencyRequest struct {
+	Code   string `json:"code"   validate:"required,uppercase,min=3,max=6"               yaml:"code"`
+	Status string `json:"status" validate:"required,oneof=ACTIVE DEPRECATED WITHDRAWN" yaml:"status"`
+}
+
+// HTTPCryptoAssetStatusRequest is a request to enable or halt trading for a Cryptocurrency.
+type HTTPCryptoAssetStatusRequest struct {
+	IsHalted *bool `json:"isHalted" validate:"required" yaml:"isHalted"`
+}
+
+// HTTPAdminFreezeRequest is a request to freeze or unfreeze a user account along with the reason for doing so.
+type HTTPAdminFreezeRequest struct {
+	IsFrozen *bool  `json:"isFrozen" validate:"required"         yaml:"isFrozen"`
+	Reason   string `json:"reason"   validate:"required,max=256" yaml:"reason"`
+}
+
+// HTTPAdminUnlockRequest is a request to lift the login lockout of a user account along with the reason for doing so.
+type HTTPAdminUnlockRequest struct {
+	Reason string `json:"reason" validate:"required,max=256" yaml:"reason"`
+}
+
+// HTTPAdminAccountStatusRequest is a request to set the status of a Fiat or Cryptocurrency account along with the
+// reason for doing so.
+type HTTPAdminAccountStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=ACTIVE FROZEN_DEBITS FROZEN" yaml:"status"`
+	Reason string `json:"reason" validate:"required,max=256"                          yaml:"reason"`
+}
+
+// HTTPAdminReversalRequest is a request to post a compensating reversal of a transaction along with the reason for
+// doing so.
+type HTTPAdminReversalRequest struct {
+	Reason string `json:"reason" validate:"required,max=256" yaml:"reason"`
+}
+
+// HTTPAdminAdjustmentRequest is a request to make a manual adjustment of a Fiat account. Credits are positive amounts and
+// debits are negative amounts. The adjustment must be approved by a different administrator before it is posted.
+type HTTPAdminAdjustmentRequest struct {
+	ClientID      string          `json:"clientID"      validate:"required"                                                      yaml:"clientID"`
+	Currency      string          `json:"currency"      validate:"required"                                                      yaml:"currency"`
+	Amount        decimal.Decimal `json:"amount"        validate:"required"                                                      yaml:"amount"`
+	ReasonCode    string          `json:"reasonCode"    validate:"required,oneof=GOODWILL FEE_CORRECTION ERROR_CORRECTION OTHER" yaml:"reasonCode"`
+	Justification string          `json:"justification" validate:"required,max=512"                                              yaml:"justification"`
+}
+
+// HTTPAdminAdjustmentDecisionRequest is a request to approve or reject a pending manual adjustment of a Fiat account
+// along with a note explaining the decision.
+type HTTPAdminAdjustmentDecisionRequest struct {
+	Note string `json:"note" validate:"required,max=256" yaml:"note"`
+}
+
+// HTTPAdminAdjustmentsPaginated is the response to a paginated manual adjustments request. It returns a link to the
+// next page of information.
+type HTTPAdminAdjustmentsPaginated struct {
+	Adjustments []postgres.FiatAdjustment `json:"adjustments"`
+	Links       HTTPLinks                 `json:"links"`
+}
+
+// HTTPAdminAuditLogPaginated is the response to a paginated audit log request. It returns a link to the next page of
+// information.
+type HTTPAdminAuditLogPaginated struct {
+	Entries []postgres.AdminAuditLog `json:"entries"`
+	Links   HTTPLinks                `json:"links"`
+}
+
+// HTTPAuditEventsPaginated is the response to a paginated security audit log request. It returns a link to the next
+// page of information.
+type HTTPAuditEventsPaginated struct {
+	Events []postgres.AuditEvent `json:"events"`
+	Links  HTTPLinks             `json:"links"`
+}
+
+// HTTPLedgerVerification is the response to a journal hash chain verification request. The first broken link in each
+// journal that is not intact is reported in its verification.
+type HTTPLedgerVerification struct {
+	Intact   bool                                 `json:"intact"`
+	Journals []*postgres.JournalChainVerification `json:"journals"`
+}
+
+// HTTPFiatJournalEntry is a Fiat journal entry of a transaction that has been reversed, or that is a reversal. It is
+// linked to the transaction that reversed it, or to the transaction it reverses.
+type HTTPFiatJournalEntry struct {
+	postgres.FiatJournal
+	ReversedBy *uuid.UUID `json:"reversedBy,omitempty"`
+	Reverses   *uuid.UUID `json:"reverses,omitempty"`
+}
+
+// HTTPCryptoJournalEntry is a Crypto journal entry of a transaction that has been reversed, or that is a reversal. It is
+// linked to the transaction that reversed it, or to the transaction it reverses.
+type HTTPCryptoJournalEntry struct {
+	postgres.CryptoJournal
+	ReversedBy *uuid.UUID `json:"reversedBy,omitempty"`
+	Reverses   *uuid.UUID `json:"reverses,omitempty"`
+}
+
+// HTTPLimitOrderRequest is a request to place a limit order to purchase or sell an amount of a Cryptocurrency once its
+// price in a Fiat currency reaches the limit price. The order expires at the supplied Unix timestamp.
+type HTTPLimitOrderRequest struct {
+	FiatCurrency string          `json:"fiatCurrency" validate:"required"             yaml:"fiatCurrency"`
+	Ticker       string          `json:"ticker"       validate:"required,min=1,max=6" yaml:"ticker"`
+	Amount       decimal.Decimal `json:"amount"       validate:"required,gt=0"        yaml:"amount"`
+	LimitPrice   decimal.Decimal `json:"limitPrice"   validate:"required,gt=0"        yaml:"limitPrice"`
+	ExpiresAt    int64           `json:"expiresAt"    validate:"required,gt=0"        yaml:"expiresAt"`
+	IsPurchase   *bool           `json:"isPurchase"   validate:"required"             yaml:"isPurchase"`
+}
+
+// HTTPLimitOrderDetails is the response to a limit order details request. It contains the order and its state history.
+type HTTPLimitOrderDetails struct {
+	Order   postgres.CryptoLimitOrder        `json:"order"`
+	History []postgres.CryptoLimitOrderEvent `json:"history"`
+}
+
+// HTTPLimitOrdersPaginated is the response to a paginated limit orders request. It returns a link to the next page of
+// information.
+type HTTPLimitOrdersPaginated struct {
+	Orders []postgres.CryptoLimitOrder `json:"orders"`
+	Links  HTTPLinks                   `json:"links"`
+}
+
+// HTTPTriggerOrderRequest is a request to place a stop-loss or take-profit order to sell an amount of a Cryptocurrency
+// position once its price in a Fiat currency crosses the trigger price. An amount of zero will sell the entire position
+// and a trailing distance will ratchet a stop-loss trigger price up behind rising prices.
+type HTTPTriggerOrderRequest struct {
+	FiatCurrency     string          `json:"fiatCurrency"     validate:"required"                             yaml:"fiatCurrency"`
+	Ticker           string          `json:"ticker"           validate:"required,min=1,max=6"                 yaml:"ticker"`
+	TriggerType      string          `json:"triggerType"      validate:"required,oneof=STOP_LOSS TAKE_PROFIT" yaml:"triggerType"`
+	TriggerPrice     decimal.Decimal `json:"triggerPrice"     validate:"required,gt=0"                        yaml:"triggerPrice"`
+	TrailingDistance decimal.Decimal `json:"trailingDistance"                                                 yaml:"trailingDistance"`
+	Amount           decimal.Decimal `json:"amount"                                                           yaml:"amount"`
+}
+
+// HTTPTriggerOrderDetails is the response to a trigger order details request. It contains the order and its state
+// history.
+type HTTPTriggerOrderDetails struct {
+	Order   postgres.CryptoTriggerOrder        `json:"order"`
+	History []postgres.CryptoTriggerOrderEvent `json:"history"`
+}
+
+// HTTPTriggerOrdersPaginated is the response to a paginated trigger orders request. It returns a link to the next page
+// of information.
+type HTTPTriggerOrdersPaginated struct {
+	Orders []postgres.CryptoTriggerOrder `json:"orders"`
+	Links  HTTPLinks                     `json:"links"`
+}
+
+// HTTPRecurringPurchaseRequest is a request to schedule recurring purchases of a Cryptocurrency with a fixed amount of a
+// Fiat currency. The first purchase is made at the supplied Unix timestamp, or immediately if none is supplied, and the
+// purchases repeat at the cadence until the optional end timestamp.
+type HTTPRecurringPurchaseRequest struct {
+	FiatCurrency string          `json:"fiatCurrency" validate:"required"                                      yaml:"fiatCurrency"`
+	Ticker       string          `json:"ticker"       validate:"required,min=1,max=6"                          yaml:"ticker"`
+	Amount       decimal.Decimal `json:"amount"       validate:"required,gt=0"                                 yaml:"amount"`
+	Cadence      string          `json:"cadence"      validate:"required,oneof=DAILY WEEKLY BIWEEKLY MONTHLY" yaml:"cadence"`
+	StartsAt     int64           `json:"startsAt"     validate:"gte=0"                                         yaml:"startsAt"`
+	EndsAt       int64           `json:"endsAt"       validate:"gte=0"                                         yaml:"endsAt"`
+}
+
+// HTTPRecurringPurchaseDetails is the response to a recurring purchase details request. It contains the plan and a page
+// of its runs, newest first, with a link to the next page of runs.
+type HTTPRecurringPurchaseDetails struct {
+	Plan  postgres.CryptoRecurringPurchase      `json:"plan"`
+	Runs  []postgres.CryptoRecurringPurchaseRun `json:"runs"`
+	Links HTTPLinks                             `json:"links"`
+}
+
+// HTTPRecurringPurchasesPaginated is the response to a paginated recurring purchases request. It returns a link to the
+// next page of information.
+type HTTPRecurringPurchasesPaginated struct {
+	Plans []postgres.CryptoRecurringPurchase `json:"plans"`
+	Links HTTPLinks                          `json:"links"`
+}
+
+// HTTPFiatBalanceHistory is the response to a Fiat balance history request. It contains the daily closing balances of
+// the account within the time range, oldest first.
+type HTTPFiatBalanceHistory struct {
+	Currency  string                         `json:"currency"`
+	From      int64                          `json:"from"`
+	To        int64                          `json:"to"`
+	Snapshots []postgres.FiatBalanceSnapshot `json:"snapshots"`
+}
+
+// HTTPCryptoBalanceHistory is the response to a Crypto balance history request. It contains the daily closing balances
+// of the account within the time range, oldest first.
+type HTTPCryptoBalanceHistory struct {
+	Ticker    string                           `json:"ticker"`
+	From      int64                            `json:"from"`
+	To        int64                            `json:"to"`
+	Snapshots []postgres.CryptoBalanceSnapshot `json:"snapshots"`
+}
+
+// HTTPAPIKeyRequest is a request to create an API key for programmatic access. The key is granted only the listed
+// scopes, may be restricted to a list of IP addresses or CIDR ranges, and expires at the optional Unix timestamp.
+type HTTPAPIKeyRequest struct {
+	Name       string   `json:"name"       validate:"required,max=64"                                  yaml:"name"`
+	Scopes     []string `json:"scopes"     validate:"required,min=1,max=3,unique,dive,oneof=read trade transfer" yaml:"scopes"`
+	AllowedIPs []string `json:"allowedIPs" validate:"max=16,dive,ip|cidr"                              yaml:"allowedIPs"`
+	ExpiresAt  int64    `json:"expiresAt"  validate:"gte=0"                                            yaml:"expiresAt"`
+}
+
+// HTTPAPIKeyResponse is the response to a successful API key creation request. The secret is only ever returned once and
+// cannot be retrieved again.
+type HTTPAPIKeyResponse struct {
+	Key    modelsPostgres.APIKeyInfo `json:"key"`
+	Secret string                    `json:"secret"`
+}
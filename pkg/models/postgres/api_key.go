@@ -0,0 +1,16 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// APIKeyInfo represents the details of a client's API key, excluding its secret.
+type APIKeyInfo struct {
+	KeyID      string             `json:"keyID"`
+	Name       string             `json:"name"`
+	Scopes     []string           `json:"scopes"`
+	AllowedIPs []string           `json:"allowedIPs"`
+	ExpiresAt  pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+	RevokedAt  pgtype.Timestamptz `json:"revokedAt"`
+}
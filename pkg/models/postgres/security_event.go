@@ -0,0 +1,14 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SecurityEvent represents a security or account event about a client along with the device it originated from.
+type SecurityEvent struct {
+	EventType  string             `json:"eventType"`
+	Outcome    string             `json:"outcome"`
+	IPAddress  string             `json:"ipAddress"`
+	UserAgent  string             `json:"userAgent"`
+	OccurredAt pgtype.Timestamptz `json:"occurredAt"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SessionInfo represents the details of an active session of a client along with the device it was last refreshed from.
+type SessionInfo struct {
+	SessionID     string             `json:"sessionID"`
+	Device        string             `json:"device"`
+	IPAddress     string             `json:"ipAddress"`
+	StartedAt     pgtype.Timestamptz `json:"startedAt"`
+	LastRefreshed pgtype.Timestamptz `json:"lastRefreshed"`
+	ExpiresAt     pgtype.Timestamptz `json:"expiresAt"`
+}
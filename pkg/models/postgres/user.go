@@ -7,8 +7,31 @@ import (
 // User represents a user's account and is a row in the user table.
 type User struct {
 	*UserAccount
-	ClientID  uuid.UUID `json:"clientId,omitempty"`
-	IsDeleted bool      `json:"isDeleted"`
+	ClientID      uuid.UUID `json:"clientId,omitempty"`
+	Role          string    `json:"role,omitempty"`
+	IsDeleted     bool      `json:"isDeleted"`
+	IsFrozen      bool      `json:"isFrozen"`
+	EmailVerified bool      `json:"emailVerified"`
+}
+
+// UserStatus is the role and account standing of a user used when authorizing requests.
+type UserStatus struct {
+	Role      string `json:"role"`
+	IsDeleted bool   `json:"isDeleted"`
+	IsFrozen  bool   `json:"isFrozen"`
+}
+
+// UserProfile is a user's account information, excluding the login credentials, as presented to administrators.
+type UserProfile struct {
+	Username      string    `json:"username"`
+	FirstName     string    `json:"firstName"`
+	LastName      string    `json:"lastName"`
+	Email         string    `json:"email"`
+	ClientID      uuid.UUID `json:"clientID"`
+	Role          string    `json:"role"`
+	IsDeleted     bool      `json:"isDeleted"`
+	IsFrozen      bool      `json:"isFrozen"`
+	EmailVerified bool      `json:"emailVerified"`
 }
 
 // UserAccount is the core user account information.
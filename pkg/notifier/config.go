@@ -0,0 +1,49 @@
+package notifier
+
+import (
+	"fmt"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/configloader"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// config is the configuration container for the notifier.
+type config struct {
+	General generalConfig `json:"general,omitempty" mapstructure:"general" yaml:"general,omitempty"`
+	File    fileConfig    `json:"file,omitempty"    mapstructure:"file"    yaml:"file,omitempty"`
+}
+
+// generalConfig contains the driver used to deliver notifications, the address they are sent from, and the public base
+// URL of the REST API that links in notifications are built on.
+//
+//nolint:lll
+type generalConfig struct {
+	Driver      string `json:"driver,omitempty"      mapstructure:"driver"      validate:"required,oneof=file" yaml:"driver,omitempty"`
+	Sender      string `json:"sender,omitempty"      mapstructure:"sender"      validate:"required,email"      yaml:"sender,omitempty"`
+	LinkBaseURL string `json:"linkBaseURL,omitempty" mapstructure:"linkBaseURL" validate:"required,url"        yaml:"linkBaseURL,omitempty"`
+}
+
+// fileConfig contains the directory that the file driver writes messages to.
+type fileConfig struct {
+	Directory string `json:"directory,omitempty" mapstructure:"directory" validate:"required" yaml:"directory,omitempty"`
+}
+
+// newConfig creates a blank configuration struct for the notifier.
+func newConfig() *config {
+	return &config{}
+}
+
+// Load will attempt to load configurations from a file on a file system.
+func (cfg *config) Load(fs afero.Fs) error {
+	if err := configloader.Load(
+		fs,
+		cfg,
+		constants.NotifierFileName(),
+		constants.NotifierPrefix(),
+		"yaml"); err != nil {
+		return fmt.Errorf("notifier config loading failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package notifier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/validator"
+)
+
+func TestNotifierConfigs_Load(t *testing.T) {
+	envGeneralKey := constants.NotifierPrefix() + "_GENERAL."
+	envFileKey := constants.NotifierPrefix() + "_FILE."
+
+	testCases := []struct {
+		name         string
+		input        string
+		expectErrCnt int
+		expectErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:         "empty - etc dir",
+			input:        notifierConfigTestData["empty"],
+			expectErrCnt: 4,
+			expectErr:    require.Error,
+		}, {
+			name:         "valid - etc dir",
+			input:        notifierConfigTestData["valid"],
+			expectErrCnt: 0,
+			expectErr:    require.NoError,
+		}, {
+			name:         "invalid driver - etc dir",
+			input:        notifierConfigTestData["invalid_driver"],
+			expectErrCnt: 1,
+			expectErr:    require.Error,
+		}, {
+			name:         "invalid sender - etc dir",
+			input:        notifierConfigTestData["invalid_sender"],
+			expectErrCnt: 1,
+			expectErr:    require.Error,
+		}, {
+			name:         "invalid link base url - etc dir",
+			input:        notifierConfigTestData["invalid_link_base_url"],
+			expectErrCnt: 1,
+			expectErr:    require.Error,
+		}, {
+			name:         "no directory - etc dir",
+			input:        notifierConfigTestData["no_directory"],
+			expectErrCnt: 1,
+			expectErr:    require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Configure mock filesystem.
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+			require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.NotifierFileName(),
+				[]byte(testCase.input), 0644), "Failed to write in memory file")
+
+			// Load from mock filesystem.
+			actual := &config{}
+			err := actual.Load(fs)
+			testCase.expectErr(t, err)
+
+			validationError := &validator.ValidationError{}
+			if errors.As(err, &validationError) {
+				require.Lenf(t, validationError.Errors, testCase.expectErrCnt,
+					"expected errors count is incorrect: %v", err)
+
+				return
+			}
+
+			// Test configuring of environment variable.
+			sender := "support@ftex.com"
+			linkBaseURL := "http://localhost:33723/api/rest/v1"
+			directory := "/tmp/ftex/mail"
+			t.Setenv(envGeneralKey+"SENDER", sender)
+			t.Setenv(envGeneralKey+"LINKBASEURL", linkBaseURL)
+			t.Setenv(envFileKey+"DIRECTORY", directory)
+
+			err = actual.Load(fs)
+			require.NoErrorf(t, actual.Load(fs), "failed to load configurations file: %v", err)
+
+			require.Equal(t, sender, actual.General.Sender, "failed to load sender.")
+			require.Equal(t, linkBaseURL, actual.General.LinkBaseURL, "failed to load link base URL.")
+			require.Equal(t, directory, actual.File.Directory, "failed to load directory.")
+		})
+	}
+}
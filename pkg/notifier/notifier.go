@@ -0,0 +1,112 @@
+package notifier
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Mock Notifier interface stub generation.
+//go:generate mockgen -destination=../mocks/mock_notifier.go -package=mocks github.com/surahman/FTeX/pkg/notifier Notifier
+
+// Notifier is the interface through which messages can be delivered to users. Created to support mock testing.
+type Notifier interface {
+	// Send will deliver a message to its recipient.
+	Send(message *Message) error
+
+	// Link will build a link to a path, relative to the public base URL of the REST API, with the query parameters to
+	// be included in a message.
+	Link(path string, query url.Values) string
+}
+
+// Message is a plaintext message addressed to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// ErrInvalidMessage is returned when a message has no recipient or its headers contain line breaks.
+var ErrInvalidMessage = errors.New("invalid notification message")
+
+// NewNotifier will create a new Notifier by loading its configuration and selecting the configured driver.
+func NewNotifier(fs *afero.Fs, logger *logger.Logger) (Notifier, error) {
+	if fs == nil || logger == nil {
+		return nil, errors.New("nil file system or logger supplied")
+	}
+
+	conf := newConfig()
+	if err := conf.Load(*fs); err != nil {
+		logger.Error("failed to load notifier configurations from disk", zap.Error(err))
+
+		return nil, err
+	}
+
+	switch conf.General.Driver {
+	case "file":
+		return &fileNotifier{conf: conf, fs: *fs, logger: logger}, nil
+	default:
+		return nil, fmt.Errorf("unsupported notifier driver %s", conf.General.Driver)
+	}
+}
+
+// Check to ensure the Notifier interface has been implemented.
+var _ Notifier = &fileNotifier{}
+
+// fileNotifier implements the Notifier interface by writing each message to its own file in the format an SMTP server
+// would receive it. It stands in for a mail server during development and testing.
+type fileNotifier struct {
+	conf   *config
+	fs     afero.Fs
+	logger *logger.Logger
+}
+
+// Send will write a message to a new file in the configured directory.
+func (n *fileNotifier) Send(message *Message) error {
+	if message == nil || message.To == "" || strings.ContainsAny(message.To+message.Subject, "\r\n") {
+		return ErrInvalidMessage
+	}
+
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "From: %s\r\n", n.conf.General.Sender)
+	fmt.Fprintf(&buffer, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&buffer, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buffer, "MIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&buffer, "%s\r\n", strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	if err := n.fs.MkdirAll(n.conf.File.Directory, 0700); err != nil {
+		n.logger.Error("failed to create notifier directory", zap.Error(err))
+
+		return fmt.Errorf("failed to create notifier directory: %w", err)
+	}
+
+	filename := filepath.Join(n.conf.File.Directory, fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), xid.New()))
+	if err := afero.WriteFile(n.fs, filename, buffer.Bytes(), 0600); err != nil {
+		n.logger.Error("failed to write notification", zap.String("filename", filename), zap.Error(err))
+
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+
+	return nil
+}
+
+// Link will build a link to a path, relative to the configured base URL, with the query parameters.
+func (n *fileNotifier) Link(path string, query url.Values) string {
+	link := strings.TrimSuffix(n.conf.General.LinkBaseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+	if len(query) > 0 {
+		link += "?" + query.Encode()
+	}
+
+	return link
+}
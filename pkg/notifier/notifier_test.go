@@ -0,0 +1,133 @@
+package notifier
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewNotifier(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expectErr require.ErrorAssertionFunc
+		expectNil require.ValueAssertionFunc
+	}{
+		{
+			name:      "valid",
+			input:     notifierConfigTestData["valid"],
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		}, {
+			name:      "invalid driver",
+			input:     notifierConfigTestData["invalid_driver"],
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+			require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.NotifierFileName(),
+				[]byte(testCase.input), 0644), "Failed to write in memory file")
+
+			notifier, err := NewNotifier(&fs, zapLogger)
+			testCase.expectErr(t, err, "error expectation failed.")
+			testCase.expectNil(t, notifier, "notifier expectation failed.")
+		})
+	}
+
+	t.Run("nil file system", func(t *testing.T) {
+		notifier, err := NewNotifier(nil, zapLogger)
+		require.Error(t, err, "nil file system accepted.")
+		require.Nil(t, notifier, "notifier returned for nil file system.")
+	})
+}
+
+func TestFileNotifier_Send(t *testing.T) {
+	t.Parallel()
+
+	conf := config{}
+	require.NoError(t, yaml.Unmarshal([]byte(notifierConfigTestData["valid"]), &conf), "failed to parse configs.")
+
+	testCases := []struct {
+		name      string
+		message   *Message
+		expectErr require.ErrorAssertionFunc
+		expectCnt int
+	}{
+		{
+			name:      "nil message",
+			message:   nil,
+			expectErr: require.Error,
+			expectCnt: 0,
+		}, {
+			name:      "no recipient",
+			message:   &Message{Subject: "subject", Body: "body"},
+			expectErr: require.Error,
+			expectCnt: 0,
+		}, {
+			name:      "header injection",
+			message:   &Message{To: "user@ftex.com", Subject: "subject\r\nBcc: attacker@ftex.com", Body: "body"},
+			expectErr: require.Error,
+			expectCnt: 0,
+		}, {
+			name:      "valid",
+			message:   &Message{To: "user@ftex.com", Subject: "subject", Body: "line one\nline two"},
+			expectErr: require.NoError,
+			expectCnt: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := afero.NewMemMapFs()
+			notifier := &fileNotifier{conf: &conf, fs: fs, logger: zapLogger}
+
+			test.expectErr(t, notifier.Send(test.message), "error expectation failed.")
+
+			files, _ := afero.ReadDir(fs, conf.File.Directory)
+			require.Len(t, files, test.expectCnt, "written message count mismatch.")
+
+			if test.expectCnt == 0 {
+				return
+			}
+
+			contents, err := afero.ReadFile(fs, conf.File.Directory+"/"+files[0].Name())
+			require.NoError(t, err, "failed to read written message.")
+			require.True(t, strings.HasSuffix(files[0].Name(), ".eml"), "message file extension mismatch.")
+			require.Contains(t, string(contents), "From: "+conf.General.Sender+"\r\n", "sender mismatch.")
+			require.Contains(t, string(contents), "To: "+test.message.To+"\r\n", "recipient mismatch.")
+			require.Contains(t, string(contents), "Subject: "+test.message.Subject+"\r\n", "subject mismatch.")
+			require.Contains(t, string(contents), "\r\n\r\nline one\r\nline two\r\n", "body mismatch.")
+		})
+	}
+}
+
+func TestFileNotifier_Link(t *testing.T) {
+	t.Parallel()
+
+	conf := config{}
+	require.NoError(t, yaml.Unmarshal([]byte(notifierConfigTestData["valid"]), &conf), "failed to parse configs.")
+
+	notifier := &fileNotifier{conf: &conf, fs: afero.NewMemMapFs(), logger: zapLogger}
+
+	require.Equal(t, "https://ftex.com/api/rest/v1/user/email/verify", notifier.Link("/user/email/verify", nil),
+		"link without query mismatch.")
+	require.Equal(t, "https://ftex.com/api/rest/v1/user/email/verify?token=a%2Bb%3Dc",
+		notifier.Link("user/email/verify", url.Values{"token": {"a+b=c"}}), "link with query mismatch.")
+
+	conf.General.LinkBaseURL += "/"
+	require.Equal(t, "https://ftex.com/api/rest/v1/user", notifier.Link("/user", nil), "trailing slash not trimmed.")
+}
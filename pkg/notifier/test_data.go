@@ -0,0 +1,48 @@
+package notifier
+
+// configTestData will return a map of test data containing valid and invalid notifier configs.
+func configTestData() map[string]string {
+	return map[string]string{
+		"empty": ``,
+
+		"valid": `
+general:
+  driver: file
+  sender: no-reply@ftex.com
+  linkBaseURL: https://ftex.com/api/rest/v1
+file:
+  directory: /var/spool/ftex/mail`,
+
+		"invalid_driver": `
+general:
+  driver: carrier-pigeon
+  sender: no-reply@ftex.com
+  linkBaseURL: https://ftex.com/api/rest/v1
+file:
+  directory: /var/spool/ftex/mail`,
+
+		"invalid_sender": `
+general:
+  driver: file
+  sender: no-reply
+  linkBaseURL: https://ftex.com/api/rest/v1
+file:
+  directory: /var/spool/ftex/mail`,
+
+		"invalid_link_base_url": `
+general:
+  driver: file
+  sender: no-reply@ftex.com
+  linkBaseURL: ftex
+file:
+  directory: /var/spool/ftex/mail`,
+
+		"no_directory": `
+general:
+  driver: file
+  sender: no-reply@ftex.com
+  linkBaseURL: https://ftex.com/api/rest/v1
+file:
+  directory:`,
+	}
+}
@@ -0,0 +1,179 @@
+package partition
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// Archiver moves closed monthly journal partitions out of the journal data tablespaces. Partitions can be moved to a
+// cold tablespace, where they remain queryable, or exported to compressed files and dropped.
+type Archiver struct {
+	db     postgres.Postgres
+	fs     afero.Fs
+	logger *logger.Logger
+}
+
+// NewArchiver will create a new journal partition archiver.
+func NewArchiver(db postgres.Postgres, fs afero.Fs, logger *logger.Logger) (*Archiver, error) {
+	if db == nil || fs == nil || logger == nil {
+		return nil, errors.New("nil database, file system, or logger supplied")
+	}
+
+	return &Archiver{db: db, fs: fs, logger: logger}, nil
+}
+
+// closed will retrieve the monthly journal partitions that ended before the retained months preceding the current
+// month, oldest first.
+func (a *Archiver) closed(now time.Time, retainMonths int) ([]postgres.JournalPartition, error) {
+	if retainMonths < 0 {
+		return nil, errors.New("retained months cannot be negative")
+	}
+
+	partitions, err := a.db.JournalPartitions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve journal partitions: %w", err)
+	}
+
+	year, month, _ := now.UTC().Date()
+	cutoff := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).AddDate(0, -retainMonths, 0)
+
+	closed := make([]postgres.JournalPartition, 0, len(partitions))
+
+	for _, partition := range partitions {
+		if !partition.EndsAt.After(cutoff) {
+			closed = append(closed, partition)
+		}
+	}
+
+	return closed, nil
+}
+
+// MoveToTablespace will move the closed monthly journal partitions that are not retained to a cold tablespace. The
+// entries remain in the journals and can still be queried. Partitions already in the tablespace are skipped.
+func (a *Archiver) MoveToTablespace(now time.Time, retainMonths int, tablespace string) (int, error) {
+	if len(tablespace) == 0 {
+		return 0, errors.New("no tablespace supplied")
+	}
+
+	partitions, err := a.closed(now, retainMonths)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+
+	for _, partition := range partitions {
+		if partition.Tablespace == tablespace {
+			continue
+		}
+
+		if err = a.db.JournalPartitionArchive(partition.Name, tablespace); err != nil {
+			return count, fmt.Errorf("failed to move journal partition %s: %w", partition.Name, err)
+		}
+
+		a.logger.Info("moved journal partition to tablespace",
+			zap.String("partition", partition.Name), zap.String("tablespace", tablespace))
+
+		count++
+	}
+
+	return count, nil
+}
+
+// Export will write the closed monthly journal partitions that are not retained to gzip compressed CSV files in a
+// directory and then drop them. Only partitions that end at or before the most recent daily closing balance snapshot
+// are exported, so that the balances at any point in time after them can still be computed.
+func (a *Archiver) Export(now time.Time, retainMonths int, directory string) (int, error) {
+	if len(directory) == 0 {
+		return 0, errors.New("no export directory supplied")
+	}
+
+	partitions, err := a.closed(now, retainMonths)
+	if err != nil {
+		return 0, err
+	}
+
+	latest, err := a.db.BalanceSnapshotLatest()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve latest balance snapshot: %w", err)
+	}
+
+	if err = a.fs.MkdirAll(directory, 0750); err != nil { //nolint:gomnd
+		return 0, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	var count int
+
+	for _, partition := range partitions {
+		if !latest.Valid || partition.EndsAt.After(latest.Time) {
+			a.logger.Warn("journal partition has not been covered by a balance snapshot and will not be exported",
+				zap.String("partition", partition.Name))
+
+			break
+		}
+
+		var rows int64
+		if rows, err = a.export(partition.Name, directory); err != nil {
+			return count, err
+		}
+
+		if err = a.db.JournalPartitionDrop(partition.Name); err != nil {
+			return count, fmt.Errorf("failed to drop exported journal partition %s: %w", partition.Name, err)
+		}
+
+		a.logger.Info("exported journal partition", zap.String("partition", partition.Name), zap.Int64("entries", rows))
+
+		count++
+	}
+
+	return count, nil
+}
+
+// export will write a monthly journal partition to a gzip compressed CSV file in a directory. The file is flushed to
+// storage and closed before returning, so that the partition is only dropped once its export is durable.
+func (a *Archiver) export(partition, directory string) (int64, error) {
+	file, err := a.fs.Create(filepath.Join(directory, partition+".csv.gz"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create export file for journal partition %s: %w", partition, err)
+	}
+
+	rows, err := a.write(partition, file)
+
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close export file for journal partition %s: %w", partition, closeErr)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return rows, nil
+}
+
+// write will write a monthly journal partition to a file as gzip compressed CSV and flush the file to storage.
+func (a *Archiver) write(partition string, file afero.File) (int64, error) {
+	writer := gzip.NewWriter(file)
+
+	rows, err := a.db.JournalPartitionExport(partition, writer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to export journal partition %s: %w", partition, err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return 0, fmt.Errorf("failed to compress journal partition %s: %w", partition, err)
+	}
+
+	if err = file.Sync(); err != nil {
+		return 0, fmt.Errorf("failed to write export file for journal partition %s: %w", partition, err)
+	}
+
+	return rows, nil
+}
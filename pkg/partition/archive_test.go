@@ -0,0 +1,436 @@
+package partition
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// closeFailureFs is a file system whose created files cannot be closed.
+type closeFailureFs struct {
+	afero.Fs
+}
+
+// Create will create a file that cannot be closed.
+func (fs closeFailureFs) Create(name string) (afero.File, error) {
+	file, err := fs.Fs.Create(name)
+
+	return closeFailureFile{File: file}, err
+}
+
+// closeFailureFile is a file that reports a failure when it is closed.
+type closeFailureFile struct {
+	afero.File
+}
+
+// Close will close the file and report a failure.
+func (file closeFailureFile) Close() error {
+	_ = file.File.Close()
+
+	return errors.New("close failure")
+}
+
+// testPartitions will generate the monthly Fiat journal partitions from January through June 2023.
+func testPartitions(tablespace string) []postgres.JournalPartition {
+	partitions := make([]postgres.JournalPartition, 0, 6)
+
+	for month := time.January; month <= time.June; month++ {
+		startsAt := time.Date(2023, month, 1, 0, 0, 0, 0, time.UTC)
+		partitions = append(partitions, postgres.JournalPartition{
+			Name:       fmt.Sprintf("fiat_journal_y2023m%02d", month),
+			Journal:    "fiat_journal",
+			Tablespace: tablespace,
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.AddDate(0, 1, 0),
+		})
+	}
+
+	return partitions
+}
+
+func TestNewArchiver(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+	archiver, err := NewArchiver(nil, afero.NewMemMapFs(), zapLogger)
+	require.Error(t, err, "created archiver with nil database.")
+	require.Nil(t, archiver, "returned archiver with nil database.")
+
+	archiver, err = NewArchiver(mockPostgres, afero.NewMemMapFs(), zapLogger)
+	require.NoError(t, err, "failed to create archiver.")
+	require.NotNil(t, archiver, "failed to return archiver.")
+}
+
+func TestArchiver_Closed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		retainMonths  int
+		partitionsErr error
+		expectErr     require.ErrorAssertionFunc
+		expectNames   []string
+	}{
+		{
+			name:          "negative retained months",
+			retainMonths:  -1,
+			partitionsErr: nil,
+			expectErr:     require.Error,
+			expectNames:   nil,
+		}, {
+			name:          "partitions failure",
+			retainMonths:  2,
+			partitionsErr: postgres.ErrNotFound,
+			expectErr:     require.Error,
+			expectNames:   nil,
+		}, {
+			name:          "retain none",
+			retainMonths:  0,
+			partitionsErr: nil,
+			expectErr:     require.NoError,
+			expectNames: []string{"fiat_journal_y2023m01", "fiat_journal_y2023m02", "fiat_journal_y2023m03",
+				"fiat_journal_y2023m04", "fiat_journal_y2023m05"},
+		}, {
+			name:          "retain two",
+			retainMonths:  2,
+			partitionsErr: nil,
+			expectErr:     require.NoError,
+			expectNames:   []string{"fiat_journal_y2023m01", "fiat_journal_y2023m02", "fiat_journal_y2023m03"},
+		}, {
+			name:          "retain all",
+			retainMonths:  12,
+			partitionsErr: nil,
+			expectErr:     require.NoError,
+			expectNames:   []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			partitionsTimes := 1
+			if test.retainMonths < 0 {
+				partitionsTimes = 0
+			}
+
+			mockPostgres.EXPECT().
+				JournalPartitions().
+				Return(testPartitions(""), test.partitionsErr).
+				Times(partitionsTimes)
+
+			archiver := &Archiver{db: mockPostgres, fs: afero.NewMemMapFs(), logger: zapLogger}
+			partitions, err := archiver.closed(now, test.retainMonths)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				return
+			}
+
+			names := make([]string, 0, len(partitions))
+			for _, partition := range partitions {
+				names = append(names, partition.Name)
+			}
+
+			require.Equal(t, test.expectNames, names, "closed partitions mismatched.")
+		})
+	}
+}
+
+func TestArchiver_MoveToTablespace(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		tablespace   string
+		current      string
+		archiveErr   error
+		archiveTimes int
+		expectErr    require.ErrorAssertionFunc
+		expectCount  int
+	}{
+		{
+			name:         "no tablespace",
+			tablespace:   "",
+			current:      "",
+			archiveErr:   nil,
+			archiveTimes: 0,
+			expectErr:    require.Error,
+			expectCount:  0,
+		}, {
+			name:         "archive failure",
+			tablespace:   "journal_archive",
+			current:      "",
+			archiveErr:   postgres.ErrJournalPartition,
+			archiveTimes: 1,
+			expectErr:    require.Error,
+			expectCount:  0,
+		}, {
+			name:         "already archived",
+			tablespace:   "journal_archive",
+			current:      "journal_archive",
+			archiveErr:   nil,
+			archiveTimes: 0,
+			expectErr:    require.NoError,
+			expectCount:  0,
+		}, {
+			name:         "archived",
+			tablespace:   "journal_archive",
+			current:      "fiat_journal_data",
+			archiveErr:   nil,
+			archiveTimes: 3,
+			expectErr:    require.NoError,
+			expectCount:  3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			partitionsTimes := 1
+			if len(test.tablespace) == 0 {
+				partitionsTimes = 0
+			}
+
+			mockPostgres.EXPECT().
+				JournalPartitions().
+				Return(testPartitions(test.current), nil).
+				Times(partitionsTimes)
+
+			mockPostgres.EXPECT().
+				JournalPartitionArchive(gomock.Any(), test.tablespace).
+				Return(test.archiveErr).
+				Times(test.archiveTimes)
+
+			archiver := &Archiver{db: mockPostgres, fs: afero.NewMemMapFs(), logger: zapLogger}
+			count, err := archiver.MoveToTablespace(now, 2, test.tablespace)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectCount, count, "archived partition count mismatched.")
+		})
+	}
+}
+
+func TestArchiver_Export(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 15, 12, 0, 0, 0, time.UTC)
+	exported := []string{"fiat_journal_y2023m01", "fiat_journal_y2023m02", "fiat_journal_y2023m03"}
+
+	testCases := []struct {
+		name            string
+		directory       string
+		partitionsTimes int
+		latest          pgtype.Timestamptz
+		latestErr       error
+		latestTimes     int
+		exportErr       error
+		exportTimes     int
+		dropErr         error
+		dropTimes       int
+		closeFailure    bool
+		expectErr       require.ErrorAssertionFunc
+		expectCount     int
+	}{
+		{
+			name:            "no directory",
+			directory:       "",
+			partitionsTimes: 0,
+			latest:          pgtype.Timestamptz{},
+			latestErr:       nil,
+			latestTimes:     0,
+			exportErr:       nil,
+			exportTimes:     0,
+			dropErr:         nil,
+			dropTimes:       0,
+			closeFailure:    false,
+			expectErr:       require.Error,
+			expectCount:     0,
+		}, {
+			name:            "latest snapshot failure",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{},
+			latestErr:       postgres.ErrNotFound,
+			latestTimes:     1,
+			exportErr:       nil,
+			exportTimes:     0,
+			dropErr:         nil,
+			dropTimes:       0,
+			closeFailure:    false,
+			expectErr:       require.Error,
+			expectCount:     0,
+		}, {
+			name:            "no snapshots",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{},
+			latestErr:       nil,
+			latestTimes:     1,
+			exportErr:       nil,
+			exportTimes:     0,
+			dropErr:         nil,
+			dropTimes:       0,
+			closeFailure:    false,
+			expectErr:       require.NoError,
+			expectCount:     0,
+		}, {
+			name:            "partially covered by snapshots",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{Time: time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+			latestErr:       nil,
+			latestTimes:     1,
+			exportErr:       nil,
+			exportTimes:     2,
+			dropErr:         nil,
+			dropTimes:       2,
+			closeFailure:    false,
+			expectErr:       require.NoError,
+			expectCount:     2,
+		}, {
+			name:            "export failure",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{Time: now, Valid: true},
+			latestErr:       nil,
+			latestTimes:     1,
+			exportErr:       postgres.ErrJournalPartition,
+			exportTimes:     1,
+			dropErr:         nil,
+			dropTimes:       0,
+			closeFailure:    false,
+			expectErr:       require.Error,
+			expectCount:     0,
+		}, {
+			name:            "close failure",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{Time: now, Valid: true},
+			latestErr:       nil,
+			latestTimes:     1,
+			exportErr:       nil,
+			exportTimes:     1,
+			dropErr:         nil,
+			dropTimes:       0,
+			closeFailure:    true,
+			expectErr:       require.Error,
+			expectCount:     0,
+		}, {
+			name:            "drop failure",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{Time: now, Valid: true},
+			latestErr:       nil,
+			latestTimes:     1,
+			exportErr:       nil,
+			exportTimes:     1,
+			dropErr:         errors.New("drop failure"),
+			dropTimes:       1,
+			closeFailure:    false,
+			expectErr:       require.Error,
+			expectCount:     0,
+		}, {
+			name:            "exported",
+			directory:       "/archive",
+			partitionsTimes: 1,
+			latest:          pgtype.Timestamptz{Time: now, Valid: true},
+			latestErr:       nil,
+			latestTimes:     1,
+			exportErr:       nil,
+			exportTimes:     3,
+			dropErr:         nil,
+			dropTimes:       3,
+			closeFailure:    false,
+			expectErr:       require.NoError,
+			expectCount:     3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			var fs afero.Fs = afero.NewMemMapFs()
+			if test.closeFailure {
+				fs = closeFailureFs{Fs: fs}
+			}
+
+			mockPostgres.EXPECT().
+				JournalPartitions().
+				Return(testPartitions(""), nil).
+				Times(test.partitionsTimes)
+
+			mockPostgres.EXPECT().
+				BalanceSnapshotLatest().
+				Return(test.latest, test.latestErr).
+				Times(test.latestTimes)
+
+			mockPostgres.EXPECT().
+				JournalPartitionExport(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(partition string, writer io.Writer) (int64, error) {
+					_, err := writer.Write([]byte(partition))
+					require.NoError(t, err, "failed to write export.")
+
+					return 1, test.exportErr
+				}).
+				Times(test.exportTimes)
+
+			mockPostgres.EXPECT().
+				JournalPartitionDrop(gomock.Any()).
+				Return(test.dropErr).
+				Times(test.dropTimes)
+
+			archiver := &Archiver{db: mockPostgres, fs: fs, logger: zapLogger}
+			count, err := archiver.Export(now, 2, test.directory)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectCount, count, "exported partition count mismatched.")
+
+			// Verify the contents of the exported files.
+			for _, name := range exported[:test.dropTimes] {
+				file, err := fs.Open("/archive/" + name + ".csv.gz")
+				require.NoError(t, err, "failed to open export file.")
+
+				reader, err := gzip.NewReader(file)
+				require.NoError(t, err, "failed to open compressed export.")
+
+				contents, err := io.ReadAll(reader)
+				require.NoError(t, err, "failed to read compressed export.")
+				require.Equal(t, name, string(contents), "export contents mismatched.")
+			}
+		})
+	}
+}
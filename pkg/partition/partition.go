@@ -0,0 +1,83 @@
+package partition
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// Partitioner is the background worker that creates the monthly partitions of the Fiat and Crypto journals ahead of
+// the months they are needed for.
+type Partitioner struct {
+	db          postgres.Postgres
+	logger      *logger.Logger
+	wg          *sync.WaitGroup
+	interval    time.Duration
+	monthsAhead int
+}
+
+// NewPartitioner will create a new journal partitioner in a non-running state.
+func NewPartitioner(db postgres.Postgres, logger *logger.Logger, wg *sync.WaitGroup) (*Partitioner, error) {
+	if db == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, logger, or wait group supplied")
+	}
+
+	return &Partitioner{
+		db:          db,
+		logger:      logger,
+		wg:          wg,
+		interval:    constants.PartitionPollInterval(),
+		monthsAhead: constants.PartitionMonthsAhead(),
+	}, nil
+}
+
+// Run will create the monthly journal partitions that are due on start and then periodically until an interrupt signal
+// is received.
+func (p *Partitioner) Run() {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	// Wait for interrupt signal to gracefully shut down the partitioner.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	p.create(time.Now())
+
+	for {
+		select {
+		case <-quit:
+			p.logger.Info("Journal partitioner exited")
+
+			return
+		case <-ticker.C:
+			p.create(time.Now())
+		}
+	}
+}
+
+// create will create the monthly journal partitions for the current month and the months ahead of it. Entries posted
+// outside the existing partitions are held in the default partitions and moved into their monthly partitions when they
+// are created. Failures are retried on the next run.
+func (p *Partitioner) create(now time.Time) {
+	count, err := p.db.JournalPartitionsCreate(now, p.monthsAhead)
+	if err != nil {
+		p.logger.Warn("failed to create journal partitions", zap.Int64("created", count), zap.Error(err))
+
+		return
+	}
+
+	if count > 0 {
+		p.logger.Info("created journal partitions", zap.Int64("created", count))
+	}
+}
@@ -0,0 +1,78 @@
+package partition
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestNewPartitioner(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+	partitioner, err := NewPartitioner(nil, zapLogger, &sync.WaitGroup{})
+	require.Error(t, err, "created partitioner with nil database.")
+	require.Nil(t, partitioner, "returned partitioner with nil database.")
+
+	partitioner, err = NewPartitioner(mockPostgres, zapLogger, &sync.WaitGroup{})
+	require.NoError(t, err, "failed to create partitioner.")
+	require.NotNil(t, partitioner, "failed to return partitioner.")
+}
+
+func TestPartitioner_Create(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 5, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		count int64
+		err   error
+	}{
+		{
+			name:  "created",
+			count: 2,
+			err:   nil,
+		}, {
+			name:  "up to date",
+			count: 0,
+			err:   nil,
+		}, {
+			name:  "failure",
+			count: 1,
+			err:   postgres.ErrJournalPartition,
+		}, {
+			name:  "unknown failure",
+			count: 0,
+			err:   errors.New("unknown failure"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().
+				JournalPartitionsCreate(now, 3).
+				Return(test.count, test.err).
+				Times(1)
+
+			partitioner := &Partitioner{db: mockPostgres, logger: zapLogger, monthsAhead: 3}
+			partitioner.create(now)
+		})
+	}
+}
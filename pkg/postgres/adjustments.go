@@ -0,0 +1,321 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// FiatAdjustmentCreate controls the transaction block that the request for a manual adjustment of a Fiat account
+// executes in. The adjustment is recorded as pending and no entries are posted until it is approved.
+func (p *postgresImpl) FiatAdjustmentCreate(adjustment *FiatAdjustment) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("adjustment request transaction block setup failed", zap.Error(err))
+
+		return ErrAdjustment
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback adjustment request transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to adjustment request core logic.
+	if err = fiatAdjustmentRequest(ctx, p.logger, p.queries.WithTx(tx), adjustment); err != nil {
+		p.logger.Warn("failed to request adjustment", zap.Error(err))
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return ErrNotFound
+		case errors.Is(err, ErrAccountStatus):
+			return ErrAccountStatus
+		default:
+			return ErrAdjustment
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit adjustment request", zap.Error(err))
+
+		return ErrAdjustment
+	}
+
+	return nil
+}
+
+// fiatAdjustmentRequest will execute the logic to record a pending manual adjustment of a Fiat account.
+/*
+   [1] Acquire a row lock on the Fiat account and verify that it exists and is not closed.
+   [2] Record the adjustment as pending a decision by another administrator and populate it with the stored record.
+*/
+func fiatAdjustmentRequest(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	adjustment *FiatAdjustment) error {
+	var (
+		err     error
+		lockRow fiatRowLockAccountRow
+	)
+
+	if lockRow, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: adjustment.ClientID,
+		Currency: adjustment.Currency,
+	}); err != nil {
+		return fmt.Errorf("failed to get row lock on Fiat account %w", err)
+	}
+
+	if err = reversalAccountCheck(lockRow.Status); err != nil {
+		return fmt.Errorf("Fiat account cannot be adjusted %w", err)
+	}
+
+	if *adjustment, err = queryTx.fiatAdjustmentCreate(ctx, &fiatAdjustmentCreateParams{
+		AdjustmentID:  adjustment.AdjustmentID,
+		ClientID:      adjustment.ClientID,
+		Currency:      adjustment.Currency,
+		Amount:        adjustment.Amount,
+		ReasonCode:    adjustment.ReasonCode,
+		Justification: adjustment.Justification,
+		MakerID:       adjustment.MakerID,
+	}); err != nil {
+		msg := "failed to create adjustment"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return nil
+}
+
+// FiatAdjustmentApprove will approve a pending manual adjustment of a Fiat account and post its journal entries
+// against the Fiat operations account.
+func (p *postgresImpl) FiatAdjustmentApprove(adjustmentID string, checkerID uuid.UUID, note string) (
+	*FiatAdjustment, error) {
+	return p.fiatAdjustmentDecision(&fiatAdjustmentDecideParams{
+		AdjustmentID: adjustmentID,
+		Status:       AdjustmentStatusAPPROVED,
+		CheckerID:    pgtype.UUID{Bytes: checkerID, Valid: true},
+		DecisionNote: note,
+	})
+}
+
+// FiatAdjustmentReject will reject a pending manual adjustment of a Fiat account without posting any journal entries.
+func (p *postgresImpl) FiatAdjustmentReject(adjustmentID string, checkerID uuid.UUID, note string) (
+	*FiatAdjustment, error) {
+	return p.fiatAdjustmentDecision(&fiatAdjustmentDecideParams{
+		AdjustmentID: adjustmentID,
+		Status:       AdjustmentStatusREJECTED,
+		CheckerID:    pgtype.UUID{Bytes: checkerID, Valid: true},
+		DecisionNote: note,
+	})
+}
+
+// fiatAdjustmentDecision controls the transaction block that the decision on a pending manual adjustment of a Fiat
+// account executes in.
+func (p *postgresImpl) fiatAdjustmentDecision(params *fiatAdjustmentDecideParams) (*FiatAdjustment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err        error
+		tx         pgx.Tx
+		adjustment FiatAdjustment
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("adjustment decision transaction block setup failed", zap.Error(err))
+
+		return nil, ErrAdjustment
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback adjustment decision transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to adjustment decision core logic.
+	if adjustment, err = fiatAdjustmentResolve(ctx, p.logger, p.queries.WithTx(tx), params); err != nil {
+		p.logger.Warn("failed to decide adjustment", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, ErrAdjustmentDecided):
+			return nil, ErrAdjustmentDecided
+		case errors.Is(err, ErrAdjustmentMaker):
+			return nil, ErrAdjustmentMaker
+		case errors.Is(err, ErrAccountStatus):
+			return nil, ErrAccountStatus
+		case errors.Is(err, ErrInsufficientFunds):
+			return nil, ErrInsufficientFunds
+		default:
+			return nil, ErrAdjustment
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit adjustment decision", zap.Error(err))
+
+		return nil, ErrAdjustment
+	}
+
+	return &adjustment, nil
+}
+
+// fiatAdjustmentResolve will execute the logic to approve or reject a pending manual adjustment of a Fiat account.
+// Adjustments are corrections made by administrators and, like reversals, are permitted on frozen accounts and for
+// suspended clients, but not on closed accounts.
+/*
+   [1] Acquire a row lock on the adjustment without holding a lock on the foreign keys.
+   [2] Verify that the adjustment is still pending and that it is not being decided by the administrator who requested
+       it.
+   [3] Rejections are recorded without any further checks.
+   [4] Approvals acquire a row lock on the Fiat account, verify that it is not closed, and check that the available
+       balance is sufficient for debits. Adjustments will not overdraw an account.
+   [5] Post the journal entries between the Fiat account and the Fiat operations account and update the balance.
+   [6] Record the decision along with the transaction ID of the entries.
+*/
+func fiatAdjustmentResolve(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	params *fiatAdjustmentDecideParams) (FiatAdjustment, error) {
+	var (
+		err        error
+		adjustment FiatAdjustment
+		lockRow    fiatRowLockAccountRow
+		journalRow fiatExternalTransferJournalEntryRow
+	)
+
+	if adjustment, err = queryTx.fiatAdjustmentRowLock(ctx, params.AdjustmentID); err != nil {
+		msg := "failed to get row lock on adjustment"
+		logger.Warn(msg, zap.Error(err))
+
+		return FiatAdjustment{}, fmt.Errorf(constants.ErrorFormatMessage(), msg, ErrNotFound)
+	}
+
+	if adjustment.Status != AdjustmentStatusPENDING {
+		return FiatAdjustment{}, fmt.Errorf("adjustment is %s %w", adjustment.Status, ErrAdjustmentDecided)
+	}
+
+	if adjustment.MakerID == params.CheckerID.Bytes {
+		return FiatAdjustment{}, fmt.Errorf("adjustment requested by %s %w", adjustment.MakerID, ErrAdjustmentMaker)
+	}
+
+	if params.Status == AdjustmentStatusAPPROVED {
+		if lockRow, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+			ClientID: adjustment.ClientID,
+			Currency: adjustment.Currency,
+		}); err != nil {
+			return FiatAdjustment{}, fmt.Errorf("failed to get row lock on Fiat account %w", err)
+		}
+
+		if err = reversalAccountCheck(lockRow.Status); err != nil {
+			return FiatAdjustment{}, fmt.Errorf("Fiat account cannot be adjusted %w", err)
+		}
+
+		if adjustment.Amount.IsNegative() {
+			if err = fiatAvailableBalanceCheck(ctx, queryTx, &FiatTransactionDetails{
+				ClientID: adjustment.ClientID,
+				Currency: adjustment.Currency,
+				Amount:   adjustment.Amount.Neg(),
+			}, lockRow.Balance); err != nil {
+				return FiatAdjustment{}, fmt.Errorf("insufficient balance in Fiat account %w", err)
+			}
+		}
+
+		// Make General Journal ledger entries.
+		if journalRow, err = queryTx.fiatExternalTransferJournalEntry(ctx, &fiatExternalTransferJournalEntryParams{
+			ClientID: adjustment.ClientID,
+			Currency: adjustment.Currency,
+			Amount:   adjustment.Amount,
+		}); err != nil {
+			return FiatAdjustment{}, fmt.Errorf("failed to post Fiat account Journal entries %w", err)
+		}
+
+		if _, err = queryTx.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+			ClientID: adjustment.ClientID,
+			Currency: adjustment.Currency,
+			Amount:   adjustment.Amount,
+			LastTxTs: journalRow.TransactedAt,
+		}); err != nil {
+			return FiatAdjustment{}, fmt.Errorf("failed to update Fiat account balance %w", err)
+		}
+
+		params.TxID = pgtype.UUID{Bytes: journalRow.TxID, Valid: true}
+	}
+
+	if adjustment, err = queryTx.fiatAdjustmentDecide(ctx, params); err != nil {
+		msg := "failed to record adjustment decision"
+		logger.Warn(msg, zap.Error(err))
+
+		return FiatAdjustment{}, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return adjustment, nil
+}
+
+// FiatAdjustmentGet is the interface through which external methods can retrieve a manual adjustment of a Fiat account.
+func (p *postgresImpl) FiatAdjustmentGet(adjustmentID string) (FiatAdjustment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	adjustment, err := p.Query.fiatAdjustmentGet(ctx, adjustmentID)
+	if err != nil {
+		return FiatAdjustment{}, ErrNotFound
+	}
+
+	return adjustment, nil
+}
+
+// FiatAdjustmentsPaginated is the interface through which external methods can retrieve a page of manual adjustments,
+// newest first, starting from an adjustment id.
+func (p *postgresImpl) FiatAdjustmentsPaginated(startID string, status string, pageSize int32) (
+	[]FiatAdjustment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	adjustments, err := p.Query.fiatAdjustmentGetPaginated(ctx, &fiatAdjustmentGetPaginatedParams{
+		Limit:   pageSize,
+		StartID: startID,
+		Status:  status,
+	})
+	if err != nil {
+		return []FiatAdjustment{}, ErrNotFound
+	}
+
+	return adjustments, nil
+}
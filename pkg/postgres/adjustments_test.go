@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustments_FiatAdjustments(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+	makerID, checkerID := clientIDs[0], clientIDs[1]
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(100)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	newAdjustment := func(amount float64) *FiatAdjustment {
+		return &FiatAdjustment{
+			AdjustmentID:  xid.New().String(),
+			ClientID:      clientID1,
+			Currency:      "USD",
+			Amount:        decimal.NewFromFloat(amount),
+			ReasonCode:    AdjustmentReasonGOODWILL,
+			Justification: "service outage credit",
+			MakerID:       makerID,
+		}
+	}
+
+	// Adjustments to unknown accounts cannot be requested.
+	unknown := newAdjustment(10)
+	unknown.Currency = "JPY"
+	require.ErrorIs(t, connection.FiatAdjustmentCreate(unknown), ErrNotFound, "requested adjustment of unknown account.")
+
+	// Request a credit and verify nothing is posted until it is approved.
+	credit := newAdjustment(25.50)
+	require.NoError(t, connection.FiatAdjustmentCreate(credit), "failed to request credit adjustment.")
+	require.True(t, credit.CreatedAt.Valid, "requested adjustment not populated.")
+
+	pending, err := connection.FiatAdjustmentGet(credit.AdjustmentID)
+	require.NoError(t, err, "failed to retrieve pending adjustment.")
+	require.Equal(t, AdjustmentStatusPENDING, pending.Status, "adjustment not pending.")
+	require.False(t, pending.CheckerID.Valid, "pending adjustment has a checker.")
+
+	usd, err := connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve USD account.")
+	require.True(t, usd.Balance.Equal(decimal.NewFromFloat(100)), "pending adjustment posted.")
+
+	// The maker cannot decide their own adjustment.
+	_, err = connection.FiatAdjustmentApprove(credit.AdjustmentID, makerID, "approved")
+	require.ErrorIs(t, err, ErrAdjustmentMaker, "maker approved their own adjustment.")
+
+	// Approval by another administrator posts the entries.
+	approved, err := connection.FiatAdjustmentApprove(credit.AdjustmentID, checkerID, "approved")
+	require.NoError(t, err, "failed to approve credit adjustment.")
+	require.Equal(t, AdjustmentStatusAPPROVED, approved.Status, "adjustment not approved.")
+	require.Equal(t, checkerID, uuid.UUID(approved.CheckerID.Bytes), "checker mismatch.")
+	require.True(t, approved.TxID.Valid, "approved adjustment missing transaction id.")
+	require.True(t, approved.DecidedAt.Valid, "approved adjustment missing decision time.")
+
+	usd, err = connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve USD account.")
+	require.True(t, usd.Balance.Equal(decimal.NewFromFloat(125.50)), "approved credit not posted.")
+
+	journal, err := connection.FiatTxDetails(clientID1, approved.TxID.Bytes)
+	require.NoError(t, err, "failed to retrieve adjustment journal entry.")
+	require.Len(t, journal, 1, "adjustment journal entry count mismatch.")
+	require.True(t, journal[0].Amount.Equal(decimal.NewFromFloat(25.50)), "adjustment journal entry amount mismatch.")
+
+	// Decided adjustments cannot be decided again.
+	_, err = connection.FiatAdjustmentReject(credit.AdjustmentID, checkerID, "rejected")
+	require.ErrorIs(t, err, ErrAdjustmentDecided, "decided adjustment twice.")
+
+	// Debits cannot overdraw the account.
+	overdraw := newAdjustment(-200)
+	overdraw.ReasonCode = AdjustmentReasonFEECORRECTION
+	require.NoError(t, connection.FiatAdjustmentCreate(overdraw), "failed to request overdrawing debit adjustment.")
+
+	_, err = connection.FiatAdjustmentApprove(overdraw.AdjustmentID, checkerID, "approved")
+	require.ErrorIs(t, err, ErrInsufficientFunds, "debit adjustment overdrew account.")
+
+	rejected, err := connection.FiatAdjustmentReject(overdraw.AdjustmentID, checkerID, "amount is incorrect")
+	require.NoError(t, err, "failed to reject debit adjustment.")
+	require.Equal(t, AdjustmentStatusREJECTED, rejected.Status, "adjustment not rejected.")
+	require.Equal(t, "amount is incorrect", rejected.DecisionNote, "decision note mismatch.")
+	require.False(t, rejected.TxID.Valid, "rejected adjustment posted.")
+
+	// The lifecycle of all the adjustments is retained.
+	adjustments, err := connection.FiatAdjustmentsPaginated("", "", 10)
+	require.NoError(t, err, "failed to retrieve adjustments.")
+	require.Len(t, adjustments, 2, "adjustments count mismatch.")
+	require.Equal(t, overdraw.AdjustmentID, adjustments[0].AdjustmentID, "adjustments not newest first.")
+
+	adjustments, err = connection.FiatAdjustmentsPaginated("", string(AdjustmentStatusAPPROVED), 10)
+	require.NoError(t, err, "failed to retrieve approved adjustments.")
+	require.Len(t, adjustments, 1, "approved adjustments count mismatch.")
+	require.Equal(t, credit.AdjustmentID, adjustments[0].AdjustmentID, "approved adjustment mismatch.")
+
+	_, err = connection.FiatAdjustmentGet("unknown-adjustment")
+	require.ErrorIs(t, err, ErrNotFound, "retrieved unknown adjustment.")
+}
+
+func TestAdjustments_FiatAdjustmentResolve_Mock(t *testing.T) {
+	t.Parallel()
+
+	makerID := uuid.Must(uuid.NewV4())
+	checkerID := pgtype.UUID{Bytes: uuid.Must(uuid.NewV4()), Valid: true}
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		status        AdjustmentStatus
+		rowLockRow    FiatAdjustment
+		rowLockErr    error
+		checkerID     pgtype.UUID
+		accountStatus AccountStatus
+		accountTimes  int
+		balanceTimes  int
+		postTimes     int
+		decideTimes   int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: ErrNotFound,
+			status:        AdjustmentStatusAPPROVED,
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			checkerID:     checkerID,
+		}, {
+			name:          "Already decided.",
+			expectErr:     require.Error,
+			expectedError: ErrAdjustmentDecided,
+			status:        AdjustmentStatusAPPROVED,
+			rowLockRow:    FiatAdjustment{Status: AdjustmentStatusREJECTED, MakerID: makerID},
+			checkerID:     checkerID,
+		}, {
+			name:          "Decided by maker.",
+			expectErr:     require.Error,
+			expectedError: ErrAdjustmentMaker,
+			status:        AdjustmentStatusREJECTED,
+			rowLockRow:    FiatAdjustment{Status: AdjustmentStatusPENDING, MakerID: makerID},
+			checkerID:     pgtype.UUID{Bytes: makerID, Valid: true},
+		}, {
+			name:        "Rejected.",
+			expectErr:   require.NoError,
+			status:      AdjustmentStatusREJECTED,
+			rowLockRow:  FiatAdjustment{Status: AdjustmentStatusPENDING, MakerID: makerID},
+			checkerID:   checkerID,
+			decideTimes: 1,
+		}, {
+			name:          "Closed account.",
+			expectErr:     require.Error,
+			expectedError: ErrAccountStatus,
+			status:        AdjustmentStatusAPPROVED,
+			rowLockRow: FiatAdjustment{
+				Status: AdjustmentStatusPENDING, MakerID: makerID, Amount: decimal.NewFromFloat(10)},
+			checkerID:     checkerID,
+			accountStatus: AccountStatusCLOSED,
+			accountTimes:  1,
+		}, {
+			name:          "Insufficient funds.",
+			expectErr:     require.Error,
+			expectedError: ErrInsufficientFunds,
+			status:        AdjustmentStatusAPPROVED,
+			rowLockRow: FiatAdjustment{
+				Status: AdjustmentStatusPENDING, MakerID: makerID, Amount: decimal.NewFromFloat(-1001)},
+			checkerID:     checkerID,
+			accountStatus: AccountStatusFROZEN,
+			accountTimes:  1,
+			balanceTimes:  1,
+		}, {
+			name:      "Approved debit.",
+			expectErr: require.NoError,
+			status:    AdjustmentStatusAPPROVED,
+			rowLockRow: FiatAdjustment{
+				Status: AdjustmentStatusPENDING, MakerID: makerID, Amount: decimal.NewFromFloat(-1000)},
+			checkerID:     checkerID,
+			accountStatus: AccountStatusACTIVE,
+			accountTimes:  1,
+			balanceTimes:  1,
+			postTimes:     1,
+			decideTimes:   1,
+		}, {
+			name:      "Approved credit.",
+			expectErr: require.NoError,
+			status:    AdjustmentStatusAPPROVED,
+			rowLockRow: FiatAdjustment{
+				Status: AdjustmentStatusPENDING, MakerID: makerID, Amount: decimal.NewFromFloat(1000)},
+			checkerID:     checkerID,
+			accountStatus: AccountStatusFROZENDEBITS,
+			accountTimes:  1,
+			postTimes:     1,
+			decideTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			mockQuerier.EXPECT().
+				fiatAdjustmentRowLock(gomock.Any(), gomock.Any()).
+				Return(test.rowLockRow, test.rowLockErr).
+				Times(1)
+
+			mockQuerier.EXPECT().
+				fiatRowLockAccount(gomock.Any(), gomock.Any()).
+				Return(fiatRowLockAccountRow{Balance: decimal.NewFromFloat(1000), Status: test.accountStatus}, nil).
+				Times(test.accountTimes)
+
+			mockQuerier.EXPECT().
+				fiatHoldTotal(gomock.Any(), gomock.Any()).
+				Return(decimal.Zero, nil).
+				Times(test.balanceTimes)
+
+			mockQuerier.EXPECT().
+				fiatExternalTransferJournalEntry(gomock.Any(), gomock.Any()).
+				Return(fiatExternalTransferJournalEntryRow{TxID: uuid.Must(uuid.NewV4())}, nil).
+				Times(test.postTimes)
+
+			mockQuerier.EXPECT().
+				fiatUpdateAccountBalance(gomock.Any(), gomock.Any()).
+				Return(fiatUpdateAccountBalanceRow{}, nil).
+				Times(test.postTimes)
+
+			mockQuerier.EXPECT().
+				fiatAdjustmentDecide(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, params *fiatAdjustmentDecideParams) {
+					require.Equal(t, test.status, params.Status, "decision status mismatch.")
+					require.Equal(t, test.status == AdjustmentStatusAPPROVED, params.TxID.Valid,
+						"decision transaction id mismatch.")
+				}).
+				Return(FiatAdjustment{Status: test.status}, nil).
+				Times(test.decideTimes)
+
+			// Check for error.
+			_, err := fiatAdjustmentResolve(context.TODO(), zapLogger, mockQuerier, &fiatAdjustmentDecideParams{
+				AdjustmentID: "adjustment-id",
+				Status:       test.status,
+				CheckerID:    test.checkerID,
+			})
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
@@ -13,9 +13,34 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const cryptoCloseAccount = `-- name: cryptoCloseAccount :execrows
+UPDATE crypto_accounts
+SET status='CLOSED'
+WHERE client_id=$1 AND ticker=$2 AND balance=0 AND status<>'CLOSED'
+`
+
+type cryptoCloseAccountParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Ticker   string    `json:"ticker"`
+}
+
+// cryptoCloseAccount will mark a Crypto account with a zero balance as closed.
+func (q *Queries) cryptoCloseAccount(ctx context.Context, arg *cryptoCloseAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cryptoCloseAccount, arg.ClientID, arg.Ticker)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const cryptoCreateAccount = `-- name: cryptoCreateAccount :execrows
 INSERT INTO crypto_accounts (client_id, ticker)
-VALUES ($1, $2)
+SELECT $1, ticker
+FROM crypto_assets
+WHERE ticker=$2 AND status='ENABLED'
+ON CONFLICT (client_id, ticker) DO UPDATE
+SET status='ACTIVE'
+WHERE crypto_accounts.status='CLOSED'
 `
 
 type cryptoCreateAccountParams struct {
@@ -23,7 +48,8 @@ type cryptoCreateAccountParams struct {
 	Ticker   string    `json:"ticker"`
 }
 
-// cryptoCreateAccount inserts a fiat account record.
+// cryptoCreateAccount inserts a fiat account record. Accounts can only be opened for enabled Cryptocurrencies. A
+// closed account will be reopened.
 func (q *Queries) cryptoCreateAccount(ctx context.Context, arg *cryptoCreateAccountParams) (int64, error) {
 	result, err := q.db.Exec(ctx, cryptoCreateAccount, arg.ClientID, arg.Ticker)
 	if err != nil {
@@ -33,8 +59,8 @@ func (q *Queries) cryptoCreateAccount(ctx context.Context, arg *cryptoCreateAcco
 }
 
 const cryptoGetAccount = `-- name: cryptoGetAccount :one
-SELECT ticker, balance, last_tx, last_tx_ts, created_at, client_id
-FROM crypto_accounts
+SELECT ticker, balance, available, last_tx, last_tx_ts, created_at, client_id, status
+FROM crypto_account_balances
 WHERE client_id=$1 AND ticker=$2
 `
 
@@ -43,25 +69,28 @@ type cryptoGetAccountParams struct {
 	Ticker   string    `json:"ticker"`
 }
 
-// cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker.
-func (q *Queries) cryptoGetAccount(ctx context.Context, arg *cryptoGetAccountParams) (CryptoAccount, error) {
+// cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker with the ledger and
+// available balances.
+func (q *Queries) cryptoGetAccount(ctx context.Context, arg *cryptoGetAccountParams) (CryptoAccountBalance, error) {
 	row := q.db.QueryRow(ctx, cryptoGetAccount, arg.ClientID, arg.Ticker)
-	var i CryptoAccount
+	var i CryptoAccountBalance
 	err := row.Scan(
 		&i.Ticker,
 		&i.Balance,
+		&i.Available,
 		&i.LastTx,
 		&i.LastTxTs,
 		&i.CreatedAt,
 		&i.ClientID,
+		&i.Status,
 	)
 	return i, err
 }
 
 const cryptoGetAllAccounts = `-- name: cryptoGetAllAccounts :many
-SELECT ticker, balance, last_tx, last_tx_ts, created_at, client_id
-FROM crypto_accounts
-WHERE client_id=$1 AND ticker >= $2
+SELECT ticker, balance, available, last_tx, last_tx_ts, created_at, client_id, status
+FROM crypto_account_balances
+WHERE client_id=$1 AND ticker >= $2 AND status<>'CLOSED'
 ORDER BY ticker
 LIMIT $3
 `
@@ -72,23 +101,26 @@ type cryptoGetAllAccountsParams struct {
 	Limit    int32     `json:"limit"`
 }
 
-// cryptoGetAllAccounts will retrieve all accounts associated with a specific user.
-func (q *Queries) cryptoGetAllAccounts(ctx context.Context, arg *cryptoGetAllAccountsParams) ([]CryptoAccount, error) {
+// cryptoGetAllAccounts will retrieve all open accounts associated with a specific user with the ledger and available
+// balances.
+func (q *Queries) cryptoGetAllAccounts(ctx context.Context, arg *cryptoGetAllAccountsParams) ([]CryptoAccountBalance, error) {
 	rows, err := q.db.Query(ctx, cryptoGetAllAccounts, arg.ClientID, arg.Ticker, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []CryptoAccount
+	var items []CryptoAccountBalance
 	for rows.Next() {
-		var i CryptoAccount
+		var i CryptoAccountBalance
 		if err := rows.Scan(
 			&i.Ticker,
 			&i.Balance,
+			&i.Available,
 			&i.LastTx,
 			&i.LastTxTs,
 			&i.CreatedAt,
 			&i.ClientID,
+			&i.Status,
 		); err != nil {
 			return nil, err
 		}
@@ -101,7 +133,7 @@ func (q *Queries) cryptoGetAllAccounts(ctx context.Context, arg *cryptoGetAllAcc
 }
 
 const cryptoGetAllJournalTransactionsPaginated = `-- name: cryptoGetAllJournalTransactionsPaginated :many
-SELECT ticker, amount, transacted_at, client_id, tx_id
+SELECT ticker, amount, transacted_at, client_id, tx_id, seq, prev_hash, entry_hash
 FROM crypto_journal
 WHERE client_id = $1
       AND ticker = $2
@@ -146,6 +178,9 @@ func (q *Queries) cryptoGetAllJournalTransactionsPaginated(ctx context.Context,
 			&i.TransactedAt,
 			&i.ClientID,
 			&i.TxID,
+			&i.Seq,
+			&i.PrevHash,
+			&i.EntryHash,
 		); err != nil {
 			return nil, err
 		}
@@ -158,7 +193,7 @@ func (q *Queries) cryptoGetAllJournalTransactionsPaginated(ctx context.Context,
 }
 
 const cryptoGetJournalTransaction = `-- name: cryptoGetJournalTransaction :many
-SELECT ticker, amount, transacted_at, client_id, tx_id
+SELECT ticker, amount, transacted_at, client_id, tx_id, seq, prev_hash, entry_hash
 FROM crypto_journal
 WHERE client_id = $1 AND tx_id = $2
 `
@@ -184,6 +219,9 @@ func (q *Queries) cryptoGetJournalTransaction(ctx context.Context, arg *cryptoGe
 			&i.TransactedAt,
 			&i.ClientID,
 			&i.TxID,
+			&i.Seq,
+			&i.PrevHash,
+			&i.EntryHash,
 		); err != nil {
 			return nil, err
 		}
@@ -195,8 +233,93 @@ func (q *Queries) cryptoGetJournalTransaction(ctx context.Context, arg *cryptoGe
 	return items, nil
 }
 
+const cryptoHoldCreate = `-- name: cryptoHoldCreate :execrows
+INSERT INTO crypto_holds (offer_id, client_id, ticker, amount, expires_at)
+VALUES ($1, $2, $3, $5::numeric(38, 18), $4)
+`
+
+type cryptoHoldCreateParams struct {
+	OfferID   string             `json:"offerID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Ticker    string             `json:"ticker"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	Amount    decimal.Decimal    `json:"amount"`
+}
+
+// cryptoHoldCreate will place a hold on funds in a Crypto account for a sale offer.
+func (q *Queries) cryptoHoldCreate(ctx context.Context, arg *cryptoHoldCreateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cryptoHoldCreate,
+		arg.OfferID,
+		arg.ClientID,
+		arg.Ticker,
+		arg.ExpiresAt,
+		arg.Amount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cryptoHoldPurgeExpired = `-- name: cryptoHoldPurgeExpired :execrows
+DELETE FROM crypto_holds
+WHERE client_id=$1 AND ticker=$2 AND expires_at<=now()
+`
+
+type cryptoHoldPurgeExpiredParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Ticker   string    `json:"ticker"`
+}
+
+// cryptoHoldPurgeExpired will remove the expired holds on a Crypto account.
+func (q *Queries) cryptoHoldPurgeExpired(ctx context.Context, arg *cryptoHoldPurgeExpiredParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cryptoHoldPurgeExpired, arg.ClientID, arg.Ticker)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cryptoHoldRelease = `-- name: cryptoHoldRelease :execrows
+DELETE FROM crypto_holds
+WHERE offer_id=$1 AND client_id=$2
+`
+
+type cryptoHoldReleaseParams struct {
+	OfferID  string    `json:"offerID"`
+	ClientID uuid.UUID `json:"clientID"`
+}
+
+// cryptoHoldRelease will release a hold on a Crypto account for a sale offer.
+func (q *Queries) cryptoHoldRelease(ctx context.Context, arg *cryptoHoldReleaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cryptoHoldRelease, arg.OfferID, arg.ClientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cryptoHoldTotal = `-- name: cryptoHoldTotal :one
+SELECT COALESCE(SUM(amount), 0)::numeric(38, 18) AS held
+FROM crypto_holds
+WHERE client_id=$1 AND ticker=$2 AND expires_at>now()
+`
+
+type cryptoHoldTotalParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Ticker   string    `json:"ticker"`
+}
+
+// cryptoHoldTotal will retrieve the total of the funds held in a Crypto account for sale offers that have not expired.
+func (q *Queries) cryptoHoldTotal(ctx context.Context, arg *cryptoHoldTotalParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, cryptoHoldTotal, arg.ClientID, arg.Ticker)
+	var held decimal.Decimal
+	err := row.Scan(&held)
+	return held, err
+}
+
 const cryptoPurchase = `-- name: cryptoPurchase :exec
-CALL purchase_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(24, 8))
+CALL purchase_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(38, 18))
 `
 
 type cryptoPurchaseParams struct {
@@ -221,8 +344,37 @@ func (q *Queries) cryptoPurchase(ctx context.Context, arg *cryptoPurchaseParams)
 	return err
 }
 
+const cryptoRowLockAccount = `-- name: cryptoRowLockAccount :one
+SELECT ca.balance, ca.status, u.is_frozen
+FROM crypto_accounts AS ca
+    INNER JOIN users AS u ON ca.client_id = u.client_id
+WHERE ca.client_id=$1 AND ca.ticker=$2
+LIMIT 1
+FOR NO KEY UPDATE OF ca
+`
+
+type cryptoRowLockAccountParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Ticker   string    `json:"ticker"`
+}
+
+type cryptoRowLockAccountRow struct {
+	Balance  decimal.Decimal `json:"balance"`
+	Status   AccountStatus   `json:"status"`
+	IsFrozen bool            `json:"isFrozen"`
+}
+
+// cryptoRowLockAccount will acquire a row level lock without locks on the foreign keys. The account status and whether
+// the client has been suspended are returned alongside the balance.
+func (q *Queries) cryptoRowLockAccount(ctx context.Context, arg *cryptoRowLockAccountParams) (cryptoRowLockAccountRow, error) {
+	row := q.db.QueryRow(ctx, cryptoRowLockAccount, arg.ClientID, arg.Ticker)
+	var i cryptoRowLockAccountRow
+	err := row.Scan(&i.Balance, &i.Status, &i.IsFrozen)
+	return i, err
+}
+
 const cryptoSell = `-- name: cryptoSell :exec
-CALL sell_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(24, 8))
+CALL sell_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(38, 18))
 `
 
 type cryptoSellParams struct {
@@ -246,3 +398,24 @@ func (q *Queries) cryptoSell(ctx context.Context, arg *cryptoSellParams) error {
 	)
 	return err
 }
+
+const cryptoSetAccountStatus = `-- name: cryptoSetAccountStatus :execrows
+UPDATE crypto_accounts
+SET status=$3::account_status
+WHERE client_id=$1 AND ticker=$2 AND status<>'CLOSED'
+`
+
+type cryptoSetAccountStatusParams struct {
+	ClientID uuid.UUID     `json:"clientID"`
+	Ticker   string        `json:"ticker"`
+	Status   AccountStatus `json:"status"`
+}
+
+// cryptoSetAccountStatus will set the status of a Crypto account that has not been closed.
+func (q *Queries) cryptoSetAccountStatus(ctx context.Context, arg *cryptoSetAccountStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cryptoSetAccountStatus, arg.ClientID, arg.Ticker, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -94,7 +94,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 			params: &cryptoPurchaseParams{
 				TransactionID:      txIDValid1,
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(456.78),
 				CryptoCreditAmount: decimal.NewFromFloat(13.12345678),
@@ -105,7 +105,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 			params: &cryptoPurchaseParams{
 				TransactionID:      txIDValid2,
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(2389.33),
 				CryptoCreditAmount: decimal.NewFromFloat(104.80808081),
@@ -116,7 +116,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 			params: &cryptoPurchaseParams{
 				TransactionID:      txIDPKR,
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyPKR,
+				FiatCurrency:       Currency("PKR"),
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(456.78),
 				CryptoCreditAmount: decimal.NewFromFloat(13.12345678),
@@ -127,7 +127,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 			params: &cryptoPurchaseParams{
 				TransactionID:      txIDBAD,
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "BAD",
 				FiatDebitAmount:    decimal.NewFromFloat(77.99),
 				CryptoCreditAmount: decimal.NewFromFloat(4.0000003),
@@ -138,7 +138,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 			params: &cryptoPurchaseParams{
 				TransactionID:      txIDNoFunds,
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(9999999.99),
 				CryptoCreditAmount: decimal.NewFromFloat(6.1100005),
@@ -158,9 +158,9 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 	ts1 := pgtype.Timestamptz{}
 	require.NoError(t, ts1.Scan(time.Now().UTC()), "time stamp 1 parse failed.")
 
-	_, err = connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err = updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID1,
-		Currency: CurrencyUSD,
+		Currency: Currency("USD"),
 		Amount:   decimal.NewFromFloat(5643.17),
 		LastTxTs: ts1,
 	})
@@ -199,7 +199,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 		require.NoError(t, err, "failed to retrieve Fiat operations user id.")
 
 		// Check balances.
-		fiatAccount, err := connection.FiatBalance(clientID1, CurrencyUSD)
+		fiatAccount, err := connection.FiatBalance(clientID1, Currency("USD"))
 		require.NoError(t, err, "failed to retrieve Fiat account balance.")
 		require.Equal(t, fiatAccount.Balance, decimal.NewFromFloat(2797.06), "Fiat balance mismatch.")
 
@@ -330,7 +330,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 			params: &cryptoSellParams{
 				TransactionID:     txIDValid1,
 				ClientID:          clientID1,
-				FiatCurrency:      CurrencyUSD,
+				FiatCurrency:      Currency("USD"),
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(992.91),
 				CryptoDebitAmount: decimal.NewFromFloat(9.11992012),
@@ -341,7 +341,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 			params: &cryptoSellParams{
 				TransactionID:     txIDValid2,
 				ClientID:          clientID1,
-				FiatCurrency:      CurrencyUSD,
+				FiatCurrency:      Currency("USD"),
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(7765.32),
 				CryptoDebitAmount: decimal.NewFromFloat(11.40404049),
@@ -352,7 +352,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 			params: &cryptoSellParams{
 				TransactionID:     txIDPKR,
 				ClientID:          clientID1,
-				FiatCurrency:      CurrencyPKR,
+				FiatCurrency:      Currency("PKR"),
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(555.11),
 				CryptoDebitAmount: decimal.NewFromFloat(88888.12345678),
@@ -363,7 +363,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 			params: &cryptoSellParams{
 				TransactionID:     txIDBAD,
 				ClientID:          clientID1,
-				FiatCurrency:      CurrencyUSD,
+				FiatCurrency:      Currency("USD"),
 				CryptoTicker:      "BAD",
 				FiatCreditAmount:  decimal.NewFromFloat(77.99),
 				CryptoDebitAmount: decimal.NewFromFloat(4.0000003),
@@ -374,7 +374,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 			params: &cryptoSellParams{
 				TransactionID:     txIDNoFunds,
 				ClientID:          clientID1,
-				FiatCurrency:      CurrencyUSD,
+				FiatCurrency:      Currency("USD"),
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(9999999.99),
 				CryptoDebitAmount: decimal.NewFromFloat(9191919191.1100005),
@@ -394,16 +394,16 @@ func TestCrypto_CryptoSell(t *testing.T) {
 	ts1 := pgtype.Timestamptz{}
 	require.NoError(t, ts1.Scan(time.Now().UTC()), "time stamp 1 parse failed.")
 
-	_, err = connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err = updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID1,
-		Currency: CurrencyUSD,
+		Currency: Currency("USD"),
 		Amount:   decimal.NewFromFloat(64320.27),
 		LastTxTs: ts1,
 	})
 	require.NoError(t, err, "error expectation condition failed.")
 
 	_, _, err = connection.CryptoPurchase(
-		clientID1, CurrencyUSD, decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444))
+		clientID1, Currency("USD"), decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444), "")
 	require.NoError(t, err, "error expectation condition failed.")
 
 	// Configure wait groups for parallel run of all threads.
@@ -439,7 +439,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 		require.NoError(t, err, "failed to retrieve Fiat operations user id.")
 
 		// Check balances.
-		fiatAccount, err := connection.FiatBalance(clientID1, CurrencyUSD)
+		fiatAccount, err := connection.FiatBalance(clientID1, Currency("USD"))
 		require.NoError(t, err, "failed to retrieve Fiat account balance.")
 		require.Equal(t, fiatAccount.Balance, decimal.NewFromFloat(73056.28), "Fiat balance mismatch.")
 
@@ -637,14 +637,14 @@ func TestCrypto_CryptoGetAllJournalTransactionPaginated(t *testing.T) {
 	{
 		_, err := connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
 			ClientID: clientID1,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 			Amount:   decimal.NewFromFloat(10203040.56),
 		})
 		require.NoError(t, err, "failed to deposit Fiat money for client 1.")
 
 		_, err = connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
 			ClientID: clientID2,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 			Amount:   decimal.NewFromFloat(10304055.78),
 		})
 		require.NoError(t, err, "failed to deposit Fiat money for client 2.")
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Currency is a Fiat currency code registered in the Fiat currency reference table. Currency codes are validated
+// against a cached copy of the reference table which is loaded when the Postgres connection is opened, and reloaded
+// whenever the reference table is updated through this package.
+type Currency string
+
+// currencyCache is a cached copy of the Fiat currency reference table that is safe for concurrent use.
+type currencyCache struct {
+	mutex      sync.RWMutex
+	currencies map[Currency]FiatCurrencyStatus
+}
+
+// currencies is the cached copy of the Fiat currency reference table used to validate currency codes.
+var currencies = currencyCache{currencies: make(map[Currency]FiatCurrencyStatus)}
+
+// SetCurrencyCache will replace the cached copy of the Fiat currency reference table.
+func SetCurrencyCache(records []FiatCurrency) {
+	cache := make(map[Currency]FiatCurrencyStatus, len(records))
+	for _, record := range records {
+		cache[Currency(record.Code)] = record.Status
+	}
+
+	currencies.mutex.Lock()
+	defer currencies.mutex.Unlock()
+
+	currencies.currencies = cache
+}
+
+// GenerateTestCurrencies will generate the Fiat currency reference table records that are seeded by the database
+// migration. These are used to populate the currency cache in test suites that do not connect to Postgres.
+func GenerateTestCurrencies() []FiatCurrency {
+	codes := []string{
+		"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN", "BAM", "BBD", "BDT", "BGN", "BHD", "BIF",
+		"BMD", "BND", "BOB", "BRL", "BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHF", "CLP", "CNY", "COP", "CRC",
+		"CUP", "CVE", "CZK", "DJF", "DKK", "DOP", "DZD", "EGP", "ERN", "ETB", "EUR", "FJD", "FKP", "GBP", "GEL", "GGP",
+		"GHS", "GIP", "GMD", "GNF", "GTQ", "GYD", "HKD", "HNL", "HTG", "HUF", "IDR", "ILS", "IMP", "INR", "IQD", "IRR",
+		"ISK", "JEP", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP",
+		"LKR", "LRD", "LSL", "LYD", "MAD", "MDL", "MGA", "MKD", "MMK", "MNT", "MOP", "MRU", "MUR", "MVR", "MWK", "MXN",
+		"MYR", "MZN", "NAD", "NGN", "NIO", "NOK", "NPR", "NZD", "OMR", "PAB", "PEN", "PGK", "PHP", "PKR", "PLN", "PYG",
+		"QAR", "RON", "RSD", "RUB", "RWF", "SAR", "SBD", "SCR", "SDG", "SEK", "SGD", "SHP", "SLE", "SOS", "SPL", "SRD",
+		"STN", "SVC", "SYP", "SZL", "THB", "TJS", "TMT", "TND", "TOP", "TRY", "TTD", "TVD", "TWD", "TZS", "UAH", "UGX",
+		"USD", "UYU", "UZS", "VES", "VND", "VUV", "WST", "XAF", "XCD", "XDR", "XOF", "XPF", "YER", "ZAR", "ZMW", "ZWL",
+	}
+
+	inactive := []FiatCurrency{
+		{Code: "SLL", Status: FiatCurrencyStatusDEPRECATED},
+		{Code: "CUC", Status: FiatCurrencyStatusWITHDRAWN},
+		{Code: "HRK", Status: FiatCurrencyStatusWITHDRAWN},
+		{Code: "VEF", Status: FiatCurrencyStatusWITHDRAWN},
+		{Code: "ZWD", Status: FiatCurrencyStatusWITHDRAWN},
+		{Code: "FIAT", Status: FiatCurrencyStatusINTERNAL},
+		{Code: "CRYPTO", Status: FiatCurrencyStatusINTERNAL},
+	}
+
+	records := make([]FiatCurrency, 0, len(codes)+len(inactive))
+
+	for _, code := range codes {
+		records = append(records, FiatCurrency{Code: code, Status: FiatCurrencyStatusACTIVE})
+	}
+
+	return append(records, inactive...)
+}
+
+// Scan implements the Scanner interface. Only currency codes present in the currency cache will be accepted.
+func (e *Currency) Scan(src interface{}) error {
+	var code Currency
+
+	switch s := src.(type) {
+	case []byte:
+		code = Currency(s)
+	case string:
+		code = Currency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Currency: %T", src)
+	}
+
+	if !code.Valid() {
+		return fmt.Errorf("unsupported Fiat currency code: %s", code)
+	}
+
+	*e = code
+
+	return nil
+}
+
+// Status will retrieve the status of the currency from the currency cache. The boolean flag will be false if the
+// currency is not registered.
+func (e Currency) Status() (FiatCurrencyStatus, bool) {
+	currencies.mutex.RLock()
+	defer currencies.mutex.RUnlock()
+
+	status, ok := currencies.currencies[e]
+
+	return status, ok
+}
+
+// Valid will check if the currency is registered, irrespective of its status. Balances and transactions in all
+// registered currencies remain readable.
+func (e Currency) Valid() bool {
+	_, ok := e.Status()
+
+	return ok
+}
+
+// Openable will check if new accounts may be opened in the currency. Only active currencies accept new accounts.
+func (e Currency) Openable() bool {
+	status, ok := e.Status()
+
+	return ok && status == FiatCurrencyStatusACTIVE
+}
+
+// Transactable will check if funds may be deposited into, or exchanged into and out of, the currency. Deprecated
+// currencies may still be transacted in until they are withdrawn.
+func (e Currency) Transactable() bool {
+	status, ok := e.Status()
+
+	return ok && (status == FiatCurrencyStatusACTIVE || status == FiatCurrencyStatusDEPRECATED)
+}
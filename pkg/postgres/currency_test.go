@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrency_Scan(t *testing.T) {
+	SetCurrencyCache(GenerateTestCurrencies())
+
+	t.Run("Byte Array", func(t *testing.T) {
+		var curr Currency
+		err := curr.Scan([]byte(Currency("USD")))
+		require.NoError(t, err, "valid byte array")
+		require.Equal(t, Currency("USD"), curr, "scanned currency mismatch")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		var curr Currency
+		err := curr.Scan("USD")
+		require.NoError(t, err, "valid string")
+		require.Equal(t, Currency("USD"), curr, "scanned currency mismatch")
+	})
+
+	t.Run("Withdrawn currency", func(t *testing.T) {
+		var curr Currency
+		err := curr.Scan("ZWD")
+		require.NoError(t, err, "withdrawn currencies should remain readable")
+	})
+
+	t.Run("Valid string, invalid currency", func(t *testing.T) {
+		var curr Currency
+		err := curr.Scan("UVW")
+		require.Error(t, err, "unregistered currency")
+		require.Empty(t, curr, "unregistered currency should not be scanned")
+	})
+
+	t.Run("Invalid Type", func(t *testing.T) {
+		var curr Currency
+		err := curr.Scan(123)
+		require.Error(t, err, "invalid type")
+	})
+}
+
+func TestCurrency_Status(t *testing.T) {
+	SetCurrencyCache(GenerateTestCurrencies())
+
+	testCases := []struct {
+		name               string
+		currency           Currency
+		expectedStatus     FiatCurrencyStatus
+		validExpected      require.BoolAssertionFunc
+		openExpected       require.BoolAssertionFunc
+		transactExpected   require.BoolAssertionFunc
+		registeredExpected require.BoolAssertionFunc
+	}{
+		{
+			name:               "Active - USD",
+			currency:           Currency("USD"),
+			expectedStatus:     FiatCurrencyStatusACTIVE,
+			validExpected:      require.True,
+			openExpected:       require.True,
+			transactExpected:   require.True,
+			registeredExpected: require.True,
+		}, {
+			name:               "Deprecated - SLL",
+			currency:           Currency("SLL"),
+			expectedStatus:     FiatCurrencyStatusDEPRECATED,
+			validExpected:      require.True,
+			openExpected:       require.False,
+			transactExpected:   require.True,
+			registeredExpected: require.True,
+		}, {
+			name:               "Withdrawn - ZWD",
+			currency:           Currency("ZWD"),
+			expectedStatus:     FiatCurrencyStatusWITHDRAWN,
+			validExpected:      require.True,
+			openExpected:       require.False,
+			transactExpected:   require.False,
+			registeredExpected: require.True,
+		}, {
+			name:               "Internal - FIAT",
+			currency:           Currency("FIAT"),
+			expectedStatus:     FiatCurrencyStatusINTERNAL,
+			validExpected:      require.True,
+			openExpected:       require.False,
+			transactExpected:   require.False,
+			registeredExpected: require.True,
+		}, {
+			name:               "Internal - CRYPTO",
+			currency:           Currency("CRYPTO"),
+			expectedStatus:     FiatCurrencyStatusINTERNAL,
+			validExpected:      require.True,
+			openExpected:       require.False,
+			transactExpected:   require.False,
+			registeredExpected: require.True,
+		}, {
+			name:               "Invalid",
+			currency:           "XYZ",
+			expectedStatus:     "",
+			validExpected:      require.False,
+			openExpected:       require.False,
+			transactExpected:   require.False,
+			registeredExpected: require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, ok := testCase.currency.Status()
+			testCase.registeredExpected(t, ok, "expected registration condition failed.")
+			require.Equal(t, testCase.expectedStatus, status, "currency status mismatch.")
+			testCase.validExpected(t, testCase.currency.Valid(), "expected validity condition failed.")
+			testCase.openExpected(t, testCase.currency.Openable(), "expected openable condition failed.")
+			testCase.transactExpected(t, testCase.currency.Transactable(), "expected transactable condition failed.")
+		})
+	}
+}
+
+func TestCurrency_SetCurrencyCache(t *testing.T) {
+	defer SetCurrencyCache(GenerateTestCurrencies())
+
+	SetCurrencyCache([]FiatCurrency{{Code: "USD", Status: FiatCurrencyStatusWITHDRAWN}})
+
+	require.True(t, Currency("USD").Valid(), "USD should be registered.")
+	require.False(t, Currency("USD").Transactable(), "USD should be withdrawn.")
+	require.False(t, Currency("CAD").Valid(), "CAD should not be registered.")
+
+	SetCurrencyCache(GenerateTestCurrencies())
+
+	require.True(t, Currency("USD").Openable(), "USD should be active.")
+	require.True(t, Currency("CAD").Valid(), "CAD should be registered.")
+}
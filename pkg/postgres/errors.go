@@ -55,11 +55,38 @@ var (
 	ErrUnhealthy             = errorUnhealthy()                // ErrUnhealthy is returned if the database cannot be pinged.
 	ErrTransactCrypto        = errorTransactionCrypto()        // ErrTransactCrypto is returned if a Crypto transaction fails.
 	ErrTransactCryptoDetails = errorTransactionCryptoDetails() // ErrTransactCryptoDetails is returned if a Crypto transaction succeeds, but transaction retrieval fails.
+	ErrCryptoAsset           = errorCryptoAsset()              // ErrCryptoAsset is returned if a Cryptocurrency could not be registered or updated.
+	ErrFiatCurrency          = errorFiatCurrency()             // ErrFiatCurrency is returned if a Fiat currency could not be registered or updated.
+	ErrAuditLog              = errorAuditLog()                 // ErrAuditLog is returned if an administrative action could not be recorded in the audit log.
+	ErrAccountStatus         = errorAccountStatus()            // ErrAccountStatus is returned if an account is frozen or closed, or its client is suspended.
+	ErrCloseAccount          = errorCloseAccount()             // ErrCloseAccount is returned if an account with a non-zero balance is being closed.
+	ErrInsufficientFunds     = errorInsufficientFunds()        // ErrInsufficientFunds is returned if the available balance of an account, net of holds, is insufficient.
+	ErrLimitOrderClosed      = errorLimitOrderClosed()         // ErrLimitOrderClosed is returned if a limit order that is no longer open is being filled or cancelled.
+	ErrTriggerOrderClosed    = errorTriggerOrderClosed()       // ErrTriggerOrderClosed is returned if a trigger order that is no longer active is being executed or cancelled.
+	ErrRecurringClosed       = errorRecurringClosed()          // ErrRecurringClosed is returned if a recurring purchase that is no longer active, or a run that has already been processed, is being executed or cancelled.
+	ErrBalanceSnapshot       = errorBalanceSnapshot()          // ErrBalanceSnapshot is returned if the daily closing balance snapshots could not be recorded.
+	ErrJournalPartition      = errorJournalPartition()         // ErrJournalPartition is returned if a monthly journal partition could not be created, archived, or exported.
+	ErrJournalChain          = errorJournalChain()             // ErrJournalChain is returned if a journal hash chain could not be walked or checkpointed.
+	ErrReversed              = errorReversed()                 // ErrReversed is returned if a transaction has already been reversed or is itself a reversal.
+	ErrReverseTransaction    = errorReverseTransaction()       // ErrReverseTransaction is returned if a compensating reversal of a transaction fails.
+	ErrAdjustmentDecided     = errorAdjustmentDecided()        // ErrAdjustmentDecided is returned if a manual adjustment that has already been approved or rejected is being decided.
+	ErrAdjustmentMaker       = errorAdjustmentMaker()          // ErrAdjustmentMaker is returned if the administrator that requested a manual adjustment is deciding it.
+	ErrAdjustment            = errorAdjustment()               // ErrAdjustment is returned if a manual adjustment could not be requested or decided.
+	ErrAPIKeyLimit           = errorAPIKeyLimit()              // ErrAPIKeyLimit is returned if a client already holds the maximum number of active API keys.
+	ErrAPIKey                = errorAPIKey()                   // ErrAPIKey is returned if an API key could not be created, revoked, or updated.
+	ErrRefreshTokenInvalid   = errorRefreshTokenInvalid()      // ErrRefreshTokenInvalid is returned if a refresh token has expired or its session has been revoked.
+	ErrRefreshTokenReuse     = errorRefreshTokenReuse()        // ErrRefreshTokenReuse is returned if a refresh token that has already been rotated is reused.
+	ErrRefreshToken          = errorRefreshToken()             // ErrRefreshToken is returned if a refresh token could not be issued, rotated, or revoked.
+	ErrMFAEnrolled           = errorMFAEnrolled()              // ErrMFAEnrolled is returned if a client that has enabled two-factor authentication enrolls again.
+	ErrMFA                   = errorMFA()                      // ErrMFA is returned if a two-factor authentication enrolment could not be read or updated.
+	ErrEmailRegistered       = errorEmailRegistered()          // ErrEmailRegistered is returned if an email address is already registered to another user account.
+	ErrLoginLockout          = errorLoginLockout()             // ErrLoginLockout is returned if a login lockout could not be recorded, retrieved, or lifted.
+	ErrAuditEvent            = errorAuditEvent()               // ErrAuditEvent is returned if a security or account event could not be recorded or retrieved.
 )
 
 func errorRegisterUser() error {
 	return &Error{
-		Message: "username is already registered",
+		Message: "username or email address is already registered",
 		Code:    http.StatusNotFound,
 	}
 }
@@ -119,3 +146,192 @@ func errorTransactionCryptoDetails() error {
 		Code:    http.StatusInternalServerError,
 	}
 }
+
+func errorCryptoAsset() error {
+	return &Error{
+		Message: "could not register or update Cryptocurrency",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorFiatCurrency() error {
+	return &Error{
+		Message: "could not register or update Fiat currency",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorAuditLog() error {
+	return &Error{
+		Message: "could not record administrative action",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorAccountStatus() error {
+	return &Error{
+		Message: "account is frozen or closed, or the client is suspended",
+		Code:    http.StatusForbidden,
+	}
+}
+
+func errorCloseAccount() error {
+	return &Error{
+		Message: "account balance must be zero before it can be closed",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorInsufficientFunds() error {
+	return &Error{
+		Message: "insufficient available funds",
+		Code:    http.StatusPaymentRequired,
+	}
+}
+
+func errorLimitOrderClosed() error {
+	return &Error{
+		Message: "limit order is no longer open",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorTriggerOrderClosed() error {
+	return &Error{
+		Message: "trigger order is no longer active",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorRecurringClosed() error {
+	return &Error{
+		Message: "recurring purchase is no longer active",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorBalanceSnapshot() error {
+	return &Error{
+		Message: "could not record balance snapshots",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorJournalPartition() error {
+	return &Error{
+		Message: "could not maintain journal partitions",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorJournalChain() error {
+	return &Error{
+		Message: "could not verify or checkpoint journal hash chain",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorReversed() error {
+	return &Error{
+		Message: "transaction has already been reversed or is a reversal",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorReverseTransaction() error {
+	return &Error{
+		Message: "could not reverse transaction",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorAdjustmentDecided() error {
+	return &Error{
+		Message: "adjustment has already been decided",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorAdjustmentMaker() error {
+	return &Error{
+		Message: "adjustment must be decided by a different administrator",
+		Code:    http.StatusForbidden,
+	}
+}
+
+func errorAdjustment() error {
+	return &Error{
+		Message: "could not process adjustment",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorAPIKeyLimit() error {
+	return &Error{
+		Message: "maximum number of active API keys reached",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorAPIKey() error {
+	return &Error{
+		Message: "could not process API key",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorRefreshTokenInvalid() error {
+	return &Error{
+		Message: "invalid or expired refresh token",
+		Code:    http.StatusForbidden,
+	}
+}
+
+func errorRefreshTokenReuse() error {
+	return &Error{
+		Message: "refresh token has already been used, the session has been revoked",
+		Code:    http.StatusForbidden,
+	}
+}
+
+func errorRefreshToken() error {
+	return &Error{
+		Message: "could not process refresh token",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorMFAEnrolled() error {
+	return &Error{
+		Message: "two-factor authentication is already enabled",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorMFA() error {
+	return &Error{
+		Message: "could not process two-factor authentication",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorEmailRegistered() error {
+	return &Error{
+		Message: "email address is already registered",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorLoginLockout() error {
+	return &Error{
+		Message: "could not process login lockout",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorAuditEvent() error {
+	return &Error{
+		Message: "could not process audit event",
+		Code:    http.StatusInternalServerError,
+	}
+}
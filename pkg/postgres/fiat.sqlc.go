@@ -13,9 +13,34 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const fiatCloseAccount = `-- name: fiatCloseAccount :execrows
+UPDATE fiat_accounts
+SET status='CLOSED'
+WHERE client_id=$1 AND currency=$2 AND balance=0 AND status<>'CLOSED'
+`
+
+type fiatCloseAccountParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Currency Currency  `json:"currency"`
+}
+
+// fiatCloseAccount will mark a Fiat account with a zero balance as closed.
+func (q *Queries) fiatCloseAccount(ctx context.Context, arg *fiatCloseAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fiatCloseAccount, arg.ClientID, arg.Currency)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const fiatCreateAccount = `-- name: fiatCreateAccount :execrows
 INSERT INTO fiat_accounts (client_id, currency)
-VALUES ($1, $2)
+SELECT $1::uuid, code
+FROM fiat_currencies
+WHERE code=$2::currency AND status='ACTIVE'
+ON CONFLICT (client_id, currency) DO UPDATE
+SET status='ACTIVE'
+WHERE fiat_accounts.status='CLOSED'
 `
 
 type fiatCreateAccountParams struct {
@@ -23,7 +48,7 @@ type fiatCreateAccountParams struct {
 	Currency Currency  `json:"currency"`
 }
 
-// fiatCreateAccount inserts a fiat account record.
+// fiatCreateAccount inserts a fiat account record for an active currency. A closed account will be reopened.
 func (q *Queries) fiatCreateAccount(ctx context.Context, arg *fiatCreateAccountParams) (int64, error) {
 	result, err := q.db.Exec(ctx, fiatCreateAccount, arg.ClientID, arg.Currency)
 	if err != nil {
@@ -87,8 +112,8 @@ func (q *Queries) fiatExternalTransferJournalEntry(ctx context.Context, arg *fia
 }
 
 const fiatGetAccount = `-- name: fiatGetAccount :one
-SELECT currency, balance, last_tx, last_tx_ts, created_at, client_id
-FROM fiat_accounts
+SELECT currency, balance, available, last_tx, last_tx_ts, created_at, client_id, status
+FROM fiat_account_balances
 WHERE client_id=$1 AND currency=$2
 `
 
@@ -97,25 +122,27 @@ type fiatGetAccountParams struct {
 	Currency Currency  `json:"currency"`
 }
 
-// fiatGetAccount will retrieve a specific user's account for a given currency.
-func (q *Queries) fiatGetAccount(ctx context.Context, arg *fiatGetAccountParams) (FiatAccount, error) {
+// fiatGetAccount will retrieve a specific user's account for a given currency with the ledger and available balances.
+func (q *Queries) fiatGetAccount(ctx context.Context, arg *fiatGetAccountParams) (FiatAccountBalance, error) {
 	row := q.db.QueryRow(ctx, fiatGetAccount, arg.ClientID, arg.Currency)
-	var i FiatAccount
+	var i FiatAccountBalance
 	err := row.Scan(
 		&i.Currency,
 		&i.Balance,
+		&i.Available,
 		&i.LastTx,
 		&i.LastTxTs,
 		&i.CreatedAt,
 		&i.ClientID,
+		&i.Status,
 	)
 	return i, err
 }
 
 const fiatGetAllAccounts = `-- name: fiatGetAllAccounts :many
-SELECT currency, balance, last_tx, last_tx_ts, created_at, client_id
-FROM fiat_accounts
-WHERE client_id=$1 AND currency >= $2
+SELECT currency, balance, available, last_tx, last_tx_ts, created_at, client_id, status
+FROM fiat_account_balances
+WHERE client_id=$1 AND currency >= $2 AND status<>'CLOSED'
 ORDER BY currency
 LIMIT $3
 `
@@ -126,23 +153,26 @@ type fiatGetAllAccountsParams struct {
 	Limit    int32     `json:"limit"`
 }
 
-// fiatGetAllAccounts will retrieve all accounts associated with a specific user.
-func (q *Queries) fiatGetAllAccounts(ctx context.Context, arg *fiatGetAllAccountsParams) ([]FiatAccount, error) {
+// fiatGetAllAccounts will retrieve all open accounts associated with a specific user with the ledger and available
+// balances.
+func (q *Queries) fiatGetAllAccounts(ctx context.Context, arg *fiatGetAllAccountsParams) ([]FiatAccountBalance, error) {
 	rows, err := q.db.Query(ctx, fiatGetAllAccounts, arg.ClientID, arg.Currency, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []FiatAccount
+	var items []FiatAccountBalance
 	for rows.Next() {
-		var i FiatAccount
+		var i FiatAccountBalance
 		if err := rows.Scan(
 			&i.Currency,
 			&i.Balance,
+			&i.Available,
 			&i.LastTx,
 			&i.LastTxTs,
 			&i.CreatedAt,
 			&i.ClientID,
+			&i.Status,
 		); err != nil {
 			return nil, err
 		}
@@ -155,7 +185,7 @@ func (q *Queries) fiatGetAllAccounts(ctx context.Context, arg *fiatGetAllAccount
 }
 
 const fiatGetAllJournalTransactionsPaginated = `-- name: fiatGetAllJournalTransactionsPaginated :many
-SELECT currency, amount, transacted_at, client_id, tx_id
+SELECT currency, amount, transacted_at, client_id, tx_id, seq, prev_hash, entry_hash
 FROM fiat_journal
 WHERE client_id = $1
       AND currency = $2
@@ -200,6 +230,9 @@ func (q *Queries) fiatGetAllJournalTransactionsPaginated(ctx context.Context, ar
 			&i.TransactedAt,
 			&i.ClientID,
 			&i.TxID,
+			&i.Seq,
+			&i.PrevHash,
+			&i.EntryHash,
 		); err != nil {
 			return nil, err
 		}
@@ -212,7 +245,7 @@ func (q *Queries) fiatGetAllJournalTransactionsPaginated(ctx context.Context, ar
 }
 
 const fiatGetJournalTransaction = `-- name: fiatGetJournalTransaction :many
-SELECT currency, amount, transacted_at, client_id, tx_id
+SELECT currency, amount, transacted_at, client_id, tx_id, seq, prev_hash, entry_hash
 FROM fiat_journal
 WHERE client_id = $1 AND tx_id = $2
 `
@@ -238,6 +271,9 @@ func (q *Queries) fiatGetJournalTransaction(ctx context.Context, arg *fiatGetJou
 			&i.TransactedAt,
 			&i.ClientID,
 			&i.TxID,
+			&i.Seq,
+			&i.PrevHash,
+			&i.EntryHash,
 		); err != nil {
 			return nil, err
 		}
@@ -250,7 +286,7 @@ func (q *Queries) fiatGetJournalTransaction(ctx context.Context, arg *fiatGetJou
 }
 
 const fiatGetJournalTransactionForAccount = `-- name: fiatGetJournalTransactionForAccount :many
-SELECT currency, amount, transacted_at, client_id, tx_id
+SELECT currency, amount, transacted_at, client_id, tx_id, seq, prev_hash, entry_hash
 FROM fiat_journal
 WHERE client_id = $1 AND currency = $2
 `
@@ -276,6 +312,9 @@ func (q *Queries) fiatGetJournalTransactionForAccount(ctx context.Context, arg *
 			&i.TransactedAt,
 			&i.ClientID,
 			&i.TxID,
+			&i.Seq,
+			&i.PrevHash,
+			&i.EntryHash,
 		); err != nil {
 			return nil, err
 		}
@@ -287,6 +326,91 @@ func (q *Queries) fiatGetJournalTransactionForAccount(ctx context.Context, arg *
 	return items, nil
 }
 
+const fiatHoldCreate = `-- name: fiatHoldCreate :execrows
+INSERT INTO fiat_holds (offer_id, client_id, currency, amount, expires_at)
+VALUES ($1, $2, $3, $5::numeric(18, 2), $4)
+`
+
+type fiatHoldCreateParams struct {
+	OfferID   string             `json:"offerID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Currency  Currency           `json:"currency"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	Amount    decimal.Decimal    `json:"amount"`
+}
+
+// fiatHoldCreate will place a hold on funds in a Fiat account for an exchange offer.
+func (q *Queries) fiatHoldCreate(ctx context.Context, arg *fiatHoldCreateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fiatHoldCreate,
+		arg.OfferID,
+		arg.ClientID,
+		arg.Currency,
+		arg.ExpiresAt,
+		arg.Amount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const fiatHoldPurgeExpired = `-- name: fiatHoldPurgeExpired :execrows
+DELETE FROM fiat_holds
+WHERE client_id=$1 AND currency=$2 AND expires_at<=now()
+`
+
+type fiatHoldPurgeExpiredParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Currency Currency  `json:"currency"`
+}
+
+// fiatHoldPurgeExpired will remove the expired holds on a Fiat account.
+func (q *Queries) fiatHoldPurgeExpired(ctx context.Context, arg *fiatHoldPurgeExpiredParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fiatHoldPurgeExpired, arg.ClientID, arg.Currency)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const fiatHoldRelease = `-- name: fiatHoldRelease :execrows
+DELETE FROM fiat_holds
+WHERE offer_id=$1 AND client_id=$2
+`
+
+type fiatHoldReleaseParams struct {
+	OfferID  string    `json:"offerID"`
+	ClientID uuid.UUID `json:"clientID"`
+}
+
+// fiatHoldRelease will release a hold on a Fiat account for an exchange offer.
+func (q *Queries) fiatHoldRelease(ctx context.Context, arg *fiatHoldReleaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fiatHoldRelease, arg.OfferID, arg.ClientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const fiatHoldTotal = `-- name: fiatHoldTotal :one
+SELECT COALESCE(SUM(amount), 0)::numeric(18, 2) AS held
+FROM fiat_holds
+WHERE client_id=$1 AND currency=$2 AND expires_at>now()
+`
+
+type fiatHoldTotalParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Currency Currency  `json:"currency"`
+}
+
+// fiatHoldTotal will retrieve the total of the funds held in a Fiat account for exchange offers that have not expired.
+func (q *Queries) fiatHoldTotal(ctx context.Context, arg *fiatHoldTotalParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, fiatHoldTotal, arg.ClientID, arg.Currency)
+	var held decimal.Decimal
+	err := row.Scan(&held)
+	return held, err
+}
+
 const fiatInternalTransferJournalEntry = `-- name: fiatInternalTransferJournalEntry :one
 WITH deposit AS (
     INSERT INTO fiat_journal(
@@ -302,6 +426,27 @@ WITH deposit AS (
         now(),
         gen_random_uuid()
     RETURNING tx_id, transacted_at
+), operations AS (
+    INSERT INTO fiat_journal(
+        client_id,
+        currency,
+        amount,
+        transacted_at,
+        tx_id)
+    SELECT
+        (   SELECT client_id
+            FROM users
+            WHERE username = 'fiat-currencies'),
+        legs.currency,
+        SUM(legs.amount),
+        deposit.transacted_at,
+        deposit.tx_id
+    FROM deposit, (
+        VALUES
+            ($5::currency, round_half_even($6::numeric(18, 2), 2)),
+            ($2::currency, round_half_even(-1 * $3::numeric(18, 2), 2))
+        ) AS legs (currency, amount)
+    GROUP BY legs.currency, deposit.transacted_at, deposit.tx_id
 )
 INSERT INTO fiat_journal (
     client_id,
@@ -334,7 +479,8 @@ type fiatInternalTransferJournalEntryRow struct {
 	TransactedAt pgtype.Timestamptz `json:"transactedAt"`
 }
 
-// fiatInternalTransferJournalEntry will create both journal entries for fiat account internal transfers.
+// fiatInternalTransferJournalEntry will create the journal entries for fiat account internal transfers. The transfer is
+// routed through the FTeX Fiat operations account so that the entries balance in each currency.
 func (q *Queries) fiatInternalTransferJournalEntry(ctx context.Context, arg *fiatInternalTransferJournalEntryParams) (fiatInternalTransferJournalEntryRow, error) {
 	row := q.db.QueryRow(ctx, fiatInternalTransferJournalEntry,
 		arg.DestinationAccount,
@@ -350,11 +496,12 @@ func (q *Queries) fiatInternalTransferJournalEntry(ctx context.Context, arg *fia
 }
 
 const fiatRowLockAccount = `-- name: fiatRowLockAccount :one
-SELECT balance
-FROM fiat_accounts
-WHERE client_id=$1 AND currency=$2
+SELECT fa.balance, fa.status, u.is_frozen
+FROM fiat_accounts AS fa
+    INNER JOIN users AS u ON fa.client_id = u.client_id
+WHERE fa.client_id=$1 AND fa.currency=$2
 LIMIT 1
-FOR NO KEY UPDATE
+FOR NO KEY UPDATE OF fa
 `
 
 type fiatRowLockAccountParams struct {
@@ -362,12 +509,40 @@ type fiatRowLockAccountParams struct {
 	Currency Currency  `json:"currency"`
 }
 
-// fiatRowLockAccount will acquire a row level lock without locks on the foreign keys.
-func (q *Queries) fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (decimal.Decimal, error) {
+type fiatRowLockAccountRow struct {
+	Balance  decimal.Decimal `json:"balance"`
+	Status   AccountStatus   `json:"status"`
+	IsFrozen bool            `json:"isFrozen"`
+}
+
+// fiatRowLockAccount will acquire a row level lock without locks on the foreign keys. The account status and whether
+// the client has been suspended are returned alongside the balance.
+func (q *Queries) fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (fiatRowLockAccountRow, error) {
 	row := q.db.QueryRow(ctx, fiatRowLockAccount, arg.ClientID, arg.Currency)
-	var balance decimal.Decimal
-	err := row.Scan(&balance)
-	return balance, err
+	var i fiatRowLockAccountRow
+	err := row.Scan(&i.Balance, &i.Status, &i.IsFrozen)
+	return i, err
+}
+
+const fiatSetAccountStatus = `-- name: fiatSetAccountStatus :execrows
+UPDATE fiat_accounts
+SET status=$3::account_status
+WHERE client_id=$1 AND currency=$2 AND status<>'CLOSED'
+`
+
+type fiatSetAccountStatusParams struct {
+	ClientID uuid.UUID     `json:"clientID"`
+	Currency Currency      `json:"currency"`
+	Status   AccountStatus `json:"status"`
+}
+
+// fiatSetAccountStatus will set the status of a Fiat account that has not been closed.
+func (q *Queries) fiatSetAccountStatus(ctx context.Context, arg *fiatSetAccountStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fiatSetAccountStatus, arg.ClientID, arg.Currency, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const fiatUpdateAccountBalance = `-- name: fiatUpdateAccountBalance :one
@@ -66,49 +66,49 @@ func TestFiat_FiatRowLockAccount(t *testing.T) {
 			name: "Client1 - USD",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client1 - AED",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client1 - CAD",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client2 - USD",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID2,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client2 - AED",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client2 - CAD",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client1 - Not Found",
 			parameter: fiatRowLockAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyEUR,
+				Currency: Currency("EUR"),
 			},
 			errExpected: require.Error,
 		},
@@ -179,7 +179,7 @@ func TestFiat_FiatUpdateAccountBalance(t *testing.T) {
 			expectedTS: amount1Ts,
 			parameter: fiatUpdateAccountBalanceParams{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount1,
 				LastTxTs: ts1,
 			},
@@ -188,7 +188,7 @@ func TestFiat_FiatUpdateAccountBalance(t *testing.T) {
 			expectedTS: amount2Ts,
 			parameter: fiatUpdateAccountBalanceParams{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount2,
 				LastTxTs: ts2,
 			},
@@ -197,7 +197,7 @@ func TestFiat_FiatUpdateAccountBalance(t *testing.T) {
 			expectedTS: amount3Ts,
 			parameter: fiatUpdateAccountBalanceParams{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount3,
 				LastTxTs: ts3,
 			},
@@ -213,7 +213,7 @@ func TestFiat_FiatUpdateAccountBalance(t *testing.T) {
 		test := testCase
 
 		t.Run(fmt.Sprintf("Inserting %s", test.name), func(t *testing.T) {
-			result, err := connection.Query.fiatUpdateAccountBalance(ctx, &test.parameter)
+			result, err := updateTestFiatAccountBalance(ctx, &test.parameter)
 			require.NoError(t, err, "error expectation condition failed.")
 			require.True(t, result.LastTxTs.Valid, "invalid last transaction timestamp.")
 			require.WithinDuration(t, test.expectedTS, result.LastTxTs.Time, time.Second,
@@ -222,7 +222,7 @@ func TestFiat_FiatUpdateAccountBalance(t *testing.T) {
 	}
 
 	// Totals check.
-	result, err := connection.Query.fiatGetAccount(ctx, &fiatGetAccountParams{ClientID: clientID1, Currency: CurrencyUSD})
+	result, err := connection.Query.fiatGetAccount(ctx, &fiatGetAccountParams{ClientID: clientID1, Currency: Currency("USD")})
 	require.NoError(t, err, "failed to retrieve updated balance.")
 	driverValue, err := result.Balance.Value()
 	require.NoError(t, err, "failed to get driver value for total.")
@@ -346,49 +346,49 @@ func TestFiat_FiatGetJournalTransactionForAccount(t *testing.T) {
 			name: "Client1 - USD",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client1 - AED",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client1 - CAD",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client2 - USD",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID2,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client2 - AED",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client2 - CAD",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 			},
 			errExpected: require.NoError,
 		}, {
 			name: "Client1 - Not Found",
 			parameter: fiatGetJournalTransactionForAccountParams{
 				ClientID: clientID1,
-				Currency: CurrencyEUR,
+				Currency: Currency("EUR"),
 			},
 			errExpected: require.NoError,
 		},
@@ -519,37 +519,37 @@ func TestFiat_FiatGetAllAccounts(t *testing.T) {
 	}{
 		{
 			name:           "ClientID 1 - ALL",
-			baseCurrency:   CurrencyAED,
+			baseCurrency:   Currency("AED"),
 			clientID:       clientID1,
 			limitCnt:       3,
 			expectedRowCnt: 3,
 		}, {
 			name:           "ClientID 1 - Limit 1",
-			baseCurrency:   CurrencyAED,
+			baseCurrency:   Currency("AED"),
 			clientID:       clientID1,
 			limitCnt:       1,
 			expectedRowCnt: 1,
 		}, {
 			name:           "ClientID 1 - Base CAD",
-			baseCurrency:   CurrencyCAD,
+			baseCurrency:   Currency("CAD"),
 			clientID:       clientID1,
 			limitCnt:       3,
 			expectedRowCnt: 2,
 		}, {
 			name:           "ClientID 1 - Base USD",
-			baseCurrency:   CurrencyUSD,
+			baseCurrency:   Currency("USD"),
 			clientID:       clientID1,
 			limitCnt:       3,
 			expectedRowCnt: 1,
 		}, {
 			name:           "ClientID 1 - Base ZWD",
-			baseCurrency:   CurrencyZWD,
+			baseCurrency:   Currency("ZWD"),
 			clientID:       clientID1,
 			limitCnt:       3,
 			expectedRowCnt: 0,
 		}, {
 			name:           "Nonexistent",
-			baseCurrency:   CurrencyAED,
+			baseCurrency:   Currency("AED"),
 			clientID:       uuid.UUID{},
 			limitCnt:       3,
 			expectedRowCnt: 0,
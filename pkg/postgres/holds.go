@@ -0,0 +1,384 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// availableBalanceCheck will verify that the available balance, which is the ledger balance net of the unexpired
+// holds, is sufficient to cover a debit.
+func availableBalanceCheck(balance, held, amount decimal.Decimal) error {
+	if available := balance.Sub(held); available.LessThan(amount) {
+		return fmt.Errorf("available balance is %s for debit of %s %w", available, amount, ErrInsufficientFunds)
+	}
+
+	return nil
+}
+
+// fiatAvailableBalanceCheck will release the hold placed on a row locked Fiat account for an offer, if a hold ID is
+// supplied, and then verify that the available balance of the account is sufficient for the debit.
+func fiatAvailableBalanceCheck(
+	ctx context.Context,
+	queryTx Querier,
+	details *FiatTransactionDetails,
+	balance decimal.Decimal) error {
+	var (
+		err  error
+		held decimal.Decimal
+	)
+
+	// Release the hold for the offer being redeemed.
+	if details.HoldID != "" {
+		if _, err = queryTx.fiatHoldRelease(ctx, &fiatHoldReleaseParams{
+			OfferID:  details.HoldID,
+			ClientID: details.ClientID,
+		}); err != nil {
+			return fmt.Errorf("failed to release hold on Fiat account %w", err)
+		}
+	}
+
+	if held, err = queryTx.fiatHoldTotal(ctx, &fiatHoldTotalParams{
+		ClientID: details.ClientID,
+		Currency: details.Currency,
+	}); err != nil {
+		return fmt.Errorf("failed to retrieve holds on Fiat account %w", err)
+	}
+
+	return availableBalanceCheck(balance, held, details.Amount)
+}
+
+// cryptoAvailableBalanceCheck will release the hold placed on a row locked Crypto account for an offer, if a hold ID is
+// supplied, and then verify that the available balance of the account is sufficient for the debit.
+func cryptoAvailableBalanceCheck(
+	ctx context.Context,
+	queryTx Querier,
+	holdID string,
+	clientID uuid.UUID,
+	ticker string,
+	balance,
+	amount decimal.Decimal) error {
+	var (
+		err  error
+		held decimal.Decimal
+	)
+
+	// Release the hold for the offer being redeemed.
+	if holdID != "" {
+		if _, err = queryTx.cryptoHoldRelease(ctx, &cryptoHoldReleaseParams{
+			OfferID:  holdID,
+			ClientID: clientID,
+		}); err != nil {
+			return fmt.Errorf("failed to release hold on Crypto account %w", err)
+		}
+	}
+
+	if held, err = queryTx.cryptoHoldTotal(ctx, &cryptoHoldTotalParams{
+		ClientID: clientID,
+		Ticker:   ticker,
+	}); err != nil {
+		return fmt.Errorf("failed to retrieve holds on Crypto account %w", err)
+	}
+
+	return availableBalanceCheck(balance, held, amount)
+}
+
+// holdErrorMapping will map the errors returned by the hold placement core logic to the errors returned by the
+// interface.
+func holdErrorMapping(err, defaultErr error) error {
+	switch {
+	case errors.Is(err, ErrAccountStatus):
+		return ErrAccountStatus
+	case errors.Is(err, ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return defaultErr
+	}
+}
+
+// FiatHold controls the transaction block that the placement of a hold on a Fiat account executes in.
+func (p *postgresImpl) FiatHold(
+	parentCtx context.Context,
+	holdID string,
+	details *FiatTransactionDetails,
+	expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(parentCtx, constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("hold Fiat transaction block setup failed", zap.Error(err))
+
+		return ErrTransactFiat
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback Fiat account hold transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Configure transaction query connection.
+	queryTx := p.queries.WithTx(tx)
+
+	// Handoff to Fiat hold core logic.
+	if err = fiatHoldPlace(ctx, p.logger, queryTx, holdID, details, expiresAt); err != nil {
+		p.logger.Warn("failed to place hold on Fiat account", zap.Error(err))
+
+		return holdErrorMapping(err, ErrTransactFiat)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit Fiat account hold", zap.Error(err))
+
+		return ErrTransactFiat
+	}
+
+	return nil
+}
+
+// fiatHoldPlace will execute the logic to place a hold on a Fiat account.
+/*
+   [1] Acquire a row lock on the account without holding a lock on the foreign key for the Client ID.
+   [2] Verify that the client has not been suspended and that the account can be debited.
+   [3] Remove the expired holds on the account.
+   [4] Verify that the available balance, net of the unexpired holds, is sufficient for the hold.
+   [5] Place the hold, which expires alongside the offer.
+*/
+func fiatHoldPlace(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	holdID string,
+	details *FiatTransactionDetails,
+	expiresAt time.Time) error {
+	var (
+		err          error
+		held         decimal.Decimal
+		lockRow      fiatRowLockAccountRow
+		rowsAffected int64
+	)
+
+	// Row lock the account.
+	if lockRow, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: details.ClientID,
+		Currency: details.Currency,
+	}); err != nil {
+		msg := "failed to get row lock on Fiat account being held"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check the account can be debited.
+	if err = accountTransactCheck(lockRow.Status, lockRow.IsFrozen, true); err != nil {
+		msg := "Fiat account cannot be held"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Remove the expired holds.
+	if _, err = queryTx.fiatHoldPurgeExpired(ctx, &fiatHoldPurgeExpiredParams{
+		ClientID: details.ClientID,
+		Currency: details.Currency,
+	}); err != nil {
+		msg := "failed to remove expired holds on Fiat account"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check the available balance.
+	if held, err = queryTx.fiatHoldTotal(ctx, &fiatHoldTotalParams{
+		ClientID: details.ClientID,
+		Currency: details.Currency,
+	}); err != nil {
+		msg := "failed to retrieve holds on Fiat account"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if err = availableBalanceCheck(lockRow.Balance, held, details.Amount); err != nil {
+		msg := "insufficient available balance to hold Fiat funds"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Place the hold.
+	if rowsAffected, err = queryTx.fiatHoldCreate(ctx, &fiatHoldCreateParams{
+		OfferID:   holdID,
+		ClientID:  details.ClientID,
+		Currency:  details.Currency,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		Amount:    details.Amount,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to place hold on Fiat account"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// CryptoHold controls the transaction block that the placement of a hold on a Crypto account executes in.
+func (p *postgresImpl) CryptoHold(
+	parentCtx context.Context,
+	holdID string,
+	clientID uuid.UUID,
+	ticker string,
+	amount decimal.Decimal,
+	expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(parentCtx, constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("hold Crypto transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback Crypto account hold transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Configure transaction query connection.
+	queryTx := p.queries.WithTx(tx)
+
+	// Handoff to Crypto hold core logic.
+	if err = cryptoHoldPlace(ctx, p.logger, queryTx, &cryptoHoldCreateParams{
+		OfferID:   holdID,
+		ClientID:  clientID,
+		Ticker:    ticker,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		Amount:    amount,
+	}); err != nil {
+		p.logger.Warn("failed to place hold on Crypto account", zap.Error(err))
+
+		return holdErrorMapping(err, ErrTransactCrypto)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit Crypto account hold", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// cryptoHoldPlace will execute the logic to place a hold on a Crypto account.
+/*
+   [1] Acquire a row lock on the account without holding a lock on the foreign key for the Client ID.
+   [2] Verify that the client has not been suspended and that the account can be debited.
+   [3] Remove the expired holds on the account.
+   [4] Verify that the available balance, net of the unexpired holds, is sufficient for the hold.
+   [5] Place the hold, which expires alongside the offer.
+*/
+func cryptoHoldPlace(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	hold *cryptoHoldCreateParams) error {
+	var (
+		err          error
+		held         decimal.Decimal
+		lockRow      cryptoRowLockAccountRow
+		rowsAffected int64
+	)
+
+	// Row lock the account.
+	if lockRow, err = queryTx.cryptoRowLockAccount(ctx, &cryptoRowLockAccountParams{
+		ClientID: hold.ClientID,
+		Ticker:   hold.Ticker,
+	}); err != nil {
+		msg := "failed to get row lock on Crypto account being held"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check the account can be debited.
+	if err = accountTransactCheck(lockRow.Status, lockRow.IsFrozen, true); err != nil {
+		msg := "Crypto account cannot be held"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Remove the expired holds.
+	if _, err = queryTx.cryptoHoldPurgeExpired(ctx, &cryptoHoldPurgeExpiredParams{
+		ClientID: hold.ClientID,
+		Ticker:   hold.Ticker,
+	}); err != nil {
+		msg := "failed to remove expired holds on Crypto account"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check the available balance.
+	if held, err = queryTx.cryptoHoldTotal(ctx, &cryptoHoldTotalParams{
+		ClientID: hold.ClientID,
+		Ticker:   hold.Ticker,
+	}); err != nil {
+		msg := "failed to retrieve holds on Crypto account"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if err = availableBalanceCheck(lockRow.Balance, held, hold.Amount); err != nil {
+		msg := "insufficient available balance to hold Crypto funds"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Place the hold.
+	if rowsAffected, err = queryTx.cryptoHoldCreate(ctx, hold); err != nil || rowsAffected != int64(1) {
+		msg := "failed to place hold on Crypto account"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
@@ -0,0 +1,546 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHolds_AvailableBalanceCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		balance   decimal.Decimal
+		held      decimal.Decimal
+		amount    decimal.Decimal
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "no holds",
+			balance:   decimal.NewFromFloat(100),
+			held:      decimal.Zero,
+			amount:    decimal.NewFromFloat(100),
+			expectErr: require.NoError,
+		}, {
+			name:      "available balance sufficient",
+			balance:   decimal.NewFromFloat(100),
+			held:      decimal.NewFromFloat(40),
+			amount:    decimal.NewFromFloat(60),
+			expectErr: require.NoError,
+		}, {
+			name:      "available balance insufficient",
+			balance:   decimal.NewFromFloat(100),
+			held:      decimal.NewFromFloat(40),
+			amount:    decimal.NewFromFloat(60.01),
+			expectErr: require.Error,
+		}, {
+			name:      "ledger balance insufficient",
+			balance:   decimal.NewFromFloat(100),
+			held:      decimal.Zero,
+			amount:    decimal.NewFromFloat(100.01),
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := availableBalanceCheck(test.balance, test.held, test.amount)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				require.ErrorIs(t, err, ErrInsufficientFunds, "error mismatch.")
+			}
+		})
+	}
+}
+
+func TestHolds_FiatHold(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	expiresAt := time.Now().Add(time.Minute)
+	held := FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(60)}
+	cadAccount := FiatTransactionDetails{ClientID: clientID1, Currency: "CAD", Amount: decimal.NewFromFloat(81)}
+
+	// Fund the USD account.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(100)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	// Place a hold and check the available balance.
+	require.NoError(t, connection.FiatHold(ctx, "hold-1", &held, expiresAt), "failed to place hold.")
+
+	account, err := connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Balance.Equal(decimal.NewFromFloat(100)), "ledger balance mismatch.")
+	require.True(t, account.Available.Equal(decimal.NewFromFloat(40)), "available balance mismatch.")
+
+	// Holds and debits cannot exceed the available balance.
+	require.ErrorIs(t, connection.FiatHold(ctx, "hold-2", &held, expiresAt), ErrInsufficientFunds,
+		"placed hold exceeding available balance.")
+
+	_, _, err = connection.FiatInternalTransfer(ctx, &held, &cadAccount)
+	require.ErrorIs(t, err, ErrInsufficientFunds, "transferred funds exceeding available balance.")
+
+	// Expired holds do not reduce the available balance.
+	require.NoError(t, connection.FiatHold(ctx, "hold-3",
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(40)},
+		time.Now().Add(-time.Minute)), "failed to place expired hold.")
+
+	account, err = connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Available.Equal(decimal.NewFromFloat(40)), "expired hold reduced available balance.")
+
+	// Redeem the offer the hold was placed for.
+	held.HoldID = "hold-1"
+	_, _, err = connection.FiatInternalTransfer(ctx, &held, &cadAccount)
+	require.NoError(t, err, "failed to redeem held funds.")
+
+	account, err = connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Balance.Equal(decimal.NewFromFloat(40)), "ledger balance mismatch after redemption.")
+	require.True(t, account.Available.Equal(account.Balance), "hold not released after redemption.")
+
+	// Accounts without sufficient funds or that are missing cannot be held.
+	require.ErrorIs(t, connection.FiatHold(ctx, "hold-4", &held, expiresAt), ErrInsufficientFunds,
+		"placed hold exceeding balance.")
+	require.ErrorIs(t, connection.FiatHold(ctx, "hold-5",
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "EUR", Amount: decimal.NewFromFloat(1)}, expiresAt),
+		ErrTransactFiat, "placed hold on missing account.")
+}
+
+func TestHolds_CryptoHold(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	expiresAt := time.Now().Add(time.Minute)
+	fiatAmount := decimal.NewFromFloat(1000)
+	cryptoAmount := decimal.NewFromFloat(1)
+	heldAmount := decimal.NewFromFloat(0.6)
+
+	// Fund the USD account, place a hold, and check the hold is honoured by a purchase.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: fiatAmount})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	require.NoError(t, connection.FiatHold(ctx, "fiat-hold",
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: fiatAmount}, expiresAt),
+		"failed to place Fiat hold.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.ErrorIs(t, err, ErrInsufficientFunds, "purchased with held funds.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "fiat-hold")
+	require.NoError(t, err, "failed to purchase BTC with held funds.")
+
+	// Place a hold and check the available balance.
+	require.NoError(t, connection.CryptoHold(ctx, "crypto-hold", clientID1, "BTC", heldAmount, expiresAt),
+		"failed to place Crypto hold.")
+
+	account, err := connection.CryptoBalance(clientID1, "BTC")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Balance.Equal(cryptoAmount), "ledger balance mismatch.")
+	require.True(t, account.Available.Equal(decimal.NewFromFloat(0.4)), "available balance mismatch.")
+
+	// Holds and sales cannot exceed the available balance.
+	require.ErrorIs(t, connection.CryptoHold(ctx, "crypto-hold-2", clientID1, "BTC", heldAmount, expiresAt),
+		ErrInsufficientFunds, "placed hold exceeding available balance.")
+
+	_, _, err = connection.CryptoSell(clientID1, "USD", fiatAmount, "BTC", heldAmount, "")
+	require.ErrorIs(t, err, ErrInsufficientFunds, "sold funds exceeding available balance.")
+
+	// Redeem the offer the hold was placed for.
+	_, _, err = connection.CryptoSell(clientID1, "USD", fiatAmount, "BTC", heldAmount, "crypto-hold")
+	require.NoError(t, err, "failed to sell held funds.")
+
+	account, err = connection.CryptoBalance(clientID1, "BTC")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Available.Equal(account.Balance), "hold not released after redemption.")
+}
+
+func TestHolds_FiatHoldPlace_Mock(t *testing.T) {
+	t.Parallel()
+
+	active := fiatRowLockAccountRow{Status: AccountStatusACTIVE, Balance: decimal.NewFromFloat(100)}
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		amount        decimal.Decimal
+		rowLockRow    fiatRowLockAccountRow
+		rowLockErr    error
+		purgeErr      error
+		purgeTimes    int
+		held          decimal.Decimal
+		heldErr       error
+		heldTimes     int
+		createRows    int64
+		createErr     error
+		createTimes   int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(10),
+			rowLockRow:    active,
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			purgeErr:      nil,
+			purgeTimes:    0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Frozen account.",
+			expectErr:     require.Error,
+			expectedError: ErrAccountStatus,
+			amount:        decimal.NewFromFloat(10),
+			rowLockRow:    fiatRowLockAccountRow{Status: AccountStatusFROZENDEBITS, Balance: decimal.NewFromFloat(100)},
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Purge failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(10),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      fmt.Errorf("purge failure"),
+			purgeTimes:    1,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Hold total failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(10),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.Zero,
+			heldErr:       fmt.Errorf("hold total failure"),
+			heldTimes:     1,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Insufficient available balance.",
+			expectErr:     require.Error,
+			expectedError: ErrInsufficientFunds,
+			amount:        decimal.NewFromFloat(60),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.NewFromFloat(50),
+			heldErr:       nil,
+			heldTimes:     1,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Create failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(50),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.NewFromFloat(50),
+			heldErr:       nil,
+			heldTimes:     1,
+			createRows:    0,
+			createErr:     fmt.Errorf("create failure"),
+			createTimes:   1,
+		}, {
+			name:          "Valid.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(50),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.NewFromFloat(50),
+			heldErr:       nil,
+			heldTimes:     1,
+			createRows:    1,
+			createErr:     nil,
+			createTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(test.rowLockRow, test.rowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					fiatHoldPurgeExpired(gomock.Any(), gomock.Any()).
+					Return(int64(0), test.purgeErr).
+					Times(test.purgeTimes),
+
+				mockQuerier.EXPECT().
+					fiatHoldTotal(gomock.Any(), gomock.Any()).
+					Return(test.held, test.heldErr).
+					Times(test.heldTimes),
+
+				mockQuerier.EXPECT().
+					fiatHoldCreate(gomock.Any(), gomock.Any()).
+					Return(test.createRows, test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Check for error.
+			err := fiatHoldPlace(context.TODO(), zapLogger, mockQuerier, "hold-id",
+				&FiatTransactionDetails{Currency: "USD", Amount: test.amount}, time.Now().Add(time.Minute))
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
+
+func TestHolds_CryptoHoldPlace_Mock(t *testing.T) {
+	t.Parallel()
+
+	active := cryptoRowLockAccountRow{Status: AccountStatusACTIVE, Balance: decimal.NewFromFloat(1)}
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		amount        decimal.Decimal
+		rowLockRow    cryptoRowLockAccountRow
+		rowLockErr    error
+		purgeErr      error
+		purgeTimes    int
+		held          decimal.Decimal
+		heldErr       error
+		heldTimes     int
+		createRows    int64
+		createErr     error
+		createTimes   int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(0.1),
+			rowLockRow:    active,
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			purgeErr:      nil,
+			purgeTimes:    0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Suspended client.",
+			expectErr:     require.Error,
+			expectedError: ErrAccountStatus,
+			amount:        decimal.NewFromFloat(0.1),
+			rowLockRow:    cryptoRowLockAccountRow{Status: AccountStatusACTIVE, IsFrozen: true},
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Purge failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(0.1),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      fmt.Errorf("purge failure"),
+			purgeTimes:    1,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Hold total failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(0.1),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.Zero,
+			heldErr:       fmt.Errorf("hold total failure"),
+			heldTimes:     1,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Insufficient available balance.",
+			expectErr:     require.Error,
+			expectedError: ErrInsufficientFunds,
+			amount:        decimal.NewFromFloat(0.6),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.NewFromFloat(0.5),
+			heldErr:       nil,
+			heldTimes:     1,
+			createRows:    0,
+			createErr:     nil,
+			createTimes:   0,
+		}, {
+			name:          "Create failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(0.5),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.NewFromFloat(0.5),
+			heldErr:       nil,
+			heldTimes:     1,
+			createRows:    0,
+			createErr:     fmt.Errorf("create failure"),
+			createTimes:   1,
+		}, {
+			name:          "Valid.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			amount:        decimal.NewFromFloat(0.5),
+			rowLockRow:    active,
+			rowLockErr:    nil,
+			purgeErr:      nil,
+			purgeTimes:    1,
+			held:          decimal.NewFromFloat(0.5),
+			heldErr:       nil,
+			heldTimes:     1,
+			createRows:    1,
+			createErr:     nil,
+			createTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					cryptoRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(test.rowLockRow, test.rowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					cryptoHoldPurgeExpired(gomock.Any(), gomock.Any()).
+					Return(int64(0), test.purgeErr).
+					Times(test.purgeTimes),
+
+				mockQuerier.EXPECT().
+					cryptoHoldTotal(gomock.Any(), gomock.Any()).
+					Return(test.held, test.heldErr).
+					Times(test.heldTimes),
+
+				mockQuerier.EXPECT().
+					cryptoHoldCreate(gomock.Any(), gomock.Any()).
+					Return(test.createRows, test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Check for error.
+			err := cryptoHoldPlace(context.TODO(), zapLogger, mockQuerier,
+				&cryptoHoldCreateParams{OfferID: "hold-id", Ticker: "BTC", Amount: test.amount})
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+const (
+	// JournalFiat is the name of the Fiat journal hash chain.
+	JournalFiat = "fiat_journal"
+
+	// JournalCrypto is the name of the Crypto journal hash chain.
+	JournalCrypto = "crypto_journal"
+)
+
+// journalGenesisHash is the previous hash of the first entry in a journal hash chain.
+var journalGenesisHash = make([]byte, 32) //nolint:gochecknoglobals
+
+// JournalChainBreak is the first link in a journal hash chain that failed verification.
+type JournalChainBreak struct {
+	Seq    int64     `json:"seq"`
+	TxID   uuid.UUID `json:"txID"`
+	Reason string    `json:"reason"`
+}
+
+// JournalChainVerification is the outcome of walking a journal hash chain up to its head at the start of the walk.
+// Entries in dropped partitions are not walked, and the chain is anchored on the highest sequence number that was
+// dropped. Entries in remaining partitions that were sequenced before the anchor are not walked either. The checkpoints
+// of entries before the anchor are counted as archived, and are verified against the exported partitions.
+type JournalChainVerification struct {
+	Journal             string             `json:"journal"`
+	AnchorSeq           int64              `json:"anchorSeq"`
+	Entries             int64              `json:"entries"`
+	FirstSeq            int64              `json:"firstSeq"`
+	LastSeq             int64              `json:"lastSeq"`
+	LastHash            string             `json:"lastHash"`
+	Checkpoints         int64              `json:"checkpoints"`
+	ArchivedCheckpoints int64              `json:"archivedCheckpoints"`
+	Intact              bool               `json:"intact"`
+	Break               *JournalChainBreak `json:"break,omitempty"`
+}
+
+// journalChainEntry is a link in a journal hash chain with the hash recomputed from the entry's fields.
+type journalChainEntry struct {
+	Seq          int64
+	TxID         uuid.UUID
+	PrevHash     []byte
+	EntryHash    []byte
+	ComputedHash []byte
+}
+
+// journalChainWalker will verify the links of a journal hash chain in sequence order from its archive anchor.
+type journalChainWalker struct {
+	result      *JournalChainVerification
+	checkpoints map[int64][]byte
+	anchorHash  []byte
+	prevHash    []byte
+}
+
+// newJournalChainWalker will create a walker for a journal hash chain that verifies the entries against checkpoints.
+// The walk starts from the archive anchor of the chain head, which is the genesis hash if no entries have been dropped.
+func newJournalChainWalker(head *JournalChainHead, checkpoints []JournalCheckpoint) *journalChainWalker {
+	walker := &journalChainWalker{
+		result: &JournalChainVerification{
+			Journal:   head.Journal,
+			AnchorSeq: head.AnchorSeq,
+			LastSeq:   head.AnchorSeq,
+			LastHash:  hex.EncodeToString(head.AnchorHash),
+			Intact:    true,
+		},
+		checkpoints: make(map[int64][]byte, len(checkpoints)),
+		anchorHash:  head.AnchorHash,
+		prevHash:    head.AnchorHash,
+	}
+
+	for _, checkpoint := range checkpoints {
+		if checkpoint.Journal == head.Journal {
+			walker.checkpoints[checkpoint.Seq] = checkpoint.EntryHash
+		}
+	}
+
+	return walker
+}
+
+// fail will record the first broken link in the chain.
+func (w *journalChainWalker) fail(seq int64, txID uuid.UUID, reason string) bool {
+	w.result.Intact = false
+	w.result.Break = &JournalChainBreak{Seq: seq, TxID: txID, Reason: reason}
+
+	return false
+}
+
+// step will verify the next entry in the chain. Returns false when a broken link is found.
+func (w *journalChainWalker) step(entry *journalChainEntry) bool {
+	if w.result.Entries == 0 {
+		w.result.FirstSeq = entry.Seq
+
+		if entry.Seq != w.result.AnchorSeq+1 {
+			return w.fail(entry.Seq, entry.TxID, "first entry does not follow the archive anchor")
+		}
+
+		if !bytes.Equal(entry.PrevHash, w.anchorHash) {
+			return w.fail(entry.Seq, entry.TxID, "first entry is not linked to the archive anchor")
+		}
+	} else {
+		if entry.Seq != w.result.LastSeq+1 {
+			return w.fail(entry.Seq, entry.TxID, "entries are missing before this sequence number")
+		}
+
+		if !bytes.Equal(entry.PrevHash, w.prevHash) {
+			return w.fail(entry.Seq, entry.TxID, "previous hash does not match the preceding entry")
+		}
+	}
+
+	if !bytes.Equal(entry.EntryHash, entry.ComputedHash) {
+		return w.fail(entry.Seq, entry.TxID, "entry hash does not match the entry fields")
+	}
+
+	if hash, found := w.checkpoints[entry.Seq]; found {
+		if !bytes.Equal(entry.EntryHash, hash) {
+			return w.fail(entry.Seq, entry.TxID, "entry hash does not match the signed checkpoint")
+		}
+
+		w.result.Checkpoints++
+
+		delete(w.checkpoints, entry.Seq)
+	}
+
+	w.result.Entries++
+	w.result.LastSeq = entry.Seq
+	w.result.LastHash = hex.EncodeToString(entry.EntryHash)
+	w.prevHash = entry.EntryHash
+
+	return true
+}
+
+// finish will verify that the walk ended on the chain head and that every checkpoint that was not reached by the walk
+// is of a dropped entry. A checkpoint of the archive anchor itself must match it.
+func (w *journalChainWalker) finish(head *JournalChainHead) *JournalChainVerification {
+	if !w.result.Intact {
+		return w.result
+	}
+
+	if w.result.LastSeq != head.Seq || !bytes.Equal(w.prevHash, head.EntryHash) {
+		w.fail(head.Seq, uuid.Nil, "last entry does not match the chain head")
+
+		return w.result
+	}
+
+	unreached := make([]int64, 0, len(w.checkpoints))
+	for seq := range w.checkpoints {
+		unreached = append(unreached, seq)
+	}
+
+	sort.Slice(unreached, func(i, j int) bool { return unreached[i] < unreached[j] })
+
+	for _, seq := range unreached {
+		switch {
+		case seq > head.Seq:
+			w.fail(seq, uuid.Nil, "signed checkpoint is beyond the chain head")
+
+			return w.result
+		case seq > w.result.AnchorSeq:
+			w.fail(seq, uuid.Nil, "signed checkpoint was not reached by the walk")
+
+			return w.result
+		case seq == w.result.AnchorSeq:
+			if !bytes.Equal(w.checkpoints[seq], w.anchorHash) {
+				w.fail(seq, uuid.Nil, "signed checkpoint does not match the archive anchor")
+
+				return w.result
+			}
+
+			w.result.Checkpoints++
+		default:
+			w.result.ArchivedCheckpoints++
+		}
+	}
+
+	return w.result
+}
+
+// JournalChainHeads is the interface through which external methods can retrieve the heads of the Fiat and Crypto
+// journal hash chains.
+func (p *postgresImpl) JournalChainHeads() ([]JournalChainHead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	heads, err := p.Query.journalChainHeads(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve journal chain heads", zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	return heads, nil
+}
+
+// JournalChainVerify will walk a journal hash chain from its archive anchor up to its head and verify each link, and
+// the entries recorded in the checkpoints. The first broken link, if any, is reported in the verification.
+func (p *postgresImpl) JournalChainVerify(journal string, checkpoints []JournalCheckpoint) (
+	*JournalChainVerification, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.LedgerTimeout())
+
+	defer cancel()
+
+	heads, err := p.Query.journalChainHeads(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve journal chain heads", zap.Error(err))
+
+		return nil, ErrJournalChain
+	}
+
+	var head *JournalChainHead
+
+	for idx := range heads {
+		if heads[idx].Journal == journal {
+			head = &heads[idx]
+		}
+	}
+
+	if head == nil {
+		return nil, ErrNotFound
+	}
+
+	walker := newJournalChainWalker(head, checkpoints)
+
+	for afterSeq := head.AnchorSeq; afterSeq < head.Seq; {
+		entries, err := p.journalChainPage(ctx, journal, afterSeq, head.Seq)
+		if err != nil {
+			p.logger.Error("failed to retrieve journal chain entries",
+				zap.String("journal", journal), zap.Int64("after_seq", afterSeq), zap.Error(err))
+
+			return nil, ErrJournalChain
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		for idx := range entries {
+			if !walker.step(&entries[idx]) {
+				return walker.result, nil
+			}
+		}
+
+		afterSeq = entries[len(entries)-1].Seq
+	}
+
+	return walker.finish(head), nil
+}
+
+// journalChainPage will retrieve a page of a journal hash chain after a sequence number up to the chain head.
+func (p *postgresImpl) journalChainPage(ctx context.Context, journal string, afterSeq, untilSeq int64) (
+	[]journalChainEntry, error) {
+	var entries []journalChainEntry
+
+	switch journal {
+	case JournalFiat:
+		rows, err := p.Query.fiatJournalChain(ctx, &fiatJournalChainParams{
+			AfterSeq:   afterSeq,
+			UntilSeq:   untilSeq,
+			LimitCount: constants.LedgerVerifyPageSize(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			entries = append(entries, journalChainEntry(row))
+		}
+	case JournalCrypto:
+		rows, err := p.Query.cryptoJournalChain(ctx, &cryptoJournalChainParams{
+			AfterSeq:   afterSeq,
+			UntilSeq:   untilSeq,
+			LimitCount: constants.LedgerVerifyPageSize(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			entries = append(entries, journalChainEntry(row))
+		}
+	default:
+		return nil, ErrNotFound
+	}
+
+	return entries, nil
+}
+
+// JournalCheckpointCreate will record a signed checkpoint of a journal hash chain head. Returns zero if the head has
+// already been checkpointed.
+func (p *postgresImpl) JournalCheckpointCreate(checkpoint *JournalCheckpoint) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.journalCheckpointCreate(ctx, &journalCheckpointCreateParams{
+		Journal:   checkpoint.Journal,
+		Seq:       checkpoint.Seq,
+		EntryHash: checkpoint.EntryHash,
+		KeyID:     checkpoint.KeyID,
+		PublicKey: checkpoint.PublicKey,
+		Signature: checkpoint.Signature,
+		CreatedAt: checkpoint.CreatedAt,
+	})
+	if err != nil {
+		p.logger.Warn("failed to record journal checkpoint",
+			zap.String("journal", checkpoint.Journal), zap.Int64("seq", checkpoint.Seq), zap.Error(err))
+
+		return 0, ErrJournalChain
+	}
+
+	return rows, nil
+}
+
+// JournalCheckpoints is the interface through which external methods can retrieve the signed checkpoints of a journal,
+// or of all journals if none is specified, recorded at or after a point in time.
+func (p *postgresImpl) JournalCheckpoints(journal string, since time.Time) ([]JournalCheckpoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.LedgerTimeout())
+
+	defer cancel()
+
+	checkpoints, err := p.Query.journalCheckpoints(ctx, &journalCheckpointsParams{
+		Journal: journal,
+		Since:   pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve journal checkpoints", zap.String("journal", journal), zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	return checkpoints, nil
+}
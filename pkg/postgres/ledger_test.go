@@ -0,0 +1,336 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// testJournalChain will generate a well-formed journal hash chain starting after a sequence number and hash.
+func testJournalChain(afterSeq int64, prevHash []byte, length int) []journalChainEntry {
+	entries := make([]journalChainEntry, 0, length)
+
+	for idx := 0; idx < length; idx++ {
+		seq := afterSeq + int64(idx) + 1
+		hash := sha256.Sum256(append([]byte{byte(seq)}, prevHash...))
+		entries = append(entries, journalChainEntry{
+			Seq:          seq,
+			TxID:         uuid.Must(uuid.NewV4()),
+			PrevHash:     prevHash,
+			EntryHash:    hash[:],
+			ComputedHash: hash[:],
+		})
+		prevHash = hash[:]
+	}
+
+	return entries
+}
+
+func TestLedger_JournalChainWalker(t *testing.T) {
+	t.Parallel()
+
+	chain := testJournalChain(0, journalGenesisHash, 5)
+	anchored := testJournalChain(4, []byte("archived-entry-hash"), 3)
+	head := &JournalChainHead{
+		Journal: JournalFiat, Seq: 5, EntryHash: chain[4].EntryHash, AnchorHash: journalGenesisHash}
+	anchoredHead := &JournalChainHead{
+		Journal: JournalFiat, Seq: 7, EntryHash: anchored[2].EntryHash, AnchorSeq: 4, AnchorHash: anchored[0].PrevHash}
+
+	testCases := []struct {
+		name           string
+		entries        func() []journalChainEntry
+		head           *JournalChainHead
+		checkpoints    []JournalCheckpoint
+		expectSeq      int64
+		expectCount    int64
+		expectArchived int64
+	}{
+		{
+			name:    "intact from genesis",
+			entries: func() []journalChainEntry { return chain },
+			head:    head,
+			checkpoints: []JournalCheckpoint{
+				{Journal: JournalFiat, Seq: 3, EntryHash: chain[2].EntryHash},
+				{Journal: JournalCrypto, Seq: 3, EntryHash: []byte("other journal")},
+			},
+			expectCount: 1,
+		}, {
+			name:    "intact from archive anchor",
+			entries: func() []journalChainEntry { return anchored },
+			head:    anchoredHead,
+			checkpoints: []JournalCheckpoint{
+				{Journal: JournalFiat, Seq: 2, EntryHash: []byte("archived checkpoint")},
+				{Journal: JournalFiat, Seq: 4, EntryHash: anchored[0].PrevHash},
+				{Journal: JournalFiat, Seq: 6, EntryHash: anchored[1].EntryHash},
+			},
+			expectCount:    2,
+			expectArchived: 1,
+		}, {
+			name:    "empty chain",
+			entries: func() []journalChainEntry { return nil },
+			head: &JournalChainHead{
+				Journal: JournalFiat, Seq: 0, EntryHash: journalGenesisHash, AnchorHash: journalGenesisHash},
+		}, {
+			name:    "all entries archived",
+			entries: func() []journalChainEntry { return nil },
+			head: &JournalChainHead{
+				Journal: JournalFiat, Seq: 4, EntryHash: anchored[0].PrevHash, AnchorSeq: 4, AnchorHash: anchored[0].PrevHash},
+		}, {
+			name:      "not linked to genesis",
+			entries:   func() []journalChainEntry { return testJournalChain(0, []byte("not genesis"), 2) },
+			head:      head,
+			expectSeq: 1,
+		}, {
+			name:      "oldest entries deleted",
+			entries:   func() []journalChainEntry { return chain[2:] },
+			head:      head,
+			expectSeq: 3,
+		}, {
+			name:    "oldest entries deleted without moving the anchor",
+			entries: func() []journalChainEntry { return anchored },
+			head: &JournalChainHead{
+				Journal: JournalFiat, Seq: 7, EntryHash: anchored[2].EntryHash, AnchorHash: journalGenesisHash},
+			checkpoints: []JournalCheckpoint{{Journal: JournalFiat, Seq: 2, EntryHash: []byte("deleted checkpoint")}},
+			expectSeq:   5,
+		}, {
+			name:    "not linked to archive anchor",
+			entries: func() []journalChainEntry { return anchored },
+			head: &JournalChainHead{
+				Journal: JournalFiat, Seq: 7, EntryHash: anchored[2].EntryHash, AnchorSeq: 4, AnchorHash: []byte("forged")},
+			expectSeq: 5,
+		}, {
+			name:        "checkpoint mismatch at archive anchor",
+			entries:     func() []journalChainEntry { return anchored },
+			head:        anchoredHead,
+			checkpoints: []JournalCheckpoint{{Journal: JournalFiat, Seq: 4, EntryHash: []byte("tampered")}},
+			expectSeq:   4,
+		}, {
+			name: "missing entry",
+			entries: func() []journalChainEntry {
+				return append(append([]journalChainEntry{}, chain[:2]...), chain[3:]...)
+			},
+			head:      head,
+			expectSeq: 4,
+		}, {
+			name: "previous hash mismatch",
+			entries: func() []journalChainEntry {
+				entries := append([]journalChainEntry{}, chain...)
+				entries[3].PrevHash = []byte("tampered")
+
+				return entries
+			},
+			head:      head,
+			expectSeq: 4,
+		}, {
+			name: "entry fields altered",
+			entries: func() []journalChainEntry {
+				entries := append([]journalChainEntry{}, chain...)
+				entries[1].ComputedHash = []byte("tampered")
+
+				return entries
+			},
+			head:      head,
+			expectSeq: 2,
+		}, {
+			name:        "checkpoint mismatch",
+			entries:     func() []journalChainEntry { return chain },
+			head:        head,
+			checkpoints: []JournalCheckpoint{{Journal: JournalFiat, Seq: 3, EntryHash: []byte("tampered")}},
+			expectSeq:   3,
+		}, {
+			name:        "checkpoint beyond head",
+			entries:     func() []journalChainEntry { return chain },
+			head:        head,
+			checkpoints: []JournalCheckpoint{{Journal: JournalFiat, Seq: 9, EntryHash: []byte("truncated")}},
+			expectSeq:   9,
+		}, {
+			name:      "truncated tail",
+			entries:   func() []journalChainEntry { return chain[:4] },
+			head:      head,
+			expectSeq: 5,
+		}, {
+			name:      "all entries removed",
+			entries:   func() []journalChainEntry { return nil },
+			head:      head,
+			expectSeq: 5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			walker := newJournalChainWalker(test.head, test.checkpoints)
+
+			for _, entry := range test.entries() {
+				entry := entry
+				if !walker.step(&entry) {
+					break
+				}
+			}
+
+			result := walker.finish(test.head)
+
+			if test.expectSeq == 0 {
+				require.True(t, result.Intact, "chain should be intact.")
+				require.Nil(t, result.Break, "intact chain reported a break.")
+				require.Equal(t, test.head.Seq, result.LastSeq, "last sequence number mismatch.")
+				require.Equal(t, test.expectCount, result.Checkpoints, "verified checkpoints count mismatch.")
+				require.Equal(t, test.expectArchived, result.ArchivedCheckpoints, "archived checkpoints count mismatch.")
+
+				if test.head.Seq > 0 {
+					require.Equal(t, hex.EncodeToString(test.head.EntryHash), result.LastHash, "last hash mismatch.")
+				}
+
+				return
+			}
+
+			require.False(t, result.Intact, "chain should be broken.")
+			require.NotNil(t, result.Break, "broken chain did not report a break.")
+			require.Equal(t, test.expectSeq, result.Break.Seq, "broken link sequence number mismatch.")
+			require.NotEmpty(t, result.Break.Reason, "broken link reason not set.")
+		})
+	}
+}
+
+func TestLedger_JournalChainVerify(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Reset the Fiat journal and its hash chain, since other tests truncate the journal.
+	_, err := connection.pool.Exec(ctx, "TRUNCATE TABLE fiat_journal CASCADE;")
+	require.NoError(t, err, "failed to wipe Fiat journal table.")
+
+	_, err = connection.pool.Exec(ctx,
+		"UPDATE journal_chain_heads SET seq = 0, entry_hash = $1, anchor_seq = 0, anchor_hash = $1 WHERE journal = $2;",
+		journalGenesisHash, JournalFiat)
+	require.NoError(t, err, "failed to reset Fiat journal chain head.")
+
+	_, err = connection.pool.Exec(ctx, "DELETE FROM journal_checkpoints WHERE journal = $1;", JournalFiat)
+	require.NoError(t, err, "failed to wipe Fiat journal checkpoints.")
+
+	// Post entries to the Fiat journal.
+	for idx := 0; idx < 2; idx++ {
+		_, err = connection.FiatExternalTransfer(ctx,
+			&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+		require.NoError(t, err, "failed to deposit into USD account.")
+	}
+
+	heads, err := connection.JournalChainHeads()
+	require.NoError(t, err, "failed to retrieve journal chain heads.")
+	require.Len(t, heads, 2, "journal chain heads count mismatch.")
+
+	var head JournalChainHead
+
+	for _, item := range heads {
+		if item.Journal == JournalFiat {
+			head = item
+		}
+	}
+
+	require.Positive(t, head.Seq, "Fiat journal chain head not advanced.")
+
+	// Verify the chain and record a checkpoint of its head.
+	verification, err := connection.JournalChainVerify(JournalFiat, nil)
+	require.NoError(t, err, "failed to verify Fiat journal chain.")
+	require.True(t, verification.Intact, "Fiat journal chain should be intact.")
+	require.Equal(t, head.Seq, verification.LastSeq, "Fiat journal chain last sequence number mismatch.")
+	require.Equal(t, hex.EncodeToString(head.EntryHash), verification.LastHash, "Fiat journal chain last hash mismatch.")
+
+	checkpoint := &JournalCheckpoint{
+		Journal:   head.Journal,
+		Seq:       head.Seq,
+		EntryHash: head.EntryHash,
+		KeyID:     "v1",
+		PublicKey: []byte("public key"),
+		Signature: []byte("signature"),
+	}
+	checkpoint.CreatedAt.Time = time.Now().UTC()
+	checkpoint.CreatedAt.Valid = true
+
+	rows, err := connection.JournalCheckpointCreate(checkpoint)
+	require.NoError(t, err, "failed to record checkpoint.")
+	require.Equal(t, int64(1), rows, "checkpoint not recorded.")
+
+	rows, err = connection.JournalCheckpointCreate(checkpoint)
+	require.NoError(t, err, "failed to record duplicate checkpoint.")
+	require.Equal(t, int64(0), rows, "duplicate checkpoint recorded.")
+
+	checkpoints, err := connection.JournalCheckpoints(JournalFiat, checkpoint.CreatedAt.Time.Add(-time.Minute))
+	require.NoError(t, err, "failed to retrieve checkpoints.")
+	require.NotEmpty(t, checkpoints, "checkpoints not retrieved.")
+	require.Equal(t, checkpoint.KeyID, checkpoints[len(checkpoints)-1].KeyID, "checkpoint key id mismatch.")
+
+	verification, err = connection.JournalChainVerify(JournalFiat, checkpoints)
+	require.NoError(t, err, "failed to verify Fiat journal chain against checkpoints.")
+	require.True(t, verification.Intact, "Fiat journal chain should be intact against checkpoints.")
+	require.Positive(t, verification.Checkpoints, "no checkpoints verified.")
+
+	// Tamper with the link of the last entry, and then restore it.
+	var prevHash []byte
+
+	require.NoError(t, connection.pool.QueryRow(ctx,
+		"SELECT prev_hash FROM fiat_journal WHERE seq = $1;", head.Seq).Scan(&prevHash), "failed to read link.")
+
+	_, err = connection.pool.Exec(ctx,
+		"UPDATE fiat_journal SET prev_hash = $2 WHERE seq = $1;", head.Seq, journalGenesisHash)
+	require.NoError(t, err, "failed to tamper with link.")
+
+	verification, err = connection.JournalChainVerify(JournalFiat, checkpoints)
+	require.NoError(t, err, "failed to verify tampered Fiat journal chain.")
+	require.False(t, verification.Intact, "tampered Fiat journal chain should be broken.")
+	require.Equal(t, head.Seq, verification.Break.Seq, "broken link sequence number mismatch.")
+
+	_, err = connection.pool.Exec(ctx, "UPDATE fiat_journal SET prev_hash = $2 WHERE seq = $1;", head.Seq, prevHash)
+	require.NoError(t, err, "failed to restore link.")
+
+	// Deleting the oldest entry breaks the chain unless the archive anchor is moved to it, as when it is dropped.
+	var anchorHash []byte
+
+	require.NoError(t, connection.pool.QueryRow(ctx,
+		"SELECT entry_hash FROM fiat_journal WHERE seq = 1;").Scan(&anchorHash), "failed to read oldest entry.")
+
+	_, err = connection.pool.Exec(ctx, "DELETE FROM fiat_journal WHERE seq = 1;")
+	require.NoError(t, err, "failed to delete oldest entry.")
+
+	verification, err = connection.JournalChainVerify(JournalFiat, checkpoints)
+	require.NoError(t, err, "failed to verify Fiat journal chain without its oldest entry.")
+	require.False(t, verification.Intact, "Fiat journal chain without its oldest entry should be broken.")
+	require.Equal(t, int64(2), verification.Break.Seq, "broken link sequence number mismatch.")
+
+	_, err = connection.pool.Exec(ctx,
+		"UPDATE journal_chain_heads SET anchor_seq = 1, anchor_hash = $1 WHERE journal = $2;", anchorHash, JournalFiat)
+	require.NoError(t, err, "failed to move archive anchor.")
+
+	verification, err = connection.JournalChainVerify(JournalFiat, checkpoints)
+	require.NoError(t, err, "failed to verify anchored Fiat journal chain.")
+	require.True(t, verification.Intact, "anchored Fiat journal chain should be intact.")
+	require.Equal(t, int64(1), verification.AnchorSeq, "archive anchor mismatch.")
+	require.Equal(t, int64(2), verification.FirstSeq, "first sequence number mismatch.")
+
+	// Unknown journals cannot be verified.
+	_, err = connection.JournalChainVerify("users", nil)
+	require.ErrorIs(t, err, ErrNotFound, "verified unknown journal.")
+}
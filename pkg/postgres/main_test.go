@@ -59,6 +59,9 @@ func TestMain(m *testing.M) {
 
 // setup will configure the connection to the test database.
 func setup() error {
+	// Populate the Fiat currency cache for unit tests that do not connect to Postgres.
+	SetCurrencyCache(GenerateTestCurrencies())
+
 	if testing.Short() {
 		zapLogger.Warn("Short test: Skipping Postgres integration tests")
 
@@ -176,6 +179,45 @@ func resetTestFiatAccounts(t *testing.T) (uuid.UUID, uuid.UUID) {
 	return clientID1, clientID2
 }
 
+// updateTestFiatAccountBalance will update the balance of a Fiat account in a transaction block alongside the journal
+// entries for a deposit from the FTeX Fiat operations account. Balance updates that are not backed by journal entries
+// are rejected when the transaction is committed.
+func updateTestFiatAccountBalance(ctx context.Context, params *fiatUpdateAccountBalanceParams) (
+	fiatUpdateAccountBalanceRow, error) {
+	var row fiatUpdateAccountBalanceRow
+
+	query := `
+		INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+		SELECT legs.client_id, $2::currency, legs.amount, $4::timestamptz, deposit.tx_id
+		FROM (SELECT gen_random_uuid() AS tx_id) AS deposit, (
+			SELECT $1::uuid AS client_id, round_half_even($3::numeric(18, 2), 2) AS amount
+			UNION ALL
+			SELECT client_id, round_half_even(-1 * $3::numeric(18, 2), 2)
+			FROM users
+			WHERE username = 'fiat-currencies') AS legs;`
+
+	tx, err := connection.pool.Begin(ctx)
+	if err != nil {
+		return row, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err = tx.Exec(ctx, query, params.ClientID, params.Currency, params.Amount, params.LastTxTs); err != nil {
+		return row, fmt.Errorf("failed to insert journal entries: %w", err)
+	}
+
+	if row, err = connection.queries.WithTx(tx).fiatUpdateAccountBalance(ctx, params); err != nil {
+		return row, fmt.Errorf("failed to update balance: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return row, fmt.Errorf("failed to commit balance update: %w", err)
+	}
+
+	return row, nil
+}
+
 // resetTestFiatJournal will reset the fiat journal with base internal and external entries.
 func resetTestFiatJournal(t *testing.T, clientID1, clientID2 uuid.UUID) {
 	t.Helper()
@@ -6,6 +6,7 @@ package postgres
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 
 	"github.com/gofrs/uuid"
@@ -13,381 +14,930 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-type Currency string
+type AccountStatus string
 
 const (
-	CurrencyAED    Currency = "AED"
-	CurrencyAFN    Currency = "AFN"
-	CurrencyALL    Currency = "ALL"
-	CurrencyAMD    Currency = "AMD"
-	CurrencyANG    Currency = "ANG"
-	CurrencyAOA    Currency = "AOA"
-	CurrencyARS    Currency = "ARS"
-	CurrencyAUD    Currency = "AUD"
-	CurrencyAWG    Currency = "AWG"
-	CurrencyAZN    Currency = "AZN"
-	CurrencyBAM    Currency = "BAM"
-	CurrencyBBD    Currency = "BBD"
-	CurrencyBDT    Currency = "BDT"
-	CurrencyBGN    Currency = "BGN"
-	CurrencyBHD    Currency = "BHD"
-	CurrencyBIF    Currency = "BIF"
-	CurrencyBMD    Currency = "BMD"
-	CurrencyBND    Currency = "BND"
-	CurrencyBOB    Currency = "BOB"
-	CurrencyBRL    Currency = "BRL"
-	CurrencyBSD    Currency = "BSD"
-	CurrencyBTN    Currency = "BTN"
-	CurrencyBWP    Currency = "BWP"
-	CurrencyBYN    Currency = "BYN"
-	CurrencyBZD    Currency = "BZD"
-	CurrencyCAD    Currency = "CAD"
-	CurrencyCDF    Currency = "CDF"
-	CurrencyCHF    Currency = "CHF"
-	CurrencyCLP    Currency = "CLP"
-	CurrencyCNY    Currency = "CNY"
-	CurrencyCOP    Currency = "COP"
-	CurrencyCRC    Currency = "CRC"
-	CurrencyCUC    Currency = "CUC"
-	CurrencyCUP    Currency = "CUP"
-	CurrencyCVE    Currency = "CVE"
-	CurrencyCZK    Currency = "CZK"
-	CurrencyDJF    Currency = "DJF"
-	CurrencyDKK    Currency = "DKK"
-	CurrencyDOP    Currency = "DOP"
-	CurrencyDZD    Currency = "DZD"
-	CurrencyEGP    Currency = "EGP"
-	CurrencyERN    Currency = "ERN"
-	CurrencyETB    Currency = "ETB"
-	CurrencyEUR    Currency = "EUR"
-	CurrencyFJD    Currency = "FJD"
-	CurrencyFKP    Currency = "FKP"
-	CurrencyGBP    Currency = "GBP"
-	CurrencyGEL    Currency = "GEL"
-	CurrencyGGP    Currency = "GGP"
-	CurrencyGHS    Currency = "GHS"
-	CurrencyGIP    Currency = "GIP"
-	CurrencyGMD    Currency = "GMD"
-	CurrencyGNF    Currency = "GNF"
-	CurrencyGTQ    Currency = "GTQ"
-	CurrencyGYD    Currency = "GYD"
-	CurrencyHKD    Currency = "HKD"
-	CurrencyHNL    Currency = "HNL"
-	CurrencyHRK    Currency = "HRK"
-	CurrencyHTG    Currency = "HTG"
-	CurrencyHUF    Currency = "HUF"
-	CurrencyIDR    Currency = "IDR"
-	CurrencyILS    Currency = "ILS"
-	CurrencyIMP    Currency = "IMP"
-	CurrencyINR    Currency = "INR"
-	CurrencyIQD    Currency = "IQD"
-	CurrencyIRR    Currency = "IRR"
-	CurrencyISK    Currency = "ISK"
-	CurrencyJEP    Currency = "JEP"
-	CurrencyJMD    Currency = "JMD"
-	CurrencyJOD    Currency = "JOD"
-	CurrencyJPY    Currency = "JPY"
-	CurrencyKES    Currency = "KES"
-	CurrencyKGS    Currency = "KGS"
-	CurrencyKHR    Currency = "KHR"
-	CurrencyKMF    Currency = "KMF"
-	CurrencyKPW    Currency = "KPW"
-	CurrencyKRW    Currency = "KRW"
-	CurrencyKWD    Currency = "KWD"
-	CurrencyKYD    Currency = "KYD"
-	CurrencyKZT    Currency = "KZT"
-	CurrencyLAK    Currency = "LAK"
-	CurrencyLBP    Currency = "LBP"
-	CurrencyLKR    Currency = "LKR"
-	CurrencyLRD    Currency = "LRD"
-	CurrencyLSL    Currency = "LSL"
-	CurrencyLYD    Currency = "LYD"
-	CurrencyMAD    Currency = "MAD"
-	CurrencyMDL    Currency = "MDL"
-	CurrencyMGA    Currency = "MGA"
-	CurrencyMKD    Currency = "MKD"
-	CurrencyMMK    Currency = "MMK"
-	CurrencyMNT    Currency = "MNT"
-	CurrencyMOP    Currency = "MOP"
-	CurrencyMRU    Currency = "MRU"
-	CurrencyMUR    Currency = "MUR"
-	CurrencyMVR    Currency = "MVR"
-	CurrencyMWK    Currency = "MWK"
-	CurrencyMXN    Currency = "MXN"
-	CurrencyMYR    Currency = "MYR"
-	CurrencyMZN    Currency = "MZN"
-	CurrencyNAD    Currency = "NAD"
-	CurrencyNGN    Currency = "NGN"
-	CurrencyNIO    Currency = "NIO"
-	CurrencyNOK    Currency = "NOK"
-	CurrencyNPR    Currency = "NPR"
-	CurrencyNZD    Currency = "NZD"
-	CurrencyOMR    Currency = "OMR"
-	CurrencyPAB    Currency = "PAB"
-	CurrencyPEN    Currency = "PEN"
-	CurrencyPGK    Currency = "PGK"
-	CurrencyPHP    Currency = "PHP"
-	CurrencyPKR    Currency = "PKR"
-	CurrencyPLN    Currency = "PLN"
-	CurrencyPYG    Currency = "PYG"
-	CurrencyQAR    Currency = "QAR"
-	CurrencyRON    Currency = "RON"
-	CurrencyRSD    Currency = "RSD"
-	CurrencyRUB    Currency = "RUB"
-	CurrencyRWF    Currency = "RWF"
-	CurrencySAR    Currency = "SAR"
-	CurrencySBD    Currency = "SBD"
-	CurrencySCR    Currency = "SCR"
-	CurrencySDG    Currency = "SDG"
-	CurrencySEK    Currency = "SEK"
-	CurrencySGD    Currency = "SGD"
-	CurrencySHP    Currency = "SHP"
-	CurrencySLL    Currency = "SLL"
-	CurrencySOS    Currency = "SOS"
-	CurrencySPL    Currency = "SPL"
-	CurrencySRD    Currency = "SRD"
-	CurrencySTN    Currency = "STN"
-	CurrencySVC    Currency = "SVC"
-	CurrencySYP    Currency = "SYP"
-	CurrencySZL    Currency = "SZL"
-	CurrencyTHB    Currency = "THB"
-	CurrencyTJS    Currency = "TJS"
-	CurrencyTMT    Currency = "TMT"
-	CurrencyTND    Currency = "TND"
-	CurrencyTOP    Currency = "TOP"
-	CurrencyTRY    Currency = "TRY"
-	CurrencyTTD    Currency = "TTD"
-	CurrencyTVD    Currency = "TVD"
-	CurrencyTWD    Currency = "TWD"
-	CurrencyTZS    Currency = "TZS"
-	CurrencyUAH    Currency = "UAH"
-	CurrencyUGX    Currency = "UGX"
-	CurrencyUSD    Currency = "USD"
-	CurrencyUYU    Currency = "UYU"
-	CurrencyUZS    Currency = "UZS"
-	CurrencyVEF    Currency = "VEF"
-	CurrencyVND    Currency = "VND"
-	CurrencyVUV    Currency = "VUV"
-	CurrencyWST    Currency = "WST"
-	CurrencyXAF    Currency = "XAF"
-	CurrencyXCD    Currency = "XCD"
-	CurrencyXDR    Currency = "XDR"
-	CurrencyXOF    Currency = "XOF"
-	CurrencyXPF    Currency = "XPF"
-	CurrencyYER    Currency = "YER"
-	CurrencyZAR    Currency = "ZAR"
-	CurrencyZMW    Currency = "ZMW"
-	CurrencyZWD    Currency = "ZWD"
-	CurrencyFIAT   Currency = "FIAT"
-	CurrencyCRYPTO Currency = "CRYPTO"
+	AccountStatusACTIVE       AccountStatus = "ACTIVE"
+	AccountStatusFROZENDEBITS AccountStatus = "FROZEN_DEBITS"
+	AccountStatusFROZEN       AccountStatus = "FROZEN"
+	AccountStatusCLOSED       AccountStatus = "CLOSED"
 )
 
-func (e *Currency) Scan(src interface{}) error {
+func (e *AccountStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = Currency(s)
+		*e = AccountStatus(s)
 	case string:
-		*e = Currency(s)
+		*e = AccountStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for Currency: %T", src)
+		return fmt.Errorf("unsupported scan type for AccountStatus: %T", src)
 	}
 	return nil
 }
 
-type NullCurrency struct {
-	Currency Currency
-	Valid    bool // Valid is true if Currency is not NULL
+type NullAccountStatus struct {
+	AccountStatus AccountStatus
+	Valid         bool // Valid is true if AccountStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullCurrency) Scan(value interface{}) error {
+func (ns *NullAccountStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.Currency, ns.Valid = "", false
+		ns.AccountStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.Currency.Scan(value)
+	return ns.AccountStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullCurrency) Value() (driver.Value, error) {
+func (ns NullAccountStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.Currency), nil
+	return string(ns.AccountStatus), nil
 }
 
-func (e Currency) Valid() bool {
+func (e AccountStatus) Valid() bool {
 	switch e {
-	case CurrencyAED,
-		CurrencyAFN,
-		CurrencyALL,
-		CurrencyAMD,
-		CurrencyANG,
-		CurrencyAOA,
-		CurrencyARS,
-		CurrencyAUD,
-		CurrencyAWG,
-		CurrencyAZN,
-		CurrencyBAM,
-		CurrencyBBD,
-		CurrencyBDT,
-		CurrencyBGN,
-		CurrencyBHD,
-		CurrencyBIF,
-		CurrencyBMD,
-		CurrencyBND,
-		CurrencyBOB,
-		CurrencyBRL,
-		CurrencyBSD,
-		CurrencyBTN,
-		CurrencyBWP,
-		CurrencyBYN,
-		CurrencyBZD,
-		CurrencyCAD,
-		CurrencyCDF,
-		CurrencyCHF,
-		CurrencyCLP,
-		CurrencyCNY,
-		CurrencyCOP,
-		CurrencyCRC,
-		CurrencyCUC,
-		CurrencyCUP,
-		CurrencyCVE,
-		CurrencyCZK,
-		CurrencyDJF,
-		CurrencyDKK,
-		CurrencyDOP,
-		CurrencyDZD,
-		CurrencyEGP,
-		CurrencyERN,
-		CurrencyETB,
-		CurrencyEUR,
-		CurrencyFJD,
-		CurrencyFKP,
-		CurrencyGBP,
-		CurrencyGEL,
-		CurrencyGGP,
-		CurrencyGHS,
-		CurrencyGIP,
-		CurrencyGMD,
-		CurrencyGNF,
-		CurrencyGTQ,
-		CurrencyGYD,
-		CurrencyHKD,
-		CurrencyHNL,
-		CurrencyHRK,
-		CurrencyHTG,
-		CurrencyHUF,
-		CurrencyIDR,
-		CurrencyILS,
-		CurrencyIMP,
-		CurrencyINR,
-		CurrencyIQD,
-		CurrencyIRR,
-		CurrencyISK,
-		CurrencyJEP,
-		CurrencyJMD,
-		CurrencyJOD,
-		CurrencyJPY,
-		CurrencyKES,
-		CurrencyKGS,
-		CurrencyKHR,
-		CurrencyKMF,
-		CurrencyKPW,
-		CurrencyKRW,
-		CurrencyKWD,
-		CurrencyKYD,
-		CurrencyKZT,
-		CurrencyLAK,
-		CurrencyLBP,
-		CurrencyLKR,
-		CurrencyLRD,
-		CurrencyLSL,
-		CurrencyLYD,
-		CurrencyMAD,
-		CurrencyMDL,
-		CurrencyMGA,
-		CurrencyMKD,
-		CurrencyMMK,
-		CurrencyMNT,
-		CurrencyMOP,
-		CurrencyMRU,
-		CurrencyMUR,
-		CurrencyMVR,
-		CurrencyMWK,
-		CurrencyMXN,
-		CurrencyMYR,
-		CurrencyMZN,
-		CurrencyNAD,
-		CurrencyNGN,
-		CurrencyNIO,
-		CurrencyNOK,
-		CurrencyNPR,
-		CurrencyNZD,
-		CurrencyOMR,
-		CurrencyPAB,
-		CurrencyPEN,
-		CurrencyPGK,
-		CurrencyPHP,
-		CurrencyPKR,
-		CurrencyPLN,
-		CurrencyPYG,
-		CurrencyQAR,
-		CurrencyRON,
-		CurrencyRSD,
-		CurrencyRUB,
-		CurrencyRWF,
-		CurrencySAR,
-		CurrencySBD,
-		CurrencySCR,
-		CurrencySDG,
-		CurrencySEK,
-		CurrencySGD,
-		CurrencySHP,
-		CurrencySLL,
-		CurrencySOS,
-		CurrencySPL,
-		CurrencySRD,
-		CurrencySTN,
-		CurrencySVC,
-		CurrencySYP,
-		CurrencySZL,
-		CurrencyTHB,
-		CurrencyTJS,
-		CurrencyTMT,
-		CurrencyTND,
-		CurrencyTOP,
-		CurrencyTRY,
-		CurrencyTTD,
-		CurrencyTVD,
-		CurrencyTWD,
-		CurrencyTZS,
-		CurrencyUAH,
-		CurrencyUGX,
-		CurrencyUSD,
-		CurrencyUYU,
-		CurrencyUZS,
-		CurrencyVEF,
-		CurrencyVND,
-		CurrencyVUV,
-		CurrencyWST,
-		CurrencyXAF,
-		CurrencyXCD,
-		CurrencyXDR,
-		CurrencyXOF,
-		CurrencyXPF,
-		CurrencyYER,
-		CurrencyZAR,
-		CurrencyZMW,
-		CurrencyZWD,
-		CurrencyFIAT,
-		CurrencyCRYPTO:
+	case AccountStatusACTIVE,
+		AccountStatusFROZENDEBITS,
+		AccountStatusFROZEN,
+		AccountStatusCLOSED:
 		return true
 	}
 	return false
 }
 
+type AdjustmentReason string
+
+const (
+	AdjustmentReasonGOODWILL        AdjustmentReason = "GOODWILL"
+	AdjustmentReasonFEECORRECTION   AdjustmentReason = "FEE_CORRECTION"
+	AdjustmentReasonERRORCORRECTION AdjustmentReason = "ERROR_CORRECTION"
+	AdjustmentReasonOTHER           AdjustmentReason = "OTHER"
+)
+
+func (e *AdjustmentReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AdjustmentReason(s)
+	case string:
+		*e = AdjustmentReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AdjustmentReason: %T", src)
+	}
+	return nil
+}
+
+type NullAdjustmentReason struct {
+	AdjustmentReason AdjustmentReason
+	Valid            bool // Valid is true if AdjustmentReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAdjustmentReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.AdjustmentReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AdjustmentReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAdjustmentReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AdjustmentReason), nil
+}
+
+func (e AdjustmentReason) Valid() bool {
+	switch e {
+	case AdjustmentReasonGOODWILL,
+		AdjustmentReasonFEECORRECTION,
+		AdjustmentReasonERRORCORRECTION,
+		AdjustmentReasonOTHER:
+		return true
+	}
+	return false
+}
+
+type AdjustmentStatus string
+
+const (
+	AdjustmentStatusPENDING  AdjustmentStatus = "PENDING"
+	AdjustmentStatusAPPROVED AdjustmentStatus = "APPROVED"
+	AdjustmentStatusREJECTED AdjustmentStatus = "REJECTED"
+)
+
+func (e *AdjustmentStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AdjustmentStatus(s)
+	case string:
+		*e = AdjustmentStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AdjustmentStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAdjustmentStatus struct {
+	AdjustmentStatus AdjustmentStatus
+	Valid            bool // Valid is true if AdjustmentStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAdjustmentStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AdjustmentStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AdjustmentStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAdjustmentStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AdjustmentStatus), nil
+}
+
+func (e AdjustmentStatus) Valid() bool {
+	switch e {
+	case AdjustmentStatusPENDING,
+		AdjustmentStatusAPPROVED,
+		AdjustmentStatusREJECTED:
+		return true
+	}
+	return false
+}
+
+type AdminAction string
+
+const (
+	AdminActionUSERSEARCH           AdminAction = "USER_SEARCH"
+	AdminActionUSERVIEW             AdminAction = "USER_VIEW"
+	AdminActionUSERFREEZE           AdminAction = "USER_FREEZE"
+	AdminActionUSERUNFREEZE         AdminAction = "USER_UNFREEZE"
+	AdminActionFIATACCOUNTVIEW      AdminAction = "FIAT_ACCOUNT_VIEW"
+	AdminActionFIATJOURNALVIEW      AdminAction = "FIAT_JOURNAL_VIEW"
+	AdminActionCRYPTOACCOUNTVIEW    AdminAction = "CRYPTO_ACCOUNT_VIEW"
+	AdminActionCRYPTOJOURNALVIEW    AdminAction = "CRYPTO_JOURNAL_VIEW"
+	AdminActionCRYPTOASSETUPSERT    AdminAction = "CRYPTO_ASSET_UPSERT"
+	AdminActionCRYPTOASSETSTATUS    AdminAction = "CRYPTO_ASSET_STATUS"
+	AdminActionFIATCURRENCYUPSERT   AdminAction = "FIAT_CURRENCY_UPSERT"
+	AdminActionAUDITLOGVIEW         AdminAction = "AUDIT_LOG_VIEW"
+	AdminActionFIATACCOUNTSTATUS    AdminAction = "FIAT_ACCOUNT_STATUS"
+	AdminActionCRYPTOACCOUNTSTATUS  AdminAction = "CRYPTO_ACCOUNT_STATUS"
+	AdminActionLEDGERVERIFY         AdminAction = "LEDGER_VERIFY"
+	AdminActionLEDGERCHECKPOINTVIEW AdminAction = "LEDGER_CHECKPOINT_VIEW"
+	AdminActionTRANSACTIONREVERSE   AdminAction = "TRANSACTION_REVERSE"
+	AdminActionADJUSTMENTCREATE     AdminAction = "ADJUSTMENT_CREATE"
+	AdminActionADJUSTMENTAPPROVE    AdminAction = "ADJUSTMENT_APPROVE"
+	AdminActionADJUSTMENTREJECT     AdminAction = "ADJUSTMENT_REJECT"
+	AdminActionADJUSTMENTVIEW       AdminAction = "ADJUSTMENT_VIEW"
+	AdminActionLOGINLOCKOUTVIEW     AdminAction = "LOGIN_LOCKOUT_VIEW"
+	AdminActionUSERUNLOCK           AdminAction = "USER_UNLOCK"
+	AdminActionAUDITEVENTVIEW       AdminAction = "AUDIT_EVENT_VIEW"
+)
+
+func (e *AdminAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AdminAction(s)
+	case string:
+		*e = AdminAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AdminAction: %T", src)
+	}
+	return nil
+}
+
+type NullAdminAction struct {
+	AdminAction AdminAction
+	Valid       bool // Valid is true if AdminAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAdminAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.AdminAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AdminAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAdminAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AdminAction), nil
+}
+
+func (e AdminAction) Valid() bool {
+	switch e {
+	case AdminActionUSERSEARCH,
+		AdminActionUSERVIEW,
+		AdminActionUSERFREEZE,
+		AdminActionUSERUNFREEZE,
+		AdminActionFIATACCOUNTVIEW,
+		AdminActionFIATJOURNALVIEW,
+		AdminActionCRYPTOACCOUNTVIEW,
+		AdminActionCRYPTOJOURNALVIEW,
+		AdminActionCRYPTOASSETUPSERT,
+		AdminActionCRYPTOASSETSTATUS,
+		AdminActionFIATCURRENCYUPSERT,
+		AdminActionAUDITLOGVIEW,
+		AdminActionFIATACCOUNTSTATUS,
+		AdminActionCRYPTOACCOUNTSTATUS,
+		AdminActionLEDGERVERIFY,
+		AdminActionLEDGERCHECKPOINTVIEW,
+		AdminActionTRANSACTIONREVERSE,
+		AdminActionADJUSTMENTCREATE,
+		AdminActionADJUSTMENTAPPROVE,
+		AdminActionADJUSTMENTREJECT,
+		AdminActionADJUSTMENTVIEW,
+		AdminActionLOGINLOCKOUTVIEW,
+		AdminActionUSERUNLOCK,
+		AdminActionAUDITEVENTVIEW:
+		return true
+	}
+	return false
+}
+
+type AuditEventType string
+
+const (
+	AuditEventTypeLOGIN              AuditEventType = "LOGIN"
+	AuditEventTypeTOKENREFRESH       AuditEventType = "TOKEN_REFRESH"
+	AuditEventTypePASSWORDCHANGE     AuditEventType = "PASSWORD_CHANGE"
+	AuditEventTypePASSWORDRESET      AuditEventType = "PASSWORD_RESET"
+	AuditEventTypeACCOUNTDELETE      AuditEventType = "ACCOUNT_DELETE"
+	AuditEventTypeADMINACTION        AuditEventType = "ADMIN_ACTION"
+	AuditEventTypeFIATDEPOSIT        AuditEventType = "FIAT_DEPOSIT"
+	AuditEventTypeFIATEXCHANGE       AuditEventType = "FIAT_EXCHANGE"
+	AuditEventTypeFIATACCOUNTCLOSE   AuditEventType = "FIAT_ACCOUNT_CLOSE"
+	AuditEventTypeCRYPTOEXCHANGE     AuditEventType = "CRYPTO_EXCHANGE"
+	AuditEventTypeCRYPTOACCOUNTCLOSE AuditEventType = "CRYPTO_ACCOUNT_CLOSE"
+)
+
+func (e *AuditEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditEventType(s)
+	case string:
+		*e = AuditEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditEventType: %T", src)
+	}
+	return nil
+}
+
+type NullAuditEventType struct {
+	AuditEventType AuditEventType
+	Valid          bool // Valid is true if AuditEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditEventType), nil
+}
+
+func (e AuditEventType) Valid() bool {
+	switch e {
+	case AuditEventTypeLOGIN,
+		AuditEventTypeTOKENREFRESH,
+		AuditEventTypePASSWORDCHANGE,
+		AuditEventTypePASSWORDRESET,
+		AuditEventTypeACCOUNTDELETE,
+		AuditEventTypeADMINACTION,
+		AuditEventTypeFIATDEPOSIT,
+		AuditEventTypeFIATEXCHANGE,
+		AuditEventTypeFIATACCOUNTCLOSE,
+		AuditEventTypeCRYPTOEXCHANGE,
+		AuditEventTypeCRYPTOACCOUNTCLOSE:
+		return true
+	}
+	return false
+}
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSUCCESS AuditOutcome = "SUCCESS"
+	AuditOutcomeFAILURE AuditOutcome = "FAILURE"
+)
+
+func (e *AuditOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditOutcome(s)
+	case string:
+		*e = AuditOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullAuditOutcome struct {
+	AuditOutcome AuditOutcome
+	Valid        bool // Valid is true if AuditOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditOutcome), nil
+}
+
+func (e AuditOutcome) Valid() bool {
+	switch e {
+	case AuditOutcomeSUCCESS,
+		AuditOutcomeFAILURE:
+		return true
+	}
+	return false
+}
+
+type CryptoAssetStatus string
+
+const (
+	CryptoAssetStatusENABLED CryptoAssetStatus = "ENABLED"
+	CryptoAssetStatusHALTED  CryptoAssetStatus = "HALTED"
+)
+
+func (e *CryptoAssetStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CryptoAssetStatus(s)
+	case string:
+		*e = CryptoAssetStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CryptoAssetStatus: %T", src)
+	}
+	return nil
+}
+
+type NullCryptoAssetStatus struct {
+	CryptoAssetStatus CryptoAssetStatus
+	Valid             bool // Valid is true if CryptoAssetStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCryptoAssetStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.CryptoAssetStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CryptoAssetStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCryptoAssetStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CryptoAssetStatus), nil
+}
+
+func (e CryptoAssetStatus) Valid() bool {
+	switch e {
+	case CryptoAssetStatusENABLED,
+		CryptoAssetStatusHALTED:
+		return true
+	}
+	return false
+}
+
+type FiatCurrencyStatus string
+
+const (
+	FiatCurrencyStatusACTIVE     FiatCurrencyStatus = "ACTIVE"
+	FiatCurrencyStatusDEPRECATED FiatCurrencyStatus = "DEPRECATED"
+	FiatCurrencyStatusWITHDRAWN  FiatCurrencyStatus = "WITHDRAWN"
+	FiatCurrencyStatusINTERNAL   FiatCurrencyStatus = "INTERNAL"
+)
+
+func (e *FiatCurrencyStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FiatCurrencyStatus(s)
+	case string:
+		*e = FiatCurrencyStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FiatCurrencyStatus: %T", src)
+	}
+	return nil
+}
+
+type NullFiatCurrencyStatus struct {
+	FiatCurrencyStatus FiatCurrencyStatus
+	Valid              bool // Valid is true if FiatCurrencyStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFiatCurrencyStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.FiatCurrencyStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FiatCurrencyStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFiatCurrencyStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FiatCurrencyStatus), nil
+}
+
+func (e FiatCurrencyStatus) Valid() bool {
+	switch e {
+	case FiatCurrencyStatusACTIVE,
+		FiatCurrencyStatusDEPRECATED,
+		FiatCurrencyStatusWITHDRAWN,
+		FiatCurrencyStatusINTERNAL:
+		return true
+	}
+	return false
+}
+
+type LimitOrderStatus string
+
+const (
+	LimitOrderStatusOPEN      LimitOrderStatus = "OPEN"
+	LimitOrderStatusFILLED    LimitOrderStatus = "FILLED"
+	LimitOrderStatusCANCELLED LimitOrderStatus = "CANCELLED"
+	LimitOrderStatusEXPIRED   LimitOrderStatus = "EXPIRED"
+	LimitOrderStatusFAILED    LimitOrderStatus = "FAILED"
+)
+
+func (e *LimitOrderStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LimitOrderStatus(s)
+	case string:
+		*e = LimitOrderStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LimitOrderStatus: %T", src)
+	}
+	return nil
+}
+
+type NullLimitOrderStatus struct {
+	LimitOrderStatus LimitOrderStatus
+	Valid            bool // Valid is true if LimitOrderStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLimitOrderStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.LimitOrderStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LimitOrderStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLimitOrderStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LimitOrderStatus), nil
+}
+
+func (e LimitOrderStatus) Valid() bool {
+	switch e {
+	case LimitOrderStatusOPEN,
+		LimitOrderStatusFILLED,
+		LimitOrderStatusCANCELLED,
+		LimitOrderStatusEXPIRED,
+		LimitOrderStatusFAILED:
+		return true
+	}
+	return false
+}
+
+type LockoutScope string
+
+const (
+	LockoutScopeUSERNAME  LockoutScope = "USERNAME"
+	LockoutScopeIPADDRESS LockoutScope = "IP_ADDRESS"
+)
+
+func (e *LockoutScope) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LockoutScope(s)
+	case string:
+		*e = LockoutScope(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LockoutScope: %T", src)
+	}
+	return nil
+}
+
+type NullLockoutScope struct {
+	LockoutScope LockoutScope
+	Valid        bool // Valid is true if LockoutScope is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLockoutScope) Scan(value interface{}) error {
+	if value == nil {
+		ns.LockoutScope, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LockoutScope.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLockoutScope) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LockoutScope), nil
+}
+
+func (e LockoutScope) Valid() bool {
+	switch e {
+	case LockoutScopeUSERNAME,
+		LockoutScopeIPADDRESS:
+		return true
+	}
+	return false
+}
+
+type RecurringPurchaseCadence string
+
+const (
+	RecurringPurchaseCadenceDAILY    RecurringPurchaseCadence = "DAILY"
+	RecurringPurchaseCadenceWEEKLY   RecurringPurchaseCadence = "WEEKLY"
+	RecurringPurchaseCadenceBIWEEKLY RecurringPurchaseCadence = "BIWEEKLY"
+	RecurringPurchaseCadenceMONTHLY  RecurringPurchaseCadence = "MONTHLY"
+)
+
+func (e *RecurringPurchaseCadence) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecurringPurchaseCadence(s)
+	case string:
+		*e = RecurringPurchaseCadence(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecurringPurchaseCadence: %T", src)
+	}
+	return nil
+}
+
+type NullRecurringPurchaseCadence struct {
+	RecurringPurchaseCadence RecurringPurchaseCadence
+	Valid                    bool // Valid is true if RecurringPurchaseCadence is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecurringPurchaseCadence) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecurringPurchaseCadence, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecurringPurchaseCadence.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecurringPurchaseCadence) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecurringPurchaseCadence), nil
+}
+
+func (e RecurringPurchaseCadence) Valid() bool {
+	switch e {
+	case RecurringPurchaseCadenceDAILY,
+		RecurringPurchaseCadenceWEEKLY,
+		RecurringPurchaseCadenceBIWEEKLY,
+		RecurringPurchaseCadenceMONTHLY:
+		return true
+	}
+	return false
+}
+
+type RecurringPurchaseRunStatus string
+
+const (
+	RecurringPurchaseRunStatusEXECUTED          RecurringPurchaseRunStatus = "EXECUTED"
+	RecurringPurchaseRunStatusINSUFFICIENTFUNDS RecurringPurchaseRunStatus = "INSUFFICIENT_FUNDS"
+	RecurringPurchaseRunStatusSKIPPED           RecurringPurchaseRunStatus = "SKIPPED"
+	RecurringPurchaseRunStatusFAILED            RecurringPurchaseRunStatus = "FAILED"
+)
+
+func (e *RecurringPurchaseRunStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecurringPurchaseRunStatus(s)
+	case string:
+		*e = RecurringPurchaseRunStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecurringPurchaseRunStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRecurringPurchaseRunStatus struct {
+	RecurringPurchaseRunStatus RecurringPurchaseRunStatus
+	Valid                      bool // Valid is true if RecurringPurchaseRunStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecurringPurchaseRunStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecurringPurchaseRunStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecurringPurchaseRunStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecurringPurchaseRunStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecurringPurchaseRunStatus), nil
+}
+
+func (e RecurringPurchaseRunStatus) Valid() bool {
+	switch e {
+	case RecurringPurchaseRunStatusEXECUTED,
+		RecurringPurchaseRunStatusINSUFFICIENTFUNDS,
+		RecurringPurchaseRunStatusSKIPPED,
+		RecurringPurchaseRunStatusFAILED:
+		return true
+	}
+	return false
+}
+
+type RecurringPurchaseStatus string
+
+const (
+	RecurringPurchaseStatusACTIVE    RecurringPurchaseStatus = "ACTIVE"
+	RecurringPurchaseStatusCANCELLED RecurringPurchaseStatus = "CANCELLED"
+	RecurringPurchaseStatusCOMPLETED RecurringPurchaseStatus = "COMPLETED"
+)
+
+func (e *RecurringPurchaseStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecurringPurchaseStatus(s)
+	case string:
+		*e = RecurringPurchaseStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecurringPurchaseStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRecurringPurchaseStatus struct {
+	RecurringPurchaseStatus RecurringPurchaseStatus
+	Valid                   bool // Valid is true if RecurringPurchaseStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecurringPurchaseStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecurringPurchaseStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecurringPurchaseStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecurringPurchaseStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecurringPurchaseStatus), nil
+}
+
+func (e RecurringPurchaseStatus) Valid() bool {
+	switch e {
+	case RecurringPurchaseStatusACTIVE,
+		RecurringPurchaseStatusCANCELLED,
+		RecurringPurchaseStatusCOMPLETED:
+		return true
+	}
+	return false
+}
+
+type TriggerOrderStatus string
+
+const (
+	TriggerOrderStatusACTIVE    TriggerOrderStatus = "ACTIVE"
+	TriggerOrderStatusTRIGGERED TriggerOrderStatus = "TRIGGERED"
+	TriggerOrderStatusCANCELLED TriggerOrderStatus = "CANCELLED"
+	TriggerOrderStatusFAILED    TriggerOrderStatus = "FAILED"
+)
+
+func (e *TriggerOrderStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TriggerOrderStatus(s)
+	case string:
+		*e = TriggerOrderStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TriggerOrderStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTriggerOrderStatus struct {
+	TriggerOrderStatus TriggerOrderStatus
+	Valid              bool // Valid is true if TriggerOrderStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTriggerOrderStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TriggerOrderStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TriggerOrderStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTriggerOrderStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TriggerOrderStatus), nil
+}
+
+func (e TriggerOrderStatus) Valid() bool {
+	switch e {
+	case TriggerOrderStatusACTIVE,
+		TriggerOrderStatusTRIGGERED,
+		TriggerOrderStatusCANCELLED,
+		TriggerOrderStatusFAILED:
+		return true
+	}
+	return false
+}
+
+type TriggerOrderType string
+
+const (
+	TriggerOrderTypeSTOPLOSS   TriggerOrderType = "STOP_LOSS"
+	TriggerOrderTypeTAKEPROFIT TriggerOrderType = "TAKE_PROFIT"
+)
+
+func (e *TriggerOrderType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TriggerOrderType(s)
+	case string:
+		*e = TriggerOrderType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TriggerOrderType: %T", src)
+	}
+	return nil
+}
+
+type NullTriggerOrderType struct {
+	TriggerOrderType TriggerOrderType
+	Valid            bool // Valid is true if TriggerOrderType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTriggerOrderType) Scan(value interface{}) error {
+	if value == nil {
+		ns.TriggerOrderType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TriggerOrderType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTriggerOrderType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TriggerOrderType), nil
+}
+
+func (e TriggerOrderType) Valid() bool {
+	switch e {
+	case TriggerOrderTypeSTOPLOSS,
+		TriggerOrderTypeTAKEPROFIT:
+		return true
+	}
+	return false
+}
+
+type UserRole string
+
+const (
+	UserRoleUSER    UserRole = "USER"
+	UserRoleSUPPORT UserRole = "SUPPORT"
+	UserRoleADMIN   UserRole = "ADMIN"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole
+	Valid    bool // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
+func (e UserRole) Valid() bool {
+	switch e {
+	case UserRoleUSER,
+		UserRoleSUPPORT,
+		UserRoleADMIN:
+		return true
+	}
+	return false
+}
+
+type AdminAuditLog struct {
+	ID        int64              `json:"id"`
+	AdminID   uuid.UUID          `json:"adminID"`
+	Action    AdminAction        `json:"action"`
+	Target    string             `json:"target"`
+	Details   json.RawMessage    `json:"details"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	Outcome   *AuditOutcome      `json:"outcome"`
+}
+
 type CryptoAccount struct {
 	Ticker    string             `json:"ticker"`
 	Balance   decimal.Decimal    `json:"balance"`
@@ -395,6 +945,122 @@ type CryptoAccount struct {
 	LastTxTs  pgtype.Timestamptz `json:"lastTxTs"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	ClientID  uuid.UUID          `json:"clientID"`
+	Status    AccountStatus      `json:"status"`
+}
+
+type CryptoAccountBalance struct {
+	Ticker    string             `json:"ticker"`
+	Balance   decimal.Decimal    `json:"balance"`
+	Available decimal.Decimal    `json:"available"`
+	LastTx    decimal.Decimal    `json:"lastTx"`
+	LastTxTs  pgtype.Timestamptz `json:"lastTxTs"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Status    AccountStatus      `json:"status"`
+}
+
+type CryptoBalanceSnapshot struct {
+	ClientID   uuid.UUID          `json:"clientID"`
+	Ticker     string             `json:"ticker"`
+	SnapshotAt pgtype.Timestamptz `json:"snapshotAt"`
+	Balance    decimal.Decimal    `json:"balance"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+type CryptoAsset struct {
+	Ticker        string             `json:"ticker"`
+	Name          string             `json:"name"`
+	DecimalPlaces int32              `json:"decimalPlaces"`
+	Status        CryptoAssetStatus  `json:"status"`
+	MinOrder      decimal.Decimal    `json:"minOrder"`
+	MaxOrder      decimal.Decimal    `json:"maxOrder"`
+	UpdatedAt     pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type CryptoHold struct {
+	OfferID   string             `json:"offerID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Ticker    string             `json:"ticker"`
+	Amount    decimal.Decimal    `json:"amount"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type CryptoLimitOrder struct {
+	OrderID      string             `json:"orderID"`
+	ClientID     uuid.UUID          `json:"clientID"`
+	FiatCurrency Currency           `json:"fiatCurrency"`
+	Ticker       string             `json:"ticker"`
+	IsPurchase   bool               `json:"isPurchase"`
+	Amount       decimal.Decimal    `json:"amount"`
+	LimitPrice   decimal.Decimal    `json:"limitPrice"`
+	Status       LimitOrderStatus   `json:"status"`
+	TxID         pgtype.UUID        `json:"txID"`
+	ExpiresAt    pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type CryptoLimitOrderEvent struct {
+	ID        int64              `json:"id"`
+	OrderID   string             `json:"orderID"`
+	Status    LimitOrderStatus   `json:"status"`
+	Price     decimal.Decimal    `json:"price"`
+	Details   string             `json:"details"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type CryptoTriggerOrder struct {
+	OrderID          string             `json:"orderID"`
+	ClientID         uuid.UUID          `json:"clientID"`
+	FiatCurrency     Currency           `json:"fiatCurrency"`
+	Ticker           string             `json:"ticker"`
+	TriggerType      TriggerOrderType   `json:"triggerType"`
+	TriggerPrice     decimal.Decimal    `json:"triggerPrice"`
+	TrailingDistance decimal.Decimal    `json:"trailingDistance"`
+	Amount           decimal.Decimal    `json:"amount"`
+	Status           TriggerOrderStatus `json:"status"`
+	TxID             pgtype.UUID        `json:"txID"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type CryptoTriggerOrderEvent struct {
+	ID        int64              `json:"id"`
+	OrderID   string             `json:"orderID"`
+	Status    TriggerOrderStatus `json:"status"`
+	Price     decimal.Decimal    `json:"price"`
+	Amount    decimal.Decimal    `json:"amount"`
+	Details   string             `json:"details"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type CryptoRecurringPurchase struct {
+	PlanID       string                   `json:"planID"`
+	ClientID     uuid.UUID                `json:"clientID"`
+	FiatCurrency Currency                 `json:"fiatCurrency"`
+	Ticker       string                   `json:"ticker"`
+	Amount       decimal.Decimal          `json:"amount"`
+	Cadence      RecurringPurchaseCadence `json:"cadence"`
+	StartsAt     pgtype.Timestamptz       `json:"startsAt"`
+	EndsAt       pgtype.Timestamptz       `json:"endsAt"`
+	NextRunAt    pgtype.Timestamptz       `json:"nextRunAt"`
+	Status       RecurringPurchaseStatus  `json:"status"`
+	CreatedAt    pgtype.Timestamptz       `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz       `json:"updatedAt"`
+}
+
+type CryptoRecurringPurchaseRun struct {
+	ID           int64                      `json:"id"`
+	PlanID       string                     `json:"planID"`
+	ScheduledAt  pgtype.Timestamptz         `json:"scheduledAt"`
+	Status       RecurringPurchaseRunStatus `json:"status"`
+	Price        decimal.Decimal            `json:"price"`
+	FiatAmount   decimal.Decimal            `json:"fiatAmount"`
+	CryptoAmount decimal.Decimal            `json:"cryptoAmount"`
+	TxID         pgtype.UUID                `json:"txID"`
+	Details      string                     `json:"details"`
+	CreatedAt    pgtype.Timestamptz         `json:"createdAt"`
 }
 
 type CryptoJournal struct {
@@ -403,6 +1069,9 @@ type CryptoJournal struct {
 	TransactedAt pgtype.Timestamptz `json:"transactedAt"`
 	ClientID     uuid.UUID          `json:"clientID"`
 	TxID         uuid.UUID          `json:"txID"`
+	Seq          int64              `json:"seq"`
+	PrevHash     []byte             `json:"prevHash"`
+	EntryHash    []byte             `json:"entryHash"`
 }
 
 type FiatAccount struct {
@@ -412,6 +1081,57 @@ type FiatAccount struct {
 	LastTxTs  pgtype.Timestamptz `json:"lastTxTs"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	ClientID  uuid.UUID          `json:"clientID"`
+	Status    AccountStatus      `json:"status"`
+}
+
+type FiatAccountBalance struct {
+	Currency  Currency           `json:"currency"`
+	Balance   decimal.Decimal    `json:"balance"`
+	Available decimal.Decimal    `json:"available"`
+	LastTx    decimal.Decimal    `json:"lastTx"`
+	LastTxTs  pgtype.Timestamptz `json:"lastTxTs"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Status    AccountStatus      `json:"status"`
+}
+
+type FiatAdjustment struct {
+	AdjustmentID  string             `json:"adjustmentID"`
+	ClientID      uuid.UUID          `json:"clientID"`
+	Currency      Currency           `json:"currency"`
+	Amount        decimal.Decimal    `json:"amount"`
+	ReasonCode    AdjustmentReason   `json:"reasonCode"`
+	Justification string             `json:"justification"`
+	Status        AdjustmentStatus   `json:"status"`
+	MakerID       uuid.UUID          `json:"makerID"`
+	CheckerID     pgtype.UUID        `json:"checkerID"`
+	DecisionNote  string             `json:"decisionNote"`
+	TxID          pgtype.UUID        `json:"txID"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+	DecidedAt     pgtype.Timestamptz `json:"decidedAt"`
+}
+
+type FiatBalanceSnapshot struct {
+	ClientID   uuid.UUID          `json:"clientID"`
+	Currency   Currency           `json:"currency"`
+	SnapshotAt pgtype.Timestamptz `json:"snapshotAt"`
+	Balance    decimal.Decimal    `json:"balance"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+}
+
+type FiatCurrency struct {
+	Code      string             `json:"code"`
+	Status    FiatCurrencyStatus `json:"status"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type FiatHold struct {
+	OfferID   string             `json:"offerID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Currency  Currency           `json:"currency"`
+	Amount    decimal.Decimal    `json:"amount"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 }
 
 type FiatJournal struct {
@@ -420,14 +1140,105 @@ type FiatJournal struct {
 	TransactedAt pgtype.Timestamptz `json:"transactedAt"`
 	ClientID     uuid.UUID          `json:"clientID"`
 	TxID         uuid.UUID          `json:"txID"`
+	Seq          int64              `json:"seq"`
+	PrevHash     []byte             `json:"prevHash"`
+	EntryHash    []byte             `json:"entryHash"`
+}
+
+type JournalChainHead struct {
+	Journal    string `json:"journal"`
+	Seq        int64  `json:"seq"`
+	EntryHash  []byte `json:"entryHash"`
+	AnchorSeq  int64  `json:"anchorSeq"`
+	AnchorHash []byte `json:"anchorHash"`
+}
+
+type JournalCheckpoint struct {
+	ID        int64              `json:"id"`
+	Journal   string             `json:"journal"`
+	Seq       int64              `json:"seq"`
+	EntryHash []byte             `json:"entryHash"`
+	KeyID     string             `json:"keyID"`
+	PublicKey []byte             `json:"publicKey"`
+	Signature []byte             `json:"signature"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type JournalReversal struct {
+	TxID         uuid.UUID          `json:"txID"`
+	ReversalTxID uuid.UUID          `json:"reversalTxID"`
+	AdminID      uuid.UUID          `json:"adminID"`
+	Reason       string             `json:"reason"`
+	ReversedAt   pgtype.Timestamptz `json:"reversedAt"`
 }
 
 type User struct {
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
-	Email     string    `json:"email"`
-	Username  string    `json:"username"`
-	Password  string    `json:"password"`
-	ClientID  uuid.UUID `json:"clientID"`
-	IsDeleted bool      `json:"isDeleted"`
+	FirstName     string    `json:"firstName"`
+	LastName      string    `json:"lastName"`
+	Email         string    `json:"email"`
+	Username      string    `json:"username"`
+	Password      string    `json:"password"`
+	ClientID      uuid.UUID `json:"clientID"`
+	IsDeleted     bool      `json:"isDeleted"`
+	Role          UserRole  `json:"role"`
+	IsFrozen      bool      `json:"isFrozen"`
+	EmailVerified bool      `json:"emailVerified"`
+}
+
+type APIKey struct {
+	KeyID      string             `json:"keyID"`
+	ClientID   uuid.UUID          `json:"clientID"`
+	Name       string             `json:"name"`
+	Secret     string             `json:"secret"`
+	Scopes     []string           `json:"scopes"`
+	AllowedIps []string           `json:"allowedIps"`
+	ExpiresAt  pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+	RevokedAt  pgtype.Timestamptz `json:"revokedAt"`
+}
+
+type RefreshToken struct {
+	TokenHash    string             `json:"tokenHash"`
+	SessionID    string             `json:"sessionID"`
+	ClientID     uuid.UUID          `json:"clientID"`
+	Device       string             `json:"device"`
+	IpAddress    string             `json:"ipAddress"`
+	SessionStart pgtype.Timestamptz `json:"sessionStart"`
+	ExpiresAt    pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	RotatedAt    pgtype.Timestamptz `json:"rotatedAt"`
+	RevokedAt    pgtype.Timestamptz `json:"revokedAt"`
+}
+
+type UserMFA struct {
+	ClientID      uuid.UUID          `json:"clientID"`
+	Secret        string             `json:"secret"`
+	LastStep      int64              `json:"lastStep"`
+	RecoveryCodes []string           `json:"recoveryCodes"`
+	EnrolledAt    pgtype.Timestamptz `json:"enrolledAt"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+}
+
+type LoginLockout struct {
+	LockoutID  int64              `json:"lockoutID"`
+	Scope      LockoutScope       `json:"scope"`
+	Subject    string             `json:"subject"`
+	Failures   int64              `json:"failures"`
+	IpAddress  string             `json:"ipAddress"`
+	LockedAt   pgtype.Timestamptz `json:"lockedAt"`
+	ExpiresAt  pgtype.Timestamptz `json:"expiresAt"`
+	UnlockedBy pgtype.UUID        `json:"unlockedBy"`
+	UnlockedAt pgtype.Timestamptz `json:"unlockedAt"`
+}
+
+type AuditEvent struct {
+	EventID   int64              `json:"eventID"`
+	EventType AuditEventType     `json:"eventType"`
+	Outcome   AuditOutcome       `json:"outcome"`
+	ActorID   pgtype.UUID        `json:"actorID"`
+	SubjectID pgtype.UUID        `json:"subjectID"`
+	IpAddress string             `json:"ipAddress"`
+	UserAgent string             `json:"userAgent"`
+	Payload   json.RawMessage    `json:"payload"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 }
@@ -6,95 +6,99 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestModels_CurrencyScan(t *testing.T) {
-	t.Run("Byte Array", func(t *testing.T) {
-		var curr Currency
-		err := curr.Scan([]byte(CurrencyUSD))
-		require.NoError(t, err, "valid byte array")
-	})
-
-	t.Run("String", func(t *testing.T) {
-		var curr Currency
-		err := curr.Scan("USD")
-		require.NoError(t, err, "valid string")
-	})
-
-	t.Run("Valid string, invalid currency", func(t *testing.T) {
-		var curr Currency
-		err := curr.Scan("UVW")
-		require.NoError(t, err, "valid string")
-	})
-
-	t.Run("Invalid Type", func(t *testing.T) {
-		var curr Currency
-		err := curr.Scan(123)
-		require.Error(t, err, "invalid type")
-	})
-}
-
-func TestModels_CurrencyValid(t *testing.T) {
+func TestModels_AccountStatusValid(t *testing.T) {
 	testCases := []struct {
 		name        string
-		currency    Currency
+		status      AccountStatus
 		errExpected require.BoolAssertionFunc
 	}{
 		{
-			name:        "Valid - USD",
-			currency:    CurrencyUSD,
+			name:        "Valid - ACTIVE",
+			status:      AccountStatusACTIVE,
 			errExpected: require.True,
 		},
 		{
-			name:        "Valid - FIAT",
-			currency:    CurrencyFIAT,
+			name:        "Valid - FROZEN_DEBITS",
+			status:      AccountStatusFROZENDEBITS,
 			errExpected: require.True,
 		},
 		{
-			name:        "Valid - CRYPTO",
-			currency:    CurrencyCRYPTO,
+			name:        "Valid - CLOSED",
+			status:      AccountStatusCLOSED,
 			errExpected: require.True,
 		},
 		{
 			name:        "Invalid",
-			currency:    "XYZ",
+			status:      "SUSPENDED",
 			errExpected: require.False,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.errExpected(t, testCase.currency.Valid(), "expected error condition failed.")
+			testCase.errExpected(t, testCase.status.Valid(), "expected error condition failed.")
 		})
 	}
 }
 
-func TestModels_NullCurrencyScan(t *testing.T) {
+func TestModels_FiatCurrencyStatusValid(t *testing.T) {
+	testCases := []struct {
+		name        string
+		status      FiatCurrencyStatus
+		errExpected require.BoolAssertionFunc
+	}{
+		{
+			name:        "Valid - ACTIVE",
+			status:      FiatCurrencyStatusACTIVE,
+			errExpected: require.True,
+		},
+		{
+			name:        "Valid - WITHDRAWN",
+			status:      FiatCurrencyStatusWITHDRAWN,
+			errExpected: require.True,
+		},
+		{
+			name:        "Invalid",
+			status:      "RETIRED",
+			errExpected: require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.errExpected(t, testCase.status.Valid(), "expected error condition failed.")
+		})
+	}
+}
+
+func TestModels_NullFiatCurrencyStatusScan(t *testing.T) {
 	testCases := []struct {
 		name         string
-		nullCurr     any
+		nullStatus   any
 		errExpected  require.ErrorAssertionFunc
 		boolExpected require.BoolAssertionFunc
 	}{
 		{
 			name:         "nil",
-			nullCurr:     nil,
+			nullStatus:   nil,
 			errExpected:  require.NoError,
 			boolExpected: require.False,
 		},
 		{
 			name:         "Invalid",
-			nullCurr:     123,
+			nullStatus:   123,
 			errExpected:  require.Error,
 			boolExpected: require.True,
 		},
 		{
 			name:         "Valid - Byte Array",
-			nullCurr:     []byte("USD"),
+			nullStatus:   []byte("ACTIVE"),
 			errExpected:  require.NoError,
 			boolExpected: require.True,
 		},
 		{
 			name:         "Valid - String",
-			nullCurr:     "USD",
+			nullStatus:   "DEPRECATED",
 			errExpected:  require.NoError,
 			boolExpected: require.True,
 		},
@@ -102,37 +106,37 @@ func TestModels_NullCurrencyScan(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			var ns NullCurrency
-			testCase.errExpected(t, ns.Scan(testCase.nullCurr), "expected error condition failed.")
+			var ns NullFiatCurrencyStatus
+			testCase.errExpected(t, ns.Scan(testCase.nullStatus), "expected error condition failed.")
 			testCase.boolExpected(t, ns.Valid, "expected validity condition failed.")
 		})
 	}
 }
 
-func TestModels_NullCurrencyValue(t *testing.T) {
+func TestModels_NullFiatCurrencyStatusValue(t *testing.T) {
 	testCases := []struct {
 		name        string
 		driverValue string
-		nullCurr    NullCurrency
+		nullStatus  NullFiatCurrencyStatus
 		errExpected require.ErrorAssertionFunc
 		nilExpected require.ValueAssertionFunc
 	}{
 		{
 			name:        "Invalid",
 			driverValue: "",
-			nullCurr: NullCurrency{
-				Currency: "invalid",
-				Valid:    false,
+			nullStatus: NullFiatCurrencyStatus{
+				FiatCurrencyStatus: "invalid",
+				Valid:              false,
 			},
 			errExpected: require.NoError,
 			nilExpected: require.Nil,
 		},
 		{
 			name:        "Valid",
-			driverValue: "FIAT",
-			nullCurr: NullCurrency{
-				Currency: CurrencyFIAT,
-				Valid:    true,
+			driverValue: "INTERNAL",
+			nullStatus: NullFiatCurrencyStatus{
+				FiatCurrencyStatus: FiatCurrencyStatusINTERNAL,
+				Valid:              true,
 			},
 			errExpected: require.NoError,
 			nilExpected: require.NotNil,
@@ -141,15 +145,45 @@ func TestModels_NullCurrencyValue(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			driver, err := testCase.nullCurr.Value()
+			driver, err := testCase.nullStatus.Value()
 			testCase.errExpected(t, err, "expected error condition failed.")
 			testCase.nilExpected(t, driver, "nil driver value expectation failed.")
 			if driver == nil {
 				return
 			}
-			curr, ok := driver.(string)
+			status, ok := driver.(string)
 			require.True(t, ok, "driver cast to string failed.")
-			require.Equal(t, testCase.driverValue, curr, "incorrect driver value.")
+			require.Equal(t, testCase.driverValue, status, "incorrect driver value.")
+		})
+	}
+}
+
+func TestModels_UserRoleValid(t *testing.T) {
+	testCases := []struct {
+		name        string
+		role        UserRole
+		errExpected require.BoolAssertionFunc
+	}{
+		{
+			name:        "Valid - USER",
+			role:        UserRoleUSER,
+			errExpected: require.True,
+		},
+		{
+			name:        "Valid - ADMIN",
+			role:        UserRoleADMIN,
+			errExpected: require.True,
+		},
+		{
+			name:        "Invalid",
+			role:        "ROOT",
+			errExpected: require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.errExpected(t, testCase.role.Valid(), "expected error condition failed.")
 		})
 	}
 }
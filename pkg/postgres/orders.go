@@ -0,0 +1,500 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LimitOrderCreate controls the transaction block that the placement of a Cryptocurrency limit order executes in.
+func (p *postgresImpl) LimitOrderCreate(order *CryptoLimitOrder, holdAmount decimal.Decimal) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("limit order placement transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback limit order placement transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to limit order placement core logic.
+	if err = limitOrderPlace(ctx, p.logger, p.queries.WithTx(tx), order, holdAmount); err != nil {
+		p.logger.Warn("failed to place limit order", zap.Error(err))
+
+		return holdErrorMapping(err, ErrTransactCrypto)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit limit order placement", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// limitOrderPlace will execute the logic to place a Cryptocurrency limit order.
+/*
+   [1] Place a hold on the source account for the lifetime of the order using the order ID as the hold ID. Purchases
+       hold the Fiat funds required at the limit price and sales hold the Cryptocurrency being sold.
+   [2] Create the order.
+   [3] Record the opening of the order in its state history.
+*/
+func limitOrderPlace(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	order *CryptoLimitOrder,
+	holdAmount decimal.Decimal) error {
+	var (
+		err          error
+		rowsAffected int64
+	)
+
+	// Reserve the source funds.
+	if order.IsPurchase {
+		err = fiatHoldPlace(ctx, logger, queryTx, order.OrderID, &FiatTransactionDetails{
+			ClientID: order.ClientID,
+			Currency: order.FiatCurrency,
+			Amount:   holdAmount,
+		}, order.ExpiresAt.Time)
+	} else {
+		err = cryptoHoldPlace(ctx, logger, queryTx, &cryptoHoldCreateParams{
+			OfferID:   order.OrderID,
+			ClientID:  order.ClientID,
+			Ticker:    order.Ticker,
+			ExpiresAt: order.ExpiresAt,
+			Amount:    holdAmount,
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to reserve funds for limit order", err)
+	}
+
+	// Create the order.
+	if rowsAffected, err = queryTx.limitOrderCreate(ctx, &limitOrderCreateParams{
+		OrderID:      order.OrderID,
+		ClientID:     order.ClientID,
+		FiatCurrency: order.FiatCurrency,
+		Ticker:       order.Ticker,
+		IsPurchase:   order.IsPurchase,
+		Amount:       order.Amount,
+		LimitPrice:   order.LimitPrice,
+		ExpiresAt:    order.ExpiresAt,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to create limit order"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	// Record the order state.
+	if rowsAffected, err = queryTx.limitOrderEventCreate(ctx, &limitOrderEventCreateParams{
+		OrderID: order.OrderID,
+		Status:  LimitOrderStatusOPEN,
+		Price:   order.LimitPrice,
+		Details: "order placed",
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to record limit order placement"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// LimitOrderClose controls the transaction block that the closure of an open Cryptocurrency limit order without
+// execution executes in.
+func (p *postgresImpl) LimitOrderClose(
+	clientID uuid.UUID,
+	orderID string,
+	status LimitOrderStatus,
+	details string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("limit order closure transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback limit order closure transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to limit order closure core logic.
+	if err = limitOrderClose(ctx, p.logger, p.queries.WithTx(tx), clientID, orderID, status, details); err != nil {
+		p.logger.Warn("failed to close limit order", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return ErrNotFound
+		case errors.Is(err, ErrLimitOrderClosed):
+			return ErrLimitOrderClosed
+		default:
+			return ErrTransactCrypto
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit limit order closure", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// limitOrderClose will execute the logic to close an open Cryptocurrency limit order without executing it.
+/*
+   [1] Acquire a row lock on the order without holding a lock on the foreign keys.
+   [2] Verify that the order is still open.
+   [3] Release the hold placed on the source account for the order.
+   [4] Close the order with the supplied status and record the change in its state history.
+*/
+func limitOrderClose(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	clientID uuid.UUID,
+	orderID string,
+	status LimitOrderStatus,
+	details string) error {
+	var (
+		err   error
+		order CryptoLimitOrder
+	)
+
+	// Row lock the order.
+	if order, err = queryTx.limitOrderRowLock(ctx, &limitOrderRowLockParams{
+		ClientID: clientID,
+		OrderID:  orderID,
+	}); err != nil {
+		msg := "failed to get row lock on limit order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, ErrNotFound)
+	}
+
+	if order.Status != LimitOrderStatusOPEN {
+		return fmt.Errorf("limit order is %s %w", order.Status, ErrLimitOrderClosed)
+	}
+
+	// Release the hold.
+	if order.IsPurchase {
+		_, err = queryTx.fiatHoldRelease(ctx, &fiatHoldReleaseParams{OfferID: orderID, ClientID: clientID})
+	} else {
+		_, err = queryTx.cryptoHoldRelease(ctx, &cryptoHoldReleaseParams{OfferID: orderID, ClientID: clientID})
+	}
+
+	if err != nil {
+		msg := "failed to release hold for limit order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return limitOrderSetStatus(ctx, logger, queryTx, &order, status, pgtype.UUID{}, decimal.Zero, details)
+}
+
+// LimitOrderFill controls the transaction block that the execution of an open Cryptocurrency limit order executes in.
+func (p *postgresImpl) LimitOrderFill(
+	order *CryptoLimitOrder,
+	fiatAmount decimal.Decimal,
+	price decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var tx pgx.Tx
+
+	txID, err := uuid.NewV4()
+	if err != nil {
+		p.logger.Error("failed to generate transaction id for limit order", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("limit order fill transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback limit order fill transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to limit order fill core logic.
+	if err = limitOrderFill(ctx, p.logger, p.queries.WithTx(tx), txID, order, fiatAmount, price); err != nil {
+		p.logger.Warn("failed to fill limit order", zap.Error(err))
+
+		if errors.Is(err, ErrLimitOrderClosed) {
+			return nil, nil, ErrLimitOrderClosed
+		}
+
+		return nil, nil, cryptoTransactionErrorMapping(err)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit limit order fill", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	fiatJournal, err := p.Query.fiatGetJournalTransaction(ctx, &fiatGetJournalTransactionParams{
+		ClientID: order.ClientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Fiat transaction details post limit order fill", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	cryptoJournal, err := p.Query.cryptoGetJournalTransaction(ctx, &cryptoGetJournalTransactionParams{
+		ClientID: order.ClientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Crypto transaction details post limit order fill", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	return &fiatJournal[0], &cryptoJournal[0], nil
+}
+
+// limitOrderFill will execute the logic to fill an open Cryptocurrency limit order.
+/*
+   [1] Acquire a row lock on the order without holding a lock on the foreign keys.
+   [2] Verify that the order is still open.
+   [3] Execute the Cryptocurrency purchase or sale, which releases the hold placed for the order.
+   [4] Close the order as filled with the transaction ID and record the change in its state history.
+*/
+func limitOrderFill(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	txID uuid.UUID,
+	order *CryptoLimitOrder,
+	fiatAmount decimal.Decimal,
+	price decimal.Decimal) error {
+	lockRow, err := queryTx.limitOrderRowLock(ctx, &limitOrderRowLockParams{
+		ClientID: order.ClientID,
+		OrderID:  order.OrderID,
+	})
+	if err != nil {
+		msg := "failed to get row lock on limit order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if lockRow.Status != LimitOrderStatusOPEN {
+		return fmt.Errorf("limit order is %s %w", lockRow.Status, ErrLimitOrderClosed)
+	}
+
+	// Execute the trade.
+	if lockRow.IsPurchase {
+		err = cryptoPurchaseTransaction(ctx, queryTx, lockRow.OrderID, &cryptoPurchaseParams{
+			TransactionID:      txID,
+			ClientID:           lockRow.ClientID,
+			FiatCurrency:       lockRow.FiatCurrency,
+			CryptoTicker:       lockRow.Ticker,
+			FiatDebitAmount:    fiatAmount,
+			CryptoCreditAmount: lockRow.Amount,
+		})
+	} else {
+		err = cryptoSellTransaction(ctx, queryTx, lockRow.OrderID, &cryptoSellParams{
+			TransactionID:     txID,
+			ClientID:          lockRow.ClientID,
+			FiatCurrency:      lockRow.FiatCurrency,
+			CryptoTicker:      lockRow.Ticker,
+			FiatCreditAmount:  fiatAmount,
+			CryptoDebitAmount: lockRow.Amount,
+		})
+	}
+
+	if err != nil {
+		msg := "failed to execute limit order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return limitOrderSetStatus(ctx, logger, queryTx, &lockRow, LimitOrderStatusFILLED,
+		pgtype.UUID{Bytes: txID, Valid: true}, price, "order filled")
+}
+
+// limitOrderSetStatus will close a row locked open limit order with a final status and record the change in its state
+// history.
+func limitOrderSetStatus(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	order *CryptoLimitOrder,
+	status LimitOrderStatus,
+	txID pgtype.UUID,
+	price decimal.Decimal,
+	details string) error {
+	var (
+		err          error
+		rowsAffected int64
+	)
+
+	if rowsAffected, err = queryTx.limitOrderUpdateStatus(ctx, &limitOrderUpdateStatusParams{
+		ClientID: order.ClientID,
+		OrderID:  order.OrderID,
+		Status:   status,
+		TxID:     txID,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to update limit order status"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	if rowsAffected, err = queryTx.limitOrderEventCreate(ctx, &limitOrderEventCreateParams{
+		OrderID: order.OrderID,
+		Status:  status,
+		Price:   price,
+		Details: details,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to record limit order status"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// LimitOrderGet is the interface through which external methods can retrieve a client's limit order along with its
+// state history.
+func (p *postgresImpl) LimitOrderGet(clientID uuid.UUID, orderID string) (
+	CryptoLimitOrder, []CryptoLimitOrderEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	order, err := p.Query.limitOrderGet(ctx, &limitOrderGetParams{ClientID: clientID, OrderID: orderID})
+	if err != nil {
+		return CryptoLimitOrder{}, nil, ErrNotFound
+	}
+
+	events, err := p.Query.limitOrderGetEvents(ctx, orderID)
+	if err != nil {
+		p.logger.Error("failed to retrieve limit order state history", zap.Error(err))
+
+		return CryptoLimitOrder{}, nil, ErrNotFound
+	}
+
+	return order, events, nil
+}
+
+// LimitOrdersPaginated is the interface through which external methods can retrieve a page of a client's limit orders,
+// newest first, starting from an order id.
+func (p *postgresImpl) LimitOrdersPaginated(clientID uuid.UUID, startID string, status string, pageSize int32) (
+	[]CryptoLimitOrder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	orders, err := p.Query.limitOrderGetPaginated(ctx, &limitOrderGetPaginatedParams{
+		ClientID: clientID,
+		Limit:    pageSize,
+		StartID:  startID,
+		Status:   status,
+	})
+	if err != nil {
+		return []CryptoLimitOrder{}, ErrNotFound
+	}
+
+	return orders, nil
+}
+
+// LimitOrdersOpen is the interface through which external methods can retrieve a batch of open and unexpired limit
+// orders, oldest first, after an order id.
+func (p *postgresImpl) LimitOrdersOpen(afterID string, batchSize int32) ([]CryptoLimitOrder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	orders, err := p.Query.limitOrderGetOpen(ctx, &limitOrderGetOpenParams{Limit: batchSize, AfterID: afterID})
+	if err != nil {
+		p.logger.Error("failed to retrieve open limit orders", zap.Error(err))
+
+		return []CryptoLimitOrder{}, ErrNotFound
+	}
+
+	return orders, nil
+}
+
+// LimitOrdersExpire is the interface through which external methods can expire the open limit orders that have passed
+// their expiry.
+func (p *postgresImpl) LimitOrdersExpire() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.limitOrderExpire(ctx)
+	if err != nil {
+		p.logger.Error("failed to expire limit orders", zap.Error(err))
+
+		return 0, ErrTransactCrypto
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_LimitOrders(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	expiresAt := pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true}
+	purchase := CryptoLimitOrder{
+		OrderID:      "order-1",
+		ClientID:     clientID1,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		IsPurchase:   true,
+		Amount:       decimal.NewFromFloat(0.5),
+		LimitPrice:   decimal.NewFromFloat(1000),
+		ExpiresAt:    expiresAt,
+	}
+	sale := CryptoLimitOrder{
+		OrderID:      "order-2",
+		ClientID:     clientID1,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		IsPurchase:   false,
+		Amount:       decimal.NewFromFloat(0.5),
+		LimitPrice:   decimal.NewFromFloat(2000),
+		ExpiresAt:    expiresAt,
+	}
+
+	// Orders cannot be placed without sufficient available funds.
+	require.ErrorIs(t, connection.LimitOrderCreate(&purchase, decimal.NewFromFloat(500)), ErrInsufficientFunds,
+		"placed purchase order without funds.")
+
+	// Fund the USD account and place the purchase order.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(500)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	require.NoError(t, connection.LimitOrderCreate(&purchase, decimal.NewFromFloat(500)),
+		"failed to place purchase order.")
+
+	account, err := connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Available.IsZero(), "purchase order funds not held.")
+
+	// Fill the purchase order below the limit price. The unused portion of the hold must be released.
+	fiatReceipt, cryptoReceipt, err := connection.LimitOrderFill(&purchase, decimal.NewFromFloat(450),
+		decimal.NewFromFloat(900))
+	require.NoError(t, err, "failed to fill purchase order.")
+	require.True(t, fiatReceipt.Amount.Equal(decimal.NewFromFloat(-450)), "Fiat debit mismatch.")
+	require.True(t, cryptoReceipt.Amount.Equal(purchase.Amount), "Crypto credit mismatch.")
+
+	account, err = connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, account.Available.Equal(decimal.NewFromFloat(50)), "purchase order hold not released.")
+
+	// Filled orders cannot be filled or cancelled again.
+	_, _, err = connection.LimitOrderFill(&purchase, decimal.NewFromFloat(450), decimal.NewFromFloat(900))
+	require.ErrorIs(t, err, ErrLimitOrderClosed, "filled order filled again.")
+	require.ErrorIs(t, connection.LimitOrderClose(clientID1, purchase.OrderID, LimitOrderStatusCANCELLED, "cancel"),
+		ErrLimitOrderClosed, "filled order cancelled.")
+
+	// Place and then cancel the sale order.
+	require.NoError(t, connection.LimitOrderCreate(&sale, sale.Amount), "failed to place sale order.")
+	require.ErrorIs(t, connection.LimitOrderCreate(&CryptoLimitOrder{
+		OrderID:      "order-3",
+		ClientID:     clientID1,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		Amount:       sale.Amount,
+		LimitPrice:   sale.LimitPrice,
+		ExpiresAt:    expiresAt,
+	}, sale.Amount), ErrInsufficientFunds, "placed sale order exceeding available balance.")
+
+	require.ErrorIs(t, connection.LimitOrderClose(clientID2, sale.OrderID, LimitOrderStatusCANCELLED, "cancel"),
+		ErrNotFound, "cancelled another client's order.")
+	require.NoError(t, connection.LimitOrderClose(clientID1, sale.OrderID, LimitOrderStatusCANCELLED, "cancel"),
+		"failed to cancel sale order.")
+
+	cryptoAccount, err := connection.CryptoBalance(clientID1, "BTC")
+	require.NoError(t, err, "failed to retrieve account.")
+	require.True(t, cryptoAccount.Available.Equal(cryptoAccount.Balance), "sale order hold not released.")
+
+	// Check the order state history.
+	order, events, err := connection.LimitOrderGet(clientID1, sale.OrderID)
+	require.NoError(t, err, "failed to retrieve sale order.")
+	require.Equal(t, LimitOrderStatusCANCELLED, order.Status, "order status mismatch.")
+	require.Len(t, events, 2, "order history length mismatch.")
+	require.Equal(t, LimitOrderStatusOPEN, events[0].Status, "order history opening mismatch.")
+	require.Equal(t, LimitOrderStatusCANCELLED, events[1].Status, "order history closing mismatch.")
+
+	_, _, err = connection.LimitOrderGet(clientID2, sale.OrderID)
+	require.ErrorIs(t, err, ErrNotFound, "retrieved another client's order.")
+
+	// Paginate and filter the client's orders.
+	orders, err := connection.LimitOrdersPaginated(clientID1, "", "", 10)
+	require.NoError(t, err, "failed to retrieve orders.")
+	require.Len(t, orders, 2, "order count mismatch.")
+	require.Equal(t, sale.OrderID, orders[0].OrderID, "orders not newest first.")
+
+	orders, err = connection.LimitOrdersPaginated(clientID1, "", string(LimitOrderStatusFILLED), 10)
+	require.NoError(t, err, "failed to retrieve filled orders.")
+	require.Len(t, orders, 1, "filled order count mismatch.")
+	require.True(t, orders[0].TxID.Valid, "filled order missing transaction id.")
+
+	// Orders past their expiry are no longer open.
+	sale.OrderID = "order-4"
+	sale.ExpiresAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+	require.NoError(t, connection.LimitOrderCreate(&sale, sale.Amount), "failed to place expired sale order.")
+
+	orders, err = connection.LimitOrdersOpen("", 10)
+	require.NoError(t, err, "failed to retrieve open orders.")
+	require.Empty(t, orders, "expired order is open.")
+
+	expired, err := connection.LimitOrdersExpire()
+	require.NoError(t, err, "failed to expire orders.")
+	require.Equal(t, int64(1), expired, "expired order count mismatch.")
+
+	order, _, err = connection.LimitOrderGet(clientID1, sale.OrderID)
+	require.NoError(t, err, "failed to retrieve expired order.")
+	require.Equal(t, LimitOrderStatusEXPIRED, order.Status, "expired order status mismatch.")
+}
+
+func TestOrders_LimitOrderClose_Mock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		rowLockRow    CryptoLimitOrder
+		rowLockErr    error
+		releaseErr    error
+		releaseTimes  int
+		updateRows    int64
+		updateTimes   int
+		eventRows     int64
+		eventTimes    int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: ErrNotFound,
+			rowLockRow:    CryptoLimitOrder{},
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			releaseErr:    nil,
+			releaseTimes:  0,
+			updateRows:    0,
+			updateTimes:   0,
+			eventRows:     0,
+			eventTimes:    0,
+		}, {
+			name:          "Order closed.",
+			expectErr:     require.Error,
+			expectedError: ErrLimitOrderClosed,
+			rowLockRow:    CryptoLimitOrder{Status: LimitOrderStatusFILLED, IsPurchase: true},
+			rowLockErr:    nil,
+			releaseErr:    nil,
+			releaseTimes:  0,
+			updateRows:    0,
+			updateTimes:   0,
+			eventRows:     0,
+			eventTimes:    0,
+		}, {
+			name:          "Hold release failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			rowLockRow:    CryptoLimitOrder{Status: LimitOrderStatusOPEN, IsPurchase: true},
+			rowLockErr:    nil,
+			releaseErr:    fmt.Errorf("release failure"),
+			releaseTimes:  1,
+			updateRows:    0,
+			updateTimes:   0,
+			eventRows:     0,
+			eventTimes:    0,
+		}, {
+			name:          "Status update failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			rowLockRow:    CryptoLimitOrder{Status: LimitOrderStatusOPEN, IsPurchase: true},
+			rowLockErr:    nil,
+			releaseErr:    nil,
+			releaseTimes:  1,
+			updateRows:    0,
+			updateTimes:   1,
+			eventRows:     0,
+			eventTimes:    0,
+		}, {
+			name:          "State history failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			rowLockRow:    CryptoLimitOrder{Status: LimitOrderStatusOPEN, IsPurchase: true},
+			rowLockErr:    nil,
+			releaseErr:    nil,
+			releaseTimes:  1,
+			updateRows:    1,
+			updateTimes:   1,
+			eventRows:     0,
+			eventTimes:    1,
+		}, {
+			name:          "Cancelled.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			rowLockRow:    CryptoLimitOrder{Status: LimitOrderStatusOPEN, IsPurchase: true},
+			rowLockErr:    nil,
+			releaseErr:    nil,
+			releaseTimes:  1,
+			updateRows:    1,
+			updateTimes:   1,
+			eventRows:     1,
+			eventTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					limitOrderRowLock(gomock.Any(), gomock.Any()).
+					Return(test.rowLockRow, test.rowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					fiatHoldRelease(gomock.Any(), gomock.Any()).
+					Return(int64(1), test.releaseErr).
+					Times(test.releaseTimes),
+
+				mockQuerier.EXPECT().
+					limitOrderUpdateStatus(gomock.Any(), gomock.Any()).
+					Return(test.updateRows, nil).
+					Times(test.updateTimes),
+
+				mockQuerier.EXPECT().
+					limitOrderEventCreate(gomock.Any(), gomock.Any()).
+					Return(test.eventRows, nil).
+					Times(test.eventTimes),
+			)
+
+			// Check for error.
+			err := limitOrderClose(context.TODO(), zapLogger, mockQuerier, uuid.UUID{}, "order-id",
+				LimitOrderStatusCANCELLED, "cancelled")
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
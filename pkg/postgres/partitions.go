@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// JournalPartition is a monthly partition of the Fiat or Crypto journal. The partition holds the entries transacted
+// from midnight UTC on the first of its month, inclusive, up to the start of the next month, exclusive.
+type JournalPartition struct {
+	Name       string    `json:"name"`
+	Journal    string    `json:"journal"`
+	Tablespace string    `json:"tablespace"`
+	StartsAt   time.Time `json:"startsAt"`
+	EndsAt     time.Time `json:"endsAt"`
+}
+
+// JournalPartitionsCreate will create the monthly partitions of the Fiat and Crypto journals for the month of a time
+// and the months after it. Partitions that already exist are left unchanged.
+func (p *postgresImpl) JournalPartitionsCreate(from time.Time, monthsAhead int) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.PartitionTimeout())
+
+	defer cancel()
+
+	var (
+		count    int64
+		start    = time.Date(from.UTC().Year(), from.UTC().Month(), 1, 0, 0, 0, 0, time.UTC)
+		journals = []string{"fiat_journal", "crypto_journal"}
+	)
+
+	for month := 0; month <= monthsAhead; month++ {
+		for _, journal := range journals {
+			created, err := p.Query.journalPartitionCreate(ctx, &journalPartitionCreateParams{
+				Journal: journal,
+				Month:   pgtype.Date{Time: start.AddDate(0, month, 0), Valid: true},
+			})
+			if err != nil {
+				p.logger.Warn("failed to create journal partition",
+					zap.String("journal", journal), zap.Time("month", start.AddDate(0, month, 0)), zap.Error(err))
+
+				return count, ErrJournalPartition
+			}
+
+			if created {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// JournalPartitions is the interface through which external methods can retrieve the monthly partitions of the Fiat
+// and Crypto journals, oldest first.
+func (p *postgresImpl) JournalPartitions() ([]JournalPartition, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.journalPartitions(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve journal partitions", zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	partitions := make([]JournalPartition, 0, len(rows))
+	for _, row := range rows {
+		partitions = append(partitions, JournalPartition{
+			Name:       row.Partition,
+			Journal:    row.Journal,
+			Tablespace: row.Tablespace,
+			StartsAt:   row.StartsAt.Time,
+			EndsAt:     row.StartsAt.Time.AddDate(0, 1, 0),
+		})
+	}
+
+	return partitions, nil
+}
+
+// JournalPartitionArchive will move a monthly journal partition and its indexes to a tablespace.
+func (p *postgresImpl) JournalPartitionArchive(partition, tablespace string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ArchiveTimeout())
+
+	defer cancel()
+
+	if err := p.Query.journalPartitionArchive(ctx, &journalPartitionArchiveParams{
+		Partition:  partition,
+		Tablespace: tablespace,
+	}); err != nil {
+		p.logger.Warn("failed to move journal partition to tablespace",
+			zap.String("partition", partition), zap.String("tablespace", tablespace), zap.Error(err))
+
+		return ErrJournalPartition
+	}
+
+	return nil
+}
+
+// JournalPartitionExport will write the entries in a monthly journal partition to a writer in CSV format with a header.
+func (p *postgresImpl) JournalPartitionExport(partition string, writer io.Writer) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ArchiveTimeout())
+
+	defer cancel()
+
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		p.logger.Warn("failed to acquire connection for journal partition export", zap.Error(err))
+
+		return 0, ErrJournalPartition
+	}
+
+	defer conn.Release()
+
+	tag, err := conn.Conn().PgConn().CopyTo(ctx, writer,
+		fmt.Sprintf("COPY %s TO STDOUT WITH (FORMAT csv, HEADER)", pgx.Identifier{partition}.Sanitize()))
+	if err != nil {
+		p.logger.Warn("failed to export journal partition", zap.String("partition", partition), zap.Error(err))
+
+		return 0, ErrJournalPartition
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// JournalPartitionDrop will detach and drop a monthly journal partition.
+func (p *postgresImpl) JournalPartitionDrop(partition string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ArchiveTimeout())
+
+	defer cancel()
+
+	if err := p.Query.journalPartitionDrop(ctx, partition); err != nil {
+		p.logger.Warn("failed to drop journal partition", zap.String("partition", partition), zap.Error(err))
+
+		return ErrJournalPartition
+	}
+
+	return nil
+}
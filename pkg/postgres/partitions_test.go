@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitions_JournalPartitions(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Post an entry to the current month's Fiat journal partition.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	// The partitions for the current month are created by the migration.
+	now := time.Now().UTC()
+	suffix := fmt.Sprintf("_y%04dm%02d", now.Year(), now.Month())
+
+	partitions, err := connection.JournalPartitions()
+	require.NoError(t, err, "failed to retrieve journal partitions.")
+
+	found := make(map[string]JournalPartition)
+	for _, partition := range partitions {
+		found[partition.Name] = partition
+	}
+
+	require.Contains(t, found, "fiat_journal"+suffix, "current Fiat journal partition not found.")
+	require.Contains(t, found, "crypto_journal"+suffix, "current Crypto journal partition not found.")
+	require.Equal(t, "fiat_journal", found["fiat_journal"+suffix].Journal, "Fiat journal partition parent mismatch.")
+	require.Equal(t, time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+		found["fiat_journal"+suffix].StartsAt.UTC(), "Fiat journal partition start mismatch.")
+
+	// Create partitions far in the future, and then recreate them.
+	future := now.AddDate(5, 0, 0)
+	futureSuffix := fmt.Sprintf("_y%04dm%02d", future.Year(), future.Month())
+
+	count, err := connection.JournalPartitionsCreate(future, 0)
+	require.NoError(t, err, "failed to create future journal partitions.")
+	require.Equal(t, int64(2), count, "future journal partitions count mismatch.")
+
+	count, err = connection.JournalPartitionsCreate(future, 0)
+	require.NoError(t, err, "failed to recreate future journal partitions.")
+	require.Equal(t, int64(0), count, "existing journal partitions recreated.")
+
+	// Export the current month's Fiat journal partition.
+	var buffer bytes.Buffer
+
+	rows, err := connection.JournalPartitionExport("fiat_journal"+suffix, &buffer)
+	require.NoError(t, err, "failed to export Fiat journal partition.")
+	require.Positive(t, rows, "no entries exported from Fiat journal partition.")
+	require.True(t, strings.HasPrefix(buffer.String(), "currency,amount,transacted_at,client_id,tx_id"),
+		"exported Fiat journal partition header mismatch.")
+
+	// Drop the future partitions.
+	require.NoError(t, connection.JournalPartitionDrop("fiat_journal"+futureSuffix), "failed to drop Fiat partition.")
+	require.NoError(t, connection.JournalPartitionDrop("crypto_journal"+futureSuffix), "failed to drop Crypto partition.")
+
+	// Only monthly journal partitions can be dropped.
+	require.ErrorIs(t, connection.JournalPartitionDrop("fiat_journal_default"), ErrJournalPartition,
+		"dropped default journal partition.")
+	require.ErrorIs(t, connection.JournalPartitionDrop("users"), ErrJournalPartition, "dropped users table.")
+	require.ErrorIs(t, connection.JournalPartitionArchive("fiat_journal"+futureSuffix, "pg_default"),
+		ErrJournalPartition, "archived dropped journal partition.")
+
+	partitions, err = connection.JournalPartitions()
+	require.NoError(t, err, "failed to retrieve journal partitions after drop.")
+
+	for _, partition := range partitions {
+		require.False(t, strings.HasSuffix(partition.Name, futureSuffix), "future journal partition not dropped.")
+	}
+}
+
+func TestPartitions_JournalPartitionDropMonthBoundary(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Reset the Fiat journal and its hash chain, since other tests truncate the journal.
+	_, err := connection.pool.Exec(ctx, "TRUNCATE TABLE fiat_journal CASCADE;")
+	require.NoError(t, err, "failed to wipe Fiat journal table.")
+
+	_, err = connection.pool.Exec(ctx,
+		"UPDATE journal_chain_heads SET seq = 0, entry_hash = $1, anchor_seq = 0, anchor_hash = $1 WHERE journal = $2;",
+		journalGenesisHash, JournalFiat)
+	require.NoError(t, err, "failed to reset Fiat journal chain head.")
+
+	// Create the partitions for two consecutive months far in the future.
+	first := time.Date(time.Now().UTC().Year()+6, time.January, 1, 0, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 1, 0)
+	firstPartition := fmt.Sprintf("fiat_journal_y%04dm%02d", first.Year(), first.Month())
+	secondPartition := fmt.Sprintf("fiat_journal_y%04dm%02d", second.Year(), second.Month())
+
+	_, err = connection.JournalPartitionsCreate(first, 1)
+	require.NoError(t, err, "failed to create journal partitions.")
+
+	// post will record a balanced transaction in the Fiat journal at a point in time.
+	post := func(transactedAt time.Time) {
+		_, err := connection.pool.Exec(ctx,
+			`INSERT INTO fiat_journal (currency, amount, transacted_at, client_id, tx_id)
+			VALUES ('USD', 10, $1, $2, $4), ('USD', -10, $1, $3, $4);`,
+			transactedAt, clientIDs[0], clientIDs[1], uuid.Must(uuid.NewV4()))
+		require.NoError(t, err, "failed to post transaction.")
+	}
+
+	// Entries are sequenced when they are inserted. A transaction that starts before midnight at the end of the first
+	// month and posts after a transaction in the second month leaves the first month with the highest sequence number.
+	post(first.Add(time.Hour))
+	post(second)
+	post(second.Add(-time.Second))
+
+	// The second month cannot be dropped before the first, which holds the start of the chain.
+	require.ErrorIs(t, connection.JournalPartitionDrop(secondPartition), ErrJournalPartition,
+		"dropped partition that is not at the start of the chain.")
+
+	// The first month is dropped and the chain is anchored on its highest sequence number.
+	require.NoError(t, connection.JournalPartitionDrop(firstPartition), "failed to drop first partition.")
+
+	verification, err := connection.JournalChainVerify(JournalFiat, nil)
+	require.NoError(t, err, "failed to verify Fiat journal chain after drop.")
+	require.True(t, verification.Intact, "Fiat journal chain should be intact after drop.")
+	require.Equal(t, int64(6), verification.AnchorSeq, "archive anchor mismatch.")
+	require.Equal(t, int64(0), verification.Entries, "entries sequenced before the anchor were walked.")
+
+	// The entries of the second month that were sequenced before the anchor remain in the journal.
+	var remaining int64
+
+	require.NoError(t, connection.pool.QueryRow(ctx, fmt.Sprintf("SELECT count(*) FROM %s;", secondPartition)).
+		Scan(&remaining), "failed to count remaining entries.")
+	require.Equal(t, int64(2), remaining, "remaining entries count mismatch.")
+
+	// New entries are linked to the anchor.
+	post(second.Add(time.Hour))
+
+	verification, err = connection.JournalChainVerify(JournalFiat, nil)
+	require.NoError(t, err, "failed to verify Fiat journal chain after posting.")
+	require.True(t, verification.Intact, "Fiat journal chain should be intact after posting.")
+	require.Equal(t, int64(7), verification.FirstSeq, "first walked sequence number mismatch.")
+	require.Equal(t, int64(2), verification.Entries, "walked entries count mismatch.")
+
+	// Drop the remaining future partitions.
+	require.NoError(t, connection.JournalPartitionDrop(secondPartition), "failed to drop second partition.")
+
+	for _, month := range []time.Time{first, second} {
+		require.NoError(t, connection.JournalPartitionDrop(
+			fmt.Sprintf("crypto_journal_y%04dm%02d", month.Year(), month.Month())), "failed to drop Crypto partition.")
+	}
+}
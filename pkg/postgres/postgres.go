@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"strconv"
 	"time"
@@ -53,21 +55,76 @@ type Postgres interface {
 	// UserIsDeleted is the interface through which external methods can check if a user account is soft-deleted.
 	UserIsDeleted(clientID uuid.UUID) (bool, error)
 
+	// UserGetStatus is the interface through which external methods can retrieve the role, soft delete, and freeze
+	// status of a user account.
+	UserGetStatus(clientID uuid.UUID) (modelsPostgres.UserStatus, error)
+
+	// UserSearch is the interface through which external methods can search for user accounts by client id, username,
+	// name, or email address.
+	UserSearch(query string, limit int32) ([]modelsPostgres.UserProfile, error)
+
+	// UserSetFrozen is the interface through which external methods can freeze or unfreeze a user account.
+	UserSetFrozen(clientID uuid.UUID, isFrozen bool) error
+
+	// UserUpdatePassword is the interface through which external methods can replace the hashed password of a user
+	// account.
+	UserUpdatePassword(clientID uuid.UUID, hashedPassword string) error
+
+	// UserUpdateProfile is the interface through which external methods can replace the names and email address of a
+	// user account. Empty values are left unchanged and a changed email address is marked as unverified.
+	UserUpdateProfile(clientID uuid.UUID, firstName, lastName, email string) error
+
+	// UserVerifyEmail is the interface through which external methods can mark the email address of a user account as
+	// verified. The email address must not have changed since it was sent the verification link.
+	UserVerifyEmail(clientID uuid.UUID, email string) error
+
+	// AdminAuditLogCreate is the interface through which external methods can record an administrative action in the
+	// audit log. The id of the entry is returned so that the outcome of the action can be recorded.
+	AdminAuditLogCreate(adminID uuid.UUID, action AdminAction, target string, details json.RawMessage) (int64, error)
+
+	// AdminAuditLogSetOutcome is the interface through which external methods can record the outcome of an
+	// administrative action in the audit log once it has been carried out.
+	AdminAuditLogSetOutcome(id int64, outcome AuditOutcome) error
+
+	// AdminAuditLogPaginated is the interface through which external methods can retrieve a page of audit log entries,
+	// newest first, starting from an entry id.
+	AdminAuditLogPaginated(target string, startID int64, pageSize int32) ([]AdminAuditLog, error)
+
 	// FiatCreateAccount will open an account associated with a Client ID for a specific currency.
 	FiatCreateAccount(clientID uuid.UUID, ticker Currency) error
 
+	// FiatAccountSetStatus is the interface through which external methods can set the status of a Fiat account that has
+	// not been closed.
+	FiatAccountSetStatus(clientID uuid.UUID, currency Currency, status AccountStatus) error
+
+	// FiatCurrencyGetAll is the interface through which external methods can retrieve all registered Fiat currencies.
+	FiatCurrencyGetAll() ([]FiatCurrency, error)
+
+	// FiatCurrencyUpsert is the interface through which external methods can register a Fiat currency or update its
+	// circulation status.
+	FiatCurrencyUpsert(code string, status FiatCurrencyStatus) error
+
 	// FiatExternalTransfer will transfer Fiat funds into an account associated with a Client ID for a specific
 	// currency.
 	FiatExternalTransfer(ctx context.Context, txDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error)
 
+	// FiatCloseAccount will close a Fiat account associated with a Client ID. The remaining balance of the source account
+	// will first be swept into the destination account if one is supplied. A zero balance is otherwise required.
+	FiatCloseAccount(ctx context.Context, source *FiatTransactionDetails, destination *FiatTransactionDetails) (
+		*FiatAccountTransferResult, *FiatAccountTransferResult, error)
+
 	// FiatInternalTransfer will transfer Fiat funds for a specific Client ID between two Fiat currency accounts for
 	// that client.
 	FiatInternalTransfer(ctx context.Context, source *FiatTransactionDetails, destination *FiatTransactionDetails) (
 		*FiatAccountTransferResult, *FiatAccountTransferResult, error)
 
+	// FiatHold will place a hold on Fiat funds in an account for an offer. The hold will be released when the offer is
+	// redeemed or expires.
+	FiatHold(ctx context.Context, holdID string, details *FiatTransactionDetails, expiresAt time.Time) error
+
 	// FiatBalance is the interface through which external methods can retrieve a Fiat account balance for a specific
 	// currency.
-	FiatBalance(clientID uuid.UUID, ticker Currency) (FiatAccount, error)
+	FiatBalance(clientID uuid.UUID, ticker Currency) (FiatAccountBalance, error)
 
 	// FiatTxDetails is the interface through which external methods can retrieve a Fiat transaction details for a
 	// specific transaction.
@@ -75,7 +132,7 @@ type Postgres interface {
 
 	// FiatBalancePaginated is the interface through which external methods can retrieve all Fiat account balances
 	// for a specific client.
-	FiatBalancePaginated(clientID uuid.UUID, ticker Currency, pageSize int32) ([]FiatAccount, error)
+	FiatBalancePaginated(clientID uuid.UUID, ticker Currency, pageSize int32) ([]FiatAccountBalance, error)
 
 	// FiatTransactionsPaginated is the interface through which external methods can retrieve transactions on a
 	// Fiat account for a specific client during a specific month.
@@ -85,30 +142,332 @@ type Postgres interface {
 	// CryptoCreateAccount is the interface through which external methods can create a Crypto account.
 	CryptoCreateAccount(clientID uuid.UUID, ticker string) error
 
+	// CryptoAccountSetStatus is the interface through which external methods can set the status of a Crypto account that
+	// has not been closed.
+	CryptoAccountSetStatus(clientID uuid.UUID, ticker string, status AccountStatus) error
+
 	// CryptoBalance is the interface through which external methods can retrieve a Fiat-account balance for a specific
 	// cryptocurrency.
-	CryptoBalance(clientID uuid.UUID, ticker string) (CryptoAccount, error)
+	CryptoBalance(clientID uuid.UUID, ticker string) (CryptoAccountBalance, error)
 
 	// CryptoTxDetails is the interface through which external methods can retrieve a Crypto transaction details for a
 	// specific transaction.
 	CryptoTxDetails(clientID uuid.UUID, txID uuid.UUID) ([]CryptoJournal, error)
 
-	// CryptoPurchase is the interface through which external methods can purchase a specific Cryptocurrency.
+	// CryptoPurchase is the interface through which external methods can purchase a specific Cryptocurrency. The hold
+	// placed on the Fiat funds for the offer will be released if a hold ID is supplied.
 	CryptoPurchase(clientID uuid.UUID, fiatTicker Currency, fiatAmount decimal.Decimal, cryptoTicker string,
+		cryptoAmount decimal.Decimal, holdID string) (*FiatJournal, *CryptoJournal, error)
+
+	// CryptoCloseAccount will close a Crypto account associated with a Client ID. A non-zero Cryptocurrency amount will
+	// first be sold and the proceeds swept into the specified Fiat currency account. A zero balance is otherwise
+	// required.
+	CryptoCloseAccount(clientID uuid.UUID, fiatTicker Currency, fiatAmount decimal.Decimal, cryptoTicker string,
 		cryptoAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error)
 
-	// CryptoSell is the interface through which external methods can sell a specific Cryptocurrency.
+	// CryptoSell is the interface through which external methods can sell a specific Cryptocurrency. The hold placed on
+	// the Cryptocurrency funds for the offer will be released if a hold ID is supplied.
 	CryptoSell(clientID uuid.UUID, fiatTicker Currency, fiatAmount decimal.Decimal, cryptoTicker string,
-		cryptoAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error)
+		cryptoAmount decimal.Decimal, holdID string) (*FiatJournal, *CryptoJournal, error)
+
+	// CryptoHold will place a hold on Cryptocurrency funds in an account for an offer. The hold will be released when
+	// the offer is redeemed or expires.
+	CryptoHold(ctx context.Context, holdID string, clientID uuid.UUID, ticker string, amount decimal.Decimal,
+		expiresAt time.Time) error
 
 	// CryptoBalancesPaginated is the interface through which external methods can retrieve all Crypto account balances
 	// for a specific client.
-	CryptoBalancesPaginated(clientID uuid.UUID, ticker string, pageSize int32) ([]CryptoAccount, error)
+	CryptoBalancesPaginated(clientID uuid.UUID, ticker string, pageSize int32) ([]CryptoAccountBalance, error)
 
 	// CryptoTransactionsPaginated is the interface through which external methods can retrieve transactions on a Crypto
 	// account for a specific client during a specific month.
 	CryptoTransactionsPaginated(clientID uuid.UUID, cryptoTicker string, pageSize int32, offset int32,
 		start pgtype.Timestamptz, end pgtype.Timestamptz) ([]CryptoJournal, error)
+
+	// CryptoAssetGet is the interface through which external methods can retrieve the registry entry for a specific
+	// Cryptocurrency.
+	CryptoAssetGet(ticker string) (CryptoAsset, error)
+
+	// CryptoAssetGetAll is the interface through which external methods can retrieve all registered Cryptocurrencies.
+	CryptoAssetGetAll() ([]CryptoAsset, error)
+
+	// CryptoAssetUpsert is the interface through which external methods can register or update a Cryptocurrency.
+	CryptoAssetUpsert(asset *CryptoAsset) error
+
+	// CryptoAssetSetStatus is the interface through which external methods can enable or halt trading for a
+	// Cryptocurrency.
+	CryptoAssetSetStatus(ticker string, status CryptoAssetStatus) error
+
+	// LimitOrderCreate will place a Cryptocurrency limit order. The hold amount is reserved in the source account for
+	// the lifetime of the order.
+	LimitOrderCreate(order *CryptoLimitOrder, holdAmount decimal.Decimal) error
+
+	// LimitOrderClose will close an open Cryptocurrency limit order without executing it and release the funds reserved
+	// for the order.
+	LimitOrderClose(clientID uuid.UUID, orderID string, status LimitOrderStatus, details string) error
+
+	// LimitOrderFill will execute an open Cryptocurrency limit order at a price and Fiat amount, releasing the funds
+	// reserved for the order.
+	LimitOrderFill(order *CryptoLimitOrder, fiatAmount decimal.Decimal, price decimal.Decimal) (
+		*FiatJournal, *CryptoJournal, error)
+
+	// LimitOrderGet is the interface through which external methods can retrieve a client's limit order along with its
+	// state history.
+	LimitOrderGet(clientID uuid.UUID, orderID string) (CryptoLimitOrder, []CryptoLimitOrderEvent, error)
+
+	// LimitOrdersPaginated is the interface through which external methods can retrieve a page of a client's limit
+	// orders, newest first, starting from an order id. The orders can be restricted to those with a specific status.
+	LimitOrdersPaginated(clientID uuid.UUID, startID string, status string, pageSize int32) ([]CryptoLimitOrder, error)
+
+	// LimitOrdersOpen is the interface through which external methods can retrieve a batch of open and unexpired limit
+	// orders, oldest first, after an order id.
+	LimitOrdersOpen(afterID string, batchSize int32) ([]CryptoLimitOrder, error)
+
+	// LimitOrdersExpire is the interface through which external methods can expire the open limit orders that have
+	// passed their expiry.
+	LimitOrdersExpire() (int64, error)
+
+	// TriggerOrderCreate will place a Cryptocurrency stop-loss or take-profit trigger order.
+	TriggerOrderCreate(order *CryptoTriggerOrder) error
+
+	// TriggerOrderClose will close an active Cryptocurrency trigger order without executing it.
+	TriggerOrderClose(clientID uuid.UUID, orderID string, status TriggerOrderStatus, details string) error
+
+	// TriggerOrderExecute will sell the position covered by an active Cryptocurrency trigger order at a price. The
+	// order amount, or the entire position if none was set, is sold up to the available balance of the account.
+	TriggerOrderExecute(order *CryptoTriggerOrder, price decimal.Decimal) (*FiatJournal, *CryptoJournal, error)
+
+	// TriggerOrderTrail is the interface through which external methods can raise the trigger price of an active
+	// trailing stop-loss order. It returns whether the trigger price was raised.
+	TriggerOrderTrail(order *CryptoTriggerOrder, triggerPrice decimal.Decimal) (bool, error)
+
+	// TriggerOrderGet is the interface through which external methods can retrieve a client's trigger order along with
+	// its state history.
+	TriggerOrderGet(clientID uuid.UUID, orderID string) (CryptoTriggerOrder, []CryptoTriggerOrderEvent, error)
+
+	// TriggerOrdersPaginated is the interface through which external methods can retrieve a page of a client's trigger
+	// orders, newest first, starting from an order id. The orders can be restricted to those with a specific status.
+	TriggerOrdersPaginated(clientID uuid.UUID, startID string, status string, pageSize int32) (
+		[]CryptoTriggerOrder, error)
+
+	// TriggerOrdersActive is the interface through which external methods can retrieve a batch of active trigger
+	// orders, oldest first, after an order id.
+	TriggerOrdersActive(afterID string, batchSize int32) ([]CryptoTriggerOrder, error)
+
+	// RecurringPurchaseCreate will schedule a recurring Cryptocurrency purchase plan.
+	RecurringPurchaseCreate(plan *CryptoRecurringPurchase) error
+
+	// RecurringPurchaseCancel will cancel an active recurring Cryptocurrency purchase plan.
+	RecurringPurchaseCancel(clientID uuid.UUID, planID string) error
+
+	// RecurringPurchaseExecute will carry out the scheduled run of an active recurring Cryptocurrency purchase plan,
+	// purchasing a Crypto amount at a price and moving the plan on to its next run.
+	RecurringPurchaseExecute(plan *CryptoRecurringPurchase, nextRunAt pgtype.Timestamptz, cryptoAmount decimal.Decimal,
+		price decimal.Decimal) (*FiatJournal, *CryptoJournal, error)
+
+	// RecurringPurchaseSkip will record the scheduled run of an active recurring Cryptocurrency purchase plan without a
+	// purchase and move the plan on to its next run.
+	RecurringPurchaseSkip(plan *CryptoRecurringPurchase, nextRunAt pgtype.Timestamptz, status RecurringPurchaseRunStatus,
+		details string) error
+
+	// RecurringPurchaseGet is the interface through which external methods can retrieve a client's recurring purchase
+	// plan.
+	RecurringPurchaseGet(clientID uuid.UUID, planID string) (CryptoRecurringPurchase, error)
+
+	// RecurringPurchasesPaginated is the interface through which external methods can retrieve a page of a client's
+	// recurring purchase plans, newest first, starting from a plan id.
+	RecurringPurchasesPaginated(clientID uuid.UUID, startID string, status string, pageSize int32) (
+		[]CryptoRecurringPurchase, error)
+
+	// RecurringPurchaseRunsPaginated is the interface through which external methods can retrieve a page of the runs of
+	// a client's recurring purchase plan, newest first, starting from a run id.
+	RecurringPurchaseRunsPaginated(clientID uuid.UUID, planID string, startID int64, pageSize int32) (
+		[]CryptoRecurringPurchaseRun, error)
+
+	// RecurringPurchasesDue is the interface through which external methods can retrieve a batch of active recurring
+	// purchase plans that are due to run, after a plan id.
+	RecurringPurchasesDue(afterID string, batchSize int32) ([]CryptoRecurringPurchase, error)
+
+	// FiatBalanceAsOf is the interface through which external methods can retrieve the balance of a Fiat account at a
+	// point in time.
+	FiatBalanceAsOf(clientID uuid.UUID, currency Currency, asOf time.Time) (BalanceAsOf, error)
+
+	// CryptoBalanceAsOf is the interface through which external methods can retrieve the balance of a Crypto account at
+	// a point in time.
+	CryptoBalanceAsOf(clientID uuid.UUID, ticker string, asOf time.Time) (BalanceAsOf, error)
+
+	// FiatBalanceHistory is the interface through which external methods can retrieve the daily closing balances of a
+	// Fiat account within a time range, oldest first.
+	FiatBalanceHistory(clientID uuid.UUID, currency Currency, from, to time.Time) ([]FiatBalanceSnapshot, error)
+
+	// CryptoBalanceHistory is the interface through which external methods can retrieve the daily closing balances of a
+	// Crypto account within a time range, oldest first.
+	CryptoBalanceHistory(clientID uuid.UUID, ticker string, from, to time.Time) ([]CryptoBalanceSnapshot, error)
+
+	// BalanceSnapshotLatest is the interface through which external methods can retrieve the time of the most recent
+	// daily closing balance snapshot.
+	BalanceSnapshotLatest() (pgtype.Timestamptz, error)
+
+	// BalanceSnapshotCreate will record the daily closing balance snapshots of all Fiat and Crypto accounts at a time.
+	BalanceSnapshotCreate(snapshotAt time.Time) (int64, error)
+
+	// JournalPartitionsCreate will create the monthly partitions of the Fiat and Crypto journals for the month of a time
+	// and the months after it.
+	JournalPartitionsCreate(from time.Time, monthsAhead int) (int64, error)
+
+	// JournalPartitions is the interface through which external methods can retrieve the monthly partitions of the Fiat
+	// and Crypto journals, oldest first.
+	JournalPartitions() ([]JournalPartition, error)
+
+	// JournalPartitionArchive will move a monthly journal partition and its indexes to a tablespace.
+	JournalPartitionArchive(partition, tablespace string) error
+
+	// JournalPartitionExport will write the entries in a monthly journal partition to a writer in CSV format.
+	JournalPartitionExport(partition string, writer io.Writer) (int64, error)
+
+	// JournalPartitionDrop will detach and drop a monthly journal partition.
+	JournalPartitionDrop(partition string) error
+
+	// JournalChainHeads is the interface through which external methods can retrieve the heads of the Fiat and Crypto
+	// journal hash chains.
+	JournalChainHeads() ([]JournalChainHead, error)
+
+	// JournalChainVerify will walk a journal hash chain up to its head and report the first broken link, if any.
+	JournalChainVerify(journal string, checkpoints []JournalCheckpoint) (*JournalChainVerification, error)
+
+	// JournalCheckpointCreate will record a signed checkpoint of a journal hash chain head.
+	JournalCheckpointCreate(checkpoint *JournalCheckpoint) (int64, error)
+
+	// JournalCheckpoints is the interface through which external methods can retrieve the signed checkpoints of a
+	// journal, or of all journals if none is specified, recorded at or after a point in time.
+	JournalCheckpoints(journal string, since time.Time) ([]JournalCheckpoint, error)
+
+	// TransactionReverse will post a compensating reversal of the Fiat and Crypto journal entries of a transaction and
+	// adjust the account balances.
+	TransactionReverse(txID, adminID uuid.UUID, reason string) (*TransactionReversal, error)
+
+	// JournalReversalGet is the interface through which external methods can retrieve the reversal of a transaction, or
+	// the reversal that a transaction records.
+	JournalReversalGet(txID uuid.UUID) (*JournalReversal, error)
+
+	// FiatAdjustmentCreate will record a pending manual adjustment of a Fiat account that awaits a decision by another
+	// administrator.
+	FiatAdjustmentCreate(adjustment *FiatAdjustment) error
+
+	// FiatAdjustmentApprove will approve a pending manual adjustment of a Fiat account and post its journal entries.
+	FiatAdjustmentApprove(adjustmentID string, checkerID uuid.UUID, note string) (*FiatAdjustment, error)
+
+	// FiatAdjustmentReject will reject a pending manual adjustment of a Fiat account.
+	FiatAdjustmentReject(adjustmentID string, checkerID uuid.UUID, note string) (*FiatAdjustment, error)
+
+	// FiatAdjustmentGet is the interface through which external methods can retrieve a manual adjustment of a Fiat
+	// account.
+	FiatAdjustmentGet(adjustmentID string) (FiatAdjustment, error)
+
+	// FiatAdjustmentsPaginated is the interface through which external methods can retrieve a page of manual
+	// adjustments, newest first, starting from an adjustment id. The adjustments can be restricted to those with a
+	// specific status.
+	FiatAdjustmentsPaginated(startID string, status string, pageSize int32) ([]FiatAdjustment, error)
+
+	// APIKeyCreate is the interface through which external methods can record an API key for a client. The key is not
+	// recorded if the client already holds the maximum number of active API keys.
+	APIKeyCreate(key *APIKey) error
+
+	// APIKeyGet is the interface through which external methods can retrieve an API key along with its encrypted
+	// secret.
+	APIKeyGet(keyID string) (APIKey, error)
+
+	// APIKeysClient is the interface through which external methods can retrieve the details, without the secrets, of
+	// all the API keys a client has created.
+	APIKeysClient(clientID uuid.UUID) ([]modelsPostgres.APIKeyInfo, error)
+
+	// APIKeyRevoke is the interface through which external methods can revoke an active API key belonging to a client.
+	APIKeyRevoke(clientID uuid.UUID, keyID string) error
+
+	// APIKeySecrets is the interface through which external methods can retrieve the encrypted secrets of all the API
+	// keys that have not been revoked, keyed by API key id.
+	APIKeySecrets() (map[string]string, error)
+
+	// APIKeySecretUpdate is the interface through which external methods can replace the encrypted secret of an API key
+	// with a re-encrypted one. The secret is not replaced if it has changed since it was retrieved.
+	APIKeySecretUpdate(keyID, secret, reencrypted string) error
+
+	// RefreshTokenCreate is the interface through which external methods can record a hashed refresh token that starts
+	// a new client session.
+	RefreshTokenCreate(token *RefreshToken) error
+
+	// RefreshTokenRotate is the interface through which external methods can exchange an active refresh token for a new
+	// one in the same session. The reuse of a rotated refresh token revokes its session.
+	RefreshTokenRotate(tokenHash string, token *RefreshToken) error
+
+	// RefreshSessionsClient is the interface through which external methods can retrieve the details of all the active
+	// sessions of a client.
+	RefreshSessionsClient(clientID uuid.UUID) ([]modelsPostgres.SessionInfo, error)
+
+	// RefreshSessionRevoke is the interface through which external methods can revoke a session belonging to a client.
+	RefreshSessionRevoke(clientID uuid.UUID, sessionID string) error
+
+	// RefreshSessionsRevokeAll is the interface through which external methods can revoke all the sessions of a client.
+	RefreshSessionsRevokeAll(clientID uuid.UUID) error
+
+	// MFAEnroll is the interface through which external methods can record a pending two-factor authentication
+	// enrolment with an encrypted TOTP secret.
+	MFAEnroll(clientID uuid.UUID, secret string) error
+
+	// MFAGet is the interface through which external methods can retrieve the two-factor authentication enrolment of a
+	// client.
+	MFAGet(clientID uuid.UUID) (UserMFA, error)
+
+	// MFAConfirm is the interface through which external methods can enable a pending two-factor authentication
+	// enrolment with the time step of its first one-time password and the hashed recovery codes.
+	MFAConfirm(clientID uuid.UUID, step int64, recoveryCodes []string) error
+
+	// MFAUseStep is the interface through which external methods can record the time step of a one-time password that
+	// has been used. One-time passwords from the same or earlier time steps are rejected.
+	MFAUseStep(clientID uuid.UUID, step int64) error
+
+	// MFAUseRecoveryCode is the interface through which external methods can consume a hashed recovery code.
+	MFAUseRecoveryCode(clientID uuid.UUID, codeHash string) error
+
+	// MFASetRecoveryCodes is the interface through which external methods can replace the hashed recovery codes of an
+	// enabled two-factor authentication enrolment.
+	MFASetRecoveryCodes(clientID uuid.UUID, recoveryCodes []string) error
+
+	// MFADelete is the interface through which external methods can disable two-factor authentication for a client.
+	MFADelete(clientID uuid.UUID) error
+
+	// MFASecrets is the interface through which external methods can retrieve the encrypted TOTP secrets of all
+	// two-factor authentication enrolments, keyed by client id.
+	MFASecrets() (map[uuid.UUID]string, error)
+
+	// MFASecretUpdate is the interface through which external methods can replace the encrypted TOTP secret of an
+	// enrolment with a re-encrypted one. The secret is not replaced if it has changed since it was retrieved.
+	MFASecretUpdate(clientID uuid.UUID, secret, reencrypted string) error
+
+	// LoginLockoutCreate is the interface through which external methods can record a temporary lockout of a username
+	// or IP address after repeated failed login attempts.
+	LoginLockoutCreate(scope LockoutScope, subject string, failures int64, ipAddress string, expiresAt time.Time) error
+
+	// LoginLockoutsRecent is the interface through which external methods can retrieve the most recent lockouts, newest
+	// first. The lockouts can be restricted to those that are still in effect.
+	LoginLockoutsRecent(activeOnly bool, limit int32) ([]LoginLockout, error)
+
+	// LoginLockoutUnlock is the interface through which external methods can record that an administrator has lifted
+	// the lockouts of a username or IP address that are still in effect.
+	LoginLockoutUnlock(adminID uuid.UUID, scope LockoutScope, subject string) error
+
+	// AuditEventCreate is the interface through which external methods can record a security or account event in the
+	// append-only audit log.
+	AuditEventCreate(event *AuditEventDetails) error
+
+	// AuditEventsPaginated is the interface through which external methods can retrieve a page of audit events, newest
+	// first, starting from an event id. The events can be restricted to a subject, an event type, and an outcome.
+	AuditEventsPaginated(subjectID uuid.UUID, eventType AuditEventType, outcome AuditOutcome, startID int64,
+		pageSize int32) ([]AuditEvent, error)
+
+	// AuditEventsRecent is the interface through which external methods can retrieve the most recent security and
+	// account events about a client, newest first, excluding administrative actions.
+	AuditEventsRecent(clientID uuid.UUID, limit int32) ([]modelsPostgres.SecurityEvent, error)
 }
 
 // Check to ensure the Postgres interface has been implemented.
@@ -190,7 +549,8 @@ func (p *postgresImpl) Open() error {
 	p.queries = New(p.pool)
 	p.Query = p.queries
 
-	return nil
+	// Load the Fiat currency reference table into the currency cache.
+	return p.loadCurrencyCache()
 }
 
 // verifySession will check to see if a session is established.
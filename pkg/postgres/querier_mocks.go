@@ -10,6 +10,7 @@ import (
 
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	decimal "github.com/shopspring/decimal"
 )
 
@@ -36,272 +37,1828 @@ func (m *MockQuerier) EXPECT() *MockQuerierMockRecorder {
 	return m.recorder
 }
 
+// adminAuditLogCreate mocks base method.
+func (m *MockQuerier) adminAuditLogCreate(arg0 context.Context, arg1 *adminAuditLogCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "adminAuditLogCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// adminAuditLogCreate indicates an expected call of adminAuditLogCreate.
+func (mr *MockQuerierMockRecorder) adminAuditLogCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "adminAuditLogCreate", reflect.TypeOf((*MockQuerier)(nil).adminAuditLogCreate), arg0, arg1)
+}
+
+// adminAuditLogGetPaginated mocks base method.
+func (m *MockQuerier) adminAuditLogGetPaginated(arg0 context.Context, arg1 *adminAuditLogGetPaginatedParams) ([]AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "adminAuditLogGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// adminAuditLogGetPaginated indicates an expected call of adminAuditLogGetPaginated.
+func (mr *MockQuerierMockRecorder) adminAuditLogGetPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "adminAuditLogGetPaginated", reflect.TypeOf((*MockQuerier)(nil).adminAuditLogGetPaginated), arg0, arg1)
+}
+
+// adminAuditLogSetOutcome mocks base method.
+func (m *MockQuerier) adminAuditLogSetOutcome(arg0 context.Context, arg1 *adminAuditLogSetOutcomeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "adminAuditLogSetOutcome", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// adminAuditLogSetOutcome indicates an expected call of adminAuditLogSetOutcome.
+func (mr *MockQuerierMockRecorder) adminAuditLogSetOutcome(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "adminAuditLogSetOutcome", reflect.TypeOf((*MockQuerier)(nil).adminAuditLogSetOutcome), arg0, arg1)
+}
+
+// apiKeyCreate mocks base method.
+func (m *MockQuerier) apiKeyCreate(arg0 context.Context, arg1 *apiKeyCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyCreate indicates an expected call of apiKeyCreate.
+func (mr *MockQuerierMockRecorder) apiKeyCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyCreate", reflect.TypeOf((*MockQuerier)(nil).apiKeyCreate), arg0, arg1)
+}
+
+// apiKeyGet mocks base method.
+func (m *MockQuerier) apiKeyGet(arg0 context.Context, arg1 string) (APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyGet", arg0, arg1)
+	ret0, _ := ret[0].(APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyGet indicates an expected call of apiKeyGet.
+func (mr *MockQuerierMockRecorder) apiKeyGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyGet", reflect.TypeOf((*MockQuerier)(nil).apiKeyGet), arg0, arg1)
+}
+
+// apiKeyGetClient mocks base method.
+func (m *MockQuerier) apiKeyGetClient(arg0 context.Context, arg1 uuid.UUID) ([]apiKeyGetClientRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyGetClient", arg0, arg1)
+	ret0, _ := ret[0].([]apiKeyGetClientRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyGetClient indicates an expected call of apiKeyGetClient.
+func (mr *MockQuerierMockRecorder) apiKeyGetClient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyGetClient", reflect.TypeOf((*MockQuerier)(nil).apiKeyGetClient), arg0, arg1)
+}
+
+// apiKeyRevoke mocks base method.
+func (m *MockQuerier) apiKeyRevoke(arg0 context.Context, arg1 *apiKeyRevokeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyRevoke", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyRevoke indicates an expected call of apiKeyRevoke.
+func (mr *MockQuerierMockRecorder) apiKeyRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyRevoke", reflect.TypeOf((*MockQuerier)(nil).apiKeyRevoke), arg0, arg1)
+}
+
+// apiKeySecrets mocks base method.
+func (m *MockQuerier) apiKeySecrets(arg0 context.Context) ([]apiKeySecretsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeySecrets", arg0)
+	ret0, _ := ret[0].([]apiKeySecretsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeySecrets indicates an expected call of apiKeySecrets.
+func (mr *MockQuerierMockRecorder) apiKeySecrets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeySecrets", reflect.TypeOf((*MockQuerier)(nil).apiKeySecrets), arg0)
+}
+
+// apiKeySetSecret mocks base method.
+func (m *MockQuerier) apiKeySetSecret(arg0 context.Context, arg1 *apiKeySetSecretParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeySetSecret", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeySetSecret indicates an expected call of apiKeySetSecret.
+func (mr *MockQuerierMockRecorder) apiKeySetSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeySetSecret", reflect.TypeOf((*MockQuerier)(nil).apiKeySetSecret), arg0, arg1)
+}
+
+// auditEventCreate mocks base method.
+func (m *MockQuerier) auditEventCreate(arg0 context.Context, arg1 *auditEventCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "auditEventCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// auditEventCreate indicates an expected call of auditEventCreate.
+func (mr *MockQuerierMockRecorder) auditEventCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "auditEventCreate", reflect.TypeOf((*MockQuerier)(nil).auditEventCreate), arg0, arg1)
+}
+
+// auditEventsGetPaginated mocks base method.
+func (m *MockQuerier) auditEventsGetPaginated(arg0 context.Context, arg1 *auditEventsGetPaginatedParams) ([]AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "auditEventsGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// auditEventsGetPaginated indicates an expected call of auditEventsGetPaginated.
+func (mr *MockQuerierMockRecorder) auditEventsGetPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "auditEventsGetPaginated", reflect.TypeOf((*MockQuerier)(nil).auditEventsGetPaginated), arg0, arg1)
+}
+
+// auditEventsRecent mocks base method.
+func (m *MockQuerier) auditEventsRecent(arg0 context.Context, arg1 *auditEventsRecentParams) ([]auditEventsRecentRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "auditEventsRecent", arg0, arg1)
+	ret0, _ := ret[0].([]auditEventsRecentRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// auditEventsRecent indicates an expected call of auditEventsRecent.
+func (mr *MockQuerierMockRecorder) auditEventsRecent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "auditEventsRecent", reflect.TypeOf((*MockQuerier)(nil).auditEventsRecent), arg0, arg1)
+}
+
+// balanceSnapshotLatest mocks base method.
+func (m *MockQuerier) balanceSnapshotLatest(arg0 context.Context) (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "balanceSnapshotLatest", arg0)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// balanceSnapshotLatest indicates an expected call of balanceSnapshotLatest.
+func (mr *MockQuerierMockRecorder) balanceSnapshotLatest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "balanceSnapshotLatest", reflect.TypeOf((*MockQuerier)(nil).balanceSnapshotLatest), arg0)
+}
+
+// cryptoAssetGet mocks base method.
+func (m *MockQuerier) cryptoAssetGet(arg0 context.Context, arg1 string) (CryptoAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoAssetGet", arg0, arg1)
+	ret0, _ := ret[0].(CryptoAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoAssetGet indicates an expected call of cryptoAssetGet.
+func (mr *MockQuerierMockRecorder) cryptoAssetGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoAssetGet", reflect.TypeOf((*MockQuerier)(nil).cryptoAssetGet), arg0, arg1)
+}
+
+// cryptoAssetGetAll mocks base method.
+func (m *MockQuerier) cryptoAssetGetAll(arg0 context.Context) ([]CryptoAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoAssetGetAll", arg0)
+	ret0, _ := ret[0].([]CryptoAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoAssetGetAll indicates an expected call of cryptoAssetGetAll.
+func (mr *MockQuerierMockRecorder) cryptoAssetGetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoAssetGetAll", reflect.TypeOf((*MockQuerier)(nil).cryptoAssetGetAll), arg0)
+}
+
+// cryptoAssetSetStatus mocks base method.
+func (m *MockQuerier) cryptoAssetSetStatus(arg0 context.Context, arg1 *cryptoAssetSetStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoAssetSetStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoAssetSetStatus indicates an expected call of cryptoAssetSetStatus.
+func (mr *MockQuerierMockRecorder) cryptoAssetSetStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoAssetSetStatus", reflect.TypeOf((*MockQuerier)(nil).cryptoAssetSetStatus), arg0, arg1)
+}
+
+// cryptoAssetUpsert mocks base method.
+func (m *MockQuerier) cryptoAssetUpsert(arg0 context.Context, arg1 *cryptoAssetUpsertParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoAssetUpsert", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoAssetUpsert indicates an expected call of cryptoAssetUpsert.
+func (mr *MockQuerierMockRecorder) cryptoAssetUpsert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoAssetUpsert", reflect.TypeOf((*MockQuerier)(nil).cryptoAssetUpsert), arg0, arg1)
+}
+
+// cryptoBalanceAsOf mocks base method.
+func (m *MockQuerier) cryptoBalanceAsOf(arg0 context.Context, arg1 *cryptoBalanceAsOfParams) (cryptoBalanceAsOfRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoBalanceAsOf", arg0, arg1)
+	ret0, _ := ret[0].(cryptoBalanceAsOfRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoBalanceAsOf indicates an expected call of cryptoBalanceAsOf.
+func (mr *MockQuerierMockRecorder) cryptoBalanceAsOf(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoBalanceAsOf", reflect.TypeOf((*MockQuerier)(nil).cryptoBalanceAsOf), arg0, arg1)
+}
+
+// cryptoBalanceHistory mocks base method.
+func (m *MockQuerier) cryptoBalanceHistory(arg0 context.Context, arg1 *cryptoBalanceHistoryParams) ([]CryptoBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoBalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoBalanceHistory indicates an expected call of cryptoBalanceHistory.
+func (mr *MockQuerierMockRecorder) cryptoBalanceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoBalanceHistory", reflect.TypeOf((*MockQuerier)(nil).cryptoBalanceHistory), arg0, arg1)
+}
+
+// cryptoBalanceSnapshotCreate mocks base method.
+func (m *MockQuerier) cryptoBalanceSnapshotCreate(arg0 context.Context, arg1 pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoBalanceSnapshotCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoBalanceSnapshotCreate indicates an expected call of cryptoBalanceSnapshotCreate.
+func (mr *MockQuerierMockRecorder) cryptoBalanceSnapshotCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoBalanceSnapshotCreate", reflect.TypeOf((*MockQuerier)(nil).cryptoBalanceSnapshotCreate), arg0, arg1)
+}
+
+// cryptoCloseAccount mocks base method.
+func (m *MockQuerier) cryptoCloseAccount(arg0 context.Context, arg1 *cryptoCloseAccountParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoCloseAccount", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoCloseAccount indicates an expected call of cryptoCloseAccount.
+func (mr *MockQuerierMockRecorder) cryptoCloseAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoCloseAccount", reflect.TypeOf((*MockQuerier)(nil).cryptoCloseAccount), arg0, arg1)
+}
+
 // cryptoCreateAccount mocks base method.
 func (m *MockQuerier) cryptoCreateAccount(arg0 context.Context, arg1 *cryptoCreateAccountParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoCreateAccount", arg0, arg1)
+	ret := m.ctrl.Call(m, "cryptoCreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoCreateAccount indicates an expected call of cryptoCreateAccount.
+func (mr *MockQuerierMockRecorder) cryptoCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoCreateAccount", reflect.TypeOf((*MockQuerier)(nil).cryptoCreateAccount), arg0, arg1)
+}
+
+// cryptoGetAccount mocks base method.
+func (m *MockQuerier) cryptoGetAccount(arg0 context.Context, arg1 *cryptoGetAccountParams) (CryptoAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoGetAccount", arg0, arg1)
+	ret0, _ := ret[0].(CryptoAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoGetAccount indicates an expected call of cryptoGetAccount.
+func (mr *MockQuerierMockRecorder) cryptoGetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetAccount", reflect.TypeOf((*MockQuerier)(nil).cryptoGetAccount), arg0, arg1)
+}
+
+// cryptoGetAllAccounts mocks base method.
+func (m *MockQuerier) cryptoGetAllAccounts(arg0 context.Context, arg1 *cryptoGetAllAccountsParams) ([]CryptoAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoGetAllAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoGetAllAccounts indicates an expected call of cryptoGetAllAccounts.
+func (mr *MockQuerierMockRecorder) cryptoGetAllAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetAllAccounts", reflect.TypeOf((*MockQuerier)(nil).cryptoGetAllAccounts), arg0, arg1)
+}
+
+// cryptoGetAllJournalTransactionsPaginated mocks base method.
+func (m *MockQuerier) cryptoGetAllJournalTransactionsPaginated(arg0 context.Context, arg1 *cryptoGetAllJournalTransactionsPaginatedParams) ([]CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoGetAllJournalTransactionsPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoGetAllJournalTransactionsPaginated indicates an expected call of cryptoGetAllJournalTransactionsPaginated.
+func (mr *MockQuerierMockRecorder) cryptoGetAllJournalTransactionsPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetAllJournalTransactionsPaginated", reflect.TypeOf((*MockQuerier)(nil).cryptoGetAllJournalTransactionsPaginated), arg0, arg1)
+}
+
+// cryptoGetJournalTransaction mocks base method.
+func (m *MockQuerier) cryptoGetJournalTransaction(arg0 context.Context, arg1 *cryptoGetJournalTransactionParams) ([]CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoGetJournalTransaction", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoGetJournalTransaction indicates an expected call of cryptoGetJournalTransaction.
+func (mr *MockQuerierMockRecorder) cryptoGetJournalTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetJournalTransaction", reflect.TypeOf((*MockQuerier)(nil).cryptoGetJournalTransaction), arg0, arg1)
+}
+
+// cryptoHoldCreate mocks base method.
+func (m *MockQuerier) cryptoHoldCreate(arg0 context.Context, arg1 *cryptoHoldCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoHoldCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoHoldCreate indicates an expected call of cryptoHoldCreate.
+func (mr *MockQuerierMockRecorder) cryptoHoldCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoHoldCreate", reflect.TypeOf((*MockQuerier)(nil).cryptoHoldCreate), arg0, arg1)
+}
+
+// cryptoHoldPurgeExpired mocks base method.
+func (m *MockQuerier) cryptoHoldPurgeExpired(arg0 context.Context, arg1 *cryptoHoldPurgeExpiredParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoHoldPurgeExpired", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoHoldPurgeExpired indicates an expected call of cryptoHoldPurgeExpired.
+func (mr *MockQuerierMockRecorder) cryptoHoldPurgeExpired(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoHoldPurgeExpired", reflect.TypeOf((*MockQuerier)(nil).cryptoHoldPurgeExpired), arg0, arg1)
+}
+
+// cryptoHoldRelease mocks base method.
+func (m *MockQuerier) cryptoHoldRelease(arg0 context.Context, arg1 *cryptoHoldReleaseParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoHoldRelease", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoHoldRelease indicates an expected call of cryptoHoldRelease.
+func (mr *MockQuerierMockRecorder) cryptoHoldRelease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoHoldRelease", reflect.TypeOf((*MockQuerier)(nil).cryptoHoldRelease), arg0, arg1)
+}
+
+// cryptoHoldTotal mocks base method.
+func (m *MockQuerier) cryptoHoldTotal(arg0 context.Context, arg1 *cryptoHoldTotalParams) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoHoldTotal", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoHoldTotal indicates an expected call of cryptoHoldTotal.
+func (mr *MockQuerierMockRecorder) cryptoHoldTotal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoHoldTotal", reflect.TypeOf((*MockQuerier)(nil).cryptoHoldTotal), arg0, arg1)
+}
+
+// cryptoJournalChain mocks base method.
+func (m *MockQuerier) cryptoJournalChain(arg0 context.Context, arg1 *cryptoJournalChainParams) ([]cryptoJournalChainRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoJournalChain", arg0, arg1)
+	ret0, _ := ret[0].([]cryptoJournalChainRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoJournalChain indicates an expected call of cryptoJournalChain.
+func (mr *MockQuerierMockRecorder) cryptoJournalChain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoJournalChain", reflect.TypeOf((*MockQuerier)(nil).cryptoJournalChain), arg0, arg1)
+}
+
+// cryptoJournalReversalEntries mocks base method.
+func (m *MockQuerier) cryptoJournalReversalEntries(arg0 context.Context, arg1 uuid.UUID) ([]cryptoJournalReversalEntriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoJournalReversalEntries", arg0, arg1)
+	ret0, _ := ret[0].([]cryptoJournalReversalEntriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoJournalReversalEntries indicates an expected call of cryptoJournalReversalEntries.
+func (mr *MockQuerierMockRecorder) cryptoJournalReversalEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoJournalReversalEntries", reflect.TypeOf((*MockQuerier)(nil).cryptoJournalReversalEntries), arg0, arg1)
+}
+
+// cryptoJournalReverse mocks base method.
+func (m *MockQuerier) cryptoJournalReverse(arg0 context.Context, arg1 *cryptoJournalReverseParams) ([]CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoJournalReverse", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoJournalReverse indicates an expected call of cryptoJournalReverse.
+func (mr *MockQuerierMockRecorder) cryptoJournalReverse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoJournalReverse", reflect.TypeOf((*MockQuerier)(nil).cryptoJournalReverse), arg0, arg1)
+}
+
+// cryptoPurchase mocks base method.
+func (m *MockQuerier) cryptoPurchase(arg0 context.Context, arg1 *cryptoPurchaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoPurchase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// cryptoPurchase indicates an expected call of cryptoPurchase.
+func (mr *MockQuerierMockRecorder) cryptoPurchase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoPurchase", reflect.TypeOf((*MockQuerier)(nil).cryptoPurchase), arg0, arg1)
+}
+
+// cryptoRowLockAccount mocks base method.
+func (m *MockQuerier) cryptoRowLockAccount(arg0 context.Context, arg1 *cryptoRowLockAccountParams) (cryptoRowLockAccountRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoRowLockAccount", arg0, arg1)
+	ret0, _ := ret[0].(cryptoRowLockAccountRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoRowLockAccount indicates an expected call of cryptoRowLockAccount.
+func (mr *MockQuerierMockRecorder) cryptoRowLockAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoRowLockAccount", reflect.TypeOf((*MockQuerier)(nil).cryptoRowLockAccount), arg0, arg1)
+}
+
+// cryptoSell mocks base method.
+func (m *MockQuerier) cryptoSell(arg0 context.Context, arg1 *cryptoSellParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoSell", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// cryptoSell indicates an expected call of cryptoSell.
+func (mr *MockQuerierMockRecorder) cryptoSell(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoSell", reflect.TypeOf((*MockQuerier)(nil).cryptoSell), arg0, arg1)
+}
+
+// cryptoSetAccountStatus mocks base method.
+func (m *MockQuerier) cryptoSetAccountStatus(arg0 context.Context, arg1 *cryptoSetAccountStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoSetAccountStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoSetAccountStatus indicates an expected call of cryptoSetAccountStatus.
+func (mr *MockQuerierMockRecorder) cryptoSetAccountStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoSetAccountStatus", reflect.TypeOf((*MockQuerier)(nil).cryptoSetAccountStatus), arg0, arg1)
+}
+
+// cryptoUpdateAccountBalance mocks base method.
+func (m *MockQuerier) cryptoUpdateAccountBalance(arg0 context.Context, arg1 *cryptoUpdateAccountBalanceParams) (cryptoUpdateAccountBalanceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoUpdateAccountBalance", arg0, arg1)
+	ret0, _ := ret[0].(cryptoUpdateAccountBalanceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoUpdateAccountBalance indicates an expected call of cryptoUpdateAccountBalance.
+func (mr *MockQuerierMockRecorder) cryptoUpdateAccountBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoUpdateAccountBalance", reflect.TypeOf((*MockQuerier)(nil).cryptoUpdateAccountBalance), arg0, arg1)
+}
+
+// fiatAdjustmentCreate mocks base method.
+func (m *MockQuerier) fiatAdjustmentCreate(arg0 context.Context, arg1 *fiatAdjustmentCreateParams) (FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatAdjustmentCreate", arg0, arg1)
+	ret0, _ := ret[0].(FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatAdjustmentCreate indicates an expected call of fiatAdjustmentCreate.
+func (mr *MockQuerierMockRecorder) fiatAdjustmentCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatAdjustmentCreate", reflect.TypeOf((*MockQuerier)(nil).fiatAdjustmentCreate), arg0, arg1)
+}
+
+// fiatAdjustmentDecide mocks base method.
+func (m *MockQuerier) fiatAdjustmentDecide(arg0 context.Context, arg1 *fiatAdjustmentDecideParams) (FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatAdjustmentDecide", arg0, arg1)
+	ret0, _ := ret[0].(FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatAdjustmentDecide indicates an expected call of fiatAdjustmentDecide.
+func (mr *MockQuerierMockRecorder) fiatAdjustmentDecide(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatAdjustmentDecide", reflect.TypeOf((*MockQuerier)(nil).fiatAdjustmentDecide), arg0, arg1)
+}
+
+// fiatAdjustmentGet mocks base method.
+func (m *MockQuerier) fiatAdjustmentGet(arg0 context.Context, arg1 string) (FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatAdjustmentGet", arg0, arg1)
+	ret0, _ := ret[0].(FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatAdjustmentGet indicates an expected call of fiatAdjustmentGet.
+func (mr *MockQuerierMockRecorder) fiatAdjustmentGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatAdjustmentGet", reflect.TypeOf((*MockQuerier)(nil).fiatAdjustmentGet), arg0, arg1)
+}
+
+// fiatAdjustmentGetPaginated mocks base method.
+func (m *MockQuerier) fiatAdjustmentGetPaginated(arg0 context.Context, arg1 *fiatAdjustmentGetPaginatedParams) ([]FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatAdjustmentGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatAdjustmentGetPaginated indicates an expected call of fiatAdjustmentGetPaginated.
+func (mr *MockQuerierMockRecorder) fiatAdjustmentGetPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatAdjustmentGetPaginated", reflect.TypeOf((*MockQuerier)(nil).fiatAdjustmentGetPaginated), arg0, arg1)
+}
+
+// fiatAdjustmentRowLock mocks base method.
+func (m *MockQuerier) fiatAdjustmentRowLock(arg0 context.Context, arg1 string) (FiatAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatAdjustmentRowLock", arg0, arg1)
+	ret0, _ := ret[0].(FiatAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatAdjustmentRowLock indicates an expected call of fiatAdjustmentRowLock.
+func (mr *MockQuerierMockRecorder) fiatAdjustmentRowLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatAdjustmentRowLock", reflect.TypeOf((*MockQuerier)(nil).fiatAdjustmentRowLock), arg0, arg1)
+}
+
+// fiatBalanceAsOf mocks base method.
+func (m *MockQuerier) fiatBalanceAsOf(arg0 context.Context, arg1 *fiatBalanceAsOfParams) (fiatBalanceAsOfRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatBalanceAsOf", arg0, arg1)
+	ret0, _ := ret[0].(fiatBalanceAsOfRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatBalanceAsOf indicates an expected call of fiatBalanceAsOf.
+func (mr *MockQuerierMockRecorder) fiatBalanceAsOf(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatBalanceAsOf", reflect.TypeOf((*MockQuerier)(nil).fiatBalanceAsOf), arg0, arg1)
+}
+
+// fiatBalanceHistory mocks base method.
+func (m *MockQuerier) fiatBalanceHistory(arg0 context.Context, arg1 *fiatBalanceHistoryParams) ([]FiatBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatBalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].([]FiatBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatBalanceHistory indicates an expected call of fiatBalanceHistory.
+func (mr *MockQuerierMockRecorder) fiatBalanceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatBalanceHistory", reflect.TypeOf((*MockQuerier)(nil).fiatBalanceHistory), arg0, arg1)
+}
+
+// fiatBalanceSnapshotCreate mocks base method.
+func (m *MockQuerier) fiatBalanceSnapshotCreate(arg0 context.Context, arg1 pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatBalanceSnapshotCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatBalanceSnapshotCreate indicates an expected call of fiatBalanceSnapshotCreate.
+func (mr *MockQuerierMockRecorder) fiatBalanceSnapshotCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatBalanceSnapshotCreate", reflect.TypeOf((*MockQuerier)(nil).fiatBalanceSnapshotCreate), arg0, arg1)
+}
+
+// fiatCloseAccount mocks base method.
+func (m *MockQuerier) fiatCloseAccount(arg0 context.Context, arg1 *fiatCloseAccountParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatCloseAccount", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatCloseAccount indicates an expected call of fiatCloseAccount.
+func (mr *MockQuerierMockRecorder) fiatCloseAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatCloseAccount", reflect.TypeOf((*MockQuerier)(nil).fiatCloseAccount), arg0, arg1)
+}
+
+// fiatCreateAccount mocks base method.
+func (m *MockQuerier) fiatCreateAccount(arg0 context.Context, arg1 *fiatCreateAccountParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatCreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatCreateAccount indicates an expected call of fiatCreateAccount.
+func (mr *MockQuerierMockRecorder) fiatCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatCreateAccount", reflect.TypeOf((*MockQuerier)(nil).fiatCreateAccount), arg0, arg1)
+}
+
+// fiatCurrencyGetAll mocks base method.
+func (m *MockQuerier) fiatCurrencyGetAll(arg0 context.Context) ([]FiatCurrency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatCurrencyGetAll", arg0)
+	ret0, _ := ret[0].([]FiatCurrency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatCurrencyGetAll indicates an expected call of fiatCurrencyGetAll.
+func (mr *MockQuerierMockRecorder) fiatCurrencyGetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatCurrencyGetAll", reflect.TypeOf((*MockQuerier)(nil).fiatCurrencyGetAll), arg0)
+}
+
+// fiatCurrencyUpsert mocks base method.
+func (m *MockQuerier) fiatCurrencyUpsert(arg0 context.Context, arg1 *fiatCurrencyUpsertParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatCurrencyUpsert", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatCurrencyUpsert indicates an expected call of fiatCurrencyUpsert.
+func (mr *MockQuerierMockRecorder) fiatCurrencyUpsert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatCurrencyUpsert", reflect.TypeOf((*MockQuerier)(nil).fiatCurrencyUpsert), arg0, arg1)
+}
+
+// fiatExternalTransferJournalEntry mocks base method.
+func (m *MockQuerier) fiatExternalTransferJournalEntry(arg0 context.Context, arg1 *fiatExternalTransferJournalEntryParams) (fiatExternalTransferJournalEntryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatExternalTransferJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(fiatExternalTransferJournalEntryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatExternalTransferJournalEntry indicates an expected call of fiatExternalTransferJournalEntry.
+func (mr *MockQuerierMockRecorder) fiatExternalTransferJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatExternalTransferJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatExternalTransferJournalEntry), arg0, arg1)
+}
+
+// fiatGetAccount mocks base method.
+func (m *MockQuerier) fiatGetAccount(arg0 context.Context, arg1 *fiatGetAccountParams) (FiatAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatGetAccount", arg0, arg1)
+	ret0, _ := ret[0].(FiatAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatGetAccount indicates an expected call of fiatGetAccount.
+func (mr *MockQuerierMockRecorder) fiatGetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAccount", reflect.TypeOf((*MockQuerier)(nil).fiatGetAccount), arg0, arg1)
+}
+
+// fiatGetAllAccounts mocks base method.
+func (m *MockQuerier) fiatGetAllAccounts(arg0 context.Context, arg1 *fiatGetAllAccountsParams) ([]FiatAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatGetAllAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]FiatAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatGetAllAccounts indicates an expected call of fiatGetAllAccounts.
+func (mr *MockQuerierMockRecorder) fiatGetAllAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAllAccounts", reflect.TypeOf((*MockQuerier)(nil).fiatGetAllAccounts), arg0, arg1)
+}
+
+// fiatGetAllJournalTransactionsPaginated mocks base method.
+func (m *MockQuerier) fiatGetAllJournalTransactionsPaginated(arg0 context.Context, arg1 *fiatGetAllJournalTransactionsPaginatedParams) ([]FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatGetAllJournalTransactionsPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatGetAllJournalTransactionsPaginated indicates an expected call of fiatGetAllJournalTransactionsPaginated.
+func (mr *MockQuerierMockRecorder) fiatGetAllJournalTransactionsPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAllJournalTransactionsPaginated", reflect.TypeOf((*MockQuerier)(nil).fiatGetAllJournalTransactionsPaginated), arg0, arg1)
+}
+
+// fiatGetJournalTransaction mocks base method.
+func (m *MockQuerier) fiatGetJournalTransaction(arg0 context.Context, arg1 *fiatGetJournalTransactionParams) ([]FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatGetJournalTransaction", arg0, arg1)
+	ret0, _ := ret[0].([]FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatGetJournalTransaction indicates an expected call of fiatGetJournalTransaction.
+func (mr *MockQuerierMockRecorder) fiatGetJournalTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetJournalTransaction", reflect.TypeOf((*MockQuerier)(nil).fiatGetJournalTransaction), arg0, arg1)
+}
+
+// fiatGetJournalTransactionForAccount mocks base method.
+func (m *MockQuerier) fiatGetJournalTransactionForAccount(arg0 context.Context, arg1 *fiatGetJournalTransactionForAccountParams) ([]FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatGetJournalTransactionForAccount", arg0, arg1)
+	ret0, _ := ret[0].([]FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatGetJournalTransactionForAccount indicates an expected call of fiatGetJournalTransactionForAccount.
+func (mr *MockQuerierMockRecorder) fiatGetJournalTransactionForAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetJournalTransactionForAccount", reflect.TypeOf((*MockQuerier)(nil).fiatGetJournalTransactionForAccount), arg0, arg1)
+}
+
+// fiatHoldCreate mocks base method.
+func (m *MockQuerier) fiatHoldCreate(arg0 context.Context, arg1 *fiatHoldCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatHoldCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatHoldCreate indicates an expected call of fiatHoldCreate.
+func (mr *MockQuerierMockRecorder) fiatHoldCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatHoldCreate", reflect.TypeOf((*MockQuerier)(nil).fiatHoldCreate), arg0, arg1)
+}
+
+// fiatHoldPurgeExpired mocks base method.
+func (m *MockQuerier) fiatHoldPurgeExpired(arg0 context.Context, arg1 *fiatHoldPurgeExpiredParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatHoldPurgeExpired", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatHoldPurgeExpired indicates an expected call of fiatHoldPurgeExpired.
+func (mr *MockQuerierMockRecorder) fiatHoldPurgeExpired(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatHoldPurgeExpired", reflect.TypeOf((*MockQuerier)(nil).fiatHoldPurgeExpired), arg0, arg1)
+}
+
+// fiatHoldRelease mocks base method.
+func (m *MockQuerier) fiatHoldRelease(arg0 context.Context, arg1 *fiatHoldReleaseParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatHoldRelease", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatHoldRelease indicates an expected call of fiatHoldRelease.
+func (mr *MockQuerierMockRecorder) fiatHoldRelease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatHoldRelease", reflect.TypeOf((*MockQuerier)(nil).fiatHoldRelease), arg0, arg1)
+}
+
+// fiatHoldTotal mocks base method.
+func (m *MockQuerier) fiatHoldTotal(arg0 context.Context, arg1 *fiatHoldTotalParams) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatHoldTotal", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatHoldTotal indicates an expected call of fiatHoldTotal.
+func (mr *MockQuerierMockRecorder) fiatHoldTotal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatHoldTotal", reflect.TypeOf((*MockQuerier)(nil).fiatHoldTotal), arg0, arg1)
+}
+
+// fiatInternalTransferJournalEntry mocks base method.
+func (m *MockQuerier) fiatInternalTransferJournalEntry(arg0 context.Context, arg1 *fiatInternalTransferJournalEntryParams) (fiatInternalTransferJournalEntryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatInternalTransferJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(fiatInternalTransferJournalEntryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatInternalTransferJournalEntry indicates an expected call of fiatInternalTransferJournalEntry.
+func (mr *MockQuerierMockRecorder) fiatInternalTransferJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatInternalTransferJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatInternalTransferJournalEntry), arg0, arg1)
+}
+
+// fiatJournalChain mocks base method.
+func (m *MockQuerier) fiatJournalChain(arg0 context.Context, arg1 *fiatJournalChainParams) ([]fiatJournalChainRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatJournalChain", arg0, arg1)
+	ret0, _ := ret[0].([]fiatJournalChainRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatJournalChain indicates an expected call of fiatJournalChain.
+func (mr *MockQuerierMockRecorder) fiatJournalChain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatJournalChain", reflect.TypeOf((*MockQuerier)(nil).fiatJournalChain), arg0, arg1)
+}
+
+// fiatJournalReversalEntries mocks base method.
+func (m *MockQuerier) fiatJournalReversalEntries(arg0 context.Context, arg1 uuid.UUID) ([]fiatJournalReversalEntriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatJournalReversalEntries", arg0, arg1)
+	ret0, _ := ret[0].([]fiatJournalReversalEntriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatJournalReversalEntries indicates an expected call of fiatJournalReversalEntries.
+func (mr *MockQuerierMockRecorder) fiatJournalReversalEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatJournalReversalEntries", reflect.TypeOf((*MockQuerier)(nil).fiatJournalReversalEntries), arg0, arg1)
+}
+
+// fiatJournalReverse mocks base method.
+func (m *MockQuerier) fiatJournalReverse(arg0 context.Context, arg1 *fiatJournalReverseParams) ([]FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatJournalReverse", arg0, arg1)
+	ret0, _ := ret[0].([]FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatJournalReverse indicates an expected call of fiatJournalReverse.
+func (mr *MockQuerierMockRecorder) fiatJournalReverse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatJournalReverse", reflect.TypeOf((*MockQuerier)(nil).fiatJournalReverse), arg0, arg1)
+}
+
+// fiatRowLockAccount mocks base method.
+func (m *MockQuerier) fiatRowLockAccount(arg0 context.Context, arg1 *fiatRowLockAccountParams) (fiatRowLockAccountRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatRowLockAccount", arg0, arg1)
+	ret0, _ := ret[0].(fiatRowLockAccountRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatRowLockAccount indicates an expected call of fiatRowLockAccount.
+func (mr *MockQuerierMockRecorder) fiatRowLockAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatRowLockAccount", reflect.TypeOf((*MockQuerier)(nil).fiatRowLockAccount), arg0, arg1)
+}
+
+// fiatSetAccountStatus mocks base method.
+func (m *MockQuerier) fiatSetAccountStatus(arg0 context.Context, arg1 *fiatSetAccountStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatSetAccountStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatSetAccountStatus indicates an expected call of fiatSetAccountStatus.
+func (mr *MockQuerierMockRecorder) fiatSetAccountStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatSetAccountStatus", reflect.TypeOf((*MockQuerier)(nil).fiatSetAccountStatus), arg0, arg1)
+}
+
+// fiatUpdateAccountBalance mocks base method.
+func (m *MockQuerier) fiatUpdateAccountBalance(arg0 context.Context, arg1 *fiatUpdateAccountBalanceParams) (fiatUpdateAccountBalanceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatUpdateAccountBalance", arg0, arg1)
+	ret0, _ := ret[0].(fiatUpdateAccountBalanceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatUpdateAccountBalance indicates an expected call of fiatUpdateAccountBalance.
+func (mr *MockQuerierMockRecorder) fiatUpdateAccountBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatUpdateAccountBalance", reflect.TypeOf((*MockQuerier)(nil).fiatUpdateAccountBalance), arg0, arg1)
+}
+
+// journalChainHeads mocks base method.
+func (m *MockQuerier) journalChainHeads(arg0 context.Context) ([]JournalChainHead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalChainHeads", arg0)
+	ret0, _ := ret[0].([]JournalChainHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalChainHeads indicates an expected call of journalChainHeads.
+func (mr *MockQuerierMockRecorder) journalChainHeads(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalChainHeads", reflect.TypeOf((*MockQuerier)(nil).journalChainHeads), arg0)
+}
+
+// journalCheckpointCreate mocks base method.
+func (m *MockQuerier) journalCheckpointCreate(arg0 context.Context, arg1 *journalCheckpointCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalCheckpointCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalCheckpointCreate indicates an expected call of journalCheckpointCreate.
+func (mr *MockQuerierMockRecorder) journalCheckpointCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalCheckpointCreate", reflect.TypeOf((*MockQuerier)(nil).journalCheckpointCreate), arg0, arg1)
+}
+
+// journalCheckpoints mocks base method.
+func (m *MockQuerier) journalCheckpoints(arg0 context.Context, arg1 *journalCheckpointsParams) ([]JournalCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalCheckpoints", arg0, arg1)
+	ret0, _ := ret[0].([]JournalCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalCheckpoints indicates an expected call of journalCheckpoints.
+func (mr *MockQuerierMockRecorder) journalCheckpoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalCheckpoints", reflect.TypeOf((*MockQuerier)(nil).journalCheckpoints), arg0, arg1)
+}
+
+// journalPartitionArchive mocks base method.
+func (m *MockQuerier) journalPartitionArchive(arg0 context.Context, arg1 *journalPartitionArchiveParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalPartitionArchive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// journalPartitionArchive indicates an expected call of journalPartitionArchive.
+func (mr *MockQuerierMockRecorder) journalPartitionArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalPartitionArchive", reflect.TypeOf((*MockQuerier)(nil).journalPartitionArchive), arg0, arg1)
+}
+
+// journalPartitionCreate mocks base method.
+func (m *MockQuerier) journalPartitionCreate(arg0 context.Context, arg1 *journalPartitionCreateParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalPartitionCreate", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalPartitionCreate indicates an expected call of journalPartitionCreate.
+func (mr *MockQuerierMockRecorder) journalPartitionCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalPartitionCreate", reflect.TypeOf((*MockQuerier)(nil).journalPartitionCreate), arg0, arg1)
+}
+
+// journalPartitionDrop mocks base method.
+func (m *MockQuerier) journalPartitionDrop(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalPartitionDrop", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// journalPartitionDrop indicates an expected call of journalPartitionDrop.
+func (mr *MockQuerierMockRecorder) journalPartitionDrop(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalPartitionDrop", reflect.TypeOf((*MockQuerier)(nil).journalPartitionDrop), arg0, arg1)
+}
+
+// journalPartitions mocks base method.
+func (m *MockQuerier) journalPartitions(arg0 context.Context) ([]journalPartitionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalPartitions", arg0)
+	ret0, _ := ret[0].([]journalPartitionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalPartitions indicates an expected call of journalPartitions.
+func (mr *MockQuerierMockRecorder) journalPartitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalPartitions", reflect.TypeOf((*MockQuerier)(nil).journalPartitions), arg0)
+}
+
+// journalReversalCreate mocks base method.
+func (m *MockQuerier) journalReversalCreate(arg0 context.Context, arg1 *journalReversalCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalReversalCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalReversalCreate indicates an expected call of journalReversalCreate.
+func (mr *MockQuerierMockRecorder) journalReversalCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalReversalCreate", reflect.TypeOf((*MockQuerier)(nil).journalReversalCreate), arg0, arg1)
+}
+
+// journalReversalGet mocks base method.
+func (m *MockQuerier) journalReversalGet(arg0 context.Context, arg1 uuid.UUID) (JournalReversal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "journalReversalGet", arg0, arg1)
+	ret0, _ := ret[0].(JournalReversal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// journalReversalGet indicates an expected call of journalReversalGet.
+func (mr *MockQuerierMockRecorder) journalReversalGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "journalReversalGet", reflect.TypeOf((*MockQuerier)(nil).journalReversalGet), arg0, arg1)
+}
+
+// limitOrderCreate mocks base method.
+func (m *MockQuerier) limitOrderCreate(arg0 context.Context, arg1 *limitOrderCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderCreate indicates an expected call of limitOrderCreate.
+func (mr *MockQuerierMockRecorder) limitOrderCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderCreate", reflect.TypeOf((*MockQuerier)(nil).limitOrderCreate), arg0, arg1)
+}
+
+// limitOrderEventCreate mocks base method.
+func (m *MockQuerier) limitOrderEventCreate(arg0 context.Context, arg1 *limitOrderEventCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderEventCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderEventCreate indicates an expected call of limitOrderEventCreate.
+func (mr *MockQuerierMockRecorder) limitOrderEventCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderEventCreate", reflect.TypeOf((*MockQuerier)(nil).limitOrderEventCreate), arg0, arg1)
+}
+
+// limitOrderExpire mocks base method.
+func (m *MockQuerier) limitOrderExpire(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderExpire", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderExpire indicates an expected call of limitOrderExpire.
+func (mr *MockQuerierMockRecorder) limitOrderExpire(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderExpire", reflect.TypeOf((*MockQuerier)(nil).limitOrderExpire), arg0)
+}
+
+// limitOrderGet mocks base method.
+func (m *MockQuerier) limitOrderGet(arg0 context.Context, arg1 *limitOrderGetParams) (CryptoLimitOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderGet", arg0, arg1)
+	ret0, _ := ret[0].(CryptoLimitOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderGet indicates an expected call of limitOrderGet.
+func (mr *MockQuerierMockRecorder) limitOrderGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderGet", reflect.TypeOf((*MockQuerier)(nil).limitOrderGet), arg0, arg1)
+}
+
+// limitOrderGetEvents mocks base method.
+func (m *MockQuerier) limitOrderGetEvents(arg0 context.Context, arg1 string) ([]CryptoLimitOrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderGetEvents", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoLimitOrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderGetEvents indicates an expected call of limitOrderGetEvents.
+func (mr *MockQuerierMockRecorder) limitOrderGetEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderGetEvents", reflect.TypeOf((*MockQuerier)(nil).limitOrderGetEvents), arg0, arg1)
+}
+
+// limitOrderGetOpen mocks base method.
+func (m *MockQuerier) limitOrderGetOpen(arg0 context.Context, arg1 *limitOrderGetOpenParams) ([]CryptoLimitOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderGetOpen", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoLimitOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderGetOpen indicates an expected call of limitOrderGetOpen.
+func (mr *MockQuerierMockRecorder) limitOrderGetOpen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderGetOpen", reflect.TypeOf((*MockQuerier)(nil).limitOrderGetOpen), arg0, arg1)
+}
+
+// limitOrderGetPaginated mocks base method.
+func (m *MockQuerier) limitOrderGetPaginated(arg0 context.Context, arg1 *limitOrderGetPaginatedParams) ([]CryptoLimitOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoLimitOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderGetPaginated indicates an expected call of limitOrderGetPaginated.
+func (mr *MockQuerierMockRecorder) limitOrderGetPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderGetPaginated", reflect.TypeOf((*MockQuerier)(nil).limitOrderGetPaginated), arg0, arg1)
+}
+
+// limitOrderRowLock mocks base method.
+func (m *MockQuerier) limitOrderRowLock(arg0 context.Context, arg1 *limitOrderRowLockParams) (CryptoLimitOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderRowLock", arg0, arg1)
+	ret0, _ := ret[0].(CryptoLimitOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderRowLock indicates an expected call of limitOrderRowLock.
+func (mr *MockQuerierMockRecorder) limitOrderRowLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderRowLock", reflect.TypeOf((*MockQuerier)(nil).limitOrderRowLock), arg0, arg1)
+}
+
+// limitOrderUpdateStatus mocks base method.
+func (m *MockQuerier) limitOrderUpdateStatus(arg0 context.Context, arg1 *limitOrderUpdateStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "limitOrderUpdateStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// limitOrderUpdateStatus indicates an expected call of limitOrderUpdateStatus.
+func (mr *MockQuerierMockRecorder) limitOrderUpdateStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "limitOrderUpdateStatus", reflect.TypeOf((*MockQuerier)(nil).limitOrderUpdateStatus), arg0, arg1)
+}
+
+// loginLockoutCreate mocks base method.
+func (m *MockQuerier) loginLockoutCreate(arg0 context.Context, arg1 *loginLockoutCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "loginLockoutCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// loginLockoutCreate indicates an expected call of loginLockoutCreate.
+func (mr *MockQuerierMockRecorder) loginLockoutCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "loginLockoutCreate", reflect.TypeOf((*MockQuerier)(nil).loginLockoutCreate), arg0, arg1)
+}
+
+// loginLockoutUnlock mocks base method.
+func (m *MockQuerier) loginLockoutUnlock(arg0 context.Context, arg1 *loginLockoutUnlockParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "loginLockoutUnlock", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// loginLockoutUnlock indicates an expected call of loginLockoutUnlock.
+func (mr *MockQuerierMockRecorder) loginLockoutUnlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "loginLockoutUnlock", reflect.TypeOf((*MockQuerier)(nil).loginLockoutUnlock), arg0, arg1)
+}
+
+// loginLockoutsRecent mocks base method.
+func (m *MockQuerier) loginLockoutsRecent(arg0 context.Context, arg1 *loginLockoutsRecentParams) ([]LoginLockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "loginLockoutsRecent", arg0, arg1)
+	ret0, _ := ret[0].([]LoginLockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// loginLockoutsRecent indicates an expected call of loginLockoutsRecent.
+func (mr *MockQuerierMockRecorder) loginLockoutsRecent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "loginLockoutsRecent", reflect.TypeOf((*MockQuerier)(nil).loginLockoutsRecent), arg0, arg1)
+}
+
+// mfaConfirm mocks base method.
+func (m *MockQuerier) mfaConfirm(arg0 context.Context, arg1 *mfaConfirmParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaConfirm", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaConfirm indicates an expected call of mfaConfirm.
+func (mr *MockQuerierMockRecorder) mfaConfirm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaConfirm", reflect.TypeOf((*MockQuerier)(nil).mfaConfirm), arg0, arg1)
+}
+
+// mfaDelete mocks base method.
+func (m *MockQuerier) mfaDelete(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaDelete", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaDelete indicates an expected call of mfaDelete.
+func (mr *MockQuerierMockRecorder) mfaDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaDelete", reflect.TypeOf((*MockQuerier)(nil).mfaDelete), arg0, arg1)
+}
+
+// mfaEnroll mocks base method.
+func (m *MockQuerier) mfaEnroll(arg0 context.Context, arg1 *mfaEnrollParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaEnroll", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaEnroll indicates an expected call of mfaEnroll.
+func (mr *MockQuerierMockRecorder) mfaEnroll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaEnroll", reflect.TypeOf((*MockQuerier)(nil).mfaEnroll), arg0, arg1)
+}
+
+// mfaGet mocks base method.
+func (m *MockQuerier) mfaGet(arg0 context.Context, arg1 uuid.UUID) (UserMFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaGet", arg0, arg1)
+	ret0, _ := ret[0].(UserMFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaGet indicates an expected call of mfaGet.
+func (mr *MockQuerierMockRecorder) mfaGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaGet", reflect.TypeOf((*MockQuerier)(nil).mfaGet), arg0, arg1)
+}
+
+// mfaSecrets mocks base method.
+func (m *MockQuerier) mfaSecrets(arg0 context.Context) ([]mfaSecretsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaSecrets", arg0)
+	ret0, _ := ret[0].([]mfaSecretsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaSecrets indicates an expected call of mfaSecrets.
+func (mr *MockQuerierMockRecorder) mfaSecrets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaSecrets", reflect.TypeOf((*MockQuerier)(nil).mfaSecrets), arg0)
+}
+
+// mfaSetRecoveryCodes mocks base method.
+func (m *MockQuerier) mfaSetRecoveryCodes(arg0 context.Context, arg1 *mfaSetRecoveryCodesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaSetRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaSetRecoveryCodes indicates an expected call of mfaSetRecoveryCodes.
+func (mr *MockQuerierMockRecorder) mfaSetRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaSetRecoveryCodes", reflect.TypeOf((*MockQuerier)(nil).mfaSetRecoveryCodes), arg0, arg1)
+}
+
+// mfaSetSecret mocks base method.
+func (m *MockQuerier) mfaSetSecret(arg0 context.Context, arg1 *mfaSetSecretParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaSetSecret", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaSetSecret indicates an expected call of mfaSetSecret.
+func (mr *MockQuerierMockRecorder) mfaSetSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaSetSecret", reflect.TypeOf((*MockQuerier)(nil).mfaSetSecret), arg0, arg1)
+}
+
+// mfaUseRecoveryCode mocks base method.
+func (m *MockQuerier) mfaUseRecoveryCode(arg0 context.Context, arg1 *mfaUseRecoveryCodeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaUseRecoveryCode", arg0, arg1)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// cryptoCreateAccount indicates an expected call of cryptoCreateAccount.
-func (mr *MockQuerierMockRecorder) cryptoCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+// mfaUseRecoveryCode indicates an expected call of mfaUseRecoveryCode.
+func (mr *MockQuerierMockRecorder) mfaUseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoCreateAccount", reflect.TypeOf((*MockQuerier)(nil).cryptoCreateAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaUseRecoveryCode", reflect.TypeOf((*MockQuerier)(nil).mfaUseRecoveryCode), arg0, arg1)
 }
 
-// cryptoGetAccount mocks base method.
-func (m *MockQuerier) cryptoGetAccount(arg0 context.Context, arg1 *cryptoGetAccountParams) (CryptoAccount, error) {
+// mfaUseStep mocks base method.
+func (m *MockQuerier) mfaUseStep(arg0 context.Context, arg1 *mfaUseStepParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoGetAccount", arg0, arg1)
-	ret0, _ := ret[0].(CryptoAccount)
+	ret := m.ctrl.Call(m, "mfaUseStep", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// cryptoGetAccount indicates an expected call of cryptoGetAccount.
-func (mr *MockQuerierMockRecorder) cryptoGetAccount(arg0, arg1 interface{}) *gomock.Call {
+// mfaUseStep indicates an expected call of mfaUseStep.
+func (mr *MockQuerierMockRecorder) mfaUseStep(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetAccount", reflect.TypeOf((*MockQuerier)(nil).cryptoGetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaUseStep", reflect.TypeOf((*MockQuerier)(nil).mfaUseStep), arg0, arg1)
 }
 
-// cryptoGetAllAccounts mocks base method.
-func (m *MockQuerier) cryptoGetAllAccounts(arg0 context.Context, arg1 *cryptoGetAllAccountsParams) ([]CryptoAccount, error) {
+// recurringPurchaseAdvance mocks base method.
+func (m *MockQuerier) recurringPurchaseAdvance(arg0 context.Context, arg1 *recurringPurchaseAdvanceParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoGetAllAccounts", arg0, arg1)
-	ret0, _ := ret[0].([]CryptoAccount)
+	ret := m.ctrl.Call(m, "recurringPurchaseAdvance", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// cryptoGetAllAccounts indicates an expected call of cryptoGetAllAccounts.
-func (mr *MockQuerierMockRecorder) cryptoGetAllAccounts(arg0, arg1 interface{}) *gomock.Call {
+// recurringPurchaseAdvance indicates an expected call of recurringPurchaseAdvance.
+func (mr *MockQuerierMockRecorder) recurringPurchaseAdvance(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetAllAccounts", reflect.TypeOf((*MockQuerier)(nil).cryptoGetAllAccounts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseAdvance", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseAdvance), arg0, arg1)
 }
 
-// cryptoGetAllJournalTransactionsPaginated mocks base method.
-func (m *MockQuerier) cryptoGetAllJournalTransactionsPaginated(arg0 context.Context, arg1 *cryptoGetAllJournalTransactionsPaginatedParams) ([]CryptoJournal, error) {
+// recurringPurchaseCreate mocks base method.
+func (m *MockQuerier) recurringPurchaseCreate(arg0 context.Context, arg1 *recurringPurchaseCreateParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoGetAllJournalTransactionsPaginated", arg0, arg1)
-	ret0, _ := ret[0].([]CryptoJournal)
+	ret := m.ctrl.Call(m, "recurringPurchaseCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// cryptoGetAllJournalTransactionsPaginated indicates an expected call of cryptoGetAllJournalTransactionsPaginated.
-func (mr *MockQuerierMockRecorder) cryptoGetAllJournalTransactionsPaginated(arg0, arg1 interface{}) *gomock.Call {
+// recurringPurchaseCreate indicates an expected call of recurringPurchaseCreate.
+func (mr *MockQuerierMockRecorder) recurringPurchaseCreate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetAllJournalTransactionsPaginated", reflect.TypeOf((*MockQuerier)(nil).cryptoGetAllJournalTransactionsPaginated), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseCreate", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseCreate), arg0, arg1)
 }
 
-// cryptoGetJournalTransaction mocks base method.
-func (m *MockQuerier) cryptoGetJournalTransaction(arg0 context.Context, arg1 *cryptoGetJournalTransactionParams) ([]CryptoJournal, error) {
+// recurringPurchaseGet mocks base method.
+func (m *MockQuerier) recurringPurchaseGet(arg0 context.Context, arg1 *recurringPurchaseGetParams) (CryptoRecurringPurchase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoGetJournalTransaction", arg0, arg1)
-	ret0, _ := ret[0].([]CryptoJournal)
+	ret := m.ctrl.Call(m, "recurringPurchaseGet", arg0, arg1)
+	ret0, _ := ret[0].(CryptoRecurringPurchase)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// cryptoGetJournalTransaction indicates an expected call of cryptoGetJournalTransaction.
-func (mr *MockQuerierMockRecorder) cryptoGetJournalTransaction(arg0, arg1 interface{}) *gomock.Call {
+// recurringPurchaseGet indicates an expected call of recurringPurchaseGet.
+func (mr *MockQuerierMockRecorder) recurringPurchaseGet(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoGetJournalTransaction", reflect.TypeOf((*MockQuerier)(nil).cryptoGetJournalTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseGet", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseGet), arg0, arg1)
 }
 
-// cryptoPurchase mocks base method.
-func (m *MockQuerier) cryptoPurchase(arg0 context.Context, arg1 *cryptoPurchaseParams) error {
+// recurringPurchaseGetDue mocks base method.
+func (m *MockQuerier) recurringPurchaseGetDue(arg0 context.Context, arg1 *recurringPurchaseGetDueParams) ([]CryptoRecurringPurchase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoPurchase", arg0, arg1)
+	ret := m.ctrl.Call(m, "recurringPurchaseGetDue", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoRecurringPurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// recurringPurchaseGetDue indicates an expected call of recurringPurchaseGetDue.
+func (mr *MockQuerierMockRecorder) recurringPurchaseGetDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseGetDue", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseGetDue), arg0, arg1)
+}
+
+// recurringPurchaseGetPaginated mocks base method.
+func (m *MockQuerier) recurringPurchaseGetPaginated(arg0 context.Context, arg1 *recurringPurchaseGetPaginatedParams) ([]CryptoRecurringPurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "recurringPurchaseGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoRecurringPurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// recurringPurchaseGetPaginated indicates an expected call of recurringPurchaseGetPaginated.
+func (mr *MockQuerierMockRecorder) recurringPurchaseGetPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseGetPaginated", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseGetPaginated), arg0, arg1)
+}
+
+// recurringPurchaseRowLock mocks base method.
+func (m *MockQuerier) recurringPurchaseRowLock(arg0 context.Context, arg1 *recurringPurchaseRowLockParams) (CryptoRecurringPurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "recurringPurchaseRowLock", arg0, arg1)
+	ret0, _ := ret[0].(CryptoRecurringPurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// recurringPurchaseRowLock indicates an expected call of recurringPurchaseRowLock.
+func (mr *MockQuerierMockRecorder) recurringPurchaseRowLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseRowLock", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseRowLock), arg0, arg1)
+}
+
+// recurringPurchaseRunCreate mocks base method.
+func (m *MockQuerier) recurringPurchaseRunCreate(arg0 context.Context, arg1 *recurringPurchaseRunCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "recurringPurchaseRunCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// recurringPurchaseRunCreate indicates an expected call of recurringPurchaseRunCreate.
+func (mr *MockQuerierMockRecorder) recurringPurchaseRunCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseRunCreate", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseRunCreate), arg0, arg1)
+}
+
+// recurringPurchaseRunGetPaginated mocks base method.
+func (m *MockQuerier) recurringPurchaseRunGetPaginated(arg0 context.Context, arg1 *recurringPurchaseRunGetPaginatedParams) ([]CryptoRecurringPurchaseRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "recurringPurchaseRunGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoRecurringPurchaseRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// recurringPurchaseRunGetPaginated indicates an expected call of recurringPurchaseRunGetPaginated.
+func (mr *MockQuerierMockRecorder) recurringPurchaseRunGetPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseRunGetPaginated", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseRunGetPaginated), arg0, arg1)
+}
+
+// recurringPurchaseUpdateStatus mocks base method.
+func (m *MockQuerier) recurringPurchaseUpdateStatus(arg0 context.Context, arg1 *recurringPurchaseUpdateStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "recurringPurchaseUpdateStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// recurringPurchaseUpdateStatus indicates an expected call of recurringPurchaseUpdateStatus.
+func (mr *MockQuerierMockRecorder) recurringPurchaseUpdateStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "recurringPurchaseUpdateStatus", reflect.TypeOf((*MockQuerier)(nil).recurringPurchaseUpdateStatus), arg0, arg1)
+}
+
+// refreshSessionGetClient mocks base method.
+func (m *MockQuerier) refreshSessionGetClient(arg0 context.Context, arg1 uuid.UUID) ([]refreshSessionGetClientRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "refreshSessionGetClient", arg0, arg1)
+	ret0, _ := ret[0].([]refreshSessionGetClientRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// refreshSessionGetClient indicates an expected call of refreshSessionGetClient.
+func (mr *MockQuerierMockRecorder) refreshSessionGetClient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshSessionGetClient", reflect.TypeOf((*MockQuerier)(nil).refreshSessionGetClient), arg0, arg1)
+}
+
+// refreshSessionRevoke mocks base method.
+func (m *MockQuerier) refreshSessionRevoke(arg0 context.Context, arg1 *refreshSessionRevokeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "refreshSessionRevoke", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// refreshSessionRevoke indicates an expected call of refreshSessionRevoke.
+func (mr *MockQuerierMockRecorder) refreshSessionRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshSessionRevoke", reflect.TypeOf((*MockQuerier)(nil).refreshSessionRevoke), arg0, arg1)
+}
+
+// refreshSessionRevokeAll mocks base method.
+func (m *MockQuerier) refreshSessionRevokeAll(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "refreshSessionRevokeAll", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// cryptoPurchase indicates an expected call of cryptoPurchase.
-func (mr *MockQuerierMockRecorder) cryptoPurchase(arg0, arg1 interface{}) *gomock.Call {
+// refreshSessionRevokeAll indicates an expected call of refreshSessionRevokeAll.
+func (mr *MockQuerierMockRecorder) refreshSessionRevokeAll(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoPurchase", reflect.TypeOf((*MockQuerier)(nil).cryptoPurchase), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshSessionRevokeAll", reflect.TypeOf((*MockQuerier)(nil).refreshSessionRevokeAll), arg0, arg1)
 }
 
-// cryptoSell mocks base method.
-func (m *MockQuerier) cryptoSell(arg0 context.Context, arg1 *cryptoSellParams) error {
+// refreshTokenCreate mocks base method.
+func (m *MockQuerier) refreshTokenCreate(arg0 context.Context, arg1 *refreshTokenCreateParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "cryptoSell", arg0, arg1)
+	ret := m.ctrl.Call(m, "refreshTokenCreate", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// cryptoSell indicates an expected call of cryptoSell.
-func (mr *MockQuerierMockRecorder) cryptoSell(arg0, arg1 interface{}) *gomock.Call {
+// refreshTokenCreate indicates an expected call of refreshTokenCreate.
+func (mr *MockQuerierMockRecorder) refreshTokenCreate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoSell", reflect.TypeOf((*MockQuerier)(nil).cryptoSell), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshTokenCreate", reflect.TypeOf((*MockQuerier)(nil).refreshTokenCreate), arg0, arg1)
 }
 
-// fiatCreateAccount mocks base method.
-func (m *MockQuerier) fiatCreateAccount(arg0 context.Context, arg1 *fiatCreateAccountParams) (int64, error) {
+// refreshTokenRotate mocks base method.
+func (m *MockQuerier) refreshTokenRotate(arg0 context.Context, arg1 string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatCreateAccount", arg0, arg1)
+	ret := m.ctrl.Call(m, "refreshTokenRotate", arg0, arg1)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatCreateAccount indicates an expected call of fiatCreateAccount.
-func (mr *MockQuerierMockRecorder) fiatCreateAccount(arg0, arg1 interface{}) *gomock.Call {
+// refreshTokenRotate indicates an expected call of refreshTokenRotate.
+func (mr *MockQuerierMockRecorder) refreshTokenRotate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatCreateAccount", reflect.TypeOf((*MockQuerier)(nil).fiatCreateAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshTokenRotate", reflect.TypeOf((*MockQuerier)(nil).refreshTokenRotate), arg0, arg1)
 }
 
-// fiatExternalTransferJournalEntry mocks base method.
-func (m *MockQuerier) fiatExternalTransferJournalEntry(arg0 context.Context, arg1 *fiatExternalTransferJournalEntryParams) (fiatExternalTransferJournalEntryRow, error) {
+// refreshTokenRowLock mocks base method.
+func (m *MockQuerier) refreshTokenRowLock(arg0 context.Context, arg1 string) (RefreshToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatExternalTransferJournalEntry", arg0, arg1)
-	ret0, _ := ret[0].(fiatExternalTransferJournalEntryRow)
+	ret := m.ctrl.Call(m, "refreshTokenRowLock", arg0, arg1)
+	ret0, _ := ret[0].(RefreshToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatExternalTransferJournalEntry indicates an expected call of fiatExternalTransferJournalEntry.
-func (mr *MockQuerierMockRecorder) fiatExternalTransferJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+// refreshTokenRowLock indicates an expected call of refreshTokenRowLock.
+func (mr *MockQuerierMockRecorder) refreshTokenRowLock(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatExternalTransferJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatExternalTransferJournalEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshTokenRowLock", reflect.TypeOf((*MockQuerier)(nil).refreshTokenRowLock), arg0, arg1)
 }
 
-// fiatGetAccount mocks base method.
-func (m *MockQuerier) fiatGetAccount(arg0 context.Context, arg1 *fiatGetAccountParams) (FiatAccount, error) {
+// testRoundHalfEven mocks base method.
+func (m *MockQuerier) testRoundHalfEven(arg0 context.Context, arg1 *testRoundHalfEvenParams) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatGetAccount", arg0, arg1)
-	ret0, _ := ret[0].(FiatAccount)
+	ret := m.ctrl.Call(m, "testRoundHalfEven", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatGetAccount indicates an expected call of fiatGetAccount.
-func (mr *MockQuerierMockRecorder) fiatGetAccount(arg0, arg1 interface{}) *gomock.Call {
+// testRoundHalfEven indicates an expected call of testRoundHalfEven.
+func (mr *MockQuerierMockRecorder) testRoundHalfEven(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAccount", reflect.TypeOf((*MockQuerier)(nil).fiatGetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "testRoundHalfEven", reflect.TypeOf((*MockQuerier)(nil).testRoundHalfEven), arg0, arg1)
 }
 
-// fiatGetAllAccounts mocks base method.
-func (m *MockQuerier) fiatGetAllAccounts(arg0 context.Context, arg1 *fiatGetAllAccountsParams) ([]FiatAccount, error) {
+// triggerOrderCreate mocks base method.
+func (m *MockQuerier) triggerOrderCreate(arg0 context.Context, arg1 *triggerOrderCreateParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatGetAllAccounts", arg0, arg1)
-	ret0, _ := ret[0].([]FiatAccount)
+	ret := m.ctrl.Call(m, "triggerOrderCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatGetAllAccounts indicates an expected call of fiatGetAllAccounts.
-func (mr *MockQuerierMockRecorder) fiatGetAllAccounts(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderCreate indicates an expected call of triggerOrderCreate.
+func (mr *MockQuerierMockRecorder) triggerOrderCreate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAllAccounts", reflect.TypeOf((*MockQuerier)(nil).fiatGetAllAccounts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderCreate", reflect.TypeOf((*MockQuerier)(nil).triggerOrderCreate), arg0, arg1)
 }
 
-// fiatGetAllJournalTransactionsPaginated mocks base method.
-func (m *MockQuerier) fiatGetAllJournalTransactionsPaginated(arg0 context.Context, arg1 *fiatGetAllJournalTransactionsPaginatedParams) ([]FiatJournal, error) {
+// triggerOrderEventCreate mocks base method.
+func (m *MockQuerier) triggerOrderEventCreate(arg0 context.Context, arg1 *triggerOrderEventCreateParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatGetAllJournalTransactionsPaginated", arg0, arg1)
-	ret0, _ := ret[0].([]FiatJournal)
+	ret := m.ctrl.Call(m, "triggerOrderEventCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatGetAllJournalTransactionsPaginated indicates an expected call of fiatGetAllJournalTransactionsPaginated.
-func (mr *MockQuerierMockRecorder) fiatGetAllJournalTransactionsPaginated(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderEventCreate indicates an expected call of triggerOrderEventCreate.
+func (mr *MockQuerierMockRecorder) triggerOrderEventCreate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAllJournalTransactionsPaginated", reflect.TypeOf((*MockQuerier)(nil).fiatGetAllJournalTransactionsPaginated), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderEventCreate", reflect.TypeOf((*MockQuerier)(nil).triggerOrderEventCreate), arg0, arg1)
 }
 
-// fiatGetJournalTransaction mocks base method.
-func (m *MockQuerier) fiatGetJournalTransaction(arg0 context.Context, arg1 *fiatGetJournalTransactionParams) ([]FiatJournal, error) {
+// triggerOrderGet mocks base method.
+func (m *MockQuerier) triggerOrderGet(arg0 context.Context, arg1 *triggerOrderGetParams) (CryptoTriggerOrder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatGetJournalTransaction", arg0, arg1)
-	ret0, _ := ret[0].([]FiatJournal)
+	ret := m.ctrl.Call(m, "triggerOrderGet", arg0, arg1)
+	ret0, _ := ret[0].(CryptoTriggerOrder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatGetJournalTransaction indicates an expected call of fiatGetJournalTransaction.
-func (mr *MockQuerierMockRecorder) fiatGetJournalTransaction(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderGet indicates an expected call of triggerOrderGet.
+func (mr *MockQuerierMockRecorder) triggerOrderGet(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetJournalTransaction", reflect.TypeOf((*MockQuerier)(nil).fiatGetJournalTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderGet", reflect.TypeOf((*MockQuerier)(nil).triggerOrderGet), arg0, arg1)
 }
 
-// fiatGetJournalTransactionForAccount mocks base method.
-func (m *MockQuerier) fiatGetJournalTransactionForAccount(arg0 context.Context, arg1 *fiatGetJournalTransactionForAccountParams) ([]FiatJournal, error) {
+// triggerOrderGetActive mocks base method.
+func (m *MockQuerier) triggerOrderGetActive(arg0 context.Context, arg1 *triggerOrderGetActiveParams) ([]CryptoTriggerOrder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatGetJournalTransactionForAccount", arg0, arg1)
-	ret0, _ := ret[0].([]FiatJournal)
+	ret := m.ctrl.Call(m, "triggerOrderGetActive", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoTriggerOrder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatGetJournalTransactionForAccount indicates an expected call of fiatGetJournalTransactionForAccount.
-func (mr *MockQuerierMockRecorder) fiatGetJournalTransactionForAccount(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderGetActive indicates an expected call of triggerOrderGetActive.
+func (mr *MockQuerierMockRecorder) triggerOrderGetActive(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetJournalTransactionForAccount", reflect.TypeOf((*MockQuerier)(nil).fiatGetJournalTransactionForAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderGetActive", reflect.TypeOf((*MockQuerier)(nil).triggerOrderGetActive), arg0, arg1)
 }
 
-// fiatInternalTransferJournalEntry mocks base method.
-func (m *MockQuerier) fiatInternalTransferJournalEntry(arg0 context.Context, arg1 *fiatInternalTransferJournalEntryParams) (fiatInternalTransferJournalEntryRow, error) {
+// triggerOrderGetEvents mocks base method.
+func (m *MockQuerier) triggerOrderGetEvents(arg0 context.Context, arg1 string) ([]CryptoTriggerOrderEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatInternalTransferJournalEntry", arg0, arg1)
-	ret0, _ := ret[0].(fiatInternalTransferJournalEntryRow)
+	ret := m.ctrl.Call(m, "triggerOrderGetEvents", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoTriggerOrderEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatInternalTransferJournalEntry indicates an expected call of fiatInternalTransferJournalEntry.
-func (mr *MockQuerierMockRecorder) fiatInternalTransferJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderGetEvents indicates an expected call of triggerOrderGetEvents.
+func (mr *MockQuerierMockRecorder) triggerOrderGetEvents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatInternalTransferJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatInternalTransferJournalEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderGetEvents", reflect.TypeOf((*MockQuerier)(nil).triggerOrderGetEvents), arg0, arg1)
 }
 
-// fiatRowLockAccount mocks base method.
-func (m *MockQuerier) fiatRowLockAccount(arg0 context.Context, arg1 *fiatRowLockAccountParams) (decimal.Decimal, error) {
+// triggerOrderGetPaginated mocks base method.
+func (m *MockQuerier) triggerOrderGetPaginated(arg0 context.Context, arg1 *triggerOrderGetPaginatedParams) ([]CryptoTriggerOrder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatRowLockAccount", arg0, arg1)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret := m.ctrl.Call(m, "triggerOrderGetPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]CryptoTriggerOrder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatRowLockAccount indicates an expected call of fiatRowLockAccount.
-func (mr *MockQuerierMockRecorder) fiatRowLockAccount(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderGetPaginated indicates an expected call of triggerOrderGetPaginated.
+func (mr *MockQuerierMockRecorder) triggerOrderGetPaginated(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatRowLockAccount", reflect.TypeOf((*MockQuerier)(nil).fiatRowLockAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderGetPaginated", reflect.TypeOf((*MockQuerier)(nil).triggerOrderGetPaginated), arg0, arg1)
 }
 
-// fiatUpdateAccountBalance mocks base method.
-func (m *MockQuerier) fiatUpdateAccountBalance(arg0 context.Context, arg1 *fiatUpdateAccountBalanceParams) (fiatUpdateAccountBalanceRow, error) {
+// triggerOrderRowLock mocks base method.
+func (m *MockQuerier) triggerOrderRowLock(arg0 context.Context, arg1 *triggerOrderRowLockParams) (CryptoTriggerOrder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "fiatUpdateAccountBalance", arg0, arg1)
-	ret0, _ := ret[0].(fiatUpdateAccountBalanceRow)
+	ret := m.ctrl.Call(m, "triggerOrderRowLock", arg0, arg1)
+	ret0, _ := ret[0].(CryptoTriggerOrder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// fiatUpdateAccountBalance indicates an expected call of fiatUpdateAccountBalance.
-func (mr *MockQuerierMockRecorder) fiatUpdateAccountBalance(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderRowLock indicates an expected call of triggerOrderRowLock.
+func (mr *MockQuerierMockRecorder) triggerOrderRowLock(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatUpdateAccountBalance", reflect.TypeOf((*MockQuerier)(nil).fiatUpdateAccountBalance), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderRowLock", reflect.TypeOf((*MockQuerier)(nil).triggerOrderRowLock), arg0, arg1)
 }
 
-// testRoundHalfEven mocks base method.
-func (m *MockQuerier) testRoundHalfEven(arg0 context.Context, arg1 *testRoundHalfEvenParams) (decimal.Decimal, error) {
+// triggerOrderTrail mocks base method.
+func (m *MockQuerier) triggerOrderTrail(arg0 context.Context, arg1 *triggerOrderTrailParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "testRoundHalfEven", arg0, arg1)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret := m.ctrl.Call(m, "triggerOrderTrail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// testRoundHalfEven indicates an expected call of testRoundHalfEven.
-func (mr *MockQuerierMockRecorder) testRoundHalfEven(arg0, arg1 interface{}) *gomock.Call {
+// triggerOrderTrail indicates an expected call of triggerOrderTrail.
+func (mr *MockQuerierMockRecorder) triggerOrderTrail(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "testRoundHalfEven", reflect.TypeOf((*MockQuerier)(nil).testRoundHalfEven), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderTrail", reflect.TypeOf((*MockQuerier)(nil).triggerOrderTrail), arg0, arg1)
+}
+
+// triggerOrderUpdateStatus mocks base method.
+func (m *MockQuerier) triggerOrderUpdateStatus(arg0 context.Context, arg1 *triggerOrderUpdateStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "triggerOrderUpdateStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// triggerOrderUpdateStatus indicates an expected call of triggerOrderUpdateStatus.
+func (mr *MockQuerierMockRecorder) triggerOrderUpdateStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "triggerOrderUpdateStatus", reflect.TypeOf((*MockQuerier)(nil).triggerOrderUpdateStatus), arg0, arg1)
 }
 
 // userCreate mocks base method.
@@ -379,6 +1936,21 @@ func (mr *MockQuerierMockRecorder) userGetInfo(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userGetInfo", reflect.TypeOf((*MockQuerier)(nil).userGetInfo), arg0, arg1)
 }
 
+// userGetStatus mocks base method.
+func (m *MockQuerier) userGetStatus(arg0 context.Context, arg1 uuid.UUID) (userGetStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userGetStatus", arg0, arg1)
+	ret0, _ := ret[0].(userGetStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userGetStatus indicates an expected call of userGetStatus.
+func (mr *MockQuerierMockRecorder) userGetStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userGetStatus", reflect.TypeOf((*MockQuerier)(nil).userGetStatus), arg0, arg1)
+}
+
 // userIsDeleted mocks base method.
 func (m *MockQuerier) userIsDeleted(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -393,3 +1965,78 @@ func (mr *MockQuerierMockRecorder) userIsDeleted(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userIsDeleted", reflect.TypeOf((*MockQuerier)(nil).userIsDeleted), arg0, arg1)
 }
+
+// userSearch mocks base method.
+func (m *MockQuerier) userSearch(arg0 context.Context, arg1 *userSearchParams) ([]userSearchRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userSearch", arg0, arg1)
+	ret0, _ := ret[0].([]userSearchRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userSearch indicates an expected call of userSearch.
+func (mr *MockQuerierMockRecorder) userSearch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userSearch", reflect.TypeOf((*MockQuerier)(nil).userSearch), arg0, arg1)
+}
+
+// userSetFrozen mocks base method.
+func (m *MockQuerier) userSetFrozen(arg0 context.Context, arg1 *userSetFrozenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userSetFrozen", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userSetFrozen indicates an expected call of userSetFrozen.
+func (mr *MockQuerierMockRecorder) userSetFrozen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userSetFrozen", reflect.TypeOf((*MockQuerier)(nil).userSetFrozen), arg0, arg1)
+}
+
+// userUpdatePassword mocks base method.
+func (m *MockQuerier) userUpdatePassword(arg0 context.Context, arg1 *userUpdatePasswordParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userUpdatePassword", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userUpdatePassword indicates an expected call of userUpdatePassword.
+func (mr *MockQuerierMockRecorder) userUpdatePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userUpdatePassword", reflect.TypeOf((*MockQuerier)(nil).userUpdatePassword), arg0, arg1)
+}
+
+// userUpdateProfile mocks base method.
+func (m *MockQuerier) userUpdateProfile(arg0 context.Context, arg1 *userUpdateProfileParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userUpdateProfile", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userUpdateProfile indicates an expected call of userUpdateProfile.
+func (mr *MockQuerierMockRecorder) userUpdateProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userUpdateProfile", reflect.TypeOf((*MockQuerier)(nil).userUpdateProfile), arg0, arg1)
+}
+
+// userVerifyEmail mocks base method.
+func (m *MockQuerier) userVerifyEmail(arg0 context.Context, arg1 *userVerifyEmailParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userVerifyEmail indicates an expected call of userVerifyEmail.
+func (mr *MockQuerierMockRecorder) userVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userVerifyEmail", reflect.TypeOf((*MockQuerier)(nil).userVerifyEmail), arg0, arg1)
+}
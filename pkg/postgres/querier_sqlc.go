@@ -8,33 +8,134 @@ import (
 	"context"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
-	// cryptoCreateAccount inserts a fiat account record.
+	// adminAuditLogCreate will record an administrative action in the audit log and return the id of the entry.
+	adminAuditLogCreate(ctx context.Context, arg *adminAuditLogCreateParams) (int64, error)
+	// adminAuditLogGetPaginated will retrieve a page of audit log entries, newest first, starting from an entry id. The
+	// entries can be restricted to those for a specific target.
+	adminAuditLogGetPaginated(ctx context.Context, arg *adminAuditLogGetPaginatedParams) ([]AdminAuditLog, error)
+	// adminAuditLogSetOutcome will record the outcome of an administrative action once it has been carried out. The outcome
+	// of an action can only be recorded once.
+	adminAuditLogSetOutcome(ctx context.Context, arg *adminAuditLogSetOutcomeParams) (int64, error)
+	// apiKeyCreate will record an API key for a client if they hold fewer than the maximum number of active API keys.
+	apiKeyCreate(ctx context.Context, arg *apiKeyCreateParams) (int64, error)
+	// apiKeyGet will retrieve an API key along with its encrypted secret.
+	apiKeyGet(ctx context.Context, keyID string) (APIKey, error)
+	// apiKeyGetClient will retrieve the details, without the secrets, of all the API keys a client has created, newest
+	// first.
+	apiKeyGetClient(ctx context.Context, clientID uuid.UUID) ([]apiKeyGetClientRow, error)
+	// apiKeyRevoke will revoke an active API key belonging to a client.
+	apiKeyRevoke(ctx context.Context, arg *apiKeyRevokeParams) (int64, error)
+	// apiKeySecrets will retrieve the encrypted secrets of all the API keys that have not been revoked.
+	apiKeySecrets(ctx context.Context) ([]apiKeySecretsRow, error)
+	// apiKeySetSecret will replace the encrypted secret of an API key if it has not changed since it was retrieved.
+	apiKeySetSecret(ctx context.Context, arg *apiKeySetSecretParams) (int64, error)
+	// auditEventCreate will record a security or account event in the append-only audit log.
+	auditEventCreate(ctx context.Context, arg *auditEventCreateParams) (int64, error)
+	// auditEventsGetPaginated will retrieve a page of audit events, newest first, starting from an event id. The events can
+	// be restricted to those about a specific subject, of a specific type, or with a specific outcome.
+	auditEventsGetPaginated(ctx context.Context, arg *auditEventsGetPaginatedParams) ([]AuditEvent, error)
+	// auditEventsRecent will retrieve the most recent events about a client, newest first, excluding administrative actions.
+	auditEventsRecent(ctx context.Context, arg *auditEventsRecentParams) ([]auditEventsRecentRow, error)
+	// balanceSnapshotLatest will retrieve the time of the most recent Fiat or Crypto balance snapshot.
+	balanceSnapshotLatest(ctx context.Context) (pgtype.Timestamptz, error)
+	// cryptoAssetGet will retrieve the registry entry for a specific Cryptocurrency ticker.
+	cryptoAssetGet(ctx context.Context, ticker string) (CryptoAsset, error)
+	// cryptoAssetGetAll will retrieve all the registered Cryptocurrencies.
+	cryptoAssetGetAll(ctx context.Context) ([]CryptoAsset, error)
+	// cryptoAssetSetStatus will enable or halt trading for a specific Cryptocurrency.
+	cryptoAssetSetStatus(ctx context.Context, arg *cryptoAssetSetStatusParams) (int64, error)
+	// cryptoAssetUpsert will register a new Cryptocurrency or update the details of an existing one.
+	cryptoAssetUpsert(ctx context.Context, arg *cryptoAssetUpsertParams) (int64, error)
+	// cryptoBalanceAsOf will compute the balance of a Crypto account at a point in time from the nearest snapshot at or
+	// before it and the journal entries posted from the snapshot up to the point in time.
+	cryptoBalanceAsOf(ctx context.Context, arg *cryptoBalanceAsOfParams) (cryptoBalanceAsOfRow, error)
+	// cryptoBalanceHistory will retrieve the daily closing balances of a Crypto account within a time range, oldest first.
+	cryptoBalanceHistory(ctx context.Context, arg *cryptoBalanceHistoryParams) ([]CryptoBalanceSnapshot, error)
+	// cryptoBalanceSnapshotCreate will record the closing balances of all Crypto accounts opened before the snapshot time.
+	// Each closing balance is the previous snapshot's balance carried forward with the journal entries posted since it.
+	cryptoBalanceSnapshotCreate(ctx context.Context, snapshotAt pgtype.Timestamptz) (int64, error)
+	// cryptoCloseAccount will mark a Crypto account with a zero balance as closed.
+	cryptoCloseAccount(ctx context.Context, arg *cryptoCloseAccountParams) (int64, error)
+	// cryptoCreateAccount inserts a fiat account record. Accounts can only be opened for enabled Cryptocurrencies. A
+	// closed account will be reopened.
 	cryptoCreateAccount(ctx context.Context, arg *cryptoCreateAccountParams) (int64, error)
-	// cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker.
-	cryptoGetAccount(ctx context.Context, arg *cryptoGetAccountParams) (CryptoAccount, error)
-	// cryptoGetAllAccounts will retrieve all accounts associated with a specific user.
-	cryptoGetAllAccounts(ctx context.Context, arg *cryptoGetAllAccountsParams) ([]CryptoAccount, error)
+	// cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker with the ledger and
+	// available balances.
+	cryptoGetAccount(ctx context.Context, arg *cryptoGetAccountParams) (CryptoAccountBalance, error)
+	// cryptoGetAllAccounts will retrieve all open accounts associated with a specific user with the ledger and available
+	// balances.
+	cryptoGetAllAccounts(ctx context.Context, arg *cryptoGetAllAccountsParams) ([]CryptoAccountBalance, error)
 	// cryptoGetAllJournalTransactionsPaginated will retrieve the journal entries associated with a specific account
 	// in a date range.
 	cryptoGetAllJournalTransactionsPaginated(ctx context.Context, arg *cryptoGetAllJournalTransactionsPaginatedParams) ([]CryptoJournal, error)
 	// cryptoGetJournalTransaction will retrieve the journal entries associated with a transaction.
 	cryptoGetJournalTransaction(ctx context.Context, arg *cryptoGetJournalTransactionParams) ([]CryptoJournal, error)
+	// cryptoHoldCreate will place a hold on funds in a Crypto account for a sale offer.
+	cryptoHoldCreate(ctx context.Context, arg *cryptoHoldCreateParams) (int64, error)
+	// cryptoHoldPurgeExpired will remove the expired holds on a Crypto account.
+	cryptoHoldPurgeExpired(ctx context.Context, arg *cryptoHoldPurgeExpiredParams) (int64, error)
+	// cryptoHoldRelease will release a hold on a Crypto account for a sale offer.
+	cryptoHoldRelease(ctx context.Context, arg *cryptoHoldReleaseParams) (int64, error)
+	// cryptoHoldTotal will retrieve the total of the funds held in a Crypto account for sale offers that have not expired.
+	cryptoHoldTotal(ctx context.Context, arg *cryptoHoldTotalParams) (decimal.Decimal, error)
+	// cryptoJournalChain will retrieve a page of the Crypto journal chain after a sequence number, up to and including a
+	// bound, alongside the hash recomputed from each entry's fields.
+	cryptoJournalChain(ctx context.Context, arg *cryptoJournalChainParams) ([]cryptoJournalChainRow, error)
+	// cryptoJournalReversalEntries will retrieve the Crypto journal entries of a transaction and whether each is for a
+	// client account with a balance. The entries for the FTeX operations accounts do not have balances.
+	cryptoJournalReversalEntries(ctx context.Context, txID uuid.UUID) ([]cryptoJournalReversalEntriesRow, error)
+	// cryptoJournalReverse will post equal and opposite Crypto journal entries for all the entries of a transaction.
+	cryptoJournalReverse(ctx context.Context, arg *cryptoJournalReverseParams) ([]CryptoJournal, error)
 	// cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency.
 	cryptoPurchase(ctx context.Context, arg *cryptoPurchaseParams) error
+	// cryptoRowLockAccount will acquire a row level lock without locks on the foreign keys. The account status and whether
+	// the client has been suspended are returned alongside the balance.
+	cryptoRowLockAccount(ctx context.Context, arg *cryptoRowLockAccountParams) (cryptoRowLockAccountRow, error)
 	// cryptoSell will execute a transaction to sell a Cryptocurrency and purchase a Fiat currency.
 	cryptoSell(ctx context.Context, arg *cryptoSellParams) error
-	// fiatCreateAccount inserts a fiat account record.
+	// cryptoSetAccountStatus will set the status of a Crypto account that has not been closed.
+	cryptoSetAccountStatus(ctx context.Context, arg *cryptoSetAccountStatusParams) (int64, error)
+	// cryptoUpdateAccountBalance will add an amount to a Crypto account's balance.
+	cryptoUpdateAccountBalance(ctx context.Context, arg *cryptoUpdateAccountBalanceParams) (cryptoUpdateAccountBalanceRow, error)
+	// fiatAdjustmentCreate will record a pending manual adjustment of a Fiat account that awaits a decision.
+	fiatAdjustmentCreate(ctx context.Context, arg *fiatAdjustmentCreateParams) (FiatAdjustment, error)
+	// fiatAdjustmentDecide will record the decision on a pending manual adjustment.
+	fiatAdjustmentDecide(ctx context.Context, arg *fiatAdjustmentDecideParams) (FiatAdjustment, error)
+	// fiatAdjustmentGet will retrieve a manual adjustment of a Fiat account.
+	fiatAdjustmentGet(ctx context.Context, adjustmentID string) (FiatAdjustment, error)
+	// fiatAdjustmentGetPaginated will retrieve a page of manual adjustments, newest first, starting from an adjustment id.
+	// The adjustments can be restricted to those with a specific status.
+	fiatAdjustmentGetPaginated(ctx context.Context, arg *fiatAdjustmentGetPaginatedParams) ([]FiatAdjustment, error)
+	// fiatAdjustmentRowLock will acquire a row level lock on a manual adjustment without locks on the foreign keys.
+	fiatAdjustmentRowLock(ctx context.Context, adjustmentID string) (FiatAdjustment, error)
+	// fiatBalanceAsOf will compute the balance of a Fiat account at a point in time from the nearest snapshot at or before
+	// it and the journal entries posted from the snapshot up to the point in time.
+	fiatBalanceAsOf(ctx context.Context, arg *fiatBalanceAsOfParams) (fiatBalanceAsOfRow, error)
+	// fiatBalanceHistory will retrieve the daily closing balances of a Fiat account within a time range, oldest first.
+	fiatBalanceHistory(ctx context.Context, arg *fiatBalanceHistoryParams) ([]FiatBalanceSnapshot, error)
+	// fiatBalanceSnapshotCreate will record the closing balances of all Fiat accounts opened before the snapshot time. Each
+	// closing balance is the previous snapshot's balance carried forward with the journal entries posted since it.
+	fiatBalanceSnapshotCreate(ctx context.Context, snapshotAt pgtype.Timestamptz) (int64, error)
+	// fiatCloseAccount will mark a Fiat account with a zero balance as closed.
+	fiatCloseAccount(ctx context.Context, arg *fiatCloseAccountParams) (int64, error)
+	// fiatCreateAccount inserts a fiat account record for an active currency. A closed account will be reopened.
 	fiatCreateAccount(ctx context.Context, arg *fiatCreateAccountParams) (int64, error)
+	// fiatCurrencyGetAll will retrieve all the Fiat currencies in the reference table.
+	fiatCurrencyGetAll(ctx context.Context) ([]FiatCurrency, error)
+	// fiatCurrencyUpsert will register a new Fiat currency or update the status of an existing one.
+	fiatCurrencyUpsert(ctx context.Context, arg *fiatCurrencyUpsertParams) (int64, error)
 	// fiatExternalTransferJournalEntry will create both journal entries for fiat accounts inbound deposits.
 	fiatExternalTransferJournalEntry(ctx context.Context, arg *fiatExternalTransferJournalEntryParams) (fiatExternalTransferJournalEntryRow, error)
-	// fiatGetAccount will retrieve a specific user's account for a given currency.
-	fiatGetAccount(ctx context.Context, arg *fiatGetAccountParams) (FiatAccount, error)
-	// fiatGetAllAccounts will retrieve all accounts associated with a specific user.
-	fiatGetAllAccounts(ctx context.Context, arg *fiatGetAllAccountsParams) ([]FiatAccount, error)
+	// fiatGetAccount will retrieve a specific user's account for a given currency with the ledger and available balances.
+	fiatGetAccount(ctx context.Context, arg *fiatGetAccountParams) (FiatAccountBalance, error)
+	// fiatGetAllAccounts will retrieve all open accounts associated with a specific user with the ledger and available
+	// balances.
+	fiatGetAllAccounts(ctx context.Context, arg *fiatGetAllAccountsParams) ([]FiatAccountBalance, error)
 	// fiatGetAllJournalTransactionsPaginated will retrieve the journal entries associated with a specific account
 	// in a date range.
 	fiatGetAllJournalTransactionsPaginated(ctx context.Context, arg *fiatGetAllJournalTransactionsPaginatedParams) ([]FiatJournal, error)
@@ -42,14 +143,160 @@ type Querier interface {
 	fiatGetJournalTransaction(ctx context.Context, arg *fiatGetJournalTransactionParams) ([]FiatJournal, error)
 	// fiatGetJournalTransactionForAccount will retrieve the journal entries associated with a specific account.
 	fiatGetJournalTransactionForAccount(ctx context.Context, arg *fiatGetJournalTransactionForAccountParams) ([]FiatJournal, error)
-	// fiatInternalTransferJournalEntry will create both journal entries for fiat account internal transfers.
+	// fiatHoldCreate will place a hold on funds in a Fiat account for an exchange offer.
+	fiatHoldCreate(ctx context.Context, arg *fiatHoldCreateParams) (int64, error)
+	// fiatHoldPurgeExpired will remove the expired holds on a Fiat account.
+	fiatHoldPurgeExpired(ctx context.Context, arg *fiatHoldPurgeExpiredParams) (int64, error)
+	// fiatHoldRelease will release a hold on a Fiat account for an exchange offer.
+	fiatHoldRelease(ctx context.Context, arg *fiatHoldReleaseParams) (int64, error)
+	// fiatHoldTotal will retrieve the total of the funds held in a Fiat account for exchange offers that have not expired.
+	fiatHoldTotal(ctx context.Context, arg *fiatHoldTotalParams) (decimal.Decimal, error)
+	// fiatInternalTransferJournalEntry will create the journal entries for fiat account internal transfers. The transfer is
+	// routed through the FTeX Fiat operations account so that the entries balance in each currency.
 	fiatInternalTransferJournalEntry(ctx context.Context, arg *fiatInternalTransferJournalEntryParams) (fiatInternalTransferJournalEntryRow, error)
-	// fiatRowLockAccount will acquire a row level lock without locks on the foreign keys.
-	fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (decimal.Decimal, error)
+	// fiatJournalChain will retrieve a page of the Fiat journal chain after a sequence number, up to and including a bound,
+	// alongside the hash recomputed from each entry's fields.
+	fiatJournalChain(ctx context.Context, arg *fiatJournalChainParams) ([]fiatJournalChainRow, error)
+	// fiatJournalReversalEntries will retrieve the Fiat journal entries of a transaction and whether each is for a client
+	// account with a balance. The entries for the FTeX operations accounts do not have balances.
+	fiatJournalReversalEntries(ctx context.Context, txID uuid.UUID) ([]fiatJournalReversalEntriesRow, error)
+	// fiatJournalReverse will post equal and opposite Fiat journal entries for all the entries of a transaction.
+	fiatJournalReverse(ctx context.Context, arg *fiatJournalReverseParams) ([]FiatJournal, error)
+	// fiatRowLockAccount will acquire a row level lock without locks on the foreign keys. The account status and whether
+	// the client has been suspended are returned alongside the balance.
+	fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (fiatRowLockAccountRow, error)
+	// fiatSetAccountStatus will set the status of a Fiat account that has not been closed.
+	fiatSetAccountStatus(ctx context.Context, arg *fiatSetAccountStatusParams) (int64, error)
 	// fiatUpdateAccountBalance will add an amount to a fiat accounts balance.
 	fiatUpdateAccountBalance(ctx context.Context, arg *fiatUpdateAccountBalanceParams) (fiatUpdateAccountBalanceRow, error)
+	// journalChainHeads will retrieve the sequence number and hash of the last entry in the Fiat and Crypto journal chains.
+	journalChainHeads(ctx context.Context) ([]JournalChainHead, error)
+	// journalCheckpointCreate will record a signed checkpoint of a journal chain head. A head that has already been
+	// checkpointed will not be recorded again.
+	journalCheckpointCreate(ctx context.Context, arg *journalCheckpointCreateParams) (int64, error)
+	// journalCheckpoints will retrieve the signed checkpoints of a journal, or all journals if none is specified, recorded
+	// at or after a point in time.
+	journalCheckpoints(ctx context.Context, arg *journalCheckpointsParams) ([]JournalCheckpoint, error)
+	// journalPartitionArchive will move a monthly journal partition and its indexes to a tablespace.
+	journalPartitionArchive(ctx context.Context, arg *journalPartitionArchiveParams) error
+	// journalPartitionCreate will create the monthly partition of a journal for the month of a date, moving in any entries
+	// that were posted to the default partition. Returns false if the partition already exists.
+	journalPartitionCreate(ctx context.Context, arg *journalPartitionCreateParams) (bool, error)
+	// journalPartitionDrop will detach and drop a monthly journal partition.
+	journalPartitionDrop(ctx context.Context, partition string) error
+	// journalPartitions will retrieve the monthly partitions of the Fiat and Crypto journals, oldest first.
+	journalPartitions(ctx context.Context) ([]journalPartitionsRow, error)
+	// journalReversalCreate will record the reversal of a transaction. A transaction that has already been reversed will
+	// not be recorded again.
+	journalReversalCreate(ctx context.Context, arg *journalReversalCreateParams) (int64, error)
+	// journalReversalGet will retrieve the reversal of a transaction, or the reversal that a transaction records.
+	journalReversalGet(ctx context.Context, txID uuid.UUID) (JournalReversal, error)
+	// limitOrderCreate will place a Cryptocurrency limit order.
+	limitOrderCreate(ctx context.Context, arg *limitOrderCreateParams) (int64, error)
+	// limitOrderEventCreate will record a change in the state of a limit order in its history.
+	limitOrderEventCreate(ctx context.Context, arg *limitOrderEventCreateParams) (int64, error)
+	// limitOrderExpire will set the status of open limit orders that have passed their expiry and record the change in
+	// their histories. The holds on expired orders lapse at the same time as the orders.
+	limitOrderExpire(ctx context.Context) (int64, error)
+	// limitOrderGet will retrieve a specific limit order belonging to a client.
+	limitOrderGet(ctx context.Context, arg *limitOrderGetParams) (CryptoLimitOrder, error)
+	// limitOrderGetEvents will retrieve the state history of a limit order in the order it occurred.
+	limitOrderGetEvents(ctx context.Context, orderID string) ([]CryptoLimitOrderEvent, error)
+	// limitOrderGetOpen will retrieve a batch of open and unexpired limit orders, oldest first, after an order id.
+	limitOrderGetOpen(ctx context.Context, arg *limitOrderGetOpenParams) ([]CryptoLimitOrder, error)
+	// limitOrderGetPaginated will retrieve a page of a client's limit orders, newest first, starting from an order id. The
+	// orders can be restricted to those with a specific status.
+	limitOrderGetPaginated(ctx context.Context, arg *limitOrderGetPaginatedParams) ([]CryptoLimitOrder, error)
+	// limitOrderRowLock will acquire a row level lock on a limit order without locks on the foreign keys.
+	limitOrderRowLock(ctx context.Context, arg *limitOrderRowLockParams) (CryptoLimitOrder, error)
+	// limitOrderUpdateStatus will close an open limit order with a final status and the id of any transaction it executed.
+	limitOrderUpdateStatus(ctx context.Context, arg *limitOrderUpdateStatusParams) (int64, error)
+	// loginLockoutCreate will record a temporary lockout of a username or IP address after repeated failed login attempts.
+	loginLockoutCreate(ctx context.Context, arg *loginLockoutCreateParams) (int64, error)
+	// loginLockoutUnlock will record that an administrator has lifted the lockouts of a username or IP address that are
+	// still in effect.
+	loginLockoutUnlock(ctx context.Context, arg *loginLockoutUnlockParams) (int64, error)
+	// loginLockoutsRecent will retrieve the most recent lockouts, newest first. The lockouts can be restricted to those
+	// that are still in effect.
+	loginLockoutsRecent(ctx context.Context, arg *loginLockoutsRecentParams) ([]LoginLockout, error)
+	// mfaConfirm will complete a pending two-factor authentication enrolment with the time step of the first one-time
+	// password and the hashed recovery codes.
+	mfaConfirm(ctx context.Context, arg *mfaConfirmParams) (int64, error)
+	// mfaDelete will remove the two-factor authentication enrolment of a client.
+	mfaDelete(ctx context.Context, clientID uuid.UUID) (int64, error)
+	// mfaEnroll will record a pending two-factor authentication enrolment with an encrypted TOTP secret. A pending
+	// enrolment is replaced, but a confirmed enrolment is not.
+	mfaEnroll(ctx context.Context, arg *mfaEnrollParams) (int64, error)
+	// mfaGet will retrieve the two-factor authentication enrolment of a client.
+	mfaGet(ctx context.Context, clientID uuid.UUID) (UserMFA, error)
+	// mfaSecrets will retrieve the encrypted TOTP secrets of all two-factor authentication enrolments.
+	mfaSecrets(ctx context.Context) ([]mfaSecretsRow, error)
+	// mfaSetRecoveryCodes will replace the hashed recovery codes of a confirmed enrolment.
+	mfaSetRecoveryCodes(ctx context.Context, arg *mfaSetRecoveryCodesParams) (int64, error)
+	// mfaSetSecret will replace the encrypted TOTP secret of an enrolment if it has not changed since it was retrieved.
+	mfaSetSecret(ctx context.Context, arg *mfaSetSecretParams) (int64, error)
+	// mfaUseRecoveryCode will remove a hashed recovery code once it has been used.
+	mfaUseRecoveryCode(ctx context.Context, arg *mfaUseRecoveryCodeParams) (int64, error)
+	// mfaUseStep will record the time step of a one-time password that has been used. Time steps at or before the last
+	// step that was used are rejected to prevent one-time passwords from being replayed.
+	mfaUseStep(ctx context.Context, arg *mfaUseStepParams) (int64, error)
+	// recurringPurchaseAdvance will move an active recurring purchase plan on to its next scheduled run.
+	recurringPurchaseAdvance(ctx context.Context, arg *recurringPurchaseAdvanceParams) (int64, error)
+	// recurringPurchaseCreate will schedule a recurring Cryptocurrency purchase plan with its first run at the start time.
+	recurringPurchaseCreate(ctx context.Context, arg *recurringPurchaseCreateParams) (int64, error)
+	// recurringPurchaseGet will retrieve a specific recurring purchase plan belonging to a client.
+	recurringPurchaseGet(ctx context.Context, arg *recurringPurchaseGetParams) (CryptoRecurringPurchase, error)
+	// recurringPurchaseGetDue will retrieve a batch of active recurring purchase plans that are due to run, after a plan id.
+	recurringPurchaseGetDue(ctx context.Context, arg *recurringPurchaseGetDueParams) ([]CryptoRecurringPurchase, error)
+	// recurringPurchaseGetPaginated will retrieve a page of a client's recurring purchase plans, newest first, starting
+	// from a plan id. The plans can be restricted to those with a specific status.
+	recurringPurchaseGetPaginated(ctx context.Context, arg *recurringPurchaseGetPaginatedParams) ([]CryptoRecurringPurchase, error)
+	// recurringPurchaseRowLock will acquire a row level lock on a recurring purchase plan without locks on the foreign keys.
+	recurringPurchaseRowLock(ctx context.Context, arg *recurringPurchaseRowLockParams) (CryptoRecurringPurchase, error)
+	// recurringPurchaseRunCreate will record the outcome of a scheduled run of a recurring purchase plan.
+	recurringPurchaseRunCreate(ctx context.Context, arg *recurringPurchaseRunCreateParams) (int64, error)
+	// recurringPurchaseRunGetPaginated will retrieve a page of the runs of a client's recurring purchase plan, newest first,
+	// starting from a run id.
+	recurringPurchaseRunGetPaginated(ctx context.Context, arg *recurringPurchaseRunGetPaginatedParams) ([]CryptoRecurringPurchaseRun, error)
+	// recurringPurchaseUpdateStatus will close an active recurring purchase plan with a final status.
+	recurringPurchaseUpdateStatus(ctx context.Context, arg *recurringPurchaseUpdateStatusParams) (int64, error)
+	// refreshSessionGetClient will retrieve the details of all the active sessions of a client, most recently refreshed
+	// first. Each active session has exactly one refresh token that has been neither rotated nor revoked.
+	refreshSessionGetClient(ctx context.Context, clientID uuid.UUID) ([]refreshSessionGetClientRow, error)
+	// refreshSessionRevoke will revoke all the refresh tokens in a client's session.
+	refreshSessionRevoke(ctx context.Context, arg *refreshSessionRevokeParams) (int64, error)
+	// refreshSessionRevokeAll will revoke all the refresh tokens in all of a client's sessions.
+	refreshSessionRevokeAll(ctx context.Context, clientID uuid.UUID) error
+	// refreshTokenCreate will record a hashed refresh token in a client session. A new session is started if no session
+	// start time is provided.
+	refreshTokenCreate(ctx context.Context, arg *refreshTokenCreateParams) error
+	// refreshTokenRotate will mark an active refresh token as used once it has been exchanged for a new one.
+	refreshTokenRotate(ctx context.Context, tokenHash string) (int64, error)
+	// refreshTokenRowLock will acquire a row lock on a refresh token and retrieve it.
+	refreshTokenRowLock(ctx context.Context, tokenHash string) (RefreshToken, error)
 	// testRoundHalfEven
 	testRoundHalfEven(ctx context.Context, arg *testRoundHalfEvenParams) (decimal.Decimal, error)
+	// triggerOrderCreate will place a Cryptocurrency stop-loss or take-profit trigger order.
+	triggerOrderCreate(ctx context.Context, arg *triggerOrderCreateParams) (int64, error)
+	// triggerOrderEventCreate will record a change in the state of a trigger order in its history.
+	triggerOrderEventCreate(ctx context.Context, arg *triggerOrderEventCreateParams) (int64, error)
+	// triggerOrderGet will retrieve a specific trigger order belonging to a client.
+	triggerOrderGet(ctx context.Context, arg *triggerOrderGetParams) (CryptoTriggerOrder, error)
+	// triggerOrderGetActive will retrieve a batch of active trigger orders, oldest first, after an order id.
+	triggerOrderGetActive(ctx context.Context, arg *triggerOrderGetActiveParams) ([]CryptoTriggerOrder, error)
+	// triggerOrderGetEvents will retrieve the state history of a trigger order in the order it occurred.
+	triggerOrderGetEvents(ctx context.Context, orderID string) ([]CryptoTriggerOrderEvent, error)
+	// triggerOrderGetPaginated will retrieve a page of a client's trigger orders, newest first, starting from an order id.
+	// The orders can be restricted to those with a specific status.
+	triggerOrderGetPaginated(ctx context.Context, arg *triggerOrderGetPaginatedParams) ([]CryptoTriggerOrder, error)
+	// triggerOrderRowLock will acquire a row level lock on a trigger order without locks on the foreign keys.
+	triggerOrderRowLock(ctx context.Context, arg *triggerOrderRowLockParams) (CryptoTriggerOrder, error)
+	// triggerOrderTrail will raise the trigger price of an active trailing stop-loss order. The trigger price never moves
+	// down.
+	triggerOrderTrail(ctx context.Context, arg *triggerOrderTrailParams) (int64, error)
+	// triggerOrderUpdateStatus will close an active trigger order with a final status and the id of any transaction it
+	// executed.
+	triggerOrderUpdateStatus(ctx context.Context, arg *triggerOrderUpdateStatusParams) (int64, error)
 	// userCreate will create a new user record.
 	userCreate(ctx context.Context, arg *userCreateParams) (uuid.UUID, error)
 	// userDelete will soft delete a users account.
@@ -60,8 +307,22 @@ type Querier interface {
 	userGetCredentials(ctx context.Context, username string) (userGetCredentialsRow, error)
 	// userGetInfo will retrieve a single users account information.
 	userGetInfo(ctx context.Context, clientID uuid.UUID) (userGetInfoRow, error)
+	// userGetStatus will return the role, soft delete, and freeze status of a user account.
+	userGetStatus(ctx context.Context, clientID uuid.UUID) (userGetStatusRow, error)
 	// userIsDeleted will return the soft delete status of a user account.
 	userIsDeleted(ctx context.Context, clientID uuid.UUID) (bool, error)
+	// userSearch will retrieve the user accounts with a client id, username, name, or email address matching the query.
+	userSearch(ctx context.Context, arg *userSearchParams) ([]userSearchRow, error)
+	// userSetFrozen will freeze or unfreeze a user account that has not been deleted.
+	userSetFrozen(ctx context.Context, arg *userSetFrozenParams) (int64, error)
+	// userUpdatePassword will replace the password of a user account that has not been deleted.
+	userUpdatePassword(ctx context.Context, arg *userUpdatePasswordParams) (int64, error)
+	// userUpdateProfile will replace the names and email address of a user account that has not been deleted. Empty values
+	// are left unchanged and a changed email address is marked as unverified.
+	userUpdateProfile(ctx context.Context, arg *userUpdateProfileParams) (int64, error)
+	// userVerifyEmail will mark the email address of a user account that has not been deleted as verified if it has not
+	// changed since the verification link was issued.
+	userVerifyEmail(ctx context.Context, arg *userVerifyEmailParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// AdminAuditLogCreate is the interface through which external methods can record an administrative action in the
+// audit log. The id of the entry is returned so that the outcome of the action can be recorded.
+func (p *postgresImpl) AdminAuditLogCreate(adminID uuid.UUID, action AdminAction, target string,
+	details json.RawMessage) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	if len(details) == 0 {
+		details = json.RawMessage("{}")
+	}
+
+	id, err := p.Query.adminAuditLogCreate(ctx, &adminAuditLogCreateParams{
+		AdminID: adminID,
+		Action:  action,
+		Target:  target,
+		Details: details,
+	})
+	if err != nil {
+		p.logger.Error("failed to record administrative action in audit log",
+			zap.String("adminID", adminID.String()), zap.String("action", string(action)), zap.Error(err))
+
+		return -1, ErrAuditLog
+	}
+
+	return id, nil
+}
+
+// AdminAuditLogSetOutcome is the interface through which external methods can record the outcome of an administrative
+// action in the audit log once it has been carried out.
+func (p *postgresImpl) AdminAuditLogSetOutcome(id int64, outcome AuditOutcome) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.adminAuditLogSetOutcome(ctx, &adminAuditLogSetOutcomeParams{
+		ID:      id,
+		Outcome: &outcome,
+	})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to record outcome of administrative action in audit log",
+			zap.Int64("id", id), zap.String("outcome", string(outcome)), zap.Error(err))
+
+		return ErrAuditLog
+	}
+
+	return nil
+}
+
+// AdminAuditLogPaginated is the interface through which external methods can retrieve a page of audit log entries,
+// newest first, starting from an entry id.
+func (p *postgresImpl) AdminAuditLogPaginated(target string, startID int64, pageSize int32) ([]AdminAuditLog, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	entries, err := p.Query.adminAuditLogGetPaginated(ctx, &adminAuditLogGetPaginatedParams{
+		Limit:   pageSize,
+		StartID: startID,
+		Target:  target,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve audit log entries", zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	if len(entries) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return entries, nil
+}
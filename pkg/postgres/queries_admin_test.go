@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_AdminAuditLog(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+	adminID := clientIDs[0]
+	target := clientIDs[1].String()
+
+	// Invalid action.
+	_, err := connection.AdminAuditLogCreate(adminID, "INVALID", target, nil)
+	require.Error(t, err, "recorded an invalid action.")
+
+	// Record entries.
+	_, err = connection.AdminAuditLogCreate(adminID, AdminActionUSERSEARCH, "", nil)
+	require.NoError(t, err, "failed to record entry without details.")
+
+	freezeID, err := connection.AdminAuditLogCreate(adminID, AdminActionUSERFREEZE, target,
+		json.RawMessage(`{"reason":"under investigation"}`))
+	require.NoError(t, err, "failed to record entry with details.")
+
+	unfreezeID, err := connection.AdminAuditLogCreate(adminID, AdminActionUSERUNFREEZE, target, nil)
+	require.NoError(t, err, "failed to record second entry for target.")
+	require.Greater(t, unfreezeID, freezeID, "entry ids are not increasing.")
+
+	// Record outcomes, which can only be recorded once.
+	require.NoError(t, connection.AdminAuditLogSetOutcome(freezeID, AuditOutcomeFAILURE),
+		"failed to record outcome.")
+	require.Error(t, connection.AdminAuditLogSetOutcome(freezeID, AuditOutcomeSUCCESS),
+		"recorded outcome twice.")
+	require.Error(t, connection.AdminAuditLogSetOutcome(math.MaxInt64, AuditOutcomeSUCCESS),
+		"recorded outcome of non-existent entry.")
+
+	// Retrieve all entries, newest first.
+	entries, err := connection.AdminAuditLogPaginated("", math.MaxInt64, 10)
+	require.NoError(t, err, "failed to retrieve audit log.")
+	require.GreaterOrEqual(t, len(entries), 3, "incorrect number of audit log entries.")
+	require.Equal(t, AdminActionUSERUNFREEZE, entries[0].Action, "entries are not ordered newest first.")
+	require.Equal(t, adminID, entries[0].AdminID, "admin id mismatch.")
+	require.JSONEq(t, `{}`, string(entries[0].Details), "default details mismatch.")
+	require.JSONEq(t, `{"reason":"under investigation"}`, string(entries[1].Details), "details mismatch.")
+	require.Nil(t, entries[0].Outcome, "outcome recorded for unfinished action.")
+	require.NotNil(t, entries[1].Outcome, "outcome not recorded.")
+	require.Equal(t, AuditOutcomeFAILURE, *entries[1].Outcome, "outcome mismatch.")
+
+	// Retrieve entries for a target, one page at a time.
+	entries, err = connection.AdminAuditLogPaginated(target, math.MaxInt64, 1)
+	require.NoError(t, err, "failed to retrieve first page of audit log for target.")
+	require.Len(t, entries, 1, "incorrect first page size.")
+	require.Equal(t, AdminActionUSERUNFREEZE, entries[0].Action, "first page action mismatch.")
+
+	entries, err = connection.AdminAuditLogPaginated(target, entries[0].ID-1, 10)
+	require.NoError(t, err, "failed to retrieve second page of audit log for target.")
+	require.Len(t, entries, 1, "incorrect second page size.")
+	require.Equal(t, AdminActionUSERFREEZE, entries[0].Action, "second page action mismatch.")
+
+	// No entries.
+	_, err = connection.AdminAuditLogPaginated("no-such-target", math.MaxInt64, 10)
+	require.Error(t, err, "retrieved audit log for non-existent target.")
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"go.uber.org/zap"
+)
+
+// APIKeyCreate is the interface through which external methods can record an API key for a client. The key is not
+// recorded if the client already holds the maximum number of active API keys.
+func (p *postgresImpl) APIKeyCreate(key *APIKey) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.apiKeyCreate(ctx, &apiKeyCreateParams{
+		KeyID:      key.KeyID,
+		ClientID:   key.ClientID,
+		Name:       key.Name,
+		Secret:     key.Secret,
+		Scopes:     key.Scopes,
+		AllowedIps: key.AllowedIps,
+		ExpiresAt:  key.ExpiresAt,
+		MaxKeys:    int64(constants.APIKeyMaxPerClient()),
+	})
+	if err != nil {
+		p.logger.Error("failed to create API key", zap.String("clientID", key.ClientID.String()), zap.Error(err))
+
+		return ErrAPIKey
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrAPIKeyLimit
+	}
+
+	return nil
+}
+
+// APIKeyGet is the interface through which external methods can retrieve an API key along with its encrypted secret.
+func (p *postgresImpl) APIKeyGet(keyID string) (APIKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	key, err := p.Query.apiKeyGet(ctx, keyID)
+	if err != nil {
+		return APIKey{}, ErrNotFound
+	}
+
+	return key, nil
+}
+
+// APIKeysClient is the interface through which external methods can retrieve the details, without the secrets, of all
+// the API keys a client has created.
+func (p *postgresImpl) APIKeysClient(clientID uuid.UUID) ([]modelsPostgres.APIKeyInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.apiKeyGetClient(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to retrieve API keys", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	keys := make([]modelsPostgres.APIKeyInfo, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, modelsPostgres.APIKeyInfo{
+			KeyID:      row.KeyID,
+			Name:       row.Name,
+			Scopes:     row.Scopes,
+			AllowedIPs: row.AllowedIps,
+			ExpiresAt:  row.ExpiresAt,
+			CreatedAt:  row.CreatedAt,
+			RevokedAt:  row.RevokedAt,
+		})
+	}
+
+	return keys, nil
+}
+
+// APIKeyRevoke is the interface through which external methods can revoke an active API key belonging to a client.
+func (p *postgresImpl) APIKeyRevoke(clientID uuid.UUID, keyID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.apiKeyRevoke(ctx, &apiKeyRevokeParams{KeyID: keyID, ClientID: clientID})
+	if err != nil {
+		p.logger.Error("failed to revoke API key", zap.String("keyID", keyID), zap.Error(err))
+
+		return ErrAPIKey
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// APIKeySecrets is the interface through which external methods can retrieve the encrypted secrets of all the API keys
+// that have not been revoked, keyed by API key id.
+func (p *postgresImpl) APIKeySecrets() (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.apiKeySecrets(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve API key secrets", zap.Error(err))
+
+		return nil, ErrAPIKey
+	}
+
+	secrets := make(map[string]string, len(rows))
+	for _, row := range rows {
+		secrets[row.KeyID] = row.Secret
+	}
+
+	return secrets, nil
+}
+
+// APIKeySecretUpdate is the interface through which external methods can replace the encrypted secret of an API key
+// with a re-encrypted one. The secret is not replaced if it has changed since it was retrieved.
+func (p *postgresImpl) APIKeySecretUpdate(keyID, secret, reencrypted string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.apiKeySetSecret(ctx, &apiKeySetSecretParams{
+		NewSecret: reencrypted,
+		KeyID:     keyID,
+		Secret:    secret,
+	})
+	if err != nil {
+		p.logger.Error("failed to replace API key secret", zap.String("keyID", keyID), zap.Error(err))
+
+		return ErrAPIKey
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/constants"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"go.uber.org/zap"
+)
+
+// AuditEventDetails are the details of a security or account event to be recorded in the audit log. The actor is the
+// client that carried out the action and the subject is the client it was carried out on. Either may be unknown, such
+// as the actor of a failed login, and is recorded as absent if it is the nil UUID.
+type AuditEventDetails struct {
+	EventType AuditEventType
+	Outcome   AuditOutcome
+	ActorID   uuid.UUID
+	SubjectID uuid.UUID
+	IPAddress string
+	UserAgent string
+	Payload   json.RawMessage
+}
+
+// auditUUID will convert a Client ID to a nullable UUID, where the nil UUID is absent.
+func auditUUID(clientID uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: clientID, Valid: clientID != uuid.Nil}
+}
+
+// AuditEventCreate is the interface through which external methods can record a security or account event in the
+// append-only audit log. User agents that are longer than the column are truncated.
+func (p *postgresImpl) AuditEventCreate(event *AuditEventDetails) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	payload := event.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+
+	userAgent := []rune(event.UserAgent)
+	if len(userAgent) > constants.AuditUserAgentLength() {
+		userAgent = userAgent[:constants.AuditUserAgentLength()]
+	}
+
+	rowsAffected, err := p.Query.auditEventCreate(ctx, &auditEventCreateParams{
+		EventType: event.EventType,
+		Outcome:   event.Outcome,
+		ActorID:   auditUUID(event.ActorID),
+		SubjectID: auditUUID(event.SubjectID),
+		IpAddress: event.IPAddress,
+		UserAgent: string(userAgent),
+		Payload:   payload,
+	})
+	if err != nil || rowsAffected != 1 {
+		p.logger.Error("failed to record audit event", zap.String("eventType", string(event.EventType)),
+			zap.String("subjectID", event.SubjectID.String()), zap.Error(err))
+
+		return ErrAuditEvent
+	}
+
+	return nil
+}
+
+// AuditEventsPaginated is the interface through which external methods can retrieve a page of audit events, newest
+// first, starting from an event id. The events can be restricted to a subject, an event type, and an outcome.
+func (p *postgresImpl) AuditEventsPaginated(subjectID uuid.UUID, eventType AuditEventType, outcome AuditOutcome,
+	startID int64, pageSize int32) ([]AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	events, err := p.Query.auditEventsGetPaginated(ctx, &auditEventsGetPaginatedParams{
+		Limit:     pageSize,
+		StartID:   startID,
+		SubjectID: auditUUID(subjectID),
+		EventType: string(eventType),
+		Outcome:   string(outcome),
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve audit events", zap.Error(err))
+
+		return nil, ErrAuditEvent
+	}
+
+	if len(events) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return events, nil
+}
+
+// AuditEventsRecent is the interface through which external methods can retrieve the most recent security and account
+// events about a client, newest first, excluding administrative actions.
+func (p *postgresImpl) AuditEventsRecent(clientID uuid.UUID, limit int32) ([]modelsPostgres.SecurityEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.auditEventsRecent(ctx, &auditEventsRecentParams{Limit: limit, SubjectID: clientID})
+	if err != nil {
+		p.logger.Error("failed to retrieve recent audit events", zap.String("clientID", clientID.String()),
+			zap.Error(err))
+
+		return nil, ErrAuditEvent
+	}
+
+	events := make([]modelsPostgres.SecurityEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, modelsPostgres.SecurityEvent{
+			EventType:  string(row.EventType),
+			Outcome:    string(row.Outcome),
+			IPAddress:  row.IpAddress,
+			UserAgent:  row.UserAgent,
+			OccurredAt: row.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+func TestQueries_AuditEvents(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+	adminID := clientIDs[0]
+	subjectID := clientIDs[1]
+
+	// Invalid event type.
+	require.Error(t, connection.AuditEventCreate(&AuditEventDetails{EventType: "INVALID", Outcome: AuditOutcomeSUCCESS}),
+		"recorded an invalid event type.")
+
+	// Record events.
+	require.NoError(t, connection.AuditEventCreate(&AuditEventDetails{
+		EventType: AuditEventTypeLOGIN,
+		Outcome:   AuditOutcomeFAILURE,
+		SubjectID: subjectID,
+		IPAddress: "127.0.0.1",
+		UserAgent: strings.Repeat("a", constants.AuditUserAgentLength()+1),
+	}), "failed to record event without an actor.")
+	require.NoError(t, connection.AuditEventCreate(&AuditEventDetails{
+		EventType: AuditEventTypeLOGIN,
+		Outcome:   AuditOutcomeSUCCESS,
+		ActorID:   subjectID,
+		SubjectID: subjectID,
+		IPAddress: "127.0.0.1",
+		UserAgent: "test agent",
+	}), "failed to record successful login.")
+	require.NoError(t, connection.AuditEventCreate(&AuditEventDetails{
+		EventType: AuditEventTypeADMINACTION,
+		Outcome:   AuditOutcomeSUCCESS,
+		ActorID:   adminID,
+		SubjectID: subjectID,
+		Payload:   json.RawMessage(`{"action":"USER_FREEZE"}`),
+	}), "failed to record administrative action.")
+
+	// Retrieve the recent events for the subject, newest first, without administrative actions.
+	recent, err := connection.AuditEventsRecent(subjectID, 10)
+	require.NoError(t, err, "failed to retrieve recent events.")
+	require.Len(t, recent, 2, "incorrect number of recent events.")
+	require.Equal(t, string(AuditOutcomeSUCCESS), recent[0].Outcome, "events are not ordered newest first.")
+	require.Equal(t, "test agent", recent[0].UserAgent, "user agent mismatch.")
+	require.Len(t, recent[1].UserAgent, constants.AuditUserAgentLength(), "user agent not truncated.")
+
+	// Retrieve all the events for the subject, newest first.
+	events, err := connection.AuditEventsPaginated(subjectID, "", "", math.MaxInt64, 10)
+	require.NoError(t, err, "failed to retrieve events.")
+	require.Len(t, events, 3, "incorrect number of events.")
+	require.Equal(t, AuditEventTypeADMINACTION, events[0].EventType, "events are not ordered newest first.")
+	require.Equal(t, adminID, uuid.UUID(events[0].ActorID.Bytes), "actor id mismatch.")
+	require.JSONEq(t, `{"action":"USER_FREEZE"}`, string(events[0].Payload), "payload mismatch.")
+	require.JSONEq(t, `{}`, string(events[1].Payload), "default payload mismatch.")
+	require.False(t, events[2].ActorID.Valid, "absent actor recorded.")
+
+	// Retrieve the events for the subject, one page at a time and filtered.
+	events, err = connection.AuditEventsPaginated(subjectID, AuditEventTypeLOGIN, "", math.MaxInt64, 1)
+	require.NoError(t, err, "failed to retrieve first page of login events.")
+	require.Len(t, events, 1, "incorrect first page size.")
+	require.Equal(t, AuditOutcomeSUCCESS, events[0].Outcome, "first page outcome mismatch.")
+
+	events, err = connection.AuditEventsPaginated(subjectID, AuditEventTypeLOGIN, "", events[0].EventID-1, 10)
+	require.NoError(t, err, "failed to retrieve second page of login events.")
+	require.Len(t, events, 1, "incorrect second page size.")
+	require.Equal(t, AuditOutcomeFAILURE, events[0].Outcome, "second page outcome mismatch.")
+
+	events, err = connection.AuditEventsPaginated(uuid.UUID{}, "", AuditOutcomeFAILURE, math.MaxInt64, 10)
+	require.NoError(t, err, "failed to retrieve failed events.")
+	require.Equal(t, AuditOutcomeFAILURE, events[0].Outcome, "outcome filter mismatch.")
+
+	// No events.
+	_, err = connection.AuditEventsPaginated(clientIDs[2], "", "", math.MaxInt64, 10)
+	require.Error(t, err, "retrieved events for a subject without events.")
+
+	// Events cannot be altered.
+	_, err = connection.pool.Exec(context.Background(), "DELETE FROM audit_events")
+	require.Error(t, err, "deleted audit events.")
+	_, err = connection.pool.Exec(context.Background(), "UPDATE audit_events SET outcome = 'SUCCESS'")
+	require.Error(t, err, "updated audit events.")
+}
@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"errors"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/surahman/FTeX/pkg/constants"
@@ -28,14 +30,14 @@ func (p *postgresImpl) CryptoCreateAccount(clientID uuid.UUID, ticker string) er
 
 // CryptoBalance is the interface through which external methods can retrieve a Crypto-account balance for a specific
 // cryptocurrency.
-func (p *postgresImpl) CryptoBalance(clientID uuid.UUID, ticker string) (CryptoAccount, error) {
+func (p *postgresImpl) CryptoBalance(clientID uuid.UUID, ticker string) (CryptoAccountBalance, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 
 	defer cancel()
 
 	balance, err := p.Query.cryptoGetAccount(ctx, &cryptoGetAccountParams{clientID, ticker})
 	if err != nil {
-		return CryptoAccount{}, ErrNotFound
+		return CryptoAccountBalance{}, ErrNotFound
 	}
 
 	return balance, nil
@@ -63,10 +65,13 @@ func (p *postgresImpl) CryptoPurchase(
 	fiatCurrency Currency,
 	fiatDebitAmount decimal.Decimal,
 	cryptoTicker string,
-	cryptoCreditAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	cryptoCreditAmount decimal.Decimal,
+	holdID string) (*FiatJournal, *CryptoJournal, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 	defer cancel()
 
+	var tx pgx.Tx
+
 	txID, err := uuid.NewV4()
 	if err != nil {
 		p.logger.Error("failed to generate transaction id for Crypto purchase", zap.Error(err))
@@ -74,15 +79,41 @@ func (p *postgresImpl) CryptoPurchase(
 		return nil, nil, ErrTransactCrypto
 	}
 
-	err = p.Query.cryptoPurchase(ctx, &cryptoPurchaseParams{
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("purchase Crypto transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback Crypto purchase transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to Crypto purchase core logic.
+	if err = cryptoPurchaseTransaction(ctx, p.queries.WithTx(tx), holdID, &cryptoPurchaseParams{
 		TransactionID:      txID,
 		ClientID:           clientID,
 		FiatCurrency:       fiatCurrency,
 		CryptoTicker:       cryptoTicker,
 		FiatDebitAmount:    fiatDebitAmount,
 		CryptoCreditAmount: cryptoCreditAmount,
-	})
-	if err != nil {
+	}); err != nil {
+		p.logger.Warn("failed to complete Crypto purchase transaction", zap.Error(err))
+
+		return nil, nil, cryptoTransactionErrorMapping(err)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit Crypto purchase transaction", zap.Error(err))
+
 		return nil, nil, ErrTransactCrypto
 	}
 
@@ -117,11 +148,14 @@ func (p *postgresImpl) CryptoSell(
 	fiatCurrency Currency,
 	fiatCreditAmount decimal.Decimal,
 	cryptoTicker string,
-	cryptoDebitAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	cryptoDebitAmount decimal.Decimal,
+	holdID string) (*FiatJournal, *CryptoJournal, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 
 	defer cancel()
 
+	var tx pgx.Tx
+
 	txID, err := uuid.NewV4()
 	if err != nil {
 		p.logger.Error("failed to generate transaction id for Crypto sale", zap.Error(err))
@@ -129,15 +163,41 @@ func (p *postgresImpl) CryptoSell(
 		return nil, nil, ErrTransactCrypto
 	}
 
-	err = p.Query.cryptoSell(ctx, &cryptoSellParams{
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("sale Crypto transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback Crypto sale transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to Crypto sale core logic.
+	if err = cryptoSellTransaction(ctx, p.queries.WithTx(tx), holdID, &cryptoSellParams{
 		TransactionID:     txID,
 		ClientID:          clientID,
 		FiatCurrency:      fiatCurrency,
 		CryptoTicker:      cryptoTicker,
 		FiatCreditAmount:  fiatCreditAmount,
 		CryptoDebitAmount: cryptoDebitAmount,
-	})
-	if err != nil {
+	}); err != nil {
+		p.logger.Warn("failed to complete Crypto sale transaction", zap.Error(err))
+
+		return nil, nil, cryptoTransactionErrorMapping(err)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit Crypto sale transaction", zap.Error(err))
+
 		return nil, nil, ErrTransactCrypto
 	}
 
@@ -164,10 +224,28 @@ func (p *postgresImpl) CryptoSell(
 	return &fiatJournal[0], &cryptoJournal[0], nil
 }
 
+// CryptoAccountSetStatus is the interface through which external methods can set the status of a Crypto account that has
+// not been closed.
+func (p *postgresImpl) CryptoAccountSetStatus(clientID uuid.UUID, ticker string, status AccountStatus) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.cryptoSetAccountStatus(ctx,
+		&cryptoSetAccountStatusParams{ClientID: clientID, Ticker: ticker, Status: status})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update Crypto account status", zap.Error(err))
+
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 // CryptoBalancesPaginated is the interface through which external methods can retrieve all Crypto account balances for
 // a specific client.
 func (p *postgresImpl) CryptoBalancesPaginated(clientID uuid.UUID, ticker string, limit int32) (
-	[]CryptoAccount, error) {
+	[]CryptoAccountBalance, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 
 	defer cancel()
@@ -178,7 +256,7 @@ func (p *postgresImpl) CryptoBalancesPaginated(clientID uuid.UUID, ticker string
 		Limit:    limit,
 	})
 	if err != nil {
-		return []CryptoAccount{}, ErrNotFound
+		return []CryptoAccountBalance{}, ErrNotFound
 	}
 
 	return balance, nil
@@ -211,3 +289,74 @@ func (p *postgresImpl) CryptoTransactionsPaginated(
 
 	return balance, nil
 }
+
+// CryptoAssetGet is the interface through which external methods can retrieve the registry entry for a specific
+// Cryptocurrency.
+func (p *postgresImpl) CryptoAssetGet(ticker string) (CryptoAsset, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	asset, err := p.Query.cryptoAssetGet(ctx, ticker)
+	if err != nil {
+		return CryptoAsset{}, ErrNotFound
+	}
+
+	return asset, nil
+}
+
+// CryptoAssetGetAll is the interface through which external methods can retrieve all registered Cryptocurrencies.
+func (p *postgresImpl) CryptoAssetGetAll() ([]CryptoAsset, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	assets, err := p.Query.cryptoAssetGetAll(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve Cryptocurrency registry", zap.Error(err))
+
+		return []CryptoAsset{}, ErrNotFound
+	}
+
+	return assets, nil
+}
+
+// CryptoAssetUpsert is the interface through which external methods can register or update a Cryptocurrency.
+func (p *postgresImpl) CryptoAssetUpsert(asset *CryptoAsset) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.cryptoAssetUpsert(ctx, &cryptoAssetUpsertParams{
+		Ticker:        asset.Ticker,
+		Name:          asset.Name,
+		DecimalPlaces: asset.DecimalPlaces,
+		Status:        asset.Status,
+		MinOrder:      asset.MinOrder,
+		MaxOrder:      asset.MaxOrder,
+	})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to register or update Cryptocurrency", zap.Error(err))
+
+		return ErrCryptoAsset
+	}
+
+	return nil
+}
+
+// CryptoAssetSetStatus is the interface through which external methods can enable or halt trading for a
+// Cryptocurrency.
+func (p *postgresImpl) CryptoAssetSetStatus(ticker string, status CryptoAssetStatus) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.cryptoAssetSetStatus(ctx, &cryptoAssetSetStatusParams{Ticker: ticker, Status: status})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update Cryptocurrency trading status", zap.Error(err))
+
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -110,7 +110,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 		{
 			name:               "valid - USD to BTC (first)",
 			clientID:           clientID1,
-			fiatCurrency:       CurrencyUSD,
+			fiatCurrency:       Currency("USD"),
 			cryptoTicker:       "BTC",
 			fiatDebitAmount:    decimal.NewFromFloat(456.78),
 			cryptoCreditAmount: decimal.NewFromFloat(13.12345678),
@@ -118,7 +118,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 		}, {
 			name:               "valid - USD to BTC (second)",
 			clientID:           clientID1,
-			fiatCurrency:       CurrencyUSD,
+			fiatCurrency:       Currency("USD"),
 			cryptoTicker:       "BTC",
 			fiatDebitAmount:    decimal.NewFromFloat(2389.33),
 			cryptoCreditAmount: decimal.NewFromFloat(104.80808081),
@@ -126,7 +126,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 		}, {
 			name:               "invalid - PKR to BTC",
 			clientID:           clientID1,
-			fiatCurrency:       CurrencyPKR,
+			fiatCurrency:       Currency("PKR"),
 			cryptoTicker:       "BTC",
 			fiatDebitAmount:    decimal.NewFromFloat(456.78),
 			cryptoCreditAmount: decimal.NewFromFloat(13.12345678),
@@ -134,7 +134,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 		}, {
 			name:               "invalid - USD to invalid crypto",
 			clientID:           clientID1,
-			fiatCurrency:       CurrencyUSD,
+			fiatCurrency:       Currency("USD"),
 			cryptoTicker:       "BAD",
 			fiatDebitAmount:    decimal.NewFromFloat(77.99),
 			cryptoCreditAmount: decimal.NewFromFloat(4.0000003),
@@ -142,7 +142,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 		}, {
 			name:               "invalid - USD insufficient funds",
 			clientID:           clientID1,
-			fiatCurrency:       CurrencyUSD,
+			fiatCurrency:       Currency("USD"),
 			cryptoTicker:       "BTC",
 			fiatDebitAmount:    decimal.NewFromFloat(9999999.99),
 			cryptoCreditAmount: decimal.NewFromFloat(6.1100005),
@@ -161,9 +161,9 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 	ts1 := pgtype.Timestamptz{}
 	require.NoError(t, ts1.Scan(time.Now().UTC()), "time stamp parse failed.")
 
-	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err := updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID1,
-		Currency: CurrencyUSD,
+		Currency: Currency("USD"),
 		Amount:   decimal.NewFromFloat(5643.17),
 		LastTxTs: ts1,
 	})
@@ -184,7 +184,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 
 			t.Run(test.name, func(t *testing.T) {
 				fiatJournal, cryptoJournal, err := connection.CryptoPurchase(
-					test.clientID, test.fiatCurrency, test.fiatDebitAmount, test.cryptoTicker, test.cryptoCreditAmount)
+					test.clientID, test.fiatCurrency, test.fiatDebitAmount, test.cryptoTicker, test.cryptoCreditAmount, "")
 				test.expectErr(t, err, "error expectation failed.")
 
 				if err != nil {
@@ -235,7 +235,7 @@ func TestQueries_CryptoSell(t *testing.T) {
 		{
 			name:              "valid - BTC to USD (first)",
 			clientID:          clientID1,
-			fiatCurrency:      CurrencyUSD,
+			fiatCurrency:      Currency("USD"),
 			cryptoTicker:      "BTC",
 			fiatCreditAmount:  decimal.NewFromFloat(992.91),
 			cryptoDebitAmount: decimal.NewFromFloat(9.11992012),
@@ -243,7 +243,7 @@ func TestQueries_CryptoSell(t *testing.T) {
 		}, {
 			name:              "valid - BTC to USD (second)",
 			clientID:          clientID1,
-			fiatCurrency:      CurrencyUSD,
+			fiatCurrency:      Currency("USD"),
 			cryptoTicker:      "BTC",
 			fiatCreditAmount:  decimal.NewFromFloat(7765.32),
 			cryptoDebitAmount: decimal.NewFromFloat(11.40404049),
@@ -251,7 +251,7 @@ func TestQueries_CryptoSell(t *testing.T) {
 		}, {
 			name:              "invalid - BTC to PKR",
 			clientID:          clientID1,
-			fiatCurrency:      CurrencyPKR,
+			fiatCurrency:      Currency("PKR"),
 			cryptoTicker:      "BTC",
 			fiatCreditAmount:  decimal.NewFromFloat(555.11),
 			cryptoDebitAmount: decimal.NewFromFloat(88888.12345678),
@@ -259,7 +259,7 @@ func TestQueries_CryptoSell(t *testing.T) {
 		}, {
 			name:              "invalid - invalid crypto to USD",
 			clientID:          clientID1,
-			fiatCurrency:      CurrencyUSD,
+			fiatCurrency:      Currency("USD"),
 			cryptoTicker:      "BAD",
 			fiatCreditAmount:  decimal.NewFromFloat(77.99),
 			cryptoDebitAmount: decimal.NewFromFloat(4.0000003),
@@ -267,7 +267,7 @@ func TestQueries_CryptoSell(t *testing.T) {
 		}, {
 			name:              "invalid - Crypto insufficient funds",
 			clientID:          clientID1,
-			fiatCurrency:      CurrencyUSD,
+			fiatCurrency:      Currency("USD"),
 			cryptoTicker:      "BTC",
 			fiatCreditAmount:  decimal.NewFromFloat(9999999.99),
 			cryptoDebitAmount: decimal.NewFromFloat(9191919191.1100005),
@@ -286,16 +286,16 @@ func TestQueries_CryptoSell(t *testing.T) {
 	ts1 := pgtype.Timestamptz{}
 	require.NoError(t, ts1.Scan(time.Now().UTC()), "time stamp parse failed.")
 
-	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err := updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID1,
-		Currency: CurrencyUSD,
+		Currency: Currency("USD"),
 		Amount:   decimal.NewFromFloat(64320.27),
 		LastTxTs: ts1,
 	})
 	require.NoError(t, err, "error expectation condition failed.")
 
 	_, _, err = connection.CryptoPurchase(
-		clientID1, CurrencyUSD, decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444))
+		clientID1, Currency("USD"), decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444), "")
 	require.NoError(t, err, "error expectation condition failed.")
 
 	negOne := decimal.NewFromFloat(-1)
@@ -313,7 +313,7 @@ func TestQueries_CryptoSell(t *testing.T) {
 
 			t.Run(test.name, func(t *testing.T) {
 				fiatJournal, cryptoJournal, err := connection.CryptoSell(
-					test.clientID, test.fiatCurrency, test.fiatCreditAmount, test.cryptoTicker, test.cryptoDebitAmount)
+					test.clientID, test.fiatCurrency, test.fiatCreditAmount, test.cryptoTicker, test.cryptoDebitAmount, "")
 				test.expectErr(t, err, "error expectation failed.")
 
 				if err != nil {
@@ -444,14 +444,14 @@ func TestCrypto_CryptoTransactionsPaginated(t *testing.T) {
 	{
 		_, err := connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
 			ClientID: clientID1,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 			Amount:   decimal.NewFromFloat(10203040.56),
 		})
 		require.NoError(t, err, "failed to deposit Fiat money for client 1.")
 
 		_, err = connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
 			ClientID: clientID2,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 			Amount:   decimal.NewFromFloat(10304055.78),
 		})
 		require.NoError(t, err, "failed to deposit Fiat money for client 2.")
@@ -580,3 +580,117 @@ func TestCrypto_CryptoTransactionsPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_CryptoAssets(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+
+	// Insert an initial set of test Crypto accounts.
+	resetTestCryptoAccounts(t, clientIDs[0], clientIDs[1])
+
+	// Registry listing.
+	assets, err := connection.CryptoAssetGetAll()
+	require.NoError(t, err, "failed to retrieve Cryptocurrency registry.")
+	require.GreaterOrEqual(t, len(assets), 3, "Cryptocurrency registry missing seeded entries.")
+
+	// Seeded precision.
+	eth, err := connection.CryptoAssetGet("ETH")
+	require.NoError(t, err, "failed to retrieve Ether registry entry.")
+	require.Equal(t, int32(18), eth.DecimalPlaces, "Ether precision mismatched.")
+
+	// Unregistered Cryptocurrency.
+	_, err = connection.CryptoAssetGet("XYZ")
+	require.ErrorIs(t, err, ErrNotFound, "retrieved unregistered Cryptocurrency.")
+	require.ErrorIs(t, connection.CryptoAssetSetStatus("XYZ", CryptoAssetStatusHALTED), ErrNotFound,
+		"halted unregistered Cryptocurrency.")
+
+	// Register and then update a Cryptocurrency.
+	asset := CryptoAsset{
+		Ticker:        "UVW",
+		Name:          "Test Coin",
+		DecimalPlaces: 4,
+		Status:        CryptoAssetStatusENABLED,
+		MinOrder:      decimal.NewFromFloat(0.01),
+		MaxOrder:      decimal.NewFromFloat(100),
+	}
+	require.NoError(t, connection.CryptoAssetUpsert(&asset), "failed to register Cryptocurrency.")
+
+	asset.MaxOrder = decimal.NewFromFloat(1000)
+	require.NoError(t, connection.CryptoAssetUpsert(&asset), "failed to update Cryptocurrency.")
+
+	actual, err := connection.CryptoAssetGet("UVW")
+	require.NoError(t, err, "failed to retrieve registered Cryptocurrency.")
+	require.True(t, asset.MaxOrder.Equal(actual.MaxOrder), "maximum order size not updated.")
+
+	// Invalid order limits.
+	asset.MinOrder = decimal.NewFromFloat(10000)
+	require.ErrorIs(t, connection.CryptoAssetUpsert(&asset), ErrCryptoAsset, "registered invalid order limits.")
+
+	// Halted Cryptocurrency accounts cannot be opened.
+	require.NoError(t, connection.CryptoAssetSetStatus("UVW", CryptoAssetStatusHALTED), "failed to halt trading.")
+	require.Error(t, connection.CryptoCreateAccount(clientIDs[0], "UVW"), "opened halted Crypto account.")
+
+	require.NoError(t, connection.CryptoAssetSetStatus("UVW", CryptoAssetStatusENABLED), "failed to enable trading.")
+	require.NoError(t, connection.CryptoCreateAccount(clientIDs[0], "UVW"), "failed to open enabled Crypto account.")
+
+	// Cleanup.
+	_, err = connection.queries.db.Exec(context.TODO(), "DELETE FROM crypto_accounts WHERE ticker = 'UVW';")
+	require.NoError(t, err, "failed to remove test Crypto account.")
+	_, err = connection.queries.db.Exec(context.TODO(), "DELETE FROM crypto_assets WHERE ticker = 'UVW';")
+	require.NoError(t, err, "failed to remove test Cryptocurrency.")
+}
+
+func TestQueries_CryptoAccountSetStatus(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	fiatAmount := decimal.NewFromFloat(10)
+	cryptoAmount := decimal.NewFromFloat(0.01)
+
+	// Accounts with frozen debits cannot be sold from, but can be purchased into. The purchase will fail on funds.
+	require.NoError(t, connection.CryptoAccountSetStatus(clientID1, "BTC", AccountStatusFROZENDEBITS),
+		"failed to freeze debits.")
+
+	account, err := connection.CryptoBalance(clientID1, "BTC")
+	require.NoError(t, err, "failed to retrieve Crypto account.")
+	require.Equal(t, AccountStatusFROZENDEBITS, account.Status, "account status mismatch.")
+
+	_, _, err = connection.CryptoSell(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.ErrorIs(t, err, ErrAccountStatus, "sold from account with frozen debits.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.ErrorIs(t, err, ErrTransactCrypto, "purchase into account with frozen debits should fail on funds.")
+
+	// Frozen accounts cannot be purchased into.
+	require.NoError(t, connection.CryptoAccountSetStatus(clientID1, "BTC", AccountStatusFROZEN), "failed to freeze.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.ErrorIs(t, err, ErrAccountStatus, "purchased into frozen account.")
+
+	// Suspended clients cannot transact.
+	require.NoError(t, connection.CryptoAccountSetStatus(clientID1, "BTC", AccountStatusACTIVE), "failed to unfreeze.")
+	require.NoError(t, connection.UserSetFrozen(clientID1, true), "failed to suspend client.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.ErrorIs(t, err, ErrAccountStatus, "purchased for suspended client.")
+
+	require.NoError(t, connection.UserSetFrozen(clientID1, false), "failed to reinstate client.")
+
+	// Missing account.
+	require.ErrorIs(t, connection.CryptoAccountSetStatus(clientID1, "XRP", AccountStatusFROZEN), ErrNotFound,
+		"set status of missing account.")
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -27,14 +28,14 @@ func (p *postgresImpl) FiatCreateAccount(clientID uuid.UUID, currency Currency)
 
 // FiatBalance is the interface through which external methods can retrieve a Fiat-account balance for a specific
 // currency.
-func (p *postgresImpl) FiatBalance(clientID uuid.UUID, currency Currency) (FiatAccount, error) {
+func (p *postgresImpl) FiatBalance(clientID uuid.UUID, currency Currency) (FiatAccountBalance, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 
 	defer cancel()
 
 	balance, err := p.Query.fiatGetAccount(ctx, &fiatGetAccountParams{ClientID: clientID, Currency: currency})
 	if err != nil {
-		return FiatAccount{}, ErrNotFound
+		return FiatAccountBalance{}, ErrNotFound
 	}
 
 	return balance, nil
@@ -58,7 +59,7 @@ func (p *postgresImpl) FiatTxDetails(clientID uuid.UUID, transactionID uuid.UUID
 // FiatBalancePaginated is the interface through which external methods can retrieve all Fiat account balances for a
 // specific client.
 func (p *postgresImpl) FiatBalancePaginated(clientID uuid.UUID, baseCurrency Currency, limit int32) (
-	[]FiatAccount, error) {
+	[]FiatAccountBalance, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 
 	defer cancel()
@@ -69,7 +70,7 @@ func (p *postgresImpl) FiatBalancePaginated(clientID uuid.UUID, baseCurrency Cur
 		Limit:    limit,
 	})
 	if err != nil {
-		return []FiatAccount{}, ErrNotFound
+		return []FiatAccountBalance{}, ErrNotFound
 	}
 
 	return balance, nil
@@ -102,3 +103,70 @@ func (p *postgresImpl) FiatTransactionsPaginated(
 
 	return balance, nil
 }
+
+// FiatAccountSetStatus is the interface through which external methods can set the status of a Fiat account that has not
+// been closed.
+func (p *postgresImpl) FiatAccountSetStatus(clientID uuid.UUID, currency Currency, status AccountStatus) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.fiatSetAccountStatus(ctx,
+		&fiatSetAccountStatusParams{ClientID: clientID, Currency: currency, Status: status})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update Fiat account status", zap.Error(err))
+
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// FiatCurrencyGetAll is the interface through which external methods can retrieve all registered Fiat currencies.
+func (p *postgresImpl) FiatCurrencyGetAll() ([]FiatCurrency, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	records, err := p.Query.fiatCurrencyGetAll(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve Fiat currency reference table", zap.Error(err))
+
+		return []FiatCurrency{}, ErrNotFound
+	}
+
+	return records, nil
+}
+
+// FiatCurrencyUpsert is the interface through which external methods can register a Fiat currency or update its
+// circulation status. The currency cache is reloaded after a successful update.
+func (p *postgresImpl) FiatCurrencyUpsert(code string, status FiatCurrencyStatus) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.fiatCurrencyUpsert(ctx, &fiatCurrencyUpsertParams{Code: code, Status: status})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to register or update Fiat currency", zap.Error(err))
+
+		return ErrFiatCurrency
+	}
+
+	if err = p.loadCurrencyCache(); err != nil {
+		return ErrFiatCurrency
+	}
+
+	return nil
+}
+
+// loadCurrencyCache will load the Fiat currency reference table into the currency cache.
+func (p *postgresImpl) loadCurrencyCache() error {
+	records, err := p.FiatCurrencyGetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load Fiat currency cache %w", err)
+	}
+
+	SetCurrencyCache(records)
+
+	return nil
+}
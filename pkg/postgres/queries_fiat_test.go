@@ -8,6 +8,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,6 +29,113 @@ func TestQueries_FiatCreateAccount(t *testing.T) {
 
 	err = connection.FiatCreateAccount(clientID1, "EUR")
 	require.Error(t, err, "created duplicate Fiat account.")
+
+	err = connection.FiatCreateAccount(clientID1, "ZWD")
+	require.Error(t, err, "created Fiat account in a withdrawn currency.")
+
+	err = connection.FiatCreateAccount(clientID1, "SLL")
+	require.Error(t, err, "created Fiat account in a deprecated currency.")
+}
+
+func TestQueries_FiatAccountSetStatus(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	deposit := &FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(100)}
+	exchangeSrc := &FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(10)}
+	exchangeDst := &FiatTransactionDetails{ClientID: clientID1, Currency: "CAD", Amount: decimal.NewFromFloat(13)}
+
+	_, err := connection.FiatExternalTransfer(context.TODO(), deposit)
+	require.NoError(t, err, "failed to deposit into active account.")
+
+	// Accounts with frozen debits can be credited but not debited.
+	require.NoError(t, connection.FiatAccountSetStatus(clientID1, "USD", AccountStatusFROZENDEBITS),
+		"failed to freeze debits.")
+
+	account, err := connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve Fiat account.")
+	require.Equal(t, AccountStatusFROZENDEBITS, account.Status, "account status mismatch.")
+
+	_, err = connection.FiatExternalTransfer(context.TODO(), deposit)
+	require.NoError(t, err, "failed to deposit into account with frozen debits.")
+
+	_, _, err = connection.FiatInternalTransfer(context.TODO(), exchangeSrc, exchangeDst)
+	require.ErrorIs(t, err, ErrAccountStatus, "debited account with frozen debits.")
+
+	// Frozen accounts can be neither credited nor debited.
+	require.NoError(t, connection.FiatAccountSetStatus(clientID1, "USD", AccountStatusFROZEN), "failed to freeze.")
+
+	_, err = connection.FiatExternalTransfer(context.TODO(), deposit)
+	require.ErrorIs(t, err, ErrAccountStatus, "credited frozen account.")
+
+	_, _, err = connection.FiatInternalTransfer(context.TODO(), exchangeDst, exchangeSrc)
+	require.ErrorIs(t, err, ErrAccountStatus, "credited frozen account through exchange.")
+
+	// Suspended clients cannot transact.
+	require.NoError(t, connection.FiatAccountSetStatus(clientID1, "USD", AccountStatusACTIVE), "failed to unfreeze.")
+	require.NoError(t, connection.UserSetFrozen(clientID1, true), "failed to suspend client.")
+
+	_, err = connection.FiatExternalTransfer(context.TODO(), deposit)
+	require.ErrorIs(t, err, ErrAccountStatus, "credited account of suspended client.")
+
+	require.NoError(t, connection.UserSetFrozen(clientID1, false), "failed to reinstate client.")
+
+	_, _, err = connection.FiatInternalTransfer(context.TODO(), exchangeSrc, exchangeDst)
+	require.NoError(t, err, "failed to exchange from reinstated account.")
+
+	// Missing and closed accounts.
+	require.ErrorIs(t, connection.FiatAccountSetStatus(clientID1, "EUR", AccountStatusFROZEN), ErrNotFound,
+		"set status of missing account.")
+
+	_, err = connection.queries.db.Exec(context.TODO(),
+		"UPDATE fiat_accounts SET status = 'CLOSED' WHERE client_id = $1 AND currency = 'AED';", clientID1)
+	require.NoError(t, err, "failed to close test account.")
+	require.ErrorIs(t, connection.FiatAccountSetStatus(clientID1, "AED", AccountStatusACTIVE), ErrNotFound,
+		"set status of closed account.")
+}
+
+func TestQueries_FiatCurrencies(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Restore the seeded reference table state.
+	defer func() {
+		require.NoError(t, connection.FiatCurrencyUpsert("PKR", FiatCurrencyStatusACTIVE), "failed to restore PKR.")
+	}()
+
+	records, err := connection.FiatCurrencyGetAll()
+	require.NoError(t, err, "failed to retrieve Fiat currencies.")
+	require.GreaterOrEqual(t, len(records), len(GenerateTestCurrencies()), "missing seeded Fiat currencies.")
+
+	for _, record := range records {
+		cached, ok := Currency(record.Code).Status()
+		require.True(t, ok, "currency missing from cache.")
+		require.Equal(t, record.Status, cached, "cached currency status mismatch.")
+	}
+
+	// Withdraw a currency and check the cache is refreshed.
+	require.NoError(t, connection.FiatCurrencyUpsert("PKR", FiatCurrencyStatusWITHDRAWN), "failed to withdraw PKR.")
+	require.False(t, Currency("PKR").Transactable(), "withdrawn currency should not be transactable.")
+	require.True(t, Currency("PKR").Valid(), "withdrawn currency should remain readable.")
+
+	// Register a new currency.
+	defer func() {
+		_, err := connection.queries.db.Exec(context.TODO(), "DELETE FROM fiat_currencies WHERE code='XTS';")
+		require.NoError(t, err, "failed to remove test currency.")
+	}()
+
+	require.NoError(t, connection.FiatCurrencyUpsert("XTS", FiatCurrencyStatusACTIVE), "failed to register XTS.")
+	require.True(t, Currency("XTS").Openable(), "registered currency should be openable.")
 }
 
 func TestQueries_FiatBalanceCurrency(t *testing.T) {
@@ -49,19 +157,19 @@ func TestQueries_FiatBalanceCurrency(t *testing.T) {
 	}{
 		{
 			name:      "AED valid",
-			currency:  CurrencyAED,
+			currency:  Currency("AED"),
 			expectErr: require.NoError,
 		}, {
 			name:      "CAD valid",
-			currency:  CurrencyCAD,
+			currency:  Currency("CAD"),
 			expectErr: require.NoError,
 		}, {
 			name:      "USD valid",
-			currency:  CurrencyUSD,
+			currency:  Currency("USD"),
 			expectErr: require.NoError,
 		}, {
 			name:      "EUR invalid",
-			currency:  CurrencyEUR,
+			currency:  Currency("EUR"),
 			expectErr: require.Error,
 		},
 	}
@@ -94,47 +202,47 @@ func TestQueries_FiatBalanceCurrencyPaginated(t *testing.T) {
 	}{
 		{
 			name:         "AED All",
-			baseCurrency: CurrencyAED,
+			baseCurrency: Currency("AED"),
 			limit:        3,
 			expectLen:    3,
 		}, {
 			name:         "AED One",
-			baseCurrency: CurrencyAED,
+			baseCurrency: Currency("AED"),
 			limit:        1,
 			expectLen:    1,
 		}, {
 			name:         "AED Two",
-			baseCurrency: CurrencyAED,
+			baseCurrency: Currency("AED"),
 			limit:        2,
 			expectLen:    2,
 		}, {
 			name:         "CAD All",
-			baseCurrency: CurrencyCAD,
+			baseCurrency: Currency("CAD"),
 			limit:        3,
 			expectLen:    2,
 		}, {
 			name:         "CAD All",
-			baseCurrency: CurrencyCAD,
+			baseCurrency: Currency("CAD"),
 			limit:        1,
 			expectLen:    1,
 		}, {
 			name:         "USD All",
-			baseCurrency: CurrencyUSD,
+			baseCurrency: Currency("USD"),
 			limit:        3,
 			expectLen:    1,
 		}, {
 			name:         "USD One",
-			baseCurrency: CurrencyUSD,
+			baseCurrency: Currency("USD"),
 			limit:        1,
 			expectLen:    1,
 		}, {
 			name:         "EUR invalid but okay",
-			baseCurrency: CurrencyEUR,
+			baseCurrency: Currency("EUR"),
 			limit:        3,
 			expectLen:    1,
 		}, {
 			name:         "ZWD invalid and not okay",
-			baseCurrency: CurrencyZWD,
+			baseCurrency: Currency("ZWD"),
 			limit:        3,
 			expectLen:    0,
 		},
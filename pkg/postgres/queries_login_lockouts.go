@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// LoginLockoutCreate is the interface through which external methods can record a temporary lockout of a username or
+// IP address after repeated failed login attempts.
+func (p *postgresImpl) LoginLockoutCreate(scope LockoutScope, subject string, failures int64, ipAddress string,
+	expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.loginLockoutCreate(ctx, &loginLockoutCreateParams{
+		Scope:     scope,
+		Subject:   subject,
+		Failures:  failures,
+		IpAddress: ipAddress,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil || rowsAffected != 1 {
+		p.logger.Error("failed to record login lockout",
+			zap.String("scope", string(scope)), zap.String("subject", subject), zap.Error(err))
+
+		return ErrLoginLockout
+	}
+
+	return nil
+}
+
+// LoginLockoutsRecent is the interface through which external methods can retrieve the most recent lockouts, newest
+// first. The lockouts can be restricted to those that are still in effect.
+func (p *postgresImpl) LoginLockoutsRecent(activeOnly bool, limit int32) ([]LoginLockout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	lockouts, err := p.Query.loginLockoutsRecent(ctx, &loginLockoutsRecentParams{Limit: limit, ActiveOnly: activeOnly})
+	if err != nil {
+		p.logger.Error("failed to retrieve login lockouts", zap.Error(err))
+
+		return nil, ErrLoginLockout
+	}
+
+	return lockouts, nil
+}
+
+// LoginLockoutUnlock is the interface through which external methods can record that an administrator has lifted the
+// lockouts of a username or IP address that are still in effect.
+func (p *postgresImpl) LoginLockoutUnlock(adminID uuid.UUID, scope LockoutScope, subject string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.loginLockoutUnlock(ctx, &loginLockoutUnlockParams{
+		UnlockedBy: adminID,
+		Scope:      scope,
+		Subject:    subject,
+	})
+	if err != nil {
+		p.logger.Error("failed to lift login lockout",
+			zap.String("scope", string(scope)), zap.String("subject", subject), zap.Error(err))
+
+		return ErrLoginLockout
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
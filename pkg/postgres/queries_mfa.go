@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// MFAEnroll is the interface through which external methods can record a pending two-factor authentication enrolment
+// with an encrypted TOTP secret. A pending enrolment is replaced, but an enabled enrolment is not.
+func (p *postgresImpl) MFAEnroll(clientID uuid.UUID, secret string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaEnroll(ctx, &mfaEnrollParams{ClientID: clientID, Secret: secret})
+	if err != nil {
+		p.logger.Error("failed to enroll two-factor authentication",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrMFAEnrolled
+	}
+
+	return nil
+}
+
+// MFAGet is the interface through which external methods can retrieve the two-factor authentication enrolment of a
+// client.
+func (p *postgresImpl) MFAGet(clientID uuid.UUID) (UserMFA, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	enrolment, err := p.Query.mfaGet(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return UserMFA{}, ErrNotFound
+		}
+
+		p.logger.Error("failed to retrieve two-factor authentication enrolment",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return UserMFA{}, ErrMFA
+	}
+
+	return enrolment, nil
+}
+
+// MFAConfirm is the interface through which external methods can enable a pending two-factor authentication enrolment
+// with the time step of its first one-time password and the hashed recovery codes.
+func (p *postgresImpl) MFAConfirm(clientID uuid.UUID, step int64, recoveryCodes []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaConfirm(ctx, &mfaConfirmParams{
+		LastStep:      step,
+		RecoveryCodes: recoveryCodes,
+		ClientID:      clientID,
+	})
+	if err != nil {
+		p.logger.Error("failed to confirm two-factor authentication enrolment",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MFAUseStep is the interface through which external methods can record the time step of a one-time password that has
+// been used. One-time passwords from the same or earlier time steps are rejected.
+func (p *postgresImpl) MFAUseStep(clientID uuid.UUID, step int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaUseStep(ctx, &mfaUseStepParams{LastStep: step, ClientID: clientID})
+	if err != nil {
+		p.logger.Error("failed to record one-time password use",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MFAUseRecoveryCode is the interface through which external methods can consume a hashed recovery code.
+func (p *postgresImpl) MFAUseRecoveryCode(clientID uuid.UUID, codeHash string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaUseRecoveryCode(ctx, &mfaUseRecoveryCodeParams{
+		CodeHash: codeHash,
+		ClientID: clientID,
+	})
+	if err != nil {
+		p.logger.Error("failed to consume recovery code", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MFASetRecoveryCodes is the interface through which external methods can replace the hashed recovery codes of an
+// enabled two-factor authentication enrolment.
+func (p *postgresImpl) MFASetRecoveryCodes(clientID uuid.UUID, recoveryCodes []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaSetRecoveryCodes(ctx, &mfaSetRecoveryCodesParams{
+		RecoveryCodes: recoveryCodes,
+		ClientID:      clientID,
+	})
+	if err != nil {
+		p.logger.Error("failed to replace recovery codes", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MFADelete is the interface through which external methods can disable two-factor authentication for a client.
+func (p *postgresImpl) MFADelete(clientID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaDelete(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to disable two-factor authentication",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MFASecrets is the interface through which external methods can retrieve the encrypted TOTP secrets of all
+// two-factor authentication enrolments, keyed by client id.
+func (p *postgresImpl) MFASecrets() (map[uuid.UUID]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.mfaSecrets(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve two-factor authentication secrets", zap.Error(err))
+
+		return nil, ErrMFA
+	}
+
+	secrets := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		secrets[row.ClientID] = row.Secret
+	}
+
+	return secrets, nil
+}
+
+// MFASecretUpdate is the interface through which external methods can replace the encrypted TOTP secret of an
+// enrolment with a re-encrypted one. The secret is not replaced if it has changed since it was retrieved.
+func (p *postgresImpl) MFASecretUpdate(clientID uuid.UUID, secret, reencrypted string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaSetSecret(ctx, &mfaSetSecretParams{
+		NewSecret: reencrypted,
+		ClientID:  clientID,
+		Secret:    secret,
+	})
+	if err != nil {
+		p.logger.Error("failed to replace two-factor authentication secret",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrMFA
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_MFA(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+	clientID := clientIDs[0]
+
+	// Clients that have not enrolled have no enrolment.
+	_, err := connection.MFAGet(clientID)
+	require.ErrorIs(t, err, ErrNotFound, "retrieved enrolment that does not exist.")
+
+	// A pending enrolment can be replaced.
+	require.NoError(t, connection.MFAEnroll(clientID, "first-secret"), "failed to enroll.")
+	require.NoError(t, connection.MFAEnroll(clientID, "second-secret"), "failed to replace pending enrolment.")
+
+	enrolment, err := connection.MFAGet(clientID)
+	require.NoError(t, err, "failed to retrieve pending enrolment.")
+	require.Equal(t, "second-secret", enrolment.Secret, "pending enrolment not replaced.")
+	require.False(t, enrolment.EnrolledAt.Valid, "pending enrolment is enabled.")
+
+	// One-time passwords and recovery codes are not accepted before the enrolment is confirmed.
+	require.ErrorIs(t, connection.MFAUseStep(clientID, 10), ErrNotFound, "used step of pending enrolment.")
+	require.ErrorIs(t, connection.MFASetRecoveryCodes(clientID, []string{"code"}), ErrNotFound,
+		"set recovery codes of pending enrolment.")
+
+	// Confirm the enrolment.
+	require.NoError(t, connection.MFAConfirm(clientID, 10, []string{"code-1", "code-2"}), "failed to confirm.")
+	require.ErrorIs(t, connection.MFAConfirm(clientID, 11, []string{}), ErrNotFound, "confirmed twice.")
+	require.ErrorIs(t, connection.MFAEnroll(clientID, "third-secret"), ErrMFAEnrolled, "re-enrolled when enabled.")
+
+	// One-time passwords cannot be replayed.
+	require.ErrorIs(t, connection.MFAUseStep(clientID, 10), ErrNotFound, "replayed time step.")
+	require.NoError(t, connection.MFAUseStep(clientID, 11), "failed to use time step.")
+
+	// Recovery codes are single-use.
+	require.NoError(t, connection.MFAUseRecoveryCode(clientID, "code-1"), "failed to use recovery code.")
+	require.ErrorIs(t, connection.MFAUseRecoveryCode(clientID, "code-1"), ErrNotFound, "reused recovery code.")
+
+	require.NoError(t, connection.MFASetRecoveryCodes(clientID, []string{"code-3"}), "failed to set recovery codes.")
+	require.ErrorIs(t, connection.MFAUseRecoveryCode(clientID, "code-2"), ErrNotFound, "used replaced recovery code.")
+
+	enrolment, err = connection.MFAGet(clientID)
+	require.NoError(t, err, "failed to retrieve enabled enrolment.")
+	require.True(t, enrolment.EnrolledAt.Valid, "enrolment is not enabled.")
+	require.Equal(t, int64(11), enrolment.LastStep, "last step mismatch.")
+	require.Equal(t, []string{"code-3"}, enrolment.RecoveryCodes, "recovery codes mismatch.")
+
+	// Secrets are only re-encrypted if they have not changed since they were retrieved.
+	secrets, err := connection.MFASecrets()
+	require.NoError(t, err, "failed to retrieve secrets.")
+	require.Equal(t, "second-secret", secrets[clientID], "secret mismatch.")
+	require.ErrorIs(t, connection.MFASecretUpdate(clientID, "stale-secret", "reencrypted-secret"), ErrNotFound,
+		"replaced a stale secret.")
+	require.NoError(t, connection.MFASecretUpdate(clientID, "second-secret", "reencrypted-secret"),
+		"failed to replace secret.")
+
+	enrolment, err = connection.MFAGet(clientID)
+	require.NoError(t, err, "failed to retrieve re-encrypted enrolment.")
+	require.Equal(t, "reencrypted-secret", enrolment.Secret, "secret not replaced.")
+
+	// Disable two-factor authentication.
+	require.NoError(t, connection.MFADelete(clientID), "failed to disable.")
+	require.ErrorIs(t, connection.MFADelete(clientID), ErrNotFound, "disabled twice.")
+}
@@ -2,13 +2,30 @@ package postgres
 
 import (
 	"context"
+	"errors"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/surahman/FTeX/pkg/constants"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"go.uber.org/zap"
 )
 
+const (
+	// uniqueViolationErrCode is the SQLSTATE raised when a unique constraint is violated.
+	uniqueViolationErrCode = "23505"
+
+	// emailUniqueIndex is the name of the unique index on the email addresses of user accounts.
+	emailUniqueIndex = "users_email_key"
+)
+
+// isEmailRegisteredError will check if a database error was raised by an email address that is already registered.
+func isEmailRegisteredError(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationErrCode && pgErr.ConstraintName == emailUniqueIndex
+}
+
 // UserRegister is the interface through which external methods can create a user.
 func (p *postgresImpl) UserRegister(userDetails *modelsPostgres.UserAccount) (uuid.UUID, error) {
 	params := userCreateParams{
@@ -72,8 +89,11 @@ func (p *postgresImpl) UserGetInfo(clientID uuid.UUID) (modelsPostgres.User, err
 				LastName:  userAccount.LastName,
 				Email:     userAccount.Email,
 			},
-			ClientID:  userAccount.ClientID,
-			IsDeleted: userAccount.IsDeleted,
+			ClientID:      userAccount.ClientID,
+			Role:          string(userAccount.Role),
+			IsDeleted:     userAccount.IsDeleted,
+			IsFrozen:      userAccount.IsFrozen,
+			EmailVerified: userAccount.EmailVerified,
 		},
 		nil
 }
@@ -109,3 +129,136 @@ func (p *postgresImpl) UserIsDeleted(clientID uuid.UUID) (bool, error) {
 
 	return isDeleted, nil
 }
+
+// UserGetStatus is the interface through which external methods can retrieve the role, soft delete, and freeze status
+// of a user account.
+func (p *postgresImpl) UserGetStatus(clientID uuid.UUID) (modelsPostgres.UserStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	status, err := p.Query.userGetStatus(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to check status of user", zap.Error(err))
+
+		return modelsPostgres.UserStatus{}, ErrNotFound
+	}
+
+	return modelsPostgres.UserStatus{
+		Role:      string(status.Role),
+		IsDeleted: status.IsDeleted,
+		IsFrozen:  status.IsFrozen,
+	}, nil
+}
+
+// UserSearch is the interface through which external methods can search for user accounts by client id, username,
+// name, or email address.
+func (p *postgresImpl) UserSearch(query string, limit int32) ([]modelsPostgres.UserProfile, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.userSearch(ctx, &userSearchParams{Limit: limit, Query: query})
+	if err != nil {
+		p.logger.Error("failed to search for users", zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+
+	profiles := make([]modelsPostgres.UserProfile, 0, len(rows))
+	for _, row := range rows {
+		profiles = append(profiles, modelsPostgres.UserProfile{
+			Username:      row.Username,
+			FirstName:     row.FirstName,
+			LastName:      row.LastName,
+			Email:         row.Email,
+			ClientID:      row.ClientID,
+			Role:          string(row.Role),
+			IsDeleted:     row.IsDeleted,
+			IsFrozen:      row.IsFrozen,
+			EmailVerified: row.EmailVerified,
+		})
+	}
+
+	return profiles, nil
+}
+
+// UserSetFrozen is the interface through which external methods can freeze or unfreeze a user account.
+func (p *postgresImpl) UserSetFrozen(clientID uuid.UUID, isFrozen bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.userSetFrozen(ctx, &userSetFrozenParams{ClientID: clientID, IsFrozen: isFrozen})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to set user account freeze status", zap.Error(err))
+
+		return ErrNotFoundUser
+	}
+
+	return nil
+}
+
+// UserUpdatePassword is the interface through which external methods can replace the hashed password of a user account.
+func (p *postgresImpl) UserUpdatePassword(clientID uuid.UUID, hashedPassword string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.userUpdatePassword(ctx,
+		&userUpdatePasswordParams{ClientID: clientID, Password: hashedPassword})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update user account password", zap.Error(err))
+
+		return ErrNotFoundUser
+	}
+
+	return nil
+}
+
+// UserUpdateProfile is the interface through which external methods can replace the names and email address of a user
+// account. Empty values are left unchanged and a changed email address is marked as unverified.
+func (p *postgresImpl) UserUpdateProfile(clientID uuid.UUID, firstName, lastName, email string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.userUpdateProfile(ctx, &userUpdateProfileParams{
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     email,
+		ClientID:  clientID,
+	})
+	if isEmailRegisteredError(err) {
+		return ErrEmailRegistered
+	}
+
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update user account profile", zap.Error(err))
+
+		return ErrNotFoundUser
+	}
+
+	return nil
+}
+
+// UserVerifyEmail is the interface through which external methods can mark the email address of a user account as
+// verified. The email address must not have changed since it was sent the verification link.
+func (p *postgresImpl) UserVerifyEmail(clientID uuid.UUID, email string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.userVerifyEmail(ctx, &userVerifyEmailParams{ClientID: clientID, Email: email})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to verify user account email address", zap.Error(err))
+
+		return ErrNotFoundUser
+	}
+
+	return nil
+}
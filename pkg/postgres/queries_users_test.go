@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/gofrs/uuid"
@@ -140,3 +141,201 @@ func TestQueries_IsDeletedUser(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_UserGetStatus(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	_, err = connection.UserGetStatus(invalidID)
+	require.Error(t, err, "retrieved status for non-existent user.")
+
+	// Users are active, unfrozen, and not administrators by default.
+	for _, clientID := range clientIDs {
+		status, err := connection.UserGetStatus(clientID)
+		require.NoError(t, err, "failed to retrieve user status.")
+		require.Equal(t, string(UserRoleUSER), status.Role, "user has elevated role by default.")
+		require.False(t, status.IsDeleted, "user is deleted by default.")
+		require.False(t, status.IsFrozen, "user is frozen by default.")
+	}
+}
+
+func TestQueries_UserSetFrozen(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	require.Error(t, connection.UserSetFrozen(invalidID, true), "froze non-existent user.")
+
+	// Freeze and unfreeze.
+	require.NoError(t, connection.UserSetFrozen(clientIDs[0], true), "failed to freeze user.")
+	status, err := connection.UserGetStatus(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve frozen user status.")
+	require.True(t, status.IsFrozen, "user was not frozen.")
+
+	require.NoError(t, connection.UserSetFrozen(clientIDs[0], false), "failed to unfreeze user.")
+	status, err = connection.UserGetStatus(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve unfrozen user status.")
+	require.False(t, status.IsFrozen, "user was not unfrozen.")
+
+	// Deleted users cannot be frozen.
+	require.NoError(t, connection.UserDelete(clientIDs[1]), "failed to delete user.")
+	require.Error(t, connection.UserSetFrozen(clientIDs[1], true), "froze deleted user.")
+}
+
+func TestQueries_UserUpdatePassword(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	require.Error(t, connection.UserUpdatePassword(invalidID, "new hashed password"), "updated non-existent user.")
+
+	// Update password.
+	require.NoError(t, connection.UserUpdatePassword(clientIDs[0], "new hashed password"),
+		"failed to update password.")
+	userAccount, err := connection.UserGetInfo(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve user account.")
+	require.Equal(t, "new hashed password", userAccount.Password, "password was not updated.")
+
+	// Deleted users cannot have their passwords updated.
+	require.NoError(t, connection.UserDelete(clientIDs[1]), "failed to delete user.")
+	require.Error(t, connection.UserUpdatePassword(clientIDs[1], "new hashed password"),
+		"updated deleted user password.")
+}
+
+func TestQueries_UserSearch(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// No matches.
+	_, err := connection.UserSearch("no-such-user-exists", 10)
+	require.Error(t, err, "found non-existent user.")
+
+	// Partial username match.
+	profiles, err := connection.UserSearch("username", 10)
+	require.NoError(t, err, "failed to search by username.")
+	require.Len(t, profiles, len(clientIDs), "incorrect number of users found.")
+
+	// Result limit.
+	profiles, err = connection.UserSearch("username", 2)
+	require.NoError(t, err, "failed to search with result limit.")
+	require.Len(t, profiles, 2, "result limit not applied.")
+
+	// Exact client id match.
+	profiles, err = connection.UserSearch(clientIDs[0].String(), 10)
+	require.NoError(t, err, "failed to search by client id.")
+	require.Len(t, profiles, 1, "incorrect number of users found by client id.")
+	require.Equal(t, clientIDs[0], profiles[0].ClientID, "client id mismatch.")
+}
+
+func TestQueries_UserUpdateProfile(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	require.ErrorIs(t, connection.UserUpdateProfile(invalidID, "first", "last", ""), ErrNotFoundUser,
+		"updated non-existent user.")
+
+	// Verify and then update the names only.
+	original, err := connection.UserGetInfo(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve user account.")
+	require.False(t, original.EmailVerified, "new user account email address is verified.")
+	require.NoError(t, connection.UserVerifyEmail(clientIDs[0], original.Email), "failed to verify email address.")
+	require.NoError(t, connection.UserUpdateProfile(clientIDs[0], "new first name", "", ""),
+		"failed to update first name.")
+	userAccount, err := connection.UserGetInfo(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve user account.")
+	require.Equal(t, "new first name", userAccount.FirstName, "first name was not updated.")
+	require.Equal(t, original.LastName, userAccount.LastName, "last name was updated.")
+	require.Equal(t, original.Email, userAccount.Email, "email address was updated.")
+	require.True(t, userAccount.EmailVerified, "unchanged email address verification was reset.")
+
+	// Email address registered to another user account, regardless of case.
+	other, err := connection.UserGetInfo(clientIDs[1])
+	require.NoError(t, err, "failed to retrieve other user account.")
+	require.ErrorIs(t, connection.UserUpdateProfile(clientIDs[0], "", "", strings.ToUpper(other.Email)),
+		ErrEmailRegistered, "updated to an email address registered to another user account.")
+
+	// Change the email address.
+	require.NoError(t, connection.UserUpdateProfile(clientIDs[0], "", "new last name", "new-email@email-address.com"),
+		"failed to update email address.")
+	userAccount, err = connection.UserGetInfo(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve user account.")
+	require.Equal(t, "new last name", userAccount.LastName, "last name was not updated.")
+	require.Equal(t, "new-email@email-address.com", userAccount.Email, "email address was not updated.")
+	require.False(t, userAccount.EmailVerified, "changed email address is verified.")
+
+	// Deleted users cannot update their profiles.
+	require.NoError(t, connection.UserDelete(clientIDs[2]), "failed to delete user.")
+	require.Error(t, connection.UserUpdateProfile(clientIDs[2], "first", "last", ""), "updated deleted user profile.")
+}
+
+func TestQueries_UserVerifyEmail(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	userAccount, err := connection.UserGetInfo(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve user account.")
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	require.Error(t, connection.UserVerifyEmail(invalidID, userAccount.Email), "verified non-existent user.")
+
+	// Email address has changed.
+	require.Error(t, connection.UserVerifyEmail(clientIDs[0], "old-email@email-address.com"),
+		"verified a stale email address.")
+
+	// Verify, and verify again, regardless of case.
+	require.NoError(t, connection.UserVerifyEmail(clientIDs[0], strings.ToUpper(userAccount.Email)),
+		"failed to verify email address.")
+	require.NoError(t, connection.UserVerifyEmail(clientIDs[0], userAccount.Email),
+		"failed to verify email address again.")
+	userAccount, err = connection.UserGetInfo(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve user account.")
+	require.True(t, userAccount.EmailVerified, "email address was not verified.")
+
+	// Deleted users cannot verify their email addresses.
+	deleted, err := connection.UserGetInfo(clientIDs[1])
+	require.NoError(t, err, "failed to retrieve user account.")
+	require.NoError(t, connection.UserDelete(clientIDs[1]), "failed to delete user.")
+	require.Error(t, connection.UserVerifyEmail(clientIDs[1], deleted.Email), "verified deleted user email address.")
+}
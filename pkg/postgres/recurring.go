@@ -0,0 +1,585 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// RecurringPurchaseCreate controls the transaction block that the scheduling of a recurring Cryptocurrency purchase
+// plan executes in.
+func (p *postgresImpl) RecurringPurchaseCreate(plan *CryptoRecurringPurchase) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("recurring purchase scheduling transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback recurring purchase scheduling transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to recurring purchase scheduling core logic.
+	if err = recurringPurchaseSchedule(ctx, p.logger, p.queries.WithTx(tx), plan); err != nil {
+		p.logger.Warn("failed to schedule recurring purchase", zap.Error(err))
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return ErrNotFound
+		case errors.Is(err, ErrAccountStatus):
+			return ErrAccountStatus
+		default:
+			return ErrTransactCrypto
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit recurring purchase scheduling", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// recurringPurchaseSchedule will execute the logic to schedule a recurring Cryptocurrency purchase plan. No funds are
+// held for the plan; each purchase is paid for from the available balance of the Fiat account when it runs.
+/*
+   [1] Acquire row locks on the Fiat and then Crypto accounts and verify that they exist and can be transacted on.
+   [2] Create the plan with its first run at the start time.
+*/
+func recurringPurchaseSchedule(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	plan *CryptoRecurringPurchase) error {
+	var (
+		err          error
+		rowsAffected int64
+		fiatRow      fiatRowLockAccountRow
+		cryptoRow    cryptoRowLockAccountRow
+	)
+
+	if fiatRow, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: plan.ClientID,
+		Currency: plan.FiatCurrency,
+	}); err != nil {
+		return fmt.Errorf("failed to get row lock on Fiat account %w", err)
+	}
+
+	if err = accountTransactCheck(fiatRow.Status, fiatRow.IsFrozen, true); err != nil {
+		return fmt.Errorf("cannot fund purchases from Fiat account %w", err)
+	}
+
+	if cryptoRow, err = queryTx.cryptoRowLockAccount(ctx, &cryptoRowLockAccountParams{
+		ClientID: plan.ClientID,
+		Ticker:   plan.Ticker,
+	}); err != nil {
+		return fmt.Errorf("failed to get row lock on Crypto account %w", err)
+	}
+
+	if err = accountTransactCheck(cryptoRow.Status, cryptoRow.IsFrozen, false); err != nil {
+		return fmt.Errorf("cannot credit purchases to Crypto account %w", err)
+	}
+
+	if rowsAffected, err = queryTx.recurringPurchaseCreate(ctx, &recurringPurchaseCreateParams{
+		PlanID:       plan.PlanID,
+		ClientID:     plan.ClientID,
+		FiatCurrency: plan.FiatCurrency,
+		Ticker:       plan.Ticker,
+		Amount:       plan.Amount,
+		Cadence:      plan.Cadence,
+		StartsAt:     plan.StartsAt,
+		EndsAt:       plan.EndsAt,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to create recurring purchase"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// RecurringPurchaseCancel controls the transaction block that the cancellation of an active recurring Cryptocurrency
+// purchase plan executes in.
+func (p *postgresImpl) RecurringPurchaseCancel(clientID uuid.UUID, planID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("recurring purchase cancellation transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback recurring purchase cancellation transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to recurring purchase cancellation core logic.
+	if err = recurringPurchaseCancel(ctx, p.logger, p.queries.WithTx(tx), clientID, planID); err != nil {
+		p.logger.Warn("failed to cancel recurring purchase", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return ErrNotFound
+		case errors.Is(err, ErrRecurringClosed):
+			return ErrRecurringClosed
+		default:
+			return ErrTransactCrypto
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit recurring purchase cancellation", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// recurringPurchaseCancel will execute the logic to cancel an active recurring Cryptocurrency purchase plan. The
+// history of its runs is retained.
+/*
+   [1] Acquire a row lock on the plan without holding a lock on the foreign keys.
+   [2] Verify that the plan is still active.
+   [3] Close the plan as cancelled.
+*/
+func recurringPurchaseCancel(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	clientID uuid.UUID,
+	planID string) error {
+	var (
+		err          error
+		rowsAffected int64
+		plan         CryptoRecurringPurchase
+	)
+
+	if plan, err = queryTx.recurringPurchaseRowLock(ctx, &recurringPurchaseRowLockParams{
+		ClientID: clientID,
+		PlanID:   planID,
+	}); err != nil {
+		msg := "failed to get row lock on recurring purchase"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, ErrNotFound)
+	}
+
+	if plan.Status != RecurringPurchaseStatusACTIVE {
+		return fmt.Errorf("recurring purchase is %s %w", plan.Status, ErrRecurringClosed)
+	}
+
+	if rowsAffected, err = queryTx.recurringPurchaseUpdateStatus(ctx, &recurringPurchaseUpdateStatusParams{
+		ClientID: clientID,
+		PlanID:   planID,
+		Status:   RecurringPurchaseStatusCANCELLED,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to cancel recurring purchase"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// RecurringPurchaseExecute controls the transaction block that a scheduled run of a recurring Cryptocurrency purchase
+// plan executes in. The purchase, the record of the run, and the advancement of the schedule are committed together.
+func (p *postgresImpl) RecurringPurchaseExecute(
+	plan *CryptoRecurringPurchase,
+	nextRunAt pgtype.Timestamptz,
+	cryptoAmount decimal.Decimal,
+	price decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var tx pgx.Tx
+
+	txID, err := uuid.NewV4()
+	if err != nil {
+		p.logger.Error("failed to generate transaction id for recurring purchase", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("recurring purchase execution transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback recurring purchase execution transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to recurring purchase execution core logic.
+	if err = recurringPurchaseExecute(
+		ctx, p.logger, p.queries.WithTx(tx), txID, plan, nextRunAt, cryptoAmount, price); err != nil {
+		p.logger.Warn("failed to execute recurring purchase", zap.Error(err))
+
+		if errors.Is(err, ErrRecurringClosed) {
+			return nil, nil, ErrRecurringClosed
+		}
+
+		return nil, nil, cryptoTransactionErrorMapping(err)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit recurring purchase execution", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	fiatJournal, err := p.Query.fiatGetJournalTransaction(ctx, &fiatGetJournalTransactionParams{
+		ClientID: plan.ClientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Fiat transaction details post recurring purchase", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	cryptoJournal, err := p.Query.cryptoGetJournalTransaction(ctx, &cryptoGetJournalTransactionParams{
+		ClientID: plan.ClientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Crypto transaction details post recurring purchase", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	return &fiatJournal[0], &cryptoJournal[0], nil
+}
+
+// recurringPurchaseExecute will execute the logic to carry out a scheduled run of a recurring Cryptocurrency purchase
+// plan.
+/*
+   [1] Acquire a row lock on the plan and verify that it is active and the run has not already been processed.
+   [2] Execute the Cryptocurrency purchase of the plan amount at the quoted Crypto amount.
+   [3] Record the run with the transaction ID and move the plan on to its next run, completing it if there is none.
+*/
+func recurringPurchaseExecute(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	txID uuid.UUID,
+	plan *CryptoRecurringPurchase,
+	nextRunAt pgtype.Timestamptz,
+	cryptoAmount decimal.Decimal,
+	price decimal.Decimal) error {
+	lockRow, err := recurringPurchaseLockDue(ctx, logger, queryTx, plan)
+	if err != nil {
+		return err
+	}
+
+	// Execute the trade.
+	if err = cryptoPurchaseTransaction(ctx, queryTx, "", &cryptoPurchaseParams{
+		TransactionID:      txID,
+		ClientID:           lockRow.ClientID,
+		FiatCurrency:       lockRow.FiatCurrency,
+		CryptoTicker:       lockRow.Ticker,
+		FiatDebitAmount:    lockRow.Amount,
+		CryptoCreditAmount: cryptoAmount,
+	}); err != nil {
+		msg := "failed to execute recurring purchase"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return recurringPurchaseRecordRun(ctx, logger, queryTx, &lockRow, nextRunAt, &recurringPurchaseRunCreateParams{
+		Status:       RecurringPurchaseRunStatusEXECUTED,
+		Price:        price,
+		FiatAmount:   lockRow.Amount,
+		CryptoAmount: cryptoAmount,
+		TxID:         pgtype.UUID{Bytes: txID, Valid: true},
+		Details:      "purchase executed",
+	})
+}
+
+// RecurringPurchaseSkip controls the transaction block that a scheduled run of a recurring Cryptocurrency purchase plan
+// is recorded without a purchase in. This is used for runs that were missed, could not be priced within limits, or
+// failed.
+func (p *postgresImpl) RecurringPurchaseSkip(
+	plan *CryptoRecurringPurchase,
+	nextRunAt pgtype.Timestamptz,
+	status RecurringPurchaseRunStatus,
+	details string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("recurring purchase skip transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback recurring purchase skip transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to recurring purchase skip core logic.
+	if err = recurringPurchaseSkip(ctx, p.logger, p.queries.WithTx(tx), plan, nextRunAt, status, details); err != nil {
+		p.logger.Warn("failed to skip recurring purchase", zap.Error(err))
+
+		if errors.Is(err, ErrRecurringClosed) {
+			return ErrRecurringClosed
+		}
+
+		return ErrTransactCrypto
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit recurring purchase skip", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// recurringPurchaseSkip will execute the logic to record a scheduled run of a recurring Cryptocurrency purchase plan
+// without a purchase.
+/*
+   [1] Acquire a row lock on the plan and verify that it is active and the run has not already been processed.
+   [2] Record the run with its outcome and move the plan on to its next run, completing it if there is none.
+*/
+func recurringPurchaseSkip(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	plan *CryptoRecurringPurchase,
+	nextRunAt pgtype.Timestamptz,
+	status RecurringPurchaseRunStatus,
+	details string) error {
+	lockRow, err := recurringPurchaseLockDue(ctx, logger, queryTx, plan)
+	if err != nil {
+		return err
+	}
+
+	return recurringPurchaseRecordRun(ctx, logger, queryTx, &lockRow, nextRunAt, &recurringPurchaseRunCreateParams{
+		Status:       status,
+		Price:        decimal.Zero,
+		FiatAmount:   decimal.Zero,
+		CryptoAmount: decimal.Zero,
+		Details:      details,
+	})
+}
+
+// recurringPurchaseLockDue will acquire a row lock on a recurring purchase plan and verify that it is still active and
+// that the run scheduled for it when it was retrieved has not already been processed.
+func recurringPurchaseLockDue(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	plan *CryptoRecurringPurchase) (CryptoRecurringPurchase, error) {
+	lockRow, err := queryTx.recurringPurchaseRowLock(ctx, &recurringPurchaseRowLockParams{
+		ClientID: plan.ClientID,
+		PlanID:   plan.PlanID,
+	})
+	if err != nil {
+		msg := "failed to get row lock on recurring purchase"
+		logger.Warn(msg, zap.Error(err))
+
+		return lockRow, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if lockRow.Status != RecurringPurchaseStatusACTIVE {
+		return lockRow, fmt.Errorf("recurring purchase is %s %w", lockRow.Status, ErrRecurringClosed)
+	}
+
+	if !lockRow.NextRunAt.Time.Equal(plan.NextRunAt.Time) {
+		return lockRow, fmt.Errorf("recurring purchase run has already been processed %w", ErrRecurringClosed)
+	}
+
+	return lockRow, nil
+}
+
+// recurringPurchaseRecordRun will record the outcome of the scheduled run of a row locked recurring purchase plan and
+// move the plan on to its next run. Plans without a next run are completed.
+func recurringPurchaseRecordRun(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	plan *CryptoRecurringPurchase,
+	nextRunAt pgtype.Timestamptz,
+	run *recurringPurchaseRunCreateParams) error {
+	var (
+		err          error
+		rowsAffected int64
+	)
+
+	run.PlanID = plan.PlanID
+	run.ScheduledAt = plan.NextRunAt
+
+	if rowsAffected, err = queryTx.recurringPurchaseRunCreate(ctx, run); err != nil || rowsAffected != int64(1) {
+		msg := "failed to record recurring purchase run"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	if nextRunAt.Valid {
+		rowsAffected, err = queryTx.recurringPurchaseAdvance(ctx, &recurringPurchaseAdvanceParams{
+			ClientID:  plan.ClientID,
+			PlanID:    plan.PlanID,
+			NextRunAt: nextRunAt,
+		})
+	} else {
+		rowsAffected, err = queryTx.recurringPurchaseUpdateStatus(ctx, &recurringPurchaseUpdateStatusParams{
+			ClientID: plan.ClientID,
+			PlanID:   plan.PlanID,
+			Status:   RecurringPurchaseStatusCOMPLETED,
+		})
+	}
+
+	if err != nil || rowsAffected != int64(1) {
+		msg := "failed to advance recurring purchase schedule"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// RecurringPurchaseGet is the interface through which external methods can retrieve a client's recurring purchase plan.
+func (p *postgresImpl) RecurringPurchaseGet(clientID uuid.UUID, planID string) (CryptoRecurringPurchase, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	plan, err := p.Query.recurringPurchaseGet(ctx, &recurringPurchaseGetParams{ClientID: clientID, PlanID: planID})
+	if err != nil {
+		return CryptoRecurringPurchase{}, ErrNotFound
+	}
+
+	return plan, nil
+}
+
+// RecurringPurchasesPaginated is the interface through which external methods can retrieve a page of a client's
+// recurring purchase plans, newest first, starting from a plan id.
+func (p *postgresImpl) RecurringPurchasesPaginated(clientID uuid.UUID, startID string, status string, pageSize int32) (
+	[]CryptoRecurringPurchase, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	plans, err := p.Query.recurringPurchaseGetPaginated(ctx, &recurringPurchaseGetPaginatedParams{
+		ClientID: clientID,
+		Limit:    pageSize,
+		StartID:  startID,
+		Status:   status,
+	})
+	if err != nil {
+		return []CryptoRecurringPurchase{}, ErrNotFound
+	}
+
+	return plans, nil
+}
+
+// RecurringPurchaseRunsPaginated is the interface through which external methods can retrieve a page of the runs of a
+// client's recurring purchase plan, newest first, starting from a run id. A start id of zero begins with the newest run.
+func (p *postgresImpl) RecurringPurchaseRunsPaginated(clientID uuid.UUID, planID string, startID int64, pageSize int32) (
+	[]CryptoRecurringPurchaseRun, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	runs, err := p.Query.recurringPurchaseRunGetPaginated(ctx, &recurringPurchaseRunGetPaginatedParams{
+		ClientID: clientID,
+		PlanID:   planID,
+		Limit:    pageSize,
+		StartID:  startID,
+	})
+	if err != nil {
+		return []CryptoRecurringPurchaseRun{}, ErrNotFound
+	}
+
+	return runs, nil
+}
+
+// RecurringPurchasesDue is the interface through which external methods can retrieve a batch of active recurring
+// purchase plans that are due to run, after a plan id.
+func (p *postgresImpl) RecurringPurchasesDue(afterID string, batchSize int32) ([]CryptoRecurringPurchase, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	plans, err := p.Query.recurringPurchaseGetDue(ctx, &recurringPurchaseGetDueParams{Limit: batchSize, AfterID: afterID})
+	if err != nil {
+		p.logger.Error("failed to retrieve due recurring purchases", zap.Error(err))
+
+		return []CryptoRecurringPurchase{}, ErrNotFound
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurring_RecurringPurchases(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Fund the USD account.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	weekly := CryptoRecurringPurchase{
+		PlanID:       "recurring-1",
+		ClientID:     clientID1,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		Amount:       decimal.NewFromFloat(100),
+		Cadence:      RecurringPurchaseCadenceWEEKLY,
+		StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+	}
+	daily := CryptoRecurringPurchase{
+		PlanID:       "recurring-2",
+		ClientID:     clientID1,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		Amount:       decimal.NewFromFloat(5000),
+		Cadence:      RecurringPurchaseCadenceDAILY,
+		StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+		EndsAt:       pgtype.Timestamptz{Time: startsAt.Add(2 * time.Hour), Valid: true},
+	}
+
+	require.NoError(t, connection.RecurringPurchaseCreate(&weekly), "failed to schedule weekly plan.")
+	require.NoError(t, connection.RecurringPurchaseCreate(&daily), "failed to schedule daily plan.")
+
+	missing := weekly
+	missing.PlanID = "recurring-3"
+	missing.Ticker = "NOPE"
+	require.ErrorIs(t, connection.RecurringPurchaseCreate(&missing), ErrNotFound, "scheduled plan without account.")
+
+	// Both plans are due from their start time.
+	due, err := connection.RecurringPurchasesDue("", 10)
+	require.NoError(t, err, "failed to retrieve due plans.")
+	require.Len(t, due, 2, "due plan count mismatch.")
+	require.True(t, due[0].NextRunAt.Time.Equal(startsAt), "first run not at start time.")
+
+	// Execute the weekly plan.
+	nextRunAt := pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 7), Valid: true}
+	fiatReceipt, cryptoReceipt, err := connection.RecurringPurchaseExecute(&due[0], nextRunAt,
+		decimal.NewFromFloat(0.1), decimal.NewFromFloat(1000))
+	require.NoError(t, err, "failed to execute weekly plan.")
+	require.True(t, fiatReceipt.Amount.Equal(decimal.NewFromFloat(-100)), "Fiat debit mismatch.")
+	require.True(t, cryptoReceipt.Amount.Equal(decimal.NewFromFloat(0.1)), "Crypto credit mismatch.")
+
+	_, _, err = connection.RecurringPurchaseExecute(&due[0], nextRunAt, decimal.NewFromFloat(0.1),
+		decimal.NewFromFloat(1000))
+	require.ErrorIs(t, err, ErrRecurringClosed, "run executed twice.")
+
+	// The daily plan cannot be funded and has no further runs before it ends.
+	_, _, err = connection.RecurringPurchaseExecute(&due[1], pgtype.Timestamptz{}, decimal.NewFromFloat(5),
+		decimal.NewFromFloat(1000))
+	require.ErrorIs(t, err, ErrInsufficientFunds, "executed unfunded plan.")
+	require.NoError(t, connection.RecurringPurchaseSkip(&due[1], pgtype.Timestamptz{},
+		RecurringPurchaseRunStatusINSUFFICIENTFUNDS, "insufficient funds"), "failed to record unfunded run.")
+
+	due, err = connection.RecurringPurchasesDue("", 10)
+	require.NoError(t, err, "failed to retrieve due plans after runs.")
+	require.Empty(t, due, "processed plans are due.")
+
+	// Check the plans and their runs.
+	plan, err := connection.RecurringPurchaseGet(clientID1, weekly.PlanID)
+	require.NoError(t, err, "failed to retrieve weekly plan.")
+	require.Equal(t, RecurringPurchaseStatusACTIVE, plan.Status, "weekly plan status mismatch.")
+	require.True(t, plan.NextRunAt.Time.Equal(nextRunAt.Time), "weekly plan not advanced.")
+
+	plan, err = connection.RecurringPurchaseGet(clientID1, daily.PlanID)
+	require.NoError(t, err, "failed to retrieve daily plan.")
+	require.Equal(t, RecurringPurchaseStatusCOMPLETED, plan.Status, "daily plan not completed.")
+
+	_, err = connection.RecurringPurchaseGet(clientID2, weekly.PlanID)
+	require.ErrorIs(t, err, ErrNotFound, "retrieved another client's plan.")
+
+	runs, err := connection.RecurringPurchaseRunsPaginated(clientID1, weekly.PlanID, 0, 10)
+	require.NoError(t, err, "failed to retrieve weekly plan runs.")
+	require.Len(t, runs, 1, "weekly plan run count mismatch.")
+	require.Equal(t, RecurringPurchaseRunStatusEXECUTED, runs[0].Status, "weekly plan run status mismatch.")
+	require.True(t, runs[0].TxID.Valid, "executed run missing transaction id.")
+	require.True(t, runs[0].CryptoAmount.Equal(decimal.NewFromFloat(0.1)), "executed run amount mismatch.")
+
+	runs, err = connection.RecurringPurchaseRunsPaginated(clientID1, daily.PlanID, 0, 10)
+	require.NoError(t, err, "failed to retrieve daily plan runs.")
+	require.Len(t, runs, 1, "daily plan run count mismatch.")
+	require.Equal(t, RecurringPurchaseRunStatusINSUFFICIENTFUNDS, runs[0].Status, "daily plan run status mismatch.")
+
+	runs, err = connection.RecurringPurchaseRunsPaginated(clientID2, weekly.PlanID, 0, 10)
+	require.NoError(t, err, "failed to attempt retrieving another client's plan runs.")
+	require.Empty(t, runs, "retrieved another client's plan runs.")
+
+	// Cancel the weekly plan.
+	require.ErrorIs(t, connection.RecurringPurchaseCancel(clientID2, weekly.PlanID), ErrNotFound,
+		"cancelled another client's plan.")
+	require.NoError(t, connection.RecurringPurchaseCancel(clientID1, weekly.PlanID), "failed to cancel plan.")
+	require.ErrorIs(t, connection.RecurringPurchaseCancel(clientID1, weekly.PlanID), ErrRecurringClosed,
+		"cancelled plan twice.")
+
+	// Paginate and filter the client's plans.
+	plans, err := connection.RecurringPurchasesPaginated(clientID1, "", "", 10)
+	require.NoError(t, err, "failed to retrieve plans.")
+	require.Len(t, plans, 2, "plan count mismatch.")
+	require.Equal(t, daily.PlanID, plans[0].PlanID, "plans not newest first.")
+
+	plans, err = connection.RecurringPurchasesPaginated(clientID1, "", string(RecurringPurchaseStatusCANCELLED), 10)
+	require.NoError(t, err, "failed to retrieve cancelled plans.")
+	require.Len(t, plans, 1, "cancelled plan count mismatch.")
+}
+
+func TestRecurring_RecurringPurchaseExecute_Mock(t *testing.T) {
+	t.Parallel()
+
+	scheduledAt := pgtype.Timestamptz{Time: time.Unix(1700000000, 0), Valid: true}
+	nextRunAt := pgtype.Timestamptz{Time: time.Unix(1700604800, 0), Valid: true}
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		rowLockRow    CryptoRecurringPurchase
+		rowLockErr    error
+		nextRunAt     pgtype.Timestamptz
+		purchaseTimes int
+		runTimes      int
+		advanceTimes  int
+		completeTimes int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			rowLockRow:    CryptoRecurringPurchase{},
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			nextRunAt:     nextRunAt,
+			purchaseTimes: 0,
+			runTimes:      0,
+			advanceTimes:  0,
+			completeTimes: 0,
+		}, {
+			name:          "Plan cancelled.",
+			expectErr:     require.Error,
+			expectedError: ErrRecurringClosed,
+			rowLockRow:    CryptoRecurringPurchase{Status: RecurringPurchaseStatusCANCELLED, NextRunAt: scheduledAt},
+			rowLockErr:    nil,
+			nextRunAt:     nextRunAt,
+			purchaseTimes: 0,
+			runTimes:      0,
+			advanceTimes:  0,
+			completeTimes: 0,
+		}, {
+			name:          "Run already processed.",
+			expectErr:     require.Error,
+			expectedError: ErrRecurringClosed,
+			rowLockRow:    CryptoRecurringPurchase{Status: RecurringPurchaseStatusACTIVE, NextRunAt: nextRunAt},
+			rowLockErr:    nil,
+			nextRunAt:     nextRunAt,
+			purchaseTimes: 0,
+			runTimes:      0,
+			advanceTimes:  0,
+			completeTimes: 0,
+		}, {
+			name:          "Advance schedule.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			rowLockRow:    CryptoRecurringPurchase{Status: RecurringPurchaseStatusACTIVE, NextRunAt: scheduledAt},
+			rowLockErr:    nil,
+			nextRunAt:     nextRunAt,
+			purchaseTimes: 1,
+			runTimes:      1,
+			advanceTimes:  1,
+			completeTimes: 0,
+		}, {
+			name:          "Complete schedule.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			rowLockRow:    CryptoRecurringPurchase{Status: RecurringPurchaseStatusACTIVE, NextRunAt: scheduledAt},
+			rowLockErr:    nil,
+			nextRunAt:     pgtype.Timestamptz{},
+			purchaseTimes: 1,
+			runTimes:      1,
+			advanceTimes:  0,
+			completeTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			mockQuerier.EXPECT().
+				recurringPurchaseRowLock(gomock.Any(), gomock.Any()).
+				Return(test.rowLockRow, test.rowLockErr).
+				Times(1)
+
+			mockQuerier.EXPECT().
+				fiatRowLockAccount(gomock.Any(), gomock.Any()).
+				Return(fiatRowLockAccountRow{Balance: decimal.NewFromFloat(1000)}, nil).
+				Times(test.purchaseTimes)
+
+			mockQuerier.EXPECT().
+				fiatHoldTotal(gomock.Any(), gomock.Any()).
+				Return(decimal.Zero, nil).
+				Times(test.purchaseTimes)
+
+			mockQuerier.EXPECT().
+				cryptoPurchase(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(test.purchaseTimes)
+
+			mockQuerier.EXPECT().
+				recurringPurchaseRunCreate(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, run *recurringPurchaseRunCreateParams) {
+					require.Equal(t, RecurringPurchaseRunStatusEXECUTED, run.Status, "run status mismatch.")
+					require.True(t, run.ScheduledAt.Time.Equal(scheduledAt.Time), "run schedule mismatch.")
+					require.True(t, run.TxID.Valid, "run missing transaction id.")
+				}).
+				Return(int64(1), nil).
+				Times(test.runTimes)
+
+			mockQuerier.EXPECT().
+				recurringPurchaseAdvance(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(test.advanceTimes)
+
+			mockQuerier.EXPECT().
+				recurringPurchaseUpdateStatus(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(test.completeTimes)
+
+			// Check for error.
+			err := recurringPurchaseExecute(context.TODO(), zapLogger, mockQuerier, uuid.UUID{},
+				&CryptoRecurringPurchase{PlanID: "plan-id", NextRunAt: scheduledAt}, test.nextRunAt,
+				decimal.NewFromFloat(0.1), decimal.NewFromFloat(1000))
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"go.uber.org/zap"
+)
+
+// RefreshTokenCreate is the interface through which external methods can record a hashed refresh token that starts a
+// new client session.
+func (p *postgresImpl) RefreshTokenCreate(token *RefreshToken) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	if err := p.Query.refreshTokenCreate(ctx, &refreshTokenCreateParams{
+		TokenHash: token.TokenHash,
+		SessionID: token.SessionID,
+		ClientID:  token.ClientID,
+		Device:    token.Device,
+		IpAddress: token.IpAddress,
+		ExpiresAt: token.ExpiresAt,
+	}); err != nil {
+		p.logger.Error("failed to create refresh token", zap.String("clientID", token.ClientID.String()), zap.Error(err))
+
+		return ErrRefreshToken
+	}
+
+	return nil
+}
+
+// RefreshTokenRotate controls the transaction block that the exchange of an active refresh token for a new one
+// executes in. The new refresh token is populated with the session and client of the refresh token it replaces.
+func (p *postgresImpl) RefreshTokenRotate(tokenHash string, token *RefreshToken) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("refresh token rotation transaction block setup failed", zap.Error(err))
+
+		return ErrRefreshToken
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback refresh token rotation transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to refresh token rotation core logic.
+	if err = refreshTokenExchange(ctx, p.logger, p.queries.WithTx(tx), tokenHash, token); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return ErrNotFound
+		case errors.Is(err, ErrRefreshTokenInvalid):
+			return ErrRefreshTokenInvalid
+		case errors.Is(err, ErrRefreshTokenReuse):
+			// The revocation of the session must be committed even though the rotation is rejected.
+			if errCommit := tx.Commit(ctx); errCommit != nil {
+				p.logger.Warn("failed to commit revocation of reused refresh token session", zap.Error(errCommit))
+
+				return ErrRefreshToken
+			}
+
+			return ErrRefreshTokenReuse
+		default:
+			p.logger.Warn("failed to rotate refresh token", zap.Error(err))
+
+			return ErrRefreshToken
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit refresh token rotation", zap.Error(err))
+
+		return ErrRefreshToken
+	}
+
+	return nil
+}
+
+// refreshTokenExchange will execute the logic to exchange an active refresh token for a new one.
+/*
+   [1] Acquire a row lock on the refresh token being exchanged.
+   [2] Reject refresh tokens that have expired or belong to a revoked session.
+   [3] A refresh token that has already been rotated has been reused, possibly after it was stolen. Revoke its session.
+   [4] Mark the refresh token as rotated and record the new refresh token in the same session.
+*/
+func refreshTokenExchange(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	tokenHash string,
+	token *RefreshToken) error {
+	var (
+		err          error
+		current      RefreshToken
+		rowsAffected int64
+	)
+
+	if current, err = queryTx.refreshTokenRowLock(ctx, tokenHash); err != nil {
+		return fmt.Errorf("failed to get row lock on refresh token %w", err)
+	}
+
+	if current.RevokedAt.Valid || !current.ExpiresAt.Time.After(time.Now()) {
+		return ErrRefreshTokenInvalid
+	}
+
+	if current.RotatedAt.Valid {
+		logger.Warn("rotated refresh token reused, revoking session",
+			zap.String("clientID", current.ClientID.String()), zap.String("sessionID", current.SessionID))
+
+		if _, err = queryTx.refreshSessionRevoke(ctx, &refreshSessionRevokeParams{
+			SessionID: current.SessionID,
+			ClientID:  current.ClientID,
+		}); err != nil {
+			return fmt.Errorf("failed to revoke session of reused refresh token %w", err)
+		}
+
+		return ErrRefreshTokenReuse
+	}
+
+	if rowsAffected, err = queryTx.refreshTokenRotate(ctx, tokenHash); err != nil {
+		return fmt.Errorf("failed to rotate refresh token %w", err)
+	}
+
+	if rowsAffected != int64(1) {
+		return fmt.Errorf("refresh token was not rotated %w", ErrRefreshToken)
+	}
+
+	token.SessionID = current.SessionID
+	token.ClientID = current.ClientID
+	token.SessionStart = current.SessionStart
+
+	if err = queryTx.refreshTokenCreate(ctx, &refreshTokenCreateParams{
+		TokenHash:    token.TokenHash,
+		SessionID:    token.SessionID,
+		ClientID:     token.ClientID,
+		Device:       token.Device,
+		IpAddress:    token.IpAddress,
+		SessionStart: token.SessionStart,
+		ExpiresAt:    token.ExpiresAt,
+	}); err != nil {
+		msg := "failed to create rotated refresh token"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return nil
+}
+
+// RefreshSessionsClient is the interface through which external methods can retrieve the details of all the active
+// sessions of a client.
+func (p *postgresImpl) RefreshSessionsClient(clientID uuid.UUID) ([]modelsPostgres.SessionInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.refreshSessionGetClient(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to retrieve sessions", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, ErrNotFound
+	}
+
+	sessions := make([]modelsPostgres.SessionInfo, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, modelsPostgres.SessionInfo{
+			SessionID:     row.SessionID,
+			Device:        row.Device,
+			IPAddress:     row.IpAddress,
+			StartedAt:     row.SessionStart,
+			LastRefreshed: row.CreatedAt,
+			ExpiresAt:     row.ExpiresAt,
+		})
+	}
+
+	return sessions, nil
+}
+
+// RefreshSessionRevoke is the interface through which external methods can revoke a session belonging to a client.
+func (p *postgresImpl) RefreshSessionRevoke(clientID uuid.UUID, sessionID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.refreshSessionRevoke(ctx, &refreshSessionRevokeParams{
+		SessionID: sessionID,
+		ClientID:  clientID,
+	})
+	if err != nil {
+		p.logger.Error("failed to revoke session", zap.String("sessionID", sessionID), zap.Error(err))
+
+		return ErrRefreshToken
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RefreshSessionsRevokeAll is the interface through which external methods can revoke all the sessions of a client.
+func (p *postgresImpl) RefreshSessionsRevokeAll(clientID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	if err := p.Query.refreshSessionRevokeAll(ctx, clientID); err != nil {
+		p.logger.Error("failed to revoke all sessions", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return ErrRefreshToken
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshTokens_Sessions(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+	clientID1, clientID2 := clientIDs[0], clientIDs[1]
+
+	newToken := func(clientID uuid.UUID) *RefreshToken {
+		return &RefreshToken{
+			TokenHash: xid.New().String(),
+			SessionID: xid.New().String(),
+			ClientID:  clientID,
+			Device:    "test-device",
+			IpAddress: "127.0.0.1",
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		}
+	}
+
+	// Start sessions for both clients.
+	session1 := newToken(clientID1)
+	require.NoError(t, connection.RefreshTokenCreate(session1), "failed to start first session.")
+	session2 := newToken(clientID1)
+	require.NoError(t, connection.RefreshTokenCreate(session2), "failed to start second session.")
+	require.NoError(t, connection.RefreshTokenCreate(newToken(clientID2)), "failed to start other client's session.")
+
+	sessions, err := connection.RefreshSessionsClient(clientID1)
+	require.NoError(t, err, "failed to retrieve sessions.")
+	require.Len(t, sessions, 2, "sessions count mismatch.")
+
+	// Unknown refresh tokens cannot be rotated.
+	require.ErrorIs(t, connection.RefreshTokenRotate("unknown", newToken(uuid.UUID{})), ErrNotFound,
+		"rotated unknown refresh token.")
+
+	// Rotation keeps the session and replaces its refresh token.
+	rotated := newToken(uuid.UUID{})
+	rotated.Device = "new-device"
+	require.NoError(t, connection.RefreshTokenRotate(session1.TokenHash, rotated), "failed to rotate refresh token.")
+	require.Equal(t, session1.SessionID, rotated.SessionID, "rotated refresh token session mismatch.")
+	require.Equal(t, clientID1, rotated.ClientID, "rotated refresh token client mismatch.")
+
+	sessions, err = connection.RefreshSessionsClient(clientID1)
+	require.NoError(t, err, "failed to retrieve sessions after rotation.")
+	require.Len(t, sessions, 2, "sessions count mismatch after rotation.")
+	require.Equal(t, session1.SessionID, sessions[0].SessionID, "sessions not most recently refreshed first.")
+	require.Equal(t, "new-device", sessions[0].Device, "session device not updated.")
+
+	// Reuse of the rotated refresh token revokes the session, including its latest refresh token.
+	require.ErrorIs(t, connection.RefreshTokenRotate(session1.TokenHash, newToken(uuid.UUID{})), ErrRefreshTokenReuse,
+		"reused refresh token rotated.")
+	require.ErrorIs(t, connection.RefreshTokenRotate(rotated.TokenHash, newToken(uuid.UUID{})), ErrRefreshTokenInvalid,
+		"refresh token in revoked session rotated.")
+
+	sessions, err = connection.RefreshSessionsClient(clientID1)
+	require.NoError(t, err, "failed to retrieve sessions after reuse.")
+	require.Len(t, sessions, 1, "sessions count mismatch after reuse.")
+
+	// Sessions can only be revoked by their owners.
+	require.ErrorIs(t, connection.RefreshSessionRevoke(clientID2, session2.SessionID), ErrNotFound,
+		"revoked another client's session.")
+	require.NoError(t, connection.RefreshSessionRevoke(clientID1, session2.SessionID), "failed to revoke session.")
+	require.ErrorIs(t, connection.RefreshSessionRevoke(clientID1, session2.SessionID), ErrNotFound,
+		"revoked session twice.")
+
+	// Revoke all the sessions of a client.
+	require.NoError(t, connection.RefreshSessionsRevokeAll(clientID2), "failed to revoke all sessions.")
+
+	sessions, err = connection.RefreshSessionsClient(clientID2)
+	require.NoError(t, err, "failed to retrieve sessions after revoking all.")
+	require.Empty(t, sessions, "sessions remain after revoking all.")
+}
+
+func TestRefreshTokens_RefreshTokenExchange_Mock(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	active := RefreshToken{
+		SessionID:    "session-id",
+		ClientID:     uuid.Must(uuid.NewV4()),
+		SessionStart: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+		ExpiresAt:    pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true},
+	}
+
+	expired := active
+	expired.ExpiresAt = pgtype.Timestamptz{Time: now.Add(-time.Minute), Valid: true}
+
+	revoked := active
+	revoked.RevokedAt = pgtype.Timestamptz{Time: now, Valid: true}
+
+	rotated := active
+	rotated.RotatedAt = pgtype.Timestamptz{Time: now, Valid: true}
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		rowLockRow    RefreshToken
+		rowLockErr    error
+		revokeTimes   int
+		rotateRows    int64
+		rotateTimes   int
+		createErr     error
+		createTimes   int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: errors.New("row lock failure"),
+			rowLockErr:    errors.New("row lock failure"),
+		}, {
+			name:          "Expired.",
+			expectErr:     require.Error,
+			expectedError: ErrRefreshTokenInvalid,
+			rowLockRow:    expired,
+		}, {
+			name:          "Revoked.",
+			expectErr:     require.Error,
+			expectedError: ErrRefreshTokenInvalid,
+			rowLockRow:    revoked,
+		}, {
+			name:          "Reused.",
+			expectErr:     require.Error,
+			expectedError: ErrRefreshTokenReuse,
+			rowLockRow:    rotated,
+			revokeTimes:   1,
+		}, {
+			name:          "Not rotated.",
+			expectErr:     require.Error,
+			expectedError: ErrRefreshToken,
+			rowLockRow:    active,
+			rotateRows:    0,
+			rotateTimes:   1,
+		}, {
+			name:          "Create failure.",
+			expectErr:     require.Error,
+			expectedError: errors.New("create failure"),
+			rowLockRow:    active,
+			rotateRows:    1,
+			rotateTimes:   1,
+			createErr:     errors.New("create failure"),
+			createTimes:   1,
+		}, {
+			name:        "Rotated.",
+			expectErr:   require.NoError,
+			rowLockRow:  active,
+			rotateRows:  1,
+			rotateTimes: 1,
+			createTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					refreshTokenRowLock(gomock.Any(), "token-hash").
+					Return(test.rowLockRow, test.rowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					refreshSessionRevoke(gomock.Any(), &refreshSessionRevokeParams{
+						SessionID: active.SessionID,
+						ClientID:  active.ClientID,
+					}).
+					Return(int64(2), nil).
+					Times(test.revokeTimes),
+
+				mockQuerier.EXPECT().
+					refreshTokenRotate(gomock.Any(), "token-hash").
+					Return(test.rotateRows, nil).
+					Times(test.rotateTimes),
+
+				mockQuerier.EXPECT().
+					refreshTokenCreate(gomock.Any(), gomock.Any()).
+					Do(func(_ context.Context, params *refreshTokenCreateParams) {
+						require.Equal(t, "new-token-hash", params.TokenHash, "new refresh token hash mismatch.")
+						require.Equal(t, active.SessionID, params.SessionID, "session mismatch.")
+						require.Equal(t, active.ClientID, params.ClientID, "client mismatch.")
+						require.Equal(t, active.SessionStart, params.SessionStart, "session start mismatch.")
+					}).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			token := &RefreshToken{TokenHash: "new-token-hash"}
+			err := refreshTokenExchange(context.TODO(), zapLogger, mockQuerier, "token-hash", token)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorContains(t, err, test.expectedError.Error(), "error mismatch.")
+
+				return
+			}
+
+			require.Equal(t, active.SessionID, token.SessionID, "rotated refresh token session mismatch.")
+			require.Equal(t, active.ClientID, token.ClientID, "rotated refresh token client mismatch.")
+		})
+	}
+}
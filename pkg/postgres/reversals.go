@@ -0,0 +1,411 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// TransactionReversal is a compensating reversal of a transaction along with the equal and opposite journal entries
+// that were posted for it.
+type TransactionReversal struct {
+	JournalReversal
+	FiatEntries   []FiatJournal   `json:"fiatEntries"`
+	CryptoEntries []CryptoJournal `json:"cryptoEntries"`
+}
+
+// cryptoReversalAccount is a Crypto account with a balance that is adjusted by a reversal.
+type cryptoReversalAccount struct {
+	ClientID uuid.UUID
+	Ticker   string
+	Amount   decimal.Decimal
+}
+
+// reversalAccountCheck will verify that an account can have its entries reversed. Reversals are corrections made by an
+// administrator and are permitted on frozen accounts and for suspended clients, but not on closed accounts.
+func reversalAccountCheck(status AccountStatus) error {
+	if status == AccountStatusCLOSED {
+		return fmt.Errorf("account is %s %w", status, ErrAccountStatus)
+	}
+
+	return nil
+}
+
+// fiatReversalAccounts will net the reversal amounts of the Fiat journal entries for each client account with a balance.
+// The accounts are ordered using the total order rule that row locks on Fiat accounts are acquired in.
+func fiatReversalAccounts(entries []fiatJournalReversalEntriesRow) []*FiatTransactionDetails {
+	accounts := make([]*FiatTransactionDetails, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.HasBalance {
+			continue
+		}
+
+		if account := fiatReversalAccount(accounts, entry.ClientID, entry.Currency); account != nil {
+			account.Amount = account.Amount.Sub(entry.Amount)
+
+			continue
+		}
+
+		accounts = append(accounts,
+			&FiatTransactionDetails{ClientID: entry.ClientID, Currency: entry.Currency, Amount: entry.Amount.Neg()})
+	}
+
+	sort.Slice(accounts, func(lhs, rhs int) bool {
+		first, _ := accounts[lhs].Less(accounts[rhs])
+
+		return *first == accounts[lhs]
+	})
+
+	return accounts
+}
+
+// fiatReversalAccount will find a Fiat account in a set of accounts with balances. Returns nil if it is not found.
+func fiatReversalAccount(accounts []*FiatTransactionDetails, clientID uuid.UUID, currency Currency) *FiatTransactionDetails {
+	for _, account := range accounts {
+		if account.ClientID == clientID && account.Currency == currency {
+			return account
+		}
+	}
+
+	return nil
+}
+
+// cryptoReversalAccounts will net the reversal amounts of the Crypto journal entries for each client account with a
+// balance. The accounts are ordered on the Client ID and then the ticker, like the Fiat accounts.
+func cryptoReversalAccounts(entries []cryptoJournalReversalEntriesRow) []*cryptoReversalAccount {
+	accounts := make([]*cryptoReversalAccount, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.HasBalance {
+			continue
+		}
+
+		if account := cryptoReversalAccountFind(accounts, entry.ClientID, entry.Ticker); account != nil {
+			account.Amount = account.Amount.Sub(entry.Amount)
+
+			continue
+		}
+
+		accounts = append(accounts,
+			&cryptoReversalAccount{ClientID: entry.ClientID, Ticker: entry.Ticker, Amount: entry.Amount.Neg()})
+	}
+
+	sort.Slice(accounts, func(lhs, rhs int) bool {
+		compare := bytes.Compare(accounts[lhs].ClientID.Bytes(), accounts[rhs].ClientID.Bytes())
+
+		return compare < 0 || (compare == 0 && accounts[lhs].Ticker < accounts[rhs].Ticker)
+	})
+
+	return accounts
+}
+
+// cryptoReversalAccountFind will find a Crypto account in a set of accounts with balances. Returns nil if it is not
+// found.
+func cryptoReversalAccountFind(accounts []*cryptoReversalAccount, clientID uuid.UUID, ticker string) *cryptoReversalAccount {
+	for _, account := range accounts {
+		if account.ClientID == clientID && account.Ticker == ticker {
+			return account
+		}
+	}
+
+	return nil
+}
+
+// TransactionReverse controls the transaction block that the compensating reversal of a Fiat or Crypto transaction
+// executes in.
+func (p *postgresImpl) TransactionReverse(txID, adminID uuid.UUID, reason string) (*TransactionReversal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err          error
+		tx           pgx.Tx
+		reversal     *TransactionReversal
+		reversalTxID uuid.UUID
+	)
+
+	if reversalTxID, err = uuid.NewV4(); err != nil {
+		p.logger.Error("failed to generate transaction id for reversal", zap.Error(err))
+
+		return nil, ErrReverseTransaction
+	}
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("transaction reversal block setup failed", zap.Error(err))
+
+		return nil, ErrReverseTransaction
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback transaction reversal", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to transaction reversal core logic.
+	if reversal, err = transactionReverse(ctx, p.logger, p.queries.WithTx(tx), &journalReversalCreateParams{
+		TxID:         txID,
+		ReversalTxID: reversalTxID,
+		AdminID:      adminID,
+		Reason:       reason,
+	}); err != nil {
+		p.logger.Warn("failed to complete transaction reversal", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, ErrReversed):
+			return nil, ErrReversed
+		case errors.Is(err, ErrAccountStatus):
+			return nil, ErrAccountStatus
+		case errors.Is(err, ErrInsufficientFunds):
+			return nil, ErrInsufficientFunds
+		default:
+			return nil, ErrReverseTransaction
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit transaction reversal", zap.Error(err))
+
+		return nil, ErrReverseTransaction
+	}
+
+	return reversal, nil
+}
+
+// transactionReverse will execute the logic to post a compensating reversal of a transaction.
+/*
+   [1] Verify that the transaction is not itself a reversal and record the reversal. Concurrent reversals of the same
+       transaction will wait on the record and then find that the transaction has already been reversed.
+   [2] Retrieve the Fiat and Crypto journal entries of the transaction along with whether each entry is for a client
+       account with a balance. The FTeX operations accounts do not have balances.
+   [3] Acquire row locks on the Fiat and then Crypto accounts with balances in a total order without holding locks on
+       the foreign keys for the Client IDs.
+   [4] Verify that none of the accounts are closed, and that the available balances of the accounts being debited are
+       sufficient. Reversals will not overdraw an account.
+   [5] Post the equal and opposite journal entries linked to the transaction by the reversal record.
+   [6] Update the balances of the accounts for each of the entries posted.
+*/
+func transactionReverse(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	params *journalReversalCreateParams) (*TransactionReversal, error) {
+	var (
+		err            error
+		rowsAffected   int64
+		existing       JournalReversal
+		fiatEntries    []fiatJournalReversalEntriesRow
+		cryptoEntries  []cryptoJournalReversalEntriesRow
+		fiatReversed   []FiatJournal
+		cryptoReversed []CryptoJournal
+	)
+
+	// Reversals cannot be reversed, and transactions can only be reversed once.
+	if existing, err = queryTx.journalReversalGet(ctx, params.TxID); err == nil {
+		return nil, fmt.Errorf("transaction is reversed by or reverses %s %w", existing.ReversalTxID, ErrReversed)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to check for an existing reversal %w", err)
+	}
+
+	if rowsAffected, err = queryTx.journalReversalCreate(ctx, params); err != nil {
+		return nil, fmt.Errorf("failed to record reversal %w", err)
+	} else if rowsAffected != int64(1) {
+		return nil, fmt.Errorf("transaction has already been reversed %w", ErrReversed)
+	}
+
+	// Retrieve the entries to reverse.
+	if fiatEntries, err = queryTx.fiatJournalReversalEntries(ctx, params.TxID); err != nil {
+		return nil, fmt.Errorf("failed to retrieve Fiat journal entries %w", err)
+	}
+
+	if cryptoEntries, err = queryTx.cryptoJournalReversalEntries(ctx, params.TxID); err != nil {
+		return nil, fmt.Errorf("failed to retrieve Crypto journal entries %w", err)
+	}
+
+	if len(fiatEntries) == 0 && len(cryptoEntries) == 0 {
+		return nil, fmt.Errorf("transaction not found %w", ErrNotFound)
+	}
+
+	// Row lock the accounts in order and check their balances.
+	fiatAccounts := fiatReversalAccounts(fiatEntries)
+	cryptoAccounts := cryptoReversalAccounts(cryptoEntries)
+
+	if err = fiatReversalRowLockAndBalanceCheck(ctx, queryTx, fiatAccounts); err != nil {
+		msg := "failed to get row locks on Fiat accounts and verify balances for reversal"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if err = cryptoReversalRowLockAndBalanceCheck(ctx, queryTx, cryptoAccounts); err != nil {
+		msg := "failed to get row locks on Crypto accounts and verify balances for reversal"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Make the equal and opposite General Journal ledger entries.
+	reverseParams := &fiatJournalReverseParams{ReversalTxID: params.ReversalTxID, TxID: params.TxID}
+	if fiatReversed, err = queryTx.fiatJournalReverse(ctx, reverseParams); err != nil {
+		return nil, fmt.Errorf("failed to post Fiat journal reversal entries %w", err)
+	}
+
+	if cryptoReversed, err = queryTx.cryptoJournalReverse(ctx, &cryptoJournalReverseParams{
+		ReversalTxID: params.ReversalTxID,
+		TxID:         params.TxID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to post Crypto journal reversal entries %w", err)
+	}
+
+	// Update the account balances for each entry.
+	for _, entry := range fiatReversed {
+		if fiatReversalAccount(fiatAccounts, entry.ClientID, entry.Currency) == nil {
+			continue
+		}
+
+		if _, err = queryTx.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+			ClientID: entry.ClientID,
+			Currency: entry.Currency,
+			Amount:   entry.Amount,
+			LastTxTs: entry.TransactedAt,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update Fiat account balance for reversal %w", err)
+		}
+	}
+
+	for _, entry := range cryptoReversed {
+		if cryptoReversalAccountFind(cryptoAccounts, entry.ClientID, entry.Ticker) == nil {
+			continue
+		}
+
+		if _, err = queryTx.cryptoUpdateAccountBalance(ctx, &cryptoUpdateAccountBalanceParams{
+			ClientID: entry.ClientID,
+			Ticker:   entry.Ticker,
+			Amount:   entry.Amount,
+			LastTxTs: entry.TransactedAt,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update Crypto account balance for reversal %w", err)
+		}
+	}
+
+	reversal := &TransactionReversal{
+		JournalReversal: JournalReversal{
+			TxID:         params.TxID,
+			ReversalTxID: params.ReversalTxID,
+			AdminID:      params.AdminID,
+			Reason:       params.Reason,
+		},
+		FiatEntries:   fiatReversed,
+		CryptoEntries: cryptoReversed,
+	}
+
+	// The reversal is recorded and its entries are posted at the same transaction timestamp.
+	if len(fiatReversed) > 0 {
+		reversal.ReversedAt = fiatReversed[0].TransactedAt
+	} else if len(cryptoReversed) > 0 {
+		reversal.ReversedAt = cryptoReversed[0].TransactedAt
+	}
+
+	return reversal, nil
+}
+
+// fiatReversalRowLockAndBalanceCheck will acquire row locks on the Fiat accounts in order. It will then check that none
+// of the accounts are closed, and that the available balances of the accounts being debited are sufficient.
+func fiatReversalRowLockAndBalanceCheck(ctx context.Context, queryTx Querier, accounts []*FiatTransactionDetails) error {
+	for _, account := range accounts {
+		row, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+			ClientID: account.ClientID,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get row lock on Fiat account %w", err)
+		}
+
+		if err = reversalAccountCheck(row.Status); err != nil {
+			return fmt.Errorf("Fiat account cannot be reversed %w", err)
+		}
+
+		if !account.Amount.IsNegative() {
+			continue
+		}
+
+		if err = fiatAvailableBalanceCheck(ctx, queryTx, &FiatTransactionDetails{
+			ClientID: account.ClientID,
+			Currency: account.Currency,
+			Amount:   account.Amount.Neg(),
+		}, row.Balance); err != nil {
+			return fmt.Errorf("insufficient balance in Fiat account %w", err)
+		}
+	}
+
+	return nil
+}
+
+// cryptoReversalRowLockAndBalanceCheck will acquire row locks on the Crypto accounts in order. It will then check that
+// none of the accounts are closed, and that the available balances of the accounts being debited are sufficient.
+func cryptoReversalRowLockAndBalanceCheck(ctx context.Context, queryTx Querier, accounts []*cryptoReversalAccount) error {
+	for _, account := range accounts {
+		row, err := queryTx.cryptoRowLockAccount(ctx, &cryptoRowLockAccountParams{
+			ClientID: account.ClientID,
+			Ticker:   account.Ticker,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get row lock on Crypto account %w", err)
+		}
+
+		if err = reversalAccountCheck(row.Status); err != nil {
+			return fmt.Errorf("Crypto account cannot be reversed %w", err)
+		}
+
+		if !account.Amount.IsNegative() {
+			continue
+		}
+
+		if err = cryptoAvailableBalanceCheck(ctx, queryTx, "", account.ClientID, account.Ticker, row.Balance,
+			account.Amount.Neg()); err != nil {
+			return fmt.Errorf("insufficient balance in Crypto account %w", err)
+		}
+	}
+
+	return nil
+}
+
+// JournalReversalGet is the interface through which external methods can retrieve the reversal of a transaction, or
+// the reversal that a transaction records.
+func (p *postgresImpl) JournalReversalGet(txID uuid.UUID) (*JournalReversal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	reversal, err := p.Query.journalReversalGet(ctx, txID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			p.logger.Error("failed to retrieve transaction reversal", zap.Error(err))
+
+			return nil, ErrReverseTransaction
+		}
+
+		return nil, ErrNotFound
+	}
+
+	return &reversal, nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReversals_ReversalAccounts(t *testing.T) {
+	t.Parallel()
+
+	clientIDs := []uuid.UUID{uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())}
+	if bytes.Compare(clientIDs[0].Bytes(), clientIDs[1].Bytes()) > 0 {
+		clientIDs[0], clientIDs[1] = clientIDs[1], clientIDs[0]
+	}
+
+	operationsID := uuid.Must(uuid.NewV4())
+
+	t.Run("fiat", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := fiatReversalAccounts([]fiatJournalReversalEntriesRow{
+			{ClientID: clientIDs[1], Currency: "USD", Amount: decimal.NewFromFloat(-100), HasBalance: true},
+			{ClientID: operationsID, Currency: "USD", Amount: decimal.NewFromFloat(100), HasBalance: false},
+			{ClientID: clientIDs[0], Currency: "USD", Amount: decimal.NewFromFloat(60), HasBalance: true},
+			{ClientID: clientIDs[0], Currency: "CAD", Amount: decimal.NewFromFloat(-20), HasBalance: true},
+			{ClientID: clientIDs[0], Currency: "USD", Amount: decimal.NewFromFloat(40), HasBalance: true},
+		})
+
+		require.Len(t, accounts, 3, "operations account not excluded or entries not netted.")
+		require.Equal(t, FiatTransactionDetails{ClientID: clientIDs[0], Currency: "CAD", Amount: decimal.NewFromFloat(20)},
+			*accounts[0], "first account mismatch.")
+		require.Equal(t, clientIDs[0], accounts[1].ClientID, "second account client id mismatch.")
+		require.Equal(t, Currency("USD"), accounts[1].Currency, "second account currency mismatch.")
+		require.True(t, accounts[1].Amount.Equal(decimal.NewFromFloat(-100)), "second account not netted.")
+		require.Equal(t, clientIDs[1], accounts[2].ClientID, "third account client id mismatch.")
+		require.True(t, accounts[2].Amount.Equal(decimal.NewFromFloat(100)), "third account amount mismatch.")
+	})
+
+	t.Run("crypto", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := cryptoReversalAccounts([]cryptoJournalReversalEntriesRow{
+			{ClientID: clientIDs[1], Ticker: "BTC", Amount: decimal.NewFromFloat(1.5), HasBalance: true},
+			{ClientID: operationsID, Ticker: "BTC", Amount: decimal.NewFromFloat(-1.5), HasBalance: false},
+			{ClientID: clientIDs[0], Ticker: "ETH", Amount: decimal.NewFromFloat(2), HasBalance: true},
+			{ClientID: clientIDs[0], Ticker: "BTC", Amount: decimal.NewFromFloat(-0.5), HasBalance: true},
+			{ClientID: clientIDs[0], Ticker: "ETH", Amount: decimal.NewFromFloat(-2), HasBalance: true},
+		})
+
+		require.Len(t, accounts, 3, "operations account not excluded or entries not netted.")
+		require.Equal(t, "BTC", accounts[0].Ticker, "first account ticker mismatch.")
+		require.True(t, accounts[0].Amount.Equal(decimal.NewFromFloat(0.5)), "first account amount mismatch.")
+		require.Equal(t, "ETH", accounts[1].Ticker, "second account ticker mismatch.")
+		require.True(t, accounts[1].Amount.IsZero(), "second account not netted.")
+		require.Equal(t, clientIDs[1], accounts[2].ClientID, "third account client id mismatch.")
+		require.True(t, accounts[2].Amount.Equal(decimal.NewFromFloat(-1.5)), "third account amount mismatch.")
+	})
+}
+
+func TestReversals_TransactionReverse(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+	adminID := clientIDs[0]
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Deposit and then exchange some of the deposit.
+	deposit, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	exchange, _, err := connection.FiatInternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(100)},
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "CAD", Amount: decimal.NewFromFloat(130)})
+	require.NoError(t, err, "failed to exchange USD to CAD.")
+
+	entries, err := connection.Query.fiatJournalReversalEntries(ctx, exchange.TxID)
+	require.NoError(t, err, "failed to retrieve exchange journal entries.")
+
+	// Reverse the exchange.
+	reversal, err := connection.TransactionReverse(exchange.TxID, adminID, "exchange made in error")
+	require.NoError(t, err, "failed to reverse exchange.")
+	require.Equal(t, exchange.TxID, reversal.TxID, "reversed transaction id mismatch.")
+	require.NotEqual(t, exchange.TxID, reversal.ReversalTxID, "reversal transaction id not generated.")
+	require.Len(t, reversal.FiatEntries, len(entries), "reversal entries count mismatch.")
+	require.Empty(t, reversal.CryptoEntries, "Crypto reversal entries posted.")
+	require.True(t, reversal.ReversedAt.Valid, "reversal timestamp not set.")
+
+	for idx, entry := range reversal.FiatEntries {
+		require.Equal(t, reversal.ReversalTxID, entry.TxID, "reversal entry transaction id mismatch.")
+		require.True(t, entry.Amount.Equal(entries[idx].Amount.Neg()), "reversal entry is not equal and opposite.")
+	}
+
+	usd, err := connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve USD account.")
+	require.True(t, usd.Balance.Equal(decimal.NewFromFloat(1000)), "USD balance not restored.")
+
+	cad, err := connection.FiatBalance(clientID1, "CAD")
+	require.NoError(t, err, "failed to retrieve CAD account.")
+	require.True(t, cad.Balance.IsZero(), "CAD balance not restored.")
+
+	// The reversal is linked to the exchange in both directions.
+	linked, err := connection.JournalReversalGet(reversal.ReversalTxID)
+	require.NoError(t, err, "failed to retrieve reversal by reversal transaction id.")
+	require.Equal(t, exchange.TxID, linked.TxID, "linked transaction id mismatch.")
+	require.Equal(t, "exchange made in error", linked.Reason, "linked reason mismatch.")
+
+	// Transactions can only be reversed once, and reversals cannot be reversed.
+	_, err = connection.TransactionReverse(exchange.TxID, adminID, "exchange made in error")
+	require.ErrorIs(t, err, ErrReversed, "reversed transaction twice.")
+
+	_, err = connection.TransactionReverse(reversal.ReversalTxID, adminID, "reversal made in error")
+	require.ErrorIs(t, err, ErrReversed, "reversed a reversal.")
+
+	// Reversals cannot overdraw an account.
+	_, _, err = connection.FiatInternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(950)},
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "CAD", Amount: decimal.NewFromFloat(1235)})
+	require.NoError(t, err, "failed to exchange USD to CAD.")
+
+	_, err = connection.TransactionReverse(deposit.TxID, adminID, "deposit made in error")
+	require.ErrorIs(t, err, ErrInsufficientFunds, "reversal overdrew account.")
+
+	_, err = connection.JournalReversalGet(deposit.TxID)
+	require.ErrorIs(t, err, ErrNotFound, "refused reversal was recorded.")
+
+	// Unknown transactions cannot be reversed.
+	_, err = connection.TransactionReverse(uuid.Must(uuid.NewV4()), adminID, "unknown transaction")
+	require.ErrorIs(t, err, ErrNotFound, "reversed unknown transaction.")
+}
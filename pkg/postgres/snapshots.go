@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// BalanceAsOf is the balance of a Fiat or Crypto account at a point in time. The snapshot time is that of the daily
+// closing balance the journal entries were added to, and is not set if there was no snapshot before the point in time.
+type BalanceAsOf struct {
+	Currency   string             `json:"currency"`
+	Balance    decimal.Decimal    `json:"balance"`
+	AsOf       pgtype.Timestamptz `json:"asOf"`
+	SnapshotAt pgtype.Timestamptz `json:"snapshotAt"`
+}
+
+// FiatBalanceAsOf is the interface through which external methods can retrieve the balance of a Fiat account at a
+// point in time.
+func (p *postgresImpl) FiatBalanceAsOf(clientID uuid.UUID, currency Currency, asOf time.Time) (BalanceAsOf, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	timestamp := pgtype.Timestamptz{Time: asOf, Valid: true}
+
+	balance, err := p.Query.fiatBalanceAsOf(ctx, &fiatBalanceAsOfParams{
+		ClientID: clientID,
+		Currency: currency,
+		AsOf:     timestamp,
+	})
+	if err != nil {
+		return BalanceAsOf{}, ErrNotFound
+	}
+
+	return BalanceAsOf{
+		Currency:   string(balance.Currency),
+		Balance:    balance.Balance,
+		AsOf:       timestamp,
+		SnapshotAt: balance.SnapshotAt,
+	}, nil
+}
+
+// CryptoBalanceAsOf is the interface through which external methods can retrieve the balance of a Crypto account at a
+// point in time.
+func (p *postgresImpl) CryptoBalanceAsOf(clientID uuid.UUID, ticker string, asOf time.Time) (BalanceAsOf, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	timestamp := pgtype.Timestamptz{Time: asOf, Valid: true}
+
+	balance, err := p.Query.cryptoBalanceAsOf(ctx, &cryptoBalanceAsOfParams{
+		ClientID: clientID,
+		Ticker:   ticker,
+		AsOf:     timestamp,
+	})
+	if err != nil {
+		return BalanceAsOf{}, ErrNotFound
+	}
+
+	return BalanceAsOf{
+		Currency:   balance.Ticker,
+		Balance:    balance.Balance,
+		AsOf:       timestamp,
+		SnapshotAt: balance.SnapshotAt,
+	}, nil
+}
+
+// FiatBalanceHistory is the interface through which external methods can retrieve the daily closing balances of a
+// Fiat account within a time range, oldest first.
+func (p *postgresImpl) FiatBalanceHistory(clientID uuid.UUID, currency Currency, from, to time.Time) (
+	[]FiatBalanceSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	snapshots, err := p.Query.fiatBalanceHistory(ctx, &fiatBalanceHistoryParams{
+		ClientID: clientID,
+		Currency: currency,
+		FromAt:   pgtype.Timestamptz{Time: from, Valid: true},
+		ToAt:     pgtype.Timestamptz{Time: to, Valid: true},
+	})
+	if err != nil {
+		return []FiatBalanceSnapshot{}, ErrNotFound
+	}
+
+	return snapshots, nil
+}
+
+// CryptoBalanceHistory is the interface through which external methods can retrieve the daily closing balances of a
+// Crypto account within a time range, oldest first.
+func (p *postgresImpl) CryptoBalanceHistory(clientID uuid.UUID, ticker string, from, to time.Time) (
+	[]CryptoBalanceSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	snapshots, err := p.Query.cryptoBalanceHistory(ctx, &cryptoBalanceHistoryParams{
+		ClientID: clientID,
+		Ticker:   ticker,
+		FromAt:   pgtype.Timestamptz{Time: from, Valid: true},
+		ToAt:     pgtype.Timestamptz{Time: to, Valid: true},
+	})
+	if err != nil {
+		return []CryptoBalanceSnapshot{}, ErrNotFound
+	}
+
+	return snapshots, nil
+}
+
+// BalanceSnapshotLatest is the interface through which external methods can retrieve the time of the most recent daily
+// closing balance snapshot. The returned time is not set if no snapshots have been recorded.
+func (p *postgresImpl) BalanceSnapshotLatest() (pgtype.Timestamptz, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	latest, err := p.Query.balanceSnapshotLatest(ctx)
+	if err != nil {
+		p.logger.Error("failed to retrieve latest balance snapshot", zap.Error(err))
+
+		return pgtype.Timestamptz{}, ErrNotFound
+	}
+
+	return latest, nil
+}
+
+// BalanceSnapshotCreate controls the transaction block that the daily closing balance snapshots of all Fiat and Crypto
+// accounts are recorded in. Snapshots that have already been recorded for the time are left unchanged.
+func (p *postgresImpl) BalanceSnapshotCreate(snapshotAt time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.SnapshotTimeout())
+
+	defer cancel()
+
+	var (
+		err         error
+		tx          pgx.Tx
+		fiatCount   int64
+		cryptoCount int64
+		timestamp   = pgtype.Timestamptz{Time: snapshotAt, Valid: true}
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("balance snapshot transaction block setup failed", zap.Error(err))
+
+		return 0, ErrBalanceSnapshot
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback balance snapshot transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	queryTx := p.queries.WithTx(tx)
+
+	if fiatCount, err = queryTx.fiatBalanceSnapshotCreate(ctx, timestamp); err != nil {
+		p.logger.Warn("failed to record Fiat balance snapshots", zap.Error(err))
+
+		return 0, ErrBalanceSnapshot
+	}
+
+	if cryptoCount, err = queryTx.cryptoBalanceSnapshotCreate(ctx, timestamp); err != nil {
+		p.logger.Warn("failed to record Crypto balance snapshots", zap.Error(err))
+
+		return 0, ErrBalanceSnapshot
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit balance snapshots", zap.Error(err))
+
+		return 0, ErrBalanceSnapshot
+	}
+
+	return fiatCount + cryptoCount, nil
+}
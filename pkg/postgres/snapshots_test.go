@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshots_BalanceSnapshots(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	beforeDeposit := time.Now().Add(-time.Second)
+
+	// Fund the USD account.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	// No snapshots have been recorded.
+	latest, err := connection.BalanceSnapshotLatest()
+	require.NoError(t, err, "failed to retrieve latest snapshot without snapshots.")
+	require.False(t, latest.Valid, "latest snapshot set without snapshots.")
+
+	// Balances are summed from the journal without snapshots.
+	balance, err := connection.FiatBalanceAsOf(clientID1, "USD", time.Now())
+	require.NoError(t, err, "failed to retrieve balance without snapshots.")
+	require.True(t, balance.Balance.Equal(decimal.NewFromFloat(1000)), "balance without snapshots mismatch.")
+	require.False(t, balance.SnapshotAt.Valid, "snapshot set without snapshots.")
+
+	balance, err = connection.FiatBalanceAsOf(clientID1, "USD", beforeDeposit)
+	require.NoError(t, err, "failed to retrieve balance before deposit.")
+	require.True(t, balance.Balance.IsZero(), "balance before deposit mismatch.")
+
+	// Record two days of snapshots.
+	firstSnapshot := time.Now().Add(time.Minute).Truncate(time.Second)
+	secondSnapshot := firstSnapshot.Add(24 * time.Hour)
+
+	count, err := connection.BalanceSnapshotCreate(firstSnapshot)
+	require.NoError(t, err, "failed to record first snapshot.")
+	require.Positive(t, count, "no accounts in first snapshot.")
+
+	count, err = connection.BalanceSnapshotCreate(firstSnapshot)
+	require.NoError(t, err, "failed to re-record first snapshot.")
+	require.Zero(t, count, "first snapshot recorded twice.")
+
+	_, err = connection.BalanceSnapshotCreate(secondSnapshot)
+	require.NoError(t, err, "failed to record second snapshot.")
+
+	latest, err = connection.BalanceSnapshotLatest()
+	require.NoError(t, err, "failed to retrieve latest snapshot.")
+	require.True(t, latest.Time.Equal(secondSnapshot), "latest snapshot mismatch.")
+
+	// Balances are computed from the nearest snapshot.
+	balance, err = connection.FiatBalanceAsOf(clientID1, "USD", secondSnapshot.Add(time.Hour))
+	require.NoError(t, err, "failed to retrieve balance after snapshots.")
+	require.True(t, balance.Balance.Equal(decimal.NewFromFloat(1000)), "balance carried forward mismatch.")
+	require.True(t, balance.SnapshotAt.Time.Equal(secondSnapshot), "nearest snapshot mismatch.")
+
+	cryptoBalance, err := connection.CryptoBalanceAsOf(clientID1, "BTC", secondSnapshot)
+	require.NoError(t, err, "failed to retrieve Crypto balance after snapshots.")
+	require.True(t, cryptoBalance.Balance.IsZero(), "Crypto balance mismatch.")
+	require.Equal(t, "BTC", cryptoBalance.Currency, "Crypto ticker mismatch.")
+
+	_, err = connection.FiatBalanceAsOf(clientID1, "JPY", time.Now())
+	require.ErrorIs(t, err, ErrNotFound, "retrieved balance of missing Fiat account.")
+
+	_, err = connection.CryptoBalanceAsOf(clientID1, "NOPE", time.Now())
+	require.ErrorIs(t, err, ErrNotFound, "retrieved balance of missing Crypto account.")
+
+	// Retrieve the balance histories.
+	fiatHistory, err := connection.FiatBalanceHistory(clientID1, "USD", firstSnapshot, secondSnapshot)
+	require.NoError(t, err, "failed to retrieve Fiat balance history.")
+	require.Len(t, fiatHistory, 2, "Fiat balance history length mismatch.")
+	require.True(t, fiatHistory[0].SnapshotAt.Time.Equal(firstSnapshot), "Fiat balance history not oldest first.")
+	require.True(t, fiatHistory[1].Balance.Equal(decimal.NewFromFloat(1000)), "Fiat balance history mismatch.")
+
+	fiatHistory, err = connection.FiatBalanceHistory(clientID1, "USD", secondSnapshot.Add(time.Second),
+		secondSnapshot.Add(time.Hour))
+	require.NoError(t, err, "failed to retrieve empty Fiat balance history.")
+	require.Empty(t, fiatHistory, "Fiat balance history outside range.")
+
+	cryptoHistory, err := connection.CryptoBalanceHistory(clientID2, "ETH", firstSnapshot, secondSnapshot)
+	require.NoError(t, err, "failed to retrieve Crypto balance history.")
+	require.Len(t, cryptoHistory, 2, "Crypto balance history length mismatch.")
+}
@@ -148,25 +148,25 @@ func getTestFiatAccounts(clientID1, clientID2 uuid.UUID) map[string][]fiatCreate
 		"clientID1": {
 			{
 				ClientID: clientID1,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 			}, {
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 			}, {
 				ClientID: clientID1,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 			},
 		},
 		"clientID2": {
 			{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 			}, {
 				ClientID: clientID2,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 			}, {
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 			},
 		},
 	}
@@ -179,25 +179,25 @@ func getTestJournalInternalFiatAccounts(
 	return map[string]fiatInternalTransferJournalEntryParams{
 		"CAD-AED": {
 			SourceAccount:       clientID1,
-			SourceCurrency:      CurrencyCAD,
+			SourceCurrency:      Currency("CAD"),
 			DestinationAccount:  clientID2,
-			DestinationCurrency: CurrencyAED,
+			DestinationCurrency: Currency("AED"),
 			CreditAmount:        decimal.NewFromFloat(123.45),
 			DebitAmount:         decimal.NewFromFloat(-123.45),
 		},
 		"CAD-USD": {
 			SourceAccount:       clientID1,
-			SourceCurrency:      CurrencyCAD,
+			SourceCurrency:      Currency("CAD"),
 			DestinationAccount:  clientID2,
-			DestinationCurrency: CurrencyUSD,
+			DestinationCurrency: Currency("USD"),
 			CreditAmount:        decimal.NewFromFloat(4567.89),
 			DebitAmount:         decimal.NewFromFloat(-4567.89),
 		},
 		"USD-AED": {
 			SourceAccount:       clientID1,
-			SourceCurrency:      CurrencyUSD,
+			SourceCurrency:      Currency("USD"),
 			DestinationAccount:  clientID2,
-			DestinationCurrency: CurrencyAED,
+			DestinationCurrency: Currency("AED"),
 			CreditAmount:        decimal.NewFromFloat(9192.24),
 			DebitAmount:         decimal.NewFromFloat(-9192.24),
 		},
@@ -216,32 +216,32 @@ func getTestFiatJournal(clientID1, clientID2 uuid.UUID) map[string]fiatExternalT
 	return map[string]fiatExternalTransferJournalEntryParams{
 		"Client ID 1 - USD": {
 			ClientID: clientID1,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 			Amount:   amount1,
 		},
 		"Client ID 1 - AED": {
 			ClientID: clientID1,
-			Currency: CurrencyAED,
+			Currency: Currency("AED"),
 			Amount:   amount2,
 		},
 		"Client ID 1 - CAD": {
 			ClientID: clientID1,
-			Currency: CurrencyCAD,
+			Currency: Currency("CAD"),
 			Amount:   amount3,
 		},
 		"Client ID 2 - USD": {
 			ClientID: clientID2,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 			Amount:   amount2,
 		},
 		"Client ID 2 - AED": {
 			ClientID: clientID2,
-			Currency: CurrencyAED,
+			Currency: Currency("AED"),
 			Amount:   amount3,
 		},
 		"Client ID 2 - CAD": {
 			ClientID: clientID2,
-			Currency: CurrencyCAD,
+			Currency: Currency("CAD"),
 			Amount:   amount1,
 		},
 	}
@@ -290,21 +290,21 @@ func getTestCryptoPurchaseParams(clientID1, clientID2 uuid.UUID) map[string][]cr
 			{
 				TransactionID:      uuid.UUID{},
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    amount1,
 				CryptoCreditAmount: amount3,
 			}, {
 				TransactionID:      uuid.UUID{},
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "ETH",
 				FiatDebitAmount:    amount3,
 				CryptoCreditAmount: amount2,
 			}, {
 				TransactionID:      uuid.UUID{},
 				ClientID:           clientID1,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "USDT",
 				FiatDebitAmount:    amount2,
 				CryptoCreditAmount: amount1,
@@ -314,21 +314,21 @@ func getTestCryptoPurchaseParams(clientID1, clientID2 uuid.UUID) map[string][]cr
 			{
 				TransactionID:      uuid.UUID{},
 				ClientID:           clientID2,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    amount1,
 				CryptoCreditAmount: amount2,
 			}, {
 				TransactionID:      uuid.UUID{},
 				ClientID:           clientID2,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "ETH",
 				FiatDebitAmount:    amount2,
 				CryptoCreditAmount: amount3,
 			}, {
 				TransactionID:      uuid.UUID{},
 				ClientID:           clientID2,
-				FiatCurrency:       CurrencyUSD,
+				FiatCurrency:       Currency("USD"),
 				CryptoTicker:       "USDT",
 				FiatDebitAmount:    amount3,
 				CryptoCreditAmount: amount1,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/surahman/FTeX/pkg/constants"
@@ -15,10 +16,36 @@ import (
 	"go.uber.org/zap"
 )
 
+// accountStatusErrCode is the SQLSTATE raised by the account_transact_check database function.
+const accountStatusErrCode = "FX001"
+
+// accountTransactCheck will verify that a client has not been suspended and that the status of their account permits the
+// transaction. Accounts with frozen debits can still be credited. This mirrors the account_transact_check database
+// function used by the Cryptocurrency stored procedures.
+func accountTransactCheck(status AccountStatus, isSuspended, isDebit bool) error {
+	if isSuspended {
+		return fmt.Errorf("client is suspended %w", ErrAccountStatus)
+	}
+
+	if status == AccountStatusACTIVE || (status == AccountStatusFROZENDEBITS && !isDebit) {
+		return nil
+	}
+
+	return fmt.Errorf("account is %s %w", status, ErrAccountStatus)
+}
+
+// isAccountStatusError will check if a database error was raised by the account_transact_check database function.
+func isAccountStatusError(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == accountStatusErrCode
+}
+
 type FiatTransactionDetails struct {
 	ClientID uuid.UUID       `json:"clientId"`
 	Currency Currency        `json:"currency"`
 	Amount   decimal.Decimal `json:"amount"`
+	HoldID   string          `json:"holdId,omitempty"`
 }
 
 // Less returns a total ordering on two FiatTransactionDetails structs.
@@ -89,6 +116,10 @@ func (p *postgresImpl) FiatExternalTransfer(parentCtx context.Context, xferDetai
 	if txReceipt, err = fiatExternalTransfer(ctx, p.logger, queryTx, xferDetails); err != nil {
 		p.logger.Warn("failed to complete external Fiat transfer transaction", zap.Error(err))
 
+		if errors.Is(err, ErrAccountStatus) {
+			return nil, ErrAccountStatus
+		}
+
 		return nil, ErrTransactFiat
 	}
 
@@ -112,8 +143,9 @@ func (p *postgresImpl) FiatExternalTransfer(parentCtx context.Context, xferDetai
 
     [1] Acquire a row lock on the destination account without holding a lock on the foreign key for the Client ID.
         There will be no update for the external account balance, so there is no need for a row lock on the account.
-    [2] Make the Journal entries for the external and internal accounts.
-    [3] Update the balance for the internal account.
+    [2] Verify that the client has not been suspended and that the destination account can be credited.
+    [3] Make the Journal entries for the external and internal accounts.
+    [4] Update the balance for the internal account.
 */
 func fiatExternalTransfer(
 	ctx context.Context,
@@ -122,12 +154,13 @@ func fiatExternalTransfer(
 	xferDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error) {
 	var (
 		err        error
+		lockRow    fiatRowLockAccountRow
 		journalRow fiatExternalTransferJournalEntryRow
 		updateRow  fiatUpdateAccountBalanceRow
 	)
 
 	// Row lock the destination account.
-	if _, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+	if lockRow, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
 		ClientID: xferDetails.ClientID,
 		Currency: xferDetails.Currency,
 	}); err != nil {
@@ -137,6 +170,14 @@ func fiatExternalTransfer(
 		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
+	// Check the destination account can be credited.
+	if err = accountTransactCheck(lockRow.Status, lockRow.IsFrozen, false); err != nil {
+		msg := "destination Fiat account cannot be credited"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
 	// Make General Journal ledger entries.
 	if journalRow, err = queryTx.fiatExternalTransferJournalEntry(ctx, &fiatExternalTransferJournalEntryParams{
 		ClientID: xferDetails.ClientID,
@@ -174,7 +215,9 @@ func fiatExternalTransfer(
 }
 
 // fiatTransactionRowLockAndBalanceCheck will acquire row locks on the Fiat accounts in a deterministic lock order.
-// It will then check to see if the balance of the source/debit account is sufficient for the transaction.
+// It will then check that the source/debit account can be debited and the destination/credit account credited, and
+// that the available balance of the source/debit account is sufficient for the transaction. The hold placed on the
+// source/debit account for the offer being redeemed is released before the available balance is checked.
 func fiatTransactionRowLockAndBalanceCheck(
 	ctx context.Context,
 	queryTx Querier,
@@ -189,7 +232,7 @@ func fiatTransactionRowLockAndBalanceCheck(
 	lockFirst, lockSecond := src.Less(dst)
 
 	// Row lock the accounts in order.
-	balanceFirst, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+	rowFirst, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
 		ClientID: (*lockFirst).ClientID,
 		Currency: (*lockFirst).Currency,
 	})
@@ -197,7 +240,7 @@ func fiatTransactionRowLockAndBalanceCheck(
 		return fmt.Errorf("failed to get row lock on first Fiat account %w", err)
 	}
 
-	balanceSecond, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+	rowSecond, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
 		ClientID: (*lockSecond).ClientID,
 		Currency: (*lockSecond).Currency,
 	})
@@ -205,15 +248,24 @@ func fiatTransactionRowLockAndBalanceCheck(
 		return fmt.Errorf("failed to get row lock on second Fiat account %w", err)
 	}
 
-	// Check which lock operation returned the source/debit balance.
-	debitBalance := &balanceFirst
+	// Check which lock operation returned the source/debit account.
+	debitRow, creditRow := &rowFirst, &rowSecond
 	if *lockSecond == src {
-		debitBalance = &balanceSecond
+		debitRow, creditRow = &rowSecond, &rowFirst
+	}
+
+	// Check the account statuses.
+	if err = accountTransactCheck(debitRow.Status, debitRow.IsFrozen, true); err != nil {
+		return fmt.Errorf("source Fiat account cannot be debited %w", err)
+	}
+
+	if err = accountTransactCheck(creditRow.Status, creditRow.IsFrozen, false); err != nil {
+		return fmt.Errorf("destination Fiat account cannot be credited %w", err)
 	}
 
-	// Check for sufficient funds.
-	if debitBalance.LessThan(src.Amount) {
-		return fmt.Errorf("insufficient balance in source account: %s, %s", debitBalance, src.Amount)
+	// Check for sufficient available funds.
+	if err = fiatAvailableBalanceCheck(ctx, queryTx, src, debitRow.Balance); err != nil {
+		return fmt.Errorf("insufficient balance in source account %w", err)
 	}
 
 	return nil
@@ -285,8 +337,11 @@ func (p *postgresImpl) FiatInternalTransfer(
 
     [1] Acquire a row lock on the accounts without holding a lock on the foreign key for the Client ID.
         Their accounts will be compared against each other using a total order rule.
-    [2] Make the Journal entries for both of the accounts.
-    [3] Update the balance for the source and destination accounts.
+    [2] Verify that the client has not been suspended, that the source account can be debited, and that the
+        destination account can be credited.
+    [3] Release the hold for the offer being redeemed and verify the available balance of the source account.
+    [4] Make the Journal entries for both of the accounts.
+    [5] Update the balance for the source and destination accounts.
 */
 func fiatInternalTransfer(
 	ctx context.Context,
@@ -367,3 +422,393 @@ func fiatInternalTransfer(
 		},
 		nil
 }
+
+// closeAccountCheck will verify that an account can be closed. The client must not be suspended, the account must be
+// permitted to be debited, and the balance must be zero.
+func closeAccountCheck(balance decimal.Decimal, status AccountStatus, isSuspended bool) error {
+	if err := accountTransactCheck(status, isSuspended, true); err != nil {
+		return err
+	}
+
+	if !balance.IsZero() {
+		return fmt.Errorf("account balance is %s %w", balance, ErrCloseAccount)
+	}
+
+	return nil
+}
+
+// FiatCloseAccount controls the transaction block that the Fiat account closure executes in.
+func (p *postgresImpl) FiatCloseAccount(
+	parentCtx context.Context,
+	src,
+	dst *FiatTransactionDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error) {
+	ctx, cancel := context.WithTimeout(parentCtx, constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err          error
+		tx           pgx.Tx
+		dstTxReceipt *FiatAccountTransferResult
+		srcTxReceipt *FiatAccountTransferResult
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("close account Fiat transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactFiat
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback close Fiat account transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Configure transaction query connection.
+	queryTx := p.queries.WithTx(tx)
+
+	// Handoff to Fiat account closure core logic.
+	if srcTxReceipt, dstTxReceipt, err = fiatAccountClose(ctx, p.logger, queryTx, src, dst); err != nil {
+		p.logger.Warn("failed to complete close Fiat account transaction", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrAccountStatus):
+			return nil, nil, ErrAccountStatus
+		case errors.Is(err, ErrCloseAccount):
+			return nil, nil, ErrCloseAccount
+		case errors.Is(err, ErrInsufficientFunds):
+			return nil, nil, ErrInsufficientFunds
+		default:
+			return nil, nil, ErrTransactFiat
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit close Fiat account transaction", zap.Error(err))
+
+		return nil, nil, ErrTransactFiat
+	}
+
+	return srcTxReceipt, dstTxReceipt, nil
+}
+
+// fiatAccountClose will execute the logic to close a Fiat account.
+/*
+   [1] Sweep the source account balance into the destination account using an internal transfer, if a destination
+       account has been supplied.
+   [2] Acquire a row lock on the source account without holding a lock on the foreign key for the Client ID.
+   [3] Verify that the client has not been suspended, that the account can be debited, and that the balance is zero.
+   [4] Mark the account as closed. The account and its Journal entries are retained.
+*/
+func fiatAccountClose(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	src,
+	dst *FiatTransactionDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error) {
+	var (
+		err          error
+		lockRow      fiatRowLockAccountRow
+		rowsAffected int64
+		dstTxReceipt *FiatAccountTransferResult
+		srcTxReceipt *FiatAccountTransferResult
+	)
+
+	// Sweep the remaining balance.
+	if dst != nil {
+		if srcTxReceipt, dstTxReceipt, err = fiatInternalTransfer(ctx, logger, queryTx, src, dst); err != nil {
+			msg := "failed to sweep Fiat account balance"
+			logger.Warn(msg, zap.Error(err))
+
+			return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		}
+	}
+
+	// Row lock the account being closed.
+	if lockRow, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: src.ClientID,
+		Currency: src.Currency,
+	}); err != nil {
+		msg := "failed to get row lock on Fiat account being closed"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check the account can be closed.
+	if err = closeAccountCheck(lockRow.Balance, lockRow.Status, lockRow.IsFrozen); err != nil {
+		msg := "Fiat account cannot be closed"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Close the account.
+	if rowsAffected, err = queryTx.fiatCloseAccount(ctx, &fiatCloseAccountParams{
+		ClientID: src.ClientID,
+		Currency: src.Currency,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to close Fiat account"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, nil, errors.New(msg)
+	}
+
+	return srcTxReceipt, dstTxReceipt, nil
+}
+
+// CryptoCloseAccount controls the transaction block that the Crypto account closure executes in.
+func (p *postgresImpl) CryptoCloseAccount(
+	clientID uuid.UUID,
+	fiatCurrency Currency,
+	fiatCreditAmount decimal.Decimal,
+	cryptoTicker string,
+	cryptoDebitAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err  error
+		tx   pgx.Tx
+		txID uuid.UUID
+	)
+
+	if txID, err = uuid.NewV4(); err != nil {
+		p.logger.Error("failed to generate transaction id for Crypto account closure", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("close account Crypto transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback close Crypto account transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Configure transaction query connection.
+	queryTx := p.queries.WithTx(tx)
+
+	// Handoff to Crypto account closure core logic.
+	if err = cryptoAccountClose(ctx, p.logger, queryTx, &cryptoSellParams{
+		TransactionID:     txID,
+		ClientID:          clientID,
+		FiatCurrency:      fiatCurrency,
+		CryptoTicker:      cryptoTicker,
+		FiatCreditAmount:  fiatCreditAmount,
+		CryptoDebitAmount: cryptoDebitAmount,
+	}); err != nil {
+		p.logger.Warn("failed to complete close Crypto account transaction", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrAccountStatus) || isAccountStatusError(err):
+			return nil, nil, ErrAccountStatus
+		case errors.Is(err, ErrCloseAccount):
+			return nil, nil, ErrCloseAccount
+		case errors.Is(err, ErrInsufficientFunds):
+			return nil, nil, ErrInsufficientFunds
+		default:
+			return nil, nil, ErrTransactCrypto
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit close Crypto account transaction", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// There are no receipts if the balance was not swept.
+	if cryptoDebitAmount.IsZero() {
+		return nil, nil, nil
+	}
+
+	fiatJournal, err := p.Query.fiatGetJournalTransaction(ctx, &fiatGetJournalTransactionParams{
+		ClientID: clientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Fiat transaction details post Crypto account closure", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	cryptoJournal, err := p.Query.cryptoGetJournalTransaction(ctx, &cryptoGetJournalTransactionParams{
+		ClientID: clientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Crypto transaction details post Crypto account closure", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	return &fiatJournal[0], &cryptoJournal[0], nil
+}
+
+// cryptoAccountClose will execute the logic to close a Crypto account.
+/*
+   [1] Sell the Cryptocurrency amount and sweep the proceeds into the Fiat account, if the amount is non-zero. The
+       available balance of the Crypto account is verified before the sale.
+   [2] Acquire a row lock on the Crypto account without holding a lock on the foreign key for the Client ID.
+   [3] Verify that the client has not been suspended, that the account can be debited, and that the balance is zero.
+   [4] Mark the account as closed. The account and its Journal entries are retained.
+*/
+func cryptoAccountClose(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	sweep *cryptoSellParams) error {
+	var (
+		err          error
+		lockRow      cryptoRowLockAccountRow
+		rowsAffected int64
+	)
+
+	// Sweep the remaining balance.
+	if !sweep.CryptoDebitAmount.IsZero() {
+		if err = cryptoSellTransaction(ctx, queryTx, "", sweep); err != nil {
+			msg := "failed to sweep Crypto account balance"
+			logger.Warn(msg, zap.Error(err))
+
+			return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		}
+	}
+
+	// Row lock the account being closed.
+	if lockRow, err = queryTx.cryptoRowLockAccount(ctx, &cryptoRowLockAccountParams{
+		ClientID: sweep.ClientID,
+		Ticker:   sweep.CryptoTicker,
+	}); err != nil {
+		msg := "failed to get row lock on Crypto account being closed"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check the account can be closed.
+	if err = closeAccountCheck(lockRow.Balance, lockRow.Status, lockRow.IsFrozen); err != nil {
+		msg := "Crypto account cannot be closed"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Close the account.
+	if rowsAffected, err = queryTx.cryptoCloseAccount(ctx, &cryptoCloseAccountParams{
+		ClientID: sweep.ClientID,
+		Ticker:   sweep.CryptoTicker,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to close Crypto account"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// cryptoTransactionErrorMapping will map the errors returned by the Cryptocurrency purchase and sale core logic to the
+// errors returned by the interface.
+func cryptoTransactionErrorMapping(err error) error {
+	switch {
+	case errors.Is(err, ErrAccountStatus) || isAccountStatusError(err):
+		return ErrAccountStatus
+	case errors.Is(err, ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return ErrTransactCrypto
+	}
+}
+
+// cryptoPurchaseTransaction will execute the logic to purchase a Cryptocurrency within a transaction block.
+/*
+   [1] Acquire a row lock on the Fiat account without holding a lock on the foreign key for the Client ID.
+   [2] Release the hold for the offer being redeemed and verify the available balance of the Fiat account.
+   [3] Execute the purchase stored procedure, which carries out its own account status checks.
+*/
+func cryptoPurchaseTransaction(
+	ctx context.Context,
+	queryTx Querier,
+	holdID string,
+	purchase *cryptoPurchaseParams) error {
+	fiatRow, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: purchase.ClientID,
+		Currency: purchase.FiatCurrency,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get row lock on Fiat account %w", err)
+	}
+
+	if err = fiatAvailableBalanceCheck(ctx, queryTx, &FiatTransactionDetails{
+		ClientID: purchase.ClientID,
+		Currency: purchase.FiatCurrency,
+		Amount:   purchase.FiatDebitAmount,
+		HoldID:   holdID,
+	}, fiatRow.Balance); err != nil {
+		return fmt.Errorf("insufficient balance in Fiat account %w", err)
+	}
+
+	if err = queryTx.cryptoPurchase(ctx, purchase); err != nil {
+		return fmt.Errorf("failed to purchase Cryptocurrency %w", err)
+	}
+
+	return nil
+}
+
+// cryptoSellTransaction will execute the logic to sell a Cryptocurrency within a transaction block.
+/*
+   [1] Acquire row locks on the Fiat and then Crypto accounts, matching the lock order of the stored procedure.
+   [2] Release the hold for the offer being redeemed and verify the available balance of the Crypto account.
+   [3] Execute the sale stored procedure, which carries out its own account status checks.
+*/
+func cryptoSellTransaction(
+	ctx context.Context,
+	queryTx Querier,
+	holdID string,
+	sale *cryptoSellParams) error {
+	if _, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: sale.ClientID,
+		Currency: sale.FiatCurrency,
+	}); err != nil {
+		return fmt.Errorf("failed to get row lock on Fiat account %w", err)
+	}
+
+	cryptoRow, err := queryTx.cryptoRowLockAccount(ctx, &cryptoRowLockAccountParams{
+		ClientID: sale.ClientID,
+		Ticker:   sale.CryptoTicker,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get row lock on Crypto account %w", err)
+	}
+
+	if err = cryptoAvailableBalanceCheck(ctx, queryTx, holdID, sale.ClientID, sale.CryptoTicker, cryptoRow.Balance,
+		sale.CryptoDebitAmount); err != nil {
+		return fmt.Errorf("insufficient balance in Crypto account %w", err)
+	}
+
+	if err = queryTx.cryptoSell(ctx, sale); err != nil {
+		return fmt.Errorf("failed to sell Cryptocurrency %w", err)
+	}
+
+	return nil
+}
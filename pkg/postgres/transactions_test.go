@@ -10,12 +10,84 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/FTeX/pkg/constants"
 )
 
+func TestTransactions_AccountTransactCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		status      AccountStatus
+		isSuspended bool
+		isDebit     bool
+		expectErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:        "active debit",
+			status:      AccountStatusACTIVE,
+			isSuspended: false,
+			isDebit:     true,
+			expectErr:   require.NoError,
+		}, {
+			name:        "active credit",
+			status:      AccountStatusACTIVE,
+			isSuspended: false,
+			isDebit:     false,
+			expectErr:   require.NoError,
+		}, {
+			name:        "frozen debits debit",
+			status:      AccountStatusFROZENDEBITS,
+			isSuspended: false,
+			isDebit:     true,
+			expectErr:   require.Error,
+		}, {
+			name:        "frozen debits credit",
+			status:      AccountStatusFROZENDEBITS,
+			isSuspended: false,
+			isDebit:     false,
+			expectErr:   require.NoError,
+		}, {
+			name:        "frozen credit",
+			status:      AccountStatusFROZEN,
+			isSuspended: false,
+			isDebit:     false,
+			expectErr:   require.Error,
+		}, {
+			name:        "closed credit",
+			status:      AccountStatusCLOSED,
+			isSuspended: false,
+			isDebit:     false,
+			expectErr:   require.Error,
+		}, {
+			name:        "suspended client",
+			status:      AccountStatusACTIVE,
+			isSuspended: true,
+			isDebit:     false,
+			expectErr:   require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := accountTransactCheck(test.status, test.isSuspended, test.isDebit)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				require.ErrorIs(t, err, ErrAccountStatus, "expected account status error.")
+			}
+		})
+	}
+}
+
 func TestTransactions_FiatTransactionsDetails_LessComparator(t *testing.T) {
 	t.Parallel()
 
@@ -26,10 +98,10 @@ func TestTransactions_FiatTransactionsDetails_LessComparator(t *testing.T) {
 	require.NoError(t, err, "failed to parse second UUID.")
 
 	var (
-		uuid1USD = &FiatTransactionDetails{ClientID: firstUUID, Currency: CurrencyUSD}
-		uuid1AED = &FiatTransactionDetails{ClientID: firstUUID, Currency: CurrencyAED}
-		uuid2USD = &FiatTransactionDetails{ClientID: secondUUID, Currency: CurrencyUSD}
-		uuid2AED = &FiatTransactionDetails{ClientID: secondUUID, Currency: CurrencyAED}
+		uuid1USD = &FiatTransactionDetails{ClientID: firstUUID, Currency: Currency("USD")}
+		uuid1AED = &FiatTransactionDetails{ClientID: firstUUID, Currency: Currency("AED")}
+		uuid2USD = &FiatTransactionDetails{ClientID: secondUUID, Currency: Currency("USD")}
+		uuid2AED = &FiatTransactionDetails{ClientID: secondUUID, Currency: Currency("AED")}
 	)
 
 	testCases := []struct {
@@ -251,7 +323,7 @@ func TestTransactions_FiatExternalTransfer(t *testing.T) {
 	t.Run("Checking end totals", func(t *testing.T) {
 		actual, err := connection.Query.fiatGetAccount(ctx, &fiatGetAccountParams{
 			ClientID: clientID1,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 		})
 		require.NoError(t, err, "failed to fiat account.")
 		require.Equal(t, expectedTotal, actual.Balance, "end of test expected totals mismatched.")
@@ -262,7 +334,8 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 	t.Parallel()
 
 	txDetails := FiatTransactionDetails{}
-	rowLockDecimal := decimal.Decimal{}
+	rowLockRow := fiatRowLockAccountRow{Status: AccountStatusACTIVE}
+	frozenRowLockRow := fiatRowLockAccountRow{Status: AccountStatusFROZEN}
 	journalEntryRow := fiatExternalTransferJournalEntryRow{}
 	accountBalanceRow := fiatUpdateAccountBalanceRow{}
 
@@ -270,7 +343,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 	testCases := []struct {
 		name                string
 		expectedErrMsg      string
-		rowLockReturn       *decimal.Decimal
+		rowLockReturn       *fiatRowLockAccountRow
 		rowLockError        error
 		rowLockTimes        int
 		extJournalReturn    *fiatExternalTransferJournalEntryRow
@@ -284,7 +357,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 			name:                "Row lock failure.",
 			expectedErrMsg:      "row lock failure",
 			rowLockTimes:        1,
-			rowLockReturn:       &rowLockDecimal,
+			rowLockReturn:       &rowLockRow,
 			rowLockError:        fmt.Errorf("row lock failure"),
 			extJournalTimes:     0,
 			extJournalReturn:    &journalEntryRow,
@@ -292,11 +365,23 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 			updateBalanceTimes:  0,
 			updateBalanceReturn: &accountBalanceRow,
 			updateBalanceError:  nil,
+		}, {
+			name:                "Account status failure.",
+			expectedErrMsg:      "cannot be credited",
+			rowLockTimes:        1,
+			rowLockReturn:       &frozenRowLockRow,
+			rowLockError:        nil,
+			extJournalTimes:     0,
+			extJournalReturn:    &journalEntryRow,
+			extJournalError:     nil,
+			updateBalanceTimes:  0,
+			updateBalanceReturn: &accountBalanceRow,
+			updateBalanceError:  nil,
 		}, {
 			name:                "Journal entry failure.",
 			expectedErrMsg:      "journal entry failure",
 			rowLockTimes:        1,
-			rowLockReturn:       &rowLockDecimal,
+			rowLockReturn:       &rowLockRow,
 			rowLockError:        nil,
 			extJournalTimes:     1,
 			extJournalReturn:    &journalEntryRow,
@@ -308,7 +393,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 			name:                "Account balance update failure.",
 			expectedErrMsg:      "account balance update failure",
 			rowLockTimes:        1,
-			rowLockReturn:       &rowLockDecimal,
+			rowLockReturn:       &rowLockRow,
 			rowLockError:        nil,
 			extJournalTimes:     1,
 			extJournalReturn:    &journalEntryRow,
@@ -392,17 +477,17 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 	})
 
 	// Update base balances in accounts to test from.
-	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err := updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID1,
-		Currency: CurrencyUSD,
+		Currency: Currency("USD"),
 		Amount:   balanceClientID1,
 		LastTxTs: txTimestamp,
 	})
 	require.NoError(t, err, "failed to set base balance for Client1 in USD")
 
-	_, err = connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err = updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID2,
-		Currency: CurrencyAED,
+		Currency: Currency("AED"),
 		Amount:   balanceClientID2,
 		LastTxTs: txTimestamp,
 	})
@@ -421,12 +506,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 			expectErrMsg: "insufficient balance",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount20k,
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 				Amount:   amount1k,
 			},
 			errExpectation: require.NoError,
@@ -435,12 +520,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 			expectErrMsg: "insufficient balance",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 				Amount:   amount1k,
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount20k,
 			},
 			errExpectation: require.NoError,
@@ -449,12 +534,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 			expectErrMsg: "insufficient balance",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(52145.80),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 				Amount:   amount20k,
 			},
 			errExpectation: require.Error,
@@ -463,12 +548,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 			expectErrMsg: "insufficient balance",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 				Amount:   amount20k,
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount1k,
 			},
 			errExpectation: require.Error,
@@ -477,12 +562,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 			expectErrMsg: "negative",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amountNegative,
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 				Amount:   amount1k,
 			},
 			errExpectation: require.Error,
@@ -491,12 +576,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 			expectErrMsg: "negative",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   amount20k,
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyAED,
+				Currency: Currency("AED"),
 				Amount:   amountNegative,
 			},
 			errExpectation: require.Error,
@@ -537,59 +622,208 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 	require.NoError(t, err, "failed to parse second UUID.")
 
 	var (
-		uuid1USD = &FiatTransactionDetails{ClientID: firstUUID, Currency: CurrencyUSD}
-		uuid2USD = &FiatTransactionDetails{ClientID: secondUUID, Currency: CurrencyUSD}
+		uuid1USD = &FiatTransactionDetails{ClientID: firstUUID, Currency: Currency("USD")}
+		uuid2USD = &FiatTransactionDetails{ClientID: secondUUID, Currency: Currency("USD")}
 	)
 
+	active := fiatRowLockAccountRow{Status: AccountStatusACTIVE}
+
 	testCases := []struct {
-		name                 string
-		expectedErrMsg       string
-		srcAccount           *FiatTransactionDetails
-		dstAccount           *FiatTransactionDetails
-		firstRowLockBalance  decimal.Decimal
-		firstRowLockErr      error
-		firstRowLockTimes    int
-		secondRowLockBalance decimal.Decimal
-		secondRowLockErr     error
-		secondRowLockTimes   int
+		name               string
+		expectedErrMsg     string
+		srcAccount         *FiatTransactionDetails
+		dstAccount         *FiatTransactionDetails
+		firstRowLock       fiatRowLockAccountRow
+		firstRowLockErr    error
+		firstRowLockTimes  int
+		secondRowLock      fiatRowLockAccountRow
+		secondRowLockErr   error
+		secondRowLockTimes int
+		releaseErr         error
+		releaseTimes       int
+		held               decimal.Decimal
+		heldErr            error
+		heldTimes          int
 	}{
 		{
-			name:                 "First row lock failure.",
-			expectedErrMsg:       "first row lock failure",
-			srcAccount:           uuid1USD,
-			dstAccount:           uuid2USD,
-			firstRowLockBalance:  decimal.Decimal{},
-			firstRowLockErr:      fmt.Errorf("first row lock failure"),
-			firstRowLockTimes:    1,
-			secondRowLockBalance: decimal.Decimal{},
-			secondRowLockErr:     nil,
-			secondRowLockTimes:   0,
-		}, {
-			name:                 "Second row lock failure.",
-			expectedErrMsg:       "second row lock failure",
-			srcAccount:           uuid1USD,
-			dstAccount:           uuid2USD,
-			firstRowLockBalance:  decimal.Decimal{},
-			firstRowLockErr:      nil,
-			firstRowLockTimes:    1,
-			secondRowLockBalance: decimal.Decimal{},
-			secondRowLockErr:     fmt.Errorf("second row lock failure"),
-			secondRowLockTimes:   1,
+			name:               "First row lock failure.",
+			expectedErrMsg:     "first row lock failure",
+			srcAccount:         uuid1USD,
+			dstAccount:         uuid2USD,
+			firstRowLock:       active,
+			firstRowLockErr:    fmt.Errorf("first row lock failure"),
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 0,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
+		}, {
+			name:               "Second row lock failure.",
+			expectedErrMsg:     "second row lock failure",
+			srcAccount:         uuid1USD,
+			dstAccount:         uuid2USD,
+			firstRowLock:       active,
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   fmt.Errorf("second row lock failure"),
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
+		}, {
+			name:               "Client suspended failure.",
+			expectedErrMsg:     "client is suspended",
+			srcAccount:         uuid1USD,
+			dstAccount:         uuid2USD,
+			firstRowLock:       fiatRowLockAccountRow{Status: AccountStatusACTIVE, IsFrozen: true},
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
+		}, {
+			name:               "Source debits frozen failure.",
+			expectedErrMsg:     "source Fiat account cannot be debited",
+			srcAccount:         uuid1USD,
+			dstAccount:         uuid2USD,
+			firstRowLock:       fiatRowLockAccountRow{Status: AccountStatusFROZENDEBITS},
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
+		}, {
+			name:               "Destination frozen failure.",
+			expectedErrMsg:     "destination Fiat account cannot be credited",
+			srcAccount:         uuid1USD,
+			dstAccount:         uuid2USD,
+			firstRowLock:       active,
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      fiatRowLockAccountRow{Status: AccountStatusFROZEN},
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
+		}, {
+			name:               "Destination closed failure.",
+			expectedErrMsg:     "destination Fiat account cannot be credited",
+			srcAccount:         uuid1USD,
+			dstAccount:         uuid2USD,
+			firstRowLock:       active,
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      fiatRowLockAccountRow{Status: AccountStatusCLOSED},
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
 		}, {
 			name:           "Insufficient balance failure.",
 			expectedErrMsg: "insufficient balance",
 			srcAccount: &FiatTransactionDetails{
 				ClientID: uuid1USD.ClientID,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(101.1),
 			},
-			dstAccount:           uuid2USD,
-			firstRowLockBalance:  decimal.Decimal{},
-			firstRowLockErr:      nil,
-			firstRowLockTimes:    1,
-			secondRowLockBalance: decimal.NewFromFloat(100.0),
-			secondRowLockErr:     nil,
-			secondRowLockTimes:   1,
+			dstAccount:         uuid2USD,
+			firstRowLock:       active,
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100.0), Status: AccountStatusACTIVE},
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          1,
+		}, {
+			name:           "Hold release failure.",
+			expectedErrMsg: "failed to release hold",
+			srcAccount: &FiatTransactionDetails{
+				ClientID: uuid1USD.ClientID,
+				Currency: Currency("USD"),
+				Amount:   decimal.NewFromFloat(60),
+				HoldID:   "offer-id",
+			},
+			dstAccount:         uuid2USD,
+			firstRowLock:       fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100.0), Status: AccountStatusACTIVE},
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         fmt.Errorf("release failure"),
+			releaseTimes:       1,
+			held:               decimal.Zero,
+			heldErr:            nil,
+			heldTimes:          0,
+		}, {
+			name:           "Hold total failure.",
+			expectedErrMsg: "failed to retrieve holds",
+			srcAccount: &FiatTransactionDetails{
+				ClientID: uuid1USD.ClientID,
+				Currency: Currency("USD"),
+				Amount:   decimal.NewFromFloat(60),
+				HoldID:   "",
+			},
+			dstAccount:         uuid2USD,
+			firstRowLock:       fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100.0), Status: AccountStatusACTIVE},
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       0,
+			held:               decimal.Zero,
+			heldErr:            fmt.Errorf("hold total failure"),
+			heldTimes:          1,
+		}, {
+			name:           "Insufficient available balance failure.",
+			expectedErrMsg: "insufficient available funds",
+			srcAccount: &FiatTransactionDetails{
+				ClientID: uuid1USD.ClientID,
+				Currency: Currency("USD"),
+				Amount:   decimal.NewFromFloat(60),
+				HoldID:   "offer-id",
+			},
+			dstAccount:         uuid2USD,
+			firstRowLock:       fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100.0), Status: AccountStatusACTIVE},
+			firstRowLockErr:    nil,
+			firstRowLockTimes:  1,
+			secondRowLock:      active,
+			secondRowLockErr:   nil,
+			secondRowLockTimes: 1,
+			releaseErr:         nil,
+			releaseTimes:       1,
+			held:               decimal.NewFromFloat(50),
+			heldErr:            nil,
+			heldTimes:          1,
 		},
 	}
 
@@ -607,13 +841,23 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 			gomock.InOrder(
 				mockQuerier.EXPECT().
 					fiatRowLockAccount(gomock.Any(), gomock.Any()).
-					Return(test.firstRowLockBalance, test.firstRowLockErr).
+					Return(test.firstRowLock, test.firstRowLockErr).
 					Times(test.firstRowLockTimes),
 
 				mockQuerier.EXPECT().
 					fiatRowLockAccount(gomock.Any(), gomock.Any()).
-					Return(test.secondRowLockBalance, test.secondRowLockErr).
+					Return(test.secondRowLock, test.secondRowLockErr).
 					Times(test.secondRowLockTimes),
+
+				mockQuerier.EXPECT().
+					fiatHoldRelease(gomock.Any(), gomock.Any()).
+					Return(int64(1), test.releaseErr).
+					Times(test.releaseTimes),
+
+				mockQuerier.EXPECT().
+					fiatHoldTotal(gomock.Any(), gomock.Any()).
+					Return(test.held, test.heldErr).
+					Times(test.heldTimes),
 			)
 
 			// Check for error.
@@ -655,17 +899,17 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 	defer cancel()
 
 	// Update base balances in accounts to test from.
-	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err := updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID1,
-		Currency: CurrencyUSD,
+		Currency: Currency("USD"),
 		Amount:   balanceClientID1,
 		LastTxTs: txTimestamp,
 	})
 	require.NoError(t, err, "failed to set base balance for Client1 in USD")
 
-	_, err = connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+	_, err = updateTestFiatAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: clientID2,
-		Currency: CurrencyCAD,
+		Currency: Currency("CAD"),
 		Amount:   balanceClientID2,
 		LastTxTs: txTimestamp,
 	})
@@ -682,12 +926,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client1USD 6830.69, Client2CAD 10182.72",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(6830.69),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(10182.72),
 			},
 			errExpectation: require.NoError,
@@ -695,12 +939,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client2CAD 9300.58, Client1USD 11894.37",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(9300.58),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(11894.37),
 			},
 			errExpectation: require.NoError,
@@ -708,12 +952,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client1USD 5741.18, Client2CAD 7678.79",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(5741.18),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(7678.79),
 			},
 			errExpectation: require.NoError,
@@ -721,12 +965,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client2CAD 5034.36, Client1USD 2469.99",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(5034.36),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(2469.99),
 			},
 			errExpectation: require.NoError,
@@ -734,12 +978,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client1USD 14657.84, Client2CAD 14763.92,",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(14657.84),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(14763.92),
 			},
 			errExpectation: require.NoError,
@@ -747,12 +991,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client2CAD 12517.73, Client1USD 12828.39",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(12517.73),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(12828.39),
 			},
 			errExpectation: require.NoError,
@@ -760,12 +1004,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client1USD 7887.40, Client2CAD 10453.91",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(7887.40),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(10453.91),
 			},
 			errExpectation: require.NoError,
@@ -773,12 +1017,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client2CAD 7838.29, Client1USD 6783.08",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(7838.29),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(6783.08),
 			},
 			errExpectation: require.NoError,
@@ -786,12 +1030,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client1USD 14287.55, Client2CAD 2407.57",
 			source: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(14287.55),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(2407.57),
 			},
 			errExpectation: require.NoError,
@@ -799,12 +1043,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Client2CAD 12039.82, Client1USD 11275.33",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(12039.82),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(11275.33),
 			},
 			errExpectation: require.NoError,
@@ -812,12 +1056,12 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			name: "Insufficient funds",
 			source: FiatTransactionDetails{
 				ClientID: clientID2,
-				Currency: CurrencyCAD,
+				Currency: Currency("CAD"),
 				Amount:   decimal.NewFromFloat(999999.82),
 			},
 			destination: FiatTransactionDetails{
 				ClientID: clientID1,
-				Currency: CurrencyUSD,
+				Currency: Currency("USD"),
 				Amount:   decimal.NewFromFloat(11275.33),
 			},
 			errExpectation: require.Error,
@@ -879,14 +1123,14 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 	t.Run("Checking end totals", func(t *testing.T) {
 		client1, err := connection.Query.fiatGetAccount(ctx, &fiatGetAccountParams{
 			ClientID: clientID1,
-			Currency: CurrencyUSD,
+			Currency: Currency("USD"),
 		})
 		require.NoError(t, err, "failed to fiat account.")
 		require.Equal(t, expectedTotalClientID1, client1.Balance, "client 1's balance mismatched.")
 
 		client2, err := connection.Query.fiatGetAccount(ctx, &fiatGetAccountParams{
 			ClientID: clientID2,
-			Currency: CurrencyCAD,
+			Currency: Currency("CAD"),
 		})
 		require.NoError(t, err, "failed to fiat account.")
 		require.Equal(t, expectedTotalClientID2, client2.Balance, "client 2's balance mismatched.")
@@ -897,7 +1141,7 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 	t.Parallel()
 
 	txDetails := FiatTransactionDetails{}
-	rowLockDecimal := decimal.Decimal{}
+	rowLockRow := fiatRowLockAccountRow{Status: AccountStatusACTIVE}
 	journalEntryRow := fiatInternalTransferJournalEntryRow{}
 	balanceUpdateRow := fiatUpdateAccountBalanceRow{}
 
@@ -984,7 +1228,12 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 			gomock.InOrder(
 				mockQuerier.EXPECT().
 					fiatRowLockAccount(gomock.Any(), gomock.Any()).
-					Return(rowLockDecimal, test.rowLockError).
+					Return(rowLockRow, test.rowLockError).
+					AnyTimes(),
+
+				mockQuerier.EXPECT().
+					fiatHoldTotal(gomock.Any(), gomock.Any()).
+					Return(decimal.Zero, nil).
 					AnyTimes(),
 
 				mockQuerier.EXPECT().
@@ -1010,3 +1259,995 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactions_CloseAccountCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		balance     decimal.Decimal
+		status      AccountStatus
+		isSuspended bool
+		expectedErr error
+	}{
+		{
+			name:        "active zero balance",
+			balance:     decimal.Zero,
+			status:      AccountStatusACTIVE,
+			isSuspended: false,
+			expectedErr: nil,
+		}, {
+			name:        "active non-zero balance",
+			balance:     decimal.NewFromFloat(0.01),
+			status:      AccountStatusACTIVE,
+			isSuspended: false,
+			expectedErr: ErrCloseAccount,
+		}, {
+			name:        "suspended",
+			balance:     decimal.Zero,
+			status:      AccountStatusACTIVE,
+			isSuspended: true,
+			expectedErr: ErrAccountStatus,
+		}, {
+			name:        "frozen debits",
+			balance:     decimal.Zero,
+			status:      AccountStatusFROZENDEBITS,
+			isSuspended: false,
+			expectedErr: ErrAccountStatus,
+		}, {
+			name:        "frozen",
+			balance:     decimal.Zero,
+			status:      AccountStatusFROZEN,
+			isSuspended: false,
+			expectedErr: ErrAccountStatus,
+		}, {
+			name:        "closed",
+			balance:     decimal.Zero,
+			status:      AccountStatusCLOSED,
+			isSuspended: false,
+			expectedErr: ErrAccountStatus,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := closeAccountCheck(test.balance, test.status, test.isSuspended)
+			if test.expectedErr == nil {
+				require.NoError(t, err, "unexpected error.")
+
+				return
+			}
+
+			require.ErrorIs(t, err, test.expectedErr, "error mismatch.")
+		})
+	}
+}
+
+func TestTransactions_FiatCloseAccount(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	usdAccount := FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(100)}
+	cadAccount := FiatTransactionDetails{ClientID: clientID1, Currency: "CAD", Amount: decimal.NewFromFloat(135.55)}
+	emptyAccount := FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.Zero}
+
+	// Fund the USD account.
+	_, err := connection.FiatExternalTransfer(ctx, &usdAccount)
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	// Accounts with a balance cannot be closed without a sweep.
+	_, _, err = connection.FiatCloseAccount(ctx, &emptyAccount, nil)
+	require.ErrorIs(t, err, ErrCloseAccount, "closed account with a non-zero balance.")
+
+	// The sweep must clear the balance.
+	_, _, err = connection.FiatCloseAccount(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(99.99)}, &cadAccount)
+	require.ErrorIs(t, err, ErrCloseAccount, "closed account with a partial sweep.")
+
+	// Sweep the balance and close.
+	srcReceipt, dstReceipt, err := connection.FiatCloseAccount(ctx, &usdAccount, &cadAccount)
+	require.NoError(t, err, "failed to sweep and close account.")
+	require.True(t, srcReceipt.Balance.IsZero(), "source balance not swept.")
+	require.True(t, dstReceipt.Balance.Equal(cadAccount.Amount), "destination balance mismatch.")
+
+	account, err := connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve closed account.")
+	require.Equal(t, AccountStatusCLOSED, account.Status, "account not closed.")
+
+	// Closed accounts are not listed.
+	accounts, err := connection.FiatBalancePaginated(clientID1, "AAA", 10)
+	require.NoError(t, err, "failed to retrieve accounts.")
+	require.Len(t, accounts, 2, "closed account listed.")
+
+	// Closed accounts cannot be closed again or deposited into.
+	_, _, err = connection.FiatCloseAccount(ctx, &emptyAccount, nil)
+	require.ErrorIs(t, err, ErrAccountStatus, "closed account twice.")
+
+	_, err = connection.FiatExternalTransfer(ctx, &usdAccount)
+	require.ErrorIs(t, err, ErrAccountStatus, "deposited into closed account.")
+
+	// Reopen the account.
+	require.NoError(t, connection.FiatCreateAccount(clientID1, "USD"), "failed to reopen account.")
+	require.ErrorIs(t, connection.FiatCreateAccount(clientID1, "USD"), ErrCreateFiat, "reopened active account.")
+
+	account, err = connection.FiatBalance(clientID1, "USD")
+	require.NoError(t, err, "failed to retrieve reopened account.")
+	require.Equal(t, AccountStatusACTIVE, account.Status, "account not reopened.")
+	require.True(t, account.Balance.IsZero(), "reopened account balance mismatch.")
+
+	// Close a zero balance account without a sweep.
+	_, _, err = connection.FiatCloseAccount(ctx, &emptyAccount, nil)
+	require.NoError(t, err, "failed to close zero balance account.")
+}
+
+func TestTransactions_FiatAccountClose_Mock(t *testing.T) {
+	t.Parallel()
+
+	txDetails := FiatTransactionDetails{}
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		rowLockRow    fiatRowLockAccountRow
+		rowLockErr    error
+		closeRows     int64
+		closeErr      error
+		closeTimes    int
+		expectedError error
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			rowLockRow:    fiatRowLockAccountRow{},
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: nil,
+		}, {
+			name:          "Account status failure.",
+			expectErr:     require.Error,
+			rowLockRow:    fiatRowLockAccountRow{Status: AccountStatusFROZEN},
+			rowLockErr:    nil,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: ErrAccountStatus,
+		}, {
+			name:          "Non-zero balance.",
+			expectErr:     require.Error,
+			rowLockRow:    fiatRowLockAccountRow{Status: AccountStatusACTIVE, Balance: decimal.NewFromFloat(1)},
+			rowLockErr:    nil,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: ErrCloseAccount,
+		}, {
+			name:          "Close failure.",
+			expectErr:     require.Error,
+			rowLockRow:    fiatRowLockAccountRow{Status: AccountStatusACTIVE},
+			rowLockErr:    nil,
+			closeRows:     0,
+			closeErr:      fmt.Errorf("close failure"),
+			closeTimes:    1,
+			expectedError: nil,
+		}, {
+			name:          "No rows closed.",
+			expectErr:     require.Error,
+			rowLockRow:    fiatRowLockAccountRow{Status: AccountStatusACTIVE},
+			rowLockErr:    nil,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    1,
+			expectedError: nil,
+		}, {
+			name:          "Valid.",
+			expectErr:     require.NoError,
+			rowLockRow:    fiatRowLockAccountRow{Status: AccountStatusACTIVE},
+			rowLockErr:    nil,
+			closeRows:     1,
+			closeErr:      nil,
+			closeTimes:    1,
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(test.rowLockRow, test.rowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					fiatCloseAccount(gomock.Any(), gomock.Any()).
+					Return(test.closeRows, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Check for error.
+			srcReceipt, dstReceipt, err := fiatAccountClose(context.TODO(), zapLogger, mockQuerier, &txDetails,
+				nil)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Nil(t, srcReceipt, "source receipt returned without sweep.")
+			require.Nil(t, dstReceipt, "destination receipt returned without sweep.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
+
+func TestTransactions_CryptoCloseAccount(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	fiatAmount := decimal.NewFromFloat(1000)
+	cryptoAmount := decimal.NewFromFloat(1)
+
+	// Fund the USD account and purchase BTC.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: fiatAmount})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.NoError(t, err, "failed to purchase BTC.")
+
+	// Accounts with a balance cannot be closed without a sweep.
+	_, _, err = connection.CryptoCloseAccount(clientID1, "", decimal.Zero, "BTC", decimal.Zero)
+	require.ErrorIs(t, err, ErrCloseAccount, "closed account with a non-zero balance.")
+
+	// Sweep the balance and close.
+	fiatReceipt, cryptoReceipt, err := connection.CryptoCloseAccount(clientID1, "USD", fiatAmount, "BTC", cryptoAmount)
+	require.NoError(t, err, "failed to sweep and close account.")
+	require.True(t, fiatReceipt.Amount.Equal(fiatAmount), "Fiat receipt amount mismatch.")
+	require.True(t, cryptoReceipt.Amount.Equal(cryptoAmount.Neg()), "Crypto receipt amount mismatch.")
+
+	account, err := connection.CryptoBalance(clientID1, "BTC")
+	require.NoError(t, err, "failed to retrieve closed account.")
+	require.Equal(t, AccountStatusCLOSED, account.Status, "account not closed.")
+
+	// Closed accounts are not listed.
+	accounts, err := connection.CryptoBalancesPaginated(clientID1, "", 10)
+	require.NoError(t, err, "failed to retrieve accounts.")
+	require.Len(t, accounts, 2, "closed account listed.")
+
+	// Closed accounts cannot be purchased into.
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", fiatAmount, "BTC", cryptoAmount, "")
+	require.ErrorIs(t, err, ErrAccountStatus, "purchased into closed account.")
+
+	// Reopen the account.
+	require.NoError(t, connection.CryptoCreateAccount(clientID1, "BTC"), "failed to reopen account.")
+
+	account, err = connection.CryptoBalance(clientID1, "BTC")
+	require.NoError(t, err, "failed to retrieve reopened account.")
+	require.Equal(t, AccountStatusACTIVE, account.Status, "account not reopened.")
+
+	// Close a zero balance account without a sweep.
+	_, _, err = connection.CryptoCloseAccount(clientID1, "", decimal.Zero, "ETH", decimal.Zero)
+	require.NoError(t, err, "failed to close zero balance account.")
+}
+
+func TestTransactions_CryptoAccountClose_Mock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		sweepAmount   decimal.Decimal
+		sweepErr      error
+		sweepTimes    int
+		rowLockRow    cryptoRowLockAccountRow
+		rowLockErr    error
+		rowLockTimes  int
+		closeRows     int64
+		closeErr      error
+		closeTimes    int
+		expectedError error
+	}{
+		{
+			name:          "Sweep failure.",
+			expectErr:     require.Error,
+			sweepAmount:   decimal.NewFromFloat(1),
+			sweepErr:      fmt.Errorf("sweep failure"),
+			sweepTimes:    1,
+			rowLockRow:    cryptoRowLockAccountRow{},
+			rowLockErr:    nil,
+			rowLockTimes:  0,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: nil,
+		}, {
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			sweepAmount:   decimal.Zero,
+			sweepErr:      nil,
+			sweepTimes:    0,
+			rowLockRow:    cryptoRowLockAccountRow{},
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			rowLockTimes:  1,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: nil,
+		}, {
+			name:          "Suspended client.",
+			expectErr:     require.Error,
+			sweepAmount:   decimal.Zero,
+			sweepErr:      nil,
+			sweepTimes:    0,
+			rowLockRow:    cryptoRowLockAccountRow{Status: AccountStatusACTIVE, IsFrozen: true},
+			rowLockErr:    nil,
+			rowLockTimes:  1,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: ErrAccountStatus,
+		}, {
+			name:          "Non-zero balance.",
+			expectErr:     require.Error,
+			sweepAmount:   decimal.Zero,
+			sweepErr:      nil,
+			sweepTimes:    0,
+			rowLockRow:    cryptoRowLockAccountRow{Status: AccountStatusACTIVE, Balance: decimal.NewFromFloat(1)},
+			rowLockErr:    nil,
+			rowLockTimes:  1,
+			closeRows:     0,
+			closeErr:      nil,
+			closeTimes:    0,
+			expectedError: ErrCloseAccount,
+		}, {
+			name:          "Close failure.",
+			expectErr:     require.Error,
+			sweepAmount:   decimal.Zero,
+			sweepErr:      nil,
+			sweepTimes:    0,
+			rowLockRow:    cryptoRowLockAccountRow{Status: AccountStatusACTIVE},
+			rowLockErr:    nil,
+			rowLockTimes:  1,
+			closeRows:     0,
+			closeErr:      fmt.Errorf("close failure"),
+			closeTimes:    1,
+			expectedError: nil,
+		}, {
+			name:          "Valid without sweep.",
+			expectErr:     require.NoError,
+			sweepAmount:   decimal.Zero,
+			sweepErr:      nil,
+			sweepTimes:    0,
+			rowLockRow:    cryptoRowLockAccountRow{Status: AccountStatusACTIVE},
+			rowLockErr:    nil,
+			rowLockTimes:  1,
+			closeRows:     1,
+			closeErr:      nil,
+			closeTimes:    1,
+			expectedError: nil,
+		}, {
+			name:          "Valid with sweep.",
+			expectErr:     require.NoError,
+			sweepAmount:   decimal.NewFromFloat(1),
+			sweepErr:      nil,
+			sweepTimes:    1,
+			rowLockRow:    cryptoRowLockAccountRow{Status: AccountStatusACTIVE},
+			rowLockErr:    nil,
+			rowLockTimes:  1,
+			closeRows:     1,
+			closeErr:      nil,
+			closeTimes:    1,
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(fiatRowLockAccountRow{Status: AccountStatusACTIVE}, nil).
+					Times(test.sweepTimes),
+
+				mockQuerier.EXPECT().
+					cryptoRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(cryptoRowLockAccountRow{Status: AccountStatusACTIVE, Balance: test.sweepAmount}, nil).
+					Times(test.sweepTimes),
+
+				mockQuerier.EXPECT().
+					cryptoHoldTotal(gomock.Any(), gomock.Any()).
+					Return(decimal.Zero, nil).
+					Times(test.sweepTimes),
+
+				mockQuerier.EXPECT().
+					cryptoSell(gomock.Any(), gomock.Any()).
+					Return(test.sweepErr).
+					Times(test.sweepTimes),
+
+				mockQuerier.EXPECT().
+					cryptoRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(test.rowLockRow, test.rowLockErr).
+					Times(test.rowLockTimes),
+
+				mockQuerier.EXPECT().
+					cryptoCloseAccount(gomock.Any(), gomock.Any()).
+					Return(test.closeRows, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Check for error.
+			err := cryptoAccountClose(context.TODO(), zapLogger, mockQuerier,
+				&cryptoSellParams{CryptoDebitAmount: test.sweepAmount})
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
+
+func TestTransactions_CryptoPurchaseTransaction_Mock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		holdID        string
+		rowLockErr    error
+		releaseTimes  int
+		held          decimal.Decimal
+		heldErr       error
+		heldTimes     int
+		purchaseErr   error
+		purchaseTimes int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			holdID:        "",
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			releaseTimes:  0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     0,
+			purchaseErr:   nil,
+			purchaseTimes: 0,
+		}, {
+			name:          "Hold total failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			holdID:        "",
+			rowLockErr:    nil,
+			releaseTimes:  0,
+			held:          decimal.Zero,
+			heldErr:       fmt.Errorf("hold total failure"),
+			heldTimes:     1,
+			purchaseErr:   nil,
+			purchaseTimes: 0,
+		}, {
+			name:          "Insufficient available balance.",
+			expectErr:     require.Error,
+			expectedError: ErrInsufficientFunds,
+			holdID:        "",
+			rowLockErr:    nil,
+			releaseTimes:  0,
+			held:          decimal.NewFromFloat(50),
+			heldErr:       nil,
+			heldTimes:     1,
+			purchaseErr:   nil,
+			purchaseTimes: 0,
+		}, {
+			name:          "Purchase failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			holdID:        "",
+			rowLockErr:    nil,
+			releaseTimes:  0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     1,
+			purchaseErr:   fmt.Errorf("purchase failure"),
+			purchaseTimes: 1,
+		}, {
+			name:          "Valid.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			holdID:        "",
+			rowLockErr:    nil,
+			releaseTimes:  0,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     1,
+			purchaseErr:   nil,
+			purchaseTimes: 1,
+		}, {
+			name:          "Valid with hold.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			holdID:        "hold-id",
+			rowLockErr:    nil,
+			releaseTimes:  1,
+			held:          decimal.Zero,
+			heldErr:       nil,
+			heldTimes:     1,
+			purchaseErr:   nil,
+			purchaseTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(fiatRowLockAccountRow{Status: AccountStatusACTIVE, Balance: decimal.NewFromFloat(100)},
+						test.rowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					fiatHoldRelease(gomock.Any(), gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.releaseTimes),
+
+				mockQuerier.EXPECT().
+					fiatHoldTotal(gomock.Any(), gomock.Any()).
+					Return(test.held, test.heldErr).
+					Times(test.heldTimes),
+
+				mockQuerier.EXPECT().
+					cryptoPurchase(gomock.Any(), gomock.Any()).
+					Return(test.purchaseErr).
+					Times(test.purchaseTimes),
+			)
+
+			// Check for error.
+			err := cryptoPurchaseTransaction(context.TODO(), mockQuerier, test.holdID,
+				&cryptoPurchaseParams{FiatCurrency: "USD", FiatDebitAmount: decimal.NewFromFloat(60)})
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
+
+func TestTransactions_CryptoSellTransaction_Mock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		expectErr        require.ErrorAssertionFunc
+		expectedError    error
+		holdID           string
+		fiatRowLockErr   error
+		cryptoRowLockErr error
+		cryptoLockTimes  int
+		releaseTimes     int
+		held             decimal.Decimal
+		heldErr          error
+		heldTimes        int
+		sellErr          error
+		sellTimes        int
+	}{
+		{
+			name:             "Fiat row lock failure.",
+			expectErr:        require.Error,
+			expectedError:    nil,
+			holdID:           "",
+			fiatRowLockErr:   fmt.Errorf("row lock failure"),
+			cryptoRowLockErr: nil,
+			cryptoLockTimes:  0,
+			releaseTimes:     0,
+			held:             decimal.Zero,
+			heldErr:          nil,
+			heldTimes:        0,
+			sellErr:          nil,
+			sellTimes:        0,
+		}, {
+			name:             "Crypto row lock failure.",
+			expectErr:        require.Error,
+			expectedError:    nil,
+			holdID:           "",
+			fiatRowLockErr:   nil,
+			cryptoRowLockErr: fmt.Errorf("row lock failure"),
+			cryptoLockTimes:  1,
+			releaseTimes:     0,
+			held:             decimal.Zero,
+			heldErr:          nil,
+			heldTimes:        0,
+			sellErr:          nil,
+			sellTimes:        0,
+		}, {
+			name:             "Hold total failure.",
+			expectErr:        require.Error,
+			expectedError:    nil,
+			holdID:           "",
+			fiatRowLockErr:   nil,
+			cryptoRowLockErr: nil,
+			cryptoLockTimes:  1,
+			releaseTimes:     0,
+			held:             decimal.Zero,
+			heldErr:          fmt.Errorf("hold total failure"),
+			heldTimes:        1,
+			sellErr:          nil,
+			sellTimes:        0,
+		}, {
+			name:             "Insufficient available balance.",
+			expectErr:        require.Error,
+			expectedError:    ErrInsufficientFunds,
+			holdID:           "",
+			fiatRowLockErr:   nil,
+			cryptoRowLockErr: nil,
+			cryptoLockTimes:  1,
+			releaseTimes:     0,
+			held:             decimal.NewFromFloat(0.5),
+			heldErr:          nil,
+			heldTimes:        1,
+			sellErr:          nil,
+			sellTimes:        0,
+		}, {
+			name:             "Sell failure.",
+			expectErr:        require.Error,
+			expectedError:    nil,
+			holdID:           "",
+			fiatRowLockErr:   nil,
+			cryptoRowLockErr: nil,
+			cryptoLockTimes:  1,
+			releaseTimes:     0,
+			held:             decimal.Zero,
+			heldErr:          nil,
+			heldTimes:        1,
+			sellErr:          fmt.Errorf("sell failure"),
+			sellTimes:        1,
+		}, {
+			name:             "Valid.",
+			expectErr:        require.NoError,
+			expectedError:    nil,
+			holdID:           "",
+			fiatRowLockErr:   nil,
+			cryptoRowLockErr: nil,
+			cryptoLockTimes:  1,
+			releaseTimes:     0,
+			held:             decimal.Zero,
+			heldErr:          nil,
+			heldTimes:        1,
+			sellErr:          nil,
+			sellTimes:        1,
+		}, {
+			name:             "Valid with hold.",
+			expectErr:        require.NoError,
+			expectedError:    nil,
+			holdID:           "hold-id",
+			fiatRowLockErr:   nil,
+			cryptoRowLockErr: nil,
+			cryptoLockTimes:  1,
+			releaseTimes:     1,
+			held:             decimal.Zero,
+			heldErr:          nil,
+			heldTimes:        1,
+			sellErr:          nil,
+			sellTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(fiatRowLockAccountRow{Status: AccountStatusACTIVE}, test.fiatRowLockErr).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					cryptoRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(cryptoRowLockAccountRow{Status: AccountStatusACTIVE, Balance: decimal.NewFromFloat(1)},
+						test.cryptoRowLockErr).
+					Times(test.cryptoLockTimes),
+
+				mockQuerier.EXPECT().
+					cryptoHoldRelease(gomock.Any(), gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.releaseTimes),
+
+				mockQuerier.EXPECT().
+					cryptoHoldTotal(gomock.Any(), gomock.Any()).
+					Return(test.held, test.heldErr).
+					Times(test.heldTimes),
+
+				mockQuerier.EXPECT().
+					cryptoSell(gomock.Any(), gomock.Any()).
+					Return(test.sellErr).
+					Times(test.sellTimes),
+			)
+
+			// Check for error.
+			err := cryptoSellTransaction(context.TODO(), mockQuerier, test.holdID,
+				&cryptoSellParams{CryptoTicker: "BTC", CryptoDebitAmount: decimal.NewFromFloat(0.6)})
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
+
+func TestTransactions_DoubleEntryInvariants(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Fund the USD account and purchase a BTC position.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", decimal.NewFromFloat(100), "BTC",
+		decimal.NewFromFloat(1), "")
+	require.NoError(t, err, "failed to purchase BTC.")
+
+	// Internal transfers across currencies must balance in each currency.
+	srcReceipt, _, err := connection.FiatInternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(100)},
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "CAD", Amount: decimal.NewFromFloat(135.79)})
+	require.NoError(t, err, "failed to exchange USD to CAD.")
+
+	var currencies, unbalanced int
+
+	require.NoError(t, connection.pool.QueryRow(ctx,
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE total <> 0)
+		FROM (SELECT SUM(amount) AS total FROM fiat_journal WHERE tx_id = $1 GROUP BY currency) AS currencies`,
+		srcReceipt.TxID).Scan(&currencies, &unbalanced), "failed to retrieve internal transfer entries.")
+	require.Equal(t, 2, currencies, "internal transfer currencies mismatch.")
+	require.Equal(t, 0, unbalanced, "internal transfer entries do not balance.")
+
+	// Statements that post journal entries and update balances directly, bypassing the transaction logic.
+	fiatEntry := `
+		INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+		VALUES ($1, $2, $3, now(), $4);`
+	cryptoEntry := `
+		INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+		VALUES ($1, $2, $3, now(), $4);`
+	fiatBalance := `
+		UPDATE fiat_accounts
+		SET balance = balance + $3, last_tx = $4, last_tx_ts = now()
+		WHERE client_id = $1 AND currency = $2;`
+	cryptoBalance := `
+		UPDATE crypto_accounts
+		SET balance = balance + $3, last_tx = $4, last_tx_ts = now()
+		WHERE client_id = $1 AND ticker = $2;`
+
+	ftexFiatID, err := connection.Query.userGetClientId(ctx, constants.SpecialAccountFiat())
+	require.NoError(t, err, "failed to retrieve FTeX Fiat operations account.")
+	ftexCryptoID, err := connection.Query.userGetClientId(ctx, constants.SpecialAccountCrypto())
+	require.NoError(t, err, "failed to retrieve FTeX Crypto operations account.")
+
+	type statement struct {
+		query string
+		args  []any
+	}
+
+	txID := func() uuid.UUID {
+		id, err := uuid.NewV4()
+		require.NoError(t, err, "failed to generate transaction ID.")
+
+		return id
+	}
+
+	txBalanced, txSingle, txUnbalanced, txCurrencies := txID(), txID(), txID(), txID()
+	txCryptoBalanced, txCryptoSingle, txCryptoUnbalanced := txID(), txID(), txID()
+	txBacked, txMismatched := txID(), txID()
+
+	testCases := []struct {
+		name       string
+		statements []statement
+		expectErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "balanced Fiat entries",
+			statements: []statement{
+				{query: fiatEntry, args: []any{clientID1, "USD", decimal.NewFromFloat(10), txBalanced}},
+				{query: fiatEntry, args: []any{ftexFiatID, "USD", decimal.NewFromFloat(-10), txBalanced}},
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "single-legged Fiat entry",
+			statements: []statement{
+				{query: fiatEntry, args: []any{clientID1, "USD", decimal.NewFromFloat(10), txSingle}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "unbalanced Fiat entries",
+			statements: []statement{
+				{query: fiatEntry, args: []any{clientID1, "USD", decimal.NewFromFloat(10), txUnbalanced}},
+				{query: fiatEntry, args: []any{ftexFiatID, "USD", decimal.NewFromFloat(-9.99), txUnbalanced}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "unbalanced Fiat currencies",
+			statements: []statement{
+				{query: fiatEntry, args: []any{clientID1, "USD", decimal.NewFromFloat(-10), txCurrencies}},
+				{query: fiatEntry, args: []any{clientID1, "CAD", decimal.NewFromFloat(13.58), txCurrencies}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "balanced Crypto entries",
+			statements: []statement{
+				{query: cryptoEntry, args: []any{clientID1, "BTC", decimal.NewFromFloat(0.5), txCryptoBalanced}},
+				{query: cryptoEntry, args: []any{ftexCryptoID, "BTC", decimal.NewFromFloat(-0.5), txCryptoBalanced}},
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "single-legged Crypto entry",
+			statements: []statement{
+				{query: cryptoEntry, args: []any{clientID1, "BTC", decimal.NewFromFloat(0.5), txCryptoSingle}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "unbalanced Crypto entries",
+			statements: []statement{
+				{query: cryptoEntry, args: []any{clientID1, "BTC", decimal.NewFromFloat(0.5), txCryptoUnbalanced}},
+				{query: cryptoEntry, args: []any{ftexCryptoID, "BTC", decimal.NewFromFloat(-0.4), txCryptoUnbalanced}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "Fiat balance backed by journal entries",
+			statements: []statement{
+				{query: fiatBalance, args: []any{clientID1, "USD", decimal.NewFromFloat(10), decimal.NewFromFloat(10)}},
+				{query: fiatEntry, args: []any{clientID1, "USD", decimal.NewFromFloat(10), txBacked}},
+				{query: fiatEntry, args: []any{ftexFiatID, "USD", decimal.NewFromFloat(-10), txBacked}},
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "Fiat balance without journal entries",
+			statements: []statement{
+				{query: fiatBalance, args: []any{clientID1, "USD", decimal.NewFromFloat(10), decimal.NewFromFloat(10)}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "Fiat balance mismatched with journal entries",
+			statements: []statement{
+				{query: fiatBalance, args: []any{clientID1, "USD", decimal.NewFromFloat(20), decimal.NewFromFloat(10)}},
+				{query: fiatEntry, args: []any{clientID1, "USD", decimal.NewFromFloat(10), txMismatched}},
+				{query: fiatEntry, args: []any{ftexFiatID, "USD", decimal.NewFromFloat(-10), txMismatched}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "Crypto balance without journal entries",
+			statements: []statement{
+				{query: cryptoBalance, args: []any{clientID1, "BTC", decimal.NewFromFloat(1), decimal.NewFromFloat(1)}},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "Fiat account opened with a balance",
+			statements: []statement{
+				{
+					query: "INSERT INTO fiat_accounts (client_id, currency, balance) VALUES ($1, $2, $3);",
+					args:  []any{clientID1, "EUR", decimal.NewFromFloat(100)},
+				},
+			},
+			expectErr: require.Error,
+		}, {
+			name: "Crypto account opened with a balance",
+			statements: []statement{
+				{
+					query: "INSERT INTO crypto_accounts (client_id, ticker, balance) VALUES ($1, $2, $3);",
+					args:  []any{clientID1, "SOL", decimal.NewFromFloat(100)},
+				},
+			},
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			tx, err := connection.pool.Begin(ctx)
+			require.NoError(t, err, "failed to start transaction.")
+
+			defer tx.Rollback(ctx) //nolint:errcheck
+
+			// The invariants are deferred, so each of the statements succeeds until the transaction is committed.
+			for _, stmt := range test.statements {
+				_, err = tx.Exec(ctx, stmt.query, stmt.args...)
+				require.NoError(t, err, "failed to execute statement.")
+			}
+
+			err = tx.Commit(ctx)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				var pgErr *pgconn.PgError
+				require.ErrorAs(t, err, &pgErr, "error is not a Postgres error.")
+				require.Equal(t, "23514", pgErr.Code, "error is not a check violation.")
+			}
+		})
+	}
+}
@@ -0,0 +1,495 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// TriggerOrderCreate controls the transaction block that the placement of a Cryptocurrency stop-loss or take-profit
+// trigger order executes in.
+func (p *postgresImpl) TriggerOrderCreate(order *CryptoTriggerOrder) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("trigger order placement transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback trigger order placement transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to trigger order placement core logic.
+	if err = triggerOrderPlace(ctx, p.logger, p.queries.WithTx(tx), order); err != nil {
+		p.logger.Warn("failed to place trigger order", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit trigger order placement", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// triggerOrderPlace will execute the logic to place a Cryptocurrency trigger order. No funds are held for trigger
+// orders; the position is sold from the available balance of the Crypto account when the order is triggered.
+/*
+   [1] Create the order.
+   [2] Record the placement of the order in its state history.
+*/
+func triggerOrderPlace(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	order *CryptoTriggerOrder) error {
+	var (
+		err          error
+		rowsAffected int64
+	)
+
+	// Create the order.
+	if rowsAffected, err = queryTx.triggerOrderCreate(ctx, &triggerOrderCreateParams{
+		OrderID:          order.OrderID,
+		ClientID:         order.ClientID,
+		FiatCurrency:     order.FiatCurrency,
+		Ticker:           order.Ticker,
+		TriggerType:      order.TriggerType,
+		TriggerPrice:     order.TriggerPrice,
+		TrailingDistance: order.TrailingDistance,
+		Amount:           order.Amount,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to create trigger order"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	// Record the order state.
+	if rowsAffected, err = queryTx.triggerOrderEventCreate(ctx, &triggerOrderEventCreateParams{
+		OrderID: order.OrderID,
+		Status:  TriggerOrderStatusACTIVE,
+		Price:   order.TriggerPrice,
+		Amount:  order.Amount,
+		Details: "order placed",
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to record trigger order placement"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// TriggerOrderClose controls the transaction block that the closure of an active Cryptocurrency trigger order without
+// execution executes in.
+func (p *postgresImpl) TriggerOrderClose(
+	clientID uuid.UUID,
+	orderID string,
+	status TriggerOrderStatus,
+	details string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err error
+		tx  pgx.Tx
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("trigger order closure transaction block setup failed", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback trigger order closure transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to trigger order closure core logic.
+	if err = triggerOrderClose(ctx, p.logger, p.queries.WithTx(tx), clientID, orderID, status, details); err != nil {
+		p.logger.Warn("failed to close trigger order", zap.Error(err))
+
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return ErrNotFound
+		case errors.Is(err, ErrTriggerOrderClosed):
+			return ErrTriggerOrderClosed
+		default:
+			return ErrTransactCrypto
+		}
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit trigger order closure", zap.Error(err))
+
+		return ErrTransactCrypto
+	}
+
+	return nil
+}
+
+// triggerOrderClose will execute the logic to close an active Cryptocurrency trigger order without executing it.
+/*
+   [1] Acquire a row lock on the order without holding a lock on the foreign keys.
+   [2] Verify that the order is still active.
+   [3] Close the order with the supplied status and record the change in its state history.
+*/
+func triggerOrderClose(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	clientID uuid.UUID,
+	orderID string,
+	status TriggerOrderStatus,
+	details string) error {
+	order, err := queryTx.triggerOrderRowLock(ctx, &triggerOrderRowLockParams{
+		ClientID: clientID,
+		OrderID:  orderID,
+	})
+	if err != nil {
+		msg := "failed to get row lock on trigger order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, ErrNotFound)
+	}
+
+	if order.Status != TriggerOrderStatusACTIVE {
+		return fmt.Errorf("trigger order is %s %w", order.Status, ErrTriggerOrderClosed)
+	}
+
+	return triggerOrderSetStatus(ctx, logger, queryTx, &order, status, pgtype.UUID{}, decimal.Zero, decimal.Zero,
+		details)
+}
+
+// TriggerOrderExecute controls the transaction block that the sale of the position covered by an active Cryptocurrency
+// trigger order executes in.
+func (p *postgresImpl) TriggerOrderExecute(order *CryptoTriggerOrder, price decimal.Decimal) (
+	*FiatJournal, *CryptoJournal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	var tx pgx.Tx
+
+	txID, err := uuid.NewV4()
+	if err != nil {
+		p.logger.Error("failed to generate transaction id for trigger order", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("trigger order execution transaction block setup failed", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(context.TODO()); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback trigger order execution transaction", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Handoff to trigger order execution core logic.
+	if err = triggerOrderExecute(ctx, p.logger, p.queries.WithTx(tx), txID, order, price); err != nil {
+		p.logger.Warn("failed to execute trigger order", zap.Error(err))
+
+		if errors.Is(err, ErrTriggerOrderClosed) {
+			return nil, nil, ErrTriggerOrderClosed
+		}
+
+		return nil, nil, cryptoTransactionErrorMapping(err)
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit trigger order execution", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	fiatJournal, err := p.Query.fiatGetJournalTransaction(ctx, &fiatGetJournalTransactionParams{
+		ClientID: order.ClientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Fiat transaction details post trigger order execution", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	cryptoJournal, err := p.Query.cryptoGetJournalTransaction(ctx, &cryptoGetJournalTransactionParams{
+		ClientID: order.ClientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Crypto transaction details post trigger order execution", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	return &fiatJournal[0], &cryptoJournal[0], nil
+}
+
+// triggerOrderExecute will execute the logic to sell the position covered by an active Cryptocurrency trigger order.
+/*
+   [1] Acquire a row lock on the order without holding a lock on the foreign keys.
+   [2] Verify that the order is still active.
+   [3] Acquire row locks on the Fiat and then Crypto accounts, matching the lock order of the sale stored procedure.
+   [4] Size the sale: the order amount, or the entire position if none was set, capped at the available balance.
+   [5] Execute the Cryptocurrency sale at the trigger price.
+   [6] Close the order as triggered with the transaction ID and record the sale in its state history.
+*/
+func triggerOrderExecute(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	txID uuid.UUID,
+	order *CryptoTriggerOrder,
+	price decimal.Decimal) error {
+	var (
+		err       error
+		held      decimal.Decimal
+		lockRow   CryptoTriggerOrder
+		cryptoRow cryptoRowLockAccountRow
+	)
+
+	if lockRow, err = queryTx.triggerOrderRowLock(ctx, &triggerOrderRowLockParams{
+		ClientID: order.ClientID,
+		OrderID:  order.OrderID,
+	}); err != nil {
+		msg := "failed to get row lock on trigger order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if lockRow.Status != TriggerOrderStatusACTIVE {
+		return fmt.Errorf("trigger order is %s %w", lockRow.Status, ErrTriggerOrderClosed)
+	}
+
+	// Size the sale from the available balance of the row locked Crypto account.
+	if _, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: lockRow.ClientID,
+		Currency: lockRow.FiatCurrency,
+	}); err != nil {
+		return fmt.Errorf("failed to get row lock on Fiat account %w", err)
+	}
+
+	if cryptoRow, err = queryTx.cryptoRowLockAccount(ctx, &cryptoRowLockAccountParams{
+		ClientID: lockRow.ClientID,
+		Ticker:   lockRow.Ticker,
+	}); err != nil {
+		return fmt.Errorf("failed to get row lock on Crypto account %w", err)
+	}
+
+	if held, err = queryTx.cryptoHoldTotal(ctx, &cryptoHoldTotalParams{
+		ClientID: lockRow.ClientID,
+		Ticker:   lockRow.Ticker,
+	}); err != nil {
+		return fmt.Errorf("failed to retrieve holds on Crypto account %w", err)
+	}
+
+	amount := cryptoRow.Balance.Sub(held)
+	if lockRow.Amount.IsPositive() && lockRow.Amount.LessThan(amount) {
+		amount = lockRow.Amount
+	}
+
+	if !amount.IsPositive() {
+		return fmt.Errorf("no available balance to sell %w", ErrInsufficientFunds)
+	}
+
+	// Execute the trade.
+	if err = cryptoSellTransaction(ctx, queryTx, "", &cryptoSellParams{
+		TransactionID:     txID,
+		ClientID:          lockRow.ClientID,
+		FiatCurrency:      lockRow.FiatCurrency,
+		CryptoTicker:      lockRow.Ticker,
+		FiatCreditAmount:  amount.Mul(price).RoundBank(constants.DecimalPlacesFiat()),
+		CryptoDebitAmount: amount,
+	}); err != nil {
+		msg := "failed to execute trigger order"
+		logger.Warn(msg, zap.Error(err))
+
+		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	details := "take-profit triggered"
+	if lockRow.TriggerType == TriggerOrderTypeSTOPLOSS {
+		details = "stop-loss triggered"
+	}
+
+	return triggerOrderSetStatus(ctx, logger, queryTx, &lockRow, TriggerOrderStatusTRIGGERED,
+		pgtype.UUID{Bytes: txID, Valid: true}, price, amount, details)
+}
+
+// triggerOrderSetStatus will close a row locked active trigger order with a final status and record the change in its
+// state history.
+func triggerOrderSetStatus(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	order *CryptoTriggerOrder,
+	status TriggerOrderStatus,
+	txID pgtype.UUID,
+	price decimal.Decimal,
+	amount decimal.Decimal,
+	details string) error {
+	var (
+		err          error
+		rowsAffected int64
+	)
+
+	if rowsAffected, err = queryTx.triggerOrderUpdateStatus(ctx, &triggerOrderUpdateStatusParams{
+		ClientID: order.ClientID,
+		OrderID:  order.OrderID,
+		Status:   status,
+		TxID:     txID,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to update trigger order status"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	if rowsAffected, err = queryTx.triggerOrderEventCreate(ctx, &triggerOrderEventCreateParams{
+		OrderID: order.OrderID,
+		Status:  status,
+		Price:   price,
+		Amount:  amount,
+		Details: details,
+	}); err != nil || rowsAffected != int64(1) {
+		msg := "failed to record trigger order status"
+		logger.Warn(msg, zap.Error(err))
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// TriggerOrderTrail is the interface through which external methods can raise the trigger price of an active trailing
+// stop-loss order. It returns whether the trigger price was raised.
+func (p *postgresImpl) TriggerOrderTrail(order *CryptoTriggerOrder, triggerPrice decimal.Decimal) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.triggerOrderTrail(ctx, &triggerOrderTrailParams{
+		ClientID:     order.ClientID,
+		OrderID:      order.OrderID,
+		TriggerPrice: triggerPrice,
+	})
+	if err != nil {
+		p.logger.Warn("failed to raise trailing stop-loss trigger price", zap.Error(err))
+
+		return false, ErrTransactCrypto
+	}
+
+	return rowsAffected == int64(1), nil
+}
+
+// TriggerOrderGet is the interface through which external methods can retrieve a client's trigger order along with its
+// state history.
+func (p *postgresImpl) TriggerOrderGet(clientID uuid.UUID, orderID string) (
+	CryptoTriggerOrder, []CryptoTriggerOrderEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	order, err := p.Query.triggerOrderGet(ctx, &triggerOrderGetParams{ClientID: clientID, OrderID: orderID})
+	if err != nil {
+		return CryptoTriggerOrder{}, nil, ErrNotFound
+	}
+
+	events, err := p.Query.triggerOrderGetEvents(ctx, orderID)
+	if err != nil {
+		p.logger.Error("failed to retrieve trigger order state history", zap.Error(err))
+
+		return CryptoTriggerOrder{}, nil, ErrNotFound
+	}
+
+	return order, events, nil
+}
+
+// TriggerOrdersPaginated is the interface through which external methods can retrieve a page of a client's trigger
+// orders, newest first, starting from an order id.
+func (p *postgresImpl) TriggerOrdersPaginated(clientID uuid.UUID, startID string, status string, pageSize int32) (
+	[]CryptoTriggerOrder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	orders, err := p.Query.triggerOrderGetPaginated(ctx, &triggerOrderGetPaginatedParams{
+		ClientID: clientID,
+		Limit:    pageSize,
+		StartID:  startID,
+		Status:   status,
+	})
+	if err != nil {
+		return []CryptoTriggerOrder{}, ErrNotFound
+	}
+
+	return orders, nil
+}
+
+// TriggerOrdersActive is the interface through which external methods can retrieve a batch of active trigger orders,
+// oldest first, after an order id.
+func (p *postgresImpl) TriggerOrdersActive(afterID string, batchSize int32) ([]CryptoTriggerOrder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	orders, err := p.Query.triggerOrderGetActive(ctx, &triggerOrderGetActiveParams{Limit: batchSize, AfterID: afterID})
+	if err != nil {
+		p.logger.Error("failed to retrieve active trigger orders", zap.Error(err))
+
+		return []CryptoTriggerOrder{}, ErrNotFound
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggers_TriggerOrders(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat and Crypto accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Fund the USD account and purchase a BTC position.
+	_, err := connection.FiatExternalTransfer(ctx,
+		&FiatTransactionDetails{ClientID: clientID1, Currency: "USD", Amount: decimal.NewFromFloat(1000)})
+	require.NoError(t, err, "failed to deposit into USD account.")
+
+	_, _, err = connection.CryptoPurchase(clientID1, "USD", decimal.NewFromFloat(1000), "BTC",
+		decimal.NewFromFloat(1), "")
+	require.NoError(t, err, "failed to purchase BTC position.")
+
+	stopLoss := CryptoTriggerOrder{
+		OrderID:          "trigger-1",
+		ClientID:         clientID1,
+		FiatCurrency:     "USD",
+		Ticker:           "BTC",
+		TriggerType:      TriggerOrderTypeSTOPLOSS,
+		TriggerPrice:     decimal.NewFromFloat(900),
+		TrailingDistance: decimal.NewFromFloat(100),
+		Amount:           decimal.NewFromFloat(0.25),
+	}
+	takeProfit := CryptoTriggerOrder{
+		OrderID:      "trigger-2",
+		ClientID:     clientID1,
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		TriggerType:  TriggerOrderTypeTAKEPROFIT,
+		TriggerPrice: decimal.NewFromFloat(1500),
+	}
+
+	require.NoError(t, connection.TriggerOrderCreate(&stopLoss), "failed to place stop-loss order.")
+	require.NoError(t, connection.TriggerOrderCreate(&takeProfit), "failed to place take-profit order.")
+
+	// Trailing stop-loss trigger prices only ever move up.
+	raised, err := connection.TriggerOrderTrail(&stopLoss, decimal.NewFromFloat(950))
+	require.NoError(t, err, "failed to raise trigger price.")
+	require.True(t, raised, "trigger price not raised.")
+
+	raised, err = connection.TriggerOrderTrail(&stopLoss, decimal.NewFromFloat(920))
+	require.NoError(t, err, "failed to attempt lowering trigger price.")
+	require.False(t, raised, "trigger price lowered.")
+
+	raised, err = connection.TriggerOrderTrail(&takeProfit, decimal.NewFromFloat(2000))
+	require.NoError(t, err, "failed to attempt trailing take-profit order.")
+	require.False(t, raised, "take-profit trigger price trailed.")
+
+	// Execute the partial stop-loss.
+	fiatReceipt, cryptoReceipt, err := connection.TriggerOrderExecute(&stopLoss, decimal.NewFromFloat(940))
+	require.NoError(t, err, "failed to execute stop-loss order.")
+	require.True(t, fiatReceipt.Amount.Equal(decimal.NewFromFloat(235)), "Fiat credit mismatch.")
+	require.True(t, cryptoReceipt.Amount.Equal(decimal.NewFromFloat(-0.25)), "Crypto debit mismatch.")
+
+	_, _, err = connection.TriggerOrderExecute(&stopLoss, decimal.NewFromFloat(940))
+	require.ErrorIs(t, err, ErrTriggerOrderClosed, "triggered order executed again.")
+	require.ErrorIs(t, connection.TriggerOrderClose(clientID1, stopLoss.OrderID, TriggerOrderStatusCANCELLED, "cancel"),
+		ErrTriggerOrderClosed, "triggered order cancelled.")
+
+	// Execute the take-profit for the entire remaining position.
+	_, cryptoReceipt, err = connection.TriggerOrderExecute(&takeProfit, decimal.NewFromFloat(1600))
+	require.NoError(t, err, "failed to execute take-profit order.")
+	require.True(t, cryptoReceipt.Amount.Equal(decimal.NewFromFloat(-0.75)), "entire position not sold.")
+
+	// Orders on an empty position cannot be executed.
+	takeProfit.OrderID = "trigger-3"
+	require.NoError(t, connection.TriggerOrderCreate(&takeProfit), "failed to place take-profit order.")
+
+	_, _, err = connection.TriggerOrderExecute(&takeProfit, decimal.NewFromFloat(1600))
+	require.ErrorIs(t, err, ErrInsufficientFunds, "executed order on empty position.")
+
+	require.ErrorIs(t, connection.TriggerOrderClose(clientID2, takeProfit.OrderID, TriggerOrderStatusCANCELLED,
+		"cancel"), ErrNotFound, "cancelled another client's order.")
+	require.NoError(t, connection.TriggerOrderClose(clientID1, takeProfit.OrderID, TriggerOrderStatusCANCELLED,
+		"cancel"), "failed to cancel take-profit order.")
+
+	// Check the order state history.
+	order, events, err := connection.TriggerOrderGet(clientID1, stopLoss.OrderID)
+	require.NoError(t, err, "failed to retrieve stop-loss order.")
+	require.Equal(t, TriggerOrderStatusTRIGGERED, order.Status, "order status mismatch.")
+	require.True(t, order.TxID.Valid, "triggered order missing transaction id.")
+	require.True(t, order.TriggerPrice.Equal(decimal.NewFromFloat(950)), "trailed trigger price mismatch.")
+	require.Len(t, events, 2, "order history length mismatch.")
+	require.Equal(t, TriggerOrderStatusTRIGGERED, events[1].Status, "order history trigger mismatch.")
+	require.True(t, events[1].Amount.Equal(stopLoss.Amount), "order history amount mismatch.")
+
+	_, _, err = connection.TriggerOrderGet(clientID2, stopLoss.OrderID)
+	require.ErrorIs(t, err, ErrNotFound, "retrieved another client's order.")
+
+	// Paginate and filter the client's orders.
+	orders, err := connection.TriggerOrdersPaginated(clientID1, "", "", 10)
+	require.NoError(t, err, "failed to retrieve orders.")
+	require.Len(t, orders, 3, "order count mismatch.")
+	require.Equal(t, takeProfit.OrderID, orders[0].OrderID, "orders not newest first.")
+
+	orders, err = connection.TriggerOrdersPaginated(clientID1, "", string(TriggerOrderStatusTRIGGERED), 10)
+	require.NoError(t, err, "failed to retrieve triggered orders.")
+	require.Len(t, orders, 2, "triggered order count mismatch.")
+
+	orders, err = connection.TriggerOrdersActive("", 10)
+	require.NoError(t, err, "failed to retrieve active orders.")
+	require.Empty(t, orders, "closed orders are active.")
+}
+
+func TestTriggers_TriggerOrderExecute_Mock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		expectErr     require.ErrorAssertionFunc
+		expectedError error
+		rowLockRow    CryptoTriggerOrder
+		rowLockErr    error
+		fiatLockTimes int
+		balance       decimal.Decimal
+		held          decimal.Decimal
+		holdTimes     int
+		saleTimes     int
+		updateTimes   int
+		eventTimes    int
+	}{
+		{
+			name:          "Row lock failure.",
+			expectErr:     require.Error,
+			expectedError: nil,
+			rowLockRow:    CryptoTriggerOrder{},
+			rowLockErr:    fmt.Errorf("row lock failure"),
+			fiatLockTimes: 0,
+			balance:       decimal.Zero,
+			held:          decimal.Zero,
+			holdTimes:     0,
+			saleTimes:     0,
+			updateTimes:   0,
+			eventTimes:    0,
+		}, {
+			name:          "Order closed.",
+			expectErr:     require.Error,
+			expectedError: ErrTriggerOrderClosed,
+			rowLockRow:    CryptoTriggerOrder{Status: TriggerOrderStatusCANCELLED},
+			rowLockErr:    nil,
+			fiatLockTimes: 0,
+			balance:       decimal.Zero,
+			held:          decimal.Zero,
+			holdTimes:     0,
+			saleTimes:     0,
+			updateTimes:   0,
+			eventTimes:    0,
+		}, {
+			name:          "Empty position.",
+			expectErr:     require.Error,
+			expectedError: ErrInsufficientFunds,
+			rowLockRow:    CryptoTriggerOrder{Status: TriggerOrderStatusACTIVE},
+			rowLockErr:    nil,
+			fiatLockTimes: 1,
+			balance:       decimal.NewFromFloat(1),
+			held:          decimal.NewFromFloat(1),
+			holdTimes:     1,
+			saleTimes:     0,
+			updateTimes:   0,
+			eventTimes:    0,
+		}, {
+			name:          "Entire position.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			rowLockRow:    CryptoTriggerOrder{Status: TriggerOrderStatusACTIVE},
+			rowLockErr:    nil,
+			fiatLockTimes: 1,
+			balance:       decimal.NewFromFloat(1),
+			held:          decimal.NewFromFloat(0.5),
+			holdTimes:     2,
+			saleTimes:     1,
+			updateTimes:   1,
+			eventTimes:    1,
+		}, {
+			name:          "Partial position.",
+			expectErr:     require.NoError,
+			expectedError: nil,
+			rowLockRow:    CryptoTriggerOrder{Status: TriggerOrderStatusACTIVE, Amount: decimal.NewFromFloat(0.25)},
+			rowLockErr:    nil,
+			fiatLockTimes: 1,
+			balance:       decimal.NewFromFloat(1),
+			held:          decimal.Zero,
+			holdTimes:     2,
+			saleTimes:     1,
+			updateTimes:   1,
+			eventTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			mockQuerier.EXPECT().
+				triggerOrderRowLock(gomock.Any(), gomock.Any()).
+				Return(test.rowLockRow, test.rowLockErr).
+				Times(1)
+
+			mockQuerier.EXPECT().
+				fiatRowLockAccount(gomock.Any(), gomock.Any()).
+				Return(fiatRowLockAccountRow{}, nil).
+				Times(test.fiatLockTimes * (1 + test.saleTimes))
+
+			mockQuerier.EXPECT().
+				cryptoRowLockAccount(gomock.Any(), gomock.Any()).
+				Return(cryptoRowLockAccountRow{Balance: test.balance}, nil).
+				Times(test.fiatLockTimes * (1 + test.saleTimes))
+
+			mockQuerier.EXPECT().
+				cryptoHoldTotal(gomock.Any(), gomock.Any()).
+				Return(test.held, nil).
+				Times(test.holdTimes)
+
+			mockQuerier.EXPECT().
+				cryptoSell(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(test.saleTimes)
+
+			mockQuerier.EXPECT().
+				triggerOrderUpdateStatus(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(test.updateTimes)
+
+			mockQuerier.EXPECT().
+				triggerOrderEventCreate(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(test.eventTimes)
+
+			// Check for error.
+			err := triggerOrderExecute(context.TODO(), zapLogger, mockQuerier, uuid.UUID{},
+				&CryptoTriggerOrder{OrderID: "order-id"}, decimal.NewFromFloat(1000))
+			test.expectErr(t, err, "error expectation failed.")
+
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError, "error mismatch.")
+			}
+		})
+	}
+}
@@ -90,20 +90,23 @@ func (q *Queries) userGetCredentials(ctx context.Context, username string) (user
 }
 
 const userGetInfo = `-- name: userGetInfo :one
-SELECT username, client_id, password, first_name, last_name, email, is_deleted
+SELECT username, client_id, password, first_name, last_name, email, role, is_deleted, is_frozen, email_verified
 FROM users
 WHERE client_id=$1
 LIMIT 1
 `
 
 type userGetInfoRow struct {
-	Username  string    `json:"username"`
-	ClientID  uuid.UUID `json:"clientID"`
-	Password  string    `json:"password"`
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
-	Email     string    `json:"email"`
-	IsDeleted bool      `json:"isDeleted"`
+	Username      string    `json:"username"`
+	ClientID      uuid.UUID `json:"clientID"`
+	Password      string    `json:"password"`
+	FirstName     string    `json:"firstName"`
+	LastName      string    `json:"lastName"`
+	Email         string    `json:"email"`
+	Role          UserRole  `json:"role"`
+	IsDeleted     bool      `json:"isDeleted"`
+	IsFrozen      bool      `json:"isFrozen"`
+	EmailVerified bool      `json:"emailVerified"`
 }
 
 // userGetInfo will retrieve a single users account information.
@@ -117,11 +120,35 @@ func (q *Queries) userGetInfo(ctx context.Context, clientID uuid.UUID) (userGetI
 		&i.FirstName,
 		&i.LastName,
 		&i.Email,
+		&i.Role,
 		&i.IsDeleted,
+		&i.IsFrozen,
+		&i.EmailVerified,
 	)
 	return i, err
 }
 
+const userGetStatus = `-- name: userGetStatus :one
+SELECT role, is_deleted, is_frozen
+FROM users
+WHERE client_id=$1
+LIMIT 1
+`
+
+type userGetStatusRow struct {
+	Role      UserRole `json:"role"`
+	IsDeleted bool     `json:"isDeleted"`
+	IsFrozen  bool     `json:"isFrozen"`
+}
+
+// userGetStatus will return the role, soft delete, and freeze status of a user account.
+func (q *Queries) userGetStatus(ctx context.Context, clientID uuid.UUID) (userGetStatusRow, error) {
+	row := q.db.QueryRow(ctx, userGetStatus, clientID)
+	var i userGetStatusRow
+	err := row.Scan(&i.Role, &i.IsDeleted, &i.IsFrozen)
+	return i, err
+}
+
 const userIsDeleted = `-- name: userIsDeleted :one
 SELECT is_deleted
 FROM users
@@ -136,3 +163,155 @@ func (q *Queries) userIsDeleted(ctx context.Context, clientID uuid.UUID) (bool,
 	err := row.Scan(&is_deleted)
 	return is_deleted, err
 }
+
+const userSearch = `-- name: userSearch :many
+SELECT username, client_id, first_name, last_name, email, role, is_deleted, is_frozen, email_verified
+FROM users
+WHERE client_id::text=$2::text
+      OR username ILIKE '%' || $2::text || '%'
+      OR email ILIKE '%' || $2::text || '%'
+      OR first_name ILIKE '%' || $2::text || '%'
+      OR last_name ILIKE '%' || $2::text || '%'
+ORDER BY username
+LIMIT $1
+`
+
+type userSearchParams struct {
+	Limit int32  `json:"limit"`
+	Query string `json:"query"`
+}
+
+type userSearchRow struct {
+	Username      string    `json:"username"`
+	ClientID      uuid.UUID `json:"clientID"`
+	FirstName     string    `json:"firstName"`
+	LastName      string    `json:"lastName"`
+	Email         string    `json:"email"`
+	Role          UserRole  `json:"role"`
+	IsDeleted     bool      `json:"isDeleted"`
+	IsFrozen      bool      `json:"isFrozen"`
+	EmailVerified bool      `json:"emailVerified"`
+}
+
+// userSearch will retrieve the user accounts with a client id, username, name, or email address matching the query.
+func (q *Queries) userSearch(ctx context.Context, arg *userSearchParams) ([]userSearchRow, error) {
+	rows, err := q.db.Query(ctx, userSearch, arg.Limit, arg.Query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []userSearchRow
+	for rows.Next() {
+		var i userSearchRow
+		if err := rows.Scan(
+			&i.Username,
+			&i.ClientID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.Role,
+			&i.IsDeleted,
+			&i.IsFrozen,
+			&i.EmailVerified,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const userSetFrozen = `-- name: userSetFrozen :execrows
+UPDATE users
+SET is_frozen=$2
+WHERE client_id=$1 AND is_deleted=false
+`
+
+type userSetFrozenParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	IsFrozen bool      `json:"isFrozen"`
+}
+
+// userSetFrozen will freeze or unfreeze a user account that has not been deleted.
+func (q *Queries) userSetFrozen(ctx context.Context, arg *userSetFrozenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, userSetFrozen, arg.ClientID, arg.IsFrozen)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const userUpdatePassword = `-- name: userUpdatePassword :execrows
+UPDATE users
+SET password=$2
+WHERE client_id=$1 AND is_deleted=false
+`
+
+type userUpdatePasswordParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Password string    `json:"password"`
+}
+
+// userUpdatePassword will replace the password of a user account that has not been deleted.
+func (q *Queries) userUpdatePassword(ctx context.Context, arg *userUpdatePasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, userUpdatePassword, arg.ClientID, arg.Password)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const userUpdateProfile = `-- name: userUpdateProfile :execrows
+UPDATE users
+SET first_name=COALESCE(NULLIF($1::text, ''), first_name),
+    last_name=COALESCE(NULLIF($2::text, ''), last_name),
+    email=COALESCE(NULLIF($3::text, ''), email),
+    email_verified=email_verified AND ($3::text='' OR lower($3::text)=lower(email))
+WHERE client_id=$4 AND is_deleted=false
+`
+
+type userUpdateProfileParams struct {
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	Email     string    `json:"email"`
+	ClientID  uuid.UUID `json:"clientID"`
+}
+
+// userUpdateProfile will replace the names and email address of a user account that has not been deleted. Empty values
+// are left unchanged and a changed email address is marked as unverified.
+func (q *Queries) userUpdateProfile(ctx context.Context, arg *userUpdateProfileParams) (int64, error) {
+	result, err := q.db.Exec(ctx, userUpdateProfile,
+		arg.FirstName,
+		arg.LastName,
+		arg.Email,
+		arg.ClientID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const userVerifyEmail = `-- name: userVerifyEmail :execrows
+UPDATE users
+SET email_verified=true
+WHERE client_id=$1 AND lower(email)=lower($2::text) AND is_deleted=false
+`
+
+type userVerifyEmailParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Email    string    `json:"email"`
+}
+
+// userVerifyEmail will mark the email address of a user account that has not been deleted as verified if it has not
+// changed since the verification link was issued.
+func (q *Queries) userVerifyEmail(ctx context.Context, arg *userVerifyEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, userVerifyEmail, arg.ClientID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -34,13 +34,13 @@ func TestCreateUser(t *testing.T) {
 		})
 	}
 
-	// New user with different username and account but duplicated fields.
+	// New user with different username and account but a duplicated email address, regardless of case.
 	userPass := userCreateParams{
 		Username:  "user-5",
 		Password:  "user-pwd-1",
 		FirstName: "firstname-1",
 		LastName:  "lastname-1",
-		Email:     "user1@email-address.com",
+		Email:     "USER1@email-address.com",
 	}
 
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
@@ -48,6 +48,13 @@ func TestCreateUser(t *testing.T) {
 	defer cancel()
 
 	clientID, err := connection.Query.userCreate(ctx, &userPass)
+	require.True(t, isEmailRegisteredError(err), "user account with a duplicate email address should not be created.")
+	require.True(t, clientID.IsNil(), "incorrectly retrieved client id from response")
+
+	// New user with different username, account, and email address but duplicated names.
+	userPass.Email = "user5@email-address.com"
+
+	clientID, err = connection.Query.userCreate(ctx, &userPass)
 	require.NoError(t, err, "user account with non-duplicate key fields should be created.")
 	require.False(t, clientID.IsNil(), "failed to retrieve client id from response")
 }
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/rs/xid"
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
@@ -35,16 +36,57 @@ type Redis interface {
 	// Set will place a key with a given value in the cache with a TTL, if specified in the configurations.
 	Set(key string, value any, ttl time.Duration) error
 
-	// Get will retrieve a value associated with a provided key.
+	// SetNX will place a key with a given value in the cache with a TTL only if the key is not already present. It
+	// returns whether the key was placed.
+	SetNX(key string, value any, ttl time.Duration) (bool, error)
+
+	// Get will retrieve a value associated with a provided key. A cache miss is only reported if the key is not present.
 	Get(key string, value any) error
 
+	// GetDel will retrieve a value associated with a provided key and remove the key in a single atomic operation. A
+	// cache miss is reported if the key is not present.
+	GetDel(key string, value any) error
+
 	// Del will remove all keys provided as a set of keys.
 	Del(key ...string) error
+
+	// Incr will atomically increment the counter stored at a key and return its new value. A counter that is not present
+	// is created with a value of one and the TTL, which is not extended by later increments.
+	Incr(key string, ttl time.Duration) (int64, error)
+
+	// SlidingWindow will atomically admit a request on a key if fewer than the limit of requests have been admitted over
+	// the trailing window. It returns whether the request was admitted, the number of requests that may still be admitted,
+	// and the time until the oldest admitted request leaves the window.
+	SlidingWindow(key string, limit int64, window time.Duration) (bool, int64, time.Duration, error)
 }
 
 // Check to ensure the Redis interface has been implemented.
 var _ Redis = &redisImpl{}
 
+// slidingWindowScript keeps a log of the requests admitted on a key as a sorted set scored by their arrival times. The
+// clock of the Redis cache server is used so that every server replica shares the same view of the window.
+var slidingWindowScript = redis.NewScript(`
+local clock = redis.call('TIME')
+local now = tonumber(clock[1]) * 1000000 + tonumber(clock[2])
+local window = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+local admitted = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[3])
+	redis.call('PEXPIRE', KEYS[1], math.ceil(window / 1000))
+	count = count + 1
+	admitted = 1
+end
+local reset = 0
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if #oldest > 0 then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {admitted, limit - count, reset}
+`)
+
 // redisImpl implements the Redis interface and contains the logic to interface with the cache.
 type redisImpl struct {
 	conf    *config
@@ -194,6 +236,31 @@ func (r *redisImpl) Set(key string, value any, expiration time.Duration) error {
 	return nil
 }
 
+// SetNX will place a key with a given value in the Redis cache server with a TTL only if the key is not already present.
+// It returns whether the key was placed.
+func (r *redisImpl) SetNX(key string, value any, expiration time.Duration) (bool, error) {
+	var (
+		err    error
+		placed bool
+	)
+
+	// Write value to a byte array.
+	buffer := bytes.Buffer{}
+	encoder := gob.NewEncoder(&buffer)
+
+	if err = encoder.Encode(value); err != nil {
+		return false, NewError(err.Error())
+	}
+
+	if placed, err = r.redisDB.SetNX(context.Background(), key, buffer.Bytes(), expiration).Result(); err != nil {
+		r.logger.Error("failed to place item in Redis cache", zap.String("key", key), zap.Error(err))
+
+		return false, NewError(err.Error()).errorCacheSet()
+	}
+
+	return placed, nil
+}
+
 // Get will retrieve a value associated with a provided key and write the result into the value parameter.
 func (r *redisImpl) Get(key string, value any) error {
 	var (
@@ -202,7 +269,40 @@ func (r *redisImpl) Get(key string, value any) error {
 	)
 
 	if rawData, err = r.redisDB.Get(context.Background(), key).Bytes(); err != nil {
-		return NewError(err.Error()).errorCacheMiss()
+		if errors.Is(err, redis.Nil) {
+			return NewError(err.Error()).errorCacheMiss()
+		}
+
+		r.logger.Error("failed to retrieve item from Redis cache", zap.String("key", key), zap.Error(err))
+
+		return NewError(err.Error())
+	}
+
+	// Convert to struct.
+	decoder := gob.NewDecoder(bytes.NewBuffer(rawData))
+	if err = decoder.Decode(value); err != nil {
+		return NewError(err.Error())
+	}
+
+	return nil
+}
+
+// GetDel will retrieve a value associated with a provided key, write the result into the value parameter, and remove
+// the key. Only one of any concurrent callers will retrieve the value.
+func (r *redisImpl) GetDel(key string, value any) error {
+	var (
+		err     error
+		rawData []byte
+	)
+
+	if rawData, err = r.redisDB.GetDel(context.Background(), key).Bytes(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return NewError(err.Error()).errorCacheMiss()
+		}
+
+		r.logger.Error("failed to retrieve and evict item from Redis cache", zap.String("key", key), zap.Error(err))
+
+		return NewError(err.Error())
 	}
 
 	// Convert to struct.
@@ -234,3 +334,41 @@ func (r *redisImpl) Del(keys ...string) error {
 
 	return nil
 }
+
+// Incr will atomically increment the counter stored at a key and return its new value. The TTL is only set when the
+// counter is created so that a fixed window is kept regardless of how often the counter is incremented.
+func (r *redisImpl) Incr(key string, expiration time.Duration) (int64, error) {
+	var incrCmd *redis.IntCmd
+
+	if _, err := r.redisDB.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		incrCmd = pipe.Incr(context.Background(), key)
+		pipe.ExpireNX(context.Background(), key, expiration)
+
+		return nil
+	}); err != nil {
+		r.logger.Error("failed to increment counter in Redis cache", zap.String("key", key), zap.Error(err))
+
+		return 0, NewError(err.Error()).errorCacheSet()
+	}
+
+	return incrCmd.Val(), nil
+}
+
+// SlidingWindow will atomically admit a request on a key if fewer than the limit of requests have been admitted over
+// the trailing window. Requests that are not admitted are not logged, so they do not extend the time a requester must
+// wait.
+func (r *redisImpl) SlidingWindow(key string, limit int64, window time.Duration) (bool, int64, time.Duration, error) {
+	result, err := slidingWindowScript.Run(context.Background(), r.redisDB, []string{key},
+		window.Microseconds(), limit, xid.New().String()).Int64Slice()
+	if err != nil {
+		r.logger.Error("failed to admit request to sliding window in Redis cache", zap.String("key", key), zap.Error(err))
+
+		return false, 0, 0, NewError(err.Error()).errorCacheSet()
+	}
+
+	if len(result) != 3 { //nolint:gomnd
+		return false, 0, 0, NewError("malformed sliding window response from Redis cache")
+	}
+
+	return result[0] == 1, result[1], time.Duration(result[2]) * time.Microsecond, nil
+}
@@ -260,3 +260,115 @@ func TestRedisImpl_Set_Get_Del(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisImpl_SetNX(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+
+	// The first write places the key.
+	placed, err := connection.SetNX(key, "first", time.Minute)
+	require.NoError(t, err, "failed to write to Redis")
+	require.True(t, placed, "key was not placed")
+
+	// Subsequent writes do not overwrite the key.
+	placed, err = connection.SetNX(key, "second", time.Minute)
+	require.NoError(t, err, "failed to write existing key to Redis")
+	require.False(t, placed, "existing key was placed")
+
+	retrieved := ""
+	require.NoError(t, connection.Get(key, &retrieved), "failed to retrieve data from Redis")
+	require.Equal(t, "first", retrieved, "existing key was overwritten")
+
+	require.NoError(t, connection.Del(key), "failed to remove key from Redis server")
+}
+
+func TestRedisImpl_GetDel(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+	value := xid.New().String()
+
+	require.NoError(t, connection.Set(key, value, time.Minute), "failed to write to Redis")
+
+	// The first retrieval returns the value and removes the key.
+	retrieved := ""
+	require.NoError(t, connection.GetDel(key, &retrieved), "failed to retrieve data from Redis")
+	require.Equal(t, value, retrieved, "retrieved value does not match expected")
+
+	// Subsequent retrievals are cache misses.
+	err := connection.GetDel(key, &retrieved)
+	require.ErrorIs(t, err, ErrCacheMiss, "removed key was retrieved")
+
+	err = connection.Get(key, &retrieved)
+	require.ErrorIs(t, err, ErrCacheMiss, "removed key was found")
+}
+
+func TestRedisImpl_Incr(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+
+	// The first increment creates the counter with a TTL.
+	count, err := connection.Incr(key, time.Second)
+	require.NoError(t, err, "failed to create counter")
+	require.Equal(t, int64(1), count, "new counter value mismatch")
+
+	// Subsequent increments do not extend the TTL.
+	time.Sleep(600 * time.Millisecond)
+
+	count, err = connection.Incr(key, time.Second)
+	require.NoError(t, err, "failed to increment counter")
+	require.Equal(t, int64(2), count, "incremented counter value mismatch")
+
+	time.Sleep(600 * time.Millisecond)
+
+	count, err = connection.Incr(key, time.Second)
+	require.NoError(t, err, "failed to increment expired counter")
+	require.Equal(t, int64(1), count, "expired counter was not reset")
+
+	require.NoError(t, connection.Del(key), "failed to remove key from Redis server")
+}
+
+func TestRedisImpl_SlidingWindow(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+	window := time.Second
+
+	// Requests up to the limit are admitted.
+	for expected := int64(1); expected >= 0; expected-- {
+		admitted, remaining, reset, err := connection.SlidingWindow(key, 2, window)
+		require.NoError(t, err, "failed to admit request")
+		require.True(t, admitted, "request within limit rejected")
+		require.Equal(t, expected, remaining, "remaining requests mismatch")
+		require.LessOrEqual(t, reset, window, "reset beyond window")
+	}
+
+	// Requests beyond the limit are rejected until the oldest request leaves the window.
+	admitted, remaining, reset, err := connection.SlidingWindow(key, 2, window)
+	require.NoError(t, err, "failed to reject request")
+	require.False(t, admitted, "request beyond limit admitted")
+	require.Equal(t, int64(0), remaining, "remaining requests mismatch")
+	require.Positive(t, reset, "reset not set for rejected request")
+
+	time.Sleep(reset + 50*time.Millisecond)
+
+	admitted, _, _, err = connection.SlidingWindow(key, 2, window)
+	require.NoError(t, err, "failed to admit request after window")
+	require.True(t, admitted, "request after window rejected")
+
+	require.NoError(t, connection.Del(key), "failed to remove key from Redis server")
+}
@@ -15,6 +15,7 @@ import (
 type config struct {
 	Server        serverConfig        `json:"server,omitempty"        mapstructure:"server"        validate:"required" yaml:"server,omitempty"`
 	Authorization authorizationConfig `json:"authorization,omitempty" mapstructure:"authorization" validate:"required" yaml:"authorization,omitempty"`
+	RateLimit     rateLimitConfig     `json:"rateLimit,omitempty"     mapstructure:"rateLimit"     validate:"required" yaml:"rateLimit,omitempty"`
 }
 
 // serverConfig contains the configurations for the HTTP REST server.
@@ -35,6 +36,27 @@ type authorizationConfig struct {
 	HeaderKey string `json:"headerKey,omitempty" mapstructure:"headerKey" validate:"required" yaml:"headerKey,omitempty"`
 }
 
+// rateLimitConfig contains the default limit on the requests from each requester, and the limits for routes that are
+// limited separately.
+//
+//nolint:lll
+type rateLimitConfig struct {
+	Limit  int64                  `json:"limit,omitempty"  mapstructure:"limit"  validate:"required,min=1"  yaml:"limit,omitempty"`
+	Window time.Duration          `json:"window,omitempty" mapstructure:"window" validate:"required,min=1s" yaml:"window,omitempty"`
+	Routes []routeRateLimitConfig `json:"routes,omitempty" mapstructure:"routes" validate:"omitempty,dive" yaml:"routes,omitempty"`
+}
+
+// routeRateLimitConfig contains the limit on the requests from each requester to a route. The path is relative to the
+// base path.
+//
+//nolint:lll
+type routeRateLimitConfig struct {
+	Method string        `json:"method,omitempty" mapstructure:"method" validate:"required,oneof=GET POST PUT PATCH DELETE" yaml:"method,omitempty"`
+	Path   string        `json:"path,omitempty"   mapstructure:"path"   validate:"required,startswith=/"                    yaml:"path,omitempty"`
+	Limit  int64         `json:"limit,omitempty"  mapstructure:"limit"  validate:"required,min=1"                           yaml:"limit,omitempty"`
+	Window time.Duration `json:"window,omitempty" mapstructure:"window" validate:"required,min=1s"                          yaml:"window,omitempty"`
+}
+
 // newConfig creates a blank configuration struct for the authorization.
 func newConfig() *config {
 	return &config{}
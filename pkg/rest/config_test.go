@@ -18,6 +18,7 @@ import (
 func TestRestConfigs_Load(t *testing.T) {
 	keyspaceServer := constants.HTTPRESTPrefix() + "_SERVER."
 	keyspaceAuth := constants.HTTPRESTPrefix() + "_AUTHORIZATION."
+	keyspaceRateLimit := constants.HTTPRESTPrefix() + "_RATELIMIT."
 
 	testCases := []struct {
 		name         string
@@ -29,7 +30,7 @@ func TestRestConfigs_Load(t *testing.T) {
 			name:         "empty - etc dir",
 			input:        restConfigTestData["empty"],
 			expectErr:    require.Error,
-			expectErrCnt: 8,
+			expectErrCnt: 10,
 		}, {
 			name:         "valid - etc dir",
 			input:        restConfigTestData["valid"],
@@ -75,6 +76,16 @@ func TestRestConfigs_Load(t *testing.T) {
 			input:        restConfigTestData["no auth header"],
 			expectErr:    require.Error,
 			expectErrCnt: 1,
+		}, {
+			name:         "no rate limit - etc dir",
+			input:        restConfigTestData["no rate limit"],
+			expectErr:    require.Error,
+			expectErrCnt: 2,
+		}, {
+			name:         "invalid route rate limit - etc dir",
+			input:        restConfigTestData["invalid route rate limit"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
 		},
 	}
 
@@ -108,6 +119,8 @@ func TestRestConfigs_Load(t *testing.T) {
 			basePath := xid.New().String()
 			swaggerPath := xid.New().String()
 			headerKey := xid.New().String()
+			rateLimit := int64(30)
+			rateLimitWindow := 10 * time.Second
 			portNumber := 1600
 			shutdownDelay := time.Duration(36)
 			readTimeout := time.Duration(4)
@@ -121,6 +134,8 @@ func TestRestConfigs_Load(t *testing.T) {
 			t.Setenv(keyspaceServer+"WRITETIMEOUT", writeTimeout.String())
 			t.Setenv(keyspaceServer+"READHEADERTIMEOUT", readHeaderTimeout.String())
 			t.Setenv(keyspaceAuth+"HEADERKEY", headerKey)
+			t.Setenv(keyspaceRateLimit+"LIMIT", strconv.FormatInt(rateLimit, 10))
+			t.Setenv(keyspaceRateLimit+"WINDOW", rateLimitWindow.String())
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
@@ -139,6 +154,10 @@ func TestRestConfigs_Load(t *testing.T) {
 				"failed to load read header timeout environment variable into configs")
 			require.Equal(t, headerKey, actual.Authorization.HeaderKey,
 				"Failed to load authorization header key environment variable into configs")
+			require.Equal(t, rateLimit, actual.RateLimit.Limit,
+				"failed to load rate limit environment variable into configs")
+			require.Equal(t, rateLimitWindow, actual.RateLimit.Window,
+				"failed to load rate limit window environment variable into configs")
 		})
 	}
 }
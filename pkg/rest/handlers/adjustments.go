@@ -0,0 +1,234 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// RequestAdjustment will handle an HTTP request to make a manual adjustment of a Fiat account.
+//
+//	@Summary		Request a manual adjustment of a Fiat account.
+//	@Description	Records a pending manual credit (positive amount) or debit (negative amount) of a Fiat account with a reason code and justification. No journal entries are posted until the adjustment is approved by a different administrator. The reason code must be one of GOODWILL, FEE_CORRECTION, ERROR_CORRECTION, or OTHER. Requires the administrative write scope.
+//	@Tags			admin adjustments
+//	@Id				requestAdjustment
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPAdminAdjustmentRequest	true	"the account, amount, reason code, and justification for the adjustment"
+//	@Success		201		{object}	models.HTTPSuccess					"the pending adjustment"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/admin/adjustments [post]
+func RequestAdjustment(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			request     models.HTTPAdminAdjustmentRequest
+			adjustment  *postgres.FiatAdjustment
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if adjustment, httpStatus, httpMessage, payload, err = common.HTTPAdminAdjustmentCreate(db, logger, adminID,
+			&request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "adjustment pending approval", Payload: adjustment})
+	}
+}
+
+// ApproveAdjustment will handle an HTTP request to approve a pending manual adjustment of a Fiat account.
+//
+//	@Summary		Approve a manual adjustment of a Fiat account.
+//	@Description	Approves a pending manual adjustment and posts its journal entries between the Fiat account and the Fiat operations account. Adjustments must be approved by an administrator other than the one who requested them, cannot be decided more than once, and are refused if they would overdraw the account. A note must be provided and is recorded with the decision. Requires the administrative write scope.
+//	@Tags			admin adjustments
+//	@Id				approveAdjustment
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			adjustmentID	path		string										true	"the adjustment ID to approve"
+//	@Param			request			body		models.HTTPAdminAdjustmentDecisionRequest	true	"the note for the decision"
+//	@Success		200				{object}	models.HTTPSuccess							"the approved adjustment"
+//	@Failure		400				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		402				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Router			/admin/adjustments/{adjustmentID}/approve [post]
+func ApproveAdjustment(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return decideAdjustment(logger, auth, db, true)
+}
+
+// RejectAdjustment will handle an HTTP request to reject a pending manual adjustment of a Fiat account.
+//
+//	@Summary		Reject a manual adjustment of a Fiat account.
+//	@Description	Rejects a pending manual adjustment without posting any journal entries. Adjustments must be rejected by an administrator other than the one who requested them and cannot be decided more than once. A note must be provided and is recorded with the decision. Requires the administrative write scope.
+//	@Tags			admin adjustments
+//	@Id				rejectAdjustment
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			adjustmentID	path		string										true	"the adjustment ID to reject"
+//	@Param			request			body		models.HTTPAdminAdjustmentDecisionRequest	true	"the note for the decision"
+//	@Success		200				{object}	models.HTTPSuccess							"the rejected adjustment"
+//	@Failure		400				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError							"error message with any available details in payload"
+//	@Router			/admin/adjustments/{adjustmentID}/reject [post]
+func RejectAdjustment(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return decideAdjustment(logger, auth, db, false)
+}
+
+// decideAdjustment will handle an HTTP request to approve or reject a pending manual adjustment of a Fiat account.
+func decideAdjustment(logger *logger.Logger, auth auth.Auth, db postgres.Postgres, approve bool) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			request     models.HTTPAdminAdjustmentDecisionRequest
+			adjustment  *postgres.FiatAdjustment
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if adjustment, httpStatus, httpMessage, payload, err = common.HTTPAdminAdjustmentDecide(db, logger, adminID,
+			ginCtx.Param("adjustmentID"), approve, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{
+			Message: "adjustment " + string(adjustment.Status), Payload: adjustment})
+	}
+}
+
+// AdjustmentDetails will handle an HTTP request to retrieve a manual adjustment of a Fiat account.
+//
+//	@Summary		Retrieve a manual adjustment of a Fiat account.
+//	@Description	Retrieves a manual adjustment along with the administrators who requested and decided it, the decision note, and the transaction ID of the posted journal entries if it was approved. Requires the administrative read scope.
+//	@Tags			admin adjustments
+//	@Id				adjustmentDetails
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			adjustmentID	path		string				true	"the adjustment ID to retrieve"
+//	@Success		200				{object}	models.HTTPSuccess	"the adjustment"
+//	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/adjustments/{adjustmentID} [get]
+func AdjustmentDetails(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			adjustment  *postgres.FiatAdjustment
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if adjustment, httpStatus, httpMessage, err = common.HTTPAdminAdjustment(db, logger, adminID,
+			ginCtx.Param("adjustmentID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "adjustment", Payload: adjustment})
+	}
+}
+
+// AdjustmentsPaginated will handle an HTTP request to retrieve manual adjustments of Fiat accounts.
+//
+//	@Summary		Retrieve manual adjustments of Fiat accounts.
+//	@Description	Retrieves manual adjustments, newest first. The adjustments can be restricted to those with a status of PENDING, APPROVED, or REJECTED. The initial request will only contain (optionally) the page size and status. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Requires the administrative read scope.
+//	@Tags			admin adjustments
+//	@Id				adjustmentsPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string				false	"The status of the adjustments to retrieve."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of adjustments"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/adjustments [get]
+func AdjustmentsPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			adjustments *models.HTTPAdminAdjustmentsPaginated
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if adjustments, httpStatus, httpMessage, err = common.HTTPAdminAdjustmentsPaginated(auth, db, logger, adminID,
+			ginCtx.Query("status"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "adjustments", Payload: adjustments})
+	}
+}
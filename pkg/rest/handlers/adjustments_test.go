@@ -0,0 +1,464 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_RequestAdjustment(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/adjustments"
+
+	validRequest := &models.HTTPAdminAdjustmentRequest{
+		ClientID:      uuid.Must(uuid.NewV4()).String(),
+		Currency:      "USD",
+		Amount:        decimal.NewFromFloat(25),
+		ReasonCode:    "GOODWILL",
+		Justification: "service outage credit",
+	}
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		request            *models.HTTPAdminAdjustmentRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditTimes         int
+		createErr          error
+		createTimes        int
+	}{
+		{
+			name:               "empty request",
+			expectedMsg:        constants.ValidationString(),
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminAdjustmentRequest{},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid JWT",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            validRequest,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "account not found",
+			expectedMsg:        "not found",
+			expectedStatus:     http.StatusNotFound,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			createErr:          postgres.ErrNotFound,
+			createTimes:        1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "adjustment pending approval",
+			expectedStatus:     http.StatusCreated,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			createTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTCREATE, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAdjustmentCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath, RequestAdjustment(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, basePath,
+				bytes.NewBuffer(requestJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_DecideAdjustment(t *testing.T) {
+	t.Parallel()
+
+	const (
+		basePath     = "/admin/adjustments/"
+		adjustmentID = "cjld2cjxh0000qzrmn831i7rn"
+	)
+
+	testCases := []struct {
+		name               string
+		approve            bool
+		expectedMsg        string
+		expectedStatus     int
+		request            *models.HTTPAdminAdjustmentDecisionRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditTimes         int
+		decideErr          error
+		approveTimes       int
+		rejectTimes        int
+	}{
+		{
+			name:               "empty request",
+			approve:            true,
+			expectedMsg:        constants.ValidationString(),
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminAdjustmentDecisionRequest{},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid JWT",
+			approve:            true,
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "self approval",
+			approve:            true,
+			expectedMsg:        "different administrator",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			decideErr:          postgres.ErrAdjustmentMaker,
+			approveTimes:       1,
+		}, {
+			name:               "already decided",
+			approve:            false,
+			expectedMsg:        "already been decided",
+			expectedStatus:     http.StatusConflict,
+			request:            &models.HTTPAdminAdjustmentDecisionRequest{Note: "duplicate"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			decideErr:          postgres.ErrAdjustmentDecided,
+			rejectTimes:        1,
+		}, {
+			name:               "approved",
+			approve:            true,
+			expectedMsg:        "adjustment APPROVED",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminAdjustmentDecisionRequest{Note: "verified"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			approveTimes:       1,
+		}, {
+			name:               "rejected",
+			approve:            false,
+			expectedMsg:        "adjustment REJECTED",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminAdjustmentDecisionRequest{Note: "duplicate"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			rejectTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+				Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+				Times(test.authTokenInfoTimes)
+
+			mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), adjustmentID, gomock.Any()).
+				Return(int64(1), nil).
+				Times(test.auditTimes)
+
+			mockDB.EXPECT().FiatAdjustmentApprove(adjustmentID, gomock.Any(), test.request.Note).
+				Return(&postgres.FiatAdjustment{Status: postgres.AdjustmentStatusAPPROVED}, test.decideErr).
+				Times(test.approveTimes)
+
+			mockDB.EXPECT().FiatAdjustmentReject(adjustmentID, gomock.Any(), test.request.Note).
+				Return(&postgres.FiatAdjustment{Status: postgres.AdjustmentStatusREJECTED}, test.decideErr).
+				Times(test.rejectTimes)
+
+			// Endpoint setup for test.
+			decision, handler := "/reject", RejectAdjustment(zapLogger, mockAuth, mockDB)
+			if test.approve {
+				decision, handler = "/approve", ApproveAdjustment(zapLogger, mockAuth, mockDB)
+			}
+
+			router := gin.Default()
+			router.POST(basePath+":adjustmentID"+decision, handler)
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, basePath+adjustmentID+decision,
+				bytes.NewBuffer(requestJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdjustmentDetails(t *testing.T) {
+	t.Parallel()
+
+	const (
+		basePath     = "/admin/adjustments/"
+		adjustmentID = "cjld2cjxh0000qzrmn831i7rn"
+	)
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditTimes         int
+		getErr             error
+		getTimes           int
+	}{
+		{
+			name:               "invalid JWT",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "not found",
+			expectedMsg:        "not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "adjustment",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			getTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTVIEW, adjustmentID,
+					gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAdjustmentGet(adjustmentID).
+					Return(postgres.FiatAdjustment{AdjustmentID: adjustmentID}, test.getErr).
+					Times(test.getTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":adjustmentID", AdjustmentDetails(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+adjustmentID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdjustmentsPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/adjustments"
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditTimes         int
+		adjustmentsErr     error
+		adjustmentsTimes   int
+		encryptTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "?pageSize=3",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid status",
+			querySegment:       "?pageSize=3&status=CANCELLED",
+			expectedMsg:        "invalid adjustment status",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "not found",
+			querySegment:       "?pageSize=3&status=PENDING",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			adjustmentsErr:     postgres.ErrNotFound,
+			adjustmentsTimes:   1,
+		}, {
+			name:               "valid",
+			querySegment:       "?pageSize=3&status=PENDING",
+			expectedMsg:        "adjustments",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			adjustmentsTimes:   1,
+			encryptTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionADJUSTMENTVIEW, "",
+					gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAdjustmentsPaginated("", "PENDING", int32(4)).
+					Return([]postgres.FiatAdjustment{
+						{AdjustmentID: "4"}, {AdjustmentID: "3"}, {AdjustmentID: "2"}, {AdjustmentID: "1"}},
+						test.adjustmentsErr).
+					Times(test.adjustmentsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, AdjustmentsPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
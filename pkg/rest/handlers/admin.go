@@ -0,0 +1,763 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+)
+
+// UpsertCryptoAsset will handle an HTTP request to register a Cryptocurrency or update its registry details.
+//
+//	@Summary		Register or update a Cryptocurrency.
+//	@Description	Registers a Cryptocurrency, or updates an existing one, with its display name, precision, trading status, and order size limits. Requires the administrative write scope.
+//	@Tags			admin crypto cryptocurrency assets registry
+//	@Id				upsertCryptoAsset
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPCryptoAssetRequest	true	"the Cryptocurrency registry details"
+//	@Success		200		{object}	models.HTTPSuccess				"the registered Cryptocurrency details"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/crypto/assets [put]
+func UpsertCryptoAsset(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			asset       *postgres.CryptoAsset
+			done        common.AdminActionDone
+			err         error
+			request     models.HTTPCryptoAssetRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if done, httpStatus, httpMessage, err = common.HTTPAdminAudit(db, logger, adminID,
+			postgres.AdminActionCRYPTOASSETUPSERT, request.Ticker, request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		asset, httpStatus, httpMessage, payload, err = common.HTTPCryptoAssetUpsert(db, logger, &request)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "cryptocurrency registered", Payload: asset})
+	}
+}
+
+// StatusCryptoAsset will handle an HTTP request to enable or halt trading for a Cryptocurrency.
+//
+//	@Summary		Enable or halt trading for a Cryptocurrency.
+//	@Description	Enables or halts trading for a registered Cryptocurrency. Balances in halted Cryptocurrencies remain accessible, but accounts cannot be opened, and offers cannot be issued or executed. Requires the administrative write scope.
+//	@Tags			admin crypto cryptocurrency assets registry halt
+//	@Id				statusCryptoAsset
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ticker	path		string								true	"the Cryptocurrency ticker to update the trading status for"
+//	@Param			request	body		models.HTTPCryptoAssetStatusRequest	true	"the trading status"
+//	@Success		200		{object}	models.HTTPSuccess					"a message to confirm the trading status update"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/admin/crypto/assets/{ticker}/status [patch]
+func StatusCryptoAsset(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			done        common.AdminActionDone
+			err         error
+			request     models.HTTPCryptoAssetStatusRequest
+			httpStatus  int
+			httpMessage string
+			ticker      = ginCtx.Param("ticker")
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest,
+				models.HTTPError{Message: constants.ValidationString(), Payload: err})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if done, httpStatus, httpMessage, err = common.HTTPAdminAudit(db, logger, adminID,
+			postgres.AdminActionCRYPTOASSETSTATUS, ticker, request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		httpStatus, httpMessage, err = common.HTTPCryptoAssetStatus(db, logger, ticker, *request.IsHalted)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: ticker})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "cryptocurrency trading status updated",
+			Payload: map[string]any{"ticker": ticker, "isHalted": *request.IsHalted}})
+	}
+}
+
+// UpsertFiatCurrency will handle an HTTP request to register a Fiat currency or update its circulation status.
+//
+//	@Summary		Register a Fiat currency or update its circulation status.
+//	@Description	Registers a Fiat currency, or updates the circulation status of an existing one. Deprecated currencies no longer accept new accounts, and withdrawn currencies can no longer be transacted in. Balances in all registered currencies remain readable. Requires the administrative write scope.
+//	@Tags			admin fiat currency currencies reference
+//	@Id				upsertFiatCurrency
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPFiatCurrencyRequest	true	"the Fiat currency code and circulation status"
+//	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the Fiat currency update"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/fiat/currencies [put]
+func UpsertFiatCurrency(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			done        common.AdminActionDone
+			err         error
+			request     models.HTTPFiatCurrencyRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if done, httpStatus, httpMessage, err = common.HTTPAdminAudit(db, logger, adminID,
+			postgres.AdminActionFIATCURRENCYUPSERT, request.Code, request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		httpStatus, httpMessage, payload, err = common.HTTPFiatCurrencyUpsert(db, logger, &request)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "fiat currency updated", Payload: request})
+	}
+}
+
+// SearchUsers will handle an HTTP request to search for user accounts.
+//
+//	@Summary		Search for user accounts.
+//	@Description	Searches for user accounts with a client id, username, name, or email address matching the query. Login credentials are never returned. Requires the administrative read scope.
+//	@Tags			admin users search
+//	@Id				searchUsers
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			query	query		string				true	"the client id, or partial username, name, or email address to search for"
+//	@Param			limit	query		int					false	"the maximum number of user accounts to return"
+//	@Success		200		{object}	models.HTTPSuccess	"the matching user accounts"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/search [get]
+func SearchUsers(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+			profiles    []modelsPostgres.UserProfile
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if profiles, httpStatus, httpMessage, err = common.HTTPAdminUserSearch(db, logger, adminID,
+			ginCtx.Query("query"), ginCtx.Query("limit")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user accounts", Payload: profiles})
+	}
+}
+
+// ViewUser will handle an HTTP request to retrieve a user account's profile.
+//
+//	@Summary		Retrieve a user account's profile.
+//	@Description	Retrieves the profile, role, and status of a user account. Login credentials are never returned. Requires the administrative read scope.
+//	@Tags			admin users
+//	@Id				viewUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the client id of the user account"
+//	@Success		200			{object}	models.HTTPSuccess	"the user account's profile"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/{clientID} [get]
+func ViewUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+			profile     *modelsPostgres.UserProfile
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if profile, httpStatus, httpMessage, err = common.HTTPAdminUserView(db, logger, adminID,
+			ginCtx.Param("clientID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user account", Payload: profile})
+	}
+}
+
+// FreezeUser will handle an HTTP request to freeze or unfreeze a user account.
+//
+//	@Summary		Freeze or unfreeze a user account.
+//	@Description	Freezes or unfreezes a user account. Frozen users cannot log in, refresh their tokens, or access any authenticated endpoints. A reason must be provided and is recorded in the audit log. Administrators cannot freeze their own accounts. Requires the administrative write scope.
+//	@Tags			admin users freeze
+//	@Id				freezeUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the client id of the user account"
+//	@Param			request		body		models.HTTPAdminFreezeRequest	true	"the freeze status and the reason for the change"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the freeze status update"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/freeze [patch]
+func FreezeUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			request     models.HTTPAdminFreezeRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+			clientIDStr = ginCtx.Param("clientID")
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, payload, err = common.HTTPAdminUserFreeze(db, logger, adminID, clientIDStr,
+			&request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user account freeze status updated",
+			Payload: map[string]any{"clientID": clientIDStr, "isFrozen": *request.IsFrozen}})
+	}
+}
+
+// StatusFiatAccount will handle an HTTP request to freeze, partially freeze, or unfreeze a user's Fiat currency account.
+//
+//	@Summary		Set the status of a user's Fiat currency account.
+//	@Description	Sets the status of a user's Fiat currency account. Active accounts can be credited and debited, accounts frozen for debits can only be credited, and frozen accounts can neither be credited nor debited. Closed accounts cannot be updated. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.
+//	@Tags			admin users fiat currency account status freeze
+//	@Id				statusFiatAccount
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID		path		string									true	"the client id of the user account"
+//	@Param			currencyCode	path		string									true	"the currency code of the account"
+//	@Param			request			body		models.HTTPAdminAccountStatusRequest	true	"the account status and the reason for the change"
+//	@Success		200				{object}	models.HTTPSuccess						"a message to confirm the account status update"
+//	@Failure		400				{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError						"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/fiat/{currencyCode}/status [patch]
+func StatusFiatAccount(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID      uuid.UUID
+			err          error
+			request      models.HTTPAdminAccountStatusRequest
+			httpStatus   int
+			httpMessage  string
+			payload      any
+			clientIDStr  = ginCtx.Param("clientID")
+			currencyCode = ginCtx.Param("currencyCode")
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, payload, err = common.HTTPAdminAccountStatus(db, logger, adminID, clientIDStr,
+			currencyCode, false, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account status updated",
+			Payload: map[string]any{"clientID": clientIDStr, "currency": currencyCode, "status": request.Status}})
+	}
+}
+
+// StatusCryptoAccount will handle an HTTP request to freeze, partially freeze, or unfreeze a user's Cryptocurrency account.
+//
+//	@Summary		Set the status of a user's Cryptocurrency account.
+//	@Description	Sets the status of a user's Cryptocurrency account. Active accounts can be credited and debited, accounts frozen for debits can only be credited, and frozen accounts can neither be credited nor debited. Closed accounts cannot be updated. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.
+//	@Tags			admin users crypto cryptocurrency account status freeze
+//	@Id				statusCryptoAccount
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string									true	"the client id of the user account"
+//	@Param			ticker		path		string									true	"the Cryptocurrency ticker of the account"
+//	@Param			request		body		models.HTTPAdminAccountStatusRequest	true	"the account status and the reason for the change"
+//	@Success		200			{object}	models.HTTPSuccess						"a message to confirm the account status update"
+//	@Failure		400			{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError						"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/crypto/{ticker}/status [patch]
+func StatusCryptoAccount(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			request     models.HTTPAdminAccountStatusRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+			clientIDStr = ginCtx.Param("clientID")
+			ticker      = ginCtx.Param("ticker")
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, payload, err = common.HTTPAdminAccountStatus(db, logger, adminID, clientIDStr,
+			ticker, true, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account status updated",
+			Payload: map[string]any{"clientID": clientIDStr, "ticker": ticker, "status": request.Status}})
+	}
+}
+
+// BalanceFiatUser will handle an HTTP request to retrieve all the Fiat currency account balances for a user account.
+//
+//	@Summary		Retrieve all the Fiat currency account balances for a user account.
+//	@Description	Retrieves all the Fiat currency account balances for a user account. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Requires the administrative read scope.
+//	@Tags			admin users fiat currency balance
+//	@Id				balanceFiatUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the client id of the user account"
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of account balances for the user's accounts"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/fiat/balance [get]
+func BalanceFiatUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			accDetails  *models.HTTPFiatDetailsPaginated
+			adminID     uuid.UUID
+			clientID    uuid.UUID
+			done        common.AdminActionDone
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if clientID, done, httpStatus, httpMessage, err = common.HTTPAdminTarget(db, logger, adminID,
+			ginCtx.Param("clientID"), postgres.AdminActionFIATACCOUNTVIEW, nil); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		accDetails, httpStatus, httpMessage, err = common.HTTPFiatBalancePaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balances", Payload: accDetails})
+	}
+}
+
+// TxDetailsFiatUser will handle an HTTP request to retrieve the Fiat currency journal entries for a user account for a
+// given month.
+//
+//	@Summary		Retrieve all the transactions for a Fiat currency account of a user account during a specified month.
+//	@Description	Retrieves the Fiat currency journal entries for a user account during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. Requires the administrative read scope.
+//	@Tags			admin users fiat currency transaction journal
+//	@Id				txDetailsFiatUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID		path		string				true	"the client id of the user account"
+//	@Param			currencyCode	path		string				true	"the currency code to retrieve the transaction details for."
+//	@Param			pageCursor		query		string				false	"The page cursor into the query results records."
+//	@Param			timezone		query		string				false	"The timezone for the month in question."
+//	@Param			month			query		int					false	"The month for which transaction records are being requested."
+//	@Param			year			query		int					false	"The year for the month for which transaction records are being requested."
+//	@Param			pageSize		query		int					false	"The number of records to retrieve on this page."
+//	@Success		200				{object}	models.HTTPSuccess	"a page of journal entries for the account"
+//	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		416				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/fiat/transactions/{currencyCode} [get]
+func TxDetailsFiatUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			journalEntries *models.HTTPFiatTransactionsPaginated
+			adminID        uuid.UUID
+			clientID       uuid.UUID
+			done           common.AdminActionDone
+			err            error
+			httpStatus     int
+			httpMessage    string
+			payload        any
+			currencyCode   = ginCtx.Param("currencyCode")
+
+			params = common.HTTPPaginatedTxParams{
+				PageSizeStr:   ginCtx.Query("pageSize"),
+				PageCursorStr: ginCtx.Query("pageCursor"),
+				TimezoneStr:   ginCtx.Query("timezone"),
+				MonthStr:      ginCtx.Query("month"),
+				YearStr:       ginCtx.Query("year"),
+			}
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if clientID, done, httpStatus, httpMessage, err = common.HTTPAdminTarget(db, logger, adminID,
+			ginCtx.Param("clientID"), postgres.AdminActionFIATJOURNALVIEW,
+			map[string]any{"currency": currencyCode}); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		journalEntries, httpStatus, httpMessage, payload, err = common.HTTPFiatTransactionsPaginated(auth, db,
+			logger, clientID, currencyCode, &params, true)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account transactions", Payload: journalEntries})
+	}
+}
+
+// BalanceCryptoUser will handle an HTTP request to retrieve all the Cryptocurrency account balances for a user
+// account.
+//
+//	@Summary		Retrieve all the Cryptocurrency account balances for a user account.
+//	@Description	Retrieves all the Cryptocurrency account balances for a user account. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Requires the administrative read scope.
+//	@Tags			admin users crypto cryptocurrency balance
+//	@Id				balanceCryptoUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the client id of the user account"
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of account balances for the user's accounts"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/crypto/balance [get]
+func BalanceCryptoUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			accDetails  models.HTTPCryptoDetailsPaginated
+			adminID     uuid.UUID
+			clientID    uuid.UUID
+			done        common.AdminActionDone
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if clientID, done, httpStatus, httpMessage, err = common.HTTPAdminTarget(db, logger, adminID,
+			ginCtx.Param("clientID"), postgres.AdminActionCRYPTOACCOUNTVIEW, nil); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		accDetails, httpStatus, httpMessage, err = common.HTTPCryptoBalancePaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balances", Payload: accDetails})
+	}
+}
+
+// TxDetailsCryptoUser will handle an HTTP request to retrieve the Cryptocurrency journal entries for a user account for
+// a given month.
+//
+//	@Summary		Retrieve all the transactions for a Cryptocurrency account of a user account during a specified month.
+//	@Description	Retrieves the Cryptocurrency journal entries for a user account during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. Requires the administrative read scope.
+//	@Tags			admin users crypto cryptocurrency transaction journal
+//	@Id				txDetailsCryptoUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the client id of the user account"
+//	@Param			ticker		path		string				true	"the Cryptocurrency ticker to retrieve the transaction details for."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			timezone	query		string				false	"The timezone for the month in question."
+//	@Param			month		query		int					false	"The month for which transaction records are being requested."
+//	@Param			year		query		int					false	"The year for the month for which transaction records are being requested."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of journal entries for the account"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		416			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/crypto/transactions/{ticker} [get]
+func TxDetailsCryptoUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			journalEntries models.HTTPCryptoTransactionsPaginated
+			adminID        uuid.UUID
+			clientID       uuid.UUID
+			done           common.AdminActionDone
+			err            error
+			httpStatus     int
+			httpMessage    string
+			ticker         = ginCtx.Param("ticker")
+
+			params = common.HTTPPaginatedTxParams{
+				PageSizeStr:   ginCtx.Query("pageSize"),
+				PageCursorStr: ginCtx.Query("pageCursor"),
+				TimezoneStr:   ginCtx.Query("timezone"),
+				MonthStr:      ginCtx.Query("month"),
+				YearStr:       ginCtx.Query("year"),
+			}
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if clientID, done, httpStatus, httpMessage, err = common.HTTPAdminTarget(db, logger, adminID,
+			ginCtx.Param("clientID"), postgres.AdminActionCRYPTOJOURNALVIEW,
+			map[string]any{"ticker": ticker}); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		journalEntries, httpStatus, httpMessage, err =
+			common.HTTPCryptoTransactionsPaginated(auth, db, logger, &params, clientID, ticker, true)
+		done(err)
+
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account transactions", Payload: journalEntries})
+	}
+}
+
+// AuditLog will handle an HTTP request to retrieve the administrative audit log.
+//
+//	@Summary		Retrieve the administrative audit log.
+//	@Description	Retrieves the administrative audit log, newest first. The entries can be restricted to a specific target such as a client id, Cryptocurrency ticker, or Fiat currency code. The initial request will only contain (optionally) the page size and target. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Viewing the audit log is itself recorded in the audit log. Requires the administrative read scope.
+//	@Tags			admin audit
+//	@Id				auditLog
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			target		query		string				false	"The target of the administrative actions to retrieve."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of audit log entries"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/audit [get]
+func AuditLog(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			auditLog    *models.HTTPAdminAuditLogPaginated
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if auditLog, httpStatus, httpMessage, err = common.HTTPAdminAuditLog(auth, db, logger, adminID,
+			ginCtx.Query("target"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "audit log", Payload: auditLog})
+	}
+}
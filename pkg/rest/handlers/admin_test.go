@@ -0,0 +1,1452 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_UpsertCryptoAsset(t *testing.T) {
+	t.Parallel()
+
+	decimalPlaces := int32(18)
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedMsg    string
+		expectedStatus int
+		request        *models.HTTPCryptoAssetRequest
+		upsertErr      error
+		upsertTimes    int
+		auditErr       error
+		auditTimes     int
+	}{
+		{
+			name:           "empty request",
+			path:           "/upsert-crypto-asset/empty-request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPCryptoAssetRequest{},
+			upsertErr:      nil,
+			upsertTimes:    0,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "invalid limits",
+			path:           "/upsert-crypto-asset/invalid-limits",
+			expectedMsg:    constants.InvalidRequestString(),
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(10), MaxOrder: decimal.NewFromFloat(1)},
+			upsertErr:   nil,
+			upsertTimes: 0,
+			auditErr:    nil,
+			auditTimes:  1,
+		}, {
+			name:           "audit failure",
+			path:           "/upsert-crypto-asset/audit-failure",
+			expectedMsg:    "could not record",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(0.001), MaxOrder: decimal.NewFromFloat(1000)},
+			upsertErr:   nil,
+			upsertTimes: 0,
+			auditErr:    postgres.ErrAuditLog,
+			auditTimes:  1,
+		}, {
+			name:           "db failure",
+			path:           "/upsert-crypto-asset/db-failure",
+			expectedMsg:    "could not register",
+			expectedStatus: http.StatusConflict,
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(0.001), MaxOrder: decimal.NewFromFloat(1000)},
+			upsertErr:   postgres.ErrCryptoAsset,
+			upsertTimes: 1,
+			auditErr:    nil,
+			auditTimes:  1,
+		}, {
+			name:           "db failure unknown",
+			path:           "/upsert-crypto-asset/db-failure-unknown",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(0.001), MaxOrder: decimal.NewFromFloat(1000)},
+			upsertErr:   errors.New("unknown server error"),
+			upsertTimes: 1,
+			auditErr:    nil,
+			auditTimes:  1,
+		}, {
+			name:           "valid",
+			path:           "/upsert-crypto-asset/valid",
+			expectedMsg:    "cryptocurrency registered",
+			expectedStatus: http.StatusOK,
+			request: &models.HTTPCryptoAssetRequest{
+				Ticker: "ETH", Name: "Ether", DecimalPlaces: &decimalPlaces,
+				MinOrder: decimal.NewFromFloat(0.001), MaxOrder: decimal.NewFromFloat(1000)},
+			upsertErr:   nil,
+			upsertTimes: 1,
+			auditErr:    nil,
+			auditTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			upsertReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().CryptoAssetUpsert(gomock.Any()).
+					Return(test.upsertErr).
+					Times(test.upsertTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.PUT(test.path, UpsertCryptoAsset(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPut, test.path, bytes.NewBuffer(upsertReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_StatusCryptoAsset(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/crypto/assets/"
+
+	var (
+		isHalted  = true
+		isEnabled = false
+	)
+
+	testCases := []struct {
+		name           string
+		ticker         string
+		expectedMsg    string
+		expectedStatus int
+		request        *models.HTTPCryptoAssetStatusRequest
+		statusErr      error
+		statusTimes    int
+		auditErr       error
+		auditTimes     int
+	}{
+		{
+			name:           "empty request",
+			ticker:         "BTC",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPCryptoAssetStatusRequest{},
+			statusErr:      nil,
+			statusTimes:    0,
+			auditErr:       nil,
+			auditTimes:     0,
+		}, {
+			name:           "audit failure",
+			ticker:         "BTC",
+			expectedMsg:    "could not record",
+			expectedStatus: http.StatusInternalServerError,
+			request:        &models.HTTPCryptoAssetStatusRequest{IsHalted: &isHalted},
+			statusErr:      nil,
+			statusTimes:    0,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+		}, {
+			name:           "not found",
+			ticker:         "XYZ",
+			expectedMsg:    "records not found",
+			expectedStatus: http.StatusNotFound,
+			request:        &models.HTTPCryptoAssetStatusRequest{IsHalted: &isHalted},
+			statusErr:      postgres.ErrNotFound,
+			statusTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "db failure unknown",
+			ticker:         "BTC",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			request:        &models.HTTPCryptoAssetStatusRequest{IsHalted: &isHalted},
+			statusErr:      errors.New("unknown server error"),
+			statusTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "valid halt",
+			ticker:         "BTC",
+			expectedMsg:    "trading status updated",
+			expectedStatus: http.StatusOK,
+			request:        &models.HTTPCryptoAssetStatusRequest{IsHalted: &isHalted},
+			statusErr:      nil,
+			statusTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "valid enable",
+			ticker:         "BTC",
+			expectedMsg:    "trading status updated",
+			expectedStatus: http.StatusOK,
+			request:        &models.HTTPCryptoAssetStatusRequest{IsHalted: &isEnabled},
+			statusErr:      nil,
+			statusTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			statusReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().CryptoAssetSetStatus(test.ticker, gomock.Any()).
+					Return(test.statusErr).
+					Times(test.statusTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.PATCH(basePath+tickerPathVar+"/status", StatusCryptoAsset(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPatch, basePath+test.ticker+"/status",
+				bytes.NewBuffer(statusReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_UpsertFiatCurrency(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedMsg    string
+		expectedStatus int
+		request        *models.HTTPFiatCurrencyRequest
+		upsertErr      error
+		upsertTimes    int
+		auditErr       error
+		auditTimes     int
+	}{
+		{
+			name:           "empty request",
+			path:           "/upsert-fiat-currency/empty-request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPFiatCurrencyRequest{},
+			upsertErr:      nil,
+			upsertTimes:    0,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "invalid status",
+			path:           "/upsert-fiat-currency/invalid-status",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPFiatCurrencyRequest{Code: "ZWD", Status: "RETIRED"},
+			upsertErr:      nil,
+			upsertTimes:    0,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "audit failure",
+			path:           "/upsert-fiat-currency/audit-failure",
+			expectedMsg:    "could not record",
+			expectedStatus: http.StatusInternalServerError,
+			request:        &models.HTTPFiatCurrencyRequest{Code: "ZWD", Status: "WITHDRAWN"},
+			upsertErr:      nil,
+			upsertTimes:    0,
+			auditErr:       postgres.ErrAuditLog,
+			auditTimes:     1,
+		}, {
+			name:           "db failure",
+			path:           "/upsert-fiat-currency/db-failure",
+			expectedMsg:    "could not register",
+			expectedStatus: http.StatusConflict,
+			request:        &models.HTTPFiatCurrencyRequest{Code: "ZWD", Status: "WITHDRAWN"},
+			upsertErr:      postgres.ErrFiatCurrency,
+			upsertTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "db failure unknown",
+			path:           "/upsert-fiat-currency/db-failure-unknown",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			request:        &models.HTTPFiatCurrencyRequest{Code: "ZWD", Status: "WITHDRAWN"},
+			upsertErr:      errors.New("unknown server error"),
+			upsertTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		}, {
+			name:           "valid",
+			path:           "/upsert-fiat-currency/valid",
+			expectedMsg:    "fiat currency updated",
+			expectedStatus: http.StatusOK,
+			request:        &models.HTTPFiatCurrencyRequest{Code: "ZWD", Status: "WITHDRAWN"},
+			upsertErr:      nil,
+			upsertTimes:    1,
+			auditErr:       nil,
+			auditTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			upsertReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatCurrencyUpsert(gomock.Any(), gomock.Any()).
+					Return(test.upsertErr).
+					Times(test.upsertTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.PUT(test.path, UpsertFiatCurrency(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPut, test.path, bytes.NewBuffer(upsertReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_SearchUsers(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/users/search"
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		searchErr          error
+		searchTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "?query=username&limit=5",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			searchErr:          nil,
+			searchTimes:        0,
+		}, {
+			name:               "short query",
+			querySegment:       "?query=us",
+			expectedMsg:        "at least 3 characters",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			searchErr:          nil,
+			searchTimes:        0,
+		}, {
+			name:               "audit failure",
+			querySegment:       "?query=username&limit=5",
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			searchErr:          nil,
+			searchTimes:        0,
+		}, {
+			name:               "not found",
+			querySegment:       "?query=username&limit=5",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			searchErr:          postgres.ErrNotFound,
+			searchTimes:        1,
+		}, {
+			name:               "valid",
+			querySegment:       "?query=username&limit=5",
+			expectedMsg:        "user accounts",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			searchErr:          nil,
+			searchTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERSEARCH, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserSearch("username", int32(5)).
+					Return([]modelsPostgres.UserProfile{{}}, test.searchErr).
+					Times(test.searchTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, SearchUsers(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_ViewUser(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/users/"
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name               string
+		clientID           string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		userInfoErr        error
+		userInfoTimes      int
+	}{
+		{
+			name:               "invalid JWT",
+			clientID:           clientID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			userInfoErr:        nil,
+			userInfoTimes:      0,
+		}, {
+			name:               "invalid client id",
+			clientID:           "invalid-client-id",
+			expectedMsg:        "invalid client id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			userInfoErr:        nil,
+			userInfoTimes:      0,
+		}, {
+			name:               "audit failure",
+			clientID:           clientID.String(),
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			userInfoErr:        nil,
+			userInfoTimes:      0,
+		}, {
+			name:               "not found",
+			clientID:           clientID.String(),
+			expectedMsg:        "user account not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			userInfoErr:        postgres.ErrNotFound,
+			userInfoTimes:      1,
+		}, {
+			name:               "valid",
+			clientID:           clientID.String(),
+			expectedMsg:        "user account",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			userInfoErr:        nil,
+			userInfoTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERVIEW, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}, ClientID: clientID},
+						test.userInfoErr).
+					Times(test.userInfoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":clientID", ViewUser(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.clientID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_FreezeUser(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/users/"
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	isFrozen := true
+
+	testCases := []struct {
+		name               string
+		clientID           string
+		expectedMsg        string
+		expectedStatus     int
+		request            *models.HTTPAdminFreezeRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		setFrozenErr       error
+		setFrozenTimes     int
+	}{
+		{
+			name:               "empty request",
+			clientID:           clientID.String(),
+			expectedMsg:        constants.ValidationString(),
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminFreezeRequest{},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			setFrozenErr:       nil,
+			setFrozenTimes:     0,
+		}, {
+			name:               "invalid JWT",
+			clientID:           clientID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			setFrozenErr:       nil,
+			setFrozenTimes:     0,
+		}, {
+			name:               "self freeze",
+			clientID:           uuid.UUID{}.String(),
+			expectedMsg:        "own account",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			setFrozenErr:       nil,
+			setFrozenTimes:     0,
+		}, {
+			name:               "audit failure",
+			clientID:           clientID.String(),
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			setFrozenErr:       nil,
+			setFrozenTimes:     0,
+		}, {
+			name:               "not found",
+			clientID:           clientID.String(),
+			expectedMsg:        "not found or deleted",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			setFrozenErr:       postgres.ErrNotFoundUser,
+			setFrozenTimes:     1,
+		}, {
+			name:               "valid",
+			clientID:           clientID.String(),
+			expectedMsg:        "freeze status updated",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminFreezeRequest{IsFrozen: &isFrozen, Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			setFrozenErr:       nil,
+			setFrozenTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			freezeReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERFREEZE, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserSetFrozen(clientID, true).
+					Return(test.setFrozenErr).
+					Times(test.setFrozenTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.PATCH(basePath+":clientID/freeze", FreezeUser(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPatch, basePath+test.clientID+"/freeze",
+				bytes.NewBuffer(freezeReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_StatusAccount(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/users/"
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name               string
+		clientID           string
+		isCrypto           bool
+		expectedMsg        string
+		expectedStatus     int
+		request            *models.HTTPAdminAccountStatusRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		fiatErr            error
+		fiatTimes          int
+		cryptoErr          error
+		cryptoTimes        int
+	}{
+		{
+			name:               "empty request",
+			clientID:           clientID.String(),
+			isCrypto:           false,
+			expectedMsg:        constants.ValidationString(),
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminAccountStatusRequest{},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			fiatErr:            nil,
+			fiatTimes:          0,
+			cryptoErr:          nil,
+			cryptoTimes:        0,
+		}, {
+			name:               "invalid JWT",
+			clientID:           clientID.String(),
+			isCrypto:           false,
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			fiatErr:            nil,
+			fiatTimes:          0,
+			cryptoErr:          nil,
+			cryptoTimes:        0,
+		}, {
+			name:               "invalid client id",
+			clientID:           "invalid-client-id",
+			isCrypto:           false,
+			expectedMsg:        "invalid client id",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			fiatErr:            nil,
+			fiatTimes:          0,
+			cryptoErr:          nil,
+			cryptoTimes:        0,
+		}, {
+			name:               "audit failure",
+			clientID:           clientID.String(),
+			isCrypto:           false,
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			fiatErr:            nil,
+			fiatTimes:          0,
+			cryptoErr:          nil,
+			cryptoTimes:        0,
+		}, {
+			name:               "fiat not found",
+			clientID:           clientID.String(),
+			isCrypto:           false,
+			expectedMsg:        "not found or closed",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			fiatErr:            postgres.ErrNotFound,
+			fiatTimes:          1,
+			cryptoErr:          nil,
+			cryptoTimes:        0,
+		}, {
+			name:               "crypto not found",
+			clientID:           clientID.String(),
+			isCrypto:           true,
+			expectedMsg:        "not found or closed",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			fiatErr:            nil,
+			fiatTimes:          0,
+			cryptoErr:          postgres.ErrNotFound,
+			cryptoTimes:        1,
+		}, {
+			name:               "fiat valid",
+			clientID:           clientID.String(),
+			isCrypto:           false,
+			expectedMsg:        "account status updated",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			fiatErr:            nil,
+			fiatTimes:          1,
+			cryptoErr:          nil,
+			cryptoTimes:        0,
+		}, {
+			name:               "crypto valid",
+			clientID:           clientID.String(),
+			isCrypto:           true,
+			expectedMsg:        "account status updated",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminAccountStatusRequest{Status: "FROZEN", Reason: "suspicious activity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			fiatErr:            nil,
+			fiatTimes:          0,
+			cryptoErr:          nil,
+			cryptoTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			statusReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), gomock.Any(), clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().FiatAccountSetStatus(clientID, postgres.Currency("USD"), postgres.AccountStatusFROZEN).
+					Return(test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockDB.EXPECT().CryptoAccountSetStatus(clientID, "BTC", postgres.AccountStatusFROZEN).
+					Return(test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+
+			// Endpoint setup for test.
+			path := basePath + test.clientID + "/fiat/USD/status"
+			router := gin.Default()
+			router.PATCH(basePath+":clientID/fiat/:currencyCode/status", StatusFiatAccount(zapLogger, mockAuth, mockDB))
+			router.PATCH(basePath+":clientID/crypto/:ticker/status", StatusCryptoAccount(zapLogger, mockAuth, mockDB))
+
+			if test.isCrypto {
+				path = basePath + test.clientID + "/crypto/BTC/status"
+			}
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPatch, path, bytes.NewBuffer(statusReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminAccountViews(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	decryptedCursor := fmt.Sprintf("%s,%s,%d",
+		fmt.Sprintf(constants.MonthFormatString(), 2023, 6, "-04:00"),
+		fmt.Sprintf(constants.MonthFormatString(), 2023, 7, "-04:00"),
+		10)
+
+	fiatBalance := BalanceFiatUser
+	fiatTx := TxDetailsFiatUser
+	cryptoBalance := BalanceCryptoUser
+	cryptoTx := TxDetailsCryptoUser
+
+	testCases := []struct {
+		name               string
+		route              string
+		path               string
+		handler            func(*logger.Logger, auth.Auth, postgres.Postgres) gin.HandlerFunc
+		action             postgres.AdminAction
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		cursorTimes        int
+		fiatBalTimes       int
+		fiatTxTimes        int
+		cryptoBalTimes     int
+		cryptoTxTimes      int
+	}{
+		{
+			name:               "fiat balance invalid JWT",
+			route:              "/admin/users/:clientID/fiat/balance",
+			path:               "/admin/users/" + clientID.String() + "/fiat/balance",
+			handler:            fiatBalance,
+			action:             postgres.AdminActionFIATACCOUNTVIEW,
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "fiat balance invalid client id",
+			route:              "/admin/users/:clientID/fiat/balance",
+			path:               "/admin/users/invalid-client-id/fiat/balance",
+			handler:            fiatBalance,
+			action:             postgres.AdminActionFIATACCOUNTVIEW,
+			expectedMsg:        "invalid client id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "fiat balance audit failure",
+			route:              "/admin/users/:clientID/fiat/balance",
+			path:               "/admin/users/" + clientID.String() + "/fiat/balance",
+			handler:            fiatBalance,
+			action:             postgres.AdminActionFIATACCOUNTVIEW,
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "fiat balance valid",
+			route:              "/admin/users/:clientID/fiat/balance",
+			path:               "/admin/users/" + clientID.String() + "/fiat/balance?pageSize=3",
+			handler:            fiatBalance,
+			action:             postgres.AdminActionFIATACCOUNTVIEW,
+			expectedMsg:        "account balances",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			cursorTimes:        0,
+			fiatBalTimes:       1,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "fiat transactions audit failure",
+			route:              "/admin/users/:clientID/fiat/transactions/:currencyCode",
+			path:               "/admin/users/" + clientID.String() + "/fiat/transactions/USD?pageCursor=cursor",
+			handler:            fiatTx,
+			action:             postgres.AdminActionFIATJOURNALVIEW,
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "fiat transactions valid",
+			route:              "/admin/users/:clientID/fiat/transactions/:currencyCode",
+			path:               "/admin/users/" + clientID.String() + "/fiat/transactions/USD?pageCursor=cursor",
+			handler:            fiatTx,
+			action:             postgres.AdminActionFIATJOURNALVIEW,
+			expectedMsg:        "account transactions",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			cursorTimes:        1,
+			fiatBalTimes:       0,
+			fiatTxTimes:        1,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "crypto balance audit failure",
+			route:              "/admin/users/:clientID/crypto/balance",
+			path:               "/admin/users/" + clientID.String() + "/crypto/balance",
+			handler:            cryptoBalance,
+			action:             postgres.AdminActionCRYPTOACCOUNTVIEW,
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "crypto balance valid",
+			route:              "/admin/users/:clientID/crypto/balance",
+			path:               "/admin/users/" + clientID.String() + "/crypto/balance?pageSize=3",
+			handler:            cryptoBalance,
+			action:             postgres.AdminActionCRYPTOACCOUNTVIEW,
+			expectedMsg:        "account balances",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     1,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "crypto transactions audit failure",
+			route:              "/admin/users/:clientID/crypto/transactions/" + tickerPathVar,
+			path:               "/admin/users/" + clientID.String() + "/crypto/transactions/BTC?pageCursor=cursor",
+			handler:            cryptoTx,
+			action:             postgres.AdminActionCRYPTOJOURNALVIEW,
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			cursorTimes:        0,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      0,
+		}, {
+			name:               "crypto transactions valid",
+			route:              "/admin/users/:clientID/crypto/transactions/" + tickerPathVar,
+			path:               "/admin/users/" + clientID.String() + "/crypto/transactions/BTC?pageCursor=cursor",
+			handler:            cryptoTx,
+			action:             postgres.AdminActionCRYPTOJOURNALVIEW,
+			expectedMsg:        "account transactions",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			cursorTimes:        1,
+			fiatBalTimes:       0,
+			fiatTxTimes:        0,
+			cryptoBalTimes:     0,
+			cryptoTxTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), test.action, clientID.String(), gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+			)
+
+			mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+				Return([]byte(decryptedCursor), nil).
+				Times(test.cursorTimes)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).
+				Return("encrypted-cursor", nil).
+				Times(test.cursorTimes)
+
+			mockDB.EXPECT().FiatBalancePaginated(clientID, gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatAccountBalance{{}}, nil).
+				Times(test.fiatBalTimes)
+
+			mockDB.EXPECT().FiatTransactionsPaginated(clientID, gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatJournal{{}}, nil).
+				Times(test.fiatTxTimes)
+
+			mockDB.EXPECT().CryptoBalancesPaginated(clientID, gomock.Any(), gomock.Any()).
+				Return([]postgres.CryptoAccountBalance{{}}, nil).
+				Times(test.cryptoBalTimes)
+
+			mockDB.EXPECT().CryptoTransactionsPaginated(clientID, gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any()).
+				Return([]postgres.CryptoJournal{{}}, nil).
+				Times(test.cryptoTxTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(test.route, test.handler(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, test.path, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AuditLog(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/audit"
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		auditLogErr        error
+		auditLogTimes      int
+		encryptTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "?pageSize=3",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			auditLogErr:        nil,
+			auditLogTimes:      0,
+			encryptTimes:       0,
+		}, {
+			name:               "invalid page size",
+			querySegment:       "?pageSize=three",
+			expectedMsg:        "invalid page cursor or page size",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			auditLogErr:        nil,
+			auditLogTimes:      0,
+			encryptTimes:       0,
+		}, {
+			name:               "audit failure",
+			querySegment:       "?pageSize=3",
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			auditLogErr:        nil,
+			auditLogTimes:      0,
+			encryptTimes:       0,
+		}, {
+			name:               "not found",
+			querySegment:       "?pageSize=3&target=BTC",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			auditLogErr:        postgres.ErrNotFound,
+			auditLogTimes:      1,
+			encryptTimes:       0,
+		}, {
+			name:               "valid",
+			querySegment:       "?pageSize=3&target=BTC",
+			expectedMsg:        "audit log",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			auditLogErr:        nil,
+			auditLogTimes:      1,
+			encryptTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionAUDITLOGVIEW, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AdminAuditLogPaginated(gomock.Any(), gomock.Any(), int32(4)).
+					Return([]postgres.AdminAuditLog{{ID: 4}, {ID: 3}, {ID: 2}, {ID: 1}}, test.auditLogErr).
+					Times(test.auditLogTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, AuditLog(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// CreateAPIKey will handle an HTTP request to create a scoped API key for programmatic access.
+//
+//	@Summary		Create an API key.
+//	@Description	Creates an API key for programmatic access with the read, trade, and/or transfer scopes. Keys may be restricted to a list of IP addresses and CIDR ranges and may expire at a Unix timestamp. The secret used to sign requests is only returned in the response to this request. API keys cannot be used to manage API keys.
+//	@Tags			user users api-key api-keys create
+//	@Id				createAPIKey
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPAPIKeyRequest	true	"the name, scopes, and optional IP allow-list and expiry of the API key"
+//	@Param			X-OTP	header		string						false	"one-time password or recovery code when two-factor authentication is enabled"
+//	@Success		201		{object}	models.HTTPSuccess			"the API key details and its secret"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/api-keys [post]
+func CreateAPIKey(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			key         *models.HTTPAPIKeyResponse
+			request     models.HTTPAPIKeyRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if key, httpStatus, httpMessage, payload, err =
+			common.HTTPAPIKeyCreate(auth, db, logger, clientID, &request, ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "API key created", Payload: key})
+	}
+}
+
+// APIKeys will handle an HTTP request to retrieve the details of all the API keys a client has created.
+//
+//	@Summary		Retrieve API keys.
+//	@Description	Retrieves the details of all the API keys a client has created, including revoked and expired keys, newest first. Secrets are never returned.
+//	@Tags			user users api-key api-keys details
+//	@Id				apiKeys
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the details of the API keys"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/api-keys [get]
+func APIKeys(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			keys        []modelsPostgres.APIKeyInfo
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if keys, httpStatus, httpMessage, err = common.HTTPAPIKeys(db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "API keys", Payload: keys})
+	}
+}
+
+// RevokeAPIKey will handle an HTTP request to revoke an active API key.
+//
+//	@Summary		Revoke an API key.
+//	@Description	Revokes an active API key. Requests signed with a revoked key are rejected.
+//	@Tags			user users api-key api-keys revoke
+//	@Id				revokeAPIKey
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			keyID	path		string				true	"the API key ID to revoke"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the revocation of the API key"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/api-keys/{keyID} [delete]
+func RevokeAPIKey(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			keyID       = ginCtx.Param("keyID")
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPAPIKeyRevoke(db, logger, clientID, keyID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: keyID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "API key revoked", Payload: keyID})
+	}
+}
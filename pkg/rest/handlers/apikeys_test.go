@@ -0,0 +1,296 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPAPIKeyRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		encryptTimes     int
+		createErr        error
+		createTimes      int
+	}{
+		{
+			name:             "valid",
+			path:             "/api-keys/valid",
+			expectedStatus:   http.StatusCreated,
+			request:          &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"read", "trade"}},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			encryptTimes:     1,
+			createErr:        nil,
+			createTimes:      1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/api-keys/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"read", "trade"}},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			encryptTimes:     0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/api-keys/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"withdraw"}},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			encryptTimes:     0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:             "key limit",
+			path:             "/api-keys/key-limit",
+			expectedStatus:   http.StatusConflict,
+			request:          &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"read"}},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			encryptTimes:     1,
+			createErr:        postgres.ErrAPIKeyLimit,
+			createTimes:      1,
+		}, {
+			name:             "db failure unknown",
+			path:             "/api-keys/db-failure-unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPAPIKeyRequest{Name: "bot", Scopes: []string{"read"}},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			encryptTimes:     1,
+			createErr:        errors.New("unknown server error"),
+			createTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			keyReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.encryptTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-secret", nil).
+					Times(test.encryptTimes),
+
+				mockPostgres.EXPECT().APIKeyCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, CreateAPIKey(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(keyReqJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		expectedStatus   int
+		authTokenInfoErr error
+		keysErr          error
+		keysTimes        int
+	}{
+		{
+			name:             "invalid jwt",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			keysErr:          nil,
+			keysTimes:        0,
+		}, {
+			name:             "db failure unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			keysErr:          errors.New("unknown server error"),
+			keysTimes:        1,
+		}, {
+			name:             "valid",
+			expectedStatus:   http.StatusOK,
+			authTokenInfoErr: nil,
+			keysErr:          nil,
+			keysTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(1),
+
+				mockDB.EXPECT().APIKeysClient(gomock.Any()).
+					Return([]modelsPostgres.APIKeyInfo{{KeyID: "key-id"}}, test.keysErr).
+					Times(test.keysTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/user/api-keys", APIKeys(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/user/api-keys", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_RevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/user/api-keys/"
+
+	testCases := []struct {
+		name               string
+		keyID              string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		revokeErr          error
+		revokeTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			keyID:              "key-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "invalid key id",
+			keyID:              "an-api-key-id-that-is-far-too-long-to-be-valid",
+			expectedMsg:        "invalid API key id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "not found",
+			keyID:              "key-id",
+			expectedMsg:        "not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			revokeErr:          postgres.ErrNotFound,
+			revokeTimes:        1,
+		}, {
+			name:               "valid",
+			keyID:              "key-id",
+			expectedMsg:        "API key revoked",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			revokeErr:          nil,
+			revokeTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().APIKeyRevoke(gomock.Any(), test.keyID).
+					Return(test.revokeErr).
+					Times(test.revokeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":keyID", RevokeAPIKey(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.keyID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
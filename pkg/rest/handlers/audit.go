@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// AuditEvents will handle an HTTP request to retrieve the security audit log.
+//
+//	@Summary		Retrieve the security audit log.
+//	@Description	Retrieves the security audit log of authentication, account, and money movement events, newest first. The events can be restricted to those about a specific client, of a specific type, or with a specific outcome. The initial request will only contain (optionally) the page size and filters. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. Viewing the security audit log is recorded in the administrative audit log. Requires the administrative read scope.
+//	@Tags			admin audit security
+//	@Id				auditEvents
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	query		string				false	"The client id the events are about."
+//	@Param			eventType	query		string				false	"The type of the events to retrieve."
+//	@Param			outcome		query		string				false	"The outcome of the events to retrieve: SUCCESS or FAILURE."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of security audit events"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/audit/events [get]
+func AuditEvents(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			events      *models.HTTPAuditEventsPaginated
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if events, httpStatus, httpMessage, err = common.HTTPAdminAuditEvents(auth, db, logger, adminID,
+			&common.HTTPAuditEventsParams{
+				ClientIDStr:   ginCtx.Query("clientID"),
+				EventType:     ginCtx.Query("eventType"),
+				Outcome:       ginCtx.Query("outcome"),
+				PageCursorStr: ginCtx.Query("pageCursor"),
+				PageSizeStr:   ginCtx.Query("pageSize"),
+			}, true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "audit events", Payload: events})
+	}
+}
+
+// SecurityActivity will handle an HTTP request to retrieve the recent security activity on a user account.
+//
+//	@Summary		Retrieve recent security activity.
+//	@Description	Retrieves the most recent logins, token refreshes, password changes, and money movements on a user account, newest first, along with the device and IP address each originated from and whether it succeeded.
+//	@Tags			user users security activity
+//	@Id				securityActivity
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the recent security activity"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/security-activity [get]
+func SecurityActivity(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			events      []modelsPostgres.SecurityEvent
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if events, httpStatus, httpMessage, err = common.HTTPSecurityActivity(db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recent security activity", Payload: events})
+	}
+}
@@ -0,0 +1,224 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_AuditEvents(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/audit/events"
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		eventsErr          error
+		eventsTimes        int
+		encryptTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "?pageSize=3",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			eventsErr:          nil,
+			eventsTimes:        0,
+			encryptTimes:       0,
+		}, {
+			name:               "invalid event type",
+			querySegment:       "?pageSize=3&eventType=LOGOUT",
+			expectedMsg:        "invalid audit event type",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			eventsErr:          nil,
+			eventsTimes:        0,
+			encryptTimes:       0,
+		}, {
+			name:               "audit failure",
+			querySegment:       "?pageSize=3",
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			eventsErr:          nil,
+			eventsTimes:        0,
+			encryptTimes:       0,
+		}, {
+			name:               "not found",
+			querySegment:       "?pageSize=3&outcome=FAILURE",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			eventsErr:          postgres.ErrNotFound,
+			eventsTimes:        1,
+			encryptTimes:       0,
+		}, {
+			name:               "valid",
+			querySegment:       "?pageSize=3&eventType=LOGIN&outcome=FAILURE",
+			expectedMsg:        "audit events",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			eventsErr:          nil,
+			eventsTimes:        1,
+			encryptTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionAUDITEVENTVIEW, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().AuditEventsPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), int32(4)).
+					Return([]postgres.AuditEvent{{EventID: 4}, {EventID: 3}, {EventID: 2}, {EventID: 1}}, test.eventsErr).
+					Times(test.eventsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.encryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, AuditEvents(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_SecurityActivity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		expectedStatus   int
+		authTokenInfoErr error
+		eventsErr        error
+		eventsTimes      int
+	}{
+		{
+			name:             "invalid jwt",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			eventsErr:        nil,
+			eventsTimes:      0,
+		}, {
+			name:             "db failure unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			eventsErr:        errors.New("unknown server error"),
+			eventsTimes:      1,
+		}, {
+			name:             "db failure known",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			eventsErr:        postgres.ErrAuditEvent,
+			eventsTimes:      1,
+		}, {
+			name:             "valid",
+			expectedStatus:   http.StatusOK,
+			authTokenInfoErr: nil,
+			eventsErr:        nil,
+			eventsTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(1),
+
+				mockDB.EXPECT().AuditEventsRecent(gomock.Any(), gomock.Any()).
+					Return([]modelsPostgres.SecurityEvent{{EventType: "LOGIN"}}, test.eventsErr).
+					Times(test.eventsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/user/security-activity", SecurityActivity(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/user/security-activity", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -1,54 +1,149 @@
 package rest
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"go.uber.org/zap"
 )
 
-// AuthMiddleware is the middleware that checks whether a JWT is valid and can access an endpoint.
-func AuthMiddleware(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, authHeaderKey string) gin.HandlerFunc {
+// AuthMiddleware is the middleware that checks whether a JWT that has not been revoked, or a request signed with an API
+// key, is valid and can access an endpoint. API keys must carry all the listed scopes and cannot access endpoints that do
+// not list any scopes. Requests signed with a verified API key are rate limited by the key.
+func AuthMiddleware(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	authHeaderKey string, apiKeyScopes ...string) gin.HandlerFunc {
 	handler := func(context *gin.Context) {
 		var (
 			err         error
 			clientID    uuid.UUID
 			expiresAt   int64
-			isDeleted   bool
+			httpStatus  int
+			httpMessage string
+			status      modelsPostgres.UserStatus
 			tokenString = context.GetHeader(authHeaderKey)
 		)
 
-		if tokenString == "" {
+		switch {
+		case tokenString == "" && context.GetHeader(constants.APIKeyHeader()) != "":
+			if clientID, expiresAt, httpStatus, httpMessage, err =
+				apiKeyAuthenticate(auth, cache, db, logger, context, apiKeyScopes...); err != nil {
+				context.JSON(httpStatus, httpMessage)
+				context.Abort()
+
+				return
+			}
+
+			if !rateLimitAPIKey(context, cache, logger, context.GetHeader(constants.APIKeyHeader())) {
+				return
+			}
+
+		case tokenString == "":
 			context.JSON(http.StatusUnauthorized, "request does not contain an access token")
 			context.Abort()
 
 			return
+
+		default:
+			if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
+				context.JSON(http.StatusForbidden, "request contains invalid or expired authorization token")
+				context.Abort()
+
+				return
+			}
+
+			if httpStatus, httpMessage, err =
+				common.HTTPJWTRevoked(auth, cache, logger, clientID, tokenString); err != nil {
+				context.JSON(httpStatus, httpMessage)
+				context.Abort()
+
+				return
+			}
 		}
 
-		if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
+		// Check for user deleted and frozen status.
+		if status, err = db.UserGetStatus(clientID); err != nil {
+			logger.Error("unable to retrieve client account status", zap.Error(err))
+
+			context.JSON(http.StatusInternalServerError, constants.RetryMessageString())
+			context.Abort()
+
+			return
+		}
+
+		if status.IsDeleted {
 			context.JSON(http.StatusForbidden, "request contains invalid or expired authorization token")
 			context.Abort()
 
 			return
 		}
 
-		// Check for user deleted status.
-		if isDeleted, err = db.UserIsDeleted(clientID); err != nil {
-			logger.Error("unable to retrieve client account status", zap.Error(err))
+		if status.IsFrozen {
+			context.JSON(http.StatusForbidden, constants.FrozenAccountString())
+			context.Abort()
 
-			context.JSON(http.StatusInternalServerError, constants.RetryMessageString())
+			return
+		}
+
+		// Store the extracted ClientID and expiration deadline values in the Gin context for handlers.
+		context.Set(constants.ClientIDCtxKey(), clientID)
+		context.Set(constants.ExpiresAtCtxKey(), expiresAt)
+
+		context.Next()
+	}
+
+	return handler
+}
+
+// AdminMiddleware is the middleware that checks whether a JWT is valid, has not been revoked, carries the required
+// administrative scopes, and belongs to an active user whose current role still grants those scopes.
+func AdminMiddleware(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	authHeaderKey string, scopes ...string) gin.HandlerFunc {
+	handler := func(context *gin.Context) {
+		var (
+			err         error
+			clientID    uuid.UUID
+			expiresAt   int64
+			httpStatus  int
+			httpMessage string
+			tokenString = context.GetHeader(authHeaderKey)
+		)
+
+		if tokenString == "" {
+			context.JSON(http.StatusUnauthorized, "request does not contain an access token")
 			context.Abort()
 
 			return
 		}
 
-		if isDeleted {
-			context.JSON(http.StatusForbidden, "request contains invalid or expired authorization token")
+		if clientID, expiresAt, err = auth.ValidateJWTScopes(tokenString, scopes...); err != nil {
+			context.JSON(http.StatusForbidden, "administrative privileges required")
+			context.Abort()
+
+			return
+		}
+
+		if httpStatus, httpMessage, err =
+			common.HTTPJWTRevoked(auth, cache, logger, clientID, tokenString); err != nil {
+			context.JSON(httpStatus, httpMessage)
+			context.Abort()
+
+			return
+		}
+
+		// Check the administrator's current account status and role.
+		if httpStatus, httpMessage, err = common.HTTPAdminAuthorize(db, logger, clientID, scopes...); err != nil {
+			context.JSON(httpStatus, httpMessage)
 			context.Abort()
 
 			return
@@ -63,3 +158,40 @@ func AuthMiddleware(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
 
 	return handler
 }
+
+// apiKeyAuthenticate will authenticate a request signed with an API key and check that the key carries the scopes. The
+// request body is restored after it has been read for the signature check.
+func apiKeyAuthenticate(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	context *gin.Context, scopes ...string) (uuid.UUID, int64, int, string, error) {
+	var (
+		err         error
+		body        []byte
+		key         *postgres.APIKey
+		expiresAt   int64
+		httpStatus  int
+		httpMessage string
+	)
+
+	if context.Request.Body != nil {
+		if body, err = io.ReadAll(context.Request.Body); err != nil {
+			return uuid.UUID{}, -1, http.StatusBadRequest, "unable to read request body", fmt.Errorf("%w", err)
+		}
+
+		context.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if key, httpStatus, httpMessage, err = common.HTTPAPIKeyAuthenticate(
+		auth, cache, db, logger, context.Request, context.ClientIP(), body); err != nil {
+		return uuid.UUID{}, -1, httpStatus, httpMessage, fmt.Errorf("%w", err)
+	}
+
+	if httpStatus, httpMessage, err = common.HTTPAPIKeyAuthorize(key, scopes...); err != nil {
+		return uuid.UUID{}, -1, httpStatus, httpMessage, fmt.Errorf("%w", err)
+	}
+
+	if key.ExpiresAt.Valid {
+		expiresAt = key.ExpiresAt.Time.Unix()
+	}
+
+	return key.ClientID, expiresAt, 0, "", nil
+}
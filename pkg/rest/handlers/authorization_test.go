@@ -3,15 +3,24 @@ package rest
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestAuthMiddleware(t *testing.T) {
@@ -20,9 +29,10 @@ func TestAuthMiddleware(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockAuth := mocks.NewMockAuth(mockCtrl)
+	mockCache := mocks.NewMockRedis(mockCtrl)
 	mockDB := mocks.NewMockPostgres(mockCtrl)
 
-	handler := AuthMiddleware(mockAuth, mockDB, zapLogger, "Authorization")
+	handler := AuthMiddleware(mockAuth, mockCache, mockDB, zapLogger, "Authorization")
 	require.NotNil(t, handler)
 }
 
@@ -37,9 +47,13 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		authJWTError      error
 		authJWTExpiration int64
 		authJWTTimes      int
+		sessionTimes      int
+		denylistErr       error
+		revokedTimes      int
 		isDeletedError    error
 		isDeletedTimes    int
 		isDeletedValue    bool
+		isFrozenValue     bool
 		expectedStatus    int
 	}{
 		{
@@ -51,6 +65,9 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      0,
+			sessionTimes:      0,
+			denylistErr:       nil,
+			revokedTimes:      0,
 			isDeletedError:    nil,
 			isDeletedTimes:    0,
 			isDeletedValue:    false,
@@ -63,6 +80,9 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      errors.New("JWT validation failure"),
 			authJWTTimes:      1,
+			sessionTimes:      0,
+			denylistErr:       nil,
+			revokedTimes:      0,
 			isDeletedError:    nil,
 			isDeletedTimes:    0,
 			isDeletedValue:    false,
@@ -75,6 +95,9 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      1,
+			sessionTimes:      1,
+			denylistErr:       redis.ErrCacheMiss,
+			revokedTimes:      1,
 			isDeletedError:    errors.New("db failure"),
 			isDeletedTimes:    1,
 			isDeletedValue:    false,
@@ -87,9 +110,43 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      1,
+			sessionTimes:      1,
+			denylistErr:       redis.ErrCacheMiss,
+			revokedTimes:      1,
 			isDeletedError:    nil,
 			isDeletedTimes:    1,
 			isDeletedValue:    true,
+		}, {
+			name:              "frozen account",
+			path:              "/auth-middleware/frozen-account",
+			token:             "valid-token",
+			expectedStatus:    http.StatusForbidden,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionTimes:      1,
+			denylistErr:       redis.ErrCacheMiss,
+			revokedTimes:      1,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenValue:     true,
+		}, {
+			name:              "revoked token",
+			path:              "/auth-middleware/revoked-token",
+			token:             "revoked-token",
+			expectedStatus:    http.StatusForbidden,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionTimes:      1,
+			denylistErr:       nil,
+			revokedTimes:      0,
+			isDeletedError:    nil,
+			isDeletedTimes:    0,
+			isDeletedValue:    false,
 		}, {
 			name:              "valid token",
 			path:              "/auth-middleware/valid-token",
@@ -99,6 +156,9 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      1,
+			sessionTimes:      1,
+			denylistErr:       redis.ErrCacheMiss,
+			revokedTimes:      1,
 			isDeletedError:    nil,
 			isDeletedTimes:    1,
 			isDeletedValue:    false,
@@ -115,6 +175,7 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			gomock.InOrder(
@@ -125,14 +186,330 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 						test.authJWTError,
 					).Times(test.authJWTTimes),
 
-				mockDB.EXPECT().UserIsDeleted(gomock.Any()).
-					Return(test.isDeletedValue, test.isDeletedError).
+				mockAuth.EXPECT().TokenSession(test.token).
+					Return("token-id", time.Now().UnixMilli(), nil).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-denylist:token-id", gomock.Any()).
+					Return(test.denylistErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-revoked-before:"+test.authJWTUUID.String(), gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.revokedTimes),
+
+				mockDB.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{IsDeleted: test.isDeletedValue, IsFrozen: test.isFrozenValue},
+						test.isDeletedError).
 					Times(test.isDeletedTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, AuthMiddleware(mockAuth, mockDB, zapLogger, "Authorization"))
+			router.POST(test.path, AuthMiddleware(mockAuth, mockCache, mockDB, zapLogger, "Authorization"))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			req.Header.Set("Authorization", test.token)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestAuthMiddleware_APIKeyHandler(t *testing.T) {
+	t.Parallel()
+
+	secret := "api-key-secret"
+	body := `{"currency":"USD"}`
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	activeKey := postgres.APIKey{KeyID: "key-id", Secret: "encrypted", Scopes: []string{constants.APIKeyScopeRead()}}
+
+	testCases := []struct {
+		name           string
+		path           string
+		keyID          string
+		scopes         []string
+		getTimes       int
+		decryptTimes   int
+		placed         bool
+		placeTimes     int
+		statusTimes    int
+		expectedStatus int
+	}{
+		{
+			name:           "incomplete credentials",
+			path:           "/auth-middleware/api-key/incomplete",
+			keyID:          "key-id",
+			scopes:         []string{constants.APIKeyScopeRead()},
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name:           "replayed",
+			path:           "/auth-middleware/api-key/replayed",
+			keyID:          "key-id",
+			scopes:         []string{constants.APIKeyScopeRead()},
+			getTimes:       1,
+			decryptTimes:   1,
+			placed:         false,
+			placeTimes:     1,
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:           "unscoped endpoint",
+			path:           "/auth-middleware/api-key/unscoped",
+			keyID:          "key-id",
+			scopes:         nil,
+			getTimes:       1,
+			decryptTimes:   1,
+			placed:         true,
+			placeTimes:     1,
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:           "missing scope",
+			path:           "/auth-middleware/api-key/missing-scope",
+			keyID:          "key-id",
+			scopes:         []string{constants.APIKeyScopeTrade()},
+			getTimes:       1,
+			decryptTimes:   1,
+			placed:         true,
+			placeTimes:     1,
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:           "valid",
+			path:           "/auth-middleware/api-key/valid",
+			keyID:          "key-id",
+			scopes:         []string{constants.APIKeyScopeRead()},
+			getTimes:       1,
+			decryptTimes:   1,
+			placed:         true,
+			placeTimes:     1,
+			statusTimes:    1,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().APIKeyGet(test.keyID).
+					Return(activeKey, nil).
+					Times(test.getTimes),
+
+				mockAuth.EXPECT().DecryptFromString(activeKey.Secret).
+					Return([]byte(secret), nil).
+					Times(test.decryptTimes),
+
+				mockCache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.placed, nil).
+					Times(test.placeTimes),
+
+				mockDB.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{}, nil).
+					Times(test.statusTimes),
+			)
+
+			// Endpoint setup for test. The handler checks that the body is still readable after authentication.
+			router := gin.Default()
+			router.POST(test.path,
+				AuthMiddleware(mockAuth, mockCache, mockDB, zapLogger, "Authorization", test.scopes...),
+				func(ginCtx *gin.Context) {
+					read, _ := io.ReadAll(ginCtx.Request.Body)
+					require.Equal(t, body, string(read), "request body was not restored")
+				})
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, strings.NewReader(body))
+			req.Header.Set(constants.APIKeyHeader(), test.keyID)
+			req.Header.Set(constants.APIKeyTimestampHeader(), timestamp)
+			req.Header.Set(constants.APIKeyNonceHeader(), "nonce")
+			req.Header.Set(constants.APIKeySignatureHeader(),
+				auth.APIKeySignature(secret, http.MethodPost, test.path, timestamp, "nonce", []byte(body)))
+
+			if test.getTimes == 0 {
+				req.Header.Del(constants.APIKeyNonceHeader())
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestAdminMiddleware_Handler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		path           string
+		token          string
+		expectedStatus int
+		authJWTErr     error
+		authJWTTimes   int
+		sessionTimes   int
+		denylistErr    error
+		revokedTimes   int
+		statusError    error
+		statusTimes    int
+		statusValue    modelsPostgres.UserStatus
+	}{
+		{
+			name:           "no token",
+			path:           "/admin-middleware/no-token",
+			token:          "",
+			expectedStatus: http.StatusUnauthorized,
+			authJWTErr:     nil,
+			authJWTTimes:   0,
+			sessionTimes:   0,
+			denylistErr:    nil,
+			revokedTimes:   0,
+			statusError:    nil,
+			statusTimes:    0,
+			statusValue:    modelsPostgres.UserStatus{},
+		}, {
+			name:           "invalid token",
+			path:           "/admin-middleware/invalid-token",
+			token:          "invalid-token",
+			expectedStatus: http.StatusForbidden,
+			authJWTErr:     errors.New("invalid jwt"),
+			authJWTTimes:   1,
+			sessionTimes:   0,
+			denylistErr:    nil,
+			revokedTimes:   0,
+			statusError:    nil,
+			statusTimes:    0,
+			statusValue:    modelsPostgres.UserStatus{},
+		}, {
+			name:           "revoked token",
+			path:           "/admin-middleware/revoked-token",
+			token:          "revoked-token",
+			expectedStatus: http.StatusForbidden,
+			authJWTErr:     nil,
+			authJWTTimes:   1,
+			sessionTimes:   1,
+			denylistErr:    nil,
+			revokedTimes:   0,
+			statusError:    nil,
+			statusTimes:    0,
+			statusValue:    modelsPostgres.UserStatus{},
+		}, {
+			name:           "db failure",
+			path:           "/admin-middleware/db-failure",
+			token:          "valid-token",
+			expectedStatus: http.StatusInternalServerError,
+			authJWTErr:     nil,
+			authJWTTimes:   1,
+			sessionTimes:   1,
+			denylistErr:    redis.ErrCacheMiss,
+			revokedTimes:   1,
+			statusError:    errors.New("db failure"),
+			statusTimes:    1,
+			statusValue:    modelsPostgres.UserStatus{},
+		}, {
+			name:           "deleted account",
+			path:           "/admin-middleware/deleted-account",
+			token:          "valid-token",
+			expectedStatus: http.StatusForbidden,
+			authJWTErr:     nil,
+			authJWTTimes:   1,
+			sessionTimes:   1,
+			denylistErr:    redis.ErrCacheMiss,
+			revokedTimes:   1,
+			statusError:    nil,
+			statusTimes:    1,
+			statusValue:    modelsPostgres.UserStatus{Role: constants.RoleAdmin(), IsDeleted: true},
+		}, {
+			name:           "frozen account",
+			path:           "/admin-middleware/frozen-account",
+			token:          "valid-token",
+			expectedStatus: http.StatusForbidden,
+			authJWTErr:     nil,
+			authJWTTimes:   1,
+			sessionTimes:   1,
+			denylistErr:    redis.ErrCacheMiss,
+			revokedTimes:   1,
+			statusError:    nil,
+			statusTimes:    1,
+			statusValue:    modelsPostgres.UserStatus{Role: constants.RoleAdmin(), IsFrozen: true},
+		}, {
+			name:           "demoted",
+			path:           "/admin-middleware/demoted",
+			token:          "valid-token",
+			expectedStatus: http.StatusForbidden,
+			authJWTErr:     nil,
+			authJWTTimes:   1,
+			sessionTimes:   1,
+			denylistErr:    redis.ErrCacheMiss,
+			revokedTimes:   1,
+			statusError:    nil,
+			statusTimes:    1,
+			statusValue:    modelsPostgres.UserStatus{Role: constants.RoleSupport()},
+		}, {
+			name:           "admin",
+			path:           "/admin-middleware/admin",
+			token:          "valid-token",
+			expectedStatus: http.StatusOK,
+			authJWTErr:     nil,
+			authJWTTimes:   1,
+			sessionTimes:   1,
+			denylistErr:    redis.ErrCacheMiss,
+			revokedTimes:   1,
+			statusError:    nil,
+			statusTimes:    1,
+			statusValue:    modelsPostgres.UserStatus{Role: constants.RoleAdmin()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWTScopes(gomock.Any(), constants.ScopeAdminWrite()).
+					Return(uuid.UUID{}, int64(-1), test.authJWTErr).
+					Times(test.authJWTTimes),
+
+				mockAuth.EXPECT().TokenSession(test.token).
+					Return("token-id", time.Now().UnixMilli(), nil).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-denylist:token-id", gomock.Any()).
+					Return(test.denylistErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get("jwt-revoked-before:"+uuid.UUID{}.String(), gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.revokedTimes),
+
+				mockDB.EXPECT().UserGetStatus(gomock.Any()).
+					Return(test.statusValue, test.statusError).
+					Times(test.statusTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path,
+				AdminMiddleware(mockAuth, mockCache, mockDB, zapLogger, "Authorization", constants.ScopeAdminWrite()))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
 			req.Header.Set("Authorization", test.token)
 			w := httptest.NewRecorder()
@@ -76,7 +76,7 @@ func OpenCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin
 // OfferCrypto will handle an HTTP request to get a purchase or sale offer for a Fiat or Cryptocurrency.
 //
 //	@Summary		Purchase or sell a Cryptocurrency and using a Fiat currency.
-//	@Description	Purchase or sell a Fiat currency using a Cryptocurrency. The amount must be a positive number with at most two or eight decimal places for Fiat and Cryptocurrencies respectively. Both currency accounts must be opened beforehand.
+//	@Description	Purchase or sell a Fiat currency using a Cryptocurrency. The amount must be a positive number with at most two decimal places for Fiat currencies, or the registered precision for Cryptocurrencies. Cryptocurrency amounts must fall within the registered order limits. Both currency accounts must be opened beforehand. The source funds can optionally be held for the lifetime of the offer, which reduces the available balance of the source account.
 //	@Tags			fiat crypto cryptocurrency currency sell sale offer
 //	@Id				sellOfferCrypto
 //	@Accept			json
@@ -85,10 +85,12 @@ func OpenCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin
 //	@Param			request	body		models.HTTPCryptoOfferRequest	true	"the Cryptocurrency ticker, Fiat currency code, and amount to be converted in the source currency"
 //	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the purchase rate for a Fiat or Cryptocurrency"
 //	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		402		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Router			/crypto/offer [post]
-func OfferCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, quotes quotes.Quotes) gin.HandlerFunc {
+func OfferCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	quotes quotes.Quotes) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
 			clientID      uuid.UUID
@@ -118,8 +120,9 @@ func OfferCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, quote
 			return
 		}
 
-		offer, status, statusMessage, err = common.HTTPCryptoOffer(auth, cache, logger, quotes,
-			clientID, request.SourceCurrency, request.DestinationCurrency, request.SourceAmount, *request.IsPurchase)
+		offer, status, statusMessage, err = common.HTTPCryptoOffer(auth, cache, db, logger, quotes,
+			clientID, request.SourceCurrency, request.DestinationCurrency, request.SourceAmount, *request.IsPurchase,
+			request.Hold)
 		if err != nil {
 			httpErr := &models.HTTPError{Message: statusMessage}
 			if statusMessage == constants.InvalidRequestString() {
@@ -150,6 +153,7 @@ func OfferCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, quote
 //	@Param			offerID	body		models.HTTPTransferRequest	true	"the two currency codes and amount to be converted"
 //	@Success		200		{object}	models.HTTPSuccess			"a message to confirm the conversion of funds"
 //	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		402		{object}	models.HTTPError			"error message with any available details in payload"
 //	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
 //	@Failure		408		{object}	models.HTTPError			"error message with any available details in payload"
 //	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
@@ -181,7 +185,8 @@ func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db
 			return
 		}
 
-		receipt, status, httpErrMsg, err := common.HTTPExchangeCrypto(auth, cache, db, logger, clientID, request.OfferID)
+		receipt, status, httpErrMsg, err := common.HTTPExchangeCrypto(auth, cache, db, logger, clientID, request.OfferID,
+			ginCtx.Request.UserAgent(), ginCtx.ClientIP())
 		if err != nil {
 			ginCtx.AbortWithStatusJSON(status, &models.HTTPError{Message: httpErrMsg})
 
@@ -192,6 +197,63 @@ func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db
 	}
 }
 
+// CloseCrypto will handle an HTTP request to close a Cryptocurrency account.
+//
+//	@Summary		Close a Cryptocurrency account.
+//	@Description	Closes a Cryptocurrency account. The account balance must be zero unless a sweep currency is supplied, in which case the remaining balance is sold and the proceeds deposited into the sweep Fiat currency account. Closed accounts can be reopened.
+//	@Tags			crypto cryptocurrency close sweep
+//	@Id				closeCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPCloseCryptoAccountRequest	true	"Cryptocurrency ticker of the account and optional sweep Fiat currency"
+//	@Success		200		{object}	models.HTTPSuccess						"a message to confirm the closure of an account with any sweep receipts"
+//	@Failure		400		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Router			/crypto/close [post]
+func CloseCrypto(
+	logger *logger.Logger,
+	auth auth.Auth,
+	db postgres.Postgres,
+	quotes quotes.Quotes) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err         error
+			clientID    uuid.UUID
+			receipt     *models.HTTPCryptoTransferResponse
+			request     models.HTTPCloseCryptoAccountRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if receipt, httpStatus, httpMessage, payload, err =
+			common.HTTPCryptoClose(db, logger, quotes, clientID, &request, ginCtx.Request.UserAgent(),
+				ginCtx.ClientIP()); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account closed", Payload: receipt})
+	}
+}
+
 // BalanceCrypto will handle an HTTP request to retrieve a balance for a specific Cryptocurrency.
 //
 //	@Summary		Retrieve balance for a specific Cryptocurrency.
@@ -213,7 +275,7 @@ func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db
 func BalanceCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
-			accDetails  *postgres.CryptoAccount
+			accDetails  *postgres.CryptoAccountBalance
 			clientID    uuid.UUID
 			err         error
 			httpStatus  int
@@ -389,3 +451,30 @@ func TxDetailsCryptoPaginated(logger *logger.Logger, auth auth.Auth, db postgres
 		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account transactions", Payload: journalEntries})
 	}
 }
+
+// CryptoAssets will handle an HTTP request to retrieve the supported Cryptocurrencies.
+//
+//	@Summary		Retrieve the supported Cryptocurrencies.
+//	@Description	Retrieves the registry of supported Cryptocurrencies with their precision, trading status, and order size limits.
+//	@Tags			crypto cryptocurrency assets registry
+//	@Id				assetsCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the registered Cryptocurrencies"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/assets [get]
+func CryptoAssets(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		assets, httpStatus, httpMessage, err := common.HTTPCryptoAssets(db, logger)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "cryptocurrencies", Payload: assets})
+	}
+}
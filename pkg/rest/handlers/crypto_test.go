@@ -103,6 +103,10 @@ func TestHandlers_OpenCrypto(t *testing.T) {
 					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
 					Times(test.authTokenInfoTimes),
 
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.cryptoCreateAccTimes),
+
 				mockPostgres.EXPECT().CryptoCreateAccount(gomock.Any(), gomock.Any()).
 					Return(test.cryptoCreateAccErr).
 					Times(test.cryptoCreateAccTimes),
@@ -141,11 +145,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 		isPurchase         bool
 		authTokenInfoErr   error
 		authTokenInfoTimes int
+		assetTimes         int
 		quotesErr          error
 		quotesAmount       decimal.Decimal
 		quotesTimes        int
 		authEncryptErr     error
 		authEncryptTimes   int
+		holdErr            error
+		holdTimes          int
 		redisErr           error
 		redisTimes         int
 	}{
@@ -165,11 +172,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   errors.New("invalid jwt"),
 			authTokenInfoTimes: 1,
+			assetTimes:         0,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -181,11 +191,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         0,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -204,11 +217,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -226,11 +242,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         0,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -249,11 +268,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -272,11 +294,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -295,11 +320,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          errors.New(""),
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -318,11 +346,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       decimal.NewFromFloat(0),
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -341,11 +372,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     errors.New(""),
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -364,11 +398,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           errors.New(""),
 			redisTimes:         1,
 		}, {
@@ -387,11 +424,14 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isPurchase,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         1,
 		}, {
@@ -410,11 +450,68 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			isPurchase:         isSale,
 			authTokenInfoErr:   nil,
 			authTokenInfoTimes: 1,
+			assetTimes:         1,
 			quotesErr:          nil,
 			quotesAmount:       amountValid,
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
+			redisErr:           nil,
+			redisTimes:         1,
+		}, {
+			name:           "hold insufficient funds",
+			expectedMsg:    "insufficient available funds",
+			path:           "/purchase-offer-crypto/hold-insufficient-funds",
+			expectedStatus: http.StatusPaymentRequired,
+			request: &models.HTTPCryptoOfferRequest{
+				HTTPExchangeOfferRequest: models.HTTPExchangeOfferRequest{
+					SourceCurrency:      "USD",
+					DestinationCurrency: "BTC",
+					SourceAmount:        amountValid,
+					Hold:                true,
+				},
+				IsPurchase: &isPurchase,
+			},
+			isPurchase:         isPurchase,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetTimes:         1,
+			quotesErr:          nil,
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptErr:     nil,
+			authEncryptTimes:   1,
+			holdErr:            postgres.ErrInsufficientFunds,
+			holdTimes:          1,
+			redisErr:           nil,
+			redisTimes:         0,
+		}, {
+			name:           "valid - held purchase",
+			expectedMsg:    "",
+			path:           "/purchase-offer-crypto/valid-held",
+			expectedStatus: http.StatusOK,
+			request: &models.HTTPCryptoOfferRequest{
+				HTTPExchangeOfferRequest: models.HTTPExchangeOfferRequest{
+					SourceCurrency:      "USD",
+					DestinationCurrency: "BTC",
+					SourceAmount:        amountValid,
+					Hold:                true,
+				},
+				IsPurchase: &isPurchase,
+			},
+			isPurchase:         isPurchase,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetTimes:         1,
+			quotesErr:          nil,
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptErr:     nil,
+			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          1,
 			redisErr:           nil,
 			redisTimes:         1,
 		},
@@ -431,6 +528,7 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
 
 			offerReqJSON, err := json.Marshal(&test.request)
@@ -441,6 +539,10 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
 					Times(test.authTokenInfoTimes),
 
+				mockDB.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
 				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), test.isPurchase, nil).
 					Return(amountValid, test.quotesAmount, test.quotesErr).
 					Times(test.quotesTimes),
@@ -449,6 +551,10 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 					Return("OFFER-ID", test.authEncryptErr).
 					Times(test.authEncryptTimes),
 
+				mockDB.EXPECT().FiatHold(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.holdErr).
+					Times(test.holdTimes),
+
 				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(test.redisErr).
 					Times(test.redisTimes),
@@ -456,7 +562,7 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, OfferCrypto(zapLogger, mockAuth, mockCache, mockQuotes))
+			router.POST(test.path, OfferCrypto(zapLogger, mockAuth, mockCache, mockDB, mockQuotes))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(offerReqJSON))
 			recorder := httptest.NewRecorder()
 			router.ServeHTTP(recorder, req)
@@ -633,6 +739,7 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			offerReqJSON, err := json.Marshal(&test.request)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
@@ -656,12 +763,12 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 					Times(test.redisDelTimes),
 
 				mockDB.EXPECT().CryptoPurchase(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.Currency("USD"), fiatAmount, "BTC", cryptoAmount, "").
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.purchaseTimes),
 
 				mockDB.EXPECT().CryptoSell(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.Currency("USD"), fiatAmount, "BTC", cryptoAmount, "").
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.sellTimes),
 			)
@@ -694,6 +801,162 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 	}
 }
 
+func TestHandlers_CloseCrypto(t *testing.T) {
+	t.Parallel()
+	balance := postgres.CryptoAccountBalance{Balance: decimal.NewFromFloat(0.5)}
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPCloseCryptoAccountRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		balanceTimes     int
+		assetTimes       int
+		quotesTimes      int
+		closeErr         error
+		closeTimes       int
+	}{
+		{
+			name:             "valid",
+			path:             "/close/valid",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			assetTimes:       0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       1,
+		}, {
+			name:             "valid with sweep",
+			path:             "/close/valid-sweep",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC", SweepCurrency: "USD"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     1,
+			assetTimes:       1,
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/close/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			assetTimes:       0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/close/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPCloseCryptoAccountRequest{},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			assetTimes:       0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+		}, {
+			name:             "invalid currency",
+			path:             "/close/invalid-currency",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "INVALID"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			assetTimes:       0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+		}, {
+			name:             "db failure",
+			path:             "/close/db-failure",
+			expectedStatus:   http.StatusConflict,
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			assetTimes:       0,
+			quotesTimes:      0,
+			closeErr:         postgres.ErrCloseAccount,
+			closeTimes:       1,
+		}, {
+			name:             "db failure unknown",
+			path:             "/close/db-failure-unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPCloseCryptoAccountRequest{Ticker: "BTC"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			assetTimes:       0,
+			quotesTimes:      0,
+			closeErr:         errors.New("unknown server error"),
+			closeTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			closeReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
+					Return(balance, nil).
+					Times(test.balanceTimes),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), false, nil).
+					Return(decimal.NewFromFloat(50001), decimal.NewFromFloat(25000.5), nil).
+					Times(test.quotesTimes),
+
+				mockPostgres.EXPECT().CryptoCloseAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, CloseCrypto(zapLogger, mockAuth, mockPostgres, mockQuotes))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(closeReqJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
 func TestHandler_BalanceCrypto(t *testing.T) { //nolint:dupl
 	t.Parallel()
 
@@ -774,8 +1037,12 @@ func TestHandler_BalanceCrypto(t *testing.T) { //nolint:dupl
 					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
 					Times(test.authTokenInfoTimes),
 
+				mockDB.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.cryptoBalanceTimes),
+
 				mockDB.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
-					Return(postgres.CryptoAccount{}, test.cryptoBalanceErr).
+					Return(postgres.CryptoAccountBalance{}, test.cryptoBalanceErr).
 					Times(test.cryptoBalanceTimes),
 			)
 
@@ -817,6 +1084,7 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 		fiatTxTimes        int
 		cryptoTxErr        error
 		cryptoTxTimes      int
+		reversalTimes      int
 	}{
 		{
 			name:               "invalid jwt",
@@ -858,6 +1126,7 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      1,
+			reversalTimes:      1,
 		},
 	}
 
@@ -885,6 +1154,10 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 				mockDB.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.CryptoJournal{{}}, test.cryptoTxErr).
 					Times(test.cryptoTxTimes),
+
+				mockDB.EXPECT().JournalReversalGet(gomock.Any()).
+					Return(nil, postgres.ErrNotFound).
+					Times(test.reversalTimes),
 			)
 
 			// Endpoint setup for test.
@@ -912,7 +1185,7 @@ func TestHandler_BalanceCurrencyCryptoPaginated(t *testing.T) { //nolint:dupl
 
 	const basePath = "/crypto/balance/details/paginated"
 
-	accDetails := []postgres.CryptoAccount{{}, {}, {}, {}}
+	accDetails := []postgres.CryptoAccountBalance{{}, {}, {}, {}}
 
 	testCases := []struct {
 		name                string
@@ -920,7 +1193,7 @@ func TestHandler_BalanceCurrencyCryptoPaginated(t *testing.T) { //nolint:dupl
 		querySegment        string
 		expectedMsg         string
 		expectedStatus      int
-		accBalances         []postgres.CryptoAccount
+		accBalances         []postgres.CryptoAccountBalance
 		authTokenInfoErr    error
 		authTokenInfoTimes  int
 		authDecryptStrErr   error
@@ -1010,7 +1283,7 @@ func TestHandler_BalanceCurrencyCryptoPaginated(t *testing.T) { //nolint:dupl
 			path:                "valid-no-query-10-records",
 			querySegment:        "",
 			expectedMsg:         "account balances",
-			accBalances:         []postgres.CryptoAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accBalances:         []postgres.CryptoAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			expectedStatus:      http.StatusOK,
 			authTokenInfoErr:    nil,
 			authTokenInfoTimes:  1,
@@ -1025,7 +1298,7 @@ func TestHandler_BalanceCurrencyCryptoPaginated(t *testing.T) { //nolint:dupl
 			path:                "valid-no-query-11-records",
 			querySegment:        "",
 			expectedMsg:         "account balances",
-			accBalances:         []postgres.CryptoAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accBalances:         []postgres.CryptoAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			expectedStatus:      http.StatusOK,
 			authTokenInfoErr:    nil,
 			authTokenInfoTimes:  1,
@@ -1313,3 +1586,67 @@ func TestHandler_TxDetailsCryptoPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_CryptoAssets(t *testing.T) {
+	t.Parallel()
+
+	const path = "/crypto/assets"
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		assetsErr      error
+	}{
+		{
+			name:           "unknown db error",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			assetsErr:      errors.New("unknown error"),
+		}, {
+			name:           "known db error",
+			expectedMsg:    "records not found",
+			expectedStatus: http.StatusNotFound,
+			assetsErr:      postgres.ErrNotFound,
+		}, {
+			name:           "valid",
+			expectedMsg:    "cryptocurrencies",
+			expectedStatus: http.StatusOK,
+			assetsErr:      nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().CryptoAssetGetAll().
+				Return([]postgres.CryptoAsset{testCryptoAsset}, test.assetsErr).
+				Times(1)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(path, CryptoAssets(zapLogger, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, path, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
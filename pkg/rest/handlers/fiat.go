@@ -113,7 +113,8 @@ func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 		}
 
 		if transferReceipt, httpStatus, httpMessage, payload, err =
-			common.HTTPFiatDeposit(db, logger, clientID, &request); err != nil {
+			common.HTTPFiatDeposit(db, logger, clientID, &request, ginCtx.Request.UserAgent(),
+				ginCtx.ClientIP()); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
 
 			return
@@ -126,7 +127,7 @@ func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 // ExchangeOfferFiat will handle an HTTP request to get an exchange offer of funds between two Fiat currencies.
 //
 //	@Summary		Exchange quote for Fiat funds between two Fiat currencies.
-//	@Description	Exchange quote for Fiat funds between two Fiat currencies. The amount must be a positive number with at most two decimal places and both currency accounts must be opened.
+//	@Description	Exchange quote for Fiat funds between two Fiat currencies. The amount must be a positive number with at most two decimal places and both currency accounts must be opened. The source funds can optionally be held for the lifetime of the offer, which reduces the available balance of the source account.
 //	@Tags			fiat currency exchange convert offer transfer
 //	@Id				exchangeOfferFiat
 //	@Accept			json
@@ -135,10 +136,12 @@ func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 //	@Param			request	body		models.HTTPExchangeOfferRequest	true	"the two currency code and amount to be converted"
 //	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the conversion rate for a currency"
 //	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		402		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Router			/fiat/exchange/offer [post]
-func ExchangeOfferFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis, quotes quotes.Quotes) gin.HandlerFunc {
+func ExchangeOfferFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	quotes quotes.Quotes) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
 			clientID    uuid.UUID
@@ -163,7 +166,7 @@ func ExchangeOfferFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis,
 		}
 
 		if offer, httpStatus, httpMessage, payload, err =
-			common.HTTPFiatOffer(auth, cache, logger, quotes, clientID, &request); err != nil {
+			common.HTTPFiatOffer(auth, cache, db, logger, quotes, clientID, &request); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
 
 			return
@@ -218,7 +221,8 @@ func ExchangeTransferFiat(
 		}
 
 		if receipt, httpStatus, httpMessage, payload, err =
-			common.HTTPFiatTransfer(auth, cache, db, logger, clientID, &request); err != nil {
+			common.HTTPFiatTransfer(auth, cache, db, logger, clientID, &request, ginCtx.Request.UserAgent(),
+				ginCtx.ClientIP()); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
 
 			return
@@ -228,6 +232,63 @@ func ExchangeTransferFiat(
 	}
 }
 
+// CloseFiat will handle an HTTP request to close a Fiat account.
+//
+//	@Summary		Close a Fiat account.
+//	@Description	Closes a Fiat account for a specific currency. The account balance must be zero unless a sweep currency is supplied, in which case the remaining balance is converted and deposited into the sweep currency account. Closed accounts can be reopened.
+//	@Tags			fiat currency close sweep
+//	@Id				closeFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPCloseFiatAccountRequest	true	"currency code of the account and optional sweep currency"
+//	@Success		200		{object}	models.HTTPSuccess					"a message to confirm the closure of an account with any sweep receipts"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/fiat/close [post]
+func CloseFiat(
+	logger *logger.Logger,
+	auth auth.Auth,
+	db postgres.Postgres,
+	quotes quotes.Quotes) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err         error
+			clientID    uuid.UUID
+			receipt     *models.HTTPFiatTransferResponse
+			request     models.HTTPCloseFiatAccountRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if receipt, httpStatus, httpMessage, payload, err =
+			common.HTTPFiatClose(db, logger, quotes, clientID, &request, ginCtx.Request.UserAgent(),
+				ginCtx.ClientIP()); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account closed", Payload: receipt})
+	}
+}
+
 // BalanceFiat will handle an HTTP request to retrieve a balance for a specific Fiat currency.
 //
 //	@Summary		Retrieve balance for a specific Fiat currency.
@@ -249,7 +310,7 @@ func ExchangeTransferFiat(
 func BalanceFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
-			accDetails  *postgres.FiatAccount
+			accDetails  *postgres.FiatAccountBalance
 			clientID    uuid.UUID
 			err         error
 			httpStatus  int
@@ -425,3 +486,30 @@ func TxDetailsFiatPaginated(logger *logger.Logger, auth auth.Auth, db postgres.P
 		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account transactions", Payload: journalEntries})
 	}
 }
+
+// FiatCurrencies will handle an HTTP request to retrieve the Fiat currencies and their circulation status.
+//
+//	@Summary		Retrieve the Fiat currencies and their circulation status.
+//	@Description	Retrieves the Fiat currency reference table. Accounts may only be opened in active currencies, deprecated currencies may still be transacted in, and balances in withdrawn currencies remain readable.
+//	@Tags			fiat currency currencies reference
+//	@Id				currenciesFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the Fiat currencies"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/fiat/currencies [get]
+func FiatCurrencies(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		records, httpStatus, httpMessage, err := common.HTTPFiatCurrencies(db, logger)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "fiat currencies", Payload: records})
+	}
+}
@@ -239,6 +239,7 @@ func TestHandlers_DepositFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			depositReqJSON, err := json.Marshal(&test.request)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
@@ -297,6 +298,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 		quotesTimes        int
 		authEncryptErr     error
 		authEncryptTimes   int
+		holdErr            error
+		holdTimes          int
 		redisErr           error
 		redisTimes         int
 	}{
@@ -316,6 +319,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -330,6 +335,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -348,6 +355,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -366,6 +375,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -384,6 +395,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -402,6 +415,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        0,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -420,6 +435,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   0,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -438,6 +455,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        1,
 			authEncryptErr:     errors.New(""),
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           nil,
 			redisTimes:         0,
 		}, {
@@ -456,6 +475,8 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
 			redisErr:           errors.New(""),
 			redisTimes:         1,
 		}, {
@@ -474,6 +495,50 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			quotesTimes:        1,
 			authEncryptErr:     nil,
 			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          0,
+			redisErr:           nil,
+			redisTimes:         1,
+		}, {
+			name:           "hold insufficient funds",
+			expectedMsg:    "insufficient",
+			path:           "/exchange-offer-fiat/hold-insufficient-funds",
+			expectedStatus: http.StatusPaymentRequired,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "USD",
+				SourceAmount:        amountValid,
+				Hold:                true,
+			},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			quotesErr:          nil,
+			quotesTimes:        1,
+			authEncryptErr:     nil,
+			authEncryptTimes:   1,
+			holdErr:            postgres.ErrInsufficientFunds,
+			holdTimes:          1,
+			redisErr:           nil,
+			redisTimes:         0,
+		}, {
+			name:           "valid hold",
+			expectedMsg:    "",
+			path:           "/exchange-offer-fiat/valid-hold",
+			expectedStatus: http.StatusOK,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "USD",
+				SourceAmount:        amountValid,
+				Hold:                true,
+			},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			quotesErr:          nil,
+			quotesTimes:        1,
+			authEncryptErr:     nil,
+			authEncryptTimes:   1,
+			holdErr:            nil,
+			holdTimes:          1,
 			redisErr:           nil,
 			redisTimes:         1,
 		},
@@ -491,6 +556,7 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			offerReqJSON, err := json.Marshal(&test.request)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
@@ -508,6 +574,10 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 					Return("OFFER-ID", test.authEncryptErr).
 					Times(test.authEncryptTimes),
 
+				mockDB.EXPECT().FiatHold(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.holdErr).
+					Times(test.holdTimes),
+
 				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(test.redisErr).
 					Times(test.redisTimes),
@@ -515,7 +585,7 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, ExchangeOfferFiat(zapLogger, mockAuth, mockCache, mockQuotes))
+			router.POST(test.path, ExchangeOfferFiat(zapLogger, mockAuth, mockCache, mockDB, mockQuotes))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(offerReqJSON))
 			recorder := httptest.NewRecorder()
 			router.ServeHTTP(recorder, req)
@@ -846,6 +916,7 @@ func TestHandler_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			xferReqJSON, err := json.Marshal(&test.request)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
@@ -901,6 +972,150 @@ func TestHandler_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 	}
 }
 
+func TestHandlers_CloseFiat(t *testing.T) {
+	t.Parallel()
+	balance := postgres.FiatAccountBalance{Balance: decimal.NewFromFloat(100.25)}
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPCloseFiatAccountRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		balanceTimes     int
+		quotesTimes      int
+		closeErr         error
+		closeTimes       int
+	}{
+		{
+			name:             "valid",
+			path:             "/close/valid",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       1,
+		}, {
+			name:             "valid with sweep",
+			path:             "/close/valid-sweep",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD", SweepCurrency: "CAD"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     1,
+			quotesTimes:      1,
+			closeErr:         nil,
+			closeTimes:       1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/close/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/close/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPCloseFiatAccountRequest{},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+		}, {
+			name:             "invalid currency",
+			path:             "/close/invalid-currency",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "UVW"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			quotesTimes:      0,
+			closeErr:         nil,
+			closeTimes:       0,
+		}, {
+			name:             "db failure",
+			path:             "/close/db-failure",
+			expectedStatus:   http.StatusConflict,
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			quotesTimes:      0,
+			closeErr:         postgres.ErrCloseAccount,
+			closeTimes:       1,
+		}, {
+			name:             "db failure unknown",
+			path:             "/close/db-failure-unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPCloseFiatAccountRequest{Currency: "USD"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			balanceTimes:     0,
+			quotesTimes:      0,
+			closeErr:         errors.New("unknown server error"),
+			closeTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			closeReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
+					Return(balance, nil).
+					Times(test.balanceTimes),
+
+				mockQuotes.EXPECT().FiatConversion(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(decimal.NewFromFloat(1.3555), decimal.NewFromFloat(135.89), nil).
+					Times(test.quotesTimes),
+
+				mockPostgres.EXPECT().FiatCloseAccount(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatAccountTransferResult{}, &postgres.FiatAccountTransferResult{}, test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, CloseFiat(zapLogger, mockAuth, mockPostgres, mockQuotes))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(closeReqJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
 func TestHandler_BalanceFiat(t *testing.T) { //nolint:dupl
 	t.Parallel()
 
@@ -982,7 +1197,7 @@ func TestHandler_BalanceFiat(t *testing.T) { //nolint:dupl
 					Times(test.authTokenInfoExp),
 
 				mockDB.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
-					Return(postgres.FiatAccount{}, test.fiatBalanceErr).
+					Return(postgres.FiatAccountBalance{}, test.fiatBalanceErr).
 					Times(test.fiatBalanceTimes),
 			)
 
@@ -1030,6 +1245,7 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 		fiatTxTimes        int
 		cryptoTxErr        error
 		cryptoTxTimes      int
+		reversalTimes      int
 	}{
 		{
 			name:               "invalid transaction ID",
@@ -1109,6 +1325,7 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      1,
+			reversalTimes:      1,
 		},
 	}
 
@@ -1136,6 +1353,10 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 				mockDB.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return(test.cryptoJournal, test.cryptoTxErr).
 					Times(test.cryptoTxTimes),
+
+				mockDB.EXPECT().JournalReversalGet(gomock.Any()).
+					Return(nil, postgres.ErrNotFound).
+					Times(test.reversalTimes),
 			)
 
 			// Endpoint setup for test.
@@ -1163,7 +1384,7 @@ func TestHandler_BalanceFiatPaginated(t *testing.T) { //nolint:dupl
 
 	const basePath = "/fiat/balances/details/paginated/"
 
-	accDetails := []postgres.FiatAccount{{}, {}, {}, {}}
+	accDetails := []postgres.FiatAccountBalance{{}, {}, {}, {}}
 
 	testCases := []struct {
 		name                string
@@ -1171,7 +1392,7 @@ func TestHandler_BalanceFiatPaginated(t *testing.T) { //nolint:dupl
 		querySegment        string
 		expectedMsg         string
 		expectedStatus      int
-		accDetails          []postgres.FiatAccount
+		accDetails          []postgres.FiatAccountBalance
 		authTokenInfoErr    error
 		authTokenInfoTimes  int
 		authDecryptStrErr   error
@@ -1261,7 +1482,7 @@ func TestHandler_BalanceFiatPaginated(t *testing.T) { //nolint:dupl
 			path:                "valid-no-query-10-records",
 			querySegment:        "",
 			expectedMsg:         "account balances",
-			accDetails:          []postgres.FiatAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:          []postgres.FiatAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			expectedStatus:      http.StatusOK,
 			authTokenInfoErr:    nil,
 			authTokenInfoTimes:  1,
@@ -1276,7 +1497,7 @@ func TestHandler_BalanceFiatPaginated(t *testing.T) { //nolint:dupl
 			path:                "valid-no-query-11-records",
 			querySegment:        "",
 			expectedMsg:         "account balances",
-			accDetails:          []postgres.FiatAccount{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
+			accDetails:          []postgres.FiatAccountBalance{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 			expectedStatus:      http.StatusOK,
 			authTokenInfoErr:    nil,
 			authTokenInfoTimes:  1,
@@ -1337,7 +1558,7 @@ func TestHandler_BalanceFiatPaginated(t *testing.T) { //nolint:dupl
 					Times(test.authTokenInfoTimes),
 
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
-					Return([]byte{}, test.authDecryptStrErr).
+					Return([]byte("USD"), test.authDecryptStrErr).
 					Times(test.authDecryptStrTimes),
 
 				mockDB.EXPECT().FiatBalancePaginated(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -1580,3 +1801,67 @@ func TestHandler_TxDetailsFiatPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_FiatCurrencies(t *testing.T) {
+	t.Parallel()
+
+	const path = "/fiat/currencies"
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		currenciesErr  error
+	}{
+		{
+			name:           "unknown db error",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			currenciesErr:  errors.New("unknown error"),
+		}, {
+			name:           "known db error",
+			expectedMsg:    "records not found",
+			expectedStatus: http.StatusNotFound,
+			currenciesErr:  postgres.ErrNotFound,
+		}, {
+			name:           "valid",
+			expectedMsg:    "fiat currencies",
+			expectedStatus: http.StatusOK,
+			currenciesErr:  nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatCurrencyGetAll().
+				Return(postgres.GenerateTestCurrencies(), test.currenciesErr).
+				Times(1)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(path, FiatCurrencies(zapLogger, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, path, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/ledger"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// LedgerVerify will handle an HTTP request to verify the journal hash chains.
+//
+//	@Summary		Verify the journal hash chains.
+//	@Description	Walks the hash chain of the Fiat or Crypto journal, or both if no journal is specified, and verifies each entry and the signed checkpoints. The first broken link in each journal that is not intact is reported. Verification is recorded in the audit log. Requires the administrative read scope.
+//	@Tags			admin ledger
+//	@Id				ledgerVerify
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			journal	query		string				false	"The journal to verify, fiat_journal or crypto_journal."
+//	@Success		200		{object}	models.HTTPSuccess	"the verification of each journal hash chain"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/ledger/verify [get]
+func LedgerVerify(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID      uuid.UUID
+			verification *models.HTTPLedgerVerification
+			err          error
+			httpStatus   int
+			httpMessage  string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if verification, httpStatus, httpMessage, err = common.HTTPAdminLedgerVerify(auth, db, logger, adminID,
+			ginCtx.Query("journal")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "journal verification", Payload: verification})
+	}
+}
+
+// LedgerCheckpoints will handle an HTTP request to retrieve the signed journal hash chain checkpoints.
+//
+//	@Summary		Retrieve the signed journal hash chain checkpoints.
+//	@Description	Retrieves the signed checkpoints of the Fiat or Crypto journal hash chain heads, or both if no journal is specified, recorded at or after a Unix timestamp. The public key and the signed message of each checkpoint are included so that auditors can verify the checkpoints independently. Viewing the checkpoints is recorded in the audit log. Requires the administrative read scope.
+//	@Tags			admin ledger
+//	@Id				ledgerCheckpoints
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			journal	query		string				false	"The journal to retrieve the checkpoints of, fiat_journal or crypto_journal."
+//	@Param			since	query		int					false	"The Unix timestamp from which to retrieve the checkpoints."
+//	@Success		200		{object}	models.HTTPSuccess	"the signed checkpoints and the public key to verify them with"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/ledger/checkpoints [get]
+func LedgerCheckpoints(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			export      *ledger.CheckpointExport
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if export, httpStatus, httpMessage, err = common.HTTPAdminLedgerCheckpoints(auth, db, logger, adminID,
+			ginCtx.Query("journal"), ginCtx.Query("since")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "journal checkpoints", Payload: export})
+	}
+}
@@ -0,0 +1,254 @@
+package rest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_LedgerVerify(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/ledger/verify"
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		verifyErr          error
+		verifyTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditTimes:         0,
+			verifyTimes:        0,
+		}, {
+			name:               "invalid journal",
+			querySegment:       "?journal=users",
+			expectedMsg:        "invalid journal",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoTimes: 1,
+			auditTimes:         0,
+			verifyTimes:        0,
+		}, {
+			name:               "audit failure",
+			querySegment:       "?journal=fiat_journal",
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			verifyTimes:        0,
+		}, {
+			name:               "verify failure",
+			querySegment:       "?journal=fiat_journal",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			verifyErr:          postgres.ErrJournalChain,
+			verifyTimes:        1,
+		}, {
+			name:               "valid",
+			querySegment:       "?journal=fiat_journal",
+			expectedMsg:        "journal verification",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			verifyTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionLEDGERVERIFY, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockAuth.EXPECT().CheckpointPublicKeys().
+					Return(map[string]ed25519.PublicKey{"v1": {}}).
+					Times(test.verifyTimes),
+
+				mockDB.EXPECT().JournalCheckpoints(postgres.JournalFiat, gomock.Any()).
+					Return(nil, nil).
+					Times(test.verifyTimes),
+
+				mockDB.EXPECT().JournalChainVerify(postgres.JournalFiat, gomock.Any()).
+					Return(&postgres.JournalChainVerification{Journal: postgres.JournalFiat, Intact: true},
+						test.verifyErr).
+					Times(test.verifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, LedgerVerify(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_LedgerCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/ledger/checkpoints"
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		checkpointsErr     error
+		checkpointsTimes   int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid timestamp",
+			querySegment:       "?since=yesterday",
+			expectedMsg:        "invalid timestamp",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "audit failure",
+			querySegment:       "?journal=crypto_journal",
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+		}, {
+			name:               "checkpoints failure",
+			querySegment:       "?journal=crypto_journal&since=1685923200",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			checkpointsErr:     postgres.ErrNotFound,
+			checkpointsTimes:   1,
+		}, {
+			name:               "valid",
+			querySegment:       "?since=1685923200",
+			expectedMsg:        "journal checkpoints",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			checkpointsTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionLEDGERCHECKPOINTVIEW, gomock.Any(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().JournalCheckpoints(gomock.Any(), gomock.Any()).
+					Return([]postgres.JournalCheckpoint{{Journal: postgres.JournalFiat, Seq: 1}}, test.checkpointsErr).
+					Times(test.checkpointsTimes),
+			)
+
+			mockAuth.EXPECT().CheckpointKeyID().
+				Return("v1").
+				MaxTimes(1)
+
+			mockAuth.EXPECT().CheckpointPublicKey().
+				Return(ed25519.PublicKey{}).
+				MaxTimes(1)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, LedgerCheckpoints(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// UnlockUser will handle an HTTP request to lift the login lockout of a user account.
+//
+//	@Summary		Lift the login lockout of a user account.
+//	@Description	Lifts the lockout of a user account that was locked out after repeated failed login attempts and resets its failed attempts. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.
+//	@Tags			admin users login lockout
+//	@Id				unlockUser
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the client id of the user account"
+//	@Param			request		body		models.HTTPAdminUnlockRequest	true	"the reason for lifting the lockout"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the lockout has been lifted"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/users/{clientID}/unlock [post]
+func UnlockUser(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			request     models.HTTPAdminUnlockRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+			clientIDStr = ginCtx.Param("clientID")
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, payload, err = common.HTTPAdminUnlockUser(cache, db, logger, adminID, clientIDStr,
+			&request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user account login lockout lifted",
+			Payload: map[string]any{"clientID": clientIDStr}})
+	}
+}
+
+// LoginLockouts will handle an HTTP request to retrieve the most recent login lockouts.
+//
+//	@Summary		Retrieve the most recent login lockouts.
+//	@Description	Retrieves the most recent lockouts of usernames and IP addresses after repeated failed login attempts, newest first. The lockouts can be restricted to those that are still in effect. Requires the administrative read scope.
+//	@Tags			admin users login lockout
+//	@Id				loginLockouts
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			active	query		bool				false	"whether to only return lockouts that are still in effect"
+//	@Param			limit	query		int					false	"the maximum number of lockouts to return"
+//	@Success		200		{object}	models.HTTPSuccess	"the most recent login lockouts"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/lockouts [get]
+func LoginLockouts(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			activeOnly  bool
+			httpStatus  int
+			httpMessage string
+			lockouts    []postgres.LoginLockout
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if active := ginCtx.Query("active"); len(active) > 0 {
+			if activeOnly, err = strconv.ParseBool(active); err != nil {
+				ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: "invalid active filter"})
+
+				return
+			}
+		}
+
+		if lockouts, httpStatus, httpMessage, err = common.HTTPAdminLoginLockouts(db, logger, adminID, activeOnly,
+			ginCtx.Query("limit")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "login lockouts", Payload: lockouts})
+	}
+}
@@ -0,0 +1,309 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestHandlers_UnlockUser(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/users/"
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	user := modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{
+		UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"},
+	}}
+
+	testCases := []struct {
+		name               string
+		clientID           string
+		expectedMsg        string
+		expectedStatus     int
+		request            *models.HTTPAdminUnlockRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		cacheTimes         int
+		unlockErr          error
+		unlockTimes        int
+	}{
+		{
+			name:               "empty request",
+			clientID:           clientID.String(),
+			expectedMsg:        constants.ValidationString(),
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminUnlockRequest{},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			cacheTimes:         0,
+			unlockErr:          nil,
+			unlockTimes:        0,
+		}, {
+			name:               "invalid JWT",
+			clientID:           clientID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPAdminUnlockRequest{Reason: "verified identity"},
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         0,
+			cacheTimes:         0,
+			unlockErr:          nil,
+			unlockTimes:        0,
+		}, {
+			name:               "audit failure",
+			clientID:           clientID.String(),
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPAdminUnlockRequest{Reason: "verified identity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+			cacheTimes:         0,
+			unlockErr:          nil,
+			unlockTimes:        0,
+		}, {
+			name:               "not locked out",
+			clientID:           clientID.String(),
+			expectedMsg:        "not locked out",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPAdminUnlockRequest{Reason: "verified identity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			cacheTimes:         1,
+			unlockErr:          postgres.ErrNotFound,
+			unlockTimes:        1,
+		}, {
+			name:               "valid",
+			clientID:           clientID.String(),
+			expectedMsg:        "lockout lifted",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminUnlockRequest{Reason: "verified identity"},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditErr:           nil,
+			auditTimes:         1,
+			cacheTimes:         1,
+			unlockErr:          nil,
+			unlockTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			unlockReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionUSERUNLOCK, clientID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().UserGetInfo(clientID).
+					Return(user, nil).
+					Times(test.cacheTimes),
+
+				mockRedis.EXPECT().Del(gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(3*test.cacheTimes),
+
+				mockDB.EXPECT().LoginLockoutUnlock(gomock.Any(), postgres.LockoutScopeUSERNAME, "username1").
+					Return(test.unlockErr).
+					Times(test.unlockTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath+":clientID/unlock", UnlockUser(zapLogger, mockAuth, mockRedis, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, basePath+test.clientID+"/unlock",
+				bytes.NewBuffer(unlockReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_LoginLockouts(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/lockouts"
+
+	testCases := []struct {
+		name               string
+		query              string
+		expectedMsg        string
+		expectedStatus     int
+		expectedActive     bool
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditTimes         int
+		lockoutsErr        error
+		lockoutsTimes      int
+	}{
+		{
+			name:               "invalid JWT",
+			query:              "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			auditTimes:         0,
+			lockoutsErr:        nil,
+			lockoutsTimes:      0,
+		}, {
+			name:               "invalid active filter",
+			query:              "?active=maybe",
+			expectedMsg:        "invalid active filter",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditTimes:         0,
+			lockoutsErr:        nil,
+			lockoutsTimes:      0,
+		}, {
+			name:               "invalid limit",
+			query:              "?limit=many",
+			expectedMsg:        "invalid result limit",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditTimes:         0,
+			lockoutsErr:        nil,
+			lockoutsTimes:      0,
+		}, {
+			name:               "database failure",
+			query:              "",
+			expectedMsg:        constants.RetryMessageString(),
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			lockoutsErr:        postgres.ErrLoginLockout,
+			lockoutsTimes:      1,
+		}, {
+			name:               "all lockouts",
+			query:              "",
+			expectedMsg:        "login lockouts",
+			expectedStatus:     http.StatusOK,
+			expectedActive:     false,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			lockoutsErr:        nil,
+			lockoutsTimes:      1,
+		}, {
+			name:               "active lockouts",
+			query:              "?active=true&limit=5",
+			expectedMsg:        "login lockouts",
+			expectedStatus:     http.StatusOK,
+			expectedActive:     true,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			lockoutsErr:        nil,
+			lockoutsTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionLOGINLOCKOUTVIEW, "",
+					gomock.Any()).
+					Return(int64(1), nil).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().LoginLockoutsRecent(test.expectedActive, gomock.Any()).
+					Return([]postgres.LoginLockout{}, test.lockoutsErr).
+					Times(test.lockoutsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, LoginLockouts(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.query, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
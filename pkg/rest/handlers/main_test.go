@@ -5,8 +5,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
 	"go.uber.org/zap"
 )
 
@@ -16,6 +18,16 @@ var zapLogger *logger.Logger
 // testUserData is the test user account data.
 var testUserData = common.HTTPGenerateTestUsers()
 
+// testCryptoAsset is the Cryptocurrency registry entry used for all tickers in the tests.
+var testCryptoAsset = postgres.CryptoAsset{
+	Ticker:        "BTC",
+	Name:          "Bitcoin",
+	DecimalPlaces: 8,
+	Status:        postgres.CryptoAssetStatusENABLED,
+	MinOrder:      decimal.NewFromFloat(0.00001),
+	MaxOrder:      decimal.NewFromFloat(1000000),
+}
+
 // tickerPathVar is the path variable that resolves to the currency ticker.
 const tickerPathVar = ":ticker"
 
@@ -47,6 +59,9 @@ func TestMain(m *testing.M) {
 
 // setup will configure the auth test object.
 func setup() error {
+	// Populate the Fiat currency cache.
+	postgres.SetCurrencyCache(postgres.GenerateTestCurrencies())
+
 	return nil
 }
 
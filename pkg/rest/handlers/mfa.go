@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// EnrollMFA will handle an HTTP request to start a two-factor authentication enrolment.
+//
+//	@Summary		Start a two-factor authentication enrolment.
+//	@Description	Generates a TOTP secret and the otpauth URI to add it to an authenticator application with. Two-factor authentication is only enabled once the enrolment is confirmed with a one-time password. A pending enrolment is replaced by a new request.
+//	@Tags			user users mfa two-factor enroll security
+//	@Id				enrollMFA
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		201	{object}	models.HTTPSuccess	"the TOTP secret and otpauth URI"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/mfa/enroll [post]
+func EnrollMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			enrolment   *models.HTTPMFAEnrollResponse
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if enrolment, httpStatus, httpMessage, err = common.HTTPMFAEnroll(auth, db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated,
+			models.HTTPSuccess{Message: "confirm the enrolment with a one-time password", Payload: enrolment})
+	}
+}
+
+// ConfirmMFA will handle an HTTP request to enable two-factor authentication with a pending enrolment.
+//
+//	@Summary		Enable two-factor authentication.
+//	@Description	Enables two-factor authentication by verifying a one-time password generated with the TOTP secret of a pending enrolment. The single-use recovery codes are only returned in the response to this request.
+//	@Tags			user users mfa two-factor confirm security
+//	@Id				confirmMFA
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPMFAConfirmRequest	true	"the one-time password to confirm the enrolment with"
+//	@Success		200		{object}	models.HTTPSuccess				"the recovery codes"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/user/mfa/confirm [post]
+func ConfirmMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			request     models.HTTPMFAConfirmRequest
+			codes       []string
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if codes, httpStatus, httpMessage, err = common.HTTPMFAConfirm(auth, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "two-factor authentication enabled", Payload: codes})
+	}
+}
+
+// RecoveryCodesMFA will handle an HTTP request to replace the recovery codes for two-factor authentication.
+//
+//	@Summary		Replace two-factor authentication recovery codes.
+//	@Description	Replaces all the recovery codes for two-factor authentication. A one-time password or an unused recovery code must be provided in the X-OTP header. The new recovery codes are only returned in the response to this request.
+//	@Tags			user users mfa two-factor recovery-codes security
+//	@Id				recoveryCodesMFA
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			X-OTP	header		string				true	"one-time password or recovery code"
+//	@Success		200		{object}	models.HTTPSuccess	"the new recovery codes"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/mfa/recovery-codes [post]
+func RecoveryCodesMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			codes       []string
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if codes, httpStatus, httpMessage, err = common.HTTPMFARecoveryCodes(
+			auth, db, logger, clientID, ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recovery codes replaced", Payload: codes})
+	}
+}
+
+// DisableMFA will handle an HTTP request to disable two-factor authentication.
+//
+//	@Summary		Disable two-factor authentication.
+//	@Description	Disables two-factor authentication and discards the TOTP secret and recovery codes. A one-time password or an unused recovery code must be provided in the X-OTP header.
+//	@Tags			user users mfa two-factor disable security
+//	@Id				disableMFA
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			X-OTP	header		string				true	"one-time password or recovery code"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm two-factor authentication has been disabled"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/mfa [delete]
+func DisableMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPMFADisable(
+			auth, db, logger, clientID, ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "two-factor authentication disabled"})
+	}
+}
@@ -0,0 +1,464 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_EnrollMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		authTokenInfoErr error
+		authTokenInfoExp int
+		userInfoTimes    int
+		enrollErr        error
+		enrollTimes      int
+	}{
+		{
+			name:             "valid",
+			path:             "/mfa-enroll/valid",
+			expectedStatus:   http.StatusCreated,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    1,
+			enrollErr:        nil,
+			enrollTimes:      1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/mfa-enroll/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			userInfoTimes:    0,
+			enrollErr:        nil,
+			enrollTimes:      0,
+		}, {
+			name:             "already enabled",
+			path:             "/mfa-enroll/already-enabled",
+			expectedStatus:   http.StatusConflict,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    1,
+			enrollErr:        postgres.ErrMFAEnrolled,
+			enrollTimes:      1,
+		}, {
+			name:             "db failure unknown",
+			path:             "/mfa-enroll/db-failure-unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    1,
+			enrollErr:        errors.New("unknown server error"),
+			enrollTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.userInfoTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-secret", nil).
+					Times(test.enrollTimes),
+
+				mockPostgres.EXPECT().MFAEnroll(gomock.Any(), "encrypted-secret").
+					Return(test.enrollErr).
+					Times(test.enrollTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, EnrollMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_ConfirmMFA(t *testing.T) {
+	t.Parallel()
+
+	secret, err := auth.NewTOTPSecret()
+	require.NoError(t, err, "failed to generate TOTP secret.")
+
+	code, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now()))
+	require.NoError(t, err, "failed to generate one-time password.")
+
+	expiredCode, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now())-100)
+	require.NoError(t, err, "failed to generate expired one-time password.")
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPMFAConfirmRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		getErr           error
+		getTimes         int
+		decryptTimes     int
+		confirmTimes     int
+	}{
+		{
+			name:             "valid",
+			path:             "/mfa-confirm/valid",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPMFAConfirmRequest{Code: code},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         1,
+			decryptTimes:     1,
+			confirmTimes:     1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/mfa-confirm/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFAConfirmRequest{Code: code},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         0,
+			decryptTimes:     0,
+			confirmTimes:     0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/mfa-confirm/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPMFAConfirmRequest{Code: "abc"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         0,
+			decryptTimes:     0,
+			confirmTimes:     0,
+		}, {
+			name:             "not enrolled",
+			path:             "/mfa-confirm/not-enrolled",
+			expectedStatus:   http.StatusNotFound,
+			request:          &models.HTTPMFAConfirmRequest{Code: code},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           postgres.ErrNotFound,
+			getTimes:         1,
+			decryptTimes:     0,
+			confirmTimes:     0,
+		}, {
+			name:             "invalid code",
+			path:             "/mfa-confirm/invalid-code",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFAConfirmRequest{Code: expiredCode},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         1,
+			decryptTimes:     1,
+			confirmTimes:     0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{Secret: "encrypted-secret"}, test.getErr).
+					Times(test.getTimes),
+
+				mockAuth.EXPECT().DecryptFromString("encrypted-secret").
+					Return([]byte(secret), nil).
+					Times(test.decryptTimes),
+
+				mockPostgres.EXPECT().MFAConfirm(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.confirmTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ConfirmMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_RecoveryCodesMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		otp              string
+		expectedStatus   int
+		authTokenInfoErr error
+		authTokenInfoExp int
+		getErr           error
+		getTimes         int
+		useCodeTimes     int
+		setTimes         int
+	}{
+		{
+			name:             "valid",
+			path:             "/mfa-recovery-codes/valid",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusOK,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         1,
+			useCodeTimes:     1,
+			setTimes:         1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/mfa-recovery-codes/invalid-jwt",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         0,
+			useCodeTimes:     0,
+			setTimes:         0,
+		}, {
+			name:             "code required",
+			path:             "/mfa-recovery-codes/code-required",
+			otp:              "",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           nil,
+			getTimes:         1,
+			useCodeTimes:     0,
+			setTimes:         0,
+		}, {
+			name:             "not enrolled",
+			path:             "/mfa-recovery-codes/not-enrolled",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusNotFound,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getErr:           postgres.ErrNotFound,
+			getTimes:         1,
+			useCodeTimes:     0,
+			setTimes:         0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, test.getErr).
+					Times(test.getTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), auth.HashRecoveryCode(test.otp)).
+					Return(nil).
+					Times(test.useCodeTimes),
+
+				mockPostgres.EXPECT().MFASetRecoveryCodes(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.setTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, RecoveryCodesMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			req.Header.Set(constants.OTPHeader(), test.otp)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_DisableMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		otp              string
+		expectedStatus   int
+		authTokenInfoErr error
+		authTokenInfoExp int
+		getTimes         int
+		useCodeErr       error
+		useCodeTimes     int
+		deleteErr        error
+		deleteTimes      int
+	}{
+		{
+			name:             "valid",
+			path:             "/mfa-disable/valid",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusOK,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getTimes:         1,
+			useCodeErr:       nil,
+			useCodeTimes:     1,
+			deleteErr:        nil,
+			deleteTimes:      1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/mfa-disable/invalid-jwt",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			getTimes:         0,
+			useCodeErr:       nil,
+			useCodeTimes:     0,
+			deleteErr:        nil,
+			deleteTimes:      0,
+		}, {
+			name:             "used recovery code",
+			path:             "/mfa-disable/used-recovery-code",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getTimes:         1,
+			useCodeErr:       postgres.ErrNotFound,
+			useCodeTimes:     1,
+			deleteErr:        nil,
+			deleteTimes:      0,
+		}, {
+			name:             "db failure unknown",
+			path:             "/mfa-disable/db-failure-unknown",
+			otp:              "abcde-fghij",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			getTimes:         1,
+			useCodeErr:       nil,
+			useCodeTimes:     1,
+			deleteErr:        postgres.ErrMFA,
+			deleteTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{EnrolledAt: pgtype.Timestamptz{Valid: true}}, nil).
+					Times(test.getTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), auth.HashRecoveryCode(test.otp)).
+					Return(test.useCodeErr).
+					Times(test.useCodeTimes),
+
+				mockPostgres.EXPECT().MFADelete(gomock.Any()).
+					Return(test.deleteErr).
+					Times(test.deleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(test.path, DisableMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, test.path, nil)
+			req.Header.Set(constants.OTPHeader(), test.otp)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
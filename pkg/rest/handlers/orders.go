@@ -0,0 +1,198 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// PlaceLimitOrder will handle an HTTP request to place a Cryptocurrency limit order.
+//
+//	@Summary		Place a Cryptocurrency limit order.
+//	@Description	Places a limit order to purchase or sell an amount of a Cryptocurrency once its price in a Fiat currency reaches the limit price. Purchases fill at or below the limit price and sales fill at or above it. The Fiat funds required at the limit price, or the Cryptocurrency being sold, are held until the order is filled, cancelled, or expires. The expiry is a Unix timestamp that must fall within thirty days.
+//	@Tags			crypto cryptocurrency limit order
+//	@Id				placeLimitOrder
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPLimitOrderRequest	true	"the Cryptocurrency ticker, Fiat currency code, amount, limit price, and expiry"
+//	@Success		201		{object}	models.HTTPSuccess				"the limit order that was placed"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		402		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/crypto/orders [post]
+func PlaceLimitOrder(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			order       *postgres.CryptoLimitOrder
+			request     models.HTTPLimitOrderRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if order, httpStatus, httpMessage, payload, err =
+			common.HTTPLimitOrderPlace(db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "limit order placed", Payload: order})
+	}
+}
+
+// CancelLimitOrder will handle an HTTP request to cancel an open Cryptocurrency limit order.
+//
+//	@Summary		Cancel a Cryptocurrency limit order.
+//	@Description	Cancels an open Cryptocurrency limit order and releases the funds held for it. Orders that have been filled, cancelled, expired, or failed cannot be cancelled.
+//	@Tags			crypto cryptocurrency limit order cancel
+//	@Id				cancelLimitOrder
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			orderID	path		string				true	"the limit order ID to cancel"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the cancellation of the order"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/orders/{orderID} [delete]
+func CancelLimitOrder(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			orderID     = ginCtx.Param("orderID")
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPLimitOrderCancel(db, logger, clientID, orderID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: orderID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "limit order cancelled", Payload: orderID})
+	}
+}
+
+// LimitOrderDetails will handle an HTTP request to retrieve a Cryptocurrency limit order and its state history.
+//
+//	@Summary		Retrieve a Cryptocurrency limit order.
+//	@Description	Retrieves a Cryptocurrency limit order along with the history of its state changes.
+//	@Tags			crypto cryptocurrency limit order details
+//	@Id				limitOrderDetails
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			orderID	path		string				true	"the limit order ID to retrieve the details for"
+//	@Success		200		{object}	models.HTTPSuccess	"the limit order and its state history"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/orders/{orderID} [get]
+func LimitOrderDetails(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			details     *models.HTTPLimitOrderDetails
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if details, httpStatus, httpMessage, err =
+			common.HTTPLimitOrderDetails(db, logger, clientID, ginCtx.Param("orderID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "limit order details", Payload: details})
+	}
+}
+
+// LimitOrdersPaginated will handle an HTTP request to retrieve the Cryptocurrency limit orders placed by a client.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted N+1'th record to retrieve in the subsequent call.
+//
+//	@Summary		Retrieve the Cryptocurrency limit orders for a client.
+//	@Description	Retrieves the Cryptocurrency limit orders placed by a client, newest first. The orders can optionally be restricted to a status of OPEN, FILLED, CANCELLED, EXPIRED, or FAILED. Subsequent requests will require a cursor to the next page that will be returned in a previous call to the endpoint.
+//	@Tags			crypto cryptocurrency limit order
+//	@Id				limitOrdersPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string				false	"The status of the orders to retrieve."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of limit orders"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/orders [get]
+func LimitOrdersPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			orders      *models.HTTPLimitOrdersPaginated
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if orders, httpStatus, httpMessage, err = common.HTTPLimitOrdersPaginated(auth, db, logger, clientID,
+			ginCtx.Query("status"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "limit orders", Payload: orders})
+	}
+}
@@ -0,0 +1,438 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_PlaceLimitOrder(t *testing.T) {
+	t.Parallel()
+
+	isPurchase := true
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPLimitOrderRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		assetTimes       int
+		createErr        error
+		createTimes      int
+	}{
+		{
+			name:           "valid",
+			path:           "/orders/valid",
+			expectedStatus: http.StatusCreated,
+			request: &models.HTTPLimitOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(0.5), LimitPrice: decimal.NewFromFloat(1000), ExpiresAt: expiresAt,
+				IsPurchase: &isPurchase},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        nil,
+			createTimes:      1,
+		}, {
+			name:           "invalid jwt",
+			path:           "/orders/invalid-jwt",
+			expectedStatus: http.StatusForbidden,
+			request: &models.HTTPLimitOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(0.5), LimitPrice: decimal.NewFromFloat(1000), ExpiresAt: expiresAt,
+				IsPurchase: &isPurchase},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			assetTimes:       0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/orders/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPLimitOrderRequest{},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:           "insufficient funds",
+			path:           "/orders/insufficient-funds",
+			expectedStatus: http.StatusPaymentRequired,
+			request: &models.HTTPLimitOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(0.5), LimitPrice: decimal.NewFromFloat(1000), ExpiresAt: expiresAt,
+				IsPurchase: &isPurchase},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        postgres.ErrInsufficientFunds,
+			createTimes:      1,
+		}, {
+			name:           "db failure unknown",
+			path:           "/orders/db-failure-unknown",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPLimitOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(0.5), LimitPrice: decimal.NewFromFloat(1000), ExpiresAt: expiresAt,
+				IsPurchase: &isPurchase},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        errors.New("unknown server error"),
+			createTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			orderReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().LimitOrderCreate(gomock.Any(), gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, PlaceLimitOrder(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(orderReqJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_CancelLimitOrder(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/orders/"
+
+	testCases := []struct {
+		name               string
+		orderID            string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		closeErr           error
+		closeTimes         int
+	}{
+		{
+			name:               "invalid JWT",
+			orderID:            "order-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "invalid order id",
+			orderID:            "an-order-id-that-is-far-too-long-to-be-valid",
+			expectedMsg:        "invalid order id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "order closed",
+			orderID:            "order-id",
+			expectedMsg:        "no longer open",
+			expectedStatus:     http.StatusConflict,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			closeErr:           postgres.ErrLimitOrderClosed,
+			closeTimes:         1,
+		}, {
+			name:               "valid",
+			orderID:            "order-id",
+			expectedMsg:        "limit order cancelled",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			closeErr:           nil,
+			closeTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().LimitOrderClose(gomock.Any(), test.orderID, postgres.LimitOrderStatusCANCELLED,
+					gomock.Any()).
+					Return(test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":orderID", CancelLimitOrder(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.orderID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_LimitOrderDetails(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/orders/"
+
+	testCases := []struct {
+		name               string
+		orderID            string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		getErr             error
+		getTimes           int
+	}{
+		{
+			name:               "invalid JWT",
+			orderID:            "order-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           0,
+		}, {
+			name:               "not found",
+			orderID:            "order-id",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+		}, {
+			name:               "unknown db error",
+			orderID:            "order-id",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             errors.New("unknown error"),
+			getTimes:           1,
+		}, {
+			name:               "valid",
+			orderID:            "order-id",
+			expectedMsg:        "limit order details",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().LimitOrderGet(gomock.Any(), test.orderID).
+					Return(postgres.CryptoLimitOrder{}, []postgres.CryptoLimitOrderEvent{}, test.getErr).
+					Times(test.getTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":orderID", LimitOrderDetails(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.orderID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_LimitOrdersPaginated(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		ordersErr          error
+		ordersTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "invalid status",
+			querySegment:       "?status=PENDING",
+			expectedMsg:        "invalid order status",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "invalid page size",
+			querySegment:       "?pageSize=three",
+			expectedMsg:        "invalid page cursor or page size",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "unknown db error",
+			querySegment:       "?status=OPEN",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          errors.New("unknown error"),
+			ordersTimes:        1,
+		}, {
+			name:               "valid",
+			querySegment:       "?status=OPEN&pageSize=3",
+			expectedMsg:        "limit orders",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().LimitOrdersPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoLimitOrder{}, test.ordersErr).
+					Times(test.ordersTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/crypto/orders", LimitOrdersPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/crypto/orders"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// ChangePassword will handle an HTTP request to change the password of a user account.
+//
+//	@Summary		Change the password of a user account.
+//	@Description	Replaces the password of a user account. The current password must be supplied and the new password must differ from it. All the sessions of the user are revoked and all the JWTs issued to the user, including the JWT the request is authorized with, are rejected once the password has been changed.
+//	@Tags			user users password security
+//	@Id				changePassword
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPChangePasswordRequest	true	"the current and new passwords"
+//	@Param			X-OTP	header		string								false	"one-time password or recovery code when two-factor authentication is enabled"
+//	@Success		200		{object}	models.HTTPSuccess					"a message to confirm the password has been changed"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/user/password [post]
+func ChangePassword(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			request     models.HTTPChangePasswordRequest
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPChangePassword(
+			auth, cache, db, logger, clientID, &request, ginCtx.Request.UserAgent(), ginCtx.ClientIP(),
+			ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "password changed, please log in again"})
+	}
+}
+
+// RequestPasswordReset will handle an HTTP request to mail a password reset token to a user.
+//
+//	@Summary		Request a password reset token.
+//	@Description	Mails a single-use password reset token to the email address on a user account. The token expires after thirty minutes. The same response is returned whether or not the username belongs to an active user account.
+//	@Tags			user users password reset security
+//	@Id				requestPasswordReset
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.HTTPPasswordResetRequest	true	"the username of the user account"
+//	@Success		202		{object}	models.HTTPSuccess				"a message to confirm the request has been accepted"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/user/password/reset-request [post]
+func RequestPasswordReset(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	notify notifier.Notifier) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err         error
+			request     models.HTTPPasswordResetRequest
+			httpStatus  int
+			httpMessage string
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPPasswordResetRequest(
+			auth, cache, db, notify, logger, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusAccepted,
+			models.HTTPSuccess{Message: "a password reset token will be sent if the user account exists"})
+	}
+}
+
+// ResetPassword will handle an HTTP request to reset the password of a user account with a password reset token.
+//
+//	@Summary		Reset the password of a user account.
+//	@Description	Replaces the password of a user account using a password reset token. The token can only be used once. All the sessions of the user are revoked and all the JWTs issued to the user are rejected once the password has been reset.
+//	@Tags			user users password reset security
+//	@Id				resetPassword
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.HTTPPasswordResetConfirmRequest	true	"the password reset token and the new password"
+//	@Param			X-OTP	header		string									false	"one-time password or recovery code when two-factor authentication is enabled"
+//	@Success		200		{object}	models.HTTPSuccess						"a message to confirm the password has been reset"
+//	@Failure		400		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError						"error message with any available details in payload"
+//	@Router			/user/password/reset [post]
+func ResetPassword(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err         error
+			request     models.HTTPPasswordResetConfirmRequest
+			httpStatus  int
+			httpMessage string
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPPasswordReset(
+			auth, cache, db, logger, &request, ginCtx.Request.UserAgent(), ginCtx.ClientIP(),
+			ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "password reset, please log in"})
+	}
+}
@@ -0,0 +1,430 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestHandlers_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPChangePasswordRequest{CurrentPassword: "current-password", NewPassword: "new-password"}
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          any
+		authTokenInfoErr error
+		authTokenInfoExp int
+		userInfoTimes    int
+		checkPassErr     error
+		checkPassTimes   int
+		updateTimes      int
+		setErr           error
+	}{
+		{
+			name:             "valid",
+			path:             "/change-password/valid",
+			expectedStatus:   http.StatusOK,
+			request:          validRequest,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    1,
+			checkPassErr:     nil,
+			checkPassTimes:   1,
+			updateTimes:      1,
+			setErr:           nil,
+		}, {
+			name:             "invalid jwt",
+			path:             "/change-password/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			request:          validRequest,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			userInfoTimes:    0,
+			checkPassErr:     nil,
+			checkPassTimes:   0,
+			updateTimes:      0,
+			setErr:           nil,
+		}, {
+			name:             "empty request",
+			path:             "/change-password/empty-request",
+			expectedStatus:   http.StatusBadRequest,
+			request:          nil,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    0,
+			checkPassErr:     nil,
+			checkPassTimes:   0,
+			updateTimes:      0,
+			setErr:           nil,
+		}, {
+			name:           "unchanged password",
+			path:           "/change-password/unchanged-password",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password", NewPassword: "current-password"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    0,
+			checkPassErr:     nil,
+			checkPassTimes:   0,
+			updateTimes:      0,
+			setErr:           nil,
+		}, {
+			name:             "incorrect current password",
+			path:             "/change-password/incorrect-current-password",
+			expectedStatus:   http.StatusForbidden,
+			request:          validRequest,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    1,
+			checkPassErr:     errors.New("password mismatch"),
+			checkPassTimes:   1,
+			updateTimes:      0,
+			setErr:           nil,
+		}, {
+			name:             "JWT revocation failure",
+			path:             "/change-password/jwt-revocation-failure",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			userInfoTimes:    1,
+			checkPassErr:     nil,
+			checkPassTimes:   1,
+			updateTimes:      1,
+			setErr:           redis.ErrCacheSet,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoError(t, err, "failed to marshall JSON.")
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.userInfoTimes),
+
+				mockAuth.EXPECT().CheckPassword(gomock.Any(), gomock.Any()).
+					Return(test.checkPassErr).
+					Times(test.checkPassTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().HashPassword(gomock.Any()).
+					Return("hashed password", nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "hashed password").
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsRevokeAll(gomock.Any()).
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.updateTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.setErr).
+					Times(test.updateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ChangePassword(zapLogger, mockAuth, mockCache, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		request        any
+		credsErr       error
+		credsTimes     int
+		resetTimes     int
+		sendErr        error
+	}{
+		{
+			name:           "valid",
+			path:           "/password-reset-request/valid",
+			expectedStatus: http.StatusAccepted,
+			request:        &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsErr:       nil,
+			credsTimes:     1,
+			resetTimes:     1,
+			sendErr:        nil,
+		}, {
+			name:           "unknown user",
+			path:           "/password-reset-request/unknown-user",
+			expectedStatus: http.StatusAccepted,
+			request:        &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsErr:       postgres.ErrLoginUser,
+			credsTimes:     1,
+			resetTimes:     0,
+			sendErr:        nil,
+		}, {
+			name:           "empty request",
+			path:           "/password-reset-request/empty-request",
+			expectedStatus: http.StatusBadRequest,
+			request:        nil,
+			credsErr:       nil,
+			credsTimes:     0,
+			resetTimes:     0,
+			sendErr:        nil,
+		}, {
+			name:           "notifier failure",
+			path:           "/password-reset-request/notifier-failure",
+			expectedStatus: http.StatusInternalServerError,
+			request:        &models.HTTPPasswordResetRequest{Username: "username1"},
+			credsErr:       nil,
+			credsTimes:     1,
+			resetTimes:     1,
+			sendErr:        errors.New("unknown error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoError(t, err, "failed to marshall JSON.")
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
+					Return(uuid.UUID{}, "hashed password", test.credsErr).
+					Times(test.credsTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{Email: "user@ftex.com"}}, nil).
+					Times(test.resetTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("reset-token", "reset-token-hash", nil).
+					Times(test.resetTimes),
+
+				mockCache.EXPECT().Set("password-reset:reset-token-hash", gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.resetTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(test.sendErr).
+					Times(test.resetTimes),
+			)
+
+			if test.sendErr != nil {
+				mockCache.EXPECT().Del("password-reset:reset-token-hash").Return(nil).Times(1)
+			}
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, RequestPasswordReset(zapLogger, mockAuth, mockCache, mockPostgres, mockNotifier))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	validRequest := &models.HTTPPasswordResetConfirmRequest{Token: "reset-token", Password: "new-password"}
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		request        any
+		getErr         error
+		getTimes       int
+		getDelErr      error
+		getDelTimes    int
+		updateTimes    int
+	}{
+		{
+			name:           "valid",
+			path:           "/password-reset/valid",
+			expectedStatus: http.StatusOK,
+			request:        validRequest,
+			getErr:         nil,
+			getTimes:       1,
+			getDelErr:      nil,
+			getDelTimes:    1,
+			updateTimes:    1,
+		}, {
+			name:           "empty request",
+			path:           "/password-reset/empty-request",
+			expectedStatus: http.StatusBadRequest,
+			request:        nil,
+			getErr:         nil,
+			getTimes:       0,
+			getDelErr:      nil,
+			getDelTimes:    0,
+			updateTimes:    0,
+		}, {
+			name:           "unknown token",
+			path:           "/password-reset/unknown-token",
+			expectedStatus: http.StatusForbidden,
+			request:        validRequest,
+			getErr:         redis.ErrCacheMiss,
+			getTimes:       1,
+			getDelErr:      nil,
+			getDelTimes:    0,
+			updateTimes:    0,
+		}, {
+			name:           "token already used",
+			path:           "/password-reset/token-already-used",
+			expectedStatus: http.StatusForbidden,
+			request:        validRequest,
+			getErr:         nil,
+			getTimes:       1,
+			getDelErr:      redis.ErrCacheMiss,
+			getDelTimes:    1,
+			updateTimes:    0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoError(t, err, "failed to marshall JSON.")
+
+			gomock.InOrder(
+				mockAuth.EXPECT().HashRefreshToken("reset-token").
+					Return("reset-token-hash").
+					Times(test.getTimes),
+
+				mockCache.EXPECT().Get("password-reset:reset-token-hash", gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						stored, ok := value.(*string)
+						require.True(t, ok, "client id destination is not a string.")
+						*stored = clientID.String()
+
+						return test.getErr
+					}).
+					Times(test.getTimes),
+
+				mockPostgres.EXPECT().MFAGet(clientID).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.getDelTimes),
+
+				mockCache.EXPECT().GetDel("password-reset:reset-token-hash", gomock.Any()).
+					Return(test.getDelErr).
+					Times(test.getDelTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("hashed password", nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(clientID, "hashed password").
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsRevokeAll(clientID).
+					Return(nil).
+					Times(test.updateTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.updateTimes),
+
+				mockCache.EXPECT().Set("jwt-revoked-before:"+clientID.String(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.updateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ResetPassword(zapLogger, mockAuth, mockCache, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// UserProfile will handle an HTTP request to retrieve the profile of a user account.
+//
+//	@Summary		Retrieve the profile of a user account.
+//	@Description	Retrieves the account information of the user the JWT was issued to. The hashed password is not returned.
+//	@Tags			user users profile
+//	@Id				userProfile
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the account information of the user"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/profile [get]
+func UserProfile(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			profile     *modelsPostgres.User
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if profile, httpStatus, httpMessage, err = common.HTTPUserProfile(db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user profile", Payload: profile})
+	}
+}
+
+// UpdateUserProfile will handle an HTTP request to update the names and email address of a user account.
+//
+//	@Summary		Update the profile of a user account.
+//	@Description	Updates the first name, last name, and email address of a user account. Fields that are not supplied are left unchanged. A changed email address is unverified until the verification link mailed to it is followed. Users that have enabled two-factor authentication must supply a one-time password or recovery code to change their email address.
+//	@Tags			user users profile
+//	@Id				updateUserProfile
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPUpdateProfileRequest	true	"the names and email address to update"
+//	@Param			X-OTP	header		string							false	"one-time password or recovery code when two-factor authentication is enabled"
+//	@Success		200		{object}	models.HTTPSuccess				"the updated account information of the user"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/user/profile [patch]
+func UpdateUserProfile(logger *logger.Logger, auth auth.Auth, db postgres.Postgres,
+	notify notifier.Notifier) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			request     models.HTTPUpdateProfileRequest
+			profile     *modelsPostgres.User
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if profile, httpStatus, httpMessage, err = common.HTTPUpdateProfile(
+			auth, db, notify, logger, clientID, &request, ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user profile updated", Payload: profile})
+	}
+}
+
+// SendEmailVerification will handle an HTTP request to mail a new verification link to the email address of a user.
+//
+//	@Summary		Request an email address verification link.
+//	@Description	Mails a new verification link to the unverified email address on a user account. The link expires after twenty-four hours.
+//	@Tags			user users profile email
+//	@Id				sendEmailVerification
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		202	{object}	models.HTTPSuccess	"a message to confirm the verification link has been sent"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/email/verification [post]
+func SendEmailVerification(logger *logger.Logger, auth auth.Auth, db postgres.Postgres,
+	notify notifier.Notifier) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPSendEmailVerification(
+			auth, db, notify, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusAccepted, models.HTTPSuccess{Message: "email address verification link sent"})
+	}
+}
+
+// VerifyEmail will handle an HTTP request to verify an email address with a signed verification link.
+//
+//	@Summary		Verify an email address.
+//	@Description	Marks the email address in a signed verification link as verified. Links that have expired or were sent to an email address that has since been changed are rejected.
+//	@Tags			user users profile email
+//	@Id				verifyEmail
+//	@Produce		json
+//	@Param			token	query		string				true	"the signed email verification token"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the email address has been verified"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/email/verify [get]
+func VerifyEmail(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err         error
+			token       string
+			httpStatus  int
+			httpMessage string
+		)
+
+		if token = ginCtx.Query("token"); token == "" {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest,
+				models.HTTPError{Message: "email verification token not supplied"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPVerifyEmail(auth, db, logger, token); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "email address verified"})
+	}
+}
@@ -0,0 +1,409 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_UserProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		authTokenInfoErr error
+		userInfoErr      error
+		userInfoTimes    int
+	}{
+		{
+			name:             "valid",
+			path:             "/user-profile/valid",
+			expectedStatus:   http.StatusOK,
+			authTokenInfoErr: nil,
+			userInfoErr:      nil,
+			userInfoTimes:    1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/user-profile/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			userInfoErr:      nil,
+			userInfoTimes:    0,
+		}, {
+			name:             "database failure",
+			path:             "/user-profile/database-failure",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			userInfoErr:      postgres.ErrNotFoundUser,
+			userInfoTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{
+						UserAccount: &modelsPostgres.UserAccount{
+							UserLoginCredentials: modelsPostgres.UserLoginCredentials{Password: "hashed password"},
+						},
+					}, test.userInfoErr).
+					Times(test.userInfoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(test.path, UserProfile(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, test.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+			require.NotContains(t, w.Body.String(), "hashed password", "hashed password returned in profile")
+		})
+	}
+}
+
+func TestHandlers_UpdateUserProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          any
+		authTokenInfoErr error
+		userInfoTimes    int
+		updateErr        error
+		updateTimes      int
+		profileTimes     int
+	}{
+		{
+			name:             "valid",
+			path:             "/update-profile/valid",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPUpdateProfileRequest{FirstName: "first name"},
+			authTokenInfoErr: nil,
+			userInfoTimes:    1,
+			updateErr:        nil,
+			updateTimes:      1,
+			profileTimes:     1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/update-profile/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPUpdateProfileRequest{FirstName: "first name"},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			userInfoTimes:    0,
+			updateErr:        nil,
+			updateTimes:      0,
+			profileTimes:     0,
+		}, {
+			name:             "empty request",
+			path:             "/update-profile/empty-request",
+			expectedStatus:   http.StatusBadRequest,
+			request:          nil,
+			authTokenInfoErr: nil,
+			userInfoTimes:    0,
+			updateErr:        nil,
+			updateTimes:      0,
+			profileTimes:     0,
+		}, {
+			name:             "invalid request",
+			path:             "/update-profile/invalid-request",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPUpdateProfileRequest{},
+			authTokenInfoErr: nil,
+			userInfoTimes:    0,
+			updateErr:        nil,
+			updateTimes:      0,
+			profileTimes:     0,
+		}, {
+			name:             "database failure",
+			path:             "/update-profile/database-failure",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPUpdateProfileRequest{FirstName: "first name"},
+			authTokenInfoErr: nil,
+			userInfoTimes:    1,
+			updateErr:        postgres.ErrNotFoundUser,
+			updateTimes:      1,
+			profileTimes:     0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoError(t, err, "failed to marshall JSON.")
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.userInfoTimes),
+
+				mockPostgres.EXPECT().UserUpdateProfile(gomock.Any(), "first name", "", "").
+					Return(test.updateErr).
+					Times(test.updateTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.profileTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.PATCH(test.path, UpdateUserProfile(zapLogger, mockAuth, mockPostgres, mockNotifier))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPatch, test.path,
+				bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_SendEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		authTokenInfoErr error
+		userInfoTimes    int
+		emailVerified    bool
+		sendErr          error
+		sendTimes        int
+	}{
+		{
+			name:             "valid",
+			path:             "/send-email-verification/valid",
+			expectedStatus:   http.StatusAccepted,
+			authTokenInfoErr: nil,
+			userInfoTimes:    1,
+			emailVerified:    false,
+			sendErr:          nil,
+			sendTimes:        1,
+		}, {
+			name:             "invalid jwt",
+			path:             "/send-email-verification/invalid-jwt",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			userInfoTimes:    0,
+			emailVerified:    false,
+			sendErr:          nil,
+			sendTimes:        0,
+		}, {
+			name:             "already verified",
+			path:             "/send-email-verification/already-verified",
+			expectedStatus:   http.StatusConflict,
+			authTokenInfoErr: nil,
+			userInfoTimes:    1,
+			emailVerified:    true,
+			sendErr:          nil,
+			sendTimes:        0,
+		}, {
+			name:             "notifier failure",
+			path:             "/send-email-verification/notifier-failure",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			userInfoTimes:    1,
+			emailVerified:    false,
+			sendErr:          errors.New("notifier failure"),
+			sendTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(1),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{
+						UserAccount:   &modelsPostgres.UserAccount{Email: "user@ftex.com"},
+						EmailVerified: test.emailVerified,
+					}, nil).
+					Times(test.userInfoTimes),
+
+				mockAuth.EXPECT().GenerateEmailVerificationToken(gomock.Any(), "user@ftex.com").
+					Return("verification-token", nil).
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Link(gomock.Any(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sendTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(test.sendErr).
+					Times(test.sendTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, SendEmailVerification(zapLogger, mockAuth, mockPostgres, mockNotifier))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		path           string
+		query          string
+		expectedStatus int
+		validateErr    error
+		validateTimes  int
+		verifyErr      error
+		verifyTimes    int
+	}{
+		{
+			name:           "valid",
+			path:           "/verify-email/valid",
+			query:          "?token=verification-token",
+			expectedStatus: http.StatusOK,
+			validateErr:    nil,
+			validateTimes:  1,
+			verifyErr:      nil,
+			verifyTimes:    1,
+		}, {
+			name:           "no token",
+			path:           "/verify-email/no-token",
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+			validateErr:    nil,
+			validateTimes:  0,
+			verifyErr:      nil,
+			verifyTimes:    0,
+		}, {
+			name:           "invalid token",
+			path:           "/verify-email/invalid-token",
+			query:          "?token=verification-token",
+			expectedStatus: http.StatusForbidden,
+			validateErr:    errors.New("token has expired"),
+			validateTimes:  1,
+			verifyErr:      nil,
+			verifyTimes:    0,
+		}, {
+			name:           "email address changed",
+			path:           "/verify-email/email-address-changed",
+			query:          "?token=verification-token",
+			expectedStatus: http.StatusForbidden,
+			validateErr:    nil,
+			validateTimes:  1,
+			verifyErr:      postgres.ErrNotFoundUser,
+			verifyTimes:    1,
+		}, {
+			name:           "database failure",
+			path:           "/verify-email/database-failure",
+			query:          "?token=verification-token",
+			expectedStatus: http.StatusInternalServerError,
+			validateErr:    nil,
+			validateTimes:  1,
+			verifyErr:      errors.New("database failure"),
+			verifyTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateEmailVerificationToken("verification-token").
+					Return(uuid.UUID{}, "user@ftex.com", test.validateErr).
+					Times(test.validateTimes),
+
+				mockPostgres.EXPECT().UserVerifyEmail(gomock.Any(), "user@ftex.com").
+					Return(test.verifyErr).
+					Times(test.verifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(test.path, VerifyEmail(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, test.path+test.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// RateLimitMiddleware is the middleware that limits the rate of requests from each requester. Routes are limited by the
+// default policy unless they have a policy of their own, which is keyed by the method and full path of the route. The
+// policy is stored in the Gin context so that requests signed with an API key can also be limited by the key once it
+// has been verified.
+func RateLimitMiddleware(auth auth.Auth, cache redis.Redis, logger *logger.Logger, authHeaderKey string,
+	defaultPolicy common.RateLimitPolicy, routePolicies map[string]common.RateLimitPolicy) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		policy, ok := routePolicies[ginCtx.Request.Method+" "+ginCtx.FullPath()]
+		if !ok {
+			policy = defaultPolicy
+		}
+
+		if !rateLimit(ginCtx, cache, logger, policy,
+			common.RateLimitSubject(auth, ginCtx.GetHeader(authHeaderKey), ginCtx.ClientIP())) {
+			return
+		}
+
+		ginCtx.Set(constants.RateLimitPoliciesCtxKey(), []common.RateLimitPolicy{policy})
+
+		ginCtx.Next()
+	}
+}
+
+// rateLimitAPIKey will limit the rate of requests signed with a verified API key under the policies the request was
+// admitted under by the rate limit middleware, if any. Returns false if the request was rejected and aborted.
+func rateLimitAPIKey(ginCtx *gin.Context, cache redis.Redis, logger *logger.Logger, keyID string) bool {
+	value, _ := ginCtx.Get(constants.RateLimitPoliciesCtxKey())
+	policies, _ := value.([]common.RateLimitPolicy)
+
+	for _, policy := range policies {
+		if !rateLimit(ginCtx, cache, logger, policy, common.RateLimitAPIKeySubject(keyID)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rateLimit will admit a request from a requester under a rate limit policy and report the rate limit status in the
+// response headers. Returns false if the request was rejected and aborted.
+func rateLimit(ginCtx *gin.Context, cache redis.Redis, logger *logger.Logger, policy common.RateLimitPolicy,
+	subject string) bool {
+	status, httpStatus, httpMessage, payload, err := common.HTTPRateLimit(cache, logger, policy, subject)
+	if status != nil {
+		for header, value := range status.Headers() {
+			ginCtx.Header(header, value)
+		}
+	}
+
+	if err != nil {
+		ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,207 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestRateLimitMiddleware_Handler(t *testing.T) {
+	t.Parallel()
+
+	defaultPolicy := common.RateLimitPolicy{Name: "rest", Limit: 100, Window: time.Minute}
+	routePolicies := map[string]common.RateLimitPolicy{
+		"POST /crypto/offer": {Name: "rest:POST /crypto/offer", Limit: 5, Window: time.Minute},
+	}
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		apiKeyID       string
+		expectedKey    string
+		expectedLimit  string
+		admitted       bool
+		slidingErr     error
+		expectedStatus int
+		expectHeaders  bool
+	}{
+		{
+			name:           "default policy admitted",
+			method:         http.MethodGet,
+			path:           "/crypto/assets",
+			expectedKey:    "rate-limit:rest:ip:192.0.2.1",
+			expectedLimit:  "100",
+			admitted:       true,
+			slidingErr:     nil,
+			expectedStatus: http.StatusOK,
+			expectHeaders:  true,
+		}, {
+			name:           "route policy admitted",
+			method:         http.MethodPost,
+			path:           "/crypto/offer",
+			expectedKey:    "rate-limit:rest:POST /crypto/offer:ip:192.0.2.1",
+			expectedLimit:  "5",
+			admitted:       true,
+			slidingErr:     nil,
+			expectedStatus: http.StatusOK,
+			expectHeaders:  true,
+		}, {
+			name:           "route policy rejected unverified api key",
+			method:         http.MethodPost,
+			path:           "/crypto/offer",
+			apiKeyID:       "api-key-id",
+			expectedKey:    "rate-limit:rest:POST /crypto/offer:ip:192.0.2.1",
+			expectedLimit:  "5",
+			admitted:       false,
+			slidingErr:     nil,
+			expectedStatus: http.StatusTooManyRequests,
+			expectHeaders:  true,
+		}, {
+			name:           "cache failure",
+			method:         http.MethodGet,
+			path:           "/crypto/assets",
+			expectedKey:    "rate-limit:rest:ip:192.0.2.1",
+			admitted:       false,
+			slidingErr:     redis.ErrCacheSet,
+			expectedStatus: http.StatusOK,
+			expectHeaders:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			mockRedis.EXPECT().SlidingWindow(test.expectedKey, gomock.Any(), time.Minute).
+				Return(test.admitted, int64(0), 3*time.Second, test.slidingErr).
+				Times(1)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(RateLimitMiddleware(mockAuth, mockRedis, zapLogger, "Authorization", defaultPolicy,
+				routePolicies))
+			router.Handle(test.method, test.path, func(ginCtx *gin.Context) { ginCtx.Status(http.StatusOK) })
+
+			req, _ := http.NewRequestWithContext(context.TODO(), test.method, test.path, nil)
+			req.RemoteAddr = "192.0.2.1:4321"
+			req.Header.Set(constants.APIKeyHeader(), test.apiKeyID)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			if !test.expectHeaders {
+				require.Empty(t, recorder.Header().Get(constants.RateLimitLimitHeader()), "unexpected rate limit header")
+
+				return
+			}
+
+			require.Equal(t, test.expectedLimit, recorder.Header().Get(constants.RateLimitLimitHeader()),
+				"rate limit header mismatch")
+
+			if test.admitted {
+				require.Empty(t, recorder.Header().Get(constants.RetryAfterHeader()), "unexpected retry after header")
+			} else {
+				require.Equal(t, "3", recorder.Header().Get(constants.RetryAfterHeader()), "retry after header mismatch")
+			}
+		})
+	}
+}
+
+func TestRateLimitMiddleware_APIKey(t *testing.T) {
+	t.Parallel()
+
+	policy := common.RateLimitPolicy{Name: "rest:POST /crypto/offer", Limit: 5, Window: time.Minute}
+
+	testCases := []struct {
+		name           string
+		policies       any
+		slidingTimes   int
+		admitted       bool
+		expectedStatus int
+		expectedLimit  string
+	}{
+		{
+			name:           "admitted",
+			policies:       []common.RateLimitPolicy{policy},
+			slidingTimes:   1,
+			admitted:       true,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "5",
+		}, {
+			name:           "rejected",
+			policies:       []common.RateLimitPolicy{policy},
+			slidingTimes:   1,
+			admitted:       false,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedLimit:  "5",
+		}, {
+			name:           "no policies",
+			policies:       nil,
+			slidingTimes:   0,
+			expectedStatus: http.StatusOK,
+			expectedLimit:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			mockRedis.EXPECT().SlidingWindow("rate-limit:rest:POST /crypto/offer:api-key:api-key-id", int64(5),
+				time.Minute).
+				Return(test.admitted, int64(0), 3*time.Second, nil).
+				Times(test.slidingTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST("/crypto/offer", func(ginCtx *gin.Context) {
+				if test.policies != nil {
+					ginCtx.Set(constants.RateLimitPoliciesCtxKey(), test.policies)
+				}
+
+				if !rateLimitAPIKey(ginCtx, mockRedis, zapLogger, "api-key-id") {
+					return
+				}
+
+				ginCtx.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "/crypto/offer", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Equal(t, test.expectedLimit, recorder.Header().Get(constants.RateLimitLimitHeader()),
+				"rate limit header mismatch")
+		})
+	}
+}
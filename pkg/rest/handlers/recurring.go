@@ -0,0 +1,204 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// ScheduleRecurringPurchase will handle an HTTP request to schedule recurring purchases of a Cryptocurrency.
+//
+//	@Summary		Schedule recurring purchases of a Cryptocurrency.
+//	@Description	Schedules purchases of a Cryptocurrency for a fixed amount of a Fiat currency at a DAILY, WEEKLY, BIWEEKLY, or MONTHLY cadence. The start and end times are UNIX timestamps in seconds. Plans without a start time make their first purchase immediately and plans without an end time run until they are cancelled. No funds are held and each purchase is paid for from the available balance of the Fiat account when it runs.
+//	@Tags			crypto cryptocurrency recurring purchase schedule
+//	@Id				scheduleRecurringPurchase
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPRecurringPurchaseRequest	true	"the Fiat currency code, Cryptocurrency ticker, Fiat amount per purchase, cadence, and optional start and end times"
+//	@Success		201		{object}	models.HTTPSuccess					"the recurring purchase plan that was scheduled"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/crypto/recurring [post]
+func ScheduleRecurringPurchase(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			plan        *postgres.CryptoRecurringPurchase
+			request     models.HTTPRecurringPurchaseRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if plan, httpStatus, httpMessage, payload, err =
+			common.HTTPRecurringPurchaseCreate(db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "recurring purchase scheduled", Payload: plan})
+	}
+}
+
+// CancelRecurringPurchase will handle an HTTP request to cancel an active recurring Cryptocurrency purchase plan.
+//
+//	@Summary		Cancel a recurring Cryptocurrency purchase plan.
+//	@Description	Cancels an active recurring Cryptocurrency purchase plan. Plans that have been completed or cancelled cannot be cancelled.
+//	@Tags			crypto cryptocurrency recurring purchase cancel
+//	@Id				cancelRecurringPurchase
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			planID	path		string				true	"the recurring purchase plan ID to cancel"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the cancellation of the plan"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/recurring/{planID} [delete]
+func CancelRecurringPurchase(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			planID      = ginCtx.Param("planID")
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPRecurringPurchaseCancel(db, logger, clientID, planID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: planID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recurring purchase cancelled", Payload: planID})
+	}
+}
+
+// RecurringPurchaseDetails will handle an HTTP request to retrieve a recurring Cryptocurrency purchase plan and its runs.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted N+1'th record to retrieve in the subsequent call.
+//
+//	@Summary		Retrieve a recurring Cryptocurrency purchase plan.
+//	@Description	Retrieves a recurring Cryptocurrency purchase plan along with a page of its runs, newest first. Each run records whether the purchase was EXECUTED, along with its receipt, or was not made due to INSUFFICIENT_FUNDS, being SKIPPED, or having FAILED. Subsequent requests will require a cursor to the next page of runs that will be returned in a previous call to the endpoint.
+//	@Tags			crypto cryptocurrency recurring purchase details
+//	@Id				recurringPurchaseDetails
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			planID		path		string				true	"the recurring purchase plan ID to retrieve the details for"
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"the recurring purchase plan and a page of its runs"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/recurring/{planID} [get]
+func RecurringPurchaseDetails(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			details     *models.HTTPRecurringPurchaseDetails
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if details, httpStatus, httpMessage, err = common.HTTPRecurringPurchaseDetails(auth, db, logger, clientID,
+			ginCtx.Param("planID"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recurring purchase details", Payload: details})
+	}
+}
+
+// RecurringPurchasesPaginated will handle an HTTP request to retrieve the recurring Cryptocurrency purchase plans
+// scheduled by a client.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted N+1'th record to retrieve in the subsequent call.
+//
+//	@Summary		Retrieve the recurring Cryptocurrency purchase plans for a client.
+//	@Description	Retrieves the recurring Cryptocurrency purchase plans scheduled by a client, newest first. The plans can optionally be restricted to a status of ACTIVE, CANCELLED, or COMPLETED. Subsequent requests will require a cursor to the next page that will be returned in a previous call to the endpoint.
+//	@Tags			crypto cryptocurrency recurring purchase
+//	@Id				recurringPurchasesPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string				false	"The status of the plans to retrieve."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of recurring purchase plans"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/recurring [get]
+func RecurringPurchasesPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			plans       *models.HTTPRecurringPurchasesPaginated
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if plans, httpStatus, httpMessage, err = common.HTTPRecurringPurchasesPaginated(auth, db, logger, clientID,
+			ginCtx.Query("status"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recurring purchases", Payload: plans})
+	}
+}
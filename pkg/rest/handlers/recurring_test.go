@@ -0,0 +1,455 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_ScheduleRecurringPurchase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPRecurringPurchaseRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		assetTimes       int
+		createErr        error
+		createTimes      int
+	}{
+		{
+			name:           "valid",
+			path:           "/recurring/valid",
+			expectedStatus: http.StatusCreated,
+			request: &models.HTTPRecurringPurchaseRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        nil,
+			createTimes:      1,
+		}, {
+			name:           "invalid jwt",
+			path:           "/recurring/invalid-jwt",
+			expectedStatus: http.StatusForbidden,
+			request: &models.HTTPRecurringPurchaseRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY"},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			assetTimes:       0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/recurring/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPRecurringPurchaseRequest{},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:           "start in the past",
+			path:           "/recurring/start-in-the-past",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPRecurringPurchaseRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY", StartsAt: 1},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:           "db failure unknown",
+			path:           "/recurring/db-failure-unknown",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPRecurringPurchaseRequest{FiatCurrency: "USD", Ticker: "BTC",
+				Amount: decimal.NewFromFloat(50), Cadence: "WEEKLY"},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        errors.New("unknown server error"),
+			createTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			planReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().RecurringPurchaseCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ScheduleRecurringPurchase(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(planReqJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_CancelRecurringPurchase(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/recurring/"
+
+	testCases := []struct {
+		name               string
+		planID             string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		cancelErr          error
+		cancelTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			planID:             "plan-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			cancelErr:          nil,
+			cancelTimes:        0,
+		}, {
+			name:               "invalid plan id",
+			planID:             "a-plan-id-that-is-far-too-long-to-be-valid",
+			expectedMsg:        "invalid plan id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			cancelErr:          nil,
+			cancelTimes:        0,
+		}, {
+			name:               "plan closed",
+			planID:             "plan-id",
+			expectedMsg:        "no longer active",
+			expectedStatus:     http.StatusConflict,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			cancelErr:          postgres.ErrRecurringClosed,
+			cancelTimes:        1,
+		}, {
+			name:               "valid",
+			planID:             "plan-id",
+			expectedMsg:        "recurring purchase cancelled",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			cancelErr:          nil,
+			cancelTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().RecurringPurchaseCancel(gomock.Any(), test.planID).
+					Return(test.cancelErr).
+					Times(test.cancelTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":planID", CancelRecurringPurchase(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.planID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_RecurringPurchaseDetails(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/recurring/"
+
+	testCases := []struct {
+		name               string
+		planID             string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		getErr             error
+		getTimes           int
+		runsTimes          int
+	}{
+		{
+			name:               "invalid JWT",
+			planID:             "plan-id",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           0,
+			runsTimes:          0,
+		}, {
+			name:               "invalid page size",
+			planID:             "plan-id",
+			querySegment:       "?pageSize=three",
+			expectedMsg:        "invalid page cursor or page size",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           0,
+			runsTimes:          0,
+		}, {
+			name:               "not found",
+			planID:             "plan-id",
+			querySegment:       "",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+			runsTimes:          0,
+		}, {
+			name:               "unknown db error",
+			planID:             "plan-id",
+			querySegment:       "",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             errors.New("unknown error"),
+			getTimes:           1,
+			runsTimes:          0,
+		}, {
+			name:               "valid",
+			planID:             "plan-id",
+			querySegment:       "?pageSize=3",
+			expectedMsg:        "recurring purchase details",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           1,
+			runsTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().RecurringPurchaseGet(gomock.Any(), test.planID).
+					Return(postgres.CryptoRecurringPurchase{}, test.getErr).
+					Times(test.getTimes),
+
+				mockDB.EXPECT().RecurringPurchaseRunsPaginated(gomock.Any(), test.planID, gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoRecurringPurchaseRun{}, nil).
+					Times(test.runsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":planID", RecurringPurchaseDetails(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.planID+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_RecurringPurchasesPaginated(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		plansErr           error
+		plansTimes         int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			plansErr:           nil,
+			plansTimes:         0,
+		}, {
+			name:               "invalid status",
+			querySegment:       "?status=PAUSED",
+			expectedMsg:        "invalid plan status",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			plansErr:           nil,
+			plansTimes:         0,
+		}, {
+			name:               "invalid page size",
+			querySegment:       "?pageSize=three",
+			expectedMsg:        "invalid page cursor or page size",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			plansErr:           nil,
+			plansTimes:         0,
+		}, {
+			name:               "unknown db error",
+			querySegment:       "?status=ACTIVE",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			plansErr:           errors.New("unknown error"),
+			plansTimes:         1,
+		}, {
+			name:               "valid",
+			querySegment:       "?status=ACTIVE&pageSize=3",
+			expectedMsg:        "recurring purchases",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			plansErr:           nil,
+			plansTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().RecurringPurchasesPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoRecurringPurchase{}, test.plansErr).
+					Times(test.plansTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/crypto/recurring", RecurringPurchasesPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/crypto/recurring"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// ReverseTransaction will handle an HTTP request to post a compensating reversal of a transaction.
+//
+//	@Summary		Reverse a transaction.
+//	@Description	Posts equal and opposite journal entries for a Fiat or Cryptocurrency transaction made in error and adjusts the account balances. The reversal is linked to the original transaction, which is marked as reversed in its transaction details. Transactions can only be reversed once, reversals cannot be reversed, and reversals that would overdraw an account are refused. A reason must be provided and is recorded in the audit log. Requires the administrative write scope.
+//	@Tags			admin transactions reversal
+//	@Id				reverseTransaction
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			transactionID	path		string							true	"the transaction ID to reverse"
+//	@Param			request			body		models.HTTPAdminReversalRequest	true	"the reason for the reversal"
+//	@Success		200				{object}	models.HTTPSuccess				"the reversal and its journal entries"
+//	@Failure		400				{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		402				{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/transactions/{transactionID}/reverse [post]
+func ReverseTransaction(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			adminID     uuid.UUID
+			err         error
+			request     models.HTTPAdminReversalRequest
+			reversal    *postgres.TransactionReversal
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if reversal, httpStatus, httpMessage, payload, err = common.HTTPAdminTransactionReverse(db, logger, adminID,
+			ginCtx.Param("transactionID"), &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "transaction reversed", Payload: reversal})
+	}
+}
@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_ReverseTransaction(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/transactions/"
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id")
+
+	reversal := &postgres.TransactionReversal{
+		JournalReversal: postgres.JournalReversal{TxID: txID, ReversalTxID: uuid.Must(uuid.NewV4())},
+		FiatEntries:     []postgres.FiatJournal{{}, {}},
+	}
+
+	testCases := []struct {
+		name               string
+		txID               string
+		expectedMsg        string
+		expectedStatus     int
+		request            *models.HTTPAdminReversalRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		auditErr           error
+		auditTimes         int
+		reverseErr         error
+		reverseTimes       int
+	}{
+		{
+			name:               "empty request",
+			txID:               txID.String(),
+			expectedMsg:        constants.ValidationString(),
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminReversalRequest{},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid JWT",
+			txID:               txID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid transaction id",
+			txID:               "invalid-transaction-id",
+			expectedMsg:        "invalid transaction ID",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "audit failure",
+			txID:               txID.String(),
+			expectedMsg:        "could not record",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			authTokenInfoTimes: 1,
+			auditErr:           postgres.ErrAuditLog,
+			auditTimes:         1,
+		}, {
+			name:               "already reversed",
+			txID:               txID.String(),
+			expectedMsg:        "already been reversed",
+			expectedStatus:     http.StatusConflict,
+			request:            &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			reverseErr:         postgres.ErrReversed,
+			reverseTimes:       1,
+		}, {
+			name:               "insufficient funds",
+			txID:               txID.String(),
+			expectedMsg:        postgres.ErrInsufficientFunds.Error(),
+			expectedStatus:     http.StatusPaymentRequired,
+			request:            &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			reverseErr:         postgres.ErrInsufficientFunds,
+			reverseTimes:       1,
+		}, {
+			name:               "valid",
+			txID:               txID.String(),
+			expectedMsg:        "transaction reversed",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPAdminReversalRequest{Reason: "deposit made in error"},
+			authTokenInfoTimes: 1,
+			auditTimes:         1,
+			reverseTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockDB.EXPECT().AdminAuditLogSetOutcome(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			reverseReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().AdminAuditLogCreate(gomock.Any(), postgres.AdminActionTRANSACTIONREVERSE, txID.String(),
+					gomock.Any()).
+					Return(int64(1), test.auditErr).
+					Times(test.auditTimes),
+
+				mockDB.EXPECT().TransactionReverse(txID, gomock.Any(), test.request.Reason).
+					Return(reversal, test.reverseErr).
+					Times(test.reverseTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath+":transactionID/reverse", ReverseTransaction(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, basePath+test.txID+"/reverse",
+				bytes.NewBuffer(reverseReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
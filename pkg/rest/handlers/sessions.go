@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// RefreshSession will handle an HTTP request to exchange a refresh token for a new JWT and refresh token.
+//
+//	@Summary		Refresh a session.
+//	@Description	Exchanges a refresh token for a new JWT and a new refresh token in the same session. Refresh tokens can only be used once. Reusing a refresh token that has already been exchanged revokes its session.
+//	@Tags			user users session sessions refresh security
+//	@Id				refreshSession
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.HTTPRefreshSessionRequest	true	"the refresh token to exchange"
+//	@Success		200		{object}	models.JWTAuthResponse				"a new valid JWT and refresh token"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/user/sessions/refresh [post]
+func RefreshSession(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			authToken  *models.JWTAuthResponse
+			request    models.HTTPRefreshSessionRequest
+			httpMsg    string
+			httpStatus int
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if authToken, httpMsg, httpStatus, err = common.HTTPRefreshSession(
+			auth, db, logger, &request, ginCtx.Request.UserAgent(), ginCtx.ClientIP()); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, authToken)
+	}
+}
+
+// Sessions will handle an HTTP request to retrieve the details of all the active sessions of a user.
+//
+//	@Summary		Retrieve active sessions.
+//	@Description	Retrieves the details of all the active sessions of a user, most recently refreshed first, along with the device and IP address each session was last refreshed from.
+//	@Tags			user users session sessions details
+//	@Id				sessions
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the details of the active sessions"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/sessions [get]
+func Sessions(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			sessions    []modelsPostgres.SessionInfo
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if sessions, httpStatus, httpMessage, err = common.HTTPSessions(db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "active sessions", Payload: sessions})
+	}
+}
+
+// RevokeSession will handle an HTTP request to revoke an active session of a user.
+//
+//	@Summary		Revoke a session.
+//	@Description	Revokes a session so that its refresh token can no longer be exchanged. JWTs that were issued in the session remain valid until they expire.
+//	@Tags			user users session sessions revoke security
+//	@Id				revokeSession
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			sessionID	path		string				true	"the session ID to revoke"
+//	@Success		200			{object}	models.HTTPSuccess	"a message to confirm the revocation of the session"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/sessions/{sessionID} [delete]
+func RevokeSession(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			sessionID   = ginCtx.Param("sessionID")
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPSessionRevoke(db, logger, clientID, sessionID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: sessionID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "session revoked", Payload: sessionID})
+	}
+}
@@ -0,0 +1,281 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_RefreshSession(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		request        *models.HTTPRefreshSessionRequest
+		rotateErr      error
+		rotateTimes    int
+		jwtTimes       int
+	}{
+		{
+			name:           "empty request",
+			path:           "/refresh/empty-request",
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPRefreshSessionRequest{},
+			rotateErr:      nil,
+			rotateTimes:    0,
+			jwtTimes:       0,
+		}, {
+			name:           "reused refresh token",
+			path:           "/refresh/reused-refresh-token",
+			expectedStatus: http.StatusForbidden,
+			request:        &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			rotateErr:      postgres.ErrRefreshTokenReuse,
+			rotateTimes:    1,
+			jwtTimes:       0,
+		}, {
+			name:           "db failure unknown",
+			path:           "/refresh/db-failure-unknown",
+			expectedStatus: http.StatusInternalServerError,
+			request:        &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			rotateErr:      errors.New("unknown server error"),
+			rotateTimes:    1,
+			jwtTimes:       0,
+		}, {
+			name:           "valid",
+			path:           "/refresh/valid",
+			expectedStatus: http.StatusOK,
+			request:        &models.HTTPRefreshSessionRequest{RefreshToken: "refresh-token"},
+			rotateErr:      nil,
+			rotateTimes:    1,
+			jwtTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("new-refresh-token", "new-refresh-token-hash", nil).
+					Times(test.rotateTimes),
+
+				mockAuth.EXPECT().HashRefreshToken(gomock.Any()).
+					Return("refresh-token-hash").
+					Times(test.rotateTimes),
+
+				mockDB.EXPECT().RefreshTokenRotate("refresh-token-hash", gomock.Any()).
+					Return(test.rotateErr).
+					Times(test.rotateTimes),
+
+				mockDB.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleUser()}, nil).
+					Times(test.jwtTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
+					Return(&models.JWTAuthResponse{}, nil).
+					Times(test.jwtTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, RefreshSession(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_Sessions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		expectedStatus   int
+		authTokenInfoErr error
+		sessionsErr      error
+		sessionsTimes    int
+	}{
+		{
+			name:             "invalid jwt",
+			expectedStatus:   http.StatusForbidden,
+			authTokenInfoErr: errors.New("invalid jwt"),
+			sessionsErr:      nil,
+			sessionsTimes:    0,
+		}, {
+			name:             "db failure unknown",
+			expectedStatus:   http.StatusInternalServerError,
+			authTokenInfoErr: nil,
+			sessionsErr:      errors.New("unknown server error"),
+			sessionsTimes:    1,
+		}, {
+			name:             "valid",
+			expectedStatus:   http.StatusOK,
+			authTokenInfoErr: nil,
+			sessionsErr:      nil,
+			sessionsTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(1),
+
+				mockDB.EXPECT().RefreshSessionsClient(gomock.Any()).
+					Return([]modelsPostgres.SessionInfo{{SessionID: "session-id"}}, test.sessionsErr).
+					Times(test.sessionsTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/user/sessions", Sessions(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/user/sessions", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/user/sessions/"
+
+	testCases := []struct {
+		name               string
+		sessionID          string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		revokeErr          error
+		revokeTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			sessionID:          "session-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "invalid session id",
+			sessionID:          "a-session-id-that-is-far-too-long-to-be-valid",
+			expectedMsg:        "invalid session id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			revokeErr:          nil,
+			revokeTimes:        0,
+		}, {
+			name:               "not found",
+			sessionID:          "session-id",
+			expectedMsg:        "not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			revokeErr:          postgres.ErrNotFound,
+			revokeTimes:        1,
+		}, {
+			name:               "valid",
+			sessionID:          "session-id",
+			expectedMsg:        "session revoked",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			revokeErr:          nil,
+			revokeTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().RefreshSessionRevoke(gomock.Any(), test.sessionID).
+					Return(test.revokeErr).
+					Times(test.revokeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":sessionID", RevokeSession(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.sessionID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// BalanceAsOfFiat will handle an HTTP request to retrieve the balance of a Fiat currency account at a point in time.
+//
+//	@Summary		Retrieve the balance for a specific Fiat currency at a point in time.
+//	@Description	Retrieves the balance for a specific Fiat currency at a point in time. The point in time is a UNIX timestamp in seconds that cannot be in the future. The balance is computed from the nearest daily closing balance snapshot at or before the point in time and the journal entries posted since.
+//	@Tags			fiat currency balance history
+//	@Id				balanceAsOfFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ticker		path		string				true	"the currency ticker to retrieve the balance for"
+//	@Param			timestamp	query		int					true	"the UNIX timestamp in seconds to retrieve the balance at"
+//	@Success		200			{object}	models.HTTPSuccess	"the balance at the point in time"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/fiat/info/balance/{ticker}/as-of [get]
+//
+//nolint:dupl
+func BalanceAsOfFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			balance     *postgres.BalanceAsOf
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if balance, httpStatus, httpMessage, payload, err = common.HTTPFiatBalanceAsOf(
+			db, logger, clientID, ginCtx.Param("ticker"), ginCtx.Query("timestamp")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balance as of", Payload: balance})
+	}
+}
+
+// BalanceHistoryFiat will handle an HTTP request to retrieve the daily closing balances of a Fiat currency account.
+//
+//	@Summary		Retrieve the daily closing balances for a specific Fiat currency.
+//	@Description	Retrieves the daily closing balances for a specific Fiat currency within a time range, oldest first. The start and end of the range are UNIX timestamps in seconds. The range ends now and starts 30 days before its end if they are not supplied, and cannot be longer than 366 days.
+//	@Tags			fiat currency balance history
+//	@Id				balanceHistoryFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ticker	path		string				true	"the currency ticker to retrieve the balance history for"
+//	@Param			from	query		int					false	"the UNIX timestamp in seconds of the start of the range"
+//	@Param			to		query		int					false	"the UNIX timestamp in seconds of the end of the range"
+//	@Success		200		{object}	models.HTTPSuccess	"the daily closing balances within the range"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/fiat/info/balance/{ticker}/history [get]
+//
+//nolint:dupl
+func BalanceHistoryFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			history     *models.HTTPFiatBalanceHistory
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if history, httpStatus, httpMessage, payload, err = common.HTTPFiatBalanceHistory(
+			db, logger, clientID, ginCtx.Param("ticker"), ginCtx.Query("from"), ginCtx.Query("to")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balance history", Payload: history})
+	}
+}
+
+// BalanceAsOfCrypto will handle an HTTP request to retrieve the balance of a Cryptocurrency account at a point in time.
+//
+//	@Summary		Retrieve the balance for a specific Cryptocurrency at a point in time.
+//	@Description	Retrieves the balance for a specific Cryptocurrency at a point in time. The point in time is a UNIX timestamp in seconds that cannot be in the future. The balance is computed from the nearest daily closing balance snapshot at or before the point in time and the journal entries posted since.
+//	@Tags			crypto cryptocurrency balance history
+//	@Id				balanceAsOfCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ticker		path		string				true	"the Cryptocurrency ticker to retrieve the balance for"
+//	@Param			timestamp	query		int					true	"the UNIX timestamp in seconds to retrieve the balance at"
+//	@Success		200			{object}	models.HTTPSuccess	"the balance at the point in time"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/info/balance/{ticker}/as-of [get]
+//
+//nolint:dupl
+func BalanceAsOfCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			balance     *postgres.BalanceAsOf
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if balance, httpStatus, httpMessage, payload, err = common.HTTPCryptoBalanceAsOf(
+			db, logger, clientID, ginCtx.Param("ticker"), ginCtx.Query("timestamp")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balance as of", Payload: balance})
+	}
+}
+
+// BalanceHistoryCrypto will handle an HTTP request to retrieve the daily closing balances of a Cryptocurrency account.
+//
+//	@Summary		Retrieve the daily closing balances for a specific Cryptocurrency.
+//	@Description	Retrieves the daily closing balances for a specific Cryptocurrency within a time range, oldest first. The start and end of the range are UNIX timestamps in seconds. The range ends now and starts 30 days before its end if they are not supplied, and cannot be longer than 366 days.
+//	@Tags			crypto cryptocurrency balance history
+//	@Id				balanceHistoryCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ticker	path		string				true	"the Cryptocurrency ticker to retrieve the balance history for"
+//	@Param			from	query		int					false	"the UNIX timestamp in seconds of the start of the range"
+//	@Param			to		query		int					false	"the UNIX timestamp in seconds of the end of the range"
+//	@Success		200		{object}	models.HTTPSuccess	"the daily closing balances within the range"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/info/balance/{ticker}/history [get]
+//
+//nolint:dupl
+func BalanceHistoryCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			history     *models.HTTPCryptoBalanceHistory
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if history, httpStatus, httpMessage, payload, err = common.HTTPCryptoBalanceHistory(
+			db, logger, clientID, ginCtx.Param("ticker"), ginCtx.Query("from"), ginCtx.Query("to")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balance history", Payload: history})
+	}
+}
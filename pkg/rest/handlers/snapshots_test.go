@@ -0,0 +1,492 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_BalanceAsOfFiat(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/fiat/info/balance/"
+
+	timestamp := time.Now().Add(-time.Hour).Unix()
+
+	testCases := []struct {
+		name               string
+		ticker             string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		balanceErr         error
+		balanceTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			ticker:             "USD",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			balanceErr:         nil,
+			balanceTimes:       0,
+		}, {
+			name:               "invalid timestamp",
+			ticker:             "USD",
+			querySegment:       "?timestamp=yesterday",
+			expectedMsg:        "invalid timestamp",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			balanceErr:         nil,
+			balanceTimes:       0,
+		}, {
+			name:               "not found",
+			ticker:             "USD",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			balanceErr:         postgres.ErrNotFound,
+			balanceTimes:       1,
+		}, {
+			name:               "unknown db error",
+			ticker:             "USD",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			balanceErr:         errors.New("unknown error"),
+			balanceTimes:       1,
+		}, {
+			name:               "valid",
+			ticker:             "USD",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "account balance as of",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			balanceErr:         nil,
+			balanceTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().FiatBalanceAsOf(gomock.Any(), postgres.Currency(test.ticker), time.Unix(timestamp, 0)).
+					Return(postgres.BalanceAsOf{}, test.balanceErr).
+					Times(test.balanceTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":ticker/as-of", BalanceAsOfFiat(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.ticker+"/as-of"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_BalanceHistoryFiat(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/fiat/info/balance/"
+
+	testCases := []struct {
+		name               string
+		ticker             string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		historyErr         error
+		historyTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			ticker:             "USD",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			historyErr:         nil,
+			historyTimes:       0,
+		}, {
+			name:               "invalid currency",
+			ticker:             "INVALID",
+			querySegment:       "",
+			expectedMsg:        "invalid currency",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			historyErr:         nil,
+			historyTimes:       0,
+		}, {
+			name:               "invalid range",
+			ticker:             "USD",
+			querySegment:       "?from=-1",
+			expectedMsg:        "invalid time range",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			historyErr:         nil,
+			historyTimes:       0,
+		}, {
+			name:               "unknown db error",
+			ticker:             "USD",
+			querySegment:       "",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			historyErr:         errors.New("unknown error"),
+			historyTimes:       1,
+		}, {
+			name:               "valid",
+			ticker:             "USD",
+			querySegment:       fmt.Sprintf("?from=%d", time.Now().Add(-48*time.Hour).Unix()),
+			expectedMsg:        "account balance history",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			historyErr:         nil,
+			historyTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().FiatBalanceHistory(gomock.Any(), postgres.Currency(test.ticker), gomock.Any(),
+					gomock.Any()).
+					Return([]postgres.FiatBalanceSnapshot{}, test.historyErr).
+					Times(test.historyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":ticker/history", BalanceHistoryFiat(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.ticker+"/history"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_BalanceAsOfCrypto(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/info/balance/"
+
+	timestamp := time.Now().Add(-time.Hour).Unix()
+
+	testCases := []struct {
+		name               string
+		ticker             string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		assetErr           error
+		assetTimes         int
+		balanceErr         error
+		balanceTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			ticker:             "BTC",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         0,
+			balanceErr:         nil,
+			balanceTimes:       0,
+		}, {
+			name:               "no timestamp",
+			ticker:             "BTC",
+			querySegment:       "",
+			expectedMsg:        "invalid timestamp",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         0,
+			balanceErr:         nil,
+			balanceTimes:       0,
+		}, {
+			name:               "unregistered ticker",
+			ticker:             "BTC",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "invalid currency",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           postgres.ErrNotFound,
+			assetTimes:         1,
+			balanceErr:         nil,
+			balanceTimes:       0,
+		}, {
+			name:               "not found",
+			ticker:             "BTC",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         1,
+			balanceErr:         postgres.ErrNotFound,
+			balanceTimes:       1,
+		}, {
+			name:               "valid",
+			ticker:             "BTC",
+			querySegment:       fmt.Sprintf("?timestamp=%d", timestamp),
+			expectedMsg:        "account balance as of",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         1,
+			balanceErr:         nil,
+			balanceTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().CryptoAssetGet(test.ticker).
+					Return(postgres.CryptoAsset{Ticker: test.ticker}, test.assetErr).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().CryptoBalanceAsOf(gomock.Any(), test.ticker, time.Unix(timestamp, 0)).
+					Return(postgres.BalanceAsOf{}, test.balanceErr).
+					Times(test.balanceTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":ticker/as-of", BalanceAsOfCrypto(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.ticker+"/as-of"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_BalanceHistoryCrypto(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/info/balance/"
+
+	testCases := []struct {
+		name               string
+		ticker             string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		assetErr           error
+		assetTimes         int
+		historyErr         error
+		historyTimes       int
+	}{
+		{
+			name:               "invalid JWT",
+			ticker:             "BTC",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         0,
+			historyErr:         nil,
+			historyTimes:       0,
+		}, {
+			name:               "invalid range",
+			ticker:             "BTC",
+			querySegment:       fmt.Sprintf("?to=%d", time.Now().Add(time.Hour).Unix()),
+			expectedMsg:        "invalid time range",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         0,
+			historyErr:         nil,
+			historyTimes:       0,
+		}, {
+			name:               "unknown db error",
+			ticker:             "BTC",
+			querySegment:       "",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         1,
+			historyErr:         errors.New("unknown error"),
+			historyTimes:       1,
+		}, {
+			name:               "valid",
+			ticker:             "BTC",
+			querySegment:       "",
+			expectedMsg:        "account balance history",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			assetErr:           nil,
+			assetTimes:         1,
+			historyErr:         nil,
+			historyTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().CryptoAssetGet(test.ticker).
+					Return(postgres.CryptoAsset{Ticker: test.ticker}, test.assetErr).
+					Times(test.assetTimes),
+
+				mockDB.EXPECT().CryptoBalanceHistory(gomock.Any(), test.ticker, gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoBalanceSnapshot{}, test.historyErr).
+					Times(test.historyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":ticker/history", BalanceHistoryCrypto(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.ticker+"/history"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// PlaceTriggerOrder will handle an HTTP request to place a Cryptocurrency trigger order.
+//
+//	@Summary		Place a Cryptocurrency trigger order.
+//	@Description	Places a STOP_LOSS or TAKE_PROFIT order to sell an amount of a Cryptocurrency position once its price in a Fiat currency crosses the trigger price. Stop-loss orders sell at or below the trigger price and take-profit orders sell at or above it. An amount of zero, or an omitted amount, sells the entire position available when the order is triggered. Stop-loss orders may specify a trailing distance in the Fiat currency, which raises the trigger price behind rising market prices but never lowers it. No funds are held and orders remain active until they are triggered or cancelled.
+//	@Tags			crypto cryptocurrency trigger order stop-loss take-profit
+//	@Id				placeTriggerOrder
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPTriggerOrderRequest	true	"the Cryptocurrency ticker, Fiat currency code, trigger type and price, and optional trailing distance and amount"
+//	@Success		201		{object}	models.HTTPSuccess				"the trigger order that was placed"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/crypto/triggers [post]
+func PlaceTriggerOrder(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			order       *postgres.CryptoTriggerOrder
+			request     models.HTTPTriggerOrderRequest
+			httpStatus  int
+			httpMessage string
+			payload     any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if order, httpStatus, httpMessage, payload, err =
+			common.HTTPTriggerOrderPlace(db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "trigger order placed", Payload: order})
+	}
+}
+
+// CancelTriggerOrder will handle an HTTP request to cancel an active Cryptocurrency trigger order.
+//
+//	@Summary		Cancel a Cryptocurrency trigger order.
+//	@Description	Cancels an active Cryptocurrency trigger order. Orders that have been triggered, cancelled, or failed cannot be cancelled.
+//	@Tags			crypto cryptocurrency trigger order cancel
+//	@Id				cancelTriggerOrder
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			orderID	path		string				true	"the trigger order ID to cancel"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the cancellation of the order"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/triggers/{orderID} [delete]
+func CancelTriggerOrder(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			orderID     = ginCtx.Param("orderID")
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPTriggerOrderCancel(db, logger, clientID, orderID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: orderID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "trigger order cancelled", Payload: orderID})
+	}
+}
+
+// TriggerOrderDetails will handle an HTTP request to retrieve a Cryptocurrency trigger order and its state history.
+//
+//	@Summary		Retrieve a Cryptocurrency trigger order.
+//	@Description	Retrieves a Cryptocurrency trigger order along with the history of its state changes.
+//	@Tags			crypto cryptocurrency trigger order details
+//	@Id				triggerOrderDetails
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			orderID	path		string				true	"the trigger order ID to retrieve the details for"
+//	@Success		200		{object}	models.HTTPSuccess	"the trigger order and its state history"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/triggers/{orderID} [get]
+func TriggerOrderDetails(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			details     *models.HTTPTriggerOrderDetails
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if details, httpStatus, httpMessage, err =
+			common.HTTPTriggerOrderDetails(db, logger, clientID, ginCtx.Param("orderID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "trigger order details", Payload: details})
+	}
+}
+
+// TriggerOrdersPaginated will handle an HTTP request to retrieve the Cryptocurrency trigger orders placed by a client.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted N+1'th record to retrieve in the subsequent call.
+//
+//	@Summary		Retrieve the Cryptocurrency trigger orders for a client.
+//	@Description	Retrieves the Cryptocurrency trigger orders placed by a client, newest first. The orders can optionally be restricted to a status of ACTIVE, TRIGGERED, CANCELLED, or FAILED. Subsequent requests will require a cursor to the next page that will be returned in a previous call to the endpoint.
+//	@Tags			crypto cryptocurrency trigger order
+//	@Id				triggerOrdersPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string				false	"The status of the orders to retrieve."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of trigger orders"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/crypto/triggers [get]
+func TriggerOrdersPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			orders      *models.HTTPTriggerOrdersPaginated
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if orders, httpStatus, httpMessage, err = common.HTTPTriggerOrdersPaginated(auth, db, logger, clientID,
+			ginCtx.Query("status"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "trigger orders", Payload: orders})
+	}
+}
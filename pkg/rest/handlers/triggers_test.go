@@ -0,0 +1,431 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_PlaceTriggerOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPTriggerOrderRequest
+		authTokenInfoErr error
+		authTokenInfoExp int
+		assetTimes       int
+		createErr        error
+		createTimes      int
+	}{
+		{
+			name:           "valid",
+			path:           "/triggers/valid",
+			expectedStatus: http.StatusCreated,
+			request: &models.HTTPTriggerOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				TriggerType: "STOP_LOSS", TriggerPrice: decimal.NewFromFloat(1000), Amount: decimal.NewFromFloat(0.5)},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        nil,
+			createTimes:      1,
+		}, {
+			name:           "invalid jwt",
+			path:           "/triggers/invalid-jwt",
+			expectedStatus: http.StatusForbidden,
+			request: &models.HTTPTriggerOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				TriggerType: "STOP_LOSS", TriggerPrice: decimal.NewFromFloat(1000), Amount: decimal.NewFromFloat(0.5)},
+			authTokenInfoErr: errors.New("invalid jwt"),
+			authTokenInfoExp: 1,
+			assetTimes:       0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:             constants.ValidationString(),
+			path:             "/triggers/validation",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPTriggerOrderRequest{},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       0,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:           "trailing take-profit",
+			path:           "/triggers/trailing-take-profit",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPTriggerOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				TriggerType: "TAKE_PROFIT", TriggerPrice: decimal.NewFromFloat(1000),
+				TrailingDistance: decimal.NewFromFloat(10)},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        nil,
+			createTimes:      0,
+		}, {
+			name:           "db failure unknown",
+			path:           "/triggers/db-failure-unknown",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPTriggerOrderRequest{FiatCurrency: "USD", Ticker: "BTC",
+				TriggerType: "STOP_LOSS", TriggerPrice: decimal.NewFromFloat(1000), Amount: decimal.NewFromFloat(0.5)},
+			authTokenInfoErr: nil,
+			authTokenInfoExp: 1,
+			assetTimes:       1,
+			createErr:        errors.New("unknown server error"),
+			createTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			orderReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoExp),
+
+				mockPostgres.EXPECT().CryptoAssetGet(gomock.Any()).
+					Return(testCryptoAsset, nil).
+					Times(test.assetTimes),
+
+				mockPostgres.EXPECT().TriggerOrderCreate(gomock.Any()).
+					Return(test.createErr).
+					Times(test.createTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, PlaceTriggerOrder(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(orderReqJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_CancelTriggerOrder(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/triggers/"
+
+	testCases := []struct {
+		name               string
+		orderID            string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		closeErr           error
+		closeTimes         int
+	}{
+		{
+			name:               "invalid JWT",
+			orderID:            "order-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "invalid order id",
+			orderID:            "an-order-id-that-is-far-too-long-to-be-valid",
+			expectedMsg:        "invalid order id",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			closeErr:           nil,
+			closeTimes:         0,
+		}, {
+			name:               "order closed",
+			orderID:            "order-id",
+			expectedMsg:        "no longer active",
+			expectedStatus:     http.StatusConflict,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			closeErr:           postgres.ErrTriggerOrderClosed,
+			closeTimes:         1,
+		}, {
+			name:               "valid",
+			orderID:            "order-id",
+			expectedMsg:        "trigger order cancelled",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			closeErr:           nil,
+			closeTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().TriggerOrderClose(gomock.Any(), test.orderID, postgres.TriggerOrderStatusCANCELLED,
+					gomock.Any()).
+					Return(test.closeErr).
+					Times(test.closeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":orderID", CancelTriggerOrder(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.orderID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_TriggerOrderDetails(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/crypto/triggers/"
+
+	testCases := []struct {
+		name               string
+		orderID            string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		getErr             error
+		getTimes           int
+	}{
+		{
+			name:               "invalid JWT",
+			orderID:            "order-id",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           0,
+		}, {
+			name:               "not found",
+			orderID:            "order-id",
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             postgres.ErrNotFound,
+			getTimes:           1,
+		}, {
+			name:               "unknown db error",
+			orderID:            "order-id",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             errors.New("unknown error"),
+			getTimes:           1,
+		}, {
+			name:               "valid",
+			orderID:            "order-id",
+			expectedMsg:        "trigger order details",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getErr:             nil,
+			getTimes:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().TriggerOrderGet(gomock.Any(), test.orderID).
+					Return(postgres.CryptoTriggerOrder{}, []postgres.CryptoTriggerOrderEvent{}, test.getErr).
+					Times(test.getTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":orderID", TriggerOrderDetails(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.orderID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_TriggerOrdersPaginated(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		querySegment       string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		ordersErr          error
+		ordersTimes        int
+	}{
+		{
+			name:               "invalid JWT",
+			querySegment:       "",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "invalid status",
+			querySegment:       "?status=PENDING",
+			expectedMsg:        "invalid order status",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "invalid page size",
+			querySegment:       "?pageSize=three",
+			expectedMsg:        "invalid page cursor or page size",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        0,
+		}, {
+			name:               "unknown db error",
+			querySegment:       "?status=ACTIVE",
+			expectedMsg:        "retry",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          errors.New("unknown error"),
+			ordersTimes:        1,
+		}, {
+			name:               "valid",
+			querySegment:       "?status=ACTIVE&pageSize=3",
+			expectedMsg:        "trigger orders",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			ordersErr:          nil,
+			ordersTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().TriggerOrdersPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoTriggerOrder{}, test.ordersErr).
+					Times(test.ordersTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/crypto/triggers", TriggerOrdersPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/crypto/triggers"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
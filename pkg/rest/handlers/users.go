@@ -2,21 +2,25 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 // RegisterUser will handle an HTTP request to create a user.
 //
 //	@Summary		Register a user.
-//	@Description	Creates a user account by inserting credentials into the database. A hashed password is stored.
+//	@Description	Creates a user account by inserting credentials into the database. A hashed password is stored. A session is started on the device the user registered from and a refresh token is returned along with the JWT. A verification link is mailed to the email address of the user.
 //	@Tags			user users register security
 //	@Id				registerUser
 //	@Accept			json
@@ -27,7 +31,8 @@ import (
 //	@Failure		404		{object}	models.HTTPError		"error message with any available details in payload"
 //	@Failure		500		{object}	models.HTTPError		"error message with any available details in payload"
 //	@Router			/user/register [post]
-func RegisterUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+func RegisterUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres,
+	notify notifier.Notifier) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
 			authToken  *models.JWTAuthResponse
@@ -44,7 +49,8 @@ func RegisterUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 			return
 		}
 
-		if authToken, httpMsg, httpStatus, payload, err = common.HTTPRegisterUser(auth, db, logger, &user); err != nil {
+		if authToken, httpMsg, httpStatus, payload, err = common.HTTPRegisterUser(
+			auth, db, notify, logger, &user, ginCtx.Request.UserAgent(), ginCtx.ClientIP()); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
 
 			return
@@ -57,18 +63,20 @@ func RegisterUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 // LoginUser validates login credentials and generates a JWT.
 //
 //	@Summary		Login a user.
-//	@Description	Logs in a user by validating credentials and returning a JWT.
+//	@Description	Logs in a user by validating credentials and returning a JWT. A session is started on the device the user logged in from and a refresh token is returned along with the JWT. Repeated failed attempts on a username or from an IP address are progressively delayed and then temporarily locked out, and rejected attempts report when to retry in the Retry-After header.
 //	@Tags			user users login security
 //	@Id				loginUser
 //	@Accept			json
 //	@Produce		json
 //	@Param			credentials	body		models.UserLoginCredentials	true	"Username and password to login with"
+//	@Param			X-OTP		header		string						false	"one-time password or recovery code when two-factor authentication is enabled"
 //	@Success		200			{object}	models.JWTAuthResponse		"a valid JWT token for the new account"
 //	@Failure		400			{object}	models.HTTPError			"error message with any available details in payload"
 //	@Failure		409			{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		429			{object}	models.HTTPError			"error message with the seconds to wait before retrying in payload"
 //	@Failure		500			{object}	models.HTTPError			"error message with any available details in payload"
 //	@Router			/user/login [post]
-func LoginUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+func LoginUser(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
 			err          error
@@ -85,7 +93,13 @@ func LoginUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.
 			return
 		}
 
-		if authToken, httpMsg, httpStatus, payload, err = common.HTTPLoginUser(auth, db, logger, &loginRequest); err != nil {
+		if authToken, httpMsg, httpStatus, payload, err = common.HTTPLoginUser(
+			auth, cache, db, logger, &loginRequest, ginCtx.Request.UserAgent(), ginCtx.ClientIP(),
+			ginCtx.GetHeader(constants.OTPHeader())); err != nil {
+			if retry, ok := payload.(models.HTTPRetryAfter); ok {
+				ginCtx.Header(constants.RetryAfterHeader(), strconv.FormatInt(retry.RetryAfter, 10))
+			}
+
 			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
 
 			return
@@ -125,7 +139,8 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 			return
 		}
 
-		if freshToken, httpMsg, httpStatus, err = common.HTTPRefreshLogin(auth, db, logger, clientID, expiresAt); err != nil {
+		if freshToken, httpMsg, httpStatus, err = common.HTTPRefreshLogin(auth, db, logger, clientID, expiresAt,
+			ginCtx.Request.UserAgent(), ginCtx.ClientIP()); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
 
 			return
@@ -146,6 +161,7 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 //	@Produce		json
 //	@Security		ApiKeyAuth
 //	@Param			request	body		models.HTTPDeleteUserRequest	true	"The request payload for deleting an account"
+//	@Param			X-OTP	header		string							false	"one-time password or recovery code when two-factor authentication is enabled"
 //	@Success		204		{object}	models.HTTPSuccess				"message with a confirmation of a deleted user account"
 //	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
 //	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
@@ -175,7 +191,9 @@ func DeleteUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin
 			return
 		}
 
-		if httpMsg, httpStatus, payload, err = common.HTTPDeleteUser(auth, db, logger, clientID, &deleteRequest); err != nil {
+		if httpMsg, httpStatus, payload, err = common.HTTPDeleteUser(
+			auth, db, logger, clientID, &deleteRequest, ginCtx.Request.UserAgent(), ginCtx.ClientIP(),
+			ginCtx.GetHeader(constants.OTPHeader())); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
 
 			return
@@ -184,3 +202,83 @@ func DeleteUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin
 		ginCtx.JSON(http.StatusNoContent, models.HTTPSuccess{Message: "account successfully deleted"})
 	}
 }
+
+// LogoutUser will revoke the JWT a request is authorized with.
+//
+//	@Summary		Log out of a session.
+//	@Description	Revokes the JWT the request is authorized with. The JWT will be rejected for the remainder of its validity interval and cannot be refreshed.
+//	@Tags			user users logout security
+//	@Id				logoutUser
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"a message to confirm the session has been logged out of"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/logout [post]
+func LogoutUser(logger *logger.Logger, auth auth.Auth, cache redis.Redis, authHeaderKey string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			expiresAt  int64
+			httpMsg    string
+			httpStatus int
+		)
+
+		if clientID, expiresAt, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMsg, err =
+			common.HTTPLogout(auth, cache, logger, clientID, ginCtx.GetHeader(authHeaderKey), expiresAt); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "successfully logged out"})
+	}
+}
+
+// LogoutAllSessions will revoke all the sessions of a user and all the JWTs issued to them, including the JWT the
+// request is authorized with.
+//
+//	@Summary		Log out of all sessions.
+//	@Description	Revokes all the sessions of the user and all the JWTs issued to the user up to and including the current second. The JWTs will be rejected for the remainder of their validity intervals and cannot be refreshed, and the refresh tokens of the sessions cannot be exchanged.
+//	@Tags			user users logout security
+//	@Id				logoutAllSessions
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"a message to confirm all sessions have been logged out of"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/logout-all [post]
+func LogoutAllSessions(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	authHeaderKey string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			expiresAt  int64
+			httpMsg    string
+			httpStatus int
+		)
+
+		if clientID, expiresAt, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMsg, err = common.HTTPLogoutAll(
+			auth, cache, db, logger, clientID, ginCtx.GetHeader(authHeaderKey), expiresAt); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "successfully logged out of all sessions"})
+	}
+}
@@ -15,11 +15,13 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestHandlers_UserRegister(t *testing.T) {
@@ -38,6 +40,7 @@ func TestHandlers_UserRegister(t *testing.T) {
 		authGenJWTTimes int
 		createUserErr   error
 		createUserTimes int
+		sessionTimes    int
 	}{
 		{
 			name:            "empty user",
@@ -62,9 +65,10 @@ func TestHandlers_UserRegister(t *testing.T) {
 			authHashTimes:   1,
 			createUserErr:   nil,
 			createUserTimes: 1,
-			authGenJWTToken: nil,
+			authGenJWTToken: &models.JWTAuthResponse{},
 			authGenJWTErr:   nil,
 			authGenJWTTimes: 1,
+			sessionTimes:    1,
 		}, {
 			name:            "password hash failure",
 			path:            "/user-register/pwd-hash-failure",
@@ -118,6 +122,7 @@ func TestHandlers_UserRegister(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
 			user := test.user
 			userJSON, err := json.Marshal(&user)
@@ -132,14 +137,34 @@ func TestHandlers_UserRegister(t *testing.T) {
 					Return(uuid.UUID{}, test.createUserErr).
 					Times(test.createUserTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
 					Return(test.authGenJWTToken, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("refresh-token", "refresh-token-hash", nil).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshTokenCreate(gomock.Any()).
+					Return(nil).
+					Times(test.sessionTimes),
+
+				mockAuth.EXPECT().GenerateEmailVerificationToken(gomock.Any(), gomock.Any()).
+					Return("verification-token", nil).
+					Times(test.sessionTimes),
+
+				mockNotifier.EXPECT().Link(gomock.Any(), gomock.Any()).
+					Return("verification-link").
+					Times(test.sessionTimes),
+
+				mockNotifier.EXPECT().Send(gomock.Any()).
+					Return(nil).
+					Times(test.sessionTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, RegisterUser(zapLogger, mockAuth, mockPostgres))
+			router.POST(test.path, RegisterUser(zapLogger, mockAuth, mockPostgres, mockNotifier))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(userJSON))
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -164,6 +189,12 @@ func TestHandlers_UserLogin(t *testing.T) {
 		authCheckPassTimes int
 		authGenJWTErr      error
 		authGenJWTTimes    int
+		sessionErr         error
+		sessionTimes       int
+		throttleTimes      int
+		lockedOut          bool
+		failureTimes       int
+		clearTimes         int
 	}{
 		{
 			name:               "empty user",
@@ -176,6 +207,9 @@ func TestHandlers_UserLogin(t *testing.T) {
 			authCheckPassTimes: 0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
+			throttleTimes:      0,
+			failureTimes:       0,
+			clearTimes:         0,
 		}, {
 			name:               "valid user",
 			path:               "/user-login/valid-user",
@@ -187,6 +221,11 @@ func TestHandlers_UserLogin(t *testing.T) {
 			authCheckPassTimes: 1,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    1,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         1,
+			sessionErr:         nil,
+			sessionTimes:       1,
 		}, {
 			name:               "database failure",
 			path:               "/user-login/database-failure",
@@ -198,6 +237,9 @@ func TestHandlers_UserLogin(t *testing.T) {
 			authCheckPassTimes: 0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       1,
+			clearTimes:         0,
 		}, {
 			name:               "password check failure",
 			path:               "/user-login/pwd-check-failure",
@@ -209,6 +251,9 @@ func TestHandlers_UserLogin(t *testing.T) {
 			authCheckPassTimes: 1,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			failureTimes:       1,
+			clearTimes:         0,
 		}, {
 			name:               "auth token failure",
 			path:               "/user-login/auth-token-failure",
@@ -220,6 +265,42 @@ func TestHandlers_UserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authGenJWTErr:      errors.New("auth token failure"),
 			authGenJWTTimes:    1,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         1,
+			sessionErr:         nil,
+			sessionTimes:       0,
+		}, {
+			name:               "session failure",
+			path:               "/user-login/session-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    1,
+			throttleTimes:      3,
+			failureTimes:       0,
+			clearTimes:         1,
+			sessionErr:         errors.New("session failure"),
+			sessionTimes:       1,
+		}, {
+			name:               "locked out",
+			path:               "/user-login/locked-out",
+			expectedStatus:     http.StatusTooManyRequests,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     0,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			throttleTimes:      3,
+			lockedOut:          true,
+			failureTimes:       0,
+			clearTimes:         0,
 		},
 	}
 
@@ -234,12 +315,41 @@ func TestHandlers_UserLogin(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 
 			user := test.user
 			userJSON, err := json.Marshal(&user)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
 
+			getErr := redis.ErrCacheMiss
+			if test.lockedOut {
+				getErr = nil
+			}
+
+			mockAuth.EXPECT().LoginThrottle().
+				Return(auth.LoginThrottle{MaxUserFailures: 5, MaxIPFailures: 20, BaseDelay: time.Second,
+					MaxDelay: time.Minute}).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Incr(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil).
+				Times(2 * test.failureTimes)
+
+			mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), time.Second).
+				Return(nil).
+				Times(test.failureTimes)
+
+			mockRedis.EXPECT().Del(gomock.Any()).
+				Return(redis.ErrCacheMiss).
+				Times(2 * test.clearTimes)
+
 			gomock.InOrder(
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+					SetArg(1, time.Now().Add(time.Minute).Unix()).
+					Return(getErr).
+					Times(test.throttleTimes),
+
 				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
 					Return(uuid.UUID{}, "hashed password", test.userCredsErr).
 					Times(test.userCredsTimes),
@@ -248,20 +358,40 @@ func TestHandlers_UserLogin(t *testing.T) {
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockPostgres.EXPECT().UserGetStatus(gomock.Any()).
+					Return(modelsPostgres.UserStatus{Role: constants.RoleUser()}, nil).
+					Times(test.authGenJWTTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
+
+				mockAuth.EXPECT().GenerateRefreshToken().
+					Return("refresh-token", "refresh-token-hash", nil).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshTokenCreate(gomock.Any()).
+					Return(test.sessionErr).
+					Times(test.sessionTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, LoginUser(zapLogger, mockAuth, mockPostgres))
+			router.POST(test.path, LoginUser(zapLogger, mockAuth, mockRedis, mockPostgres))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(userJSON))
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
 
 			// Verify responses
 			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+
+			if test.lockedOut {
+				require.NotEmpty(t, w.Header().Get("Retry-After"), "retry after header not set")
+			}
 		})
 	}
 }
@@ -405,6 +535,7 @@ func TestHandlers_LoginRefresh(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			gomock.InOrder(
 				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
@@ -419,7 +550,7 @@ func TestHandlers_LoginRefresh(t *testing.T) {
 					Return(test.authRefreshThresh).
 					Times(test.authRefreshTimes),
 
-				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+				mockAuth.EXPECT().GenerateJWT(gomock.Any(), gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
 			)
@@ -662,6 +793,7 @@ func TestHandlers_DeleteUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockPostgres.EXPECT().AuditEventCreate(gomock.Any()).Return(nil).AnyTimes()
 
 			requestJSON, err := json.Marshal(&test.deleteRequest)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
@@ -679,6 +811,10 @@ func TestHandlers_DeleteUser(t *testing.T) {
 					Return(test.authCheckPwdErr).
 					Times(test.authCheckPwdTimes),
 
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.UserMFA{}, postgres.ErrNotFound).
+					Times(test.userDeleteTimes),
+
 				mockPostgres.EXPECT().UserDelete(gomock.Any()).
 					Return(test.userDeleteErr).
 					Times(test.userDeleteTimes),
@@ -696,3 +832,229 @@ func TestHandlers_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlers_LogoutUser(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		sessionErr         error
+		sessionTimes       int
+		revokeAllErr       error
+		revokeAllTimes     int
+		cacheSetErr        error
+		cacheSetTimes      int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/user-logout/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "invalid token",
+			path:               "/user-logout/no-session-information",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         errors.New("invalid token"),
+			sessionTimes:       1,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "cache failure",
+			path:               "/user-logout/cache-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			cacheSetErr:        errors.New("cache failure"),
+			cacheSetTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/user-logout/valid",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			cacheSetErr:        nil,
+			cacheSetTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().TokenSession("valid-token").
+					Return("token-id", time.Now().UnixMilli(), test.sessionErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Set("jwt-denylist:token-id", true, gomock.Any()).
+					Return(test.cacheSetErr).
+					Times(test.cacheSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, LogoutUser(zapLogger, mockAuth, mockCache, "Authorization"))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			req.Header.Set("Authorization", "valid-token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_LogoutAllSessions(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		sessionErr         error
+		sessionTimes       int
+		revokeAllErr       error
+		revokeAllTimes     int
+		cacheSetErr        error
+		cacheSetTimes      int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/user-logout-all/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid JWT"),
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       0,
+			revokeAllErr:       nil,
+			revokeAllTimes:     0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "invalid token",
+			path:               "/user-logout-all/no-session-information",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         errors.New("invalid token"),
+			sessionTimes:       1,
+			revokeAllErr:       nil,
+			revokeAllTimes:     0,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "db failure",
+			path:               "/user-logout-all/db-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			revokeAllErr:       errors.New("db failure"),
+			revokeAllTimes:     1,
+			cacheSetErr:        nil,
+			cacheSetTimes:      0,
+		}, {
+			name:               "cache failure",
+			path:               "/user-logout-all/cache-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			revokeAllErr:       nil,
+			revokeAllTimes:     1,
+			cacheSetErr:        errors.New("cache failure"),
+			cacheSetTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/user-logout-all/valid",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			revokeAllErr:       nil,
+			revokeAllTimes:     1,
+			cacheSetErr:        nil,
+			cacheSetTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(clientID, time.Now().Add(time.Minute).Unix(), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().TokenSession("valid-token").
+					Return("token-id", time.Now().UnixMilli(), test.sessionErr).
+					Times(test.sessionTimes),
+
+				mockPostgres.EXPECT().RefreshSessionsRevokeAll(clientID).
+					Return(test.revokeAllErr).
+					Times(test.revokeAllTimes),
+
+				mockCache.EXPECT().Set("jwt-revoked-before:"+clientID.String(), gomock.Any(), gomock.Any()).
+					Return(test.cacheSetErr).
+					Times(test.cacheSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, LogoutAllSessions(zapLogger, mockAuth, mockCache, mockPostgres, "Authorization"))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			req.Header.Set("Authorization", "valid-token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path"
 	"sync"
 	"syscall"
 
@@ -13,7 +14,10 @@ import (
 	"github.com/spf13/afero"
 	_ "github.com/surahman/FTeX/docs" // Swaggo generated Swagger documentation
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -31,6 +35,7 @@ type Server struct {
 	auth   auth.Auth
 	cache  redis.Redis
 	db     postgres.Postgres
+	notify notifier.Notifier
 	quotes quotes.Quotes
 	conf   *config
 	logger *logger.Logger
@@ -40,7 +45,7 @@ type Server struct {
 
 // NewServer will create a new REST server instance in a non-running state.
 func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis redis.Redis, quotes quotes.Quotes,
-	logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
+	notify notifier.Notifier, logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
 	// Load configurations.
 	conf := newConfig()
 	if err = conf.Load(*fs); err != nil {
@@ -52,6 +57,7 @@ func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis r
 			auth:   auth,
 			cache:  redis,
 			db:     postgres,
+			notify: notify,
 			quotes: quotes,
 			logger: logger,
 			wg:     wg,
@@ -89,14 +95,27 @@ func (s *Server) initialize() {
 	s.router.GET(s.conf.Server.SwaggerPath, ginSwagger.WrapHandler(swaggerfiles.Handler))
 
 	// Endpoint configurations
-	authMiddleware := restHandlers.AuthMiddleware(s.auth, s.db, s.logger, s.conf.Authorization.HeaderKey)
-	api := s.router.Group(s.conf.Server.BasePath)
+	authMiddleware := restHandlers.AuthMiddleware(s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey)
+	readMiddleware := restHandlers.AuthMiddleware(
+		s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey, constants.APIKeyScopeRead())
+	tradeMiddleware := restHandlers.AuthMiddleware(
+		s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey, constants.APIKeyScopeTrade())
+	transferMiddleware := restHandlers.AuthMiddleware(
+		s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey, constants.APIKeyScopeTransfer())
+	defaultPolicy, routePolicies := rateLimitPolicies(s.conf)
+	api := s.router.Group(s.conf.Server.BasePath, restHandlers.RateLimitMiddleware(
+		s.auth, s.cache, s.logger, s.conf.Authorization.HeaderKey, defaultPolicy, routePolicies))
 
 	api.GET("/health", restHandlers.Healthcheck(s.logger, s.db, s.cache))
 
 	userGroup := api.Group("/user")
-	userGroup.POST("/register", restHandlers.RegisterUser(s.logger, s.auth, s.db))
-	userGroup.POST("/login", restHandlers.LoginUser(s.logger, s.auth, s.db))
+	userGroup.POST("/register", restHandlers.RegisterUser(s.logger, s.auth, s.db, s.notify))
+	userGroup.POST("/login", restHandlers.LoginUser(s.logger, s.auth, s.cache, s.db))
+	userGroup.POST("/sessions/refresh", restHandlers.RefreshSession(s.logger, s.auth, s.db))
+	userGroup.POST("/password/reset-request",
+		restHandlers.RequestPasswordReset(s.logger, s.auth, s.cache, s.db, s.notify))
+	userGroup.POST("/password/reset", restHandlers.ResetPassword(s.logger, s.auth, s.cache, s.db))
+	userGroup.GET("/email/verify", restHandlers.VerifyEmail(s.logger, s.auth, s.db))
 	userGroup.
 		Use(authMiddleware).
 		POST("/refresh", restHandlers.LoginRefresh(s.logger, s.auth, s.db))
@@ -104,24 +123,125 @@ func (s *Server) initialize() {
 		Use(authMiddleware).
 		DELETE("/delete", restHandlers.DeleteUser(s.logger, s.auth, s.db))
 
-	fiatGroup := api.Group("/fiat").Use(authMiddleware)
-	fiatGroup.POST("/open", restHandlers.OpenFiat(s.logger, s.auth, s.db))
-	fiatGroup.POST("/deposit", restHandlers.DepositFiat(s.logger, s.auth, s.db))
-	fiatGroup.POST("/exchange/offer", restHandlers.ExchangeOfferFiat(s.logger, s.auth, s.cache, s.quotes))
-	fiatGroup.POST("/exchange/transfer", restHandlers.ExchangeTransferFiat(s.logger, s.auth, s.cache, s.db))
-	fiatGroup.GET("/info/balance/:ticker", restHandlers.BalanceFiat(s.logger, s.auth, s.db))
-	fiatGroup.GET("/info/balance/", restHandlers.BalanceFiatPaginated(s.logger, s.auth, s.db))
-	fiatGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsFiat(s.logger, s.auth, s.db))
-	fiatGroup.GET("/info/transaction/all/:currencyCode", restHandlers.TxDetailsFiatPaginated(s.logger, s.auth, s.db))
-
-	cryptoGroup := api.Group("/crypto").Use(authMiddleware)
-	cryptoGroup.POST("/open", restHandlers.OpenCrypto(s.logger, s.auth, s.db))
-	cryptoGroup.POST("/offer", restHandlers.OfferCrypto(s.logger, s.auth, s.cache, s.quotes))
-	cryptoGroup.POST("/exchange", restHandlers.ExchangeCrypto(s.logger, s.auth, s.cache, s.db))
-	cryptoGroup.GET("/info/balance/:ticker", restHandlers.BalanceCrypto(s.logger, s.auth, s.db))
-	cryptoGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsCrypto(s.logger, s.auth, s.db))
-	cryptoGroup.GET("/info/balance/", restHandlers.BalanceCryptoPaginated(s.logger, s.auth, s.db))
-	cryptoGroup.GET("/info/transaction/all/:ticker", restHandlers.TxDetailsCryptoPaginated(s.logger, s.auth, s.db))
+	sessionsGroup := api.Group("/user").Use(authMiddleware)
+	sessionsGroup.POST("/logout", restHandlers.LogoutUser(s.logger, s.auth, s.cache, s.conf.Authorization.HeaderKey))
+	sessionsGroup.POST("/logout-all",
+		restHandlers.LogoutAllSessions(s.logger, s.auth, s.cache, s.db, s.conf.Authorization.HeaderKey))
+	sessionsGroup.POST("/password", restHandlers.ChangePassword(s.logger, s.auth, s.cache, s.db))
+	sessionsGroup.GET("/sessions", restHandlers.Sessions(s.logger, s.auth, s.db))
+	sessionsGroup.DELETE("/sessions/:sessionID", restHandlers.RevokeSession(s.logger, s.auth, s.db))
+	sessionsGroup.GET("/security-activity", restHandlers.SecurityActivity(s.logger, s.auth, s.db))
+	sessionsGroup.GET("/profile", restHandlers.UserProfile(s.logger, s.auth, s.db))
+	sessionsGroup.PATCH("/profile", restHandlers.UpdateUserProfile(s.logger, s.auth, s.db, s.notify))
+	sessionsGroup.POST("/email/verification", restHandlers.SendEmailVerification(s.logger, s.auth, s.db, s.notify))
+
+	mfaGroup := api.Group("/user/mfa").Use(authMiddleware)
+	mfaGroup.POST("/enroll", restHandlers.EnrollMFA(s.logger, s.auth, s.db))
+	mfaGroup.POST("/confirm", restHandlers.ConfirmMFA(s.logger, s.auth, s.db))
+	mfaGroup.POST("/recovery-codes", restHandlers.RecoveryCodesMFA(s.logger, s.auth, s.db))
+	mfaGroup.DELETE("", restHandlers.DisableMFA(s.logger, s.auth, s.db))
+
+	apiKeysGroup := api.Group("/user/api-keys").Use(authMiddleware)
+	apiKeysGroup.POST("", restHandlers.CreateAPIKey(s.logger, s.auth, s.db))
+	apiKeysGroup.GET("", restHandlers.APIKeys(s.logger, s.auth, s.db))
+	apiKeysGroup.DELETE("/:keyID", restHandlers.RevokeAPIKey(s.logger, s.auth, s.db))
+
+	fiatReadGroup := api.Group("/fiat").Use(readMiddleware)
+	fiatReadGroup.GET("/info/balance/:ticker", restHandlers.BalanceFiat(s.logger, s.auth, s.db))
+	fiatReadGroup.GET("/info/balance/:ticker/as-of", restHandlers.BalanceAsOfFiat(s.logger, s.auth, s.db))
+	fiatReadGroup.GET("/info/balance/:ticker/history", restHandlers.BalanceHistoryFiat(s.logger, s.auth, s.db))
+	fiatReadGroup.GET("/info/balance/", restHandlers.BalanceFiatPaginated(s.logger, s.auth, s.db))
+	fiatReadGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsFiat(s.logger, s.auth, s.db))
+	fiatReadGroup.GET("/info/transaction/all/:currencyCode", restHandlers.TxDetailsFiatPaginated(s.logger, s.auth, s.db))
+	fiatReadGroup.GET("/currencies", restHandlers.FiatCurrencies(s.logger, s.db))
+
+	fiatTradeGroup := api.Group("/fiat").Use(tradeMiddleware)
+	fiatTradeGroup.POST("/exchange/offer", restHandlers.ExchangeOfferFiat(s.logger, s.auth, s.cache, s.db, s.quotes))
+	fiatTradeGroup.POST("/exchange/transfer", restHandlers.ExchangeTransferFiat(s.logger, s.auth, s.cache, s.db))
+
+	fiatTransferGroup := api.Group("/fiat").Use(transferMiddleware)
+	fiatTransferGroup.POST("/open", restHandlers.OpenFiat(s.logger, s.auth, s.db))
+	fiatTransferGroup.POST("/deposit", restHandlers.DepositFiat(s.logger, s.auth, s.db))
+	fiatTransferGroup.POST("/close", restHandlers.CloseFiat(s.logger, s.auth, s.db, s.quotes))
+
+	cryptoReadGroup := api.Group("/crypto").Use(readMiddleware)
+	cryptoReadGroup.GET("/info/balance/:ticker", restHandlers.BalanceCrypto(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/info/balance/:ticker/as-of", restHandlers.BalanceAsOfCrypto(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/info/balance/:ticker/history", restHandlers.BalanceHistoryCrypto(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsCrypto(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/info/balance/", restHandlers.BalanceCryptoPaginated(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/info/transaction/all/:ticker", restHandlers.TxDetailsCryptoPaginated(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/assets", restHandlers.CryptoAssets(s.logger, s.db))
+	cryptoReadGroup.GET("/orders", restHandlers.LimitOrdersPaginated(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/orders/:orderID", restHandlers.LimitOrderDetails(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/triggers", restHandlers.TriggerOrdersPaginated(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/triggers/:orderID", restHandlers.TriggerOrderDetails(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/recurring", restHandlers.RecurringPurchasesPaginated(s.logger, s.auth, s.db))
+	cryptoReadGroup.GET("/recurring/:planID", restHandlers.RecurringPurchaseDetails(s.logger, s.auth, s.db))
+
+	cryptoTradeGroup := api.Group("/crypto").Use(tradeMiddleware)
+	cryptoTradeGroup.POST("/offer", restHandlers.OfferCrypto(s.logger, s.auth, s.cache, s.db, s.quotes))
+	cryptoTradeGroup.POST("/exchange", restHandlers.ExchangeCrypto(s.logger, s.auth, s.cache, s.db))
+	cryptoTradeGroup.POST("/orders", restHandlers.PlaceLimitOrder(s.logger, s.auth, s.db))
+	cryptoTradeGroup.DELETE("/orders/:orderID", restHandlers.CancelLimitOrder(s.logger, s.auth, s.db))
+	cryptoTradeGroup.POST("/triggers", restHandlers.PlaceTriggerOrder(s.logger, s.auth, s.db))
+	cryptoTradeGroup.DELETE("/triggers/:orderID", restHandlers.CancelTriggerOrder(s.logger, s.auth, s.db))
+	cryptoTradeGroup.POST("/recurring", restHandlers.ScheduleRecurringPurchase(s.logger, s.auth, s.db))
+	cryptoTradeGroup.DELETE("/recurring/:planID", restHandlers.CancelRecurringPurchase(s.logger, s.auth, s.db))
+
+	cryptoTransferGroup := api.Group("/crypto").Use(transferMiddleware)
+	cryptoTransferGroup.POST("/open", restHandlers.OpenCrypto(s.logger, s.auth, s.db))
+	cryptoTransferGroup.POST("/close", restHandlers.CloseCrypto(s.logger, s.auth, s.db, s.quotes))
+
+	adminReadGroup := api.Group("/admin").Use(restHandlers.AdminMiddleware(
+		s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey, constants.ScopeAdminRead()))
+	adminReadGroup.GET("/users/search", restHandlers.SearchUsers(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/users/:clientID", restHandlers.ViewUser(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/users/:clientID/fiat/balance", restHandlers.BalanceFiatUser(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/users/:clientID/fiat/transactions/:currencyCode",
+		restHandlers.TxDetailsFiatUser(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/users/:clientID/crypto/balance", restHandlers.BalanceCryptoUser(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/users/:clientID/crypto/transactions/:ticker",
+		restHandlers.TxDetailsCryptoUser(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/audit", restHandlers.AuditLog(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/audit/events", restHandlers.AuditEvents(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/lockouts", restHandlers.LoginLockouts(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/ledger/verify", restHandlers.LedgerVerify(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/ledger/checkpoints", restHandlers.LedgerCheckpoints(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/adjustments", restHandlers.AdjustmentsPaginated(s.logger, s.auth, s.db))
+	adminReadGroup.GET("/adjustments/:adjustmentID", restHandlers.AdjustmentDetails(s.logger, s.auth, s.db))
+
+	adminWriteGroup := api.Group("/admin").Use(restHandlers.AdminMiddleware(
+		s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey, constants.ScopeAdminWrite()))
+	adminWriteGroup.PATCH("/users/:clientID/freeze", restHandlers.FreezeUser(s.logger, s.auth, s.db))
+	adminWriteGroup.POST("/users/:clientID/unlock", restHandlers.UnlockUser(s.logger, s.auth, s.cache, s.db))
+	adminWriteGroup.PATCH("/users/:clientID/fiat/:currencyCode/status",
+		restHandlers.StatusFiatAccount(s.logger, s.auth, s.db))
+	adminWriteGroup.PATCH("/users/:clientID/crypto/:ticker/status",
+		restHandlers.StatusCryptoAccount(s.logger, s.auth, s.db))
+	adminWriteGroup.PUT("/crypto/assets", restHandlers.UpsertCryptoAsset(s.logger, s.auth, s.db))
+	adminWriteGroup.PATCH("/crypto/assets/:ticker/status", restHandlers.StatusCryptoAsset(s.logger, s.auth, s.db))
+	adminWriteGroup.PUT("/fiat/currencies", restHandlers.UpsertFiatCurrency(s.logger, s.auth, s.db))
+	adminWriteGroup.POST("/transactions/:transactionID/reverse",
+		restHandlers.ReverseTransaction(s.logger, s.auth, s.db))
+	adminWriteGroup.POST("/adjustments", restHandlers.RequestAdjustment(s.logger, s.auth, s.db))
+	adminWriteGroup.POST("/adjustments/:adjustmentID/approve", restHandlers.ApproveAdjustment(s.logger, s.auth, s.db))
+	adminWriteGroup.POST("/adjustments/:adjustmentID/reject", restHandlers.RejectAdjustment(s.logger, s.auth, s.db))
+}
+
+// rateLimitPolicies will build the default rate limit policy and the policies for individual routes. Route policies are
+// keyed by the method and the full path of the route, including the base path, as they are matched by the router.
+func rateLimitPolicies(conf *config) (common.RateLimitPolicy, map[string]common.RateLimitPolicy) {
+	defaultPolicy := common.RateLimitPolicy{Name: "rest", Limit: conf.RateLimit.Limit, Window: conf.RateLimit.Window}
+	routePolicies := make(map[string]common.RateLimitPolicy, len(conf.RateLimit.Routes))
+
+	for _, route := range conf.RateLimit.Routes {
+		name := route.Method + " " + route.Path
+		routePolicies[route.Method+" "+path.Join("/", conf.Server.BasePath, route.Path)] =
+			common.RateLimitPolicy{Name: "rest:" + name, Limit: route.Limit, Window: route.Window}
+	}
+
+	return defaultPolicy, routePolicies
 }
 
 // Run brings the HTTP service up.
@@ -15,7 +15,15 @@ server:
   writeTimeout: 3s
   readHeaderTimeout: 3s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"out of range port": `
 server:
@@ -27,7 +35,15 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"out of range time delay": `
 server:
@@ -39,7 +55,15 @@ server:
   writeTimeout: 0s
   readHeaderTimeout: 0s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"no base path": `
 server:
@@ -50,7 +74,15 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"no swagger path": `
 server:
@@ -61,7 +93,15 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"no read timeout": `
 server:
@@ -72,7 +112,15 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"no write timeout": `
 server:
@@ -83,7 +131,15 @@ server:
   readTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"no read header timeout": `
 server:
@@ -94,7 +150,15 @@ server:
   readTimeout: 1s
   writeTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
 
 		"no auth header": `
 server:
@@ -106,6 +170,46 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey:`,
+  headerKey:
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: POST
+      path: /crypto/offer
+      limit: 10
+      window: 60s`,
+
+		"no rate limit": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  swaggerPath: /swagger/*any
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization`,
+
+		"invalid route rate limit": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  swaggerPath: /swagger/*any
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization
+rateLimit:
+  limit: 120
+  window: 60s
+  routes:
+    - method: FETCH
+      path: crypto/offer
+      limit: 10
+      window: 500ms`,
 	}
 }
@@ -0,0 +1,111 @@
+package rotation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// Counts is the tally of the stored secrets of one kind that were visited during a re-encryption run.
+type Counts struct {
+	Reencrypted int64 `json:"reencrypted"`
+	Current     int64 `json:"current"`
+	Skipped     int64 `json:"skipped"`
+	Failed      int64 `json:"failed"`
+}
+
+// Report is the outcome of re-encrypting the stored secrets with the active crypto secret. Secrets that changed while
+// they were being re-encrypted are skipped since they were written with the active crypto secret.
+type Report struct {
+	MFASecrets    Counts `json:"mfaSecrets"`
+	APIKeySecrets Counts `json:"apiKeySecrets"`
+}
+
+// Failed will return the number of stored secrets that could not be re-encrypted.
+func (r *Report) Failed() int64 {
+	return r.MFASecrets.Failed + r.APIKeySecrets.Failed
+}
+
+// Reencrypt will encrypt the stored two-factor authentication and API key secrets again with the active crypto secret.
+// Secrets that are already encrypted with the active crypto secret are left in place. Once a run reports no failures,
+// the crypto secrets they were previously encrypted with can be retired.
+func Reencrypt(db postgres.Postgres, authority auth.Auth, logger *logger.Logger) (Report, error) {
+	var report Report
+
+	if db == nil || authority == nil || logger == nil {
+		return report, errors.New("nil database, authority, or logger supplied")
+	}
+
+	mfaSecrets, err := db.MFASecrets()
+	if err != nil {
+		return report, fmt.Errorf("failed to retrieve two-factor authentication secrets: %w", err)
+	}
+
+	for clientID, secret := range mfaSecrets {
+		clientID := clientID
+
+		report.MFASecrets.tally(reencryptSecret(authority, secret, func(reencrypted string) error {
+			return db.MFASecretUpdate(clientID, secret, reencrypted)
+		}), logger, zap.String("clientID", clientID.String()))
+	}
+
+	apiKeySecrets, err := db.APIKeySecrets()
+	if err != nil {
+		return report, fmt.Errorf("failed to retrieve API key secrets: %w", err)
+	}
+
+	for keyID, secret := range apiKeySecrets {
+		keyID := keyID
+
+		report.APIKeySecrets.tally(reencryptSecret(authority, secret, func(reencrypted string) error {
+			return db.APIKeySecretUpdate(keyID, secret, reencrypted)
+		}), logger, zap.String("keyID", keyID))
+	}
+
+	return report, nil
+}
+
+// outcome is the result of re-encrypting a single stored secret.
+type outcome struct {
+	reencrypted bool
+	err         error
+}
+
+// reencryptSecret will encrypt a stored secret again with the active crypto secret and store it with the update
+// function if it was encrypted with another crypto secret.
+func reencryptSecret(authority auth.Auth, secret string, update func(string) error) outcome {
+	reencrypted, changed, err := authority.ReencryptString(secret)
+	if err != nil {
+		return outcome{err: fmt.Errorf("failed to re-encrypt secret: %w", err)}
+	}
+
+	if !changed {
+		return outcome{}
+	}
+
+	if err = update(reencrypted); err != nil {
+		return outcome{err: err}
+	}
+
+	return outcome{reencrypted: true}
+}
+
+// tally will record the outcome of re-encrypting a single stored secret and log any failures.
+func (c *Counts) tally(result outcome, logger *logger.Logger, field zap.Field) {
+	switch {
+	case errors.Is(result.err, postgres.ErrNotFound):
+		c.Skipped++
+	case result.err != nil:
+		c.Failed++
+
+		logger.Error("failed to re-encrypt stored secret", field, zap.Error(result.err))
+	case result.reencrypted:
+		c.Reencrypted++
+	default:
+		c.Current++
+	}
+}
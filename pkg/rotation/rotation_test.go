@@ -0,0 +1,181 @@
+package rotation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestRotation_Reencrypt(t *testing.T) {
+	t.Parallel()
+
+	testAuth := auth.TestAuth(zapLogger, 60, 30)
+	rotatedAuth := auth.TestAuthRotated(zapLogger, 60, 30)
+
+	oldSecret, err := testAuth.EncryptToString([]byte("stored secret"))
+	require.NoError(t, err, "failed to encrypt with the old crypto secret.")
+
+	currentSecret, err := rotatedAuth.EncryptToString([]byte("stored secret"))
+	require.NoError(t, err, "failed to encrypt with the active crypto secret.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name             string
+		mfaSecrets       map[uuid.UUID]string
+		mfaSecretsErr    error
+		mfaUpdateErr     error
+		mfaUpdateTimes   int
+		apiKeySecrets    map[string]string
+		apiKeySecretsErr error
+		apiKeyTimes      int
+		apiUpdateErr     error
+		apiUpdateTimes   int
+		expectErr        require.ErrorAssertionFunc
+		expectReport     Report
+	}{
+		{
+			name:           "re-encrypted",
+			mfaSecrets:     map[uuid.UUID]string{clientID: oldSecret},
+			mfaUpdateTimes: 1,
+			apiKeySecrets:  map[string]string{"key-id": oldSecret},
+			apiKeyTimes:    1,
+			apiUpdateTimes: 1,
+			expectErr:      require.NoError,
+			expectReport: Report{
+				MFASecrets:    Counts{Reencrypted: 1},
+				APIKeySecrets: Counts{Reencrypted: 1},
+			},
+		}, {
+			name:          "current",
+			mfaSecrets:    map[uuid.UUID]string{clientID: currentSecret},
+			apiKeySecrets: map[string]string{"key-id": currentSecret},
+			apiKeyTimes:   1,
+			expectErr:     require.NoError,
+			expectReport: Report{
+				MFASecrets:    Counts{Current: 1},
+				APIKeySecrets: Counts{Current: 1},
+			},
+		}, {
+			name:           "changed concurrently",
+			mfaSecrets:     map[uuid.UUID]string{clientID: oldSecret},
+			mfaUpdateErr:   postgres.ErrNotFound,
+			mfaUpdateTimes: 1,
+			apiKeySecrets:  map[string]string{"key-id": oldSecret},
+			apiKeyTimes:    1,
+			apiUpdateErr:   postgres.ErrNotFound,
+			apiUpdateTimes: 1,
+			expectErr:      require.NoError,
+			expectReport: Report{
+				MFASecrets:    Counts{Skipped: 1},
+				APIKeySecrets: Counts{Skipped: 1},
+			},
+		}, {
+			name:           "update failure",
+			mfaSecrets:     map[uuid.UUID]string{clientID: oldSecret},
+			mfaUpdateErr:   postgres.ErrMFA,
+			mfaUpdateTimes: 1,
+			apiKeySecrets:  map[string]string{"key-id": "undecryptable"},
+			apiKeyTimes:    1,
+			expectErr:      require.NoError,
+			expectReport: Report{
+				MFASecrets:    Counts{Failed: 1},
+				APIKeySecrets: Counts{Failed: 1},
+			},
+		}, {
+			name:          "mfa secrets failure",
+			mfaSecretsErr: postgres.ErrMFA,
+			expectErr:     require.Error,
+		}, {
+			name:             "api key secrets failure",
+			mfaSecrets:       map[uuid.UUID]string{},
+			apiKeySecretsErr: errors.New("api key secrets failure"),
+			apiKeyTimes:      1,
+			expectErr:        require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().MFASecrets().
+					Return(test.mfaSecrets, test.mfaSecretsErr).
+					Times(1),
+
+				mockDB.EXPECT().MFASecretUpdate(clientID, oldSecret, gomock.Any()).
+					Return(test.mfaUpdateErr).
+					Times(test.mfaUpdateTimes),
+
+				mockDB.EXPECT().APIKeySecrets().
+					Return(test.apiKeySecrets, test.apiKeySecretsErr).
+					Times(test.apiKeyTimes),
+
+				mockDB.EXPECT().APIKeySecretUpdate("key-id", oldSecret, gomock.Any()).
+					Return(test.apiUpdateErr).
+					Times(test.apiUpdateTimes),
+			)
+
+			report, err := Reencrypt(mockDB, rotatedAuth, zapLogger)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectReport, report, "report mismatch.")
+			require.Equal(t, test.expectReport.MFASecrets.Failed+test.expectReport.APIKeySecrets.Failed,
+				report.Failed(), "failure count mismatch.")
+		})
+	}
+}
+
+func TestRotation_Reencrypt_Stored(t *testing.T) {
+	t.Parallel()
+
+	testAuth := auth.TestAuth(zapLogger, 60, 30)
+	rotatedAuth := auth.TestAuthRotated(zapLogger, 60, 30)
+
+	oldSecret, err := testAuth.EncryptToString([]byte("stored secret"))
+	require.NoError(t, err, "failed to encrypt with the old crypto secret.")
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockDB := mocks.NewMockPostgres(mockCtrl)
+
+	var stored string
+
+	mockDB.EXPECT().MFASecrets().Return(map[uuid.UUID]string{}, nil).Times(1)
+	mockDB.EXPECT().APIKeySecrets().Return(map[string]string{"key-id": oldSecret}, nil).Times(1)
+	mockDB.EXPECT().APIKeySecretUpdate("key-id", oldSecret, gomock.Any()).
+		DoAndReturn(func(_, _, reencrypted string) error {
+			stored = reencrypted
+
+			return nil
+		}).Times(1)
+
+	_, err = Reencrypt(mockDB, rotatedAuth, zapLogger)
+	require.NoError(t, err, "failed to re-encrypt.")
+
+	// The re-encrypted secret is under the active crypto secret and decrypts to the original plaintext.
+	plaintext, err := rotatedAuth.DecryptFromString(stored)
+	require.NoError(t, err, "failed to decrypt re-encrypted secret.")
+	require.Equal(t, []byte("stored secret"), plaintext, "plaintext mismatch.")
+
+	_, changed, err := rotatedAuth.ReencryptString(stored)
+	require.NoError(t, err, "failed to check re-encrypted secret.")
+	require.False(t, changed, "re-encrypted secret is not under the active crypto secret.")
+
+	_, err = Reencrypt(nil, rotatedAuth, zapLogger)
+	require.Error(t, err, "nil database accepted.")
+}
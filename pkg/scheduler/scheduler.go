@@ -0,0 +1,282 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+// Scheduler is the background worker that carries out the scheduled runs of recurring Cryptocurrency purchase plans.
+type Scheduler struct {
+	db        postgres.Postgres
+	quotes    quotes.Quotes
+	logger    *logger.Logger
+	wg        *sync.WaitGroup
+	interval  time.Duration
+	batchSize int32
+}
+
+// NewScheduler will create a new recurring purchase scheduler in a non-running state.
+func NewScheduler(db postgres.Postgres, quotes quotes.Quotes, logger *logger.Logger, wg *sync.WaitGroup) (
+	*Scheduler, error) {
+	if db == nil || quotes == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, quotes, logger, or wait group supplied")
+	}
+
+	return &Scheduler{
+		db:        db,
+		quotes:    quotes,
+		logger:    logger,
+		wg:        wg,
+		interval:  constants.RecurringPollInterval(),
+		batchSize: constants.RecurringBatchSize(),
+	}, nil
+}
+
+// Run will periodically sweep the due recurring purchases until an interrupt signal is received.
+func (s *Scheduler) Run() {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Wait for interrupt signal to gracefully shut down the scheduler.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-quit:
+			s.logger.Info("Recurring purchase scheduler exited")
+
+			return
+		case <-ticker.C:
+			s.sweep(time.Now())
+		}
+	}
+}
+
+// sweep will attempt to carry out the scheduled run of every due recurring purchase in batches. Cryptocurrency registry
+// entries are retrieved at most once per sweep for each asset.
+func (s *Scheduler) sweep(now time.Time) {
+	var (
+		afterID string
+		assets  = make(map[string]*postgres.CryptoAsset)
+	)
+
+	for {
+		plans, err := s.db.RecurringPurchasesDue(afterID, s.batchSize)
+		if err != nil {
+			s.logger.Warn("failed to retrieve due recurring purchases", zap.Error(err))
+
+			return
+		}
+
+		for idx := range plans {
+			s.run(&plans[idx], now, assets)
+		}
+
+		if len(plans) < int(s.batchSize) {
+			return
+		}
+
+		afterID = plans[len(plans)-1].PlanID
+	}
+}
+
+// asset will retrieve the registry entry for a Cryptocurrency. A nil entry is cached for assets that could not be
+// retrieved so that their plans are retried on the next sweep.
+func (s *Scheduler) asset(ticker string, assets map[string]*postgres.CryptoAsset) *postgres.CryptoAsset {
+	entry, ok := assets[ticker]
+	if !ok {
+		asset, err := s.db.CryptoAssetGet(ticker)
+		if err != nil {
+			s.logger.Warn("failed to retrieve Cryptocurrency registry entry for recurring purchases",
+				zap.String("ticker", ticker), zap.Error(err))
+		} else {
+			entry = &asset
+		}
+
+		assets[ticker] = entry
+	}
+
+	return entry
+}
+
+// run will carry out the most recent due run of a recurring purchase plan. Earlier runs that were missed whilst the
+// scheduler was not running are recorded as skipped. Runs that cannot be funded, priced within the order limits of the
+// Cryptocurrency, or executed against the accounts are recorded with their outcome and the plan moves on to its next
+// run. All other failures are retried on the next sweep.
+func (s *Scheduler) run(plan *postgres.CryptoRecurringPurchase, now time.Time,
+	assets map[string]*postgres.CryptoAsset) {
+	// Record the missed runs.
+	for {
+		next, ok := nextRun(plan, plan.NextRunAt.Time)
+		if !ok || next.After(now) {
+			break
+		}
+
+		if !s.skip(plan, postgres.RecurringPurchaseRunStatusSKIPPED, "run missed") {
+			return
+		}
+	}
+
+	asset := s.asset(plan.Ticker, assets)
+	if asset == nil {
+		return
+	}
+
+	if asset.Status != postgres.CryptoAssetStatusENABLED {
+		s.skip(plan, postgres.RecurringPurchaseRunStatusSKIPPED, fmt.Sprintf("%s trading is halted", plan.Ticker))
+
+		return
+	}
+
+	_, cryptoAmount, err := s.quotes.CryptoConversion(
+		string(plan.FiatCurrency), plan.Ticker, plan.Amount, true, nil)
+	if err != nil {
+		s.logger.Warn("failed to retrieve quote for recurring purchase, retrying on next sweep",
+			zap.String("planID", plan.PlanID), zap.Error(err))
+
+		return
+	}
+
+	// Round the Cryptocurrency purchase amount to the precision supported by the asset.
+	cryptoAmount = cryptoAmount.RoundBank(asset.DecimalPlaces)
+	if err = common.HTTPCryptoOrderCheck(asset, cryptoAmount); err != nil {
+		s.skip(plan, postgres.RecurringPurchaseRunStatusSKIPPED, err.Error())
+
+		return
+	}
+
+	price := plan.Amount.Div(cryptoAmount).RoundBank(constants.DecimalPlacesFiat())
+
+	_, _, err = s.db.RecurringPurchaseExecute(plan, nextRunAt(plan), cryptoAmount, price)
+
+	switch {
+	case err == nil:
+		s.logger.Info("executed recurring purchase", zap.String("planID", plan.PlanID),
+			zap.String("price", price.String()))
+	case errors.Is(err, postgres.ErrRecurringClosed):
+		// The plan was cancelled or the run was processed since it was retrieved.
+	case errors.Is(err, postgres.ErrInsufficientFunds):
+		s.skip(plan, postgres.RecurringPurchaseRunStatusINSUFFICIENTFUNDS, err.Error())
+	case errors.Is(err, postgres.ErrAccountStatus):
+		s.skip(plan, postgres.RecurringPurchaseRunStatusFAILED, err.Error())
+	default:
+		s.logger.Warn("failed to execute recurring purchase, retrying on next sweep",
+			zap.String("planID", plan.PlanID), zap.Error(err))
+	}
+}
+
+// skip will record the scheduled run of a recurring purchase plan without a purchase and move the plan on to its next
+// run. It returns whether the plan is still active and due for its next run.
+func (s *Scheduler) skip(plan *postgres.CryptoRecurringPurchase, status postgres.RecurringPurchaseRunStatus,
+	details string) bool {
+	next := nextRunAt(plan)
+
+	if err := s.db.RecurringPurchaseSkip(plan, next, status, details); err != nil {
+		if !errors.Is(err, postgres.ErrRecurringClosed) {
+			s.logger.Warn("failed to record recurring purchase run", zap.String("planID", plan.PlanID),
+				zap.Error(err))
+		}
+
+		return false
+	}
+
+	plan.NextRunAt = next
+
+	return next.Valid
+}
+
+// nextRunAt will compute the run following the currently scheduled run of a recurring purchase plan. An invalid
+// timestamp is returned if the plan ends before its next run.
+func nextRunAt(plan *postgres.CryptoRecurringPurchase) pgtype.Timestamptz {
+	next, ok := nextRun(plan, plan.NextRunAt.Time)
+
+	return pgtype.Timestamptz{Time: next, Valid: ok}
+}
+
+// nextRun will compute the first run of a recurring purchase plan after a point in time. Runs are anchored to the start
+// of the plan so that they do not drift, and monthly runs that fall on days absent from shorter months run on the last
+// day of those months. It returns false if the plan ends before the run.
+func nextRun(plan *postgres.CryptoRecurringPurchase, after time.Time) (time.Time, bool) {
+	var (
+		start = plan.StartsAt.Time
+		days  = cadenceDays(plan.Cadence)
+		count int
+		next  time.Time
+	)
+
+	// Estimate the number of runs elapsed since the start and step forward to the first run after the point in time.
+	if after.After(start) {
+		if days == 0 {
+			count = (after.Year()-start.Year())*12 + int(after.Month()-start.Month()) - 1
+		} else {
+			count = int(after.Sub(start)/(time.Duration(days)*24*time.Hour)) - 1
+		}
+	}
+
+	if count < 0 {
+		count = 0
+	}
+
+	for ; ; count++ {
+		if days == 0 {
+			next = addMonths(start, count)
+		} else {
+			next = start.AddDate(0, 0, count*days)
+		}
+
+		if next.After(after) {
+			break
+		}
+	}
+
+	if plan.EndsAt.Valid && next.After(plan.EndsAt.Time) {
+		return next, false
+	}
+
+	return next, true
+}
+
+// addMonths will add a number of months to a point in time, clamping the day to the last day of the resulting month.
+func addMonths(start time.Time, months int) time.Time {
+	year, month, day := start.Date()
+	target := time.Date(year, month+time.Month(months), 1, start.Hour(), start.Minute(), start.Second(),
+		start.Nanosecond(), start.Location())
+
+	if lastDay := target.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+
+	return target.AddDate(0, 0, day-1)
+}
+
+// cadenceDays is the number of days between the runs of a recurring purchase plan. Monthly cadences do not have a fixed
+// number of days between runs and are reported as zero.
+func cadenceDays(cadence postgres.RecurringPurchaseCadence) int {
+	switch cadence {
+	case postgres.RecurringPurchaseCadenceDAILY:
+		return 1
+	case postgres.RecurringPurchaseCadenceWEEKLY:
+		return 7
+	case postgres.RecurringPurchaseCadenceBIWEEKLY:
+		return 14
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,439 @@
+package scheduler
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+)
+
+func TestNewScheduler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+	scheduler, err := NewScheduler(nil, mockQuotes, zapLogger, &sync.WaitGroup{})
+	require.Error(t, err, "created scheduler with nil database.")
+	require.Nil(t, scheduler, "returned scheduler with nil database.")
+
+	scheduler, err = NewScheduler(mockPostgres, mockQuotes, zapLogger, &sync.WaitGroup{})
+	require.NoError(t, err, "failed to create scheduler.")
+	require.NotNil(t, scheduler, "failed to return scheduler.")
+}
+
+func TestScheduler_Sweep(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 5, 12, 30, 0, 0, time.UTC)
+	startsAt := time.Date(2023, time.June, 5, 12, 0, 0, 0, time.UTC)
+	plan := postgres.CryptoRecurringPurchase{
+		PlanID:       "plan-weekly",
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		Amount:       decimal.NewFromFloat(50),
+		Cadence:      postgres.RecurringPurchaseCadenceWEEKLY,
+		StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+		NextRunAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+	}
+	enabled := postgres.CryptoAsset{
+		Ticker:        "BTC",
+		DecimalPlaces: 8,
+		Status:        postgres.CryptoAssetStatusENABLED,
+		MinOrder:      decimal.NewFromFloat(0.0001),
+		MaxOrder:      decimal.NewFromFloat(10),
+	}
+	halted := enabled
+	halted.Status = postgres.CryptoAssetStatusHALTED
+
+	testCases := []struct {
+		name         string
+		plans        []postgres.CryptoRecurringPurchase
+		dueErr       error
+		asset        postgres.CryptoAsset
+		assetErr     error
+		assetTimes   int
+		amount       decimal.Decimal
+		quoteErr     error
+		quoteTimes   int
+		executeErr   error
+		executeTimes int
+		skipStatus   postgres.RecurringPurchaseRunStatus
+		skipTimes    int
+	}{
+		{
+			name:         "due plans failure",
+			plans:        nil,
+			dueErr:       errors.New("due plans failure"),
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   0,
+			amount:       decimal.Zero,
+			quoteErr:     nil,
+			quoteTimes:   0,
+			executeErr:   nil,
+			executeTimes: 0,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    0,
+		}, {
+			name:         "registry failure",
+			plans:        []postgres.CryptoRecurringPurchase{plan, plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     errors.New("registry failure"),
+			assetTimes:   1,
+			amount:       decimal.Zero,
+			quoteErr:     nil,
+			quoteTimes:   0,
+			executeErr:   nil,
+			executeTimes: 0,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    0,
+		}, {
+			name:         "trading halted",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        halted,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.Zero,
+			quoteErr:     nil,
+			quoteTimes:   0,
+			executeErr:   nil,
+			executeTimes: 0,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    1,
+		}, {
+			name:         "quote failure",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.Zero,
+			quoteErr:     errors.New("quote failure"),
+			quoteTimes:   1,
+			executeErr:   nil,
+			executeTimes: 0,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    0,
+		}, {
+			name:         "below order limits",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.NewFromFloat(0.00001),
+			quoteErr:     nil,
+			quoteTimes:   1,
+			executeErr:   nil,
+			executeTimes: 0,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    1,
+		}, {
+			name:         "executed",
+			plans:        []postgres.CryptoRecurringPurchase{plan, plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.NewFromFloat(0.002),
+			quoteErr:     nil,
+			quoteTimes:   2,
+			executeErr:   nil,
+			executeTimes: 2,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    0,
+		}, {
+			name:         "plan closed",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.NewFromFloat(0.002),
+			quoteErr:     nil,
+			quoteTimes:   1,
+			executeErr:   postgres.ErrRecurringClosed,
+			executeTimes: 1,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    0,
+		}, {
+			name:         "execution failure",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.NewFromFloat(0.002),
+			quoteErr:     nil,
+			quoteTimes:   1,
+			executeErr:   postgres.ErrTransactCrypto,
+			executeTimes: 1,
+			skipStatus:   postgres.RecurringPurchaseRunStatusSKIPPED,
+			skipTimes:    0,
+		}, {
+			name:         "insufficient funds",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.NewFromFloat(0.002),
+			quoteErr:     nil,
+			quoteTimes:   1,
+			executeErr:   postgres.ErrInsufficientFunds,
+			executeTimes: 1,
+			skipStatus:   postgres.RecurringPurchaseRunStatusINSUFFICIENTFUNDS,
+			skipTimes:    1,
+		}, {
+			name:         "account frozen",
+			plans:        []postgres.CryptoRecurringPurchase{plan},
+			dueErr:       nil,
+			asset:        enabled,
+			assetErr:     nil,
+			assetTimes:   1,
+			amount:       decimal.NewFromFloat(0.002),
+			quoteErr:     nil,
+			quoteTimes:   1,
+			executeErr:   postgres.ErrAccountStatus,
+			executeTimes: 1,
+			skipStatus:   postgres.RecurringPurchaseRunStatusFAILED,
+			skipTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			nextRunAt := pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 7), Valid: true}
+
+			mockPostgres.EXPECT().
+				RecurringPurchasesDue(gomock.Any(), gomock.Any()).
+				Return(test.plans, test.dueErr).
+				Times(1)
+
+			mockPostgres.EXPECT().
+				CryptoAssetGet(gomock.Any()).
+				Return(test.asset, test.assetErr).
+				Times(test.assetTimes)
+
+			mockQuotes.EXPECT().
+				CryptoConversion("USD", "BTC", gomock.Any(), true, gomock.Any()).
+				Return(decimal.Zero, test.amount, test.quoteErr).
+				Times(test.quoteTimes)
+
+			mockPostgres.EXPECT().
+				RecurringPurchaseExecute(gomock.Any(), nextRunAt, gomock.Any(), gomock.Any()).
+				Do(func(_ *postgres.CryptoRecurringPurchase, _ pgtype.Timestamptz, amount decimal.Decimal,
+					price decimal.Decimal) {
+					require.True(t, amount.Equal(test.amount), "purchase amount mismatch.")
+					require.True(t, price.Equal(decimal.NewFromFloat(25000)), "purchase price mismatch.")
+				}).
+				Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.executeErr).
+				Times(test.executeTimes)
+
+			mockPostgres.EXPECT().
+				RecurringPurchaseSkip(gomock.Any(), nextRunAt, test.skipStatus, gomock.Any()).
+				Return(nil).
+				Times(test.skipTimes)
+
+			scheduler := &Scheduler{db: mockPostgres, quotes: mockQuotes, logger: zapLogger, batchSize: 10}
+			scheduler.sweep(now)
+		})
+	}
+}
+
+func TestScheduler_SweepMissedRuns(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+	startsAt := time.Date(2023, time.June, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2023, time.June, 3, 10, 0, 0, 0, time.UTC)
+	firstBatch := []postgres.CryptoRecurringPurchase{
+		{
+			PlanID:       "plan-1",
+			FiatCurrency: "USD",
+			Ticker:       "BTC",
+			Amount:       decimal.NewFromFloat(50),
+			Cadence:      postgres.RecurringPurchaseCadenceDAILY,
+			StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+			NextRunAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+		}, {
+			PlanID:       "plan-2",
+			FiatCurrency: "USD",
+			Ticker:       "BTC",
+			Amount:       decimal.NewFromFloat(50),
+			Cadence:      postgres.RecurringPurchaseCadenceDAILY,
+			StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+			EndsAt:       pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 1), Valid: true},
+			NextRunAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+		},
+	}
+	halted := postgres.CryptoAsset{Ticker: "BTC", Status: postgres.CryptoAssetStatusHALTED}
+	timestamp := func(days int) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, days), Valid: true}
+	}
+
+	gomock.InOrder(
+		mockPostgres.EXPECT().
+			RecurringPurchasesDue("", int32(2)).
+			Return(firstBatch, nil).
+			Times(1),
+
+		// The first plan misses two runs before its latest run is skipped for the halted asset.
+		mockPostgres.EXPECT().
+			RecurringPurchaseSkip(gomock.Any(), timestamp(1), postgres.RecurringPurchaseRunStatusSKIPPED, "run missed").
+			Return(nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			RecurringPurchaseSkip(gomock.Any(), timestamp(2), postgres.RecurringPurchaseRunStatusSKIPPED, "run missed").
+			Return(nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			CryptoAssetGet("BTC").
+			Return(halted, nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			RecurringPurchaseSkip(gomock.Any(), timestamp(3), postgres.RecurringPurchaseRunStatusSKIPPED,
+				gomock.Any()).
+			Return(nil).
+			Times(1),
+
+		// The second plan misses its first run and completes with its final run.
+		mockPostgres.EXPECT().
+			RecurringPurchaseSkip(gomock.Any(), timestamp(1), postgres.RecurringPurchaseRunStatusSKIPPED, "run missed").
+			Return(nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			RecurringPurchaseSkip(gomock.Any(), pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 2)},
+				postgres.RecurringPurchaseRunStatusSKIPPED, gomock.Any()).
+			Return(nil).
+			Times(1),
+
+		mockPostgres.EXPECT().
+			RecurringPurchasesDue("plan-2", int32(2)).
+			Return(nil, nil).
+			Times(1),
+	)
+
+	scheduler := &Scheduler{db: mockPostgres, quotes: mockQuotes, logger: zapLogger, batchSize: 2}
+	scheduler.sweep(now)
+}
+
+func TestNextRun(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		cadence    postgres.RecurringPurchaseCadence
+		endsAt     pgtype.Timestamptz
+		after      time.Time
+		expected   time.Time
+		expectedOk bool
+	}{
+		{
+			name:       "before start",
+			cadence:    postgres.RecurringPurchaseCadenceDAILY,
+			endsAt:     pgtype.Timestamptz{},
+			after:      startsAt.Add(-time.Hour),
+			expected:   startsAt,
+			expectedOk: true,
+		}, {
+			name:       "daily",
+			cadence:    postgres.RecurringPurchaseCadenceDAILY,
+			endsAt:     pgtype.Timestamptz{},
+			after:      startsAt,
+			expected:   time.Date(2023, time.February, 1, 9, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		}, {
+			name:       "weekly between runs",
+			cadence:    postgres.RecurringPurchaseCadenceWEEKLY,
+			endsAt:     pgtype.Timestamptz{},
+			after:      time.Date(2023, time.February, 10, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2023, time.February, 14, 9, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		}, {
+			name:       "biweekly",
+			cadence:    postgres.RecurringPurchaseCadenceBIWEEKLY,
+			endsAt:     pgtype.Timestamptz{},
+			after:      startsAt,
+			expected:   time.Date(2023, time.February, 14, 9, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		}, {
+			name:       "monthly clamped to shorter month",
+			cadence:    postgres.RecurringPurchaseCadenceMONTHLY,
+			endsAt:     pgtype.Timestamptz{},
+			after:      startsAt,
+			expected:   time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		}, {
+			name:       "monthly does not drift",
+			cadence:    postgres.RecurringPurchaseCadenceMONTHLY,
+			endsAt:     pgtype.Timestamptz{},
+			after:      time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC),
+			expected:   time.Date(2023, time.March, 31, 9, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		}, {
+			name:       "after end",
+			cadence:    postgres.RecurringPurchaseCadenceWEEKLY,
+			endsAt:     pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 6), Valid: true},
+			after:      startsAt,
+			expected:   time.Date(2023, time.February, 7, 9, 0, 0, 0, time.UTC),
+			expectedOk: false,
+		}, {
+			name:       "on end",
+			cadence:    postgres.RecurringPurchaseCadenceWEEKLY,
+			endsAt:     pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 7), Valid: true},
+			after:      startsAt,
+			expected:   time.Date(2023, time.February, 7, 9, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := &postgres.CryptoRecurringPurchase{
+				Cadence:  test.cadence,
+				StartsAt: pgtype.Timestamptz{Time: startsAt, Valid: true},
+				EndsAt:   test.endsAt,
+			}
+
+			actual, ok := nextRun(plan, test.after)
+			require.Equal(t, test.expectedOk, ok, "end of plan mismatch.")
+			require.True(t, test.expected.Equal(actual), "next run mismatch: %s", actual)
+		})
+	}
+}
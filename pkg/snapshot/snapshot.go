@@ -0,0 +1,100 @@
+package snapshot
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// Snapshotter is the background worker that records the daily closing balances of all Fiat and Crypto accounts.
+type Snapshotter struct {
+	db       postgres.Postgres
+	logger   *logger.Logger
+	wg       *sync.WaitGroup
+	interval time.Duration
+	delay    time.Duration
+}
+
+// NewSnapshotter will create a new balance snapshotter in a non-running state.
+func NewSnapshotter(db postgres.Postgres, logger *logger.Logger, wg *sync.WaitGroup) (*Snapshotter, error) {
+	if db == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, logger, or wait group supplied")
+	}
+
+	return &Snapshotter{
+		db:       db,
+		logger:   logger,
+		wg:       wg,
+		interval: constants.SnapshotPollInterval(),
+		delay:    constants.SnapshotSettlementDelay(),
+	}, nil
+}
+
+// Run will periodically record the daily closing balance snapshots that are due until an interrupt signal is received.
+func (s *Snapshotter) Run() {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Wait for interrupt signal to gracefully shut down the snapshotter.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-quit:
+			s.logger.Info("Balance snapshotter exited")
+
+			return
+		case <-ticker.C:
+			s.sweep(time.Now())
+		}
+	}
+}
+
+// sweep will record the closing balance snapshots for every midnight UTC that has passed since the most recent
+// snapshot, oldest first. Each midnight is only snapshotted once the settlement delay has elapsed. If there are no
+// snapshots, the first is taken at the most recent midnight. Failures are retried on the next sweep.
+func (s *Snapshotter) sweep(now time.Time) {
+	latest, err := s.db.BalanceSnapshotLatest()
+	if err != nil {
+		s.logger.Warn("failed to retrieve latest balance snapshot", zap.Error(err))
+
+		return
+	}
+
+	cutoff := now.Add(-s.delay)
+	next := midnight(cutoff)
+
+	if latest.Valid {
+		next = midnight(latest.Time).AddDate(0, 0, 1)
+	}
+
+	for ; !next.After(cutoff); next = next.AddDate(0, 0, 1) {
+		count, err := s.db.BalanceSnapshotCreate(next)
+		if err != nil {
+			s.logger.Warn("failed to record balance snapshots", zap.Time("snapshotAt", next), zap.Error(err))
+
+			return
+		}
+
+		s.logger.Info("recorded balance snapshots", zap.Time("snapshotAt", next), zap.Int64("accounts", count))
+	}
+}
+
+// midnight will return the midnight UTC at the start of the day of a time.
+func midnight(timestamp time.Time) time.Time {
+	year, month, day := timestamp.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
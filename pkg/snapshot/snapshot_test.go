@@ -0,0 +1,140 @@
+package snapshot
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+)
+
+func TestNewSnapshotter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+	snapshotter, err := NewSnapshotter(nil, zapLogger, &sync.WaitGroup{})
+	require.Error(t, err, "created snapshotter with nil database.")
+	require.Nil(t, snapshotter, "returned snapshotter with nil database.")
+
+	snapshotter, err = NewSnapshotter(mockPostgres, zapLogger, &sync.WaitGroup{})
+	require.NoError(t, err, "failed to create snapshotter.")
+	require.NotNil(t, snapshotter, "failed to return snapshotter.")
+}
+
+func TestSnapshotter_Sweep(t *testing.T) {
+	t.Parallel()
+
+	today := time.Date(2023, time.June, 5, 0, 0, 0, 0, time.UTC)
+	now := today.Add(time.Hour)
+	day := func(days int) time.Time {
+		return today.AddDate(0, 0, days)
+	}
+
+	testCases := []struct {
+		name      string
+		now       time.Time
+		latest    pgtype.Timestamptz
+		latestErr error
+		snapshots []time.Time
+		createErr error
+	}{
+		{
+			name:      "latest snapshot failure",
+			now:       now,
+			latest:    pgtype.Timestamptz{},
+			latestErr: errors.New("latest snapshot failure"),
+			snapshots: nil,
+			createErr: nil,
+		}, {
+			name:      "no snapshots",
+			now:       now,
+			latest:    pgtype.Timestamptz{},
+			latestErr: nil,
+			snapshots: []time.Time{day(0)},
+			createErr: nil,
+		}, {
+			name:      "no snapshots within settlement delay",
+			now:       today.Add(time.Minute),
+			latest:    pgtype.Timestamptz{},
+			latestErr: nil,
+			snapshots: []time.Time{day(-1)},
+			createErr: nil,
+		}, {
+			name:      "up to date",
+			now:       now,
+			latest:    pgtype.Timestamptz{Time: day(0), Valid: true},
+			latestErr: nil,
+			snapshots: nil,
+			createErr: nil,
+		}, {
+			name:      "within settlement delay",
+			now:       today.Add(time.Minute),
+			latest:    pgtype.Timestamptz{Time: day(-1), Valid: true},
+			latestErr: nil,
+			snapshots: nil,
+			createErr: nil,
+		}, {
+			name:      "catch up",
+			now:       now,
+			latest:    pgtype.Timestamptz{Time: day(-3), Valid: true},
+			latestErr: nil,
+			snapshots: []time.Time{day(-2), day(-1), day(0)},
+			createErr: nil,
+		}, {
+			name:      "create failure",
+			now:       now,
+			latest:    pgtype.Timestamptz{Time: day(-3), Valid: true},
+			latestErr: nil,
+			snapshots: []time.Time{day(-2)},
+			createErr: errors.New("create failure"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			calls := []*gomock.Call{
+				mockPostgres.EXPECT().
+					BalanceSnapshotLatest().
+					Return(test.latest, test.latestErr).
+					Times(1),
+			}
+
+			for _, snapshotAt := range test.snapshots {
+				calls = append(calls, mockPostgres.EXPECT().
+					BalanceSnapshotCreate(snapshotAt).
+					Return(int64(2), test.createErr).
+					Times(1))
+			}
+
+			gomock.InOrder(calls...)
+
+			snapshotter := &Snapshotter{db: mockPostgres, logger: zapLogger, delay: 5 * time.Minute}
+			snapshotter.sweep(test.now)
+		})
+	}
+}
+
+func TestMidnight(t *testing.T) {
+	t.Parallel()
+
+	toronto := time.FixedZone("EDT", -4*60*60)
+
+	require.Equal(t, time.Date(2023, time.June, 5, 0, 0, 0, 0, time.UTC),
+		midnight(time.Date(2023, time.June, 5, 23, 59, 59, 0, time.UTC)), "end of day mismatch.")
+	require.Equal(t, time.Date(2023, time.June, 6, 0, 0, 0, 0, time.UTC),
+		midnight(time.Date(2023, time.June, 5, 21, 0, 0, 0, toronto)), "time zone mismatch.")
+}